@@ -0,0 +1,312 @@
+package blocklog
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/pactus-project/pactus/state"
+	"github.com/pactus-project/pactus/types/certificate"
+	"github.com/pactus-project/pactus/util/logger"
+	"github.com/pactus-project/pactus/util/recovery"
+)
+
+// checkInterval is the interval for checking the new committed blocks.
+const checkInterval = time.Second
+
+// BlockLog appends the committed blocks to the log.
+// It follows the committed blocks of the state, so it catches up after a restart,
+// and a slow disk doesn't slow down committing the blocks.
+type BlockLog struct {
+	lk sync.Mutex
+
+	ctx         context.Context
+	cancel      context.CancelFunc
+	config      *Config
+	state       state.Facade
+	dir         string
+	segments    []segment
+	file        *os.File // The last segment, nil if no segment is created yet
+	fileSize    int64
+	lastHeight  uint32
+	logger      *logger.SubLogger
+	lastFailure error
+}
+
+func NewBlockLog(conf *Config, st state.Facade) *BlockLog {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	return &BlockLog{
+		ctx:    ctx,
+		cancel: cancel,
+		config: conf,
+		state:  st,
+		dir:    conf.LogPath(),
+		logger: logger.NewSubLogger("_blocklog", nil),
+	}
+}
+
+// Start opens the log and starts appending the committed blocks.
+// If the log is empty, it starts from the next committed block.
+func (l *BlockLog) Start() error {
+	if !l.config.Enable {
+		return nil
+	}
+
+	if err := l.open(); err != nil {
+		return err
+	}
+
+	go recovery.Loop("blocklog", l.logger, l.appendLoop)
+
+	l.logger.Info("block log started", "path", l.dir, "lastHeight", l.LastHeight())
+
+	return nil
+}
+
+func (l *BlockLog) Stop() {
+	l.cancel()
+
+	l.lk.Lock()
+	defer l.lk.Unlock()
+
+	if l.file != nil {
+		if err := l.file.Close(); err != nil {
+			l.logger.Warn("unable to close the block log", "error", err)
+		}
+		l.file = nil
+	}
+}
+
+// LastHeight returns the height of the last block in the log.
+func (l *BlockLog) LastHeight() uint32 {
+	l.lk.Lock()
+	defer l.lk.Unlock()
+
+	return l.lastHeight
+}
+
+// open opens the last segment of the log, and recovers it if the node is crashed
+// while a record was being written.
+func (l *BlockLog) open() error {
+	l.lk.Lock()
+	defer l.lk.Unlock()
+
+	if err := os.MkdirAll(l.dir, 0o750); err != nil {
+		return err
+	}
+
+	segments, err := listSegments(l.dir)
+	if err != nil {
+		return err
+	}
+	l.segments = segments
+
+	if len(segments) == 0 {
+		l.lastHeight = l.state.LastBlockHeight()
+
+		return nil
+	}
+
+	last := segments[len(segments)-1]
+	file, err := os.OpenFile(segmentPath(l.dir, last), os.O_RDWR, 0o600)
+	if err != nil {
+		return err
+	}
+
+	l.lastHeight = last.firstHeight - 1
+	offset := int64(0)
+	for {
+		rec, size, err := readRecordAt(file, offset)
+		if err != nil {
+			if !errors.Is(err, errIncomplete) && !errors.Is(err, ErrCorrupted) {
+				_ = file.Close()
+
+				return err
+			}
+
+			break
+		}
+		if rec.Height != l.lastHeight+1 {
+			break
+		}
+
+		l.lastHeight = rec.Height
+		offset += size
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		_ = file.Close()
+
+		return err
+	}
+	if info.Size() > offset {
+		l.logger.Warn("truncating the incomplete record of the block log",
+			"segment", last.name, "offset", offset, "size", info.Size())
+
+		if err := file.Truncate(offset); err != nil {
+			_ = file.Close()
+
+			return err
+		}
+	}
+
+	l.file = file
+	l.fileSize = offset
+
+	return nil
+}
+
+func (l *BlockLog) appendLoop() {
+	ticker := time.NewTicker(checkInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-l.ctx.Done():
+			return
+
+		case <-ticker.C:
+			l.appendNewBlocks()
+		}
+	}
+}
+
+// appendNewBlocks appends the blocks that are committed since the last check.
+func (l *BlockLog) appendNewBlocks() {
+	l.lk.Lock()
+	defer l.lk.Unlock()
+
+	lastHeight := l.state.LastBlockHeight()
+	for height := l.lastHeight + 1; height <= lastHeight; height++ {
+		if err := l.appendBlock(height); err != nil {
+			// The failure is logged once, until it is resolved.
+			if l.lastFailure == nil || l.lastFailure.Error() != err.Error() {
+				l.logger.Error("unable to append block to the block log", "height", height, "error", err)
+			}
+			l.lastFailure = err
+
+			return
+		}
+		l.lastFailure = nil
+	}
+}
+
+func (l *BlockLog) appendBlock(height uint32) error {
+	committedBlock := l.state.CommittedBlock(height)
+	if committedBlock == nil {
+		return fmt.Errorf("block not found")
+	}
+
+	cert, err := l.certificate(height)
+	if err != nil {
+		return err
+	}
+	w := bytes.NewBuffer(make([]byte, 0, cert.SerializeSize()))
+	if err := cert.Encode(w); err != nil {
+		return err
+	}
+
+	rec := &Record{
+		Height:          height,
+		BlockData:       committedBlock.Data,
+		CertificateData: w.Bytes(),
+	}
+
+	return l.append(rec)
+}
+
+// certificate returns the certificate of the block at the height.
+// It is the last certificate for the last block,
+// otherwise it is the previous certificate of the next block.
+func (l *BlockLog) certificate(height uint32) (*certificate.Certificate, error) {
+	if cert := l.state.LastCertificate(); cert != nil && cert.Height() == height {
+		return cert, nil
+	}
+
+	nextBlock := l.state.CommittedBlock(height + 1)
+	if nextBlock == nil {
+		return nil, fmt.Errorf("certificate not found")
+	}
+	blk, err := nextBlock.ToBlock()
+	if err != nil {
+		return nil, err
+	}
+	cert := blk.PrevCertificate()
+	if cert == nil || cert.Height() != height {
+		return nil, fmt.Errorf("certificate not found")
+	}
+
+	return cert, nil
+}
+
+func (l *BlockLog) append(rec *Record) error {
+	if l.file == nil || l.fileSize >= l.config.SegmentSize() {
+		if err := l.rotate(rec.Height); err != nil {
+			return err
+		}
+	}
+
+	data := rec.encode()
+	if _, err := l.file.WriteAt(data, l.fileSize); err != nil {
+		// Dropping the partially written record, the record is written again on the next try.
+		_ = l.file.Truncate(l.fileSize)
+
+		return err
+	}
+	if err := l.file.Sync(); err != nil {
+		return err
+	}
+
+	l.fileSize += int64(len(data))
+	l.lastHeight = rec.Height
+
+	return nil
+}
+
+// rotate closes the current segment and starts a new segment from the height.
+func (l *BlockLog) rotate(firstHeight uint32) error {
+	seg := segment{firstHeight: firstHeight, name: segmentName(firstHeight)}
+	file, err := os.OpenFile(segmentPath(l.dir, seg), os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0o600)
+	if err != nil {
+		return err
+	}
+
+	if l.file != nil {
+		if err := l.file.Close(); err != nil {
+			l.logger.Warn("unable to close the block log segment", "error", err)
+		}
+	}
+
+	l.file = file
+	l.fileSize = 0
+	l.segments = append(l.segments, seg)
+	l.logger.Debug("new block log segment", "segment", seg.name)
+
+	l.removeOldSegments()
+
+	return nil
+}
+
+// removeOldSegments removes the oldest segments, if there are more than the maximum segments.
+func (l *BlockLog) removeOldSegments() {
+	if l.config.MaxSegments == 0 {
+		return
+	}
+
+	for len(l.segments) > l.config.MaxSegments {
+		oldest := l.segments[0]
+		if err := os.Remove(segmentPath(l.dir, oldest)); err != nil && !errors.Is(err, os.ErrNotExist) {
+			l.logger.Warn("unable to remove the block log segment", "segment", oldest.name, "error", err)
+
+			return
+		}
+		l.segments = l.segments[1:]
+		l.logger.Info("block log segment removed", "segment", oldest.name)
+	}
+}
@@ -0,0 +1,192 @@
+package blocklog
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/pactus-project/pactus/state"
+	"github.com/pactus-project/pactus/util"
+	"github.com/pactus-project/pactus/util/testsuite"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testData struct {
+	*testsuite.TestSuite
+
+	config *Config
+	state  *state.MockState
+	log    *BlockLog
+}
+
+func setup(t *testing.T, conf *Config) *testData {
+	t.Helper()
+
+	ts := testsuite.NewTestSuite(t)
+	if conf == nil {
+		conf = DefaultConfig()
+		conf.Enable = true
+		conf.Path = util.TempDirPath()
+	}
+
+	st := state.MockingState(ts)
+	st.CommitTestBlocks(5)
+
+	td := &testData{
+		TestSuite: ts,
+		config:    conf,
+		state:     st,
+		log:       NewBlockLog(conf, st),
+	}
+	require.NoError(t, td.log.open())
+	t.Cleanup(td.log.Stop)
+
+	return td
+}
+
+func (td *testData) readAll(t *testing.T, from uint32) []*Record {
+	t.Helper()
+
+	reader, err := OpenReader(td.config.LogPath(), from)
+	require.NoError(t, err)
+	defer func() { _ = reader.Close() }()
+
+	records := []*Record{}
+	for {
+		rec, err := reader.Next()
+		if err == io.EOF {
+			return records
+		}
+		require.NoError(t, err)
+		records = append(records, rec)
+	}
+}
+
+func TestConfigBasicCheck(t *testing.T) {
+	conf := DefaultConfig()
+	assert.NoError(t, conf.BasicCheck())
+
+	conf.Enable = true
+	assert.NoError(t, conf.BasicCheck())
+
+	conf.SegmentSizeMB = 0
+	assert.Error(t, conf.BasicCheck())
+
+	conf = DefaultConfig()
+	conf.Enable = true
+	conf.MaxSegments = -1
+	assert.Error(t, conf.BasicCheck())
+
+	conf = DefaultConfig()
+	conf.Enable = true
+	conf.Path = ""
+	assert.Error(t, conf.BasicCheck())
+}
+
+func TestAppendBlocks(t *testing.T) {
+	td := setup(t, nil)
+
+	// The empty log starts from the next committed block.
+	assert.Equal(t, uint32(5), td.log.LastHeight())
+
+	td.state.CommitTestBlocks(3)
+	td.log.appendNewBlocks()
+	assert.Equal(t, uint32(8), td.log.LastHeight())
+
+	records := td.readAll(t, 6)
+	require.Len(t, records, 3)
+	for i, rec := range records {
+		height := uint32(6 + i)
+		assert.Equal(t, height, rec.Height)
+
+		committed := td.state.CommittedBlock(height)
+		assert.Equal(t, committed.Data, rec.BlockData)
+
+		blk, err := rec.Block()
+		require.NoError(t, err)
+		assert.Equal(t, committed.BlockHash, blk.Hash())
+
+		cert, err := rec.Certificate()
+		require.NoError(t, err)
+		assert.Equal(t, height, cert.Height())
+	}
+
+	lastCert, _ := records[2].Certificate()
+	assert.Equal(t, td.state.LastCertificate().Hash(), lastCert.Hash())
+}
+
+func TestMissingBlock(t *testing.T) {
+	td := setup(t, nil)
+
+	td.log.lastHeight = 2
+	delete(td.state.TestStore.Blocks, 3)
+	td.log.appendNewBlocks()
+
+	assert.Equal(t, uint32(2), td.log.LastHeight())
+	assert.Error(t, td.log.lastFailure)
+}
+
+func TestRotateSegments(t *testing.T) {
+	conf := DefaultConfig()
+	conf.Enable = true
+	conf.Path = util.TempDirPath()
+	conf.MaxSegments = 3
+	td := setup(t, conf)
+
+	// One record per segment.
+	td.config.SegmentSizeMB = 0
+	td.state.CommitTestBlocks(5)
+	td.log.appendNewBlocks()
+
+	segments, err := listSegments(conf.LogPath())
+	require.NoError(t, err)
+	require.Len(t, segments, 3)
+	assert.Equal(t, segmentName(8), segments[0].name)
+	assert.Equal(t, segmentName(10), segments[2].name)
+
+	_, err = OpenReader(conf.LogPath(), 6)
+	assert.ErrorIs(t, err, ErrPruned)
+
+	records := td.readAll(t, 8)
+	require.Len(t, records, 3)
+	assert.Equal(t, uint32(10), records[2].Height)
+}
+
+func TestRecoverIncompleteRecord(t *testing.T) {
+	td := setup(t, nil)
+
+	td.state.CommitTestBlocks(2)
+	td.log.appendNewBlocks()
+	td.log.Stop()
+
+	// The node is crashed while the record of the next block was being written.
+	segPath := filepath.Join(td.config.LogPath(), segmentName(6))
+	info, err := os.Stat(segPath)
+	require.NoError(t, err)
+	file, err := os.OpenFile(segPath, os.O_WRONLY|os.O_APPEND, 0o600)
+	require.NoError(t, err)
+	_, err = file.Write([]byte{0x10, 0x00, 0x00})
+	require.NoError(t, err)
+	require.NoError(t, file.Close())
+
+	// The reader waits for the incomplete record.
+	records := td.readAll(t, 6)
+	assert.Len(t, records, 2)
+
+	td.state.CommitTestBlocks(1)
+	log := NewBlockLog(td.config, td.state)
+	require.NoError(t, log.open())
+	defer log.Stop()
+	assert.Equal(t, uint32(7), log.LastHeight())
+
+	info2, err := os.Stat(segPath)
+	require.NoError(t, err)
+	assert.Equal(t, info.Size(), info2.Size())
+
+	log.appendNewBlocks()
+	records = td.readAll(t, 6)
+	require.Len(t, records, 3)
+	assert.Equal(t, uint32(8), records[2].Height)
+}
@@ -0,0 +1,51 @@
+package blocklog
+
+import (
+	"github.com/pactus-project/pactus/util"
+	"github.com/pactus-project/pactus/util/errors"
+)
+
+type Config struct {
+	Enable        bool   `toml:"enable"`
+	Path          string `toml:"path"`
+	SegmentSizeMB int    `toml:"segment_size_mb"`
+	MaxSegments   int    `toml:"max_segments"`
+}
+
+func DefaultConfig() *Config {
+	return &Config{
+		Enable:        false,
+		Path:          "block_log",
+		SegmentSizeMB: 256,
+		MaxSegments:   0,
+	}
+}
+
+// BasicCheck performs basic checks on the configuration.
+func (conf *Config) BasicCheck() error {
+	if !conf.Enable {
+		return nil
+	}
+
+	if conf.Path == "" {
+		return errors.Errorf(errors.ErrInvalidConfig, "block log path is not set")
+	}
+	if conf.SegmentSizeMB <= 0 {
+		return errors.Errorf(errors.ErrInvalidConfig, "block log segment size should be positive")
+	}
+	if conf.MaxSegments < 0 {
+		return errors.Errorf(errors.ErrInvalidConfig, "block log max segments can't be negative")
+	}
+
+	return nil
+}
+
+// LogPath returns the absolute path of the block log directory.
+func (conf *Config) LogPath() string {
+	return util.MakeAbs(conf.Path)
+}
+
+// SegmentSize returns the size of a segment, in bytes, that the next records go to a new segment.
+func (conf *Config) SegmentSize() int64 {
+	return int64(conf.SegmentSizeMB) << 20
+}
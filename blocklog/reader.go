@@ -0,0 +1,170 @@
+package blocklog
+
+import (
+	"errors"
+	"io"
+	"os"
+)
+
+// Reader reads the records of the log, and it can tail the log while the node appends to it.
+// A consumer can keep the height of the last processed record and resume from the next height.
+type Reader struct {
+	dir       string
+	seg       segment
+	file      *os.File
+	exhausted bool // Whether the current segment is completely read
+	offset    int64
+	next      uint32
+	current   Position
+}
+
+// OpenReader opens the log in the directory for reading the records from the height.
+// If the height is not in the log yet, Next returns io.EOF until it is appended.
+func OpenReader(dir string, height uint32) (*Reader, error) {
+	r := &Reader{
+		dir:  dir,
+		next: height,
+	}
+
+	segments, err := listSegments(dir)
+	if err != nil {
+		return nil, err
+	}
+	if len(segments) > 0 && height < segments[0].firstHeight {
+		return nil, ErrPruned
+	}
+
+	return r, nil
+}
+
+// Next returns the next record of the log.
+// It returns io.EOF if there is no complete record yet, and the caller can try again later.
+func (r *Reader) Next() (*Record, error) {
+	for {
+		if r.file == nil {
+			opened, err := r.openSegment()
+			if err != nil {
+				return nil, err
+			}
+			if !opened {
+				return nil, io.EOF
+			}
+		}
+
+		rec, size, err := readRecordAt(r.file, r.offset)
+		if errors.Is(err, errIncomplete) {
+			// The segment is completed once the next segment is created.
+			hasNext, err := r.hasNextSegment()
+			if err != nil {
+				return nil, err
+			}
+			if !hasNext {
+				return nil, io.EOF
+			}
+
+			// Checking the current segment again, before moving to the next one.
+			rec, size, err = readRecordAt(r.file, r.offset)
+			if errors.Is(err, errIncomplete) {
+				_ = r.file.Close()
+				r.file = nil
+				r.exhausted = true
+
+				continue
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		position := Position{Segment: r.seg.name, Offset: r.offset}
+		r.offset += size
+		if rec.Height < r.next {
+			continue
+		}
+		if rec.Height > r.next {
+			return nil, ErrCorrupted
+		}
+
+		r.next = rec.Height + 1
+		r.current = position
+
+		return rec, nil
+	}
+}
+
+// NextHeight returns the height of the record that is returned by the next call of Next.
+func (r *Reader) NextHeight() uint32 {
+	return r.next
+}
+
+// Position returns the position of the last record that is returned by Next.
+func (r *Reader) Position() Position {
+	return r.current
+}
+
+func (r *Reader) Close() error {
+	if r.file == nil {
+		return nil
+	}
+	err := r.file.Close()
+	r.file = nil
+
+	return err
+}
+
+// openSegment opens the segment that contains the next height.
+// It returns false if there is no such segment yet.
+func (r *Reader) openSegment() (bool, error) {
+	segments, err := listSegments(r.dir)
+	if err != nil {
+		return false, err
+	}
+
+	found := -1
+	for i, seg := range segments {
+		if seg.firstHeight <= r.next {
+			found = i
+		}
+	}
+	if r.exhausted && found != -1 && segments[found] == r.seg {
+		// There is a gap between the segments, moving to the next one.
+		found++
+		if found == len(segments) {
+			return false, nil
+		}
+	}
+	if found == -1 {
+		if len(segments) > 0 && r.next < segments[0].firstHeight {
+			return false, ErrPruned
+		}
+
+		return false, nil
+	}
+
+	file, err := os.Open(segmentPath(r.dir, segments[found]))
+	if err != nil {
+		return false, err
+	}
+
+	r.seg = segments[found]
+	r.file = file
+	r.exhausted = false
+	r.offset = 0
+
+	return true, nil
+}
+
+func (r *Reader) hasNextSegment() (bool, error) {
+	segments, err := listSegments(r.dir)
+	if err != nil {
+		return false, err
+	}
+
+	for _, seg := range segments {
+		if seg.firstHeight > r.seg.firstHeight {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
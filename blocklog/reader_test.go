@@ -0,0 +1,92 @@
+package blocklog
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReaderTailing(t *testing.T) {
+	td := setup(t, nil)
+
+	reader, err := OpenReader(td.config.LogPath(), 6)
+	require.NoError(t, err)
+	defer func() { _ = reader.Close() }()
+
+	t.Run("Empty log", func(t *testing.T) {
+		_, err := reader.Next()
+		assert.ErrorIs(t, err, io.EOF)
+	})
+
+	t.Run("Reading the appended blocks", func(t *testing.T) {
+		td.state.CommitTestBlocks(1)
+		td.log.appendNewBlocks()
+
+		rec, err := reader.Next()
+		require.NoError(t, err)
+		assert.Equal(t, uint32(6), rec.Height)
+		assert.Equal(t, Position{Segment: segmentName(6), Offset: 0}, reader.Position())
+		assert.Equal(t, uint32(7), reader.NextHeight())
+
+		_, err = reader.Next()
+		assert.ErrorIs(t, err, io.EOF)
+	})
+
+	t.Run("Following the new segments", func(t *testing.T) {
+		td.config.SegmentSizeMB = 0
+		td.state.CommitTestBlocks(2)
+		td.log.appendNewBlocks()
+
+		rec, err := reader.Next()
+		require.NoError(t, err)
+		assert.Equal(t, uint32(7), rec.Height)
+		assert.Equal(t, Position{Segment: segmentName(7), Offset: 0}, reader.Position())
+
+		rec, err = reader.Next()
+		require.NoError(t, err)
+		assert.Equal(t, uint32(8), rec.Height)
+
+		_, err = reader.Next()
+		assert.ErrorIs(t, err, io.EOF)
+	})
+}
+
+func TestReaderResume(t *testing.T) {
+	td := setup(t, nil)
+
+	td.state.CommitTestBlocks(10)
+	td.log.appendNewBlocks()
+
+	reader, err := OpenReader(td.config.LogPath(), 12)
+	require.NoError(t, err)
+	defer func() { _ = reader.Close() }()
+
+	rec, err := reader.Next()
+	require.NoError(t, err)
+	assert.Equal(t, uint32(12), rec.Height)
+	assert.Positive(t, reader.Position().Offset)
+}
+
+func TestReaderCorruptedRecord(t *testing.T) {
+	td := setup(t, nil)
+
+	td.state.CommitTestBlocks(1)
+	td.log.appendNewBlocks()
+
+	segPath := filepath.Join(td.config.LogPath(), segmentName(6))
+	data, err := os.ReadFile(segPath)
+	require.NoError(t, err)
+	data[len(data)-1] ^= 0xff
+	require.NoError(t, os.WriteFile(segPath, data, 0o600))
+
+	reader, err := OpenReader(td.config.LogPath(), 6)
+	require.NoError(t, err)
+	defer func() { _ = reader.Close() }()
+
+	_, err = reader.Next()
+	assert.ErrorIs(t, err, ErrCorrupted)
+}
@@ -0,0 +1,192 @@
+// Package blocklog appends the committed blocks and their certificates to a rotating binary log,
+// so the downstream consumers can tail the log and resume from any height,
+// without depending on the availability of the node RPC.
+//
+// The log is a directory of segment files. Each segment is named by the height of its first block,
+// like `0000012345.blocklog`, and contains the records of the consecutive blocks:
+//
+//	record:  <payload_length: uint32><checksum: uint32><payload>
+//	payload: <height: uint32><block_length: uint32><block><certificate>
+//
+// The integers are little-endian and the checksum is the CRC-32 (Castagnoli) of the payload.
+// The block and the certificate are in their wire format.
+// Each record is synced to the disk once it is written, but a consumer may see a partially
+// written record at the end of the last segment, and it should retry reading it later.
+package blocklog
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/pactus-project/pactus/types/block"
+	"github.com/pactus-project/pactus/types/certificate"
+)
+
+const (
+	segmentExt       = ".blocklog"
+	recordHeaderSize = 8
+	// maxPayloadSize protects the readers against the corrupted lengths.
+	maxPayloadSize = 64 << 20
+)
+
+var (
+	// ErrPruned describes an error in which the height is before the first segment of the log.
+	ErrPruned = errors.New("height is pruned from the block log")
+
+	// ErrCorrupted describes an error in which a record of the log is corrupted.
+	ErrCorrupted = errors.New("block log record is corrupted")
+
+	// errIncomplete means the record is not completely written yet.
+	errIncomplete = errors.New("incomplete record")
+
+	crcTable = crc32.MakeTable(crc32.Castagnoli)
+)
+
+// Record is a committed block and its certificate in the log.
+type Record struct {
+	Height          uint32
+	BlockData       []byte
+	CertificateData []byte
+}
+
+// Block decodes the block of the record.
+func (r *Record) Block() (*block.Block, error) {
+	return block.FromBytes(r.BlockData)
+}
+
+// Certificate decodes the certificate of the record.
+func (r *Record) Certificate() (*certificate.Certificate, error) {
+	cert := new(certificate.Certificate)
+	if err := cert.Decode(bytes.NewReader(r.CertificateData)); err != nil {
+		return nil, err
+	}
+
+	return cert, nil
+}
+
+// Position is the location of a record in the log.
+type Position struct {
+	Segment string // Name of the segment file
+	Offset  int64  // Offset of the record in the segment file
+}
+
+func (p Position) String() string {
+	return fmt.Sprintf("%s:%d", p.Segment, p.Offset)
+}
+
+func (r *Record) encode() []byte {
+	payloadSize := 8 + len(r.BlockData) + len(r.CertificateData)
+	buf := make([]byte, recordHeaderSize+payloadSize)
+	payload := buf[recordHeaderSize:]
+
+	binary.LittleEndian.PutUint32(payload[0:4], r.Height)
+	binary.LittleEndian.PutUint32(payload[4:8], uint32(len(r.BlockData)))
+	copy(payload[8:], r.BlockData)
+	copy(payload[8+len(r.BlockData):], r.CertificateData)
+
+	binary.LittleEndian.PutUint32(buf[0:4], uint32(payloadSize))
+	binary.LittleEndian.PutUint32(buf[4:8], crc32.Checksum(payload, crcTable))
+
+	return buf
+}
+
+// readRecordAt reads the record at the offset of the file.
+// It returns the record and the size of the record in the file.
+func readRecordAt(file *os.File, offset int64) (*Record, int64, error) {
+	header := make([]byte, recordHeaderSize)
+	n, err := file.ReadAt(header, offset)
+	if n < recordHeaderSize {
+		if err == nil || errors.Is(err, io.EOF) {
+			return nil, 0, errIncomplete
+		}
+
+		return nil, 0, err
+	}
+
+	payloadSize := binary.LittleEndian.Uint32(header[0:4])
+	checksum := binary.LittleEndian.Uint32(header[4:8])
+	if payloadSize < 8 || payloadSize > maxPayloadSize {
+		return nil, 0, ErrCorrupted
+	}
+
+	payload := make([]byte, payloadSize)
+	n, err = file.ReadAt(payload, offset+recordHeaderSize)
+	if n < len(payload) {
+		if err == nil || errors.Is(err, io.EOF) {
+			return nil, 0, errIncomplete
+		}
+
+		return nil, 0, err
+	}
+
+	if crc32.Checksum(payload, crcTable) != checksum {
+		return nil, 0, ErrCorrupted
+	}
+
+	blockSize := binary.LittleEndian.Uint32(payload[4:8])
+	if blockSize > payloadSize-8 {
+		return nil, 0, ErrCorrupted
+	}
+
+	rec := &Record{
+		Height:          binary.LittleEndian.Uint32(payload[0:4]),
+		BlockData:       payload[8 : 8+blockSize],
+		CertificateData: payload[8+blockSize:],
+	}
+
+	return rec, recordHeaderSize + int64(payloadSize), nil
+}
+
+type segment struct {
+	firstHeight uint32
+	name        string
+}
+
+func segmentName(firstHeight uint32) string {
+	return fmt.Sprintf("%010d%s", firstHeight, segmentExt)
+}
+
+// listSegments returns the segments of the log, sorted by their first height.
+func listSegments(dir string) ([]segment, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	segments := make([]segment, 0, len(entries))
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasSuffix(name, segmentExt) {
+			continue
+		}
+
+		firstHeight, err := strconv.ParseUint(strings.TrimSuffix(name, segmentExt), 10, 32)
+		if err != nil {
+			continue
+		}
+		segments = append(segments, segment{firstHeight: uint32(firstHeight), name: name})
+	}
+
+	sort.Slice(segments, func(i, j int) bool {
+		return segments[i].firstHeight < segments[j].firstHeight
+	})
+
+	return segments, nil
+}
+
+func segmentPath(dir string, seg segment) string {
+	return filepath.Join(dir, seg.name)
+}
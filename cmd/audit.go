@@ -0,0 +1,93 @@
+package cmd
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"github.com/pactus-project/pactus/config"
+	"github.com/pactus-project/pactus/util/audit"
+)
+
+// PactusAuditLogPath returns the path of the audit log in the data directory of the node.
+func PactusAuditLogPath(workingDir string) (string, error) {
+	conf, err := config.LoadFromFile(PactusConfigPath(workingDir), false, config.DefaultConfigMainnet())
+	if err != nil {
+		return "", err
+	}
+
+	dataPath := conf.Store.Path
+	if !filepath.IsAbs(dataPath) {
+		dataPath = filepath.Join(workingDir, dataPath)
+	}
+
+	return filepath.Join(dataPath, audit.Filename), nil
+}
+
+// ExportAuditLog verifies the chain of the audit log and writes its entries in the format,
+// which is `json` for one JSON object per line, or `csv`.
+// Nothing is written if the chain is broken.
+// It returns the last entry, whose hash attests all the exported entries.
+func ExportAuditLog(path, format string, w io.Writer) (audit.Entry, error) {
+	if format != "json" && format != "csv" {
+		return audit.Entry{}, fmt.Errorf("unsupported format: %s", format)
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return audit.Entry{}, err
+	}
+	defer func() {
+		_ = file.Close()
+	}()
+
+	entries := []audit.Entry{}
+	last, err := audit.Verify(file, func(entry audit.Entry) error {
+		entries = append(entries, entry)
+
+		return nil
+	})
+	if err != nil {
+		return last, err
+	}
+
+	if format == "json" {
+		enc := json.NewEncoder(w)
+		for _, entry := range entries {
+			if err := enc.Encode(entry); err != nil {
+				return last, err
+			}
+		}
+
+		return last, nil
+	}
+
+	csvw := csv.NewWriter(w)
+	_ = csvw.Write([]string{
+		"seq", "time", "kind", "subject", "signer", "digest",
+		"height", "round", "requester", "prev_hash", "hash",
+	})
+	for _, entry := range entries {
+		_ = csvw.Write([]string{
+			strconv.FormatUint(entry.Seq, 10),
+			entry.Time.UTC().Format(time.RFC3339Nano),
+			string(entry.Kind),
+			entry.Subject,
+			entry.Signer,
+			entry.Digest,
+			strconv.FormatUint(uint64(entry.Height), 10),
+			strconv.FormatInt(int64(entry.Round), 10),
+			entry.Requester,
+			entry.PrevHash,
+			entry.Hash,
+		})
+	}
+	csvw.Flush()
+
+	return last, csvw.Error()
+}
@@ -0,0 +1,68 @@
+package cmd
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/pactus-project/pactus/network"
+	"github.com/pactus-project/pactus/util"
+	"github.com/pactus-project/pactus/util/audit"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExportAuditLog(t *testing.T) {
+	outputDir := util.TempDirPath()
+	nodes, err := GenerateLocalnet(outputDir, 1, "secret", false, network.ChaosConfig{})
+	require.NoError(t, err)
+
+	logPath, err := PactusAuditLogPath(nodes[0].WorkingDir)
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(nodes[0].WorkingDir, "data", audit.Filename), logPath)
+
+	require.NoError(t, os.MkdirAll(filepath.Dir(logPath), 0o750))
+	log, err := audit.Open(logPath)
+	require.NoError(t, err)
+	require.NoError(t, log.Append(audit.Entry{Kind: audit.KindVote, Signer: "val-1", Height: 10}))
+	require.NoError(t, log.Append(audit.Entry{Kind: audit.KindTransaction, Signer: "acc-1", Requester: "wallet"}))
+	require.NoError(t, log.Close())
+
+	t.Run("JSON", func(t *testing.T) {
+		buf := bytes.Buffer{}
+		last, err := ExportAuditLog(logPath, "json", &buf)
+		require.NoError(t, err)
+		assert.Equal(t, uint64(2), last.Seq)
+		assert.Len(t, strings.Split(strings.TrimSpace(buf.String()), "\n"), 2)
+	})
+
+	t.Run("CSV", func(t *testing.T) {
+		buf := bytes.Buffer{}
+		_, err := ExportAuditLog(logPath, "csv", &buf)
+		require.NoError(t, err)
+
+		lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+		require.Len(t, lines, 3)
+		assert.True(t, strings.HasPrefix(lines[0], "seq,time,kind"))
+		assert.Contains(t, lines[1], ",vote,")
+	})
+
+	t.Run("Unsupported format", func(t *testing.T) {
+		_, err := ExportAuditLog(logPath, "xml", &bytes.Buffer{})
+		assert.Error(t, err)
+	})
+
+	t.Run("Broken chain", func(t *testing.T) {
+		data, err := os.ReadFile(logPath)
+		require.NoError(t, err)
+		tampered := strings.Replace(string(data), `"signer":"acc-1"`, `"signer":"acc-2"`, 1)
+		require.NoError(t, os.WriteFile(logPath, []byte(tampered), 0o600))
+
+		buf := bytes.Buffer{}
+		_, err = ExportAuditLog(logPath, "json", &buf)
+		assert.ErrorIs(t, err, audit.ChainError{Seq: 2, Reason: "hash mismatch"})
+		assert.Zero(t, buf.Len())
+	})
+}
@@ -0,0 +1,211 @@
+package cmd
+
+import (
+	"crypto/rand"
+	"errors"
+	"time"
+
+	"github.com/pactus-project/pactus/crypto/bls"
+	"github.com/pactus-project/pactus/execution"
+	"github.com/pactus-project/pactus/genesis"
+	"github.com/pactus-project/pactus/state"
+	"github.com/pactus-project/pactus/store"
+	"github.com/pactus-project/pactus/sync/bundle/message"
+	"github.com/pactus-project/pactus/txpool"
+	"github.com/pactus-project/pactus/types/block"
+	"github.com/pactus-project/pactus/types/param"
+	"github.com/pactus-project/pactus/types/tx"
+	"github.com/pactus-project/pactus/types/tx/payload"
+	"github.com/pactus-project/pactus/util"
+)
+
+// BenchProposeConfig defines the size of the block proposal benchmark.
+type BenchProposeConfig struct {
+	Transactions int
+	Accounts     int
+	Rounds       int
+}
+
+// BenchStat holds the measured durations of one step of the benchmark.
+type BenchStat struct {
+	MinMs     float64 `json:"min_ms"`
+	AvgMs     float64 `json:"avg_ms"`
+	MaxMs     float64 `json:"max_ms"`
+	TxsPerSec float64 `json:"txs_per_sec"`
+}
+
+// BenchProposeResult holds the results of the block proposal benchmark.
+type BenchProposeResult struct {
+	PoolSize   int       `json:"pool_size"`
+	Accounts   int       `json:"accounts"`
+	Rounds     int       `json:"rounds"`
+	BlockTxs   int       `json:"block_txs"`
+	BlockBytes int       `json:"block_bytes"`
+	Propose    BenchStat `json:"propose"`
+	MerkleRoot BenchStat `json:"merkle_root"`
+	Validate   BenchStat `json:"validate"`
+}
+
+// BenchPropose fills a synthetic transaction pool and measures the block assembly,
+// the Merkle root computation and the block validation.
+// The state is kept in memory, so the benchmark doesn't touch the disk.
+func BenchPropose(conf BenchProposeConfig) (*BenchProposeResult, error) {
+	if conf.Transactions <= 0 || conf.Accounts <= 0 || conf.Rounds <= 0 {
+		return nil, errors.New("the number of transactions, accounts and rounds should be positive")
+	}
+
+	valKey, err := randValidatorKey()
+	if err != nil {
+		return nil, err
+	}
+
+	senders := make([]*bls.PrivateKey, 0, conf.Accounts)
+	allocs := make([]genesis.Allocation, 0, conf.Accounts)
+	for i := 0; i < conf.Accounts; i++ {
+		prv, err := randPrivateKey()
+		if err != nil {
+			return nil, err
+		}
+		senders = append(senders, prv)
+		allocs = append(allocs, genesis.Allocation{
+			Address: prv.PublicKeyNative().AccountAddress(),
+			Balance: 1_000_000e9, // 1,000,000 PAC
+		})
+	}
+
+	params := param.DefaultParams()
+	genTime := util.RoundNow(params.BlockIntervalInSecond).Add(-params.BlockInterval())
+	totalSupply := int64(conf.Accounts+1) * 1_000_000e9
+	genDoc, err := genesis.MakeGenesisFromAllocations(genTime, totalSupply, allocs,
+		[]*bls.PublicKey{valKey.PublicKey()}, params)
+	if err != nil {
+		return nil, err
+	}
+
+	storeConf := store.DefaultConfig()
+	storeConf.InMemory = true
+	str, err := store.NewStore(storeConf)
+	if err != nil {
+		return nil, err
+	}
+
+	poolConf := txpool.DefaultConfig()
+	poolConf.MaxSize = conf.Transactions * 2
+	pool := txpool.NewTxPool(poolConf, make(chan message.Message, 1))
+
+	st, err := state.LoadOrNewState(state.DefaultConfig(), genDoc, []*bls.ValidatorKey{valKey}, str, pool, nil)
+	if err != nil {
+		_ = str.Close()
+
+		return nil, err
+	}
+	defer st.Close()
+
+	for i := 0; i < conf.Transactions; i++ {
+		sender := senders[i%len(senders)]
+		receiver := senders[(i+1)%len(senders)]
+		amount := 1e9 + int64(i)
+		fee, err := execution.CalculatePayloadFee(amount, payload.TypeTransfer, params)
+		if err != nil {
+			return nil, err
+		}
+		trx := tx.NewTransferTx(1,
+			sender.PublicKeyNative().AccountAddress(),
+			receiver.PublicKeyNative().AccountAddress(),
+			amount, fee, "")
+		trx.SetSignature(sender.Sign(trx.SignBytes()))
+		trx.SetPublicKey(sender.PublicKey())
+
+		if err := pool.AppendTx(trx); err != nil {
+			return nil, err
+		}
+	}
+
+	res := &BenchProposeResult{
+		PoolSize: pool.Size(),
+		Accounts: conf.Accounts,
+		Rounds:   conf.Rounds,
+	}
+	proposeTimes := make([]time.Duration, 0, conf.Rounds)
+	merkleTimes := make([]time.Duration, 0, conf.Rounds)
+	validateTimes := make([]time.Duration, 0, conf.Rounds)
+	for i := 0; i < conf.Rounds; i++ {
+		start := time.Now()
+		blk, err := st.ProposeBlock(bls.NewKeySigner(valKey), valKey.PublicKey().AccountAddress())
+		if err != nil {
+			return nil, err
+		}
+		proposeTimes = append(proposeTimes, time.Since(start))
+
+		start = time.Now()
+		blk.Transactions().Root()
+		merkleTimes = append(merkleTimes, time.Since(start))
+
+		// Decoding a fresh copy of the block, so the signatures are verified again,
+		// as they are when the block is received from the proposer.
+		data, err := blk.Bytes()
+		if err != nil {
+			return nil, err
+		}
+		received, err := block.FromBytes(data)
+		if err != nil {
+			return nil, err
+		}
+
+		start = time.Now()
+		if err := st.ValidateBlock(received, 0); err != nil {
+			return nil, err
+		}
+		validateTimes = append(validateTimes, time.Since(start))
+
+		res.BlockTxs = blk.Transactions().Len()
+		res.BlockBytes = len(data)
+	}
+
+	res.Propose = makeBenchStat(proposeTimes, res.BlockTxs)
+	res.MerkleRoot = makeBenchStat(merkleTimes, res.BlockTxs)
+	res.Validate = makeBenchStat(validateTimes, res.BlockTxs)
+
+	return res, nil
+}
+
+func makeBenchStat(durations []time.Duration, txs int) BenchStat {
+	minDur := durations[0]
+	maxDur := durations[0]
+	total := time.Duration(0)
+	for _, d := range durations {
+		minDur = util.Min(minDur, d)
+		maxDur = util.Max(maxDur, d)
+		total += d
+	}
+	avg := total / time.Duration(len(durations))
+
+	stat := BenchStat{
+		MinMs: float64(minDur.Microseconds()) / 1000,
+		AvgMs: float64(avg.Microseconds()) / 1000,
+		MaxMs: float64(maxDur.Microseconds()) / 1000,
+	}
+	if avg > 0 {
+		stat.TxsPerSec = float64(txs) / avg.Seconds()
+	}
+
+	return stat
+}
+
+func randPrivateKey() (*bls.PrivateKey, error) {
+	ikm := make([]byte, 32)
+	if _, err := rand.Read(ikm); err != nil {
+		return nil, err
+	}
+
+	return bls.KeyGen(ikm, nil)
+}
+
+func randValidatorKey() (*bls.ValidatorKey, error) {
+	prv, err := randPrivateKey()
+	if err != nil {
+		return nil, err
+	}
+
+	return bls.NewValidatorKey(prv), nil
+}
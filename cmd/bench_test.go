@@ -0,0 +1,27 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBenchPropose(t *testing.T) {
+	t.Run("Invalid config", func(t *testing.T) {
+		_, err := BenchPropose(BenchProposeConfig{Transactions: 10, Accounts: 0, Rounds: 1})
+		assert.Error(t, err)
+	})
+
+	t.Run("Ok", func(t *testing.T) {
+		res, err := BenchPropose(BenchProposeConfig{Transactions: 20, Accounts: 3, Rounds: 2})
+		require.NoError(t, err)
+
+		assert.Equal(t, 20, res.PoolSize)
+		assert.Equal(t, 21, res.BlockTxs, "transactions plus the subsidy transaction")
+		assert.Positive(t, res.BlockBytes)
+		assert.Positive(t, res.Propose.AvgMs)
+		assert.LessOrEqual(t, res.Propose.MinMs, res.Propose.MaxMs)
+		assert.LessOrEqual(t, res.Validate.MinMs, res.Validate.MaxMs)
+	})
+}
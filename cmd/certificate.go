@@ -0,0 +1,110 @@
+package cmd
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/pactus-project/pactus/crypto/hash"
+	"github.com/pactus-project/pactus/types/block"
+	"github.com/pactus-project/pactus/types/certificate/verifier"
+	"github.com/pactus-project/pactus/types/validator"
+)
+
+// CertificateVerification is the result of verifying a block certificate offline.
+type CertificateVerification struct {
+	// BlockHash is the hash of the block that is certified.
+	BlockHash hash.Hash
+	Height    uint32
+	Round     int16
+	// Signers are the committee validators that have signed the certificate.
+	Signers []*validator.Validator
+	// Absentees are the committee validators that have not signed the certificate.
+	Absentees []*validator.Validator
+	// Err is the reason that the certificate is not valid, or nil if it is valid.
+	Err error
+}
+
+// LoadCommitteeFile loads the committee validators from a JSON file,
+// which is an array of the hex-encoded validators.
+func LoadCommitteeFile(path string) ([]*validator.Validator, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	rawVals := []string{}
+	if err := json.Unmarshal(data, &rawVals); err != nil {
+		return nil, fmt.Errorf("invalid committee file: %w", err)
+	}
+
+	vals := make([]*validator.Validator, 0, len(rawVals))
+	for _, rawVal := range rawVals {
+		valData, err := hex.DecodeString(strings.TrimSpace(rawVal))
+		if err != nil {
+			return nil, fmt.Errorf("invalid validator %q: %w", rawVal, err)
+		}
+		val, err := validator.FromBytes(valData)
+		if err != nil {
+			return nil, fmt.Errorf("invalid validator %q: %w", rawVal, err)
+		}
+		vals = append(vals, val)
+	}
+
+	return vals, nil
+}
+
+// VerifyBlockCertificate verifies the certificate inside the hex-encoded block offline.
+// The certificate of a block is included in the next block, therefore the verified
+// certificate is the one that certifies the previous block.
+// The committee should be the committee at the time the previous block is committed.
+// It returns an error only if the block can't be decoded, or it has no certificate.
+func VerifyBlockCertificate(rawBlock string, committee []*validator.Validator) (*CertificateVerification, error) {
+	data, err := hex.DecodeString(strings.TrimSpace(rawBlock))
+	if err != nil {
+		return nil, err
+	}
+	blk, err := block.FromBytes(data)
+	if err != nil {
+		return nil, err
+	}
+
+	cert := blk.PrevCertificate()
+	if cert == nil {
+		return nil, verifier.ErrNoCertificate
+	}
+
+	res := &CertificateVerification{
+		BlockHash: blk.Header().PrevBlockHash(),
+		Height:    cert.Height(),
+		Round:     cert.Round(),
+		Signers:   []*validator.Validator{},
+		Absentees: []*validator.Validator{},
+	}
+
+	vals := make(map[int32]*validator.Validator, len(committee))
+	for _, val := range committee {
+		vals[val.Number()] = val
+	}
+	absentees := make(map[int32]bool, len(cert.Absentees()))
+	for _, num := range cert.Absentees() {
+		absentees[num] = true
+	}
+	for _, num := range cert.Committers() {
+		val, ok := vals[num]
+		if !ok {
+			continue
+		}
+		if absentees[num] {
+			res.Absentees = append(res.Absentees, val)
+		} else {
+			res.Signers = append(res.Signers, val)
+		}
+	}
+
+	res.Err = verifier.NewCommittee(committee).VerifyCertificate(res.BlockHash, cert)
+
+	return res, nil
+}
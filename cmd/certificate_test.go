@@ -0,0 +1,92 @@
+package cmd
+
+import (
+	"encoding/hex"
+	"path/filepath"
+	"testing"
+
+	"github.com/pactus-project/pactus/crypto/bls"
+	"github.com/pactus-project/pactus/types/block"
+	"github.com/pactus-project/pactus/types/certificate"
+	"github.com/pactus-project/pactus/types/validator"
+	"github.com/pactus-project/pactus/util"
+	"github.com/pactus-project/pactus/util/testsuite"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerifyBlockCertificate(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	valKeys := make([]*bls.ValidatorKey, 0, 4)
+	rawVals := make([]string, 0, 4)
+	for i := int32(0); i < 4; i++ {
+		valKey := ts.RandValKey()
+		val := validator.NewValidator(valKey.PublicKey(), i)
+		valData, _ := val.Bytes()
+		valKeys = append(valKeys, valKey)
+		rawVals = append(rawVals, hex.EncodeToString(valData))
+	}
+
+	committeePath := filepath.Join(util.TempDirPath(), "committee.json")
+	require.NoError(t, util.WriteFile(committeePath,
+		[]byte(`["`+rawVals[0]+`","`+rawVals[1]+`","`+rawVals[2]+`","`+rawVals[3]+`"]`)))
+	committee, err := LoadCommitteeFile(committeePath)
+	require.NoError(t, err)
+	require.Len(t, committee, 4)
+
+	// makeBlock makes a block that contains the certificate of the previous block, signed by the signers.
+	prevBlockHash := ts.RandHash()
+	height := ts.RandHeight()
+	makeBlock := func(signers ...int) string {
+		signBytes := certificate.BlockCertificateSignBytes(prevBlockHash, height, 0)
+		absentees := []int32{0, 1, 2, 3}
+		sigs := []*bls.Signature{}
+		for _, signer := range signers {
+			sigs = append(sigs, valKeys[signer].Sign(signBytes))
+			absentees, _ = util.RemoveFirstOccurrenceOf(absentees, int32(signer))
+		}
+		cert := certificate.NewCertificate(height, 0, []int32{0, 1, 2, 3}, absentees,
+			bls.SignatureAggregate(sigs...))
+
+		trx, _ := ts.GenerateTestTransferTx()
+		txs := block.NewTxs()
+		txs.Append(trx)
+		blk := block.MakeBlock(1, util.Now(), txs, prevBlockHash, ts.RandHash(),
+			cert, ts.RandSeed(), ts.RandValAddress())
+		data, _ := blk.Bytes()
+
+		return hex.EncodeToString(data)
+	}
+
+	t.Run("Valid certificate", func(t *testing.T) {
+		res, err := VerifyBlockCertificate(makeBlock(0, 1, 3), committee)
+		require.NoError(t, err)
+
+		assert.NoError(t, res.Err)
+		assert.Equal(t, prevBlockHash, res.BlockHash)
+		assert.Equal(t, height, res.Height)
+		assert.Equal(t, []*validator.Validator{committee[0], committee[1], committee[3]}, res.Signers)
+		assert.Equal(t, []*validator.Validator{committee[2]}, res.Absentees)
+	})
+
+	t.Run("Not enough signers", func(t *testing.T) {
+		res, err := VerifyBlockCertificate(makeBlock(0, 1), committee)
+		require.NoError(t, err)
+
+		assert.Error(t, res.Err)
+		assert.Len(t, res.Signers, 2)
+	})
+
+	t.Run("Wrong committee", func(t *testing.T) {
+		res, err := VerifyBlockCertificate(makeBlock(0, 1, 2, 3), committee[:3])
+		require.NoError(t, err)
+
+		assert.Error(t, res.Err)
+	})
+
+	t.Run("Invalid block", func(t *testing.T) {
+		_, err := VerifyBlockCertificate("invalid", committee)
+		assert.Error(t, err)
+	})
+}
@@ -12,7 +12,6 @@ import (
 	"strconv"
 	"strings"
 	"syscall"
-	"time"
 
 	"github.com/manifoldco/promptui"
 	"github.com/pactus-project/pactus/config"
@@ -25,6 +24,7 @@ import (
 	"github.com/pactus-project/pactus/types/param"
 	"github.com/pactus-project/pactus/types/validator"
 	"github.com/pactus-project/pactus/util"
+	"github.com/pactus-project/pactus/util/i18n"
 	"github.com/pactus-project/pactus/wallet"
 	"github.com/pactus-project/pactus/wallet/addresspath"
 )
@@ -33,6 +33,8 @@ const (
 	DefaultHomeDirName    = "pactus"
 	DefaultWalletsDirName = "wallets"
 	DefaultWalletName     = "default_wallet"
+	DefaultUnixSocketName = "pactus.sock"
+	DefaultLocalesDirName = "locales"
 )
 
 var terminalSupported = false
@@ -53,7 +55,7 @@ func CheckTerminalSupported() bool {
 // to require the user to confirm the password.
 func PromptPassword(label string, confirmation bool) string {
 	prompt := promptui.Prompt{
-		Label:   label,
+		Label:   i18n.T(label),
 		Mask:    '*',
 		Pointer: promptui.PipeCursor,
 	}
@@ -63,14 +65,14 @@ func PromptPassword(label string, confirmation bool) string {
 	if confirmation {
 		validate := func(input string) error {
 			if input != password {
-				return errors.New("passwords do not match")
+				return errors.New(i18n.T("passwords do not match"))
 			}
 
 			return nil
 		}
 
 		confirmPrompt := promptui.Prompt{
-			Label:    "Confirm password",
+			Label:    i18n.T("Confirm password"),
 			Validate: validate,
 			Mask:     '*',
 			Pointer:  promptui.PipeCursor,
@@ -86,7 +88,7 @@ func PromptPassword(label string, confirmation bool) string {
 // PromptConfirm prompts user to confirm the operation.
 func PromptConfirm(label string) bool {
 	prompt := promptui.Prompt{
-		Label:     label,
+		Label:     i18n.T(label),
 		IsConfirm: true,
 		Pointer:   promptui.PipeCursor,
 	}
@@ -110,7 +112,7 @@ func PromptConfirm(label string) bool {
 // PromptInput prompts for an input string.
 func PromptInput(label string) string {
 	prompt := promptui.Prompt{
-		Label:   label,
+		Label:   i18n.T(label),
 		Pointer: promptui.PipeCursor,
 	}
 	result, err := prompt.Run()
@@ -122,7 +124,7 @@ func PromptInput(label string) string {
 // PromptInputWithSuggestion prompts the user for an input string with a suggestion.
 func PromptInputWithSuggestion(label, suggestion string) string {
 	prompt := promptui.Prompt{
-		Label:   label,
+		Label:   i18n.T(label),
 		Default: suggestion,
 		Pointer: promptui.PipeCursor,
 	}
@@ -135,7 +137,7 @@ func PromptInputWithSuggestion(label, suggestion string) string {
 // PromptInputWithRange prompts the user for an input integer within a specified range.
 func PromptInputWithRange(label string, def, min, max int) int {
 	prompt := promptui.Prompt{
-		Label:     label,
+		Label:     i18n.T(label),
 		Default:   fmt.Sprintf("%v", def),
 		IsVimMode: true,
 		Pointer:   promptui.PipeCursor,
@@ -160,6 +162,17 @@ func PromptInputWithRange(label string, def, min, max int) int {
 	return num
 }
 
+// SetupLocale loads the custom message catalogs from the `locales` directory of the home directory,
+// and sets the locale of the messages. If lang is empty, the locale of the system is used.
+func SetupLocale(homeDir, lang string) {
+	if err := i18n.LoadDir(filepath.Join(homeDir, DefaultLocalesDirName)); err != nil {
+		PrintWarnMsgf("unable to load the message catalogs: %v", err)
+	}
+	if lang != "" {
+		i18n.SetLocale(lang)
+	}
+}
+
 func FatalErrorCheck(err error) {
 	if err != nil {
 		if terminalSupported {
@@ -173,7 +186,7 @@ func FatalErrorCheck(err error) {
 }
 
 func PrintErrorMsgf(format string, a ...interface{}) {
-	format = "[ERROR] " + format
+	format = "[ERROR] " + i18n.T(format)
 	if terminalSupported {
 		// Print error msg with red color
 		format = fmt.Sprintf("\033[31m%s\033[0m", format)
@@ -182,6 +195,7 @@ func PrintErrorMsgf(format string, a ...interface{}) {
 }
 
 func PrintSuccessMsgf(format string, a ...interface{}) {
+	format = i18n.T(format)
 	if terminalSupported {
 		// Print successful msg with green color
 		format = fmt.Sprintf("\033[32m%s\033[0m", format)
@@ -190,6 +204,7 @@ func PrintSuccessMsgf(format string, a ...interface{}) {
 }
 
 func PrintWarnMsgf(format string, a ...interface{}) {
+	format = i18n.T(format)
 	if terminalSupported {
 		// Print warning msg with yellow color
 		format = fmt.Sprintf("\033[33m%s\033[0m", format)
@@ -198,10 +213,11 @@ func PrintWarnMsgf(format string, a ...interface{}) {
 }
 
 func PrintInfoMsgf(format string, a ...interface{}) {
-	fmt.Printf(format+"\n", a...)
+	fmt.Printf(i18n.T(format)+"\n", a...)
 }
 
 func PrintInfoMsgBoldf(format string, a ...interface{}) {
+	format = i18n.T(format)
 	if terminalSupported {
 		format = fmt.Sprintf("\033[1m%s\033[0m", format)
 	}
@@ -255,6 +271,22 @@ func PactusDefaultWalletPath(home string) string {
 	return filepath.Join(PactusWalletDir(home), DefaultWalletName)
 }
 
+func PactusUnixSocketPath(home string) string {
+	return filepath.Join(home, DefaultUnixSocketName)
+}
+
+// LocalUnixSocketAddress returns the gRPC address of the unix socket for the local node.
+// It returns false if the local node doesn't listen on the default unix socket.
+func LocalUnixSocketAddress(home string) (string, bool) {
+	socketPath := PactusUnixSocketPath(home)
+	info, err := os.Stat(socketPath)
+	if err != nil || info.Mode()&os.ModeSocket == 0 {
+		return "", false
+	}
+
+	return "unix://" + socketPath, true
+}
+
 // TrapSignal traps SIGINT and SIGTERM and terminates the server correctly.
 func TrapSignal(cleanupFunc func()) {
 	sigs := make(chan os.Signal, 1)
@@ -279,9 +311,21 @@ func TrapSignal(cleanupFunc func()) {
 func CreateNode(numValidators int, chain genesis.ChainType, workingDir string,
 	mnemonic string, walletPassword string,
 ) ([]string, []string, error) {
+	return CreateNodeWithHRP(numValidators, chain, workingDir, mnemonic, walletPassword, crypto.HRP{})
+}
+
+// CreateNodeWithHRP is like CreateNode, but it customizes the human-readable parts of
+// the addresses and keys. Custom HRPs are only supported for the local networks.
+func CreateNodeWithHRP(numValidators int, chain genesis.ChainType, workingDir string,
+	mnemonic string, walletPassword string, customHRP crypto.HRP,
+) ([]string, []string, error) {
+	if !customHRP.IsEmpty() && chain != genesis.Localnet && chain != genesis.Simnet {
+		return nil, nil, fmt.Errorf("custom HRP is not supported for %s", chain)
+	}
+
 	// To make process faster, we update the password after creating the addresses
 	walletPath := PactusDefaultWalletPath(workingDir)
-	walletInstance, err := wallet.Create(walletPath, mnemonic, "", chain)
+	walletInstance, err := wallet.CreateWithHRP(walletPath, mnemonic, "", chain, customHRP)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -327,11 +371,20 @@ func CreateNode(numValidators int, chain genesis.ChainType, workingDir string,
 			return nil, nil, err
 		}
 
-	case genesis.Localnet:
+	case genesis.Localnet, genesis.Simnet:
 		genDoc := makeLocalGenesis(*walletInstance)
+		if chain == genesis.Simnet {
+			genDoc.SetSimnet()
+		}
+		if err := genDoc.SetCustomHRP(customHRP); err != nil {
+			return nil, nil, err
+		}
 		if err := genDoc.SaveToFile(genPath); err != nil {
 			return nil, nil, err
 		}
+		if err := walletInstance.SetChainID(genDoc.ChainID()); err != nil {
+			return nil, nil, err
+		}
 
 		conf := config.DefaultConfigLocalnet()
 		if err := conf.Save(confPath); err != nil {
@@ -351,21 +404,18 @@ func CreateNode(numValidators int, chain genesis.ChainType, workingDir string,
 }
 
 // TODO: write test for me.
-func StartNode(workingDir string, passwordFetcher func(*wallet.Wallet) (string, bool)) (
-	*node.Node, *wallet.Wallet, error,
-) {
+// StartNode starts the node in the working directory.
+// The config modifiers can change the loaded config before the node is created.
+func StartNode(workingDir string, passwordFetcher func(*wallet.Wallet) (string, bool),
+	configModifiers ...func(*config.Config),
+) (*node.Node, *wallet.Wallet, error) {
 	gen, err := genesis.LoadFromFile(PactusGenesisPath(workingDir))
 	if err != nil {
 		return nil, nil, err
 	}
 
-	if !gen.ChainType().IsMainnet() {
-		crypto.AddressHRP = "tpc"
-		crypto.PublicKeyHRP = "tpublic"
-		crypto.PrivateKeyHRP = "tsecret"
-		crypto.XPublicKeyHRP = "txpublic"
-		crypto.XPrivateKeyHRP = "txsecret"
-	}
+	crypto.SetHRP(gen.HRP())
+	crypto.SetSignDomain(gen.SignDomain())
 
 	walletsDir := PactusWalletDir(workingDir)
 	confPath := PactusConfigPath(workingDir)
@@ -374,6 +424,9 @@ func StartNode(workingDir string, passwordFetcher func(*wallet.Wallet) (string,
 	if err != nil {
 		return nil, nil, err
 	}
+	for _, modifier := range configModifiers {
+		modifier(conf)
+	}
 
 	err = conf.BasicCheck()
 	if err != nil {
@@ -462,6 +515,11 @@ func StartNode(workingDir string, passwordFetcher func(*wallet.Wallet) (string,
 		return nil, nil, err
 	}
 
+	err = applyHaltArtifacts(workingDir, nodeInstance.State())
+	if err != nil {
+		return nil, nil, err
+	}
+
 	err = nodeInstance.Start()
 	if err != nil {
 		return nil, nil, err
@@ -472,13 +530,6 @@ func StartNode(workingDir string, passwordFetcher func(*wallet.Wallet) (string,
 
 // makeLocalGenesis makes genesis file for the local network.
 func makeLocalGenesis(w wallet.Wallet) *genesis.Genesis {
-	// Treasury account
-	acc := account.NewAccount(0)
-	acc.AddToBalance(21 * 1e14)
-	accs := map[crypto.Address]*account.Account{
-		crypto.TreasuryAddress: acc,
-	}
-
 	vals := make([]*validator.Validator, 4)
 	for i := 0; i < 4; i++ {
 		info := w.AddressInfo(w.AddressInfos()[i].Address)
@@ -486,6 +537,18 @@ func makeLocalGenesis(w wallet.Wallet) *genesis.Genesis {
 		vals[i] = validator.NewValidator(pub, int32(i))
 	}
 
+	return makeLocalGenesisWithValidators(vals)
+}
+
+// makeLocalGenesisWithValidators makes genesis file for the local network with the given validators.
+func makeLocalGenesisWithValidators(vals []*validator.Validator) *genesis.Genesis {
+	// Treasury account
+	acc := account.NewAccount(0)
+	acc.AddToBalance(21 * 1e14)
+	accs := map[crypto.Address]*account.Account{
+		crypto.TreasuryAddress: acc,
+	}
+
 	// create genesis
 	params := param.DefaultParams()
 	params.BlockVersion = 0
@@ -504,10 +567,22 @@ func MakeConfig(genDoc *genesis.Genesis, confPath, walletsDir string) (*config.C
 		defConf = config.DefaultConfigMainnet()
 	case genesis.Testnet:
 		defConf = config.DefaultConfigTestnet()
-	case genesis.Localnet:
+	case genesis.Localnet, genesis.Simnet:
 		defConf = config.DefaultConfigLocalnet()
 	}
 
+	report, err := config.MigrateFile(confPath)
+	if err != nil {
+		return nil, err
+	}
+	if report != nil {
+		PrintInfoMsgf("Config migrated from version %d to %d, the old config is saved at: %s",
+			report.FromVersion, report.ToVersion, report.BackupPath)
+		for _, change := range report.Changes {
+			PrintInfoMsgf("  %s", change.String())
+		}
+	}
+
 	conf, err := config.LoadFromFile(confPath, true, defConf)
 	if err != nil {
 		PrintWarnMsgf("Unable to load the config: %s", err)
@@ -539,8 +614,7 @@ func RecoverConfig(confPath string, defConf *config.Config, chainType genesis.Ch
 
 	// Create a backup of the config
 	if util.PathExists(confPath) {
-		confBackupPath := fmt.Sprintf("%v_bak_%s", confPath, time.Now().Format("2006-01-02T15-04-05"))
-		renameErr := os.Rename(confPath, confBackupPath)
+		renameErr := os.Rename(confPath, config.BackupPath(confPath))
 		if renameErr != nil {
 			return nil, renameErr
 		}
@@ -561,7 +635,8 @@ func RecoverConfig(confPath string, defConf *config.Config, chainType genesis.Ch
 			}
 
 		case genesis.Testnet,
-			genesis.Localnet:
+			genesis.Localnet,
+			genesis.Simnet:
 			err = defConf.Save(confPath)
 			if err != nil {
 				return nil, err
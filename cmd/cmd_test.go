@@ -3,6 +3,7 @@ package cmd
 import (
 	"bytes"
 	"io"
+	"net"
 	"os"
 	"runtime"
 	"testing"
@@ -161,3 +162,18 @@ func TestPathsWindows(t *testing.T) {
 		assert.Equal(t, test.expectedConfigPath, configPath)
 	}
 }
+
+func TestLocalUnixSocketAddress(t *testing.T) {
+	home := t.TempDir()
+
+	_, ok := LocalUnixSocketAddress(home)
+	assert.False(t, ok, "no socket file")
+
+	listener, err := net.Listen("unix", PactusUnixSocketPath(home))
+	assert.NoError(t, err)
+	defer listener.Close()
+
+	addr, ok := LocalUnixSocketAddress(home)
+	assert.True(t, ok)
+	assert.Equal(t, "unix://"+PactusUnixSocketPath(home), addr)
+}
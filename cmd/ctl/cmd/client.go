@@ -1,6 +1,7 @@
 package cmd
 
 import (
+	pactuscmd "github.com/pactus-project/pactus/cmd"
 	pb "github.com/pactus-project/pactus/www/grpc/gen/go"
 	"github.com/spf13/cobra"
 )
@@ -18,8 +19,14 @@ func init() {
 }
 
 func changeDefaultParameters(cmd *cobra.Command) *cobra.Command {
-	_ = cmd.PersistentFlags().Lookup("server-addr").Value.Set(defaultServerAddr)
-	cmd.PersistentFlags().Lookup("server-addr").DefValue = defaultServerAddr
+	serverAddr := defaultServerAddr
+	// Prefer the local node, if it is listening on the unix socket.
+	if addr, ok := pactuscmd.LocalUnixSocketAddress(pactuscmd.PactusDefaultHomeDir()); ok {
+		serverAddr = addr
+	}
+
+	_ = cmd.PersistentFlags().Lookup("server-addr").Value.Set(serverAddr)
+	cmd.PersistentFlags().Lookup("server-addr").DefValue = serverAddr
 
 	_ = cmd.PersistentFlags().Lookup("response-format").Value.Set(defaultResponseFormat)
 	cmd.PersistentFlags().Lookup("response-format").DefValue = defaultResponseFormat
@@ -0,0 +1,61 @@
+package cmd
+
+import (
+	"os"
+
+	pactuscmd "github.com/pactus-project/pactus/cmd"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	rootCmd.AddCommand(buildVerifyCertificateCmd())
+}
+
+// buildVerifyCertificateCmd builds a command to verify a block certificate offline,
+// without connecting to any node.
+func buildVerifyCertificateCmd() *cobra.Command {
+	verifyCmd := &cobra.Command{
+		Use:   "verify-certificate",
+		Short: "verify the certificate inside a block offline, and print the validators that have signed it",
+		Long: `The certificate of a block is included in the next block.
+Therefore the certificate that is verified certifies the previous block,
+and the committee should be the committee at the time the previous block is committed.
+The committee file is a JSON array of the hex-encoded validators.`,
+	}
+
+	blockOpt := verifyCmd.Flags().String("block", "", "the hex-encoded block that contains the certificate")
+	committeeOpt := verifyCmd.Flags().String("committee", "", "the path to the committee file")
+	_ = verifyCmd.MarkFlagRequired("block")
+	_ = verifyCmd.MarkFlagRequired("committee")
+
+	verifyCmd.Run = func(_ *cobra.Command, _ []string) {
+		committee, err := pactuscmd.LoadCommitteeFile(*committeeOpt)
+		pactuscmd.FatalErrorCheck(err)
+
+		res, err := pactuscmd.VerifyBlockCertificate(*blockOpt, committee)
+		pactuscmd.FatalErrorCheck(err)
+
+		pactuscmd.PrintInfoMsgf("Block hash: %s", res.BlockHash)
+		pactuscmd.PrintInfoMsgf("Height:     %d", res.Height)
+		pactuscmd.PrintInfoMsgf("Round:      %d", res.Round)
+		pactuscmd.PrintLine()
+
+		pactuscmd.PrintInfoMsgf("Signed by %d validators:", len(res.Signers))
+		for _, val := range res.Signers {
+			pactuscmd.PrintInfoMsgf("  %d: %s", val.Number(), val.Address())
+		}
+		pactuscmd.PrintInfoMsgf("Absent %d validators:", len(res.Absentees))
+		for _, val := range res.Absentees {
+			pactuscmd.PrintInfoMsgf("  %d: %s", val.Number(), val.Address())
+		}
+		pactuscmd.PrintLine()
+
+		if res.Err != nil {
+			pactuscmd.PrintErrorMsgf("The certificate is not valid: %v", res.Err)
+			os.Exit(1)
+		}
+		pactuscmd.PrintSuccessMsgf("The certificate is valid.")
+	}
+
+	return verifyCmd
+}
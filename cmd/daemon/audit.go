@@ -0,0 +1,58 @@
+package main
+
+import (
+	"io"
+	"os"
+
+	"github.com/pactus-project/pactus/cmd"
+	"github.com/spf13/cobra"
+)
+
+// buildAuditCmd builds a sub-command to work with the audit log of the signatures.
+func buildAuditCmd(parentCmd *cobra.Command) {
+	auditCmd := &cobra.Command{
+		Use:   "audit",
+		Short: "verify and export the audit log of the signatures",
+	}
+	parentCmd.AddCommand(auditCmd)
+
+	buildAuditExportCmd(auditCmd)
+}
+
+func buildAuditExportCmd(parentCmd *cobra.Command) {
+	exportCmd := &cobra.Command{
+		Use:   "export",
+		Short: "verify the chain of the audit log and export its entries",
+	}
+	parentCmd.AddCommand(exportCmd)
+
+	workingDirOpt := exportCmd.Flags().StringP("working-dir", "w", cmd.PactusDefaultHomeDir(),
+		"the path to the working directory of the node")
+	formatOpt := exportCmd.Flags().String("format", "json",
+		"the format of the exported entries: `json` or `csv`")
+	outputOpt := exportCmd.Flags().StringP("output", "o", "",
+		"a path to save the exported entries, if not set they are printed")
+
+	exportCmd.Run = func(_ *cobra.Command, _ []string) {
+		logPath, err := cmd.PactusAuditLogPath(absPath(*workingDirOpt))
+		cmd.FatalErrorCheck(err)
+
+		var w io.Writer = os.Stdout
+		if *outputOpt != "" {
+			file, err := os.Create(*outputOpt)
+			cmd.FatalErrorCheck(err)
+			defer func() {
+				_ = file.Close()
+			}()
+			w = file
+		}
+
+		last, err := cmd.ExportAuditLog(logPath, *formatOpt, w)
+		cmd.FatalErrorCheck(err)
+
+		if *outputOpt != "" {
+			cmd.PrintSuccessMsgf("%d entries are exported to: %s", last.Seq, *outputOpt)
+			cmd.PrintInfoMsgf("The hash of the last entry: %s", last.Hash)
+		}
+	}
+}
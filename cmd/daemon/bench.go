@@ -0,0 +1,68 @@
+package main
+
+import (
+	"encoding/json"
+
+	"github.com/pactus-project/pactus/cmd"
+	"github.com/pactus-project/pactus/util"
+	"github.com/pactus-project/pactus/util/logger"
+	"github.com/spf13/cobra"
+)
+
+// buildBenchCmd builds a sub-command to run the benchmarks of the node.
+func buildBenchCmd(parentCmd *cobra.Command) {
+	benchCmd := &cobra.Command{
+		Use:   "bench",
+		Short: "run the benchmarks of the node",
+	}
+	parentCmd.AddCommand(benchCmd)
+
+	buildBenchProposeCmd(benchCmd)
+}
+
+// buildBenchProposeCmd builds a sub-command to benchmark the block proposal.
+func buildBenchProposeCmd(parentCmd *cobra.Command) {
+	proposeCmd := &cobra.Command{
+		Use:   "propose",
+		Short: "measure the block assembly, Merkle root computation and validation with a synthetic transaction pool",
+	}
+	parentCmd.AddCommand(proposeCmd)
+
+	txsOpt := proposeCmd.Flags().IntP("transactions", "t", 1000,
+		"number of the transactions in the synthetic transaction pool")
+
+	accountsOpt := proposeCmd.Flags().IntP("accounts", "a", 100,
+		"number of the accounts that send the transactions")
+
+	roundsOpt := proposeCmd.Flags().IntP("rounds", "r", 10,
+		"number of the blocks to propose and validate")
+
+	outputOpt := proposeCmd.Flags().StringP("output", "o", "",
+		"a path to save the results in JSON, if not set the results are printed")
+
+	proposeCmd.Run = func(_ *cobra.Command, _ []string) {
+		logConf := logger.DefaultConfig()
+		for name := range logConf.Levels {
+			logConf.Levels[name] = "error"
+		}
+		logger.InitGlobalLogger(logConf)
+
+		res, err := cmd.BenchPropose(cmd.BenchProposeConfig{
+			Transactions: *txsOpt,
+			Accounts:     *accountsOpt,
+			Rounds:       *roundsOpt,
+		})
+		cmd.FatalErrorCheck(err)
+
+		data, err := json.MarshalIndent(res, "", "  ")
+		cmd.FatalErrorCheck(err)
+
+		if *outputOpt == "" {
+			cmd.PrintJSONData(data)
+
+			return
+		}
+		cmd.FatalErrorCheck(util.WriteFile(*outputOpt, data))
+		cmd.PrintSuccessMsgf("Benchmark results are saved at: %s", *outputOpt)
+	}
+}
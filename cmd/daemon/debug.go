@@ -0,0 +1,111 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/pactus-project/pactus/cmd"
+	"github.com/pactus-project/pactus/config"
+	"github.com/pactus-project/pactus/util/logger"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// buildCollectDebugCmd builds a sub-command to collect the debug information of the node
+// in an archive, to attach to the bug reports.
+func buildCollectDebugCmd(parentCmd *cobra.Command) {
+	collectCmd := &cobra.Command{
+		Use:   "collect-debug",
+		Short: "collect the logs, config, node info, peers, metrics and goroutines in an archive for the bug reports",
+	}
+	parentCmd.AddCommand(collectCmd)
+
+	workingDirOpt := collectCmd.Flags().StringP("working-dir", "w", cmd.PactusDefaultHomeDir(),
+		"the path to the working directory of the node")
+
+	serverAddrOpt := collectCmd.Flags().String("server-addr", "",
+		"the gRPC address of the node, if not set the unix socket of the node is used")
+
+	pprofAddrOpt := collectCmd.Flags().String("pprof-addr", "",
+		"the address of the pprof server of the node, to collect the goroutines")
+
+	logSizeOpt := collectCmd.Flags().Int64("log-size-mb", 4,
+		"the size of the recent logs to collect, in megabytes")
+
+	outputOpt := collectCmd.Flags().StringP("output", "o", "",
+		"a path to save the archive, if not set it is saved in the current directory")
+
+	collectCmd.Run = func(_ *cobra.Command, _ []string) {
+		workingDir := absPath(*workingDirOpt)
+		confPath := cmd.PactusConfigPath(workingDir)
+
+		opts := cmd.DebugOptions{
+			ConfigFile: confPath,
+			LogFile:    filepath.Join(workingDir, logger.LogFilename),
+			LogSize:    *logSizeOpt << 20,
+			MetricsURL: metricsURL(confPath),
+			PprofAddr:  *pprofAddrOpt,
+		}
+
+		serverAddr := *serverAddrOpt
+		if serverAddr == "" {
+			serverAddr, _ = cmd.LocalUnixSocketAddress(workingDir)
+		}
+		if serverAddr != "" {
+			conn, err := grpc.Dial(serverAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+			cmd.FatalErrorCheck(err)
+			defer func() {
+				_ = conn.Close()
+			}()
+			opts.Conn = conn
+		}
+
+		output := *outputOpt
+		if output == "" {
+			output = fmt.Sprintf("pactus-debug-%s.tar.gz", time.Now().Format("20060102-150405"))
+		}
+		file, err := os.Create(output)
+		cmd.FatalErrorCheck(err)
+		defer func() {
+			_ = file.Close()
+		}()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+
+		collected, err := cmd.CollectDebugInfo(ctx, opts, file)
+		cmd.FatalErrorCheck(err)
+
+		cmd.PrintSuccessMsgf("Debug information is saved at: %s", output)
+		cmd.PrintInfoMsgf("Collected: %v", collected)
+		cmd.PrintWarnMsgf("The secrets in the config are redacted, " +
+			"but please review the archive before sharing it, it contains the IP addresses of the peers.")
+	}
+}
+
+// metricsURL returns the URL of the Prometheus metrics, if they are enabled in the config.
+func metricsURL(confPath string) string {
+	conf, err := config.LoadFromFile(confPath, false, config.DefaultConfigMainnet())
+	if err != nil {
+		return ""
+	}
+
+	if !conf.HTTP.Enable || !conf.HTTP.EnableMetrics {
+		return ""
+	}
+
+	host, port, err := net.SplitHostPort(conf.HTTP.Listen)
+	if err != nil {
+		return ""
+	}
+	if host == "" || host == "0.0.0.0" || host == "::" {
+		host = "127.0.0.1"
+	}
+
+	return fmt.Sprintf("http://%s/metrics/prometheus", net.JoinHostPort(host, port))
+}
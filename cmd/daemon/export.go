@@ -0,0 +1,71 @@
+package main
+
+import (
+	"github.com/pactus-project/pactus/cmd"
+	"github.com/pactus-project/pactus/util/dirlock"
+	"github.com/spf13/cobra"
+)
+
+// buildExportCmd builds a sub-command to export the chain for the analytics.
+func buildExportCmd(parentCmd *cobra.Command) {
+	exportCmd := &cobra.Command{
+		Use:   "export",
+		Short: "export the chain data for the analytics",
+	}
+	parentCmd.AddCommand(exportCmd)
+
+	buildExportParquetCmd(exportCmd)
+}
+
+// buildExportParquetCmd builds a sub-command to export the blocks to the Parquet files.
+func buildExportParquetCmd(parentCmd *cobra.Command) {
+	parquetCmd := &cobra.Command{
+		Use:   "parquet",
+		Short: "export the blocks, transactions, transfers and validator events to the Parquet files",
+	}
+	parentCmd.AddCommand(parquetCmd)
+
+	workingDirOpt := parquetCmd.Flags().StringP("working-dir", "w", cmd.PactusDefaultHomeDir(),
+		"the path to the working directory of the node, the node should not be running")
+
+	fromOpt := parquetCmd.Flags().Uint32("from", 1,
+		"the height of the first block to export")
+
+	toOpt := parquetCmd.Flags().Uint32("to", 0,
+		"the height of the last block to export, if not set the blocks are exported up to the last block")
+
+	outputOpt := parquetCmd.Flags().StringP("output", "o", "",
+		"a path to the directory to save the Parquet files")
+
+	_ = parquetCmd.MarkFlagRequired("output")
+
+	parquetCmd.Run = func(_ *cobra.Command, _ []string) {
+		workingDir := absPath(*workingDirOpt)
+
+		lock, err := dirlock.Acquire(workingDir)
+		cmd.FatalErrorCheck(err)
+		defer func() {
+			_ = lock.Release()
+		}()
+
+		str, err := cmd.OpenChainStore(workingDir)
+		cmd.FatalErrorCheck(err)
+		defer func() {
+			_ = str.Close()
+		}()
+
+		res, err := cmd.ExportChain(str, cmd.ExportChainConfig{
+			From:      *fromOpt,
+			To:        *toOpt,
+			OutputDir: absPath(*outputOpt),
+		})
+		cmd.FatalErrorCheck(err)
+
+		cmd.PrintSuccessMsgf("Blocks %d to %d are exported: %d blocks, %d transactions, %d transfers, %d validator events",
+			res.From, res.To, res.Blocks, res.Transactions, res.Transfers, res.ValidatorEvents)
+		for _, file := range res.Files {
+			cmd.PrintInfoMsgf("  %s", file)
+		}
+		cmd.PrintInfoMsgf("To continue the export later, run it again with '--from %d'.", res.To+1)
+	}
+}
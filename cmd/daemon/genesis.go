@@ -0,0 +1,72 @@
+package main
+
+import (
+	"github.com/pactus-project/pactus/cmd"
+	"github.com/pactus-project/pactus/crypto"
+	"github.com/pactus-project/pactus/genesis"
+	"github.com/pactus-project/pactus/types/param"
+	"github.com/pactus-project/pactus/util"
+	"github.com/spf13/cobra"
+)
+
+// buildGenesisCmd builds a sub-command to manage the genesis of new chains.
+func buildGenesisCmd(parentCmd *cobra.Command) {
+	genesisCmd := &cobra.Command{
+		Use:   "genesis",
+		Short: "manage the genesis of new chains",
+	}
+	parentCmd.AddCommand(genesisCmd)
+
+	buildGenesisBuildCmd(genesisCmd)
+}
+
+// buildGenesisBuildCmd builds a sub-command to build a genesis from the bulk allocations.
+func buildGenesisBuildCmd(parentCmd *cobra.Command) {
+	buildCmd := &cobra.Command{
+		Use:   "build",
+		Short: "build a genesis file from the account allocations and validators in CSV or JSON files",
+	}
+	parentCmd.AddCommand(buildCmd)
+
+	allocationsOpt := buildCmd.Flags().StringP("allocations", "a", "",
+		"a path to the CSV or JSON file of the account allocations (address and balance in NanoPAC)")
+
+	validatorsOpt := buildCmd.Flags().StringP("validators", "v", "",
+		"a path to the CSV or JSON file of the public keys of the genesis validators")
+
+	totalSupplyOpt := buildCmd.Flags().String("total-supply", "42000000",
+		"the total supply of the chain in PAC, the rest of the allocations goes to the treasury")
+
+	genesisTimeOpt := buildCmd.Flags().Int("genesis-time-round", 60,
+		"round the genesis time to this number of seconds")
+
+	outputOpt := buildCmd.Flags().StringP("output", "o", "genesis.json",
+		"a path to save the genesis file")
+
+	_ = buildCmd.MarkFlagRequired("validators")
+
+	buildCmd.Run = func(_ *cobra.Command, _ []string) {
+		totalSupply, err := util.StringToChange(*totalSupplyOpt)
+		cmd.FatalErrorCheck(err)
+
+		allocs := []genesis.Allocation{}
+		if *allocationsOpt != "" {
+			allocs, err = genesis.LoadAllocations(*allocationsOpt)
+			cmd.FatalErrorCheck(err)
+		}
+		pubs, err := genesis.LoadValidators(*validatorsOpt)
+		cmd.FatalErrorCheck(err)
+
+		gen, err := genesis.MakeGenesisFromAllocations(util.RoundNow(*genesisTimeOpt),
+			totalSupply, allocs, pubs, param.DefaultParams())
+		cmd.FatalErrorCheck(err)
+		cmd.FatalErrorCheck(gen.SaveToFile(*outputOpt))
+
+		treasury := gen.Accounts()[crypto.TreasuryAddress]
+		cmd.PrintInfoMsgf("Accounts:     %d", len(allocs))
+		cmd.PrintInfoMsgf("Validators:   %d", len(pubs))
+		cmd.PrintInfoMsgf("Total supply: %v PAC", util.ChangeToCoin(gen.TotalSupply()))
+		cmd.PrintInfoMsgf("Treasury:     %v PAC", util.ChangeToCoin(treasury.Balance()))
+		cmd.PrintSuccessMsgf("Genesis is saved at: %s", *outputOpt)
+	}
+}
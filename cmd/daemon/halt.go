@@ -0,0 +1,182 @@
+package main
+
+import (
+	"path/filepath"
+
+	"github.com/pactus-project/pactus/cmd"
+	"github.com/pactus-project/pactus/crypto/hash"
+	"github.com/pactus-project/pactus/types/halt"
+	"github.com/pactus-project/pactus/wallet"
+	"github.com/spf13/cobra"
+)
+
+// buildHaltCmd builds a sub-command to make and sign the halt orders.
+func buildHaltCmd(parentCmd *cobra.Command) {
+	haltCmd := &cobra.Command{
+		Use:   "halt",
+		Short: "make and sign the orders to halt the chain at a specific height (emergency only)",
+	}
+	parentCmd.AddCommand(haltCmd)
+
+	buildHaltCreateCmd(haltCmd)
+	buildHaltSignCmd(haltCmd)
+}
+
+// buildResumeCmd builds a sub-command to make and sign the resume artifacts.
+func buildResumeCmd(parentCmd *cobra.Command) {
+	resumeCmd := &cobra.Command{
+		Use:   "resume",
+		Short: "make and sign the artifacts to resume the halted chain",
+	}
+	parentCmd.AddCommand(resumeCmd)
+
+	buildResumeCreateCmd(resumeCmd)
+	buildResumeSignCmd(resumeCmd)
+}
+
+func buildHaltCreateCmd(parentCmd *cobra.Command) {
+	createCmd := &cobra.Command{
+		Use:   "create",
+		Short: "create a new halt order without any signature",
+	}
+	parentCmd.AddCommand(createCmd)
+
+	heightOpt := createCmd.Flags().Uint32("height", 0,
+		"the last height that the nodes commit before halting")
+	reasonOpt := createCmd.Flags().String("reason", "",
+		"the reason for halting the chain")
+	committeeHeightOpt, committersOpt := addCommitteeFlags(createCmd)
+	outputOpt := createCmd.Flags().StringP("output", "o", "halt.json",
+		"a path to save the halt order")
+
+	createCmd.Run = func(_ *cobra.Command, _ []string) {
+		ord := halt.NewOrder(*heightOpt, *reasonOpt, *committeeHeightOpt, *committersOpt)
+		cmd.FatalErrorCheck(ord.BasicCheck())
+		cmd.FatalErrorCheck(ord.SaveToFile(*outputOpt))
+
+		cmd.PrintSuccessMsgf("Halt order is saved at: %s", *outputOpt)
+		cmd.PrintInfoMsgf("Ask the committee members to sign it using: pactus-daemon halt sign")
+	}
+}
+
+func buildHaltSignCmd(parentCmd *cobra.Command) {
+	signCmd := &cobra.Command{
+		Use:   "sign [file]",
+		Short: "sign the halt order by the validators of the wallet",
+		Args:  cobra.ExactArgs(1),
+	}
+	parentCmd.AddCommand(signCmd)
+
+	workingDirOpt, passwordOpt := addSignerFlags(signCmd)
+
+	signCmd.Run = func(_ *cobra.Command, args []string) {
+		ord, err := halt.LoadOrderFromFile(args[0])
+		cmd.FatalErrorCheck(err)
+
+		cmd.PrintWarnMsgf("You are signing an order to halt the chain at height %d: %s",
+			ord.Height(), ord.Reason())
+		valKeys, err := cmd.LoadValidatorKeys(absPath(*workingDirOpt), passwordFetcher(*passwordOpt))
+		cmd.FatalErrorCheck(err)
+
+		for _, key := range valKeys {
+			ord.Sign(key)
+		}
+		cmd.FatalErrorCheck(ord.SaveToFile(args[0]))
+
+		cmd.PrintSuccessMsgf("Halt order is signed by %d validators", len(valKeys))
+	}
+}
+
+func buildResumeCreateCmd(parentCmd *cobra.Command) {
+	createCmd := &cobra.Command{
+		Use:   "create",
+		Short: "create a new resume artifact without any signature",
+	}
+	parentCmd.AddCommand(createCmd)
+
+	heightOpt := createCmd.Flags().Uint32("height", 0,
+		"the height that the chain is halted at")
+	stateRootOpt := createCmd.Flags().String("state-root", "",
+		"the expected state root at the halt height")
+	committeeHeightOpt, committersOpt := addCommitteeFlags(createCmd)
+	outputOpt := createCmd.Flags().StringP("output", "o", "resume.json",
+		"a path to save the resume artifact")
+
+	createCmd.Run = func(_ *cobra.Command, _ []string) {
+		stateRoot, err := hash.FromString(*stateRootOpt)
+		cmd.FatalErrorCheck(err)
+
+		rsm := halt.NewResume(*heightOpt, stateRoot, *committeeHeightOpt, *committersOpt)
+		cmd.FatalErrorCheck(rsm.BasicCheck())
+		cmd.FatalErrorCheck(rsm.SaveToFile(*outputOpt))
+
+		cmd.PrintSuccessMsgf("Resume artifact is saved at: %s", *outputOpt)
+		cmd.PrintInfoMsgf("Ask the committee members to sign it using: pactus-daemon resume sign")
+	}
+}
+
+func buildResumeSignCmd(parentCmd *cobra.Command) {
+	signCmd := &cobra.Command{
+		Use:   "sign [file]",
+		Short: "sign the resume artifact by the validators of the wallet",
+		Args:  cobra.ExactArgs(1),
+	}
+	parentCmd.AddCommand(signCmd)
+
+	workingDirOpt, passwordOpt := addSignerFlags(signCmd)
+
+	signCmd.Run = func(_ *cobra.Command, args []string) {
+		rsm, err := halt.LoadResumeFromFile(args[0])
+		cmd.FatalErrorCheck(err)
+
+		cmd.PrintWarnMsgf("You are signing to resume the chain at height %d with state root: %s",
+			rsm.Height(), rsm.StateRoot())
+		valKeys, err := cmd.LoadValidatorKeys(absPath(*workingDirOpt), passwordFetcher(*passwordOpt))
+		cmd.FatalErrorCheck(err)
+
+		for _, key := range valKeys {
+			rsm.Sign(key)
+		}
+		cmd.FatalErrorCheck(rsm.SaveToFile(args[0]))
+
+		cmd.PrintSuccessMsgf("Resume artifact is signed by %d validators", len(valKeys))
+	}
+}
+
+func addCommitteeFlags(c *cobra.Command) (*uint32, *[]int32) {
+	committeeHeightOpt := c.Flags().Uint32("committee-height", 0,
+		"the height of the block whose committee signs the artifact")
+	committersOpt := c.Flags().Int32Slice("committers", nil,
+		"the numbers of the validators that committed the block at the committee height")
+
+	return committeeHeightOpt, committersOpt
+}
+
+func addSignerFlags(c *cobra.Command) (*string, *string) {
+	workingDirOpt := c.Flags().StringP("working-dir", "w", cmd.PactusDefaultHomeDir(),
+		"the path to the working directory to load the wallet")
+	passwordOpt := c.Flags().StringP("password", "p", "",
+		"the wallet password")
+
+	return workingDirOpt, passwordOpt
+}
+
+func absPath(path string) string {
+	abs, _ := filepath.Abs(path)
+
+	return abs
+}
+
+func passwordFetcher(password string) func(*wallet.Wallet) (string, bool) {
+	return func(wlt *wallet.Wallet) (string, bool) {
+		if !wlt.IsEncrypted() {
+			return "", true
+		}
+
+		if password != "" {
+			return password, true
+		}
+
+		return cmd.PromptPassword("Wallet password", false), true
+	}
+}
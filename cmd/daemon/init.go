@@ -1,9 +1,12 @@
 package main
 
 import (
+	"fmt"
 	"path/filepath"
+	"strings"
 
 	"github.com/pactus-project/pactus/cmd"
+	"github.com/pactus-project/pactus/crypto"
 	"github.com/pactus-project/pactus/genesis"
 	"github.com/pactus-project/pactus/util"
 	"github.com/pactus-project/pactus/wallet"
@@ -26,6 +29,9 @@ func buildInitCmd(parentCmd *cobra.Command) {
 	localnetOpt := initCmd.Flags().Bool("localnet", false,
 		"initialize working directory for localnet (for development)")
 
+	simnetOpt := initCmd.Flags().Bool("simnet", false,
+		"initialize working directory for simnet, that blocks are produced on demand through the RPC (for testing)")
+
 	restoreOpt := initCmd.Flags().String("restore", "",
 		"restore the 'default_wallet' using a mnemonic or seed phrase")
 
@@ -35,9 +41,15 @@ func buildInitCmd(parentCmd *cobra.Command) {
 	entropyOpt := initCmd.Flags().IntP("entropy", "e", 128,
 		"entropy bits for seed generation. range: 128 to 256")
 
+	languageOpt := initCmd.Flags().String("language", wallet.LanguageEnglish,
+		fmt.Sprintf("the language of the generated seed: %s", strings.Join(wallet.MnemonicLanguages(), ", ")))
+
 	valNumOpt := initCmd.Flags().IntP("val-num", "", 0,
 		"number of validators to be created. range: 1 to 32")
 
+	addressHRPOpt := initCmd.Flags().String("address-hrp", "",
+		"a custom human-readable part for the addresses of a private localnet, e.g. 'mynet'")
+
 	initCmd.Run = func(_ *cobra.Command, _ []string) {
 		workingDir, _ := filepath.Abs(*workingDirOpt)
 		if !util.IsDirNotExistsOrEmpty(workingDir) {
@@ -45,9 +57,22 @@ func buildInitCmd(parentCmd *cobra.Command) {
 
 			return
 		}
+		customHRP := crypto.HRP{Address: *addressHRPOpt}
+		if !customHRP.IsEmpty() && !*localnetOpt && !*simnetOpt {
+			cmd.PrintErrorMsgf("The custom address HRP is only supported for localnet and simnet")
+
+			return
+		}
+		if err := customHRP.WithDefaults(crypto.TestnetHRP).BasicCheck(); err != nil {
+			cmd.PrintErrorMsgf("Invalid address HRP: %s", err)
+
+			return
+		}
 		var mnemonic string
 		if *restoreOpt == "" {
-			mnemonic, _ = wallet.GenerateMnemonic(*entropyOpt)
+			var err error
+			mnemonic, err = wallet.GenerateMnemonicInLanguage(*entropyOpt, *languageOpt)
+			cmd.FatalErrorCheck(err)
 			cmd.PrintLine()
 			cmd.PrintInfoMsgf("Your wallet seed is:")
 			cmd.PrintInfoMsgBoldf("   " + mnemonic)
@@ -97,7 +122,10 @@ func buildInitCmd(parentCmd *cobra.Command) {
 		if *localnetOpt {
 			chain = genesis.Localnet
 		}
-		validatorAddrs, rewardAddrs, err := cmd.CreateNode(valNum, chain, workingDir, mnemonic, password)
+		if *simnetOpt {
+			chain = genesis.Simnet
+		}
+		validatorAddrs, rewardAddrs, err := cmd.CreateNodeWithHRP(valNum, chain, workingDir, mnemonic, password, customHRP)
 		cmd.FatalErrorCheck(err)
 
 		cmd.PrintLine()
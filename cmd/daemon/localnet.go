@@ -0,0 +1,93 @@
+package main
+
+import (
+	"path/filepath"
+
+	"github.com/pactus-project/pactus/cmd"
+	"github.com/pactus-project/pactus/network"
+	"github.com/spf13/cobra"
+)
+
+// buildLocalnetCmd builds a sub-command to manage the local networks.
+func buildLocalnetCmd(parentCmd *cobra.Command) {
+	localnetCmd := &cobra.Command{
+		Use:   "localnet",
+		Short: "manage the local networks (for development)",
+	}
+	parentCmd.AddCommand(localnetCmd)
+
+	buildLocalnetGenerateCmd(localnetCmd)
+}
+
+// buildLocalnetGenerateCmd builds a sub-command to generate a local network.
+func buildLocalnetGenerateCmd(parentCmd *cobra.Command) {
+	generateCmd := &cobra.Command{
+		Use:   "generate",
+		Short: "generate the keys, genesis and configs for a local network with multiple validators",
+	}
+	parentCmd.AddCommand(generateCmd)
+
+	outputDirOpt := generateCmd.Flags().StringP("output-dir", "o", "localnet",
+		"a path to the directory to save the nodes' working directories")
+
+	validatorsOpt := generateCmd.Flags().IntP("validators", "n", 4,
+		"number of validators (nodes) in the local network. range: 1 to 32")
+
+	passwordOpt := generateCmd.Flags().StringP("password", "p", "",
+		"the password of the wallets")
+
+	dockerComposeOpt := generateCmd.Flags().Bool("docker-compose", false,
+		"generate a docker compose file to run the nodes in containers")
+
+	latencyOpt := generateCmd.Flags().Duration("latency", 0,
+		"simulated latency of the received messages (chaos mode)")
+
+	jitterOpt := generateCmd.Flags().Duration("jitter", 0,
+		"simulated random variation of the latency (chaos mode)")
+
+	packetLossOpt := generateCmd.Flags().Float64("packet-loss", 0,
+		"simulated probability of dropping the received messages, between 0 and 1 (chaos mode)")
+
+	chaosSeedOpt := generateCmd.Flags().Int64("chaos-seed", 0,
+		"seed of the chaos mode to make the scenarios repeatable, zero means random")
+
+	generateCmd.Run = func(_ *cobra.Command, _ []string) {
+		outputDir, _ := filepath.Abs(*outputDirOpt)
+
+		chaos := network.ChaosConfig{
+			Enable:     *latencyOpt != 0 || *jitterOpt != 0 || *packetLossOpt != 0,
+			Latency:    *latencyOpt,
+			Jitter:     *jitterOpt,
+			PacketLoss: *packetLossOpt,
+			Seed:       *chaosSeedOpt,
+		}
+		nodes, err := cmd.GenerateLocalnet(outputDir, *validatorsOpt, *passwordOpt, *dockerComposeOpt, chaos)
+		cmd.FatalErrorCheck(err)
+
+		cmd.PrintLine()
+		for _, node := range nodes {
+			cmd.PrintInfoMsgBoldf("%s:", node.Name)
+			cmd.PrintInfoMsgf("   Working directory: %s", node.WorkingDir)
+			cmd.PrintInfoMsgf("   Validator address: %s", node.ValidatorAddr)
+			cmd.PrintInfoMsgf("   Reward address:    %s", node.RewardAddr)
+			cmd.PrintInfoMsgf("   P2P port: %d, gRPC port: %d, gateway port: %d, HTTP port: %d",
+				node.P2PPort, node.GRPCPort, node.GatewayPort, node.HTTPPort)
+			cmd.PrintLine()
+		}
+
+		if chaos.Enable {
+			cmd.PrintWarnMsgf("Chaos mode is enabled: latency %v, jitter %v, packet loss %v",
+				chaos.Latency, chaos.Jitter, chaos.PacketLoss)
+		}
+		cmd.PrintSuccessMsgf("A local network with %d validators is successfully generated at %v",
+			len(nodes), outputDir)
+		cmd.PrintLine()
+		if *dockerComposeOpt {
+			cmd.PrintInfoMsgf("You can start the network by running this command:")
+			cmd.PrintInfoMsgf("docker compose -f %s up", filepath.Join(outputDir, "docker-compose.yml"))
+		} else {
+			cmd.PrintInfoMsgf("You can start each node by running this command:")
+			cmd.PrintInfoMsgf("./pactus-daemon start -w %v", filepath.Join(outputDir, "node-<N>"))
+		}
+	}
+}
@@ -20,9 +20,26 @@ func main() {
 	// Hide the "help" sub-command
 	rootCmd.SetHelpCommand(&cobra.Command{Hidden: true})
 
+	langOpt := rootCmd.PersistentFlags().String("lang", "",
+		"the language of the messages, like `es`; if not specified, the system locale is used")
+	rootCmd.PersistentPreRun = func(_ *cobra.Command, _ []string) {
+		cmd.SetupLocale(cmd.PactusDefaultHomeDir(), *langOpt)
+	}
+
 	buildVersionCmd(rootCmd)
 	buildInitCmd(rootCmd)
 	buildStartCmd(rootCmd)
+	buildMonitorCmd(rootCmd)
+	buildLocalnetCmd(rootCmd)
+	buildGenesisCmd(rootCmd)
+	buildBenchCmd(rootCmd)
+	buildHaltCmd(rootCmd)
+	buildResumeCmd(rootCmd)
+	buildTestVectorsCmd(rootCmd)
+	buildCollectDebugCmd(rootCmd)
+	buildAuditCmd(rootCmd)
+	buildSimulateCmd(rootCmd)
+	buildExportCmd(rootCmd)
 
 	err := rootCmd.Execute()
 	if err != nil {
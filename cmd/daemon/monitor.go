@@ -0,0 +1,108 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"syscall"
+	"time"
+
+	"github.com/pactus-project/pactus/cmd"
+	"github.com/pactus-project/pactus/util/logger"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+const (
+	// ANSI escape codes to switch to the alternate screen of the terminal, without line wrapping.
+	enterScreen = "\033[?1049h\033[?7l\033[?25l"
+	leaveScreen = "\033[?25h\033[?7h\033[?1049l"
+	clearScreen = "\033[H\033[2J"
+)
+
+// buildMonitorCmd builds a sub-command to monitor the running node in the terminal.
+func buildMonitorCmd(parentCmd *cobra.Command) {
+	monitorCmd := &cobra.Command{
+		Use:   "monitor",
+		Short: "monitor the running node: height, round, peers, mempool, validators and recent logs",
+	}
+	parentCmd.AddCommand(monitorCmd)
+
+	workingDirOpt := monitorCmd.Flags().StringP("working-dir", "w", cmd.PactusDefaultHomeDir(),
+		"the path to the working directory of the node")
+
+	serverAddrOpt := monitorCmd.Flags().String("server-addr", "",
+		"the gRPC address of the node, if not set the unix socket of the node is used")
+
+	intervalOpt := monitorCmd.Flags().DurationP("interval", "i", 2*time.Second,
+		"the interval to refresh the information")
+
+	logLinesOpt := monitorCmd.Flags().Int("log-lines", 10,
+		"number of the recent log lines to show")
+
+	monitorCmd.Run = func(_ *cobra.Command, _ []string) {
+		workingDir := absPath(*workingDirOpt)
+
+		serverAddr := *serverAddrOpt
+		if serverAddr == "" {
+			addr, ok := cmd.LocalUnixSocketAddress(workingDir)
+			if !ok {
+				cmd.PrintErrorMsgf("The node is not running in %s, or its unix socket is disabled", workingDir)
+				cmd.PrintInfoMsgf("Use --server-addr to monitor the node over TCP")
+
+				return
+			}
+			serverAddr = addr
+		}
+
+		conn, err := grpc.Dial(serverAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+		cmd.FatalErrorCheck(err)
+		defer func() {
+			_ = conn.Close()
+		}()
+
+		ctx, cancel := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+		defer cancel()
+
+		if cmd.CheckTerminalSupported() {
+			fmt.Print(enterScreen)
+			defer fmt.Print(leaveScreen)
+		}
+
+		logFile := filepath.Join(workingDir, logger.LogFilename)
+		ticker := time.NewTicker(*intervalOpt)
+		defer ticker.Stop()
+
+		for {
+			fmt.Print(clearScreen)
+			fmt.Print(renderMonitor(ctx, conn, logFile, *logLinesOpt))
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}
+}
+
+func renderMonitor(ctx context.Context, conn *grpc.ClientConn, logFile string, logLines int) string {
+	reqCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	info, err := cmd.FetchMonitorInfo(reqCtx, conn)
+	if err != nil {
+		return fmt.Sprintf("Unable to get the node information: %v\nRetrying...\n", err)
+	}
+
+	lines, err := cmd.TailLogFile(logFile, logLines)
+	if err != nil && !os.IsNotExist(err) {
+		lines = []string{err.Error()}
+	}
+	info.Logs = lines
+
+	return cmd.RenderMonitor(info, time.Now())
+}
@@ -0,0 +1,79 @@
+package main
+
+import (
+	"encoding/json"
+
+	"github.com/pactus-project/pactus/cmd"
+	"github.com/pactus-project/pactus/util"
+	"github.com/spf13/cobra"
+)
+
+// buildSimulateCmd builds a sub-command to run the offline simulations of the network.
+func buildSimulateCmd(parentCmd *cobra.Command) {
+	simulateCmd := &cobra.Command{
+		Use:   "simulate",
+		Short: "run the offline simulations of the network, for tuning the parameters",
+	}
+	parentCmd.AddCommand(simulateCmd)
+
+	buildSimulateCommitteeCmd(simulateCmd)
+}
+
+// buildSimulateCommitteeCmd builds a sub-command to simulate the committee for a stake distribution.
+func buildSimulateCommitteeCmd(parentCmd *cobra.Command) {
+	committeeCmd := &cobra.Command{
+		Use:   "committee",
+		Short: "simulate the sortition and the committee for a stake distribution of the validators",
+	}
+	parentCmd.AddCommand(committeeCmd)
+
+	stakesOpt := committeeCmd.Flags().StringP("stakes", "s", "",
+		"a path to the JSON file of the validators and their stakes in PAC, like: "+
+			"[{\"name\": \"val-1\", \"stake\": 1000}]")
+
+	blocksOpt := committeeCmd.Flags().Uint32P("blocks", "b", 100_000,
+		"number of the blocks to simulate")
+
+	warmupOpt := committeeCmd.Flags().Uint32("warmup", 8640,
+		"number of the blocks to simulate before collecting the results, so the committee is filled")
+
+	windowOpt := committeeCmd.Flags().Uint32("window", 8640,
+		"number of the blocks in each window, to measure the variance of the proposals")
+
+	committeeSizeOpt := committeeCmd.Flags().Int("committee-size", 51,
+		"number of the validators in the committee")
+
+	seedOpt := committeeCmd.Flags().Int64("seed", 0,
+		"seed of the simulation to reproduce the results, if not set a random seed is used")
+
+	outputOpt := committeeCmd.Flags().StringP("output", "o", "",
+		"a path to save the results in JSON, if not set the results are printed")
+
+	_ = committeeCmd.MarkFlagRequired("stakes")
+
+	committeeCmd.Run = func(_ *cobra.Command, _ []string) {
+		vals, err := cmd.LoadStakeDistribution(*stakesOpt)
+		cmd.FatalErrorCheck(err)
+
+		res, err := cmd.SimulateCommittee(cmd.SimulateCommitteeConfig{
+			Validators:    vals,
+			Blocks:        *blocksOpt,
+			Warmup:        *warmupOpt,
+			Window:        *windowOpt,
+			CommitteeSize: *committeeSizeOpt,
+			Seed:          *seedOpt,
+		})
+		cmd.FatalErrorCheck(err)
+
+		data, err := json.MarshalIndent(res, "", "  ")
+		cmd.FatalErrorCheck(err)
+
+		if *outputOpt == "" {
+			cmd.PrintJSONData(data)
+
+			return
+		}
+		cmd.FatalErrorCheck(util.WriteFile(*outputOpt, data))
+		cmd.PrintSuccessMsgf("Simulation results are saved at: %s", *outputOpt)
+	}
+}
@@ -1,14 +1,15 @@
 package main
 
 import (
+	"fmt"
 	"net/http"
 	_ "net/http/pprof" // #nosec
 	"os"
 	"path/filepath"
 	"time"
 
-	flock "github.com/gofrs/flock"
 	"github.com/pactus-project/pactus/cmd"
+	"github.com/pactus-project/pactus/util/dirlock"
 	"github.com/pactus-project/pactus/wallet"
 	"github.com/spf13/cobra"
 )
@@ -31,26 +32,36 @@ func buildStartCmd(parentCmd *cobra.Command) {
 	pprofOpt := startCmd.Flags().String("pprof", "",
 		"pprof server address (for debugging)")
 
+	resetOpt := startCmd.Flags().Bool("reset-data", false,
+		"reset the data directory without confirmation if the stored chain doesn't match the genesis (test networks only)")
+
+	upgradeBinaryOpt := startCmd.Flags().String("upgrade-binary", "",
+		"the path to the binary to upgrade to on SIGUSR2, default is the path of the running binary")
+
+	ignoreLockOpt := startCmd.Flags().Bool("ignore-lock", false,
+		"start even if the working directory is locked by another instance (dangerous, it may corrupt the database)")
+
 	startCmd.Run = func(_ *cobra.Command, _ []string) {
 		workingDir, _ := filepath.Abs(*workingDirOpt)
 		// change working directory
 		err := os.Chdir(workingDir)
 		cmd.FatalErrorCheck(err)
 
-		// Define the lock file path
-		lockFilePath := filepath.Join(workingDir, ".pactus.lock")
-		fileLock := flock.New(lockFilePath)
-
-		locked, err := fileLock.TryLock()
+		// Prevent two instances from opening the same database.
+		lock, err := dirlock.Acquire(workingDir)
 		if err != nil {
-			// handle unable to attempt to acquire lock
-			cmd.FatalErrorCheck(err)
-		}
+			if !*ignoreLockOpt {
+				cmd.PrintErrorMsgf("%v", err)
+				cmd.PrintInfoMsgf("Use '--ignore-lock' only if you are sure no other instance is running.")
 
-		if !locked {
-			cmd.PrintWarnMsgf("Could not lock '%s', another instance is running?", lockFilePath)
-
-			return
+				return
+			}
+			cmd.PrintWarnMsgf("Ignoring the lock: %v", err)
+		}
+		releaseLock := func() {
+			if lock != nil {
+				_ = lock.Release()
+			}
 		}
 
 		if *pprofOpt != "" {
@@ -65,30 +76,61 @@ func buildStartCmd(parentCmd *cobra.Command) {
 			}()
 		}
 
+		// The wallet password is kept to hand it over to the upgraded binary.
+		var walletPassword string
 		passwordFetcher := func(wlt *wallet.Wallet) (string, bool) {
 			if !wlt.IsEncrypted() {
 				return "", true
 			}
 
-			var password string
-			if *passwordOpt != "" {
-				password = *passwordOpt
+			if password, ok := cmd.UpgradePassword(); ok {
+				walletPassword = password
+			} else if *passwordOpt != "" {
+				walletPassword = *passwordOpt
 			} else {
-				password = cmd.PromptPassword("Wallet password", false)
+				walletPassword = cmd.PromptPassword("Wallet password", false)
 			}
 
-			return password, true
+			return walletPassword, true
 		}
 		node, _, err := cmd.StartNode(
 			workingDir, passwordFetcher)
+		if cmd.IsGenesisMismatch(err) {
+			cmd.PrintWarnMsgf("The stored chain doesn't match the genesis, the network might be reset: %s", err)
+
+			reset, resetErr := cmd.ResetDataDir(workingDir, func(dataPath string) bool {
+				if *resetOpt {
+					return true
+				}
+
+				return cmd.PromptConfirm(fmt.Sprintf("Do you want to remove the data directory '%s' and sync again", dataPath))
+			})
+			cmd.FatalErrorCheck(resetErr)
+
+			if reset {
+				cmd.PrintInfoMsgf("The data directory is reset, syncing from the genesis...")
+				node, _, err = cmd.StartNode(
+					workingDir, passwordFetcher)
+			}
+		}
 		cmd.FatalErrorCheck(err)
 
 		cmd.TrapSignal(func() {
-			_ = fileLock.Unlock()
 			node.Stop()
+			releaseLock()
 			cmd.PrintInfoMsgf("Exiting ...")
 		})
 
+		upgradeBinary := *upgradeBinaryOpt
+		if upgradeBinary == "" {
+			upgradeBinary, err = os.Executable()
+			cmd.FatalErrorCheck(err)
+		}
+		cmd.TrapUpgradeSignal(upgradeBinary, walletPassword, func() {
+			node.StopForUpgrade()
+			releaseLock()
+		})
+
 		// run forever (the node will not be returned)
 		select {}
 	}
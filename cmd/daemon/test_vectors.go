@@ -0,0 +1,66 @@
+package main
+
+import (
+	"os"
+
+	"github.com/pactus-project/pactus/cmd"
+	"github.com/pactus-project/pactus/types/testvector"
+	"github.com/spf13/cobra"
+)
+
+// buildTestVectorsCmd builds a sub-command to generate and verify the canonical test vectors.
+func buildTestVectorsCmd(parentCmd *cobra.Command) {
+	testVectorsCmd := &cobra.Command{
+		Use:   "test-vectors",
+		Short: "generate and verify the canonical test vectors of the serialized types",
+	}
+	parentCmd.AddCommand(testVectorsCmd)
+
+	buildTestVectorsGenerateCmd(testVectorsCmd)
+	buildTestVectorsVerifyCmd(testVectorsCmd)
+}
+
+func buildTestVectorsGenerateCmd(parentCmd *cobra.Command) {
+	generateCmd := &cobra.Command{
+		Use:   "generate",
+		Short: "generate the test vectors for addresses, transactions, blocks, certificates and votes",
+	}
+	parentCmd.AddCommand(generateCmd)
+
+	outputOpt := generateCmd.Flags().StringP("output", "o", "test_vectors.json",
+		"a path to save the test vectors")
+
+	generateCmd.Run = func(_ *cobra.Command, _ []string) {
+		suite, err := testvector.Generate()
+		cmd.FatalErrorCheck(err)
+		cmd.FatalErrorCheck(suite.SaveToFile(*outputOpt))
+
+		cmd.PrintSuccessMsgf("%d test vectors (version %d) are saved at: %s",
+			len(suite.Vectors), suite.Version, *outputOpt)
+	}
+}
+
+func buildTestVectorsVerifyCmd(parentCmd *cobra.Command) {
+	verifyCmd := &cobra.Command{
+		Use:   "verify [file]",
+		Short: "verify the test vectors against this implementation",
+		Args:  cobra.ExactArgs(1),
+	}
+	parentCmd.AddCommand(verifyCmd)
+
+	verifyCmd.Run = func(_ *cobra.Command, args []string) {
+		suite, err := testvector.LoadFromFile(args[0])
+		cmd.FatalErrorCheck(err)
+
+		errs := testvector.Verify(suite)
+		for _, err := range errs {
+			cmd.PrintErrorMsgf("%s", err)
+		}
+		if len(errs) > 0 {
+			cmd.PrintWarnMsgf("%d of %d test vectors failed", len(errs), len(suite.Vectors))
+			os.Exit(1)
+		}
+
+		cmd.PrintSuccessMsgf("All %d test vectors are verified", len(suite.Vectors))
+	}
+}
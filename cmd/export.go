@@ -0,0 +1,295 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+
+	"github.com/pactus-project/pactus/config"
+	"github.com/pactus-project/pactus/crypto"
+	"github.com/pactus-project/pactus/genesis"
+	"github.com/pactus-project/pactus/store"
+	"github.com/pactus-project/pactus/types/tx"
+	"github.com/pactus-project/pactus/util"
+	"github.com/pactus-project/pactus/util/parquet"
+)
+
+// The rows of the exported tables.
+// The column names are part of the schema that the analytics tools rely on,
+// so they should not be renamed; new columns can be added at the end.
+// The addresses that are not set, like the receiver of the sortition transactions,
+// are exported as empty strings.
+
+// BlockRow is a row of the blocks table.
+type BlockRow struct {
+	Height        uint32 `parquet:"height"`
+	Hash          string `parquet:"hash"`
+	PrevBlockHash string `parquet:"prev_block_hash"`
+	StateRoot     string `parquet:"state_root"`
+	Time          int64  `parquet:"time,timestamp"`
+	Version       uint8  `parquet:"version"`
+	Proposer      string `parquet:"proposer"`
+	TxCount       int32  `parquet:"tx_count"`
+	Size          int32  `parquet:"size"`
+}
+
+// TransactionRow is a row of the transactions table.
+type TransactionRow struct {
+	Height      uint32 `parquet:"height"`
+	Index       int32  `parquet:"index"`
+	ID          string `parquet:"id"`
+	Version     uint8  `parquet:"version"`
+	LockTime    uint32 `parquet:"lock_time"`
+	PayloadType string `parquet:"payload_type"`
+	Signer      string `parquet:"signer"`
+	Receiver    string `parquet:"receiver"`
+	Value       int64  `parquet:"value"`
+	Fee         int64  `parquet:"fee"`
+	Memo        string `parquet:"memo"`
+}
+
+// TransferRow is a row of the transfers table.
+// The subsidy transactions are exported as the transfers from the treasury address.
+type TransferRow struct {
+	Height   uint32 `parquet:"height"`
+	TxID     string `parquet:"tx_id"`
+	Sender   string `parquet:"sender"`
+	Receiver string `parquet:"receiver"`
+	Amount   int64  `parquet:"amount"`
+	Fee      int64  `parquet:"fee"`
+}
+
+// ValidatorEventRow is a row of the validator events table.
+// The events are the bond, unbond, withdraw and sortition transactions.
+type ValidatorEventRow struct {
+	Height    uint32 `parquet:"height"`
+	TxID      string `parquet:"tx_id"`
+	Event     string `parquet:"event"`
+	Validator string `parquet:"validator"`
+	Account   string `parquet:"account"`
+	Amount    int64  `parquet:"amount"`
+}
+
+// ExportChainConfig defines the range of the blocks to export.
+type ExportChainConfig struct {
+	From      uint32
+	To        uint32
+	OutputDir string
+}
+
+// ExportChainResult holds the number of the exported rows and the created files.
+type ExportChainResult struct {
+	From            uint32   `json:"from"`
+	To              uint32   `json:"to"`
+	Blocks          int      `json:"blocks"`
+	Transactions    int      `json:"transactions"`
+	Transfers       int      `json:"transfers"`
+	ValidatorEvents int      `json:"validator_events"`
+	Files           []string `json:"files"`
+}
+
+// ExportChain exports the blocks in the given height range, inclusive, to the Parquet files.
+// Each table is written into its own sub-directory of the output directory, and the files
+// are named by the height range, so the chain can be exported incrementally.
+// If To is zero, the blocks are exported up to the last committed block.
+func ExportChain(str store.Reader, conf ExportChainConfig) (*ExportChainResult, error) {
+	lastCert := str.LastCertificate()
+	if lastCert == nil {
+		return nil, errors.New("no block is committed yet")
+	}
+
+	from := conf.From
+	if from == 0 {
+		from = 1
+	}
+	to := conf.To
+	if to == 0 {
+		to = lastCert.Height()
+	}
+	if from > to {
+		return nil, fmt.Errorf("invalid height range: %d-%d", from, to)
+	}
+	if to > lastCert.Height() {
+		return nil, fmt.Errorf("the last committed block is %d", lastCert.Height())
+	}
+
+	blocks := []BlockRow{}
+	trxs := []TransactionRow{}
+	transfers := []TransferRow{}
+	events := []ValidatorEventRow{}
+	for height := from; height <= to; height++ {
+		cBlk, err := str.Block(height)
+		if err != nil {
+			return nil, fmt.Errorf("unable to load block %d: %w", height, err)
+		}
+		blk, err := cBlk.ToBlock()
+		if err != nil {
+			return nil, fmt.Errorf("unable to decode block %d: %w", height, err)
+		}
+
+		hdr := blk.Header()
+		blocks = append(blocks, BlockRow{
+			Height:        height,
+			Hash:          cBlk.BlockHash.String(),
+			PrevBlockHash: hdr.PrevBlockHash().String(),
+			StateRoot:     hdr.StateRoot().String(),
+			Time:          hdr.UnixMilli(),
+			Version:       hdr.Version(),
+			Proposer:      hdr.ProposerAddress().String(),
+			TxCount:       int32(blk.Transactions().Len()),
+			Size:          int32(len(cBlk.Data)),
+		})
+
+		for i, trx := range blk.Transactions() {
+			txID := trx.ID().String()
+			pld := trx.Payload()
+			row := TransactionRow{
+				Height:      height,
+				Index:       int32(i),
+				ID:          txID,
+				Version:     trx.Version(),
+				LockTime:    trx.LockTime(),
+				PayloadType: pld.Type().String(),
+				Signer:      pld.Signer().String(),
+				Value:       pld.Value(),
+				Fee:         trx.Fee(),
+				Memo:        trx.Memo(),
+			}
+			if receiver := pld.Receiver(); receiver != nil {
+				row.Receiver = receiver.String()
+			}
+			trxs = append(trxs, row)
+
+			if trx.IsTransferTx() {
+				transfers = append(transfers, TransferRow{
+					Height:   height,
+					TxID:     txID,
+					Sender:   pld.Signer().String(),
+					Receiver: row.Receiver,
+					Amount:   pld.Value(),
+					Fee:      trx.Fee(),
+				})
+			}
+
+			if event, ok := makeValidatorEvent(trx); ok {
+				event.Height = height
+				event.TxID = txID
+				events = append(events, event)
+			}
+		}
+	}
+
+	res := &ExportChainResult{
+		From:            from,
+		To:              to,
+		Blocks:          len(blocks),
+		Transactions:    len(trxs),
+		Transfers:       len(transfers),
+		ValidatorEvents: len(events),
+	}
+
+	fileName := fmt.Sprintf("%010d-%010d.parquet", from, to)
+	for _, write := range []func() (string, error){
+		func() (string, error) {
+			return writeParquet(filepath.Join(conf.OutputDir, "blocks", fileName), blocks)
+		},
+		func() (string, error) {
+			return writeParquet(filepath.Join(conf.OutputDir, "transactions", fileName), trxs)
+		},
+		func() (string, error) {
+			return writeParquet(filepath.Join(conf.OutputDir, "transfers", fileName), transfers)
+		},
+		func() (string, error) {
+			return writeParquet(filepath.Join(conf.OutputDir, "validator_events", fileName), events)
+		},
+	} {
+		path, err := write()
+		if err != nil {
+			return nil, err
+		}
+		res.Files = append(res.Files, path)
+	}
+
+	return res, nil
+}
+
+// OpenChainStore opens the store of the node in the working directory to read the chain.
+// The node should not be running, since the store can't be opened by two processes.
+func OpenChainStore(workingDir string) (store.Store, error) {
+	gen, err := genesis.LoadFromFile(PactusGenesisPath(workingDir))
+	if err != nil {
+		return nil, err
+	}
+
+	crypto.SetHRP(gen.HRP())
+
+	var defConf *config.Config
+	switch gen.ChainType() {
+	case genesis.Mainnet:
+		defConf = config.DefaultConfigMainnet()
+	case genesis.Testnet:
+		defConf = config.DefaultConfigTestnet()
+	case genesis.Localnet, genesis.Simnet:
+		defConf = config.DefaultConfigLocalnet()
+	}
+
+	conf, err := config.LoadFromFile(PactusConfigPath(workingDir), false, defConf)
+	if err != nil {
+		return nil, err
+	}
+
+	if !filepath.IsAbs(conf.Store.Path) {
+		conf.Store.Path = filepath.Join(workingDir, conf.Store.Path)
+	}
+
+	return store.NewStore(conf.Store)
+}
+
+func makeValidatorEvent(trx *tx.Tx) (ValidatorEventRow, bool) {
+	var event string
+	switch {
+	case trx.IsBondTx():
+		event = "bond"
+	case trx.IsUnbondTx():
+		event = "unbond"
+	case trx.IsWithdrawTx():
+		event = "withdraw"
+	case trx.IsSortitionTx():
+		event = "sortition"
+	default:
+		return ValidatorEventRow{}, false
+	}
+
+	pld := trx.Payload()
+	row := ValidatorEventRow{
+		Event:  event,
+		Amount: pld.Value(),
+	}
+
+	// The bond moves the stake from an account to a validator,
+	// and the withdraw moves it back to an account.
+	signer := pld.Signer()
+	receiver := pld.Receiver()
+	if signer.IsValidatorAddress() {
+		row.Validator = signer.String()
+		if receiver != nil {
+			row.Account = receiver.String()
+		}
+	} else {
+		row.Account = signer.String()
+		if receiver != nil {
+			row.Validator = receiver.String()
+		}
+	}
+
+	return row, true
+}
+
+func writeParquet[T any](path string, rows []T) (string, error) {
+	data, err := parquet.Marshal(rows)
+	if err != nil {
+		return "", err
+	}
+
+	return path, util.WriteFile(path, data)
+}
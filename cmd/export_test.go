@@ -0,0 +1,89 @@
+package cmd
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/pactus-project/pactus/store"
+	"github.com/pactus-project/pactus/util/testsuite"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExportChain(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	str := store.MockingStore(ts)
+	outputDir := t.TempDir()
+
+	t.Run("No block is committed", func(t *testing.T) {
+		_, err := ExportChain(str, ExportChainConfig{OutputDir: outputDir})
+		assert.Error(t, err)
+	})
+
+	// Each test block has a transfer, a sortition, a bond, an unbond and a withdraw transaction.
+	for height := uint32(1); height <= 3; height++ {
+		blk, cert := ts.GenerateTestBlock(height)
+		str.SaveBlock(blk, cert)
+	}
+
+	t.Run("Invalid height range", func(t *testing.T) {
+		_, err := ExportChain(str, ExportChainConfig{From: 3, To: 2, OutputDir: outputDir})
+		assert.Error(t, err)
+
+		_, err = ExportChain(str, ExportChainConfig{From: 1, To: 4, OutputDir: outputDir})
+		assert.Error(t, err)
+	})
+
+	t.Run("Export all the blocks", func(t *testing.T) {
+		res, err := ExportChain(str, ExportChainConfig{OutputDir: outputDir})
+		require.NoError(t, err)
+
+		assert.Equal(t, uint32(1), res.From)
+		assert.Equal(t, uint32(3), res.To)
+		assert.Equal(t, 3, res.Blocks)
+		assert.Equal(t, 15, res.Transactions)
+		assert.Equal(t, 3, res.Transfers)
+		assert.Equal(t, 12, res.ValidatorEvents)
+		assert.Equal(t, []string{
+			filepath.Join(outputDir, "blocks", "0000000001-0000000003.parquet"),
+			filepath.Join(outputDir, "transactions", "0000000001-0000000003.parquet"),
+			filepath.Join(outputDir, "transfers", "0000000001-0000000003.parquet"),
+			filepath.Join(outputDir, "validator_events", "0000000001-0000000003.parquet"),
+		}, res.Files)
+		for _, file := range res.Files {
+			assert.FileExists(t, file)
+		}
+	})
+
+	t.Run("Export incrementally", func(t *testing.T) {
+		res, err := ExportChain(str, ExportChainConfig{From: 3, OutputDir: outputDir})
+		require.NoError(t, err)
+
+		assert.Equal(t, 1, res.Blocks)
+		assert.Equal(t, 5, res.Transactions)
+		assert.FileExists(t, filepath.Join(outputDir, "blocks", "0000000003-0000000003.parquet"))
+	})
+}
+
+func TestMakeValidatorEvent(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	trx, _ := ts.GenerateTestBondTx()
+	event, ok := makeValidatorEvent(trx)
+	require.True(t, ok)
+	assert.Equal(t, "bond", event.Event)
+	assert.Equal(t, trx.Payload().Signer().String(), event.Account)
+	assert.Equal(t, trx.Payload().Receiver().String(), event.Validator)
+
+	trx, _ = ts.GenerateTestWithdrawTx()
+	event, ok = makeValidatorEvent(trx)
+	require.True(t, ok)
+	assert.Equal(t, "withdraw", event.Event)
+	assert.Equal(t, trx.Payload().Signer().String(), event.Validator)
+	assert.Equal(t, trx.Payload().Receiver().String(), event.Account)
+
+	trx, _ = ts.GenerateTestTransferTx()
+	_, ok = makeValidatorEvent(trx)
+	assert.False(t, ok)
+}
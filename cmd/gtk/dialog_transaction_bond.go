@@ -3,10 +3,12 @@
 package main
 
 import (
+	"context"
 	_ "embed"
 	"fmt"
 
 	"github.com/gotk3/gotk3/gtk"
+	"github.com/pactus-project/pactus/types/tx"
 	"github.com/pactus-project/pactus/types/tx/payload"
 	"github.com/pactus-project/pactus/util"
 	"github.com/pactus-project/pactus/wallet"
@@ -15,6 +17,26 @@ import (
 //go:embed assets/ui/dialog_transaction_bond.ui
 var uiTransactionBondDialog []byte
 
+// stakeConsequences validates the bond or unbond transaction against the state of the validator,
+// and returns its consequences to be confirmed by the user.
+func stakeConsequences(wlt *wallet.Wallet, trx *tx.Tx) (string, error) {
+	if wlt.IsOffline() {
+		return "", nil
+	}
+
+	summary, err := wlt.PreflightStakeTx(context.Background(), trx)
+	if err != nil {
+		return "", err
+	}
+
+	consequences := "\nBy signing this transaction:\n"
+	for _, consequence := range summary.Consequences() {
+		consequences += fmt.Sprintf("  - %v\n", consequence)
+	}
+
+	return consequences, nil
+}
+
 func broadcastTransactionBond(wlt *wallet.Wallet) {
 	builder, err := gtk.BuilderNewFromString(string(uiTransactionBondDialog))
 	fatalErrorCheck(err)
@@ -78,6 +100,12 @@ func broadcastTransactionBond(wlt *wallet.Wallet) {
 
 			return
 		}
+		consequences, err := stakeConsequences(wlt, trx)
+		if err != nil {
+			errorCheck(err)
+
+			return
+		}
 		msg := fmt.Sprintf(`
 You are going to sign and broadcast this transaction:
 
@@ -85,9 +113,9 @@ From:   %v
 To:     %v
 Amount: %v
 Fee:    %v
-
+%v
 THIS ACTION IS NOT REVERSIBLE. Do you want to continue?`, sender, receiver,
-			util.ChangeToString(amount), util.ChangeToString(trx.Fee()))
+			util.ChangeToString(amount), util.ChangeToString(trx.Fee()), consequences)
 
 		signAndBroadcastTransaction(dlg, msg, wlt, trx)
 
@@ -44,12 +44,18 @@ func broadcastTransactionUnbond(wlt *wallet.Wallet) {
 
 			return
 		}
+		consequences, err := stakeConsequences(wlt, trx)
+		if err != nil {
+			errorCheck(err)
+
+			return
+		}
 		msg := fmt.Sprintf(`
 You are going to sign and broadcast this transaction:
 
 Validator: %v
-
-THIS ACTION IS NOT REVERSIBLE. Do you want to continue?`, validator)
+%v
+THIS ACTION IS NOT REVERSIBLE. Do you want to continue?`, validator, consequences)
 
 		signAndBroadcastTransaction(dlg, msg, wlt, trx)
 
@@ -85,6 +85,9 @@ Fee:    %v
 
 THIS ACTION IS NOT REVERSIBLE. Do you want to continue?`, sender, receiver,
 			util.ChangeToString(amount), util.ChangeToString(trx.Fee()))
+		if err := wlt.CheckReceiver(receiver); err != nil {
+			msg = fmt.Sprintf("\nWARNING: %v\n%v", err, msg)
+		}
 
 		signAndBroadcastTransaction(dlg, msg, wlt, trx)
 
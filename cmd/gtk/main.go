@@ -9,13 +9,14 @@ import (
 	"os"
 	"path/filepath"
 
-	flock "github.com/gofrs/flock"
 	"github.com/gotk3/gotk3/gdk"
 	"github.com/gotk3/gotk3/glib"
 	"github.com/gotk3/gotk3/gtk"
 	"github.com/pactus-project/pactus/cmd"
+	"github.com/pactus-project/pactus/config"
 	"github.com/pactus-project/pactus/genesis"
 	"github.com/pactus-project/pactus/util"
+	"github.com/pactus-project/pactus/util/dirlock"
 	"github.com/pactus-project/pactus/version"
 	"github.com/pactus-project/pactus/wallet"
 )
@@ -26,12 +27,15 @@ var (
 	workingDirOpt *string
 	passwordOpt   *string
 	testnetOpt    *bool
+	ignoreLockOpt *bool
 )
 
 func init() {
 	workingDirOpt = flag.String("working-dir", cmd.PactusDefaultHomeDir(), "working directory path")
 	passwordOpt = flag.String("password", "", "wallet password")
 	testnetOpt = flag.Bool("testnet", false, "initializing for the testnet")
+	ignoreLockOpt = flag.Bool("ignore-lock", false,
+		"start even if the working directory is locked by another instance (dangerous, it may corrupt the database)")
 	version.AppType = "gui"
 	gtk.Init(nil)
 }
@@ -58,20 +62,16 @@ func main() {
 		}
 	}
 
-	// Define the lock file path
-	lockFilePath := filepath.Join(workingDir, ".pactus.lock")
-	fileLock := flock.New(lockFilePath)
-
-	locked, err := fileLock.TryLock()
+	// Prevent two instances from opening the same database.
+	lock, err := dirlock.Acquire(workingDir)
 	if err != nil {
-		// handle unable to attempt to acquire lock
-		fatalErrorCheck(err)
-	}
-
-	if !locked {
-		fmt.Printf("Could not lock '%s', another instance is running?", lockFilePath)
+		if !*ignoreLockOpt {
+			showErrorDialog(nil, err.Error())
+			cmd.PrintErrorMsgf("%v", err)
 
-		return
+			return
+		}
+		log.Printf("Ignoring the lock: %v", err)
 	}
 
 	// Create a new app.
@@ -118,7 +118,9 @@ func main() {
 
 	// Connect function to application shutdown event, this is not required.
 	app.Connect("shutdown", func() {
-		_ = fileLock.Unlock()
+		if lock != nil {
+			_ = lock.Release()
+		}
 		log.Println("application shutdown")
 	})
 
@@ -141,13 +143,28 @@ func start(workingDir string, app *gtk.Application) {
 
 		return getWalletPassword(wlt)
 	}
-	node, wlt, err := cmd.StartNode(workingDir, passwordFetcher)
+	// The GUI connects to the node through the bridge, that is a local endpoint for the GUI.
+	enableBridge := func(conf *config.Config) {
+		conf.GRPC.Bridge.Enable = true
+	}
+	node, wlt, err := cmd.StartNode(workingDir, passwordFetcher, enableBridge)
+	if cmd.IsGenesisMismatch(err) {
+		reset, resetErr := cmd.ResetDataDir(workingDir, func(dataPath string) bool {
+			return showQuestionDialog(nil, fmt.Sprintf("The stored chain doesn't match the genesis, "+
+				"the network might be reset.\n\nDo you want to remove the data directory '%s' and sync again?", dataPath))
+		})
+		fatalErrorCheck(resetErr)
+
+		if reset {
+			node, wlt, err = cmd.StartNode(workingDir, passwordFetcher, enableBridge)
+		}
+	}
 	fatalErrorCheck(err)
 
-	grpcAddr := node.GRPC().Address()
-	fmt.Printf("connect wallet to grpc server: %s\n", grpcAddr)
+	bridgeAddr := node.GRPC().BridgeAddress()
+	fmt.Printf("connect wallet to grpc bridge: %s\n", bridgeAddr)
 
-	err = wlt.Connect(grpcAddr)
+	err = wlt.ConnectToBridge(bridgeAddr, node.GRPC().BridgeToken())
 	fatalErrorCheck(err)
 
 	nodeModel := newNodeModel(node)
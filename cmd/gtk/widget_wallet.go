@@ -3,11 +3,14 @@
 package main
 
 import (
+	"context"
 	_ "embed"
+	"log"
 
 	"github.com/gotk3/gotk3/gdk"
 	"github.com/gotk3/gotk3/glib"
 	"github.com/gotk3/gotk3/gtk"
+	pactus "github.com/pactus-project/pactus/www/grpc/gen/go"
 )
 
 // IDs to access the tree view columns.
@@ -145,7 +148,7 @@ func buildWidgetWallet(model *walletModel) (*widgetWallet, error) {
 	}
 	builder.ConnectSignals(signals)
 
-	glib.TimeoutAdd(15000, w.timeout) // each 15 seconds
+	go w.watchBlockchain()
 
 	return w, nil
 }
@@ -170,6 +173,24 @@ func (ww *widgetWallet) onShowSeed() {
 	showSeed(seed)
 }
 
+// watchBlockchain rebuilds the model whenever a new block is committed.
+// If the wallet is not connected to the bridge, it falls back to rebuilding the model periodically.
+func (ww *widgetWallet) watchBlockchain() {
+	err := ww.model.wallet.WatchBlockchain(context.Background(),
+		func(_ *pactus.GetBlockchainInfoResponse) {
+			ww.model.rebuildModel()
+		})
+	if err != nil {
+		log.Printf("unable to watch the blockchain: %v\n", err)
+	}
+
+	glib.IdleAdd(func() bool {
+		glib.TimeoutAdd(15000, ww.timeout) // each 15 seconds
+
+		return false
+	})
+}
+
 func (ww *widgetWallet) timeout() bool {
 	ww.model.rebuildModel()
 
@@ -0,0 +1,98 @@
+package cmd
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/pactus-project/pactus/crypto"
+	"github.com/pactus-project/pactus/crypto/bls"
+	"github.com/pactus-project/pactus/genesis"
+	"github.com/pactus-project/pactus/state"
+	"github.com/pactus-project/pactus/types/halt"
+	"github.com/pactus-project/pactus/util"
+	"github.com/pactus-project/pactus/wallet"
+)
+
+// PactusHaltOrderPath returns the path of the halt order in the working directory.
+func PactusHaltOrderPath(home string) string {
+	return filepath.Join(home, "halt.json")
+}
+
+// PactusResumePath returns the path of the resume artifact in the working directory.
+func PactusResumePath(home string) string {
+	return filepath.Join(home, "resume.json")
+}
+
+// applyHaltArtifacts applies the halt order and the resume artifact,
+// if they exist in the working directory.
+// An invalid artifact prevents the node from starting, since the operator
+// expects the node to halt or resume.
+func applyHaltArtifacts(workingDir string, st state.Facade) error {
+	haltPath := PactusHaltOrderPath(workingDir)
+	if util.PathExists(haltPath) {
+		ord, err := halt.LoadOrderFromFile(haltPath)
+		if err != nil {
+			return err
+		}
+		if err := st.Halt(ord); err != nil {
+			return fmt.Errorf("unable to apply the halt order: %w", err)
+		}
+		PrintWarnMsgf("The chain halts at height %d: %s", ord.Height(), ord.Reason())
+	}
+
+	resumePath := PactusResumePath(workingDir)
+	if util.PathExists(resumePath) && st.HaltHeight() != 0 {
+		rsm, err := halt.LoadResumeFromFile(resumePath)
+		if err != nil {
+			return err
+		}
+		if err := st.Resume(rsm); err != nil {
+			return fmt.Errorf("unable to apply the resume artifact: %w", err)
+		}
+		PrintInfoMsgf("The chain is resumed at height %d", rsm.Height())
+	}
+
+	return nil
+}
+
+// LoadValidatorKeys loads the validator keys of the default wallet in the working directory.
+func LoadValidatorKeys(workingDir string, passwordFetcher func(*wallet.Wallet) (string, bool)) (
+	[]*bls.ValidatorKey, error,
+) {
+	gen, err := genesis.LoadFromFile(PactusGenesisPath(workingDir))
+	if err != nil {
+		return nil, err
+	}
+	crypto.SetHRP(gen.HRP())
+
+	walletInstance, err := wallet.Open(PactusDefaultWalletPath(workingDir), true)
+	if err != nil {
+		return nil, err
+	}
+
+	valAddrsInfo := walletInstance.AllValidatorAddresses()
+	if len(valAddrsInfo) == 0 {
+		return nil, fmt.Errorf("no validator addresses found in the wallet")
+	}
+
+	valAddrs := make([]string, 0, len(valAddrsInfo))
+	for _, info := range valAddrsInfo {
+		valAddrs = append(valAddrs, info.Address)
+	}
+
+	password, ok := passwordFetcher(walletInstance)
+	if !ok {
+		return nil, fmt.Errorf("aborted")
+	}
+	prvKeys, err := walletInstance.PrivateKeys(password, valAddrs)
+	if err != nil {
+		return nil, err
+	}
+
+	valKeys := make([]*bls.ValidatorKey, 0, len(prvKeys))
+	for _, prv := range prvKeys {
+		valKeys = append(valKeys, bls.NewValidatorKey(prv.(*bls.PrivateKey)))
+	}
+
+	return valKeys, nil
+}
@@ -0,0 +1,85 @@
+package cmd
+
+import (
+	"os"
+	"testing"
+
+	"github.com/pactus-project/pactus/network"
+	"github.com/pactus-project/pactus/state"
+	"github.com/pactus-project/pactus/types/halt"
+	"github.com/pactus-project/pactus/util"
+	"github.com/pactus-project/pactus/util/testsuite"
+	"github.com/pactus-project/pactus/wallet"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApplyHaltArtifacts(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	workingDir := util.TempDirPath()
+	st := state.MockingState(ts)
+
+	t.Run("No artifact", func(t *testing.T) {
+		require.NoError(t, applyHaltArtifacts(workingDir, st))
+		assert.Zero(t, st.HaltHeight())
+	})
+
+	t.Run("Resume without halt is ignored", func(t *testing.T) {
+		rsm := halt.NewResume(100, ts.RandHash(), 100, []int32{0, 1, 2, 3})
+		require.NoError(t, rsm.SaveToFile(PactusResumePath(workingDir)))
+
+		require.NoError(t, applyHaltArtifacts(workingDir, st))
+		assert.Zero(t, st.HaltHeight())
+	})
+
+	t.Run("Invalid halt order", func(t *testing.T) {
+		require.NoError(t, util.WriteFile(PactusHaltOrderPath(workingDir), []byte("invalid")))
+
+		assert.Error(t, applyHaltArtifacts(workingDir, st))
+	})
+
+	t.Run("Halt and resume", func(t *testing.T) {
+		ord := halt.NewOrder(100, "emergency", 90, []int32{0, 1, 2, 3})
+		require.NoError(t, ord.SaveToFile(PactusHaltOrderPath(workingDir)))
+
+		require.NoError(t, applyHaltArtifacts(workingDir, st))
+		assert.Zero(t, st.HaltHeight(), "the resume artifact should be applied")
+	})
+
+	t.Run("Halt without resume", func(t *testing.T) {
+		require.NoError(t, os.Remove(PactusResumePath(workingDir)))
+
+		require.NoError(t, applyHaltArtifacts(workingDir, st))
+		assert.Equal(t, uint32(100), st.HaltHeight())
+	})
+}
+
+func TestLoadValidatorKeys(t *testing.T) {
+	outputDir := util.TempDirPath()
+	nodes, err := GenerateLocalnet(outputDir, 1, "secret", false, network.ChaosConfig{})
+	require.NoError(t, err)
+
+	t.Run("Wrong password", func(t *testing.T) {
+		_, err := LoadValidatorKeys(nodes[0].WorkingDir, func(*wallet.Wallet) (string, bool) {
+			return "wrong", true
+		})
+		assert.Error(t, err)
+	})
+
+	t.Run("Aborted", func(t *testing.T) {
+		_, err := LoadValidatorKeys(nodes[0].WorkingDir, func(*wallet.Wallet) (string, bool) {
+			return "", false
+		})
+		assert.Error(t, err)
+	})
+
+	t.Run("Ok", func(t *testing.T) {
+		valKeys, err := LoadValidatorKeys(nodes[0].WorkingDir, func(*wallet.Wallet) (string, bool) {
+			return "secret", true
+		})
+		require.NoError(t, err)
+		require.Len(t, valKeys, 1)
+		assert.Equal(t, nodes[0].ValidatorAddr, valKeys[0].Address().String())
+	})
+}
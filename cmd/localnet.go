@@ -0,0 +1,234 @@
+package cmd
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	lp2ppeer "github.com/libp2p/go-libp2p/core/peer"
+	"github.com/pactus-project/pactus/config"
+	"github.com/pactus-project/pactus/crypto/bls"
+	"github.com/pactus-project/pactus/genesis"
+	"github.com/pactus-project/pactus/network"
+	"github.com/pactus-project/pactus/types/validator"
+	"github.com/pactus-project/pactus/util"
+	"github.com/pactus-project/pactus/wallet"
+)
+
+// The ports of the first node in the local network.
+// The ports of the other nodes are incremented by the index of the node.
+const (
+	LocalnetP2PPort     = 21666
+	LocalnetGRPCPort    = 50052
+	LocalnetGatewayPort = 8080
+	LocalnetHTTPPort    = 8180
+	LocalnetNanomsgPort = 40799
+
+	LocalnetMaxNodes = 32
+)
+
+// LocalnetNode contains the information of a node in the generated local network.
+type LocalnetNode struct {
+	Name          string
+	WorkingDir    string
+	ValidatorAddr string
+	RewardAddr    string
+	PeerID        string
+	P2PPort       int
+	GRPCPort      int
+	GatewayPort   int
+	HTTPPort      int
+	NanomsgPort   int
+}
+
+func newLocalnetNode(outputDir string, index int) *LocalnetNode {
+	name := fmt.Sprintf("node-%d", index+1)
+
+	return &LocalnetNode{
+		Name:        name,
+		WorkingDir:  filepath.Join(outputDir, name),
+		P2PPort:     LocalnetP2PPort + index,
+		GRPCPort:    LocalnetGRPCPort + index,
+		GatewayPort: LocalnetGatewayPort + index,
+		HTTPPort:    LocalnetHTTPPort + index,
+		NanomsgPort: LocalnetNanomsgPort + index,
+	}
+}
+
+// bootstrapAddr returns the address of the node that the other nodes can connect to.
+// Inside the docker network, the nodes are reachable by their service names.
+func (n *LocalnetNode) bootstrapAddr(dockerCompose bool) string {
+	if dockerCompose {
+		return fmt.Sprintf("/dns/%s/tcp/%d/p2p/%s", n.Name, n.P2PPort, n.PeerID)
+	}
+
+	return fmt.Sprintf("/ip4/127.0.0.1/tcp/%d/p2p/%s", n.P2PPort, n.PeerID)
+}
+
+func (n *LocalnetNode) config(bootstrapAddrs []string, chaos network.ChaosConfig) *config.Config {
+	conf := config.DefaultConfigLocalnet()
+	conf.Network.ListenAddrStrings = []string{
+		fmt.Sprintf("/ip4/0.0.0.0/tcp/%d", n.P2PPort),
+		fmt.Sprintf("/ip4/0.0.0.0/udp/%d/quic-v1", n.P2PPort),
+	}
+	conf.Network.BootstrapAddrStrings = bootstrapAddrs
+	conf.Network.ForcePrivateNetwork = true
+	conf.Network.Chaos = chaos
+	conf.GRPC.Listen = fmt.Sprintf("[::]:%d", n.GRPCPort)
+	conf.GRPC.Gateway.Listen = fmt.Sprintf("[::]:%d", n.GatewayPort)
+	conf.HTTP.Listen = fmt.Sprintf("[::]:%d", n.HTTPPort)
+	conf.Nanomsg.Listen = fmt.Sprintf("tcp://[::]:%d", n.NanomsgPort)
+
+	return conf
+}
+
+// GenerateLocalnet generates the working directories of the nodes for a local network.
+// Each node has a wallet with one validator, and all the validators are in the genesis committee.
+// The first node is the bootstrap node of the network.
+// If dockerCompose is set, a docker compose file is also generated in the output directory.
+// The chaos config is set for all the nodes, to simulate adverse network conditions.
+// If the chaos seed is set, each node gets a different seed derived from it.
+func GenerateLocalnet(outputDir string, numNodes int, password string,
+	dockerCompose bool, chaos network.ChaosConfig,
+) ([]*LocalnetNode, error) {
+	if numNodes < 1 || numNodes > LocalnetMaxNodes {
+		return nil, fmt.Errorf("number of nodes should be between 1 and %d", LocalnetMaxNodes)
+	}
+
+	if !util.IsDirNotExistsOrEmpty(outputDir) {
+		return nil, fmt.Errorf("the output directory is not empty: %s", outputDir)
+	}
+
+	nodes := make([]*LocalnetNode, 0, numNodes)
+	vals := make([]*validator.Validator, 0, numNodes)
+	for i := 0; i < numNodes; i++ {
+		node := newLocalnetNode(outputDir, i)
+
+		val, err := createLocalnetWallet(node, int32(i), password)
+		if err != nil {
+			return nil, err
+		}
+
+		networkKey, err := network.LoadOrCreateKey(filepath.Join(node.WorkingDir, "network_key"))
+		if err != nil {
+			return nil, err
+		}
+		peerID, err := lp2ppeer.IDFromPrivateKey(networkKey)
+		if err != nil {
+			return nil, err
+		}
+		node.PeerID = peerID.String()
+
+		nodes = append(nodes, node)
+		vals = append(vals, val)
+	}
+
+	genDoc := makeLocalGenesisWithValidators(vals)
+	bootstrapAddrs := []string{nodes[0].bootstrapAddr(dockerCompose)}
+	for i, node := range nodes {
+		if err := genDoc.SaveToFile(PactusGenesisPath(node.WorkingDir)); err != nil {
+			return nil, err
+		}
+		if err := setLocalnetChainID(node, genDoc.ChainID()); err != nil {
+			return nil, err
+		}
+
+		nodeChaos := chaos
+		if nodeChaos.Seed != 0 {
+			nodeChaos.Seed += int64(i)
+		}
+		conf := node.config(bootstrapAddrs, nodeChaos)
+		if i == 0 {
+			conf.Network.BootstrapAddrStrings = []string{}
+		}
+		if err := conf.Save(PactusConfigPath(node.WorkingDir)); err != nil {
+			return nil, err
+		}
+	}
+
+	if dockerCompose {
+		composePath := filepath.Join(outputDir, "docker-compose.yml")
+		if err := util.WriteFile(composePath, makeDockerCompose(nodes, password)); err != nil {
+			return nil, err
+		}
+	}
+
+	return nodes, nil
+}
+
+// createLocalnetWallet creates the default wallet of the node with
+// a validator address and a reward address, and returns the validator.
+func createLocalnetWallet(node *LocalnetNode, number int32, password string) (*validator.Validator, error) {
+	mnemonic, err := wallet.GenerateMnemonic(128)
+	if err != nil {
+		return nil, err
+	}
+
+	// To make process faster, we update the password after creating the addresses
+	walletInstance, err := wallet.Create(PactusDefaultWalletPath(node.WorkingDir), mnemonic, "", genesis.Localnet)
+	if err != nil {
+		return nil, err
+	}
+
+	node.ValidatorAddr, err = walletInstance.NewValidatorAddress("Validator address 1")
+	if err != nil {
+		return nil, err
+	}
+
+	node.RewardAddr, err = walletInstance.NewBLSAccountAddress("Reward address 1")
+	if err != nil {
+		return nil, err
+	}
+
+	if err := walletInstance.UpdatePassword("", password); err != nil {
+		return nil, err
+	}
+
+	if err := walletInstance.Save(); err != nil {
+		return nil, err
+	}
+
+	pub, err := bls.PublicKeyFromString(walletInstance.AddressInfo(node.ValidatorAddr).PublicKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return validator.NewValidator(pub, number), nil
+}
+
+// setLocalnetChainID keeps the chain ID of the genesis in the wallet of the node.
+// The wallets are created before the genesis, as the genesis needs their validators.
+func setLocalnetChainID(node *LocalnetNode, chainID string) error {
+	walletInstance, err := wallet.Open(PactusDefaultWalletPath(node.WorkingDir), true)
+	if err != nil {
+		return err
+	}
+	if err := walletInstance.SetChainID(chainID); err != nil {
+		return err
+	}
+
+	return walletInstance.Save()
+}
+
+func makeDockerCompose(nodes []*LocalnetNode, password string) []byte {
+	builder := strings.Builder{}
+	builder.WriteString("services:\n")
+	for _, node := range nodes {
+		builder.WriteString(fmt.Sprintf("  %s:\n", node.Name))
+		builder.WriteString("    image: pactus/pactus\n")
+		builder.WriteString(fmt.Sprintf("    container_name: pactus-localnet-%s\n", node.Name))
+		if password == "" {
+			builder.WriteString("    command: pactus-daemon start -w /pactus\n")
+		} else {
+			builder.WriteString(fmt.Sprintf("    command: pactus-daemon start -w /pactus -p %q\n", password))
+		}
+		builder.WriteString("    volumes:\n")
+		builder.WriteString(fmt.Sprintf("      - ./%s:/pactus\n", node.Name))
+		builder.WriteString("    ports:\n")
+		for _, port := range []int{node.P2PPort, node.GRPCPort, node.GatewayPort, node.HTTPPort} {
+			builder.WriteString(fmt.Sprintf("      - \"%d:%d\"\n", port, port))
+		}
+	}
+
+	return []byte(builder.String())
+}
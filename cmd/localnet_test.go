@@ -0,0 +1,121 @@
+package cmd
+
+import (
+	"fmt"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/pactus-project/pactus/config"
+	"github.com/pactus-project/pactus/genesis"
+	"github.com/pactus-project/pactus/network"
+	"github.com/pactus-project/pactus/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateLocalnet(t *testing.T) {
+	t.Run("Invalid number of nodes", func(t *testing.T) {
+		_, err := GenerateLocalnet(util.TempDirPath(), 0, "", false, network.ChaosConfig{})
+		assert.Error(t, err)
+
+		_, err = GenerateLocalnet(util.TempDirPath(), LocalnetMaxNodes+1, "", false, network.ChaosConfig{})
+		assert.Error(t, err)
+	})
+
+	t.Run("Output directory is not empty", func(t *testing.T) {
+		outputDir := util.TempDirPath()
+		require.NoError(t, util.WriteFile(filepath.Join(outputDir, "file"), []byte{}))
+
+		_, err := GenerateLocalnet(outputDir, 1, "", false, network.ChaosConfig{})
+		assert.Error(t, err)
+	})
+
+	t.Run("Generate a local network", func(t *testing.T) {
+		outputDir := util.TempDirPath()
+		nodes, err := GenerateLocalnet(outputDir, 3, "", false, network.ChaosConfig{})
+		require.NoError(t, err)
+		require.Len(t, nodes, 3)
+
+		genDoc, err := genesis.LoadFromFile(PactusGenesisPath(nodes[0].WorkingDir))
+		require.NoError(t, err)
+		assert.Equal(t, genesis.Localnet, genDoc.ChainType())
+		require.Len(t, genDoc.Validators(), 3)
+
+		for i, node := range nodes {
+			assert.Equal(t, filepath.Join(outputDir, fmt.Sprintf("node-%d", i+1)), node.WorkingDir)
+			assert.Equal(t, LocalnetP2PPort+i, node.P2PPort)
+			assert.Equal(t, LocalnetGRPCPort+i, node.GRPCPort)
+			assert.Equal(t, genDoc.Validators()[i].Address().String(), node.ValidatorAddr)
+			assert.FileExists(t, PactusDefaultWalletPath(node.WorkingDir))
+			assert.FileExists(t, filepath.Join(node.WorkingDir, "network_key"))
+
+			// All the nodes should have the same genesis
+			nodeGenDoc, err := genesis.LoadFromFile(PactusGenesisPath(node.WorkingDir))
+			require.NoError(t, err)
+			assert.Equal(t, genDoc.Hash(), nodeGenDoc.Hash())
+
+			conf, err := config.LoadFromFile(PactusConfigPath(node.WorkingDir), true,
+				config.DefaultConfigLocalnet())
+			require.NoError(t, err)
+			assert.NoError(t, conf.BasicCheck())
+			assert.Equal(t, fmt.Sprintf("[::]:%d", node.GRPCPort), conf.GRPC.Listen)
+			assert.Contains(t, conf.Network.ListenAddrStrings, fmt.Sprintf("/ip4/0.0.0.0/tcp/%d", node.P2PPort))
+
+			if i == 0 {
+				assert.Empty(t, conf.Network.BootstrapAddrStrings)
+			} else {
+				assert.Equal(t, []string{
+					fmt.Sprintf("/ip4/127.0.0.1/tcp/%d/p2p/%s", LocalnetP2PPort, nodes[0].PeerID),
+				}, conf.Network.BootstrapAddrStrings)
+			}
+		}
+
+		assert.NoFileExists(t, filepath.Join(outputDir, "docker-compose.yml"))
+	})
+
+	t.Run("Generate a local network in chaos mode", func(t *testing.T) {
+		outputDir := util.TempDirPath()
+		chaos := network.ChaosConfig{
+			Enable:     true,
+			Latency:    100 * time.Millisecond,
+			Jitter:     20 * time.Millisecond,
+			PacketLoss: 0.1,
+			Seed:       42,
+		}
+		nodes, err := GenerateLocalnet(outputDir, 2, "", false, chaos)
+		require.NoError(t, err)
+
+		for i, node := range nodes {
+			conf, err := config.LoadFromFile(PactusConfigPath(node.WorkingDir), true,
+				config.DefaultConfigLocalnet())
+			require.NoError(t, err)
+
+			assert.True(t, conf.Network.Chaos.Enable)
+			assert.Equal(t, chaos.Latency, conf.Network.Chaos.Latency)
+			assert.Equal(t, chaos.Jitter, conf.Network.Chaos.Jitter)
+			assert.Equal(t, chaos.PacketLoss, conf.Network.Chaos.PacketLoss)
+			assert.Equal(t, chaos.Seed+int64(i), conf.Network.Chaos.Seed)
+		}
+	})
+
+	t.Run("Generate a local network with docker compose", func(t *testing.T) {
+		outputDir := util.TempDirPath()
+		nodes, err := GenerateLocalnet(outputDir, 2, "password", true, network.ChaosConfig{})
+		require.NoError(t, err)
+
+		conf, err := config.LoadFromFile(PactusConfigPath(nodes[1].WorkingDir), true,
+			config.DefaultConfigLocalnet())
+		require.NoError(t, err)
+		assert.Equal(t, []string{
+			fmt.Sprintf("/dns/node-1/tcp/%d/p2p/%s", LocalnetP2PPort, nodes[0].PeerID),
+		}, conf.Network.BootstrapAddrStrings)
+
+		compose, err := util.ReadFile(filepath.Join(outputDir, "docker-compose.yml"))
+		require.NoError(t, err)
+		assert.Contains(t, string(compose), "  node-1:\n")
+		assert.Contains(t, string(compose), "  node-2:\n")
+		assert.Contains(t, string(compose), "      - ./node-2:/pactus\n")
+		assert.Contains(t, string(compose), "pactus-daemon start -w /pactus -p \"password\"\n")
+	})
+}
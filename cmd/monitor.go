@@ -0,0 +1,221 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pactus-project/pactus/util"
+	pactus "github.com/pactus-project/pactus/www/grpc/gen/go"
+	"google.golang.org/grpc"
+)
+
+// logTailSize is the maximum number of bytes that are read from the end of the log file.
+const logTailSize = 64 * 1024
+
+// MonitorValidator holds the status of a validator that runs on the node.
+type MonitorValidator struct {
+	Address     string
+	Active      bool
+	InCommittee bool
+	// Info is nil if the validator is not bonded yet.
+	Info *pactus.ValidatorInfo
+}
+
+// Status returns the status of the validator in the blockchain.
+func (v MonitorValidator) Status() string {
+	switch {
+	case v.Info == nil:
+		return "not bonded"
+	case v.Info.UnbondingHeight != 0:
+		return "unbonded"
+	case v.InCommittee:
+		return "in committee"
+	default:
+		return "bonded"
+	}
+}
+
+// MonitorInfo holds the information of the node that is shown by the monitor.
+type MonitorInfo struct {
+	Node       *pactus.GetNodeInfoResponse
+	Blockchain *pactus.GetBlockchainInfoResponse
+	Consensus  *pactus.GetConsensusInfoResponse
+	Network    *pactus.GetNetworkInfoResponse
+	Validators []MonitorValidator
+	Logs       []string
+}
+
+// FetchMonitorInfo queries the node for the information that is shown by the monitor.
+func FetchMonitorInfo(ctx context.Context, conn grpc.ClientConnInterface) (*MonitorInfo, error) {
+	blockchainClient := pactus.NewBlockchainClient(conn)
+	networkClient := pactus.NewNetworkClient(conn)
+
+	nodeInfo, err := networkClient.GetNodeInfo(ctx, &pactus.GetNodeInfoRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	networkInfo, err := networkClient.GetNetworkInfo(ctx, &pactus.GetNetworkInfoRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	blockchainInfo, err := blockchainClient.GetBlockchainInfo(ctx, &pactus.GetBlockchainInfoRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	consensusInfo, err := blockchainClient.GetConsensusInfo(ctx, &pactus.GetConsensusInfoRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	committee := make(map[string]bool, len(blockchainInfo.CommitteeValidators))
+	for _, val := range blockchainInfo.CommitteeValidators {
+		committee[val.Address] = true
+	}
+
+	validators := make([]MonitorValidator, 0, len(consensusInfo.Instances))
+	for _, inst := range consensusInfo.Instances {
+		val := MonitorValidator{
+			Address:     inst.Address,
+			Active:      inst.Active,
+			InCommittee: committee[inst.Address],
+		}
+
+		// The validator is not found, if it is not bonded yet.
+		res, err := blockchainClient.GetValidator(ctx, &pactus.GetValidatorRequest{Address: inst.Address})
+		if err == nil {
+			val.Info = res.Validator
+		}
+		validators = append(validators, val)
+	}
+
+	return &MonitorInfo{
+		Node:       nodeInfo,
+		Blockchain: blockchainInfo,
+		Consensus:  consensusInfo,
+		Network:    networkInfo,
+		Validators: validators,
+	}, nil
+}
+
+// RenderMonitor renders the information of the node as a screen of text.
+func RenderMonitor(info *MonitorInfo, now time.Time) string {
+	builder := &strings.Builder{}
+	write := func(format string, a ...any) {
+		fmt.Fprintf(builder, format+"\n", a...)
+	}
+
+	write("Pactus Node Monitor (%s)", now.Format(time.DateTime))
+	write("Moniker: %s, Agent: %s", info.Node.Moniker, info.Node.Agent)
+	if info.Node.SafeMode {
+		write("Safe mode is enabled, the validators don't sign.")
+	}
+
+	write("")
+	write("Blockchain")
+	write("  Height:     %d", info.Blockchain.LastBlockHeight)
+	if len(info.Consensus.Instances) > 0 {
+		inst := info.Consensus.Instances[0]
+		write("  Consensus:  height %d, round %d", inst.Height, inst.Round)
+	}
+	write("  Validators: %d, committee power: %.9f PAC",
+		info.Blockchain.TotalValidators, util.ChangeToCoin(info.Blockchain.CommitteePower))
+	write("  Mempool:    %d pending transactions", info.Blockchain.PendingTransactions)
+
+	outbound := 0
+	bestHeight := uint32(0)
+	for _, p := range info.Network.ConnectedPeers {
+		if strings.EqualFold(p.Direction, "outbound") {
+			outbound++
+		}
+		if p.Height > bestHeight {
+			bestHeight = p.Height
+		}
+	}
+
+	write("")
+	write("Network")
+	write("  Name:         %s", info.Network.NetworkName)
+	write("  Reachability: %s", info.Node.Reachability)
+	write("  Peers:        %d connected, %d outbound, %d inbound",
+		info.Network.ConnectedPeersCount, outbound, len(info.Network.ConnectedPeers)-outbound)
+	if bestHeight > info.Blockchain.LastBlockHeight {
+		write("  Syncing:      %d blocks behind the peers", bestHeight-info.Blockchain.LastBlockHeight)
+	}
+
+	write("")
+	write("Validators")
+	if len(info.Validators) == 0 {
+		write("  No validator")
+	}
+	for _, val := range info.Validators {
+		if val.Info == nil {
+			write("  %s  %s", val.Address, val.Status())
+
+			continue
+		}
+		write("  %s  %s, stake: %.9f PAC, availability: %.2f",
+			val.Address, val.Status(), util.ChangeToCoin(val.Info.Stake), val.Info.AvailabilityScore)
+	}
+
+	write("")
+	write("Recent logs")
+	for _, line := range info.Logs {
+		write("  %s", line)
+	}
+
+	return builder.String()
+}
+
+// TailLogFile returns the last lines of the log file in a short and readable format.
+func TailLogFile(path string, count int) ([]string, error) {
+	data, truncated, err := readFileTail(path, logTailSize)
+	if err != nil {
+		return nil, err
+	}
+
+	text := strings.TrimRight(string(data), "\n")
+	if text == "" {
+		return []string{}, nil
+	}
+
+	lines := strings.Split(text, "\n")
+	if truncated {
+		// The first line might be cut.
+		lines = lines[1:]
+	}
+	if len(lines) > count {
+		lines = lines[len(lines)-count:]
+	}
+
+	for i, line := range lines {
+		lines[i] = formatLogLine(line)
+	}
+
+	return lines, nil
+}
+
+// formatLogLine formats a JSON log entry like: `15:04:05 INF message`.
+// The entries that are not in JSON are returned as they are.
+func formatLogLine(line string) string {
+	entry := struct {
+		Time    time.Time `json:"time"`
+		Level   string    `json:"level"`
+		Message string    `json:"message"`
+	}{}
+	if err := json.Unmarshal([]byte(line), &entry); err != nil {
+		return line
+	}
+
+	level := strings.ToUpper(entry.Level)
+	if len(level) > 3 {
+		level = level[:3]
+	}
+
+	return fmt.Sprintf("%s %s %s", entry.Time.Local().Format(time.TimeOnly), level, entry.Message)
+}
@@ -0,0 +1,108 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/pactus-project/pactus/util"
+	pactus "github.com/pactus-project/pactus/www/grpc/gen/go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMonitorValidatorStatus(t *testing.T) {
+	assert.Equal(t, "not bonded", MonitorValidator{}.Status())
+	assert.Equal(t, "bonded", MonitorValidator{Info: &pactus.ValidatorInfo{}}.Status())
+	assert.Equal(t, "in committee", MonitorValidator{Info: &pactus.ValidatorInfo{}, InCommittee: true}.Status())
+	assert.Equal(t, "unbonded", MonitorValidator{Info: &pactus.ValidatorInfo{UnbondingHeight: 1}}.Status())
+}
+
+func TestRenderMonitor(t *testing.T) {
+	info := &MonitorInfo{
+		Node: &pactus.GetNodeInfoResponse{Moniker: "my-node", Reachability: "Public", SafeMode: true},
+		Blockchain: &pactus.GetBlockchainInfoResponse{
+			LastBlockHeight:     100,
+			TotalValidators:     21,
+			PendingTransactions: 7,
+		},
+		Consensus: &pactus.GetConsensusInfoResponse{
+			Instances: []*pactus.ConsensusInfo{{Height: 101, Round: 2}},
+		},
+		Network: &pactus.GetNetworkInfoResponse{
+			NetworkName:         "pactus-testnet",
+			ConnectedPeersCount: 3,
+			ConnectedPeers: []*pactus.PeerInfo{
+				{Direction: "Outbound", Height: 100},
+				{Direction: "Outbound", Height: 110},
+				{Direction: "Inbound", Height: 90},
+			},
+		},
+		Validators: []MonitorValidator{
+			{Address: "val-1", Info: &pactus.ValidatorInfo{Stake: 1e9, AvailabilityScore: 0.9}},
+			{Address: "val-2"},
+		},
+		Logs: []string{"12:00:00 INF block committed"},
+	}
+
+	screen := RenderMonitor(info, time.Now())
+
+	assert.Contains(t, screen, "my-node")
+	assert.Contains(t, screen, "Safe mode is enabled")
+	assert.Contains(t, screen, "Height:     100")
+	assert.Contains(t, screen, "height 101, round 2")
+	assert.Contains(t, screen, "7 pending transactions")
+	assert.Contains(t, screen, "3 connected, 2 outbound, 1 inbound")
+	assert.Contains(t, screen, "10 blocks behind the peers")
+	assert.Contains(t, screen, "val-1  bonded, stake: 1.000000000 PAC, availability: 0.90")
+	assert.Contains(t, screen, "val-2  not bonded")
+	assert.Contains(t, screen, "block committed")
+}
+
+func TestTailLogFile(t *testing.T) {
+	path := util.TempFilePath()
+
+	t.Run("File doesn't exist", func(t *testing.T) {
+		_, err := TailLogFile(path, 10)
+		assert.Error(t, err)
+	})
+
+	t.Run("Empty file", func(t *testing.T) {
+		require.NoError(t, util.WriteFile(path, []byte{}))
+
+		lines, err := TailLogFile(path, 10)
+		assert.NoError(t, err)
+		assert.Empty(t, lines)
+	})
+
+	t.Run("Last lines", func(t *testing.T) {
+		entries := make([]string, 0, 20)
+		for i := 0; i < 20; i++ {
+			entries = append(entries, fmt.Sprintf(
+				`{"level":"info","_sync":"{sync}","time":"2024-01-01T12:00:00Z","message":"message %d"}`, i))
+		}
+		entries = append(entries, "not a json entry")
+		require.NoError(t, util.WriteFile(path, []byte(strings.Join(entries, "\n")+"\n")))
+
+		lines, err := TailLogFile(path, 3)
+		assert.NoError(t, err)
+		require.Len(t, lines, 3)
+		assert.True(t, strings.HasSuffix(lines[0], " INF message 18"))
+		assert.True(t, strings.HasSuffix(lines[1], " INF message 19"))
+		assert.Equal(t, "not a json entry", lines[2])
+	})
+
+	t.Run("Large file", func(t *testing.T) {
+		line := strings.Repeat("x", 1023)
+		data := strings.Repeat(line+"\n", 2*logTailSize/1024)
+		require.NoError(t, util.WriteFile(path, []byte(data)))
+
+		lines, err := TailLogFile(path, 1000)
+		assert.NoError(t, err)
+		assert.Len(t, lines, logTailSize/1024-1)
+		for _, l := range lines {
+			assert.Equal(t, line, l)
+		}
+	})
+}
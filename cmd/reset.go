@@ -0,0 +1,72 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/pactus-project/pactus/config"
+	"github.com/pactus-project/pactus/genesis"
+	"github.com/pactus-project/pactus/state"
+)
+
+// IsGenesisMismatch checks if the error indicates that the stored chain
+// doesn't belong to the genesis document in the working directory.
+// It usually happens when a test network is reset with a new genesis.
+func IsGenesisMismatch(err error) bool {
+	return errors.As(err, &state.GenesisMismatchError{})
+}
+
+// ResetDataDir removes the data directory of a test network, so the node can
+// start syncing the chain from the genesis in the working directory again.
+// The confirm function is called with the path of the data directory before removing it.
+// It returns false if the reset is not confirmed.
+// Resetting the data directory is not allowed on the Mainnet.
+func ResetDataDir(workingDir string, confirm func(dataPath string) bool) (bool, error) {
+	gen, err := genesis.LoadFromFile(PactusGenesisPath(workingDir))
+	if err != nil {
+		return false, err
+	}
+
+	if gen.ChainType().IsMainnet() {
+		return false, fmt.Errorf("resetting the data directory is not allowed on the Mainnet")
+	}
+
+	conf, err := config.LoadFromFile(PactusConfigPath(workingDir), false, config.DefaultConfigTestnet())
+	if err != nil {
+		return false, err
+	}
+
+	workingDir, err = filepath.Abs(workingDir)
+	if err != nil {
+		return false, err
+	}
+
+	dataPath := conf.Store.Path
+	if !filepath.IsAbs(dataPath) {
+		dataPath = filepath.Join(workingDir, dataPath)
+	}
+	dataPath = filepath.Clean(dataPath)
+
+	// The data directory must not contain the working directory;
+	// otherwise, the wallets would be removed too.
+	rel, err := filepath.Rel(dataPath, workingDir)
+	if err != nil {
+		return false, err
+	}
+	if rel == "." || !strings.HasPrefix(rel, "..") {
+		return false, fmt.Errorf("data directory contains the working directory: %s", dataPath)
+	}
+
+	if !confirm(dataPath) {
+		return false, nil
+	}
+
+	if err := os.RemoveAll(dataPath); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
@@ -0,0 +1,68 @@
+package cmd
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/pactus-project/pactus/config"
+	"github.com/pactus-project/pactus/genesis"
+	"github.com/pactus-project/pactus/network"
+	"github.com/pactus-project/pactus/state"
+	"github.com/pactus-project/pactus/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsGenesisMismatch(t *testing.T) {
+	assert.True(t, IsGenesisMismatch(state.GenesisMismatchError{}))
+	assert.False(t, IsGenesisMismatch(state.ChainHaltedError{}))
+	assert.False(t, IsGenesisMismatch(nil))
+}
+
+func TestResetDataDir(t *testing.T) {
+	outputDir := util.TempDirPath()
+	nodes, err := GenerateLocalnet(outputDir, 1, "secret", false, network.ChaosConfig{})
+	require.NoError(t, err)
+
+	workingDir := nodes[0].WorkingDir
+	dataPath := filepath.Join(workingDir, "data")
+	require.NoError(t, util.WriteFile(filepath.Join(dataPath, "store.db"), []byte("store")))
+
+	t.Run("Not confirmed", func(t *testing.T) {
+		reset, err := ResetDataDir(workingDir, func(path string) bool {
+			assert.Equal(t, dataPath, path)
+
+			return false
+		})
+		require.NoError(t, err)
+		assert.False(t, reset)
+		assert.True(t, util.PathExists(dataPath))
+	})
+
+	t.Run("Confirmed", func(t *testing.T) {
+		reset, err := ResetDataDir(workingDir, func(string) bool { return true })
+		require.NoError(t, err)
+		assert.True(t, reset)
+		assert.False(t, util.PathExists(dataPath))
+		assert.True(t, util.PathExists(PactusDefaultWalletPath(workingDir)))
+	})
+
+	t.Run("Data directory contains the working directory", func(t *testing.T) {
+		conf := config.DefaultConfigLocalnet()
+		conf.Store.Path = ".."
+		require.NoError(t, conf.Save(PactusConfigPath(workingDir)))
+
+		_, err := ResetDataDir(workingDir, func(string) bool { return true })
+		assert.Error(t, err)
+		assert.True(t, util.PathExists(PactusDefaultWalletPath(workingDir)))
+	})
+
+	t.Run("Mainnet", func(t *testing.T) {
+		mainnetDir := util.TempDirPath()
+		require.NoError(t, genesis.MainnetGenesis().SaveToFile(PactusGenesisPath(mainnetDir)))
+		require.NoError(t, config.SaveMainnetConfig(PactusConfigPath(mainnetDir)))
+
+		_, err := ResetDataDir(mainnetDir, func(string) bool { return true })
+		assert.Error(t, err)
+	})
+}
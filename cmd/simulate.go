@@ -0,0 +1,315 @@
+package cmd
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	mrand "math/rand"
+	"os"
+	"sort"
+
+	"github.com/pactus-project/pactus/committee"
+	"github.com/pactus-project/pactus/crypto/bls"
+	"github.com/pactus-project/pactus/types/param"
+	"github.com/pactus-project/pactus/types/validator"
+	"github.com/pactus-project/pactus/util"
+)
+
+// StakeEntry is a validator in the stake distribution file.
+// The stake is in PAC.
+type StakeEntry struct {
+	Name  string  `json:"name"`
+	Stake float64 `json:"stake"`
+}
+
+// LoadStakeDistribution loads the stake distribution of the validators from a JSON file,
+// that contains a list of the validators with their names and stakes.
+func LoadStakeDistribution(path string) ([]StakeEntry, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := []StakeEntry{}
+	if err := json.Unmarshal(data, &entries); err != nil {
+		return nil, err
+	}
+
+	return entries, nil
+}
+
+// SimulateCommitteeConfig defines the scenario of the committee simulation.
+type SimulateCommitteeConfig struct {
+	Validators    []StakeEntry
+	Blocks        uint32
+	Warmup        uint32 // Number of the blocks that are simulated before collecting the results
+	Window        uint32 // Number of the blocks in each window, to measure the variance
+	CommitteeSize int
+	Seed          int64
+}
+
+// ValidatorSimStat holds the simulated selections of a validator.
+type ValidatorSimStat struct {
+	Name           string  `json:"name"`
+	Stake          float64 `json:"stake"`
+	StakeShare     float64 `json:"stake_share"`
+	Sortitions     int     `json:"sortitions"`
+	Joins          int     `json:"joins"`
+	CommitteeRatio float64 `json:"committee_ratio"`
+	Proposals      int     `json:"proposals"`
+	ProposalShare  float64 `json:"proposal_share"`
+	ProposalRatio  float64 `json:"proposal_ratio"`
+	WindowMean     float64 `json:"window_mean"`
+	WindowVariance float64 `json:"window_variance"`
+}
+
+// SimulateCommitteeResult holds the results of the committee simulation.
+type SimulateCommitteeResult struct {
+	Validators            int                 `json:"validators"`
+	Blocks                uint32              `json:"blocks"`
+	Warmup                uint32              `json:"warmup"`
+	Window                uint32              `json:"window"`
+	CommitteeSize         int                 `json:"committee_size"`
+	Seed                  int64               `json:"seed"`
+	AvgCommitteeSize      float64             `json:"avg_committee_size"`
+	AvgCommitteeStake     float64             `json:"avg_committee_stake"`
+	JoinsPerBlock         float64             `json:"joins_per_block"`
+	ProposalRatioVariance float64             `json:"proposal_ratio_variance"`
+	Stats                 []*ValidatorSimStat `json:"stats"`
+}
+
+// SimulateCommittee simulates the sortition and the committee over a number of blocks,
+// for the given stake distribution, and reports how often each validator is selected.
+//
+// The committee starts with the first validator of the distribution, like the bootstrap validator
+// of the genesis, and it is filled by the sortitions. In each block, the proposer of the first round
+// proposes the block. Each validator wins the sortition in proportion to its stake, like the VRF does,
+// and joins the committee under the same rules as the sortition transactions.
+// Evaluating the VRF is replaced by a seeded random number generator,
+// so the simulation is fast and can be reproduced by its seed.
+func SimulateCommittee(conf SimulateCommitteeConfig) (*SimulateCommitteeResult, error) {
+	if len(conf.Validators) == 0 {
+		return nil, errors.New("no validator is defined")
+	}
+	if conf.Blocks == 0 || conf.CommitteeSize <= 0 {
+		return nil, errors.New("the number of blocks and the committee size should be positive")
+	}
+	window := conf.Window
+	if window == 0 || window > conf.Blocks {
+		window = conf.Blocks
+	}
+
+	params := param.DefaultParams()
+	vals := make([]*validator.Validator, 0, len(conf.Validators))
+	stats := make([]*ValidatorSimStat, 0, len(conf.Validators))
+	indexes := make(map[int32]int, len(conf.Validators))
+	totalStake := int64(0)
+	for i, entry := range conf.Validators {
+		stake := util.CoinToChange(entry.Stake)
+		if stake < params.MinimumStake || stake > params.MaximumStake {
+			return nil, fmt.Errorf("stake of %s should be between %s and %s PAC", entry.Name,
+				util.ChangeToString(params.MinimumStake), util.ChangeToString(params.MaximumStake))
+		}
+
+		valKey, err := randValidatorKey()
+		if err != nil {
+			return nil, err
+		}
+		// Decoding the public key keeps it in the affine form,
+		// so the validator address is calculated faster in the simulation loop.
+		pub, err := bls.PublicKeyFromBytes(valKey.PublicKey().Bytes())
+		if err != nil {
+			return nil, err
+		}
+		val := validator.NewValidator(pub, int32(i))
+		val.AddToStake(stake)
+
+		vals = append(vals, val)
+		stats = append(stats, &ValidatorSimStat{Name: entry.Name, Stake: entry.Stake})
+		indexes[val.Number()] = i
+		totalStake += stake
+	}
+
+	seed := conf.Seed
+	if seed == 0 {
+		seed = util.RandInt64(math.MaxInt64)
+	}
+	//nolint:gosec // deterministic by design, to reproduce the simulations
+	rnd := mrand.New(mrand.NewSource(seed))
+
+	cmt, err := committee.NewCommittee(vals[:1], conf.CommitteeSize, vals[0].Address())
+	if err != nil {
+		return nil, err
+	}
+
+	windows := int(conf.Blocks / window)
+	windowProposals := make([][]int, len(vals))
+	for i := range windowProposals {
+		windowProposals[i] = make([]int, windows)
+	}
+
+	committeeSizeSum := 0
+	committeeStakeSum := float64(0)
+	joins := 0
+	for height := uint32(1); height <= conf.Warmup+conf.Blocks; height++ {
+		measured := height > conf.Warmup
+		if measured {
+			proposer := indexes[cmt.Proposer(0).Number()]
+			stats[proposer].Proposals++
+			if w := int((height - conf.Warmup - 1) / window); w < windows {
+				windowProposals[proposer][w]++
+			}
+
+			committeeSizeSum += cmt.Size()
+			committeeStakeSum += float64(cmt.TotalPower()) / float64(totalStake)
+			for _, num := range cmt.Committers() {
+				stats[indexes[num]].CommitteeRatio++
+			}
+		}
+
+		// The committee members are kept by their numbers,
+		// since calculating the validator addresses is slow.
+		members := make(map[int32]bool, cmt.Size())
+		for _, num := range cmt.Committers() {
+			members[num] = true
+		}
+
+		winners := make([]*validator.Validator, 0)
+		for _, val := range vals {
+			if rnd.Int63n(totalStake) < val.Power() {
+				winners = append(winners, val)
+			}
+		}
+		// The sortition transactions are included in the block in a random order.
+		rnd.Shuffle(len(winners), func(i, j int) {
+			winners[i], winners[j] = winners[j], winners[i]
+		})
+
+		joined := make([]*validator.Validator, 0, len(winners))
+		for _, val := range winners {
+			if !canJoinCommittee(cmt, members, conf.CommitteeSize, height, joined, val) {
+				continue
+			}
+			val.UpdateLastSortitionHeight(height)
+			joined = append(joined, val)
+
+			if measured {
+				stats[indexes[val.Number()]].Sortitions++
+				if !members[val.Number()] {
+					stats[indexes[val.Number()]].Joins++
+					joins++
+				}
+			}
+		}
+		cmt.Update(0, joined)
+	}
+
+	res := &SimulateCommitteeResult{
+		Validators:        len(vals),
+		Blocks:            conf.Blocks,
+		Warmup:            conf.Warmup,
+		Window:            window,
+		CommitteeSize:     conf.CommitteeSize,
+		Seed:              seed,
+		AvgCommitteeSize:  float64(committeeSizeSum) / float64(conf.Blocks),
+		AvgCommitteeStake: committeeStakeSum / float64(conf.Blocks),
+		JoinsPerBlock:     float64(joins) / float64(conf.Blocks),
+		Stats:             stats,
+	}
+
+	ratios := make([]float64, 0, len(stats))
+	for i, stat := range stats {
+		stat.StakeShare = float64(vals[i].Stake()) / float64(totalStake)
+		stat.CommitteeRatio /= float64(conf.Blocks)
+		stat.ProposalShare = float64(stat.Proposals) / float64(conf.Blocks)
+		stat.ProposalRatio = stat.ProposalShare / stat.StakeShare
+		stat.WindowMean, stat.WindowVariance = meanAndVariance(windowProposals[i])
+
+		ratios = append(ratios, stat.ProposalRatio)
+	}
+	_, res.ProposalRatioVariance = meanAndVarianceFloat(ratios)
+
+	sort.SliceStable(stats, func(i, j int) bool {
+		return stats[i].Stake > stats[j].Stake
+	})
+
+	return res, nil
+}
+
+// canJoinCommittee checks the rules of the sortition transactions for joining the committee.
+// The members are the numbers of the committee validators, and the joined validators
+// are the ones that have joined before, in the same block.
+func canJoinCommittee(cmt committee.Reader, members map[int32]bool, committeeSize int, height uint32,
+	joined []*validator.Validator, val *validator.Validator,
+) bool {
+	if cmt.Size() < committeeSize {
+		// There are available seats in the committee.
+		return !members[val.Number()]
+	}
+
+	joiningNum := 0
+	joiningPower := int64(0)
+	for _, j := range joined {
+		if !members[j.Number()] {
+			joiningPower += j.Power()
+			joiningNum++
+		}
+	}
+	if !members[val.Number()] {
+		joiningPower += val.Power()
+		joiningNum++
+	}
+	if joiningPower >= cmt.TotalPower()/3 {
+		return false
+	}
+
+	oldestFirst := cmt.Validators()
+	sort.SliceStable(oldestFirst, func(i, j int) bool {
+		return oldestFirst[i].LastSortitionHeight() < oldestFirst[j].LastSortitionHeight()
+	})
+	leavingPower := int64(0)
+	for i := 0; i < joiningNum; i++ {
+		leavingPower += oldestFirst[i].Power()
+	}
+	if leavingPower >= cmt.TotalPower()/3 {
+		return false
+	}
+
+	// If the oldest validator in the committee still hasn't proposed a block yet,
+	// it stays in the committee.
+	oldestSortitionHeight := height
+	for _, v := range oldestFirst {
+		oldestSortitionHeight = util.Min(oldestSortitionHeight, v.LastSortitionHeight())
+	}
+
+	return oldestSortitionHeight < cmt.Proposer(0).LastSortitionHeight()
+}
+
+func meanAndVariance(values []int) (float64, float64) {
+	floats := make([]float64, 0, len(values))
+	for _, v := range values {
+		floats = append(floats, float64(v))
+	}
+
+	return meanAndVarianceFloat(floats)
+}
+
+func meanAndVarianceFloat(values []float64) (float64, float64) {
+	if len(values) == 0 {
+		return 0, 0
+	}
+
+	sum := float64(0)
+	for _, v := range values {
+		sum += v
+	}
+	mean := sum / float64(len(values))
+
+	variance := float64(0)
+	for _, v := range values {
+		variance += (v - mean) * (v - mean)
+	}
+
+	return mean, variance / float64(len(values))
+}
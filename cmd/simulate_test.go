@@ -0,0 +1,91 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadStakeDistribution(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "stakes.json")
+	require.NoError(t, os.WriteFile(path,
+		[]byte(`[{"name": "val-1", "stake": 1000}, {"name": "val-2", "stake": 1.5}]`), 0o600))
+
+	entries, err := LoadStakeDistribution(path)
+	require.NoError(t, err)
+	assert.Equal(t, []StakeEntry{{Name: "val-1", Stake: 1000}, {Name: "val-2", Stake: 1.5}}, entries)
+
+	_, err = LoadStakeDistribution(filepath.Join(t.TempDir(), "not-exist.json"))
+	assert.Error(t, err)
+}
+
+func TestSimulateCommittee(t *testing.T) {
+	vals := make([]StakeEntry, 0, 20)
+	for i := 0; i < 10; i++ {
+		vals = append(vals, StakeEntry{Name: "small", Stake: 100})
+	}
+	for i := 0; i < 10; i++ {
+		vals = append(vals, StakeEntry{Name: "big", Stake: 1000})
+	}
+
+	t.Run("Invalid config", func(t *testing.T) {
+		_, err := SimulateCommittee(SimulateCommitteeConfig{Blocks: 10, CommitteeSize: 7})
+		assert.Error(t, err)
+
+		_, err = SimulateCommittee(SimulateCommitteeConfig{Validators: vals, Blocks: 0, CommitteeSize: 7})
+		assert.Error(t, err)
+
+		_, err = SimulateCommittee(SimulateCommitteeConfig{
+			Validators:    []StakeEntry{{Name: "too-big", Stake: 2000}},
+			Blocks:        10,
+			CommitteeSize: 7,
+		})
+		assert.Error(t, err)
+	})
+
+	conf := SimulateCommitteeConfig{
+		Validators:    vals,
+		Blocks:        5000,
+		Warmup:        500,
+		Window:        1000,
+		CommitteeSize: 7,
+		Seed:          1,
+	}
+	res, err := SimulateCommittee(conf)
+	require.NoError(t, err)
+
+	t.Run("Committee is filled", func(t *testing.T) {
+		assert.Equal(t, 20, res.Validators)
+		assert.InDelta(t, 7, res.AvgCommitteeSize, 0.01)
+		assert.Positive(t, res.JoinsPerBlock)
+	})
+
+	t.Run("Selections are in proportion to the stakes", func(t *testing.T) {
+		totalProposals := 0
+		smallProposals := 0
+		for _, stat := range res.Stats {
+			totalProposals += stat.Proposals
+			if stat.Name == "small" {
+				smallProposals += stat.Proposals
+			}
+			assert.LessOrEqual(t, stat.CommitteeRatio, 1.0)
+			assert.GreaterOrEqual(t, stat.WindowVariance, 0.0)
+		}
+		assert.Equal(t, 5000, totalProposals)
+
+		// The small validators have 1/11 of the stake.
+		assert.InDelta(t, 1.0/11, float64(smallProposals)/float64(totalProposals), 0.05)
+		assert.Equal(t, "big", res.Stats[0].Name, "the stats are sorted by the stake")
+	})
+
+	t.Run("Simulation is reproduced by the seed", func(t *testing.T) {
+		res2, err := SimulateCommittee(conf)
+		require.NoError(t, err)
+
+		assert.Equal(t, res.JoinsPerBlock, res2.JoinsPerBlock)
+		assert.Equal(t, res.ProposalRatioVariance, res2.ProposalRatioVariance)
+	})
+}
@@ -0,0 +1,38 @@
+package cmd
+
+import (
+	"io"
+	"os"
+	"strconv"
+)
+
+// upgradePasswordEnv is the environment variable that keeps the file descriptor of
+// the pipe that hands the wallet password over to the upgraded binary.
+const upgradePasswordEnv = "PACTUS_UPGRADE_PASSWORD_FD"
+
+// UpgradePassword reads the wallet password that is handed over by the previous binary on upgrade.
+// It returns false if the binary is not started by an upgrade, or there is no password.
+func UpgradePassword() (string, bool) {
+	fdStr, ok := os.LookupEnv(upgradePasswordEnv)
+	if !ok {
+		return "", false
+	}
+	_ = os.Unsetenv(upgradePasswordEnv)
+
+	fd, err := strconv.Atoi(fdStr)
+	if err != nil {
+		return "", false
+	}
+	file := os.NewFile(uintptr(fd), "upgrade-password")
+	if file == nil {
+		return "", false
+	}
+	defer func() { _ = file.Close() }()
+
+	data, err := io.ReadAll(file)
+	if err != nil {
+		return "", false
+	}
+
+	return string(data), true
+}
@@ -0,0 +1,85 @@
+//go:build !windows
+
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+)
+
+// TrapUpgradeSignal traps SIGUSR2 to upgrade the daemon with a minimum downtime.
+// On the signal, stopFunc stops the node and hands its live state off,
+// then the process is replaced by the binary, with the same arguments.
+// The wallet password, if any, is handed over to the new binary through a pipe,
+// so it doesn't ask for the password again.
+func TrapUpgradeSignal(binaryPath, password string, stopFunc func()) {
+	sigs := make(chan os.Signal, 1)
+	signal.Notify(sigs, syscall.SIGUSR2)
+	go func() {
+		for range sigs {
+			// The node keeps running if the binary can't be executed.
+			if err := checkExecutable(binaryPath); err != nil {
+				PrintErrorMsgf("Unable to upgrade: %v", err)
+
+				continue
+			}
+			env, err := upgradeEnv(password)
+			if err != nil {
+				PrintErrorMsgf("Unable to upgrade: %v", err)
+
+				continue
+			}
+
+			PrintInfoMsgf("Upgrading to %s ...", binaryPath)
+			stopFunc()
+
+			// Exec only returns on failure, when the node is stopped already.
+			err = syscall.Exec(binaryPath, os.Args, env)
+			FatalErrorCheck(err)
+		}
+	}()
+}
+
+func checkExecutable(path string) error {
+	info, err := os.Stat(path)
+	if err != nil {
+		return err
+	}
+	if info.IsDir() || info.Mode().Perm()&0o111 == 0 {
+		return fmt.Errorf("%s is not executable", path)
+	}
+
+	return nil
+}
+
+// upgradeEnv writes the password in a pipe that is inherited by the new binary,
+// and returns the environment of the new binary with the file descriptor of the pipe.
+func upgradeEnv(password string) ([]string, error) {
+	env := make([]string, 0)
+	for _, e := range os.Environ() {
+		if !strings.HasPrefix(e, upgradePasswordEnv+"=") {
+			env = append(env, e)
+		}
+	}
+	if password == "" {
+		return env, nil
+	}
+
+	// Unlike os.Pipe, the pipe is not closed on exec.
+	fds := make([]int, 2)
+	if err := syscall.Pipe(fds); err != nil {
+		return nil, err
+	}
+	if _, err := syscall.Write(fds[1], []byte(password)); err != nil {
+		_ = syscall.Close(fds[0])
+		_ = syscall.Close(fds[1])
+
+		return nil, err
+	}
+	_ = syscall.Close(fds[1])
+
+	return append(env, fmt.Sprintf("%s=%d", upgradePasswordEnv, fds[0])), nil
+}
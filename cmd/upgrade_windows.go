@@ -0,0 +1,7 @@
+//go:build windows
+
+package cmd
+
+// TrapUpgradeSignal does nothing on Windows, since there is no signal to trap,
+// and the process can't be replaced by another binary.
+func TrapUpgradeSignal(_, _ string, _ func()) {}
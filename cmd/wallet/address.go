@@ -25,6 +25,7 @@ func buildAllAddrCmd(parentCmd *cobra.Command) {
 	buildPublicKeyCmd(addrCmd)
 	buildImportPrivateKeyCmd(addrCmd)
 	buildSetLabelCmd(addrCmd)
+	buildPaymentURICmd(addrCmd)
 }
 
 // buildAllAddressesCmd builds a command to list all addresses from the wallet.
@@ -1,6 +1,9 @@
 package main
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/pactus-project/pactus/cmd"
 	"github.com/pactus-project/pactus/genesis"
 	"github.com/pactus-project/pactus/wallet"
@@ -19,10 +22,12 @@ func buildCreateCmd(parentCmd *cobra.Command) {
 		"create a wallet for the testnet environment")
 	entropyOpt := generateCmd.Flags().Int("entropy", 128,
 		"specify the entropy bit length")
+	languageOpt := generateCmd.Flags().String("language", wallet.LanguageEnglish,
+		fmt.Sprintf("the language of the seed phrase: %s", strings.Join(wallet.MnemonicLanguages(), ", ")))
 
 	generateCmd.Run = func(_ *cobra.Command, _ []string) {
 		password := cmd.PromptPassword("Password", true)
-		mnemonic, err := wallet.GenerateMnemonic(*entropyOpt)
+		mnemonic, err := wallet.GenerateMnemonicInLanguage(*entropyOpt, *languageOpt)
 		cmd.FatalErrorCheck(err)
 
 		network := genesis.Mainnet
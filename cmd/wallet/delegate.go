@@ -0,0 +1,120 @@
+package main
+
+import (
+	"encoding/hex"
+	"errors"
+
+	"github.com/pactus-project/pactus/cmd"
+	"github.com/pactus-project/pactus/types/tx"
+	"github.com/pactus-project/pactus/types/tx/payload"
+	"github.com/pactus-project/pactus/util"
+	"github.com/pactus-project/pactus/wallet"
+	"github.com/spf13/cobra"
+)
+
+// buildApproveDelegationTxCmd builds a command for the operator to create and approve
+// a `Delegate` transaction. The partially signed transaction is then signed
+// and published by the delegator.
+func buildApproveDelegationTxCmd(parentCmd *cobra.Command) {
+	approveCmd := &cobra.Command{
+		Use:   "approve-delegation [flags] <DELEGATOR> <VALIDATOR> <STAKE>",
+		Short: "create and approve a `Delegate` transaction by the validator key, to be signed by the delegator",
+		Args:  cobra.ExactArgs(3),
+	}
+	parentCmd.AddCommand(approveCmd)
+
+	lockTimeOpt := approveCmd.Flags().Int("lock-time", 0,
+		"transaction lock-time, if not specified will be the current height")
+	feeOpt := approveCmd.Flags().Float64("fee", 0,
+		"transaction fee, if not specified will calculate automatically")
+	memoOpt := approveCmd.Flags().String("memo", "",
+		"transaction memo, maximum should be 64 character")
+	passOpt := addPasswordOption(approveCmd)
+
+	approveCmd.Run = func(_ *cobra.Command, args []string) {
+		delegator := args[0]
+		validator := args[1]
+		amount, err := util.StringToChange(args[2])
+		cmd.FatalErrorCheck(err)
+
+		w, err := openWallet()
+		cmd.FatalErrorCheck(err)
+
+		opts := []wallet.TxOption{
+			wallet.OptionFee(util.CoinToChange(*feeOpt)),
+			wallet.OptionLockTime(uint32(*lockTimeOpt)),
+			wallet.OptionMemo(*memoOpt),
+		}
+
+		password := getPassword(w, *passOpt)
+		trx, err := w.MakeDelegateTx(password, delegator, validator, amount, opts...)
+		cmd.FatalErrorCheck(err)
+
+		bs, err := trx.Bytes()
+		cmd.FatalErrorCheck(err)
+
+		cmd.PrintLine()
+		cmd.PrintInfoMsgf("You approved this \033[1mDelegate\033[0m transition:")
+		printDelegateTx(trx)
+		printTxExpiry(w, trx)
+		cmd.PrintLine()
+		cmd.PrintInfoMsgf("Partially signed transaction data: %x", bs)
+		cmd.PrintLine()
+		cmd.PrintInfoMsgf("Send it to the delegator to sign and publish it by `pactus-wallet tx delegate`.")
+		cmd.PrintWarnMsgf("The approval is valid only for the lock time %d.", trx.LockTime())
+	}
+}
+
+// buildDelegateTxCmd builds a command for the delegator to sign and publish
+// a `Delegate` transaction that is approved by the operator.
+func buildDelegateTxCmd(parentCmd *cobra.Command) {
+	delegateCmd := &cobra.Command{
+		Use:   "delegate [flags] <PARTIALLY_SIGNED_TX>",
+		Short: "sign and publish a `Delegate` transaction that is approved by the operator",
+		Args:  cobra.ExactArgs(1),
+	}
+	parentCmd.AddCommand(delegateCmd)
+
+	noConfirmOpt := delegateCmd.Flags().Bool("no-confirm", false,
+		"no confirmation question")
+	passOpt := addPasswordOption(delegateCmd)
+
+	delegateCmd.Run = func(_ *cobra.Command, args []string) {
+		bs, err := hex.DecodeString(args[0])
+		cmd.FatalErrorCheck(err)
+
+		trx, err := tx.FromBytes(bs)
+		cmd.FatalErrorCheck(err)
+
+		if !trx.IsDelegateTx() {
+			cmd.FatalErrorCheck(errors.New("the transaction is not a delegate transaction"))
+		}
+		cmd.FatalErrorCheck(trx.CheckUnsigned())
+
+		w, err := openWallet()
+		cmd.FatalErrorCheck(err)
+
+		if !w.Contains(trx.Payload().Signer().String()) {
+			cmd.FatalErrorCheck(errors.New("the delegator is not an address of this wallet"))
+		}
+
+		cmd.PrintLine()
+		cmd.PrintInfoMsgf("You are going to sign this \033[1mDelegate\033[0m transition:")
+		printDelegateTx(trx)
+
+		signAndPublishTx(w, trx, *noConfirmOpt, *passOpt)
+	}
+}
+
+func printDelegateTx(trx *tx.Tx) {
+	pld := trx.Payload().(*payload.DelegatePayload)
+
+	cmd.PrintInfoMsgf("Delegator: %s", pld.From)
+	cmd.PrintInfoMsgf("Validator: %s", pld.To)
+	cmd.PrintInfoMsgf("Stake    : %.9f", util.ChangeToCoin(pld.Stake))
+	cmd.PrintInfoMsgf("Fee      : %.9f", util.ChangeToCoin(trx.Fee()))
+	if pld.PublicKey != nil {
+		cmd.PrintInfoMsgf("New validator with the public key: %s", pld.PublicKey)
+	}
+	cmd.PrintInfoMsgf("The stake can only be withdrawn to the delegator %s.", pld.From)
+}
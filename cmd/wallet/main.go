@@ -2,31 +2,63 @@ package main
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/pactus-project/pactus/cmd"
+	"github.com/pactus-project/pactus/util/deprecation"
 	"github.com/pactus-project/pactus/wallet"
 	"github.com/spf13/cobra"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
 )
 
 var (
 	pathOpt       *string
 	offlineOpt    *bool
 	serverAddrOpt *string
+	tlsCAOpt      *string
+	tlsCertOpt    *string
+	tlsKeyOpt     *string
+
+	warningPolicyOpt *string
+
+	deprecatedServerOpt *string
 )
 
+var serverFlagDeprecation = deprecation.Deprecation{
+	Kind:        deprecation.KindFlag,
+	Name:        "server",
+	Replacement: "server-addr",
+	Since:       "1.1.0",
+}
+
 func addPasswordOption(c *cobra.Command) *string {
 	return c.Flags().StringP("password", "p",
 		"", "the wallet password")
 }
 
 func openWallet() (*wallet.Wallet, error) {
+	if !*offlineOpt && *serverAddrOpt == "" {
+		// Prefer the local node, if it is listening on the unix socket.
+		if addr, ok := cmd.LocalUnixSocketAddress(cmd.PactusDefaultHomeDir()); ok {
+			*serverAddrOpt = addr
+		}
+	}
+
 	if !*offlineOpt && *serverAddrOpt != "" {
 		wlt, err := wallet.Open(*pathOpt, true)
 		if err != nil {
 			return nil, err
 		}
 
-		err = wlt.Connect(*serverAddrOpt)
+		var creds credentials.TransportCredentials = insecure.NewCredentials()
+		if *tlsCAOpt != "" || *tlsCertOpt != "" {
+			creds, err = wallet.LoadTLSCredentials(*tlsCAOpt, *tlsCertOpt, *tlsKeyOpt)
+			if err != nil {
+				return nil, err
+			}
+		}
+		err = wlt.ConnectToNodes(serverAddrs(*serverAddrOpt), creds)
 		if err != nil {
 			fmt.Println(err.Error())
 
@@ -43,6 +75,19 @@ func openWallet() (*wallet.Wallet, error) {
 	return wlt, nil
 }
 
+// serverAddrs splits the comma-separated addresses of the nodes.
+func serverAddrs(opt string) []string {
+	addrs := []string{}
+	for _, addr := range strings.Split(opt, ",") {
+		addr = strings.TrimSpace(addr)
+		if addr != "" {
+			addrs = append(addrs, addr)
+		}
+	}
+
+	return addrs
+}
+
 func main() {
 	rootCmd := &cobra.Command{
 		Use:               "pactus-wallet",
@@ -53,18 +98,42 @@ func main() {
 	// Hide the "help" sub-command
 	rootCmd.SetHelpCommand(&cobra.Command{Hidden: true})
 
+	langOpt := rootCmd.PersistentFlags().String("lang", "",
+		"the language of the messages, like `es`; if not specified, the system locale is used")
+	rootCmd.PersistentPreRun = func(c *cobra.Command, _ []string) {
+		cmd.SetupLocale(cmd.PactusDefaultHomeDir(), *langOpt)
+
+		if c.Flags().Changed("server") && !c.Flags().Changed("server-addr") {
+			deprecation.Use(serverFlagDeprecation)
+			*serverAddrOpt = *deprecatedServerOpt
+		}
+	}
+
 	pathOpt = rootCmd.PersistentFlags().String("path",
 		cmd.PactusDefaultWalletPath(cmd.PactusDefaultHomeDir()), "the path to the wallet file")
 	offlineOpt = rootCmd.PersistentFlags().Bool("offline", false, "offline mode")
-	serverAddrOpt = rootCmd.PersistentFlags().String("server", "", "server gRPC address")
+	serverAddrOpt = rootCmd.PersistentFlags().String("server-addr", "",
+		"server gRPC address, or multiple addresses separated by commas to fail over between them")
+	deprecatedServerOpt = rootCmd.PersistentFlags().String("server", "", "server gRPC address")
+	_ = rootCmd.PersistentFlags().MarkHidden("server")
+	tlsCAOpt = rootCmd.PersistentFlags().String("tls-ca", "",
+		"the CA certificate file to verify the server certificate (enables TLS)")
+	tlsCertOpt = rootCmd.PersistentFlags().String("tls-cert", "",
+		"the client certificate file for mutual TLS")
+	tlsKeyOpt = rootCmd.PersistentFlags().String("tls-key", "",
+		"the client key file for mutual TLS")
+	warningPolicyOpt = rootCmd.PersistentFlags().String("warning-policy", "warn",
+		"how to treat the transaction warnings before signing: `warn` or `block`")
 
 	buildCreateCmd(rootCmd)
 	buildRecoverCmd(rootCmd)
 	buildGetSeedCmd(rootCmd)
 	buildChangePasswordCmd(rootCmd)
+	buildMigrateCmd(rootCmd)
 	buildAllTransactionCmd(rootCmd)
 	buildAllAddrCmd(rootCmd)
 	buildAllHistoryCmd(rootCmd)
+	buildWatchCmd(rootCmd)
 
 	err := rootCmd.Execute()
 	if err != nil {
@@ -0,0 +1,57 @@
+package main
+
+import (
+	"github.com/pactus-project/pactus/cmd"
+	"github.com/pactus-project/pactus/wallet"
+	"github.com/spf13/cobra"
+)
+
+// buildMigrateCmd builds a command to migrate the wallet to the latest version.
+func buildMigrateCmd(parentCmd *cobra.Command) {
+	migrateCmd := &cobra.Command{
+		Use:   "migrate",
+		Short: "migrates the wallet to the latest version",
+	}
+	parentCmd.AddCommand(migrateCmd)
+	passOpt := addPasswordOption(migrateCmd)
+	dryRunOpt := migrateCmd.Flags().Bool("dry-run", false,
+		"show the migration steps without changing the wallet")
+	noBackupOpt := migrateCmd.Flags().Bool("no-backup", false,
+		"don't keep a backup of the original wallet")
+
+	migrateCmd.Run = func(_ *cobra.Command, _ []string) {
+		// Checking the migration steps first, to ask for the password only if it is needed.
+		res, err := wallet.Migrate(*pathOpt, "", wallet.MigrateOptions{DryRun: true})
+		cmd.FatalErrorCheck(err)
+
+		cmd.PrintLine()
+		if res.IsUpToDate() {
+			cmd.PrintSuccessMsgf("Your wallet is up to date (version %d).", res.ToVersion)
+
+			return
+		}
+
+		cmd.PrintInfoMsgf("Migrating the wallet from version %d to %d:", res.FromVersion, res.ToVersion)
+		for i, step := range res.Steps {
+			cmd.PrintInfoMsgf("%d- %s", i+1, step)
+		}
+
+		if *dryRunOpt {
+			return
+		}
+
+		password := *passOpt
+		if res.RequiresPassword && password == "" {
+			password = cmd.PromptPassword("Wallet password", false)
+		}
+
+		res, err = wallet.Migrate(*pathOpt, password, wallet.MigrateOptions{Backup: !*noBackupOpt})
+		cmd.FatalErrorCheck(err)
+
+		cmd.PrintLine()
+		if res.BackupPath != "" {
+			cmd.PrintInfoMsgf("The original wallet is kept at: %s", res.BackupPath)
+		}
+		cmd.PrintSuccessMsgf("Your wallet was successfully migrated to version %d.", res.ToVersion)
+	}
+}
@@ -0,0 +1,132 @@
+package main
+
+import (
+	"errors"
+	"time"
+
+	"github.com/pactus-project/pactus/cmd"
+	"github.com/pactus-project/pactus/crypto"
+	"github.com/pactus-project/pactus/util"
+	"github.com/pactus-project/pactus/wallet"
+	"github.com/pactus-project/pactus/wallet/paymenturi"
+	"github.com/spf13/cobra"
+)
+
+// buildPaymentURICmd builds a command to generate a payment URI for receiving funds.
+func buildPaymentURICmd(parentCmd *cobra.Command) {
+	paymentURICmd := &cobra.Command{
+		Use:   "payment-uri [flags] <ADDRESS>",
+		Short: "generates a payment URI to receive funds, for example to share it as a QR code",
+		Args:  cobra.ExactArgs(1),
+	}
+	parentCmd.AddCommand(paymentURICmd)
+
+	amountOpt := paymentURICmd.Flags().String("amount", "",
+		"the requested amount in PAC, if not specified the payer chooses the amount")
+	memoOpt := paymentURICmd.Flags().String("memo", "",
+		"the memo of the transaction, maximum should be 64 character")
+	expiresInOpt := paymentURICmd.Flags().Duration("expires-in", 0,
+		"the duration that the payment request is valid for, like `1h`, if not specified it never expires")
+
+	paymentURICmd.Run = func(_ *cobra.Command, args []string) {
+		addr, err := crypto.AddressFromString(args[0])
+		cmd.FatalErrorCheck(err)
+
+		uri := paymenturi.PaymentURI{
+			Address: addr,
+			Memo:    *memoOpt,
+		}
+		if *amountOpt != "" {
+			uri.Amount, err = util.StringToChange(*amountOpt)
+			cmd.FatalErrorCheck(err)
+		}
+		if *expiresInOpt > 0 {
+			uri.Expiry = time.Now().Add(*expiresInOpt).Truncate(time.Second)
+		}
+		cmd.FatalErrorCheck(uri.BasicCheck())
+
+		cmd.PrintLine()
+		cmd.PrintInfoMsgf("%s", uri.String())
+	}
+}
+
+// buildPayTxCmd builds a command for create, sign and publish a `Transfer` transaction
+// that pays a payment URI.
+func buildPayTxCmd(parentCmd *cobra.Command) {
+	payCmd := &cobra.Command{
+		Use:   "pay [flags] <FROM> <PAYMENT_URI>",
+		Short: "create, sign and publish a `Transfer` transaction that pays a payment URI",
+		Args:  cobra.ExactArgs(2),
+	}
+	parentCmd.AddCommand(payCmd)
+
+	amountOpt := payCmd.Flags().String("amount", "",
+		"the amount in PAC, required if the payment URI doesn't specify the amount")
+	lockTimeOpt, feeOpt, memoOpt, noConfirmOpt := addCommonTxOptions(payCmd)
+	expiryOpt := addExpiryOption(payCmd)
+	burnOpt := addBurnOption(payCmd)
+	passOpt := addPasswordOption(payCmd)
+
+	payCmd.Run = func(_ *cobra.Command, args []string) {
+		from := args[0]
+		uri, err := paymenturi.Parse(args[1])
+		cmd.FatalErrorCheck(err)
+
+		if uri.IsExpired(time.Now()) {
+			cmd.FatalErrorCheck(paymenturi.ErrExpired)
+		}
+
+		amount := uri.Amount
+		if *amountOpt != "" {
+			optAmount, err := util.StringToChange(*amountOpt)
+			cmd.FatalErrorCheck(err)
+
+			if amount != 0 && amount != optAmount {
+				cmd.FatalErrorCheck(errors.New("the amount doesn't match the amount of the payment URI"))
+			}
+			amount = optAmount
+		}
+		if amount == 0 {
+			cmd.FatalErrorCheck(errors.New("the payment URI doesn't specify the amount, use `--amount`"))
+		}
+
+		memo := uri.Memo
+		if *memoOpt != "" {
+			if memo != "" && memo != *memoOpt {
+				cmd.FatalErrorCheck(errors.New("the memo doesn't match the memo of the payment URI"))
+			}
+			memo = *memoOpt
+		}
+
+		w, err := openWallet()
+		cmd.FatalErrorCheck(err)
+
+		to := uri.Address.String()
+		opts := []wallet.TxOption{
+			wallet.OptionFee(util.CoinToChange(*feeOpt)),
+			wallet.OptionLockTime(uint32(*lockTimeOpt)),
+			wallet.OptionExpiry(*expiryOpt),
+			wallet.OptionMemo(memo),
+		}
+
+		trx, err := w.MakeTransferTx(from, to, amount, opts...)
+		cmd.FatalErrorCheck(err)
+
+		checkReceiver(w, to, *burnOpt)
+
+		cmd.PrintLine()
+		cmd.PrintInfoMsgf("You are going to sign this \033[1mTransfer\033[0m transition:")
+		cmd.PrintInfoMsgf("From  : %s", from)
+		cmd.PrintInfoMsgf("To    : %s", to)
+		cmd.PrintInfoMsgf("Amount: %.9f", util.ChangeToCoin(amount))
+		cmd.PrintInfoMsgf("Fee   : %.9f", util.ChangeToCoin(trx.Fee()))
+		if memo != "" {
+			cmd.PrintInfoMsgf("Memo  : %s", memo)
+		}
+		if !uri.Expiry.IsZero() {
+			cmd.PrintInfoMsgf("Expiry: %s", uri.Expiry.Format(time.RFC3339))
+		}
+
+		signAndPublishTx(w, trx, *noConfirmOpt, *passOpt)
+	}
+}
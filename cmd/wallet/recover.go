@@ -25,6 +25,9 @@ func buildRecoverCmd(parentCmd *cobra.Command) {
 		if mnemonic == "" {
 			mnemonic = cmd.PromptInput("Seed")
 		}
+		language, err := wallet.MnemonicLanguage(mnemonic)
+		cmd.FatalErrorCheck(err)
+
 		chainType := genesis.Mainnet
 		if *testnetOpt {
 			chainType = genesis.Testnet
@@ -36,6 +39,7 @@ func buildRecoverCmd(parentCmd *cobra.Command) {
 		cmd.FatalErrorCheck(err)
 
 		cmd.PrintLine()
+		cmd.PrintInfoMsgf("Seed language: %s", language)
 		cmd.PrintInfoMsgf("Wallet successfully recovered and saved at: %s", wallet.Path())
 	}
 }
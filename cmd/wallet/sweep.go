@@ -0,0 +1,128 @@
+package main
+
+import (
+	"context"
+	"time"
+
+	"github.com/pactus-project/pactus/cmd"
+	"github.com/pactus-project/pactus/util"
+	"github.com/pactus-project/pactus/wallet"
+	"github.com/spf13/cobra"
+)
+
+// buildSweepTxCmd builds a command for consolidating the balances of the wallet accounts,
+// like the reward addresses, into one address.
+func buildSweepTxCmd(parentCmd *cobra.Command) {
+	sweepCmd := &cobra.Command{
+		Use:   "sweep [flags] <TO>",
+		Short: "consolidate the balances of the wallet accounts into one address",
+		Args:  cobra.ExactArgs(1),
+	}
+	parentCmd.AddCommand(sweepCmd)
+
+	minBalanceOpt := sweepCmd.Flags().Float64("min-balance", 1,
+		"the accounts with less balance than this are not swept")
+	maxFeeRatioOpt := sweepCmd.Flags().Float64("max-fee-ratio", 0.01,
+		"the accounts that transferring their balance costs more than this ratio are not swept")
+	batchSizeOpt := sweepCmd.Flags().Int("batch-size", 10,
+		"the maximum number of transactions that are broadcasted in a batch")
+	intervalOpt := sweepCmd.Flags().Duration("interval", 0,
+		"if set, one batch is swept at every interval and the command keeps running")
+	memoOpt := sweepCmd.Flags().String("memo", "",
+		"transaction memo, maximum should be 64 character")
+	noConfirmOpt := sweepCmd.Flags().Bool("no-confirm", false,
+		"no confirmation question")
+	burnOpt := addBurnOption(sweepCmd)
+	passOpt := addPasswordOption(sweepCmd)
+
+	sweepCmd.Run = func(_ *cobra.Command, args []string) {
+		to := args[0]
+
+		w, err := openWallet()
+		cmd.FatalErrorCheck(err)
+
+		if w.IsOffline() {
+			cmd.FatalErrorCheck(wallet.ErrOffline)
+		}
+
+		checkReceiver(w, to, *burnOpt)
+
+		password := getPassword(w, *passOpt)
+		minBalance := util.CoinToChange(*minBalanceOpt)
+		confirmed := *noConfirmOpt
+
+		for {
+			transfers, err := w.PlanSweep(context.Background(), to, minBalance, *maxFeeRatioOpt)
+			cmd.FatalErrorCheck(err)
+
+			batches := wallet.SweepBatches(transfers, *batchSizeOpt)
+			if *intervalOpt > 0 && len(batches) > 1 {
+				batches = batches[:1]
+			}
+
+			if len(batches) == 0 {
+				cmd.PrintInfoMsgf("No account to sweep")
+			}
+
+			for _, batch := range batches {
+				printSweepBatch(to, batch)
+
+				if !confirmed {
+					cmd.PrintWarnMsgf("THIS ACTION IS NOT REVERSIBLE")
+					if !cmd.PromptConfirm("Do you want to continue") {
+						return
+					}
+					confirmed = true
+				}
+
+				sweepBatch(w, to, batch, password, *memoOpt)
+			}
+
+			if *intervalOpt <= 0 {
+				return
+			}
+			time.Sleep(*intervalOpt)
+		}
+	}
+}
+
+func printSweepBatch(to string, batch []wallet.SweepTransfer) {
+	total := int64(0)
+	totalFee := int64(0)
+	for _, trans := range batch {
+		total += trans.Amount
+		totalFee += trans.Fee
+	}
+
+	cmd.PrintLine()
+	cmd.PrintInfoMsgf("You are going to sweep %d accounts to: %s", len(batch), to)
+	for _, trans := range batch {
+		cmd.PrintInfoMsgf("%s  amount: %.9f  fee: %.9f", trans.Sender,
+			util.ChangeToCoin(trans.Amount), util.ChangeToCoin(trans.Fee))
+	}
+	cmd.PrintInfoMsgf("Total amount: %.9f", util.ChangeToCoin(total))
+	cmd.PrintInfoMsgf("Total fee   : %.9f", util.ChangeToCoin(totalFee))
+}
+
+func sweepBatch(w *wallet.Wallet, to string, batch []wallet.SweepTransfer, password, memo string) {
+	trxs, err := w.MakeSweepTxs(to, batch, wallet.OptionMemo(memo))
+	cmd.FatalErrorCheck(err)
+
+	for _, trx := range trxs {
+		err := w.SignTransaction(password, trx)
+		cmd.FatalErrorCheck(err)
+
+		res, err := w.BroadcastTransaction(trx)
+		if err != nil {
+			// The balance may have changed since the transfer is planned.
+			// It will be swept in the next run.
+			cmd.PrintWarnMsgf("Unable to sweep %s: %s", trx.Payload().Signer(), err)
+
+			continue
+		}
+		cmd.PrintInfoMsgf("Transaction hash: %s", res)
+	}
+
+	err = w.Save()
+	cmd.FatalErrorCheck(err)
+}
@@ -1,8 +1,17 @@
 package main
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
 	"github.com/pactus-project/pactus/cmd"
+	"github.com/pactus-project/pactus/crypto"
 	"github.com/pactus-project/pactus/types/tx"
+	"github.com/pactus-project/pactus/types/validator"
 	"github.com/pactus-project/pactus/util"
 	"github.com/pactus-project/pactus/wallet"
 	"github.com/spf13/cobra"
@@ -17,9 +26,14 @@ func buildAllTransactionCmd(parentCmd *cobra.Command) {
 
 	parentCmd.AddCommand(txCmd)
 	buildTransferTxCmd(txCmd)
+	buildPayTxCmd(txCmd)
 	buildBondTxCmd(txCmd)
+	buildApproveDelegationTxCmd(txCmd)
+	buildDelegateTxCmd(txCmd)
 	buildUnbondTxCmd(txCmd)
+	buildRewardSplitTxCmd(txCmd)
 	buildWithdrawTxCmd(txCmd)
+	buildSweepTxCmd(txCmd)
 }
 
 // buildTransferTxCmd builds a command for create, sign and publish a `Transfer` transaction.
@@ -32,6 +46,8 @@ func buildTransferTxCmd(parentCmd *cobra.Command) {
 	parentCmd.AddCommand(transferCmd)
 
 	lockTimeOpt, feeOpt, memoOpt, noConfirmOpt := addCommonTxOptions(transferCmd)
+	expiryOpt := addExpiryOption(transferCmd)
+	burnOpt := addBurnOption(transferCmd)
 	passOpt := addPasswordOption(transferCmd)
 
 	transferCmd.Run = func(_ *cobra.Command, args []string) {
@@ -43,19 +59,28 @@ func buildTransferTxCmd(parentCmd *cobra.Command) {
 		w, err := openWallet()
 		cmd.FatalErrorCheck(err)
 
+		name, to := resolveReceiver(w, to)
+
 		opts := []wallet.TxOption{
 			wallet.OptionFee(util.CoinToChange(*feeOpt)),
 			wallet.OptionLockTime(uint32(*lockTimeOpt)),
+			wallet.OptionExpiry(*expiryOpt),
 			wallet.OptionMemo(*memoOpt),
 		}
 
 		trx, err := w.MakeTransferTx(from, to, amount, opts...)
 		cmd.FatalErrorCheck(err)
 
+		checkReceiver(w, to, *burnOpt)
+
 		cmd.PrintLine()
 		cmd.PrintInfoMsgf("You are going to sign this \033[1mTransfer\033[0m transition:")
 		cmd.PrintInfoMsgf("From  : %s", from)
-		cmd.PrintInfoMsgf("To    : %s", to)
+		if name != "" {
+			cmd.PrintInfoMsgf("To    : %s (resolved from %s)", to, name)
+		} else {
+			cmd.PrintInfoMsgf("To    : %s", to)
+		}
 		cmd.PrintInfoMsgf("Amount: %.9f", util.ChangeToCoin(amount))
 		cmd.PrintInfoMsgf("Fee   : %.9f", util.ChangeToCoin(trx.Fee()))
 
@@ -72,8 +97,10 @@ func buildBondTxCmd(parentCmd *cobra.Command) {
 	}
 	parentCmd.AddCommand(bondCmd)
 
-	pubKeyOpt := bondCmd.Flags().String("pub", "", "validator's public key")
+	pubKeyOpt := bondCmd.Flags().String("pub", "",
+		"validator's public key, if not specified will be looked up in the wallet or on the chain")
 	lockTime, feeOpt, memoOpt, noConfirmOpt := addCommonTxOptions(bondCmd)
+	expiryOpt := addExpiryOption(bondCmd)
 	passOpt := addPasswordOption(bondCmd)
 
 	bondCmd.Run = func(_ *cobra.Command, args []string) {
@@ -88,6 +115,7 @@ func buildBondTxCmd(parentCmd *cobra.Command) {
 		opts := []wallet.TxOption{
 			wallet.OptionFee(util.CoinToChange(*feeOpt)),
 			wallet.OptionLockTime(uint32(*lockTime)),
+			wallet.OptionExpiry(*expiryOpt),
 			wallet.OptionMemo(*memoOpt),
 		}
 
@@ -101,6 +129,10 @@ func buildBondTxCmd(parentCmd *cobra.Command) {
 		cmd.PrintInfoMsgf("Stake    : %.9f", util.ChangeToCoin(amount))
 		cmd.PrintInfoMsgf("Fee      : %.9f", util.ChangeToCoin(trx.Fee()))
 
+		if !confirmStakeTx(w, trx, *noConfirmOpt) {
+			return
+		}
+
 		signAndPublishTx(w, trx, *noConfirmOpt, *passOpt)
 	}
 }
@@ -115,6 +147,7 @@ func buildUnbondTxCmd(parentCmd *cobra.Command) {
 	parentCmd.AddCommand(unbondCmd)
 
 	lockTime, feeOpt, memoOpt, noConfirmOpt := addCommonTxOptions(unbondCmd)
+	expiryOpt := addExpiryOption(unbondCmd)
 	passOpt := addPasswordOption(unbondCmd)
 
 	unbondCmd.Run = func(_ *cobra.Command, args []string) {
@@ -126,6 +159,7 @@ func buildUnbondTxCmd(parentCmd *cobra.Command) {
 		opts := []wallet.TxOption{
 			wallet.OptionFee(util.CoinToChange(*feeOpt)),
 			wallet.OptionLockTime(uint32(*lockTime)),
+			wallet.OptionExpiry(*expiryOpt),
 			wallet.OptionMemo(*memoOpt),
 		}
 
@@ -137,10 +171,85 @@ func buildUnbondTxCmd(parentCmd *cobra.Command) {
 		cmd.PrintInfoMsgf("Validator: %s", from)
 		cmd.PrintInfoMsgf("Fee      : %.9f", util.ChangeToCoin(trx.Fee()))
 
+		if !confirmStakeTx(w, trx, *noConfirmOpt) {
+			return
+		}
+
 		signAndPublishTx(w, trx, *noConfirmOpt, *passOpt)
 	}
 }
 
+// buildRewardSplitTxCmd builds a command for create, sign and publish a `RewardSplit` transaction.
+func buildRewardSplitTxCmd(parentCmd *cobra.Command) {
+	rewardSplitCmd := &cobra.Command{
+		Use:   "reward-split [flags] <VALIDATOR> [<ADDRESS>:<PERCENT>...]",
+		Short: "create, sign and publish a `RewardSplit` transaction, no share removes the reward split",
+		Args:  cobra.MinimumNArgs(1),
+	}
+	parentCmd.AddCommand(rewardSplitCmd)
+
+	lockTime, feeOpt, memoOpt, noConfirmOpt := addCommonTxOptions(rewardSplitCmd)
+	expiryOpt := addExpiryOption(rewardSplitCmd)
+	passOpt := addPasswordOption(rewardSplitCmd)
+
+	rewardSplitCmd.Run = func(_ *cobra.Command, args []string) {
+		from := args[0]
+		split := &validator.RewardSplit{}
+		for _, arg := range args[1:] {
+			share, err := parseRewardShare(arg)
+			cmd.FatalErrorCheck(err)
+
+			split.Shares = append(split.Shares, share)
+		}
+
+		w, err := openWallet()
+		cmd.FatalErrorCheck(err)
+
+		opts := []wallet.TxOption{
+			wallet.OptionFee(util.CoinToChange(*feeOpt)),
+			wallet.OptionLockTime(uint32(*lockTime)),
+			wallet.OptionExpiry(*expiryOpt),
+			wallet.OptionMemo(*memoOpt),
+		}
+
+		trx, err := w.MakeRewardSplitTx(from, split, opts...)
+		cmd.FatalErrorCheck(err)
+
+		cmd.PrintLine()
+		cmd.PrintInfoMsgf("You are going to sign this \033[1mRewardSplit\033[0m transition:")
+		cmd.PrintInfoMsgf("Validator: %s", from)
+		if split.IsEmpty() {
+			cmd.PrintWarnMsgf("The reward split of the validator will be removed.")
+		}
+		for _, share := range split.Shares {
+			cmd.PrintInfoMsgf("Share    : %s %d%%", share.Address, share.Percent)
+		}
+		cmd.PrintInfoMsgf("Fee      : %.9f", util.ChangeToCoin(trx.Fee()))
+
+		signAndPublishTx(w, trx, *noConfirmOpt, *passOpt)
+	}
+}
+
+// parseRewardShare parses a reward share in the form of `<ADDRESS>:<PERCENT>`.
+func parseRewardShare(str string) (validator.RewardShare, error) {
+	addrStr, percentStr, ok := strings.Cut(str, ":")
+	if !ok {
+		return validator.RewardShare{}, fmt.Errorf("invalid share %s, expected <ADDRESS>:<PERCENT>", str)
+	}
+
+	addr, err := crypto.AddressFromString(addrStr)
+	if err != nil {
+		return validator.RewardShare{}, err
+	}
+
+	percent, err := strconv.ParseUint(percentStr, 10, 8)
+	if err != nil {
+		return validator.RewardShare{}, fmt.Errorf("invalid percent %s: %w", percentStr, err)
+	}
+
+	return validator.RewardShare{Address: addr, Percent: uint8(percent)}, nil
+}
+
 // buildWithdrawTxCmd builds a command for create, sign and publish a `Withdraw` transaction.
 func buildWithdrawTxCmd(parentCmd *cobra.Command) {
 	withdrawCmd := &cobra.Command{
@@ -151,6 +260,8 @@ func buildWithdrawTxCmd(parentCmd *cobra.Command) {
 	parentCmd.AddCommand(withdrawCmd)
 
 	lockTime, feeOpt, memoOpt, noConfirmOpt := addCommonTxOptions(withdrawCmd)
+	expiryOpt := addExpiryOption(withdrawCmd)
+	burnOpt := addBurnOption(withdrawCmd)
 	passOpt := addPasswordOption(withdrawCmd)
 
 	withdrawCmd.Run = func(_ *cobra.Command, args []string) {
@@ -165,12 +276,15 @@ func buildWithdrawTxCmd(parentCmd *cobra.Command) {
 		opts := []wallet.TxOption{
 			wallet.OptionFee(util.CoinToChange(*feeOpt)),
 			wallet.OptionLockTime(uint32(*lockTime)),
+			wallet.OptionExpiry(*expiryOpt),
 			wallet.OptionMemo(*memoOpt),
 		}
 
 		trx, err := w.MakeWithdrawTx(from, to, amount, opts...)
 		cmd.FatalErrorCheck(err)
 
+		checkReceiver(w, to, *burnOpt)
+
 		cmd.PrintLine()
 		cmd.PrintInfoMsgf("You are going to sign this \033[1mWithdraw\033[0m transition:")
 		cmd.PrintInfoMsgf("Validator: %s", from)
@@ -198,7 +312,60 @@ func addCommonTxOptions(c *cobra.Command) (*int, *float64, *string, *bool) {
 	return lockTimeOpt, feeOpt, memoOpt, noConfirmOpt
 }
 
+// addExpiryOption adds the option that sets the number of blocks that the transaction
+// can be committed. A transaction that is not committed in time expires,
+// instead of being committed long after it is signed.
+func addExpiryOption(c *cobra.Command) *uint32 {
+	return c.Flags().Uint32("expiry", 0,
+		"number of blocks that the transaction can be committed, "+
+			"if not specified will be the time-to-live interval (about one day)")
+}
+
+// addBurnOption adds the option that confirms sending the funds to a suspicious receiver.
+// It is a separate option from `--no-confirm`, so the scripts don't burn the funds by mistake.
+func addBurnOption(c *cobra.Command) *bool {
+	return c.Flags().Bool("i-know-this-burns-funds", false,
+		"send the funds even if the receiver is a burn address or looks like a mistyped address")
+}
+
+// resolveReceiver resolves the receiver by the name service of the node, if it is not an address.
+// It returns the name and the resolved address, or an empty name if the receiver is an address.
+func resolveReceiver(w *wallet.Wallet, receiver string) (string, string) {
+	if _, err := crypto.AddressFromString(receiver); err == nil {
+		return "", receiver
+	}
+
+	addr, err := w.ResolveName(receiver)
+	cmd.FatalErrorCheck(err)
+
+	cmd.PrintInfoMsgf("The name %s is resolved to %s", receiver, addr)
+
+	return receiver, addr
+}
+
+// checkReceiver stops sending the funds to a burn or a lookalike address,
+// unless the user explicitly confirms it.
+func checkReceiver(w *wallet.Wallet, receiver string, burnConfirmed bool) {
+	err := w.CheckReceiver(receiver)
+	if err == nil {
+		return
+	}
+
+	cmd.PrintLine()
+	cmd.PrintWarnMsgf("WARNING: %s", err)
+	if !burnConfirmed {
+		cmd.FatalErrorCheck(errors.New(
+			"use `--i-know-this-burns-funds` if you really want to send the funds to this address"))
+	}
+}
+
 func signAndPublishTx(w *wallet.Wallet, trx *tx.Tx, noConfirm bool, pass string) {
+	printTxExpiry(w, trx)
+
+	if !checkTxWarnings(w, trx, noConfirm) {
+		return
+	}
+
 	cmd.PrintLine()
 	password := getPassword(w, pass)
 	err := w.SignTransaction(password, trx)
@@ -227,6 +394,70 @@ func signAndPublishTx(w *wallet.Wallet, trx *tx.Tx, noConfirm bool, pass string)
 	}
 }
 
+// printTxExpiry prints the last block that the transaction can be committed,
+// so the user knows when an uncommitted transaction is no longer valid.
+func printTxExpiry(w *wallet.Wallet, trx *tx.Tx) {
+	expiry := w.TxExpiry(trx)
+	if trx.IsTimestampLocked() {
+		cmd.PrintWarnMsgf("The transaction expires if it is not committed by %s.",
+			time.Unix(int64(expiry), 0).Format(time.RFC3339))
+	} else {
+		cmd.PrintWarnMsgf("The transaction expires if it is not committed by the block %d.", expiry)
+	}
+}
+
+// checkTxWarnings reports the warnings of the transaction before signing it.
+// Depending on the warning policy, signing is blocked or the user is asked to confirm it.
+func checkTxWarnings(w *wallet.Wallet, trx *tx.Tx, noConfirm bool) bool {
+	if w.IsOffline() {
+		return true
+	}
+
+	policy, err := wallet.ParseWarningPolicy(*warningPolicyOpt)
+	cmd.FatalErrorCheck(err)
+
+	warnings, err := w.CheckTransaction(context.Background(), trx)
+	cmd.FatalErrorCheck(err)
+	if len(warnings) == 0 {
+		return true
+	}
+
+	cmd.PrintLine()
+	for _, warning := range warnings {
+		cmd.PrintWarnMsgf("WARNING: %s", warning)
+	}
+	cmd.FatalErrorCheck(policy.Enforce(warnings))
+
+	if noConfirm {
+		return true
+	}
+
+	return cmd.PromptConfirm("Do you want to sign it anyway")
+}
+
+// confirmStakeTx validates the bond or unbond transaction against the state of the validator,
+// and asks the user to confirm its consequences before signing it.
+func confirmStakeTx(w *wallet.Wallet, trx *tx.Tx, noConfirm bool) bool {
+	if w.IsOffline() {
+		return true
+	}
+
+	summary, err := w.PreflightStakeTx(context.Background(), trx)
+	cmd.FatalErrorCheck(err)
+
+	cmd.PrintLine()
+	cmd.PrintInfoMsgf("By signing this transaction:")
+	for _, consequence := range summary.Consequences() {
+		cmd.PrintInfoMsgf("  - %s", consequence)
+	}
+
+	if noConfirm {
+		return true
+	}
+
+	return cmd.PromptConfirm("Do you accept these consequences")
+}
+
 func getPassword(wlt *wallet.Wallet, passOpt string) string {
 	password := passOpt
 	if wlt.IsEncrypted() && password == "" {
@@ -0,0 +1,105 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"os/signal"
+	"runtime"
+	"syscall"
+	"time"
+
+	"github.com/pactus-project/pactus/cmd"
+	"github.com/pactus-project/pactus/util"
+	"github.com/pactus-project/pactus/wallet"
+	"github.com/spf13/cobra"
+)
+
+// buildWatchCmd builds a command to watch the transactions of the wallet addresses.
+func buildWatchCmd(parentCmd *cobra.Command) {
+	watchCmd := &cobra.Command{
+		Use:   "watch",
+		Short: "stays connected to the node and reports the transactions of the wallet addresses",
+	}
+	parentCmd.AddCommand(watchCmd)
+
+	confirmationsOpt := watchCmd.Flags().Uint32("confirmations", 1,
+		"number of the confirmations to report a transaction as confirmed")
+	fromHeightOpt := watchCmd.Flags().Uint32("from-height", 0,
+		"watch the blocks after this height; if not set, watching starts from the last block")
+	intervalOpt := watchCmd.Flags().DurationP("interval", "i", 10*time.Second,
+		"the interval to poll the node")
+	execOpt := watchCmd.Flags().String("exec", "",
+		"the command to execute for each event; the event is passed by the PACTUS_* environment variables")
+
+	watchCmd.Run = func(_ *cobra.Command, _ []string) {
+		wlt, err := openWallet()
+		cmd.FatalErrorCheck(err)
+
+		watcher, err := wlt.NewWatcher(*confirmationsOpt, *fromHeightOpt)
+		cmd.FatalErrorCheck(err)
+
+		ctx, cancel := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+		defer cancel()
+
+		cmd.PrintInfoMsgf("Watching %d addresses, press Ctrl+C to stop...", wlt.AddressCount())
+		watcher.Run(ctx, *intervalOpt,
+			func(event wallet.WatchEvent) {
+				printWatchEvent(event)
+
+				if *execOpt != "" {
+					if err := execWatchHook(ctx, *execOpt, event); err != nil {
+						cmd.PrintWarnMsgf("Hook failed: %v", err)
+					}
+				}
+			},
+			func(err error) {
+				cmd.PrintWarnMsgf("Unable to poll the node: %v", err)
+			})
+	}
+}
+
+func watchEventDirection(event wallet.WatchEvent) string {
+	if event.Incoming {
+		return "incoming"
+	}
+
+	return "outgoing"
+}
+
+func printWatchEvent(event wallet.WatchEvent) {
+	msg := fmt.Sprintf("%s %s %s %s %v PAC, fee %v PAC",
+		event.Type, watchEventDirection(event), event.TxID, event.Address,
+		util.ChangeToCoin(event.Amount), util.ChangeToCoin(event.Fee))
+	if event.Type == wallet.WatchEventConfirmed {
+		cmd.PrintSuccessMsgf("%s, height %d", msg, event.Height)
+	} else {
+		cmd.PrintInfoMsgf("%s", msg)
+	}
+}
+
+// execWatchHook executes the hook command by the shell of the system.
+func execWatchHook(ctx context.Context, command string, event wallet.WatchEvent) error {
+	var hook *exec.Cmd
+	if runtime.GOOS == "windows" {
+		hook = exec.CommandContext(ctx, "cmd", "/C", command)
+	} else {
+		hook = exec.CommandContext(ctx, "sh", "-c", command)
+	}
+
+	hook.Env = append(os.Environ(),
+		fmt.Sprintf("PACTUS_EVENT=%s", event.Type),
+		fmt.Sprintf("PACTUS_TX_ID=%s", event.TxID),
+		fmt.Sprintf("PACTUS_ADDRESS=%s", event.Address),
+		fmt.Sprintf("PACTUS_DIRECTION=%s", watchEventDirection(event)),
+		fmt.Sprintf("PACTUS_AMOUNT=%d", event.Amount),
+		fmt.Sprintf("PACTUS_FEE=%d", event.Fee),
+		fmt.Sprintf("PACTUS_PAYLOAD_TYPE=%s", event.PayloadType),
+		fmt.Sprintf("PACTUS_HEIGHT=%d", event.Height),
+	)
+	hook.Stdout = os.Stdout
+	hook.Stderr = os.Stderr
+
+	return hook.Run()
+}
@@ -0,0 +1,226 @@
+// Package lightclient provides the functions of the WebAssembly light client.
+// It verifies the blocks and signs the transactions in the browser,
+// and depends only on the packages that have no file or system dependencies.
+// The inputs and the outputs are strings, so they can be passed to JavaScript easily.
+// The binary data, like the transactions and the blocks, are hex encoded.
+package lightclient
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+
+	"github.com/pactus-project/pactus/crypto"
+	"github.com/pactus-project/pactus/crypto/bls"
+	"github.com/pactus-project/pactus/genesis"
+	"github.com/pactus-project/pactus/types/block"
+	"github.com/pactus-project/pactus/types/certificate/verifier"
+	"github.com/pactus-project/pactus/types/tx"
+	"github.com/pactus-project/pactus/types/tx/txbuilder"
+	"github.com/pactus-project/pactus/types/validator"
+)
+
+// KeyInfo contains a private key and the public key and addresses that are derived from it.
+type KeyInfo struct {
+	PrivateKey       string `json:"private_key"`
+	PublicKey        string `json:"public_key"`
+	AccountAddress   string `json:"account_address"`
+	ValidatorAddress string `json:"validator_address"`
+}
+
+// SetNetwork sets the human-readable parts of the addresses and the sign domain
+// of the transactions for the network. The network is "mainnet" or "testnet".
+// The default network is the Mainnet.
+func SetNetwork(network string) error {
+	var gen *genesis.Genesis
+	switch network {
+	case "mainnet":
+		gen = genesis.MainnetGenesis()
+	case "testnet":
+		gen = genesis.TestnetGenesis()
+	default:
+		return fmt.Errorf("unknown network: %s", network)
+	}
+
+	crypto.SetHRP(gen.HRP())
+	crypto.SetSignDomain(gen.SignDomain())
+
+	return nil
+}
+
+// GenerateKey generates a new random private key.
+func GenerateKey() (*KeyInfo, error) {
+	ikm := make([]byte, 32)
+	if _, err := rand.Read(ikm); err != nil {
+		return nil, err
+	}
+	prv, err := bls.KeyGen(ikm, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return keyInfo(prv), nil
+}
+
+// ImportKey returns the public key and the addresses of the private key.
+func ImportKey(prvStr string) (*KeyInfo, error) {
+	prv, err := bls.PrivateKeyFromString(prvStr)
+	if err != nil {
+		return nil, err
+	}
+
+	return keyInfo(prv), nil
+}
+
+func keyInfo(prv *bls.PrivateKey) *KeyInfo {
+	pub := prv.PublicKeyNative()
+
+	return &KeyInfo{
+		PrivateKey:       prv.String(),
+		PublicKey:        pub.String(),
+		AccountAddress:   pub.AccountAddress().String(),
+		ValidatorAddress: pub.ValidatorAddress().String(),
+	}
+}
+
+// ValidateAddress checks if the address is valid for the current network.
+func ValidateAddress(addrStr string) error {
+	_, err := crypto.AddressFromString(addrStr)
+
+	return err
+}
+
+// BuildTransfer builds an unsigned transfer transaction.
+// The amount and the fee are in NanoPAC, as decimal strings, since they may not fit
+// in the JavaScript numbers. The lock time and the fee should be set, since there is
+// no node to provide the default values.
+func BuildTransfer(sender, receiver, amount, fee string, lockTime uint32, memo string) (string, error) {
+	from, err := crypto.AddressFromString(sender)
+	if err != nil {
+		return "", err
+	}
+	to, err := crypto.AddressFromString(receiver)
+	if err != nil {
+		return "", err
+	}
+	amt, err := strconv.ParseInt(amount, 10, 64)
+	if err != nil {
+		return "", fmt.Errorf("invalid amount: %w", err)
+	}
+	fe, err := strconv.ParseInt(fee, 10, 64)
+	if err != nil {
+		return "", fmt.Errorf("invalid fee: %w", err)
+	}
+	trx, err := txbuilder.New(nil).
+		Transfer(from, to, amt).
+		LockTime(lockTime).
+		Fee(fe).
+		Memo(memo).
+		Build()
+	if err != nil {
+		return "", err
+	}
+
+	return encodeTx(trx)
+}
+
+// SignTransaction signs the raw transaction with the private key of the signer.
+// It returns the signed transaction that can be broadcast, and its ID.
+func SignTransaction(rawTx, prvStr string) (signedTx, txID string, err error) {
+	data, err := hex.DecodeString(rawTx)
+	if err != nil {
+		return "", "", err
+	}
+	trx, err := tx.FromBytes(data)
+	if err != nil {
+		return "", "", err
+	}
+	prv, err := bls.PrivateKeyFromString(prvStr)
+	if err != nil {
+		return "", "", err
+	}
+
+	pub := prv.PublicKeyNative()
+	if trx.Payload().Signer() != pub.AccountAddress() &&
+		trx.Payload().Signer() != pub.ValidatorAddress() {
+		return "", "", fmt.Errorf("private key is not for the signer: %s", trx.Payload().Signer())
+	}
+
+	trx.SetSignature(prv.Sign(trx.SignBytes()))
+	trx.SetPublicKey(pub)
+	if err := trx.BasicCheck(); err != nil {
+		return "", "", err
+	}
+
+	signedTx, err = encodeTx(trx)
+	if err != nil {
+		return "", "", err
+	}
+
+	return signedTx, trx.ID().String(), nil
+}
+
+// VerifySignature verifies the signature of the message by the public key.
+func VerifySignature(pubStr, msg, sigStr string) error {
+	pub, err := bls.PublicKeyFromString(pubStr)
+	if err != nil {
+		return err
+	}
+	sigData, err := hex.DecodeString(sigStr)
+	if err != nil {
+		return err
+	}
+	sig, err := bls.SignatureFromBytes(sigData)
+	if err != nil {
+		return err
+	}
+
+	return pub.Verify([]byte(msg), sig)
+}
+
+// VerifyBlock verifies the finality of the block using the certificate in the next block.
+// The committee contains the validators of the committee, at the time the block is committed.
+func VerifyBlock(committee []string, rawBlock, rawNextBlock string) error {
+	vals := make([]*validator.Validator, 0, len(committee))
+	for _, rawVal := range committee {
+		data, err := hex.DecodeString(rawVal)
+		if err != nil {
+			return err
+		}
+		val, err := validator.FromBytes(data)
+		if err != nil {
+			return err
+		}
+		vals = append(vals, val)
+	}
+
+	blk, err := decodeBlock(rawBlock)
+	if err != nil {
+		return err
+	}
+	nextBlk, err := decodeBlock(rawNextBlock)
+	if err != nil {
+		return err
+	}
+
+	return verifier.NewCommittee(vals).VerifyBlock(blk, nextBlk)
+}
+
+func encodeTx(trx *tx.Tx) (string, error) {
+	data, err := trx.Bytes()
+	if err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(data), nil
+}
+
+func decodeBlock(rawBlock string) (*block.Block, error) {
+	data, err := hex.DecodeString(rawBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	return block.FromBytes(data)
+}
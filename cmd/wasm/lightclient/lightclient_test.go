@@ -0,0 +1,141 @@
+package lightclient
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/pactus-project/pactus/crypto"
+	"github.com/pactus-project/pactus/crypto/bls"
+	"github.com/pactus-project/pactus/genesis"
+	"github.com/pactus-project/pactus/types/block"
+	"github.com/pactus-project/pactus/types/certificate"
+	"github.com/pactus-project/pactus/types/tx"
+	"github.com/pactus-project/pactus/types/validator"
+	"github.com/pactus-project/pactus/util"
+	"github.com/pactus-project/pactus/util/testsuite"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetNetwork(t *testing.T) {
+	defer func() { _ = SetNetwork("mainnet") }()
+
+	assert.Error(t, SetNetwork("unknown"))
+
+	require.NoError(t, SetNetwork("testnet"))
+	key, err := GenerateKey()
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(key.AccountAddress, crypto.TestnetHRP.Address))
+	assert.Equal(t, genesis.TestnetGenesis().SignDomain(), crypto.CurrentSignDomain())
+
+	require.NoError(t, SetNetwork("mainnet"))
+	assert.Error(t, ValidateAddress(key.AccountAddress))
+}
+
+func TestImportKey(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	pub, prv := ts.RandBLSKeyPair()
+	key, err := ImportKey(prv.String())
+	require.NoError(t, err)
+
+	assert.Equal(t, pub.String(), key.PublicKey)
+	assert.Equal(t, pub.AccountAddress().String(), key.AccountAddress)
+	assert.Equal(t, pub.ValidatorAddress().String(), key.ValidatorAddress)
+	assert.NoError(t, ValidateAddress(key.ValidatorAddress))
+
+	_, err = ImportKey("invalid")
+	assert.Error(t, err)
+}
+
+func TestTransfer(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	key, err := GenerateKey()
+	require.NoError(t, err)
+	receiver := ts.RandAccAddress()
+
+	t.Run("Invalid amount", func(t *testing.T) {
+		_, err := BuildTransfer(key.AccountAddress, receiver.String(), "1.5", "1000", 1, "")
+		assert.ErrorContains(t, err, "invalid amount")
+	})
+
+	t.Run("No fee", func(t *testing.T) {
+		_, err := BuildTransfer(key.AccountAddress, receiver.String(), "1000", "0", 1, "")
+		assert.Error(t, err)
+	})
+
+	rawTx, err := BuildTransfer(key.AccountAddress, receiver.String(),
+		"9007199254740993", "10000000", 100, "memo")
+	require.NoError(t, err)
+
+	t.Run("Wrong signer", func(t *testing.T) {
+		_, prv := ts.RandBLSKeyPair()
+		_, _, err := SignTransaction(rawTx, prv.String())
+		assert.ErrorContains(t, err, "not for the signer")
+	})
+
+	t.Run("Signed transaction", func(t *testing.T) {
+		signedTx, txID, err := SignTransaction(rawTx, key.PrivateKey)
+		require.NoError(t, err)
+
+		data, _ := hex.DecodeString(signedTx)
+		trx, err := tx.FromBytes(data)
+		require.NoError(t, err)
+		assert.Equal(t, int64(9007199254740993), trx.Payload().Value())
+		assert.Equal(t, uint32(100), trx.LockTime())
+		assert.Equal(t, "memo", trx.Memo())
+		assert.Equal(t, trx.ID().String(), txID)
+		assert.NoError(t, trx.BasicCheck())
+		assert.NoError(t, trx.PublicKey().Verify(trx.SignBytes(), trx.Signature()))
+	})
+}
+
+func TestVerifySignature(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	pub, prv := ts.RandBLSKeyPair()
+	sig := hex.EncodeToString(prv.Sign([]byte("message")).Bytes())
+
+	assert.NoError(t, VerifySignature(pub.String(), "message", sig))
+	assert.Error(t, VerifySignature(pub.String(), "another message", sig))
+}
+
+func TestVerifyBlock(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	valKeys := make([]*bls.ValidatorKey, 0, 4)
+	committee := make([]string, 0, 4)
+	for i := int32(0); i < 4; i++ {
+		valKey := ts.RandValKey()
+		valKeys = append(valKeys, valKey)
+		data, _ := validator.NewValidator(valKey.PublicKey(), i).Bytes()
+		committee = append(committee, hex.EncodeToString(data))
+	}
+
+	blk, _ := ts.GenerateTestBlock(ts.RandHeight())
+	round := ts.RandRound()
+	height := ts.RandHeight()
+	signBytes := certificate.BlockCertificateSignBytes(blk.Hash(), height, round)
+	sigs := make([]*bls.Signature, 0, len(valKeys))
+	for _, valKey := range valKeys {
+		sigs = append(sigs, valKey.Sign(signBytes))
+	}
+	cert := certificate.NewCertificate(height, round, []int32{0, 1, 2, 3}, []int32{},
+		bls.SignatureAggregate(sigs...))
+
+	trx, _ := ts.GenerateTestTransferTx()
+	txs := block.NewTxs()
+	txs.Append(trx)
+	nextBlk := block.MakeBlock(1, util.Now(), txs, blk.Hash(), ts.RandHash(),
+		cert, ts.RandSeed(), ts.RandValAddress())
+
+	blkData, _ := blk.Bytes()
+	nextBlkData, _ := nextBlk.Bytes()
+
+	assert.NoError(t, VerifyBlock(committee, hex.EncodeToString(blkData), hex.EncodeToString(nextBlkData)))
+	assert.Error(t, VerifyBlock(committee[:3], hex.EncodeToString(blkData), hex.EncodeToString(nextBlkData)))
+	assert.Error(t, VerifyBlock(committee, hex.EncodeToString(nextBlkData), hex.EncodeToString(nextBlkData)))
+	assert.Error(t, VerifyBlock(committee, "invalid", hex.EncodeToString(nextBlkData)))
+}
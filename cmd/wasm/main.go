@@ -0,0 +1,91 @@
+//go:build js && wasm
+
+// Command wasm is the WebAssembly build of the Pactus light client.
+// It registers the "pactus" object in the JavaScript global scope:
+//
+//	GOOS=js GOARCH=wasm go build -o pactus.wasm ./cmd/wasm
+//
+// Each function returns an object that has either the "result" or the "error" field.
+package main
+
+import (
+	"fmt"
+	"syscall/js"
+
+	"github.com/pactus-project/pactus/cmd/wasm/lightclient"
+)
+
+func main() {
+	js.Global().Set("pactus", js.ValueOf(map[string]any{
+		"setNetwork": js.FuncOf(func(_ js.Value, args []js.Value) any {
+			return respond(nil, lightclient.SetNetwork(arg(args, 0).String()))
+		}),
+		"generateKey": js.FuncOf(func(_ js.Value, _ []js.Value) any {
+			return respond(lightclient.GenerateKey())
+		}),
+		"importKey": js.FuncOf(func(_ js.Value, args []js.Value) any {
+			return respond(lightclient.ImportKey(arg(args, 0).String()))
+		}),
+		"validateAddress": js.FuncOf(func(_ js.Value, args []js.Value) any {
+			return respond(nil, lightclient.ValidateAddress(arg(args, 0).String()))
+		}),
+		"buildTransfer": js.FuncOf(func(_ js.Value, args []js.Value) any {
+			return respond(lightclient.BuildTransfer(
+				arg(args, 0).String(), arg(args, 1).String(),
+				arg(args, 2).String(), arg(args, 3).String(),
+				uint32(arg(args, 4).Int()), arg(args, 5).String()))
+		}),
+		"signTransaction": js.FuncOf(func(_ js.Value, args []js.Value) any {
+			signedTx, txID, err := lightclient.SignTransaction(arg(args, 0).String(), arg(args, 1).String())
+
+			return respond(map[string]any{"signed_tx": signedTx, "id": txID}, err)
+		}),
+		"verifySignature": js.FuncOf(func(_ js.Value, args []js.Value) any {
+			return respond(nil, lightclient.VerifySignature(
+				arg(args, 0).String(), arg(args, 1).String(), arg(args, 2).String()))
+		}),
+		"verifyBlock": js.FuncOf(func(_ js.Value, args []js.Value) any {
+			committee := arg(args, 0)
+			vals := make([]string, 0, committee.Length())
+			for i := 0; i < committee.Length(); i++ {
+				vals = append(vals, committee.Index(i).String())
+			}
+
+			return respond(nil, lightclient.VerifyBlock(vals, arg(args, 1).String(), arg(args, 2).String()))
+		}),
+	}))
+
+	// Keep the functions alive for the JavaScript callers.
+	select {}
+}
+
+// arg returns the argument at the index, or undefined if it is not passed.
+func arg(args []js.Value, index int) js.Value {
+	if index < len(args) {
+		return args[index]
+	}
+
+	return js.Undefined()
+}
+
+func respond(result any, err error) any {
+	if err != nil {
+		return map[string]any{"error": err.Error()}
+	}
+
+	switch res := result.(type) {
+	case nil:
+		return map[string]any{"result": true}
+	case *lightclient.KeyInfo:
+		return map[string]any{"result": map[string]any{
+			"private_key":       res.PrivateKey,
+			"public_key":        res.PublicKey,
+			"account_address":   res.AccountAddress,
+			"validator_address": res.ValidatorAddress,
+		}}
+	case string, map[string]any:
+		return map[string]any{"result": res}
+	default:
+		return map[string]any{"error": fmt.Sprintf("unsupported result: %T", res)}
+	}
+}
@@ -49,6 +49,26 @@ func NewCommittee(validators []*validator.Validator, committeeSize int,
 	}, nil
 }
 
+// Clone returns a deep copy of the committee.
+// The copy is not affected by the later updates of the committee.
+func (c *committee) Clone() Committee {
+	validatorList := linkedlist.New[*validator.Validator]()
+	var proposerPos *linkedlist.Element[*validator.Validator]
+
+	for e := c.validatorList.Head; e != nil; e = e.Next {
+		el := validatorList.InsertAtTail(cloneValidator(e.Data))
+		if e == c.proposerPos {
+			proposerPos = el
+		}
+	}
+
+	return &committee{
+		committeeSize: c.committeeSize,
+		validatorList: validatorList,
+		proposerPos:   proposerPos,
+	}
+}
+
 func (c *committee) TotalPower() int64 {
 	p := int64(0)
 	c.iterate(func(v *validator.Validator) bool {
@@ -386,3 +386,22 @@ func TestTotalPower(t *testing.T) {
 	assert.Equal(t, cmt.TotalPower(), totalPower)
 	assert.Equal(t, cmt.TotalPower(), totalStake+1)
 }
+
+func TestClone(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	cmt, _ := ts.GenerateTestCommittee(4)
+	cloned := cmt.Clone()
+
+	assert.Equal(t, cmt.Committers(), cloned.Committers())
+	assert.Equal(t, cmt.Proposer(0).Address(), cloned.Proposer(0).Address())
+
+	val5, _ := ts.GenerateTestValidator(4)
+	val5.UpdateLastSortitionHeight(ts.RandHeight())
+	cmt.Update(0, []*validator.Validator{val5})
+
+	assert.True(t, cmt.Contains(val5.Address()))
+	assert.False(t, cloned.Contains(val5.Address()))
+	assert.Equal(t, int32(0), cloned.Proposer(0).Number())
+	assert.Equal(t, 4, cloned.Size())
+}
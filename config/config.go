@@ -6,18 +6,22 @@ import (
 	"encoding/json"
 	"os"
 
+	"github.com/pactus-project/pactus/blocklog"
 	"github.com/pactus-project/pactus/consensus"
 	"github.com/pactus-project/pactus/crypto"
 	"github.com/pactus-project/pactus/network"
+	"github.com/pactus-project/pactus/state"
 	"github.com/pactus-project/pactus/store"
 	"github.com/pactus-project/pactus/sync"
 	"github.com/pactus-project/pactus/txpool"
 	"github.com/pactus-project/pactus/util"
 	"github.com/pactus-project/pactus/util/errors"
 	"github.com/pactus-project/pactus/util/logger"
+	"github.com/pactus-project/pactus/watcher"
 	"github.com/pactus-project/pactus/www/grpc"
 	"github.com/pactus-project/pactus/www/http"
 	"github.com/pactus-project/pactus/www/nanomsg"
+	"github.com/pactus-project/pactus/www/sink"
 	"github.com/pelletier/go-toml"
 )
 
@@ -30,16 +34,22 @@ var (
 )
 
 type Config struct {
+	Version   int               `toml:"version"`
 	Node      *NodeConfig       `toml:"node"`
+	Memory    *MemoryConfig     `toml:"memory"`
 	Store     *store.Config     `toml:"store"`
 	Network   *network.Config   `toml:"network"`
 	Sync      *sync.Config      `toml:"sync"`
 	TxPool    *txpool.Config    `toml:"tx_pool"`
+	State     *state.Config     `toml:"state"`
 	Consensus *consensus.Config `toml:"-"`
 	Logger    *logger.Config    `toml:"logger"`
 	GRPC      *grpc.Config      `toml:"grpc"`
 	HTTP      *http.Config      `toml:"http"`
 	Nanomsg   *nanomsg.Config   `toml:"nanomsg"`
+	Watcher   *watcher.Config   `toml:"watcher"`
+	Sinks     *sink.Config      `toml:"sinks"`
+	BlockLog  *blocklog.Config  `toml:"block_log"`
 }
 
 type BootstrapInfo struct {
@@ -51,6 +61,22 @@ type BootstrapInfo struct {
 
 type NodeConfig struct {
 	RewardAddresses []string `toml:"reward_addresses"`
+	SafeMode        bool     `toml:"safe_mode"`
+
+	// RemoteSigners configures the validators that sign through a remote signer.
+	RemoteSigners []consensus.RemoteSignerConfig `toml:"remote_signers"`
+
+	// ParticipationReportInterval is the number of blocks between the participation reports.
+	// Zero disables the reports.
+	ParticipationReportInterval uint32 `toml:"participation_report_interval"`
+
+	// ValidatorExportInterval is the number of blocks between the exports of the validator set,
+	// for the monitoring systems. Zero disables the export.
+	ValidatorExportInterval uint32 `toml:"validator_export_interval"`
+
+	// AuditLog enables recording the signatures of the validators and the wallet service
+	// in the audit log of the data directory.
+	AuditLog bool `toml:"audit_log"`
 }
 
 func DefaultNodeConfig() *NodeConfig {
@@ -70,21 +96,81 @@ func (conf *NodeConfig) BasicCheck() error {
 		}
 	}
 
+	signers := make(map[string]bool)
+	for i := range conf.RemoteSigners {
+		signerConf := &conf.RemoteSigners[i]
+		if err := signerConf.BasicCheck(); err != nil {
+			return errors.Errorf(errors.ErrInvalidConfig, "%s", err.Error())
+		}
+
+		if signers[signerConf.PublicKey] {
+			return errors.Errorf(errors.ErrInvalidConfig, "duplicated remote signer: %s", signerConf.PublicKey)
+		}
+		signers[signerConf.PublicKey] = true
+	}
+
+	if conf.ParticipationReportInterval > state.MaxParticipationRange {
+		return errors.Errorf(errors.ErrInvalidConfig,
+			"participation report interval is more than %d blocks", state.MaxParticipationRange)
+	}
+
+	return nil
+}
+
+// MemoryConfig contains the options to tune the memory usage of the node.
+type MemoryConfig struct {
+	LimitMB   int64 `toml:"limit_mb"`
+	GCPercent int   `toml:"gc_percent"`
+	BallastMB int64 `toml:"ballast_mb"`
+}
+
+func DefaultMemoryConfig() *MemoryConfig {
+	return &MemoryConfig{
+		LimitMB:   0,
+		GCPercent: 0,
+		BallastMB: 0,
+	}
+}
+
+// BasicCheck performs basic checks on the configuration.
+func (conf *MemoryConfig) BasicCheck() error {
+	if conf.LimitMB < 0 {
+		return errors.Errorf(errors.ErrInvalidConfig, "memory limit can't be negative")
+	}
+	if conf.BallastMB < 0 {
+		return errors.Errorf(errors.ErrInvalidConfig, "memory ballast can't be negative")
+	}
+	if conf.GCPercent < -1 {
+		return errors.Errorf(errors.ErrInvalidConfig, "gc percent should be -1 or greater")
+	}
+	if conf.GCPercent == -1 && conf.LimitMB == 0 {
+		return errors.Errorf(errors.ErrInvalidConfig, "disabling the garbage collector needs a memory limit")
+	}
+	if conf.LimitMB != 0 && conf.BallastMB >= conf.LimitMB {
+		return errors.Errorf(errors.ErrInvalidConfig, "memory ballast should be less than the memory limit")
+	}
+
 	return nil
 }
 
 func defaultConfig() *Config {
 	conf := &Config{
+		Version:   CurrentVersion,
 		Node:      DefaultNodeConfig(),
+		Memory:    DefaultMemoryConfig(),
 		Store:     store.DefaultConfig(),
 		Network:   network.DefaultConfig(),
 		Sync:      sync.DefaultConfig(),
 		TxPool:    txpool.DefaultConfig(),
+		State:     state.DefaultConfig(),
 		Consensus: consensus.DefaultConfig(),
 		Logger:    logger.DefaultConfig(),
 		GRPC:      grpc.DefaultConfig(),
 		HTTP:      http.DefaultConfig(),
 		Nanomsg:   nanomsg.DefaultConfig(),
+		Watcher:   watcher.DefaultConfig(),
+		Sinks:     sink.DefaultConfig(),
+		BlockLog:  blocklog.DefaultConfig(),
 	}
 
 	return conf
@@ -206,12 +292,18 @@ func (conf *Config) BasicCheck() error {
 	if err := conf.Node.BasicCheck(); err != nil {
 		return err
 	}
+	if err := conf.Memory.BasicCheck(); err != nil {
+		return err
+	}
 	if err := conf.Store.BasicCheck(); err != nil {
 		return err
 	}
 	if err := conf.TxPool.BasicCheck(); err != nil {
 		return err
 	}
+	if err := conf.State.BasicCheck(); err != nil {
+		return err
+	}
 	if err := conf.Consensus.BasicCheck(); err != nil {
 		return err
 	}
@@ -227,6 +319,34 @@ func (conf *Config) BasicCheck() error {
 	if err := conf.Nanomsg.BasicCheck(); err != nil {
 		return err
 	}
+	if err := conf.Watcher.BasicCheck(); err != nil {
+		return err
+	}
+	if err := conf.Sinks.BasicCheck(); err != nil {
+		return err
+	}
+	if err := conf.BlockLog.BasicCheck(); err != nil {
+		return err
+	}
+	if err := conf.GRPC.BasicCheck(); err != nil {
+		return err
+	}
+	if err := conf.HTTP.BasicCheck(); err != nil {
+		return err
+	}
 
-	return conf.HTTP.BasicCheck()
+	// The HTTP server and the gRPC gateway are the clients of the gRPC server.
+	if conf.HTTP.Enable && !conf.GRPC.Enable {
+		return errors.Errorf(errors.ErrInvalidConfig, "http server needs the grpc server to be enabled")
+	}
+	if conf.GRPC.Gateway.Enable && !conf.GRPC.Enable {
+		return errors.Errorf(errors.ErrInvalidConfig, "grpc gateway needs the grpc server to be enabled")
+	}
+
+	// A network node serves the whole history of the blocks to the other nodes.
+	if conf.Store.Retention.Blocks != 0 && conf.Sync.NodeNetwork {
+		return errors.Errorf(errors.ErrInvalidConfig, "network node can't prune the blocks")
+	}
+
+	return nil
 }
@@ -3,8 +3,12 @@ package config
 import (
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/pactus-project/pactus/consensus"
+	"github.com/pactus-project/pactus/state"
 	"github.com/pactus-project/pactus/util"
+	"github.com/pactus-project/pactus/util/errors"
 	"github.com/pactus-project/pactus/util/testsuite"
 	"github.com/stretchr/testify/assert"
 )
@@ -56,6 +60,32 @@ func TestDefaultConfig(t *testing.T) {
 	assert.Equal(t, conf.Nanomsg.Listen, "")
 }
 
+func TestPrunedNetworkNode(t *testing.T) {
+	conf := defaultConfig()
+	conf.Store.Retention.Blocks = 100_000
+	conf.Store.Retention.Txs = 100_000
+	assert.ErrorIs(t, conf.BasicCheck(), errors.Errorf(errors.ErrInvalidConfig, "network node can't prune the blocks"))
+
+	conf.Sync.NodeNetwork = false
+	assert.NoError(t, conf.BasicCheck())
+}
+
+func TestDependentSubsystems(t *testing.T) {
+	conf := DefaultConfigMainnet()
+	conf.GRPC.Enable = false
+	conf.HTTP.Enable = true
+	assert.ErrorIs(t, conf.BasicCheck(),
+		errors.Errorf(errors.ErrInvalidConfig, "http server needs the grpc server to be enabled"))
+
+	conf.HTTP.Enable = false
+	conf.GRPC.Gateway.Enable = true
+	assert.ErrorIs(t, conf.BasicCheck(),
+		errors.Errorf(errors.ErrInvalidConfig, "grpc gateway needs the grpc server to be enabled"))
+
+	conf.GRPC.Gateway.Enable = false
+	assert.NoError(t, conf.BasicCheck())
+}
+
 func TestMainnetConfig(t *testing.T) {
 	conf := DefaultConfigMainnet()
 
@@ -129,6 +159,24 @@ func TestLoadFromFile(t *testing.T) {
 	assert.Equal(t, conf, defConf)
 }
 
+func TestLoadLogSampling(t *testing.T) {
+	path := util.TempFilePath()
+	data := `
+[logger]
+  [logger.sampling]
+    _consensus = { every = 100, burst = 50, period = "1s" }
+`
+	assert.NoError(t, util.WriteFile(path, []byte(data)))
+	conf, err := LoadFromFile(path, true, DefaultConfigMainnet())
+	assert.NoError(t, err)
+	assert.NoError(t, conf.BasicCheck())
+
+	sampling := conf.Logger.Sampling["_consensus"]
+	assert.Equal(t, uint32(100), sampling.Every)
+	assert.Equal(t, uint32(50), sampling.Burst)
+	assert.Equal(t, time.Second, sampling.Period)
+}
+
 func TestExampleConfig(t *testing.T) {
 	lines := strings.Split(string(exampleConfigBytes), "\n")
 	exampleToml := ""
@@ -175,8 +223,40 @@ func TestNodeConfigBasicCheck(t *testing.T) {
 		assert.Error(t, conf.BasicCheck())
 	})
 
+	t.Run("invalid remote signer", func(t *testing.T) {
+		conf := DefaultNodeConfig()
+		conf.RemoteSigners = []consensus.RemoteSignerConfig{
+			{PublicKey: "invalid", URL: "http://127.0.0.1:9090/sign", TokenFile: "signer_token"},
+		}
+
+		assert.Error(t, conf.BasicCheck())
+	})
+
+	t.Run("duplicated remote signers", func(t *testing.T) {
+		pubKey, _ := ts.RandBLSKeyPair()
+		conf := DefaultNodeConfig()
+		conf.RemoteSigners = []consensus.RemoteSignerConfig{
+			{PublicKey: pubKey.String(), URL: "http://127.0.0.1:9090/sign", TokenFile: "signer_token"},
+			{PublicKey: pubKey.String(), URL: "http://127.0.0.1:9091/sign", TokenFile: "signer_token"},
+		}
+
+		assert.Error(t, conf.BasicCheck())
+	})
+
+	t.Run("too long participation report interval", func(t *testing.T) {
+		conf := DefaultNodeConfig()
+		conf.ParticipationReportInterval = state.MaxParticipationRange + 1
+
+		assert.Error(t, conf.BasicCheck())
+	})
+
 	t.Run("ok", func(t *testing.T) {
 		conf := DefaultNodeConfig()
+		conf.ParticipationReportInterval = 8640
+		pubKey, _ := ts.RandBLSKeyPair()
+		conf.RemoteSigners = []consensus.RemoteSignerConfig{
+			{PublicKey: pubKey.String(), URL: "https://signer.example.com/sign", TokenFile: "signer_token"},
+		}
 		conf.RewardAddresses = []string{
 			ts.RandAccAddress().String(),
 			ts.RandAccAddress().String(),
@@ -192,3 +272,86 @@ func TestNodeConfigBasicCheck(t *testing.T) {
 		assert.NoError(t, conf.BasicCheck())
 	})
 }
+
+func TestLoadRemoteSigners(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	pubKey, _ := ts.RandBLSKeyPair()
+	rewardAddr := ts.RandAccAddress().String()
+	path := util.TempFilePath()
+	data := "[node]\n" +
+		"[[node.remote_signers]]\n" +
+		"public_key = \"" + pubKey.String() + "\"\n" +
+		"reward_address = \"" + rewardAddr + "\"\n" +
+		"url = \"https://signer.example.com/sign\"\n" +
+		"token_file = \"signer_token\"\n" +
+		"ca_file = \"signer_ca.pem\"\n" +
+		"timeout = \"3s\"\n"
+	assert.NoError(t, util.WriteFile(path, []byte(data)))
+
+	conf, err := LoadFromFile(path, true, DefaultConfigMainnet())
+	assert.NoError(t, err)
+	assert.Equal(t, []consensus.RemoteSignerConfig{
+		{
+			PublicKey:     pubKey.String(),
+			RewardAddress: rewardAddr,
+			URL:           "https://signer.example.com/sign",
+			TokenFile:     "signer_token",
+			CAFile:        "signer_ca.pem",
+			Timeout:       3 * time.Second,
+		},
+	}, conf.Node.RemoteSigners)
+}
+
+func TestMemoryConfigBasicCheck(t *testing.T) {
+	t.Run("default config, Ok", func(t *testing.T) {
+		conf := DefaultMemoryConfig()
+
+		assert.NoError(t, conf.BasicCheck())
+	})
+
+	t.Run("negative limit", func(t *testing.T) {
+		conf := DefaultMemoryConfig()
+		conf.LimitMB = -1
+
+		assert.Error(t, conf.BasicCheck())
+	})
+
+	t.Run("negative ballast", func(t *testing.T) {
+		conf := DefaultMemoryConfig()
+		conf.BallastMB = -1
+
+		assert.Error(t, conf.BasicCheck())
+	})
+
+	t.Run("invalid gc percent", func(t *testing.T) {
+		conf := DefaultMemoryConfig()
+		conf.GCPercent = -2
+
+		assert.Error(t, conf.BasicCheck())
+	})
+
+	t.Run("disabling gc without memory limit", func(t *testing.T) {
+		conf := DefaultMemoryConfig()
+		conf.GCPercent = -1
+
+		assert.Error(t, conf.BasicCheck())
+	})
+
+	t.Run("ballast is greater than the limit", func(t *testing.T) {
+		conf := DefaultMemoryConfig()
+		conf.LimitMB = 512
+		conf.BallastMB = 512
+
+		assert.Error(t, conf.BasicCheck())
+	})
+
+	t.Run("ok", func(t *testing.T) {
+		conf := DefaultMemoryConfig()
+		conf.LimitMB = 1024
+		conf.GCPercent = -1
+		conf.BallastMB = 128
+
+		assert.NoError(t, conf.BasicCheck())
+	})
+}
@@ -0,0 +1,234 @@
+package config
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/pactus-project/pactus/util"
+	"github.com/pactus-project/pactus/util/deprecation"
+	"github.com/pactus-project/pactus/util/errors"
+	"github.com/pelletier/go-toml"
+)
+
+// CurrentVersion is the version of the config schema.
+// Renaming or removing a key of the config needs a new version and a migration for it.
+const CurrentVersion = 2
+
+// KeyChange describes a key of the config file that is renamed or removed by a migration.
+type KeyChange struct {
+	Key string
+	// NewKey is empty if the key is removed.
+	NewKey string
+}
+
+func (c KeyChange) String() string {
+	if c.NewKey == "" {
+		return fmt.Sprintf("`%s` is removed", c.Key)
+	}
+
+	return fmt.Sprintf("`%s` is renamed to `%s`", c.Key, c.NewKey)
+}
+
+// MigrationReport reports how a config file is migrated to the current version.
+type MigrationReport struct {
+	FromVersion int
+	ToVersion   int
+	BackupPath  string
+	Changes     []KeyChange
+}
+
+// migration transforms the config of the previous version to its version.
+type migration struct {
+	version int
+	// since is the release that deprecated the keys.
+	since   string
+	changes []KeyChange
+}
+
+// migrations should be sorted by version, and the last one should be the current version.
+var migrations = []migration{
+	{
+		// The config files before versioning, with the network keys of the early releases.
+		version: 1,
+		since:   "1.1.0",
+		changes: []KeyChange{
+			{Key: "network.name"},
+			{Key: "network.listens", NewKey: "network.listen_addrs"},
+			{Key: "network.public_address", NewKey: "network.public_addr"},
+			{Key: "network.relay_addresses"},
+			{Key: "network.max_conns", NewKey: "network.max_connections"},
+			{Key: "network.enable_nat", NewKey: "network.enable_nat_service"},
+			{Key: "network.bootstrap.addresses", NewKey: "network.bootstrap_addrs"},
+			{Key: "network.bootstrap.min_threshold"},
+			{Key: "network.bootstrap.max_threshold"},
+		},
+	},
+	{
+		// The block time tolerance is a consensus parameter now.
+		version: 2,
+		since:   "1.1.0",
+		changes: []KeyChange{
+			{Key: "state.block_time_tolerance"},
+		},
+	},
+}
+
+func init() {
+	for _, m := range migrations {
+		for _, change := range m.changes {
+			deprecation.Register(change.deprecation(m.since))
+		}
+	}
+}
+
+func (c KeyChange) deprecation(since string) deprecation.Deprecation {
+	return deprecation.Deprecation{
+		Kind:        deprecation.KindConfig,
+		Name:        c.Key,
+		Replacement: c.NewKey,
+		Since:       since,
+	}
+}
+
+// BackupPath returns a path to back up the config file before changing it.
+func BackupPath(path string) string {
+	return fmt.Sprintf("%v_bak_%s", path, time.Now().Format("2006-01-02T15-04-05"))
+}
+
+// MigrateFile migrates the config file to the current version.
+// The original file is backed up before writing the migrated one.
+// It returns nil if the config file is already in the current version.
+func MigrateFile(path string) (*MigrationReport, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	migrated, report, err := migrate(data)
+	if err != nil {
+		return nil, err
+	}
+	if report == nil {
+		return nil, nil
+	}
+
+	report.BackupPath = BackupPath(path)
+	if err := util.WriteFile(report.BackupPath, data); err != nil {
+		return nil, err
+	}
+	if err := util.WriteFile(path, migrated); err != nil {
+		return nil, err
+	}
+
+	return report, nil
+}
+
+func migrate(data []byte) ([]byte, *MigrationReport, error) {
+	tree, err := toml.LoadBytes(data)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	version := 0
+	if tree.Has("version") {
+		val, ok := tree.Get("version").(int64)
+		if !ok {
+			return nil, nil, errors.Errorf(errors.ErrInvalidConfig, "invalid config version: %v", tree.Get("version"))
+		}
+		version = int(val)
+	}
+
+	if version > CurrentVersion {
+		return nil, nil, errors.Errorf(errors.ErrInvalidConfig,
+			"config version %d is not supported, the latest supported version is %d", version, CurrentVersion)
+	}
+	if version == CurrentVersion {
+		return nil, nil, nil
+	}
+
+	report := &MigrationReport{
+		FromVersion: version,
+		ToVersion:   CurrentVersion,
+		Changes:     make([]KeyChange, 0),
+	}
+	for _, m := range migrations {
+		if m.version <= version {
+			continue
+		}
+
+		for _, change := range m.changes {
+			if applyChange(tree, change) {
+				deprecation.Use(change.deprecation(m.since))
+				report.Changes = append(report.Changes, change)
+			}
+		}
+	}
+
+	if len(report.Changes) == 0 {
+		// Keep the comments of the file, by only setting the version.
+		return setVersion(data, tree.Has("version")), report, nil
+	}
+
+	tree.Set("version", int64(CurrentVersion))
+	migrated, err := tree.Marshal()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return migrated, report, nil
+}
+
+// applyChange renames or removes the key in the tree, and returns false if the key doesn't exist.
+// The value of a renamed key is dropped if the new key is already set.
+func applyChange(tree *toml.Tree, change KeyChange) bool {
+	if !tree.Has(change.Key) {
+		return false
+	}
+
+	value := tree.Get(change.Key)
+	if err := tree.Delete(change.Key); err != nil {
+		return false
+	}
+	if change.NewKey != "" && !tree.Has(change.NewKey) {
+		tree.Set(change.NewKey, value)
+	}
+
+	// Remove the parent table if it is empty now.
+	if idx := strings.LastIndex(change.Key, "."); idx > 0 {
+		parent := change.Key[:idx]
+		if sub, ok := tree.Get(parent).(*toml.Tree); ok && len(sub.Keys()) == 0 {
+			_ = tree.Delete(parent)
+		}
+	}
+
+	return true
+}
+
+// setVersion sets the version key at the top of the config file, without touching the rest of it.
+func setVersion(data []byte, hasVersion bool) []byte {
+	versionLine := fmt.Sprintf("version = %d", CurrentVersion)
+	lines := bytes.Split(data, []byte("\n"))
+	if hasVersion {
+		// The top-level keys are defined before the first table.
+		for i, line := range lines {
+			trimmed := strings.TrimSpace(string(line))
+			if strings.HasPrefix(trimmed, "[") {
+				break
+			}
+
+			key, _, found := strings.Cut(trimmed, "=")
+			if found && strings.TrimSpace(key) == "version" {
+				lines[i] = []byte(versionLine)
+
+				break
+			}
+		}
+
+		return bytes.Join(lines, []byte("\n"))
+	}
+
+	return append([]byte(versionLine+"\n\n"), data...)
+}
@@ -0,0 +1,167 @@
+package config
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/pactus-project/pactus/util"
+	"github.com/pactus-project/pactus/util/deprecation"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeConfigFile(t *testing.T, data string) string {
+	t.Helper()
+
+	path := util.TempFilePath()
+	require.NoError(t, util.WriteFile(path, []byte(data)))
+
+	return path
+}
+
+func TestMigrateCurrentVersion(t *testing.T) {
+	path := util.TempFilePath()
+	require.NoError(t, SaveMainnetConfig(path))
+
+	report, err := MigrateFile(path)
+	assert.NoError(t, err)
+	assert.Nil(t, report)
+
+	data, _ := util.ReadFile(path)
+	assert.Equal(t, exampleConfigBytes, data)
+}
+
+func TestMigrateNewerVersion(t *testing.T) {
+	path := writeConfigFile(t, "version = 100\n")
+
+	_, err := MigrateFile(path)
+	assert.Error(t, err)
+
+	data, _ := util.ReadFile(path)
+	assert.Equal(t, "version = 100\n", string(data))
+}
+
+func TestMigrateInvalidVersion(t *testing.T) {
+	path := writeConfigFile(t, "version = \"one\"\n")
+
+	_, err := MigrateFile(path)
+	assert.Error(t, err)
+}
+
+func TestMigrateUnversionedConfig(t *testing.T) {
+	// The example config of the releases before versioning.
+	oldData := strings.Replace(string(exampleConfigBytes), "version = 2\n", "", 1)
+	path := writeConfigFile(t, oldData)
+
+	report, err := MigrateFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, 0, report.FromVersion)
+	assert.Equal(t, CurrentVersion, report.ToVersion)
+	assert.Empty(t, report.Changes)
+
+	backup, _ := util.ReadFile(report.BackupPath)
+	assert.Equal(t, oldData, string(backup))
+
+	// Comments are kept
+	data, _ := util.ReadFile(path)
+	assert.True(t, strings.HasPrefix(string(data), "version = 2\n"))
+	assert.Contains(t, string(data), "# `node` contains configuration options for the Pactus node.")
+
+	conf, err := LoadFromFile(path, true, DefaultConfigMainnet())
+	require.NoError(t, err)
+	oldConf, err := LoadFromFile(report.BackupPath, true, DefaultConfigMainnet())
+	require.NoError(t, err)
+	assert.Equal(t, oldConf, conf)
+}
+
+func TestMigrateLegacyKeys(t *testing.T) {
+	path := writeConfigFile(t, `
+[network]
+  name = "pactus"
+  listens = ["/ip4/0.0.0.0/tcp/21888"]
+  public_address = "/ip4/1.2.3.4/tcp/21888"
+  max_conns = 32
+  enable_mdns = true
+  [network.bootstrap]
+    addresses = ["/ip4/5.6.7.8/tcp/21888/p2p/12D3KooWCwQZt8UriVXobQHPXPR8m83eceXVoeT6brPNiBHomebc"]
+    min_threshold = 8
+    max_threshold = 16
+
+[tx_pool]
+  max_size = 1000
+`)
+
+	report, err := MigrateFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, 0, report.FromVersion)
+	assert.Equal(t, []KeyChange{
+		{Key: "network.name"},
+		{Key: "network.listens", NewKey: "network.listen_addrs"},
+		{Key: "network.public_address", NewKey: "network.public_addr"},
+		{Key: "network.max_conns", NewKey: "network.max_connections"},
+		{Key: "network.bootstrap.addresses", NewKey: "network.bootstrap_addrs"},
+		{Key: "network.bootstrap.min_threshold"},
+		{Key: "network.bootstrap.max_threshold"},
+	}, report.Changes)
+	assert.Equal(t, "`network.name` is removed", report.Changes[0].String())
+	assert.Equal(t, "`network.listens` is renamed to `network.listen_addrs`", report.Changes[1].String())
+
+	used := deprecation.List(true)
+	assert.Contains(t, used, deprecation.Entry{
+		Deprecation: deprecation.Deprecation{
+			Kind:        deprecation.KindConfig,
+			Name:        "network.listens",
+			Replacement: "network.listen_addrs",
+			Since:       "1.1.0",
+		},
+		UseCount: 1,
+	})
+
+	conf, err := LoadFromFile(path, true, DefaultConfigMainnet())
+	require.NoError(t, err)
+	assert.Equal(t, CurrentVersion, conf.Version)
+	assert.Equal(t, []string{"/ip4/0.0.0.0/tcp/21888"}, conf.Network.ListenAddrStrings)
+	assert.Equal(t, "/ip4/1.2.3.4/tcp/21888", conf.Network.PublicAddrString)
+	assert.Equal(t, 32, conf.Network.MaxConns)
+	assert.True(t, conf.Network.EnableMdns)
+	assert.Len(t, conf.Network.BootstrapAddrStrings, 1)
+	assert.Equal(t, 1000, conf.TxPool.MaxSize)
+
+	// Migrating again does nothing
+	report, err = MigrateFile(path)
+	assert.NoError(t, err)
+	assert.Nil(t, report)
+}
+
+func TestMigrateRenamedKeyAlreadySet(t *testing.T) {
+	path := writeConfigFile(t, `
+[network]
+  max_conns = 32
+  max_connections = 16
+`)
+
+	report, err := MigrateFile(path)
+	require.NoError(t, err)
+	assert.Len(t, report.Changes, 1)
+
+	conf, err := LoadFromFile(path, true, DefaultConfigMainnet())
+	require.NoError(t, err)
+	assert.Equal(t, 16, conf.Network.MaxConns)
+}
+
+func TestMigrateRemovedStateKey(t *testing.T) {
+	path := writeConfigFile(t, `version = 1
+
+[state]
+  block_time_tolerance = "5s"
+`)
+
+	report, err := MigrateFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, 1, report.FromVersion)
+	assert.Equal(t, []KeyChange{{Key: "state.block_time_tolerance"}}, report.Changes)
+
+	conf, err := LoadFromFile(path, true, DefaultConfigMainnet())
+	require.NoError(t, err)
+	assert.Equal(t, CurrentVersion, conf.Version)
+}
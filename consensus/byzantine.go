@@ -0,0 +1,119 @@
+package consensus
+
+import (
+	"time"
+
+	"github.com/pactus-project/pactus/crypto/hash"
+	"github.com/pactus-project/pactus/sync/bundle/message"
+	"github.com/pactus-project/pactus/types/proposal"
+	"github.com/pactus-project/pactus/types/vote"
+	"github.com/pactus-project/pactus/util"
+)
+
+// ByzantineConfig defines the faults that a validator injects into the consensus.
+// It helps to exercise the evidence and liveness paths on test networks.
+// The node refuses to start on the Mainnet if any of these faults are enabled.
+type ByzantineConfig struct {
+	// Equivocate signs and broadcasts a conflicting vote for every prepare and precommit vote.
+	Equivocate bool `toml:"-"`
+	// WithholdProposal creates the proposal but never broadcasts it.
+	WithholdProposal bool `toml:"-"`
+	// DelayVotes delays broadcasting the votes.
+	DelayVotes time.Duration `toml:"-"`
+	// StaleRounds broadcasts the prepare and precommit votes for an earlier round.
+	StaleRounds int16 `toml:"-"`
+}
+
+// IsEnabled returns true if any fault is enabled.
+func (conf *ByzantineConfig) IsEnabled() bool {
+	return conf.Equivocate ||
+		conf.WithholdProposal ||
+		conf.DelayVotes > 0 ||
+		conf.StaleRounds > 0
+}
+
+func (cs *consensus) byzantine() *ByzantineConfig {
+	return &cs.config.Byzantine
+}
+
+// shouldWithholdProposal returns true if the proposal should not be broadcasted.
+func (cs *consensus) shouldWithholdProposal(p *proposal.Proposal) bool {
+	if cs.byzantine().WithholdProposal {
+		cs.logger.Warn("byzantine: withholding proposal", "proposal", p)
+
+		return true
+	}
+
+	return false
+}
+
+// byzantineVotes returns the votes that should be broadcasted instead of our vote.
+func (cs *consensus) byzantineVotes(v *vote.Vote) []*vote.Vote {
+	conf := cs.byzantine()
+	if v.IsCPVote() {
+		return []*vote.Vote{v}
+	}
+
+	if conf.StaleRounds > 0 {
+		staleRound := util.Max(v.Round()-conf.StaleRounds, 0)
+		cs.logger.Warn("byzantine: sending vote for stale round",
+			"vote", v, "stale round", staleRound)
+
+		if staleVote := cs.signBlockVote(v.Type(), v.BlockHash(), v.Height(), staleRound); staleVote != nil {
+			v = staleVote
+		}
+	}
+
+	votes := []*vote.Vote{v}
+	if conf.Equivocate {
+		conflictingHash := hash.CalcHash(v.BlockHash().Bytes())
+		conflictingVote := cs.signBlockVote(v.Type(), conflictingHash, v.Height(), v.Round())
+		if conflictingVote == nil {
+			return votes
+		}
+		cs.logger.Warn("byzantine: equivocating vote",
+			"vote", v, "conflicting vote", conflictingVote)
+
+		votes = append(votes, conflictingVote)
+	}
+
+	return votes
+}
+
+func (cs *consensus) signBlockVote(voteType vote.Type, h hash.Hash, height uint32, round int16) *vote.Vote {
+	var v *vote.Vote
+	if voteType == vote.VoteTypePrepare {
+		v = vote.NewPrepareVote(h, height, round, cs.valAddr)
+	} else {
+		v = vote.NewPrecommitVote(h, height, round, cs.valAddr)
+	}
+	sig, err := cs.signer.Sign(v.SignBytes())
+	if err != nil {
+		cs.logger.Error("byzantine: unable to sign the vote", "error", err, "vote", v)
+
+		return nil
+	}
+	v.SetSignature(sig)
+
+	return v
+}
+
+// broadcastByzantineVote broadcasts our vote, injecting the configured faults.
+func (cs *consensus) broadcastByzantineVote(v *vote.Vote) {
+	votes := cs.byzantineVotes(v)
+	broadcast := func() {
+		for _, v := range votes {
+			cs.broadcaster(cs.valAddr, message.NewVoteMessage(v))
+		}
+	}
+
+	delay := cs.byzantine().DelayVotes
+	if delay > 0 {
+		cs.logger.Warn("byzantine: delaying votes", "vote", v, "delay", delay)
+		time.AfterFunc(delay, broadcast)
+
+		return
+	}
+
+	broadcast()
+}
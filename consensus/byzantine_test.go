@@ -0,0 +1,130 @@
+package consensus
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pactus-project/pactus/crypto/bls"
+	"github.com/pactus-project/pactus/state"
+	"github.com/pactus-project/pactus/store"
+	"github.com/pactus-project/pactus/sync/bundle/message"
+	"github.com/pactus-project/pactus/types/vote"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestByzantineConfigIsEnabled(t *testing.T) {
+	conf := ByzantineConfig{}
+	assert.False(t, conf.IsEnabled())
+
+	conf.Equivocate = true
+	assert.True(t, conf.IsEnabled())
+
+	conf = ByzantineConfig{WithholdProposal: true}
+	assert.True(t, conf.IsEnabled())
+
+	conf = ByzantineConfig{DelayVotes: time.Second}
+	assert.True(t, conf.IsEnabled())
+
+	conf = ByzantineConfig{StaleRounds: 1}
+	assert.True(t, conf.IsEnabled())
+}
+
+func TestByzantineWithholdProposal(t *testing.T) {
+	td := setup(t)
+
+	td.consB.config.Byzantine.WithholdProposal = true
+	td.enterNewHeight(td.consB)
+
+	prop := td.makeProposal(t, 1, 0)
+	td.consB.broadcastProposal(prop)
+
+	td.shouldNotPublish(t, td.consB, message.TypeProposal)
+}
+
+func TestByzantineEquivocate(t *testing.T) {
+	td := setup(t)
+
+	td.consB.config.Byzantine.Equivocate = true
+	td.enterNewHeight(td.consB)
+	td.enterNewHeight(td.consX)
+
+	h := td.RandHash()
+	td.consB.signAddPrepareVote(h)
+
+	honestVote := td.shouldPublishVote(t, td.consB, vote.VoteTypePrepare, h)
+	var conflictingVote *vote.Vote
+	for _, consMsg := range td.consMessages {
+		if consMsg.sender == td.consB.valAddr &&
+			consMsg.message.Type() == message.TypeVote {
+			v := consMsg.message.(*message.VoteMessage).Vote
+			if v.BlockHash() != h {
+				conflictingVote = v
+			}
+		}
+	}
+	require.NotNil(t, conflictingVote)
+	assert.Equal(t, honestVote.Type(), conflictingVote.Type())
+	assert.Equal(t, honestVote.Height(), conflictingVote.Height())
+	assert.Equal(t, honestVote.Round(), conflictingVote.Round())
+	assert.NoError(t, conflictingVote.Verify(td.consB.signer.PublicKey()))
+
+	// The honest validator should detect the conflicting vote
+	td.consX.AddVote(honestVote)
+	td.consX.AddVote(conflictingVote)
+	assert.True(t, td.consX.HasVote(honestVote.Hash()))
+	assert.False(t, td.consX.HasVote(conflictingVote.Hash()))
+}
+
+func TestByzantineStaleRounds(t *testing.T) {
+	td := setup(t)
+
+	td.consB.config.Byzantine.StaleRounds = 1
+	td.enterNewHeight(td.consB)
+	td.enterNextRound(td.consB)
+	td.enterNextRound(td.consB)
+
+	h := td.RandHash()
+	td.consB.signAddPrecommitVote(h)
+
+	v := td.shouldPublishVote(t, td.consB, vote.VoteTypePrecommit, h)
+	assert.Equal(t, int16(1), v.Round())
+
+	// Our own log keeps the vote for the current round
+	assert.False(t, td.consB.HasVote(v.Hash()))
+}
+
+func TestByzantineDelayVotes(t *testing.T) {
+	td := setup(t)
+
+	valKey := td.valKeys[tIndexB]
+	st, _ := state.LoadOrNewState(state.DefaultConfig(), td.genDoc, []*bls.ValidatorKey{valKey},
+		store.MockingStore(td.TestSuite), td.txPool, nil)
+	conf := testConfig()
+	conf.Byzantine.DelayVotes = 100 * time.Millisecond
+	broadcastCh := make(chan message.Message, 100)
+	cons := NewConsensus(conf, st, valKey, valKey.Address(), broadcastCh,
+		newConcreteMediator()).(*consensus)
+
+	td.enterNewHeight(cons)
+	start := time.Now()
+	cons.signAddPrepareVote(td.RandHash())
+
+	timeout := time.After(time.Second)
+	for {
+		select {
+		case msg := <-broadcastCh:
+			if msg.Type() != message.TypeVote {
+				continue
+			}
+			assert.GreaterOrEqual(t, time.Since(start), conf.Byzantine.DelayVotes)
+
+			return
+
+		case <-timeout:
+			require.Fail(t, "vote is not broadcasted")
+
+			return
+		}
+	}
+}
@@ -6,6 +6,9 @@ type Config struct {
 	ChangeProposerTimeout    time.Duration `toml:"-"`
 	ChangeProposerDelta      time.Duration `toml:"-"`
 	MinimumAvailabilityScore float64       `toml:"-"`
+
+	// Byzantine injects faults into the consensus. For test networks only.
+	Byzantine ByzantineConfig `toml:"-"`
 }
 
 func DefaultConfig() *Config {
@@ -33,6 +36,16 @@ func (conf *Config) BasicCheck() error {
 			Reason: "minimum availability score can't be negative or more than 1",
 		}
 	}
+	if conf.Byzantine.DelayVotes < 0 {
+		return ConfigError{
+			Reason: "byzantine vote delay can't be negative",
+		}
+	}
+	if conf.Byzantine.StaleRounds < 0 {
+		return ConfigError{
+			Reason: "byzantine stale rounds can't be negative",
+		}
+	}
 
 	return nil
 }
@@ -29,6 +29,14 @@ func TestDefaultConfigCheck(t *testing.T) {
 
 	c5.MinimumAvailabilityScore = -0.8
 	assert.ErrorIs(t, c5.BasicCheck(), ConfigError{Reason: "minimum availability score can't be negative or more than 1"})
+
+	c6 := DefaultConfig()
+	c6.Byzantine.DelayVotes = -1 * time.Second
+	assert.ErrorIs(t, c6.BasicCheck(), ConfigError{Reason: "byzantine vote delay can't be negative"})
+
+	c7 := DefaultConfig()
+	c7.Byzantine.StaleRounds = -1
+	assert.ErrorIs(t, c7.BasicCheck(), ConfigError{Reason: "byzantine stale rounds can't be negative"})
 }
 
 func TestCalculateChangeProposerTimeout(t *testing.T) {
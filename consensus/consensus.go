@@ -1,6 +1,7 @@
 package consensus
 
 import (
+	"bytes"
 	"fmt"
 	"sync"
 	"time"
@@ -17,7 +18,10 @@ import (
 	"github.com/pactus-project/pactus/types/validator"
 	"github.com/pactus-project/pactus/types/vote"
 	"github.com/pactus-project/pactus/util"
+	"github.com/pactus-project/pactus/util/audit"
 	"github.com/pactus-project/pactus/util/logger"
+	"github.com/pactus-project/pactus/util/txtrace"
+	"golang.org/x/exp/slices"
 )
 
 type broadcaster func(crypto.Address, message.Message)
@@ -34,7 +38,8 @@ type consensus struct {
 	height          uint32
 	round           int16
 	cpRound         int16
-	valKey          *bls.ValidatorKey
+	signer          bls.Signer
+	valAddr         crypto.Address // The validator address of the signer
 	rewardAddr      crypto.Address
 	bcState         state.Facade // Blockchain state
 	changeProposer  *changeProposer
@@ -50,6 +55,10 @@ type consensus struct {
 	broadcaster     broadcaster
 	mediator        mediator
 	active          bool
+	safeMode        bool
+	stats           Stats
+	trackedHeight   uint32 // The height of the last certificate that is tracked for the availability
+	equivocations   []*ProposalEquivocation
 }
 
 func NewConsensus(
@@ -65,13 +74,13 @@ func NewConsensus(
 	}
 
 	return newConsensus(conf, bcState,
-		valKey, rewardAddr, broadcaster, mediator)
+		bls.NewKeySigner(valKey), rewardAddr, broadcaster, mediator)
 }
 
 func newConsensus(
 	conf *Config,
 	bcState state.Facade,
-	valKey *bls.ValidatorKey,
+	signer bls.Signer,
 	rewardAddr crypto.Address,
 	broadcaster broadcaster,
 	mediator mediator,
@@ -80,7 +89,8 @@ func newConsensus(
 		config:      conf,
 		bcState:     bcState,
 		broadcaster: broadcaster,
-		valKey:      valKey,
+		signer:      signer,
+		valAddr:     signer.PublicKey().ValidatorAddress(),
 	}
 
 	// Update height later, See enterNewHeight.
@@ -108,7 +118,7 @@ func newConsensus(
 	mediator.Register(cs)
 
 	logger.Info("consensus instance created",
-		"validator address", valKey.Address().String(),
+		"validator address", signer.PublicKey().ValidatorAddress().String(),
 		"reward address", rewardAddr.String())
 
 	return cs
@@ -127,7 +137,7 @@ func (cs *consensus) Start() {
 
 func (cs *consensus) String() string {
 	return fmt.Sprintf("{%s %d/%d/%s/%d}",
-		cs.valKey.Address().ShortString(),
+		cs.valAddr.ShortString(),
 		cs.height, cs.round, cs.currentState.name(), cs.cpRound)
 }
 
@@ -135,7 +145,7 @@ func (cs *consensus) ConsensusKey() *bls.PublicKey {
 	cs.lk.RLock()
 	defer cs.lk.RUnlock()
 
-	return cs.valKey.PublicKey()
+	return cs.signer.PublicKey()
 }
 
 func (cs *consensus) HeightRound() (uint32, int16) {
@@ -221,15 +231,20 @@ func (cs *consensus) SetProposal(p *proposal.Proposal) {
 		return
 	}
 
-	if p.Round() < cs.round {
-		cs.logger.Trace("expired round", "proposal", p)
+	roundProposal := cs.log.RoundProposal(p.Round())
+	if roundProposal != nil {
+		if !roundProposal.IsForBlock(p.Block().Hash()) {
+			cs.handleConflictingProposal(roundProposal, p)
+
+			return
+		}
+		cs.logger.Trace("this round has proposal", "proposal", p)
 
 		return
 	}
 
-	roundProposal := cs.log.RoundProposal(p.Round())
-	if roundProposal != nil {
-		cs.logger.Trace("this round has proposal", "proposal", p)
+	if p.Round() < cs.round {
+		cs.logger.Trace("expired round", "proposal", p)
 
 		return
 	}
@@ -262,6 +277,7 @@ func (cs *consensus) SetProposal(p *proposal.Proposal) {
 
 	cs.logger.Info("proposal set", "proposal", p)
 	cs.log.SetRoundProposal(p.Round(), p)
+	traceProposed(p)
 
 	cs.currentState.onSetProposal(p)
 }
@@ -317,23 +333,61 @@ func (cs *consensus) AddVote(v *vote.Vote) {
 	if added {
 		cs.logger.Info("new vote added", "vote", v)
 
+		if v.Round() > cs.round && cs.canJumpToRound(v.Round()) {
+			cs.jumpToRound(v.Round())
+
+			return
+		}
+
 		cs.currentState.onAddVote(v)
 	}
 }
 
+// canJumpToRound checks if the consensus can skip the intermediate rounds and
+// jump directly to the given future round.
+// Honest validators only prepare a block in a round after the change-proposer phase
+// of the previous rounds is decided, so a quorum of prepare votes in a future round
+// means the network has already moved to that round.
+func (cs *consensus) canJumpToRound(round int16) bool {
+	if cs.currentState == cs.commitState {
+		return false
+	}
+
+	return cs.log.PrepareVoteSet(round).HasQuorumHash()
+}
+
+// jumpToRound moves the consensus to the given future round, without going through
+// the intermediate rounds. It helps the validators that are recovering from an outage.
+func (cs *consensus) jumpToRound(round int16) {
+	cs.logger.Info("jumping to a future round", "from", cs.round, "to", round)
+
+	cs.round = round
+	cs.cpRound = 0
+	cs.cpDecided = -1
+	cs.cpWeakValidity = nil
+	cs.enterNewState(cs.prepareState)
+}
+
 func (cs *consensus) proposer(round int16) *validator.Validator {
 	return cs.bcState.Proposer(round)
 }
 
+// traceProposed records that the transactions of the proposal are proposed.
+func traceProposed(p *proposal.Proposal) {
+	for _, trx := range p.Block().Transactions() {
+		txtrace.Record(trx.ID(), txtrace.StageProposed)
+	}
+}
+
 func (cs *consensus) isProposer() bool {
-	return cs.proposer(cs.round).Address() == cs.valKey.Address()
+	return cs.proposer(cs.round).Address() == cs.valAddr
 }
 
 func (cs *consensus) signAddCPPreVote(h hash.Hash,
 	cpRound int16, cpValue vote.CPValue, just vote.Just,
 ) {
 	v := vote.NewCPPreVote(h, cs.height,
-		cs.round, cpRound, cpValue, just, cs.valKey.Address())
+		cs.round, cpRound, cpValue, just, cs.valAddr)
 	cs.signAddVote(v)
 }
 
@@ -341,7 +395,7 @@ func (cs *consensus) signAddCPMainVote(h hash.Hash,
 	cpRound int16, cpValue vote.CPValue, just vote.Just,
 ) {
 	v := vote.NewCPMainVote(h, cs.height, cs.round,
-		cpRound, cpValue, just, cs.valKey.Address())
+		cpRound, cpValue, just, cs.valAddr)
 	cs.signAddVote(v)
 }
 
@@ -349,60 +403,102 @@ func (cs *consensus) signAddCPDecidedVote(h hash.Hash,
 	cpRound int16, cpValue vote.CPValue, just vote.Just,
 ) {
 	v := vote.NewCPDecidedVote(h, cs.height, cs.round,
-		cpRound, cpValue, just, cs.valKey.Address())
+		cpRound, cpValue, just, cs.valAddr)
 	cs.signAddVote(v)
 }
 
 func (cs *consensus) signAddPrepareVote(h hash.Hash) {
-	v := vote.NewPrepareVote(h, cs.height, cs.round, cs.valKey.Address())
+	v := vote.NewPrepareVote(h, cs.height, cs.round, cs.valAddr)
 	cs.signAddVote(v)
 }
 
 func (cs *consensus) signAddPrecommitVote(h hash.Hash) {
-	v := vote.NewPrecommitVote(h, cs.height, cs.round, cs.valKey.Address())
+	v := vote.NewPrecommitVote(h, cs.height, cs.round, cs.valAddr)
 	cs.signAddVote(v)
 }
 
 func (cs *consensus) signAddVote(v *vote.Vote) {
-	sig := cs.valKey.Sign(v.SignBytes())
+	if cs.safeMode {
+		cs.logger.Warn("safe mode is enabled, our vote is not signed", "vote", v)
+
+		return
+	}
+
+	if cs.isRemoteSigner() {
+		cs.signAsync(audit.KindVote, v.Type().String(), v.SignBytes(), func(sig *bls.Signature) {
+			if cs.addSignedVote(v, sig) {
+				cs.currentState.onAddVote(v)
+			}
+		})
+
+		return
+	}
+
+	sig, err := cs.sign(audit.KindVote, v.Type().String(), v.SignBytes())
+	if err != nil {
+		cs.logger.Error("unable to sign our vote", "error", err, "vote", v)
+
+		return
+	}
+	cs.addSignedVote(v, sig)
+}
+
+// addSignedVote adds our signed vote to the log and broadcasts it.
+// It returns true if the vote is added to the log.
+func (cs *consensus) addSignedVote(v *vote.Vote, sig *bls.Signature) bool {
 	v.SetSignature(sig)
+	cs.stats.SignedVotes++
+	signedVotesCounter.WithLabelValues(cs.validatorLabel()).Inc()
 	cs.logger.Info("our vote signed and broadcasted", "vote", v)
 
-	_, err := cs.log.AddVote(v)
+	added, err := cs.log.AddVote(v)
 	if err != nil {
 		cs.logger.Error("error on adding our vote", "error", err, "vote", v)
 	}
 	cs.broadcastVote(v)
+
+	return added
 }
 
 func (cs *consensus) queryProposal() {
-	cs.broadcaster(cs.valKey.Address(),
-		message.NewQueryProposalMessage(cs.height, cs.valKey.Address()))
+	cs.broadcaster(cs.valAddr,
+		message.NewQueryProposalMessage(cs.height, cs.valAddr))
 }
 
 // queryVotes is an anti-entropy mechanism to retrieve missed votes
 // when a validator falls behind the network.
 // However, invoking this method might result in unnecessary bandwidth usage.
 func (cs *consensus) queryVotes() {
-	cs.broadcaster(cs.valKey.Address(),
-		message.NewQueryVotesMessage(cs.height, cs.round, cs.valKey.Address()))
+	cs.broadcaster(cs.valAddr,
+		message.NewQueryVotesMessage(cs.height, cs.round, cs.valAddr))
 }
 
 func (cs *consensus) broadcastProposal(p *proposal.Proposal) {
+	if cs.shouldWithholdProposal(p) {
+		return
+	}
+
 	go cs.mediator.OnPublishProposal(cs, p)
-	cs.broadcaster(cs.valKey.Address(),
+	cs.broadcaster(cs.valAddr,
 		message.NewProposalMessage(p))
 }
 
 func (cs *consensus) broadcastVote(v *vote.Vote) {
 	go cs.mediator.OnPublishVote(cs, v)
-	cs.broadcaster(cs.valKey.Address(),
+
+	if cs.byzantine().IsEnabled() {
+		cs.broadcastByzantineVote(v)
+
+		return
+	}
+
+	cs.broadcaster(cs.valAddr,
 		message.NewVoteMessage(v))
 }
 
 func (cs *consensus) announceNewBlock(blk *block.Block, cert *certificate.Certificate) {
 	go cs.mediator.OnBlockAnnounce(cs)
-	cs.broadcaster(cs.valKey.Address(),
+	cs.broadcaster(cs.valAddr,
 		message.NewBlockAnnounceMessage(blk, cert))
 }
 
@@ -428,6 +524,33 @@ func (cs *consensus) makeCertificate(votes map[crypto.Address]*vote.Vote) *certi
 	return certificate.NewCertificate(cs.height, cs.round, committers, absentees, aggSig)
 }
 
+// SetSafeMode enables or disables the safe mode.
+// In safe mode, the consensus keeps following the other validators,
+// but it doesn't sign any proposal or vote.
+func (cs *consensus) SetSafeMode(enable bool) {
+	cs.lk.Lock()
+	defer cs.lk.Unlock()
+
+	if cs.safeMode == enable {
+		return
+	}
+	cs.safeMode = enable
+
+	if enable {
+		cs.logger.Warn("safe mode is enabled, signing is disabled")
+	} else {
+		cs.logger.Info("safe mode is disabled, signing is enabled")
+	}
+}
+
+// IsSafeMode checks if the consensus is in safe mode.
+func (cs *consensus) IsSafeMode() bool {
+	cs.lk.RLock()
+	defer cs.lk.RUnlock()
+
+	return cs.safeMode
+}
+
 // IsActive checks if the consensus is in an active state and participating in the consensus algorithm.
 func (cs *consensus) IsActive() bool {
 	cs.lk.RLock()
@@ -454,6 +577,11 @@ func (cs *consensus) PickRandomVote(round int16) *vote.Vote {
 		return nil
 	}
 
+	// Sorting votes makes the selection reproducible with a deterministic random source.
+	slices.SortFunc(votes, func(a, b *vote.Vote) int {
+		return bytes.Compare(a.Hash().Bytes(), b.Hash().Bytes())
+	})
+
 	return votes[util.RandInt32(int32(len(votes)))]
 }
 
@@ -73,6 +73,7 @@ func setupWithSeed(t *testing.T, seed int64) *testData {
 	fmt.Printf("=== test %s, seed: %d\n", t.Name(), seed)
 
 	ts := testsuite.NewTestSuiteForSeed(seed)
+	ts.UseDeterministicRand(t)
 
 	_, valKeys := ts.GenerateTestCommittee(4)
 	txPool := txpool.MockingTxPool()
@@ -92,16 +93,16 @@ func setupWithSeed(t *testing.T, seed int64) *testData {
 	// to prevent triggering timers before starting the tests to avoid double entries for new heights in some tests.
 	getTime := util.RoundNow(params.BlockIntervalInSecond).Add(time.Duration(params.BlockIntervalInSecond) * time.Second)
 	genDoc := genesis.MakeGenesis(getTime, accs, vals, params)
-	stX, err := state.LoadOrNewState(genDoc, []*bls.ValidatorKey{valKeys[tIndexX]},
+	stX, err := state.LoadOrNewState(state.DefaultConfig(), genDoc, []*bls.ValidatorKey{valKeys[tIndexX]},
 		store.MockingStore(ts), txPool, nil)
 	require.NoError(t, err)
-	stY, err := state.LoadOrNewState(genDoc, []*bls.ValidatorKey{valKeys[tIndexY]},
+	stY, err := state.LoadOrNewState(state.DefaultConfig(), genDoc, []*bls.ValidatorKey{valKeys[tIndexY]},
 		store.MockingStore(ts), txPool, nil)
 	require.NoError(t, err)
-	stB, err := state.LoadOrNewState(genDoc, []*bls.ValidatorKey{valKeys[tIndexB]},
+	stB, err := state.LoadOrNewState(state.DefaultConfig(), genDoc, []*bls.ValidatorKey{valKeys[tIndexB]},
 		store.MockingStore(ts), txPool, nil)
 	require.NoError(t, err)
-	stP, err := state.LoadOrNewState(genDoc, []*bls.ValidatorKey{valKeys[tIndexP]},
+	stP, err := state.LoadOrNewState(state.DefaultConfig(), genDoc, []*bls.ValidatorKey{valKeys[tIndexP]},
 		store.MockingStore(ts), txPool, nil)
 	require.NoError(t, err)
 
@@ -120,13 +121,13 @@ func setupWithSeed(t *testing.T, seed int64) *testData {
 			message: msg,
 		})
 	}
-	td.consX = newConsensus(testConfig(), stX, valKeys[tIndexX],
+	td.consX = newConsensus(testConfig(), stX, bls.NewKeySigner(valKeys[tIndexX]),
 		valKeys[tIndexX].PublicKey().AccountAddress(), broadcasterFunc, newConcreteMediator())
-	td.consY = newConsensus(testConfig(), stY, valKeys[tIndexY],
+	td.consY = newConsensus(testConfig(), stY, bls.NewKeySigner(valKeys[tIndexY]),
 		valKeys[tIndexY].PublicKey().AccountAddress(), broadcasterFunc, newConcreteMediator())
-	td.consB = newConsensus(testConfig(), stB, valKeys[tIndexB],
+	td.consB = newConsensus(testConfig(), stB, bls.NewKeySigner(valKeys[tIndexB]),
 		valKeys[tIndexB].PublicKey().AccountAddress(), broadcasterFunc, newConcreteMediator())
-	td.consP = newConsensus(testConfig(), stP, valKeys[tIndexP],
+	td.consP = newConsensus(testConfig(), stP, bls.NewKeySigner(valKeys[tIndexP]),
 		valKeys[tIndexP].PublicKey().AccountAddress(), broadcasterFunc, newConcreteMediator())
 
 	// -------------------------------
@@ -151,7 +152,7 @@ func (td *testData) shouldPublishBlockAnnounce(t *testing.T, cons *consensus, h
 	t.Helper()
 
 	for _, consMsg := range td.consMessages {
-		if consMsg.sender == cons.valKey.Address() &&
+		if consMsg.sender == cons.valAddr &&
 			consMsg.message.Type() == message.TypeBlockAnnounce {
 			m := consMsg.message.(*message.BlockAnnounceMessage)
 			assert.Equal(t, m.Block.Hash(), h)
@@ -168,7 +169,7 @@ func (td *testData) shouldPublishProposal(t *testing.T, cons *consensus,
 	t.Helper()
 
 	for _, consMsg := range td.consMessages {
-		if consMsg.sender == cons.valKey.Address() &&
+		if consMsg.sender == cons.valAddr &&
 			consMsg.message.Type() == message.TypeProposal {
 			m := consMsg.message.(*message.ProposalMessage)
 			require.Equal(t, m.Proposal.Height(), height)
@@ -186,7 +187,7 @@ func (td *testData) shouldNotPublish(t *testing.T, cons *consensus, msgType mess
 	t.Helper()
 
 	for _, consMsg := range td.consMessages {
-		if consMsg.sender == cons.valKey.Address() &&
+		if consMsg.sender == cons.valAddr &&
 			consMsg.message.Type() == msgType {
 			require.Error(t, fmt.Errorf("should not public %s", msgType))
 		}
@@ -197,14 +198,14 @@ func (td *testData) shouldPublishQueryProposal(t *testing.T, cons *consensus, he
 	t.Helper()
 
 	for _, consMsg := range td.consMessages {
-		if consMsg.sender != cons.valKey.Address() ||
+		if consMsg.sender != cons.valAddr ||
 			consMsg.message.Type() != message.TypeQueryProposal {
 			continue
 		}
 
 		m := consMsg.message.(*message.QueryProposalMessage)
 		assert.Equal(t, m.Height, height)
-		assert.Equal(t, m.Querier, cons.valKey.Address())
+		assert.Equal(t, m.Querier, cons.valAddr)
 
 		return
 	}
@@ -215,7 +216,7 @@ func (td *testData) shouldPublishQueryVote(t *testing.T, cons *consensus, height
 	t.Helper()
 
 	for _, consMsg := range td.consMessages {
-		if consMsg.sender != cons.valKey.Address() ||
+		if consMsg.sender != cons.valAddr ||
 			consMsg.message.Type() != message.TypeQueryVotes {
 			continue
 		}
@@ -223,7 +224,7 @@ func (td *testData) shouldPublishQueryVote(t *testing.T, cons *consensus, height
 		m := consMsg.message.(*message.QueryVotesMessage)
 		assert.Equal(t, m.Height, height)
 		assert.Equal(t, m.Round, round)
-		assert.Equal(t, m.Querier, cons.valKey.Address())
+		assert.Equal(t, m.Querier, cons.valAddr)
 
 		return
 	}
@@ -235,7 +236,7 @@ func (td *testData) shouldPublishVote(t *testing.T, cons *consensus, voteType vo
 
 	for i := len(td.consMessages) - 1; i >= 0; i-- {
 		consMsg := td.consMessages[i]
-		if consMsg.sender == cons.valKey.Address() &&
+		if consMsg.sender == cons.valAddr &&
 			consMsg.message.Type() == message.TypeVote {
 			m := consMsg.message.(*message.VoteMessage)
 			if m.Vote.Type() == voteType &&
@@ -355,10 +356,10 @@ func (td *testData) commitBlockForAllStates(t *testing.T) (*block.Block, *certif
 	p := td.makeProposal(t, height+1, 0)
 
 	sb := certificate.BlockCertificateSignBytes(p.Block().Hash(), height+1, 0)
-	sig1 := td.consX.valKey.Sign(sb)
-	sig2 := td.consY.valKey.Sign(sb)
-	sig3 := td.consB.valKey.Sign(sb)
-	sig4 := td.consP.valKey.Sign(sb)
+	sig1 := td.valKeys[tIndexX].Sign(sb)
+	sig2 := td.valKeys[tIndexY].Sign(sb)
+	sig3 := td.valKeys[tIndexB].Sign(sb)
+	sig4 := td.valKeys[tIndexP].Sign(sb)
 
 	sig := bls.SignatureAggregate(sig1, sig2, sig3, sig4)
 	cert := certificate.NewCertificate(height+1, 0,
@@ -383,25 +384,25 @@ func (td *testData) makeProposal(t *testing.T, height uint32, round int16) *prop
 	var p *proposal.Proposal
 	switch (height % 4) + uint32(round%4) {
 	case 1:
-		blk, err := td.consX.bcState.ProposeBlock(td.consX.valKey, td.consX.rewardAddr)
+		blk, err := td.consX.bcState.ProposeBlock(bls.NewKeySigner(td.valKeys[tIndexX]), td.consX.rewardAddr)
 		require.NoError(t, err)
 		p = proposal.NewProposal(height, round, blk)
-		td.HelperSignProposal(td.consX.valKey, p)
+		td.HelperSignProposal(td.valKeys[tIndexX], p)
 	case 2:
-		blk, err := td.consY.bcState.ProposeBlock(td.consY.valKey, td.consY.rewardAddr)
+		blk, err := td.consY.bcState.ProposeBlock(bls.NewKeySigner(td.valKeys[tIndexY]), td.consY.rewardAddr)
 		require.NoError(t, err)
 		p = proposal.NewProposal(height, round, blk)
-		td.HelperSignProposal(td.consY.valKey, p)
+		td.HelperSignProposal(td.valKeys[tIndexY], p)
 	case 3:
-		blk, err := td.consB.bcState.ProposeBlock(td.consB.valKey, td.consB.rewardAddr)
+		blk, err := td.consB.bcState.ProposeBlock(bls.NewKeySigner(td.valKeys[tIndexB]), td.consB.rewardAddr)
 		require.NoError(t, err)
 		p = proposal.NewProposal(height, round, blk)
-		td.HelperSignProposal(td.consB.valKey, p)
+		td.HelperSignProposal(td.valKeys[tIndexB], p)
 	case 0, 4:
-		blk, err := td.consP.bcState.ProposeBlock(td.consP.valKey, td.consP.rewardAddr)
+		blk, err := td.consP.bcState.ProposeBlock(bls.NewKeySigner(td.valKeys[tIndexP]), td.consP.rewardAddr)
 		require.NoError(t, err)
 		p = proposal.NewProposal(height, round, blk)
-		td.HelperSignProposal(td.consP.valKey, p)
+		td.HelperSignProposal(td.valKeys[tIndexP], p)
 	}
 
 	return p
@@ -421,7 +422,7 @@ func TestNotInCommittee(t *testing.T) {
 	valKey := td.RandValKey()
 	str := store.MockingStore(td.TestSuite)
 
-	st, _ := state.LoadOrNewState(td.genDoc, []*bls.ValidatorKey{valKey}, str, td.txPool, nil)
+	st, _ := state.LoadOrNewState(state.DefaultConfig(), td.genDoc, []*bls.ValidatorKey{valKey}, str, td.txPool, nil)
 	Cons := NewConsensus(testConfig(), st, valKey, valKey.Address(), make(chan message.Message, 100),
 		newConcreteMediator())
 	cons := Cons.(*consensus)
@@ -470,6 +471,44 @@ func TestConsensusNormalCase(t *testing.T) {
 	td.shouldPublishBlockAnnounce(t, td.consX, p.Block().Hash())
 }
 
+func TestConsensusSafeMode(t *testing.T) {
+	td := setup(t)
+
+	td.commitBlockForAllStates(t) // height 1
+
+	td.consX.SetSafeMode(true)
+	assert.True(t, td.consX.IsSafeMode())
+
+	td.enterNewHeight(td.consX)
+	td.checkHeightRound(t, td.consX, 2, 0)
+
+	p := td.makeProposal(t, 2, 0)
+	td.consX.SetProposal(p)
+
+	td.addPrepareVote(td.consX, p.Block().Hash(), 2, 0, tIndexY)
+	td.addPrepareVote(td.consX, p.Block().Hash(), 2, 0, tIndexB)
+	td.addPrepareVote(td.consX, p.Block().Hash(), 2, 0, tIndexP)
+
+	td.addPrecommitVote(td.consX, p.Block().Hash(), 2, 0, tIndexY)
+	td.addPrecommitVote(td.consX, p.Block().Hash(), 2, 0, tIndexB)
+	td.addPrecommitVote(td.consX, p.Block().Hash(), 2, 0, tIndexP)
+
+	// The block is committed, without signing any vote.
+	td.shouldNotPublish(t, td.consX, message.TypeVote)
+	td.shouldPublishBlockAnnounce(t, td.consX, p.Block().Hash())
+
+	td.consX.SetSafeMode(false)
+	assert.False(t, td.consX.IsSafeMode())
+}
+
+func TestProposeBlockInSafeMode(t *testing.T) {
+	td := setup(t)
+
+	td.consX.SetSafeMode(true)
+	td.enterNewHeight(td.consX)
+	td.shouldNotPublish(t, td.consX, message.TypeProposal)
+}
+
 func TestConsensusAddVote(t *testing.T) {
 	td := setup(t)
 
@@ -600,6 +639,12 @@ func TestPickRandomVote(t *testing.T) {
 
 	assert.NotNil(t, td.consP.PickRandomVote(0))
 
+	// The same seed should pick the same vote
+	td.UseDeterministicRand(t)
+	rndVote := td.consP.PickRandomVote(0)
+	td.UseDeterministicRand(t)
+	assert.Equal(t, rndVote, td.consP.PickRandomVote(0))
+
 	// Round 1
 	td.enterNextRound(td.consP)
 	td.addPrepareVote(td.consP, td.RandHash(), 1, 1, tIndexY)
@@ -652,7 +697,7 @@ func TestDuplicateProposal(t *testing.T) {
 	p1 := td.makeProposal(t, h, r)
 	trx := tx.NewTransferTx(h, td.consX.rewardAddr,
 		td.RandAccAddress(), 1000, 1000, "proposal changer")
-	td.HelperSignTransaction(td.consX.valKey.PrivateKey(), trx)
+	td.HelperSignTransaction(td.valKeys[tIndexX].PrivateKey(), trx)
 
 	assert.NoError(t, td.txPool.AppendTx(trx))
 	p2 := td.makeProposal(t, h, r)
@@ -660,8 +705,36 @@ func TestDuplicateProposal(t *testing.T) {
 
 	td.consX.SetProposal(p1)
 	td.consX.SetProposal(p2)
+	td.consX.SetProposal(p2)
 
 	assert.Equal(t, td.consX.Proposal().Hash(), p1.Hash())
+
+	equivocations := td.consX.ProposalEquivocations()
+	require.Len(t, equivocations, 1)
+	assert.Equal(t, p1.Hash(), equivocations[0].Canonical.Hash())
+	assert.Equal(t, p2.Hash(), equivocations[0].Conflicting.Hash())
+	assert.Equal(t, p1.Block().Header().ProposerAddress(), equivocations[0].Proposer())
+
+	t.Run("Invalid conflicting proposal is not recorded", func(t *testing.T) {
+		p3 := proposal.NewProposal(h, r, p2.Block())
+		td.HelperSignProposal(td.RandValKey(), p3)
+		td.consX.SetProposal(p3)
+
+		assert.Len(t, td.consX.ProposalEquivocations(), 1)
+	})
+
+	t.Run("The prepared proposal replaces the canonical one", func(t *testing.T) {
+		td.shouldPublishVote(t, td.consX, vote.VoteTypePrepare, p1.Block().Hash())
+
+		td.addPrepareVote(td.consX, p2.Block().Hash(), h, r, tIndexY)
+		td.addPrepareVote(td.consX, p2.Block().Hash(), h, r, tIndexB)
+		td.addPrepareVote(td.consX, p2.Block().Hash(), h, r, tIndexP)
+		td.shouldPublishQueryProposal(t, td.consX, h)
+
+		td.consX.SetProposal(p2)
+		assert.Equal(t, td.consX.Proposal().Hash(), p2.Hash())
+		td.shouldPublishVote(t, td.consX, vote.VoteTypePrecommit, p2.Block().Hash())
+	})
 }
 
 func TestNonActiveValidator(t *testing.T) {
@@ -733,6 +806,46 @@ func TestProposalWithBigRound(t *testing.T) {
 	assert.Nil(t, td.consP.Proposal())
 }
 
+func TestJumpToFutureRound(t *testing.T) {
+	td := setup(t)
+
+	td.commitBlockForAllStates(t) // height 1
+
+	td.enterNewHeight(td.consX)
+	td.checkHeightRound(t, td.consX, 2, 0)
+
+	t.Run("No quorum of prepare votes, should not jump", func(t *testing.T) {
+		td.addPrepareVote(td.consX, td.RandHash(), 2, 3, tIndexY)
+		td.addPrepareVote(td.consX, td.RandHash(), 2, 3, tIndexB)
+		td.addPrepareVote(td.consX, td.RandHash(), 2, 3, tIndexP)
+
+		td.addPrecommitVote(td.consX, td.RandHash(), 2, 4, tIndexY)
+		td.addPrecommitVote(td.consX, td.RandHash(), 2, 4, tIndexB)
+		td.addPrecommitVote(td.consX, td.RandHash(), 2, 4, tIndexP)
+
+		td.checkHeightRound(t, td.consX, 2, 0)
+	})
+
+	t.Run("Quorum of prepare votes, should jump to the future round", func(t *testing.T) {
+		p := td.makeProposal(t, 2, 2)
+
+		td.addPrepareVote(td.consX, p.Block().Hash(), 2, 2, tIndexY)
+		td.addPrepareVote(td.consX, p.Block().Hash(), 2, 2, tIndexB)
+		td.checkHeightRound(t, td.consX, 2, 0)
+
+		td.addPrepareVote(td.consX, p.Block().Hash(), 2, 2, tIndexP)
+		td.checkHeightRound(t, td.consX, 2, 2)
+		assert.Equal(t, "precommit", td.consX.currentState.name())
+
+		td.consX.SetProposal(p)
+		td.shouldPublishVote(t, td.consX, vote.VoteTypePrecommit, p.Block().Hash())
+
+		td.addPrecommitVote(td.consX, p.Block().Hash(), 2, 2, tIndexY)
+		td.addPrecommitVote(td.consX, p.Block().Hash(), 2, 2, tIndexB)
+		td.shouldPublishBlockAnnounce(t, td.consX, p.Block().Hash())
+	})
+}
+
 func TestCases(t *testing.T) {
 	tests := []struct {
 		seed        int64
@@ -844,13 +957,13 @@ func TestByzantine(t *testing.T) {
 	// Byzantine node create the second proposal and send it to the partitioned node P
 	byzTrx := tx.NewTransferTx(h,
 		td.consB.rewardAddr, td.RandAccAddress(), 1000, 1000, "")
-	td.HelperSignTransaction(td.consB.valKey.PrivateKey(), byzTrx)
+	td.HelperSignTransaction(td.valKeys[tIndexB].PrivateKey(), byzTrx)
 	assert.NoError(t, td.txPool.AppendTx(byzTrx))
 	p2 := td.makeProposal(t, h, r)
 
 	require.NotEqual(t, p1.Block().Hash(), p2.Block().Hash())
-	require.Equal(t, p1.Block().Header().ProposerAddress(), td.consB.valKey.Address())
-	require.Equal(t, p2.Block().Header().ProposerAddress(), td.consB.valKey.Address())
+	require.Equal(t, p1.Block().Header().ProposerAddress(), td.consB.valAddr)
+	require.Equal(t, p2.Block().Header().ProposerAddress(), td.consB.valAddr)
 
 	td.enterNewHeight(td.consP)
 
@@ -875,7 +988,7 @@ func TestByzantine(t *testing.T) {
 
 	// Let's make Byzantine node happy by removing his votes from the log
 	for j := len(td.consMessages) - 1; j >= 0; j-- {
-		if td.consMessages[j].sender == td.consB.valKey.Address() {
+		if td.consMessages[j].sender == td.consB.valAddr {
 			td.consMessages = slices.Delete(td.consMessages, j, j+1)
 		}
 	}
@@ -935,7 +1048,7 @@ func checkConsensus(td *testData, height uint32, byzVotes []*vote.Vote) (
 				p := cons.Proposal()
 				if p != nil {
 					td.consMessages = append(td.consMessages, consMessage{
-						sender:  cons.valKey.Address(),
+						sender:  cons.valAddr,
 						message: message.NewProposalMessage(p),
 					})
 				}
@@ -0,0 +1,90 @@
+package consensus
+
+import (
+	"time"
+
+	"github.com/pactus-project/pactus/crypto"
+	"github.com/pactus-project/pactus/types/proposal"
+	"github.com/pactus-project/pactus/util"
+)
+
+// maxProposalEquivocations is the number of the recent proposal equivocations that are kept.
+const maxProposalEquivocations = 32
+
+// ProposalEquivocation is the evidence of a proposer that has signed two different
+// proposals for the same height and round.
+type ProposalEquivocation struct {
+	Canonical   *proposal.Proposal // The proposal that was accepted first
+	Conflicting *proposal.Proposal // The other proposal, signed by the same proposer
+	DetectedAt  time.Time
+}
+
+// Proposer returns the address of the validator that has signed both proposals.
+func (e *ProposalEquivocation) Proposer() crypto.Address {
+	return e.Canonical.Block().Header().ProposerAddress()
+}
+
+// ProposalEquivocations returns the recent proposal equivocations, from the oldest to the newest.
+func (cs *consensus) ProposalEquivocations() []*ProposalEquivocation {
+	cs.lk.RLock()
+	defer cs.lk.RUnlock()
+
+	equivocations := make([]*ProposalEquivocation, len(cs.equivocations))
+	copy(equivocations, cs.equivocations)
+
+	return equivocations
+}
+
+// handleConflictingProposal is called when a proposal for a round that already has a proposal is received.
+// If the proposal is signed by the proposer of the round, the equivocation is recorded.
+// The canonical proposal is kept, unless the network has prepared the conflicting one.
+func (cs *consensus) handleConflictingProposal(canonical, p *proposal.Proposal) {
+	proposer := cs.proposer(p.Round())
+	if err := p.Verify(proposer.PublicKey()); err != nil {
+		cs.logger.Debug("conflicting proposal is invalid", "proposal", p, "error", err)
+
+		return
+	}
+
+	cs.recordProposalEquivocation(canonical, p)
+
+	prepareQH := cs.log.PrepareVoteSet(p.Round()).QuorumHash()
+	if prepareQH == nil || !p.IsForBlock(*prepareQH) {
+		return
+	}
+
+	if err := cs.bcState.ValidateBlock(p.Block(), p.Round()); err != nil {
+		cs.logger.Warn("invalid block", "proposal", p, "error", err)
+
+		return
+	}
+
+	cs.logger.Info("replacing the proposal with the prepared one", "proposal", p)
+	cs.log.SetRoundProposal(p.Round(), p)
+	traceProposed(p)
+
+	cs.currentState.onSetProposal(p)
+}
+
+func (cs *consensus) recordProposalEquivocation(canonical, conflicting *proposal.Proposal) {
+	for _, e := range cs.equivocations {
+		if e.Canonical.Hash() == canonical.Hash() &&
+			e.Conflicting.Hash() == conflicting.Hash() {
+			return
+		}
+	}
+
+	cs.logger.Warn("proposal equivocation detected",
+		"proposer", canonical.Block().Header().ProposerAddress(),
+		"canonical", canonical, "conflicting", conflicting)
+
+	if len(cs.equivocations) == maxProposalEquivocations {
+		cs.equivocations = cs.equivocations[1:]
+	}
+	cs.equivocations = append(cs.equivocations, &ProposalEquivocation{
+		Canonical:   canonical,
+		Conflicting: conflicting,
+		DetectedAt:  util.Now(),
+	})
+	proposalEquivocationsCounter.WithLabelValues(cs.validatorLabel()).Inc()
+}
@@ -0,0 +1,129 @@
+package consensus
+
+import (
+	"github.com/pactus-project/pactus/crypto/hash"
+	"github.com/pactus-project/pactus/types/proposal"
+	"github.com/pactus-project/pactus/types/vote"
+)
+
+// Handoff is the live state of the consensus at the current height.
+// It is handed over to the next binary on upgrade, so the new binary resumes the consensus
+// from the same round, instead of starting the height from the first round.
+type Handoff struct {
+	Height    uint32               `cbor:"1,keyasint"`
+	Round     int16                `cbor:"2,keyasint"`
+	Proposals []*proposal.Proposal `cbor:"3,keyasint"`
+	Votes     []*vote.Vote         `cbor:"4,keyasint"`
+}
+
+// Handoff returns the proposals and the votes of the current height, up to the current round.
+// It returns nil if the instance is not in the committee.
+func (cs *consensus) Handoff() *Handoff {
+	cs.lk.RLock()
+	defer cs.lk.RUnlock()
+
+	if !cs.active {
+		return nil
+	}
+
+	h := &Handoff{
+		Height:    cs.height,
+		Round:     cs.round,
+		Proposals: []*proposal.Proposal{},
+		Votes:     []*vote.Vote{},
+	}
+	for r := int16(0); r <= cs.round; r++ {
+		if p := cs.log.RoundProposal(r); p != nil {
+			h.Proposals = append(h.Proposals, p)
+		}
+		h.Votes = append(h.Votes, cs.log.RoundMessages(r).AllVotes()...)
+	}
+
+	return h
+}
+
+// Restore moves the consensus to the current height, restores the proposals and the votes
+// of the handoff, and jumps to the round of the handoff.
+// Our own proposals and votes are restored as well, so we don't sign a conflicting one
+// after the upgrade. The proposals and the votes are verified like the ones received from the peers.
+// It should be called before starting the consensus.
+func (cs *consensus) Restore(h *Handoff) {
+	cs.lk.Lock()
+	defer cs.lk.Unlock()
+
+	cs.moveToNewHeight()
+	if !cs.active {
+		return
+	}
+
+	if h.Height != cs.height {
+		cs.logger.Warn("handoff is not for the current height", "handoff", h.Height)
+
+		return
+	}
+
+	for _, p := range h.Proposals {
+		if p.Height() != cs.height || cs.log.HasRoundProposal(p.Round()) {
+			continue
+		}
+		proposer := cs.proposer(p.Round())
+		if err := p.Verify(proposer.PublicKey()); err != nil {
+			cs.logger.Warn("handed off proposal is invalid", "proposal", p, "error", err)
+
+			continue
+		}
+		cs.log.SetRoundProposal(p.Round(), p)
+	}
+
+	for _, v := range h.Votes {
+		if v.Height() != cs.height {
+			continue
+		}
+		if _, err := cs.log.AddVote(v); err != nil {
+			cs.logger.Warn("handed off vote is invalid", "vote", v, "error", err)
+		}
+	}
+
+	cs.logger.Info("consensus restored from the handoff", "round", h.Round,
+		"proposals", len(h.Proposals), "votes", len(h.Votes))
+
+	if h.Round > cs.round {
+		cs.jumpToRound(h.Round)
+	}
+}
+
+// Handoff returns the live state of the consensus to hand over to the next binary.
+// The votes of all the instances are merged. It returns nil if no instance is in the committee.
+func (mgr *manager) Handoff() *Handoff {
+	var merged *Handoff
+	seen := make(map[hash.Hash]bool)
+	for _, cons := range mgr.instances {
+		h := cons.Handoff()
+		if h == nil {
+			continue
+		}
+
+		if merged == nil {
+			merged = &Handoff{
+				Height:    h.Height,
+				Round:     h.Round,
+				Proposals: h.Proposals,
+				Votes:     []*vote.Vote{},
+			}
+		}
+		for _, v := range h.Votes {
+			if !seen[v.Hash()] {
+				seen[v.Hash()] = true
+				merged.Votes = append(merged.Votes, v)
+			}
+		}
+	}
+
+	return merged
+}
+
+// Restore keeps the handoff of the previous binary to restore the consensus instances on start.
+// It should be called before starting the manager.
+func (mgr *manager) Restore(h *Handoff) {
+	mgr.handoff = h
+}
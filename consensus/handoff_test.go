@@ -0,0 +1,116 @@
+package consensus
+
+import (
+	"testing"
+
+	"github.com/fxamacker/cbor/v2"
+	"github.com/pactus-project/pactus/sync/bundle/message"
+	"github.com/pactus-project/pactus/types/proposal"
+	"github.com/pactus-project/pactus/types/vote"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandoff(t *testing.T) {
+	td := setup(t)
+
+	td.commitBlockForAllStates(t) // height 1
+
+	t.Run("Inactive instance has nothing to hand off", func(t *testing.T) {
+		assert.Nil(t, td.consX.Handoff())
+	})
+
+	td.enterNewHeight(td.consX)
+	td.enterNextRound(td.consX)
+	p := td.makeProposal(t, 2, 1)
+	td.consX.SetProposal(p)
+	prepareX := td.shouldPublishVote(t, td.consX, vote.VoteTypePrepare, p.Block().Hash())
+	prepareY := td.addPrepareVote(td.consX, p.Block().Hash(), 2, 1, tIndexY)
+
+	hnd := td.consX.Handoff()
+	require.NotNil(t, hnd)
+
+	t.Run("Should hand off the proposals and the votes", func(t *testing.T) {
+		assert.Equal(t, uint32(2), hnd.Height)
+		assert.Equal(t, int16(1), hnd.Round)
+		assert.Equal(t, []*proposal.Proposal{p}, hnd.Proposals)
+		assert.Len(t, hnd.Votes, 2)
+	})
+
+	t.Run("Should encode and decode the handoff", func(t *testing.T) {
+		data, err := cbor.Marshal(hnd)
+		require.NoError(t, err)
+
+		decoded := &Handoff{}
+		require.NoError(t, cbor.Unmarshal(data, decoded))
+		assert.Equal(t, hnd.Height, decoded.Height)
+		assert.Equal(t, hnd.Round, decoded.Round)
+		assert.Equal(t, p.Hash(), decoded.Proposals[0].Hash())
+		assert.Len(t, decoded.Votes, 2)
+	})
+
+	t.Run("Should restore the handoff and resume from the same round", func(t *testing.T) {
+		td.consY.Restore(hnd)
+
+		td.checkHeightRound(t, td.consY, 2, 1)
+		assert.True(t, td.consY.HasVote(prepareX.Hash()))
+		assert.True(t, td.consY.HasVote(prepareY.Hash()))
+
+		// Our vote is restored, and it is signed again for the same block.
+		td.shouldPublishVote(t, td.consY, vote.VoteTypePrepare, p.Block().Hash())
+	})
+
+	t.Run("Should ignore the handoff of another height", func(t *testing.T) {
+		td.consB.Restore(&Handoff{Height: 5, Round: 2})
+
+		td.checkHeightRound(t, td.consB, 2, 0)
+	})
+}
+
+func TestHandoffRestoredProposal(t *testing.T) {
+	td := setup(t)
+
+	td.commitBlockForAllStates(t) // height 1
+
+	// consY is the proposer of the first round at height 2.
+	p := td.makeProposal(t, 2, 0)
+	td.consY.Restore(&Handoff{
+		Height:    2,
+		Round:     0,
+		Proposals: []*proposal.Proposal{p},
+	})
+	td.newHeightTimeout(td.consY)
+
+	td.shouldNotPublish(t, td.consY, message.TypeProposal)
+	td.shouldPublishVote(t, td.consY, vote.VoteTypePrepare, p.Block().Hash())
+}
+
+func TestManagerHandoff(t *testing.T) {
+	td := setup(t)
+
+	td.commitBlockForAllStates(t) // height 1
+
+	mgr := &manager{instances: []Consensus{td.consX, td.consY}}
+	assert.Nil(t, mgr.Handoff())
+
+	p := td.makeProposal(t, 2, 0)
+	v := vote.NewPrepareVote(p.Block().Hash(), 2, 0, td.valKeys[tIndexB].Address())
+	td.HelperSignVote(td.valKeys[tIndexB], v)
+
+	mgr.Restore(&Handoff{
+		Height:    2,
+		Round:     0,
+		Proposals: []*proposal.Proposal{p},
+		Votes:     []*vote.Vote{v},
+	})
+	require.NoError(t, mgr.Start())
+	assert.Nil(t, mgr.handoff)
+	assert.True(t, td.consX.HasVote(v.Hash()))
+	assert.True(t, td.consY.HasVote(v.Hash()))
+
+	// The same vote in both instances is handed off once.
+	hnd := mgr.Handoff()
+	require.NotNil(t, hnd)
+	assert.Len(t, hnd.Votes, 1)
+	assert.Len(t, hnd.Proposals, 1)
+}
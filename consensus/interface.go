@@ -15,6 +15,10 @@ type Reader interface {
 	HasVote(h hash.Hash) bool
 	HeightRound() (uint32, int16)
 	IsActive() bool
+	IsSafeMode() bool
+	Stats() Stats
+	ProposalEquivocations() []*ProposalEquivocation
+	Handoff() *Handoff
 }
 
 type Consensus interface {
@@ -24,6 +28,8 @@ type Consensus interface {
 	MoveToNewHeight()
 	AddVote(vote *vote.Vote)
 	SetProposal(proposal *proposal.Proposal)
+	SetSafeMode(enable bool)
+	Restore(h *Handoff)
 }
 
 type ManagerReader interface {
@@ -32,6 +38,10 @@ type ManagerReader interface {
 	Proposal() *proposal.Proposal
 	HeightRound() (uint32, int16)
 	HasActiveInstance() bool
+	HasVote(h hash.Hash) bool
+	IsSafeMode() bool
+	ProposalEquivocations() []*ProposalEquivocation
+	Handoff() *Handoff
 }
 
 type Manager interface {
@@ -42,4 +52,6 @@ type Manager interface {
 	MoveToNewHeight()
 	AddVote(vote *vote.Vote)
 	SetProposal(proposal *proposal.Proposal)
+	SetSafeMode(enable bool)
+	Restore(h *Handoff)
 }
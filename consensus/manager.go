@@ -3,6 +3,7 @@ package consensus
 import (
 	"github.com/pactus-project/pactus/crypto"
 	"github.com/pactus-project/pactus/crypto/bls"
+	"github.com/pactus-project/pactus/crypto/hash"
 	"github.com/pactus-project/pactus/state"
 	"github.com/pactus-project/pactus/sync/bundle/message"
 	"github.com/pactus-project/pactus/types/proposal"
@@ -20,6 +21,7 @@ type manager struct {
 	upcomingVotes     []*vote.Vote         // Map to cache votes for future block heights
 	upcomingProposals []*proposal.Proposal // Map to cache proposals for future block heights
 	state             state.Facade
+	handoff           *Handoff // Handoff of the previous binary, restored on start
 }
 
 // NewManager creates a new manager instance that manages a set of consensus instances,
@@ -31,17 +33,37 @@ func NewManager(
 	valKeys []*bls.ValidatorKey,
 	rewardAddrs []crypto.Address,
 	broadcastCh chan message.Message,
+) Manager {
+	signers := make([]bls.Signer, len(valKeys))
+	for i, key := range valKeys {
+		signers[i] = bls.NewKeySigner(key)
+	}
+
+	return NewManagerWithSigners(conf, st, signers, rewardAddrs, broadcastCh)
+}
+
+// NewManagerWithSigners creates a new manager instance like NewManager,
+// but each validator signs through its signer, for example a remote signer.
+func NewManagerWithSigners(
+	conf *Config,
+	st state.Facade,
+	signers []bls.Signer,
+	rewardAddrs []crypto.Address,
+	broadcastCh chan message.Message,
 ) Manager {
 	mgr := &manager{
-		instances:         make([]Consensus, len(valKeys)),
+		instances:         make([]Consensus, len(signers)),
 		upcomingVotes:     make([]*vote.Vote, 0),
 		upcomingProposals: make([]*proposal.Proposal, 0),
 		state:             st,
 	}
 	mediatorConcrete := newConcreteMediator()
+	broadcaster := func(_ crypto.Address, msg message.Message) {
+		broadcastCh <- msg
+	}
 
-	for i, key := range valKeys {
-		cons := NewConsensus(conf, st, key, rewardAddrs[i], broadcastCh, mediatorConcrete)
+	for i, signer := range signers {
+		cons := newConsensus(conf, st, signer, rewardAddrs[i], broadcaster, mediatorConcrete)
 
 		mgr.instances[i] = cons
 	}
@@ -53,8 +75,12 @@ func NewManager(
 func (mgr *manager) Start() error {
 	logger.Debug("starting consensus instances")
 	for _, cons := range mgr.instances {
+		if mgr.handoff != nil {
+			cons.Restore(mgr.handoff)
+		}
 		cons.Start()
 	}
+	mgr.handoff = nil
 
 	return nil
 }
@@ -106,6 +132,59 @@ func (mgr *manager) HasActiveInstance() bool {
 	return false
 }
 
+// HasVote checks if any of the consensus instances has the vote.
+func (mgr *manager) HasVote(h hash.Hash) bool {
+	for _, cons := range mgr.instances {
+		if cons.HasVote(h) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// SetSafeMode enables or disables the safe mode for all consensus instances.
+func (mgr *manager) SetSafeMode(enable bool) {
+	for _, cons := range mgr.instances {
+		cons.SetSafeMode(enable)
+	}
+}
+
+// IsSafeMode checks if any of the consensus instances is in safe mode.
+func (mgr *manager) IsSafeMode() bool {
+	for _, cons := range mgr.instances {
+		if cons.IsSafeMode() {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ProposalEquivocations returns the proposal equivocations that are detected by the consensus instances.
+// The same equivocation, detected by multiple instances, is returned once.
+func (mgr *manager) ProposalEquivocations() []*ProposalEquivocation {
+	type key struct {
+		canonical   hash.Hash
+		conflicting hash.Hash
+	}
+
+	seen := make(map[key]bool)
+	equivocations := make([]*ProposalEquivocation, 0)
+	for _, cons := range mgr.instances {
+		for _, e := range cons.ProposalEquivocations() {
+			k := key{canonical: e.Canonical.Hash(), conflicting: e.Conflicting.Hash()}
+			if seen[k] {
+				continue
+			}
+			seen[k] = true
+			equivocations = append(equivocations, e)
+		}
+	}
+
+	return equivocations
+}
+
 // MoveToNewHeight moves all consensus instances to a new height.
 func (mgr *manager) MoveToNewHeight() {
 	for _, cons := range mgr.instances {
@@ -50,6 +50,30 @@ func TestManager(t *testing.T) {
 		assert.Zero(t, r)
 	})
 
+	t.Run("Check safe mode for all instances", func(t *testing.T) {
+		assert.False(t, mgr.IsSafeMode())
+
+		mgr.SetSafeMode(true)
+		assert.True(t, mgr.IsSafeMode())
+		assert.True(t, consA.IsSafeMode())
+		assert.True(t, consB.IsSafeMode())
+
+		mgr.SetSafeMode(false)
+		assert.False(t, mgr.IsSafeMode())
+	})
+
+	t.Run("Check creating the instances by the signers", func(t *testing.T) {
+		signers := []bls.Signer{bls.NewKeySigner(valKeys[0]), bls.NewKeySigner(valKeys[1])}
+		signersMgr := NewManagerWithSigners(testConfig(), st, signers, rewardAddrs, broadcastCh).(*manager)
+
+		for i, inst := range signersMgr.instances {
+			cons := inst.(*consensus)
+			assert.Equal(t, signers[i], cons.signer)
+			assert.Equal(t, valKeys[i].Address(), cons.valAddr)
+			assert.Equal(t, rewardAddrs[i], cons.rewardAddr)
+		}
+	})
+
 	t.Run("Testing add vote", func(t *testing.T) {
 		v := vote.NewPrepareVote(ts.RandHash(), stateHeight+1, 0, valKeys[0].Address())
 		ts.HelperSignVote(valKeys[0], v)
@@ -58,10 +82,12 @@ func TestManager(t *testing.T) {
 
 		assert.True(t, consA.HasVote(v.Hash()))
 		assert.False(t, consB.HasVote(v.Hash()))
+		assert.True(t, mgr.HasVote(v.Hash()))
+		assert.False(t, mgr.HasVote(ts.RandHash()))
 	})
 
 	t.Run("Testing set proposal", func(t *testing.T) {
-		b, _ := st.ProposeBlock(valKeys[0], valKeys[0].Address())
+		b, _ := st.ProposeBlock(bls.NewKeySigner(valKeys[0]), valKeys[0].Address())
 		p := proposal.NewProposal(stateHeight+1, 0, b)
 		ts.HelperSignProposal(valKeys[0], p)
 
@@ -80,7 +106,7 @@ func TestManager(t *testing.T) {
 	})
 
 	t.Run("Check discarding old proposals", func(t *testing.T) {
-		b, _ := st.ProposeBlock(valKeys[0], valKeys[0].Address())
+		b, _ := st.ProposeBlock(bls.NewKeySigner(valKeys[0]), valKeys[0].Address())
 		p := proposal.NewProposal(stateHeight-1, 1, b)
 		ts.HelperSignProposal(valKeys[0], p)
 
@@ -119,13 +145,13 @@ func TestManager(t *testing.T) {
 	})
 
 	t.Run("Processing upcoming proposal", func(t *testing.T) {
-		b1, _ := st.ProposeBlock(valKeys[0], valKeys[0].Address())
+		b1, _ := st.ProposeBlock(bls.NewKeySigner(valKeys[0]), valKeys[0].Address())
 		p1 := proposal.NewProposal(stateHeight+2, 0, b1)
 
-		b2, _ := st.ProposeBlock(valKeys[0], valKeys[0].Address())
+		b2, _ := st.ProposeBlock(bls.NewKeySigner(valKeys[0]), valKeys[0].Address())
 		p2 := proposal.NewProposal(stateHeight+3, 0, b2)
 
-		b3, _ := st.ProposeBlock(valKeys[0], valKeys[0].Address())
+		b3, _ := st.ProposeBlock(bls.NewKeySigner(valKeys[0]), valKeys[0].Address())
 		p3 := proposal.NewProposal(stateHeight+4, 0, b3)
 
 		ts.HelperSignProposal(valKeys[0], p1)
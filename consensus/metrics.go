@@ -0,0 +1,64 @@
+package consensus
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// All the consensus metrics are labeled by the validator address,
+// so the validators that run in the same node can be observed separately.
+var (
+	signedVotesCounter = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "pactus",
+		Subsystem: "consensus",
+		Name:      "signed_votes_total",
+		Help:      "The number of votes that are signed by the validator.",
+	}, []string{"validator"})
+	signedProposalsCounter = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "pactus",
+		Subsystem: "consensus",
+		Name:      "signed_proposals_total",
+		Help:      "The number of proposals that are signed by the validator.",
+	}, []string{"validator"})
+	signFailuresCounter = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "pactus",
+		Subsystem: "consensus",
+		Name:      "sign_failures_total",
+		Help:      "The number of failures on signing by the validator.",
+	}, []string{"validator"})
+	committedBlocksCounter = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "pactus",
+		Subsystem: "consensus",
+		Name:      "committed_blocks_total",
+		Help:      "The number of blocks that the validator is a committer in their certificate.",
+	}, []string{"validator"})
+	missedBlocksCounter = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "pactus",
+		Subsystem: "consensus",
+		Name:      "missed_blocks_total",
+		Help:      "The number of blocks that the validator is an absentee in their certificate.",
+	}, []string{"validator"})
+	availabilityScoreGauge = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "pactus",
+		Subsystem: "consensus",
+		Name:      "availability_score",
+		Help:      "The availability score of the validator.",
+	}, []string{"validator"})
+	proposalEquivocationsCounter = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "pactus",
+		Subsystem: "consensus",
+		Name:      "proposal_equivocations_total",
+		Help:      "The number of the conflicting proposals that are detected by the validator.",
+	}, []string{"validator"})
+	activeGauge = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "pactus",
+		Subsystem: "consensus",
+		Name:      "active",
+		Help:      "Whether the validator is in the committee, one means active.",
+	}, []string{"validator"})
+)
+
+func init() {
+	prometheus.MustRegister(signedVotesCounter, signedProposalsCounter, signFailuresCounter,
+		committedBlocksCounter, missedBlocksCounter, availabilityScoreGauge,
+		proposalEquivocationsCounter, activeGauge)
+}
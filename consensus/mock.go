@@ -21,6 +21,8 @@ type MockConsensus struct {
 	Votes       []*vote.Vote
 	CurProposal *proposal.Proposal
 	Active      bool
+	SafeMode    bool
+	Statistics  Stats
 	Height      uint32
 	Round       int16
 }
@@ -138,3 +140,60 @@ func (m *MockConsensus) SetActive(active bool) {
 
 	m.Active = active
 }
+
+func (m *MockConsensus) SetSafeMode(enable bool) {
+	m.lk.Lock()
+	defer m.lk.Unlock()
+
+	m.SafeMode = enable
+}
+
+func (m *MockConsensus) IsSafeMode() bool {
+	m.lk.Lock()
+	defer m.lk.Unlock()
+
+	return m.SafeMode
+}
+
+func (m *MockConsensus) Stats() Stats {
+	m.lk.Lock()
+	defer m.lk.Unlock()
+
+	return m.Statistics
+}
+
+func (*MockConsensus) ProposalEquivocations() []*ProposalEquivocation {
+	return nil
+}
+
+func (m *MockConsensus) Handoff() *Handoff {
+	m.lk.Lock()
+	defer m.lk.Unlock()
+
+	if !m.Active {
+		return nil
+	}
+
+	h := &Handoff{
+		Height:    m.Height,
+		Round:     m.Round,
+		Proposals: []*proposal.Proposal{},
+		Votes:     m.Votes,
+	}
+	if m.CurProposal != nil {
+		h.Proposals = append(h.Proposals, m.CurProposal)
+	}
+
+	return h
+}
+
+func (m *MockConsensus) Restore(h *Handoff) {
+	m.lk.Lock()
+	defer m.lk.Unlock()
+
+	m.Round = h.Round
+	m.Votes = append(m.Votes, h.Votes...)
+	if len(h.Proposals) > 0 {
+		m.CurProposal = h.Proposals[len(h.Proposals)-1]
+	}
+}
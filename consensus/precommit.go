@@ -63,7 +63,7 @@ func (s *precommitState) vote() {
 	prepares := s.log.PrepareVoteSet(s.round)
 	prepareQH := prepares.QuorumHash()
 	if !roundProposal.IsForBlock(*prepareQH) {
-		s.log.SetRoundProposal(s.round, nil)
+		// The proposal is kept until the prepared one is received, to record the equivocation.
 		s.queryProposal()
 		s.logger.Warn("double proposal detected", "roundProposal", roundProposal, "prepared", *prepareQH)
 
@@ -41,7 +41,7 @@ func TestPrecommitDuplicatedProposal(t *testing.T) {
 	p1 := td.makeProposal(t, h, r)
 	trx := tx.NewTransferTx(h, td.consX.rewardAddr,
 		td.RandAccAddress(), 1000, 1000, "invalid proposal")
-	td.HelperSignTransaction(td.consX.valKey.PrivateKey(), trx)
+	td.HelperSignTransaction(td.valKeys[tIndexX].PrivateKey(), trx)
 
 	assert.NoError(t, td.txPool.AppendTx(trx))
 	p2 := td.makeProposal(t, h, r)
@@ -58,17 +58,20 @@ func TestPrecommitDuplicatedProposal(t *testing.T) {
 	td.addPrepareVote(td.consP, p1.Block().Hash(), h, r, tIndexY)
 	td.addPrepareVote(td.consP, p1.Block().Hash(), h, r, tIndexB)
 
-	assert.Nil(t, td.consP.Proposal())
+	// The proposal is kept until the prepared one is received.
+	assert.Equal(t, p2.Hash(), td.consP.Proposal().Hash())
 	td.shouldPublishQueryProposal(t, td.consP, h)
 
 	// Byzantine node sends second proposal to Partitioned node,
 	// in precommit step
 	td.consP.SetProposal(p2)
-	assert.Nil(t, td.consP.Proposal())
-	td.shouldPublishQueryProposal(t, td.consP, h)
+	assert.Equal(t, p2.Hash(), td.consP.Proposal().Hash())
+	assert.Empty(t, td.consP.ProposalEquivocations())
 
 	td.consP.SetProposal(p1)
-	assert.NotNil(t, td.consP.Proposal())
+	assert.Equal(t, p1.Hash(), td.consP.Proposal().Hash())
+	assert.Len(t, td.consP.ProposalEquivocations(), 1)
+	td.shouldPublishVote(t, td.consP, vote.VoteTypePrecommit, p1.Block().Hash())
 }
 
 func TestGoToChangeProposerFromPrecommit(t *testing.T) {
@@ -1,8 +1,11 @@
 package consensus
 
 import (
+	"github.com/pactus-project/pactus/crypto"
+	"github.com/pactus-project/pactus/crypto/bls"
 	"github.com/pactus-project/pactus/types/proposal"
 	"github.com/pactus-project/pactus/types/vote"
+	"github.com/pactus-project/pactus/util/audit"
 )
 
 type proposeState struct {
@@ -15,9 +18,14 @@ func (s *proposeState) enter() {
 
 func (s *proposeState) decide() {
 	proposer := s.proposer(s.round)
-	if proposer.Address() == s.valKey.Address() {
-		s.logger.Info("our turn to propose", "proposer", proposer.Address())
-		s.createProposal(s.height, s.round)
+	if proposer.Address() == s.valAddr {
+		if s.log.HasRoundProposal(s.round) {
+			// Our proposal is restored from the handoff, so we don't propose another block.
+			s.logger.Info("our proposal is restored", "proposer", proposer.Address())
+		} else {
+			s.logger.Info("our turn to propose", "proposer", proposer.Address())
+			s.createProposal(s.height, s.round)
+		}
 	} else {
 		s.logger.Debug("not our turn to propose", "proposer", proposer.Address())
 	}
@@ -41,27 +49,103 @@ func (s *proposeState) decide() {
 }
 
 func (s *proposeState) createProposal(height uint32, round int16) {
-	block, err := s.bcState.ProposeBlock(s.valKey, s.rewardAddr)
+	if s.safeMode {
+		s.logger.Warn("safe mode is enabled, no proposal is signed")
+
+		return
+	}
+
+	if s.isRemoteSigner() {
+		// The remote signer signs the sortition seed and the proposal,
+		// so the proposal is created in the background, without holding the lock.
+		go s.createRemoteProposal(height, round, s.signer, s.rewardAddr)
+
+		return
+	}
+
+	prop, err := s.makeProposal(height, round, s.signer, s.rewardAddr)
 	if err != nil {
 		s.logger.Error("unable to propose a block!", "error", err)
 
 		return
 	}
-	if err := s.bcState.ValidateBlock(block, round); err != nil {
-		s.logger.Error("proposed block is invalid!", "error", err)
+
+	sig, err := s.sign(audit.KindProposal, prop.Block().Hash().String(), prop.SignBytes())
+	if err != nil {
+		s.logger.Error("unable to sign the proposal", "error", err)
 
 		return
 	}
+	prop.SetSignature(sig)
+	s.setOurProposal(prop)
+}
+
+// createRemoteProposal creates the proposal and signs it through the remote signer.
+// The signer and the reward address are taken under the lock by the caller.
+// The proposal is set only if the consensus is still in the same height and round.
+func (cs *consensus) createRemoteProposal(height uint32, round int16,
+	signer bls.Signer, rewardAddr crypto.Address,
+) {
+	prop, err := cs.makeProposal(height, round, signer, rewardAddr)
+	if err != nil {
+		cs.lk.Lock()
+		defer cs.lk.Unlock()
+
+		cs.logger.Error("unable to propose a block!", "error", err)
+
+		return
+	}
+
+	sig, err := signer.Sign(prop.SignBytes())
 
-	prop := proposal.NewProposal(height, round, block)
-	sig := s.valKey.Sign(prop.SignBytes())
+	cs.lk.Lock()
+	defer cs.lk.Unlock()
+
+	cs.recordSign(audit.KindProposal, prop.Block().Hash().String(), prop.SignBytes(), height, round, err)
+	if err != nil {
+		cs.logger.Error("unable to sign the proposal", "error", err)
+
+		return
+	}
 	prop.SetSignature(sig)
 
-	s.log.SetRoundProposal(round, prop)
+	if cs.height != height || cs.round != round || cs.log.HasRoundProposal(round) {
+		cs.logger.Debug("our proposal is expired", "proposal", prop)
+
+		return
+	}
+
+	cs.setOurProposal(prop)
+	cs.currentState.onSetProposal(prop)
+}
+
+// makeProposal proposes a new block for the given height and round.
+// It doesn't access the consensus states, so it can be called without the lock.
+func (cs *consensus) makeProposal(height uint32, round int16,
+	signer bls.Signer, rewardAddr crypto.Address,
+) (*proposal.Proposal, error) {
+	block, err := cs.bcState.ProposeBlock(signer, rewardAddr)
+	if err != nil {
+		return nil, err
+	}
+	if err := cs.bcState.ValidateBlock(block, round); err != nil {
+		return nil, err
+	}
+
+	return proposal.NewProposal(height, round, block), nil
+}
+
+// setOurProposal sets our signed proposal for the round and broadcasts it.
+func (cs *consensus) setOurProposal(prop *proposal.Proposal) {
+	cs.stats.SignedProposals++
+	signedProposalsCounter.WithLabelValues(cs.validatorLabel()).Inc()
+
+	cs.log.SetRoundProposal(prop.Round(), prop)
+	traceProposed(prop)
 
-	s.broadcastProposal(prop)
+	cs.broadcastProposal(prop)
 
-	s.logger.Info("proposal signed and broadcasted", "proposal", prop)
+	cs.logger.Info("proposal signed and broadcasted", "proposal", prop)
 }
 
 func (s *proposeState) onAddVote(_ *vote.Vote) {
@@ -0,0 +1,283 @@
+package consensus
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/pactus-project/pactus/crypto"
+	"github.com/pactus-project/pactus/crypto/bls"
+	"github.com/pactus-project/pactus/util"
+	"github.com/pactus-project/pactus/util/audit"
+)
+
+// RemoteSignerConfig configures a validator to sign through a remote signer.
+// The validator key is kept by the remote signer, so the node only knows the public key.
+// The remote signer should be served over TLS, unless it listens on the loopback interface,
+// and every request carries the token that is stored in the token file.
+type RemoteSignerConfig struct {
+	PublicKey     string        `toml:"public_key"`
+	RewardAddress string        `toml:"reward_address"`
+	URL           string        `toml:"url"`
+	TokenFile     string        `toml:"token_file"`
+	CAFile        string        `toml:"ca_file"`
+	Timeout       time.Duration `toml:"timeout"`
+}
+
+// BasicCheck performs basic checks on the remote signer configuration.
+func (conf *RemoteSignerConfig) BasicCheck() error {
+	if _, err := bls.PublicKeyFromString(conf.PublicKey); err != nil {
+		return ConfigError{
+			Reason: fmt.Sprintf("invalid remote signer public key: %s", err.Error()),
+		}
+	}
+	if conf.RewardAddress != "" {
+		addr, err := crypto.AddressFromString(conf.RewardAddress)
+		if err != nil {
+			return ConfigError{
+				Reason: fmt.Sprintf("invalid remote signer reward address: %s", err.Error()),
+			}
+		}
+		if !addr.IsAccountAddress() {
+			return ConfigError{
+				Reason: fmt.Sprintf("remote signer reward address is not an account address: %s", conf.RewardAddress),
+			}
+		}
+	}
+	if err := checkRemoteSignerURL(conf.URL); err != nil {
+		return err
+	}
+	if conf.TokenFile == "" {
+		return ConfigError{
+			Reason: fmt.Sprintf("remote signer token file is not set for: %s", conf.PublicKey),
+		}
+	}
+	if conf.Timeout < 0 {
+		return ConfigError{
+			Reason: "remote signer timeout can't be negative",
+		}
+	}
+
+	return nil
+}
+
+// checkRemoteSignerURL checks that the remote signer is reached over TLS,
+// or it listens on the loopback interface.
+func checkRemoteSignerURL(rawURL string) error {
+	signerURL, err := url.Parse(rawURL)
+	if err != nil || signerURL.Host == "" {
+		return ConfigError{
+			Reason: fmt.Sprintf("invalid remote signer url: %s", rawURL),
+		}
+	}
+
+	switch signerURL.Scheme {
+	case "https":
+		return nil
+
+	case "http":
+		host := signerURL.Hostname()
+		if host == "localhost" {
+			return nil
+		}
+		if ip := net.ParseIP(host); ip != nil && ip.IsLoopback() {
+			return nil
+		}
+
+		return ConfigError{
+			Reason: fmt.Sprintf("remote signer should use https, unless it is on the loopback interface: %s", rawURL),
+		}
+
+	default:
+		return ConfigError{
+			Reason: fmt.Sprintf("invalid remote signer url: %s", rawURL),
+		}
+	}
+}
+
+// ValidatorPublicKey returns the public key of the validator.
+func (conf *RemoteSignerConfig) ValidatorPublicKey() (*bls.PublicKey, error) {
+	return bls.PublicKeyFromString(conf.PublicKey)
+}
+
+// RewardAddr returns the reward address of the validator.
+// If it is not set, the account address of the validator public key is used.
+func (conf *RemoteSignerConfig) RewardAddr() (crypto.Address, error) {
+	if conf.RewardAddress == "" {
+		pubKey, err := conf.ValidatorPublicKey()
+		if err != nil {
+			return crypto.Address{}, err
+		}
+
+		return pubKey.AccountAddress(), nil
+	}
+
+	return crypto.AddressFromString(conf.RewardAddress)
+}
+
+// RemoteSignRequest is the request that is posted to the remote signer.
+type RemoteSignRequest struct {
+	PublicKey string `json:"public_key"`
+	Data      string `json:"data"` // Hex encoded sign bytes
+}
+
+// RemoteSignResponse is the response of the remote signer.
+type RemoteSignResponse struct {
+	Signature string `json:"signature"` // Hex encoded signature
+}
+
+// remoteSigner posts the sign bytes to a remote signer over HTTP.
+// The returned signature is verified before using it.
+type remoteSigner struct {
+	pubKey *bls.PublicKey
+	url    string
+	token  string
+	client *http.Client
+}
+
+// NewRemoteSigner creates a new signer that signs through the remote signer.
+// If the timeout is not set, the remote signer should respond in two seconds.
+func NewRemoteSigner(conf *RemoteSignerConfig) (bls.Signer, error) {
+	if err := conf.BasicCheck(); err != nil {
+		return nil, err
+	}
+
+	pubKey, err := conf.ValidatorPublicKey()
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := util.ReadFile(util.MakeAbs(conf.TokenFile))
+	if err != nil {
+		return nil, fmt.Errorf("unable to read the remote signer token: %w", err)
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if conf.CAFile != "" {
+		data, err := util.ReadFile(util.MakeAbs(conf.CAFile))
+		if err != nil {
+			return nil, fmt.Errorf("unable to load the remote signer CA file: %w", err)
+		}
+		rootCAs := x509.NewCertPool()
+		if !rootCAs.AppendCertsFromPEM(data) {
+			return nil, fmt.Errorf("no valid certificate found in %s", conf.CAFile)
+		}
+		transport.TLSClientConfig = &tls.Config{
+			RootCAs:    rootCAs,
+			MinVersion: tls.VersionTLS12,
+		}
+	}
+
+	timeout := conf.Timeout
+	if timeout == 0 {
+		timeout = 2 * time.Second
+	}
+
+	return &remoteSigner{
+		pubKey: pubKey,
+		url:    conf.URL,
+		token:  strings.TrimSpace(string(token)),
+		client: &http.Client{Timeout: timeout, Transport: transport},
+	}, nil
+}
+
+func (s *remoteSigner) PublicKey() *bls.PublicKey {
+	return s.pubKey
+}
+
+func (s *remoteSigner) Sign(data []byte) (*bls.Signature, error) {
+	reqBody, err := json.Marshal(RemoteSignRequest{
+		PublicKey: s.pubKey.String(),
+		Data:      hex.EncodeToString(data),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(context.Background(),
+		http.MethodPost, s.url, bytes.NewReader(reqBody))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+s.token)
+
+	res, err := s.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("remote signer responded with status: %s", res.Status)
+	}
+
+	signRes := RemoteSignResponse{}
+	if err := json.NewDecoder(res.Body).Decode(&signRes); err != nil {
+		return nil, err
+	}
+
+	sigBytes, err := hex.DecodeString(signRes.Signature)
+	if err != nil {
+		return nil, err
+	}
+	sig, err := bls.SignatureFromBytes(sigBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.pubKey.Verify(data, sig); err != nil {
+		return nil, fmt.Errorf("remote signer returned an invalid signature: %w", err)
+	}
+
+	return sig, nil
+}
+
+// isRemoteSigner checks if the validator signs through a remote signer.
+func (cs *consensus) isRemoteSigner() bool {
+	_, ok := cs.signer.(*remoteSigner)
+
+	return ok
+}
+
+// signAsync signs the data in the background, so the consensus is not locked
+// while waiting for the remote signer.
+// The onSigned is called under the lock, if the consensus is still in the same height and round.
+func (cs *consensus) signAsync(kind audit.Kind, subject string, data []byte,
+	onSigned func(sig *bls.Signature),
+) {
+	height, round := cs.height, cs.round
+	signer := cs.signer
+
+	go func() {
+		sig, err := signer.Sign(data)
+
+		cs.lk.Lock()
+		defer cs.lk.Unlock()
+
+		cs.recordSign(kind, subject, data, height, round, err)
+		if err != nil {
+			cs.logger.Error("unable to sign", "kind", kind, "subject", subject, "error", err)
+
+			return
+		}
+
+		if cs.height != height || cs.round != round {
+			cs.logger.Debug("signature is expired", "kind", kind, "subject", subject,
+				"height", height, "round", round)
+
+			return
+		}
+
+		onSigned(sig)
+	}()
+}
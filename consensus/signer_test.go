@@ -0,0 +1,336 @@
+package consensus
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/pactus-project/pactus/crypto/bls"
+	"github.com/pactus-project/pactus/types/vote"
+	"github.com/pactus-project/pactus/util"
+	"github.com/pactus-project/pactus/util/audit"
+	"github.com/pactus-project/pactus/util/testsuite"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testSignerToken = "secret-token"
+
+// remoteSignerHandler handles the sign requests by the given key.
+func remoteSignerHandler(valKey *bls.ValidatorKey) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer "+testSignerToken {
+			w.WriteHeader(http.StatusUnauthorized)
+
+			return
+		}
+
+		req := RemoteSignRequest{}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+
+			return
+		}
+		if req.PublicKey != valKey.PublicKey().String() {
+			w.WriteHeader(http.StatusNotFound)
+
+			return
+		}
+
+		data, _ := hex.DecodeString(req.Data)
+		sig := valKey.Sign(data)
+		_ = json.NewEncoder(w).Encode(RemoteSignResponse{
+			Signature: hex.EncodeToString(sig.Bytes()),
+		})
+	})
+}
+
+// startRemoteSigner starts a remote signer that signs with the given key.
+func startRemoteSigner(t *testing.T, valKey *bls.ValidatorKey) *httptest.Server {
+	t.Helper()
+
+	server := httptest.NewServer(remoteSignerHandler(valKey))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+// writeTokenFile writes the token in a temporary file and returns its path.
+func writeTokenFile(t *testing.T, token string) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "signer_token")
+	require.NoError(t, util.WriteFile(path, []byte(token+"\n")))
+
+	return path
+}
+
+func TestRemoteSignerConfigBasicCheck(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	pubKey, _ := ts.RandBLSKeyPair()
+	validConfig := func() *RemoteSignerConfig {
+		return &RemoteSignerConfig{
+			PublicKey: pubKey.String(),
+			URL:       "https://signer.example.com/sign",
+			TokenFile: "signer_token",
+		}
+	}
+
+	tests := []struct {
+		name   string
+		update func(conf *RemoteSignerConfig)
+	}{
+		{"Invalid public key", func(conf *RemoteSignerConfig) { conf.PublicKey = "invalid" }},
+		{"Invalid reward address", func(conf *RemoteSignerConfig) { conf.RewardAddress = "invalid" }},
+		{"Reward address is not an account address", func(conf *RemoteSignerConfig) {
+			conf.RewardAddress = ts.RandValAddress().String()
+		}},
+		{"No URL", func(conf *RemoteSignerConfig) { conf.URL = "" }},
+		{"Invalid scheme", func(conf *RemoteSignerConfig) { conf.URL = "ftp://signer.example.com/sign" }},
+		{"Plain http on a remote host", func(conf *RemoteSignerConfig) { conf.URL = "http://signer.example.com/sign" }},
+		{"No token file", func(conf *RemoteSignerConfig) { conf.TokenFile = "" }},
+		{"Negative timeout", func(conf *RemoteSignerConfig) { conf.Timeout = -time.Second }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conf := validConfig()
+			tt.update(conf)
+			assert.Error(t, conf.BasicCheck())
+		})
+	}
+
+	t.Run("Ok", func(t *testing.T) {
+		conf := validConfig()
+		assert.NoError(t, conf.BasicCheck())
+
+		conf.URL = "http://127.0.0.1:9090/sign"
+		assert.NoError(t, conf.BasicCheck())
+
+		conf.URL = "http://localhost:9090/sign"
+		assert.NoError(t, conf.BasicCheck())
+	})
+
+	t.Run("Reward address", func(t *testing.T) {
+		conf := validConfig()
+		rewardAddr, err := conf.RewardAddr()
+		require.NoError(t, err)
+		assert.Equal(t, pubKey.AccountAddress(), rewardAddr)
+
+		conf.RewardAddress = ts.RandAccAddress().String()
+		rewardAddr, err = conf.RewardAddr()
+		require.NoError(t, err)
+		assert.Equal(t, conf.RewardAddress, rewardAddr.String())
+	})
+}
+
+func TestRemoteSigner(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	valKey := ts.RandValKey()
+	server := startRemoteSigner(t, valKey)
+	tokenFile := writeTokenFile(t, testSignerToken)
+
+	t.Run("Token file doesn't exist", func(t *testing.T) {
+		conf := &RemoteSignerConfig{
+			PublicKey: valKey.PublicKey().String(),
+			URL:       server.URL,
+			TokenFile: filepath.Join(t.TempDir(), "not_exist"),
+		}
+		_, err := NewRemoteSigner(conf)
+		assert.Error(t, err)
+	})
+
+	t.Run("Should sign through the remote signer", func(t *testing.T) {
+		conf := &RemoteSignerConfig{PublicKey: valKey.PublicKey().String(), URL: server.URL, TokenFile: tokenFile}
+		signer, err := NewRemoteSigner(conf)
+		require.NoError(t, err)
+
+		data := ts.RandBytes(32)
+		sig, err := signer.Sign(data)
+		require.NoError(t, err)
+		assert.True(t, valKey.Sign(data).EqualsTo(sig))
+		assert.True(t, valKey.PublicKey().EqualsTo(signer.PublicKey()))
+	})
+
+	t.Run("Should fail with a wrong token", func(t *testing.T) {
+		conf := &RemoteSignerConfig{
+			PublicKey: valKey.PublicKey().String(),
+			URL:       server.URL,
+			TokenFile: writeTokenFile(t, "wrong-token"),
+		}
+		signer, err := NewRemoteSigner(conf)
+		require.NoError(t, err)
+
+		_, err = signer.Sign(ts.RandBytes(32))
+		assert.Error(t, err)
+	})
+
+	t.Run("Should reject the signature of another key", func(t *testing.T) {
+		otherKey := ts.RandValKey()
+		otherServer := startRemoteSigner(t, otherKey)
+
+		// The remote signer signs with another key, pretending to be our validator.
+		conf := &RemoteSignerConfig{PublicKey: otherKey.PublicKey().String(), URL: otherServer.URL, TokenFile: tokenFile}
+		signer, err := NewRemoteSigner(conf)
+		require.NoError(t, err)
+		signer.(*remoteSigner).pubKey = valKey.PublicKey()
+
+		_, err = signer.Sign(ts.RandBytes(32))
+		assert.Error(t, err)
+	})
+
+	t.Run("Should fail if the remote signer is not available", func(t *testing.T) {
+		unavailable := httptest.NewServer(http.NotFoundHandler())
+		unavailable.Close()
+
+		conf := &RemoteSignerConfig{
+			PublicKey: valKey.PublicKey().String(),
+			URL:       unavailable.URL,
+			TokenFile: tokenFile,
+			Timeout:   100 * time.Millisecond,
+		}
+		signer, err := NewRemoteSigner(conf)
+		require.NoError(t, err)
+
+		_, err = signer.Sign(ts.RandBytes(32))
+		assert.Error(t, err)
+	})
+
+	t.Run("Should fail on unsuccessful responses", func(t *testing.T) {
+		notFound := httptest.NewServer(http.NotFoundHandler())
+		defer notFound.Close()
+
+		conf := &RemoteSignerConfig{PublicKey: valKey.PublicKey().String(), URL: notFound.URL, TokenFile: tokenFile}
+		signer, err := NewRemoteSigner(conf)
+		require.NoError(t, err)
+
+		_, err = signer.Sign(ts.RandBytes(32))
+		assert.Error(t, err)
+	})
+}
+
+func TestRemoteSignerTLS(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	valKey := ts.RandValKey()
+	server := httptest.NewTLSServer(remoteSignerHandler(valKey))
+	defer server.Close()
+
+	caFile := filepath.Join(t.TempDir(), "signer_ca.pem")
+	caPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+	require.NoError(t, util.WriteFile(caFile, caPEM))
+
+	conf := &RemoteSignerConfig{
+		PublicKey: valKey.PublicKey().String(),
+		URL:       server.URL,
+		TokenFile: writeTokenFile(t, testSignerToken),
+	}
+
+	t.Run("Should fail if the certificate is not trusted", func(t *testing.T) {
+		signer, err := NewRemoteSigner(conf)
+		require.NoError(t, err)
+
+		_, err = signer.Sign(ts.RandBytes(32))
+		assert.Error(t, err)
+	})
+
+	t.Run("Should sign by trusting the CA file", func(t *testing.T) {
+		conf.CAFile = caFile
+		signer, err := NewRemoteSigner(conf)
+		require.NoError(t, err)
+
+		_, err = signer.Sign(ts.RandBytes(32))
+		assert.NoError(t, err)
+	})
+}
+
+func TestRemoteSignerInConsensus(t *testing.T) {
+	td := setup(t)
+
+	server := startRemoteSigner(t, td.valKeys[tIndexX])
+	signer, err := NewRemoteSigner(&RemoteSignerConfig{
+		PublicKey: td.valKeys[tIndexX].PublicKey().String(),
+		URL:       server.URL,
+		TokenFile: writeTokenFile(t, testSignerToken),
+	})
+	require.NoError(t, err)
+	td.consX.signer = signer
+
+	// The proposal and the prepare vote are signed in the background,
+	// without holding the lock of the consensus.
+	td.enterNewHeight(td.consX)
+	assert.Eventually(t, func() bool {
+		stats := td.consX.Stats()
+
+		return stats.SignedProposals == 1 && stats.SignedVotes == 1
+	}, 5*time.Second, 10*time.Millisecond)
+
+	p := td.shouldPublishProposal(t, td.consX, 1, 0)
+	assert.Equal(t, td.consX.valAddr, p.Block().Header().ProposerAddress())
+	v := td.shouldPublishVote(t, td.consX, vote.VoteTypePrepare, p.Block().Hash())
+	assert.NoError(t, v.Verify(td.valKeys[tIndexX].PublicKey()))
+}
+
+func TestRemoteProposalIsExpired(t *testing.T) {
+	td := setup(t)
+
+	td.enterNewHeight(td.consY)
+	td.enterNextRound(td.consY)
+	signedProposals := td.consY.Stats().SignedProposals
+
+	// The proposal for the previous round is signed after the consensus has moved to the next round.
+	td.consY.createRemoteProposal(1, 0, td.consY.signer, td.consY.rewardAddr)
+
+	td.consY.lk.RLock()
+	defer td.consY.lk.RUnlock()
+
+	assert.Nil(t, td.consY.log.RoundProposal(0))
+	assert.Equal(t, signedProposals, td.consY.stats.SignedProposals)
+}
+
+func TestRemoteProposalIsLate(t *testing.T) {
+	td := setup(t)
+
+	td.enterNewHeight(td.consY)
+	p := td.makeProposal(t, 1, 0)
+	td.consY.SetProposal(p)
+	signedProposals := td.consY.Stats().SignedProposals
+
+	// The proposal is signed after another proposal for the same round is received.
+	td.consY.createRemoteProposal(1, 0, td.consY.signer, td.consY.rewardAddr)
+
+	td.consY.lk.RLock()
+	defer td.consY.lk.RUnlock()
+
+	assert.Equal(t, p.Hash(), td.consY.log.RoundProposal(0).Hash())
+	assert.Equal(t, signedProposals, td.consY.stats.SignedProposals)
+}
+
+func TestSignAsyncIsExpired(t *testing.T) {
+	td := setup(t)
+
+	td.enterNewHeight(td.consY)
+	require.Zero(t, td.consY.Stats().LastSignedHeight)
+
+	var called atomic.Bool
+	td.consY.lk.Lock()
+	td.consY.signAsync(audit.KindVote, "expired", td.RandBytes(32), func(_ *bls.Signature) {
+		called.Store(true)
+	})
+	// The consensus moves to the next round before the signature is returned.
+	td.consY.round++
+	td.consY.lk.Unlock()
+
+	assert.Eventually(t, func() bool {
+		return td.consY.Stats().LastSignedHeight == 1
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.False(t, called.Load())
+}
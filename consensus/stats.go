@@ -0,0 +1,102 @@
+package consensus
+
+import (
+	"github.com/pactus-project/pactus/crypto/bls"
+	"github.com/pactus-project/pactus/util/audit"
+	"golang.org/x/exp/slices"
+)
+
+// Stats contains the statistics of the validator in a consensus instance.
+// Each validator in the node has its own statistics.
+type Stats struct {
+	SignedVotes      int64  // Number of votes that are signed
+	SignedProposals  int64  // Number of proposals that are signed
+	SignFailures     int64  // Number of failures on signing
+	CommittedBlocks  int64  // Number of blocks that we voted for and are in the certificate
+	MissedBlocks     int64  // Number of blocks that we were in the committee but absent
+	LastSignedHeight uint32 // The height of the last signed proposal or vote
+}
+
+// Stats returns the statistics of the validator in this consensus instance.
+func (cs *consensus) Stats() Stats {
+	cs.lk.RLock()
+	defer cs.lk.RUnlock()
+
+	return cs.stats
+}
+
+// validatorLabel is the label of the validator in the metrics.
+func (cs *consensus) validatorLabel() string {
+	return cs.valAddr.String()
+}
+
+// sign signs the data through the signer of the validator.
+// The signature is recorded in the audit log of the node.
+func (cs *consensus) sign(kind audit.Kind, subject string, data []byte) (*bls.Signature, error) {
+	sig, err := cs.signer.Sign(data)
+	cs.recordSign(kind, subject, data, cs.height, cs.round, err)
+	if err != nil {
+		return nil, err
+	}
+
+	return sig, nil
+}
+
+// recordSign updates the statistics of the validator after signing the data
+// at the given height and round. Successful signatures are recorded in the audit log.
+func (cs *consensus) recordSign(kind audit.Kind, subject string, data []byte,
+	height uint32, round int16, err error,
+) {
+	if err != nil {
+		cs.stats.SignFailures++
+		signFailuresCounter.WithLabelValues(cs.validatorLabel()).Inc()
+
+		return
+	}
+	cs.stats.LastSignedHeight = height
+
+	audit.Record(audit.Entry{
+		Kind:      kind,
+		Subject:   subject,
+		Signer:    cs.valAddr.String(),
+		Digest:    audit.Digest(data),
+		Height:    height,
+		Round:     round,
+		Requester: "consensus",
+	})
+}
+
+// trackAvailability checks whether our vote is included in the certificate of the last block.
+// Each certificate is tracked only once.
+func (cs *consensus) trackAvailability() {
+	label := cs.validatorLabel()
+	if cs.active {
+		activeGauge.WithLabelValues(label).Set(1)
+	} else {
+		activeGauge.WithLabelValues(label).Set(0)
+	}
+
+	cert := cs.bcState.LastCertificate()
+	if cert == nil || cert.Height() <= cs.trackedHeight {
+		return
+	}
+	cs.trackedHeight = cert.Height()
+
+	val := cs.bcState.ValidatorByAddress(cs.valAddr)
+	if val == nil {
+		return
+	}
+	availabilityScoreGauge.WithLabelValues(label).Set(cs.bcState.AvailabilityScore(val.Number()))
+
+	if !slices.Contains(cert.Committers(), val.Number()) {
+		return
+	}
+
+	if slices.Contains(cert.Absentees(), val.Number()) {
+		cs.stats.MissedBlocks++
+		missedBlocksCounter.WithLabelValues(label).Inc()
+	} else {
+		cs.stats.CommittedBlocks++
+		committedBlocksCounter.WithLabelValues(label).Inc()
+	}
+}
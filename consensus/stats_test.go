@@ -0,0 +1,116 @@
+package consensus
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/pactus-project/pactus/crypto/bls"
+	"github.com/pactus-project/pactus/sync/bundle/message"
+	"github.com/pactus-project/pactus/types/certificate"
+	"github.com/pactus-project/pactus/types/vote"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type failingSigner struct {
+	pubKey *bls.PublicKey
+}
+
+func (s *failingSigner) PublicKey() *bls.PublicKey {
+	return s.pubKey
+}
+
+func (*failingSigner) Sign(_ []byte) (*bls.Signature, error) {
+	return nil, errors.New("unable to sign")
+}
+
+func TestStatsSignedVotes(t *testing.T) {
+	td := setup(t)
+
+	td.commitBlockForAllStates(t) // height 1
+
+	td.enterNewHeight(td.consX)
+	td.checkHeightRound(t, td.consX, 2, 0)
+
+	p := td.makeProposal(t, 2, 0)
+	td.consX.SetProposal(p)
+
+	td.addPrepareVote(td.consX, p.Block().Hash(), 2, 0, tIndexY)
+	td.addPrepareVote(td.consX, p.Block().Hash(), 2, 0, tIndexP)
+	td.shouldPublishVote(t, td.consX, vote.VoteTypePrepare, p.Block().Hash())
+
+	td.addPrecommitVote(td.consX, p.Block().Hash(), 2, 0, tIndexY)
+	td.addPrecommitVote(td.consX, p.Block().Hash(), 2, 0, tIndexP)
+	td.shouldPublishVote(t, td.consX, vote.VoteTypePrecommit, p.Block().Hash())
+
+	stats := td.consX.Stats()
+	assert.Equal(t, int64(2), stats.SignedVotes)
+	assert.Zero(t, stats.SignedProposals)
+	assert.Zero(t, stats.SignFailures)
+	assert.Equal(t, int64(2), stats.CommittedBlocks) // Heights 1 and 2
+	assert.Zero(t, stats.MissedBlocks)
+	assert.Equal(t, uint32(2), stats.LastSignedHeight)
+
+	// The other validators are tracked independently.
+	assert.Zero(t, td.consY.Stats().SignedVotes)
+}
+
+func TestStatsSignedProposal(t *testing.T) {
+	td := setup(t)
+
+	td.enterNewHeight(td.consX)
+	td.shouldPublishProposal(t, td.consX, 1, 0)
+
+	stats := td.consX.Stats()
+	assert.Equal(t, int64(1), stats.SignedProposals)
+	assert.Equal(t, uint32(1), stats.LastSignedHeight)
+}
+
+func TestStatsSignFailures(t *testing.T) {
+	td := setup(t)
+
+	td.consX.signer = &failingSigner{pubKey: td.consX.signer.PublicKey()}
+
+	// The sortition seed of the block can't be signed, so no block is proposed.
+	td.enterNewHeight(td.consX)
+	td.shouldNotPublish(t, td.consX, message.TypeProposal)
+
+	p := td.makeProposal(t, 1, 0)
+	td.consX.SetProposal(p)
+	td.addPrepareVote(td.consX, p.Block().Hash(), 1, 0, tIndexY)
+	td.addPrepareVote(td.consX, p.Block().Hash(), 1, 0, tIndexP)
+	td.shouldNotPublish(t, td.consX, message.TypeVote)
+
+	stats := td.consX.Stats()
+	assert.Zero(t, stats.SignedVotes)
+	assert.Zero(t, stats.SignedProposals)
+	assert.Equal(t, int64(1), stats.SignFailures)
+	assert.Zero(t, stats.LastSignedHeight)
+}
+
+func TestStatsMissedBlocks(t *testing.T) {
+	td := setup(t)
+
+	p := td.makeProposal(t, 1, 0)
+	sb := certificate.BlockCertificateSignBytes(p.Block().Hash(), 1, 0)
+	sig := bls.SignatureAggregate(
+		td.valKeys[tIndexY].Sign(sb),
+		td.valKeys[tIndexB].Sign(sb),
+		td.valKeys[tIndexP].Sign(sb))
+	cert := certificate.NewCertificate(1, 0,
+		[]int32{tIndexX, tIndexY, tIndexB, tIndexP}, []int32{tIndexX}, sig)
+	require.NoError(t, td.consX.bcState.CommitBlock(p.Block(), cert))
+	require.NoError(t, td.consY.bcState.CommitBlock(p.Block(), cert))
+
+	td.enterNewHeight(td.consX)
+	td.enterNewHeight(td.consY)
+
+	assert.Equal(t, int64(1), td.consX.Stats().MissedBlocks)
+	assert.Zero(t, td.consX.Stats().CommittedBlocks)
+	assert.Equal(t, int64(1), td.consY.Stats().CommittedBlocks)
+	assert.Zero(t, td.consY.Stats().MissedBlocks)
+
+	// Entering the same height again should not track the certificate twice.
+	td.enterNewHeight(td.consX)
+	assert.Equal(t, int64(1), td.consX.Stats().MissedBlocks)
+}
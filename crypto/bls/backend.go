@@ -0,0 +1,15 @@
+package bls
+
+// The arithmetic backends of the BLS12-381 curve.
+//
+// On amd64, the field arithmetic is written in assembly, and the instructions of
+// the ADX and BMI2 extensions are used if the CPU supports them.
+// On the other platforms, or if the `generic` build tag is set, the pure-Go
+// arithmetic is used, so the node builds for any platform that Go supports.
+// The backends produce the same keys and signatures, that is checked by running
+// the tests with the `generic` tag.
+const (
+	BackendGeneric  = "generic"
+	BackendAMD64    = "amd64"
+	BackendAMD64ADX = "amd64_adx"
+)
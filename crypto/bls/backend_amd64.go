@@ -0,0 +1,14 @@
+//go:build amd64 && !generic
+
+package bls
+
+import "golang.org/x/sys/cpu"
+
+// Backend returns the arithmetic backend that is selected for this platform.
+func Backend() string {
+	if cpu.X86.HasADX && cpu.X86.HasBMI2 {
+		return BackendAMD64ADX
+	}
+
+	return BackendAMD64
+}
@@ -0,0 +1,8 @@
+//go:build !amd64 || generic
+
+package bls
+
+// Backend returns the arithmetic backend that is selected for this platform.
+func Backend() string {
+	return BackendGeneric
+}
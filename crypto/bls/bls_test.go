@@ -2,6 +2,7 @@ package bls_test
 
 import (
 	"encoding/hex"
+	"runtime"
 	"testing"
 
 	bls12381 "github.com/kilic/bls12-381"
@@ -202,3 +203,12 @@ func TestHashToCurve(t *testing.T) {
 			"test %v: not match", no)
 	}
 }
+
+func TestBackend(t *testing.T) {
+	backend := bls.Backend()
+	assert.Contains(t, []string{bls.BackendGeneric, bls.BackendAMD64, bls.BackendAMD64ADX}, backend)
+
+	if runtime.GOARCH != "amd64" {
+		assert.Equal(t, bls.BackendGeneric, backend)
+	}
+}
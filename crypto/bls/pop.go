@@ -0,0 +1,95 @@
+package bls
+
+import (
+	"crypto/rand"
+	"math/big"
+
+	bls12381 "github.com/kilic/bls12-381"
+	"github.com/pactus-project/pactus/crypto"
+	"github.com/pactus-project/pactus/util/errors"
+)
+
+// set Ciphersuite for the proof of possession
+// https://datatracker.ietf.org/doc/html/draft-irtf-cfrg-bls-signature-04#section-4.2.3
+var popDST = []byte("BLS_POP_BLS12381G1_XMD:SHA-256_SSWU_RO_POP_")
+
+// ProofOfPossession proves that the owner of the public key has its private key.
+// It signs the public key with a separate domain, so it can't be mixed with the normal signatures.
+// The proof prevents the rogue-key attacks against the aggregated signatures.
+func (prv *PrivateKey) ProofOfPossession() *Signature {
+	g1 := bls12381.NewG1()
+
+	q, err := g1.HashToCurve(prv.PublicKeyNative().Bytes(), popDST)
+	if err != nil {
+		panic(err)
+	}
+	s := g1.MulScalar(g1.New(), q, &prv.fr)
+
+	return &Signature{pointG1: *s}
+}
+
+// ProofOfPossession returns the proof of possession of the validator key.
+func (key *ValidatorKey) ProofOfPossession() *Signature {
+	return key.privateKey.ProofOfPossession()
+}
+
+// VerifyProofOfPossession verifies the proof of possession of the public key.
+func (pub *PublicKey) VerifyProofOfPossession(pop *Signature) error {
+	return VerifyProofsOfPossession([]*PublicKey{pub}, []*Signature{pop})
+}
+
+// VerifyProofsOfPossession verifies the proofs of possession of the public keys in a batch.
+// Each proof is multiplied by a random scalar, so an invalid proof can't be canceled by another one.
+// It checks: e(∑ rᵢ⋅popᵢ, g₂) = ∏ e(rᵢ⋅H(pkᵢ), pkᵢ)
+func VerifyProofsOfPossession(pubs []*PublicKey, pops []*Signature) error {
+	if len(pubs) != len(pops) {
+		return errors.Errorf(errors.ErrInvalidSignature,
+			"number of proofs (%d) is not same as the public keys (%d)", len(pops), len(pubs))
+	}
+	if len(pubs) == 0 {
+		return nil
+	}
+
+	g1 := bls12381.NewG1()
+	g2 := bls12381.NewG2()
+	eng := bls12381.NewEngine()
+	aggPoP := g1.Zero()
+	scalarBytes := make([]byte, 8)
+	for i, pub := range pubs {
+		pop := pops[i]
+		if pop == nil || g1.IsZero(&pop.pointG1) {
+			return errors.Errorf(errors.ErrInvalidSignature,
+				"proof of possession is zero")
+		}
+		if g2.IsZero(&pub.pointG2) {
+			return errors.Errorf(errors.ErrInvalidPublicKey,
+				"public key is zero")
+		}
+
+		if _, err := rand.Read(scalarBytes); err != nil {
+			return err
+		}
+		// The scalar should not be zero, otherwise the proof is ignored.
+		scalarBytes[0] |= 0x80
+		scalar := new(big.Int).SetBytes(scalarBytes)
+
+		q, err := g1.HashToCurve(pub.Bytes(), popDST)
+		if err != nil {
+			panic(err)
+		}
+		g1.MulScalarBig(q, q, scalar)
+		eng.AddPair(q, pub.point())
+
+		r := g1.MulScalarBig(g1.New(), &pop.pointG1, scalar)
+		g1.Add(aggPoP, aggPoP, r)
+	}
+
+	g2one := g2.New().Set(&bls12381.G2One)
+	eng.AddPairInv(aggPoP, g2one)
+
+	if !eng.Check() {
+		return crypto.ErrInvalidSignature
+	}
+
+	return nil
+}
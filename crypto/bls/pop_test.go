@@ -0,0 +1,67 @@
+package bls_test
+
+import (
+	"testing"
+
+	"github.com/pactus-project/pactus/crypto"
+	"github.com/pactus-project/pactus/crypto/bls"
+	"github.com/pactus-project/pactus/util/testsuite"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProofOfPossession(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	pub1, prv1 := ts.RandBLSKeyPair()
+	_, prv2 := ts.RandBLSKeyPair()
+	pop1 := prv1.ProofOfPossession()
+	pop2 := prv2.ProofOfPossession()
+
+	assert.NoError(t, pub1.VerifyProofOfPossession(pop1))
+	assert.ErrorIs(t, pub1.VerifyProofOfPossession(pop2), crypto.ErrInvalidSignature)
+
+	t.Run("A normal signature of the public key is not a proof", func(t *testing.T) {
+		sig := prv1.SignNative(pub1.Bytes())
+
+		assert.ErrorIs(t, pub1.VerifyProofOfPossession(sig), crypto.ErrInvalidSignature)
+	})
+
+	t.Run("Validator key", func(t *testing.T) {
+		valKey := bls.NewValidatorKey(prv1)
+
+		assert.Equal(t, pop1, valKey.ProofOfPossession())
+	})
+}
+
+func TestVerifyProofsOfPossession(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	pubs := make([]*bls.PublicKey, 0, 4)
+	pops := make([]*bls.Signature, 0, 4)
+	for i := 0; i < 4; i++ {
+		pub, prv := ts.RandBLSKeyPair()
+		pubs = append(pubs, pub)
+		pops = append(pops, prv.ProofOfPossession())
+	}
+
+	t.Run("Valid proofs", func(t *testing.T) {
+		assert.NoError(t, bls.VerifyProofsOfPossession(pubs, pops))
+		assert.NoError(t, bls.VerifyProofsOfPossession(nil, nil))
+	})
+
+	t.Run("Mismatched length", func(t *testing.T) {
+		assert.Error(t, bls.VerifyProofsOfPossession(pubs, pops[:3]))
+	})
+
+	t.Run("Nil proof", func(t *testing.T) {
+		assert.Error(t, bls.VerifyProofsOfPossession(pubs[:1], []*bls.Signature{nil}))
+	})
+
+	t.Run("Swapped proofs", func(t *testing.T) {
+		// The sum of the swapped proofs is the same, so they pass if they are not randomized.
+		swapped := []*bls.Signature{pops[1], pops[0], pops[2], pops[3]}
+
+		assert.ErrorIs(t, bls.VerifyProofsOfPossession(pubs, swapped), crypto.ErrInvalidSignature)
+	})
+
+}
@@ -0,0 +1,27 @@
+package bls
+
+// Signer signs on behalf of a validator.
+// Unlike the validator key, the key of a signer can be kept out of the node,
+// like in a remote signer, so signing can fail or take time.
+type Signer interface {
+	PublicKey() *PublicKey
+	Sign(data []byte) (*Signature, error)
+}
+
+// keySigner signs with a validator key that is loaded in the node.
+type keySigner struct {
+	key *ValidatorKey
+}
+
+// NewKeySigner returns a signer that signs with the validator key.
+func NewKeySigner(key *ValidatorKey) Signer {
+	return &keySigner{key: key}
+}
+
+func (s *keySigner) PublicKey() *PublicKey {
+	return s.key.PublicKey()
+}
+
+func (s *keySigner) Sign(data []byte) (*Signature, error) {
+	return s.key.Sign(data), nil
+}
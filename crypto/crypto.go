@@ -12,3 +12,116 @@ var (
 	// XPrivateKeyHRP is the Human Readable Part (HRP) for extended private key.
 	XPrivateKeyHRP = "xsecret"
 )
+
+// maxHRPLength is the maximum length of a custom human-readable part.
+const maxHRPLength = 16
+
+// HRP contains the human-readable parts of the addresses and keys of a network.
+// The empty parts in a custom HRP are replaced with the defaults of the network.
+type HRP struct {
+	Address     string `cbor:"1,keyasint,omitempty" json:"address,omitempty"`
+	PublicKey   string `cbor:"2,keyasint,omitempty" json:"public_key,omitempty"`
+	PrivateKey  string `cbor:"3,keyasint,omitempty" json:"private_key,omitempty"`
+	XPublicKey  string `cbor:"4,keyasint,omitempty" json:"xpublic_key,omitempty"`
+	XPrivateKey string `cbor:"5,keyasint,omitempty" json:"xprivate_key,omitempty"`
+}
+
+var (
+	// MainnetHRP contains the human-readable parts of the Mainnet.
+	MainnetHRP = HRP{
+		Address:     "pc",
+		PublicKey:   "public",
+		PrivateKey:  "secret",
+		XPublicKey:  "xpublic",
+		XPrivateKey: "xsecret",
+	}
+	// TestnetHRP contains the human-readable parts of the Testnet and Localnet.
+	TestnetHRP = HRP{
+		Address:     "tpc",
+		PublicKey:   "tpublic",
+		PrivateKey:  "tsecret",
+		XPublicKey:  "txpublic",
+		XPrivateKey: "txsecret",
+	}
+)
+
+// CurrentHRP returns the human-readable parts that are used for encoding and decoding.
+func CurrentHRP() HRP {
+	return HRP{
+		Address:     AddressHRP,
+		PublicKey:   PublicKeyHRP,
+		PrivateKey:  PrivateKeyHRP,
+		XPublicKey:  XPublicKeyHRP,
+		XPrivateKey: XPrivateKeyHRP,
+	}
+}
+
+// SetHRP sets the human-readable parts that are used for encoding and decoding.
+func SetHRP(hrp HRP) {
+	AddressHRP = hrp.Address
+	PublicKeyHRP = hrp.PublicKey
+	PrivateKeyHRP = hrp.PrivateKey
+	XPublicKeyHRP = hrp.XPublicKey
+	XPrivateKeyHRP = hrp.XPrivateKey
+}
+
+// IsEmpty checks if none of the human-readable parts is set.
+func (hrp HRP) IsEmpty() bool {
+	return hrp == HRP{}
+}
+
+// WithDefaults returns a copy of the HRP that its empty parts are set from the defaults.
+func (hrp HRP) WithDefaults(def HRP) HRP {
+	pick := func(part, defPart string) string {
+		if part == "" {
+			return defPart
+		}
+
+		return part
+	}
+
+	return HRP{
+		Address:     pick(hrp.Address, def.Address),
+		PublicKey:   pick(hrp.PublicKey, def.PublicKey),
+		PrivateKey:  pick(hrp.PrivateKey, def.PrivateKey),
+		XPublicKey:  pick(hrp.XPublicKey, def.XPublicKey),
+		XPrivateKey: pick(hrp.XPrivateKey, def.XPrivateKey),
+	}
+}
+
+// BasicCheck checks that the set parts are valid and distinct.
+// A valid part has lowercase letters and digits only, and starts with a letter.
+func (hrp HRP) BasicCheck() error {
+	parts := []string{hrp.Address, hrp.PublicKey, hrp.PrivateKey, hrp.XPublicKey, hrp.XPrivateKey}
+	seen := make(map[string]bool)
+	for _, part := range parts {
+		if part == "" {
+			continue
+		}
+		if !isValidHRP(part) {
+			return InvalidHRPError(part)
+		}
+		if seen[part] {
+			return DuplicatedHRPError(part)
+		}
+		seen[part] = true
+	}
+
+	return nil
+}
+
+func isValidHRP(part string) bool {
+	if part == "" || len(part) > maxHRPLength {
+		return false
+	}
+	if part[0] < 'a' || part[0] > 'z' {
+		return false
+	}
+	for _, c := range part {
+		if (c < 'a' || c > 'z') && (c < '0' || c > '9') {
+			return false
+		}
+	}
+
+	return true
+}
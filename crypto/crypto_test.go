@@ -0,0 +1,139 @@
+package crypto_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/pactus-project/pactus/crypto"
+	"github.com/pactus-project/pactus/crypto/bls"
+	"github.com/pactus-project/pactus/util/testsuite"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHRPBasicCheck(t *testing.T) {
+	tests := []struct {
+		hrp crypto.HRP
+		err error
+	}{
+		{crypto.HRP{}, nil},
+		{crypto.MainnetHRP, nil},
+		{crypto.TestnetHRP, nil},
+		{crypto.HRP{Address: "mynet1"}, nil},
+		{crypto.HRP{Address: "MyNet"}, crypto.InvalidHRPError("MyNet")},
+		{crypto.HRP{Address: "1net"}, crypto.InvalidHRPError("1net")},
+		{crypto.HRP{Address: "my-net"}, crypto.InvalidHRPError("my-net")},
+		{crypto.HRP{Address: "abcdefghijklmnopq"}, crypto.InvalidHRPError("abcdefghijklmnopq")},
+		{crypto.HRP{Address: "mynet", PublicKey: "mynet"}, crypto.DuplicatedHRPError("mynet")},
+	}
+
+	for i, test := range tests {
+		err := test.hrp.BasicCheck()
+		assert.Equal(t, test.err, err, "test %v failed", i)
+	}
+}
+
+func TestHRPWithDefaults(t *testing.T) {
+	hrp := crypto.HRP{Address: "mynet", PrivateKey: "mysecret"}.WithDefaults(crypto.TestnetHRP)
+
+	assert.Equal(t, "mynet", hrp.Address)
+	assert.Equal(t, "tpublic", hrp.PublicKey)
+	assert.Equal(t, "mysecret", hrp.PrivateKey)
+	assert.Equal(t, "txpublic", hrp.XPublicKey)
+	assert.Equal(t, "txsecret", hrp.XPrivateKey)
+
+	assert.True(t, crypto.HRP{}.IsEmpty())
+	assert.False(t, hrp.IsEmpty())
+	assert.Equal(t, crypto.MainnetHRP, crypto.HRP{}.WithDefaults(crypto.MainnetHRP))
+}
+
+func TestSetHRP(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	current := crypto.CurrentHRP()
+	t.Cleanup(func() { crypto.SetHRP(current) })
+
+	hrp := crypto.HRP{Address: "mynet"}.WithDefaults(crypto.TestnetHRP)
+	crypto.SetHRP(hrp)
+	assert.Equal(t, hrp, crypto.CurrentHRP())
+
+	pub, prv := ts.RandBLSKeyPair()
+	addr := pub.AccountAddress()
+	addrStr := addr.String()
+	pubStr := pub.String()
+	assert.Equal(t, "mynet1", addrStr[:6])
+	assert.Equal(t, "tpublic1", pubStr[:8])
+	assert.Equal(t, "TSECRET1", prv.String()[:8])
+
+	decoded, err := crypto.AddressFromString(addrStr)
+	require.NoError(t, err)
+	assert.Equal(t, addr, decoded)
+
+	// The addresses of the other networks are rejected.
+	crypto.SetHRP(crypto.MainnetHRP)
+	_, err = crypto.AddressFromString(addrStr)
+	assert.ErrorIs(t, err, crypto.InvalidHRPError("mynet"))
+	_, err = bls.PublicKeyFromString(pubStr)
+	assert.Error(t, err)
+}
+
+func TestSignDomain(t *testing.T) {
+	signBytes := []byte{1, 2, 3}
+
+	t.Run("Empty domain", func(t *testing.T) {
+		domain := crypto.SignDomain{}
+		assert.False(t, domain.IsActive(0))
+		assert.Equal(t, signBytes, domain.Apply(100, signBytes))
+	})
+
+	t.Run("Before activation", func(t *testing.T) {
+		domain := crypto.SignDomain{ChainID: "mynet", ActivationHeight: 100}
+		assert.False(t, domain.IsActive(99))
+		assert.Equal(t, signBytes, domain.Apply(99, signBytes))
+	})
+
+	t.Run("After activation", func(t *testing.T) {
+		domain := crypto.SignDomain{ChainID: "mynet", ActivationHeight: 100}
+		assert.True(t, domain.IsActive(100))
+		assert.Equal(t, []byte{5, 'm', 'y', 'n', 'e', 't', 1, 2, 3}, domain.Apply(100, signBytes))
+	})
+
+	t.Run("Wrap", func(t *testing.T) {
+		domain := crypto.SignDomain{ChainID: "mynet", ActivationHeight: 100}
+		assert.Equal(t, []byte{5, 'm', 'y', 'n', 'e', 't', 1, 2, 3}, domain.Wrap(signBytes))
+		assert.Equal(t, signBytes, crypto.SignDomain{}.Wrap(signBytes))
+	})
+
+	t.Run("Different chains", func(t *testing.T) {
+		domain1 := crypto.SignDomain{ChainID: "net1"}
+		domain2 := crypto.SignDomain{ChainID: "net2"}
+		assert.NotEqual(t, domain1.Apply(1, signBytes), domain2.Apply(1, signBytes))
+	})
+
+	t.Run("Invalid chain ID", func(t *testing.T) {
+		chainID := strings.Repeat("a", 65)
+		domain := crypto.SignDomain{ChainID: chainID}
+		assert.ErrorIs(t, domain.BasicCheck(), crypto.InvalidChainIDError(chainID))
+		assert.NoError(t, crypto.SignDomain{ChainID: "mynet"}.BasicCheck())
+	})
+}
+
+func TestSetSignDomain(t *testing.T) {
+	current := crypto.CurrentSignDomain()
+	t.Cleanup(func() { crypto.SetSignDomain(current) })
+
+	domain := crypto.SignDomain{ChainID: "mynet", ActivationHeight: 10}
+
+	// The domain can be read while it is being set.
+	done := make(chan struct{})
+	go func() {
+		for i := 0; i < 100; i++ {
+			_ = crypto.CurrentSignDomain()
+		}
+		close(done)
+	}()
+	crypto.SetSignDomain(domain)
+	<-done
+
+	assert.Equal(t, domain, crypto.CurrentSignDomain())
+}
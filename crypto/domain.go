@@ -0,0 +1,78 @@
+package crypto
+
+import "sync"
+
+// maxChainIDLength is the maximum length of a chain ID.
+const maxChainIDLength = 64
+
+// SignDomain separates the signatures of different networks.
+// When it is active, the chain ID is prepended to the sign bytes of the transactions, votes and proposals,
+// so a signature made for one network is never valid on another one.
+//
+// The existing networks activate it at a specific height to keep their history valid.
+// Before that height, the legacy sign bytes without the chain ID are used.
+type SignDomain struct {
+	ChainID          string
+	ActivationHeight uint32
+}
+
+var (
+	currentSignDomainLk sync.RWMutex
+	currentSignDomain   SignDomain
+)
+
+// CurrentSignDomain returns the sign domain that is used for signing and verifying.
+func CurrentSignDomain() SignDomain {
+	currentSignDomainLk.RLock()
+	defer currentSignDomainLk.RUnlock()
+
+	return currentSignDomain
+}
+
+// SetSignDomain sets the sign domain that is used for signing and verifying.
+func SetSignDomain(domain SignDomain) {
+	currentSignDomainLk.Lock()
+	defer currentSignDomainLk.Unlock()
+
+	currentSignDomain = domain
+}
+
+// IsActive checks if the chain ID should be included in the sign bytes at the given height.
+func (d SignDomain) IsActive(height uint32) bool {
+	return d.ChainID != "" && height >= d.ActivationHeight
+}
+
+// BasicCheck checks that the chain ID is not too long.
+func (d SignDomain) BasicCheck() error {
+	if len(d.ChainID) > maxChainIDLength {
+		return InvalidChainIDError(d.ChainID)
+	}
+
+	return nil
+}
+
+// Apply prepends the length-prefixed chain ID to the sign bytes if the domain is active
+// at the given height. Otherwise, it returns the sign bytes untouched.
+func (d SignDomain) Apply(height uint32, signBytes []byte) []byte {
+	if !d.IsActive(height) {
+		return signBytes
+	}
+
+	return d.Wrap(signBytes)
+}
+
+// Wrap prepends the length-prefixed chain ID to the sign bytes, regardless of the activation height.
+// It is used by the sign bytes that are only valid when the domain is active,
+// like the transactions that are signed with the chain ID.
+// If the chain ID is not set, it returns the sign bytes untouched.
+func (d SignDomain) Wrap(signBytes []byte) []byte {
+	if d.ChainID == "" {
+		return signBytes
+	}
+
+	sb := make([]byte, 0, 1+len(d.ChainID)+len(signBytes))
+	sb = append(sb, byte(len(d.ChainID)))
+	sb = append(sb, d.ChainID...)
+
+	return append(sb, signBytes...)
+}
@@ -24,6 +24,21 @@ func (e InvalidHRPError) Error() string {
 	return fmt.Sprintf("invalid HRP: %s", string(e))
 }
 
+// DuplicatedHRPError is returned when the same HRP is used for
+// different types of addresses or keys.
+type DuplicatedHRPError string
+
+func (e DuplicatedHRPError) Error() string {
+	return fmt.Sprintf("duplicated HRP: %s", string(e))
+}
+
+// InvalidChainIDError is returned when the chain ID of a sign domain is not valid.
+type InvalidChainIDError string
+
+func (e InvalidChainIDError) Error() string {
+	return fmt.Sprintf("invalid chain ID: %s", string(e))
+}
+
 // InvalidAddressTypeError is returned when the address type is not recognized or supported.
 type InvalidAddressTypeError int
 
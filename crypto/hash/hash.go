@@ -57,10 +57,9 @@ func FromBytes(data []byte) (Hash, error) {
 	return h, nil
 }
 
+// CalcHash calculates the hash of the data by the default hash version.
 func CalcHash(data []byte) Hash {
-	h, _ := FromBytes(Hash256(data))
-
-	return h
+	return DefaultHasher().CalcHash(data)
 }
 
 func (h Hash) String() string {
@@ -57,3 +57,24 @@ func TestHashBasicCheck(t *testing.T) {
 	assert.True(t, h.IsUndef())
 	assert.Equal(t, hash.UndefHash.Bytes(), h.Bytes())
 }
+
+func TestHasher(t *testing.T) {
+	hasher, err := hash.NewHasher(hash.VersionBlake2b)
+	assert.NoError(t, err)
+	assert.Equal(t, hash.VersionBlake2b, hasher.Version())
+	assert.Equal(t, hash.DefaultHasher(), hasher)
+
+	data := []byte("pactus")
+	assert.Equal(t, hash.Hash256(data), hasher.CalcHash(data).Bytes())
+	assert.Equal(t, hash.CalcHash(data), hasher.CalcHash(data))
+
+	_, err = hash.NewHasher(0)
+	assert.ErrorIs(t, err, hash.UnknownVersionError{Version: 0})
+}
+
+func BenchmarkCalcHash(b *testing.B) {
+	data := make([]byte, 1024)
+	for i := 0; i < b.N; i++ {
+		hash.CalcHash(data)
+	}
+}
@@ -0,0 +1,62 @@
+package hash
+
+import (
+	"fmt"
+
+	"golang.org/x/crypto/blake2b"
+)
+
+// Version is the version of the hash function.
+// Each protocol version uses a hash version, so a new hash function can be activated
+// by a new protocol version, without changing the callers.
+type Version uint8
+
+const (
+	VersionBlake2b Version = 1
+
+	DefaultVersion = VersionBlake2b
+)
+
+// Hasher calculates the hashes by the hash function of a version.
+type Hasher interface {
+	Version() Version
+	CalcHash(data []byte) Hash
+}
+
+// UnknownVersionError is returned when the hash version is not supported.
+type UnknownVersionError struct {
+	Version Version
+}
+
+func (e UnknownVersionError) Error() string {
+	return fmt.Sprintf("unknown hash version: %d", e.Version)
+}
+
+type blake2bHasher struct{}
+
+func (blake2bHasher) Version() Version {
+	return VersionBlake2b
+}
+
+func (blake2bHasher) CalcHash(data []byte) Hash {
+	return blake2b.Sum256(data)
+}
+
+var hashers = map[Version]Hasher{
+	VersionBlake2b: blake2bHasher{},
+}
+
+// NewHasher returns the hasher of the hash version.
+func NewHasher(ver Version) (Hasher, error) {
+	hasher, ok := hashers[ver]
+	if !ok {
+		return nil, UnknownVersionError{Version: ver}
+	}
+
+	return hasher, nil
+}
+
+// DefaultHasher returns the hasher of the default hash version.
+func DefaultHasher() Hasher {
+	return hashers[DefaultVersion]
+}
@@ -0,0 +1,69 @@
+package execution
+
+import (
+	"fmt"
+
+	"github.com/pactus-project/pactus/sandbox"
+	"github.com/pactus-project/pactus/types/tx"
+	"github.com/pactus-project/pactus/types/tx/payload"
+)
+
+// checkCondition evaluates the spending condition of the account that signs the transaction.
+// The accounts without a condition, and the validators, are not restricted.
+func (*Execution) checkCondition(trx *tx.Tx, sb sandbox.Sandbox) error {
+	if trx.IsSubsidyTx() {
+		return nil
+	}
+
+	signer := trx.Payload().Signer()
+	if !signer.IsAccountAddress() {
+		return nil
+	}
+
+	acc := sb.Account(signer)
+	if acc == nil || acc.Condition() == nil {
+		return nil
+	}
+	cond := acc.Condition()
+
+	if sb.CurrentHeight() < cond.UnlockHeight {
+		return UnmetConditionError{
+			Reason: fmt.Sprintf("account is locked until height %v", cond.UnlockHeight),
+		}
+	}
+
+	if cond.MaxAmount > 0 && trx.Payload().Value() > cond.MaxAmount {
+		return UnmetConditionError{
+			Reason: fmt.Sprintf("amount is more than %v", cond.MaxAmount),
+		}
+	}
+
+	if cond.Threshold > 0 {
+		pld, ok := trx.Payload().(*payload.ConditionPayload)
+		if !ok {
+			return UnmetConditionError{
+				Reason: fmt.Sprintf("account requires %v approvals", cond.Threshold),
+			}
+		}
+
+		approved := 0
+		signBytes := pld.ApprovalSignBytes(trx.LockTime())
+		for _, approval := range pld.Approvals {
+			if !cond.IsCosigner(approval.PublicKey.AccountAddress()) {
+				continue
+			}
+			if err := approval.PublicKey.Verify(signBytes, approval.Signature); err != nil {
+				continue
+			}
+			approved++
+		}
+
+		if approved < int(cond.Threshold) {
+			return UnmetConditionError{
+				Reason: fmt.Sprintf("account requires %v approvals, got %v", cond.Threshold, approved),
+			}
+		}
+	}
+
+	return nil
+}
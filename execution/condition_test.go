@@ -0,0 +1,143 @@
+package execution
+
+import (
+	"testing"
+
+	"github.com/pactus-project/pactus/crypto"
+	"github.com/pactus-project/pactus/crypto/bls"
+	"github.com/pactus-project/pactus/sandbox"
+	"github.com/pactus-project/pactus/types/account"
+	"github.com/pactus-project/pactus/types/tx"
+	"github.com/pactus-project/pactus/types/tx/payload"
+	"github.com/pactus-project/pactus/util/errors"
+	"github.com/pactus-project/pactus/util/testsuite"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSpendingCondition(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	sb := sandbox.MockingSandbox(ts)
+	exe := NewExecutor()
+	_ = sb.TestStore.AddTestBlock(1000)
+
+	pub, prv := ts.RandBLSKeyPair()
+	addr := pub.AccountAddress()
+	acc := sb.MakeNewAccount(addr)
+	acc.AddToBalance(100 * 1e9)
+	sb.UpdateAccount(addr, acc)
+
+	cosignerPub1, cosignerPrv1 := ts.RandBLSKeyPair()
+	cosignerPub2, cosignerPrv2 := ts.RandBLSKeyPair()
+	_, strangerPrv := ts.RandBLSKeyPair()
+
+	setCondition := func(cond *account.Condition) {
+		acc := sb.Account(addr)
+		acc.SetCondition(cond)
+		sb.UpdateAccount(addr, acc)
+	}
+
+	transferTx := func(amount int64) *tx.Tx {
+		fee, err := CalculatePayloadFee(amount, payload.TypeTransfer, sb.Params())
+		require.NoError(t, err)
+		trx := tx.NewTransferTx(sb.CurrentHeight(), addr, ts.RandAccAddress(), amount, fee, "")
+		ts.HelperSignTransaction(prv, trx)
+
+		return trx
+	}
+
+	conditionTx := func(amount int64, cond *account.Condition, approvers ...*bls.PrivateKey) *tx.Tx {
+		fee, err := CalculatePayloadFee(amount, payload.TypeCondition, sb.Params())
+		require.NoError(t, err)
+		trx := tx.NewConditionTx(sb.CurrentHeight(), addr, ts.RandAccAddress(), amount, cond, fee, "")
+		pld := trx.Payload().(*payload.ConditionPayload)
+		for _, approver := range approvers {
+			pld.Approvals = append(pld.Approvals, payload.Approval{
+				PublicKey: approver.PublicKeyNative(),
+				Signature: approver.SignNative(pld.ApprovalSignBytes(trx.LockTime())),
+			})
+		}
+		ts.HelperSignTransaction(prv, trx)
+
+		return trx
+	}
+
+	t.Run("No condition, transfer is accepted", func(t *testing.T) {
+		assert.NoError(t, exe.Execute(transferTx(1e9), sb))
+	})
+
+	t.Run("Locked account, transfer is rejected", func(t *testing.T) {
+		setCondition(&account.Condition{UnlockHeight: 2000})
+
+		err := exe.Execute(transferTx(1e9), sb)
+		assert.ErrorIs(t, err, UnmetConditionError{
+			Reason: "account is locked until height 2000",
+		})
+		assert.Equal(t, errors.ErrInvalidTx, errors.Code(err))
+	})
+
+	t.Run("Locked account, removing the condition is rejected", func(t *testing.T) {
+		err := exe.Execute(conditionTx(0, &account.Condition{}), sb)
+		assert.Equal(t, errors.ErrInvalidTx, errors.Code(err))
+	})
+
+	t.Run("Unlocked account, transfer is accepted", func(t *testing.T) {
+		setCondition(&account.Condition{UnlockHeight: sb.CurrentHeight()})
+
+		assert.NoError(t, exe.Execute(transferTx(1e9), sb))
+	})
+
+	t.Run("Amount ceiling", func(t *testing.T) {
+		setCondition(&account.Condition{MaxAmount: 1e9})
+
+		err := exe.Execute(transferTx(1e9+1), sb)
+		assert.ErrorIs(t, err, UnmetConditionError{
+			Reason: "amount is more than 1000000000",
+		})
+		assert.NoError(t, exe.Execute(transferTx(1e9), sb))
+	})
+
+	t.Run("Multi-key", func(t *testing.T) {
+		setCondition(&account.Condition{
+			Threshold: 2,
+			Cosigners: []crypto.Address{
+				cosignerPub1.AccountAddress(),
+				cosignerPub2.AccountAddress(),
+			},
+		})
+
+		err := exe.Execute(transferTx(1e9), sb)
+		assert.ErrorIs(t, err, UnmetConditionError{
+			Reason: "account requires 2 approvals",
+		})
+
+		err = exe.Execute(conditionTx(1e9, nil, cosignerPrv1), sb)
+		assert.ErrorIs(t, err, UnmetConditionError{
+			Reason: "account requires 2 approvals, got 1",
+		})
+
+		err = exe.Execute(conditionTx(1e9, nil, cosignerPrv1, strangerPrv), sb)
+		assert.ErrorIs(t, err, UnmetConditionError{
+			Reason: "account requires 2 approvals, got 1",
+		})
+
+		assert.NoError(t, exe.Execute(conditionTx(1e9, nil, cosignerPrv1, cosignerPrv2), sb))
+		assert.NotNil(t, sb.Account(addr).Condition())
+	})
+
+	t.Run("Multi-key, tampered approval", func(t *testing.T) {
+		trx := conditionTx(1e9, nil, cosignerPrv1, cosignerPrv2)
+		trx.Payload().(*payload.ConditionPayload).Amount++
+		ts.HelperSignTransaction(prv, trx)
+
+		err := exe.Execute(trx, sb)
+		assert.Equal(t, errors.ErrInvalidTx, errors.Code(err))
+	})
+
+	t.Run("Multi-key, removing the condition", func(t *testing.T) {
+		assert.NoError(t, exe.Execute(conditionTx(0, &account.Condition{}, cosignerPrv1, cosignerPrv2), sb))
+		assert.Nil(t, sb.Account(addr).Condition())
+		assert.NoError(t, exe.Execute(transferTx(1e9), sb))
+	})
+}
@@ -0,0 +1,44 @@
+package execution
+
+import (
+	"github.com/pactus-project/pactus/types/param"
+	"github.com/pactus-project/pactus/types/tx/payload"
+)
+
+// Engine defines the execution rules of the chain.
+// The private chains can wrap the default engine to add their own rules,
+// like permissioned accounts, without changing the consensus and sync.
+type Engine interface {
+	// Executor returns an executor that executes the transactions of a block.
+	// It rejects the transactions that can't be committed in the next block.
+	Executor() Executor
+	// Checker returns an executor that checks the transactions before they enter the pool.
+	// It accepts the transactions that might be committed later, like the ones with a future lock time.
+	Checker() Executor
+	// CalculateFee returns the fee of a transaction with the given amount and payload type.
+	// The engines that change it should also create their executor and checker
+	// by NewExecutorWithFee and NewCheckerWithFee, so the fees are checked by the same rules.
+	CalculateFee(amount int64, payloadType payload.Type, params *param.Params) (int64, error)
+}
+
+var _ Engine = defaultEngine{}
+
+// defaultEngine is the reference engine that implements the execution rules of Pactus.
+type defaultEngine struct{}
+
+// DefaultEngine returns the reference execution engine.
+func DefaultEngine() Engine {
+	return defaultEngine{}
+}
+
+func (defaultEngine) Executor() Executor {
+	return NewExecutor()
+}
+
+func (defaultEngine) Checker() Executor {
+	return NewChecker()
+}
+
+func (defaultEngine) CalculateFee(amount int64, payloadType payload.Type, params *param.Params) (int64, error) {
+	return CalculatePayloadFee(amount, payloadType, params)
+}
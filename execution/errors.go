@@ -2,9 +2,11 @@ package execution
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/pactus-project/pactus/types/tx"
 	"github.com/pactus-project/pactus/types/tx/payload"
+	"github.com/pactus-project/pactus/util/errors"
 )
 
 // TransactionCommittedError is returned when an attempt is made
@@ -20,6 +22,10 @@ func (e TransactionCommittedError) Error() string {
 		e.ID.String())
 }
 
+func (e TransactionCommittedError) Code() int {
+	return errors.ErrTxCommitted
+}
+
 // UnknownPayloadTypeError is returned when transaction payload type
 // is not valid.
 type UnknownPayloadTypeError struct {
@@ -31,6 +37,42 @@ func (e UnknownPayloadTypeError) Error() string {
 		e.PayloadType.String())
 }
 
+func (e UnknownPayloadTypeError) Code() int {
+	return errors.ErrUnknownPayloadType
+}
+
+// PayloadNotActiveError is returned when the payload type of a transaction
+// is not accepted yet at the current height.
+type PayloadNotActiveError struct {
+	PayloadType payload.Type
+	Height      uint32
+}
+
+func (e PayloadNotActiveError) Error() string {
+	return fmt.Sprintf("payload type %s is not active at height %v",
+		e.PayloadType.String(), e.Height)
+}
+
+func (e PayloadNotActiveError) Code() int {
+	return errors.ErrUnknownPayloadType
+}
+
+// SignDomainMismatchError is returned when a transaction is signed with the chain ID
+// while the sign domain is not active at the current height, or vice versa.
+type SignDomainMismatchError struct {
+	Version uint8
+	Height  uint32
+}
+
+func (e SignDomainMismatchError) Error() string {
+	return fmt.Sprintf("transaction version %v doesn't match the sign domain at height %v",
+		e.Version, e.Height)
+}
+
+func (e SignDomainMismatchError) Code() int {
+	return errors.ErrInvalidTx
+}
+
 // PastLockTimeError is returned when the lock time of a transaction
 // is in the past and has expired,
 // indicating the transaction can no longer be executed.
@@ -39,7 +81,11 @@ type PastLockTimeError struct {
 }
 
 func (e PastLockTimeError) Error() string {
-	return fmt.Sprintf("Transaction's lock time is in the past: %v", e.LockTime)
+	return fmt.Sprintf("Transaction's lock time is in the past: %v", lockTimeString(e.LockTime))
+}
+
+func (e PastLockTimeError) Code() int {
+	return errors.ErrPastLockTime
 }
 
 // FutureLockTimeError is returned when the lock time of a transaction
@@ -50,5 +96,32 @@ type FutureLockTimeError struct {
 }
 
 func (e FutureLockTimeError) Error() string {
-	return fmt.Sprintf("Transaction's lock time is in the future: %v", e.LockTime)
+	return fmt.Sprintf("Transaction's lock time is in the future: %v", lockTimeString(e.LockTime))
+}
+
+func (e FutureLockTimeError) Code() int {
+	return errors.ErrFutureLockTime
+}
+
+func lockTimeString(lockTime uint32) string {
+	if tx.IsTimestampLockTime(lockTime) {
+		return fmt.Sprintf("%d (%s)", lockTime,
+			time.Unix(int64(lockTime), 0).UTC().Format(time.RFC3339))
+	}
+
+	return fmt.Sprintf("%d", lockTime)
+}
+
+// UnmetConditionError is returned when a transaction doesn't meet
+// the spending conditions of the account that signs it.
+type UnmetConditionError struct {
+	Reason string
+}
+
+func (e UnmetConditionError) Error() string {
+	return fmt.Sprintf("spending condition is not met: %s", e.Reason)
+}
+
+func (e UnmetConditionError) Code() int {
+	return errors.ErrInvalidTx
 }
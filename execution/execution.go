@@ -1,6 +1,7 @@
 package execution
 
 import (
+	"github.com/pactus-project/pactus/crypto"
 	"github.com/pactus-project/pactus/execution/executor"
 	"github.com/pactus-project/pactus/sandbox"
 	"github.com/pactus-project/pactus/types/param"
@@ -10,34 +11,68 @@ import (
 	"github.com/pactus-project/pactus/util/errors"
 )
 
+// DataFeePerByte is the fee, in NanoPAC, that a data transaction pays
+// for each byte of its data.
+const DataFeePerByte = int64(10_000)
+
 type Executor interface {
 	Execute(trx *tx.Tx, sb sandbox.Sandbox) error
 }
+
+// FeeCalculator calculates the fee of a transaction with the given amount and payload type.
+type FeeCalculator interface {
+	CalculateFee(amount int64, payloadType payload.Type, params *param.Params) (int64, error)
+}
+
 type Execution struct {
 	executors map[payload.Type]Executor
+	feeCalc   FeeCalculator
 	strict    bool
 }
 
-func newExecution(strict bool) *Execution {
+func newExecution(strict bool, feeCalc FeeCalculator) *Execution {
 	execs := make(map[payload.Type]Executor)
 	execs[payload.TypeTransfer] = executor.NewTransferExecutor(strict)
 	execs[payload.TypeBond] = executor.NewBondExecutor(strict)
 	execs[payload.TypeSortition] = executor.NewSortitionExecutor(strict)
 	execs[payload.TypeUnbond] = executor.NewUnbondExecutor(strict)
 	execs[payload.TypeWithdraw] = executor.NewWithdrawExecutor(strict)
+	execs[payload.TypeEscrow] = executor.NewEscrowExecutor(strict)
+	execs[payload.TypeData] = executor.NewDataExecutor(strict)
+	execs[payload.TypeAllowlist] = executor.NewAllowlistExecutor(strict)
+	execs[payload.TypeCondition] = executor.NewConditionExecutor(strict)
+	execs[payload.TypeDelegate] = executor.NewDelegateExecutor(strict)
+	execs[payload.TypeRewardSplit] = executor.NewRewardSplitExecutor(strict)
+
+	for typ, custom := range customPayloads {
+		execs[typ] = custom.NewExecutor(strict)
+	}
 
 	return &Execution{
 		executors: execs,
+		feeCalc:   feeCalc,
 		strict:    strict,
 	}
 }
 
 func NewExecutor() *Execution {
-	return newExecution(true)
+	return newExecution(true, defaultEngine{})
 }
 
 func NewChecker() *Execution {
-	return newExecution(false)
+	return newExecution(false, defaultEngine{})
+}
+
+// NewExecutorWithFee creates a new executor that checks the fee of the transactions by the fee calculator.
+// The engines that change the fees should create their executors by it.
+func NewExecutorWithFee(feeCalc FeeCalculator) *Execution {
+	return newExecution(true, feeCalc)
+}
+
+// NewCheckerWithFee creates a new checker that checks the fee of the transactions by the fee calculator.
+// The engines that change the fees should create their checkers by it.
+func NewCheckerWithFee(feeCalc FeeCalculator) *Execution {
+	return newExecution(false, feeCalc)
 }
 
 func (exe *Execution) Execute(trx *tx.Tx, sb sandbox.Sandbox) error {
@@ -47,6 +82,17 @@ func (exe *Execution) Execute(trx *tx.Tx, sb sandbox.Sandbox) error {
 		}
 	}
 
+	if !sb.Params().IsPayloadActive(trx.Payload().Type(), sb.CurrentHeight()) {
+		return PayloadNotActiveError{
+			PayloadType: trx.Payload().Type(),
+			Height:      sb.CurrentHeight(),
+		}
+	}
+
+	if err := exe.checkSignDomain(trx, sb); err != nil {
+		return err
+	}
+
 	if err := exe.checkLockTime(trx, sb); err != nil {
 		return err
 	}
@@ -55,6 +101,10 @@ func (exe *Execution) Execute(trx *tx.Tx, sb sandbox.Sandbox) error {
 		return err
 	}
 
+	if err := exe.checkCondition(trx, sb); err != nil {
+		return err
+	}
+
 	e, ok := exe.executors[trx.Payload().Type()]
 	if !ok {
 		return UnknownPayloadTypeError{
@@ -71,15 +121,33 @@ func (exe *Execution) Execute(trx *tx.Tx, sb sandbox.Sandbox) error {
 	return nil
 }
 
-func (exe *Execution) checkLockTime(trx *tx.Tx, sb sandbox.Sandbox) error {
-	interval := sb.Params().TransactionToLiveInterval
+// checkSignDomain checks that the transaction is signed with the chain ID if, and only if,
+// the sign domain is active at the current height, so a transaction has only one valid signature.
+func (*Execution) checkSignDomain(trx *tx.Tx, sb sandbox.Sandbox) error {
+	if trx.IsSignedWithChainID() != crypto.CurrentSignDomain().IsActive(sb.CurrentHeight()) {
+		return SignDomainMismatchError{
+			Version: trx.Version(),
+			Height:  sb.CurrentHeight(),
+		}
+	}
+
+	return nil
+}
 
-	if trx.IsSubsidyTx() {
-		interval = 0
-	} else if trx.IsSortitionTx() {
-		interval = sb.Params().SortitionInterval
+// checkLockTime checks the lock time of the transaction, either as a block height or as a timestamp.
+// The relative lock times are resolved into the block heights before signing, so they are not checked here.
+// Before the timestamp lock times are active, all the lock times are checked as block heights.
+func (exe *Execution) checkLockTime(trx *tx.Tx, sb sandbox.Sandbox) error {
+	if trx.IsTimestampLocked() && sb.Params().IsTimestampLockTimeActive(sb.CurrentHeight()) {
+		return exe.checkTimestampLockTime(trx, sb)
 	}
 
+	return exe.checkHeightLockTime(trx, sb)
+}
+
+func (exe *Execution) checkHeightLockTime(trx *tx.Tx, sb sandbox.Sandbox) error {
+	interval := trx.LiveInterval(sb.Params())
+
 	if sb.CurrentHeight() > interval {
 		if trx.LockTime() < sb.CurrentHeight()-interval {
 			return PastLockTimeError{
@@ -102,13 +170,46 @@ func (exe *Execution) checkLockTime(trx *tx.Tx, sb sandbox.Sandbox) error {
 	return nil
 }
 
+// checkTimestampLockTime checks the lock time of the transaction against
+// the time of the last committed block. Using the last block time, instead of
+// the proposed block time, keeps the result deterministic for all validators.
+func (exe *Execution) checkTimestampLockTime(trx *tx.Tx, sb sandbox.Sandbox) error {
+	interval := int64(trx.LiveInterval(sb.Params())) *
+		int64(sb.Params().BlockIntervalInSecond)
+	lastBlockTime := sb.LastBlockTime().Unix()
+	lockTime := int64(trx.LockTime())
+
+	if lockTime < lastBlockTime-interval {
+		return PastLockTimeError{
+			LockTime: trx.LockTime(),
+		}
+	}
+
+	if exe.strict {
+		if lockTime > lastBlockTime {
+			return FutureLockTimeError{
+				LockTime: trx.LockTime(),
+			}
+		}
+	}
+
+	return nil
+}
+
 func (exe *Execution) checkFee(trx *tx.Tx, sb sandbox.Sandbox) error {
 	if trx.IsFreeTx() {
 		if trx.Fee() != 0 {
 			return errors.Errorf(errors.ErrInvalidFee, "fee is wrong, expected: 0, got: %v", trx.Fee())
 		}
 	} else {
-		fee := CalculateFee(trx.Payload().Value(), sb.Params())
+		amount := trx.Payload().Value()
+		if trx.IsDataTx() {
+			amount = int64(len(trx.Payload().(*payload.DataPayload).Data))
+		}
+		fee, err := exe.feeCalc.CalculateFee(amount, trx.Payload().Type(), sb.Params())
+		if err != nil {
+			return err
+		}
 		if trx.Fee() != fee {
 			return errors.Errorf(errors.ErrInvalidFee, "fee is wrong, expected: %v, got: %v", fee, trx.Fee())
 		}
@@ -124,3 +225,10 @@ func CalculateFee(amt int64, params *param.Params) int64 {
 
 	return fee
 }
+
+// CalculateDataFee calculates the fee of a data transaction.
+// Unlike other transactions, the fee scales with the size of the data and
+// it is not capped by the maximum fee.
+func CalculateDataFee(dataSize int, params *param.Params) int64 {
+	return params.MinimumFee + int64(dataSize)*DataFeePerByte
+}
@@ -5,7 +5,10 @@ import (
 
 	"github.com/pactus-project/pactus/crypto"
 	"github.com/pactus-project/pactus/sandbox"
+	"github.com/pactus-project/pactus/types/param"
 	"github.com/pactus-project/pactus/types/tx"
+	"github.com/pactus-project/pactus/types/tx/payload"
+	"github.com/pactus-project/pactus/types/validator"
 	"github.com/pactus-project/pactus/util/errors"
 	"github.com/pactus-project/pactus/util/testsuite"
 	"github.com/stretchr/testify/assert"
@@ -34,6 +37,7 @@ func TestLockTime(t *testing.T) {
 		ts.HelperSignTransaction(rndPrvKey, trx)
 		err := exe.Execute(trx, sb)
 		assert.ErrorIs(t, err, FutureLockTimeError{LockTime: lockTime})
+		assert.Equal(t, errors.ErrFutureLockTime, errors.Code(err))
 	})
 
 	t.Run("Past LockTime, Should returns error (-8641)", func(t *testing.T) {
@@ -42,6 +46,7 @@ func TestLockTime(t *testing.T) {
 		ts.HelperSignTransaction(rndPrvKey, trx)
 		err := exe.Execute(trx, sb)
 		assert.ErrorIs(t, err, PastLockTimeError{LockTime: lockTime})
+		assert.Equal(t, errors.ErrPastLockTime, errors.Code(err))
 	})
 
 	t.Run("Transaction has valid LockTime (-8640)", func(t *testing.T) {
@@ -112,6 +117,86 @@ func TestLockTime(t *testing.T) {
 	})
 }
 
+func TestTimestampLockTime(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	sb := sandbox.MockingSandbox(ts)
+	exe := NewExecutor()
+	checker := NewChecker()
+	rndPubKey, rndPrvKey := ts.RandBLSKeyPair()
+	rndAccAddr := rndPubKey.AccountAddress()
+	rndAcc := sb.MakeNewAccount(rndAccAddr)
+	rndAcc.AddToBalance(100 * 1e9)
+	sb.UpdateAccount(rndAccAddr, rndAcc)
+
+	lastBlockTime := uint32(sb.LastBlockTime().Unix())
+	interval := sb.TestParams.TransactionToLiveInterval * uint32(sb.TestParams.BlockIntervalInSecond)
+
+	t.Run("Future LockTime, Should returns error (+1s)", func(t *testing.T) {
+		lockTime := lastBlockTime + 1
+		trx := tx.NewTransferTx(lockTime, rndAccAddr, ts.RandAccAddress(), 1000, 1000, "future-lockTime")
+		ts.HelperSignTransaction(rndPrvKey, trx)
+		err := exe.Execute(trx, sb)
+		assert.ErrorIs(t, err, FutureLockTimeError{LockTime: lockTime})
+
+		err = checker.Execute(trx, sb)
+		assert.NoError(t, err)
+	})
+
+	t.Run("Past LockTime, Should returns error (-1 day -1s)", func(t *testing.T) {
+		lockTime := lastBlockTime - interval - 1
+		trx := tx.NewTransferTx(lockTime, rndAccAddr, ts.RandAccAddress(), 1000, 1000, "past-lockTime")
+		ts.HelperSignTransaction(rndPrvKey, trx)
+		err := exe.Execute(trx, sb)
+		assert.ErrorIs(t, err, PastLockTimeError{LockTime: lockTime})
+	})
+
+	t.Run("Transaction has valid LockTime (-1 day)", func(t *testing.T) {
+		lockTime := lastBlockTime - interval
+		trx := tx.NewTransferTx(lockTime, rndAccAddr, ts.RandAccAddress(), 1000, 1000, "ok")
+		ts.HelperSignTransaction(rndPrvKey, trx)
+		err := exe.Execute(trx, sb)
+		assert.NoError(t, err)
+	})
+
+	t.Run("Transaction has valid LockTime (0)", func(t *testing.T) {
+		lockTime := lastBlockTime
+		trx := tx.NewTransferTx(lockTime, rndAccAddr, ts.RandAccAddress(), 1000, 1000, "ok")
+		ts.HelperSignTransaction(rndPrvKey, trx)
+		err := exe.Execute(trx, sb)
+		assert.NoError(t, err)
+	})
+
+	t.Run("Timestamp lock time is not active, Should check it as a block height", func(t *testing.T) {
+		sb.TestParams.TimestampLockTimeHeight = sb.CurrentHeight() + 1
+		defer func() { sb.TestParams.TimestampLockTimeHeight = 0 }()
+
+		lockTime := lastBlockTime
+		trx := tx.NewTransferTx(lockTime, rndAccAddr, ts.RandAccAddress(), 1000, 1000, "not-active")
+		ts.HelperSignTransaction(rndPrvKey, trx)
+		err := exe.Execute(trx, sb)
+		assert.ErrorIs(t, err, FutureLockTimeError{LockTime: lockTime})
+	})
+
+	t.Run("Timestamp lock time is active at the activation height", func(t *testing.T) {
+		sb.TestParams.TimestampLockTimeHeight = sb.CurrentHeight()
+		defer func() { sb.TestParams.TimestampLockTimeHeight = 0 }()
+
+		lockTime := lastBlockTime
+		trx := tx.NewTransferTx(lockTime, rndAccAddr, ts.RandAccAddress(), 1000, 1000, "active")
+		ts.HelperSignTransaction(rndPrvKey, trx)
+		err := exe.Execute(trx, sb)
+		assert.NoError(t, err)
+	})
+}
+
+func TestLockTimeErrorMessage(t *testing.T) {
+	assert.Equal(t, "Transaction's lock time is in the past: 1000",
+		PastLockTimeError{LockTime: 1000}.Error())
+	assert.Equal(t, "Transaction's lock time is in the future: 1700000000 (2023-11-14T22:13:20Z)",
+		FutureLockTimeError{LockTime: 1700000000}.Error())
+}
+
 func TestExecution(t *testing.T) {
 	ts := testsuite.NewTestSuite(t)
 
@@ -256,3 +341,158 @@ func TestFee(t *testing.T) {
 			"test %v failed. invalid fee", i)
 	}
 }
+
+func TestDataFee(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	exe := NewChecker()
+	sb := sandbox.MockingSandbox(ts)
+
+	tests := []struct {
+		dataSize        int
+		fee             int64
+		expectedFee     int64
+		expectedErrCode int
+	}{
+		{1, 1000, 11000, errors.ErrInvalidFee},
+		{1, 11000, 11000, errors.ErrNone},
+
+		{100, 1001000, 1001000, errors.ErrNone},
+		{100, 1000000, 1001000, errors.ErrInvalidFee},
+
+		// Data fee is not capped by the maximum fee
+		{1024, 1000000, 10241000, errors.ErrInvalidFee},
+		{1024, 10241000, 10241000, errors.ErrNone},
+	}
+
+	sender := ts.RandAccAddress()
+	for i, test := range tests {
+		trx := tx.NewDataTx(sb.CurrentHeight()+1, sender, ts.RandBytes(test.dataSize), test.fee,
+			"testing data fee")
+		err := exe.checkFee(trx, sb)
+
+		assert.Equal(t, errors.Code(err), test.expectedErrCode,
+			"test %v failed. unexpected error", i)
+
+		assert.Equal(t, CalculateDataFee(test.dataSize, sb.Params()), test.expectedFee,
+			"test %v failed. invalid fee", i)
+	}
+}
+
+// flatFeeEngine is an execution engine that charges a flat fee for all the transactions.
+type flatFeeEngine struct {
+	Engine
+	fee int64
+}
+
+func (e *flatFeeEngine) Executor() Executor {
+	return NewExecutorWithFee(e)
+}
+
+func (e *flatFeeEngine) Checker() Executor {
+	return NewCheckerWithFee(e)
+}
+
+func (e *flatFeeEngine) CalculateFee(_ int64, _ payload.Type, _ *param.Params) (int64, error) {
+	return e.fee, nil
+}
+
+func TestEngineFee(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	engine := &flatFeeEngine{Engine: DefaultEngine(), fee: 5000}
+	exe := engine.Checker().(*Execution)
+	sb := sandbox.MockingSandbox(ts)
+
+	sender := ts.RandAccAddress()
+	receiver := ts.RandAccAddress()
+
+	t.Run("The default fee should be rejected", func(t *testing.T) {
+		trx := tx.NewTransferTx(sb.CurrentHeight()+1, sender, receiver, 1, sb.TestParams.MinimumFee, "")
+		err := exe.checkFee(trx, sb)
+		assert.Equal(t, errors.ErrInvalidFee, errors.Code(err))
+	})
+
+	t.Run("The fee of the engine should be accepted", func(t *testing.T) {
+		trx := tx.NewTransferTx(sb.CurrentHeight()+1, sender, receiver, 1, engine.fee, "")
+		assert.NoError(t, exe.checkFee(trx, sb))
+	})
+}
+
+func TestPayloadActivation(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	sb := sandbox.MockingSandbox(ts)
+	exe := NewExecutor()
+	lockTime := sb.CurrentHeight()
+	defer func() { sb.TestParams.PayloadActivationHeights = nil }()
+
+	tests := []struct {
+		name string
+		trx  *tx.Tx
+	}{
+		{
+			"Escrow", tx.NewEscrowTx(lockTime, ts.RandAccAddress(), ts.RandAccAddress(), ts.RandAccAddress(),
+				1, ts.RandAccAddress(), ts.RandAccAddress(), nil, nil, 1, 1, ""),
+		},
+		{"Data", tx.NewDataTx(lockTime, ts.RandAccAddress(), []byte("data"), 1, "")},
+		{"Allowlist", tx.NewAllowlistTx(lockTime, ts.RandAccAddress(), ts.RandValAddress(), true, 1, "")},
+		{"Condition", tx.NewConditionTx(lockTime, ts.RandAccAddress(), ts.RandAccAddress(), 1, nil, 1, "")},
+		{
+			"Delegate", tx.NewDelegateTx(lockTime, ts.RandAccAddress(), ts.RandValAddress(),
+				nil, nil, 1, nil, 1, ""),
+		},
+		{
+			"Reward split", tx.NewRewardSplitTx(lockTime, ts.RandValAddress(),
+				&validator.RewardSplit{}, 1, ""),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			typ := tt.trx.Payload().Type()
+
+			sb.TestParams.PayloadActivationHeights = map[payload.Type]uint32{typ: sb.CurrentHeight() + 1}
+			err := exe.Execute(tt.trx, sb)
+			assert.ErrorIs(t, err, PayloadNotActiveError{PayloadType: typ, Height: sb.CurrentHeight()})
+			assert.Equal(t, errors.ErrUnknownPayloadType, errors.Code(err))
+
+			sb.TestParams.PayloadActivationHeights = map[payload.Type]uint32{typ: sb.CurrentHeight()}
+			err = exe.Execute(tt.trx, sb)
+			assert.NotErrorIs(t, err, PayloadNotActiveError{PayloadType: typ, Height: sb.CurrentHeight()})
+		})
+	}
+}
+
+func TestSignDomain(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	current := crypto.CurrentSignDomain()
+	t.Cleanup(func() { crypto.SetSignDomain(current) })
+
+	sb := sandbox.MockingSandbox(ts)
+	exe := NewExecutor()
+	height := sb.CurrentHeight()
+
+	crypto.SetSignDomain(crypto.SignDomain{ChainID: "mynet", ActivationHeight: height + 1})
+	legacyTrx := tx.NewTransferTx(height, ts.RandAccAddress(), ts.RandAccAddress(), 1, 1, "")
+	crypto.SetSignDomain(crypto.SignDomain{ChainID: "mynet", ActivationHeight: 0})
+	domainTrx := tx.NewTransferTx(height, ts.RandAccAddress(), ts.RandAccAddress(), 1, 1, "")
+
+	t.Run("Before activation, the legacy transactions are accepted", func(t *testing.T) {
+		crypto.SetSignDomain(crypto.SignDomain{ChainID: "mynet", ActivationHeight: height + 1})
+
+		assert.NoError(t, exe.checkSignDomain(legacyTrx, sb))
+		err := exe.checkSignDomain(domainTrx, sb)
+		assert.ErrorIs(t, err, SignDomainMismatchError{Version: domainTrx.Version(), Height: height})
+		assert.Equal(t, errors.ErrInvalidTx, errors.Code(err))
+	})
+
+	t.Run("After activation, the transactions should be signed with the chain ID", func(t *testing.T) {
+		crypto.SetSignDomain(crypto.SignDomain{ChainID: "mynet", ActivationHeight: height})
+
+		assert.NoError(t, exe.checkSignDomain(domainTrx, sb))
+		err := exe.checkSignDomain(legacyTrx, sb)
+		assert.ErrorIs(t, err, SignDomainMismatchError{Version: legacyTrx.Version(), Height: height})
+	})
+}
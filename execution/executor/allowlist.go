@@ -0,0 +1,46 @@
+package executor
+
+import (
+	"github.com/pactus-project/pactus/sandbox"
+	"github.com/pactus-project/pactus/types/tx"
+	"github.com/pactus-project/pactus/types/tx/payload"
+	"github.com/pactus-project/pactus/util/errors"
+)
+
+type AllowlistExecutor struct {
+	strict bool
+}
+
+func NewAllowlistExecutor(strict bool) *AllowlistExecutor {
+	return &AllowlistExecutor{strict: strict}
+}
+
+func (*AllowlistExecutor) Execute(trx *tx.Tx, sb sandbox.Sandbox) error {
+	pld := trx.Payload().(*payload.AllowlistPayload)
+
+	if !sb.Params().Permissioned {
+		return errors.Errorf(errors.ErrInvalidTx,
+			"network is not permissioned")
+	}
+
+	if !sb.Params().IsGovernor(pld.Governor) {
+		return errors.Errorf(errors.ErrInvalidTx,
+			"%v is not a governor", pld.Governor)
+	}
+
+	governorAcc := sb.Account(pld.Governor)
+	if governorAcc == nil {
+		return errors.Errorf(errors.ErrInvalidAddress,
+			"unable to retrieve governor account")
+	}
+
+	if governorAcc.Balance() < trx.Fee() {
+		return ErrInsufficientFunds
+	}
+
+	governorAcc.SubtractFromBalance(trx.Fee())
+	sb.UpdateAccount(pld.Governor, governorAcc)
+	sb.UpdateAllowlist(pld.Validator, pld.Allow)
+
+	return nil
+}
@@ -0,0 +1,103 @@
+package executor
+
+import (
+	"testing"
+
+	"github.com/pactus-project/pactus/types/tx"
+	"github.com/pactus-project/pactus/util/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExecuteAllowlistTx(t *testing.T) {
+	td := setup(t)
+	exe := NewAllowlistExecutor(true)
+
+	governorAddr, governorAcc := td.sandbox.TestStore.RandomTestAcc()
+	governorBalance := governorAcc.Balance()
+	valAddr := td.RandValAddress()
+	fee := td.RandInt64NonZero(1e9)
+	lockTime := td.sandbox.CurrentHeight()
+
+	t.Run("Should fail, network is not permissioned", func(t *testing.T) {
+		trx := tx.NewAllowlistTx(lockTime, governorAddr, valAddr, true, fee, "not permissioned")
+
+		err := exe.Execute(trx, td.sandbox)
+		assert.Equal(t, errors.ErrInvalidTx, errors.Code(err))
+	})
+
+	td.sandbox.TestParams.Permissioned = true
+	td.sandbox.TestParams.Governors = []string{governorAddr.String()}
+
+	t.Run("Should fail, signer is not a governor", func(t *testing.T) {
+		otherAddr, _ := td.sandbox.TestStore.RandomTestAcc()
+		for otherAddr == governorAddr {
+			otherAddr, _ = td.sandbox.TestStore.RandomTestAcc()
+		}
+		trx := tx.NewAllowlistTx(lockTime, otherAddr, valAddr, true, fee, "not governor")
+
+		err := exe.Execute(trx, td.sandbox)
+		assert.Equal(t, errors.ErrInvalidTx, errors.Code(err))
+	})
+
+	t.Run("Should fail, governor has no account", func(t *testing.T) {
+		otherAddr := td.RandAccAddress()
+		td.sandbox.TestParams.Governors = append(td.sandbox.TestParams.Governors, otherAddr.String())
+		trx := tx.NewAllowlistTx(lockTime, otherAddr, valAddr, true, fee, "no account")
+
+		err := exe.Execute(trx, td.sandbox)
+		assert.Equal(t, errors.ErrInvalidAddress, errors.Code(err))
+	})
+
+	t.Run("Should fail, insufficient balance", func(t *testing.T) {
+		trx := tx.NewAllowlistTx(lockTime, governorAddr, valAddr, true, governorBalance+1, "insufficient balance")
+
+		err := exe.Execute(trx, td.sandbox)
+		assert.ErrorIs(t, err, ErrInsufficientFunds)
+	})
+
+	t.Run("Ok, allow the validator", func(t *testing.T) {
+		trx := tx.NewAllowlistTx(lockTime, governorAddr, valAddr, true, fee, "ok")
+
+		err := exe.Execute(trx, td.sandbox)
+		assert.NoError(t, err)
+		assert.True(t, td.sandbox.IsAllowlisted(valAddr))
+	})
+
+	t.Run("Ok, disallow the validator", func(t *testing.T) {
+		trx := tx.NewAllowlistTx(lockTime, governorAddr, valAddr, false, fee, "ok")
+
+		err := exe.Execute(trx, td.sandbox)
+		assert.NoError(t, err)
+		assert.False(t, td.sandbox.IsAllowlisted(valAddr))
+	})
+
+	assert.Equal(t, governorBalance-2*fee, td.sandbox.Account(governorAddr).Balance())
+
+	td.checkTotalCoin(t, 2*fee)
+}
+
+func TestPermissionedBond(t *testing.T) {
+	td := setup(t)
+	exe := NewBondExecutor(true)
+	td.sandbox.TestParams.Permissioned = true
+
+	senderAddr, senderAcc := td.sandbox.TestStore.RandomTestAcc()
+	pub, prv := td.RandBLSKeyPair()
+	valAddr := pub.ValidatorAddress()
+	amt, fee := td.randomAmountAndFee(td.sandbox.TestParams.MinimumStake, senderAcc.Balance())
+	lockTime := td.sandbox.CurrentHeight()
+	trx := tx.NewBondTx(lockTime, senderAddr, valAddr, pub, amt, fee, "permissioned")
+	td.HelperSetProofOfPossession(prv, trx)
+
+	t.Run("Should fail, validator is not allowlisted", func(t *testing.T) {
+		err := exe.Execute(trx, td.sandbox)
+		assert.Equal(t, errors.ErrInvalidTx, errors.Code(err))
+	})
+
+	t.Run("Ok, validator is allowlisted", func(t *testing.T) {
+		td.sandbox.UpdateAllowlist(valAddr, true)
+
+		err := exe.Execute(trx, td.sandbox)
+		assert.NoError(t, err)
+	})
+}
@@ -24,16 +24,29 @@ func (e *BondExecutor) Execute(trx *tx.Tx, sb sandbox.Sandbox) error {
 			"unable to retrieve sender account")
 	}
 
+	if sb.Params().Permissioned && !sb.IsAllowlisted(pld.To) {
+		return errors.Errorf(errors.ErrInvalidTx,
+			"validator %v is not allowlisted", pld.To)
+	}
+
 	receiverVal := sb.Validator(pld.To)
 	if receiverVal == nil {
 		if pld.PublicKey == nil {
 			return errors.Errorf(errors.ErrInvalidPublicKey,
 				"public key is not set")
 		}
+		if err := e.checkProofOfPossession(pld, sb); err != nil {
+			return err
+		}
 		receiverVal = sb.MakeNewValidator(pld.PublicKey)
 	} else if pld.PublicKey != nil {
 		return errors.Errorf(errors.ErrInvalidPublicKey,
 			"public key is set")
+	} else if receiverVal.IsDelegated() && *receiverVal.Delegator() != pld.From {
+		// The stake of a delegated validator is withdrawn to its delegator,
+		// so nobody else can bond to it.
+		return errors.Errorf(errors.ErrInvalidTx,
+			"validator %v is delegated by %v", pld.To, receiverVal.Delegator())
 	}
 	if receiverVal.UnbondingHeight() > 0 {
 		return errors.Errorf(errors.ErrInvalidHeight,
@@ -79,3 +92,27 @@ func (e *BondExecutor) Execute(trx *tx.Tx, sb sandbox.Sandbox) error {
 
 	return nil
 }
+
+// checkProofOfPossession checks the proof of possession of the public key of the new validator.
+// In strict mode, the transactions of a block are executed and the proofs are verified
+// by the state in a batch, before executing the block.
+// In non-strict mode, the proof is verified here, before the transaction enters the pool.
+func (e *BondExecutor) checkProofOfPossession(pld *payload.BondPayload, sb sandbox.Sandbox) error {
+	if pld.ProofOfPossession == nil {
+		if sb.Params().IsProofOfPossessionRequired(sb.CurrentHeight()) {
+			return errors.Errorf(errors.ErrInvalidPublicKey,
+				"proof of possession is not set")
+		}
+
+		return nil
+	}
+
+	if !e.strict {
+		if err := pld.PublicKey.VerifyProofOfPossession(pld.ProofOfPossession); err != nil {
+			return errors.Errorf(errors.ErrInvalidPublicKey,
+				"invalid proof of possession: %v", err)
+		}
+	}
+
+	return nil
+}
@@ -15,7 +15,7 @@ func TestExecuteBondTx(t *testing.T) {
 
 	senderAddr, senderAcc := td.sandbox.TestStore.RandomTestAcc()
 	senderBalance := senderAcc.Balance()
-	pub, _ := td.RandBLSKeyPair()
+	pub, prv := td.RandBLSKeyPair()
 	receiverAddr := pub.ValidatorAddress()
 	amt, fee := td.randomAmountAndFee(td.sandbox.TestParams.MinimumStake, senderBalance)
 	lockTime := td.sandbox.CurrentHeight()
@@ -39,6 +39,7 @@ func TestExecuteBondTx(t *testing.T) {
 	t.Run("Should fail, insufficient balance", func(t *testing.T) {
 		trx := tx.NewBondTx(lockTime, senderAddr,
 			receiverAddr, pub, senderBalance+1, 0, "insufficient balance")
+		td.HelperSetProofOfPossession(prv, trx)
 
 		err := exe.Execute(trx, td.sandbox)
 		assert.ErrorIs(t, err, ErrInsufficientFunds)
@@ -77,14 +78,24 @@ func TestExecuteBondTx(t *testing.T) {
 	t.Run("Should fail, amount less than MinimumStake", func(t *testing.T) {
 		trx := tx.NewBondTx(lockTime, senderAddr,
 			receiverAddr, pub, 1000, fee, "less than MinimumStake")
+		td.HelperSetProofOfPossession(prv, trx)
 
 		err := exe.Execute(trx, td.sandbox)
 		assert.Equal(t, errors.ErrInvalidTx, errors.Code(err))
 	})
 
+	t.Run("Should fail, proof of possession is not set", func(t *testing.T) {
+		trx := tx.NewBondTx(lockTime, senderAddr,
+			receiverAddr, pub, amt, fee, "no proof of possession")
+
+		err := exe.Execute(trx, td.sandbox)
+		assert.Equal(t, errors.ErrInvalidPublicKey, errors.Code(err))
+	})
+
 	t.Run("Ok", func(t *testing.T) {
 		trx := tx.NewBondTx(lockTime, senderAddr,
 			receiverAddr, pub, amt, fee, "ok")
+		td.HelperSetProofOfPossession(prv, trx)
 
 		err := exe.Execute(trx, td.sandbox)
 		assert.NoError(t, err, "Ok")
@@ -93,6 +104,7 @@ func TestExecuteBondTx(t *testing.T) {
 	t.Run("Should fail, public key should not set for existing validators", func(t *testing.T) {
 		trx := tx.NewBondTx(lockTime, senderAddr,
 			receiverAddr, pub, amt, fee, "with public key")
+		td.HelperSetProofOfPossession(prv, trx)
 
 		err := exe.Execute(trx, td.sandbox)
 		assert.Equal(t, errors.Code(err), errors.ErrInvalidPublicKey)
@@ -163,11 +175,12 @@ func TestStakeExceeded(t *testing.T) {
 	senderAddr, senderAcc := td.sandbox.TestStore.RandomTestAcc()
 	senderAcc.AddToBalance(td.sandbox.TestParams.MaximumStake + 1)
 	td.sandbox.UpdateAccount(senderAddr, senderAcc)
-	pub, _ := td.RandBLSKeyPair()
+	pub, prv := td.RandBLSKeyPair()
 	lockTime := td.sandbox.CurrentHeight()
 
 	trx := tx.NewBondTx(lockTime, senderAddr,
 		pub.ValidatorAddress(), pub, amt, fee, "stake exceeded")
+	td.HelperSetProofOfPossession(prv, trx)
 
 	err := exe.Execute(trx, td.sandbox)
 	assert.Equal(t, errors.Code(err), errors.ErrInvalidAmount)
@@ -179,15 +192,52 @@ func TestPowerDeltaBond(t *testing.T) {
 
 	senderAddr, senderAcc := td.sandbox.TestStore.RandomTestAcc()
 	senderBalance := senderAcc.Balance()
-	pub, _ := td.RandBLSKeyPair()
+	pub, prv := td.RandBLSKeyPair()
 	receiverAddr := pub.ValidatorAddress()
 	amt, fee := td.randomAmountAndFee(td.sandbox.TestParams.MinimumStake, senderBalance)
 	lockTime := td.sandbox.CurrentHeight()
 	trx := tx.NewBondTx(lockTime, senderAddr,
 		receiverAddr, pub, amt, fee, "ok")
+	td.HelperSetProofOfPossession(prv, trx)
 
 	err := exe.Execute(trx, td.sandbox)
 	assert.NoError(t, err, "Ok")
 
 	assert.Equal(t, amt, td.sandbox.PowerDelta())
 }
+
+// TestProofOfPossession checks the proof of possession of the new validators.
+// In strict mode, the proof is verified by the state in a batch.
+func TestProofOfPossession(t *testing.T) {
+	td := setup(t)
+
+	exe1 := NewBondExecutor(true)
+	exe2 := NewBondExecutor(false)
+	senderAddr, senderAcc := td.sandbox.TestStore.RandomTestAcc()
+	// The sender bonds twice, so the amount is limited to the half of the balance.
+	amt, fee := td.randomAmountAndFee(td.sandbox.TestParams.MinimumStake, senderAcc.Balance()/2)
+	lockTime := td.sandbox.CurrentHeight()
+
+	t.Run("Invalid proof of possession", func(t *testing.T) {
+		pub, _ := td.RandBLSKeyPair()
+		_, otherPrv := td.RandBLSKeyPair()
+		trx := tx.NewBondTx(lockTime, senderAddr,
+			pub.ValidatorAddress(), pub, amt, fee, "invalid proof")
+		td.HelperSetProofOfPossession(otherPrv, trx)
+
+		err := exe2.Execute(trx, td.sandbox)
+		assert.Equal(t, errors.ErrInvalidPublicKey, errors.Code(err))
+		assert.NoError(t, exe1.Execute(trx, td.sandbox))
+	})
+
+	t.Run("Proof is not required before the activation height", func(t *testing.T) {
+		td.sandbox.TestParams.ProofOfPossessionHeight = td.sandbox.CurrentHeight() + 1
+		defer func() { td.sandbox.TestParams.ProofOfPossessionHeight = 0 }()
+
+		pub, _ := td.RandBLSKeyPair()
+		trx := tx.NewBondTx(lockTime, senderAddr,
+			pub.ValidatorAddress(), pub, amt, fee, "legacy bond")
+
+		assert.NoError(t, exe1.Execute(trx, td.sandbox))
+	})
+}
@@ -0,0 +1,56 @@
+package executor
+
+import (
+	"github.com/pactus-project/pactus/sandbox"
+	"github.com/pactus-project/pactus/types/tx"
+	"github.com/pactus-project/pactus/types/tx/payload"
+	"github.com/pactus-project/pactus/util/errors"
+)
+
+type ConditionExecutor struct {
+	strict bool
+}
+
+func NewConditionExecutor(strict bool) *ConditionExecutor {
+	return &ConditionExecutor{strict: strict}
+}
+
+// Execute transfers the amount and replaces the condition of the sender.
+// The current condition of the sender, including the approvals,
+// is evaluated by the execution before running the executor.
+func (*ConditionExecutor) Execute(trx *tx.Tx, sb sandbox.Sandbox) error {
+	pld := trx.Payload().(*payload.ConditionPayload)
+
+	senderAcc := sb.Account(pld.From)
+	if senderAcc == nil {
+		return errors.Errorf(errors.ErrInvalidAddress,
+			"unable to retrieve sender account")
+	}
+
+	if senderAcc.Balance() < pld.Amount+trx.Fee() {
+		return ErrInsufficientFunds
+	}
+
+	senderAcc.SubtractFromBalance(pld.Amount + trx.Fee())
+	if pld.Condition != nil {
+		senderAcc.SetCondition(pld.Condition)
+	}
+
+	if pld.To == pld.From {
+		senderAcc.AddToBalance(pld.Amount)
+		sb.UpdateAccount(pld.From, senderAcc)
+
+		return nil
+	}
+
+	receiverAcc := sb.Account(pld.To)
+	if receiverAcc == nil {
+		receiverAcc = sb.MakeNewAccount(pld.To)
+	}
+	receiverAcc.AddToBalance(pld.Amount)
+
+	sb.UpdateAccount(pld.From, senderAcc)
+	sb.UpdateAccount(pld.To, receiverAcc)
+
+	return nil
+}
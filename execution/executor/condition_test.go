@@ -0,0 +1,70 @@
+package executor
+
+import (
+	"testing"
+
+	"github.com/pactus-project/pactus/crypto"
+	"github.com/pactus-project/pactus/types/account"
+	"github.com/pactus-project/pactus/types/tx"
+	"github.com/pactus-project/pactus/util/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExecuteConditionTx(t *testing.T) {
+	td := setup(t)
+	exe := NewConditionExecutor(true)
+
+	senderAddr, senderAcc := td.sandbox.TestStore.RandomTestAcc()
+	senderBalance := senderAcc.Balance()
+	receiverAddr := td.RandAccAddress()
+	amt, fee := td.randomAmountAndFee(0, senderBalance/2)
+	lockTime := td.sandbox.CurrentHeight()
+
+	t.Run("Should fail, unknown sender", func(t *testing.T) {
+		trx := tx.NewConditionTx(lockTime, td.RandAccAddress(), receiverAddr, amt, nil, fee, "unknown sender")
+
+		err := exe.Execute(trx, td.sandbox)
+		assert.Equal(t, errors.ErrInvalidAddress, errors.Code(err))
+	})
+
+	t.Run("Should fail, insufficient balance", func(t *testing.T) {
+		trx := tx.NewConditionTx(lockTime, senderAddr, receiverAddr, senderBalance+1, nil, 0, "insufficient balance")
+
+		err := exe.Execute(trx, td.sandbox)
+		assert.ErrorIs(t, err, ErrInsufficientFunds)
+	})
+
+	t.Run("Ok, transfer and set the condition", func(t *testing.T) {
+		cond := &account.Condition{
+			MaxAmount: 1e9,
+			Threshold: 1,
+			Cosigners: []crypto.Address{td.RandAccAddress()},
+		}
+		trx := tx.NewConditionTx(lockTime, senderAddr, receiverAddr, amt, cond, fee, "ok")
+
+		err := exe.Execute(trx, td.sandbox)
+		assert.NoError(t, err)
+		assert.Equal(t, cond, td.sandbox.Account(senderAddr).Condition())
+		assert.Equal(t, amt, td.sandbox.Account(receiverAddr).Balance())
+	})
+
+	t.Run("Ok, self transfer keeps the condition", func(t *testing.T) {
+		trx := tx.NewConditionTx(lockTime, senderAddr, senderAddr, 1, nil, fee, "self")
+
+		err := exe.Execute(trx, td.sandbox)
+		assert.NoError(t, err)
+		assert.NotNil(t, td.sandbox.Account(senderAddr).Condition())
+	})
+
+	t.Run("Ok, remove the condition", func(t *testing.T) {
+		trx := tx.NewConditionTx(lockTime, senderAddr, senderAddr, 0, &account.Condition{}, fee, "remove")
+
+		err := exe.Execute(trx, td.sandbox)
+		assert.NoError(t, err)
+		assert.Nil(t, td.sandbox.Account(senderAddr).Condition())
+	})
+
+	assert.Equal(t, senderBalance-amt-3*fee, td.sandbox.Account(senderAddr).Balance())
+
+	td.checkTotalCoin(t, 3*fee)
+}
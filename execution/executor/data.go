@@ -0,0 +1,45 @@
+package executor
+
+import (
+	"github.com/pactus-project/pactus/sandbox"
+	"github.com/pactus-project/pactus/types/tx"
+	"github.com/pactus-project/pactus/types/tx/payload"
+	"github.com/pactus-project/pactus/util/errors"
+)
+
+type DataExecutor struct {
+	strict bool
+}
+
+func NewDataExecutor(strict bool) *DataExecutor {
+	return &DataExecutor{strict: strict}
+}
+
+func (e *DataExecutor) Execute(trx *tx.Tx, sb sandbox.Sandbox) error {
+	pld := trx.Payload().(*payload.DataPayload)
+
+	if e.strict {
+		// In strict mode, the total data size of a block is capped.
+		// In non-strict mode, the transaction pool takes care of it
+		// when it prepares the block transactions.
+		if sb.AccumulatedDataSize()+len(pld.Data) > payload.MaxBlockDataSize {
+			return errors.Errorf(errors.ErrInvalidTx,
+				"block data size exceeded, max: %d", payload.MaxBlockDataSize)
+		}
+	}
+
+	senderAcc := sb.Account(pld.From)
+	if senderAcc == nil {
+		return errors.Errorf(errors.ErrInvalidAddress,
+			"unable to retrieve sender account")
+	}
+
+	if senderAcc.Balance() < trx.Fee() {
+		return ErrInsufficientFunds
+	}
+
+	senderAcc.SubtractFromBalance(trx.Fee())
+	sb.UpdateAccount(pld.From, senderAcc)
+
+	return nil
+}
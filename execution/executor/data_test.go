@@ -0,0 +1,69 @@
+package executor
+
+import (
+	"testing"
+
+	"github.com/pactus-project/pactus/types/tx"
+	"github.com/pactus-project/pactus/types/tx/payload"
+	"github.com/pactus-project/pactus/util/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExecuteDataTx(t *testing.T) {
+	td := setup(t)
+	exe := NewDataExecutor(true)
+
+	senderAddr, senderAcc := td.sandbox.TestStore.RandomTestAcc()
+	senderBalance := senderAcc.Balance()
+	data := td.RandBytes(payload.MaxDataSize)
+	fee := td.RandInt64NonZero(1e9)
+	lockTime := td.sandbox.CurrentHeight()
+
+	t.Run("Should fail, Sender has no account", func(t *testing.T) {
+		trx := tx.NewDataTx(lockTime, td.RandAccAddress(), data, fee, "non-existing account")
+
+		err := exe.Execute(trx, td.sandbox)
+		assert.Equal(t, errors.Code(err), errors.ErrInvalidAddress)
+	})
+
+	t.Run("Should fail, insufficient balance", func(t *testing.T) {
+		trx := tx.NewDataTx(lockTime, senderAddr, data, senderBalance+1, "insufficient balance")
+
+		err := exe.Execute(trx, td.sandbox)
+		assert.ErrorIs(t, err, ErrInsufficientFunds)
+	})
+
+	t.Run("Ok", func(t *testing.T) {
+		trx := tx.NewDataTx(lockTime, senderAddr, data, fee, "ok")
+
+		err := exe.Execute(trx, td.sandbox)
+		assert.NoError(t, err)
+	})
+
+	assert.Equal(t, td.sandbox.Account(senderAddr).Balance(), senderBalance-fee)
+
+	td.checkTotalCoin(t, fee)
+}
+
+func TestDataBlockSize(t *testing.T) {
+	td := setup(t)
+
+	senderAddr, _ := td.sandbox.TestStore.RandomTestAcc()
+	lockTime := td.sandbox.CurrentHeight()
+	td.sandbox.TestDataSize = payload.MaxBlockDataSize - 1
+	trx := tx.NewDataTx(lockTime, senderAddr, td.RandBytes(2), 1000, "block is full")
+
+	t.Run("Should fail in strict mode, block data size exceeded", func(t *testing.T) {
+		exe := NewDataExecutor(true)
+
+		err := exe.Execute(trx, td.sandbox)
+		assert.Equal(t, errors.Code(err), errors.ErrInvalidTx)
+	})
+
+	t.Run("Should pass in non-strict mode", func(t *testing.T) {
+		exe := NewDataExecutor(false)
+
+		err := exe.Execute(trx, td.sandbox)
+		assert.NoError(t, err)
+	})
+}
@@ -0,0 +1,125 @@
+package executor
+
+import (
+	"github.com/pactus-project/pactus/sandbox"
+	"github.com/pactus-project/pactus/types/tx"
+	"github.com/pactus-project/pactus/types/tx/payload"
+	"github.com/pactus-project/pactus/util/errors"
+)
+
+type DelegateExecutor struct {
+	strict bool
+}
+
+func NewDelegateExecutor(strict bool) *DelegateExecutor {
+	return &DelegateExecutor{strict: strict}
+}
+
+func (e *DelegateExecutor) Execute(trx *tx.Tx, sb sandbox.Sandbox) error {
+	pld := trx.Payload().(*payload.DelegatePayload)
+
+	delegatorAcc := sb.Account(pld.From)
+	if delegatorAcc == nil {
+		return errors.Errorf(errors.ErrInvalidAddress,
+			"unable to retrieve delegator account")
+	}
+
+	if sb.Params().Permissioned && !sb.IsAllowlisted(pld.To) {
+		return errors.Errorf(errors.ErrInvalidTx,
+			"validator %v is not allowlisted", pld.To)
+	}
+
+	receiverVal := sb.Validator(pld.To)
+	operatorKey := pld.PublicKey
+	if receiverVal == nil {
+		if pld.PublicKey == nil {
+			return errors.Errorf(errors.ErrInvalidPublicKey,
+				"public key is not set")
+		}
+		if err := e.checkProofOfPossession(pld, sb); err != nil {
+			return err
+		}
+	} else {
+		if pld.PublicKey != nil {
+			return errors.Errorf(errors.ErrInvalidPublicKey,
+				"public key is set")
+		}
+		// A validator is delegated when it is created, so the stake of the operator,
+		// or of another delegator, is never mixed with the stake of the delegator.
+		if !receiverVal.IsDelegated() || *receiverVal.Delegator() != pld.From {
+			return errors.Errorf(errors.ErrInvalidTx,
+				"validator %v is not delegated by %v", pld.To, pld.From)
+		}
+		operatorKey = receiverVal.PublicKey()
+	}
+
+	// The transaction signature proves the consent of the delegator,
+	// the operator signature proves the consent of the operator.
+	err := operatorKey.Verify(pld.ApprovalSignBytes(trx.LockTime()), pld.OperatorSignature)
+	if err != nil {
+		return errors.Errorf(errors.ErrInvalidSignature,
+			"invalid operator approval")
+	}
+
+	if receiverVal == nil {
+		receiverVal = sb.MakeNewValidator(pld.PublicKey)
+		receiverVal.SetDelegator(pld.From)
+	}
+	if receiverVal.UnbondingHeight() > 0 {
+		return errors.Errorf(errors.ErrInvalidHeight,
+			"validator has unbonded at height %v", receiverVal.UnbondingHeight())
+	}
+	if e.strict {
+		// The same as the bond transactions, the delegations are rejected in strict mode
+		// if the validator is in the committee or joins it in the next height.
+		if sb.Committee().Contains(pld.To) {
+			return errors.Errorf(errors.ErrInvalidTx,
+				"validator %v is in committee", pld.To)
+		}
+		if sb.IsJoinedCommittee(pld.To) {
+			return errors.Errorf(errors.ErrInvalidTx,
+				"validator %v joins committee in the next height", pld.To)
+		}
+	}
+	if delegatorAcc.Balance() < pld.Stake+trx.Fee() {
+		return ErrInsufficientFunds
+	}
+	if receiverVal.Stake()+pld.Stake > sb.Params().MaximumStake {
+		return errors.Errorf(errors.ErrInvalidAmount,
+			"validator's stake can't be more than %v", sb.Params().MaximumStake)
+	} else if pld.Stake < sb.Params().MinimumStake {
+		return errors.Errorf(errors.ErrInvalidTx,
+			"validator's stake can't be less than %v", sb.Params().MinimumStake)
+	}
+
+	delegatorAcc.SubtractFromBalance(pld.Stake + trx.Fee())
+	receiverVal.AddToStake(pld.Stake)
+	receiverVal.UpdateLastBondingHeight(sb.CurrentHeight())
+
+	sb.UpdatePowerDelta(pld.Stake)
+	sb.UpdateAccount(pld.From, delegatorAcc)
+	sb.UpdateValidator(receiverVal)
+
+	return nil
+}
+
+// checkProofOfPossession checks the proof of possession of the public key of the new validator.
+// Unlike the bond transactions, the proofs of the delegations are not verified by the state
+// in a batch, so they are verified here in both modes.
+func (*DelegateExecutor) checkProofOfPossession(pld *payload.DelegatePayload, sb sandbox.Sandbox) error {
+	if pld.ProofOfPossession == nil {
+		if sb.Params().IsProofOfPossessionRequired(sb.CurrentHeight()) {
+			return errors.Errorf(errors.ErrInvalidPublicKey,
+				"proof of possession is not set")
+		}
+
+		return nil
+	}
+
+	if err := pld.PublicKey.VerifyProofOfPossession(pld.ProofOfPossession); err != nil {
+		return errors.Errorf(errors.ErrInvalidPublicKey,
+			"invalid proof of possession: %v", err)
+	}
+
+	return nil
+}
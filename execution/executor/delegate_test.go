@@ -0,0 +1,205 @@
+package executor
+
+import (
+	"testing"
+
+	"github.com/pactus-project/pactus/crypto"
+	"github.com/pactus-project/pactus/crypto/bls"
+	"github.com/pactus-project/pactus/types/tx"
+	"github.com/pactus-project/pactus/types/tx/payload"
+	"github.com/pactus-project/pactus/util/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// approveDelegation signs the approval of the operator by the validator key.
+func approveDelegation(valPrv *bls.PrivateKey, trx *tx.Tx) {
+	pld := trx.Payload().(*payload.DelegatePayload)
+	pld.OperatorSignature = valPrv.SignNative(pld.ApprovalSignBytes(trx.LockTime()))
+}
+
+// otherTestAcc returns an account address in the store, other than the given address.
+func (td *testData) otherTestAcc(addr crypto.Address) crypto.Address {
+	for {
+		other, _ := td.sandbox.TestStore.RandomTestAcc()
+		if other != addr {
+			return other
+		}
+	}
+}
+
+func TestExecuteDelegateTx(t *testing.T) {
+	td := setup(t)
+	exe := NewDelegateExecutor(true)
+
+	delegatorAddr, delegatorAcc := td.sandbox.TestStore.RandomTestAcc()
+	delegatorBalance := delegatorAcc.Balance()
+	pub, prv := td.RandBLSKeyPair()
+	valAddr := pub.ValidatorAddress()
+	pop := prv.ProofOfPossession()
+	amt, fee := td.randomAmountAndFee(td.sandbox.TestParams.MinimumStake, delegatorBalance/2)
+	lockTime := td.sandbox.CurrentHeight()
+
+	t.Run("Should fail, invalid delegator", func(t *testing.T) {
+		trx := tx.NewDelegateTx(lockTime, td.RandAccAddress(), valAddr,
+			pub, pop, amt, nil, fee, "invalid delegator")
+		approveDelegation(prv, trx)
+
+		err := exe.Execute(trx, td.sandbox)
+		assert.Equal(t, errors.ErrInvalidAddress, errors.Code(err))
+	})
+
+	t.Run("Should fail, public key is not set", func(t *testing.T) {
+		trx := tx.NewDelegateTx(lockTime, delegatorAddr, valAddr,
+			nil, nil, amt, nil, fee, "no public key")
+		approveDelegation(prv, trx)
+
+		err := exe.Execute(trx, td.sandbox)
+		assert.Equal(t, errors.ErrInvalidPublicKey, errors.Code(err))
+	})
+
+	t.Run("Should fail, invalid proof of possession", func(t *testing.T) {
+		trx := tx.NewDelegateTx(lockTime, delegatorAddr, valAddr,
+			pub, td.RandBLSSignature(), amt, nil, fee, "invalid proof of possession")
+		approveDelegation(prv, trx)
+
+		err := exe.Execute(trx, td.sandbox)
+		assert.Equal(t, errors.ErrInvalidPublicKey, errors.Code(err))
+	})
+
+	t.Run("Should fail, not approved by the operator", func(t *testing.T) {
+		_, otherPrv := td.RandBLSKeyPair()
+		trx := tx.NewDelegateTx(lockTime, delegatorAddr, valAddr,
+			pub, pop, amt, nil, fee, "not approved")
+		approveDelegation(otherPrv, trx)
+
+		err := exe.Execute(trx, td.sandbox)
+		assert.Equal(t, errors.ErrInvalidSignature, errors.Code(err))
+	})
+
+	t.Run("Should fail, approved for another lock time", func(t *testing.T) {
+		trx := tx.NewDelegateTx(lockTime, delegatorAddr, valAddr,
+			pub, pop, amt, nil, fee, "another lock time")
+		pld := trx.Payload().(*payload.DelegatePayload)
+		pld.OperatorSignature = prv.SignNative(pld.ApprovalSignBytes(lockTime + 1))
+
+		err := exe.Execute(trx, td.sandbox)
+		assert.Equal(t, errors.ErrInvalidSignature, errors.Code(err))
+	})
+
+	t.Run("Should fail, insufficient balance", func(t *testing.T) {
+		trx := tx.NewDelegateTx(lockTime, delegatorAddr, valAddr,
+			pub, pop, delegatorBalance+1, nil, 0, "insufficient balance")
+		approveDelegation(prv, trx)
+
+		err := exe.Execute(trx, td.sandbox)
+		assert.ErrorIs(t, err, ErrInsufficientFunds)
+	})
+
+	t.Run("Should fail, amount less than MinimumStake", func(t *testing.T) {
+		trx := tx.NewDelegateTx(lockTime, delegatorAddr, valAddr,
+			pub, pop, 1000, nil, fee, "less than MinimumStake")
+		approveDelegation(prv, trx)
+
+		err := exe.Execute(trx, td.sandbox)
+		assert.Equal(t, errors.ErrInvalidTx, errors.Code(err))
+	})
+
+	t.Run("Ok", func(t *testing.T) {
+		trx := tx.NewDelegateTx(lockTime, delegatorAddr, valAddr,
+			pub, pop, amt, nil, fee, "ok")
+		approveDelegation(prv, trx)
+
+		err := exe.Execute(trx, td.sandbox)
+		assert.NoError(t, err)
+	})
+
+	t.Run("Should fail, public key should not set for existing validators", func(t *testing.T) {
+		trx := tx.NewDelegateTx(lockTime, delegatorAddr, valAddr,
+			pub, pop, amt, nil, fee, "with public key")
+		approveDelegation(prv, trx)
+
+		err := exe.Execute(trx, td.sandbox)
+		assert.Equal(t, errors.ErrInvalidPublicKey, errors.Code(err))
+	})
+
+	t.Run("Should fail, delegated by another delegator", func(t *testing.T) {
+		otherAddr := td.otherTestAcc(delegatorAddr)
+		trx := tx.NewDelegateTx(lockTime, otherAddr, valAddr,
+			nil, nil, amt, nil, fee, "another delegator")
+		approveDelegation(prv, trx)
+
+		err := exe.Execute(trx, td.sandbox)
+		assert.Equal(t, errors.ErrInvalidTx, errors.Code(err))
+	})
+
+	val := td.sandbox.Validator(valAddr)
+	require.NotNil(t, val)
+	assert.Equal(t, delegatorAddr, *val.Delegator())
+	assert.Equal(t, amt, val.Stake())
+	assert.Equal(t, td.sandbox.CurrentHeight(), val.LastBondingHeight())
+	assert.Equal(t, delegatorBalance-(amt+fee), td.sandbox.Account(delegatorAddr).Balance())
+	assert.Equal(t, amt, td.sandbox.PowerDelta())
+	td.checkTotalCoin(t, fee)
+}
+
+func TestDelegateToNonDelegatedValidator(t *testing.T) {
+	td := setup(t)
+	exe := NewDelegateExecutor(true)
+
+	delegatorAddr, delegatorAcc := td.sandbox.TestStore.RandomTestAcc()
+	pub, prv := td.RandBLSKeyPair()
+	val := td.sandbox.MakeNewValidator(pub)
+	td.sandbox.UpdateValidator(val)
+	amt, fee := td.randomAmountAndFee(td.sandbox.TestParams.MinimumStake, delegatorAcc.Balance())
+	lockTime := td.sandbox.CurrentHeight()
+
+	trx := tx.NewDelegateTx(lockTime, delegatorAddr, pub.ValidatorAddress(),
+		nil, nil, amt, nil, fee, "not delegated")
+	approveDelegation(prv, trx)
+
+	err := exe.Execute(trx, td.sandbox)
+	assert.Equal(t, errors.ErrInvalidTx, errors.Code(err))
+}
+
+func TestDelegatedValidator(t *testing.T) {
+	td := setup(t)
+
+	delegatorAddr, delegatorAcc := td.sandbox.TestStore.RandomTestAcc()
+	otherAddr := td.otherTestAcc(delegatorAddr)
+	pub, _ := td.RandBLSKeyPair()
+	val := td.sandbox.MakeNewValidator(pub)
+	val.SetDelegator(delegatorAddr)
+	amt, fee := td.randomAmountAndFee(td.sandbox.TestParams.MinimumStake, delegatorAcc.Balance()/2)
+	lockTime := td.sandbox.CurrentHeight()
+
+	t.Run("Only the delegator can bond to the delegated validator", func(t *testing.T) {
+		td.sandbox.UpdateValidator(val)
+		exe := NewBondExecutor(true)
+
+		trx := tx.NewBondTx(lockTime, otherAddr, val.Address(), nil, amt, fee, "another sender")
+		err := exe.Execute(trx, td.sandbox)
+		assert.Equal(t, errors.ErrInvalidTx, errors.Code(err))
+
+		trx = tx.NewBondTx(lockTime, delegatorAddr, val.Address(), nil, amt, fee, "delegator")
+		err = exe.Execute(trx, td.sandbox)
+		assert.NoError(t, err)
+	})
+
+	t.Run("Stake can only be withdrawn to the delegator", func(t *testing.T) {
+		val := td.sandbox.Validator(val.Address())
+		val.UpdateUnbondingHeight(td.sandbox.CurrentHeight() - td.sandbox.Params().UnbondInterval)
+		td.sandbox.UpdateValidator(val)
+		exe := NewWithdrawExecutor(true)
+
+		trx := tx.NewWithdrawTx(lockTime, val.Address(), otherAddr, amt-fee, fee, "another receiver")
+		err := exe.Execute(trx, td.sandbox)
+		assert.Equal(t, errors.ErrInvalidAddress, errors.Code(err))
+
+		balance := td.sandbox.Account(delegatorAddr).Balance()
+		trx = tx.NewWithdrawTx(lockTime, val.Address(), delegatorAddr, amt-fee, fee, "delegator")
+		err = exe.Execute(trx, td.sandbox)
+		assert.NoError(t, err)
+		assert.Equal(t, balance+amt-fee, td.sandbox.Account(delegatorAddr).Balance())
+	})
+}
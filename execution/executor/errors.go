@@ -1,6 +1,6 @@
 package executor
 
-import "errors"
+import "github.com/pactus-project/pactus/util/errors"
 
 // ErrInsufficientFunds indicates the balance is low for the transaction.
-var ErrInsufficientFunds = errors.New("insufficient funds")
+var ErrInsufficientFunds = errors.Error(errors.ErrInsufficientFunds)
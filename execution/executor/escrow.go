@@ -0,0 +1,59 @@
+package executor
+
+import (
+	"github.com/pactus-project/pactus/sandbox"
+	"github.com/pactus-project/pactus/types/tx"
+	"github.com/pactus-project/pactus/types/tx/payload"
+	"github.com/pactus-project/pactus/util/errors"
+)
+
+type EscrowExecutor struct {
+	strict bool
+}
+
+func NewEscrowExecutor(strict bool) *EscrowExecutor {
+	return &EscrowExecutor{strict: strict}
+}
+
+func (e *EscrowExecutor) Execute(trx *tx.Tx, sb sandbox.Sandbox) error {
+	pld := trx.Payload().(*payload.EscrowPayload)
+
+	escrowAddr := pld.Escrow()
+	escrowAcc := sb.Account(escrowAddr)
+	if escrowAcc == nil {
+		return errors.Errorf(errors.ErrInvalidAddress,
+			"unable to retrieve escrow account")
+	}
+
+	// The transaction signature proves the sender's consent,
+	// the approval signature proves the consent of the second party.
+	err := pld.ApproverPublicKey.Verify(pld.ApprovalSignBytes(trx.LockTime()), pld.ApproverSignature)
+	if err != nil {
+		return errors.Errorf(errors.ErrInvalidSignature,
+			"invalid escrow approval")
+	}
+
+	if escrowAcc.Balance() < pld.Amount+trx.Fee() {
+		return ErrInsufficientFunds
+	}
+	// An escrow is resolved at once. This prevents reusing an approval
+	// to withdraw the remaining funds.
+	if escrowAcc.Balance() != pld.Amount+trx.Fee() {
+		return errors.Errorf(errors.ErrInvalidAmount,
+			"escrow should be resolved with its entire balance, expected: %v, got: %v",
+			escrowAcc.Balance()-trx.Fee(), pld.Amount)
+	}
+
+	receiverAcc := sb.Account(pld.To)
+	if receiverAcc == nil {
+		receiverAcc = sb.MakeNewAccount(pld.To)
+	}
+
+	escrowAcc.SubtractFromBalance(pld.Amount + trx.Fee())
+	receiverAcc.AddToBalance(pld.Amount)
+
+	sb.UpdateAccount(escrowAddr, escrowAcc)
+	sb.UpdateAccount(pld.To, receiverAcc)
+
+	return nil
+}
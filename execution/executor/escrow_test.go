@@ -0,0 +1,92 @@
+package executor
+
+import (
+	"testing"
+
+	"github.com/pactus-project/pactus/crypto"
+	"github.com/pactus-project/pactus/crypto/bls"
+	"github.com/pactus-project/pactus/types/tx"
+	"github.com/pactus-project/pactus/types/tx/payload"
+	"github.com/pactus-project/pactus/util/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExecuteEscrowTx(t *testing.T) {
+	td := setup(t)
+	exe := NewEscrowExecutor(true)
+
+	buyerPub, buyerPrv := td.RandBLSKeyPair()
+	sellerPub, _ := td.RandBLSKeyPair()
+	arbiterPub, arbiterPrv := td.RandBLSKeyPair()
+	buyerAddr := buyerPub.AccountAddress()
+	sellerAddr := sellerPub.AccountAddress()
+	arbiterAddr := arbiterPub.AccountAddress()
+	nonce := td.RandUint32(1000)
+	lockTime := td.sandbox.CurrentHeight()
+
+	escrowAddr := payload.EscrowAddress(buyerAddr, sellerAddr, arbiterAddr, nonce)
+	amt, fee := td.randomAmountAndFee(0, 100*1e9)
+
+	makeTx := func(to crypto.Address, amount int64, approverPrv *bls.PrivateKey) *tx.Tx {
+		pld := &payload.EscrowPayload{
+			Buyer: buyerAddr, Seller: sellerAddr, Arbiter: arbiterAddr, Nonce: nonce,
+			From: buyerAddr, To: to, Amount: amount,
+		}
+		approval := approverPrv.SignNative(pld.ApprovalSignBytes(lockTime))
+		trx := tx.NewEscrowTx(lockTime, buyerAddr, sellerAddr, arbiterAddr, nonce,
+			buyerAddr, to, approverPrv.PublicKeyNative(), approval, amount, fee, "escrow")
+		td.HelperSignTransaction(buyerPrv, trx)
+
+		return trx
+	}
+
+	t.Run("Should fail, escrow is not funded", func(t *testing.T) {
+		trx := makeTx(sellerAddr, amt, arbiterPrv)
+
+		err := exe.Execute(trx, td.sandbox)
+		assert.Equal(t, errors.Code(err), errors.ErrInvalidAddress)
+	})
+
+	// Funding the escrow
+	escrowAcc := td.sandbox.MakeNewAccount(escrowAddr)
+	escrowAcc.AddToBalance(amt + fee)
+	td.sandbox.UpdateAccount(escrowAddr, escrowAcc)
+
+	t.Run("Should fail, invalid approval", func(t *testing.T) {
+		trx := makeTx(sellerAddr, amt, arbiterPrv)
+		pld := trx.Payload().(*payload.EscrowPayload)
+		// Approval is signed for another receiver
+		pld.ApproverSignature = arbiterPrv.SignNative(
+			(&payload.EscrowPayload{
+				Buyer: buyerAddr, Seller: sellerAddr, Arbiter: arbiterAddr, Nonce: nonce,
+				To: buyerAddr, Amount: amt,
+			}).ApprovalSignBytes(lockTime))
+
+		err := exe.Execute(trx, td.sandbox)
+		assert.Equal(t, errors.Code(err), errors.ErrInvalidSignature)
+	})
+
+	t.Run("Should fail, insufficient balance", func(t *testing.T) {
+		trx := makeTx(sellerAddr, amt+1, arbiterPrv)
+
+		err := exe.Execute(trx, td.sandbox)
+		assert.ErrorIs(t, err, ErrInsufficientFunds)
+	})
+
+	t.Run("Should fail, partial resolution", func(t *testing.T) {
+		trx := makeTx(sellerAddr, amt-1, arbiterPrv)
+
+		err := exe.Execute(trx, td.sandbox)
+		assert.Equal(t, errors.Code(err), errors.ErrInvalidAmount)
+	})
+
+	t.Run("Ok", func(t *testing.T) {
+		trx := makeTx(sellerAddr, amt, arbiterPrv)
+
+		err := exe.Execute(trx, td.sandbox)
+		assert.NoError(t, err)
+	})
+
+	assert.Zero(t, td.sandbox.Account(escrowAddr).Balance())
+	assert.Equal(t, td.sandbox.Account(sellerAddr).Balance(), amt)
+}
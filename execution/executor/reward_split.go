@@ -0,0 +1,42 @@
+package executor
+
+import (
+	"github.com/pactus-project/pactus/sandbox"
+	"github.com/pactus-project/pactus/types/tx"
+	"github.com/pactus-project/pactus/types/tx/payload"
+	"github.com/pactus-project/pactus/util/errors"
+)
+
+type RewardSplitExecutor struct {
+	strict bool
+}
+
+func NewRewardSplitExecutor(strict bool) *RewardSplitExecutor {
+	return &RewardSplitExecutor{strict: strict}
+}
+
+func (*RewardSplitExecutor) Execute(trx *tx.Tx, sb sandbox.Sandbox) error {
+	pld := trx.Payload().(*payload.RewardSplitPayload)
+
+	val := sb.Validator(pld.Validator)
+	if val == nil {
+		return errors.Errorf(errors.ErrInvalidAddress,
+			"unable to retrieve validator")
+	}
+
+	if val.UnbondingHeight() > 0 {
+		return errors.Errorf(errors.ErrInvalidHeight,
+			"validator has unbonded at height %v", val.UnbondingHeight())
+	}
+
+	// The validator has no account, so the fee is paid from its stake.
+	if val.Stake() < trx.Fee() {
+		return ErrInsufficientFunds
+	}
+
+	val.SubtractFromStake(trx.Fee())
+	val.SetRewardSplit(&pld.Split)
+	sb.UpdateValidator(val)
+
+	return nil
+}
@@ -0,0 +1,74 @@
+package executor
+
+import (
+	"testing"
+
+	"github.com/pactus-project/pactus/types/tx"
+	"github.com/pactus-project/pactus/types/validator"
+	"github.com/pactus-project/pactus/util/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExecuteRewardSplitTx(t *testing.T) {
+	td := setup(t)
+	exe := NewRewardSplitExecutor(true)
+
+	pub, _ := td.RandBLSKeyPair()
+	valAddr := pub.ValidatorAddress()
+	val := td.sandbox.MakeNewValidator(pub)
+	accAddr, acc := td.sandbox.TestStore.RandomTestAcc()
+	stake, fee := td.randomAmountAndFee(0, acc.Balance())
+	val.AddToStake(stake)
+	acc.SubtractFromBalance(stake)
+	td.sandbox.UpdateAccount(accAddr, acc)
+	td.sandbox.UpdateValidator(val)
+	lockTime := td.sandbox.CurrentHeight()
+	split := &validator.RewardSplit{
+		Shares: []validator.RewardShare{
+			{Address: td.RandAccAddress(), Percent: 60},
+			{Address: td.RandAccAddress(), Percent: 30},
+		},
+	}
+
+	t.Run("Should fail, Invalid validator", func(t *testing.T) {
+		trx := tx.NewRewardSplitTx(lockTime, td.RandValAddress(), split, fee, "invalid validator")
+		err := exe.Execute(trx, td.sandbox)
+		assert.Equal(t, errors.ErrInvalidAddress, errors.Code(err))
+	})
+
+	t.Run("Should fail, Unbonded validator", func(t *testing.T) {
+		pub, _ := td.RandBLSKeyPair()
+		unbondedVal := td.sandbox.MakeNewValidator(pub)
+		unbondedVal.UpdateUnbondingHeight(td.sandbox.CurrentHeight())
+		td.sandbox.UpdateValidator(unbondedVal)
+
+		trx := tx.NewRewardSplitTx(lockTime, pub.ValidatorAddress(), split, fee, "unbonded")
+		err := exe.Execute(trx, td.sandbox)
+		assert.Equal(t, errors.ErrInvalidHeight, errors.Code(err))
+	})
+
+	t.Run("Should fail, insufficient stake", func(t *testing.T) {
+		trx := tx.NewRewardSplitTx(lockTime, valAddr, split, stake+1, "insufficient stake")
+		err := exe.Execute(trx, td.sandbox)
+		assert.ErrorIs(t, err, ErrInsufficientFunds)
+	})
+
+	t.Run("Ok", func(t *testing.T) {
+		trx := tx.NewRewardSplitTx(lockTime, valAddr, split, fee, "Ok")
+		err := exe.Execute(trx, td.sandbox)
+		assert.NoError(t, err)
+
+		assert.Equal(t, split, td.sandbox.Validator(valAddr).RewardSplit())
+		assert.Equal(t, stake-fee, td.sandbox.Validator(valAddr).Stake())
+	})
+
+	t.Run("Remove the reward split", func(t *testing.T) {
+		trx := tx.NewRewardSplitTx(lockTime, valAddr, &validator.RewardSplit{}, 0, "remove")
+		err := exe.Execute(trx, td.sandbox)
+		assert.NoError(t, err)
+
+		assert.Nil(t, td.sandbox.Validator(valAddr).RewardSplit())
+	})
+
+	td.checkTotalCoin(t, fee)
+}
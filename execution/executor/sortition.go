@@ -27,6 +27,13 @@ func (e *SortitionExecutor) Execute(trx *tx.Tx, sb sandbox.Sandbox) error {
 			"unable to retrieve validator")
 	}
 
+	// In a permissioned network, the validators that are removed from the allowlist
+	// can't join the committee anymore and leave it once their seats are taken.
+	if sb.Params().Permissioned && !sb.IsAllowlisted(pld.Validator) {
+		return errors.Errorf(errors.ErrInvalidTx,
+			"validator %v is not allowlisted", pld.Validator)
+	}
+
 	if sb.CurrentHeight()-val.LastBondingHeight() < sb.Params().BondInterval {
 		return errors.Errorf(errors.ErrInvalidHeight,
 			"validator has bonded at height %v", val.LastBondingHeight())
@@ -77,6 +77,16 @@ func TestExecuteSortitionTx(t *testing.T) {
 		assert.Equal(t, errors.Code(err), errors.ErrInvalidTx)
 	})
 
+	t.Run("Should fail, validator is not allowlisted in permissioned network", func(t *testing.T) {
+		td.sandbox.TestParams.Permissioned = true
+		defer func() { td.sandbox.TestParams.Permissioned = false }()
+
+		trx := tx.NewSortitionTx(lockTime, newVal.Address(), proof)
+		td.sandbox.TestAcceptSortition = true
+		err := exe.Execute(trx, td.sandbox)
+		assert.Equal(t, errors.Code(err), errors.ErrInvalidTx)
+	})
+
 	t.Run("Should be ok", func(t *testing.T) {
 		trx := tx.NewSortitionTx(lockTime, newVal.Address(), proof)
 		td.sandbox.TestAcceptSortition = true
@@ -37,6 +37,11 @@ func (e *WithdrawExecutor) Execute(trx *tx.Tx, sb sandbox.Sandbox) error {
 			val.UnbondingHeight()+sb.Params().UnbondInterval, sb.CurrentHeight())
 	}
 
+	if val.IsDelegated() && pld.To != *val.Delegator() {
+		return errors.Errorf(errors.ErrInvalidAddress,
+			"stake of a delegated validator can only be withdrawn to the delegator %v", val.Delegator())
+	}
+
 	acc := sb.Account(pld.To)
 	if acc == nil {
 		acc = sb.MakeNewAccount(pld.To)
@@ -0,0 +1,42 @@
+package execution
+
+import (
+	"fmt"
+
+	"github.com/pactus-project/pactus/types/tx/payload"
+)
+
+// CustomPayload defines how the transactions with a custom payload are executed and charged.
+// The fee of a custom transaction is calculated from its value, like a transfer transaction,
+// and the weight of its resources is charged on top of it.
+type CustomPayload struct {
+	// NewExecutor creates the executor of the payload.
+	// In strict mode, the executor should reject the transactions that are valid
+	// only for the transaction pool, like the future lock times.
+	NewExecutor   func(strict bool) Executor
+	Signatures    int // Number of signatures or proofs to verify
+	StateAccesses int // Number of accounts and validators to read or update
+}
+
+// customPayloads keeps the registered custom payloads.
+// It is only updated at initialization time, so it is not guarded by a lock.
+var customPayloads = map[payload.Type]CustomPayload{}
+
+// RegisterPayload registers the execution of a custom payload type.
+// The payload type should be registered by `payload.Register` first.
+// It should be called in the `init` function of the package that defines the payload,
+// and it panics if the payload type is not registered or is not in the custom range.
+func RegisterPayload(typ payload.Type, custom CustomPayload) {
+	if !typ.IsCustom() || !typ.IsRegistered() {
+		panic(fmt.Sprintf("payload type %d is not a registered custom payload", typ))
+	}
+	if _, ok := customPayloads[typ]; ok {
+		panic(fmt.Sprintf("execution of payload type %d is already registered", typ))
+	}
+
+	customPayloads[typ] = custom
+	payloadCosts[typ] = payloadCost{
+		signatures:    custom.Signatures,
+		stateAccesses: custom.StateAccesses,
+	}
+}
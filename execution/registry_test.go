@@ -0,0 +1,108 @@
+package execution
+
+import (
+	"testing"
+
+	"github.com/pactus-project/pactus/sandbox"
+	"github.com/pactus-project/pactus/types/tx"
+	"github.com/pactus-project/pactus/types/tx/payload"
+	"github.com/pactus-project/pactus/util/errors"
+	"github.com/pactus-project/pactus/util/testsuite"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const typeNote = payload.TypeCustomMin
+
+// notePayload is a custom payload that carries a note, like a data payload.
+type notePayload struct {
+	payload.DataPayload
+}
+
+func (*notePayload) Type() payload.Type {
+	return typeNote
+}
+
+type noteExecutor struct {
+	strict bool
+}
+
+func (e *noteExecutor) Execute(trx *tx.Tx, sb sandbox.Sandbox) error {
+	pld := trx.Payload().(*notePayload)
+	senderAcc := sb.Account(pld.From)
+	if senderAcc == nil {
+		return errors.Errorf(errors.ErrInvalidAddress, "unable to retrieve sender account")
+	}
+	senderAcc.SubtractFromBalance(trx.Fee())
+	sb.UpdateAccount(pld.From, senderAcc)
+
+	return nil
+}
+
+func init() {
+	payload.Register(typeNote, "note", func() payload.Payload { return new(notePayload) })
+	RegisterPayload(typeNote, CustomPayload{
+		NewExecutor:   func(strict bool) Executor { return &noteExecutor{strict: strict} },
+		Signatures:    1,
+		StateAccesses: 1,
+	})
+}
+
+func TestRegisterPayload(t *testing.T) {
+	t.Run("Not registered in payload", func(t *testing.T) {
+		assert.Panics(t, func() {
+			RegisterPayload(payload.TypeCustomMax, CustomPayload{})
+		})
+	})
+
+	t.Run("Builtin payload", func(t *testing.T) {
+		assert.Panics(t, func() {
+			RegisterPayload(payload.TypeData, CustomPayload{})
+		})
+	})
+
+	t.Run("Already registered", func(t *testing.T) {
+		assert.Panics(t, func() {
+			RegisterPayload(typeNote, CustomPayload{})
+		})
+	})
+
+	t.Run("Executors", func(t *testing.T) {
+		assert.True(t, NewExecutor().executors[typeNote].(*noteExecutor).strict)
+		assert.False(t, NewChecker().executors[typeNote].(*noteExecutor).strict)
+	})
+}
+
+func TestExecuteCustomPayload(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	sb := sandbox.MockingSandbox(ts)
+	exe := NewExecutor()
+
+	sender := ts.RandAccAddress()
+	senderAcc := sb.MakeNewAccount(sender)
+	senderAcc.AddToBalance(1e9)
+	sb.UpdateAccount(sender, senderAcc)
+
+	fee, err := CalculatePayloadFee(0, typeNote, sb.Params())
+	require.NoError(t, err)
+	assert.Equal(t, CalculateFee(0, sb.Params()), fee)
+
+	weight, err := PayloadWeight(typeNote, 0)
+	require.NoError(t, err)
+	assert.Equal(t, WeightPerSignature+WeightPerStateAccess, weight)
+
+	pld := &notePayload{payload.DataPayload{From: sender, Data: []byte("note")}}
+	trx := tx.NewCustomTx(sb.CurrentHeight(), pld, fee, "custom")
+
+	bs, err := trx.Bytes()
+	require.NoError(t, err)
+	decoded, err := tx.FromBytes(bs)
+	require.NoError(t, err)
+	assert.Equal(t, trx.ID(), decoded.ID())
+	assert.Equal(t, pld, decoded.Payload())
+
+	err = exe.Execute(decoded, sb)
+	require.NoError(t, err)
+	assert.Equal(t, 1e9-fee, sb.Account(sender).Balance())
+}
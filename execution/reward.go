@@ -0,0 +1,49 @@
+package execution
+
+import (
+	"github.com/pactus-project/pactus/crypto"
+	"github.com/pactus-project/pactus/sandbox"
+	"github.com/pactus-project/pactus/types/tx"
+	"github.com/pactus-project/pactus/types/tx/payload"
+)
+
+// SplitReward pays the shares of the block reward, based on the reward split of the proposer.
+// The subsidy transaction pays the whole reward to the reward address of the block,
+// then the shares are moved from the reward address to the accounts of the split.
+// It should be called right after the subsidy transaction is executed,
+// so the other transactions of the block can't spend the shares.
+// The blocks of the proposers without a reward split are not affected.
+func SplitReward(sb sandbox.Sandbox, proposer crypto.Address, subsidyTrx *tx.Tx) {
+	if !sb.Params().IsPayloadActive(payload.TypeRewardSplit, sb.CurrentHeight()) {
+		return
+	}
+
+	val := sb.Validator(proposer)
+	if val == nil || val.RewardSplit() == nil {
+		return
+	}
+
+	pld := subsidyTrx.Payload().(*payload.TransferPayload)
+	rewardAcc := sb.Account(pld.To)
+	for _, share := range val.RewardSplit().Shares {
+		// The share of the reward address itself stays in the reward address.
+		if share.Address == pld.To {
+			continue
+		}
+
+		amt := share.ShareAmount(pld.Amount)
+		if amt == 0 {
+			continue
+		}
+
+		shareAcc := sb.Account(share.Address)
+		if shareAcc == nil {
+			shareAcc = sb.MakeNewAccount(share.Address)
+		}
+
+		rewardAcc.SubtractFromBalance(amt)
+		shareAcc.AddToBalance(amt)
+		sb.UpdateAccount(share.Address, shareAcc)
+	}
+	sb.UpdateAccount(pld.To, rewardAcc)
+}
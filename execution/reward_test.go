@@ -0,0 +1,82 @@
+package execution
+
+import (
+	"testing"
+
+	"github.com/pactus-project/pactus/sandbox"
+	"github.com/pactus-project/pactus/types/tx"
+	"github.com/pactus-project/pactus/types/tx/payload"
+	"github.com/pactus-project/pactus/types/validator"
+	"github.com/pactus-project/pactus/util/testsuite"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSplitReward(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	sb := sandbox.MockingSandbox(ts)
+	_ = sb.TestStore.AddTestBlock(1000)
+
+	pub, _ := ts.RandBLSKeyPair()
+	val := sb.MakeNewValidator(pub)
+	sb.UpdateValidator(val)
+
+	rewardAddr := ts.RandAccAddress()
+	shareAddr1 := ts.RandAccAddress()
+	shareAddr2 := ts.RandAccAddress()
+	reward := int64(1_000_000_001)
+
+	// payReward executes the subsidy transaction and splits the reward.
+	payReward := func(memo string) {
+		trx := tx.NewSubsidyTx(sb.CurrentHeight(), rewardAddr, reward, memo)
+		require.NoError(t, NewExecutor().Execute(trx, sb))
+		SplitReward(sb, val.Address(), trx)
+	}
+
+	t.Run("No reward split", func(t *testing.T) {
+		payReward("no reward split")
+
+		assert.Equal(t, reward, sb.Account(rewardAddr).Balance())
+		assert.Nil(t, sb.Account(shareAddr1))
+	})
+
+	t.Run("With reward split", func(t *testing.T) {
+		val := sb.Validator(val.Address())
+		val.SetRewardSplit(&validator.RewardSplit{
+			Shares: []validator.RewardShare{
+				{Address: shareAddr1, Percent: 70},
+				{Address: shareAddr2, Percent: 20},
+				{Address: rewardAddr, Percent: 10},
+			},
+		})
+		sb.UpdateValidator(val)
+
+		payReward("with reward split")
+
+		assert.Equal(t, int64(700_000_000), sb.Account(shareAddr1).Balance())
+		assert.Equal(t, int64(200_000_000), sb.Account(shareAddr2).Balance())
+		assert.Equal(t, reward+100_000_001, sb.Account(rewardAddr).Balance())
+	})
+
+	t.Run("Reward split is not active", func(t *testing.T) {
+		sb.TestParams.PayloadActivationHeights = map[payload.Type]uint32{
+			payload.TypeRewardSplit: sb.CurrentHeight() + 1,
+		}
+		defer func() { sb.TestParams.PayloadActivationHeights = nil }()
+
+		balance := sb.Account(shareAddr1).Balance()
+		payReward("reward split is not active")
+
+		assert.Equal(t, balance, sb.Account(shareAddr1).Balance())
+	})
+
+	t.Run("Unknown proposer", func(t *testing.T) {
+		trx := tx.NewSubsidyTx(sb.CurrentHeight(), rewardAddr, reward, "unknown proposer")
+		balance := sb.Account(rewardAddr).Balance()
+		require.NoError(t, NewExecutor().Execute(trx, sb))
+		SplitReward(sb, ts.RandValAddress(), trx)
+
+		assert.Equal(t, balance+reward, sb.Account(rewardAddr).Balance())
+	})
+}
@@ -0,0 +1,117 @@
+package execution
+
+import (
+	"github.com/pactus-project/pactus/types/param"
+	"github.com/pactus-project/pactus/types/tx"
+	"github.com/pactus-project/pactus/types/tx/payload"
+	"github.com/pactus-project/pactus/util/errors"
+)
+
+// The weight units charged for each resource that a transaction consumes.
+// Verifying a signature is much more expensive than storing a byte,
+// and reading or writing a state entry lies in between.
+const (
+	WeightPerByte        = int64(1)
+	WeightPerSignature   = int64(1_000)
+	WeightPerStateAccess = int64(100)
+)
+
+// payloadCost defines the resources that executing a payload consumes,
+// regardless of the size of the transaction.
+type payloadCost struct {
+	signatures    int // Number of signatures or proofs to verify
+	stateAccesses int // Number of accounts and validators to read or update
+}
+
+var payloadCosts = map[payload.Type]payloadCost{
+	payload.TypeTransfer:    {signatures: 1, stateAccesses: 2}, // sender and receiver accounts
+	payload.TypeBond:        {signatures: 1, stateAccesses: 2}, // sender account and receiver validator
+	payload.TypeSortition:   {signatures: 2, stateAccesses: 1}, // signature and sortition proof
+	payload.TypeUnbond:      {signatures: 1, stateAccesses: 1}, // validator
+	payload.TypeWithdraw:    {signatures: 1, stateAccesses: 2}, // validator and receiver account
+	payload.TypeEscrow:      {signatures: 2, stateAccesses: 2}, // signature and approval, escrow and receiver accounts
+	payload.TypeData:        {signatures: 1, stateAccesses: 1}, // sender account
+	payload.TypeAllowlist:   {signatures: 1, stateAccesses: 2}, // governor account and allowlist
+	payload.TypeCondition:   {signatures: 3, stateAccesses: 2}, // signature and approvals, sender and receiver accounts
+	payload.TypeDelegate:    {signatures: 3, stateAccesses: 2}, // signature, approval and proof, delegator account and validator
+	payload.TypeRewardSplit: {signatures: 1, stateAccesses: 1}, // validator
+}
+
+// PayloadWeight returns the weight of executing a payload of the given type.
+// The data size is only counted for data transactions.
+// The weight doesn't depend on the rest of the transaction, therefore the fee
+// can be calculated before the transaction is made.
+func PayloadWeight(payloadType payload.Type, dataSize int) (int64, error) {
+	cost, ok := payloadCosts[payloadType]
+	if !ok {
+		return 0, errors.Errorf(errors.ErrInvalidTx, "unexpected tx type: %v", payloadType)
+	}
+
+	weight := int64(cost.signatures)*WeightPerSignature +
+		int64(cost.stateAccesses)*WeightPerStateAccess
+	if payloadType == payload.TypeData {
+		weight += int64(dataSize) * WeightPerByte
+	}
+
+	return weight, nil
+}
+
+// TxWeight returns the weight of the transaction, which is used to limit the
+// size of the blocks. Unlike the payload weight, it counts the serialized size
+// of the whole transaction.
+func TxWeight(trx *tx.Tx) int64 {
+	cost := payloadCosts[trx.Payload().Type()]
+	signatures := cost.signatures
+	if trx.IsSubsidyTx() {
+		// Subsidy transactions are not signed.
+		signatures = 0
+	}
+
+	return int64(trx.SerializeSize())*WeightPerByte +
+		int64(signatures)*WeightPerSignature +
+		int64(cost.stateAccesses)*WeightPerStateAccess
+}
+
+// CalculatePayloadFee calculates the fee of a transaction with the given payload type.
+// For data transactions, the amount is the size of the data in bytes.
+// Custom payloads are charged like transfer transactions.
+// If the fee per weight is set in the parameters, the weight of the payload is
+// charged on top of the value based fee.
+func CalculatePayloadFee(amount int64, payloadType payload.Type, params *param.Params) (int64, error) {
+	var fee int64
+	dataSize := 0
+	switch payloadType {
+	case payload.TypeTransfer,
+		payload.TypeBond,
+		payload.TypeWithdraw,
+		payload.TypeEscrow,
+		payload.TypeAllowlist,
+		payload.TypeCondition,
+		payload.TypeDelegate,
+		payload.TypeRewardSplit:
+
+		fee = CalculateFee(amount, params)
+
+	case payload.TypeData:
+		dataSize = int(amount)
+		fee = CalculateDataFee(dataSize, params)
+
+	case payload.TypeUnbond,
+		payload.TypeSortition:
+
+		return 0, nil
+
+	default:
+		if _, ok := customPayloads[payloadType]; !ok {
+			return 0, errors.Errorf(errors.ErrInvalidTx, "unexpected tx type: %v", payloadType)
+		}
+		fee = CalculateFee(amount, params)
+	}
+
+	weight, err := PayloadWeight(payloadType, dataSize)
+	if err != nil {
+		return 0, err
+	}
+
+	return fee + weight*params.FeePerWeight, nil
+}
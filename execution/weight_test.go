@@ -0,0 +1,148 @@
+package execution
+
+import (
+	"testing"
+
+	"github.com/pactus-project/pactus/sandbox"
+	"github.com/pactus-project/pactus/types/tx"
+	"github.com/pactus-project/pactus/types/tx/payload"
+	"github.com/pactus-project/pactus/util/errors"
+	"github.com/pactus-project/pactus/util/testsuite"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPayloadWeight(t *testing.T) {
+	tests := []struct {
+		payloadType    payload.Type
+		dataSize       int
+		expectedWeight int64
+	}{
+		{payload.TypeTransfer, 0, 1200},
+		{payload.TypeBond, 0, 1200},
+		{payload.TypeSortition, 0, 2100},
+		{payload.TypeUnbond, 0, 1100},
+		{payload.TypeWithdraw, 0, 1200},
+		{payload.TypeEscrow, 0, 2200},
+		{payload.TypeData, 0, 1100},
+		{payload.TypeData, 1024, 2124},
+		{payload.TypeAllowlist, 0, 1200},
+		{payload.TypeCondition, 0, 3200},
+		{payload.TypeDelegate, 0, 3200},
+		{payload.TypeRewardSplit, 0, 1100},
+
+		// Data size is ignored for non-data payloads
+		{payload.TypeTransfer, 1024, 1200},
+	}
+
+	for i, test := range tests {
+		weight, err := PayloadWeight(test.payloadType, test.dataSize)
+		require.NoError(t, err)
+		assert.Equal(t, test.expectedWeight, weight, "test %v failed", i)
+	}
+
+	_, err := PayloadWeight(payload.Type(0x7F), 0)
+	assert.Equal(t, errors.ErrInvalidTx, errors.Code(err))
+}
+
+func TestTxWeight(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	t.Run("Transfer transaction", func(t *testing.T) {
+		trx, _ := ts.GenerateTestTransferTx()
+
+		expected := int64(trx.SerializeSize()) + WeightPerSignature + 2*WeightPerStateAccess
+		assert.Equal(t, expected, TxWeight(trx))
+	})
+
+	t.Run("Subsidy transaction is not signed", func(t *testing.T) {
+		trx := tx.NewSubsidyTx(ts.RandHeight(), ts.RandAccAddress(), ts.RandAmount(), "")
+
+		expected := int64(trx.SerializeSize()) + 2*WeightPerStateAccess
+		assert.Equal(t, expected, TxWeight(trx))
+	})
+
+	t.Run("Bond transaction is heavier than transfer transaction", func(t *testing.T) {
+		trx1 := tx.NewTransferTx(ts.RandHeight(), ts.RandAccAddress(), ts.RandAccAddress(), 1e9, 1000, "")
+		pub, _ := ts.RandBLSKeyPair()
+		trx2 := tx.NewBondTx(ts.RandHeight(), ts.RandAccAddress(), pub.ValidatorAddress(), pub, 1e9, 1000, "")
+
+		assert.Greater(t, TxWeight(trx2), TxWeight(trx1))
+	})
+
+	t.Run("Larger data, heavier transaction", func(t *testing.T) {
+		sender := ts.RandAccAddress()
+		trx1 := tx.NewDataTx(ts.RandHeight(), sender, ts.RandBytes(10), 1000, "")
+		trx2 := tx.NewDataTx(ts.RandHeight(), sender, ts.RandBytes(100), 1000, "")
+
+		assert.Equal(t, int64(90), TxWeight(trx2)-TxWeight(trx1))
+	})
+}
+
+func TestCalculatePayloadFee(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	sb := sandbox.MockingSandbox(ts)
+	params := sb.TestParams
+
+	t.Run("No fee per weight", func(t *testing.T) {
+		params.FeePerWeight = 0
+
+		fee, err := CalculatePayloadFee(1e9, payload.TypeTransfer, params)
+		assert.NoError(t, err)
+		assert.Equal(t, CalculateFee(1e9, params), fee)
+
+		fee, err = CalculatePayloadFee(100, payload.TypeData, params)
+		assert.NoError(t, err)
+		assert.Equal(t, CalculateDataFee(100, params), fee)
+	})
+
+	t.Run("With fee per weight", func(t *testing.T) {
+		params.FeePerWeight = 10
+
+		tests := []struct {
+			amount      int64
+			payloadType payload.Type
+			expectedFee int64
+		}{
+			{1e9, payload.TypeTransfer, 100000 + 12000},
+			{1e9, payload.TypeBond, 100000 + 12000},
+			{1e9, payload.TypeWithdraw, 100000 + 12000},
+			{1e9, payload.TypeEscrow, 100000 + 22000},
+			{100, payload.TypeData, 1001000 + 12000},
+			{0, payload.TypeAllowlist, 1000 + 12000},
+			{1e9, payload.TypeCondition, 100000 + 32000},
+			{1e9, payload.TypeUnbond, 0},
+			{1e9, payload.TypeSortition, 0},
+			{0, payload.TypeRewardSplit, 1000 + 11000},
+		}
+
+		for i, test := range tests {
+			fee, err := CalculatePayloadFee(test.amount, test.payloadType, params)
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedFee, fee, "test %v failed", i)
+		}
+
+		_, err := CalculatePayloadFee(1e9, payload.Type(0x7F), params)
+		assert.Equal(t, errors.ErrInvalidTx, errors.Code(err))
+	})
+
+	t.Run("Checking fee with fee per weight", func(t *testing.T) {
+		params.FeePerWeight = 10
+		exe := NewChecker()
+
+		sender := ts.RandAccAddress()
+		trx1 := tx.NewTransferTx(sb.CurrentHeight()+1, sender, ts.RandAccAddress(), 1e9, 100000, "")
+		err := exe.checkFee(trx1, sb)
+		assert.Equal(t, errors.ErrInvalidFee, errors.Code(err))
+
+		trx2 := tx.NewTransferTx(sb.CurrentHeight()+1, sender, ts.RandAccAddress(), 1e9, 112000, "")
+		assert.NoError(t, exe.checkFee(trx2, sb))
+
+		trx3 := tx.NewDataTx(sb.CurrentHeight()+1, sender, ts.RandBytes(100), 1013000, "")
+		assert.NoError(t, exe.checkFee(trx3, sb))
+
+		trx4 := tx.NewUnbondTx(sb.CurrentHeight()+1, ts.RandValAddress(), "")
+		assert.NoError(t, exe.checkFee(trx4, sb))
+	})
+}
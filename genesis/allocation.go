@@ -0,0 +1,222 @@
+package genesis
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pactus-project/pactus/crypto"
+	"github.com/pactus-project/pactus/crypto/bls"
+	"github.com/pactus-project/pactus/types/account"
+	"github.com/pactus-project/pactus/types/param"
+	"github.com/pactus-project/pactus/types/validator"
+)
+
+// Allocation is the initial balance of an account in the genesis.
+// The balance is in NanoPAC, the same as the genesis file.
+type Allocation struct {
+	Address crypto.Address
+	Balance int64
+}
+
+// LoadAllocations loads the account allocations from a CSV or JSON file,
+// based on the file extension.
+//
+// A CSV file has two columns, "address" and "balance", and the header line is optional.
+// A JSON file contains an array of objects with the "address" and "balance" fields,
+// the same as the accounts in the genesis file.
+// The addresses are decoded with the current HRP.
+func LoadAllocations(file string) ([]Allocation, error) {
+	records, err := loadRecords(file, []string{"address", "balance"})
+	if err != nil {
+		return nil, err
+	}
+
+	allocs := make([]Allocation, 0, len(records))
+	for _, rec := range records {
+		addr, err := crypto.AddressFromString(rec.fields[0])
+		if err != nil {
+			return nil, InvalidRecordError{File: file, Line: rec.line, Reason: err.Error()}
+		}
+		balance, err := strconv.ParseInt(rec.fields[1], 10, 64)
+		if err != nil {
+			return nil, InvalidRecordError{File: file, Line: rec.line, Reason: err.Error()}
+		}
+		if balance <= 0 {
+			return nil, InvalidRecordError{File: file, Line: rec.line, Reason: "balance should be positive"}
+		}
+		allocs = append(allocs, Allocation{Address: addr, Balance: balance})
+	}
+
+	return allocs, nil
+}
+
+// LoadValidators loads the public keys of the genesis validators from a CSV or JSON file,
+// based on the file extension.
+//
+// A CSV file has one column, "public_key", and the header line is optional.
+// A JSON file contains an array of objects with the "public_key" field,
+// the same as the validators in the genesis file.
+func LoadValidators(file string) ([]*bls.PublicKey, error) {
+	records, err := loadRecords(file, []string{"public_key"})
+	if err != nil {
+		return nil, err
+	}
+
+	pubs := make([]*bls.PublicKey, 0, len(records))
+	for _, rec := range records {
+		pub, err := bls.PublicKeyFromString(rec.fields[0])
+		if err != nil {
+			return nil, InvalidRecordError{File: file, Line: rec.line, Reason: err.Error()}
+		}
+		pubs = append(pubs, pub)
+	}
+
+	return pubs, nil
+}
+
+// MakeGenesisFromAllocations makes a genesis for a new chain from the bulk allocations.
+// The treasury account receives the rest of the total supply that is not allocated.
+// It returns an error if an address or a validator is duplicated,
+// or if the allocations exceed the total supply.
+func MakeGenesisFromAllocations(genesisTime time.Time, totalSupply int64,
+	allocs []Allocation, pubs []*bls.PublicKey, params *param.Params,
+) (*Genesis, error) {
+	if totalSupply <= 0 {
+		return nil, errors.New("total supply should be positive")
+	}
+	if len(pubs) == 0 {
+		return nil, errors.New("at least one validator is required")
+	}
+
+	allocated := int64(0)
+	accs := make(map[crypto.Address]*account.Account, len(allocs)+1)
+	treasury := account.NewAccount(0)
+	accs[crypto.TreasuryAddress] = treasury
+	for i, alloc := range allocs {
+		if _, ok := accs[alloc.Address]; ok {
+			return nil, DuplicatedAccountError{Address: alloc.Address.String()}
+		}
+		if alloc.Balance > totalSupply-allocated {
+			return nil, ExceedsTotalSupplyError{Allocated: sumBalances(allocs), TotalSupply: totalSupply}
+		}
+		allocated += alloc.Balance
+
+		acc := account.NewAccount(int32(i + 1))
+		acc.AddToBalance(alloc.Balance)
+		accs[alloc.Address] = acc
+	}
+	treasury.AddToBalance(totalSupply - allocated)
+
+	seen := make(map[crypto.Address]bool, len(pubs))
+	vals := make([]*validator.Validator, 0, len(pubs))
+	for i, pub := range pubs {
+		valAddr := pub.ValidatorAddress()
+		if seen[valAddr] {
+			return nil, DuplicatedValidatorError{PublicKey: pub.String()}
+		}
+		seen[valAddr] = true
+
+		vals = append(vals, validator.NewValidator(pub, int32(i)))
+	}
+
+	return MakeGenesis(genesisTime, accs, vals, params), nil
+}
+
+// sumBalances returns the sum of the allocated balances, capped to avoid overflow.
+func sumBalances(allocs []Allocation) int64 {
+	sum := int64(0)
+	for _, alloc := range allocs {
+		if sum > math.MaxInt64-alloc.Balance {
+			return math.MaxInt64
+		}
+		sum += alloc.Balance
+	}
+
+	return sum
+}
+
+type record struct {
+	line   int
+	fields []string
+}
+
+// loadRecords reads the records of a CSV or JSON file with the given columns.
+func loadRecords(file string, columns []string) ([]record, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = f.Close() }()
+
+	switch strings.ToLower(filepath.Ext(file)) {
+	case ".csv":
+		return readCSVRecords(f, file, columns)
+	case ".json":
+		return readJSONRecords(f, file, columns)
+	default:
+		return nil, errors.New("unsupported file format, it should be CSV or JSON")
+	}
+}
+
+func readCSVRecords(r io.Reader, file string, columns []string) ([]record, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = len(columns)
+	reader.TrimLeadingSpace = true
+	reader.Comment = '#'
+
+	records := make([]record, 0)
+	for {
+		fields, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		line, _ := reader.FieldPos(0)
+		if err != nil {
+			return nil, InvalidRecordError{File: file, Line: line, Reason: err.Error()}
+		}
+
+		if len(records) == 0 && strings.EqualFold(fields[0], columns[0]) {
+			// Skip the header line.
+			continue
+		}
+		records = append(records, record{line: line, fields: fields})
+	}
+
+	return records, nil
+}
+
+func readJSONRecords(r io.Reader, file string, columns []string) ([]record, error) {
+	var objs []map[string]json.RawMessage
+	if err := json.NewDecoder(r).Decode(&objs); err != nil {
+		return nil, err
+	}
+
+	records := make([]record, 0, len(objs))
+	for i, obj := range objs {
+		// The line is the index of the object in the array, starting from one.
+		rec := record{line: i + 1, fields: make([]string, len(columns))}
+		for j, col := range columns {
+			raw, ok := obj[col]
+			if !ok {
+				return nil, InvalidRecordError{File: file, Line: rec.line, Reason: "missing field: " + col}
+			}
+			var str string
+			if err := json.Unmarshal(raw, &str); err != nil {
+				// Numbers are kept as they are.
+				str = string(raw)
+			}
+			rec.fields[j] = str
+		}
+		records = append(records, rec)
+	}
+
+	return records, nil
+}
@@ -0,0 +1,240 @@
+package genesis_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/pactus-project/pactus/crypto"
+	"github.com/pactus-project/pactus/crypto/bls"
+	"github.com/pactus-project/pactus/genesis"
+	"github.com/pactus-project/pactus/types/param"
+	"github.com/pactus-project/pactus/util"
+	"github.com/pactus-project/pactus/util/testsuite"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeTempFile(t *testing.T, name, content string) string {
+	t.Helper()
+
+	file := filepath.Join(t.TempDir(), name)
+	require.NoError(t, util.WriteFile(file, []byte(content)))
+
+	return file
+}
+
+func TestLoadAllocations(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	addr1 := ts.RandAccAddress()
+	addr2 := ts.RandAccAddress()
+
+	t.Run("CSV with header", func(t *testing.T) {
+		file := writeTempFile(t, "allocs.csv", fmt.Sprintf(
+			"address,balance\n# comment\n%s,1000\n%s, 2000\n", addr1, addr2))
+
+		allocs, err := genesis.LoadAllocations(file)
+		require.NoError(t, err)
+		assert.Equal(t, []genesis.Allocation{
+			{Address: addr1, Balance: 1000},
+			{Address: addr2, Balance: 2000},
+		}, allocs)
+	})
+
+	t.Run("CSV without header", func(t *testing.T) {
+		file := writeTempFile(t, "allocs.CSV", fmt.Sprintf("%s,1000\n", addr1))
+
+		allocs, err := genesis.LoadAllocations(file)
+		require.NoError(t, err)
+		assert.Equal(t, []genesis.Allocation{{Address: addr1, Balance: 1000}}, allocs)
+	})
+
+	t.Run("JSON", func(t *testing.T) {
+		file := writeTempFile(t, "allocs.json", fmt.Sprintf(
+			`[{"address":"%s","balance":1000},{"address":"%s","balance":"2000"}]`, addr1, addr2))
+
+		allocs, err := genesis.LoadAllocations(file)
+		require.NoError(t, err)
+		assert.Equal(t, []genesis.Allocation{
+			{Address: addr1, Balance: 1000},
+			{Address: addr2, Balance: 2000},
+		}, allocs)
+	})
+
+	t.Run("Invalid records", func(t *testing.T) {
+		tests := []struct {
+			name    string
+			content string
+			line    int
+		}{
+			{"allocs.csv", fmt.Sprintf("%s,1000\ninvalid,1000\n", addr1), 2},
+			{"allocs.csv", fmt.Sprintf("%s,1000\n%s,abc\n", addr1, addr2), 2},
+			{"allocs.csv", fmt.Sprintf("%s,0\n", addr1), 1},
+			{"allocs.csv", fmt.Sprintf("%s,-1\n", addr1), 1},
+			{"allocs.csv", fmt.Sprintf("%s,1,2\n", addr1), 1},
+			{"allocs.json", fmt.Sprintf(`[{"address":"%s"}]`, addr1), 1},
+		}
+
+		for i, test := range tests {
+			file := writeTempFile(t, test.name, test.content)
+			_, err := genesis.LoadAllocations(file)
+
+			var recErr genesis.InvalidRecordError
+			require.ErrorAs(t, err, &recErr, "test %d failed", i)
+			assert.Equal(t, test.line, recErr.Line, "test %d failed", i)
+		}
+	})
+
+	t.Run("Unsupported format", func(t *testing.T) {
+		file := writeTempFile(t, "allocs.txt", "")
+		_, err := genesis.LoadAllocations(file)
+		assert.Error(t, err)
+	})
+
+	t.Run("File not found", func(t *testing.T) {
+		_, err := genesis.LoadAllocations(util.TempFilePath() + ".csv")
+		assert.Error(t, err)
+	})
+}
+
+func TestLoadValidators(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	pub1, _ := ts.RandBLSKeyPair()
+	pub2, _ := ts.RandBLSKeyPair()
+
+	t.Run("CSV", func(t *testing.T) {
+		file := writeTempFile(t, "vals.csv", fmt.Sprintf("public_key\n%s\n%s\n", pub1, pub2))
+
+		pubs, err := genesis.LoadValidators(file)
+		require.NoError(t, err)
+		require.Len(t, pubs, 2)
+		assert.True(t, pub1.EqualsTo(pubs[0]))
+		assert.True(t, pub2.EqualsTo(pubs[1]))
+	})
+
+	t.Run("JSON", func(t *testing.T) {
+		file := writeTempFile(t, "vals.json", fmt.Sprintf(`[{"public_key":"%s"}]`, pub1))
+
+		pubs, err := genesis.LoadValidators(file)
+		require.NoError(t, err)
+		require.Len(t, pubs, 1)
+		assert.True(t, pub1.EqualsTo(pubs[0]))
+	})
+
+	t.Run("Invalid public key", func(t *testing.T) {
+		file := writeTempFile(t, "vals.csv", fmt.Sprintf("%s\ninvalid\n", pub1))
+
+		_, err := genesis.LoadValidators(file)
+		var recErr genesis.InvalidRecordError
+		require.ErrorAs(t, err, &recErr)
+		assert.Equal(t, 2, recErr.Line)
+	})
+}
+
+func TestMakeGenesisFromAllocations(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	totalSupply := int64(42e15)
+	pub1, _ := ts.RandBLSKeyPair()
+	pub2, _ := ts.RandBLSKeyPair()
+	pubs := []*bls.PublicKey{pub1, pub2}
+
+	// Thousands of allocations are supported.
+	allocs := make([]genesis.Allocation, 0, 5000)
+	allocated := int64(0)
+	for i := 0; i < 5000; i++ {
+		balance := ts.RandInt64(1e12) + 1
+		allocs = append(allocs, genesis.Allocation{Address: ts.RandAccAddress(), Balance: balance})
+		allocated += balance
+	}
+
+	t.Run("Ok", func(t *testing.T) {
+		gen, err := genesis.MakeGenesisFromAllocations(util.Now(), totalSupply, allocs, pubs, param.DefaultParams())
+		require.NoError(t, err)
+
+		assert.Equal(t, totalSupply, gen.TotalSupply())
+		accs := gen.Accounts()
+		assert.Len(t, accs, len(allocs)+1)
+		assert.Equal(t, totalSupply-allocated, accs[crypto.TreasuryAddress].Balance())
+		assert.Equal(t, int32(0), accs[crypto.TreasuryAddress].Number())
+		for i, alloc := range allocs {
+			assert.Equal(t, alloc.Balance, accs[alloc.Address].Balance())
+			assert.Equal(t, int32(i+1), accs[alloc.Address].Number())
+		}
+
+		vals := gen.Validators()
+		require.Len(t, vals, 2)
+		assert.True(t, pub1.EqualsTo(vals[0].PublicKey()))
+		assert.True(t, pub2.EqualsTo(vals[1].PublicKey()))
+
+		// The genesis file can be loaded back.
+		file := util.TempFilePath()
+		require.NoError(t, gen.SaveToFile(file))
+		loaded, err := genesis.LoadFromFile(file)
+		require.NoError(t, err)
+		assert.Equal(t, gen.Hash(), loaded.Hash())
+	})
+
+	t.Run("Duplicated address", func(t *testing.T) {
+		dupAllocs := append([]genesis.Allocation{}, allocs[:10]...)
+		dupAllocs = append(dupAllocs, allocs[3])
+
+		_, err := genesis.MakeGenesisFromAllocations(util.Now(), totalSupply, dupAllocs, pubs, param.DefaultParams())
+		assert.ErrorIs(t, err, genesis.DuplicatedAccountError{Address: allocs[3].Address.String()})
+	})
+
+	t.Run("Allocating to the treasury", func(t *testing.T) {
+		treasuryAllocs := []genesis.Allocation{{Address: crypto.TreasuryAddress, Balance: 1}}
+
+		_, err := genesis.MakeGenesisFromAllocations(util.Now(), totalSupply, treasuryAllocs, pubs, param.DefaultParams())
+		assert.ErrorIs(t, err, genesis.DuplicatedAccountError{Address: crypto.TreasuryAddress.String()})
+	})
+
+	t.Run("Duplicated validator", func(t *testing.T) {
+		_, err := genesis.MakeGenesisFromAllocations(util.Now(), totalSupply, allocs,
+			[]*bls.PublicKey{pub1, pub2, pub1}, param.DefaultParams())
+		assert.ErrorIs(t, err, genesis.DuplicatedValidatorError{PublicKey: pub1.String()})
+	})
+
+	t.Run("Exceeds total supply", func(t *testing.T) {
+		_, err := genesis.MakeGenesisFromAllocations(util.Now(), allocated-1, allocs, pubs, param.DefaultParams())
+		assert.ErrorIs(t, err, genesis.ExceedsTotalSupplyError{Allocated: allocated, TotalSupply: allocated - 1})
+	})
+
+	t.Run("No validator", func(t *testing.T) {
+		_, err := genesis.MakeGenesisFromAllocations(util.Now(), totalSupply, allocs, nil, param.DefaultParams())
+		assert.Error(t, err)
+	})
+
+	t.Run("Invalid total supply", func(t *testing.T) {
+		_, err := genesis.MakeGenesisFromAllocations(util.Now(), 0, allocs, pubs, param.DefaultParams())
+		assert.Error(t, err)
+	})
+}
+
+func TestLoadAndMakeGenesis(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	pub, _ := ts.RandBLSKeyPair()
+	lines := []string{"address,balance"}
+	for i := 0; i < 100; i++ {
+		lines = append(lines, fmt.Sprintf("%s,%d", ts.RandAccAddress(), int64(1e9)))
+	}
+	allocsFile := writeTempFile(t, "allocs.csv", strings.Join(lines, "\n"))
+	valsJSON, _ := json.Marshal([]map[string]string{{"public_key": pub.String()}})
+	valsFile := writeTempFile(t, "vals.json", string(valsJSON))
+
+	allocs, err := genesis.LoadAllocations(allocsFile)
+	require.NoError(t, err)
+	pubs, err := genesis.LoadValidators(valsFile)
+	require.NoError(t, err)
+
+	gen, err := genesis.MakeGenesisFromAllocations(util.Now(), 1e12, allocs, pubs, param.DefaultParams())
+	require.NoError(t, err)
+	assert.Equal(t, int64(1e12), gen.TotalSupply())
+	assert.Equal(t, int64(1e12-100*1e9), gen.Accounts()[crypto.TreasuryAddress].Balance())
+}
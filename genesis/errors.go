@@ -0,0 +1,61 @@
+package genesis
+
+import (
+	"fmt"
+)
+
+// InvalidRecordError is returned when a record of an allocations or validators file is not valid.
+type InvalidRecordError struct {
+	File   string
+	Line   int
+	Reason string
+}
+
+func (e InvalidRecordError) Error() string {
+	return fmt.Sprintf("invalid record at %s:%d: %s", e.File, e.Line, e.Reason)
+}
+
+// DuplicatedAccountError is returned when an address is allocated more than once.
+type DuplicatedAccountError struct {
+	Address string
+}
+
+func (e DuplicatedAccountError) Error() string {
+	return fmt.Sprintf("address %s is allocated more than once", e.Address)
+}
+
+// DuplicatedValidatorError is returned when a validator is added more than once.
+type DuplicatedValidatorError struct {
+	PublicKey string
+}
+
+func (e DuplicatedValidatorError) Error() string {
+	return fmt.Sprintf("validator %s is added more than once", e.PublicKey)
+}
+
+// ExceedsTotalSupplyError is returned when the allocated balances exceed the total supply.
+type ExceedsTotalSupplyError struct {
+	Allocated   int64
+	TotalSupply int64
+}
+
+func (e ExceedsTotalSupplyError) Error() string {
+	return fmt.Sprintf("allocated balances (%d) exceed the total supply (%d)",
+		e.Allocated, e.TotalSupply)
+}
+
+// NoGovernorError is returned when a permissioned network has no governor.
+type NoGovernorError struct{}
+
+func (NoGovernorError) Error() string {
+	return "permissioned network has no governor"
+}
+
+// InvalidGovernorError is returned when a governor is not an account address.
+type InvalidGovernorError struct {
+	Address string
+}
+
+func (e InvalidGovernorError) Error() string {
+	return fmt.Sprintf("governor %s is not an account address", e.Address)
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/pactus-project/pactus/crypto/hash"
 	"github.com/pactus-project/pactus/types/account"
 	"github.com/pactus-project/pactus/types/param"
+	"github.com/pactus-project/pactus/types/tx/payload"
 	"github.com/pactus-project/pactus/types/validator"
 	"github.com/pactus-project/pactus/util"
 )
@@ -22,6 +23,9 @@ const (
 	Mainnet  ChainType = 0
 	Testnet  ChainType = 1
 	Localnet ChainType = 2
+	// Simnet is a local network that blocks are produced on demand, through the RPC,
+	// rather than by the consensus. It is used by the integration tests.
+	Simnet ChainType = 3
 )
 
 func (n ChainType) IsMainnet() bool {
@@ -36,11 +40,93 @@ func (n ChainType) String() string {
 		return "Testnet"
 	case Localnet:
 		return "Localnet"
+	case Simnet:
+		return "Simnet"
 	default:
 		return "Unknown"
 	}
 }
 
+// The heights that the existing networks include the chain ID in the sign bytes.
+// Before these heights, the legacy sign bytes are used to keep the history valid.
+// The other networks include the chain ID from the genesis.
+const (
+	MainnetSignDomainHeight = 2_500_000
+	TestnetSignDomainHeight = 1_500_000
+)
+
+// The heights that the existing networks require the proof of possession of the public key
+// of the new validators. Before these heights, the proof is optional.
+// The other networks require it from the genesis.
+const (
+	MainnetProofOfPossessionHeight = 2_500_000
+	TestnetProofOfPossessionHeight = 1_500_000
+)
+
+// ProofOfPossessionHeight returns the height that the proof of possession is required
+// for the public key of the new validators.
+func (n ChainType) ProofOfPossessionHeight() uint32 {
+	switch n {
+	case Mainnet:
+		return MainnetProofOfPossessionHeight
+	case Testnet:
+		return TestnetProofOfPossessionHeight
+	default:
+		return 0
+	}
+}
+
+// The heights that the existing networks interpret the lock times at or above the threshold
+// as Unix timestamps. Before these heights, all the lock times are block heights.
+// The other networks accept the timestamp lock times from the genesis.
+const (
+	MainnetTimestampLockTimeHeight = 2_500_000
+	TestnetTimestampLockTimeHeight = 1_500_000
+)
+
+// TimestampLockTimeHeight returns the height that the timestamp lock times are accepted from.
+func (n ChainType) TimestampLockTimeHeight() uint32 {
+	switch n {
+	case Mainnet:
+		return MainnetTimestampLockTimeHeight
+	case Testnet:
+		return TestnetTimestampLockTimeHeight
+	default:
+		return 0
+	}
+}
+
+// The heights that the existing networks accept the payload types that are added after the launch.
+// Before these heights, the transactions with these payload types are rejected.
+// The other networks accept them from the genesis.
+const (
+	MainnetPayloadActivationHeight = 2_500_000
+	TestnetPayloadActivationHeight = 1_500_000
+)
+
+// PayloadActivationHeights returns the heights that the payload types,
+// added after the launch, are accepted from.
+func (n ChainType) PayloadActivationHeights() map[payload.Type]uint32 {
+	var height uint32
+	switch n {
+	case Mainnet:
+		height = MainnetPayloadActivationHeight
+	case Testnet:
+		height = TestnetPayloadActivationHeight
+	default:
+		return map[payload.Type]uint32{}
+	}
+
+	return map[payload.Type]uint32{
+		payload.TypeEscrow:      height,
+		payload.TypeData:        height,
+		payload.TypeAllowlist:   height,
+		payload.TypeCondition:   height,
+		payload.TypeDelegate:    height,
+		payload.TypeRewardSplit: height,
+	}
+}
+
 type genAccount struct {
 	Address string `cbor:"1,keyasint" json:"address"`
 	Balance int64  `cbor:"2,keyasint" json:"balance"`
@@ -60,6 +146,8 @@ type genesisData struct {
 	Params      *param.Params  `cbor:"2,keyasint" json:"params"`
 	Accounts    []genAccount   `cbor:"3,keyasint" json:"accounts"`
 	Validators  []genValidator `cbor:"4,keyasint" json:"validators"`
+	HRP         *crypto.HRP    `cbor:"5,keyasint,omitempty" json:"hrp,omitempty"`
+	Simnet      bool           `cbor:"6,keyasint,omitempty" json:"simnet,omitempty"`
 }
 
 func (gen *Genesis) Hash() hash.Hash {
@@ -71,6 +159,28 @@ func (gen *Genesis) Hash() hash.Hash {
 	return hash.CalcHash(bs)
 }
 
+// ChainID returns the chain ID that separates the signatures of this network from the others.
+// It is derived from the genesis hash, so two local networks never share a chain ID.
+func (gen *Genesis) ChainID() string {
+	return gen.Hash().String()
+}
+
+// SignDomain returns the sign domain of the network.
+func (gen *Genesis) SignDomain() crypto.SignDomain {
+	activationHeight := uint32(0)
+	switch gen.ChainType() {
+	case Mainnet:
+		activationHeight = MainnetSignDomainHeight
+	case Testnet:
+		activationHeight = TestnetSignDomainHeight
+	}
+
+	return crypto.SignDomain{
+		ChainID:          gen.ChainID(),
+		ActivationHeight: activationHeight,
+	}
+}
+
 func (gen *Genesis) GenesisTime() time.Time {
 	return gen.data.GenesisTime
 }
@@ -79,6 +189,66 @@ func (gen *Genesis) Params() *param.Params {
 	return gen.data.Params
 }
 
+// HRP returns the human-readable parts of the addresses and keys for this chain.
+// The parts that are not customized in the genesis are set from the chain defaults.
+func (gen *Genesis) HRP() crypto.HRP {
+	if gen.data.HRP != nil {
+		return gen.data.HRP.WithDefaults(crypto.TestnetHRP)
+	}
+
+	if gen.ChainType().IsMainnet() {
+		return crypto.MainnetHRP
+	}
+
+	return crypto.TestnetHRP
+}
+
+// SetCustomHRP customizes the human-readable parts of the addresses and keys for
+// a private network.
+// It changes the genesis hash, so it must be called before sharing the genesis.
+func (gen *Genesis) SetCustomHRP(hrp crypto.HRP) error {
+	if err := hrp.WithDefaults(crypto.TestnetHRP).BasicCheck(); err != nil {
+		return err
+	}
+
+	if hrp.IsEmpty() {
+		gen.data.HRP = nil
+	} else {
+		gen.data.HRP = &hrp
+	}
+
+	return nil
+}
+
+// SetSimnet makes the network a simnet, that blocks are produced on demand.
+// It changes the genesis hash, so it must be called before sharing the genesis.
+func (gen *Genesis) SetSimnet() {
+	gen.data.Simnet = true
+}
+
+// SetPermission makes the network permissioned. Only the allowlisted validators,
+// which are the genesis validators and the ones that the governors allow later,
+// can bond or join the committee.
+// It changes the genesis hash, so it must be called before sharing the genesis.
+func (gen *Genesis) SetPermission(governors []crypto.Address) error {
+	if len(governors) == 0 {
+		return NoGovernorError{}
+	}
+
+	govs := make([]string, 0, len(governors))
+	for _, addr := range governors {
+		if !addr.IsAccountAddress() {
+			return InvalidGovernorError{Address: addr.String()}
+		}
+		govs = append(govs, addr.String())
+	}
+
+	gen.data.Params.Permissioned = true
+	gen.data.Params.Governors = govs
+
+	return nil
+}
+
 func (gen *Genesis) Accounts() map[crypto.Address]*account.Account {
 	accs := make(map[crypto.Address]*account.Account)
 	for i, genAcc := range gen.data.Accounts {
@@ -161,6 +331,14 @@ func LoadFromFile(file string) (*Genesis, error) {
 	if err := json.Unmarshal(dat, &gen); err != nil {
 		return nil, err
 	}
+	if gen.data.HRP != nil {
+		if err := gen.HRP().BasicCheck(); err != nil {
+			return nil, err
+		}
+	}
+	if gen.data.Params.Permissioned && len(gen.data.Params.Governors) == 0 {
+		return nil, NoGovernorError{}
+	}
 
 	return &gen, nil
 }
@@ -186,6 +364,10 @@ func (gen *Genesis) TotalSupply() int64 {
 }
 
 func (gen *Genesis) ChainType() ChainType {
+	if gen.data.Simnet {
+		return Simnet
+	}
+
 	switch gen.Hash() {
 	case MainnetGenesis().Hash():
 		return Mainnet
@@ -10,6 +10,7 @@ import (
 	"github.com/pactus-project/pactus/genesis"
 	"github.com/pactus-project/pactus/types/account"
 	"github.com/pactus-project/pactus/types/param"
+	"github.com/pactus-project/pactus/types/tx/payload"
 	"github.com/pactus-project/pactus/types/validator"
 	"github.com/pactus-project/pactus/util"
 	"github.com/pactus-project/pactus/util/testsuite"
@@ -103,3 +104,213 @@ func TestCheckGenesisAccountAndValidator(t *testing.T) {
 		assert.Equal(t, val.Hash(), vals[i].Hash())
 	}
 }
+
+func TestGenesisHRP(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	assert.Equal(t, crypto.MainnetHRP, genesis.MainnetGenesis().HRP())
+	assert.Equal(t, crypto.TestnetHRP, genesis.TestnetGenesis().HRP())
+
+	val, _ := ts.GenerateTestValidator(0)
+	gen := genesis.MakeGenesis(util.Now(), map[crypto.Address]*account.Account{},
+		[]*validator.Validator{val}, param.DefaultParams())
+	hashBefore := gen.Hash()
+	assert.Equal(t, crypto.TestnetHRP, gen.HRP())
+
+	t.Run("Invalid HRP", func(t *testing.T) {
+		err := gen.SetCustomHRP(crypto.HRP{Address: "My-Net"})
+		assert.ErrorIs(t, err, crypto.InvalidHRPError("My-Net"))
+
+		err = gen.SetCustomHRP(crypto.HRP{Address: "tpublic"})
+		assert.ErrorIs(t, err, crypto.DuplicatedHRPError("tpublic"))
+		assert.Equal(t, hashBefore, gen.Hash())
+	})
+
+	t.Run("Custom HRP", func(t *testing.T) {
+		require.NoError(t, gen.SetCustomHRP(crypto.HRP{Address: "mynet"}))
+		assert.NotEqual(t, hashBefore, gen.Hash())
+		assert.Equal(t, genesis.Localnet, gen.ChainType())
+		assert.Equal(t, "mynet", gen.HRP().Address)
+		assert.Equal(t, crypto.TestnetHRP.PublicKey, gen.HRP().PublicKey)
+
+		f := util.TempFilePath()
+		require.NoError(t, gen.SaveToFile(f))
+		loaded, err := genesis.LoadFromFile(f)
+		require.NoError(t, err)
+		assert.Equal(t, gen.Hash(), loaded.Hash())
+		assert.Equal(t, gen.HRP(), loaded.HRP())
+	})
+
+	t.Run("Reset HRP", func(t *testing.T) {
+		require.NoError(t, gen.SetCustomHRP(crypto.HRP{}))
+		assert.Equal(t, hashBefore, gen.Hash())
+	})
+
+	t.Run("Loading invalid HRP", func(t *testing.T) {
+		f := util.TempFilePath()
+		require.NoError(t, gen.SaveToFile(f))
+		bs, _ := util.ReadFile(f)
+		var data map[string]any
+		require.NoError(t, json.Unmarshal(bs, &data))
+		data["hrp"] = map[string]string{"address": "PC"}
+		bs, _ = json.Marshal(data)
+		require.NoError(t, util.WriteFile(f, bs))
+
+		_, err := genesis.LoadFromFile(f)
+		assert.ErrorIs(t, err, crypto.InvalidHRPError("PC"))
+	})
+}
+
+func TestGenesisPermission(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	val, _ := ts.GenerateTestValidator(0)
+	gen := genesis.MakeGenesis(util.Now(), map[crypto.Address]*account.Account{},
+		[]*validator.Validator{val}, param.DefaultParams())
+	hashBefore := gen.Hash()
+	assert.False(t, gen.Params().Permissioned)
+
+	t.Run("No governor", func(t *testing.T) {
+		err := gen.SetPermission([]crypto.Address{})
+		assert.ErrorIs(t, err, genesis.NoGovernorError{})
+	})
+
+	t.Run("Invalid governor", func(t *testing.T) {
+		valAddr := ts.RandValAddress()
+		err := gen.SetPermission([]crypto.Address{valAddr})
+		assert.ErrorIs(t, err, genesis.InvalidGovernorError{Address: valAddr.String()})
+		assert.Equal(t, hashBefore, gen.Hash())
+	})
+
+	t.Run("Ok", func(t *testing.T) {
+		governor := ts.RandAccAddress()
+		require.NoError(t, gen.SetPermission([]crypto.Address{governor}))
+		assert.NotEqual(t, hashBefore, gen.Hash())
+		assert.True(t, gen.Params().Permissioned)
+		assert.True(t, gen.Params().IsGovernor(governor))
+		assert.False(t, gen.Params().IsGovernor(ts.RandAccAddress()))
+
+		f := util.TempFilePath()
+		require.NoError(t, gen.SaveToFile(f))
+		loaded, err := genesis.LoadFromFile(f)
+		require.NoError(t, err)
+		assert.Equal(t, gen.Hash(), loaded.Hash())
+	})
+
+	t.Run("Loading without governors", func(t *testing.T) {
+		gen.Params().Governors = nil
+
+		f := util.TempFilePath()
+		require.NoError(t, gen.SaveToFile(f))
+		_, err := genesis.LoadFromFile(f)
+		assert.ErrorIs(t, err, genesis.NoGovernorError{})
+	})
+}
+
+func TestGenesisSimnet(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	val, _ := ts.GenerateTestValidator(0)
+	gen := genesis.MakeGenesis(util.Now(), map[crypto.Address]*account.Account{},
+		[]*validator.Validator{val}, param.DefaultParams())
+	hashBefore := gen.Hash()
+	assert.Equal(t, genesis.Localnet, gen.ChainType())
+
+	gen.SetSimnet()
+	assert.NotEqual(t, hashBefore, gen.Hash())
+	assert.Equal(t, genesis.Simnet, gen.ChainType())
+	assert.Equal(t, crypto.TestnetHRP, gen.HRP())
+	assert.Equal(t, gen.Hash().String(), gen.ChainID())
+
+	f := util.TempFilePath()
+	require.NoError(t, gen.SaveToFile(f))
+	loaded, err := genesis.LoadFromFile(f)
+	require.NoError(t, err)
+	assert.Equal(t, gen.Hash(), loaded.Hash())
+	assert.Equal(t, genesis.Simnet, loaded.ChainType())
+}
+
+func TestSignDomain(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	mainnetGen := genesis.MainnetGenesis()
+	testnetGen := genesis.TestnetGenesis()
+	val, _ := ts.GenerateTestValidator(0)
+	localnetGen := genesis.MakeGenesis(util.Now(), map[crypto.Address]*account.Account{},
+		[]*validator.Validator{val}, param.DefaultParams())
+
+	mainnet := mainnetGen.SignDomain()
+	testnet := testnetGen.SignDomain()
+	localnet := localnetGen.SignDomain()
+
+	assert.Equal(t, crypto.SignDomain{
+		ChainID:          mainnetGen.Hash().String(),
+		ActivationHeight: genesis.MainnetSignDomainHeight,
+	}, mainnet)
+	assert.Equal(t, crypto.SignDomain{
+		ChainID:          testnetGen.Hash().String(),
+		ActivationHeight: genesis.TestnetSignDomainHeight,
+	}, testnet)
+	assert.Equal(t, crypto.SignDomain{ChainID: localnetGen.Hash().String(), ActivationHeight: 0}, localnet)
+
+	// Two local networks don't share the chain ID.
+	otherGen := genesis.MakeGenesis(util.Now().Add(time.Minute), map[crypto.Address]*account.Account{},
+		[]*validator.Validator{val}, param.DefaultParams())
+	assert.NotEqual(t, localnetGen.ChainID(), otherGen.ChainID())
+
+	assert.NoError(t, mainnet.BasicCheck())
+	assert.NoError(t, testnet.BasicCheck())
+	assert.NoError(t, localnet.BasicCheck())
+
+	// The existing networks keep the legacy sign bytes before the activation height.
+	assert.False(t, mainnet.IsActive(genesis.MainnetSignDomainHeight-1))
+	assert.True(t, mainnet.IsActive(genesis.MainnetSignDomainHeight))
+	assert.True(t, localnet.IsActive(1))
+}
+
+func TestProofOfPossessionHeight(t *testing.T) {
+	assert.Equal(t, uint32(genesis.MainnetProofOfPossessionHeight), genesis.Mainnet.ProofOfPossessionHeight())
+	assert.Equal(t, uint32(genesis.TestnetProofOfPossessionHeight), genesis.Testnet.ProofOfPossessionHeight())
+	assert.Zero(t, genesis.Localnet.ProofOfPossessionHeight())
+	assert.Zero(t, genesis.Simnet.ProofOfPossessionHeight())
+
+	// The existing networks don't require the proof before the activation height.
+	params := genesis.MainnetGenesis().Params()
+	params.ProofOfPossessionHeight = genesis.Mainnet.ProofOfPossessionHeight()
+	assert.False(t, params.IsProofOfPossessionRequired(genesis.MainnetProofOfPossessionHeight-1))
+	assert.True(t, params.IsProofOfPossessionRequired(genesis.MainnetProofOfPossessionHeight))
+}
+
+func TestTimestampLockTimeHeight(t *testing.T) {
+	assert.Equal(t, uint32(genesis.MainnetTimestampLockTimeHeight), genesis.Mainnet.TimestampLockTimeHeight())
+	assert.Equal(t, uint32(genesis.TestnetTimestampLockTimeHeight), genesis.Testnet.TimestampLockTimeHeight())
+	assert.Zero(t, genesis.Localnet.TimestampLockTimeHeight())
+	assert.Zero(t, genesis.Simnet.TimestampLockTimeHeight())
+
+	// The existing networks interpret all the lock times as block heights before the activation height.
+	params := genesis.MainnetGenesis().Params()
+	params.TimestampLockTimeHeight = genesis.Mainnet.TimestampLockTimeHeight()
+	assert.False(t, params.IsTimestampLockTimeActive(genesis.MainnetTimestampLockTimeHeight-1))
+	assert.True(t, params.IsTimestampLockTimeActive(genesis.MainnetTimestampLockTimeHeight))
+}
+
+func TestPayloadActivationHeights(t *testing.T) {
+	assert.Equal(t, uint32(genesis.MainnetPayloadActivationHeight),
+		genesis.Mainnet.PayloadActivationHeights()[payload.TypeEscrow])
+	assert.Equal(t, uint32(genesis.TestnetPayloadActivationHeight),
+		genesis.Testnet.PayloadActivationHeights()[payload.TypeEscrow])
+	assert.Empty(t, genesis.Localnet.PayloadActivationHeights())
+	assert.Empty(t, genesis.Simnet.PayloadActivationHeights())
+
+	// The existing networks reject the new payload types before the activation height.
+	params := genesis.MainnetGenesis().Params()
+	params.PayloadActivationHeights = genesis.Mainnet.PayloadActivationHeights()
+	assert.False(t, params.IsPayloadActive(payload.TypeEscrow, genesis.MainnetPayloadActivationHeight-1))
+	assert.True(t, params.IsPayloadActive(payload.TypeEscrow, genesis.MainnetPayloadActivationHeight))
+	assert.False(t, params.IsPayloadActive(payload.TypeData, genesis.MainnetPayloadActivationHeight-1))
+	assert.False(t, params.IsPayloadActive(payload.TypeAllowlist, genesis.MainnetPayloadActivationHeight-1))
+	assert.False(t, params.IsPayloadActive(payload.TypeCondition, genesis.MainnetPayloadActivationHeight-1))
+	assert.False(t, params.IsPayloadActive(payload.TypeDelegate, genesis.MainnetPayloadActivationHeight-1))
+	assert.False(t, params.IsPayloadActive(payload.TypeRewardSplit, genesis.MainnetPayloadActivationHeight-1))
+	assert.True(t, params.IsPayloadActive(payload.TypeTransfer, 1))
+}
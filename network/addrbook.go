@@ -0,0 +1,423 @@
+package network
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	mrand "math/rand"
+	"sync"
+	"time"
+
+	lp2ppeer "github.com/libp2p/go-libp2p/core/peer"
+	"github.com/multiformats/go-multiaddr"
+	"github.com/pactus-project/pactus/crypto/hash"
+	"github.com/pactus-project/pactus/util"
+)
+
+const (
+	// The new table keeps the addresses that are heard about, but not connected to yet.
+	newBucketCount = 64
+	newBucketSize  = 32
+
+	// The tried table keeps the addresses that the node has successfully connected to.
+	triedBucketCount = 16
+	triedBucketSize  = 32
+
+	// triedBucketsPerGroup is the number of the tried buckets that a network group can be placed in.
+	triedBucketsPerGroup = 4
+
+	// An address is terrible, if it is not connected after this number of attempts.
+	maxRetries = 3
+	// An address is terrible, if it has failed this number of times since the last success,
+	// and the last success is older than minFailDuration.
+	maxFailures     = 10
+	minFailDuration = 7 * 24 * time.Hour
+	// An address is terrible, if it is not connected within this duration since it is added.
+	addrHorizon = 30 * 24 * time.Hour
+)
+
+// knownAddress is an address in the address book, together with its connection history.
+type knownAddress struct {
+	AddrInfo    lp2ppeer.AddrInfo `json:"addr_info"`
+	Source      string            `json:"source"`
+	Attempts    int               `json:"attempts"`
+	AddedAt     time.Time         `json:"added_at"`
+	LastAttempt time.Time         `json:"last_attempt"`
+	LastSuccess time.Time         `json:"last_success"`
+	Tried       bool              `json:"tried"`
+
+	bucket int
+}
+
+// isTerrible checks if the address is not worth keeping.
+// The addresses that are attempted in the last minute are never terrible,
+// to prevent removing them while the connection is in progress.
+func (ka *knownAddress) isTerrible(now time.Time) bool {
+	if now.Sub(ka.LastAttempt) < time.Minute {
+		return false
+	}
+
+	if ka.LastSuccess.IsZero() {
+		return ka.Attempts >= maxRetries || now.Sub(ka.AddedAt) > addrHorizon
+	}
+
+	return ka.Attempts >= maxFailures && now.Sub(ka.LastSuccess) > minFailDuration
+}
+
+// addrBookData is the persisted form of the address book.
+type addrBookData struct {
+	Key       string          `json:"key"`
+	Addresses []*knownAddress `json:"addresses"`
+}
+
+// addrBook is the address manager of the node.
+// It keeps the peer addresses in two tables: the new table for the addresses that are heard about,
+// and the tried table for the addresses that the node has successfully connected to.
+// The tables are divided into the buckets, and the bucket of an address is chosen by its network group
+// and a secret key, so an attacker can't fill the tables with the addresses under its control.
+// When a bucket is full, the terrible or the oldest address in the bucket is evicted.
+type addrBook struct {
+	lk sync.RWMutex
+
+	key          []byte
+	geoDB        *geoDB
+	addrs        map[lp2ppeer.ID]*knownAddress
+	newBuckets   [newBucketCount]map[lp2ppeer.ID]*knownAddress
+	triedBuckets [triedBucketCount]map[lp2ppeer.ID]*knownAddress
+}
+
+func newAddrBook(db *geoDB) *addrBook {
+	key := make([]byte, 32)
+	_, _ = rand.Read(key)
+
+	book := &addrBook{
+		key:   key,
+		geoDB: db,
+		addrs: make(map[lp2ppeer.ID]*knownAddress),
+	}
+	for i := range book.newBuckets {
+		book.newBuckets[i] = make(map[lp2ppeer.ID]*knownAddress)
+	}
+	for i := range book.triedBuckets {
+		book.triedBuckets[i] = make(map[lp2ppeer.ID]*knownAddress)
+	}
+
+	return book
+}
+
+// loadAddrBook loads the address book from the file.
+// If the file doesn't exist, it returns an empty address book.
+func loadAddrBook(path string, db *geoDB) (*addrBook, error) {
+	book := newAddrBook(db)
+	if !util.PathExists(path) {
+		return book, nil
+	}
+
+	data, err := util.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	bookData := new(addrBookData)
+	if err := json.Unmarshal(data, bookData); err != nil {
+		return nil, err
+	}
+	key, err := hex.DecodeString(bookData.Key)
+	if err != nil {
+		return nil, err
+	}
+	book.key = key
+
+	for _, ka := range bookData.Addresses {
+		if len(ka.AddrInfo.Addrs) == 0 {
+			continue
+		}
+		if ka.Tried {
+			ka.Tried = false
+			book.addNew(ka, time.Now())
+			book.markTried(ka, time.Now())
+		} else {
+			book.addNew(ka, time.Now())
+		}
+	}
+
+	return book, nil
+}
+
+// Save writes the address book to the file.
+func (book *addrBook) Save(path string) error {
+	book.lk.RLock()
+	bookData := &addrBookData{
+		Key:       hex.EncodeToString(book.key),
+		Addresses: make([]*knownAddress, 0, len(book.addrs)),
+	}
+	for _, ka := range book.addrs {
+		bookData.Addresses = append(bookData.Addresses, ka)
+	}
+	data, err := json.MarshalIndent(bookData, "", "  ")
+	book.lk.RUnlock()
+
+	if err != nil {
+		return err
+	}
+
+	return util.WriteFile(path, data)
+}
+
+// Size returns the number of the addresses in the new and the tried tables.
+func (book *addrBook) Size() (int, int) {
+	book.lk.RLock()
+	defer book.lk.RUnlock()
+
+	numTried := 0
+	for _, bucket := range book.triedBuckets {
+		numTried += len(bucket)
+	}
+
+	return len(book.addrs) - numTried, numTried
+}
+
+// Get returns the known address of the peer, or nil if it is not in the address book.
+func (book *addrBook) Get(pid lp2ppeer.ID) *knownAddress {
+	book.lk.RLock()
+	defer book.lk.RUnlock()
+
+	return book.addrs[pid]
+}
+
+// AddAddress adds the address to the new table.
+// The source is the network group of the peer that the address is heard from.
+// If the address is already known, it is ignored.
+func (book *addrBook) AddAddress(ai lp2ppeer.AddrInfo, source string) {
+	if len(ai.Addrs) == 0 {
+		return
+	}
+
+	book.lk.Lock()
+	defer book.lk.Unlock()
+
+	if _, ok := book.addrs[ai.ID]; ok {
+		return
+	}
+
+	now := time.Now()
+	book.addNew(&knownAddress{
+		AddrInfo: book.pickAddr(ai),
+		Source:   source,
+		AddedAt:  now,
+	}, now)
+}
+
+// MarkAttempt records a connection attempt to the peer.
+func (book *addrBook) MarkAttempt(pid lp2ppeer.ID) {
+	book.lk.Lock()
+	defer book.lk.Unlock()
+
+	ka, ok := book.addrs[pid]
+	if !ok {
+		return
+	}
+	ka.Attempts++
+	ka.LastAttempt = time.Now()
+}
+
+// MarkGood records a successful connection to the peer and moves it to the tried table.
+// If the address is not known, it is added first.
+func (book *addrBook) MarkGood(ai lp2ppeer.AddrInfo) {
+	if len(ai.Addrs) == 0 {
+		return
+	}
+
+	book.lk.Lock()
+	defer book.lk.Unlock()
+
+	now := time.Now()
+	ka, ok := book.addrs[ai.ID]
+	if !ok {
+		ka = &knownAddress{
+			AddrInfo: book.pickAddr(ai),
+			Source:   book.netGroup(ai.Addrs[0]),
+			AddedAt:  now,
+		}
+		book.addNew(ka, now)
+	}
+	ka.Attempts = 0
+	ka.LastSuccess = now
+	ka.LastAttempt = now
+
+	if !ka.Tried {
+		book.markTried(ka, now)
+	}
+}
+
+// Select returns up to count addresses to connect to, excluding the terrible ones
+// and the ones that the exclude function returns true for.
+// The tried and the new addresses are selected with the same chance.
+func (book *addrBook) Select(count int, exclude func(lp2ppeer.ID) bool) []lp2ppeer.AddrInfo {
+	book.lk.RLock()
+	defer book.lk.RUnlock()
+
+	now := time.Now()
+	tried := make([]*knownAddress, 0)
+	fresh := make([]*knownAddress, 0)
+	for pid, ka := range book.addrs {
+		if ka.isTerrible(now) || (exclude != nil && exclude(pid)) {
+			continue
+		}
+		if ka.Tried {
+			tried = append(tried, ka)
+		} else {
+			fresh = append(fresh, ka)
+		}
+	}
+	mrand.Shuffle(len(tried), func(i, j int) { tried[i], tried[j] = tried[j], tried[i] })
+	mrand.Shuffle(len(fresh), func(i, j int) { fresh[i], fresh[j] = fresh[j], fresh[i] })
+
+	selected := make([]lp2ppeer.AddrInfo, 0, count)
+	for len(selected) < count && (len(tried) > 0 || len(fresh) > 0) {
+		if len(fresh) == 0 || (len(tried) > 0 && mrand.Intn(2) == 0) {
+			selected = append(selected, tried[0].AddrInfo)
+			tried = tried[1:]
+		} else {
+			selected = append(selected, fresh[0].AddrInfo)
+			fresh = fresh[1:]
+		}
+	}
+
+	return selected
+}
+
+// SelectNew returns a random address from the new table to be tested by a feeler connection.
+func (book *addrBook) SelectNew(exclude func(lp2ppeer.ID) bool) (lp2ppeer.AddrInfo, bool) {
+	book.lk.RLock()
+	defer book.lk.RUnlock()
+
+	now := time.Now()
+	candidates := make([]*knownAddress, 0)
+	for pid, ka := range book.addrs {
+		if ka.Tried || ka.isTerrible(now) || (exclude != nil && exclude(pid)) {
+			continue
+		}
+		candidates = append(candidates, ka)
+	}
+	if len(candidates) == 0 {
+		return lp2ppeer.AddrInfo{}, false
+	}
+
+	return candidates[mrand.Intn(len(candidates))].AddrInfo, true
+}
+
+// pickAddr keeps one address of the peer, preferring the public ones.
+func (book *addrBook) pickAddr(ai lp2ppeer.AddrInfo) lp2ppeer.AddrInfo {
+	for _, ma := range ai.Addrs {
+		if multiAddrNetGroup(book.geoDB, ma) != "" {
+			return lp2ppeer.AddrInfo{ID: ai.ID, Addrs: []multiaddr.Multiaddr{ma}}
+		}
+	}
+
+	return lp2ppeer.AddrInfo{ID: ai.ID, Addrs: []multiaddr.Multiaddr{ai.Addrs[0]}}
+}
+
+func (book *addrBook) netGroup(ma multiaddr.Multiaddr) string {
+	group := multiAddrNetGroup(book.geoDB, ma)
+	if group == "" {
+		// The local addresses are grouped together.
+		return "local"
+	}
+
+	return group
+}
+
+// bucketIndex returns a keyed hash of the data, modulo the number of the buckets.
+func (book *addrBook) bucketIndex(count int, data ...[]byte) int {
+	buf := make([]byte, 0, 64)
+	buf = append(buf, book.key...)
+	for _, d := range data {
+		buf = append(buf, d...)
+	}
+	h := hash.CalcHash(buf)
+
+	return int(binary.LittleEndian.Uint64(h.Bytes()) % uint64(count))
+}
+
+func (book *addrBook) newBucketIndex(ka *knownAddress) int {
+	group := book.netGroup(ka.AddrInfo.Addrs[0])
+
+	return book.bucketIndex(newBucketCount, []byte(group), []byte(ka.Source))
+}
+
+func (book *addrBook) triedBucketIndex(ka *knownAddress) int {
+	group := book.netGroup(ka.AddrInfo.Addrs[0])
+	slot := book.bucketIndex(triedBucketsPerGroup, []byte(ka.AddrInfo.ID))
+
+	return book.bucketIndex(triedBucketCount, []byte(group), []byte{byte(slot)})
+}
+
+// addNew adds the address to its bucket in the new table, evicting an address if the bucket is full.
+// It should be called while the lock is held.
+func (book *addrBook) addNew(ka *knownAddress, now time.Time) {
+	index := book.newBucketIndex(ka)
+	bucket := book.newBuckets[index]
+	if len(bucket) >= newBucketSize {
+		book.remove(book.evictionCandidate(bucket, now, func(ka *knownAddress) time.Time {
+			return ka.AddedAt
+		}))
+	}
+
+	ka.Tried = false
+	ka.bucket = index
+	bucket[ka.AddrInfo.ID] = ka
+	book.addrs[ka.AddrInfo.ID] = ka
+}
+
+// markTried moves the address from the new table to the tried table.
+// If the tried bucket is full, the oldest address in the bucket is moved back to the new table.
+// It should be called while the lock is held.
+func (book *addrBook) markTried(ka *knownAddress, now time.Time) {
+	book.remove(ka)
+
+	index := book.triedBucketIndex(ka)
+	bucket := book.triedBuckets[index]
+	if len(bucket) >= triedBucketSize {
+		evicted := book.evictionCandidate(bucket, now, func(ka *knownAddress) time.Time {
+			return ka.LastSuccess
+		})
+		book.remove(evicted)
+		book.addNew(evicted, now)
+	}
+
+	ka.Tried = true
+	ka.bucket = index
+	bucket[ka.AddrInfo.ID] = ka
+	book.addrs[ka.AddrInfo.ID] = ka
+}
+
+// evictionCandidate returns a terrible address in the bucket, or the oldest one.
+// It should be called while the lock is held.
+func (book *addrBook) evictionCandidate(bucket map[lp2ppeer.ID]*knownAddress, now time.Time,
+	age func(*knownAddress) time.Time,
+) *knownAddress {
+	var oldest *knownAddress
+	for _, ka := range bucket {
+		if ka.isTerrible(now) {
+			return ka
+		}
+		if oldest == nil || age(ka).Before(age(oldest)) {
+			oldest = ka
+		}
+	}
+
+	return oldest
+}
+
+// remove removes the address from the address book.
+// It should be called while the lock is held.
+func (book *addrBook) remove(ka *knownAddress) {
+	if ka.Tried {
+		delete(book.triedBuckets[ka.bucket], ka.AddrInfo.ID)
+	} else {
+		delete(book.newBuckets[ka.bucket], ka.AddrInfo.ID)
+	}
+	delete(book.addrs, ka.AddrInfo.ID)
+}
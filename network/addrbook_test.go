@@ -0,0 +1,288 @@
+package network
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	lp2pnet "github.com/libp2p/go-libp2p/core/network"
+	lp2ppeer "github.com/libp2p/go-libp2p/core/peer"
+	"github.com/multiformats/go-multiaddr"
+	"github.com/pactus-project/pactus/util"
+	"github.com/pactus-project/pactus/util/testsuite"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func makeTestAddrInfo(ts *testsuite.TestSuite, ip string) lp2ppeer.AddrInfo {
+	return lp2ppeer.AddrInfo{
+		ID:    ts.RandPeerID(),
+		Addrs: []multiaddr.Multiaddr{multiaddr.StringCast(fmt.Sprintf("/ip4/%s/tcp/21888", ip))},
+	}
+}
+
+func TestAddrBookAddAddress(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+	book := newAddrBook(nil)
+
+	ai := makeTestAddrInfo(ts, "1.2.3.4")
+	book.AddAddress(ai, "bootstrap")
+	book.AddAddress(ai, "bootstrap")
+	book.AddAddress(lp2ppeer.AddrInfo{ID: ts.RandPeerID()}, "bootstrap")
+
+	numNew, numTried := book.Size()
+	assert.Equal(t, 1, numNew)
+	assert.Equal(t, 0, numTried)
+
+	ka := book.Get(ai.ID)
+	require.NotNil(t, ka)
+	assert.Equal(t, ai, ka.AddrInfo)
+	assert.Equal(t, "bootstrap", ka.Source)
+	assert.False(t, ka.Tried)
+}
+
+func TestAddrBookPickAddr(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+	book := newAddrBook(nil)
+
+	pid := ts.RandPeerID()
+	public := multiaddr.StringCast("/ip4/1.2.3.4/tcp/21888")
+	book.AddAddress(lp2ppeer.AddrInfo{
+		ID: pid,
+		Addrs: []multiaddr.Multiaddr{
+			multiaddr.StringCast("/ip4/127.0.0.1/tcp/21888"),
+			public,
+		},
+	}, "peerstore")
+
+	assert.Equal(t, []multiaddr.Multiaddr{public}, book.Get(pid).AddrInfo.Addrs)
+}
+
+func TestAddrBookNewBucketLimit(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+	book := newAddrBook(nil)
+
+	// All the addresses are in the same network group, so they go to the same bucket.
+	for i := 0; i < newBucketSize*3; i++ {
+		book.AddAddress(makeTestAddrInfo(ts, fmt.Sprintf("1.2.%d.%d", i/250, i%250+1)), "attacker")
+	}
+
+	numNew, _ := book.Size()
+	assert.Equal(t, newBucketSize, numNew)
+
+	// The addresses from the other groups are not affected.
+	book.AddAddress(makeTestAddrInfo(ts, "5.6.7.8"), "attacker")
+	numNew, _ = book.Size()
+	assert.Equal(t, newBucketSize+1, numNew)
+}
+
+func TestAddrBookEvictTerrible(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+	book := newAddrBook(nil)
+
+	addrs := make([]lp2ppeer.AddrInfo, 0, newBucketSize)
+	for i := 0; i < newBucketSize; i++ {
+		ai := makeTestAddrInfo(ts, fmt.Sprintf("1.2.3.%d", i+1))
+		book.AddAddress(ai, "bootstrap")
+		addrs = append(addrs, ai)
+	}
+
+	// Making the newest address terrible.
+	terrible := book.Get(addrs[len(addrs)-1].ID)
+	terrible.Attempts = maxRetries
+	terrible.LastAttempt = time.Now().Add(-time.Hour)
+
+	ai := makeTestAddrInfo(ts, "1.2.4.1")
+	book.AddAddress(ai, "bootstrap")
+
+	assert.NotNil(t, book.Get(ai.ID))
+	assert.Nil(t, book.Get(terrible.AddrInfo.ID))
+	assert.NotNil(t, book.Get(addrs[0].ID))
+}
+
+func TestAddrBookMarkGood(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+	book := newAddrBook(nil)
+
+	ai1 := makeTestAddrInfo(ts, "1.2.3.4")
+	ai2 := makeTestAddrInfo(ts, "5.6.7.8")
+	book.AddAddress(ai1, "bootstrap")
+	book.MarkAttempt(ai1.ID)
+	book.MarkAttempt(ts.RandPeerID())
+	assert.Equal(t, 1, book.Get(ai1.ID).Attempts)
+
+	book.MarkGood(ai1)
+	book.MarkGood(ai2)
+	book.MarkGood(lp2ppeer.AddrInfo{ID: ts.RandPeerID()})
+
+	numNew, numTried := book.Size()
+	assert.Equal(t, 0, numNew)
+	assert.Equal(t, 2, numTried)
+
+	ka := book.Get(ai1.ID)
+	assert.True(t, ka.Tried)
+	assert.Zero(t, ka.Attempts)
+	assert.False(t, ka.LastSuccess.IsZero())
+	assert.Equal(t, "5.6.0.0/16", book.Get(ai2.ID).Source)
+}
+
+func TestAddrBookTriedBucketLimit(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+	book := newAddrBook(nil)
+
+	// The addresses of a network group can only be placed in a few tried buckets.
+	count := triedBucketSize * triedBucketsPerGroup * 2
+	for i := 0; i < count; i++ {
+		book.MarkGood(makeTestAddrInfo(ts, fmt.Sprintf("1.2.%d.%d", i/250, i%250+1)))
+	}
+
+	numNew, numTried := book.Size()
+	assert.LessOrEqual(t, numTried, triedBucketSize*triedBucketsPerGroup)
+	// The evicted addresses are moved back to the new table.
+	assert.Positive(t, numNew)
+}
+
+func TestAddrBookIsTerrible(t *testing.T) {
+	now := time.Now()
+
+	tests := []struct {
+		name     string
+		ka       knownAddress
+		terrible bool
+	}{
+		{"New address", knownAddress{AddedAt: now}, false},
+		{"Never connected, many attempts", knownAddress{
+			AddedAt: now, Attempts: maxRetries, LastAttempt: now.Add(-time.Hour),
+		}, true},
+		{"Never connected, attempting now", knownAddress{
+			AddedAt: now, Attempts: maxRetries, LastAttempt: now,
+		}, false},
+		{"Never connected, too old", knownAddress{AddedAt: now.Add(-addrHorizon - time.Hour)}, true},
+		{"Connected recently, many failures", knownAddress{
+			AddedAt: now, LastSuccess: now.Add(-time.Hour), Attempts: maxFailures,
+			LastAttempt: now.Add(-time.Hour),
+		}, false},
+		{"Connected long ago, many failures", knownAddress{
+			AddedAt: now, LastSuccess: now.Add(-minFailDuration - time.Hour), Attempts: maxFailures,
+			LastAttempt: now.Add(-time.Hour),
+		}, true},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.terrible, tt.ka.isTerrible(now), tt.name)
+	}
+}
+
+func TestAddrBookSelect(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+	book := newAddrBook(nil)
+
+	assert.Empty(t, book.Select(8, nil))
+	_, ok := book.SelectNew(nil)
+	assert.False(t, ok)
+
+	triedAddr := makeTestAddrInfo(ts, "1.1.1.1")
+	newAddr := makeTestAddrInfo(ts, "2.2.2.2")
+	excludedAddr := makeTestAddrInfo(ts, "3.3.3.3")
+	terribleAddr := makeTestAddrInfo(ts, "4.4.4.4")
+	book.MarkGood(triedAddr)
+	book.AddAddress(newAddr, "bootstrap")
+	book.AddAddress(excludedAddr, "bootstrap")
+	book.AddAddress(terribleAddr, "bootstrap")
+	ka := book.Get(terribleAddr.ID)
+	ka.Attempts = maxRetries
+	ka.LastAttempt = time.Now().Add(-time.Hour)
+
+	exclude := func(pid lp2ppeer.ID) bool {
+		return pid == excludedAddr.ID
+	}
+
+	selected := book.Select(8, exclude)
+	assert.Len(t, selected, 2)
+	assert.Contains(t, selected, triedAddr)
+	assert.Contains(t, selected, newAddr)
+	assert.Len(t, book.Select(1, exclude), 1)
+
+	for i := 0; i < 10; i++ {
+		ai, ok := book.SelectNew(exclude)
+		require.True(t, ok)
+		assert.Equal(t, newAddr, ai)
+	}
+}
+
+func TestAddrBookPersistence(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+	path := util.TempFilePath()
+
+	book, err := loadAddrBook(path, nil)
+	require.NoError(t, err)
+
+	triedAddr := makeTestAddrInfo(ts, "1.1.1.1")
+	newAddr := makeTestAddrInfo(ts, "2.2.2.2")
+	book.MarkGood(triedAddr)
+	book.AddAddress(newAddr, "bootstrap")
+	book.MarkAttempt(newAddr.ID)
+	require.NoError(t, book.Save(path))
+
+	loaded, err := loadAddrBook(path, nil)
+	require.NoError(t, err)
+	assert.Equal(t, book.key, loaded.key)
+
+	numNew, numTried := loaded.Size()
+	assert.Equal(t, 1, numNew)
+	assert.Equal(t, 1, numTried)
+
+	ka := loaded.Get(triedAddr.ID)
+	require.NotNil(t, ka)
+	assert.True(t, ka.Tried)
+	assert.Equal(t, triedAddr, ka.AddrInfo)
+	assert.Equal(t, book.Get(triedAddr.ID).bucket, ka.bucket)
+
+	ka = loaded.Get(newAddr.ID)
+	require.NotNil(t, ka)
+	assert.False(t, ka.Tried)
+	assert.Equal(t, 1, ka.Attempts)
+	assert.Equal(t, "bootstrap", ka.Source)
+	assert.Equal(t, book.Get(newAddr.ID).bucket, ka.bucket)
+
+	t.Run("Invalid file", func(t *testing.T) {
+		path := util.TempFilePath()
+		require.NoError(t, util.WriteFile(path, []byte("invalid")))
+
+		_, err := loadAddrBook(path, nil)
+		assert.Error(t, err)
+	})
+}
+
+func TestPeerMgrAddrBook(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	conf := testConfig()
+	conf.AddrBookFile = util.TempFilePath()
+	bootstrap := makeTestAddrInfo(ts, "127.0.0.1")
+	conf.BootstrapAddrStrings = []string{
+		fmt.Sprintf("%s/p2p/%s", bootstrap.Addrs[0], bootstrap.ID),
+	}
+	net := makeTestNetwork(t, conf, nil)
+
+	// The bootstrap peers are added to the address book on start.
+	assert.NotNil(t, net.peerMgr.addrBook.Get(bootstrap.ID))
+
+	// The outbound peers are moved to the tried table.
+	outbound := makeTestAddrInfo(ts, "1.2.3.4")
+	net.peerMgr.AddPeer(outbound.ID, outbound.Addrs[0], lp2pnet.DirOutbound)
+	assert.True(t, net.peerMgr.addrBook.Get(outbound.ID).Tried)
+
+	// The addresses in the peer store are collected.
+	discovered := makeTestAddrInfo(ts, "5.6.7.8")
+	net.host.Peerstore().AddAddrs(discovered.ID, discovered.Addrs, time.Hour)
+	net.peerMgr.collectAddresses()
+	assert.NotNil(t, net.peerMgr.addrBook.Get(discovered.ID))
+
+	net.Stop()
+
+	book, err := loadAddrBook(conf.AddrBookFile, nil)
+	require.NoError(t, err)
+	assert.NotNil(t, book.Get(outbound.ID))
+	assert.NotNil(t, book.Get(discovered.ID))
+}
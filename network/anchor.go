@@ -0,0 +1,59 @@
+package network
+
+import (
+	"encoding/json"
+	"os"
+
+	lp2ppeer "github.com/libp2p/go-libp2p/core/peer"
+	"github.com/pactus-project/pactus/util"
+)
+
+// Anchors are the outbound peers that the node was connected to before shutting down.
+// They are persisted on stop and reconnected first on the next start,
+// so an attacker can't take over all the outbound connections by poisoning the address table
+// and waiting for the node to restart.
+
+// loadAnchors reads the anchor peers from the file and removes the file.
+// The file is removed, so the node doesn't reconnect to the same anchors after a crash.
+// If the file doesn't exist, it returns no anchors.
+func loadAnchors(path string) ([]lp2ppeer.AddrInfo, error) {
+	if !util.PathExists(path) {
+		return nil, nil
+	}
+
+	data, err := util.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	if err := os.Remove(path); err != nil {
+		return nil, err
+	}
+
+	addrs := []string{}
+	if err := json.Unmarshal(data, &addrs); err != nil {
+		return nil, err
+	}
+
+	return MakeAddrInfos(addrs)
+}
+
+// saveAnchors writes the anchor peers to the file.
+func saveAnchors(path string, anchors []lp2ppeer.AddrInfo) error {
+	addrs := make([]string, 0, len(anchors))
+	for _, ai := range anchors {
+		p2pAddrs, err := lp2ppeer.AddrInfoToP2pAddrs(&ai)
+		if err != nil {
+			return err
+		}
+		for _, ma := range p2pAddrs {
+			addrs = append(addrs, ma.String())
+		}
+	}
+
+	data, err := json.MarshalIndent(addrs, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return util.WriteFile(path, data)
+}
@@ -0,0 +1,46 @@
+package network
+
+import (
+	"testing"
+
+	lp2ppeer "github.com/libp2p/go-libp2p/core/peer"
+	"github.com/multiformats/go-multiaddr"
+	"github.com/pactus-project/pactus/util"
+	"github.com/pactus-project/pactus/util/testsuite"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAnchors(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	t.Run("File not exists", func(t *testing.T) {
+		anchors, err := loadAnchors(util.TempFilePath())
+		assert.NoError(t, err)
+		assert.Empty(t, anchors)
+	})
+
+	t.Run("Invalid file", func(t *testing.T) {
+		path := util.TempFilePath()
+		require.NoError(t, util.WriteFile(path, []byte("invalid")))
+
+		_, err := loadAnchors(path)
+		assert.Error(t, err)
+	})
+
+	t.Run("Save and load", func(t *testing.T) {
+		path := util.TempFilePath()
+		anchors := []lp2ppeer.AddrInfo{
+			{ID: ts.RandPeerID(), Addrs: []multiaddr.Multiaddr{multiaddr.StringCast("/ip4/1.2.3.4/tcp/21888")}},
+			{ID: ts.RandPeerID(), Addrs: []multiaddr.Multiaddr{multiaddr.StringCast("/ip6/2a01:4f8::1/udp/21888/quic-v1")}},
+		}
+		require.NoError(t, saveAnchors(path, anchors))
+
+		loaded, err := loadAnchors(path)
+		require.NoError(t, err)
+		assert.Equal(t, anchors, loaded)
+
+		// The file is removed after loading.
+		assert.False(t, util.PathExists(path))
+	})
+}
@@ -0,0 +1,121 @@
+package network
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/pactus-project/pactus/util/logger"
+	"github.com/pactus-project/pactus/util/rand"
+)
+
+// chaosService sits between the gossip and stream services and the event channel.
+// It drops the received messages randomly and delays the rest of them.
+// Connection events are not affected.
+type chaosService struct {
+	ctx    context.Context
+	conf   *ChaosConfig
+	src    rand.Source
+	inCh   chan Event
+	outCh  chan Event
+	wg     sync.WaitGroup
+	logger *logger.SubLogger
+}
+
+func newChaosService(ctx context.Context, conf *ChaosConfig,
+	outCh chan Event, log *logger.SubLogger,
+) *chaosService {
+	seed := conf.Seed
+	if seed == 0 {
+		seed = int64(rand.Uint64())
+	}
+
+	return &chaosService{
+		ctx:    ctx,
+		conf:   conf,
+		src:    rand.NewSource(seed),
+		inCh:   make(chan Event, cap(outCh)),
+		outCh:  outCh,
+		logger: log,
+	}
+}
+
+func (c *chaosService) Start() {
+	c.logger.Warn("chaos mode is enabled",
+		"latency", c.conf.Latency, "jitter", c.conf.Jitter, "packet_loss", c.conf.PacketLoss)
+
+	c.wg.Add(1)
+	go c.run()
+}
+
+func (c *chaosService) Stop() {
+	c.wg.Wait()
+}
+
+func (c *chaosService) run() {
+	defer c.wg.Done()
+
+	for {
+		select {
+		case <-c.ctx.Done():
+			return
+
+		case e := <-c.inCh:
+			c.deliver(e)
+		}
+	}
+}
+
+func (c *chaosService) deliver(e Event) {
+	if c.shouldDrop() {
+		c.logger.Trace("chaos: message dropped", "type", e.Type())
+		if msg, ok := e.(*StreamMessage); ok {
+			_ = msg.Reader.Close()
+		}
+
+		return
+	}
+
+	delay := c.delay()
+	if delay == 0 {
+		select {
+		case <-c.ctx.Done():
+		case c.outCh <- e:
+		}
+
+		return
+	}
+
+	time.AfterFunc(delay, func() {
+		select {
+		case <-c.ctx.Done():
+		case c.outCh <- e:
+		}
+	})
+}
+
+func (c *chaosService) shouldDrop() bool {
+	if c.conf.PacketLoss == 0 {
+		return false
+	}
+
+	// A random float in [0, 1) with 53 bits of precision
+	f := float64(c.src.Uint64()>>11) / float64(uint64(1)<<53)
+
+	return f < c.conf.PacketLoss
+}
+
+// delay returns the latency plus a random jitter in [-jitter, +jitter].
+func (c *chaosService) delay() time.Duration {
+	delay := c.conf.Latency
+	if c.conf.Jitter > 0 {
+		span := 2*uint64(c.conf.Jitter) + 1
+		delay += time.Duration(c.src.Uint64()%span) - c.conf.Jitter
+	}
+
+	if delay < 0 {
+		return 0
+	}
+
+	return delay
+}
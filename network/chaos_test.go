@@ -0,0 +1,136 @@
+package network
+
+import (
+	"context"
+	"io"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/pactus-project/pactus/util/logger"
+	"github.com/pactus-project/pactus/util/testsuite"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type closeTracker struct {
+	closed atomic.Bool
+}
+
+func (*closeTracker) Read(_ []byte) (int, error) {
+	return 0, io.EOF
+}
+
+func (c *closeTracker) Close() error {
+	c.closed.Store(true)
+
+	return nil
+}
+
+func setupChaos(t *testing.T, conf *ChaosConfig) (*chaosService, chan Event) {
+	t.Helper()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	outCh := make(chan Event, 10)
+	chaos := newChaosService(ctx, conf, outCh, logger.NewSubLogger("_chaos", nil))
+	chaos.Start()
+
+	t.Cleanup(func() {
+		cancel()
+		chaos.Stop()
+	})
+
+	return chaos, outCh
+}
+
+func TestChaosDropAll(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	chaos, outCh := setupChaos(t, &ChaosConfig{Enable: true, PacketLoss: 1})
+
+	reader := &closeTracker{}
+	chaos.inCh <- &GossipMessage{From: ts.RandPeerID(), Data: []byte{1}}
+	chaos.inCh <- &StreamMessage{From: ts.RandPeerID(), Reader: reader}
+
+	assert.Eventually(t, func() bool { return reader.closed.Load() }, time.Second, 10*time.Millisecond)
+	assert.Empty(t, outCh)
+}
+
+func TestChaosNoLoss(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	chaos, outCh := setupChaos(t, &ChaosConfig{Enable: true})
+
+	msg := &GossipMessage{From: ts.RandPeerID(), Data: []byte{1}}
+	chaos.inCh <- msg
+
+	select {
+	case e := <-outCh:
+		assert.Equal(t, msg, e)
+	case <-time.After(time.Second):
+		require.Fail(t, "message is not delivered")
+	}
+}
+
+func TestChaosLatency(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	latency := 100 * time.Millisecond
+	chaos, outCh := setupChaos(t, &ChaosConfig{Enable: true, Latency: latency})
+
+	start := time.Now()
+	chaos.inCh <- &GossipMessage{From: ts.RandPeerID(), Data: []byte{1}}
+
+	select {
+	case <-outCh:
+		assert.GreaterOrEqual(t, time.Since(start), latency)
+	case <-time.After(time.Second):
+		require.Fail(t, "message is not delivered")
+	}
+}
+
+func TestChaosDelayBounds(t *testing.T) {
+	conf := &ChaosConfig{
+		Latency: 100 * time.Millisecond,
+		Jitter:  30 * time.Millisecond,
+		Seed:    1,
+	}
+	chaos := newChaosService(context.Background(), conf, make(chan Event), logger.NewSubLogger("_chaos", nil))
+
+	for i := 0; i < 1000; i++ {
+		delay := chaos.delay()
+		assert.GreaterOrEqual(t, delay, conf.Latency-conf.Jitter)
+		assert.LessOrEqual(t, delay, conf.Latency+conf.Jitter)
+	}
+
+	conf.Latency = 0
+	for i := 0; i < 1000; i++ {
+		assert.GreaterOrEqual(t, chaos.delay(), time.Duration(0))
+	}
+}
+
+func TestChaosDeterministicSeed(t *testing.T) {
+	conf := &ChaosConfig{
+		Jitter:     50 * time.Millisecond,
+		PacketLoss: 0.3,
+		Seed:       42,
+	}
+	log := logger.NewSubLogger("_chaos", nil)
+	chaos1 := newChaosService(context.Background(), conf, make(chan Event), log)
+	chaos2 := newChaosService(context.Background(), conf, make(chan Event), log)
+
+	dropped := 0
+	for i := 0; i < 1000; i++ {
+		drop1 := chaos1.shouldDrop()
+		drop2 := chaos2.shouldDrop()
+		assert.Equal(t, drop1, drop2)
+		assert.Equal(t, chaos1.delay(), chaos2.delay())
+
+		if drop1 {
+			dropped++
+		}
+	}
+
+	// Roughly 30 percent of the messages should be dropped.
+	assert.InDelta(t, 300, dropped, 60)
+}
@@ -2,6 +2,7 @@ package network
 
 import (
 	"fmt"
+	"time"
 
 	lp2pcore "github.com/libp2p/go-libp2p/core"
 	lp2ppeer "github.com/libp2p/go-libp2p/core/peer"
@@ -10,25 +11,72 @@ import (
 )
 
 type Config struct {
-	NetworkKey           string   `toml:"network_key"`
-	PublicAddrString     string   `toml:"public_addr"`
-	ListenAddrStrings    []string `toml:"listen_addrs"`
-	BootstrapAddrStrings []string `toml:"bootstrap_addrs"`
-	MaxConns             int      `toml:"max_connections"`
-	EnableUDP            bool     `toml:"enable_udp"`
-	EnableNATService     bool     `toml:"enable_nat_service"`
-	EnableUPnP           bool     `toml:"enable_upnp"`
-	EnableRelay          bool     `toml:"enable_relay"`
-	EnableRelayService   bool     `toml:"enable_relay_service"`
-	EnableMdns           bool     `toml:"enable_mdns"`
-	EnableMetrics        bool     `toml:"enable_metrics"`
-	ForcePrivateNetwork  bool     `toml:"force_private_network"`
+	NetworkKey           string          `toml:"network_key"`
+	PublicAddrString     string          `toml:"public_addr"`
+	ListenAddrStrings    []string        `toml:"listen_addrs"`
+	BootstrapAddrStrings []string        `toml:"bootstrap_addrs"`
+	MaxConns             int             `toml:"max_connections"`
+	EnableUDP            bool            `toml:"enable_udp"`
+	EnableNATService     bool            `toml:"enable_nat_service"`
+	EnableUPnP           bool            `toml:"enable_upnp"`
+	EnableRelay          bool            `toml:"enable_relay"`
+	EnableRelayService   bool            `toml:"enable_relay_service"`
+	EnableMdns           bool            `toml:"enable_mdns"`
+	EnableMetrics        bool            `toml:"enable_metrics"`
+	ForcePrivateNetwork  bool            `toml:"force_private_network"`
+	AnchorsFile          string          `toml:"anchors_file"`
+	AddrBookFile         string          `toml:"addrbook_file"`
+	FeelerInterval       time.Duration   `toml:"feeler_interval"`
+	Chaos                ChaosConfig     `toml:"chaos"`
+	Outbound             OutboundConfig  `toml:"outbound"`
+	Diversity            DiversityConfig `toml:"diversity"`
+	Dial                 DialConfig      `toml:"dial"`
 
 	// Private configs
 	NetworkName                 string   `toml:"-"`
 	DefaultPort                 int      `toml:"-"`
 	DefaultBootstrapAddrStrings []string `toml:"-"`
 	IsBootstrapper              bool     `toml:"-"`
+	MaxAnchors                  int      `toml:"-"`
+	// PersistentAddrStrings are the peers that the node always stays connected to,
+	// like the primaries of a read replica.
+	PersistentAddrStrings []string `toml:"-"`
+}
+
+// ChaosConfig simulates adverse network conditions on the received messages.
+// It is intended for testing and benchmarking on the local networks,
+// and should never be enabled on the mainnet or testnet.
+type ChaosConfig struct {
+	Enable     bool          `toml:"enable"`
+	Latency    time.Duration `toml:"latency"`
+	Jitter     time.Duration `toml:"jitter"`
+	PacketLoss float64       `toml:"packet_loss"`
+	Seed       int64         `toml:"seed"`
+}
+
+// OutboundConfig limits the outbound queue of each peer.
+// Each priority class has its own queue with the same size.
+type OutboundConfig struct {
+	QueueSize int    `toml:"queue_size"`
+	Policy    string `toml:"policy"`
+}
+
+// DiversityConfig spreads the outbound peers over different network groups,
+// so the node is not eclipsed when all of its outbound peers are in the same datacenter.
+// A network group is the autonomous system of the peer, if the ASN database is provided,
+// otherwise it is the /16 prefix for IPv4 and the /32 prefix for IPv6 addresses.
+type DiversityConfig struct {
+	GeoIPFile           string `toml:"geoip_file"`
+	MaxOutboundPerGroup int    `toml:"max_outbound_per_group"`
+}
+
+// DialConfig defines the order of dialing the addresses, when a peer advertises multiple addresses.
+// The priority is a list of the address classes: `quic-ip6`, `quic-ip4`, `tcp-ip6` and `tcp-ip4`.
+// The addresses are dialed in the order of the priority, each one after the delay,
+// and the first connection that succeeds is kept.
+type DialConfig struct {
+	Priority []string      `toml:"priority"`
+	Delay    time.Duration `toml:"delay"`
 }
 
 func DefaultConfig() *Config {
@@ -46,8 +94,31 @@ func DefaultConfig() *Config {
 		EnableMdns:           false,
 		EnableMetrics:        false,
 		ForcePrivateNetwork:  false,
-		DefaultPort:          0,
-		IsBootstrapper:       false,
+		AnchorsFile:          "anchors.json",
+		AddrBookFile:         "addrbook.json",
+		FeelerInterval:       2 * time.Minute,
+		Chaos: ChaosConfig{
+			Enable:     false,
+			Latency:    0,
+			Jitter:     0,
+			PacketLoss: 0,
+			Seed:       0,
+		},
+		Outbound: OutboundConfig{
+			QueueSize: 128,
+			Policy:    DropOldest,
+		},
+		Diversity: DiversityConfig{
+			GeoIPFile:           "",
+			MaxOutboundPerGroup: 2,
+		},
+		Dial: DialConfig{
+			Priority: []string{},
+			Delay:    250 * time.Millisecond,
+		},
+		DefaultPort:    0,
+		IsBootstrapper: false,
+		MaxAnchors:     2,
 	}
 }
 
@@ -92,6 +163,59 @@ func (conf *Config) BasicCheck() error {
 		}
 	}
 
+	if conf.Chaos.Latency < 0 || conf.Chaos.Jitter < 0 {
+		return ConfigError{
+			Reason: "chaos latency and jitter can't be negative",
+		}
+	}
+	if conf.Chaos.PacketLoss < 0 || conf.Chaos.PacketLoss > 1 {
+		return ConfigError{
+			Reason: "chaos packet loss should be between 0 and 1",
+		}
+	}
+
+	if conf.Outbound.QueueSize <= 0 {
+		return ConfigError{
+			Reason: "outbound queue size should be positive",
+		}
+	}
+	switch conf.Outbound.Policy {
+	case DropOldest, DropNewest, Disconnect:
+	default:
+		return ConfigError{
+			Reason: fmt.Sprintf("invalid outbound policy: %s", conf.Outbound.Policy),
+		}
+	}
+
+	if conf.FeelerInterval < 0 {
+		return ConfigError{
+			Reason: "feeler interval can't be negative",
+		}
+	}
+
+	if conf.Diversity.MaxOutboundPerGroup < 0 {
+		return ConfigError{
+			Reason: "max outbound peers per group can't be negative",
+		}
+	}
+
+	for _, class := range conf.Dial.Priority {
+		if !isDialClass(class) {
+			return ConfigError{
+				Reason: fmt.Sprintf("invalid dial priority: %s", class),
+			}
+		}
+	}
+	if conf.Dial.Delay < 0 {
+		return ConfigError{
+			Reason: "dial delay can't be negative",
+		}
+	}
+
+	if err := validateAddrInfo(conf.PersistentAddrStrings...); err != nil {
+		return err
+	}
+
 	return validateAddrInfo(conf.BootstrapAddrStrings...)
 }
 
@@ -127,6 +251,12 @@ func (conf *Config) BootstrapAddrInfos() []lp2ppeer.AddrInfo {
 	return addrInfos
 }
 
+func (conf *Config) PersistentAddrInfos() []lp2ppeer.AddrInfo {
+	addrInfos, _ := MakeAddrInfos(conf.PersistentAddrStrings)
+
+	return addrInfos
+}
+
 func (conf *Config) CheckIsBootstrapper(pid lp2pcore.PeerID) {
 	addrInfos := conf.BootstrapAddrInfos()
 	for _, ai := range addrInfos {
@@ -2,6 +2,7 @@ package network
 
 import (
 	"testing"
+	"time"
 
 	lp2ppeer "github.com/libp2p/go-libp2p/core/peer"
 	"github.com/stretchr/testify/assert"
@@ -77,6 +78,78 @@ func TestConfigBasicCheck(t *testing.T) {
 				c.BootstrapAddrStrings = []string{"/ip4/127.0.0.1/"}
 			},
 		},
+		{
+			name: "Negative chaos latency - Expect Error",
+			expectError: ConfigError{
+				Reason: "chaos latency and jitter can't be negative",
+			},
+			updateFn: func(c *Config) {
+				c.Chaos.Latency = -time.Second
+			},
+		},
+		{
+			name: "Invalid chaos packet loss - Expect Error",
+			expectError: ConfigError{
+				Reason: "chaos packet loss should be between 0 and 1",
+			},
+			updateFn: func(c *Config) {
+				c.Chaos.PacketLoss = 1.5
+			},
+		},
+		{
+			name: "Invalid outbound queue size - Expect Error",
+			expectError: ConfigError{
+				Reason: "outbound queue size should be positive",
+			},
+			updateFn: func(c *Config) {
+				c.Outbound.QueueSize = 0
+			},
+		},
+		{
+			name: "Invalid outbound policy - Expect Error",
+			expectError: ConfigError{
+				Reason: "invalid outbound policy: drop_all",
+			},
+			updateFn: func(c *Config) {
+				c.Outbound.Policy = "drop_all"
+			},
+		},
+		{
+			name: "Invalid feeler interval - Expect Error",
+			expectError: ConfigError{
+				Reason: "feeler interval can't be negative",
+			},
+			updateFn: func(c *Config) {
+				c.FeelerInterval = -time.Minute
+			},
+		},
+		{
+			name: "Invalid max outbound peers per group - Expect Error",
+			expectError: ConfigError{
+				Reason: "max outbound peers per group can't be negative",
+			},
+			updateFn: func(c *Config) {
+				c.Diversity.MaxOutboundPerGroup = -1
+			},
+		},
+		{
+			name: "Invalid dial priority - Expect Error",
+			expectError: ConfigError{
+				Reason: "invalid dial priority: udp-ip4",
+			},
+			updateFn: func(c *Config) {
+				c.Dial.Priority = []string{DialQUICIPv4, "udp-ip4"}
+			},
+		},
+		{
+			name: "Invalid dial delay - Expect Error",
+			expectError: ConfigError{
+				Reason: "dial delay can't be negative",
+			},
+			updateFn: func(c *Config) {
+				c.Dial.Delay = -time.Second
+			},
+		},
 		{
 			name:        "Valid Public Address - No Error",
 			expectError: nil,
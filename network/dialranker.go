@@ -0,0 +1,123 @@
+package network
+
+import (
+	"sort"
+	"strconv"
+	"time"
+
+	lp2pnetwork "github.com/libp2p/go-libp2p/core/network"
+	lp2pswarm "github.com/libp2p/go-libp2p/p2p/net/swarm"
+	"github.com/multiformats/go-multiaddr"
+)
+
+// The classes of the addresses in the dial priority.
+const (
+	DialQUICIPv6 = "quic-ip6"
+	DialQUICIPv4 = "quic-ip4"
+	DialTCPIPv6  = "tcp-ip6"
+	DialTCPIPv4  = "tcp-ip4"
+)
+
+func isDialClass(class string) bool {
+	switch class {
+	case DialQUICIPv6, DialQUICIPv4, DialTCPIPv6, DialTCPIPv4:
+		return true
+	default:
+		return false
+	}
+}
+
+// dialClass returns the class of the address, or an empty string if it is not in any class.
+func dialClass(addr multiaddr.Multiaddr) string {
+	transport := ""
+	if hasProtocol(addr, multiaddr.P_QUIC_V1) || hasProtocol(addr, multiaddr.P_QUIC) {
+		transport = "quic"
+	} else if hasProtocol(addr, multiaddr.P_TCP) {
+		transport = "tcp"
+	}
+
+	family := ""
+	if hasProtocol(addr, multiaddr.P_IP6) {
+		family = "ip6"
+	} else if hasProtocol(addr, multiaddr.P_IP4) {
+		family = "ip4"
+	}
+
+	if transport == "" || family == "" {
+		return ""
+	}
+
+	return transport + "-" + family
+}
+
+func hasProtocol(addr multiaddr.Multiaddr, code int) bool {
+	_, err := addr.ValueForProtocol(code)
+
+	return err == nil
+}
+
+// dialPort returns the TCP or UDP port of the address.
+// The lower ports are dialed first, since they are more likely to be the listen ports.
+func dialPort(addr multiaddr.Multiaddr) int {
+	for _, code := range []int{multiaddr.P_TCP, multiaddr.P_UDP} {
+		if val, err := addr.ValueForProtocol(code); err == nil {
+			port, _ := strconv.Atoi(val)
+
+			return port
+		}
+	}
+
+	return 0
+}
+
+// newDialRanker returns the ranker of the addresses, when a peer has multiple addresses.
+// If the priority is not set, the default ranker of libp2p is used.
+//
+// Otherwise, the direct addresses are ordered by the priority of their class, then by their ports,
+// and they are dialed one after another with the delay, like Happy Eyeballs (RFC 8305).
+// So the preferred address is tried first, and an unreachable address doesn't hold the dial
+// until it times out. The addresses that are not in the priority come after the others,
+// and the relay addresses come last.
+func newDialRanker(conf DialConfig) lp2pnetwork.DialRanker {
+	if len(conf.Priority) == 0 {
+		return lp2pswarm.DefaultDialRanker
+	}
+
+	ranks := make(map[string]int, len(conf.Priority))
+	for i, class := range conf.Priority {
+		ranks[class] = i
+	}
+	rank := func(addr multiaddr.Multiaddr) int {
+		if hasProtocol(addr, multiaddr.P_CIRCUIT) {
+			return len(ranks) + 1
+		}
+		if r, ok := ranks[dialClass(addr)]; ok {
+			return r
+		}
+
+		return len(ranks)
+	}
+
+	return func(addrs []multiaddr.Multiaddr) []lp2pnetwork.AddrDelay {
+		sorted := make([]multiaddr.Multiaddr, len(addrs))
+		copy(sorted, addrs)
+		sort.SliceStable(sorted, func(i, j int) bool {
+			ri, rj := rank(sorted[i]), rank(sorted[j])
+			if ri != rj {
+				return ri < rj
+			}
+
+			return dialPort(sorted[i]) < dialPort(sorted[j])
+		})
+
+		res := make([]lp2pnetwork.AddrDelay, 0, len(sorted))
+		for i, addr := range sorted {
+			res = append(res, lp2pnetwork.AddrDelay{
+				Addr:  addr,
+				Delay: time.Duration(i) * conf.Delay,
+			})
+		}
+
+		return res
+	}
+}
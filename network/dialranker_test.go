@@ -0,0 +1,68 @@
+package network
+
+import (
+	"testing"
+	"time"
+
+	"github.com/multiformats/go-multiaddr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDialClass(t *testing.T) {
+	tests := []struct {
+		addr  string
+		class string
+	}{
+		{"/ip4/1.2.3.4/tcp/21888", DialTCPIPv4},
+		{"/ip6/2001:db8::1/tcp/21888", DialTCPIPv6},
+		{"/ip4/1.2.3.4/udp/21888/quic-v1", DialQUICIPv4},
+		{"/ip6/2001:db8::1/udp/21888/quic-v1", DialQUICIPv6},
+		{"/dns/pactus.org/tcp/21888", ""},
+	}
+
+	for _, tt := range tests {
+		addr, err := multiaddr.NewMultiaddr(tt.addr)
+		require.NoError(t, err)
+		assert.Equal(t, tt.class, dialClass(addr), tt.addr)
+	}
+}
+
+func TestDialRanker(t *testing.T) {
+	addrs, err := MakeMultiAddrs([]string{
+		"/ip4/1.2.3.4/tcp/21888",
+		"/ip4/1.2.3.4/p2p/12D3KooWDwzDSj6Mg4Gf8vbVKSC3L2FLF3GcxfWGCNnA6S8CB1Cd/p2p-circuit",
+		"/ip6/2001:db8::1/udp/21888/quic-v1",
+		"/ip4/1.2.3.4/tcp/10000",
+		"/ip4/1.2.3.4/udp/21888/quic-v1",
+		"/ip6/2001:db8::1/tcp/21888",
+	})
+	require.NoError(t, err)
+
+	t.Run("Default ranker of libp2p", func(t *testing.T) {
+		ranker := newDialRanker(DialConfig{Priority: []string{}, Delay: time.Second})
+		assert.Len(t, ranker(addrs), len(addrs))
+	})
+
+	t.Run("Ranked by the priority, then by the port", func(t *testing.T) {
+		ranker := newDialRanker(DialConfig{
+			Priority: []string{DialTCPIPv4, DialQUICIPv4},
+			Delay:    time.Second,
+		})
+		ranked := ranker(addrs)
+
+		expected := []string{
+			"/ip4/1.2.3.4/tcp/10000",
+			"/ip4/1.2.3.4/tcp/21888",
+			"/ip4/1.2.3.4/udp/21888/quic-v1",
+			"/ip6/2001:db8::1/udp/21888/quic-v1",
+			"/ip6/2001:db8::1/tcp/21888",
+			"/ip4/1.2.3.4/p2p/12D3KooWDwzDSj6Mg4Gf8vbVKSC3L2FLF3GcxfWGCNnA6S8CB1Cd/p2p-circuit",
+		}
+		require.Len(t, ranked, len(expected))
+		for i, addrDelay := range ranked {
+			assert.Equal(t, expected[i], addrDelay.Addr.String())
+			assert.Equal(t, time.Duration(i)*time.Second, addrDelay.Delay)
+		}
+	})
+}
@@ -0,0 +1,182 @@
+package network
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	lp2pnet "github.com/libp2p/go-libp2p/core/network"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// EclipseRiskLevel is the level of the eclipse risk score.
+type EclipseRiskLevel int
+
+const (
+	EclipseRiskLow      EclipseRiskLevel = 0
+	EclipseRiskElevated EclipseRiskLevel = 1
+	EclipseRiskHigh     EclipseRiskLevel = 2
+)
+
+func (l EclipseRiskLevel) String() string {
+	switch l {
+	case EclipseRiskLow:
+		return "low"
+	case EclipseRiskElevated:
+		return "elevated"
+	case EclipseRiskHigh:
+		return "high"
+	}
+
+	return "invalid"
+}
+
+var (
+	// EclipseElevatedScore and EclipseHighScore are the scores that the risk level is raised at.
+	EclipseElevatedScore = 40
+	EclipseHighScore     = 70
+
+	// eclipseTargetOutboundGroups is the number of the distinct network groups of the outbound peers
+	// that the node is considered well-connected with.
+	eclipseTargetOutboundGroups = 4
+
+	// eclipseYoungPeerAge is the age that the peers younger than it are considered young.
+	// An attacker that takes over the connections replaces the old peers with the young ones.
+	eclipseYoungPeerAge = 10 * time.Minute
+)
+
+// The weights of the risk factors in the score. They add up to one.
+const (
+	eclipseConcentrationWeight = 0.35
+	eclipseOutboundWeight      = 0.30
+	eclipseInboundWeight       = 0.15
+	eclipseYoungWeight         = 0.20
+)
+
+var (
+	eclipseScoreGauge = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: "pactus",
+		Subsystem: "network",
+		Name:      "eclipse_risk_score",
+		Help:      "The eclipse risk score of the node, from 0 (diverse peers) to 100 (no diversity).",
+	})
+	eclipseLevelGauge = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: "pactus",
+		Subsystem: "network",
+		Name:      "eclipse_risk_level",
+		Help:      "The level of the eclipse risk score: zero is low, one is elevated and two is high.",
+	})
+)
+
+func init() {
+	prometheus.MustRegister(eclipseScoreGauge, eclipseLevelGauge)
+}
+
+// EclipseRisk is a local estimation of the risk of being eclipsed,
+// that is all the peers of the node are controlled by an attacker.
+// It is based on the concentration of the peers in the network groups (ASN or IP prefix),
+// the ratio of the inbound peers, the diversity of the outbound peers and the age of the peers.
+type EclipseRisk struct {
+	Score             int // From 0 (diverse peers) to 100 (no diversity).
+	Level             EclipseRiskLevel
+	Peers             int
+	InboundPeers      int
+	OutboundPeers     int
+	YoungPeers        int
+	NetGroups         int     // The distinct network groups of the peers with a public IP address.
+	OutboundNetGroups int     // The distinct network groups of the outbound peers.
+	LargestGroupShare float64 // The share of the peers in the largest network group.
+	Reasons           []string
+}
+
+// eclipseRiskLevel returns the level of the score.
+func eclipseRiskLevel(score int) EclipseRiskLevel {
+	switch {
+	case score >= EclipseHighScore:
+		return EclipseRiskHigh
+	case score >= EclipseElevatedScore:
+		return EclipseRiskElevated
+	default:
+		return EclipseRiskLow
+	}
+}
+
+// calcEclipseRisk calculates the eclipse risk of the connected peers at the given time.
+// The peers without a public IP address, like the relayed or the local peers, have no network group,
+// so they are not counted in the network groups.
+func calcEclipseRisk(peers []*peerInfo, now time.Time) EclipseRisk {
+	risk := EclipseRisk{
+		Peers: len(peers),
+	}
+
+	if len(peers) == 0 {
+		risk.Score = 100
+		risk.Level = EclipseRiskHigh
+		risk.Reasons = []string{"no connected peer"}
+
+		return risk
+	}
+
+	groups := make(map[string]int)
+	outboundGroups := make(map[string]bool)
+	grouped := 0
+	largestGroup := ""
+	for _, peer := range peers {
+		if peer.Direction == lp2pnet.DirOutbound {
+			risk.OutboundPeers++
+		} else {
+			risk.InboundPeers++
+		}
+		if now.Sub(peer.ConnectedAt) < eclipseYoungPeerAge {
+			risk.YoungPeers++
+		}
+		if peer.NetGroup == "" {
+			continue
+		}
+
+		grouped++
+		groups[peer.NetGroup]++
+		if groups[peer.NetGroup] > groups[largestGroup] {
+			largestGroup = peer.NetGroup
+		}
+		if peer.Direction == lp2pnet.DirOutbound {
+			outboundGroups[peer.NetGroup] = true
+		}
+	}
+	risk.NetGroups = len(groups)
+	risk.OutboundNetGroups = len(outboundGroups)
+
+	concentration := 0.0
+	if grouped > 0 {
+		risk.LargestGroupShare = float64(groups[largestGroup]) / float64(grouped)
+		concentration = risk.LargestGroupShare
+	}
+	outbound := 1 - math.Min(float64(risk.OutboundNetGroups), float64(eclipseTargetOutboundGroups))/
+		float64(eclipseTargetOutboundGroups)
+	inbound := float64(risk.InboundPeers) / float64(risk.Peers)
+	young := float64(risk.YoungPeers) / float64(risk.Peers)
+
+	risk.Score = int(math.Round(100 * (eclipseConcentrationWeight*concentration +
+		eclipseOutboundWeight*outbound +
+		eclipseInboundWeight*inbound +
+		eclipseYoungWeight*young)))
+	risk.Level = eclipseRiskLevel(risk.Score)
+
+	if concentration >= 0.5 && grouped > 1 {
+		risk.Reasons = append(risk.Reasons, fmt.Sprintf("%.0f%% of the peers are in the network group %s",
+			100*concentration, largestGroup))
+	}
+	if risk.OutboundNetGroups < eclipseTargetOutboundGroups {
+		risk.Reasons = append(risk.Reasons, fmt.Sprintf("the outbound peers are in %d network groups, less than %d",
+			risk.OutboundNetGroups, eclipseTargetOutboundGroups))
+	}
+	if inbound >= 0.8 {
+		risk.Reasons = append(risk.Reasons, fmt.Sprintf("%.0f%% of the peers are inbound", 100*inbound))
+	}
+	if young >= 0.5 {
+		risk.Reasons = append(risk.Reasons, fmt.Sprintf("%.0f%% of the peers are connected less than %s ago",
+			100*young, eclipseYoungPeerAge))
+	}
+
+	return risk
+}
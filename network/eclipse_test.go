@@ -0,0 +1,129 @@
+package network
+
+import (
+	"testing"
+	"time"
+
+	lp2pnet "github.com/libp2p/go-libp2p/core/network"
+	"github.com/pactus-project/pactus/util/testsuite"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCalcEclipseRisk(t *testing.T) {
+	now := time.Now()
+	old := now.Add(-time.Hour)
+	young := now.Add(-time.Minute)
+
+	peer := func(group string, direction lp2pnet.Direction, connectedAt time.Time) *peerInfo {
+		return &peerInfo{NetGroup: group, Direction: direction, ConnectedAt: connectedAt}
+	}
+
+	t.Run("No peer", func(t *testing.T) {
+		risk := calcEclipseRisk(nil, now)
+
+		assert.Equal(t, 100, risk.Score)
+		assert.Equal(t, EclipseRiskHigh, risk.Level)
+		assert.Equal(t, []string{"no connected peer"}, risk.Reasons)
+	})
+
+	t.Run("Diverse and old peers", func(t *testing.T) {
+		peers := []*peerInfo{
+			peer("AS1", lp2pnet.DirOutbound, old),
+			peer("AS2", lp2pnet.DirOutbound, old),
+			peer("AS3", lp2pnet.DirOutbound, old),
+			peer("AS4", lp2pnet.DirOutbound, old),
+			peer("AS5", lp2pnet.DirInbound, old),
+			peer("AS6", lp2pnet.DirInbound, old),
+			peer("AS7", lp2pnet.DirInbound, young),
+			peer("AS8", lp2pnet.DirInbound, young),
+		}
+		risk := calcEclipseRisk(peers, now)
+
+		// 0.35*1/8 + 0.30*0 + 0.15*4/8 + 0.20*2/8
+		assert.Equal(t, 17, risk.Score)
+		assert.Equal(t, EclipseRiskLow, risk.Level)
+		assert.Equal(t, 8, risk.Peers)
+		assert.Equal(t, 4, risk.InboundPeers)
+		assert.Equal(t, 4, risk.OutboundPeers)
+		assert.Equal(t, 2, risk.YoungPeers)
+		assert.Equal(t, 8, risk.NetGroups)
+		assert.Equal(t, 4, risk.OutboundNetGroups)
+		assert.Equal(t, 0.125, risk.LargestGroupShare)
+		assert.Empty(t, risk.Reasons)
+	})
+
+	t.Run("Homogeneous and young peers", func(t *testing.T) {
+		peers := []*peerInfo{
+			peer("AS1", lp2pnet.DirOutbound, young),
+			peer("AS1", lp2pnet.DirInbound, young),
+			peer("AS1", lp2pnet.DirInbound, young),
+			peer("AS1", lp2pnet.DirInbound, young),
+			peer("AS1", lp2pnet.DirInbound, young),
+			peer("AS2", lp2pnet.DirInbound, old),
+		}
+		risk := calcEclipseRisk(peers, now)
+
+		// 0.35*5/6 + 0.30*3/4 + 0.15*5/6 + 0.20*5/6
+		assert.Equal(t, 81, risk.Score)
+		assert.Equal(t, EclipseRiskHigh, risk.Level)
+		assert.Equal(t, 2, risk.NetGroups)
+		assert.Equal(t, 1, risk.OutboundNetGroups)
+		assert.Equal(t, []string{
+			"83% of the peers are in the network group AS1",
+			"the outbound peers are in 1 network groups, less than 4",
+			"83% of the peers are inbound",
+			"83% of the peers are connected less than 10m0s ago",
+		}, risk.Reasons)
+	})
+
+	t.Run("Peers without a network group", func(t *testing.T) {
+		peers := []*peerInfo{
+			peer("", lp2pnet.DirOutbound, old),
+			peer("", lp2pnet.DirOutbound, old),
+			peer("AS1", lp2pnet.DirOutbound, old),
+			peer("AS1", lp2pnet.DirOutbound, old),
+			peer("AS2", lp2pnet.DirOutbound, old),
+		}
+		risk := calcEclipseRisk(peers, now)
+
+		// 0.35*2/3 + 0.30*2/4
+		assert.Equal(t, 38, risk.Score)
+		assert.Equal(t, EclipseRiskLow, risk.Level)
+		assert.Equal(t, 2, risk.NetGroups)
+		assert.InDelta(t, 2.0/3, risk.LargestGroupShare, 1e-9)
+	})
+}
+
+func TestEclipseRiskLevel(t *testing.T) {
+	assert.Equal(t, EclipseRiskLow, eclipseRiskLevel(0))
+	assert.Equal(t, EclipseRiskLow, eclipseRiskLevel(EclipseElevatedScore-1))
+	assert.Equal(t, EclipseRiskElevated, eclipseRiskLevel(EclipseElevatedScore))
+	assert.Equal(t, EclipseRiskHigh, eclipseRiskLevel(EclipseHighScore))
+	assert.Equal(t, "elevated", EclipseRiskElevated.String())
+}
+
+func TestUpdateEclipseRisk(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	conf := testConfig()
+	net := makeTestNetwork(t, conf, nil)
+	mgr := net.peerMgr
+
+	mgr.updateEclipseRisk()
+	assert.Equal(t, EclipseRiskHigh, mgr.eclipseLevel)
+
+	groups := []string{"1.1.1.1", "2.2.2.2", "3.3.3.3", "4.4.4.4", "5.5.5.5"}
+	for _, ip := range groups {
+		addr, _ := IPToMultiAddr(ip, 1234)
+		pid := ts.RandPeerID()
+		mgr.AddPeer(pid, addr, lp2pnet.DirOutbound)
+		mgr.peers[pid].ConnectedAt = time.Now().Add(-time.Hour)
+	}
+
+	risk := net.EclipseRisk()
+	assert.Equal(t, 5, risk.NetGroups)
+	assert.Equal(t, EclipseRiskLow, risk.Level)
+
+	mgr.updateEclipseRisk()
+	assert.Equal(t, EclipseRiskLow, mgr.eclipseLevel)
+}
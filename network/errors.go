@@ -1,6 +1,10 @@
 package network
 
-import "fmt"
+import (
+	"fmt"
+
+	lp2pcore "github.com/libp2p/go-libp2p/core"
+)
 
 // ConfigError is returned when the config is not valid with a descriptive Reason message.
 type ConfigError struct {
@@ -30,6 +34,16 @@ func (e InvalidTopicError) Error() string {
 	return fmt.Sprintf("invalid topic: '%s'", e.TopicID.String())
 }
 
+// OutboundQueueFullError is returned when the outbound queue of the peer is full,
+// and the peer is disconnected.
+type OutboundQueueFullError struct {
+	PeerID lp2pcore.PeerID
+}
+
+func (e OutboundQueueFullError) Error() string {
+	return fmt.Sprintf("outbound queue of peer %s is full", e.PeerID)
+}
+
 // LibP2PError is returned when an underlying libp2p operation encounters an error.
 type LibP2PError struct {
 	Err error
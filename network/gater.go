@@ -84,6 +84,12 @@ func (g *ConnectionGater) InterceptAddrDial(pid lp2ppeer.ID, ma multiaddr.Multia
 		return false
 	}
 
+	if g.peerMgr != nil && g.peerMgr.IsGroupSaturated(ma) {
+		g.logger.Debug("InterceptAddrDial rejected: network group is saturated", "pid", pid, "ma", ma.String())
+
+		return false
+	}
+
 	return true
 }
 
@@ -123,13 +123,14 @@ type Network interface {
 	Protect(lp2pcore.PeerID, string)
 	EventChannel() <-chan Event
 	Broadcast([]byte, TopicID) error
-	SendTo([]byte, lp2pcore.PeerID) error
+	SendTo([]byte, lp2pcore.PeerID, Priority) error
 	JoinGeneralTopic(shouldPropagate ShouldPropagate) error
 	JoinConsensusTopic(shouldPropagate ShouldPropagate) error
 	CloseConnection(pid lp2pcore.PeerID)
 	SelfID() lp2pcore.PeerID
 	NumConnectedPeers() int
 	ReachabilityStatus() string
+	EclipseRisk() EclipseRisk
 	HostAddrs() []string
 	Name() string
 	Protocols() []string
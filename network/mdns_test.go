@@ -34,7 +34,7 @@ func TestMDNS(t *testing.T) {
 	assert.Equal(t, ce.PeerID, net1.SelfID())
 
 	msg := []byte("test-mdns")
-	assert.NoError(t, net1.SendTo(msg, net2.SelfID()))
+	assert.NoError(t, net1.SendTo(msg, net2.SelfID(), PriorityNormal))
 
 	se := shouldReceiveEvent(t, net2, EventTypeStream).(*StreamMessage)
 	assert.Equal(t, se.From, net1.SelfID())
@@ -24,6 +24,7 @@ type MockNetwork struct {
 	ID        lp2ppeer.ID
 	OtherNets []*MockNetwork
 	SendError error
+	Risk      EclipseRisk
 }
 
 func MockingNetwork(ts *testsuite.TestSuite, id lp2ppeer.ID) *MockNetwork {
@@ -60,7 +61,7 @@ func (mock *MockNetwork) SelfID() lp2ppeer.ID {
 	return mock.ID
 }
 
-func (mock *MockNetwork) SendTo(data []byte, pid lp2pcore.PeerID) error {
+func (mock *MockNetwork) SendTo(data []byte, pid lp2pcore.PeerID, _ Priority) error {
 	if mock.SendError != nil {
 		return mock.SendError
 	}
@@ -131,6 +132,10 @@ func (mock *MockNetwork) ReachabilityStatus() string {
 	return "Unknown"
 }
 
+func (mock *MockNetwork) EclipseRisk() EclipseRisk {
+	return mock.Risk
+}
+
 func (mock *MockNetwork) HostAddrs() []string {
 	return []string{"localhost"}
 }
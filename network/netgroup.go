@@ -0,0 +1,158 @@
+package network
+
+import (
+	"bufio"
+	"fmt"
+	"net/netip"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/multiformats/go-multiaddr"
+	manet "github.com/multiformats/go-multiaddr/net"
+)
+
+// asnRange is a range of IP addresses that are announced by the same autonomous system.
+type asnRange struct {
+	start   netip.Addr
+	end     netip.Addr
+	asn     uint32
+	country string
+}
+
+// geoDB is an offline IP to ASN database.
+// It is loaded from a file in the ip2asn TSV format, one range per line:
+//
+//	range_start	range_end	AS_number	country_code	AS_description
+type geoDB struct {
+	ranges []asnRange
+}
+
+// loadGeoDB loads the offline IP to ASN database from the file.
+// The ranges that are not routed (AS_number is 0) are ignored.
+func loadGeoDB(path string) (*geoDB, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = file.Close()
+	}()
+
+	db := &geoDB{}
+	scanner := bufio.NewScanner(file)
+	lineNo := 0
+	for scanner.Scan() {
+		lineNo++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		fields := strings.Split(line, "\t")
+		if len(fields) < 3 {
+			return nil, fmt.Errorf("invalid line %d: expected at least 3 fields", lineNo)
+		}
+		start, err := netip.ParseAddr(fields[0])
+		if err != nil {
+			return nil, fmt.Errorf("invalid line %d: %w", lineNo, err)
+		}
+		end, err := netip.ParseAddr(fields[1])
+		if err != nil {
+			return nil, fmt.Errorf("invalid line %d: %w", lineNo, err)
+		}
+		if start.Is4() != end.Is4() || end.Less(start) {
+			return nil, fmt.Errorf("invalid line %d: invalid range", lineNo)
+		}
+		asn, err := strconv.ParseUint(fields[2], 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid line %d: %w", lineNo, err)
+		}
+		if asn == 0 {
+			continue
+		}
+
+		country := ""
+		if len(fields) > 3 {
+			country = fields[3]
+		}
+		db.ranges = append(db.ranges, asnRange{
+			start:   start.Unmap(),
+			end:     end.Unmap(),
+			asn:     uint32(asn),
+			country: country,
+		})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	sort.Slice(db.ranges, func(i, j int) bool {
+		return db.ranges[i].start.Less(db.ranges[j].start)
+	})
+
+	return db, nil
+}
+
+// Lookup returns the range that contains the IP address, or nil if not found.
+func (db *geoDB) Lookup(ip netip.Addr) *asnRange {
+	ip = ip.Unmap()
+	// Find the last range that starts before or at the IP address.
+	i := sort.Search(len(db.ranges), func(i int) bool {
+		return ip.Less(db.ranges[i].start)
+	}) - 1
+	if i < 0 {
+		return nil
+	}
+	r := &db.ranges[i]
+	if r.end.Less(ip) {
+		return nil
+	}
+
+	return r
+}
+
+// netGroup returns the network group of the IP address.
+// Peers in the same group are likely operated by the same provider or located in the same datacenter.
+// If the ASN database is available, the group is the autonomous system number,
+// otherwise it falls back to the /16 prefix for IPv4 and the /32 prefix for IPv6 addresses.
+func netGroup(db *geoDB, ip netip.Addr) string {
+	ip = ip.Unmap()
+	if db != nil {
+		if r := db.Lookup(ip); r != nil {
+			return fmt.Sprintf("AS%d", r.asn)
+		}
+	}
+
+	bits := 32
+	if ip.Is4() {
+		bits = 16
+	}
+	prefix, _ := ip.Prefix(bits)
+
+	return prefix.String()
+}
+
+// multiAddrNetGroup returns the network group of the multi-address.
+// It returns an empty string if the multi-address has no public IP address,
+// like DNS, relay, loopback or private addresses.
+func multiAddrNetGroup(db *geoDB, ma multiaddr.Multiaddr) string {
+	if ma == nil {
+		return ""
+	}
+	netIP, err := manet.ToIP(ma)
+	if err != nil {
+		return ""
+	}
+	ip, ok := netip.AddrFromSlice(netIP)
+	if !ok {
+		return ""
+	}
+	ip = ip.Unmap()
+	if ip.IsLoopback() || ip.IsPrivate() || ip.IsLinkLocalUnicast() || ip.IsUnspecified() {
+		return ""
+	}
+
+	return netGroup(db, ip)
+}
@@ -0,0 +1,179 @@
+package network
+
+import (
+	"net/netip"
+	"os"
+	"testing"
+
+	lp2pnet "github.com/libp2p/go-libp2p/core/network"
+	lp2ppeer "github.com/libp2p/go-libp2p/core/peer"
+	"github.com/multiformats/go-multiaddr"
+	"github.com/pactus-project/pactus/util"
+	"github.com/pactus-project/pactus/util/logger"
+	"github.com/pactus-project/pactus/util/testsuite"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testGeoIPData = `# range_start	range_end	AS_number	country_code	AS_description
+1.0.0.0	1.0.0.255	13335	US	CLOUDFLARENET
+1.0.1.0	1.0.3.255	0	None	Not routed
+8.8.8.0	8.8.8.255	15169	US	GOOGLE
+8.8.4.0	8.8.4.255	15169	US	GOOGLE
+2001:4860::	2001:4860:ffff:ffff:ffff:ffff:ffff:ffff	15169	US	GOOGLE
+`
+
+func writeGeoIPFile(t *testing.T, data string) string {
+	t.Helper()
+
+	path := util.TempFilePath()
+	require.NoError(t, os.WriteFile(path, []byte(data), 0o600))
+
+	return path
+}
+
+func TestLoadGeoDB(t *testing.T) {
+	t.Run("Valid file", func(t *testing.T) {
+		db, err := loadGeoDB(writeGeoIPFile(t, testGeoIPData))
+		require.NoError(t, err)
+		assert.Len(t, db.ranges, 4)
+	})
+
+	t.Run("File not exists", func(t *testing.T) {
+		_, err := loadGeoDB(util.TempFilePath())
+		assert.Error(t, err)
+	})
+
+	t.Run("Invalid lines", func(t *testing.T) {
+		invalids := []string{
+			"1.0.0.0\t1.0.0.255\n",
+			"1.0.0.x\t1.0.0.255\t13335\n",
+			"1.0.0.0\t1.0.0.x\t13335\n",
+			"1.0.0.255\t1.0.0.0\t13335\n",
+			"1.0.0.0\t2001:4860::\t13335\n",
+			"1.0.0.0\t1.0.0.255\tAS13335\n",
+		}
+		for _, data := range invalids {
+			_, err := loadGeoDB(writeGeoIPFile(t, data))
+			assert.Error(t, err, "data: %q", data)
+		}
+	})
+}
+
+func TestGeoDBLookup(t *testing.T) {
+	db, err := loadGeoDB(writeGeoIPFile(t, testGeoIPData))
+	require.NoError(t, err)
+
+	tests := []struct {
+		ip  string
+		asn uint32
+	}{
+		{"1.0.0.0", 13335},
+		{"1.0.0.128", 13335},
+		{"1.0.0.255", 13335},
+		{"1.0.1.1", 0},
+		{"0.255.255.255", 0},
+		{"8.8.4.4", 15169},
+		{"8.8.8.8", 15169},
+		{"8.8.9.0", 0},
+		{"::ffff:8.8.8.8", 15169},
+		{"2001:4860:4860::8888", 15169},
+		{"2001:4861::", 0},
+	}
+
+	for _, tt := range tests {
+		r := db.Lookup(netip.MustParseAddr(tt.ip))
+		if tt.asn == 0 {
+			assert.Nil(t, r, "ip: %s", tt.ip)
+		} else {
+			require.NotNil(t, r, "ip: %s", tt.ip)
+			assert.Equal(t, tt.asn, r.asn, "ip: %s", tt.ip)
+			assert.Equal(t, "US", r.country)
+		}
+	}
+}
+
+func TestNetGroup(t *testing.T) {
+	db, err := loadGeoDB(writeGeoIPFile(t, testGeoIPData))
+	require.NoError(t, err)
+
+	tests := []struct {
+		addr      string
+		withDB    string
+		withoutDB string
+	}{
+		{"/ip4/8.8.8.8/tcp/21888", "AS15169", "8.8.0.0/16"},
+		{"/ip4/8.8.4.4/udp/21888/quic-v1", "AS15169", "8.8.0.0/16"},
+		{"/ip4/1.0.0.1/tcp/21888", "AS13335", "1.0.0.0/16"},
+		{"/ip4/1.0.2.1/tcp/21888", "1.0.0.0/16", "1.0.0.0/16"},
+		{"/ip6/2001:4860:4860::8888/tcp/21888", "AS15169", "2001:4860::/32"},
+		{"/ip6/2a01:4f8::1/tcp/21888", "2a01:4f8::/32", "2a01:4f8::/32"},
+		{"/ip4/127.0.0.1/tcp/21888", "", ""},
+		{"/ip4/192.168.1.1/tcp/21888", "", ""},
+		{"/ip6/::1/tcp/21888", "", ""},
+		{"/dns/bootstrap.pactus.org/tcp/21888", "", ""},
+	}
+
+	for _, tt := range tests {
+		ma := multiaddr.StringCast(tt.addr)
+		assert.Equal(t, tt.withDB, multiAddrNetGroup(db, ma), "addr: %s", tt.addr)
+		assert.Equal(t, tt.withoutDB, multiAddrNetGroup(nil, ma), "addr: %s", tt.addr)
+	}
+	assert.Empty(t, multiAddrNetGroup(db, nil))
+}
+
+func TestOutboundDiversity(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	conf := testConfig()
+	conf.MaxConns = 8
+	conf.Diversity.MaxOutboundPerGroup = 2
+	conf.Diversity.GeoIPFile = writeGeoIPFile(t, testGeoIPData)
+	net := makeTestNetwork(t, conf, nil)
+	assert.Equal(t, 2, conf.ScaledMinConns())
+
+	maGoogle1 := multiaddr.StringCast("/ip4/8.8.8.8/tcp/1234")
+	maGoogle2 := multiaddr.StringCast("/ip4/8.8.4.4/tcp/1234")
+	maCloudflare := multiaddr.StringCast("/ip4/1.0.0.1/tcp/1234")
+	pid := ts.RandPeerID()
+
+	// Below the minimum connections, all groups are allowed.
+	net.peerMgr.AddPeer(ts.RandPeerID(), maGoogle1, lp2pnet.DirOutbound)
+	assert.False(t, net.peerMgr.IsGroupSaturated(maGoogle2))
+	assert.True(t, net.connGater.InterceptAddrDial(pid, maGoogle2))
+
+	net.peerMgr.AddPeer(ts.RandPeerID(), maGoogle2, lp2pnet.DirOutbound)
+	assert.True(t, net.peerMgr.IsGroupSaturated(maGoogle1))
+	assert.False(t, net.connGater.InterceptAddrDial(pid, maGoogle1))
+	assert.True(t, net.connGater.InterceptAddrDial(pid, maCloudflare))
+
+	// Inbound peers are not counted.
+	net.peerMgr.AddPeer(ts.RandPeerID(), maCloudflare, lp2pnet.DirInbound)
+	net.peerMgr.AddPeer(ts.RandPeerID(), maCloudflare, lp2pnet.DirInbound)
+	assert.False(t, net.peerMgr.IsGroupSaturated(maCloudflare))
+
+	t.Run("Bootstrap peers in less populated groups come first", func(t *testing.T) {
+		addrInfos := []lp2ppeer.AddrInfo{
+			{ID: ts.RandPeerID(), Addrs: []multiaddr.Multiaddr{maGoogle1}},
+			{ID: ts.RandPeerID(), Addrs: []multiaddr.Multiaddr{maCloudflare}},
+			{ID: ts.RandPeerID()},
+		}
+		sorted := net.peerMgr.sortByDiversity(addrInfos)
+		assert.Equal(t, addrInfos[1].ID, sorted[0].ID)
+		assert.Equal(t, addrInfos[2].ID, sorted[1].ID)
+		assert.Equal(t, addrInfos[0].ID, sorted[2].ID)
+	})
+
+	t.Run("Disabled diversity", func(t *testing.T) {
+		net.peerMgr.maxOutboundPerGroup = 0
+		assert.False(t, net.peerMgr.IsGroupSaturated(maGoogle1))
+	})
+}
+
+func TestInvalidGeoIPFile(t *testing.T) {
+	conf := testConfig()
+	conf.Diversity.GeoIPFile = util.TempFilePath()
+
+	_, err := newNetwork(conf, logger.NewSubLogger("_network", nil), nil)
+	assert.ErrorContains(t, err, "unable to load the GeoIP file")
+}
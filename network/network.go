@@ -12,6 +12,7 @@ import (
 	lp2pcrypto "github.com/libp2p/go-libp2p/core/crypto"
 	lp2phost "github.com/libp2p/go-libp2p/core/host"
 	lp2pmetrics "github.com/libp2p/go-libp2p/core/metrics"
+	lp2pnetwork "github.com/libp2p/go-libp2p/core/network"
 	lp2ppeer "github.com/libp2p/go-libp2p/core/peer"
 	lp2pautorelay "github.com/libp2p/go-libp2p/p2p/host/autorelay"
 	lp2prcmgr "github.com/libp2p/go-libp2p/p2p/host/resource-manager"
@@ -46,13 +47,17 @@ type network struct {
 	stream         *streamService
 	gossip         *gossipService
 	notifee        *NotifeeService
+	chaos          *chaosService
+	outbound       *outboundService
 	generalTopic   *lp2pps.Topic
 	consensusTopic *lp2pps.Topic
 	eventChannel   chan Event
 	logger         *logger.SubLogger
 }
 
-func loadOrCreateKey(path string) (lp2pcrypto.PrivKey, error) {
+// LoadOrCreateKey loads the network key from the given path.
+// If the file doesn't exist, it generates a new key and saves it to the path.
+func LoadOrCreateKey(path string) (lp2pcrypto.PrivKey, error) {
 	if util.PathExists(path) {
 		h, err := util.ReadFile(path)
 		if err != nil {
@@ -95,7 +100,7 @@ func NewNetwork(conf *Config) (Network, error) {
 func newNetwork(conf *Config, log *logger.SubLogger, opts []lp2p.Option) (*network, error) {
 	self := new(network)
 
-	networkKey, err := loadOrCreateKey(conf.NetworkKey)
+	networkKey, err := LoadOrCreateKey(conf.NetworkKey)
 	if err != nil {
 		return nil, LibP2PError{Err: err}
 	}
@@ -153,6 +158,7 @@ func newNetwork(conf *Config, log *logger.SubLogger, opts []lp2p.Option) (*netwo
 		lp2p.Ping(false),
 		lp2p.Transport(lp2ptcp.NewTCPTransport),
 		lp2p.Security(lp2pnoise.ID, lp2pnoise.New),
+		lp2p.DialRanker(newDialRanker(conf.Dial)),
 	)
 
 	if conf.EnableUDP {
@@ -231,6 +237,17 @@ func newNetwork(conf *Config, log *logger.SubLogger, opts []lp2p.Option) (*netwo
 	})
 	opts = append(opts, addrFactory)
 
+	var geo *geoDB
+	if conf.Diversity.GeoIPFile != "" {
+		geo, err = loadGeoDB(conf.Diversity.GeoIPFile)
+		if err != nil {
+			return nil, ConfigError{
+				Reason: fmt.Sprintf("unable to load the GeoIP file: %s", err.Error()),
+			}
+		}
+		log.Info("GeoIP database loaded", "ranges", len(geo.ranges))
+	}
+
 	connGater, err := NewConnectionGater(conf, log)
 	if err != nil {
 		return nil, LibP2PError{Err: err}
@@ -264,9 +281,18 @@ func newNetwork(conf *Config, log *logger.SubLogger, opts []lp2p.Option) (*netwo
 	}
 
 	self.dht = newDHTService(self.ctx, self.host, kadProtocolID, conf, self.logger)
-	self.peerMgr = newPeerMgr(ctx, host, conf, self.logger)
-	self.stream = newStreamService(ctx, self.host, streamProtocolID, self.eventChannel, self.logger)
-	self.gossip = newGossipService(ctx, self.host, self.eventChannel, conf, self.logger)
+	self.peerMgr = newPeerMgr(ctx, host, conf, geo, self.logger)
+	// In chaos mode, the received messages pass through the chaos service before being delivered.
+	msgChannel := self.eventChannel
+	if conf.Chaos.Enable {
+		self.chaos = newChaosService(ctx, &conf.Chaos, self.eventChannel, self.logger)
+		msgChannel = self.chaos.inCh
+	}
+
+	self.stream = newStreamService(ctx, self.host, streamProtocolID, msgChannel, self.logger)
+	self.outbound = newOutboundService(ctx, &conf.Outbound,
+		self.stream.SendRequest, self.CloseConnection, self.logger)
+	self.gossip = newGossipService(ctx, self.host, msgChannel, conf, self.logger)
 	self.notifee = newNotifeeService(ctx, self.host, self.eventChannel, self.peerMgr, streamProtocolID, self.logger)
 
 	self.logger.Info("network setup", "id", self.host.ID(),
@@ -336,6 +362,9 @@ func (n *network) Start() error {
 			return LibP2PError{Err: err}
 		}
 	}
+	if n.chaos != nil {
+		n.chaos.Start()
+	}
 	n.gossip.Start()
 	n.stream.Start()
 	n.peerMgr.Start()
@@ -356,6 +385,9 @@ func (n *network) Stop() {
 		n.mdns.Stop()
 	}
 
+	if n.chaos != nil {
+		n.chaos.Stop()
+	}
 	n.gossip.Stop()
 	n.stream.Stop()
 	n.peerMgr.Stop()
@@ -375,10 +407,18 @@ func (n *network) Protect(pid lp2pcore.PeerID, tag string) {
 	n.host.ConnManager().Protect(pid, tag)
 }
 
-func (n *network) SendTo(msg []byte, pid lp2pcore.PeerID) error {
-	n.logger.Trace("Sending new message", "to", pid)
+// SendTo queues the message to be sent to the peer.
+// The messages are sent in the order of their priority.
+func (n *network) SendTo(msg []byte, pid lp2pcore.PeerID, priority Priority) error {
+	n.logger.Trace("Sending new message", "to", pid, "priority", priority)
+
+	// The messages are only sent through the existing connections,
+	// so there is no need to queue the messages for the peers that are not connected.
+	if n.host.Network().Connectedness(pid) != lp2pnetwork.Connected {
+		return LibP2PError{Err: lp2pnetwork.ErrNoConn}
+	}
 
-	return n.stream.SendRequest(msg, pid)
+	return n.outbound.Enqueue(msg, pid, priority)
 }
 
 func (n *network) Broadcast(msg []byte, topicID TopicID) error {
@@ -467,6 +507,10 @@ func (n *network) ReachabilityStatus() string {
 	return n.notifee.Reachability().String()
 }
 
+func (n *network) EclipseRisk() EclipseRisk {
+	return n.peerMgr.EclipseRisk()
+}
+
 func (n *network) HostAddrs() []string {
 	addrs := make([]string, 0, len(n.host.Addrs()))
 	for _, addr := range n.host.Addrs() {
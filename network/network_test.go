@@ -50,6 +50,11 @@ func testConfig() *Config {
 		ForcePrivateNetwork:  true,
 		NetworkName:          "test",
 		DefaultPort:          12345,
+		MaxAnchors:           2,
+		Outbound: OutboundConfig{
+			QueueSize: 16,
+			Policy:    DropOldest,
+		},
 	}
 }
 
@@ -286,7 +291,7 @@ func TestNetwork(t *testing.T) {
 		require.NoError(t, networkM.host.Connect(networkM.ctx, *publicAddrInfo))
 
 		msgM := ts.RandBytes(64)
-		require.NoError(t, networkM.SendTo(msgM, networkP.SelfID()))
+		require.NoError(t, networkM.SendTo(msgM, networkP.SelfID(), PriorityNormal))
 		eP := shouldReceiveEvent(t, networkP, EventTypeStream).(*StreamMessage)
 		assert.Equal(t, eP.From, networkM.SelfID())
 		assert.Equal(t, readData(t, eP.Reader, len(msgM)), msgM)
@@ -296,7 +301,7 @@ func TestNetwork(t *testing.T) {
 		require.NoError(t, networkX.host.Connect(networkX.ctx, *publicAddrInfo))
 
 		msgX := ts.RandBytes(64)
-		require.NoError(t, networkX.SendTo(msgX, networkP.SelfID()))
+		require.NoError(t, networkX.SendTo(msgX, networkP.SelfID(), PriorityNormal))
 		eP := shouldReceiveEvent(t, networkP, EventTypeStream).(*StreamMessage)
 		assert.Equal(t, eP.From, networkX.SelfID())
 		assert.Equal(t, readData(t, eP.Reader, len(msgX)), msgX)
@@ -305,7 +310,7 @@ func TestNetwork(t *testing.T) {
 	t.Run("node P (public) is directly accessible by node B (bootstrap)", func(t *testing.T) {
 		msgB := ts.RandBytes(64)
 
-		require.NoError(t, networkB.SendTo(msgB, networkP.SelfID()))
+		require.NoError(t, networkB.SendTo(msgB, networkP.SelfID(), PriorityNormal))
 		eB := shouldReceiveEvent(t, networkP, EventTypeStream).(*StreamMessage)
 		assert.Equal(t, eB.From, networkB.SelfID())
 		assert.Equal(t, readData(t, eB.Reader, len(msgB)), msgB)
@@ -328,13 +333,13 @@ func TestNetwork(t *testing.T) {
 
 	t.Run("node X (private, not connected via relay) is not accessible by node M", func(t *testing.T) {
 		msgM := ts.RandBytes(64)
-		require.Error(t, networkM.SendTo(msgM, networkX.SelfID()))
+		require.Error(t, networkM.SendTo(msgM, networkX.SelfID(), PriorityNormal))
 	})
 
 	// TODO: How to test this?
 	// t.Run("nodes M and N (private, connected via relay) can communicate using the relay node R", func(t *testing.T) {
 	// 	msgM := ts.RandBytes(64)
-	// 	require.NoError(t, networkM.SendTo(msgM, networkN.SelfID()))
+	// 	require.NoError(t, networkM.SendTo(msgM, networkN.SelfID(), PriorityNormal))
 	// 	eM := shouldReceiveEvent(t, networkN, EventTypeStream).(*StreamMessage)
 	// 	assert.Equal(t, readData(t, eM.Reader, len(msgM)), msgM)
 	// })
@@ -346,7 +351,7 @@ func TestNetwork(t *testing.T) {
 		networkB.CloseConnection(networkP.SelfID())
 		e := shouldReceiveEvent(t, networkB, EventTypeDisconnect).(*DisconnectEvent)
 		assert.Equal(t, e.PeerID, networkP.SelfID())
-		require.Error(t, networkB.SendTo(msgB, networkP.SelfID()))
+		require.Error(t, networkB.SendTo(msgB, networkP.SelfID(), PriorityNormal))
 	})
 
 	t.Run("Reachability Status", func(t *testing.T) {
@@ -421,7 +426,7 @@ func testConnection(t *testing.T, networkP, networkB *network) {
 
 	msg := []byte("test-msg")
 
-	require.NoError(t, networkP.SendTo(msg, networkB.SelfID()))
+	require.NoError(t, networkP.SendTo(msg, networkB.SelfID(), PriorityNormal))
 	e := shouldReceiveEvent(t, networkB, EventTypeStream).(*StreamMessage)
 	assert.Equal(t, e.From, networkP.SelfID())
 	assert.Equal(t, readData(t, e.Reader, len(msg)), msg)
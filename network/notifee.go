@@ -89,6 +89,11 @@ func (s *NotifeeService) Reachability() lp2pnetwork.Reachability {
 
 func (s *NotifeeService) Connected(_ lp2pnetwork.Network, conn lp2pnetwork.Conn) {
 	pid := conn.RemotePeer()
+	if s.peerMgr.IsFeeler(pid) {
+		s.logger.Debug("feeler connection established", "pid", pid, "addr", conn.RemoteMultiaddr())
+
+		return
+	}
 	s.logger.Info("connected to peer", "pid", pid, "direction", conn.Stat().Direction, "addr", conn.RemoteMultiaddr())
 
 	s.peerMgr.AddPeer(pid, conn.RemoteMultiaddr(), conn.Stat().Direction)
@@ -97,6 +102,11 @@ func (s *NotifeeService) Connected(_ lp2pnetwork.Network, conn lp2pnetwork.Conn)
 
 func (s *NotifeeService) Disconnected(_ lp2pnetwork.Network, conn lp2pnetwork.Conn) {
 	pid := conn.RemotePeer()
+	if s.peerMgr.IsFeeler(pid) {
+		s.logger.Debug("feeler connection closed", "pid", pid)
+
+		return
+	}
 	s.logger.Info("disconnected from peer", "pid", pid)
 
 	s.peerMgr.RemovePeer(pid)
@@ -115,6 +125,10 @@ func (s *NotifeeService) ListenClose(_ lp2pnetwork.Network, ma multiaddr.Multiad
 }
 
 func (s *NotifeeService) sendProtocolsEvent(pid lp2pcore.PeerID) {
+	if s.peerMgr.IsFeeler(pid) {
+		return
+	}
+
 	protocols, _ := s.host.Peerstore().GetProtocols(pid)
 	protocolsStr := []string{}
 	for _, p := range protocols {
@@ -0,0 +1,239 @@
+package network
+
+import (
+	"context"
+	"sync"
+
+	lp2pcore "github.com/libp2p/go-libp2p/core"
+	"github.com/pactus-project/pactus/util/linkedlist"
+	"github.com/pactus-project/pactus/util/logger"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Priority is the priority class of an outbound message.
+// The messages with the higher priority are sent first.
+type Priority int
+
+const (
+	PriorityLow    = Priority(0)
+	PriorityNormal = Priority(1)
+	PriorityHigh   = Priority(2)
+
+	numPriorities = 3
+)
+
+func (p Priority) String() string {
+	switch p {
+	case PriorityLow:
+		return "low"
+	case PriorityNormal:
+		return "normal"
+	case PriorityHigh:
+		return "high"
+	default:
+		return "unknown"
+	}
+}
+
+// The policies that are applied when the outbound queue of a peer is full.
+const (
+	// DropOldest drops the oldest message in the queue to make room for the new one.
+	DropOldest = "drop_oldest"
+	// DropNewest drops the new message and keeps the queue as it is.
+	DropNewest = "drop_newest"
+	// Disconnect closes the connection to the peer, as it can't keep up with us.
+	Disconnect = "disconnect"
+)
+
+var (
+	outboundQueueDepthGauge = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "pactus",
+		Subsystem: "network",
+		Name:      "outbound_queue_depth",
+		Help:      "The number of messages that are waiting in the outbound queues of all peers.",
+	}, []string{"priority"})
+	outboundDroppedCounter = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "pactus",
+		Subsystem: "network",
+		Name:      "outbound_dropped_total",
+		Help:      "The number of outbound messages that are dropped because the peer is too slow.",
+	}, []string{"priority"})
+	outboundDisconnectsCounter = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: "pactus",
+		Subsystem: "network",
+		Name:      "outbound_disconnects_total",
+		Help:      "The number of peers that are disconnected because their outbound queue is full.",
+	})
+)
+
+func init() {
+	prometheus.MustRegister(outboundQueueDepthGauge, outboundDroppedCounter, outboundDisconnectsCounter)
+}
+
+// peerQueue keeps the outbound messages of a peer, one bounded queue per priority class.
+type peerQueue struct {
+	queues  [numPriorities]*linkedlist.LinkedList[[]byte]
+	sending bool
+}
+
+func newPeerQueue() *peerQueue {
+	q := &peerQueue{}
+	for i := range q.queues {
+		q.queues[i] = linkedlist.New[[]byte]()
+	}
+
+	return q
+}
+
+// pop returns the first message with the highest priority.
+func (q *peerQueue) pop() ([]byte, Priority, bool) {
+	for p := numPriorities - 1; p >= 0; p-- {
+		if head := q.queues[p].Head; head != nil {
+			q.queues[p].DeleteAtHead()
+
+			return head.Data, Priority(p), true
+		}
+	}
+
+	return nil, 0, false
+}
+
+// outboundService sends the direct messages to the peers through the per-peer queues,
+// so a slow peer can't block sending messages to the other peers or grow the memory unbounded.
+// Each peer has a sender goroutine that runs as long as there is a message in its queue.
+type outboundService struct {
+	lk sync.Mutex
+
+	ctx    context.Context
+	config *OutboundConfig
+	peers  map[lp2pcore.PeerID]*peerQueue
+	send   func([]byte, lp2pcore.PeerID) error
+	close  func(lp2pcore.PeerID)
+	logger *logger.SubLogger
+}
+
+func newOutboundService(ctx context.Context, conf *OutboundConfig,
+	send func([]byte, lp2pcore.PeerID) error, closeConn func(lp2pcore.PeerID),
+	log *logger.SubLogger,
+) *outboundService {
+	return &outboundService{
+		ctx:    ctx,
+		config: conf,
+		peers:  make(map[lp2pcore.PeerID]*peerQueue),
+		send:   send,
+		close:  closeConn,
+		logger: log,
+	}
+}
+
+// Enqueue queues the message to be sent to the peer.
+// If the queue of the peer is full, the configured policy is applied.
+func (o *outboundService) Enqueue(msg []byte, pid lp2pcore.PeerID, priority Priority) error {
+	if priority < PriorityLow || priority > PriorityHigh {
+		priority = PriorityNormal
+	}
+
+	o.lk.Lock()
+	defer o.lk.Unlock()
+
+	q, ok := o.peers[pid]
+	if !ok {
+		q = newPeerQueue()
+		o.peers[pid] = q
+	}
+
+	list := q.queues[priority]
+	if list.Length() >= o.config.QueueSize {
+		switch o.config.Policy {
+		case DropNewest:
+			o.logger.Debug("outbound queue is full, dropping the new message",
+				"pid", pid, "priority", priority)
+			outboundDroppedCounter.WithLabelValues(priority.String()).Inc()
+
+			return nil
+
+		case Disconnect:
+			o.logger.Info("outbound queue is full, disconnecting the peer",
+				"pid", pid, "priority", priority)
+			o.clearQueue(pid, q)
+			outboundDisconnectsCounter.Inc()
+			go o.close(pid)
+
+			return OutboundQueueFullError{PeerID: pid}
+
+		default:
+			o.logger.Debug("outbound queue is full, dropping the oldest message",
+				"pid", pid, "priority", priority)
+			list.DeleteAtHead()
+			outboundDroppedCounter.WithLabelValues(priority.String()).Inc()
+			outboundQueueDepthGauge.WithLabelValues(priority.String()).Dec()
+		}
+	}
+
+	list.InsertAtTail(msg)
+	outboundQueueDepthGauge.WithLabelValues(priority.String()).Inc()
+
+	if !q.sending {
+		q.sending = true
+		go o.sendLoop(pid, q)
+	}
+
+	return nil
+}
+
+// QueueDepth returns the number of the queued messages for the peer in the priority class.
+func (o *outboundService) QueueDepth(pid lp2pcore.PeerID, priority Priority) int {
+	o.lk.Lock()
+	defer o.lk.Unlock()
+
+	q, ok := o.peers[pid]
+	if !ok {
+		return 0
+	}
+
+	return q.queues[priority].Length()
+}
+
+// sendLoop sends the queued messages of the peer and exits once the queue is empty.
+// If sending a message fails, the connection is closed and the rest of the queue is dropped.
+func (o *outboundService) sendLoop(pid lp2pcore.PeerID, q *peerQueue) {
+	for {
+		o.lk.Lock()
+		msg, priority, ok := q.pop()
+		if !ok || o.ctx.Err() != nil {
+			o.clearQueue(pid, q)
+			o.lk.Unlock()
+
+			return
+		}
+		outboundQueueDepthGauge.WithLabelValues(priority.String()).Dec()
+		o.lk.Unlock()
+
+		if err := o.send(msg, pid); err != nil {
+			o.logger.Debug("error on sending the message, closing the connection",
+				"pid", pid, "error", err)
+
+			o.lk.Lock()
+			o.clearQueue(pid, q)
+			o.lk.Unlock()
+
+			o.close(pid)
+
+			return
+		}
+	}
+}
+
+// clearQueue drops all the queued messages of the peer and removes its queue.
+// It should be called while the lock is held.
+func (o *outboundService) clearQueue(pid lp2pcore.PeerID, q *peerQueue) {
+	for p, list := range q.queues {
+		outboundQueueDepthGauge.WithLabelValues(Priority(p).String()).Sub(float64(list.Length()))
+		list.Clear()
+	}
+	q.sending = false
+
+	if o.peers[pid] == q {
+		delete(o.peers, pid)
+	}
+}
@@ -0,0 +1,205 @@
+package network
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	lp2pcore "github.com/libp2p/go-libp2p/core"
+	"github.com/pactus-project/pactus/util/logger"
+	"github.com/pactus-project/pactus/util/testsuite"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type outboundTestData struct {
+	*testsuite.TestSuite
+
+	outbound *outboundService
+	lk       sync.Mutex
+	sent     [][]byte
+	closed   []lp2pcore.PeerID
+	block    chan struct{}
+	sendErr  error
+}
+
+func setupOutbound(t *testing.T, policy string) *outboundTestData {
+	t.Helper()
+
+	td := &outboundTestData{
+		TestSuite: testsuite.NewTestSuite(t),
+		block:     make(chan struct{}),
+	}
+	conf := &OutboundConfig{
+		QueueSize: 2,
+		Policy:    policy,
+	}
+	send := func(msg []byte, _ lp2pcore.PeerID) error {
+		<-td.block
+
+		td.lk.Lock()
+		defer td.lk.Unlock()
+
+		if td.sendErr != nil {
+			return td.sendErr
+		}
+		td.sent = append(td.sent, msg)
+
+		return nil
+	}
+	closeConn := func(pid lp2pcore.PeerID) {
+		td.lk.Lock()
+		defer td.lk.Unlock()
+
+		td.closed = append(td.closed, pid)
+	}
+	td.outbound = newOutboundService(context.Background(), conf, send, closeConn,
+		logger.NewSubLogger("_outbound", nil))
+
+	return td
+}
+
+// fill queues the messages while the first message is being sent, and the sender is blocked.
+func (td *outboundTestData) fill(t *testing.T, pid lp2pcore.PeerID, msgs ...[]byte) {
+	t.Helper()
+
+	for _, msg := range msgs {
+		require.NoError(t, td.outbound.Enqueue(msg, pid, PriorityNormal))
+	}
+}
+
+func (td *outboundTestData) waitForSent(t *testing.T, count int) [][]byte {
+	t.Helper()
+
+	assert.Eventually(t, func() bool {
+		td.lk.Lock()
+		defer td.lk.Unlock()
+
+		return len(td.sent) == count
+	}, time.Second, time.Millisecond)
+
+	td.lk.Lock()
+	defer td.lk.Unlock()
+
+	return td.sent
+}
+
+func TestOutboundPriority(t *testing.T) {
+	td := setupOutbound(t, DropOldest)
+	pid := td.RandPeerID()
+
+	// The first message is taken by the sender, and it is blocked on sending it.
+	require.NoError(t, td.outbound.Enqueue([]byte("first"), pid, PriorityLow))
+	assert.Eventually(t, func() bool {
+		return td.outbound.QueueDepth(pid, PriorityLow) == 0
+	}, time.Second, time.Millisecond)
+
+	require.NoError(t, td.outbound.Enqueue([]byte("low"), pid, PriorityLow))
+	require.NoError(t, td.outbound.Enqueue([]byte("normal"), pid, PriorityNormal))
+	require.NoError(t, td.outbound.Enqueue([]byte("high"), pid, PriorityHigh))
+	assert.Equal(t, 1, td.outbound.QueueDepth(pid, PriorityHigh))
+
+	close(td.block)
+	sent := td.waitForSent(t, 4)
+	assert.Equal(t, [][]byte{[]byte("first"), []byte("high"), []byte("normal"), []byte("low")}, sent)
+
+	assert.Eventually(t, func() bool {
+		td.outbound.lk.Lock()
+		defer td.outbound.lk.Unlock()
+
+		return len(td.outbound.peers) == 0
+	}, time.Second, time.Millisecond, "queue should be removed once it is empty")
+}
+
+func TestOutboundDropOldest(t *testing.T) {
+	td := setupOutbound(t, DropOldest)
+	pid := td.RandPeerID()
+
+	td.fill(t, pid, []byte("first"))
+	assert.Eventually(t, func() bool {
+		return td.outbound.QueueDepth(pid, PriorityNormal) == 0
+	}, time.Second, time.Millisecond)
+	td.fill(t, pid, []byte("1"), []byte("2"), []byte("3"))
+	assert.Equal(t, 2, td.outbound.QueueDepth(pid, PriorityNormal))
+
+	close(td.block)
+	sent := td.waitForSent(t, 3)
+	assert.Equal(t, [][]byte{[]byte("first"), []byte("2"), []byte("3")}, sent)
+}
+
+func TestOutboundDropNewest(t *testing.T) {
+	td := setupOutbound(t, DropNewest)
+	pid := td.RandPeerID()
+
+	td.fill(t, pid, []byte("first"))
+	assert.Eventually(t, func() bool {
+		return td.outbound.QueueDepth(pid, PriorityNormal) == 0
+	}, time.Second, time.Millisecond)
+	td.fill(t, pid, []byte("1"), []byte("2"), []byte("3"))
+	assert.Equal(t, 2, td.outbound.QueueDepth(pid, PriorityNormal))
+
+	close(td.block)
+	sent := td.waitForSent(t, 3)
+	assert.Equal(t, [][]byte{[]byte("first"), []byte("1"), []byte("2")}, sent)
+}
+
+func TestOutboundDisconnect(t *testing.T) {
+	td := setupOutbound(t, Disconnect)
+	pid := td.RandPeerID()
+
+	td.fill(t, pid, []byte("first"))
+	assert.Eventually(t, func() bool {
+		return td.outbound.QueueDepth(pid, PriorityNormal) == 0
+	}, time.Second, time.Millisecond)
+	td.fill(t, pid, []byte("1"), []byte("2"))
+
+	err := td.outbound.Enqueue([]byte("3"), pid, PriorityNormal)
+	assert.ErrorIs(t, err, OutboundQueueFullError{PeerID: pid})
+	assert.Zero(t, td.outbound.QueueDepth(pid, PriorityNormal))
+
+	assert.Eventually(t, func() bool {
+		td.lk.Lock()
+		defer td.lk.Unlock()
+
+		return len(td.closed) == 1 && td.closed[0] == pid
+	}, time.Second, time.Millisecond)
+
+	close(td.block)
+	sent := td.waitForSent(t, 1)
+	assert.Equal(t, [][]byte{[]byte("first")}, sent)
+}
+
+func TestOutboundSendError(t *testing.T) {
+	td := setupOutbound(t, DropOldest)
+	pid1 := td.RandPeerID()
+	pid2 := td.RandPeerID()
+	td.sendErr = errors.New("unable to send")
+
+	td.fill(t, pid1, []byte("1"), []byte("2"))
+	close(td.block)
+
+	assert.Eventually(t, func() bool {
+		td.lk.Lock()
+		defer td.lk.Unlock()
+
+		return len(td.closed) == 1 && td.closed[0] == pid1
+	}, time.Second, time.Millisecond)
+	assert.Zero(t, td.outbound.QueueDepth(pid1, PriorityNormal))
+
+	// Other peers are not affected.
+	td.lk.Lock()
+	td.sendErr = nil
+	td.lk.Unlock()
+	td.fill(t, pid2, []byte("3"))
+	sent := td.waitForSent(t, 1)
+	assert.Equal(t, [][]byte{[]byte("3")}, sent)
+}
+
+func TestPriorityString(t *testing.T) {
+	assert.Equal(t, "low", PriorityLow.String())
+	assert.Equal(t, "normal", PriorityNormal.String())
+	assert.Equal(t, "high", PriorityHigh.String())
+	assert.Equal(t, "unknown", Priority(3).String())
+}
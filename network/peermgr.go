@@ -2,6 +2,7 @@ package network
 
 import (
 	"context"
+	"sort"
 	"sync"
 	"time"
 
@@ -15,34 +16,65 @@ import (
 type peerInfo struct {
 	MultiAddress multiaddr.Multiaddr
 	Direction    lp2pnet.Direction
+	NetGroup     string
+	ConnectedAt  time.Time
 }
 
+// feelerTimeout is the timeout of a feeler connection.
+// The feeler peer is ignored for this duration, until its connection is closed.
+const feelerTimeout = 30 * time.Second
+
 // Peer Manager attempts to establish connections with other nodes when the
 // number of connections falls below the minimum threshold.
+// The peer addresses are kept in the address book, which is fed by the bootstrap peers
+// and the addresses that are discovered through the DHT and the peer exchange.
+// To mitigate the eclipse attacks, it reconnects to the anchor peers first,
+// and it periodically opens short-lived feeler connections to test the new addresses.
 type peerMgr struct {
 	lk sync.RWMutex
 
-	ctx            context.Context
-	bootstrapAddrs []lp2ppeer.AddrInfo
-	minConns       int
-	maxConns       int
-	host           lp2phost.Host
-	peers          map[lp2ppeer.ID]*peerInfo
-	logger         *logger.SubLogger
+	ctx                 context.Context
+	bootstrapAddrs      []lp2ppeer.AddrInfo
+	persistentAddrs     []lp2ppeer.AddrInfo
+	minConns            int
+	maxConns            int
+	maxOutboundPerGroup int
+	geoDB               *geoDB
+	anchorsFile         string
+	maxAnchors          int
+	feelerInterval      time.Duration
+	anchors             []lp2ppeer.AddrInfo
+	feelers             map[lp2ppeer.ID]time.Time
+	addrBookFile        string
+	addrBook            *addrBook
+	host                lp2phost.Host
+	peers               map[lp2ppeer.ID]*peerInfo
+	eclipseLevel        EclipseRiskLevel
+	logger              *logger.SubLogger
 }
 
 // newPeerMgr creates a new Peer Manager instance.
+// The ASN database is optional and can be nil.
 func newPeerMgr(ctx context.Context, h lp2phost.Host,
-	conf *Config, log *logger.SubLogger,
+	conf *Config, db *geoDB, log *logger.SubLogger,
 ) *peerMgr {
 	b := &peerMgr{
-		ctx:            ctx,
-		bootstrapAddrs: conf.BootstrapAddrInfos(),
-		minConns:       conf.ScaledMinConns(),
-		maxConns:       conf.ScaledMaxConns(),
-		peers:          make(map[lp2ppeer.ID]*peerInfo),
-		host:           h,
-		logger:         log,
+		ctx:                 ctx,
+		bootstrapAddrs:      conf.BootstrapAddrInfos(),
+		persistentAddrs:     conf.PersistentAddrInfos(),
+		minConns:            conf.ScaledMinConns(),
+		maxConns:            conf.ScaledMaxConns(),
+		maxOutboundPerGroup: conf.Diversity.MaxOutboundPerGroup,
+		geoDB:               db,
+		anchorsFile:         conf.AnchorsFile,
+		maxAnchors:          conf.MaxAnchors,
+		feelerInterval:      conf.FeelerInterval,
+		feelers:             make(map[lp2ppeer.ID]time.Time),
+		addrBookFile:        conf.AddrBookFile,
+		addrBook:            newAddrBook(db),
+		peers:               make(map[lp2ppeer.ID]*peerInfo),
+		host:                h,
+		logger:              log,
 	}
 
 	return b
@@ -50,24 +82,86 @@ func newPeerMgr(ctx context.Context, h lp2phost.Host,
 
 // Start starts the Peer  Manager.
 func (mgr *peerMgr) Start() {
+	if mgr.anchorsFile != "" {
+		anchors, err := loadAnchors(mgr.anchorsFile)
+		if err != nil {
+			mgr.logger.Warn("unable to load the anchor peers", "error", err)
+		}
+
+		mgr.lk.Lock()
+		mgr.anchors = anchors
+		mgr.lk.Unlock()
+	}
+
+	if mgr.addrBookFile != "" {
+		book, err := loadAddrBook(mgr.addrBookFile, mgr.geoDB)
+		if err != nil {
+			mgr.logger.Warn("unable to load the address book", "error", err)
+		} else {
+			mgr.lk.Lock()
+			mgr.addrBook = book
+			mgr.lk.Unlock()
+		}
+	}
+
+	for _, ai := range mgr.bootstrapAddrs {
+		if ai.ID != mgr.host.ID() {
+			mgr.addrBook.AddAddress(ai, "bootstrap")
+		}
+	}
+
+	// The connection manager never trims the connections to the persistent peers.
+	for _, ai := range mgr.persistentAddrs {
+		mgr.host.ConnManager().Protect(ai.ID, "persistent")
+	}
+
 	mgr.CheckConnectivity()
 
 	go func() {
 		ticker := time.NewTicker(1 * time.Minute)
 		defer ticker.Stop()
 
+		var feelerCh <-chan time.Time
+		if mgr.feelerInterval > 0 {
+			feelerTicker := time.NewTicker(mgr.feelerInterval)
+			defer feelerTicker.Stop()
+
+			feelerCh = feelerTicker.C
+		}
+
 		for {
 			select {
 			case <-mgr.ctx.Done():
 				return
 			case <-ticker.C:
 				mgr.CheckConnectivity()
+				mgr.updateEclipseRisk()
+			case <-feelerCh:
+				mgr.OpenFeeler()
 			}
 		}
 	}()
 }
 
+// Stop stops the Peer Manager and persists the anchor peers and the address book.
 func (mgr *peerMgr) Stop() {
+	if mgr.anchorsFile != "" {
+		anchors := mgr.SelectAnchors()
+		if err := saveAnchors(mgr.anchorsFile, anchors); err != nil {
+			mgr.logger.Warn("unable to save the anchor peers", "error", err)
+		} else {
+			mgr.logger.Info("anchor peers saved", "count", len(anchors))
+		}
+	}
+
+	if mgr.addrBookFile != "" {
+		if err := mgr.addrBook.Save(mgr.addrBookFile); err != nil {
+			mgr.logger.Warn("unable to save the address book", "error", err)
+		} else {
+			numNew, numTried := mgr.addrBook.Size()
+			mgr.logger.Info("address book saved", "new", numNew, "tried", numTried)
+		}
+	}
 }
 
 func (mgr *peerMgr) NumOfConnected() int {
@@ -85,6 +179,13 @@ func (mgr *peerMgr) AddPeer(pid lp2ppeer.ID, ma multiaddr.Multiaddr, direction l
 	mgr.peers[pid] = &peerInfo{
 		MultiAddress: ma,
 		Direction:    direction,
+		NetGroup:     multiAddrNetGroup(mgr.geoDB, ma),
+		ConnectedAt:  time.Now(),
+	}
+
+	// Only the outbound addresses are reachable for sure.
+	if direction == lp2pnet.DirOutbound && ma != nil {
+		mgr.addrBook.MarkGood(lp2ppeer.AddrInfo{ID: pid, Addrs: []multiaddr.Multiaddr{ma}})
 	}
 }
 
@@ -95,6 +196,48 @@ func (mgr *peerMgr) RemovePeer(pid lp2ppeer.ID) {
 	delete(mgr.peers, pid)
 }
 
+// EclipseRisk calculates the eclipse risk of the connected peers.
+func (mgr *peerMgr) EclipseRisk() EclipseRisk {
+	mgr.lk.RLock()
+	defer mgr.lk.RUnlock()
+
+	return mgr.eclipseRisk()
+}
+
+// eclipseRisk calculates the eclipse risk of the connected peers.
+// It should be called while the lock is held.
+func (mgr *peerMgr) eclipseRisk() EclipseRisk {
+	peers := make([]*peerInfo, 0, len(mgr.peers))
+	for _, peer := range mgr.peers {
+		peers = append(peers, peer)
+	}
+
+	return calcEclipseRisk(peers, time.Now())
+}
+
+// updateEclipseRisk updates the eclipse risk metrics, and alerts the operator
+// when the risk level changes, so a connectivity that has become homogeneous is noticed.
+func (mgr *peerMgr) updateEclipseRisk() {
+	mgr.lk.Lock()
+	defer mgr.lk.Unlock()
+
+	risk := mgr.eclipseRisk()
+	eclipseScoreGauge.Set(float64(risk.Score))
+	eclipseLevelGauge.Set(float64(risk.Level))
+
+	if risk.Level == mgr.eclipseLevel {
+		return
+	}
+	if risk.Level > mgr.eclipseLevel {
+		mgr.logger.Warn("eclipse risk is raised", "risk", risk.Level, "score", risk.Score,
+			"peers", risk.Peers, "reasons", risk.Reasons)
+	} else {
+		mgr.logger.Info("eclipse risk is lowered", "risk", risk.Level, "score", risk.Score,
+			"peers", risk.Peers)
+	}
+	mgr.eclipseLevel = risk.Level
+}
+
 func (mgr *peerMgr) GetMultiAddr(pid lp2ppeer.ID) multiaddr.Multiaddr {
 	mgr.lk.RLock()
 	defer mgr.lk.RUnlock()
@@ -107,6 +250,191 @@ func (mgr *peerMgr) GetMultiAddr(pid lp2ppeer.ID) multiaddr.Multiaddr {
 	return peer.MultiAddress
 }
 
+// SelectAnchors returns the oldest outbound peers, up to the maximum number of the anchors.
+// The long-lived outbound peers are the most trustworthy ones,
+// as they are selected by this node and they have behaved well for a long time.
+func (mgr *peerMgr) SelectAnchors() []lp2ppeer.AddrInfo {
+	mgr.lk.RLock()
+	defer mgr.lk.RUnlock()
+
+	type candidate struct {
+		pid  lp2ppeer.ID
+		info *peerInfo
+	}
+	candidates := make([]candidate, 0)
+	for pid, info := range mgr.peers {
+		if info.Direction != lp2pnet.DirOutbound || info.MultiAddress == nil {
+			continue
+		}
+		candidates = append(candidates, candidate{pid: pid, info: info})
+	}
+
+	sort.Slice(candidates, func(i, j int) bool {
+		return candidates[i].info.ConnectedAt.Before(candidates[j].info.ConnectedAt)
+	})
+
+	anchors := make([]lp2ppeer.AddrInfo, 0, mgr.maxAnchors)
+	for _, c := range candidates {
+		if len(anchors) >= mgr.maxAnchors {
+			break
+		}
+		anchors = append(anchors, lp2ppeer.AddrInfo{
+			ID:    c.pid,
+			Addrs: []multiaddr.Multiaddr{c.info.MultiAddress},
+		})
+	}
+
+	return anchors
+}
+
+// IsFeeler checks if the peer is connected through a feeler connection.
+// The feeler connections are short-lived and they are not reported to the other modules.
+func (mgr *peerMgr) IsFeeler(pid lp2ppeer.ID) bool {
+	mgr.lk.RLock()
+	defer mgr.lk.RUnlock()
+
+	startedAt, ok := mgr.feelers[pid]
+
+	return ok && time.Since(startedAt) < feelerTimeout
+}
+
+// collectAddresses adds the addresses in the peer store to the address book.
+// The peer store is filled by the DHT and the gossip peer exchange.
+func (mgr *peerMgr) collectAddresses() {
+	peerstore := mgr.host.Peerstore()
+	for _, pid := range peerstore.PeersWithAddrs() {
+		if pid == mgr.host.ID() {
+			continue
+		}
+		mgr.addrBook.AddAddress(peerstore.PeerInfo(pid), "peerstore")
+	}
+}
+
+// isConnectedOrFeeler checks if the peer is connected or it is being tested by a feeler connection.
+// It should be called while the lock is held.
+func (mgr *peerMgr) isConnectedOrFeeler(pid lp2ppeer.ID) bool {
+	if _, ok := mgr.feelers[pid]; ok {
+		return true
+	}
+
+	return pid == mgr.host.ID() || mgr.host.Network().Connectedness(pid) == lp2pnet.Connected
+}
+
+// selectFeeler returns a random address from the new table of the address book
+// that is not connected, or false if there is no such address.
+// It should be called while the lock is held.
+func (mgr *peerMgr) selectFeeler() (lp2ppeer.AddrInfo, bool) {
+	mgr.collectAddresses()
+
+	return mgr.addrBook.SelectNew(mgr.isConnectedOrFeeler)
+}
+
+// OpenFeeler opens a short-lived connection to a new address to test it without keeping the connection.
+// The peers that accept the feeler connection are moved to the tried table of the address book.
+func (mgr *peerMgr) OpenFeeler() {
+	mgr.lk.Lock()
+	for pid, startedAt := range mgr.feelers {
+		if time.Since(startedAt) >= feelerTimeout {
+			delete(mgr.feelers, pid)
+		}
+	}
+
+	ai, ok := mgr.selectFeeler()
+	if !ok {
+		mgr.lk.Unlock()
+
+		return
+	}
+	mgr.feelers[ai.ID] = time.Now()
+	mgr.addrBook.MarkAttempt(ai.ID)
+	mgr.lk.Unlock()
+
+	mgr.logger.Debug("opening a feeler connection", "peer", ai.String())
+
+	go func() {
+		ctx, cancel := context.WithTimeout(mgr.ctx, feelerTimeout/2)
+		defer cancel()
+
+		if err := mgr.host.Connect(ctx, ai); err != nil {
+			mgr.logger.Debug("feeler connection failed", "peer", ai.String(), "error", err)
+
+			return
+		}
+
+		mgr.addrBook.MarkGood(ai)
+
+		_ = mgr.host.Network().ClosePeer(ai.ID)
+		mgr.logger.Debug("feeler connection succeeded", "peer", ai.String())
+	}()
+}
+
+// numOfOutboundInGroup returns the number of the outbound peers in the network group.
+// It should be called while the lock is held.
+func (mgr *peerMgr) numOfOutboundInGroup(group string) int {
+	count := 0
+	for _, peer := range mgr.peers {
+		if peer.Direction == lp2pnet.DirOutbound && peer.NetGroup == group {
+			count++
+		}
+	}
+
+	return count
+}
+
+// IsGroupSaturated checks if the network group of the address has enough outbound peers.
+// Until the node has the minimum number of connections, no group is considered saturated,
+// so the diversity never prevents the node from connecting to the network.
+func (mgr *peerMgr) IsGroupSaturated(ma multiaddr.Multiaddr) bool {
+	if mgr.maxOutboundPerGroup == 0 {
+		return false
+	}
+
+	group := multiAddrNetGroup(mgr.geoDB, ma)
+	if group == "" {
+		return false
+	}
+
+	mgr.lk.RLock()
+	defer mgr.lk.RUnlock()
+
+	if len(mgr.peers) < mgr.minConns {
+		return false
+	}
+
+	return mgr.numOfOutboundInGroup(group) >= mgr.maxOutboundPerGroup
+}
+
+// sortByDiversity sorts the address infos so that the peers in the less populated
+// network groups come first.
+// It should be called while the lock is held.
+func (mgr *peerMgr) sortByDiversity(addrInfos []lp2ppeer.AddrInfo) []lp2ppeer.AddrInfo {
+	counts := make(map[string]int)
+	groupCount := func(ai lp2ppeer.AddrInfo) int {
+		if len(ai.Addrs) == 0 {
+			return 0
+		}
+		group := multiAddrNetGroup(mgr.geoDB, ai.Addrs[0])
+		if group == "" {
+			return 0
+		}
+		count, ok := counts[group]
+		if !ok {
+			count = mgr.numOfOutboundInGroup(group)
+			counts[group] = count
+		}
+
+		return count
+	}
+
+	sorted := make([]lp2ppeer.AddrInfo, len(addrInfos))
+	copy(sorted, addrInfos)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return groupCount(sorted[i]) < groupCount(sorted[j])
+	})
+
+	return sorted
+}
+
 // checkConnectivity performs the actual work of maintaining connections.
 // It ensures that the number of connections stays within the minimum and maximum thresholds.
 func (mgr *peerMgr) CheckConnectivity() {
@@ -126,6 +454,16 @@ func (mgr *peerMgr) CheckConnectivity() {
 		}
 	}
 
+	// The persistent peers are reconnected, regardless of the number of the connections.
+	for _, ai := range mgr.persistentAddrs {
+		if ai.ID == mgr.host.ID() || net.Connectedness(ai.ID) == lp2pnet.Connected {
+			continue
+		}
+
+		mgr.logger.Debug("reconnecting to a persistent peer", "peer", ai.String())
+		ConnectAsync(mgr.ctx, mgr.host, ai, mgr.logger)
+	}
+
 	if len(connectedPeers) > mgr.maxConns {
 		mgr.logger.Debug("peer count is about maximum threshold",
 			"count", len(connectedPeers),
@@ -139,13 +477,23 @@ func (mgr *peerMgr) CheckConnectivity() {
 			"count", len(connectedPeers),
 			"min", mgr.minConns)
 
-		for _, ai := range mgr.bootstrapAddrs {
+		// The anchor peers are reconnected first, then the peers from the address book,
+		// and finally the bootstrap peers.
+		mgr.collectAddresses()
+		needed := mgr.minConns - len(connectedPeers)
+		candidates := make([]lp2ppeer.AddrInfo, 0)
+		candidates = append(candidates, mgr.anchors...)
+		candidates = append(candidates, mgr.sortByDiversity(
+			mgr.addrBook.Select(needed, mgr.isConnectedOrFeeler))...)
+		candidates = append(candidates, mgr.sortByDiversity(mgr.bootstrapAddrs)...)
+
+		for _, ai := range candidates {
 			// preventing self dialing.
 			if ai.ID == mgr.host.ID() {
 				continue
 			}
 
-			mgr.logger.Debug("try connecting to a bootstrap peer", "peer", ai.String())
+			mgr.logger.Debug("try connecting to a peer", "peer", ai.String())
 
 			// Don't try to connect to an already connected peer.
 			if HasPID(connectedPeers, ai.ID) {
@@ -154,7 +502,11 @@ func (mgr *peerMgr) CheckConnectivity() {
 				continue
 			}
 
+			connectedPeers = append(connectedPeers, ai.ID)
+			mgr.addrBook.MarkAttempt(ai.ID)
 			ConnectAsync(mgr.ctx, mgr.host, ai, mgr.logger)
 		}
+		// The anchors are only used once, after the start.
+		mgr.anchors = nil
 	}
 }
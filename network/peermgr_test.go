@@ -2,10 +2,15 @@ package network
 
 import (
 	"testing"
+	"time"
 
 	lp2pnet "github.com/libp2p/go-libp2p/core/network"
+	lp2ppeer "github.com/libp2p/go-libp2p/core/peer"
+	"github.com/multiformats/go-multiaddr"
+	"github.com/pactus-project/pactus/util"
 	"github.com/pactus-project/pactus/util/testsuite"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestGetMultiAddr(t *testing.T) {
@@ -24,3 +29,116 @@ func TestGetMultiAddr(t *testing.T) {
 	net.peerMgr.RemovePeer(pid)
 	assert.Nil(t, net.peerMgr.GetMultiAddr(pid))
 }
+
+func TestSelectAnchors(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	conf := testConfig()
+	net := makeTestNetwork(t, conf, nil)
+	assert.Empty(t, net.peerMgr.SelectAnchors())
+
+	addr1, _ := IPToMultiAddr("1.1.1.1", 1234)
+	addr2, _ := IPToMultiAddr("2.2.2.2", 1234)
+	addr3, _ := IPToMultiAddr("3.3.3.3", 1234)
+	addr4, _ := IPToMultiAddr("4.4.4.4", 1234)
+	pid1 := ts.RandPeerID()
+	pid2 := ts.RandPeerID()
+	pid3 := ts.RandPeerID()
+	pid4 := ts.RandPeerID()
+
+	net.peerMgr.AddPeer(pid1, addr1, lp2pnet.DirInbound)
+	net.peerMgr.AddPeer(pid2, addr2, lp2pnet.DirOutbound)
+	net.peerMgr.AddPeer(pid3, addr3, lp2pnet.DirOutbound)
+	net.peerMgr.AddPeer(pid4, addr4, lp2pnet.DirOutbound)
+	net.peerMgr.peers[pid3].ConnectedAt = time.Now().Add(-time.Hour)
+	net.peerMgr.peers[pid4].ConnectedAt = time.Now().Add(-time.Minute)
+
+	anchors := net.peerMgr.SelectAnchors()
+	require.Len(t, anchors, conf.MaxAnchors)
+	assert.Equal(t, pid3, anchors[0].ID)
+	assert.Equal(t, []multiaddr.Multiaddr{addr3}, anchors[0].Addrs)
+	assert.Equal(t, pid4, anchors[1].ID)
+}
+
+func TestAnchorsPersistence(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	conf := testConfig()
+	conf.AnchorsFile = util.TempFilePath()
+	anchor := lp2ppeer.AddrInfo{
+		ID:    ts.RandPeerID(),
+		Addrs: []multiaddr.Multiaddr{multiaddr.StringCast("/ip4/127.0.0.1/tcp/1")},
+	}
+	require.NoError(t, saveAnchors(conf.AnchorsFile, []lp2ppeer.AddrInfo{anchor}))
+
+	net := makeTestNetwork(t, conf, nil)
+
+	// The anchors are loaded and the file is removed.
+	assert.False(t, util.PathExists(conf.AnchorsFile))
+
+	pid := ts.RandPeerID()
+	addr, _ := IPToMultiAddr("1.2.3.4", 1234)
+	net.peerMgr.AddPeer(pid, addr, lp2pnet.DirOutbound)
+	net.Stop()
+
+	anchors, err := loadAnchors(conf.AnchorsFile)
+	require.NoError(t, err)
+	require.Len(t, anchors, 1)
+	assert.Equal(t, pid, anchors[0].ID)
+}
+
+func TestFeeler(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	conf := testConfig()
+	net := makeTestNetwork(t, conf, nil)
+
+	_, ok := net.peerMgr.selectFeeler()
+	assert.False(t, ok, "no address to test")
+
+	pid := ts.RandPeerID()
+	addr, _ := IPToMultiAddr("127.0.0.1", 1)
+	net.host.Peerstore().AddAddr(pid, addr, time.Hour)
+
+	ai, ok := net.peerMgr.selectFeeler()
+	require.True(t, ok)
+	assert.Equal(t, pid, ai.ID)
+
+	net.peerMgr.OpenFeeler()
+	assert.True(t, net.peerMgr.IsFeeler(pid))
+
+	// The address is being tested, so it is not selected again.
+	_, ok = net.peerMgr.selectFeeler()
+	assert.False(t, ok)
+
+	t.Run("Expired feeler", func(t *testing.T) {
+		net.peerMgr.lk.Lock()
+		net.peerMgr.feelers[pid] = time.Now().Add(-feelerTimeout)
+		net.peerMgr.lk.Unlock()
+
+		assert.False(t, net.peerMgr.IsFeeler(pid))
+	})
+
+	t.Run("Tried address is not selected", func(t *testing.T) {
+		net.peerMgr.lk.Lock()
+		delete(net.peerMgr.feelers, pid)
+		net.peerMgr.addrBook.MarkGood(ai)
+		_, ok := net.peerMgr.selectFeeler()
+		net.peerMgr.lk.Unlock()
+
+		assert.False(t, ok)
+	})
+}
+
+func TestPersistentPeers(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	pid := ts.RandPeerID()
+	conf := testConfig()
+	conf.PersistentAddrStrings = []string{"/ip4/127.0.0.1/tcp/1/p2p/" + pid.String()}
+	net := makeTestNetwork(t, conf, nil)
+
+	require.Len(t, net.peerMgr.persistentAddrs, 1)
+	assert.Equal(t, pid, net.peerMgr.persistentAddrs[0].ID)
+	assert.True(t, net.host.ConnManager().IsProtected(pid, "persistent"))
+}
@@ -0,0 +1,111 @@
+package node
+
+import (
+	"github.com/pactus-project/pactus/crypto/hash"
+	"github.com/pactus-project/pactus/sync/bundle/message"
+	"github.com/pactus-project/pactus/types/tx"
+	"github.com/pactus-project/pactus/types/vote"
+	"github.com/pactus-project/pactus/util/logger"
+	"github.com/pactus-project/pactus/util/pubsub"
+	"github.com/pactus-project/pactus/util/recovery"
+	"github.com/pactus-project/pactus/www/nanomsg/event"
+)
+
+// busBufferSize is the buffer size of the channels that the modules publish to,
+// and of the subscriptions of the node modules.
+const busBufferSize = 500
+
+// BlockEvent is published when a new block is committed.
+type BlockEvent struct {
+	Hash   hash.Hash
+	Height uint32
+}
+
+// Bus connects the modules of the node through the typed topics.
+// The modules publish to their channels, and the bus delivers the values to the topics.
+// So the new consumers, like the indexers or the webhook dispatchers, can subscribe to
+// the topics without changing the publishers.
+type Bus struct {
+	// Messages are the messages that the consensus and the transaction pool broadcast.
+	Messages *pubsub.Topic[message.Message]
+	// Txs are the transactions that the transaction pool broadcasts.
+	Txs *pubsub.Topic[*tx.Tx]
+	// Votes are the votes that the consensus broadcasts.
+	Votes *pubsub.Topic[*vote.Vote]
+	// Blocks are the blocks that are committed by the state.
+	Blocks *pubsub.Topic[BlockEvent]
+	// Events are the state and watcher events that are published through nanomsg.
+	Events *pubsub.Topic[event.Event]
+
+	messageCh chan message.Message
+	eventCh   chan event.Event
+	done      chan struct{}
+	logger    *logger.SubLogger
+}
+
+func newBus() *Bus {
+	return &Bus{
+		Messages:  pubsub.NewTopic[message.Message]("messages"),
+		Txs:       pubsub.NewTopic[*tx.Tx]("txs"),
+		Votes:     pubsub.NewTopic[*vote.Vote]("votes"),
+		Blocks:    pubsub.NewTopic[BlockEvent]("blocks"),
+		Events:    pubsub.NewTopic[event.Event]("events"),
+		messageCh: make(chan message.Message, busBufferSize),
+		eventCh:   make(chan event.Event, busBufferSize),
+		done:      make(chan struct{}),
+		logger:    logger.NewSubLogger("_bus", nil),
+	}
+}
+
+func (b *Bus) start() {
+	go recovery.Loop("bus", b.logger, b.messageLoop)
+	go recovery.Loop("bus", b.logger, b.eventLoop)
+}
+
+func (b *Bus) stop() {
+	close(b.done)
+}
+
+func (b *Bus) messageLoop() {
+	for {
+		select {
+		case <-b.done:
+			return
+
+		case msg := <-b.messageCh:
+			b.Messages.Publish(msg)
+
+			switch m := msg.(type) {
+			case *message.TransactionsMessage:
+				for _, trx := range m.Transactions {
+					b.Txs.Publish(trx)
+				}
+
+			case *message.VoteMessage:
+				b.Votes.Publish(m.Vote)
+			}
+		}
+	}
+}
+
+func (b *Bus) eventLoop() {
+	for {
+		select {
+		case <-b.done:
+			return
+
+		case e := <-b.eventCh:
+			b.Events.Publish(e)
+
+			if e.Topic() == event.TopicBlock {
+				blockHash, height, err := event.DecodeBlockEvent(e)
+				if err != nil {
+					b.logger.Error("unable to decode block event", "error", err)
+
+					continue
+				}
+				b.Blocks.Publish(BlockEvent{Hash: blockHash, Height: height})
+			}
+		}
+	}
+}
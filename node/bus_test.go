@@ -0,0 +1,64 @@
+package node
+
+import (
+	"testing"
+
+	"github.com/pactus-project/pactus/sync/bundle/message"
+	"github.com/pactus-project/pactus/types/tx"
+	"github.com/pactus-project/pactus/util/testsuite"
+	"github.com/pactus-project/pactus/www/nanomsg/event"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBusMessages(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	bus := newBus()
+	bus.start()
+	defer bus.stop()
+
+	msgSub := bus.Messages.Subscribe(10)
+	txSub := bus.Txs.Subscribe(10)
+	voteSub := bus.Votes.Subscribe(10)
+
+	trx1, _ := ts.GenerateTestTransferTx()
+	trx2, _ := ts.GenerateTestTransferTx()
+	txMsg := message.NewTransactionsMessage([]*tx.Tx{trx1, trx2})
+	bus.messageCh <- txMsg
+
+	assert.Equal(t, txMsg, <-msgSub.C())
+	assert.Equal(t, trx1, <-txSub.C())
+	assert.Equal(t, trx2, <-txSub.C())
+
+	v, _ := ts.GenerateTestPrecommitVote(ts.RandHeight(), ts.RandRound())
+	voteMsg := message.NewVoteMessage(v)
+	bus.messageCh <- voteMsg
+
+	assert.Equal(t, voteMsg, <-msgSub.C())
+	assert.Equal(t, v, <-voteSub.C())
+	assert.Empty(t, txSub.C())
+}
+
+func TestBusEvents(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	bus := newBus()
+	bus.start()
+	defer bus.stop()
+
+	eventSub := bus.Events.Subscribe(10)
+	blockSub := bus.Blocks.Subscribe(10)
+
+	txEvent := event.CreateTransactionEvent(ts.RandHash(), ts.RandHeight())
+	bus.eventCh <- txEvent
+
+	blockHash := ts.RandHash()
+	height := ts.RandHeight()
+	blockEvent := event.CreateBlockEvent(blockHash, height)
+	bus.eventCh <- blockEvent
+
+	assert.Equal(t, txEvent, <-eventSub.C())
+	assert.Equal(t, blockEvent, <-eventSub.C())
+	assert.Equal(t, BlockEvent{Hash: blockHash, Height: height}, <-blockSub.C())
+	assert.Empty(t, blockSub.C())
+}
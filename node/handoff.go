@@ -0,0 +1,116 @@
+package node
+
+import (
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/fxamacker/cbor/v2"
+	"github.com/pactus-project/pactus/consensus"
+	"github.com/pactus-project/pactus/types/tx"
+	"github.com/pactus-project/pactus/util"
+	"github.com/pactus-project/pactus/util/logger"
+)
+
+// HandoffFilename is the name of the handoff of the live state, inside the data directory.
+const HandoffFilename = "handoff.cbor"
+
+// handoffMaxAge is the maximum age of a handoff to be restored.
+// The network has moved on from an older handoff, so it is useless.
+const handoffMaxAge = time.Minute
+
+// handoff is the live state of the node that is not kept by the store,
+// and is lost on restart. The peer connections are not handed off, the new binary connects again.
+type handoff struct {
+	SavedAt      int64              `cbor:"1,keyasint"`
+	Consensus    *consensus.Handoff `cbor:"2,keyasint"`
+	Transactions []*tx.Tx           `cbor:"3,keyasint"`
+}
+
+func (n *Node) handoffPath() string {
+	return filepath.Join(n.config.Store.DataPath(), HandoffFilename)
+}
+
+// saveHandoff writes the consensus state of the current height and the pending transactions in the file,
+// so the upgraded binary resumes from where this one stopped.
+// It should be called after the consensus is stopped.
+func (n *Node) saveHandoff() error {
+	_, ids := n.txPool.Snapshot()
+	trxs := make([]*tx.Tx, 0, len(ids))
+	for _, id := range ids {
+		if trx := n.txPool.PendingTx(id); trx != nil {
+			trxs = append(trxs, trx)
+		}
+	}
+
+	hnd := handoff{
+		SavedAt:      util.Now().Unix(),
+		Consensus:    n.consMgr.Handoff(),
+		Transactions: trxs,
+	}
+	data, err := cbor.Marshal(hnd)
+	if err != nil {
+		return err
+	}
+
+	// The file is replaced at once, so a crash never leaves a partial handoff.
+	path := n.handoffPath()
+	tmpPath := path + ".tmp"
+	if err := util.WriteFile(tmpPath, data); err != nil {
+		return err
+	}
+	if err := os.Rename(tmpPath, path); err != nil {
+		return err
+	}
+
+	logger.Info("live state handed off", "consensus", hnd.Consensus != nil, "transactions", len(trxs))
+
+	return nil
+}
+
+// restoreHandoff restores the handoff of the previous binary, and removes the file,
+// so it is restored only once. It does nothing if the file doesn't exist.
+// It should be called before starting the consensus.
+func (n *Node) restoreHandoff() error {
+	path := n.handoffPath()
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+
+		return err
+	}
+	if err := os.Remove(path); err != nil {
+		return err
+	}
+
+	hnd := handoff{}
+	if err := cbor.Unmarshal(data, &hnd); err != nil {
+		return err
+	}
+
+	age := util.Now().Sub(time.Unix(hnd.SavedAt, 0))
+	if age > handoffMaxAge {
+		logger.Info("handoff is too old, ignored", "age", age)
+
+		return nil
+	}
+
+	if hnd.Consensus != nil {
+		n.consMgr.Restore(hnd.Consensus)
+	}
+
+	// The transactions might be committed or invalid by now; they are checked again.
+	restored := 0
+	for _, trx := range hnd.Transactions {
+		if err := n.txPool.AppendTx(trx); err == nil {
+			restored++
+		}
+	}
+
+	logger.Info("live state restored from the handoff", "age", age,
+		"consensus", hnd.Consensus != nil, "transactions", restored)
+
+	return nil
+}
@@ -0,0 +1,145 @@
+package node
+
+import (
+	"sync"
+
+	"github.com/pactus-project/pactus/consensus"
+	"github.com/pactus-project/pactus/state"
+	"github.com/pactus-project/pactus/store"
+	"github.com/pactus-project/pactus/sync/peerset"
+	"github.com/pactus-project/pactus/util/logger"
+	"github.com/pactus-project/pactus/util/pubsub"
+	"github.com/pactus-project/pactus/util/recovery"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// The names of the lifetime counters in the store.
+const (
+	counterBlocksProposed   = "blocks_proposed"
+	counterMissedSortitions = "missed_sortitions"
+	counterServedBytes      = "served_bytes"
+)
+
+var lifetimeGauge = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+	Namespace: "pactus",
+	Subsystem: "node",
+	Name:      "lifetime_total",
+	Help:      "The lifetime totals of the node statistics, that are kept across the restarts.",
+}, []string{"counter"})
+
+func init() {
+	prometheus.MustRegister(lifetimeGauge)
+}
+
+// LifetimeStats are the statistics of the node since its data directory was created.
+// Unlike the metrics, they don't reset to zero when the node restarts.
+type LifetimeStats struct {
+	BlocksProposed   int64 // Number of the proposals that are signed by the validators of the node
+	MissedSortitions int64 // Number of the won sortitions that their transactions are rejected
+	ServedBytes      int64 // Number of the bytes of the blocks and filters that are served to the peers
+}
+
+func (s LifetimeStats) counters() map[string]int64 {
+	return map[string]int64{
+		counterBlocksProposed:   s.BlocksProposed,
+		counterMissedSortitions: s.MissedSortitions,
+		counterServedBytes:      s.ServedBytes,
+	}
+}
+
+// lifetime persists the lifetime statistics in the store, on every new block and when the node stops.
+// The statistics of this run are added to the totals that were saved by the previous runs.
+type lifetime struct {
+	lk       sync.Mutex
+	stopped  bool
+	store    store.Store
+	state    state.Facade
+	consMgr  consensus.ManagerReader
+	peerSet  *peerset.PeerSet
+	base     LifetimeStats
+	blockSub *pubsub.Subscription[BlockEvent]
+	done     chan struct{}
+	logger   *logger.SubLogger
+}
+
+func newLifetime(str store.Store, st state.Facade, consMgr consensus.ManagerReader,
+	peerSet *peerset.PeerSet, bus *Bus,
+) *lifetime {
+	lt := &lifetime{
+		store:   str,
+		state:   st,
+		consMgr: consMgr,
+		peerSet: peerSet,
+		base: LifetimeStats{
+			BlocksProposed:   str.Counter(counterBlocksProposed),
+			MissedSortitions: str.Counter(counterMissedSortitions),
+			ServedBytes:      str.Counter(counterServedBytes),
+		},
+		blockSub: bus.Blocks.Subscribe(busBufferSize),
+		done:     make(chan struct{}),
+		logger:   logger.NewSubLogger("_lifetime", nil),
+	}
+	lt.updateMetrics(lt.base)
+
+	return lt
+}
+
+func (lt *lifetime) start() {
+	go recovery.Loop("lifetime", lt.logger, lt.blockLoop)
+}
+
+// stop saves the statistics for the last time. It should be called before closing the store.
+func (lt *lifetime) stop() {
+	close(lt.done)
+	lt.blockSub.Unsubscribe()
+	lt.save()
+
+	lt.lk.Lock()
+	lt.stopped = true
+	lt.lk.Unlock()
+}
+
+func (lt *lifetime) blockLoop() {
+	for {
+		select {
+		case <-lt.done:
+			return
+
+		case <-lt.blockSub.C():
+			lt.save()
+		}
+	}
+}
+
+// stats returns the lifetime statistics, including this run.
+func (lt *lifetime) stats() LifetimeStats {
+	stats := lt.base
+	for _, inst := range lt.consMgr.Instances() {
+		stats.BlocksProposed += inst.Stats().SignedProposals
+	}
+	stats.MissedSortitions += lt.state.MissedSortitions()
+	stats.ServedBytes += lt.peerSet.TotalServedBytes()
+
+	return stats
+}
+
+func (lt *lifetime) save() {
+	lt.lk.Lock()
+	defer lt.lk.Unlock()
+
+	if lt.stopped {
+		return
+	}
+
+	stats := lt.stats()
+	if err := lt.store.SaveCounters(stats.counters()); err != nil {
+		lt.logger.Warn("unable to save the lifetime statistics", "error", err)
+	}
+	lt.updateMetrics(stats)
+}
+
+func (*lifetime) updateMetrics(stats LifetimeStats) {
+	for name, value := range stats.counters() {
+		lifetimeGauge.WithLabelValues(name).Set(float64(value))
+	}
+}
@@ -0,0 +1,50 @@
+package node
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pactus-project/pactus/consensus"
+	"github.com/pactus-project/pactus/crypto/bls"
+	"github.com/pactus-project/pactus/state"
+	"github.com/pactus-project/pactus/sync/bundle/message"
+	"github.com/pactus-project/pactus/sync/peerset"
+	"github.com/pactus-project/pactus/util/testsuite"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLifetimeStats(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	st := state.MockingState(ts)
+	st.TestStore.Counters[counterBlocksProposed] = 10
+	st.TestStore.Counters[counterServedBytes] = 1000
+
+	consMgr, consMocks := consensus.MockingManager(ts, []*bls.ValidatorKey{ts.RandValKey()})
+	peerSet := peerset.NewPeerSet(time.Minute)
+
+	lt := newLifetime(st.TestStore, st, consMgr, peerSet, newBus())
+	assert.Equal(t, LifetimeStats{BlocksProposed: 10, ServedBytes: 1000}, lt.stats())
+
+	consMocks[0].Statistics.SignedProposals = 2
+	st.TestMissed = 1
+	pid := ts.RandPeerID()
+	peerSet.IncreaseSentCounters(message.TypeBlocksResponse, 500, &pid)
+
+	expected := LifetimeStats{BlocksProposed: 12, MissedSortitions: 1, ServedBytes: 1500}
+	assert.Equal(t, expected, lt.stats())
+
+	t.Run("Statistics are saved when the node stops", func(t *testing.T) {
+		lt.stop()
+
+		assert.Equal(t, int64(12), st.TestStore.Counter(counterBlocksProposed))
+		assert.Equal(t, int64(1), st.TestStore.Counter(counterMissedSortitions))
+		assert.Equal(t, int64(1500), st.TestStore.Counter(counterServedBytes))
+
+		// The next run continues from the saved totals.
+		next := newLifetime(st.TestStore, st, consMgr, peerset.NewPeerSet(time.Minute), newBus())
+		consMocks[0].Statistics.SignedProposals = 0
+		st.TestMissed = 0
+		assert.Equal(t, expected, next.stats())
+	})
+}
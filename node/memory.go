@@ -0,0 +1,84 @@
+package node
+
+import (
+	"math"
+	"runtime/debug"
+
+	"github.com/pactus-project/pactus/config"
+	"github.com/pactus-project/pactus/util/logger"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	memoryLimitGauge = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: "pactus",
+		Subsystem: "memory",
+		Name:      "limit_bytes",
+		Help:      "The soft memory limit of the Go runtime, zero means no limit.",
+	})
+	gcPercentGauge = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: "pactus",
+		Subsystem: "memory",
+		Name:      "gc_percent",
+		Help:      "The garbage collection target percentage, -1 means disabled.",
+	})
+	ballastGauge = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: "pactus",
+		Subsystem: "memory",
+		Name:      "ballast_bytes",
+		Help:      "The size of the memory ballast.",
+	})
+)
+
+func init() {
+	// The heap and the GC statistics are exported by the default Go collector.
+	prometheus.MustRegister(memoryLimitGauge, gcPercentGauge, ballastGauge)
+}
+
+// applyMemoryConfig tunes the Go runtime based on the memory configuration.
+// The options that are not set keep the runtime defaults, which respect
+// the `GOMEMLIMIT` and `GOGC` environment variables.
+// It returns the memory ballast, which should be kept alive while the node is running.
+func applyMemoryConfig(conf *config.MemoryConfig) []byte {
+	if conf.LimitMB > 0 {
+		debug.SetMemoryLimit(conf.LimitMB << 20)
+	}
+	if conf.GCPercent != 0 {
+		debug.SetGCPercent(conf.GCPercent)
+	}
+
+	var ballast []byte
+	if conf.BallastMB > 0 {
+		// The ballast is never written, so the OS doesn't back it by physical memory.
+		ballast = make([]byte, conf.BallastMB<<20)
+	}
+
+	limit := currentMemoryLimit()
+	gcPercent := currentGCPercent()
+	memoryLimitGauge.Set(float64(limit))
+	gcPercentGauge.Set(float64(gcPercent))
+	ballastGauge.Set(float64(len(ballast)))
+
+	logger.Info("memory management",
+		"limit_mb", limit>>20, "gc_percent", gcPercent, "ballast_mb", len(ballast)>>20)
+
+	return ballast
+}
+
+// currentMemoryLimit returns the memory limit of the runtime, or zero if there is no limit.
+func currentMemoryLimit() int64 {
+	// A negative input doesn't change the limit.
+	limit := debug.SetMemoryLimit(-1)
+	if limit == math.MaxInt64 {
+		return 0
+	}
+
+	return limit
+}
+
+func currentGCPercent() int {
+	gcPercent := debug.SetGCPercent(100)
+	debug.SetGCPercent(gcPercent)
+
+	return gcPercent
+}
@@ -1,8 +1,14 @@
 package node
 
 import (
+	"context"
+
+	stdnet "net"
+	"path/filepath"
 	"time"
 
+	manet "github.com/multiformats/go-multiaddr/net"
+	"github.com/pactus-project/pactus/blocklog"
 	"github.com/pactus-project/pactus/config"
 	"github.com/pactus-project/pactus/consensus"
 	"github.com/pactus-project/pactus/crypto"
@@ -12,15 +18,19 @@ import (
 	"github.com/pactus-project/pactus/state"
 	"github.com/pactus-project/pactus/store"
 	"github.com/pactus-project/pactus/sync"
-	"github.com/pactus-project/pactus/sync/bundle/message"
 	"github.com/pactus-project/pactus/txpool"
+	"github.com/pactus-project/pactus/txpool/filter"
 	"github.com/pactus-project/pactus/util"
+	"github.com/pactus-project/pactus/util/audit"
 	"github.com/pactus-project/pactus/util/logger"
+	"github.com/pactus-project/pactus/util/selftest"
 	"github.com/pactus-project/pactus/version"
+	"github.com/pactus-project/pactus/watcher"
 	"github.com/pactus-project/pactus/www/grpc"
 	"github.com/pactus-project/pactus/www/http"
 	"github.com/pactus-project/pactus/www/nanomsg"
 	"github.com/pactus-project/pactus/www/nanomsg/event"
+	"github.com/pactus-project/pactus/www/sink"
 	"github.com/pkg/errors"
 )
 
@@ -36,51 +46,163 @@ type Node struct {
 	http       *http.Server
 	grpc       *grpc.Server
 	nanomsg    *nanomsg.Server
+	sinks      *sink.Dispatcher
+	blockLog   *blocklog.BlockLog
+	watcher    *watcher.Watcher
+	bus        *Bus
+	reporter   *reporter
+	valExport  *validatorExporter
+	lifetime   *lifetime
+	auditLog   *audit.Log
+	ballast    []byte
+	ownStore   bool
+	hooks      []Hooks
+	upgrading  bool
 }
 
+// NewNode creates a new node with the given genesis, configuration and validators.
 func NewNode(genDoc *genesis.Genesis, conf *config.Config,
 	valKeys []*bls.ValidatorKey, rewardAddrs []crypto.Address,
 ) (*Node, error) {
+	return New(conf, WithGenesis(genDoc), WithValidators(valKeys, rewardAddrs))
+}
+
+// New creates a new node with the given configuration, that is customized by the options.
+// It is the entry point for the programs that embed a Pactus node.
+// The human-readable parts of the addresses and the sign domain are global,
+// so the caller should set them for the chain before loading the keys, by
+// crypto.SetHRP and crypto.SetSignDomain.
+// The node doesn't start until Start is called.
+func New(conf *config.Config, opts ...Option) (*Node, error) {
+	o, err := newOptions(opts...)
+	if err != nil {
+		return nil, err
+	}
+	genDoc := o.genDoc
+	valKeys := o.valKeys
+	rewardAddrs := o.rewardAddrs
+	o.applyDisabled(conf)
+
 	// Initialize the logger
-	logger.InitGlobalLogger(conf.Logger)
+	if o.logWriter != nil {
+		logger.InitGlobalLoggerWithWriter(conf.Logger, o.logWriter)
+	} else {
+		logger.InitGlobalLogger(conf.Logger)
+	}
 
 	logger.Info("You are running a Pactus blockchain",
 		"version", version.Version(),
-		"network", genDoc.ChainType())
+		"network", genDoc.ChainType(),
+		"crypto", bls.Backend())
 
-	messageCh := make(chan message.Message, 500)
-	eventCh := make(chan event.Event, 500)
-	if !conf.Nanomsg.Enable {
-		eventCh = nil
+	if genDoc.ChainType().IsMainnet() && conf.Consensus.Byzantine.IsEnabled() {
+		return nil, errors.New("byzantine behavior can't be enabled on the Mainnet")
 	}
 
-	txPool := txpool.NewTxPool(conf.TxPool, messageCh)
+	ballast := applyMemoryConfig(conf.Memory)
+
+	// The self-test should run before opening the store and listening on the ports.
+	selfTestResults := runSelfTest(conf)
+
+	bus := newBus()
+	messageCh := bus.messageCh
+	eventCh := bus.eventCh
 
-	str, err := store.NewStore(conf.Store)
+	filters, err := filter.Load(&conf.TxPool.Filter)
 	if err != nil {
 		return nil, err
 	}
+	txPool := txpool.NewTxPool(conf.TxPool, messageCh, filters...)
 
-	st, err := state.LoadOrNewState(genDoc, valKeys, str, txPool, eventCh)
+	str := o.store
+	if str == nil {
+		str, err = store.NewStore(conf.Store)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	remoteSigners, remoteRewardAddrs, err := newRemoteSigners(conf.Node.RemoteSigners, valKeys)
 	if err != nil {
 		return nil, err
 	}
 
+	st, err := state.LoadOrNewState(conf.State, genDoc, valKeys, str, txPool, eventCh,
+		state.WithRemoteSigners(remoteSigners))
+	if err != nil {
+		// Release the store, so the caller can reset the data directory.
+		if o.store == nil {
+			_ = str.Close()
+		}
+
+		return nil, err
+	}
+
+	if conf.Sync.Replica.Enable {
+		// The read replica always stays connected to its primaries.
+		conf.Network.PersistentAddrStrings = conf.Sync.Replica.Primaries
+	}
+
 	net, err := network.NewNetwork(conf.Network)
 	if err != nil {
 		return nil, err
 	}
 
-	consMgr := consensus.NewManager(conf.Consensus, st, valKeys, rewardAddrs, messageCh)
+	// The local validators sign by their keys, and the others through their remote signers.
+	signers := make([]bls.Signer, 0, len(valKeys)+len(remoteSigners))
+	signerRewardAddrs := make([]crypto.Address, 0, len(valKeys)+len(remoteSigners))
+	for i, key := range valKeys {
+		signers = append(signers, bls.NewKeySigner(key))
+		signerRewardAddrs = append(signerRewardAddrs, rewardAddrs[i])
+	}
+	signers = append(signers, remoteSigners...)
+	signerRewardAddrs = append(signerRewardAddrs, remoteRewardAddrs...)
+	consMgr := consensus.NewManagerWithSigners(conf.Consensus, st, signers, signerRewardAddrs, messageCh)
+	// On the simnet, the blocks are generated on demand through the RPC,
+	// so the consensus is kept in the safe mode and never proposes or votes.
+	if conf.Node.SafeMode || genDoc.ChainType() == genesis.Simnet || o.disabled[SubsystemConsensus] {
+		consMgr.SetSafeMode(true)
+	}
+
+	syn, err := sync.NewSynchronizer(context.Background(), conf.Sync, valKeys, st, consMgr, net,
+		bus.Messages.Subscribe(busBufferSize).C())
+	if err != nil {
+		return nil, err
+	}
 
-	syn, err := sync.NewSynchronizer(conf.Sync, valKeys, st, consMgr, net, messageCh)
+	wat, err := watcher.NewWatcher(conf.Watcher, st, eventCh)
 	if err != nil {
 		return nil, err
 	}
 
 	httpServer := http.NewServer(conf.HTTP)
-	grpcServer := grpc.NewServer(conf.GRPC, st, syn, net, consMgr)
-	nanomsgServer := nanomsg.NewServer(conf.Nanomsg, eventCh)
+	grpcServer := grpc.NewServer(conf.GRPC, st, syn, net, consMgr, selfTestResults, wat)
+	var nanomsgCh <-chan event.Event
+	if conf.Nanomsg.Enable {
+		nanomsgCh = bus.Events.Subscribe(busBufferSize).C()
+	}
+	nanomsgServer := nanomsg.NewServer(conf.Nanomsg, nanomsgCh)
+	var sinkCh <-chan event.Event
+	if conf.Sinks.IsEnabled() {
+		sinkCh = bus.Events.Subscribe(busBufferSize).C()
+	}
+	sinkDispatcher := sink.NewDispatcher(conf.Sinks, sinkCh)
+	blockLog := blocklog.NewBlockLog(conf.BlockLog, st)
+
+	var rep *reporter
+	if conf.Node.ParticipationReportInterval > 0 {
+		rep = newReporter(conf.Node.ParticipationReportInterval,
+			filepath.Join(conf.Store.DataPath(), "reports"), st, valKeys, bus)
+	}
+
+	var valExporter *validatorExporter
+	if conf.Node.ValidatorExportInterval > 0 {
+		valExporter = newValidatorExporter(conf.Node.ValidatorExportInterval,
+			conf.Store.DataPath(), st, bus)
+		httpServer.SetValidatorExport(valExporter.JSON)
+	}
+
+	lifetime := newLifetime(str, st, consMgr, syn.PeerSet(), bus)
 
 	node := &Node{
 		config:     conf,
@@ -94,12 +216,94 @@ func NewNode(genDoc *genesis.Genesis, conf *config.Config,
 		http:       httpServer,
 		grpc:       grpcServer,
 		nanomsg:    nanomsgServer,
+		sinks:      sinkDispatcher,
+		blockLog:   blockLog,
+		watcher:    wat,
+		bus:        bus,
+		reporter:   rep,
+		valExport:  valExporter,
+		lifetime:   lifetime,
+		ballast:    ballast,
+		ownStore:   o.store == nil,
+		hooks:      o.hooks,
 	}
 
 	return node, nil
 }
 
+// runSelfTest checks the environment of the node and logs the issues that it finds.
+func runSelfTest(conf *config.Config) []selftest.Result {
+	results := selftest.Run(&selftest.Config{
+		DataDir:   conf.Store.DataPath(),
+		Addresses: listenAddresses(conf),
+		NTPServer: selftest.DefaultNTPServer,
+		Timeout:   2 * time.Second,
+	})
+
+	for _, res := range results {
+		switch res.Status {
+		case selftest.StatusWarning, selftest.StatusFailed:
+			logger.Warn("self-test: "+res.Message, "check", res.Name, "status", res.Status)
+		case selftest.StatusSkipped:
+			logger.Debug("self-test: "+res.Message, "check", res.Name, "status", res.Status)
+		default:
+			logger.Info("self-test passed", "check", res.Name, "details", res.Message)
+		}
+	}
+
+	return results
+}
+
+// listenAddresses returns the TCP addresses that the node is going to listen on.
+func listenAddresses(conf *config.Config) []string {
+	addrs := []string{}
+	for _, ma := range conf.Network.ListenAddrs() {
+		netAddr, err := manet.ToNetAddr(ma)
+		if err != nil {
+			continue
+		}
+		if tcpAddr, ok := netAddr.(*stdnet.TCPAddr); ok && tcpAddr.Port != 0 {
+			addrs = append(addrs, tcpAddr.String())
+		}
+	}
+
+	if conf.GRPC.Enable && conf.GRPC.Listen != "" {
+		addrs = append(addrs, conf.GRPC.Listen)
+	}
+	if conf.GRPC.Gateway.Enable && conf.GRPC.Gateway.Listen != "" {
+		addrs = append(addrs, conf.GRPC.Gateway.Listen)
+	}
+	if conf.HTTP.Enable && conf.HTTP.Listen != "" {
+		addrs = append(addrs, conf.HTTP.Listen)
+	}
+
+	return addrs
+}
+
 func (n *Node) Start() error {
+	for _, hooks := range n.hooks {
+		if hooks.BeforeStart != nil {
+			if err := hooks.BeforeStart(n); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err := n.start(); err != nil {
+		return err
+	}
+	n.logSubsystems()
+
+	for _, hooks := range n.hooks {
+		if hooks.AfterStart != nil {
+			hooks.AfterStart(n)
+		}
+	}
+
+	return nil
+}
+
+func (n *Node) start() error {
 	now := util.Now()
 	genTime := n.genesisDoc.GenesisTime()
 	if genTime.After(now) {
@@ -108,26 +312,60 @@ func (n *Node) Start() error {
 		time.Sleep(genTime.Sub(now) - 1*time.Second)
 	}
 
+	n.bus.start()
+
 	if err := n.network.Start(); err != nil {
 		return err
 	}
 	// Wait for network to started
 	time.Sleep(1 * time.Second)
 
+	checkpointPath := filepath.Join(n.config.Store.DataPath(), sync.CheckpointFilename)
+	if err := n.sync.LoadCheckpoint(checkpointPath); err != nil {
+		logger.Warn("unable to load the sync checkpoint", "error", err)
+	}
 	if err := n.sync.Start(); err != nil {
 		return err
 	}
 
+	if n.config.Node.AuditLog {
+		auditLog, err := audit.Open(filepath.Join(n.config.Store.DataPath(), audit.Filename))
+		if err != nil {
+			return errors.Wrap(err, "could not open audit log")
+		}
+		n.auditLog = auditLog
+		audit.SetLog(auditLog)
+	}
+
+	if err := n.restoreHandoff(); err != nil {
+		logger.Warn("unable to restore the handoff", "error", err)
+	}
 	if err := n.consMgr.Start(); err != nil {
 		return err
 	}
 
+	if err := n.watcher.Start(); err != nil {
+		return err
+	}
+
+	if err := n.blockLog.Start(); err != nil {
+		return errors.Wrap(err, "could not start block log")
+	}
+
+	if n.reporter != nil {
+		n.reporter.start()
+	}
+	if n.valExport != nil {
+		n.valExport.start()
+	}
+	n.lifetime.start()
+
 	err := n.grpc.StartServer()
 	if err != nil {
 		return errors.Wrap(err, "could not start grpc server")
 	}
 
-	err = n.http.StartServer(n.grpc.Address())
+	err = n.http.StartServer(n.grpc.Address(), n.grpc.ClientCredentials())
 	if err != nil {
 		return errors.Wrap(err, "could not start http server")
 	}
@@ -137,24 +375,122 @@ func (n *Node) Start() error {
 		return errors.Wrap(err, "could not start nanomsg server")
 	}
 
+	err = n.sinks.Start()
+	if err != nil {
+		return errors.Wrap(err, "could not start event sinks")
+	}
+
 	return nil
 }
 
 func (n *Node) Stop() {
+	for _, hooks := range n.hooks {
+		if hooks.BeforeStop != nil {
+			hooks.BeforeStop(n)
+		}
+	}
+
+	n.stop()
+
+	for _, hooks := range n.hooks {
+		if hooks.AfterStop != nil {
+			hooks.AfterStop(n)
+		}
+	}
+}
+
+// StopForUpgrade stops the node like Stop, and hands its live state off to the next binary.
+// The next binary restores the handoff on start, and resumes the consensus from the same round.
+func (n *Node) StopForUpgrade() {
+	n.upgrading = true
+	n.Stop()
+}
+
+func (n *Node) stop() {
 	logger.Info("stopping Node")
 
 	n.consMgr.Stop()
+	if n.upgrading {
+		if err := n.saveHandoff(); err != nil {
+			logger.Warn("unable to save the handoff", "error", err)
+		}
+	}
+	n.watcher.Stop()
+	n.blockLog.Stop()
+	if n.reporter != nil {
+		n.reporter.stop()
+	}
+	if n.valExport != nil {
+		n.valExport.stop()
+	}
 	n.network.Stop()
 	n.sync.Stop()
-	n.state.Close()
-	n.store.Close()
+	checkpointPath := filepath.Join(n.config.Store.DataPath(), sync.CheckpointFilename)
+	if err := n.sync.SaveCheckpoint(checkpointPath); err != nil {
+		logger.Warn("unable to save the sync checkpoint", "error", err)
+	}
+	n.lifetime.stop()
+	// Closing the state closes its store.
+	if n.ownStore {
+		n.state.Close()
+		n.store.Close()
+	}
 	n.http.StopServer()
 	n.grpc.StopServer()
 	n.nanomsg.StopServer()
+	n.sinks.Stop()
+	n.bus.stop()
+	if n.auditLog != nil {
+		audit.SetLog(nil)
+		_ = n.auditLog.Close()
+	}
+	n.ballast = nil
 }
 
 // these methods are using by GUI.
 
+// Subsystems returns the subsystems of the node, and whether they are active.
+func (n *Node) Subsystems() map[Subsystem]bool {
+	conf := n.config
+
+	return map[Subsystem]bool{
+		SubsystemConsensus:       len(n.consMgr.Instances()) > 0 && !n.consMgr.IsSafeMode(),
+		SubsystemGRPC:            conf.GRPC.Enable,
+		SubsystemWallet:          conf.GRPC.Enable && conf.GRPC.EnableWallet,
+		SubsystemGateway:         conf.GRPC.Enable && conf.GRPC.Gateway.Enable,
+		SubsystemHTTP:            conf.HTTP.Enable,
+		SubsystemMetrics:         conf.HTTP.Enable && conf.HTTP.EnableMetrics,
+		SubsystemNanomsg:         conf.Nanomsg.Enable,
+		SubsystemSinks:           conf.Sinks.IsEnabled(),
+		SubsystemBlockLog:        conf.BlockLog.Enable,
+		SubsystemWatcher:         conf.Watcher.Enable,
+		SubsystemReporter:        n.reporter != nil,
+		SubsystemValidatorExport: n.valExport != nil,
+	}
+}
+
+// logSubsystems reports the active and the inactive subsystems on startup,
+// so the operators can check the exposed services of the node.
+func (n *Node) logSubsystems() {
+	subsystems := n.Subsystems()
+	active := []string{}
+	inactive := []string{}
+	for _, sub := range allSubsystems {
+		if subsystems[sub] {
+			active = append(active, string(sub))
+		} else {
+			inactive = append(inactive, string(sub))
+		}
+	}
+
+	logger.Info("node subsystems", "active", active, "inactive", inactive)
+}
+
+// LifetimeStats returns the statistics of the node that are kept across the restarts.
+func (n *Node) LifetimeStats() LifetimeStats {
+	return n.lifetime.stats()
+}
+
 func (n *Node) ConsManager() consensus.ManagerReader {
 	return n.consMgr
 }
@@ -167,6 +503,11 @@ func (n *Node) State() state.Facade {
 	return n.state
 }
 
+// Bus returns the bus of the node that the consumers can subscribe to its topics.
+func (n *Node) Bus() *Bus {
+	return n.bus
+}
+
 func (n *Node) GRPC() *grpc.Server {
 	return n.grpc
 }
@@ -1,9 +1,13 @@
 package node
 
 import (
+	"math"
+	"path/filepath"
+	"runtime/debug"
 	"testing"
 
 	"github.com/pactus-project/pactus/config"
+	"github.com/pactus-project/pactus/consensus"
 	"github.com/pactus-project/pactus/crypto"
 	"github.com/pactus-project/pactus/crypto/bls"
 	"github.com/pactus-project/pactus/crypto/hash"
@@ -36,6 +40,8 @@ func TestRunningNode(t *testing.T) {
 	conf.Store.Path = util.TempDirPath()
 	conf.Network.EnableRelay = false
 	conf.Network.NetworkKey = util.TempFilePath()
+	conf.Network.AnchorsFile = util.TempFilePath()
+	conf.Network.AddrBookFile = util.TempFilePath()
 
 	valKeys := []*bls.ValidatorKey{ts.RandValKey(), ts.RandValKey()}
 	rewardAddrs := []crypto.Address{ts.RandAccAddress(), ts.RandAccAddress()}
@@ -48,3 +54,102 @@ func TestRunningNode(t *testing.T) {
 	require.NoError(t, err)
 	n.Stop()
 }
+
+func TestByzantineOnMainnet(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	logger.LogFilename = util.TempFilePath()
+	conf := config.DefaultConfigMainnet()
+	conf.Consensus.Byzantine.WithholdProposal = true
+
+	valKeys := []*bls.ValidatorKey{ts.RandValKey()}
+	rewardAddrs := []crypto.Address{ts.RandAccAddress()}
+	_, err := NewNode(genesis.MainnetGenesis(), conf, valKeys, rewardAddrs)
+	assert.Error(t, err)
+}
+
+func TestListenAddresses(t *testing.T) {
+	conf := config.DefaultConfigMainnet()
+	conf.Network.ListenAddrStrings = []string{
+		"/ip4/0.0.0.0/tcp/21888",
+		"/ip4/0.0.0.0/udp/21888/quic-v1",
+		"/ip6/::/tcp/21888",
+	}
+	conf.GRPC.Enable = true
+	conf.GRPC.Listen = "127.0.0.1:50051"
+	conf.GRPC.Gateway.Enable = false
+	conf.GRPC.Gateway.Listen = "127.0.0.1:8080"
+	conf.HTTP.Enable = true
+	conf.HTTP.Listen = "127.0.0.1:80"
+
+	assert.Equal(t, []string{
+		"0.0.0.0:21888",
+		"[::]:21888",
+		"127.0.0.1:50051",
+		"127.0.0.1:80",
+	}, listenAddresses(conf))
+}
+
+func TestApplyMemoryConfig(t *testing.T) {
+	defer debug.SetMemoryLimit(debug.SetMemoryLimit(-1))
+	defer debug.SetGCPercent(debug.SetGCPercent(-1))
+
+	t.Run("default config keeps the runtime settings", func(t *testing.T) {
+		debug.SetMemoryLimit(math.MaxInt64)
+		debug.SetGCPercent(100)
+
+		ballast := applyMemoryConfig(config.DefaultMemoryConfig())
+		assert.Nil(t, ballast)
+		assert.Zero(t, currentMemoryLimit())
+		assert.Equal(t, 100, currentGCPercent())
+	})
+
+	t.Run("tuning the runtime", func(t *testing.T) {
+		conf := &config.MemoryConfig{
+			LimitMB:   1024,
+			GCPercent: 50,
+			BallastMB: 16,
+		}
+
+		ballast := applyMemoryConfig(conf)
+		assert.Len(t, ballast, 16<<20)
+		assert.Equal(t, int64(1024<<20), currentMemoryLimit())
+		assert.Equal(t, 50, currentGCPercent())
+	})
+}
+
+func TestNewRemoteSigners(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	valKeys := []*bls.ValidatorKey{ts.RandValKey(), ts.RandValKey()}
+	tokenFile := filepath.Join(t.TempDir(), "signer_token")
+	require.NoError(t, util.WriteFile(tokenFile, []byte("secret-token")))
+
+	t.Run("Remote signer for a local validator", func(t *testing.T) {
+		confs := []consensus.RemoteSignerConfig{
+			{PublicKey: valKeys[1].PublicKey().String(), URL: "http://127.0.0.1:9090/sign", TokenFile: tokenFile},
+		}
+		_, _, err := newRemoteSigners(confs, valKeys)
+		assert.Error(t, err)
+	})
+
+	t.Run("Remote signers without the validator keys", func(t *testing.T) {
+		pubKey1, _ := ts.RandBLSKeyPair()
+		pubKey2, _ := ts.RandBLSKeyPair()
+		rewardAddr := ts.RandAccAddress()
+		confs := []consensus.RemoteSignerConfig{
+			{PublicKey: pubKey1.String(), URL: "http://127.0.0.1:9090/sign", TokenFile: tokenFile},
+			{
+				PublicKey: pubKey2.String(), RewardAddress: rewardAddr.String(),
+				URL: "http://127.0.0.1:9091/sign", TokenFile: tokenFile,
+			},
+		}
+		signers, rewardAddrs, err := newRemoteSigners(confs, valKeys)
+		require.NoError(t, err)
+
+		require.Len(t, signers, 2)
+		assert.True(t, pubKey1.EqualsTo(signers[0].PublicKey()))
+		assert.True(t, pubKey2.EqualsTo(signers[1].PublicKey()))
+		assert.Equal(t, []crypto.Address{pubKey1.AccountAddress(), rewardAddr}, rewardAddrs)
+	})
+}
@@ -0,0 +1,181 @@
+package node
+
+import (
+	"fmt"
+	"io"
+	"slices"
+
+	"github.com/pactus-project/pactus/config"
+	"github.com/pactus-project/pactus/crypto"
+	"github.com/pactus-project/pactus/crypto/bls"
+	"github.com/pactus-project/pactus/genesis"
+	"github.com/pactus-project/pactus/store"
+)
+
+// Subsystem is a part of the node that can be disabled when the node is embedded.
+type Subsystem string
+
+const (
+	// SubsystemConsensus keeps the consensus in the safe mode, so it never proposes or votes.
+	SubsystemConsensus Subsystem = "consensus"
+	// SubsystemGRPC disables the gRPC server, and the subsystems that depend on it.
+	SubsystemGRPC     Subsystem = "grpc"
+	SubsystemWallet   Subsystem = "wallet"
+	SubsystemGateway  Subsystem = "gateway"
+	SubsystemHTTP     Subsystem = "http"
+	SubsystemMetrics  Subsystem = "metrics"
+	SubsystemNanomsg  Subsystem = "nanomsg"
+	SubsystemSinks    Subsystem = "sinks"
+	SubsystemBlockLog Subsystem = "block_log"
+	SubsystemWatcher  Subsystem = "watcher"
+	SubsystemReporter Subsystem = "reporter"
+	// SubsystemValidatorExport disables the periodic export of the validator set.
+	SubsystemValidatorExport Subsystem = "validator_export"
+)
+
+// allSubsystems lists the subsystems, in the order that they are reported.
+var allSubsystems = []Subsystem{
+	SubsystemConsensus, SubsystemGRPC, SubsystemWallet, SubsystemGateway, SubsystemHTTP,
+	SubsystemMetrics, SubsystemNanomsg, SubsystemSinks, SubsystemBlockLog, SubsystemWatcher,
+	SubsystemReporter, SubsystemValidatorExport,
+}
+
+// Hooks are the functions that are called during the lifecycle of the node.
+// Any of them can be nil.
+type Hooks struct {
+	// BeforeStart is called before starting the subsystems. An error aborts starting the node.
+	BeforeStart func(n *Node) error
+	// AfterStart is called after all the subsystems are started.
+	AfterStart func(n *Node)
+	// BeforeStop is called before stopping the subsystems.
+	BeforeStop func(n *Node)
+	// AfterStop is called after all the subsystems are stopped.
+	AfterStop func(n *Node)
+}
+
+type options struct {
+	genDoc      *genesis.Genesis
+	valKeys     []*bls.ValidatorKey
+	rewardAddrs []crypto.Address
+	logWriter   io.Writer
+	store       store.Store
+	disabled    map[Subsystem]bool
+	hooks       []Hooks
+}
+
+// Option customizes the node that is created by New.
+type Option func(opts *options)
+
+// WithGenesis sets the genesis of the chain. The default is the Mainnet genesis.
+func WithGenesis(genDoc *genesis.Genesis) Option {
+	return func(opts *options) {
+		opts.genDoc = genDoc
+	}
+}
+
+// WithValidators sets the validator keys of the node, and the reward addresses of them.
+// Each validator has its own reward address, in the same order.
+func WithValidators(valKeys []*bls.ValidatorKey, rewardAddrs []crypto.Address) Option {
+	return func(opts *options) {
+		opts.valKeys = valKeys
+		opts.rewardAddrs = rewardAddrs
+	}
+}
+
+// WithLogWriter writes the logs of the node to the writer, instead of the console and the log file.
+// The logger is global, so only the first node in the process can set it.
+func WithLogWriter(w io.Writer) Option {
+	return func(opts *options) {
+		opts.logWriter = w
+	}
+}
+
+// WithStore sets the store of the node, instead of opening the store that is configured.
+// The store is owned by the caller, so the node doesn't close it on stop.
+func WithStore(str store.Store) Option {
+	return func(opts *options) {
+		opts.store = str
+	}
+}
+
+// WithDisabled disables the subsystems of the node, regardless of the configuration.
+func WithDisabled(subsystems ...Subsystem) Option {
+	return func(opts *options) {
+		for _, sub := range subsystems {
+			opts.disabled[sub] = true
+		}
+	}
+}
+
+// WithHooks adds the lifecycle hooks of the node.
+// The hooks are called in the order that they are added.
+func WithHooks(hooks Hooks) Option {
+	return func(opts *options) {
+		opts.hooks = append(opts.hooks, hooks)
+	}
+}
+
+func newOptions(opts ...Option) (*options, error) {
+	o := &options{
+		disabled: make(map[Subsystem]bool),
+	}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	if o.genDoc == nil {
+		o.genDoc = genesis.MainnetGenesis()
+	}
+	if len(o.valKeys) != len(o.rewardAddrs) {
+		return nil, fmt.Errorf("number of reward addresses (%d) is not same as the validator keys (%d)",
+			len(o.rewardAddrs), len(o.valKeys))
+	}
+	for sub := range o.disabled {
+		if !slices.Contains(allSubsystems, sub) {
+			return nil, fmt.Errorf("unknown subsystem: %s", sub)
+		}
+	}
+
+	return o, nil
+}
+
+// applyDisabled turns off the disabled subsystems in the configuration.
+// The consensus is disabled after it is created, by keeping it in the safe mode.
+func (o *options) applyDisabled(conf *config.Config) {
+	if o.disabled[SubsystemGRPC] {
+		conf.GRPC.Enable = false
+		conf.GRPC.Gateway.Enable = false
+		conf.HTTP.Enable = false
+	}
+	if o.disabled[SubsystemWallet] {
+		conf.GRPC.EnableWallet = false
+	}
+	if o.disabled[SubsystemGateway] {
+		conf.GRPC.Gateway.Enable = false
+	}
+	if o.disabled[SubsystemHTTP] {
+		conf.HTTP.Enable = false
+	}
+	if o.disabled[SubsystemMetrics] {
+		conf.HTTP.EnableMetrics = false
+	}
+	if o.disabled[SubsystemNanomsg] {
+		conf.Nanomsg.Enable = false
+	}
+	if o.disabled[SubsystemSinks] {
+		conf.Sinks.NATS.Enable = false
+		conf.Sinks.Kafka.Enable = false
+	}
+	if o.disabled[SubsystemBlockLog] {
+		conf.BlockLog.Enable = false
+	}
+	if o.disabled[SubsystemWatcher] {
+		conf.Watcher.Enable = false
+	}
+	if o.disabled[SubsystemReporter] {
+		conf.Node.ParticipationReportInterval = 0
+	}
+	if o.disabled[SubsystemValidatorExport] {
+		conf.Node.ValidatorExportInterval = 0
+	}
+}
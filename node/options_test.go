@@ -0,0 +1,173 @@
+package node
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/pactus-project/pactus/config"
+	"github.com/pactus-project/pactus/crypto"
+	"github.com/pactus-project/pactus/crypto/bls"
+	"github.com/pactus-project/pactus/genesis"
+	"github.com/pactus-project/pactus/store"
+	"github.com/pactus-project/pactus/types/account"
+	"github.com/pactus-project/pactus/types/param"
+	"github.com/pactus-project/pactus/types/validator"
+	"github.com/pactus-project/pactus/util"
+	"github.com/pactus-project/pactus/util/logger"
+	"github.com/pactus-project/pactus/util/testsuite"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testConfig() *config.Config {
+	conf := config.DefaultConfigMainnet()
+	conf.GRPC.Enable = false
+	conf.HTTP.Enable = false
+	conf.Store.Path = util.TempDirPath()
+	conf.Network.EnableRelay = false
+	conf.Network.NetworkKey = util.TempFilePath()
+	conf.Network.AnchorsFile = util.TempFilePath()
+	conf.Network.AddrBookFile = util.TempFilePath()
+
+	return conf
+}
+
+func testGenesis(ts *testsuite.TestSuite) *genesis.Genesis {
+	pub, _ := ts.RandBLSKeyPair()
+	acc := account.NewAccount(0)
+	acc.AddToBalance(21 * 1e14)
+	val := validator.NewValidator(pub, 0)
+
+	return genesis.MakeGenesis(util.Now(),
+		map[crypto.Address]*account.Account{crypto.TreasuryAddress: acc},
+		[]*validator.Validator{val}, param.DefaultParams())
+}
+
+func TestNewOptions(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	t.Run("Default genesis is Mainnet", func(t *testing.T) {
+		o, err := newOptions()
+		require.NoError(t, err)
+
+		assert.Equal(t, genesis.Mainnet, o.genDoc.ChainType())
+	})
+
+	t.Run("Mismatched reward addresses", func(t *testing.T) {
+		_, err := newOptions(WithValidators(
+			[]*bls.ValidatorKey{ts.RandValKey(), ts.RandValKey()},
+			[]crypto.Address{ts.RandAccAddress()}))
+		assert.Error(t, err)
+	})
+
+	t.Run("Unknown subsystem", func(t *testing.T) {
+		_, err := newOptions(WithDisabled(SubsystemGRPC, Subsystem("unknown")))
+		assert.ErrorContains(t, err, "unknown subsystem")
+	})
+
+	t.Run("Hooks are appended", func(t *testing.T) {
+		o, err := newOptions(WithHooks(Hooks{}), WithHooks(Hooks{}))
+		require.NoError(t, err)
+
+		assert.Len(t, o.hooks, 2)
+	})
+}
+
+func TestApplyDisabled(t *testing.T) {
+	conf := config.DefaultConfigMainnet()
+	conf.GRPC.Enable = true
+	conf.HTTP.Enable = true
+	conf.Nanomsg.Enable = true
+	conf.Watcher.Enable = true
+
+	o, err := newOptions(WithDisabled(SubsystemGRPC, SubsystemNanomsg, SubsystemReporter))
+	require.NoError(t, err)
+	o.applyDisabled(conf)
+
+	assert.False(t, conf.GRPC.Enable)
+	assert.False(t, conf.GRPC.Gateway.Enable)
+	assert.False(t, conf.HTTP.Enable)
+	assert.False(t, conf.Nanomsg.Enable)
+	assert.True(t, conf.Watcher.Enable)
+	assert.Zero(t, conf.Node.ParticipationReportInterval)
+}
+
+func TestBeforeStartError(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	logger.LogFilename = util.TempFilePath()
+	errAbort := errors.New("abort")
+	afterStart := false
+	n, err := New(testConfig(),
+		WithGenesis(testGenesis(ts)),
+		WithHooks(Hooks{
+			BeforeStart: func(_ *Node) error { return errAbort },
+			AfterStart:  func(_ *Node) { afterStart = true },
+		}))
+	require.NoError(t, err)
+
+	err = n.Start()
+	assert.ErrorIs(t, err, errAbort)
+	assert.False(t, afterStart)
+}
+
+func TestEmbeddedNode(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	logger.LogFilename = util.TempFilePath()
+	conf := testConfig()
+	str, err := store.NewStore(conf.Store)
+	require.NoError(t, err)
+
+	calls := []string{}
+	n, err := New(conf,
+		WithGenesis(testGenesis(ts)),
+		WithValidators([]*bls.ValidatorKey{ts.RandValKey()}, []crypto.Address{ts.RandAccAddress()}),
+		WithStore(str),
+		WithDisabled(SubsystemConsensus),
+		WithHooks(Hooks{
+			BeforeStart: func(_ *Node) error {
+				calls = append(calls, "before-start")
+
+				return nil
+			},
+			AfterStart: func(_ *Node) { calls = append(calls, "after-start") },
+			BeforeStop: func(_ *Node) { calls = append(calls, "before-stop") },
+			AfterStop:  func(_ *Node) { calls = append(calls, "after-stop") },
+		}))
+	require.NoError(t, err)
+	assert.True(t, n.ConsManager().IsSafeMode())
+
+	require.NoError(t, n.Start())
+	n.Stop()
+
+	assert.Equal(t, []string{"before-start", "after-start", "before-stop", "after-stop"}, calls)
+
+	// The store is owned by the caller, so it is not closed by the node.
+	assert.NoError(t, str.Close())
+}
+
+func TestSubsystems(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	logger.LogFilename = util.TempFilePath()
+	conf := testConfig()
+	conf.GRPC.Enable = true
+	conf.GRPC.Listen = "127.0.0.1:0"
+	conf.GRPC.EnableWallet = true
+	conf.HTTP.Enable = true
+	n, err := New(conf,
+		WithGenesis(testGenesis(ts)),
+		WithDisabled(SubsystemConsensus, SubsystemWallet, SubsystemMetrics))
+	require.NoError(t, err)
+
+	subsystems := n.Subsystems()
+	assert.Len(t, subsystems, len(allSubsystems))
+	assert.False(t, subsystems[SubsystemConsensus])
+	assert.True(t, subsystems[SubsystemGRPC])
+	assert.False(t, subsystems[SubsystemWallet])
+	assert.False(t, subsystems[SubsystemGateway])
+	assert.True(t, subsystems[SubsystemHTTP])
+	assert.False(t, subsystems[SubsystemMetrics])
+	assert.False(t, subsystems[SubsystemNanomsg])
+}
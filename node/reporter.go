@@ -0,0 +1,109 @@
+package node
+
+import (
+	"encoding/hex"
+	"fmt"
+	"path/filepath"
+
+	"github.com/pactus-project/pactus/crypto/bls"
+	"github.com/pactus-project/pactus/state"
+	"github.com/pactus-project/pactus/util"
+	"github.com/pactus-project/pactus/util/logger"
+	"github.com/pactus-project/pactus/util/pubsub"
+	"github.com/pactus-project/pactus/util/recovery"
+)
+
+// reporter writes a signed participation report for each validator of the node,
+// every interval blocks. The reports are hex-encoded, so they can be published as they are
+// and be verified by the VerifyParticipationReport RPC of any node.
+type reporter struct {
+	state    state.Facade
+	valKeys  []*bls.ValidatorKey
+	interval uint32
+	dir      string
+	blockSub *pubsub.Subscription[BlockEvent]
+	done     chan struct{}
+	logger   *logger.SubLogger
+}
+
+func newReporter(interval uint32, dir string, st state.Facade,
+	valKeys []*bls.ValidatorKey, bus *Bus,
+) *reporter {
+	return &reporter{
+		state:    st,
+		valKeys:  valKeys,
+		interval: interval,
+		dir:      dir,
+		blockSub: bus.Blocks.Subscribe(busBufferSize),
+		done:     make(chan struct{}),
+		logger:   logger.NewSubLogger("_reporter", nil),
+	}
+}
+
+func (r *reporter) start() {
+	go recovery.Loop("reporter", r.logger, r.blockLoop)
+}
+
+func (r *reporter) stop() {
+	close(r.done)
+	r.blockSub.Unsubscribe()
+}
+
+func (r *reporter) blockLoop() {
+	for {
+		select {
+		case <-r.done:
+			return
+
+		case e := <-r.blockSub.C():
+			if e.Height%r.interval == 0 {
+				r.writeReports(e.Height)
+			}
+		}
+	}
+}
+
+// writeReports writes the reports of the validators for the last interval, ending at the given height.
+// The validators that are not bonded yet are skipped.
+func (r *reporter) writeReports(height uint32) {
+	from := uint32(1)
+	if height > r.interval {
+		from = height - r.interval + 1
+	}
+
+	for _, valKey := range r.valKeys {
+		if !r.state.IsValidator(valKey.Address()) {
+			continue
+		}
+
+		path, err := r.writeReport(valKey, from, height)
+		if err != nil {
+			r.logger.Error("unable to write participation report",
+				"validator", valKey.Address(), "error", err)
+
+			continue
+		}
+
+		r.logger.Info("participation report is written", "validator", valKey.Address(), "path", path)
+	}
+}
+
+func (r *reporter) writeReport(valKey *bls.ValidatorKey, from, to uint32) (string, error) {
+	report, err := r.state.ParticipationReport(valKey.Address(), from, to)
+	if err != nil {
+		return "", err
+	}
+	report.SetSignature(valKey.Sign(report.SignBytes()))
+
+	data, err := report.Bytes()
+	if err != nil {
+		return "", err
+	}
+
+	path := filepath.Join(r.dir, fmt.Sprintf("%s_%d_%d.report", valKey.Address(), from, to))
+	if err := util.WriteFile(path, []byte(hex.EncodeToString(data))); err != nil {
+		return "", err
+	}
+
+	return path, nil
+}
@@ -0,0 +1,45 @@
+package node
+
+import (
+	"encoding/hex"
+	"path/filepath"
+	"testing"
+
+	"github.com/pactus-project/pactus/crypto/bls"
+	"github.com/pactus-project/pactus/state"
+	"github.com/pactus-project/pactus/types/participation"
+	"github.com/pactus-project/pactus/types/validator"
+	"github.com/pactus-project/pactus/util"
+	"github.com/pactus-project/pactus/util/testsuite"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReporter(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	st := state.MockingState(ts)
+	st.CommitTestBlocks(10)
+
+	bondedKey := ts.RandValKey()
+	unbondedKey := ts.RandValKey()
+	st.TestStore.UpdateValidator(validator.NewValidator(bondedKey.PublicKey(), 0))
+
+	dir := util.TempDirPath()
+	rep := newReporter(5, dir, st, []*bls.ValidatorKey{bondedKey, unbondedKey}, newBus())
+	rep.writeReports(10)
+
+	data, err := util.ReadFile(filepath.Join(dir, bondedKey.Address().String()+"_6_10.report"))
+	require.NoError(t, err)
+	bs, err := hex.DecodeString(string(data))
+	require.NoError(t, err)
+	report, err := participation.FromBytes(bs)
+	require.NoError(t, err)
+
+	assert.Equal(t, bondedKey.Address(), report.Validator())
+	assert.Equal(t, uint32(6), report.FromHeight())
+	assert.Equal(t, uint32(10), report.ToHeight())
+	assert.NoError(t, report.Verify(bondedKey.PublicKey()))
+
+	assert.False(t, util.PathExists(filepath.Join(dir, unbondedKey.Address().String()+"_6_10.report")))
+}
@@ -0,0 +1,47 @@
+package node
+
+import (
+	"fmt"
+
+	"github.com/pactus-project/pactus/consensus"
+	"github.com/pactus-project/pactus/crypto"
+	"github.com/pactus-project/pactus/crypto/bls"
+	"github.com/pactus-project/pactus/util/logger"
+)
+
+// newRemoteSigners creates the signers of the validators that sign through a remote signer,
+// with their reward addresses.
+// The keys of these validators are not loaded in the node, so they shouldn't be in the wallet.
+// Each validator has its own remote signer, so a failing signer doesn't affect the other validators.
+func newRemoteSigners(confs []consensus.RemoteSignerConfig, valKeys []*bls.ValidatorKey,
+) ([]bls.Signer, []crypto.Address, error) {
+	signers := make([]bls.Signer, 0, len(confs))
+	rewardAddrs := make([]crypto.Address, 0, len(confs))
+	for i := range confs {
+		signerConf := &confs[i]
+
+		signer, err := consensus.NewRemoteSigner(signerConf)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		valAddr := signer.PublicKey().ValidatorAddress()
+		for _, key := range valKeys {
+			if key.Address() == valAddr {
+				return nil, nil, fmt.Errorf("validator has both a local key and a remote signer: %s", valAddr)
+			}
+		}
+
+		rewardAddr, err := signerConf.RewardAddr()
+		if err != nil {
+			return nil, nil, err
+		}
+
+		signers = append(signers, signer)
+		rewardAddrs = append(rewardAddrs, rewardAddr)
+
+		logger.Info("remote signer is set", "validator", valAddr, "url", signerConf.URL)
+	}
+
+	return signers, rewardAddrs, nil
+}
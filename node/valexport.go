@@ -0,0 +1,252 @@
+package node
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/pactus-project/pactus/state"
+	"github.com/pactus-project/pactus/util"
+	"github.com/pactus-project/pactus/util/logger"
+	"github.com/pactus-project/pactus/util/pubsub"
+	"github.com/pactus-project/pactus/util/recovery"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// The statuses of the validators in the export.
+const (
+	ValidatorStatusUnbonded  = "unbonded"
+	ValidatorStatusActive    = "active"
+	ValidatorStatusCommittee = "committee"
+)
+
+// validatorExportFilename is the name of the validator set export, inside the data directory.
+const validatorExportFilename = "validators.json"
+
+// All the validator metrics are labeled by the validator address.
+var (
+	validatorStakeGauge = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "pactus",
+		Subsystem: "validator",
+		Name:      "stake",
+		Help:      "The stake of the validator in NanoPAC.",
+	}, []string{"validator"})
+	validatorStatusGauge = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "pactus",
+		Subsystem: "validator",
+		Name:      "status",
+		Help:      "The status of the validator: zero for unbonded, one for active and two for in the committee.",
+	}, []string{"validator"})
+	validatorLastSeenGauge = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "pactus",
+		Subsystem: "validator",
+		Name:      "last_seen_height",
+		Help:      "The height of the last certificate that the validator has signed, since the node has started.",
+	}, []string{"validator"})
+	validatorAvailabilityGauge = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "pactus",
+		Subsystem: "validator",
+		Name:      "availability_score",
+		Help:      "The availability score of the validator.",
+	}, []string{"validator"})
+)
+
+func init() {
+	prometheus.MustRegister(validatorStakeGauge, validatorStatusGauge,
+		validatorLastSeenGauge, validatorAvailabilityGauge)
+}
+
+// ValidatorSetExport is the export of the validator set at a height.
+type ValidatorSetExport struct {
+	Height     uint32              `json:"height"`
+	ExportedAt time.Time           `json:"exported_at"`
+	Validators []ExportedValidator `json:"validators"`
+}
+
+// ExportedValidator is a validator in the export.
+type ExportedValidator struct {
+	Address             string  `json:"address"`
+	Number              int32   `json:"number"`
+	Stake               int64   `json:"stake"`
+	Status              string  `json:"status"`
+	LastBondingHeight   uint32  `json:"last_bonding_height"`
+	LastSortitionHeight uint32  `json:"last_sortition_height"`
+	UnbondingHeight     uint32  `json:"unbonding_height"`
+	LastSeenHeight      uint32  `json:"last_seen_height"` // Zero if not seen since the node has started
+	AvailabilityScore   float64 `json:"availability_score"`
+}
+
+// validatorExporter exports the validator set every interval blocks, for the monitoring systems.
+// The export is kept in memory to be served over HTTP, written in the data directory,
+// and the validators are exposed in the metrics.
+type validatorExporter struct {
+	lk       sync.RWMutex
+	state    state.Facade
+	interval uint32
+	path     string
+	lastSeen map[int32]uint32
+	export   []byte
+	blockSub *pubsub.Subscription[BlockEvent]
+	done     chan struct{}
+	logger   *logger.SubLogger
+}
+
+func newValidatorExporter(interval uint32, dir string, st state.Facade, bus *Bus) *validatorExporter {
+	return &validatorExporter{
+		state:    st,
+		interval: interval,
+		path:     filepath.Join(dir, validatorExportFilename),
+		lastSeen: make(map[int32]uint32),
+		blockSub: bus.Blocks.Subscribe(busBufferSize),
+		done:     make(chan struct{}),
+		logger:   logger.NewSubLogger("_valexport", nil),
+	}
+}
+
+func (e *validatorExporter) start() {
+	e.trackLastSeen()
+	e.refresh()
+
+	go recovery.Loop("validator-exporter", e.logger, e.blockLoop)
+}
+
+func (e *validatorExporter) stop() {
+	close(e.done)
+	e.blockSub.Unsubscribe()
+}
+
+func (e *validatorExporter) blockLoop() {
+	for {
+		select {
+		case <-e.done:
+			return
+
+		case b := <-e.blockSub.C():
+			e.trackLastSeen()
+			if b.Height%e.interval == 0 {
+				e.refresh()
+			}
+		}
+	}
+}
+
+// trackLastSeen records the committers of the last certificate that are not absent.
+func (e *validatorExporter) trackLastSeen() {
+	cert := e.state.LastCertificate()
+	if cert == nil {
+		return
+	}
+
+	e.lk.Lock()
+	defer e.lk.Unlock()
+
+	absentees := make(map[int32]bool, len(cert.Absentees()))
+	for _, num := range cert.Absentees() {
+		absentees[num] = true
+	}
+	for _, num := range cert.Committers() {
+		if !absentees[num] {
+			e.lastSeen[num] = cert.Height()
+		}
+	}
+}
+
+// refresh exports the validator set at the current height.
+func (e *validatorExporter) refresh() {
+	export := e.collect()
+	data, err := json.Marshal(export)
+	if err != nil {
+		e.logger.Error("unable to marshal the validator set", "error", err)
+
+		return
+	}
+
+	e.lk.Lock()
+	e.export = data
+	e.lk.Unlock()
+
+	for _, val := range export.Validators {
+		label := val.Address
+		validatorStakeGauge.WithLabelValues(label).Set(float64(val.Stake))
+		validatorStatusGauge.WithLabelValues(label).Set(statusValue(val.Status))
+		validatorLastSeenGauge.WithLabelValues(label).Set(float64(val.LastSeenHeight))
+		validatorAvailabilityGauge.WithLabelValues(label).Set(val.AvailabilityScore)
+	}
+
+	// The file is replaced at once, so the readers never see a partial export.
+	tmpPath := e.path + ".tmp"
+	if err := util.WriteFile(tmpPath, data); err != nil {
+		e.logger.Warn("unable to write the validator set export", "error", err)
+
+		return
+	}
+	if err := os.Rename(tmpPath, e.path); err != nil {
+		e.logger.Warn("unable to write the validator set export", "error", err)
+
+		return
+	}
+
+	e.logger.Debug("validator set exported", "height", export.Height, "validators", len(export.Validators))
+}
+
+func (e *validatorExporter) collect() *ValidatorSetExport {
+	e.lk.RLock()
+	defer e.lk.RUnlock()
+
+	total := e.state.TotalValidators()
+	export := &ValidatorSetExport{
+		Height:     e.state.LastBlockHeight(),
+		ExportedAt: util.Now().UTC(),
+		Validators: make([]ExportedValidator, 0, total),
+	}
+
+	for num := int32(0); num < total; num++ {
+		val := e.state.ValidatorByNumber(num)
+		if val == nil {
+			continue
+		}
+
+		status := ValidatorStatusActive
+		switch {
+		case val.UnbondingHeight() > 0:
+			status = ValidatorStatusUnbonded
+		case e.state.IsInCommittee(val.Address()):
+			status = ValidatorStatusCommittee
+		}
+
+		export.Validators = append(export.Validators, ExportedValidator{
+			Address:             val.Address().String(),
+			Number:              val.Number(),
+			Stake:               val.Stake(),
+			Status:              status,
+			LastBondingHeight:   val.LastBondingHeight(),
+			LastSortitionHeight: val.LastSortitionHeight(),
+			UnbondingHeight:     val.UnbondingHeight(),
+			LastSeenHeight:      e.lastSeen[val.Number()],
+			AvailabilityScore:   e.state.AvailabilityScore(val.Number()),
+		})
+	}
+
+	return export
+}
+
+// JSON returns the last export of the validator set.
+func (e *validatorExporter) JSON() []byte {
+	e.lk.RLock()
+	defer e.lk.RUnlock()
+
+	return e.export
+}
+
+func statusValue(status string) float64 {
+	switch status {
+	case ValidatorStatusCommittee:
+		return 2
+	case ValidatorStatusActive:
+		return 1
+	default:
+		return 0
+	}
+}
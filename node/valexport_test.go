@@ -0,0 +1,58 @@
+package node
+
+import (
+	"encoding/json"
+	"path/filepath"
+	"testing"
+
+	"github.com/pactus-project/pactus/state"
+	"github.com/pactus-project/pactus/types/certificate"
+	"github.com/pactus-project/pactus/types/validator"
+	"github.com/pactus-project/pactus/util"
+	"github.com/pactus-project/pactus/util/testsuite"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidatorExport(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	st := state.MockingState(ts)
+	st.CommitTestBlocks(10)
+
+	committeeVal := st.TestCommittee.Validators()[0]
+	activeVal := validator.NewValidator(ts.RandValKey().PublicKey(), 1)
+	unbondedVal := validator.NewValidator(ts.RandValKey().PublicKey(), 2)
+	unbondedVal.UpdateUnbondingHeight(8)
+	st.TestStore.UpdateValidator(committeeVal)
+	st.TestStore.UpdateValidator(activeVal)
+	st.TestStore.UpdateValidator(unbondedVal)
+	st.TestStore.LastCert = certificate.NewCertificate(10, 0,
+		[]int32{0, 1, 2}, []int32{1}, ts.RandBLSSignature())
+
+	dir := util.TempDirPath()
+	exp := newValidatorExporter(5, dir, st, newBus())
+	exp.trackLastSeen()
+	exp.refresh()
+
+	data, err := util.ReadFile(filepath.Join(dir, validatorExportFilename))
+	require.NoError(t, err)
+	assert.Equal(t, data, exp.JSON())
+
+	export := new(ValidatorSetExport)
+	require.NoError(t, json.Unmarshal(data, export))
+	require.Len(t, export.Validators, 3)
+	assert.Equal(t, uint32(10), export.Height)
+
+	assert.Equal(t, committeeVal.Address().String(), export.Validators[0].Address)
+	assert.Equal(t, ValidatorStatusCommittee, export.Validators[0].Status)
+	assert.Equal(t, uint32(10), export.Validators[0].LastSeenHeight)
+
+	assert.Equal(t, activeVal.Address().String(), export.Validators[1].Address)
+	assert.Equal(t, ValidatorStatusActive, export.Validators[1].Status)
+	assert.Zero(t, export.Validators[1].LastSeenHeight, "absent validator is not seen")
+
+	assert.Equal(t, ValidatorStatusUnbonded, export.Validators[2].Status)
+	assert.Equal(t, uint32(8), export.Validators[2].UnbondingHeight)
+	assert.Equal(t, uint32(10), export.Validators[2].LastSeenHeight)
+}
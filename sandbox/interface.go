@@ -1,6 +1,8 @@
 package sandbox
 
 import (
+	"time"
+
 	"github.com/pactus-project/pactus/committee"
 	"github.com/pactus-project/pactus/crypto"
 	"github.com/pactus-project/pactus/crypto/bls"
@@ -27,13 +29,19 @@ type Sandbox interface {
 	UpdatePowerDelta(delta int64)
 	PowerDelta() int64
 	AccumulatedFee() int64
+	AccumulatedDataSize() int
 
 	VerifyProof(uint32, sortition.Proof, *validator.Validator) bool
 	Committee() committee.Reader
 
 	Params() *param.Params
 	CurrentHeight() uint32
+	LastBlockTime() time.Time
 
 	IterateAccounts(consumer func(crypto.Address, *account.Account, bool))
 	IterateValidators(consumer func(*validator.Validator, bool, bool))
+
+	IsAllowlisted(crypto.Address) bool
+	UpdateAllowlist(addr crypto.Address, allowed bool)
+	IterateAllowlist(consumer func(crypto.Address, bool))
 }
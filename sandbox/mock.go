@@ -1,6 +1,8 @@
 package sandbox
 
 import (
+	"time"
+
 	"github.com/pactus-project/pactus/committee"
 	"github.com/pactus-project/pactus/crypto"
 	"github.com/pactus-project/pactus/crypto/bls"
@@ -9,7 +11,9 @@ import (
 	"github.com/pactus-project/pactus/types/account"
 	"github.com/pactus-project/pactus/types/param"
 	"github.com/pactus-project/pactus/types/tx"
+	"github.com/pactus-project/pactus/types/tx/payload"
 	"github.com/pactus-project/pactus/types/validator"
+	"github.com/pactus-project/pactus/util"
 	"github.com/pactus-project/pactus/util/testsuite"
 )
 
@@ -26,6 +30,8 @@ type MockSandbox struct {
 	TestJoinedValidators map[crypto.Address]bool
 	TestCommittedTrxs    map[tx.ID]*tx.Tx
 	TestPowerDelta       int64
+	TestLastBlockTime    time.Time
+	TestDataSize         int
 }
 
 func MockingSandbox(ts *testsuite.TestSuite) *MockSandbox {
@@ -38,6 +44,7 @@ func MockingSandbox(ts *testsuite.TestSuite) *MockSandbox {
 		TestCommittee:        cmt,
 		TestJoinedValidators: make(map[crypto.Address]bool),
 		TestCommittedTrxs:    make(map[tx.ID]*tx.Tx),
+		TestLastBlockTime:    util.RoundNow(10),
 	}
 
 	treasuryAmt := int64(21000000 * 1e9)
@@ -110,6 +117,10 @@ func (m *MockSandbox) CurrentHeight() uint32 {
 	return m.TestStore.LastHeight + 1
 }
 
+func (m *MockSandbox) LastBlockTime() time.Time {
+	return m.TestLastBlockTime
+}
+
 func (m *MockSandbox) Params() *param.Params {
 	return m.TestParams
 }
@@ -130,6 +141,20 @@ func (m *MockSandbox) IterateValidators(consumer func(*validator.Validator, bool
 	})
 }
 
+func (m *MockSandbox) IsAllowlisted(addr crypto.Address) bool {
+	return m.TestStore.IsAllowlisted(addr)
+}
+
+func (m *MockSandbox) UpdateAllowlist(addr crypto.Address, allowed bool) {
+	m.TestStore.UpdateAllowlist(addr, allowed)
+}
+
+func (m *MockSandbox) IterateAllowlist(consumer func(crypto.Address, bool)) {
+	for addr := range m.TestStore.Allowlist {
+		consumer(addr, true)
+	}
+}
+
 func (m *MockSandbox) Committee() committee.Reader {
 	return m.TestCommittee
 }
@@ -148,8 +173,16 @@ func (m *MockSandbox) VerifyProof(uint32, sortition.Proof, *validator.Validator)
 
 func (m *MockSandbox) CommitTransaction(trx *tx.Tx) {
 	m.TestCommittedTrxs[trx.ID()] = trx
+
+	if trx.IsDataTx() {
+		m.TestDataSize += len(trx.Payload().(*payload.DataPayload).Data)
+	}
 }
 
 func (m *MockSandbox) AccumulatedFee() int64 {
 	return 0
 }
+
+func (m *MockSandbox) AccumulatedDataSize() int {
+	return m.TestDataSize
+}
@@ -2,6 +2,7 @@ package sandbox
 
 import (
 	"sync"
+	"time"
 
 	"github.com/pactus-project/pactus/committee"
 	"github.com/pactus-project/pactus/crypto"
@@ -11,6 +12,7 @@ import (
 	"github.com/pactus-project/pactus/types/account"
 	"github.com/pactus-project/pactus/types/param"
 	"github.com/pactus-project/pactus/types/tx"
+	"github.com/pactus-project/pactus/types/tx/payload"
 	"github.com/pactus-project/pactus/types/validator"
 	"github.com/pactus-project/pactus/util/logger"
 )
@@ -24,14 +26,17 @@ type sandbox struct {
 	committee       committee.Reader
 	accounts        map[crypto.Address]*sandboxAccount
 	validators      map[crypto.Address]*sandboxValidator
+	allowlist       map[crypto.Address]bool
 	committedTrxs   map[tx.ID]*tx.Tx
 	params          *param.Params
 	height          uint32
+	blockTime       time.Time
 	totalAccounts   int32
 	totalValidators int32
 	totalPower      int64
 	powerDelta      int64
 	accumulatedFee  int64
+	dataSize        int
 }
 
 type sandboxValidator struct {
@@ -45,11 +50,12 @@ type sandboxAccount struct {
 	updated bool
 }
 
-func NewSandbox(height uint32, str store.Reader, params *param.Params,
+func NewSandbox(height uint32, blockTime time.Time, str store.Reader, params *param.Params,
 	cmt committee.Reader, totalPower int64,
 ) Sandbox {
 	sb := &sandbox{
 		height:     height,
+		blockTime:  blockTime,
 		store:      str,
 		committee:  cmt,
 		totalPower: totalPower,
@@ -58,6 +64,7 @@ func NewSandbox(height uint32, str store.Reader, params *param.Params,
 
 	sb.accounts = make(map[crypto.Address]*sandboxAccount)
 	sb.validators = make(map[crypto.Address]*sandboxValidator)
+	sb.allowlist = make(map[crypto.Address]bool)
 	sb.committedTrxs = make(map[tx.ID]*tx.Tx)
 	sb.totalAccounts = sb.store.TotalAccounts()
 	sb.totalValidators = sb.store.TotalValidators()
@@ -237,6 +244,14 @@ func (sb *sandbox) CurrentHeight() uint32 {
 	return sb.height + 1
 }
 
+// LastBlockTime returns the time of the last committed block.
+func (sb *sandbox) LastBlockTime() time.Time {
+	sb.lk.RLock()
+	defer sb.lk.RUnlock()
+
+	return sb.blockTime
+}
+
 func (sb *sandbox) IterateAccounts(
 	consumer func(crypto.Address, *account.Account, bool),
 ) {
@@ -259,6 +274,36 @@ func (sb *sandbox) IterateValidators(
 	}
 }
 
+// IsAllowlisted checks if the validator is in the allowlist of the permissioned network.
+func (sb *sandbox) IsAllowlisted(addr crypto.Address) bool {
+	sb.lk.RLock()
+	defer sb.lk.RUnlock()
+
+	allowed, ok := sb.allowlist[addr]
+	if ok {
+		return allowed
+	}
+
+	return sb.store.IsAllowlisted(addr)
+}
+
+// UpdateAllowlist adds the validator to, or removes it from, the allowlist.
+func (sb *sandbox) UpdateAllowlist(addr crypto.Address, allowed bool) {
+	sb.lk.Lock()
+	defer sb.lk.Unlock()
+
+	sb.allowlist[addr] = allowed
+}
+
+func (sb *sandbox) IterateAllowlist(consumer func(crypto.Address, bool)) {
+	sb.lk.RLock()
+	defer sb.lk.RUnlock()
+
+	for addr, allowed := range sb.allowlist {
+		consumer(addr, allowed)
+	}
+}
+
 func (sb *sandbox) Committee() committee.Reader {
 	return sb.committee
 }
@@ -298,6 +343,10 @@ func (sb *sandbox) CommitTransaction(trx *tx.Tx) {
 
 	sb.committedTrxs[trx.ID()] = trx
 	sb.accumulatedFee += trx.Fee()
+
+	if trx.IsDataTx() {
+		sb.dataSize += len(trx.Payload().(*payload.DataPayload).Data)
+	}
 }
 
 func (sb *sandbox) AccumulatedFee() int64 {
@@ -306,3 +355,12 @@ func (sb *sandbox) AccumulatedFee() int64 {
 
 	return sb.accumulatedFee
 }
+
+// AccumulatedDataSize returns the total size of data carried by
+// the data transactions committed in this sandbox.
+func (sb *sandbox) AccumulatedDataSize() int {
+	sb.lk.RLock()
+	defer sb.lk.RUnlock()
+
+	return sb.dataSize
+}
@@ -9,7 +9,9 @@ import (
 	"github.com/pactus-project/pactus/store"
 	"github.com/pactus-project/pactus/types/account"
 	"github.com/pactus-project/pactus/types/param"
+	"github.com/pactus-project/pactus/types/tx/payload"
 	"github.com/pactus-project/pactus/types/validator"
+	"github.com/pactus-project/pactus/util"
 	"github.com/pactus-project/pactus/util/testsuite"
 	"github.com/stretchr/testify/assert"
 )
@@ -52,9 +54,11 @@ func setup(t *testing.T) *testData {
 		blk, cert := ts.GenerateTestBlock(height)
 		mockStore.SaveBlock(blk, cert)
 	}
-	sandbox := NewSandbox(mockStore.LastHeight,
+	lastBlockTime := util.RoundNow(10)
+	sandbox := NewSandbox(mockStore.LastHeight, lastBlockTime,
 		mockStore, params, cmt, totalPower).(*sandbox)
 	assert.Equal(t, sandbox.CurrentHeight(), lastHeight)
+	assert.Equal(t, sandbox.LastBlockTime(), lastBlockTime)
 	assert.Equal(t, sandbox.Params(), params)
 
 	return &testData{
@@ -149,6 +153,21 @@ func TestAnyRecentTransaction(t *testing.T) {
 	assert.Equal(t, td.sandbox.AccumulatedFee(), totalTxFees)
 }
 
+func TestAccumulatedDataSize(t *testing.T) {
+	td := setup(t)
+
+	randTx1, _ := td.GenerateTestDataTx()
+	randTx2, _ := td.GenerateTestDataTx()
+	randTx3, _ := td.GenerateTestTransferTx()
+	td.sandbox.CommitTransaction(randTx1)
+	td.sandbox.CommitTransaction(randTx2)
+	td.sandbox.CommitTransaction(randTx3)
+
+	totalDataSize := len(randTx1.Payload().(*payload.DataPayload).Data) +
+		len(randTx2.Payload().(*payload.DataPayload).Data)
+	assert.Equal(t, td.sandbox.AccumulatedDataSize(), totalDataSize)
+}
+
 func TestValidatorChange(t *testing.T) {
 	td := setup(t)
 
@@ -371,6 +390,29 @@ func TestPowerDelta(t *testing.T) {
 	assert.Zero(t, td.sandbox.PowerDelta())
 }
 
+func TestAllowlist(t *testing.T) {
+	td := setup(t)
+
+	addr1 := td.RandValAddress()
+	addr2 := td.RandValAddress()
+	td.store.UpdateAllowlist(addr1, true)
+
+	assert.True(t, td.sandbox.IsAllowlisted(addr1))
+	assert.False(t, td.sandbox.IsAllowlisted(addr2))
+
+	td.sandbox.UpdateAllowlist(addr1, false)
+	td.sandbox.UpdateAllowlist(addr2, true)
+	assert.False(t, td.sandbox.IsAllowlisted(addr1))
+	assert.True(t, td.sandbox.IsAllowlisted(addr2))
+	assert.True(t, td.store.IsAllowlisted(addr1), "store should not be updated")
+
+	changes := map[crypto.Address]bool{}
+	td.sandbox.IterateAllowlist(func(addr crypto.Address, allowed bool) {
+		changes[addr] = allowed
+	})
+	assert.Equal(t, map[crypto.Address]bool{addr1: false, addr2: true}, changes)
+}
+
 func TestVerifyProof(t *testing.T) {
 	td := setup(t)
 
@@ -40,6 +40,18 @@ func (s *VerifiableSeed) GenerateNext(prv *bls.PrivateKey) VerifiableSeed {
 	return newSeed
 }
 
+// GenerateNextBySigner is like GenerateNext, but it signs through the signer,
+// so the key of the validator can be kept out of the node.
+func (s *VerifiableSeed) GenerateNextBySigner(signer bls.Signer) (VerifiableSeed, error) {
+	h := hash.CalcHash(s[:])
+	sig, err := signer.Sign(h.Bytes())
+	if err != nil {
+		return UndefVerifiableSeed, err
+	}
+
+	return VerifiableSeedFromBytes(sig.Bytes())
+}
+
 func (s *VerifiableSeed) Verify(public *bls.PublicKey, prevSeed VerifiableSeed) bool {
 	sig, err := bls.SignatureFromBytes(s[:])
 	if err != nil {
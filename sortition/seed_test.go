@@ -3,6 +3,7 @@ package sortition_test
 import (
 	"testing"
 
+	"github.com/pactus-project/pactus/crypto/bls"
 	"github.com/pactus-project/pactus/sortition"
 	"github.com/pactus-project/pactus/util/testsuite"
 	"github.com/stretchr/testify/assert"
@@ -30,4 +31,9 @@ func TestValidate(t *testing.T) {
 	assert.False(t, seed2.Verify(valKey.PublicKey(), ts.RandSeed()))
 	assert.False(t, seed3.Verify(valKey.PublicKey(), seed1))
 	assert.False(t, seed4.Verify(valKey.PublicKey(), seed1))
+
+	// Signing through a signer generates the same seed.
+	seed5, err := seed1.GenerateNextBySigner(bls.NewKeySigner(valKey))
+	assert.NoError(t, err)
+	assert.Equal(t, seed2, seed5)
 }
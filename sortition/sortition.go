@@ -13,6 +13,20 @@ func EvaluateSortition(seed VerifiableSeed, prv *bls.PrivateKey, total, threshol
 	return false, Proof{}
 }
 
+// EvaluateSortitionBySigner is like EvaluateSortition, but it signs through the signer,
+// so the key of the validator can be kept out of the node.
+func EvaluateSortitionBySigner(seed VerifiableSeed, signer bls.Signer, total, threshold int64) (bool, Proof, error) {
+	index, proof, err := EvaluateBySigner(seed, signer, uint64(total))
+	if err != nil {
+		return false, Proof{}, err
+	}
+	if int64(index) < threshold {
+		return true, proof, nil
+	}
+
+	return false, Proof{}, nil
+}
+
 func VerifyProof(seed VerifiableSeed, proof Proof, pub *bls.PublicKey, total, threshold int64) bool {
 	index, result := Verify(seed, pub, proof, uint64(total))
 	if !result {
@@ -18,11 +18,7 @@ func init() {
 // It returns the random number and the proof that can regenerate the random number using
 // the public key of the signer, without revealing the private key.
 func Evaluate(seed VerifiableSeed, prv *bls.PrivateKey, max uint64) (uint64, Proof) {
-	signData := make([]byte, 0, bls.SignatureSize+bls.PublicKeySize)
-	signData = append(signData, seed[:]...)
-	signData = append(signData, prv.PublicKey().Bytes()...)
-
-	sig := prv.Sign(signData)
+	sig := prv.Sign(evaluateSignData(seed, prv.PublicKey().Bytes()))
 
 	proof, _ := ProofFromBytes(sig.Bytes())
 	index := GetIndex(proof, max)
@@ -30,6 +26,32 @@ func Evaluate(seed VerifiableSeed, prv *bls.PrivateKey, max uint64) (uint64, Pro
 	return index, proof
 }
 
+// EvaluateBySigner is like Evaluate, but it signs through the signer,
+// so the key of the validator can be kept out of the node.
+func EvaluateBySigner(seed VerifiableSeed, signer bls.Signer, max uint64) (uint64, Proof, error) {
+	sig, err := signer.Sign(evaluateSignData(seed, signer.PublicKey().Bytes()))
+	if err != nil {
+		return 0, Proof{}, err
+	}
+
+	proof, err := ProofFromBytes(sig.Bytes())
+	if err != nil {
+		return 0, Proof{}, err
+	}
+	index := GetIndex(proof, max)
+
+	return index, proof, nil
+}
+
+// evaluateSignData returns the data that is signed to evaluate the random number.
+func evaluateSignData(seed VerifiableSeed, pubKey []byte) []byte {
+	signData := make([]byte, 0, bls.SignatureSize+bls.PublicKeySize)
+	signData = append(signData, seed[:]...)
+	signData = append(signData, pubKey...)
+
+	return signData
+}
+
 // Verify checks if the provided proof, based on the seed and public key, is valid.
 // If the proof is valid, it calculates the random number that
 // can be generated based on the given proof.
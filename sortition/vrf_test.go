@@ -29,6 +29,12 @@ func TestVRF(t *testing.T) {
 
 		assert.Equal(t, result, true)
 		assert.Equal(t, index, index2)
+
+		// Signing through a signer evaluates the same number.
+		index3, proof3, err := sortition.EvaluateBySigner(seed, bls.NewKeySigner(valKey), max)
+		assert.NoError(t, err)
+		assert.Equal(t, index, index3)
+		assert.Equal(t, proof, proof3)
 	}
 }
 
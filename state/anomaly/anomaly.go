@@ -0,0 +1,150 @@
+// Package anomaly detects the sudden changes in the core metrics of the chain,
+// so the operators can be warned about the network incidents.
+package anomaly
+
+import (
+	"fmt"
+
+	"github.com/pactus-project/pactus/types/block"
+	"golang.org/x/exp/slices"
+)
+
+// Kind defines the kind of the anomaly.
+type Kind uint8
+
+const (
+	KindFeeSpike       Kind = 1
+	KindEmptyBlocks    Kind = 2
+	KindCommitteeChurn Kind = 3
+)
+
+func (k Kind) String() string {
+	switch k {
+	case KindFeeSpike:
+		return "fee-spike"
+	case KindEmptyBlocks:
+		return "empty-blocks"
+	case KindCommitteeChurn:
+		return "committee-churn"
+	default:
+		return fmt.Sprintf("%d", k)
+	}
+}
+
+// Alarm is raised when an anomaly is detected in a committed block.
+type Alarm struct {
+	Kind     Kind
+	Height   uint32
+	Value    int64 // The observed value
+	Baseline int64 // The expected value or the threshold that is exceeded
+}
+
+func (a Alarm) String() string {
+	return fmt.Sprintf("{%s #%d %d/%d}", a.Kind, a.Height, a.Value, a.Baseline)
+}
+
+// Detector keeps track of the recent blocks and checks every new block against them.
+// The detector only reports the anomalies and it doesn't affect the consensus.
+type Detector struct {
+	config      *Config
+	avgFee      float64 // Exponential moving average of the median fee of the blocks
+	feeBlocks   int     // Number of the blocks that are averaged
+	emptyStreak uint32
+}
+
+func NewDetector(conf *Config) *Detector {
+	return &Detector{
+		config: conf,
+	}
+}
+
+// Check checks the committed block and returns the detected anomalies.
+// The pending is the number of the transactions in the pool when the block is committed,
+// and the joined is the number of the validators that joined the committee by the block.
+func (d *Detector) Check(height uint32, blk *block.Block, pending, joined int) []Alarm {
+	alarms := make([]Alarm, 0)
+
+	fees := make([]int64, 0, blk.Transactions().Len())
+	for _, trx := range blk.Transactions() {
+		if trx.IsSubsidyTx() {
+			continue
+		}
+		fees = append(fees, trx.Fee())
+	}
+
+	if alarm, ok := d.checkFees(height, fees); ok {
+		alarms = append(alarms, alarm)
+	}
+
+	if alarm, ok := d.checkEmptyBlocks(height, len(fees) == 0 && pending > 0); ok {
+		alarms = append(alarms, alarm)
+	}
+
+	if d.config.CommitteeChurn > 0 && joined > d.config.CommitteeChurn {
+		alarms = append(alarms, Alarm{
+			Kind:     KindCommitteeChurn,
+			Height:   height,
+			Value:    int64(joined),
+			Baseline: int64(d.config.CommitteeChurn),
+		})
+	}
+
+	return alarms
+}
+
+// checkFees compares the median fee of the block against the moving average of the recent blocks.
+// The blocks without any transaction are not averaged.
+func (d *Detector) checkFees(height uint32, fees []int64) (Alarm, bool) {
+	if len(fees) == 0 {
+		return Alarm{}, false
+	}
+
+	slices.Sort(fees)
+	median := float64(fees[len(fees)/2])
+
+	alarm := Alarm{}
+	spiked := false
+	if d.config.FeeSpikeFactor > 0 && d.feeBlocks >= d.config.Warmup &&
+		median > d.avgFee*d.config.FeeSpikeFactor {
+		alarm = Alarm{
+			Kind:     KindFeeSpike,
+			Height:   height,
+			Value:    int64(median),
+			Baseline: int64(d.avgFee),
+		}
+		spiked = true
+	}
+
+	if d.feeBlocks == 0 {
+		d.avgFee = median
+	} else {
+		alpha := 2 / float64(d.config.FeeWindow+1)
+		d.avgFee += alpha * (median - d.avgFee)
+	}
+	d.feeBlocks++
+
+	return alarm, spiked
+}
+
+// checkEmptyBlocks counts the consecutive empty blocks, while there are pending transactions.
+// The alarm is raised once the streak reaches the threshold, and repeated for every
+// multiple of the threshold.
+func (d *Detector) checkEmptyBlocks(height uint32, empty bool) (Alarm, bool) {
+	if !empty {
+		d.emptyStreak = 0
+
+		return Alarm{}, false
+	}
+
+	d.emptyStreak++
+	if d.config.EmptyBlockStreak == 0 || d.emptyStreak%d.config.EmptyBlockStreak != 0 {
+		return Alarm{}, false
+	}
+
+	return Alarm{
+		Kind:     KindEmptyBlocks,
+		Height:   height,
+		Value:    int64(d.emptyStreak),
+		Baseline: int64(d.config.EmptyBlockStreak),
+	}, true
+}
@@ -0,0 +1,137 @@
+package anomaly
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pactus-project/pactus/types/block"
+	"github.com/pactus-project/pactus/types/tx"
+	"github.com/pactus-project/pactus/util/testsuite"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func makeBlock(ts *testsuite.TestSuite, fees ...int64) *block.Block {
+	txs := block.NewTxs()
+	txs.Append(tx.NewSubsidyTx(1, ts.RandAccAddress(), 1e9, "subsidy"))
+	for _, fee := range fees {
+		txs.Append(tx.NewTransferTx(1, ts.RandAccAddress(), ts.RandAccAddress(), 1e9, fee, ""))
+	}
+	header := block.NewHeader(1, time.Now(), ts.RandHash(), ts.RandHash(), ts.RandSeed(), ts.RandValAddress())
+
+	return block.NewBlock(header, nil, txs)
+}
+
+func TestConfigBasicCheck(t *testing.T) {
+	conf := DefaultConfig()
+	assert.NoError(t, conf.BasicCheck())
+
+	conf.FeeSpikeFactor = 0
+	assert.NoError(t, conf.BasicCheck())
+
+	conf.FeeSpikeFactor = 0.5
+	assert.Error(t, conf.BasicCheck())
+
+	conf = DefaultConfig()
+	conf.CommitteeChurn = -1
+	assert.Error(t, conf.BasicCheck())
+}
+
+func TestFeeSpike(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	conf := DefaultConfig()
+	conf.Warmup = 3
+	det := NewDetector(conf)
+
+	t.Run("No alarm during the warmup", func(t *testing.T) {
+		assert.Empty(t, det.Check(1, makeBlock(ts, 1000, 1000), 0, 0))
+		assert.Empty(t, det.Check(2, makeBlock(ts, 1000, 1000, 1000), 0, 0))
+		assert.Empty(t, det.Check(3, makeBlock(ts, 1000), 0, 0))
+	})
+
+	t.Run("Empty blocks are not averaged", func(t *testing.T) {
+		assert.Empty(t, det.Check(4, makeBlock(ts), 0, 0))
+		assert.Empty(t, det.Check(5, makeBlock(ts, 1000, 2000, 3000), 0, 0))
+	})
+
+	t.Run("Median fee is spiked", func(t *testing.T) {
+		alarms := det.Check(6, makeBlock(ts, 1000, 100000, 100000), 0, 0)
+		require.Len(t, alarms, 1)
+
+		assert.Equal(t, KindFeeSpike, alarms[0].Kind)
+		assert.Equal(t, uint32(6), alarms[0].Height)
+		assert.Equal(t, int64(100000), alarms[0].Value)
+		assert.Equal(t, int64(1019), alarms[0].Baseline)
+	})
+
+	t.Run("Disabled", func(t *testing.T) {
+		det.config.FeeSpikeFactor = 0
+
+		assert.Empty(t, det.Check(7, makeBlock(ts, 1e9), 0, 0))
+	})
+}
+
+func TestEmptyBlocks(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	conf := DefaultConfig()
+	conf.EmptyBlockStreak = 3
+	det := NewDetector(conf)
+
+	height := uint32(0)
+	check := func(blk *block.Block, pending int) []Alarm {
+		height++
+
+		return det.Check(height, blk, pending, 0)
+	}
+
+	t.Run("Empty blocks without pending transactions", func(t *testing.T) {
+		for i := 0; i < 5; i++ {
+			assert.Empty(t, check(makeBlock(ts), 0))
+		}
+	})
+
+	t.Run("Streak is broken", func(t *testing.T) {
+		assert.Empty(t, check(makeBlock(ts), 1))
+		assert.Empty(t, check(makeBlock(ts), 1))
+		assert.Empty(t, check(makeBlock(ts, 1000), 1))
+		assert.Empty(t, check(makeBlock(ts), 1))
+	})
+
+	t.Run("Streak reaches the threshold", func(t *testing.T) {
+		assert.Empty(t, check(makeBlock(ts), 1))
+
+		alarms := check(makeBlock(ts), 1)
+		require.Len(t, alarms, 1)
+		assert.Equal(t, KindEmptyBlocks, alarms[0].Kind)
+		assert.Equal(t, int64(3), alarms[0].Value)
+		assert.Equal(t, int64(3), alarms[0].Baseline)
+	})
+
+	t.Run("Alarm is repeated for every multiple of the threshold", func(t *testing.T) {
+		assert.Empty(t, check(makeBlock(ts), 1))
+		assert.Empty(t, check(makeBlock(ts), 1))
+
+		alarms := check(makeBlock(ts), 1)
+		require.Len(t, alarms, 1)
+		assert.Equal(t, int64(6), alarms[0].Value)
+	})
+}
+
+func TestCommitteeChurn(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	conf := DefaultConfig()
+	conf.CommitteeChurn = 5
+	det := NewDetector(conf)
+
+	assert.Empty(t, det.Check(1, makeBlock(ts), 0, 5))
+
+	alarms := det.Check(2, makeBlock(ts), 0, 6)
+	require.Len(t, alarms, 1)
+	assert.Equal(t, KindCommitteeChurn, alarms[0].Kind)
+	assert.Equal(t, int64(6), alarms[0].Value)
+	assert.Equal(t, int64(5), alarms[0].Baseline)
+	assert.Equal(t, "{committee-churn #2 6/5}", alarms[0].String())
+}
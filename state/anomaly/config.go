@@ -0,0 +1,45 @@
+package anomaly
+
+import "github.com/pactus-project/pactus/util/errors"
+
+// Config defines the thresholds of the anomaly detector.
+// Setting a threshold to zero disables its check.
+type Config struct {
+	// FeeSpikeFactor is how many times the median fee of a block can be more than
+	// the moving average of the recent blocks, before it is reported as a fee spike.
+	FeeSpikeFactor float64 `toml:"fee_spike_factor"`
+
+	// EmptyBlockStreak is the number of consecutive blocks that can be committed empty,
+	// while there are pending transactions in the pool.
+	EmptyBlockStreak uint32 `toml:"empty_block_streak"`
+
+	// CommitteeChurn is the number of validators that can join the committee in a single block.
+	CommitteeChurn int `toml:"committee_churn"`
+
+	// Private configs
+	FeeWindow int `toml:"-"`
+	Warmup    int `toml:"-"`
+}
+
+func DefaultConfig() *Config {
+	return &Config{
+		FeeSpikeFactor:   5,
+		EmptyBlockStreak: 30,
+		CommitteeChurn:   10,
+		FeeWindow:        100,
+		Warmup:           10,
+	}
+}
+
+// BasicCheck performs basic checks on the configuration.
+func (conf *Config) BasicCheck() error {
+	if conf.FeeSpikeFactor != 0 && conf.FeeSpikeFactor <= 1 {
+		return errors.Errorf(errors.ErrInvalidConfig, "fee spike factor should be more than one")
+	}
+
+	if conf.CommitteeChurn < 0 {
+		return errors.Errorf(errors.ErrInvalidConfig, "committee churn can't be negative")
+	}
+
+	return nil
+}
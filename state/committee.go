@@ -0,0 +1,81 @@
+package state
+
+import (
+	"fmt"
+
+	"github.com/pactus-project/pactus/committee"
+	"github.com/pactus-project/pactus/store"
+	"github.com/pactus-project/pactus/types/certificate"
+	"github.com/pactus-project/pactus/types/validator"
+)
+
+// blockCertificate returns the certificate of the block at the given height.
+// The certificate of a block is stored inside the next block,
+// except for the last block that its certificate is stored separately.
+func blockCertificate(str store.Reader, height uint32) (*certificate.Certificate, error) {
+	lastCert := str.LastCertificate()
+	if lastCert == nil || height == 0 || height > lastCert.Height() {
+		return nil, store.ErrNotFound
+	}
+
+	if height == lastCert.Height() {
+		return lastCert, nil
+	}
+
+	cb, err := str.Block(height + 1)
+	if err != nil {
+		return nil, err
+	}
+	nextBlock, err := cb.ToBlock()
+	if err != nil {
+		return nil, err
+	}
+
+	return nextBlock.PrevCertificate(), nil
+}
+
+// historicalCommittee reconstructs the committee that signed the block at the given height.
+// The members of the committee are the committers of the block certificate,
+// and the proposer of the block is used to restore the order of the proposers.
+// The validatorByNumber returns the validator as it was when the block was proposed.
+func historicalCommittee(str store.Reader, height uint32, committeeSize int,
+	validatorByNumber func(num int32) (*validator.Validator, error),
+) (committee.Committee, error) {
+	cert, err := blockCertificate(str, height)
+	if err != nil {
+		return nil, err
+	}
+
+	cb, err := str.Block(height)
+	if err != nil {
+		return nil, err
+	}
+	blk, err := cb.ToBlock()
+	if err != nil {
+		return nil, err
+	}
+
+	proposerIndex := -1
+	vals := make([]*validator.Validator, len(cert.Committers()))
+	for i, num := range cert.Committers() {
+		val, err := validatorByNumber(num)
+		if err != nil {
+			return nil, fmt.Errorf("unable to retrieve committee member %v: %w", num, err)
+		}
+		if blk.Header().ProposerAddress() == val.Address() {
+			proposerIndex = i
+		}
+		vals[i] = val
+	}
+
+	if proposerIndex == -1 {
+		return nil, fmt.Errorf("proposer of block %v is not in the committee", height)
+	}
+
+	// The block is proposed in the certificate round,
+	// so the proposer of the first round is moved back by the number of rounds.
+	proposerIndex = (proposerIndex + len(vals) -
+		(int(cert.Round()) % len(vals))) % len(vals)
+
+	return committee.NewCommittee(vals, committeeSize, vals[proposerIndex].Address())
+}
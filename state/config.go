@@ -0,0 +1,50 @@
+package state
+
+import (
+	"github.com/pactus-project/pactus/crypto"
+	"github.com/pactus-project/pactus/state/anomaly"
+	"github.com/pactus-project/pactus/util/errors"
+)
+
+type Config struct {
+	// PausedValidators are the addresses of the local validators that don't take part in the sortition,
+	// for example while the node is being migrated. They stay bonded, and the peers are informed by a hint.
+	PausedValidators []string `toml:"paused_validators"`
+
+	// Anomaly contains the thresholds of the anomaly detector.
+	Anomaly *anomaly.Config `toml:"anomaly"`
+}
+
+func DefaultConfig() *Config {
+	return &Config{
+		PausedValidators: []string{},
+		Anomaly:          anomaly.DefaultConfig(),
+	}
+}
+
+// BasicCheck performs basic checks on the configuration.
+func (conf *Config) BasicCheck() error {
+	for _, str := range conf.PausedValidators {
+		addr, err := crypto.AddressFromString(str)
+		if err != nil {
+			return errors.Errorf(errors.ErrInvalidConfig, "invalid paused validator %s: %v", str, err)
+		}
+		if !addr.IsValidatorAddress() {
+			return errors.Errorf(errors.ErrInvalidConfig, "paused validator %s is not a validator address", str)
+		}
+	}
+
+	return conf.Anomaly.BasicCheck()
+}
+
+// PausedValidatorAddrs returns the addresses of the paused validators.
+// It should be called after the basic check.
+func (conf *Config) PausedValidatorAddrs() []crypto.Address {
+	addrs := make([]crypto.Address, 0, len(conf.PausedValidators))
+	for _, str := range conf.PausedValidators {
+		addr, _ := crypto.AddressFromString(str)
+		addrs = append(addrs, addr)
+	}
+
+	return addrs
+}
@@ -0,0 +1,33 @@
+package state
+
+import (
+	"testing"
+
+	"github.com/pactus-project/pactus/crypto"
+	"github.com/pactus-project/pactus/util/testsuite"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConfigBasicCheck(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	conf := DefaultConfig()
+	assert.NoError(t, conf.BasicCheck())
+
+	conf.Anomaly.CommitteeChurn = -1
+	assert.Error(t, conf.BasicCheck())
+
+	conf = DefaultConfig()
+	conf.PausedValidators = []string{"invalid"}
+	assert.Error(t, conf.BasicCheck())
+
+	conf = DefaultConfig()
+	conf.PausedValidators = []string{ts.RandAccAddress().String()}
+	assert.Error(t, conf.BasicCheck())
+
+	valAddr := ts.RandValAddress()
+	conf = DefaultConfig()
+	conf.PausedValidators = []string{valAddr.String()}
+	assert.NoError(t, conf.BasicCheck())
+	assert.Equal(t, []crypto.Address{valAddr}, conf.PausedValidatorAddrs())
+}
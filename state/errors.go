@@ -3,6 +3,8 @@ package state
 import (
 	"fmt"
 
+	"github.com/pactus-project/pactus/crypto/hash"
+	"github.com/pactus-project/pactus/genesis"
 	"github.com/pactus-project/pactus/types/certificate"
 	"github.com/pactus-project/pactus/types/vote"
 )
@@ -27,3 +29,35 @@ func (e InvalidCertificateError) Error() string {
 	return fmt.Sprintf("invalid certificate for block %d",
 		e.Cert.Height())
 }
+
+// ChainHaltedError is returned when a block is committed or proposed
+// after the halt height.
+type ChainHaltedError struct {
+	Height uint32
+}
+
+func (e ChainHaltedError) Error() string {
+	return fmt.Sprintf("chain is halted at height %d", e.Height)
+}
+
+// GenesisMismatchError is returned when the stored chain doesn't belong to
+// the given genesis document, e.g. after a test network is reset.
+type GenesisMismatchError struct {
+	GenesisStateRoot hash.Hash
+	StoredStateRoot  hash.Hash
+}
+
+func (e GenesisMismatchError) Error() string {
+	return fmt.Sprintf("invalid genesis doc: expected state root %s, stored state root %s",
+		e.GenesisStateRoot, e.StoredStateRoot)
+}
+
+// NotSimnetError is returned when blocks are generated on demand on a network
+// other than the simnet.
+type NotSimnetError struct {
+	ChainType genesis.ChainType
+}
+
+func (e NotSimnetError) Error() string {
+	return fmt.Sprintf("blocks can only be generated on the simnet, not the %s", e.ChainType)
+}
@@ -2,41 +2,61 @@ package state
 
 import (
 	"github.com/pactus-project/pactus/crypto"
+	"github.com/pactus-project/pactus/crypto/bls"
 	"github.com/pactus-project/pactus/execution"
 	"github.com/pactus-project/pactus/sandbox"
 	"github.com/pactus-project/pactus/types/block"
+	"github.com/pactus-project/pactus/types/receipt"
 	"github.com/pactus-project/pactus/types/tx"
+	"github.com/pactus-project/pactus/types/tx/payload"
 	"github.com/pactus-project/pactus/util/errors"
 )
 
-func (st *state) executeBlock(b *block.Block, sb sandbox.Sandbox) error {
-	exe := execution.NewExecutor()
+// executeBlock executes the transactions of the block and returns their receipts.
+func (st *state) executeBlock(b *block.Block, sb sandbox.Sandbox) ([]*receipt.Receipt, error) {
+	if err := st.checkBlockWeight(b); err != nil {
+		return nil, err
+	}
+
+	if err := st.verifyProofsOfPossession(b); err != nil {
+		return nil, err
+	}
+
+	exe := st.engine.Executor()
 
 	var subsidyTrx *tx.Tx
+	rcpts := make([]*receipt.Receipt, 0, b.Transactions().Len())
 	for i, trx := range b.Transactions() {
 		// The first transaction should be subsidy transaction
 		isSubsidyTx := (i == 0)
 		if isSubsidyTx {
 			if !trx.IsSubsidyTx() {
-				return errors.Errorf(errors.ErrInvalidTx,
+				return nil, errors.Errorf(errors.ErrInvalidTx,
 					"first transaction should be a subsidy transaction")
 			}
 			subsidyTrx = trx
 		} else if trx.IsSubsidyTx() {
-			return errors.Errorf(errors.ErrInvalidTx,
+			return nil, errors.Errorf(errors.ErrInvalidTx,
 				"duplicated subsidy transaction")
 		}
 
-		err := exe.Execute(trx, sb)
+		recorder := newReceiptRecorder(sb)
+		err := exe.Execute(trx, recorder)
 		if err != nil {
-			return err
+			return nil, err
+		}
+		rcpts = append(rcpts, recorder.receipt(trx, sb.CurrentHeight(), i))
+
+		if isSubsidyTx {
+			// Pay the shares of the reward, if the proposer has a reward split
+			execution.SplitReward(sb, b.Header().ProposerAddress(), subsidyTrx)
 		}
 	}
 
 	accumulatedFee := sb.AccumulatedFee()
 	subsidyAmt := st.params.BlockReward + sb.AccumulatedFee()
 	if subsidyTrx.Payload().Value() != subsidyAmt {
-		return errors.Errorf(errors.ErrInvalidTx,
+		return nil, errors.Errorf(errors.ErrInvalidTx,
 			"invalid subsidy amount, expected %v, got %v", subsidyAmt, subsidyTrx.Payload().Value())
 	}
 
@@ -45,5 +65,52 @@ func (st *state) executeBlock(b *block.Block, sb sandbox.Sandbox) error {
 	acc.AddToBalance(accumulatedFee)
 	sb.UpdateAccount(crypto.TreasuryAddress, acc)
 
+	return rcpts, nil
+}
+
+// checkBlockWeight checks that the total weight of the non-subsidy transactions
+// doesn't exceed the maximum block weight.
+func (st *state) checkBlockWeight(b *block.Block) error {
+	if st.params.MaxBlockWeight == 0 {
+		return nil
+	}
+
+	blockWeight := int64(0)
+	for _, trx := range b.Transactions() {
+		if trx.IsSubsidyTx() {
+			continue
+		}
+		blockWeight += execution.TxWeight(trx)
+	}
+
+	if blockWeight > st.params.MaxBlockWeight {
+		return errors.Errorf(errors.ErrInvalidBlock,
+			"block weight exceeds the limit, maximum: %v, got: %v", st.params.MaxBlockWeight, blockWeight)
+	}
+
+	return nil
+}
+
+// verifyProofsOfPossession verifies the proofs of possession of the new validators in the block,
+// in a batch. The executor checks that the proof is set, when it is required.
+func (st *state) verifyProofsOfPossession(b *block.Block) error {
+	pubs := []*bls.PublicKey{}
+	pops := []*bls.Signature{}
+	for _, trx := range b.Transactions() {
+		if !trx.IsBondTx() {
+			continue
+		}
+		pld := trx.Payload().(*payload.BondPayload)
+		if pld.PublicKey != nil && pld.ProofOfPossession != nil {
+			pubs = append(pubs, pld.PublicKey)
+			pops = append(pops, pld.ProofOfPossession)
+		}
+	}
+
+	if err := bls.VerifyProofsOfPossession(pubs, pops); err != nil {
+		return errors.Errorf(errors.ErrInvalidBlock,
+			"invalid proof of possession: %v", err)
+	}
+
 	return nil
 }
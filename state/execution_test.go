@@ -4,10 +4,16 @@ import (
 	"testing"
 
 	"github.com/pactus-project/pactus/crypto"
+	"github.com/pactus-project/pactus/execution"
+	"github.com/pactus-project/pactus/sandbox"
 	"github.com/pactus-project/pactus/types/block"
 	"github.com/pactus-project/pactus/types/tx"
+	"github.com/pactus-project/pactus/types/tx/payload"
+	"github.com/pactus-project/pactus/types/validator"
 	"github.com/pactus-project/pactus/util"
+	"github.com/pactus-project/pactus/util/errors"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestProposeBlock(t *testing.T) {
@@ -21,13 +27,14 @@ func TestProposeBlock(t *testing.T) {
 	invBondTx, _ := td.GenerateTestBondTx()
 	invSortitionTx, _ := td.GenerateTestSortitionTx()
 
-	pub, _ := td.RandBLSKeyPair()
+	pub, prv := td.RandBLSKeyPair()
 	validTrx1 := tx.NewTransferTx(lockTime, td.genAccKey.PublicKeyNative().AccountAddress(),
 		td.RandAccAddress(), 1, 1000, "")
 	td.HelperSignTransaction(td.genAccKey, validTrx1)
 
 	validTrx2 := tx.NewBondTx(lockTime, td.genAccKey.PublicKeyNative().AccountAddress(),
 		pub.ValidatorAddress(), pub, 1000000000, 100000, "")
+	td.HelperSetProofOfPossession(prv, validTrx2)
 	td.HelperSignTransaction(td.genAccKey, validTrx2)
 
 	assert.NoError(t, td.state.AddPendingTx(invTransferTx))
@@ -76,7 +83,8 @@ func TestExecuteBlock(t *testing.T) {
 			td.state.lastInfo.SortitionSeed(), proposerAddr)
 		sb := td.state.concreteSandbox()
 
-		assert.Error(t, td.state.executeBlock(invBlock, sb))
+		_, err := td.state.executeBlock(invBlock, sb)
+		assert.Error(t, err)
 	})
 
 	t.Run("Has invalid tx", func(t *testing.T) {
@@ -88,7 +96,8 @@ func TestExecuteBlock(t *testing.T) {
 			td.state.lastInfo.SortitionSeed(), proposerAddr)
 		sb := td.state.concreteSandbox()
 
-		assert.Error(t, td.state.executeBlock(invBlock, sb))
+		_, err := td.state.executeBlock(invBlock, sb)
+		assert.Error(t, err)
 	})
 
 	t.Run("Subsidy is not first tx", func(t *testing.T) {
@@ -100,7 +109,8 @@ func TestExecuteBlock(t *testing.T) {
 			td.state.lastInfo.SortitionSeed(), proposerAddr)
 		sb := td.state.concreteSandbox()
 
-		assert.Error(t, td.state.executeBlock(invBlock, sb))
+		_, err := td.state.executeBlock(invBlock, sb)
+		assert.Error(t, err)
 	})
 
 	t.Run("Has no subsidy", func(t *testing.T) {
@@ -111,7 +121,8 @@ func TestExecuteBlock(t *testing.T) {
 			td.state.lastInfo.SortitionSeed(), proposerAddr)
 		sb := td.state.concreteSandbox()
 
-		assert.Error(t, td.state.executeBlock(invBlock, sb))
+		_, err := td.state.executeBlock(invBlock, sb)
+		assert.Error(t, err)
 	})
 
 	t.Run("Two subsidy transactions", func(t *testing.T) {
@@ -123,7 +134,28 @@ func TestExecuteBlock(t *testing.T) {
 			td.state.lastInfo.SortitionSeed(), proposerAddr)
 		sb := td.state.concreteSandbox()
 
-		assert.Error(t, td.state.executeBlock(invBlock, sb))
+		_, err := td.state.executeBlock(invBlock, sb)
+		assert.Error(t, err)
+	})
+
+	t.Run("Invalid proof of possession", func(t *testing.T) {
+		pub, _ := td.RandBLSKeyPair()
+		_, otherPrv := td.RandBLSKeyPair()
+		bondTx := tx.NewBondTx(1, td.genAccKey.PublicKeyNative().AccountAddress(),
+			pub.ValidatorAddress(), pub, 1000000000, 100000, "")
+		td.HelperSetProofOfPossession(otherPrv, bondTx)
+		td.HelperSignTransaction(td.genAccKey, bondTx)
+
+		txs := block.NewTxs()
+		txs.Append(validSubsidyTx)
+		txs.Append(bondTx)
+		invBlock := block.MakeBlock(1, util.Now(), txs, td.state.lastInfo.BlockHash(),
+			td.state.stateRoot(), td.state.lastInfo.Certificate(),
+			td.state.lastInfo.SortitionSeed(), proposerAddr)
+		sb := td.state.concreteSandbox()
+
+		_, err := td.state.executeBlock(invBlock, sb)
+		assert.ErrorContains(t, err, "invalid proof of possession")
 	})
 
 	t.Run("OK", func(t *testing.T) {
@@ -134,11 +166,151 @@ func TestExecuteBlock(t *testing.T) {
 			td.state.stateRoot(), td.state.lastInfo.Certificate(),
 			td.state.lastInfo.SortitionSeed(), proposerAddr)
 		sb := td.state.concreteSandbox()
-		assert.NoError(t, td.state.executeBlock(invBlock, sb))
+		_, err := td.state.executeBlock(invBlock, sb)
+		assert.NoError(t, err)
 
 		// Check if fee is claimed
 		treasury := sb.Account(crypto.TreasuryAddress)
 		subsidy := td.state.params.BlockReward
 		assert.Equal(t, treasury.Balance(), 21*1e15-(12*subsidy)) // Two blocks has committed yet
 	})
+
+	t.Run("Reward split of the proposer", func(t *testing.T) {
+		shareAddr := td.RandAccAddress()
+		proposer := td.state.Proposer(0)
+
+		txs := block.NewTxs()
+		txs.Append(validSubsidyTx)
+		txs.Append(validTx1)
+		blk := block.MakeBlock(1, util.Now(), txs, td.state.lastInfo.BlockHash(),
+			td.state.stateRoot(), td.state.lastInfo.Certificate(),
+			td.state.lastInfo.SortitionSeed(), proposer.Address())
+		sb := td.state.concreteSandbox()
+		val := sb.Validator(proposer.Address())
+		val.SetRewardSplit(&validator.RewardSplit{
+			Shares: []validator.RewardShare{{Address: shareAddr, Percent: 40}},
+		})
+		sb.UpdateValidator(val)
+
+		_, err := td.state.executeBlock(blk, sb)
+		require.NoError(t, err)
+
+		reward := validSubsidyTx.Payload().Value()
+		assert.Equal(t, reward*40/100, sb.Account(shareAddr).Balance())
+		assert.Equal(t, reward-reward*40/100, sb.Account(rewardAddr).Balance())
+	})
+
+	t.Run("Spending the shares of the reward in the same block", func(t *testing.T) {
+		rewardPub, rewardPrv := td.RandBLSKeyPair()
+		rewardAddr := rewardPub.AccountAddress()
+		shareAddr := td.RandAccAddress()
+		proposer := td.state.Proposer(0)
+
+		// The shares are paid when the reward is credited,
+		// so the reward address can't spend more than its own share.
+		makeBlock := func(amt int64) *block.Block {
+			fee, _ := td.state.CalculateFee(amt, payload.TypeTransfer)
+			spendTx := tx.NewTransferTx(1, rewardAddr, td.RandAccAddress(), amt, fee, "")
+			td.HelperSignTransaction(rewardPrv, spendTx)
+
+			txs := block.NewTxs()
+			txs.Append(td.state.createSubsidyTx(rewardAddr, fee))
+			txs.Append(spendTx)
+
+			return block.MakeBlock(1, util.Now(), txs, td.state.lastInfo.BlockHash(),
+				td.state.stateRoot(), td.state.lastInfo.Certificate(),
+				td.state.lastInfo.SortitionSeed(), proposer.Address())
+		}
+		splitSandbox := func() sandbox.Sandbox {
+			sb := td.state.concreteSandbox()
+			val := sb.Validator(proposer.Address())
+			val.SetRewardSplit(&validator.RewardSplit{
+				Shares: []validator.RewardShare{{Address: shareAddr, Percent: 40}},
+			})
+			sb.UpdateValidator(val)
+
+			return sb
+		}
+
+		_, err := td.state.executeBlock(makeBlock(td.state.params.BlockReward*80/100), splitSandbox())
+		assert.Equal(t, errors.ErrInsufficientFunds, errors.Code(err))
+
+		sb := splitSandbox()
+		blk := makeBlock(td.state.params.BlockReward * 50 / 100)
+		_, err = td.state.executeBlock(blk, sb)
+		require.NoError(t, err)
+		reward := blk.Transactions()[0].Payload().Value()
+		assert.Equal(t, reward*40/100, sb.Account(shareAddr).Balance())
+		assert.GreaterOrEqual(t, sb.Account(rewardAddr).Balance(), int64(0))
+	})
+}
+
+// permissionedEngine is an execution engine that only accepts the transactions of the permitted signers.
+type permissionedEngine struct {
+	execution.Engine
+	permitted map[crypto.Address]bool
+}
+
+type permissionedExecutor struct {
+	execution.Executor
+	permitted map[crypto.Address]bool
+}
+
+func (e *permissionedEngine) Executor() execution.Executor {
+	return &permissionedExecutor{Executor: e.Engine.Executor(), permitted: e.permitted}
+}
+
+func (e *permissionedEngine) Checker() execution.Executor {
+	return &permissionedExecutor{Executor: e.Engine.Checker(), permitted: e.permitted}
+}
+
+func (e *permissionedExecutor) Execute(trx *tx.Tx, sb sandbox.Sandbox) error {
+	if !trx.IsSubsidyTx() && !e.permitted[trx.Payload().Signer()] {
+		return errors.Errorf(errors.ErrInvalidTx, "signer is not permitted")
+	}
+
+	return e.Executor.Execute(trx, sb)
+}
+
+func TestExecutionEngine(t *testing.T) {
+	td := setup(t)
+
+	engine := &permissionedEngine{
+		Engine:    execution.DefaultEngine(),
+		permitted: map[crypto.Address]bool{},
+	}
+	newState, err := LoadOrNewState(DefaultConfig(), td.state.genDoc, td.state.valKeys,
+		td.state.store, td.commonTxPool, nil, WithEngine(engine))
+	require.NoError(t, err)
+	td.state = newState.(*state)
+	assert.Equal(t, engine, td.state.engine)
+
+	lockTime := td.state.LastBlockHeight()
+	trx := tx.NewTransferTx(lockTime, td.genAccKey.PublicKeyNative().AccountAddress(),
+		td.RandAccAddress(), 1, 1000, "")
+	td.HelperSignTransaction(td.genAccKey, trx)
+	assert.NoError(t, td.state.AddPendingTx(trx))
+
+	t.Run("Signer is not permitted", func(t *testing.T) {
+		blk, _ := td.makeBlockAndCertificate(t, 0)
+		assert.Equal(t, 1, blk.Transactions().Len())
+
+		txs := block.NewTxs()
+		txs.Append(blk.Transactions()[0])
+		txs.Append(trx)
+		invBlock := block.MakeBlock(1, util.Now(), txs, td.state.lastInfo.BlockHash(),
+			td.state.stateRoot(), td.state.lastInfo.Certificate(),
+			td.state.lastInfo.SortitionSeed(), td.RandAccAddress())
+
+		_, err := td.state.executeBlock(invBlock, td.state.concreteSandbox())
+		assert.Error(t, err)
+	})
+
+	t.Run("Signer is permitted", func(t *testing.T) {
+		engine.permitted[trx.Payload().Signer()] = true
+
+		blk, cert := td.makeBlockAndCertificate(t, 0)
+		assert.Equal(t, block.Txs{trx}, blk.Transactions()[1:])
+		assert.NoError(t, td.state.CommitBlock(blk, cert))
+	})
 }
@@ -3,19 +3,28 @@ package state
 import (
 	"time"
 
+	"github.com/pactus-project/pactus/committee"
 	"github.com/pactus-project/pactus/crypto"
 	"github.com/pactus-project/pactus/crypto/bls"
 	"github.com/pactus-project/pactus/crypto/hash"
 	"github.com/pactus-project/pactus/genesis"
+	"github.com/pactus-project/pactus/state/reward"
+	"github.com/pactus-project/pactus/state/statistics"
 	"github.com/pactus-project/pactus/store"
+	"github.com/pactus-project/pactus/txpool"
 	"github.com/pactus-project/pactus/types/account"
 	"github.com/pactus-project/pactus/types/block"
 	"github.com/pactus-project/pactus/types/certificate"
+	"github.com/pactus-project/pactus/types/halt"
 	"github.com/pactus-project/pactus/types/param"
+	"github.com/pactus-project/pactus/types/participation"
+	"github.com/pactus-project/pactus/types/receipt"
 	"github.com/pactus-project/pactus/types/tx"
 	"github.com/pactus-project/pactus/types/tx/payload"
 	"github.com/pactus-project/pactus/types/validator"
 	"github.com/pactus-project/pactus/types/vote"
+	"github.com/pactus-project/pactus/util/bloom"
+	"github.com/pactus-project/pactus/util/gcs"
 )
 
 type Facade interface {
@@ -25,10 +34,13 @@ type Facade interface {
 	LastBlockTime() time.Time
 	LastCertificate() *certificate.Certificate
 	UpdateLastCertificate(v *vote.Vote) error
-	ProposeBlock(valKey *bls.ValidatorKey, rewardAddr crypto.Address) (*block.Block, error)
+	ProposeBlock(signer bls.Signer, rewardAddr crypto.Address) (*block.Block, error)
 	ValidateBlock(blk *block.Block, round int16) error
 	CommitBlock(blk *block.Block, cert *certificate.Certificate) error
+	GenerateBlocks(count int, rewardAddr crypto.Address) ([]hash.Hash, error)
 	CommitteeValidators() []*validator.Validator
+	CommitteeAt(height uint32) (committee.Reader, error)
+	ParticipationReport(addr crypto.Address, from, to uint32) (*participation.Report, error)
 	IsInCommittee(addr crypto.Address) bool
 	Proposer(round int16) *validator.Validator
 	IsProposer(addr crypto.Address, round int16) bool
@@ -37,20 +49,41 @@ type Facade interface {
 	TotalAccounts() int32
 	TotalValidators() int32
 	CommitteePower() int64
+	MissedSortitions() int64
 	PendingTx(id tx.ID) *tx.Tx
+	PendingTxsCount() int
+	PendingTxsSnapshot() (uint64, []tx.ID)
+	PendingTxsChangesSince(seq uint64) ([]txpool.Change, uint64, bool)
 	AddPendingTx(trx *tx.Tx) error
 	AddPendingTxAndBroadcast(trx *tx.Tx) error
 	CommittedBlock(height uint32) *store.CommittedBlock
+	HeaderProof(height uint32) *store.HeaderProof
 	CommittedTx(id tx.ID) *store.CommittedTx
+	TransactionReceipt(id tx.ID) *receipt.Receipt
 	BlockHash(height uint32) hash.Hash
+	BlockBloom(height uint32) *bloom.Filter
+	Beacon(height uint32) hash.Hash
+	CompactFilter(height uint32) *gcs.Filter
+	StoreFamilies() []store.FamilyInfo
 	BlockHeight(h hash.Hash) uint32
 	AccountByAddress(addr crypto.Address) *account.Account
 	ValidatorByAddress(addr crypto.Address) *validator.Validator
 	ValidatorByNumber(number int32) *validator.Validator
 	ValidatorAddresses() []crypto.Address
+	SnapshotHeight(stateRoot hash.Hash) (uint32, error)
+	AccountByAddressAt(addr crypto.Address, height uint32) (*account.Account, error)
+	ValidatorByAddressAt(addr crypto.Address, height uint32) (*validator.Validator, error)
+	ValidatorByNumberAt(number int32, height uint32) (*validator.Validator, error)
 	Params() *param.Params
 	Close() error
 	CalculateFee(amount int64, payloadType payload.Type) (int64, error)
 	PublicKey(addr crypto.Address) (crypto.PublicKey, error)
 	AvailabilityScore(valNum int32) float64
+	PausedValidators() []crypto.Address
+	ChainStatistics(window uint32) *statistics.Statistics
+	FeeHistogram(from, to uint32) (*FeeHistogram, error)
+	RewardInfo(height uint32) *reward.Info
+	Halt(ord *halt.Order) error
+	Resume(rsm *halt.Resume) error
+	HaltHeight() uint32
 }
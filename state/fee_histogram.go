@@ -0,0 +1,45 @@
+package state
+
+import (
+	"github.com/pactus-project/pactus/store"
+	"github.com/pactus-project/pactus/util/errors"
+	"github.com/pactus-project/pactus/util/feehist"
+)
+
+// MaxFeeHistogramRange is the maximum number of blocks that a fee histogram can cover.
+// It is about 30 days with 10-second blocks.
+const MaxFeeHistogramRange = 259200
+
+// FeeHistogram is the histogram of the transaction fees in a range of the blocks.
+type FeeHistogram struct {
+	FromHeight uint32
+	ToHeight   uint32
+	Blocks     uint32 // Number of the blocks in the range that are indexed, the pruned blocks are not
+	Histogram  *feehist.Histogram
+}
+
+// feeHistogram merges the fee histograms of the blocks from the given height up to the given height, inclusive.
+func feeHistogram(str store.Reader, from, to uint32) (*FeeHistogram, error) {
+	lastCert := str.LastCertificate()
+	if lastCert == nil || from == 0 || from > to || to > lastCert.Height() {
+		return nil, errors.Errorf(errors.ErrInvalidHeight, "invalid range: %d-%d", from, to)
+	}
+	if to-from+1 > MaxFeeHistogramRange {
+		return nil, errors.Errorf(errors.ErrInvalidHeight,
+			"range is more than %d blocks", MaxFeeHistogramRange)
+	}
+
+	res := &FeeHistogram{
+		FromHeight: from,
+		ToHeight:   to,
+		Histogram:  feehist.New(),
+	}
+	str.IterateFeeHistograms(from, to, func(_ uint32, hist *feehist.Histogram) bool {
+		res.Blocks++
+		res.Histogram.Merge(hist)
+
+		return false
+	})
+
+	return res, nil
+}
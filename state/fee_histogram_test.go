@@ -0,0 +1,46 @@
+package state
+
+import (
+	"testing"
+
+	"github.com/pactus-project/pactus/util/errors"
+	"github.com/pactus-project/pactus/util/feehist"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFeeHistogram(t *testing.T) {
+	td := setup(t)
+
+	lastHeight := td.state.LastBlockHeight()
+	expected := feehist.New()
+	for height := uint32(1); height <= lastHeight; height++ {
+		blk, _ := td.state.CommittedBlock(height).ToBlock()
+		for _, trx := range blk.Transactions() {
+			if !trx.IsSubsidyTx() {
+				expected.Add(trx.Fee())
+			}
+		}
+	}
+
+	t.Run("Whole chain", func(t *testing.T) {
+		res, err := td.state.FeeHistogram(1, lastHeight)
+		require.NoError(t, err)
+
+		assert.Equal(t, uint32(1), res.FromHeight)
+		assert.Equal(t, lastHeight, res.ToHeight)
+		assert.Equal(t, lastHeight, res.Blocks)
+		assert.Equal(t, expected.Buckets(), res.Histogram.Buckets())
+	})
+
+	t.Run("Invalid ranges", func(t *testing.T) {
+		_, err := td.state.FeeHistogram(0, lastHeight)
+		assert.Equal(t, errors.ErrInvalidHeight, errors.Code(err))
+
+		_, err = td.state.FeeHistogram(lastHeight, lastHeight-1)
+		assert.Equal(t, errors.ErrInvalidHeight, errors.Code(err))
+
+		_, err = td.state.FeeHistogram(1, lastHeight+1)
+		assert.Equal(t, errors.ErrInvalidHeight, errors.Code(err))
+	})
+}
@@ -0,0 +1,146 @@
+package state
+
+import (
+	"fmt"
+
+	"github.com/pactus-project/pactus/types/halt"
+	"github.com/pactus-project/pactus/types/validator"
+)
+
+// Halt schedules halting the chain at the height of the order.
+// The order should be signed by the committee at its committee height.
+// Once the halt height is committed, no more blocks are committed or proposed
+// until the chain is resumed.
+func (st *state) Halt(ord *halt.Order) error {
+	st.lk.Lock()
+	defer st.lk.Unlock()
+
+	if err := ord.BasicCheck(); err != nil {
+		return err
+	}
+
+	if ord.Height() < st.lastInfo.BlockHeight() {
+		return fmt.Errorf("halt height %d is already committed, last height: %d",
+			ord.Height(), st.lastInfo.BlockHeight())
+	}
+
+	vals, err := st.committeeValidatorsAt(ord.CommitteeHeight())
+	if err != nil {
+		return err
+	}
+
+	if err := ord.Verify(vals); err != nil {
+		return err
+	}
+
+	st.haltOrder = ord
+	st.logger.Warn("chain halt is scheduled", "height", ord.Height(), "reason", ord.Reason())
+
+	return nil
+}
+
+// Resume clears the halt, if the state root at the halt height matches
+// the state root of the resume artifact.
+func (st *state) Resume(rsm *halt.Resume) error {
+	st.lk.Lock()
+	defer st.lk.Unlock()
+
+	if err := rsm.BasicCheck(); err != nil {
+		return err
+	}
+
+	if st.haltOrder == nil {
+		return fmt.Errorf("chain is not halted")
+	}
+
+	if rsm.Height() != st.haltOrder.Height() {
+		return fmt.Errorf("resume height is not same as the halt height, expected %d, got %d",
+			st.haltOrder.Height(), rsm.Height())
+	}
+
+	if st.lastInfo.BlockHeight() != rsm.Height() {
+		return fmt.Errorf("state is not at the halt height, expected %d, got %d",
+			rsm.Height(), st.lastInfo.BlockHeight())
+	}
+
+	if st.stateRoot() != rsm.StateRoot() {
+		return fmt.Errorf("state root is not same as the resume state root, expected %s, got %s",
+			rsm.StateRoot(), st.stateRoot())
+	}
+
+	vals, err := st.committeeValidatorsAt(rsm.CommitteeHeight())
+	if err != nil {
+		return err
+	}
+
+	if err := rsm.Verify(vals); err != nil {
+		return err
+	}
+
+	st.haltOrder = nil
+	st.logger.Warn("chain is resumed", "height", rsm.Height(), "state_root", rsm.StateRoot())
+
+	return nil
+}
+
+// HaltHeight returns the height that the chain is halted at,
+// or zero if no halt is scheduled.
+func (st *state) HaltHeight() uint32 {
+	st.lk.RLock()
+	defer st.lk.RUnlock()
+
+	return st.haltHeight()
+}
+
+func (st *state) haltHeight() uint32 {
+	if st.haltOrder == nil {
+		return 0
+	}
+
+	return st.haltOrder.Height()
+}
+
+// checkHalt returns an error if the given height is after the halt height.
+func (st *state) checkHalt(height uint32) error {
+	haltHeight := st.haltHeight()
+	if haltHeight != 0 && height > haltHeight {
+		return ChainHaltedError{Height: haltHeight}
+	}
+
+	return nil
+}
+
+// committeeValidatorsAt returns the committee members that committed
+// the block at the given height.
+func (st *state) committeeValidatorsAt(height uint32) ([]*validator.Validator, error) {
+	lastHeight := st.lastInfo.BlockHeight()
+	if height > lastHeight {
+		return nil, fmt.Errorf("committee height %d is not committed yet, last height: %d",
+			height, lastHeight)
+	}
+
+	cert := st.lastInfo.Certificate()
+	if height < lastHeight {
+		// The certificate of a block is kept in the next block.
+		cb, err := st.store.Block(height + 1)
+		if err != nil {
+			return nil, err
+		}
+		blk, err := cb.ToBlock()
+		if err != nil {
+			return nil, err
+		}
+		cert = blk.PrevCertificate()
+	}
+
+	vals := make([]*validator.Validator, 0, len(cert.Committers()))
+	for _, num := range cert.Committers() {
+		val, err := st.store.ValidatorByNumber(num)
+		if err != nil {
+			return nil, err
+		}
+		vals = append(vals, val)
+	}
+
+	return vals, nil
+}
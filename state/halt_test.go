@@ -0,0 +1,128 @@
+package state
+
+import (
+	"testing"
+
+	"github.com/pactus-project/pactus/crypto/bls"
+	"github.com/pactus-project/pactus/types/halt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func (td *testData) makeHaltOrder(t *testing.T, height, committeeHeight uint32) *halt.Order {
+	t.Helper()
+
+	ord := halt.NewOrder(height, "emergency", committeeHeight, []int32{0, 1, 2, 3})
+	for _, key := range td.genValKeys[:3] {
+		ord.Sign(key)
+	}
+
+	return ord
+}
+
+func (td *testData) makeResume(t *testing.T, height uint32) *halt.Resume {
+	t.Helper()
+
+	rsm := halt.NewResume(height, td.state.stateRoot(), height, []int32{0, 1, 2, 3})
+	for _, key := range td.genValKeys[:3] {
+		rsm.Sign(key)
+	}
+
+	return rsm
+}
+
+func TestHaltAndResume(t *testing.T) {
+	td := setup(t)
+
+	lastHeight := td.state.LastBlockHeight()
+	haltHeight := lastHeight + 2
+	assert.Zero(t, td.state.HaltHeight())
+
+	ord := td.makeHaltOrder(t, haltHeight, lastHeight-5)
+	require.NoError(t, td.state.Halt(ord))
+	assert.Equal(t, haltHeight, td.state.HaltHeight())
+
+	td.commitBlocks(t, 2)
+	assert.Equal(t, haltHeight, td.state.LastBlockHeight())
+
+	_, err := td.state.ProposeBlock(bls.NewKeySigner(td.genValKeys[0]), td.RandAccAddress())
+	assert.ErrorIs(t, err, ChainHaltedError{Height: haltHeight})
+
+	// The block is made by another node that is not halted.
+	blk, _ := td.GenerateTestBlock(haltHeight + 1)
+	cert := td.makeCertificateAndSign(t, blk.Hash(), 0)
+	err = td.state.CommitBlock(blk, cert)
+	assert.ErrorIs(t, err, ChainHaltedError{Height: haltHeight})
+	assert.Equal(t, haltHeight, td.state.LastBlockHeight())
+
+	t.Run("Resume with invalid state root", func(t *testing.T) {
+		rsm := halt.NewResume(haltHeight, td.RandHash(), haltHeight, []int32{0, 1, 2, 3})
+		for _, key := range td.genValKeys[:3] {
+			rsm.Sign(key)
+		}
+		assert.Error(t, td.state.Resume(rsm))
+		assert.Equal(t, haltHeight, td.state.HaltHeight())
+	})
+
+	t.Run("Resume with invalid height", func(t *testing.T) {
+		rsm := td.makeResume(t, haltHeight-1)
+		assert.Error(t, td.state.Resume(rsm))
+	})
+
+	t.Run("Resume without enough signatures", func(t *testing.T) {
+		rsm := halt.NewResume(haltHeight, td.state.stateRoot(), haltHeight, []int32{0, 1, 2, 3})
+		rsm.Sign(td.genValKeys[0])
+		assert.Error(t, td.state.Resume(rsm))
+	})
+
+	rsm := td.makeResume(t, haltHeight)
+	require.NoError(t, td.state.Resume(rsm))
+	assert.Zero(t, td.state.HaltHeight())
+
+	td.commitBlocks(t, 1)
+	assert.Equal(t, haltHeight+1, td.state.LastBlockHeight())
+
+	t.Run("Chain is not halted", func(t *testing.T) {
+		assert.Error(t, td.state.Resume(td.makeResume(t, td.state.LastBlockHeight())))
+	})
+}
+
+func TestHaltInvalidOrder(t *testing.T) {
+	td := setup(t)
+
+	lastHeight := td.state.LastBlockHeight()
+
+	t.Run("Halt height is committed before", func(t *testing.T) {
+		ord := td.makeHaltOrder(t, lastHeight-1, lastHeight-2)
+		assert.Error(t, td.state.Halt(ord))
+	})
+
+	t.Run("Committee height is not committed yet", func(t *testing.T) {
+		ord := td.makeHaltOrder(t, lastHeight+10, lastHeight+1)
+		assert.Error(t, td.state.Halt(ord))
+	})
+
+	t.Run("Not enough signatures", func(t *testing.T) {
+		ord := halt.NewOrder(lastHeight+10, "emergency", lastHeight, []int32{0, 1, 2, 3})
+		ord.Sign(td.genValKeys[0])
+		ord.Sign(td.genValKeys[1])
+		assert.Error(t, td.state.Halt(ord))
+	})
+
+	t.Run("Unexpected committers", func(t *testing.T) {
+		ord := halt.NewOrder(lastHeight+10, "emergency", lastHeight, []int32{0, 1, 2})
+		for _, key := range td.genValKeys[:3] {
+			ord.Sign(key)
+		}
+		assert.Error(t, td.state.Halt(ord))
+	})
+
+	t.Run("Invalid order", func(t *testing.T) {
+		ord := td.makeHaltOrder(t, lastHeight+10, lastHeight)
+		ord2 := halt.NewOrder(ord.Height(), "", ord.CommitteeHeight(), ord.Committers())
+		assert.Error(t, td.state.Halt(ord2))
+	})
+
+	assert.Zero(t, td.state.HaltHeight())
+	td.commitBlocks(t, 1)
+}
@@ -0,0 +1,52 @@
+package state
+
+import (
+	"sort"
+	"time"
+)
+
+// medianTime keeps the times of the recent blocks,
+// to validate the new block times against the median time past,
+// instead of relying only on the time of the last block and the local clock.
+type medianTime struct {
+	span  int
+	times []time.Time
+}
+
+func newMedianTime(span int) *medianTime {
+	return &medianTime{
+		span:  span,
+		times: make([]time.Time, 0, span),
+	}
+}
+
+// addBlockTime adds the time of a committed block, pruning the oldest one if needed.
+func (mt *medianTime) addBlockTime(t time.Time) {
+	if len(mt.times) == mt.span {
+		mt.times = mt.times[1:]
+	}
+	mt.times = append(mt.times, t)
+}
+
+// medianTimePast returns the median time of the recent blocks,
+// and the number of the recent blocks that are committed after the median one.
+func (mt *medianTime) medianTimePast() (time.Time, int) {
+	sorted := make([]time.Time, len(mt.times))
+	copy(sorted, mt.times)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Before(sorted[j])
+	})
+
+	mid := len(sorted) / 2
+
+	return sorted[mid], len(sorted) - mid - 1
+}
+
+// expectedTime returns the expected time of the next block, if the blocks after
+// the median one are committed on time.
+// It smooths the block time, so a single block with a skewed time can't move it.
+func (mt *medianTime) expectedTime(interval time.Duration) time.Time {
+	median, after := mt.medianTimePast()
+
+	return median.Add(time.Duration(after+1) * interval)
+}
@@ -0,0 +1,35 @@
+package state
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMedianTimePast(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	interval := 10 * time.Second
+	mt := newMedianTime(5)
+
+	mt.addBlockTime(start)
+	median, after := mt.medianTimePast()
+	assert.Equal(t, start, median)
+	assert.Zero(t, after)
+	assert.Equal(t, start.Add(interval), mt.expectedTime(interval))
+
+	for i := 1; i < 8; i++ {
+		mt.addBlockTime(start.Add(time.Duration(i) * interval))
+	}
+
+	// Only the last 5 block times are kept.
+	assert.Len(t, mt.times, 5)
+	median, after = mt.medianTimePast()
+	assert.Equal(t, start.Add(50*time.Second), median)
+	assert.Equal(t, 2, after)
+	assert.Equal(t, start.Add(80*time.Second), mt.expectedTime(interval))
+
+	// A skewed block time doesn't move the expected time.
+	mt.addBlockTime(start.Add(10 * time.Minute))
+	assert.Equal(t, start.Add(90*time.Second), mt.expectedTime(interval))
+}
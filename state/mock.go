@@ -10,17 +10,26 @@ import (
 	"github.com/pactus-project/pactus/crypto/bls"
 	"github.com/pactus-project/pactus/crypto/hash"
 	"github.com/pactus-project/pactus/genesis"
+	"github.com/pactus-project/pactus/state/reward"
+	"github.com/pactus-project/pactus/state/snapshot"
+	"github.com/pactus-project/pactus/state/statistics"
 	"github.com/pactus-project/pactus/store"
 	"github.com/pactus-project/pactus/txpool"
 	"github.com/pactus-project/pactus/types/account"
 	"github.com/pactus-project/pactus/types/block"
 	"github.com/pactus-project/pactus/types/certificate"
+	"github.com/pactus-project/pactus/types/halt"
 	"github.com/pactus-project/pactus/types/param"
+	"github.com/pactus-project/pactus/types/participation"
+	"github.com/pactus-project/pactus/types/receipt"
 	"github.com/pactus-project/pactus/types/tx"
 	"github.com/pactus-project/pactus/types/tx/payload"
 	"github.com/pactus-project/pactus/types/validator"
 	"github.com/pactus-project/pactus/types/vote"
+	"github.com/pactus-project/pactus/util"
+	"github.com/pactus-project/pactus/util/bloom"
 	"github.com/pactus-project/pactus/util/errors"
+	"github.com/pactus-project/pactus/util/gcs"
 	"github.com/pactus-project/pactus/util/testsuite"
 )
 
@@ -37,6 +46,9 @@ type MockState struct {
 	TestCommittee committee.Committee
 	TestValKeys   []*bls.ValidatorKey
 	TestParams    *param.Params
+	TestHaltOrder *halt.Order
+	TestMissed    int64
+	TestPaused    []crypto.Address
 }
 
 func MockingState(ts *testsuite.TestSuite) *MockState {
@@ -112,12 +124,32 @@ func (m *MockState) CommitBlock(b *block.Block, cert *certificate.Certificate) e
 	return nil
 }
 
+func (m *MockState) GenerateBlocks(count int, _ crypto.Address) ([]hash.Hash, error) {
+	if chainType := m.TestGenesis.ChainType(); chainType != genesis.Simnet {
+		return nil, NotSimnetError{ChainType: chainType}
+	}
+
+	hashes := make([]hash.Hash, 0, count)
+	for i := 0; i < count; i++ {
+		height := m.LastBlockHeight() + 1
+		blk, cert := m.ts.GenerateTestBlock(height)
+
+		m.lk.Lock()
+		m.TestStore.SaveBlock(blk, cert)
+		m.lk.Unlock()
+
+		hashes = append(hashes, blk.Hash())
+	}
+
+	return hashes, nil
+}
+
 func (m *MockState) Close() error {
 	return nil
 }
 
-func (m *MockState) ProposeBlock(valKey *bls.ValidatorKey, _ crypto.Address) (*block.Block, error) {
-	blk, _ := m.ts.GenerateTestBlockWithProposer(m.TestStore.LastHeight, valKey.Address())
+func (m *MockState) ProposeBlock(signer bls.Signer, _ crypto.Address) (*block.Block, error) {
+	blk, _ := m.ts.GenerateTestBlockWithProposer(m.TestStore.LastHeight, signer.PublicKey().ValidatorAddress())
 
 	return blk, nil
 }
@@ -130,6 +162,21 @@ func (m *MockState) CommitteeValidators() []*validator.Validator {
 	return m.TestCommittee.Validators()
 }
 
+func (m *MockState) CommitteeAt(height uint32) (committee.Reader, error) {
+	m.lk.RLock()
+	defer m.lk.RUnlock()
+
+	if height == 0 || height > m.TestStore.LastHeight {
+		return nil, store.ErrNotFound
+	}
+
+	return m.TestCommittee, nil
+}
+
+func (m *MockState) ParticipationReport(addr crypto.Address, from, to uint32) (*participation.Report, error) {
+	return participationReport(m.TestStore, addr, from, to)
+}
+
 func (m *MockState) IsInCommittee(addr crypto.Address) bool {
 	return m.TestCommittee.Contains(addr)
 }
@@ -147,7 +194,7 @@ func (m *MockState) IsValidator(addr crypto.Address) bool {
 }
 
 func (m *MockState) TotalValidators() int32 {
-	return m.TestStore.TotalAccounts()
+	return m.TestStore.TotalValidators()
 }
 
 func (m *MockState) TotalAccounts() int32 {
@@ -169,6 +216,13 @@ func (m *MockState) CommitteePower() int64 {
 	return m.TestCommittee.TotalPower()
 }
 
+func (m *MockState) MissedSortitions() int64 {
+	m.lk.RLock()
+	defer m.lk.RUnlock()
+
+	return m.TestMissed
+}
+
 func (m *MockState) CommittedBlock(height uint32) *store.CommittedBlock {
 	m.lk.RLock()
 	defer m.lk.RUnlock()
@@ -178,6 +232,15 @@ func (m *MockState) CommittedBlock(height uint32) *store.CommittedBlock {
 	return b
 }
 
+func (m *MockState) HeaderProof(height uint32) *store.HeaderProof {
+	m.lk.RLock()
+	defer m.lk.RUnlock()
+
+	proof, _ := m.TestStore.HeaderProof(height)
+
+	return proof
+}
+
 func (m *MockState) CommittedTx(id tx.ID) *store.CommittedTx {
 	m.lk.RLock()
 	defer m.lk.RUnlock()
@@ -187,6 +250,61 @@ func (m *MockState) CommittedTx(id tx.ID) *store.CommittedTx {
 	return trx
 }
 
+func (m *MockState) TransactionReceipt(id tx.ID) *receipt.Receipt {
+	m.lk.RLock()
+	defer m.lk.RUnlock()
+
+	rcpt, _ := m.TestStore.Receipt(id)
+
+	return rcpt
+}
+
+func (m *MockState) Beacon(height uint32) hash.Hash {
+	m.lk.RLock()
+	defer m.lk.RUnlock()
+
+	if height == 0 || height >= m.TestStore.LastHeight {
+		return hash.UndefHash
+	}
+
+	committedBlock, err := m.TestStore.Block(height + 1)
+	if err != nil {
+		return hash.UndefHash
+	}
+
+	blk, err := committedBlock.ToBlock()
+	if err != nil {
+		return hash.UndefHash
+	}
+
+	return blk.PrevCertificate().Beacon()
+}
+
+func (m *MockState) BlockBloom(height uint32) *bloom.Filter {
+	m.lk.RLock()
+	defer m.lk.RUnlock()
+
+	filter, _ := m.TestStore.Bloom(height)
+
+	return filter
+}
+
+func (m *MockState) CompactFilter(height uint32) *gcs.Filter {
+	m.lk.RLock()
+	defer m.lk.RUnlock()
+
+	filter, _ := m.TestStore.CompactFilter(height)
+
+	return filter
+}
+
+func (m *MockState) StoreFamilies() []store.FamilyInfo {
+	m.lk.RLock()
+	defer m.lk.RUnlock()
+
+	return m.TestStore.FamilyInfos()
+}
+
 func (m *MockState) BlockHash(height uint32) hash.Hash {
 	m.lk.RLock()
 	defer m.lk.RUnlock()
@@ -233,6 +351,18 @@ func (m *MockState) PendingTx(id tx.ID) *tx.Tx {
 	return m.TestPool.PendingTx(id)
 }
 
+func (m *MockState) PendingTxsCount() int {
+	return m.TestPool.Size()
+}
+
+func (m *MockState) PendingTxsSnapshot() (uint64, []tx.ID) {
+	return m.TestPool.Snapshot()
+}
+
+func (m *MockState) PendingTxsChangesSince(seq uint64) ([]txpool.Change, uint64, bool) {
+	return m.TestPool.ChangesSince(seq)
+}
+
 func (m *MockState) AddPendingTx(trx *tx.Tx) error {
 	if m.TestPool.HasTx(trx.ID()) {
 		return errors.Error(errors.ErrGeneric)
@@ -257,16 +387,26 @@ func (m *MockState) CalculateFee(_ int64, payloadType payload.Type) (int64, erro
 	switch payloadType {
 	case payload.TypeTransfer,
 		payload.TypeBond,
-		payload.TypeWithdraw:
+		payload.TypeWithdraw,
+		payload.TypeEscrow,
+		payload.TypeData,
+		payload.TypeAllowlist,
+		payload.TypeCondition,
+		payload.TypeDelegate:
 
 		return m.ts.RandInt64(1e9), nil
 
 	case payload.TypeUnbond,
-		payload.TypeSortition:
+		payload.TypeSortition,
+		payload.TypeRewardSplit:
 
 		return 0, nil
 
 	default:
+		if payloadType.IsCustom() && payloadType.IsRegistered() {
+			return m.ts.RandInt64(1e9), nil
+		}
+
 		return 0, errors.Errorf(errors.ErrInvalidTx, "unexpected tx type: %v", payloadType)
 	}
 }
@@ -278,3 +418,121 @@ func (m *MockState) PublicKey(addr crypto.Address) (crypto.PublicKey, error) {
 func (m *MockState) AvailabilityScore(_ int32) float64 {
 	return 0.987
 }
+
+func (m *MockState) PausedValidators() []crypto.Address {
+	return m.TestPaused
+}
+
+func (m *MockState) RewardInfo(height uint32) *reward.Info {
+	m.lk.RLock()
+	defer m.lk.RUnlock()
+
+	return rewardInfo(m.TestStore, height)
+}
+
+func (m *MockState) FeeHistogram(from, to uint32) (*FeeHistogram, error) {
+	return feeHistogram(m.TestStore, from, to)
+}
+
+func (m *MockState) ChainStatistics(window uint32) *statistics.Statistics {
+	m.lk.RLock()
+	defer m.lk.RUnlock()
+
+	window = util.Min(window, statisticsWindow)
+	statsMgr := statistics.NewStatisticsManager(window, maxTransactionsPerBlock)
+	lastHeight := m.TestStore.LastHeight
+	startHeight := uint32(1)
+	if lastHeight > window {
+		startHeight = lastHeight - window + 1
+	}
+	for h := startHeight; h <= lastHeight; h++ {
+		cb, err := m.TestStore.Block(h)
+		if err != nil {
+			continue
+		}
+		blk, err := cb.ToBlock()
+		if err != nil {
+			continue
+		}
+		statsMgr.AddBlock(h, blk)
+	}
+
+	return statsMgr.Statistics(window)
+}
+
+// SnapshotHeight looks for the block that refers to the given state root.
+// The state root in the block header is the state root of its previous block.
+func (m *MockState) SnapshotHeight(stateRoot hash.Hash) (uint32, error) {
+	m.lk.RLock()
+	defer m.lk.RUnlock()
+
+	for h, blk := range m.TestStore.Blocks {
+		if blk.Header().StateRoot() == stateRoot {
+			return h - 1, nil
+		}
+	}
+
+	return 0, store.ErrNotFound
+}
+
+// AccountByAddressAt returns the current account, since the mock doesn't keep the history.
+func (m *MockState) AccountByAddressAt(addr crypto.Address, height uint32) (*account.Account, error) {
+	if height > m.LastBlockHeight() {
+		return nil, snapshot.UnavailableError{Height: height}
+	}
+
+	return m.AccountByAddress(addr), nil
+}
+
+// ValidatorByAddressAt returns the current validator, since the mock doesn't keep the history.
+func (m *MockState) ValidatorByAddressAt(addr crypto.Address, height uint32) (*validator.Validator, error) {
+	if height > m.LastBlockHeight() {
+		return nil, snapshot.UnavailableError{Height: height}
+	}
+
+	return m.ValidatorByAddress(addr), nil
+}
+
+// ValidatorByNumberAt returns the current validator, since the mock doesn't keep the history.
+func (m *MockState) ValidatorByNumberAt(n int32, height uint32) (*validator.Validator, error) {
+	if height > m.LastBlockHeight() {
+		return nil, snapshot.UnavailableError{Height: height}
+	}
+
+	return m.ValidatorByNumber(n), nil
+}
+
+func (m *MockState) Halt(ord *halt.Order) error {
+	m.lk.Lock()
+	defer m.lk.Unlock()
+
+	if err := ord.BasicCheck(); err != nil {
+		return err
+	}
+	m.TestHaltOrder = ord
+
+	return nil
+}
+
+func (m *MockState) Resume(rsm *halt.Resume) error {
+	m.lk.Lock()
+	defer m.lk.Unlock()
+
+	if err := rsm.BasicCheck(); err != nil {
+		return err
+	}
+	m.TestHaltOrder = nil
+
+	return nil
+}
+
+func (m *MockState) HaltHeight() uint32 {
+	m.lk.RLock()
+	defer m.lk.RUnlock()
+
+	if m.TestHaltOrder == nil {
+		return 0
+	}
+
+	return m.TestHaltOrder.Height()
+}
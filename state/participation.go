@@ -0,0 +1,79 @@
+package state
+
+import (
+	"github.com/pactus-project/pactus/crypto"
+	"github.com/pactus-project/pactus/store"
+	"github.com/pactus-project/pactus/types/participation"
+	"github.com/pactus-project/pactus/util/errors"
+	"golang.org/x/exp/slices"
+)
+
+// MaxParticipationRange is the maximum number of blocks that a participation report can cover.
+// It is about 30 days with 10-second blocks.
+const MaxParticipationRange = 259200
+
+// participationReport calculates the participation of the validator in the blocks
+// from the given height up to the given height, inclusive.
+// The certificate of each block shows if the validator was in the committee and if it has voted.
+func participationReport(str store.Reader, valAddr crypto.Address, from, to uint32,
+) (*participation.Report, error) {
+	lastCert := str.LastCertificate()
+	if lastCert == nil || from == 0 || from > to || to > lastCert.Height() {
+		return nil, errors.Errorf(errors.ErrInvalidHeight, "invalid range: %d-%d", from, to)
+	}
+	if to-from+1 > MaxParticipationRange {
+		return nil, errors.Errorf(errors.ErrInvalidHeight,
+			"range is more than %d blocks", MaxParticipationRange)
+	}
+
+	val, err := str.Validator(valAddr)
+	if err != nil {
+		return nil, errors.Errorf(errors.ErrInvalidAddress, "validator not found: %s", valAddr)
+	}
+	valNum := val.Number()
+
+	inCommittee := uint32(0)
+	votesCast := uint32(0)
+	blocksProposed := uint32(0)
+
+	cb, err := str.Block(from)
+	if err != nil {
+		return nil, err
+	}
+	blk, err := cb.ToBlock()
+	if err != nil {
+		return nil, err
+	}
+
+	for height := from; height <= to; height++ {
+		// The certificate of a block is stored in the next block.
+		cert := lastCert
+		nextBlk := blk
+		if height < lastCert.Height() {
+			cb, err := str.Block(height + 1)
+			if err != nil {
+				return nil, err
+			}
+			nextBlk, err = cb.ToBlock()
+			if err != nil {
+				return nil, err
+			}
+			cert = nextBlk.PrevCertificate()
+		}
+
+		if slices.Contains(cert.Committers(), valNum) {
+			inCommittee++
+
+			if !slices.Contains(cert.Absentees(), valNum) {
+				votesCast++
+			}
+		}
+		if blk.Header().ProposerAddress() == valAddr {
+			blocksProposed++
+		}
+
+		blk = nextBlk
+	}
+
+	return participation.NewReport(valAddr, from, to, inCommittee, votesCast, blocksProposed), nil
+}
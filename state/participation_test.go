@@ -0,0 +1,66 @@
+package state
+
+import (
+	"testing"
+
+	"github.com/pactus-project/pactus/util/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParticipationReport(t *testing.T) {
+	td := setup(t)
+
+	lastHeight := td.state.LastBlockHeight()
+	proposed := map[int]uint32{}
+	for height := uint32(1); height <= lastHeight; height++ {
+		blk, _ := td.state.CommittedBlock(height).ToBlock()
+		for i, key := range td.genValKeys {
+			if key.Address() == blk.Header().ProposerAddress() {
+				proposed[i]++
+			}
+		}
+	}
+
+	t.Run("Voting validator", func(t *testing.T) {
+		addr := td.genValKeys[0].Address()
+		report, err := td.state.ParticipationReport(addr, 1, lastHeight)
+		require.NoError(t, err)
+
+		assert.Equal(t, addr, report.Validator())
+		assert.Equal(t, uint32(1), report.FromHeight())
+		assert.Equal(t, lastHeight, report.ToHeight())
+		assert.Equal(t, lastHeight, report.InCommittee())
+		assert.Equal(t, lastHeight, report.VotesCast())
+		assert.Equal(t, proposed[0], report.BlocksProposed())
+		assert.Equal(t, 1.0, report.Uptime())
+	})
+
+	t.Run("Absent validator", func(t *testing.T) {
+		addr := td.genValKeys[3].Address()
+		report, err := td.state.ParticipationReport(addr, lastHeight, lastHeight)
+		require.NoError(t, err)
+
+		assert.Equal(t, uint32(1), report.InCommittee())
+		assert.Zero(t, report.VotesCast())
+		assert.Zero(t, report.Uptime())
+	})
+
+	t.Run("Unknown validator", func(t *testing.T) {
+		_, err := td.state.ParticipationReport(td.RandValAddress(), 1, lastHeight)
+		assert.Equal(t, errors.ErrInvalidAddress, errors.Code(err))
+	})
+
+	t.Run("Invalid range", func(t *testing.T) {
+		addr := td.genValKeys[0].Address()
+
+		_, err := td.state.ParticipationReport(addr, 0, lastHeight)
+		assert.Equal(t, errors.ErrInvalidHeight, errors.Code(err))
+
+		_, err = td.state.ParticipationReport(addr, lastHeight, lastHeight-1)
+		assert.Equal(t, errors.ErrInvalidHeight, errors.Code(err))
+
+		_, err = td.state.ParticipationReport(addr, 1, lastHeight+1)
+		assert.Equal(t, errors.ErrInvalidHeight, errors.Code(err))
+	})
+}
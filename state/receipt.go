@@ -0,0 +1,194 @@
+package state
+
+import (
+	"bytes"
+	"slices"
+
+	"github.com/pactus-project/pactus/crypto"
+	"github.com/pactus-project/pactus/crypto/bls"
+	"github.com/pactus-project/pactus/crypto/hash"
+	"github.com/pactus-project/pactus/sandbox"
+	"github.com/pactus-project/pactus/state/reward"
+	"github.com/pactus-project/pactus/store"
+	"github.com/pactus-project/pactus/types/account"
+	"github.com/pactus-project/pactus/types/block"
+	"github.com/pactus-project/pactus/types/receipt"
+	"github.com/pactus-project/pactus/types/tx"
+	"github.com/pactus-project/pactus/types/validator"
+	"github.com/pactus-project/pactus/util/bloom"
+	"github.com/pactus-project/pactus/util/gcs"
+)
+
+// receiptRecorder wraps the sandbox to record the accounts and validators
+// that a transaction touches, together with their values before the transaction.
+type receiptRecorder struct {
+	sandbox.Sandbox
+
+	accAddrs    []crypto.Address
+	oldBalances map[crypto.Address]int64
+	valAddrs    []crypto.Address
+	oldStakes   map[crypto.Address]int64
+}
+
+func newReceiptRecorder(sb sandbox.Sandbox) *receiptRecorder {
+	return &receiptRecorder{
+		Sandbox:     sb,
+		oldBalances: make(map[crypto.Address]int64),
+		oldStakes:   make(map[crypto.Address]int64),
+	}
+}
+
+func (r *receiptRecorder) recordAccount(addr crypto.Address, oldBalance int64) {
+	if _, ok := r.oldBalances[addr]; ok {
+		return
+	}
+	r.accAddrs = append(r.accAddrs, addr)
+	r.oldBalances[addr] = oldBalance
+}
+
+func (r *receiptRecorder) recordValidator(addr crypto.Address, oldStake int64) {
+	if _, ok := r.oldStakes[addr]; ok {
+		return
+	}
+	r.valAddrs = append(r.valAddrs, addr)
+	r.oldStakes[addr] = oldStake
+}
+
+func (r *receiptRecorder) MakeNewAccount(addr crypto.Address) *account.Account {
+	r.recordAccount(addr, 0)
+
+	return r.Sandbox.MakeNewAccount(addr)
+}
+
+func (r *receiptRecorder) UpdateAccount(addr crypto.Address, acc *account.Account) {
+	if oldAcc := r.Sandbox.Account(addr); oldAcc != nil {
+		r.recordAccount(addr, oldAcc.Balance())
+	}
+	r.Sandbox.UpdateAccount(addr, acc)
+}
+
+func (r *receiptRecorder) MakeNewValidator(pub *bls.PublicKey) *validator.Validator {
+	r.recordValidator(pub.ValidatorAddress(), 0)
+
+	return r.Sandbox.MakeNewValidator(pub)
+}
+
+func (r *receiptRecorder) UpdateValidator(val *validator.Validator) {
+	if oldVal := r.Sandbox.Validator(val.Address()); oldVal != nil {
+		r.recordValidator(val.Address(), oldVal.Stake())
+	}
+	r.Sandbox.UpdateValidator(val)
+}
+
+// receipt creates the receipt of the executed transaction.
+// The balances and events are in the order that the transaction touched them.
+func (r *receiptRecorder) receipt(trx *tx.Tx, height uint32, index int) *receipt.Receipt {
+	balances := make([]receipt.Balance, 0, len(r.accAddrs))
+	events := make([]receipt.Event, 0, len(r.accAddrs)+len(r.valAddrs))
+	for _, addr := range r.accAddrs {
+		newBalance := r.Sandbox.Account(addr).Balance()
+		balances = append(balances, receipt.Balance{
+			Address: addr,
+			Balance: newBalance,
+		})
+
+		if oldBalance := r.oldBalances[addr]; oldBalance != newBalance {
+			events = append(events, receipt.Event{
+				Type:     receipt.EventBalanceChange,
+				Address:  addr,
+				OldValue: oldBalance,
+				NewValue: newBalance,
+			})
+		}
+	}
+
+	for _, addr := range r.valAddrs {
+		newStake := r.Sandbox.Validator(addr).Stake()
+		if oldStake := r.oldStakes[addr]; oldStake != newStake {
+			events = append(events, receipt.Event{
+				Type:     receipt.EventStakeChange,
+				Address:  addr,
+				OldValue: oldStake,
+				NewValue: newStake,
+			})
+		}
+	}
+
+	return receipt.NewReceipt(trx.ID(), height, uint16(index), receipt.StatusSuccess,
+		trx.Fee(), balances, events)
+}
+
+// touchedAddresses returns the addresses that the block touched: the signers and
+// the receivers of the transactions and the addresses in their receipts.
+// The addresses are sorted to keep them deterministic.
+func touchedAddresses(blk *block.Block, rcpts []*receipt.Receipt) []crypto.Address {
+	addrs := make(map[crypto.Address]bool)
+	for _, trx := range blk.Transactions() {
+		addrs[trx.Payload().Signer()] = true
+		if receiver := trx.Payload().Receiver(); receiver != nil {
+			addrs[*receiver] = true
+		}
+	}
+
+	for _, rcpt := range rcpts {
+		for _, b := range rcpt.Balances() {
+			addrs[b.Address] = true
+		}
+		for _, e := range rcpt.Events() {
+			addrs[e.Address] = true
+		}
+	}
+
+	sorted := make([]crypto.Address, 0, len(addrs))
+	for addr := range addrs {
+		sorted = append(sorted, addr)
+	}
+	slices.SortFunc(sorted, func(a, b crypto.Address) int {
+		return bytes.Compare(a.Bytes(), b.Bytes())
+	})
+
+	return sorted
+}
+
+// blockBloom creates the bloom filter of the addresses that the block touched.
+func blockBloom(addrs []crypto.Address) *bloom.Filter {
+	filter := bloom.New(len(addrs))
+	for _, addr := range addrs {
+		filter.Add(addr.Bytes())
+	}
+
+	return filter
+}
+
+// blockCompactFilter creates the compact filter of the addresses that the block touched.
+// The filter is keyed by the block hash.
+func blockCompactFilter(blockHash hash.Hash, addrs []crypto.Address) *gcs.Filter {
+	items := make([][]byte, 0, len(addrs))
+	for _, addr := range addrs {
+		items = append(items, addr.Bytes())
+	}
+
+	return gcs.Build(blockHash, items)
+}
+
+// rewardInfo calculates the reward information of the block from its subsidy receipt.
+func rewardInfo(str store.Reader, height uint32) *reward.Info {
+	cb, err := str.Block(height)
+	if err != nil {
+		return nil
+	}
+	blk, err := cb.ToBlock()
+	if err != nil {
+		return nil
+	}
+	rcpt, err := str.Receipt(blk.Transactions()[0].ID())
+	if err != nil {
+		return nil
+	}
+	info, err := reward.Calculate(height, blk, rcpt)
+	if err != nil {
+		return nil
+	}
+
+	return info
+}
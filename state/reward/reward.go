@@ -0,0 +1,76 @@
+// Package reward calculates the block reward and the treasury accounting of the committed blocks.
+package reward
+
+import (
+	"errors"
+
+	"github.com/pactus-project/pactus/crypto"
+	"github.com/pactus-project/pactus/types/block"
+	"github.com/pactus-project/pactus/types/receipt"
+)
+
+// ErrNoTreasuryBalance is returned when the receipt of the subsidy transaction
+// doesn't keep the balance of the treasury.
+var ErrNoTreasuryBalance = errors.New("treasury balance not found in the subsidy receipt")
+
+// Info contains the block reward and the treasury accounting of a committed block.
+//
+// The subsidy transaction pays the block reward plus the transaction fees from the treasury
+// to the reward address, and the fees are claimed back by the treasury at the end of the block.
+// Therefore, the treasury balance decreases by the block reward in each block.
+// All the coins that leave the treasury are paid through the subsidy transaction.
+type Info struct {
+	Height                uint32
+	RewardAddress         crypto.Address // Receiver of the subsidy transaction
+	BlockReward           int64          // Newly issued coins that are paid from the treasury
+	Fees                  int64          // Fees of the transactions, excluding the subsidy transaction
+	TreasuryBalanceBefore int64          // Balance of the treasury before the block
+	TreasuryBalanceAfter  int64          // Balance of the treasury after the block
+}
+
+// TotalPayout returns the amount that is paid to the reward address.
+func (i *Info) TotalPayout() int64 {
+	return i.BlockReward + i.Fees
+}
+
+// TreasuryChange returns the change in the treasury balance by the block.
+func (i *Info) TreasuryChange() int64 {
+	return i.TreasuryBalanceAfter - i.TreasuryBalanceBefore
+}
+
+// Calculate calculates the reward information of the block from its subsidy receipt.
+func Calculate(height uint32, blk *block.Block, subsidyRcpt *receipt.Receipt) (*Info, error) {
+	subsidyTx := blk.Transactions()[0]
+
+	fees := int64(0)
+	for _, trx := range blk.Transactions() {
+		if trx.IsSubsidyTx() {
+			continue
+		}
+		fees += trx.Fee()
+	}
+
+	// The treasury balance in the receipt is the balance right after paying the subsidy.
+	treasuryBalance, found := int64(0), false
+	for _, b := range subsidyRcpt.Balances() {
+		if b.Address == crypto.TreasuryAddress {
+			treasuryBalance, found = b.Balance, true
+
+			break
+		}
+	}
+	if !found {
+		return nil, ErrNoTreasuryBalance
+	}
+
+	subsidyAmt := subsidyTx.Payload().Value()
+
+	return &Info{
+		Height:                height,
+		RewardAddress:         *subsidyTx.Payload().Receiver(),
+		BlockReward:           subsidyAmt - fees,
+		Fees:                  fees,
+		TreasuryBalanceBefore: treasuryBalance + subsidyAmt,
+		TreasuryBalanceAfter:  treasuryBalance + fees,
+	}, nil
+}
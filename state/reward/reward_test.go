@@ -0,0 +1,62 @@
+package reward
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pactus-project/pactus/crypto"
+	"github.com/pactus-project/pactus/types/block"
+	"github.com/pactus-project/pactus/types/receipt"
+	"github.com/pactus-project/pactus/types/tx"
+	"github.com/pactus-project/pactus/util/testsuite"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCalculate(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	rewardAddr := ts.RandAccAddress()
+	blockReward := int64(1e9)
+	fees := []int64{1000, 2000}
+
+	txs := block.NewTxs()
+	subsidyTx := tx.NewSubsidyTx(1, rewardAddr, blockReward+fees[0]+fees[1], "subsidy")
+	txs.Append(subsidyTx)
+	for _, fee := range fees {
+		txs.Append(tx.NewTransferTx(1, ts.RandAccAddress(), ts.RandAccAddress(), 1e9, fee, ""))
+	}
+	header := block.NewHeader(1, time.Now(), ts.RandHash(), ts.RandHash(), ts.RandSeed(), ts.RandValAddress())
+	blk := block.NewBlock(header, nil, txs)
+
+	t.Run("Should calculate the reward info", func(t *testing.T) {
+		treasuryBalance := int64(21e15)
+		rcpt := receipt.NewReceipt(subsidyTx.ID(), 10, 0, receipt.StatusSuccess, 0,
+			[]receipt.Balance{
+				{Address: rewardAddr, Balance: blockReward + 3000},
+				{Address: crypto.TreasuryAddress, Balance: treasuryBalance},
+			}, nil)
+
+		info, err := Calculate(10, blk, rcpt)
+		require.NoError(t, err)
+
+		assert.Equal(t, uint32(10), info.Height)
+		assert.Equal(t, rewardAddr, info.RewardAddress)
+		assert.Equal(t, blockReward, info.BlockReward)
+		assert.Equal(t, int64(3000), info.Fees)
+		assert.Equal(t, blockReward+3000, info.TotalPayout())
+		assert.Equal(t, treasuryBalance+blockReward+3000, info.TreasuryBalanceBefore)
+		assert.Equal(t, treasuryBalance+3000, info.TreasuryBalanceAfter)
+		assert.Equal(t, -blockReward, info.TreasuryChange())
+	})
+
+	t.Run("Should return error if the receipt has no treasury balance", func(t *testing.T) {
+		rcpt := receipt.NewReceipt(subsidyTx.ID(), 10, 0, receipt.StatusSuccess, 0,
+			[]receipt.Balance{
+				{Address: rewardAddr, Balance: blockReward + 3000},
+			}, nil)
+
+		_, err := Calculate(10, blk, rcpt)
+		assert.ErrorIs(t, err, ErrNoTreasuryBalance)
+	})
+}
@@ -0,0 +1,97 @@
+package state
+
+import (
+	"fmt"
+
+	"github.com/pactus-project/pactus/crypto"
+	"github.com/pactus-project/pactus/crypto/bls"
+	"github.com/pactus-project/pactus/crypto/hash"
+	"github.com/pactus-project/pactus/genesis"
+	"github.com/pactus-project/pactus/types/block"
+	"github.com/pactus-project/pactus/types/certificate"
+)
+
+// GenerateBlocks produces and commits the given number of blocks on the simnet,
+// without running the consensus.
+// The blocks are proposed and certified by the validator keys of the node,
+// so they should hold more than 2/3 of the committee power.
+// The block rewards and the fees are paid to the reward address.
+// It returns the hashes of the committed blocks, even if it fails in the middle.
+func (st *state) GenerateBlocks(count int, rewardAddr crypto.Address) ([]hash.Hash, error) {
+	if chainType := st.genDoc.ChainType(); chainType != genesis.Simnet {
+		return nil, NotSimnetError{ChainType: chainType}
+	}
+
+	st.generateLk.Lock()
+	defer st.generateLk.Unlock()
+
+	hashes := make([]hash.Hash, 0, count)
+	for i := 0; i < count; i++ {
+		blk, cert, err := st.generateBlock(rewardAddr)
+		if err != nil {
+			return hashes, err
+		}
+
+		if err := st.CommitBlock(blk, cert); err != nil {
+			return hashes, err
+		}
+		hashes = append(hashes, blk.Hash())
+	}
+
+	return hashes, nil
+}
+
+// generateBlock proposes the next block by the first local validator that can propose it,
+// and certifies it by all the local validators in the committee.
+func (st *state) generateBlock(rewardAddr crypto.Address) (*block.Block, *certificate.Certificate, error) {
+	st.lk.RLock()
+	height := st.lastInfo.BlockHeight() + 1
+	vals := st.committee.Validators()
+	var proposerKey *bls.ValidatorKey
+	round := int16(0)
+	for ; int(round) < len(vals); round++ {
+		proposerKey = st.valKey(st.committee.Proposer(round).Address())
+		if proposerKey != nil {
+			break
+		}
+	}
+	st.lk.RUnlock()
+
+	if proposerKey == nil {
+		return nil, nil, fmt.Errorf("no local validator is in the committee at height %d", height)
+	}
+
+	blk, err := st.ProposeBlock(bls.NewKeySigner(proposerKey), rewardAddr)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	signBytes := certificate.BlockCertificateSignBytes(blk.Hash(), height, round)
+	committers := make([]int32, 0, len(vals))
+	absentees := make([]int32, 0)
+	sigs := make([]*bls.Signature, 0, len(vals))
+	for _, val := range vals {
+		committers = append(committers, val.Number())
+		key := st.valKey(val.Address())
+		if key == nil {
+			absentees = append(absentees, val.Number())
+
+			continue
+		}
+		sigs = append(sigs, key.Sign(signBytes))
+	}
+	cert := certificate.NewCertificate(height, round, committers, absentees, bls.SignatureAggregate(sigs...))
+
+	return blk, cert, nil
+}
+
+// valKey returns the local validator key with the given address, or nil if there is no such key.
+func (st *state) valKey(addr crypto.Address) *bls.ValidatorKey {
+	for _, key := range st.valKeys {
+		if key.Address() == addr {
+			return key
+		}
+	}
+
+	return nil
+}
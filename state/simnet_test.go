@@ -0,0 +1,57 @@
+package state
+
+import (
+	"testing"
+
+	"github.com/pactus-project/pactus/genesis"
+	"github.com/pactus-project/pactus/types/certificate"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateBlocks(t *testing.T) {
+	td := setup(t)
+
+	t.Run("Not simnet", func(t *testing.T) {
+		_, err := td.state.GenerateBlocks(1, td.RandAccAddress())
+		assert.ErrorIs(t, err, NotSimnetError{ChainType: genesis.Localnet})
+	})
+
+	td.state.genDoc.SetSimnet()
+
+	t.Run("No local validator in the committee", func(t *testing.T) {
+		lastHeight := td.state.LastBlockHeight()
+		hashes, err := td.state.GenerateBlocks(1, td.RandAccAddress())
+		assert.Error(t, err)
+		assert.Empty(t, hashes)
+		assert.Equal(t, lastHeight, td.state.LastBlockHeight())
+	})
+
+	t.Run("Not enough power", func(t *testing.T) {
+		td.state.valKeys = td.genValKeys[:2]
+
+		lastHeight := td.state.LastBlockHeight()
+		hashes, err := td.state.GenerateBlocks(1, td.RandAccAddress())
+		assert.ErrorIs(t, err, certificate.InsufficientPowerError{SignedPower: 2, RequiredPower: 3})
+		assert.Empty(t, hashes)
+		assert.Equal(t, lastHeight, td.state.LastBlockHeight())
+	})
+
+	t.Run("Ok", func(t *testing.T) {
+		td.state.valKeys = td.genValKeys[1:]
+
+		lastHeight := td.state.LastBlockHeight()
+		rewardAddr := td.RandAccAddress()
+		hashes, err := td.state.GenerateBlocks(5, rewardAddr)
+		require.NoError(t, err)
+		require.Len(t, hashes, 5)
+		assert.Equal(t, lastHeight+5, td.state.LastBlockHeight())
+		for i, h := range hashes {
+			assert.Equal(t, h, td.state.BlockHash(lastHeight+uint32(i)+1))
+		}
+
+		acc := td.state.AccountByAddress(rewardAddr)
+		require.NotNil(t, acc)
+		assert.Equal(t, 5*td.state.params.BlockReward, acc.Balance())
+	})
+}
@@ -0,0 +1,152 @@
+package snapshot
+
+import (
+	"fmt"
+
+	"github.com/pactus-project/pactus/crypto"
+	"github.com/pactus-project/pactus/crypto/hash"
+	"github.com/pactus-project/pactus/types/account"
+	"github.com/pactus-project/pactus/types/validator"
+)
+
+// UnavailableError is returned when the state at the given height is not kept anymore,
+// or the height is not reached yet.
+type UnavailableError struct {
+	Height uint32
+}
+
+func (e UnavailableError) Error() string {
+	return fmt.Sprintf("no snapshot for height %d", e.Height)
+}
+
+// ChangeSet holds the values of the accounts and validators before they are
+// changed by a block. A nil value means that the entity did not exist before the block.
+type ChangeSet struct {
+	height     uint32
+	accounts   map[crypto.Address]*account.Account
+	validators map[crypto.Address]*validator.Validator
+}
+
+func NewChangeSet(height uint32) *ChangeSet {
+	return &ChangeSet{
+		height:     height,
+		accounts:   make(map[crypto.Address]*account.Account),
+		validators: make(map[crypto.Address]*validator.Validator),
+	}
+}
+
+func (cs *ChangeSet) AddAccount(addr crypto.Address, prevAcc *account.Account) {
+	cs.accounts[addr] = prevAcc
+}
+
+func (cs *ChangeSet) AddValidator(addr crypto.Address, prevVal *validator.Validator) {
+	cs.validators[addr] = prevVal
+}
+
+type changes struct {
+	*ChangeSet
+	stateRoot hash.Hash
+}
+
+// Manager keeps the change sets of the recent blocks.
+// It makes it possible to read the state as it was at any of the recent heights,
+// by reverting the changes that are made after that height.
+type Manager struct {
+	maxHeights int
+	baseHeight uint32
+	baseRoot   hash.Hash
+	changes    []changes // Ordered by height, the oldest first
+}
+
+// NewSnapshotManager creates a new snapshot manager that keeps the state of the last
+// maxHeights blocks. The height and stateRoot are the current state of the blockchain.
+func NewSnapshotManager(maxHeights int, height uint32, stateRoot hash.Hash) *Manager {
+	return &Manager{
+		maxHeights: maxHeights,
+		baseHeight: height,
+		baseRoot:   stateRoot,
+		changes:    make([]changes, 0, maxHeights),
+	}
+}
+
+// AddChangeSet adds the change set of a newly committed block.
+// The stateRoot is the state root after committing the block.
+func (m *Manager) AddChangeSet(cs *ChangeSet, stateRoot hash.Hash) {
+	if len(m.changes) == m.maxHeights {
+		m.baseHeight = m.changes[0].height
+		m.baseRoot = m.changes[0].stateRoot
+		m.changes = m.changes[1:]
+	}
+	m.changes = append(m.changes, changes{ChangeSet: cs, stateRoot: stateRoot})
+}
+
+// LastHeight returns the height of the latest change set.
+func (m *Manager) LastHeight() uint32 {
+	if len(m.changes) == 0 {
+		return m.baseHeight
+	}
+
+	return m.changes[len(m.changes)-1].height
+}
+
+// Height returns the height at which the state root is equal to the given state root.
+func (m *Manager) Height(stateRoot hash.Hash) (uint32, bool) {
+	for i := len(m.changes) - 1; i >= 0; i-- {
+		if m.changes[i].stateRoot == stateRoot {
+			return m.changes[i].height, true
+		}
+	}
+
+	if m.baseRoot == stateRoot {
+		return m.baseHeight, true
+	}
+
+	return 0, false
+}
+
+// Account returns the account as it was at the given height.
+// The current is the latest value of the account in the store.
+func (m *Manager) Account(height uint32, addr crypto.Address,
+	current *account.Account,
+) (*account.Account, error) {
+	if err := m.CheckHeight(height); err != nil {
+		return nil, err
+	}
+
+	acc := current
+	for i := len(m.changes) - 1; i >= 0 && m.changes[i].height > height; i-- {
+		if prevAcc, ok := m.changes[i].accounts[addr]; ok {
+			acc = prevAcc
+		}
+	}
+
+	return acc, nil
+}
+
+// Validator returns the validator as it was at the given height.
+// The current is the latest value of the validator in the store.
+func (m *Manager) Validator(height uint32, addr crypto.Address,
+	current *validator.Validator,
+) (*validator.Validator, error) {
+	if err := m.CheckHeight(height); err != nil {
+		return nil, err
+	}
+
+	val := current
+	for i := len(m.changes) - 1; i >= 0 && m.changes[i].height > height; i-- {
+		if prevVal, ok := m.changes[i].validators[addr]; ok {
+			val = prevVal
+		}
+	}
+
+	return val, nil
+}
+
+// CheckHeight returns an error if the state at the given height is not available.
+func (m *Manager) CheckHeight(height uint32) error {
+	if height < m.baseHeight || height > m.LastHeight() {
+		return UnavailableError{Height: height}
+	}
+
+	return nil
+}
@@ -0,0 +1,98 @@
+package snapshot
+
+import (
+	"testing"
+
+	"github.com/pactus-project/pactus/util/testsuite"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSnapshot(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	root1 := ts.RandHash()
+	root2 := ts.RandHash()
+	root3 := ts.RandHash()
+	root4 := ts.RandHash()
+	sm := NewSnapshotManager(2, 1, root1)
+
+	acc1, addr := ts.GenerateTestAccount(1)
+	acc2 := acc1.Clone()
+	acc2.AddToBalance(1)
+	acc3 := acc2.Clone()
+	acc3.AddToBalance(1)
+	val3, _ := ts.GenerateTestValidator(1)
+
+	// Height 2: the balance of the account is changed
+	cs2 := NewChangeSet(2)
+	cs2.AddAccount(addr, acc1)
+	sm.AddChangeSet(cs2, root2)
+
+	// Height 3: the balance of the account is changed, and a new validator is created
+	cs3 := NewChangeSet(3)
+	cs3.AddAccount(addr, acc2)
+	cs3.AddValidator(val3.Address(), nil)
+	sm.AddChangeSet(cs3, root3)
+
+	assert.Equal(t, uint32(3), sm.LastHeight())
+
+	t.Run("Reading the state at the recent heights", func(t *testing.T) {
+		acc, err := sm.Account(3, addr, acc3)
+		require.NoError(t, err)
+		assert.Equal(t, acc3, acc)
+
+		acc, err = sm.Account(2, addr, acc3)
+		require.NoError(t, err)
+		assert.Equal(t, acc2, acc)
+
+		acc, err = sm.Account(1, addr, acc3)
+		require.NoError(t, err)
+		assert.Equal(t, acc1, acc)
+
+		val, err := sm.Validator(3, val3.Address(), val3)
+		require.NoError(t, err)
+		assert.Equal(t, val3, val)
+
+		val, err = sm.Validator(2, val3.Address(), val3)
+		require.NoError(t, err)
+		assert.Nil(t, val)
+	})
+
+	t.Run("Height is not reached yet", func(t *testing.T) {
+		_, err := sm.Account(4, addr, acc3)
+		assert.ErrorIs(t, err, UnavailableError{Height: 4})
+	})
+
+	t.Run("Looking for the state root", func(t *testing.T) {
+		h, ok := sm.Height(root1)
+		assert.True(t, ok)
+		assert.Equal(t, uint32(1), h)
+
+		h, ok = sm.Height(root3)
+		assert.True(t, ok)
+		assert.Equal(t, uint32(3), h)
+
+		_, ok = sm.Height(root4)
+		assert.False(t, ok)
+	})
+
+	// Height 4: nothing is changed, the oldest change set should be dropped
+	sm.AddChangeSet(NewChangeSet(4), root4)
+
+	t.Run("Oldest height is dropped", func(t *testing.T) {
+		_, err := sm.Account(1, addr, acc3)
+		assert.ErrorIs(t, err, UnavailableError{Height: 1})
+
+		_, ok := sm.Height(root1)
+		assert.False(t, ok)
+
+		acc, err := sm.Account(2, addr, acc3)
+		require.NoError(t, err)
+		assert.Equal(t, acc2, acc)
+
+		h, ok := sm.Height(root2)
+		assert.True(t, ok)
+		assert.Equal(t, uint32(2), h)
+	})
+}
@@ -1,8 +1,10 @@
 package state
 
 import (
+	"bytes"
 	"fmt"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/pactus-project/pactus/committee"
@@ -13,32 +15,54 @@ import (
 	"github.com/pactus-project/pactus/genesis"
 	"github.com/pactus-project/pactus/sandbox"
 	"github.com/pactus-project/pactus/sortition"
+	"github.com/pactus-project/pactus/state/anomaly"
 	"github.com/pactus-project/pactus/state/lastinfo"
+	"github.com/pactus-project/pactus/state/reward"
 	"github.com/pactus-project/pactus/state/score"
+	"github.com/pactus-project/pactus/state/snapshot"
+	"github.com/pactus-project/pactus/state/statistics"
 	"github.com/pactus-project/pactus/store"
 	"github.com/pactus-project/pactus/txpool"
 	"github.com/pactus-project/pactus/types/account"
 	"github.com/pactus-project/pactus/types/block"
 	"github.com/pactus-project/pactus/types/certificate"
+	"github.com/pactus-project/pactus/types/halt"
 	"github.com/pactus-project/pactus/types/param"
+	"github.com/pactus-project/pactus/types/participation"
+	"github.com/pactus-project/pactus/types/receipt"
 	"github.com/pactus-project/pactus/types/tx"
 	"github.com/pactus-project/pactus/types/tx/payload"
 	"github.com/pactus-project/pactus/types/validator"
 	"github.com/pactus-project/pactus/types/vote"
 	"github.com/pactus-project/pactus/util"
+	"github.com/pactus-project/pactus/util/bloom"
 	"github.com/pactus-project/pactus/util/errors"
+	"github.com/pactus-project/pactus/util/gcs"
 	"github.com/pactus-project/pactus/util/logger"
 	"github.com/pactus-project/pactus/util/persistentmerkle"
 	"github.com/pactus-project/pactus/util/simplemerkle"
+	"github.com/pactus-project/pactus/util/txtrace"
 	"github.com/pactus-project/pactus/www/nanomsg/event"
+	"golang.org/x/exp/slices"
 )
 
 var maxTransactionsPerBlock = 1000
 
+// statisticsWindow is the maximum number of the recent blocks that the chain statistics are kept for.
+const statisticsWindow = uint32(8640)
+
+// snapshotWindow is the maximum number of the recent blocks that the state can be read at.
+const snapshotWindow = 360
+
 type state struct {
 	lk sync.RWMutex
+	// generateLk serializes generating the blocks on the simnet.
+	generateLk sync.Mutex
 
+	config          *Config
 	valKeys         []*bls.ValidatorKey
+	remoteSigners   []bls.Signer
+	pausedVals      []crypto.Address
 	genDoc          *genesis.Genesis
 	store           store.Store
 	params          *param.Params
@@ -49,29 +73,78 @@ type state struct {
 	accountMerkle   *persistentmerkle.Tree
 	validatorMerkle *persistentmerkle.Tree
 	scoreMgr        *score.Manager
+	statsMgr        *statistics.Manager
+	anomalyDetector *anomaly.Detector
+	snapshotMgr     *snapshot.Manager
+	medianTime      *medianTime
+	haltOrder       *halt.Order
+	sortitionMisses atomic.Int64
+	view            atomic.Pointer[view]
+	engine          execution.Engine
 	logger          *logger.SubLogger
 	eventCh         chan event.Event
 }
 
+// Option customizes the state.
+type Option func(st *state)
+
+// WithEngine sets the execution engine of the state.
+// The checker of the engine is also set to the transaction pool, so the pool accepts
+// only the transactions that are valid under the rules of the engine.
+func WithEngine(engine execution.Engine) Option {
+	return func(st *state) {
+		st.engine = engine
+	}
+}
+
+// WithRemoteSigners sets the validators of the node that sign through a remote signer.
+// Their keys are not loaded in the node, and they take part in the sortition by their signers.
+func WithRemoteSigners(signers []bls.Signer) Option {
+	return func(st *state) {
+		st.remoteSigners = signers
+	}
+}
+
+// stateParams returns a copy of the genesis parameters,
+// with the parameters that are defined by the chain type.
+func stateParams(genDoc *genesis.Genesis) *param.Params {
+	params := *genDoc.Params()
+	params.ProofOfPossessionHeight = genDoc.ChainType().ProofOfPossessionHeight()
+	params.PayloadActivationHeights = genDoc.ChainType().PayloadActivationHeights()
+	params.TimestampLockTimeHeight = genDoc.ChainType().TimestampLockTimeHeight()
+
+	return &params
+}
+
 func LoadOrNewState(
+	conf *Config,
 	genDoc *genesis.Genesis,
 	valKeys []*bls.ValidatorKey,
 	str store.Store,
-	txPool txpool.TxPool, eventCh chan event.Event,
+	txPool txpool.TxPool, eventCh chan event.Event, opts ...Option,
 ) (Facade, error) {
+	params := stateParams(genDoc)
 	st := &state{
+		config:          conf,
 		valKeys:         valKeys,
 		genDoc:          genDoc,
 		txPool:          txPool,
-		params:          genDoc.Params(),
+		params:          params,
 		store:           str,
 		lastInfo:        lastinfo.NewLastInfo(),
 		accountMerkle:   persistentmerkle.New(),
 		validatorMerkle: persistentmerkle.New(),
 		eventCh:         eventCh,
+		medianTime:      newMedianTime(params.MedianTimeBlocks()),
+		anomalyDetector: anomaly.NewDetector(conf.Anomaly),
+		engine:          execution.DefaultEngine(),
+	}
+	for _, opt := range opts {
+		opt(st)
 	}
 	st.logger = logger.NewSubLogger("_state", st)
 	st.store = str
+	st.pausedVals = st.localPausedValidators(conf.PausedValidatorAddrs())
 
 	// Check if the number of accounts is greater than the genesis time;
 	// this indicates we are not at the genesis height anymore.
@@ -89,21 +162,29 @@ func LoadOrNewState(
 	}
 
 	st.totalPower = st.retrieveTotalPower()
+	st.publishView()
 
 	st.loadMerkels()
 
+	txPool.SetChecker(st.engine.Checker())
 	txPool.SetNewSandboxAndRecheck(st.concreteSandbox())
 
 	// Restoring score manager
 	st.logger.Info("calculating the availability scores...")
 	scoreWindow := uint32(60000)
-	startHeight := uint32(2)
+	startHeight := uint32(1)
 	endHeight := st.lastInfo.BlockHeight()
 	if endHeight > scoreWindow {
 		startHeight = endHeight - scoreWindow
 	}
 
+	if endHeight == 0 {
+		// The genesis time is the time of the block before the first block.
+		st.medianTime.addBlockTime(st.lastInfo.BlockTime())
+	}
+
 	scoreMgr := score.NewScoreManager(scoreWindow)
+	statsMgr := statistics.NewStatisticsManager(statisticsWindow, maxTransactionsPerBlock)
 	for h := startHeight; h <= endHeight; h++ {
 		cb, err := st.store.Block(h)
 		if err != nil {
@@ -113,9 +194,27 @@ func LoadOrNewState(
 		if err != nil {
 			return nil, err
 		}
-		scoreMgr.SetCertificate(blk.PrevCertificate())
+		if blk.PrevCertificate() != nil {
+			scoreMgr.SetCertificate(blk.PrevCertificate())
+		}
+
+		if endHeight-h < statisticsWindow {
+			statsMgr.AddBlock(h, blk)
+
+			// Warming up the anomaly detector, the alarms of the past blocks are ignored.
+			st.anomalyDetector.Check(h, blk, 0, 0)
+		}
+
+		if endHeight-h < uint32(st.params.MedianTimeBlocks()) {
+			st.medianTime.addBlockTime(blk.Header().Time())
+		}
 	}
 	st.scoreMgr = scoreMgr
+	st.statsMgr = statsMgr
+
+	// The changes of the blocks before restarting are not known,
+	// therefore the snapshots are available from the current height onward.
+	st.snapshotMgr = snapshot.NewSnapshotManager(snapshotWindow, endHeight, st.stateRoot())
 
 	for _, num := range st.committee.Committers() {
 		st.logger.Debug("availability score", "val", num, "score", st.scoreMgr.AvailabilityScore(num))
@@ -127,7 +226,7 @@ func LoadOrNewState(
 }
 
 func (st *state) concreteSandbox() sandbox.Sandbox {
-	return sandbox.NewSandbox(st.lastInfo.BlockHeight(),
+	return sandbox.NewSandbox(st.lastInfo.BlockHeight(), st.lastInfo.BlockTime(),
 		st.store, st.params, st.committee, st.totalPower)
 }
 
@@ -148,7 +247,10 @@ func (st *state) tryLoadLastInfo() error {
 	}
 
 	if genStateRoot != blockOne.Header().StateRoot() {
-		return fmt.Errorf("invalid genesis doc")
+		return GenesisMismatchError{
+			GenesisStateRoot: genStateRoot,
+			StoredStateRoot:  blockOne.Header().StateRoot(),
+		}
 	}
 
 	logger.Debug("try to restore the last state")
@@ -175,6 +277,11 @@ func (st *state) makeGenesisState(genDoc *genesis.Genesis) error {
 	vals := genDoc.Validators()
 	for _, val := range vals {
 		st.store.UpdateValidator(val)
+
+		// In a permissioned network, the genesis validators are allowlisted.
+		if st.params.Permissioned {
+			st.store.UpdateAllowlist(val.Address(), true)
+		}
 	}
 
 	err := st.store.WriteBatch()
@@ -272,31 +379,19 @@ func (st *state) Genesis() *genesis.Genesis {
 }
 
 func (st *state) LastBlockHeight() uint32 {
-	st.lk.RLock()
-	defer st.lk.RUnlock()
-
-	return st.lastInfo.BlockHeight()
+	return st.currentView().lastBlockHeight
 }
 
 func (st *state) LastBlockHash() hash.Hash {
-	st.lk.RLock()
-	defer st.lk.RUnlock()
-
-	return st.lastInfo.BlockHash()
+	return st.currentView().lastBlockHash
 }
 
 func (st *state) LastBlockTime() time.Time {
-	st.lk.RLock()
-	defer st.lk.RUnlock()
-
-	return st.lastInfo.BlockTime()
+	return st.currentView().lastBlockTime
 }
 
 func (st *state) LastCertificate() *certificate.Certificate {
-	st.lk.RLock()
-	defer st.lk.RUnlock()
-
-	return st.lastInfo.Certificate()
+	return st.currentView().lastCertificate
 }
 
 func (st *state) UpdateLastCertificate(v *vote.Vote) error {
@@ -333,6 +428,7 @@ func (st *state) UpdateLastCertificate(v *vote.Vote) error {
 
 	cloneLastCert.AddSignature(val.Number(), v.Signature())
 	st.lastInfo.UpdateCertificate(cloneLastCert)
+	st.publishView()
 
 	return nil
 }
@@ -344,17 +440,37 @@ func (st *state) createSubsidyTx(rewardAddr crypto.Address, fee int64) *tx.Tx {
 	return transaction
 }
 
-func (st *state) ProposeBlock(valKey *bls.ValidatorKey, rewardAddr crypto.Address) (*block.Block, error) {
+// ProposeBlock proposes a new block that is signed by the signer.
+// The signer might be a remote signer, so the sortition seed is signed without holding the lock.
+func (st *state) ProposeBlock(signer bls.Signer, rewardAddr crypto.Address) (*block.Block, error) {
+	st.lk.RLock()
+	prevSeed := st.lastInfo.SortitionSeed()
+	st.lk.RUnlock()
+
+	nextSeed, err := prevSeed.GenerateNextBySigner(signer)
+	if err != nil {
+		return nil, err
+	}
+
 	st.lk.Lock()
 	defer st.lk.Unlock()
 
+	if st.lastInfo.SortitionSeed() != prevSeed {
+		return nil, errors.Errorf(errors.ErrInvalidBlock, "a new block is committed while proposing")
+	}
+
+	if err := st.checkHalt(st.lastInfo.BlockHeight() + 1); err != nil {
+		return nil, err
+	}
+
 	// Create new sandbox and execute transactions
 	sb := st.concreteSandbox()
-	exe := execution.NewExecutor()
+	exe := st.engine.Executor()
 
 	// Re-check all transactions strictly and remove invalid ones
 	txs := st.txPool.PrepareBlockTransactions()
 	txs = util.Trim(txs, maxTransactionsPerBlock-1)
+	blockWeight := int64(0)
 	for i := 0; i < txs.Len(); i++ {
 		// Only one subsidy transaction per blk
 		if txs[i].IsSubsidyTx() {
@@ -366,11 +482,23 @@ func (st *state) ProposeBlock(valKey *bls.ValidatorKey, rewardAddr crypto.Addres
 			continue
 		}
 
+		// Transactions that don't fit in this block are left in the pool for the next blocks.
+		weight := execution.TxWeight(txs[i])
+		if st.params.MaxBlockWeight > 0 && blockWeight+weight > st.params.MaxBlockWeight {
+			txs.Remove(i)
+			i--
+
+			continue
+		}
+
 		if err := exe.Execute(txs[i], sb); err != nil {
 			st.logger.Debug("found invalid transaction", "tx", txs[i], "error", err)
 			txs.Remove(i)
 			i--
+
+			continue
 		}
+		blockWeight += weight
 	}
 
 	subsidyTx := st.createSubsidyTx(rewardAddr, sb.AccumulatedFee())
@@ -381,7 +509,6 @@ func (st *state) ProposeBlock(valKey *bls.ValidatorKey, rewardAddr crypto.Addres
 		return nil, errors.Errorf(errors.ErrInvalidBlock, "no subsidy transaction")
 	}
 	txs.Prepend(subsidyTx)
-	prevSeed := st.lastInfo.SortitionSeed()
 
 	blk := block.MakeBlock(
 		st.params.BlockVersion,
@@ -390,8 +517,8 @@ func (st *state) ProposeBlock(valKey *bls.ValidatorKey, rewardAddr crypto.Addres
 		st.lastInfo.BlockHash(),
 		st.stateRoot(),
 		st.lastInfo.Certificate(),
-		prevSeed.GenerateNext(valKey.PrivateKey()),
-		valKey.Address())
+		nextSeed,
+		signer.PublicKey().ValidatorAddress())
 
 	return blk, nil
 }
@@ -411,7 +538,9 @@ func (st *state) ValidateBlock(blk *block.Block, round int16) error {
 
 	sb := st.concreteSandbox()
 
-	return st.executeBlock(blk, sb)
+	_, err := st.executeBlock(blk, sb)
+
+	return err
 }
 
 func (st *state) CommitBlock(blk *block.Block, cert *certificate.Certificate) error {
@@ -425,6 +554,19 @@ func (st *state) CommitBlock(blk *block.Block, cert *certificate.Certificate) er
 		return nil
 	}
 
+	if err := st.checkHalt(height); err != nil {
+		st.logger.Warn("unable to commit block", "height", height, "error", err)
+
+		return err
+	}
+
+	// Refuse the block, rather than risking corrupting the database on a full disk.
+	if err := st.store.CheckDiskSpace(); err != nil {
+		st.logger.Error("unable to commit block", "height", height, "error", err)
+
+		return err
+	}
+
 	err := st.validateCertificate(cert, blk.Hash())
 	if err != nil {
 		return err
@@ -438,6 +580,7 @@ func (st *state) CommitBlock(blk *block.Block, cert *certificate.Certificate) er
 	// We should never have a fork in our blockchain.
 	// But if it happens, here we can catch it.
 	if blk.Header().PrevBlockHash() != st.lastInfo.BlockHash() {
+		st.publishForkEvent(blk.Header().PrevBlockHash())
 		st.logger.Panic("a possible fork is detected",
 			"our hash", st.lastInfo.BlockHash(),
 			"block hash", blk.Header().PrevBlockHash())
@@ -453,14 +596,21 @@ func (st *state) CommitBlock(blk *block.Block, cert *certificate.Certificate) er
 	// -----------------------------------
 	// Execute block
 	sb := st.concreteSandbox()
-	if err := st.executeBlock(blk, sb); err != nil {
+	rcpts, err := st.executeBlock(blk, sb)
+	if err != nil {
 		return err
 	}
 
+	// Collecting the state changes before committing the sandbox
+	stateChanges := st.stateChangeEvents(height, blk, rcpts, sb)
+	changeSet := st.snapshotChangeSet(height, sb)
+	joined := joinedCommittee(sb)
+
 	// -----------------------------------
 	// Commit block
 	st.lastInfo.UpdateBlockHash(blk.Hash())
 	st.lastInfo.UpdateBlockTime(blk.Header().Time())
+	st.medianTime.addBlockTime(blk.Header().Time())
 	st.lastInfo.UpdateSortitionSeed(blk.Header().SortitionSeed())
 	st.lastInfo.UpdateCertificate(cert)
 	st.lastInfo.UpdateValidators(st.committee.Validators())
@@ -469,23 +619,29 @@ func (st *state) CommitBlock(blk *block.Block, cert *certificate.Certificate) er
 	st.commitSandbox(sb, cert.Round())
 
 	st.store.SaveBlock(blk, cert)
+	st.store.SaveReceipts(rcpts)
+	addrs := touchedAddresses(blk, rcpts)
+	st.store.SaveBloom(height, blockBloom(addrs))
+	st.store.SaveCompactFilter(height, blockCompactFilter(blk.Hash(), addrs))
+	st.snapshotMgr.AddChangeSet(changeSet, st.stateRoot())
 
-	// Remove transactions from pool
-	for _, trx := range blk.Transactions() {
-		st.txPool.RemoveTx(trx.ID())
-	}
+	pending := st.txPool.Size()
 
 	if err := st.store.WriteBatch(); err != nil {
 		st.logger.Panic("unable to update state", "error", err)
 	}
 
 	st.logger.Info("new block committed", "block", blk, "round", cert.Round())
+	for _, trx := range blk.Transactions() {
+		txtrace.Record(trx.ID(), txtrace.StageCommitted)
+	}
 
 	st.evaluateSortition()
+	st.publishView()
 
 	// -----------------------------------
-	// At this point we can assign a new sandbox to tx pool
-	st.txPool.SetNewSandboxAndRecheck(st.concreteSandbox())
+	// At this point we can reconcile the tx pool with the committed block
+	st.txPool.Reconcile(blk, st.concreteSandbox())
 
 	// -----------------------------------
 	// Updating score manager
@@ -501,53 +657,154 @@ func (st *state) CommitBlock(blk *block.Block, cert *certificate.Certificate) er
 		}
 	}
 
+	// -----------------------------------
+	// Updating chain statistics
+	st.statsMgr.AddBlock(height, blk)
+
+	// -----------------------------------
+	// Detecting anomalies
+	alarms := st.anomalyDetector.Check(height, blk, pending, joined)
+	for _, alarm := range alarms {
+		st.logger.Warn("anomaly detected", "kind", alarm.Kind, "height", alarm.Height,
+			"value", alarm.Value, "baseline", alarm.Baseline)
+	}
+
 	// -----------------------------------
 	// Publishing the events to the zmq
-	st.publishEvents(height, blk)
+	st.publishEvents(height, blk, stateChanges)
+	st.publishAnomalyEvents(alarms)
 
 	return nil
 }
 
+// localPausedValidators returns the paused validators that their keys are in this node,
+// or they sign through a remote signer.
+func (st *state) localPausedValidators(addrs []crypto.Address) []crypto.Address {
+	paused := make([]crypto.Address, 0, len(addrs))
+	for _, addr := range addrs {
+		isLocal := slices.ContainsFunc(st.valKeys, func(key *bls.ValidatorKey) bool {
+			return key.Address() == addr
+		}) || slices.ContainsFunc(st.remoteSigners, func(signer bls.Signer) bool {
+			return signer.PublicKey().ValidatorAddress() == addr
+		})
+		if !isLocal {
+			st.logger.Warn("paused validator is not a validator of this node", "address", addr)
+
+			continue
+		}
+
+		st.logger.Warn("sortition is paused for the validator", "address", addr)
+		paused = append(paused, addr)
+	}
+
+	return paused
+}
+
+// PausedValidators returns the local validators that don't take part in the sortition.
+func (st *state) PausedValidators() []crypto.Address {
+	return st.pausedVals
+}
+
 func (st *state) evaluateSortition() bool {
+	seed := st.lastInfo.SortitionSeed()
+	height := st.lastInfo.BlockHeight()
+
 	evaluated := false
 	for _, key := range st.valKeys {
-		val, _ := st.store.Validator(key.Address())
+		val := st.sortitionValidator(key.Address())
 		if val == nil {
-			// We are not a validator
 			continue
 		}
 
-		if st.lastInfo.BlockHeight()-val.LastBondingHeight() < st.params.BondInterval {
-			// Bonding period
-			continue
+		if st.evaluateSortitionBySigner(bls.NewKeySigner(key), seed, height, st.totalPower, val.Power()) {
+			evaluated = true
 		}
+	}
 
-		if val.UnbondingHeight() > 0 {
-			// we have Unbonded
+	for _, signer := range st.remoteSigners {
+		val := st.sortitionValidator(signer.PublicKey().ValidatorAddress())
+		if val == nil {
 			continue
 		}
 
-		ok, proof := sortition.EvaluateSortition(st.lastInfo.SortitionSeed(), key.PrivateKey(), st.totalPower, val.Power())
-		if ok {
-			trx := tx.NewSortitionTx(st.lastInfo.BlockHeight(), val.Address(), proof)
-			sig := key.Sign(trx.SignBytes())
-			trx.SetSignature(sig)
-			trx.SetPublicKey(key.PublicKey())
+		// The remote signer might take time to respond,
+		// so the sortition is evaluated in the background.
+		go st.evaluateSortitionBySigner(signer, seed, height, st.totalPower, val.Power())
+	}
 
-			err := st.txPool.AppendTxAndBroadcast(trx)
-			if err == nil {
-				st.logger.Info("sortition transaction broadcasted",
-					"address", key.Address(), "power", val.Power(), "tx", trx)
+	return evaluated
+}
 
-				evaluated = true
-			} else {
-				st.logger.Error("our sortition transaction is invalid!",
-					"address", key.Address(), "power", val.Power(), "tx", trx, "error", err)
-			}
-		}
+// sortitionValidator returns the validator with the given address,
+// if it can take part in the sortition.
+func (st *state) sortitionValidator(addr crypto.Address) *validator.Validator {
+	val, _ := st.store.Validator(addr)
+	if val == nil {
+		// We are not a validator
+		return nil
 	}
 
-	return evaluated
+	if slices.Contains(st.pausedVals, addr) {
+		// The validator keeps its committee seat until it is rotated out,
+		// and it is not counted in the availability score afterward.
+		return nil
+	}
+
+	if st.lastInfo.BlockHeight()-val.LastBondingHeight() < st.params.BondInterval {
+		// Bonding period
+		return nil
+	}
+
+	if val.UnbondingHeight() > 0 {
+		// we have Unbonded
+		return nil
+	}
+
+	return val
+}
+
+// evaluateSortitionBySigner evaluates the sortition for the validator of the signer,
+// and broadcasts the sortition transaction if the validator is chosen.
+// It doesn't access the state, except the transaction pool, so it can be called without the lock.
+func (st *state) evaluateSortitionBySigner(signer bls.Signer, seed sortition.VerifiableSeed,
+	height uint32, totalPower, power int64,
+) bool {
+	addr := signer.PublicKey().ValidatorAddress()
+	ok, proof, err := sortition.EvaluateSortitionBySigner(seed, signer, totalPower, power)
+	if err != nil {
+		st.sortitionMisses.Add(1)
+		st.logger.Error("unable to evaluate the sortition", "address", addr, "error", err)
+
+		return false
+	}
+	if !ok {
+		return false
+	}
+
+	trx := tx.NewSortitionTx(height, addr, proof)
+	sig, err := signer.Sign(trx.SignBytes())
+	if err != nil {
+		st.sortitionMisses.Add(1)
+		st.logger.Error("unable to sign the sortition transaction", "address", addr, "error", err)
+
+		return false
+	}
+	trx.SetSignature(sig)
+	trx.SetPublicKey(signer.PublicKey())
+
+	err = st.txPool.AppendTxAndBroadcast(trx)
+	if err != nil {
+		st.sortitionMisses.Add(1)
+		st.logger.Error("our sortition transaction is invalid!",
+			"address", addr, "power", power, "tx", trx, "error", err)
+
+		return false
+	}
+
+	st.logger.Info("sortition transaction broadcasted",
+		"address", addr, "power", power, "tx", trx)
+
+	return true
 }
 
 func (st *state) String() string {
@@ -557,6 +814,18 @@ func (st *state) String() string {
 		st.lastInfo.BlockTime().Format("15.04.05"))
 }
 
+// joinedCommittee returns the number of the validators that join the committee by the sandbox.
+func joinedCommittee(sb sandbox.Sandbox) int {
+	joined := 0
+	sb.IterateValidators(func(_ *validator.Validator, _ bool, j bool) {
+		if j {
+			joined++
+		}
+	})
+
+	return joined
+}
+
 func (st *state) commitSandbox(sb sandbox.Sandbox, round int16) {
 	joiningCommittee := make([]*validator.Validator, 0)
 	sb.IterateValidators(func(val *validator.Validator, _ bool, joined bool) {
@@ -582,41 +851,63 @@ func (st *state) commitSandbox(sb sandbox.Sandbox, round int16) {
 		}
 	})
 
+	sb.IterateAllowlist(func(addr crypto.Address, allowed bool) {
+		st.store.UpdateAllowlist(addr, allowed)
+	})
+
 	st.totalPower += sb.PowerDelta()
 }
 
 func (st *state) validateBlockTime(t time.Time) error {
-	if t.Second()%st.params.BlockIntervalInSecond != 0 {
+	// The block times with milliseconds are not rounded,
+	// but they should be a block interval after the last block time, which is checked below.
+	if st.params.BlockVersion < block.MillisecondTimeVersion &&
+		t.Second()%st.params.BlockIntervalInSecond != 0 {
 		return errors.Errorf(errors.ErrInvalidBlock, "block time (%s) is not rounded", t.String())
 	}
-	if t.Before(st.lastInfo.BlockTime()) {
+	lastBlockTime := st.lastInfo.BlockTime()
+	if t.Before(lastBlockTime) {
 		return errors.Errorf(errors.ErrInvalidBlock, "block time (%s) is before the last block time", t.String())
 	}
-	if t.Equal(st.lastInfo.BlockTime()) {
+	if t.Equal(lastBlockTime) {
 		return errors.Errorf(errors.ErrInvalidBlock, "block time (%s) is same as the last block time", t.String())
 	}
-	proposeTime := st.proposeNextBlockTime()
-	threshold := st.params.BlockInterval()
-	if t.After(proposeTime.Add(threshold)) {
-		return errors.Errorf(errors.ErrInvalidBlock, "block time (%s) is more than threshold (%s)",
-			t.String(), proposeTime.String())
+	// The rounded block times are spaced by the block interval implicitly.
+	// Without it, a proposer could compress the block times, and a transaction that is locked
+	// by the block time could leave the replay cache, that covers a number of the blocks,
+	// while its lock window is still open.
+	if st.params.BlockVersion >= block.MillisecondTimeVersion &&
+		t.Before(lastBlockTime.Add(st.params.BlockInterval())) {
+		return errors.Errorf(errors.ErrInvalidBlock,
+			"block time (%s) is less than a block interval after the last block time", t.String())
+	}
+	// The expected time is smoothed by the median time past,
+	// so a validator with a lagging clock still accepts the blocks that are on time,
+	// while a proposer with a skewed clock can't move the block time ahead.
+	expectedTime := st.medianTime.expectedTime(st.params.BlockInterval())
+	if now := util.Now(); now.After(expectedTime) {
+		expectedTime = now
+	}
+	if t.After(expectedTime.Add(st.params.BlockTimeTolerance())) {
+		return errors.Errorf(errors.ErrInvalidBlock, "block time (%s) is more than tolerance (%s)",
+			t.String(), expectedTime.String())
 	}
 
 	return nil
 }
 
 func (st *state) TotalPower() int64 {
-	st.lk.RLock()
-	defer st.lk.RUnlock()
+	return st.currentView().totalPower
+}
 
-	return st.totalPower
+// MissedSortitions returns the number of the sortitions that our validators have won
+// in this run, but their sortition transactions are rejected.
+func (st *state) MissedSortitions() int64 {
+	return st.currentView().sortitionMisses
 }
 
 func (st *state) CommitteePower() int64 {
-	st.lk.RLock()
-	defer st.lk.RUnlock()
-
-	return st.committee.TotalPower()
+	return st.currentView().committeePower
 }
 
 func (st *state) proposeNextBlockTime() time.Time {
@@ -625,17 +916,18 @@ func (st *state) proposeNextBlockTime() time.Time {
 	now := util.Now()
 	if now.After(timestamp.Add(1 * time.Second)) {
 		st.logger.Debug("it looks the last block had delay", "delay", now.Sub(timestamp))
-		timestamp = util.RoundNow(st.params.BlockIntervalInSecond)
+		if st.params.BlockVersion >= block.MillisecondTimeVersion {
+			timestamp = now.Truncate(time.Millisecond)
+		} else {
+			timestamp = util.RoundNow(st.params.BlockIntervalInSecond)
+		}
 	}
 
 	return timestamp
 }
 
 func (st *state) CommitteeValidators() []*validator.Validator {
-	st.lk.RLock()
-	defer st.lk.RUnlock()
-
-	return st.committee.Validators()
+	return st.currentView().committee.Validators()
 }
 
 func (st *state) TotalAccounts() int32 {
@@ -647,24 +939,15 @@ func (st *state) TotalValidators() int32 {
 }
 
 func (st *state) IsInCommittee(addr crypto.Address) bool {
-	st.lk.RLock()
-	defer st.lk.RUnlock()
-
-	return st.committee.Contains(addr)
+	return st.currentView().committee.Contains(addr)
 }
 
 func (st *state) Proposer(round int16) *validator.Validator {
-	st.lk.RLock()
-	defer st.lk.RUnlock()
-
-	return st.committee.Proposer(round)
+	return st.currentView().committee.Proposer(round)
 }
 
 func (st *state) IsProposer(addr crypto.Address, round int16) bool {
-	st.lk.RLock()
-	defer st.lk.RUnlock()
-
-	return st.committee.IsProposer(addr, round)
+	return st.currentView().committee.IsProposer(addr, round)
 }
 
 func (st *state) IsValidator(addr crypto.Address) bool {
@@ -682,6 +965,19 @@ func (st *state) CommittedBlock(height uint32) *store.CommittedBlock {
 	return b
 }
 
+// HeaderProof returns the block header and the certificate that commits the block.
+// They are retained for the pruned blocks, so the light clients can still verify them.
+func (st *state) HeaderProof(height uint32) *store.HeaderProof {
+	proof, err := st.store.HeaderProof(height)
+	if err != nil {
+		st.logger.Trace("error on retrieving header proof", "error", err)
+
+		return nil
+	}
+
+	return proof
+}
+
 func (st *state) CommittedTx(id tx.ID) *store.CommittedTx {
 	transaction, err := st.store.Transaction(id)
 	if err != nil {
@@ -691,6 +987,69 @@ func (st *state) CommittedTx(id tx.ID) *store.CommittedTx {
 	return transaction
 }
 
+func (st *state) TransactionReceipt(id tx.ID) *receipt.Receipt {
+	rcpt, err := st.store.Receipt(id)
+	if err != nil {
+		st.logger.Trace("searching transaction receipt in local store failed", "id", id, "error", err)
+	}
+
+	return rcpt
+}
+
+func (st *state) BlockBloom(height uint32) *bloom.Filter {
+	filter, err := st.store.Bloom(height)
+	if err != nil {
+		st.logger.Trace("searching block bloom filter in local store failed", "height", height, "error", err)
+	}
+
+	return filter
+}
+
+// Beacon returns the randomness beacon of the block at the given height,
+// or an undefined hash if the beacon is not final yet.
+// The certificate of the last block can still get more votes, therefore the beacon
+// of a block is only final once its certificate is committed in the next block.
+func (st *state) Beacon(height uint32) hash.Hash {
+	if height == 0 || height >= st.LastBlockHeight() {
+		return hash.UndefHash
+	}
+
+	cert, err := st.store.Certificate(height)
+	if err == nil {
+		return cert.Beacon()
+	}
+
+	// Blocks committed before the certificates were stored separately,
+	// have their certificate only in the next block.
+	committedBlock, err := st.store.Block(height + 1)
+	if err != nil {
+		st.logger.Trace("searching block in local store failed", "height", height+1, "error", err)
+
+		return hash.UndefHash
+	}
+
+	blk, err := committedBlock.ToBlock()
+	if err != nil {
+		return hash.UndefHash
+	}
+
+	return blk.PrevCertificate().Beacon()
+}
+
+func (st *state) CompactFilter(height uint32) *gcs.Filter {
+	filter, err := st.store.CompactFilter(height)
+	if err != nil {
+		st.logger.Trace("searching compact filter in local store failed", "height", height, "error", err)
+	}
+
+	return filter
+}
+
+// StoreFamilies returns the retention and the size of the column families of the store.
+func (st *state) StoreFamilies() []store.FamilyInfo {
+	return st.store.FamilyInfos()
+}
+
 func (st *state) BlockHash(height uint32) hash.Hash {
 	return st.store.BlockHash(height)
 }
@@ -735,6 +1094,18 @@ func (st *state) PendingTx(id tx.ID) *tx.Tx {
 	return st.txPool.PendingTx(id)
 }
 
+func (st *state) PendingTxsCount() int {
+	return st.txPool.Size()
+}
+
+func (st *state) PendingTxsSnapshot() (uint64, []tx.ID) {
+	return st.txPool.Snapshot()
+}
+
+func (st *state) PendingTxsChangesSince(seq uint64) ([]txpool.Change, uint64, bool) {
+	return st.txPool.ChangesSince(seq)
+}
+
 func (st *state) AddPendingTx(trx *tx.Tx) error {
 	return st.txPool.AppendTx(trx)
 }
@@ -748,13 +1119,17 @@ func (st *state) Params() *param.Params {
 }
 
 // publishEvents publishes block related events.
-func (st *state) publishEvents(height uint32, blk *block.Block) {
+func (st *state) publishEvents(height uint32, blk *block.Block, stateChanges []event.Event) {
 	if st.eventCh == nil {
 		return
 	}
 	blockEvent := event.CreateBlockEvent(blk.Hash(), height)
 	st.eventCh <- blockEvent
 
+	for _, e := range stateChanges {
+		st.eventCh <- e
+	}
+
 	for i := 1; i < blk.Transactions().Len(); i++ {
 		transaction := blk.Transactions().Get(i)
 
@@ -768,27 +1143,185 @@ func (st *state) publishEvents(height uint32, blk *block.Block) {
 
 		TxEvent := event.CreateTransactionEvent(transaction.ID(), height)
 		st.eventCh <- TxEvent
+		txtrace.Record(transaction.ID(), txtrace.StagePublished)
 	}
 }
 
-func (st *state) CalculateFee(amount int64, payloadType payload.Type) (int64, error) {
-	switch payloadType {
-	case payload.TypeTransfer,
-		payload.TypeBond,
-		payload.TypeWithdraw:
-
-		return execution.CalculateFee(amount, st.params), nil
+// publishAnomalyEvents publishes the anomalies that are detected in the new block.
+func (st *state) publishAnomalyEvents(alarms []anomaly.Alarm) {
+	if st.eventCh == nil {
+		return
+	}
 
-	case payload.TypeUnbond,
-		payload.TypeSortition:
+	for _, alarm := range alarms {
+		st.eventCh <- event.CreateAnomalyEvent(alarm.Height, uint8(alarm.Kind), alarm.Value, alarm.Baseline)
+	}
+}
 
-		return 0, nil
+// publishForkEvent publishes the fork detected event, without blocking,
+// since the node stops right after detecting a fork.
+func (st *state) publishForkEvent(prevBlockHash hash.Hash) {
+	if st.eventCh == nil {
+		return
+	}
 
+	e := event.CreateForkDetectedEvent(st.lastInfo.BlockHeight()+1, prevBlockHash, st.lastInfo.BlockHash())
+	select {
+	case st.eventCh <- e:
 	default:
-		return 0, errors.Errorf(errors.ErrInvalidTx, "unexpected tx type: %v", payloadType)
 	}
 }
 
+// stateChangeEvents creates the events for the changes that the block makes in the state:
+// the reward payout, the treasury accounting, the balance changes of the accounts and
+// the stake changes of the validators.
+// It should be called before committing the sandbox, since it compares the sandbox against the store.
+// The balance and stake events are sorted by address to keep them deterministic.
+func (st *state) stateChangeEvents(height uint32, blk *block.Block,
+	rcpts []*receipt.Receipt, sb sandbox.Sandbox,
+) []event.Event {
+	if st.eventCh == nil {
+		return nil
+	}
+
+	subsidyTx := blk.Transactions()[0]
+	events := []event.Event{
+		event.CreateRewardPayoutEvent(*subsidyTx.Payload().Receiver(), height, subsidyTx.Payload().Value()),
+	}
+
+	if info, err := reward.Calculate(height, blk, rcpts[0]); err == nil {
+		events = append(events, event.CreateTreasuryEvent(height, info.BlockReward, info.Fees,
+			info.TreasuryBalanceBefore, info.TreasuryBalanceAfter))
+	} else {
+		st.logger.Error("unable to calculate the block reward", "height", height, "error", err)
+	}
+
+	accAddrs := make([]crypto.Address, 0)
+	accEvents := make(map[crypto.Address]event.Event)
+	sb.IterateAccounts(func(addr crypto.Address, acc *account.Account, updated bool) {
+		if !updated {
+			return
+		}
+
+		oldBalance := int64(0)
+		if oldAcc, err := st.store.Account(addr); err == nil {
+			oldBalance = oldAcc.Balance()
+		}
+		if oldBalance != acc.Balance() {
+			accAddrs = append(accAddrs, addr)
+			accEvents[addr] = event.CreateBalanceChangeEvent(addr, height, oldBalance, acc.Balance())
+		}
+	})
+
+	valAddrs := make([]crypto.Address, 0)
+	valEvents := make(map[crypto.Address]event.Event)
+	sb.IterateValidators(func(val *validator.Validator, updated bool, _ bool) {
+		if !updated {
+			return
+		}
+
+		oldStake := int64(0)
+		if oldVal, err := st.store.Validator(val.Address()); err == nil {
+			oldStake = oldVal.Stake()
+		}
+		if oldStake != val.Stake() {
+			valAddrs = append(valAddrs, val.Address())
+			valEvents[val.Address()] = event.CreateValidatorStakeEvent(val.Address(), height, oldStake, val.Stake())
+		}
+	})
+
+	compareAddress := func(a, b crypto.Address) int {
+		return bytes.Compare(a.Bytes(), b.Bytes())
+	}
+	slices.SortFunc(accAddrs, compareAddress)
+	slices.SortFunc(valAddrs, compareAddress)
+
+	for _, addr := range accAddrs {
+		events = append(events, accEvents[addr])
+	}
+	for _, addr := range valAddrs {
+		events = append(events, valEvents[addr])
+	}
+
+	return events
+}
+
+// snapshotChangeSet keeps the values of the accounts and validators before they are changed by the block.
+// It should be called before committing the sandbox.
+func (st *state) snapshotChangeSet(height uint32, sb sandbox.Sandbox) *snapshot.ChangeSet {
+	cs := snapshot.NewChangeSet(height)
+	sb.IterateAccounts(func(addr crypto.Address, _ *account.Account, updated bool) {
+		if updated {
+			prevAcc, _ := st.store.Account(addr)
+			cs.AddAccount(addr, prevAcc)
+		}
+	})
+
+	sb.IterateValidators(func(val *validator.Validator, updated bool, _ bool) {
+		if updated {
+			prevVal, _ := st.store.Validator(val.Address())
+			cs.AddValidator(val.Address(), prevVal)
+		}
+	})
+
+	return cs
+}
+
+// SnapshotHeight returns the height of the recent block that the state root belongs to.
+func (st *state) SnapshotHeight(stateRoot hash.Hash) (uint32, error) {
+	st.lk.RLock()
+	defer st.lk.RUnlock()
+
+	height, ok := st.snapshotMgr.Height(stateRoot)
+	if !ok {
+		return 0, store.ErrNotFound
+	}
+
+	return height, nil
+}
+
+// AccountByAddressAt returns the account as it was at the given height.
+// It returns nil if the account did not exist at that height.
+func (st *state) AccountByAddressAt(addr crypto.Address, height uint32) (*account.Account, error) {
+	st.lk.RLock()
+	defer st.lk.RUnlock()
+
+	acc, _ := st.store.Account(addr)
+
+	return st.snapshotMgr.Account(height, addr, acc)
+}
+
+// ValidatorByAddressAt returns the validator as it was at the given height.
+// It returns nil if the validator did not exist at that height.
+func (st *state) ValidatorByAddressAt(addr crypto.Address, height uint32) (*validator.Validator, error) {
+	st.lk.RLock()
+	defer st.lk.RUnlock()
+
+	val, _ := st.store.Validator(addr)
+
+	return st.snapshotMgr.Validator(height, addr, val)
+}
+
+// ValidatorByNumberAt returns the validator as it was at the given height.
+// It returns nil if the validator did not exist at that height.
+func (st *state) ValidatorByNumberAt(n int32, height uint32) (*validator.Validator, error) {
+	st.lk.RLock()
+	defer st.lk.RUnlock()
+
+	val, err := st.store.ValidatorByNumber(n)
+	if err != nil {
+		// The numbers are assigned incrementally, then
+		// a validator that doesn't exist now didn't exist before.
+		return nil, st.snapshotMgr.CheckHeight(height)
+	}
+
+	return st.snapshotMgr.Validator(height, val.Address(), val)
+}
+
+func (st *state) CalculateFee(amount int64, payloadType payload.Type) (int64, error) {
+	return st.engine.CalculateFee(amount, payloadType, st.params)
+}
+
 func (st *state) PublicKey(addr crypto.Address) (crypto.PublicKey, error) {
 	st.lk.RLock()
 	defer st.lk.RUnlock()
@@ -802,3 +1335,54 @@ func (st *state) AvailabilityScore(valNum int32) float64 {
 
 	return st.scoreMgr.AvailabilityScore(valNum)
 }
+
+// RewardInfo returns the block reward and the treasury accounting of the block at the given height.
+// It returns nil if the block or its subsidy receipt is not found.
+func (st *state) RewardInfo(height uint32) *reward.Info {
+	st.lk.RLock()
+	defer st.lk.RUnlock()
+
+	return rewardInfo(st.store, height)
+}
+
+// CommitteeAt reconstructs the committee that signed the block at the given height.
+// The validators are read as they were before committing the block, if that height
+// is still in the snapshot window. Otherwise, their latest state is used.
+func (st *state) CommitteeAt(height uint32) (committee.Reader, error) {
+	st.lk.RLock()
+	defer st.lk.RUnlock()
+
+	validatorByNumber := func(num int32) (*validator.Validator, error) {
+		val, err := st.store.ValidatorByNumber(num)
+		if err != nil {
+			return nil, err
+		}
+		if st.snapshotMgr.CheckHeight(height-1) != nil {
+			return val, nil
+		}
+
+		return st.snapshotMgr.Validator(height-1, val.Address(), val)
+	}
+
+	return historicalCommittee(st.store, height, st.params.CommitteeSize, validatorByNumber)
+}
+
+// ParticipationReport returns the unsigned participation report of the validator.
+// The store is read without holding the state lock, so committing blocks is not blocked by long ranges.
+func (st *state) ParticipationReport(addr crypto.Address, from, to uint32) (*participation.Report, error) {
+	return participationReport(st.store, addr, from, to)
+}
+
+// FeeHistogram returns the histogram of the transaction fees in the blocks
+// from the given height up to the given height, inclusive.
+// The store is read without holding the state lock, so committing blocks is not blocked by long ranges.
+func (st *state) FeeHistogram(from, to uint32) (*FeeHistogram, error) {
+	return feeHistogram(st.store, from, to)
+}
+
+func (st *state) ChainStatistics(window uint32) *statistics.Statistics {
+	st.lk.RLock()
+	defer st.lk.RUnlock()
+
+	return st.statsMgr.Statistics(window)
+}
@@ -1,26 +1,32 @@
 package state
 
 import (
+	"bytes"
 	"testing"
 	"time"
 
 	"github.com/pactus-project/pactus/crypto"
 	"github.com/pactus-project/pactus/crypto/bls"
 	"github.com/pactus-project/pactus/crypto/hash"
+	"github.com/pactus-project/pactus/execution"
 	"github.com/pactus-project/pactus/genesis"
+	"github.com/pactus-project/pactus/state/snapshot"
 	"github.com/pactus-project/pactus/store"
 	"github.com/pactus-project/pactus/txpool"
 	"github.com/pactus-project/pactus/types/account"
 	"github.com/pactus-project/pactus/types/block"
 	"github.com/pactus-project/pactus/types/certificate"
 	"github.com/pactus-project/pactus/types/param"
+	"github.com/pactus-project/pactus/types/receipt"
 	"github.com/pactus-project/pactus/types/tx"
 	"github.com/pactus-project/pactus/types/tx/payload"
 	"github.com/pactus-project/pactus/types/validator"
 	"github.com/pactus-project/pactus/types/vote"
 	"github.com/pactus-project/pactus/util"
+	"github.com/pactus-project/pactus/util/encoding"
 	"github.com/pactus-project/pactus/util/errors"
 	"github.com/pactus-project/pactus/util/testsuite"
+	"github.com/pactus-project/pactus/www/nanomsg/event"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"golang.org/x/exp/slices"
@@ -73,7 +79,7 @@ func setup(t *testing.T) *testData {
 	gnDoc := genesis.MakeGenesis(genTime, genAccs, genVals, params)
 
 	valKeys := []*bls.ValidatorKey{ts.RandValKey(), ts.RandValKey()}
-	st1, err := LoadOrNewState(gnDoc, valKeys, mockStore, mockTxPool, nil)
+	st1, err := LoadOrNewState(DefaultConfig(), gnDoc, valKeys, mockStore, mockTxPool, nil)
 	require.NoError(t, err)
 
 	state, _ := st1.(*state)
@@ -101,7 +107,7 @@ func (td *testData) makeBlockAndCertificate(t *testing.T, round int16) (
 		return E.Address() == blockProposer.Address()
 	})
 	valKey := td.genValKeys[valKeyIndex]
-	blk, _ := td.state.ProposeBlock(valKey, td.RandAccAddress())
+	blk, _ := td.state.ProposeBlock(bls.NewKeySigner(valKey), td.RandAccAddress())
 	cert := td.makeCertificateAndSign(t, blk.Hash(), round)
 
 	return blk, cert
@@ -401,6 +407,7 @@ func TestSortition(t *testing.T) {
 
 	trx := tx.NewBondTx(1, td.genAccKey.PublicKeyNative().AccountAddress(),
 		myValKey.Address(), myValKey.PublicKey(), 1000000000, 100000, "")
+	td.HelperSetProofOfPossession(myValKey.PrivateKey(), trx)
 	td.HelperSignTransaction(td.genAccKey, trx)
 	assert.NoError(t, td.state.AddPendingTx(trx))
 
@@ -414,8 +421,13 @@ func TestSortition(t *testing.T) {
 	// Committing another 10 blocks
 	td.commitBlocks(t, 10)
 
+	td.state.pausedVals = []crypto.Address{myValKey.Address()}
+	assert.False(t, td.state.evaluateSortition()) //  paused
+	td.state.pausedVals = nil
+
 	assert.True(t, td.state.evaluateSortition())                     //  ok
 	assert.False(t, td.state.committee.Contains(myValKey.Address())) // still not in the committee
+	assert.Zero(t, td.state.MissedSortitions())
 
 	td.commitBlocks(t, 1)
 
@@ -424,6 +436,94 @@ func TestSortition(t *testing.T) {
 	assert.True(t, td.state.committee.Contains(myValKey.Address())) // In the committee
 }
 
+// failingSigner is a signer that always fails, like an unavailable remote signer.
+type failingSigner struct {
+	pubKey *bls.PublicKey
+}
+
+func (s *failingSigner) PublicKey() *bls.PublicKey {
+	return s.pubKey
+}
+
+func (*failingSigner) Sign(_ []byte) (*bls.Signature, error) {
+	return nil, errors.Errorf(errors.ErrGeneric, "signer is not available")
+}
+
+func TestSortitionBySigner(t *testing.T) {
+	td := setup(t)
+
+	remoteKey := td.RandValKey()
+	trx := tx.NewBondTx(1, td.genAccKey.PublicKeyNative().AccountAddress(),
+		remoteKey.Address(), remoteKey.PublicKey(), 1000000000, 100000, "")
+	td.HelperSetProofOfPossession(remoteKey.PrivateKey(), trx)
+	td.HelperSignTransaction(td.genAccKey, trx)
+	assert.NoError(t, td.state.AddPendingTx(trx))
+
+	td.commitBlocks(t, 11)
+
+	seed := td.state.lastInfo.SortitionSeed()
+	height := td.state.lastInfo.BlockHeight()
+	val := td.state.sortitionValidator(remoteKey.Address())
+	require.NotNil(t, val)
+
+	t.Run("Signer is not available", func(t *testing.T) {
+		signer := &failingSigner{pubKey: remoteKey.PublicKey()}
+		assert.False(t, td.state.evaluateSortitionBySigner(signer, seed, height, td.state.totalPower, val.Power()))
+		assert.Equal(t, int64(1), td.state.sortitionMisses.Load())
+	})
+
+	t.Run("Ok", func(t *testing.T) {
+		signer := bls.NewKeySigner(remoteKey)
+		assert.True(t, td.state.evaluateSortitionBySigner(signer, seed, height, td.state.totalPower, val.Power()))
+
+		td.commitBlocks(t, 1)
+		assert.True(t, td.state.committee.Contains(remoteKey.Address()))
+	})
+}
+
+func TestProposeBlockBySigner(t *testing.T) {
+	td := setup(t)
+
+	t.Run("Signer is not available", func(t *testing.T) {
+		signer := &failingSigner{pubKey: td.state.valKeys[0].PublicKey()}
+		_, err := td.state.ProposeBlock(signer, td.RandAccAddress())
+		assert.Error(t, err)
+	})
+
+	t.Run("Ok", func(t *testing.T) {
+		remoteKey := td.RandValKey()
+		blk, err := td.state.ProposeBlock(bls.NewKeySigner(remoteKey), td.RandAccAddress())
+		require.NoError(t, err)
+		assert.Equal(t, remoteKey.Address(), blk.Header().ProposerAddress())
+
+		prevSeed := td.state.lastInfo.SortitionSeed()
+		seed := blk.Header().SortitionSeed()
+		assert.True(t, seed.Verify(remoteKey.PublicKey(), prevSeed))
+	})
+}
+
+func TestLocalPausedValidators(t *testing.T) {
+	td := setup(t)
+
+	myValAddr := td.state.valKeys[1].Address()
+	remoteKey := td.RandValKey()
+	td.state.remoteSigners = []bls.Signer{bls.NewKeySigner(remoteKey)}
+	paused := td.state.localPausedValidators([]crypto.Address{
+		td.RandValAddress(), myValAddr, remoteKey.Address(),
+	})
+	assert.Equal(t, []crypto.Address{myValAddr, remoteKey.Address()}, paused)
+}
+
+// setRecentBlockTimes sets the times of the recent blocks, starting from the given time.
+func (td *testData) setRecentBlockTimes(start time.Time, interval time.Duration, count int) {
+	td.state.medianTime = newMedianTime(td.state.params.MedianTimeBlocks())
+	for i := 0; i < count; i++ {
+		td.state.medianTime.addBlockTime(start.Add(time.Duration(i) * interval))
+	}
+	td.state.lastInfo.UpdateBlockTime(start.Add(time.Duration(count-1) * interval))
+	td.state.publishView()
+}
+
 func TestValidateBlockTime(t *testing.T) {
 	td := setup(t)
 
@@ -437,7 +537,7 @@ func TestValidateBlockTime(t *testing.T) {
 	})
 
 	t.Run("Last block is committed 10 seconds ago", func(t *testing.T) {
-		td.state.lastInfo.UpdateBlockTime(roundedNow.Add(-10 * time.Second))
+		td.setRecentBlockTimes(roundedNow.Add(-100*time.Second), 10*time.Second, 10)
 
 		// Before or same as the last block time
 		assert.Error(t, td.state.validateBlockTime(roundedNow.Add(-20*time.Second)))
@@ -445,9 +545,8 @@ func TestValidateBlockTime(t *testing.T) {
 
 		// Ok
 		assert.NoError(t, td.state.validateBlockTime(roundedNow))
-		assert.NoError(t, td.state.validateBlockTime(roundedNow.Add(10*time.Second)))
 
-		// More than the threshold
+		// More than the tolerance
 		assert.Error(t, td.state.validateBlockTime(roundedNow.Add(20*time.Second)))
 
 		expectedProposeTime := roundedNow
@@ -455,7 +554,7 @@ func TestValidateBlockTime(t *testing.T) {
 	})
 
 	t.Run("Last block is committed one minute ago", func(t *testing.T) {
-		td.state.lastInfo.UpdateBlockTime(roundedNow.Add(-1 * time.Minute)) // One minute ago
+		td.setRecentBlockTimes(roundedNow.Add(-150*time.Second), 10*time.Second, 10)
 		lastBlockTime := td.state.LastBlockTime()
 
 		// Before or same as the last block time
@@ -465,36 +564,93 @@ func TestValidateBlockTime(t *testing.T) {
 		// Ok
 		assert.NoError(t, td.state.validateBlockTime(roundedNow.Add(-10*time.Second)))
 		assert.NoError(t, td.state.validateBlockTime(roundedNow))
-		assert.NoError(t, td.state.validateBlockTime(roundedNow.Add(10*time.Second)))
 
-		// More than the threshold
+		// More than the tolerance
 		assert.Error(t, td.state.validateBlockTime(roundedNow.Add(20*time.Second)))
 
 		expectedProposeTime := roundedNow
 		assert.Equal(t, expectedProposeTime, td.state.proposeNextBlockTime())
 	})
 
-	t.Run("Last block is committed in future", func(t *testing.T) {
-		td.state.lastInfo.UpdateBlockTime(roundedNow.Add(1 * time.Minute)) // One minute later
+	t.Run("Blocks are committed in future", func(t *testing.T) {
+		td.setRecentBlockTimes(roundedNow.Add(1*time.Minute), 10*time.Second, 10)
 		lastBlockTime := td.state.LastBlockTime()
 
-		assert.Error(t, td.state.validateBlockTime(lastBlockTime.Add(+1*time.Minute)))
-
 		// Before the last block time
 		assert.Error(t, td.state.validateBlockTime(lastBlockTime.Add(-10*time.Second)))
 		assert.Error(t, td.state.validateBlockTime(lastBlockTime))
 
-		// Ok
+		// Ok, the expected time is based on the median time past
 		assert.NoError(t, td.state.validateBlockTime(lastBlockTime.Add(10*time.Second)))
-		assert.NoError(t, td.state.validateBlockTime(lastBlockTime.Add(20*time.Second)))
 
-		// More than the threshold
-		assert.Error(t, td.state.validateBlockTime(lastBlockTime.Add(30*time.Second)))
+		// More than the tolerance
+		assert.Error(t, td.state.validateBlockTime(lastBlockTime.Add(20*time.Second)))
 
-		expectedProposeTime := roundedNow.Add(1 * time.Minute).Add(
+		expectedProposeTime := lastBlockTime.Add(
 			time.Duration(td.state.params.BlockIntervalInSecond) * time.Second)
 		assert.Equal(t, expectedProposeTime, td.state.proposeNextBlockTime())
 	})
+
+	t.Run("Last block is skewed", func(t *testing.T) {
+		td.setRecentBlockTimes(roundedNow.Add(-90*time.Second), 10*time.Second, 9)
+		td.state.medianTime.addBlockTime(roundedNow.Add(1 * time.Minute))
+		td.state.lastInfo.UpdateBlockTime(roundedNow.Add(1 * time.Minute))
+		td.state.publishView()
+		lastBlockTime := td.state.LastBlockTime()
+
+		// The skewed block time doesn't move the expected time ahead.
+		assert.Error(t, td.state.validateBlockTime(lastBlockTime.Add(10*time.Second)))
+	})
+
+	t.Run("Boundary of the last block time", func(t *testing.T) {
+		td.setRecentBlockTimes(roundedNow.Add(-100*time.Second), 10*time.Second, 10)
+		lastBlockTime := td.state.LastBlockTime()
+
+		// The median time past is before the last block time,
+		// so the block times after the last block time are after the median time past too.
+		median, _ := td.state.medianTime.medianTimePast()
+		assert.True(t, median.Before(lastBlockTime))
+
+		assert.Error(t, td.state.validateBlockTime(lastBlockTime.Add(-10*time.Second)))
+		assert.Error(t, td.state.validateBlockTime(lastBlockTime))
+		assert.NoError(t, td.state.validateBlockTime(lastBlockTime.Add(10*time.Second)))
+	})
+
+	t.Run("Tolerance", func(t *testing.T) {
+		// The blocks are in future, so the expected time doesn't depend on the local clock.
+		td.setRecentBlockTimes(roundedNow.Add(1*time.Minute), 10*time.Second, 10)
+		lastBlockTime := td.state.LastBlockTime()
+
+		prevTolerance := td.state.params.BlockTimeToleranceInSecond
+		defer func() { td.state.params.BlockTimeToleranceInSecond = prevTolerance }()
+
+		td.state.params.BlockTimeToleranceInSecond = 1
+		assert.Error(t, td.state.validateBlockTime(lastBlockTime.Add(20*time.Second)))
+
+		td.state.params.BlockTimeToleranceInSecond = 10
+		assert.NoError(t, td.state.validateBlockTime(lastBlockTime.Add(20*time.Second)))
+	})
+
+	t.Run("Millisecond time version", func(t *testing.T) {
+		prevVersion := td.state.params.BlockVersion
+		td.state.params.BlockVersion = block.MillisecondTimeVersion
+		defer func() { td.state.params.BlockVersion = prevVersion }()
+
+		td.setRecentBlockTimes(roundedNow.Add(-100*time.Second), 10*time.Second, 10)
+		lastBlockTime := td.state.LastBlockTime()
+
+		// Not rounded, but at least a block interval after the last block time
+		assert.Error(t, td.state.validateBlockTime(lastBlockTime))
+		assert.Error(t, td.state.validateBlockTime(lastBlockTime.Add(time.Millisecond)))
+		assert.Error(t, td.state.validateBlockTime(lastBlockTime.Add(9999*time.Millisecond)))
+		assert.NoError(t, td.state.validateBlockTime(lastBlockTime.Add(10*time.Second)))
+		assert.NoError(t, td.state.validateBlockTime(roundedNow.Add(2500*time.Millisecond)))
+
+		// The last block had delay, so the proposed time is now, in milliseconds.
+		proposeTime := td.state.proposeNextBlockTime()
+		assert.Equal(t, proposeTime, proposeTime.Truncate(time.Millisecond))
+		assert.True(t, proposeTime.After(roundedNow.Add(-time.Second)))
+	})
 }
 
 func TestValidatorHelpers(t *testing.T) {
@@ -527,10 +683,11 @@ func TestLoadState(t *testing.T) {
 	td := setup(t)
 
 	// Add a bond transactions to change total power (stake)
-	pub, _ := td.RandBLSKeyPair()
+	pub, prv := td.RandBLSKeyPair()
 	lockTime := td.state.LastBlockHeight()
 	bondTrx := tx.NewBondTx(lockTime, td.genAccKey.PublicKeyNative().AccountAddress(),
 		pub.ValidatorAddress(), pub, 1000000000, 100000, "")
+	td.HelperSetProofOfPossession(prv, bondTrx)
 	td.HelperSignTransaction(td.genAccKey, bondTrx)
 
 	assert.NoError(t, td.state.AddPendingTx(bondTrx))
@@ -542,7 +699,7 @@ func TestLoadState(t *testing.T) {
 	blk6, cert6 := td.makeBlockAndCertificate(t, 0)
 
 	// Load last state info
-	newState, err := LoadOrNewState(td.state.genDoc, td.state.valKeys,
+	newState, err := LoadOrNewState(DefaultConfig(), td.state.genDoc, td.state.valKeys,
 		td.state.store, td.commonTxPool, nil)
 	require.NoError(t, err)
 
@@ -561,10 +718,458 @@ func TestLoadState(t *testing.T) {
 	require.NoError(t, newState.CommitBlock(blk6, cert6))
 }
 
+func TestChainStatistics(t *testing.T) {
+	td := setup(t)
+
+	stats := td.state.ChainStatistics(100)
+	require.NotNil(t, stats)
+	assert.Equal(t, uint32(1), stats.FromHeight)
+	assert.Equal(t, uint32(10), stats.ToHeight)
+	assert.Zero(t, stats.TotalTransactions)
+
+	stats = td.state.ChainStatistics(4)
+	require.NotNil(t, stats)
+	assert.Equal(t, uint32(7), stats.FromHeight)
+	assert.Equal(t, uint32(10), stats.ToHeight)
+
+	// Statistics should be restored after loading the state
+	newState, err := LoadOrNewState(DefaultConfig(), td.state.genDoc, td.state.valKeys,
+		td.state.store, td.commonTxPool, nil)
+	require.NoError(t, err)
+
+	assert.Equal(t, td.state.ChainStatistics(100), newState.ChainStatistics(100))
+}
+
+func TestStateChangeEvents(t *testing.T) {
+	td := setup(t)
+
+	eventCh := make(chan event.Event, 100)
+	td.state.eventCh = eventCh
+
+	senderAddr := td.genAccKey.PublicKeyNative().AccountAddress()
+	pub, prv := td.RandBLSKeyPair()
+	amt, fee := int64(1000000000), int64(100000)
+	bondTrx := tx.NewBondTx(td.state.LastBlockHeight(), senderAddr,
+		pub.ValidatorAddress(), pub, amt, fee, "")
+	td.HelperSetProofOfPossession(prv, bondTrx)
+	td.HelperSignTransaction(td.genAccKey, bondTrx)
+	assert.NoError(t, td.state.AddPendingTx(bondTrx))
+
+	senderBalance := td.state.AccountByAddress(senderAddr).Balance()
+	treasuryBalance := td.state.AccountByAddress(crypto.TreasuryAddress).Balance()
+	blk, cert := td.makeBlockAndCertificate(t, 0)
+	require.NoError(t, td.state.CommitBlock(blk, cert))
+	close(eventCh)
+
+	topics := make([]uint16, 0)
+	for e := range eventCh {
+		r := bytes.NewReader(e)
+		var topic uint16
+		require.NoError(t, encoding.ReadElement(r, &topic))
+		topics = append(topics, topic)
+
+		switch topic {
+		case event.TopicRewardPayout:
+			var addr crypto.Address
+			var height uint32
+			var amount int64
+			require.NoError(t, encoding.ReadElements(r, &addr, &height, &amount))
+
+			subsidyTx := blk.Transactions()[0]
+			assert.Equal(t, *subsidyTx.Payload().Receiver(), addr)
+			assert.Equal(t, cert.Height(), height)
+			assert.Equal(t, subsidyTx.Payload().Value(), amount)
+
+		case event.TopicTreasury:
+			var height uint32
+			var blockReward, fees, balanceBefore, balanceAfter int64
+			require.NoError(t, encoding.ReadElements(r, &height, &blockReward, &fees, &balanceBefore, &balanceAfter))
+
+			assert.Equal(t, cert.Height(), height)
+			assert.Equal(t, td.state.params.BlockReward, blockReward)
+			assert.Equal(t, fee, fees)
+			assert.Equal(t, treasuryBalance, balanceBefore)
+			assert.Equal(t, td.state.AccountByAddress(crypto.TreasuryAddress).Balance(), balanceAfter)
+
+		case event.TopicBalanceChange:
+			var addr crypto.Address
+			var height uint32
+			var oldBalance, newBalance int64
+			require.NoError(t, encoding.ReadElements(r, &addr, &height, &oldBalance, &newBalance))
+
+			assert.Equal(t, td.state.AccountByAddress(addr).Balance(), newBalance)
+			if addr == senderAddr {
+				assert.Equal(t, senderBalance, oldBalance)
+				assert.Equal(t, senderBalance-amt-fee, newBalance)
+			}
+
+		case event.TopicValidatorStake:
+			var addr crypto.Address
+			var height uint32
+			var oldStake, newStake int64
+			require.NoError(t, encoding.ReadElements(r, &addr, &height, &oldStake, &newStake))
+
+			assert.Equal(t, pub.ValidatorAddress(), addr)
+			assert.Zero(t, oldStake)
+			assert.Equal(t, amt, newStake)
+		}
+	}
+
+	// Block event, reward payout, treasury, balance changes for the treasury, the proposer and the sender,
+	// stake change of the new validator, and transaction events.
+	assert.Equal(t, event.TopicBlock, topics[0])
+	assert.Equal(t, event.TopicRewardPayout, topics[1])
+	assert.Equal(t, event.TopicTreasury, topics[2])
+	assert.Equal(t, event.TopicBalanceChange, topics[3])
+	assert.Equal(t, event.TopicBalanceChange, topics[4])
+	assert.Equal(t, event.TopicBalanceChange, topics[5])
+	assert.Equal(t, event.TopicValidatorStake, topics[6])
+}
+
+func TestRewardInfo(t *testing.T) {
+	td := setup(t)
+
+	senderAddr := td.genAccKey.PublicKeyNative().AccountAddress()
+	receiverAddr := td.RandAccAddress()
+	amt, fee := int64(1000000000), int64(100000)
+	transferTrx := tx.NewTransferTx(td.state.LastBlockHeight(), senderAddr,
+		receiverAddr, amt, fee, "")
+	td.HelperSignTransaction(td.genAccKey, transferTrx)
+	assert.NoError(t, td.state.AddPendingTx(transferTrx))
+
+	treasuryBalance := td.state.AccountByAddress(crypto.TreasuryAddress).Balance()
+	blk, cert := td.makeBlockAndCertificate(t, 0)
+	require.NoError(t, td.state.CommitBlock(blk, cert))
+
+	t.Run("Should return the reward info", func(t *testing.T) {
+		info := td.state.RewardInfo(cert.Height())
+		require.NotNil(t, info)
+
+		assert.Equal(t, cert.Height(), info.Height)
+		assert.Equal(t, *blk.Transactions()[0].Payload().Receiver(), info.RewardAddress)
+		assert.Equal(t, td.state.params.BlockReward, info.BlockReward)
+		assert.Equal(t, fee, info.Fees)
+		assert.Equal(t, blk.Transactions()[0].Payload().Value(), info.TotalPayout())
+		assert.Equal(t, treasuryBalance, info.TreasuryBalanceBefore)
+		assert.Equal(t, td.state.AccountByAddress(crypto.TreasuryAddress).Balance(), info.TreasuryBalanceAfter)
+		assert.Equal(t, -td.state.params.BlockReward, info.TreasuryChange())
+	})
+
+	t.Run("Should be continuous with the previous block", func(t *testing.T) {
+		prevInfo := td.state.RewardInfo(cert.Height() - 1)
+		info := td.state.RewardInfo(cert.Height())
+		require.NotNil(t, prevInfo)
+
+		assert.Equal(t, prevInfo.TreasuryBalanceAfter, info.TreasuryBalanceBefore)
+	})
+
+	t.Run("Unknown block", func(t *testing.T) {
+		assert.Nil(t, td.state.RewardInfo(cert.Height()+1))
+	})
+}
+
+func TestCommitteeAt(t *testing.T) {
+	td := setup(t)
+
+	type committeeInfo struct {
+		committers []int32
+		proposer   *validator.Validator
+	}
+	infos := make(map[uint32]committeeInfo)
+	for round := int16(0); round < 4; round++ {
+		height := td.state.LastBlockHeight() + 1
+		infos[height] = committeeInfo{
+			committers: td.state.committee.Committers(),
+			proposer:   td.state.Proposer(0),
+		}
+
+		blk, cert := td.makeBlockAndCertificate(t, round)
+		require.NoError(t, td.state.CommitBlock(blk, cert))
+	}
+
+	checkCommittee := func(t *testing.T, st Facade) {
+		t.Helper()
+
+		for height, info := range infos {
+			cmt, err := st.CommitteeAt(height)
+			require.NoError(t, err)
+
+			assert.Equal(t, info.committers, cmt.Committers())
+			assert.Equal(t, info.proposer.Address(), cmt.Proposer(0).Address())
+
+			cb := st.CommittedBlock(height)
+			blk, _ := cb.ToBlock()
+			cert, _ := blockCertificate(td.state.store, height)
+			signBytes := certificate.BlockCertificateSignBytes(blk.Hash(), height, cert.Round())
+			assert.NoError(t, cert.Validate(height, cmt.Validators(), signBytes))
+		}
+	}
+
+	t.Run("Should reconstruct the committee of the past heights", func(t *testing.T) {
+		checkCommittee(t, td.state)
+	})
+
+	t.Run("Should reconstruct the committee after restarting the node", func(t *testing.T) {
+		newState, err := LoadOrNewState(DefaultConfig(), td.state.genDoc, td.state.valKeys,
+			td.state.store, td.commonTxPool, nil)
+		require.NoError(t, err)
+
+		checkCommittee(t, newState)
+	})
+
+	t.Run("Unknown height", func(t *testing.T) {
+		_, err := td.state.CommitteeAt(0)
+		assert.ErrorIs(t, err, store.ErrNotFound)
+
+		_, err = td.state.CommitteeAt(td.state.LastBlockHeight() + 1)
+		assert.ErrorIs(t, err, store.ErrNotFound)
+	})
+}
+
+func TestTransactionReceipts(t *testing.T) {
+	td := setup(t)
+
+	senderAddr := td.genAccKey.PublicKeyNative().AccountAddress()
+	pub, prv := td.RandBLSKeyPair()
+	amt, fee := int64(1000000000), int64(100000)
+	bondTrx := tx.NewBondTx(td.state.LastBlockHeight(), senderAddr,
+		pub.ValidatorAddress(), pub, amt, fee, "")
+	td.HelperSetProofOfPossession(prv, bondTrx)
+	td.HelperSignTransaction(td.genAccKey, bondTrx)
+	assert.NoError(t, td.state.AddPendingTx(bondTrx))
+
+	senderBalance := td.state.AccountByAddress(senderAddr).Balance()
+	blk, cert := td.makeBlockAndCertificate(t, 0)
+	require.NoError(t, td.state.CommitBlock(blk, cert))
+
+	t.Run("Subsidy transaction", func(t *testing.T) {
+		subsidyTx := blk.Transactions()[0]
+		rcpt := td.state.TransactionReceipt(subsidyTx.ID())
+		require.NotNil(t, rcpt)
+
+		assert.Equal(t, cert.Height(), rcpt.Height())
+		assert.Equal(t, uint16(0), rcpt.Index())
+		assert.Equal(t, receipt.StatusSuccess, rcpt.Status())
+		assert.Zero(t, rcpt.Fee())
+		require.Len(t, rcpt.Balances(), 2)
+		rewardAddr := *subsidyTx.Payload().Receiver()
+		assert.Equal(t, rewardAddr, rcpt.Balances()[0].Address)
+		assert.Equal(t, td.state.AccountByAddress(rewardAddr).Balance(), rcpt.Balances()[0].Balance)
+		assert.Equal(t, crypto.TreasuryAddress, rcpt.Balances()[1].Address)
+	})
+
+	t.Run("Bond transaction", func(t *testing.T) {
+		rcpt := td.state.TransactionReceipt(bondTrx.ID())
+		require.NotNil(t, rcpt)
+
+		assert.Equal(t, bondTrx.ID(), rcpt.TxID())
+		assert.Equal(t, cert.Height(), rcpt.Height())
+		assert.Equal(t, uint16(1), rcpt.Index())
+		assert.Equal(t, receipt.StatusSuccess, rcpt.Status())
+		assert.Equal(t, fee, rcpt.Fee())
+		assert.Equal(t, []receipt.Balance{
+			{Address: senderAddr, Balance: senderBalance - amt - fee},
+		}, rcpt.Balances())
+		assert.Equal(t, []receipt.Event{
+			{
+				Type:     receipt.EventBalanceChange,
+				Address:  senderAddr,
+				OldValue: senderBalance,
+				NewValue: senderBalance - amt - fee,
+			},
+			{
+				Type:     receipt.EventStakeChange,
+				Address:  pub.ValidatorAddress(),
+				OldValue: 0,
+				NewValue: amt,
+			},
+		}, rcpt.Events())
+	})
+
+	t.Run("Unknown transaction", func(t *testing.T) {
+		assert.Nil(t, td.state.TransactionReceipt(td.RandHash()))
+	})
+}
+
+func TestBlockBloom(t *testing.T) {
+	td := setup(t)
+
+	senderAddr := td.genAccKey.PublicKeyNative().AccountAddress()
+	pub, prv := td.RandBLSKeyPair()
+	bondTrx := tx.NewBondTx(td.state.LastBlockHeight(), senderAddr,
+		pub.ValidatorAddress(), pub, 1000000000, 100000, "")
+	td.HelperSetProofOfPossession(prv, bondTrx)
+	td.HelperSignTransaction(td.genAccKey, bondTrx)
+	assert.NoError(t, td.state.AddPendingTx(bondTrx))
+
+	blk, cert := td.makeBlockAndCertificate(t, 0)
+	require.NoError(t, td.state.CommitBlock(blk, cert))
+
+	filter := td.state.BlockBloom(cert.Height())
+	require.NotNil(t, filter)
+
+	rewardAddr := *blk.Transactions()[0].Payload().Receiver()
+	assert.True(t, filter.Contains(senderAddr.Bytes()))
+	assert.True(t, filter.Contains(pub.ValidatorAddress().Bytes()))
+	assert.True(t, filter.Contains(rewardAddr.Bytes()))
+	assert.True(t, filter.Contains(crypto.TreasuryAddress.Bytes()))
+
+	matched := 0
+	for i := 0; i < 100; i++ {
+		if filter.Contains(td.RandAccAddress().Bytes()) {
+			matched++
+		}
+	}
+	assert.Less(t, matched, 10)
+
+	assert.Nil(t, td.state.BlockBloom(cert.Height()+1))
+}
+
+func TestBeacon(t *testing.T) {
+	td := setup(t)
+
+	blk1, cert1 := td.makeBlockAndCertificate(t, 0)
+	require.NoError(t, td.state.CommitBlock(blk1, cert1))
+
+	blk2, cert2 := td.makeBlockAndCertificate(t, 0)
+	require.NoError(t, td.state.CommitBlock(blk2, cert2))
+
+	assert.Equal(t, cert1.Beacon(), blk2.PrevCertificate().Beacon())
+	assert.Equal(t, cert1.Beacon(), td.state.Beacon(cert1.Height()))
+	assert.NotEqual(t, td.state.Beacon(cert1.Height()), td.state.Beacon(cert1.Height()-1))
+
+	// The beacon of the last block is not final yet.
+	assert.Equal(t, hash.UndefHash, td.state.Beacon(cert2.Height()))
+	assert.Equal(t, hash.UndefHash, td.state.Beacon(cert2.Height()+1))
+	assert.Equal(t, hash.UndefHash, td.state.Beacon(0))
+}
+
+func TestCommitBlockOnFullDisk(t *testing.T) {
+	td := setup(t)
+
+	blk, cert := td.makeBlockAndCertificate(t, 0)
+	height := td.state.LastBlockHeight()
+
+	td.state.store.(*store.MockStore).DiskError = store.InsufficientDiskSpaceError{}
+	err := td.state.CommitBlock(blk, cert)
+	assert.ErrorIs(t, err, store.InsufficientDiskSpaceError{})
+	assert.Equal(t, height, td.state.LastBlockHeight())
+
+	td.state.store.(*store.MockStore).DiskError = nil
+	assert.NoError(t, td.state.CommitBlock(blk, cert))
+	assert.Equal(t, height+1, td.state.LastBlockHeight())
+}
+
+func TestCompactFilter(t *testing.T) {
+	td := setup(t)
+
+	senderAddr := td.genAccKey.PublicKeyNative().AccountAddress()
+	receiverAddr := td.RandAccAddress()
+	transferTrx := tx.NewTransferTx(td.state.LastBlockHeight(), senderAddr,
+		receiverAddr, 1000000000, 100000, "")
+	td.HelperSignTransaction(td.genAccKey, transferTrx)
+	assert.NoError(t, td.state.AddPendingTx(transferTrx))
+
+	blk, cert := td.makeBlockAndCertificate(t, 0)
+	require.NoError(t, td.state.CommitBlock(blk, cert))
+
+	filter := td.state.CompactFilter(cert.Height())
+	require.NotNil(t, filter)
+
+	key := blk.Hash()
+	rewardAddr := *blk.Transactions()[0].Payload().Receiver()
+	assert.True(t, filter.Match(key, senderAddr.Bytes()))
+	assert.True(t, filter.Match(key, receiverAddr.Bytes()))
+	assert.True(t, filter.Match(key, rewardAddr.Bytes()))
+	assert.True(t, filter.Match(key, crypto.TreasuryAddress.Bytes()))
+	assert.False(t, filter.Match(td.RandHash(), receiverAddr.Bytes()))
+
+	assert.Nil(t, td.state.CompactFilter(cert.Height()+1))
+}
+
+func TestSnapshotReads(t *testing.T) {
+	td := setup(t)
+
+	senderAddr := td.genAccKey.PublicKeyNative().AccountAddress()
+	pub, prv := td.RandBLSKeyPair()
+	amt, fee := int64(1000000000), int64(100000)
+	bondTrx := tx.NewBondTx(td.state.LastBlockHeight(), senderAddr,
+		pub.ValidatorAddress(), pub, amt, fee, "")
+	td.HelperSetProofOfPossession(prv, bondTrx)
+	td.HelperSignTransaction(td.genAccKey, bondTrx)
+	assert.NoError(t, td.state.AddPendingTx(bondTrx))
+
+	prevHeight := td.state.LastBlockHeight()
+	prevRoot := td.state.stateRoot()
+	prevAcc := td.state.AccountByAddress(senderAddr)
+	prevVal := td.state.ValidatorByNumber(0)
+
+	blk, cert := td.makeBlockAndCertificate(t, 0)
+	require.NoError(t, td.state.CommitBlock(blk, cert))
+
+	t.Run("Reading the state at the previous height", func(t *testing.T) {
+		height, err := td.state.SnapshotHeight(prevRoot)
+		require.NoError(t, err)
+		assert.Equal(t, prevHeight, height)
+
+		acc, err := td.state.AccountByAddressAt(senderAddr, height)
+		require.NoError(t, err)
+		assert.Equal(t, prevAcc, acc)
+
+		val, err := td.state.ValidatorByAddressAt(pub.ValidatorAddress(), height)
+		require.NoError(t, err)
+		assert.Nil(t, val)
+
+		val, err = td.state.ValidatorByNumberAt(0, height)
+		require.NoError(t, err)
+		assert.Equal(t, prevVal, val)
+	})
+
+	t.Run("Reading the state at the last height", func(t *testing.T) {
+		height, err := td.state.SnapshotHeight(td.state.stateRoot())
+		require.NoError(t, err)
+		assert.Equal(t, cert.Height(), height)
+
+		acc, err := td.state.AccountByAddressAt(senderAddr, height)
+		require.NoError(t, err)
+		assert.Equal(t, prevAcc.Balance()-amt-fee, acc.Balance())
+
+		val, err := td.state.ValidatorByAddressAt(pub.ValidatorAddress(), height)
+		require.NoError(t, err)
+		assert.Equal(t, amt, val.Stake())
+	})
+
+	t.Run("Height is not reached yet", func(t *testing.T) {
+		_, err := td.state.AccountByAddressAt(senderAddr, cert.Height()+1)
+		assert.ErrorIs(t, err, snapshot.UnavailableError{Height: cert.Height() + 1})
+
+		_, err = td.state.ValidatorByNumberAt(td.state.TotalValidators(), cert.Height()+1)
+		assert.ErrorIs(t, err, snapshot.UnavailableError{Height: cert.Height() + 1})
+	})
+
+	t.Run("Reading the state before restarting the node", func(t *testing.T) {
+		newState, err := LoadOrNewState(DefaultConfig(), td.state.genDoc, td.state.valKeys,
+			td.state.store, td.commonTxPool, nil)
+		require.NoError(t, err)
+
+		_, err = newState.AccountByAddressAt(senderAddr, prevHeight)
+		assert.ErrorIs(t, err, snapshot.UnavailableError{Height: prevHeight})
+
+		acc, err := newState.AccountByAddressAt(senderAddr, cert.Height())
+		require.NoError(t, err)
+		assert.Equal(t, prevAcc.Balance()-amt-fee, acc.Balance())
+	})
+
+	t.Run("Unknown state root", func(t *testing.T) {
+		_, err := td.state.SnapshotHeight(td.RandHash())
+		assert.ErrorIs(t, err, store.ErrNotFound)
+	})
+}
+
 func TestLoadStateAfterChangingGenesis(t *testing.T) {
 	td := setup(t)
 
-	_, err := LoadOrNewState(td.state.genDoc, td.state.valKeys,
+	_, err := LoadOrNewState(DefaultConfig(), td.state.genDoc, td.state.valKeys,
 		td.state.store, txpool.MockingTxPool(), nil)
 	require.NoError(t, err)
 
@@ -579,9 +1184,9 @@ func TestLoadStateAfterChangingGenesis(t *testing.T) {
 		td.state.genDoc.Params())
 
 	// Load last state info after modifying genesis
-	_, err = LoadOrNewState(genDoc, td.state.valKeys,
+	_, err = LoadOrNewState(DefaultConfig(), genDoc, td.state.valKeys,
 		td.state.store, txpool.MockingTxPool(), nil)
-	require.Error(t, err)
+	assert.ErrorAs(t, err, &GenesisMismatchError{})
 }
 
 func TestIsValidator(t *testing.T) {
@@ -624,13 +1229,16 @@ func TestCalcFee(t *testing.T) {
 
 		{1 * 1e12, payload.TypeSortition, 0, 0, errors.ErrInvalidFee},
 		{1 * 1e12, payload.TypeUnbond, 0, 0, errors.ErrNone},
+
+		{1 * 1e9, payload.TypeEscrow, 100000, 100000, errors.ErrNone},
+		{100, payload.TypeData, 1001000, 1001000, errors.ErrNone},
 	}
 	for _, test := range tests {
 		fee, err := td.state.CalculateFee(test.amount, test.pldType)
 		assert.NoError(t, err)
 		assert.Equal(t, test.expectedFee, fee)
 
-		_, err = td.state.CalculateFee(test.amount, 6)
+		_, err = td.state.CalculateFee(test.amount, 0x7F)
 		assert.Error(t, err)
 	}
 }
@@ -649,11 +1257,37 @@ func TestCheckMaximumTransactionPerBlock(t *testing.T) {
 		assert.NoError(t, err)
 	}
 
-	blk, err := td.state.ProposeBlock(td.state.valKeys[0], td.RandAccAddress())
+	blk, err := td.state.ProposeBlock(bls.NewKeySigner(td.state.valKeys[0]), td.RandAccAddress())
 	assert.NoError(t, err)
 	assert.Equal(t, maxTransactionsPerBlock, blk.Transactions().Len())
 }
 
+func TestCheckMaximumBlockWeight(t *testing.T) {
+	td := setup(t)
+
+	lockTime := td.state.LastBlockHeight()
+	senderAddr := td.genAccKey.PublicKeyNative().AccountAddress()
+	amt := int64(1e6)
+	fee, _ := td.state.CalculateFee(amt, payload.TypeTransfer)
+	weight := int64(0)
+	for i := 0; i < 5; i++ {
+		trx := tx.NewTransferTx(lockTime, senderAddr, td.RandAccAddress(), amt, fee, "")
+		td.HelperSignTransaction(td.genAccKey, trx)
+		weight = execution.TxWeight(trx)
+		err := td.state.AddPendingTx(trx)
+		assert.NoError(t, err)
+	}
+
+	td.state.params.MaxBlockWeight = 3 * weight
+	blk, _ := td.makeBlockAndCertificate(t, 0)
+	assert.Equal(t, 4, blk.Transactions().Len()) // subsidy and 3 transfers
+	assert.NoError(t, td.state.ValidateBlock(blk, 0))
+
+	td.state.params.MaxBlockWeight = 2 * weight
+	err := td.state.ValidateBlock(blk, 0)
+	assert.Equal(t, errors.ErrInvalidBlock, errors.Code(err))
+}
+
 func TestCommittedBlock(t *testing.T) {
 	td := setup(t)
 
@@ -681,3 +1315,60 @@ func TestCommittedBlock(t *testing.T) {
 		assert.Equal(t, td.state.LastBlockHash(), lastBlk.Hash())
 	})
 }
+
+func TestPermissionedNetwork(t *testing.T) {
+	td := setup(t)
+
+	governorAddr := td.genAccKey.PublicKeyNative().AccountAddress()
+	td.state.params.Permissioned = true
+	td.state.params.Governors = []string{governorAddr.String()}
+
+	pub, prv := td.RandBLSKeyPair()
+	valAddr := pub.ValidatorAddress()
+	lockTime := td.state.LastBlockHeight()
+
+	bondTrx := tx.NewBondTx(lockTime, governorAddr, valAddr, pub, 1e9, 100000, "")
+	td.HelperSetProofOfPossession(prv, bondTrx)
+	td.HelperSignTransaction(td.genAccKey, bondTrx)
+
+	t.Run("Validator is not allowlisted", func(t *testing.T) {
+		assert.NoError(t, td.state.AddPendingTx(bondTrx))
+
+		blk, cert := td.makeBlockAndCertificate(t, 0)
+		assert.Equal(t, 1, blk.Transactions().Len())
+		assert.NoError(t, td.state.CommitBlock(blk, cert))
+		td.commonTxPool.Txs = nil
+	})
+
+	t.Run("Governor allowlists the validator", func(t *testing.T) {
+		allowTrx := tx.NewAllowlistTx(lockTime, governorAddr, valAddr, true, 1000, "")
+		td.HelperSignTransaction(td.genAccKey, allowTrx)
+		assert.NoError(t, td.state.AddPendingTx(allowTrx))
+		assert.NoError(t, td.state.AddPendingTx(bondTrx))
+
+		blk, cert := td.makeBlockAndCertificate(t, 0)
+		assert.Equal(t, block.Txs{allowTrx, bondTrx}, blk.Transactions()[1:])
+		assert.NoError(t, td.state.CommitBlock(blk, cert))
+
+		assert.True(t, td.state.store.IsAllowlisted(valAddr))
+		assert.True(t, td.state.IsValidator(valAddr))
+	})
+}
+
+func TestPermissionedGenesis(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	val, _ := ts.GenerateTestValidator(0)
+	treasury := account.NewAccount(0)
+	treasury.AddToBalance(21 * 1e15)
+	genDoc := genesis.MakeGenesis(util.RoundNow(10), map[crypto.Address]*account.Account{
+		crypto.TreasuryAddress: treasury,
+	}, []*validator.Validator{val}, param.DefaultParams())
+	require.NoError(t, genDoc.SetPermission([]crypto.Address{ts.RandAccAddress()}))
+
+	mockStore := store.MockingStore(ts)
+	_, err := LoadOrNewState(DefaultConfig(), genDoc, nil, mockStore, txpool.MockingTxPool(), nil)
+	require.NoError(t, err)
+
+	assert.True(t, mockStore.IsAllowlisted(val.Address()))
+}
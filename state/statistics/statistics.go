@@ -0,0 +1,133 @@
+package statistics
+
+import (
+	"time"
+
+	"github.com/pactus-project/pactus/types/block"
+	"golang.org/x/exp/slices"
+)
+
+// FeePercentiles defines the percentiles of the transaction fees that are reported.
+var FeePercentiles = []uint32{10, 25, 50, 75, 90}
+
+// Statistics contains the statistics of the blocks in a window.
+type Statistics struct {
+	FromHeight        uint32
+	ToHeight          uint32
+	BlockInterval     time.Duration // Average interval between blocks
+	TotalTransactions int           // Subsidy transactions are not counted
+	AvgTransactions   float64       // Average number of transactions per block
+	FeePercentiles    []int64       // Fee percentiles, as defined by FeePercentiles
+	BlockFullness     float64       // Average block fullness, between 0 and 1
+}
+
+type blockData struct {
+	height uint32
+	time   time.Time
+	txs    int     // Number of transactions, including the subsidy transaction
+	fees   []int64 // Sorted fees of the transactions
+}
+
+// Manager keeps the recent blocks' data and updates it on every new block,
+// so the statistics can be calculated without reading the blocks from the store.
+type Manager struct {
+	blocks    []*blockData // A ring buffer of the recent blocks
+	head      int          // Index of the oldest block in the ring buffer
+	maxBlocks uint32
+	maxTxs    int
+}
+
+func NewStatisticsManager(maxBlocks uint32, maxTxs int) *Manager {
+	return &Manager{
+		blocks:    make([]*blockData, 0, maxBlocks),
+		maxBlocks: maxBlocks,
+		maxTxs:    maxTxs,
+	}
+}
+
+// MaxWindow returns the maximum number of blocks that statistics can be calculated for.
+func (sm *Manager) MaxWindow() uint32 {
+	return sm.maxBlocks
+}
+
+// AddBlock adds a committed block to the manager.
+// The oldest block is dropped once the manager is full.
+func (sm *Manager) AddBlock(height uint32, blk *block.Block) {
+	data := &blockData{
+		height: height,
+		time:   blk.Header().Time(),
+		txs:    blk.Transactions().Len(),
+	}
+	for _, trx := range blk.Transactions() {
+		if trx.IsSubsidyTx() {
+			continue
+		}
+		data.fees = append(data.fees, trx.Fee())
+	}
+	slices.Sort(data.fees)
+
+	if uint32(len(sm.blocks)) < sm.maxBlocks {
+		sm.blocks = append(sm.blocks, data)
+
+		return
+	}
+
+	sm.blocks[sm.head] = data
+	sm.head = (sm.head + 1) % len(sm.blocks)
+}
+
+// block returns the i-th block, counting from the oldest one.
+func (sm *Manager) block(i int) *blockData {
+	return sm.blocks[(sm.head+i)%len(sm.blocks)]
+}
+
+// Statistics calculates the statistics for the last `window` blocks.
+// The window is capped to the number of blocks kept by the manager.
+// It returns nil if there is no block.
+func (sm *Manager) Statistics(window uint32) *Statistics {
+	count := len(sm.blocks)
+	if count == 0 || window == 0 {
+		return nil
+	}
+	if window < uint32(count) {
+		count = int(window)
+	}
+
+	first := sm.block(len(sm.blocks) - count)
+	last := sm.block(len(sm.blocks) - 1)
+
+	stats := &Statistics{
+		FromHeight:     first.height,
+		ToHeight:       last.height,
+		FeePercentiles: make([]int64, len(FeePercentiles)),
+	}
+
+	totalTxs := 0
+	fees := make([]int64, 0)
+	for i := len(sm.blocks) - count; i < len(sm.blocks); i++ {
+		data := sm.block(i)
+		totalTxs += data.txs
+		fees = append(fees, data.fees...)
+	}
+	slices.Sort(fees)
+
+	if count > 1 {
+		stats.BlockInterval = last.time.Sub(first.time) / time.Duration(count-1)
+	}
+	stats.TotalTransactions = len(fees)
+	stats.AvgTransactions = float64(len(fees)) / float64(count)
+	stats.BlockFullness = float64(totalTxs) / float64(count*sm.maxTxs)
+
+	if len(fees) > 0 {
+		for i, p := range FeePercentiles {
+			// Nearest-rank method
+			rank := (int(p)*len(fees) + 99) / 100
+			if rank < 1 {
+				rank = 1
+			}
+			stats.FeePercentiles[i] = fees[rank-1]
+		}
+	}
+
+	return stats
+}
@@ -0,0 +1,95 @@
+package statistics
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pactus-project/pactus/types/block"
+	"github.com/pactus-project/pactus/types/tx"
+	"github.com/pactus-project/pactus/util/testsuite"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func makeBlock(ts *testsuite.TestSuite, tme time.Time, fees ...int64) *block.Block {
+	txs := block.NewTxs()
+	txs.Append(tx.NewSubsidyTx(1, ts.RandAccAddress(), 1e9, "subsidy"))
+	for _, fee := range fees {
+		txs.Append(tx.NewTransferTx(1, ts.RandAccAddress(), ts.RandAccAddress(), 1e9, fee, ""))
+	}
+	header := block.NewHeader(1, tme, ts.RandHash(), ts.RandHash(), ts.RandSeed(), ts.RandValAddress())
+
+	return block.NewBlock(header, nil, txs)
+}
+
+func TestEmptyStatistics(t *testing.T) {
+	sm := NewStatisticsManager(10, 4)
+
+	assert.Nil(t, sm.Statistics(10))
+}
+
+func TestStatistics(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	sm := NewStatisticsManager(3, 4)
+	start := time.Now()
+
+	sm.AddBlock(1, makeBlock(ts, start, 1000, 2000, 3000))
+	sm.AddBlock(2, makeBlock(ts, start.Add(10*time.Second)))
+
+	t.Run("Window is zero", func(t *testing.T) {
+		assert.Nil(t, sm.Statistics(0))
+	})
+
+	t.Run("One block", func(t *testing.T) {
+		stats := sm.Statistics(1)
+		require.NotNil(t, stats)
+
+		assert.Equal(t, uint32(2), stats.FromHeight)
+		assert.Equal(t, uint32(2), stats.ToHeight)
+		assert.Zero(t, stats.BlockInterval)
+		assert.Zero(t, stats.TotalTransactions)
+		assert.Equal(t, []int64{0, 0, 0, 0, 0}, stats.FeePercentiles)
+		assert.Equal(t, 0.25, stats.BlockFullness)
+	})
+
+	t.Run("Window is greater than the number of blocks", func(t *testing.T) {
+		stats := sm.Statistics(10)
+		require.NotNil(t, stats)
+
+		assert.Equal(t, uint32(1), stats.FromHeight)
+		assert.Equal(t, uint32(2), stats.ToHeight)
+		assert.Equal(t, 10*time.Second, stats.BlockInterval)
+		assert.Equal(t, 3, stats.TotalTransactions)
+		assert.Equal(t, 1.5, stats.AvgTransactions)
+		assert.Equal(t, []int64{1000, 1000, 2000, 3000, 3000}, stats.FeePercentiles)
+		assert.Equal(t, 0.625, stats.BlockFullness)
+	})
+
+	sm.AddBlock(3, makeBlock(ts, start.Add(20*time.Second), 4000))
+	sm.AddBlock(4, makeBlock(ts, start.Add(40*time.Second), 5000, 6000))
+
+	t.Run("Oldest block is dropped", func(t *testing.T) {
+		stats := sm.Statistics(sm.MaxWindow())
+		require.NotNil(t, stats)
+
+		assert.Equal(t, uint32(2), stats.FromHeight)
+		assert.Equal(t, uint32(4), stats.ToHeight)
+		assert.Equal(t, 15*time.Second, stats.BlockInterval)
+		assert.Equal(t, 3, stats.TotalTransactions)
+		assert.Equal(t, 1.0, stats.AvgTransactions)
+		assert.Equal(t, []int64{4000, 4000, 5000, 6000, 6000}, stats.FeePercentiles)
+		assert.Equal(t, 0.5, stats.BlockFullness)
+	})
+
+	t.Run("Last two blocks", func(t *testing.T) {
+		stats := sm.Statistics(2)
+		require.NotNil(t, stats)
+
+		assert.Equal(t, uint32(3), stats.FromHeight)
+		assert.Equal(t, uint32(4), stats.ToHeight)
+		assert.Equal(t, 20*time.Second, stats.BlockInterval)
+		assert.Equal(t, 3, stats.TotalTransactions)
+		assert.Equal(t, 1.5, stats.AvgTransactions)
+	})
+}
@@ -2,12 +2,10 @@ package state
 
 import (
 	"testing"
-	"time"
 
 	"github.com/pactus-project/pactus/sortition"
 	"github.com/pactus-project/pactus/types/block"
 	"github.com/pactus-project/pactus/types/certificate"
-	"github.com/pactus-project/pactus/util"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -38,7 +36,8 @@ func TestBlockValidation(t *testing.T) {
 
 	t.Run("Invalid time", func(t *testing.T) {
 		blk0, _ := td.makeBlockAndCertificate(t, round)
-		invBlockTime := util.RoundNow(td.state.params.BlockIntervalInSecond).Add(30 * time.Second)
+		// The block time doesn't go back, so it keeps the median time past for the next blocks.
+		invBlockTime := td.state.lastInfo.BlockTime()
 		blk := block.MakeBlock(
 			blk0.Header().Version(),
 			invBlockTime,
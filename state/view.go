@@ -0,0 +1,82 @@
+package state
+
+import (
+	"time"
+
+	"github.com/pactus-project/pactus/committee"
+	"github.com/pactus-project/pactus/crypto/hash"
+	"github.com/pactus-project/pactus/types/certificate"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	viewsPublishedCounter = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: "pactus",
+		Subsystem: "state",
+		Name:      "views_published_total",
+		Help:      "The number of the state views that are published.",
+	})
+	viewReadsCounter = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: "pactus",
+		Subsystem: "state",
+		Name:      "view_reads_total",
+		Help:      "The number of the reads that are served by the state views, without locking the state.",
+	})
+	viewLifetimeHistogram = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Namespace: "pactus",
+		Subsystem: "state",
+		Name:      "view_lifetime_seconds",
+		Help:      "The time that a state view is current, until it is replaced by the next one.",
+		Buckets:   []float64{0.1, 1, 5, 10, 20, 30, 60, 120, 300},
+	})
+)
+
+func init() {
+	prometheus.MustRegister(viewsPublishedCounter, viewReadsCounter, viewLifetimeHistogram)
+}
+
+// view is an immutable copy of the in-memory state.
+// A new view is published whenever the state changes, and the readers load the current view
+// without holding the state lock. Therefore, the heavy RPC reads never block committing the blocks,
+// and committing the blocks never blocks the readers.
+type view struct {
+	publishedAt     time.Time
+	lastBlockHeight uint32
+	lastBlockHash   hash.Hash
+	lastBlockTime   time.Time
+	lastCertificate *certificate.Certificate
+	totalPower      int64
+	sortitionMisses int64
+	committee       committee.Reader
+	committeePower  int64
+}
+
+// publishView replaces the current view by a copy of the state.
+// It should be called while the state is locked for writing.
+func (st *state) publishView() {
+	cmt := st.committee.Clone()
+	v := &view{
+		publishedAt:     time.Now(),
+		lastBlockHeight: st.lastInfo.BlockHeight(),
+		lastBlockHash:   st.lastInfo.BlockHash(),
+		lastBlockTime:   st.lastInfo.BlockTime(),
+		lastCertificate: st.lastInfo.Certificate(),
+		totalPower:      st.totalPower,
+		sortitionMisses: st.sortitionMisses.Load(),
+		committee:       cmt,
+		committeePower:  cmt.TotalPower(),
+	}
+
+	old := st.view.Swap(v)
+	if old != nil {
+		viewLifetimeHistogram.Observe(v.publishedAt.Sub(old.publishedAt).Seconds())
+	}
+	viewsPublishedCounter.Inc()
+}
+
+// currentView returns the current view of the state. The view should not be modified.
+func (st *state) currentView() *view {
+	viewReadsCounter.Inc()
+
+	return st.view.Load()
+}
@@ -0,0 +1,64 @@
+package state
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestViewReads(t *testing.T) {
+	td := setup(t)
+
+	t.Run("The view follows the committed blocks", func(t *testing.T) {
+		published := testutil.ToFloat64(viewsPublishedCounter)
+		height := td.state.LastBlockHeight()
+		blk, cert := td.makeBlockAndCertificate(t, 0)
+		require.NoError(t, td.state.CommitBlock(blk, cert))
+
+		assert.Equal(t, published+1, testutil.ToFloat64(viewsPublishedCounter))
+		assert.Equal(t, height+1, td.state.LastBlockHeight())
+		assert.Equal(t, blk.Hash(), td.state.LastBlockHash())
+		assert.Equal(t, blk.Header().Time(), td.state.LastBlockTime())
+		assert.Equal(t, cert.Hash(), td.state.LastCertificate().Hash())
+		assert.Equal(t, td.state.committee.TotalPower(), td.state.CommitteePower())
+		assert.Equal(t, td.state.committee.Committers(), td.state.currentView().committee.Committers())
+	})
+
+	t.Run("Reads don't wait for the state lock", func(t *testing.T) {
+		td.state.lk.Lock()
+		defer td.state.lk.Unlock()
+
+		done := make(chan struct{})
+		go func() {
+			_ = td.state.LastBlockHeight()
+			_ = td.state.LastCertificate()
+			_ = td.state.CommitteeValidators()
+			_ = td.state.IsProposer(td.genValKeys[0].Address(), 0)
+			close(done)
+		}()
+
+		select {
+		case <-done:
+		case <-time.After(time.Second):
+			assert.Fail(t, "reading the state is blocked by the state lock")
+		}
+	})
+
+	t.Run("The views are not changed by the next blocks", func(t *testing.T) {
+		oldView := td.state.currentView()
+		oldCommitters := oldView.committee.Committers()
+		oldProposer := oldView.committee.Proposer(0).Address()
+
+		td.commitBlocks(t, 1)
+
+		newView := td.state.currentView()
+		assert.NotSame(t, oldView, newView)
+		assert.Equal(t, oldView.lastBlockHeight+1, newView.lastBlockHeight)
+		assert.Equal(t, oldCommitters, oldView.committee.Committers())
+		assert.Equal(t, oldProposer, oldView.committee.Proposer(0).Address())
+		assert.NotEqual(t, oldProposer, newView.committee.Proposer(0).Address())
+	})
+}
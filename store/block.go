@@ -17,6 +17,14 @@ import (
 )
 
 func blockKey(height uint32) []byte { return append(blockPrefix, util.Uint32ToSlice(height)...) }
+func bloomKey(height uint32) []byte { return append(bloomPrefix, util.Uint32ToSlice(height)...) }
+func compactFilterKey(height uint32) []byte {
+	return append(compactFilterPrefix, util.Uint32ToSlice(height)...)
+}
+func allowlistKey(addr crypto.Address) []byte {
+	return append(allowlistPrefix, addr.Bytes()...)
+}
+
 func publicKeyKey(addr crypto.Address) []byte {
 	return append(publicKeyPrefix, addr.Bytes()...)
 }
@@ -30,16 +38,24 @@ type blockStore struct {
 	pubKeyCache        *lru.Cache[crypto.Address, *bls.PublicKey]
 	sortitionSeedCache *pairslice.PairSlice[uint32, *sortition.VerifiableSeed]
 	sortitionCacheSize uint32
+	compressor         *blockCompressor
 }
 
-func newBlockStore(db *leveldb.DB, sortitionCacheSize uint32, publicKeyCacheSize int) *blockStore {
+func newBlockStore(db *leveldb.DB, sortitionCacheSize uint32, publicKeyCacheSize int,
+	compressBlocks bool,
+) *blockStore {
 	pubKeyCache, err := lru.New[crypto.Address, *bls.PublicKey](publicKeyCacheSize)
 	if err != nil {
 		return nil
 	}
+	compressor, err := newBlockCompressor(db, compressBlocks)
+	if err != nil {
+		return nil
+	}
 
 	return &blockStore{
 		db:                 db,
+		compressor:         compressor,
 		sortitionSeedCache: pairslice.New[uint32, *sortition.VerifiableSeed](int(sortitionCacheSize)),
 		pubKeyCache:        pubKeyCache,
 		sortitionCacheSize: sortitionCacheSize,
@@ -104,7 +120,7 @@ func (bs *blockStore) saveBlock(batch *leveldb.Batch, height uint32, blk *block.
 	blockKey := blockKey(height)
 	blockHashKey := blockHashKey(blockHash)
 
-	batch.Put(blockKey, w.Bytes())
+	batch.Put(blockKey, bs.compressor.compress(w.Bytes()))
 	batch.Put(blockHashKey, util.Uint32ToSlice(height))
 
 	sortitionSeed := blk.Header().SortitionSeed()
@@ -119,7 +135,7 @@ func (bs *blockStore) block(height uint32) ([]byte, error) {
 		return nil, err
 	}
 
-	return data, nil
+	return bs.compressor.decompress(data)
 }
 
 func (bs *blockStore) blockHeight(h hash.Hash) uint32 {
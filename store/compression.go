@@ -0,0 +1,192 @@
+package store
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/pactus-project/pactus/crypto/hash"
+	"github.com/pactus-project/pactus/util/logger"
+	"github.com/syndtr/goleveldb/leveldb"
+)
+
+// blockEncodingZstd marks the block values that their body is compressed by zstd.
+// The raw values have the block version right after the block hash,
+// which is always less than this marker, so both encodings can be read.
+const blockEncodingZstd = byte(0x80)
+
+const (
+	// dictID is the ID of the trained dictionary, that is written in the header of the zstd frames.
+	dictID = uint32(1)
+	// dictTrainBlocks is the number of the block bodies that the dictionary is trained by.
+	dictTrainBlocks = 1000
+	// dictSamplesSize is the maximum size of the samples, so the big blocks don't use much memory.
+	dictSamplesSize = 8 << 20
+	// dictMaxSize is the maximum size of the trained dictionary.
+	dictMaxSize = 64 << 10
+)
+
+// blockCompressor compresses the block bodies by zstd.
+// Until the dictionary is trained, the bodies are compressed without a dictionary and kept as
+// the samples. The trained dictionary is saved in the database, since it is needed to read the
+// blocks that are compressed by it.
+type blockCompressor struct {
+	lk          sync.RWMutex
+	db          *leveldb.DB
+	enabled     bool
+	trained     bool
+	encoder     *zstd.Encoder
+	decoder     *zstd.Decoder
+	samples     [][]byte
+	samplesSize int
+}
+
+func newBlockCompressor(db *leveldb.DB, enabled bool) (*blockCompressor, error) {
+	bc := &blockCompressor{
+		db:      db,
+		enabled: enabled,
+	}
+
+	dict, _ := tryGet(db, blockDictKey)
+	if err := bc.setDict(dict); err != nil {
+		return nil, err
+	}
+
+	return bc, nil
+}
+
+// setDict creates the encoder and the decoder by the dictionary, if it is not empty.
+func (bc *blockCompressor) setDict(dict []byte) error {
+	encOpts := []zstd.EOption{zstd.WithEncoderConcurrency(1)}
+	decOpts := []zstd.DOption{zstd.WithDecoderConcurrency(1)}
+	if dict != nil {
+		encOpts = append(encOpts, zstd.WithEncoderDict(dict))
+		decOpts = append(decOpts, zstd.WithDecoderDicts(dict))
+	}
+
+	encoder, err := zstd.NewWriter(nil, encOpts...)
+	if err != nil {
+		return err
+	}
+	decoder, err := zstd.NewReader(nil, decOpts...)
+	if err != nil {
+		return err
+	}
+
+	bc.encoder = encoder
+	bc.decoder = decoder
+	bc.trained = dict != nil
+	bc.samples = nil
+	bc.samplesSize = 0
+
+	return nil
+}
+
+// compress compresses the body of the block value, if the compression is enabled.
+// The block hash is kept as it is, so it can be read without decompressing the value.
+func (bc *blockCompressor) compress(value []byte) []byte {
+	if !bc.enabled {
+		return value
+	}
+
+	bc.lk.Lock()
+	defer bc.lk.Unlock()
+
+	body := value[hash.HashSize:]
+	if !bc.trained {
+		bc.addSample(body)
+	}
+
+	compressed := make([]byte, 0, hash.HashSize+1+len(body)/2)
+	compressed = append(compressed, value[:hash.HashSize]...)
+	compressed = append(compressed, blockEncodingZstd)
+
+	return bc.encoder.EncodeAll(body, compressed)
+}
+
+// decompress returns the raw block value. The raw values are returned as they are.
+func (bc *blockCompressor) decompress(value []byte) ([]byte, error) {
+	if len(value) <= hash.HashSize || value[hash.HashSize] != blockEncodingZstd {
+		return value, nil
+	}
+
+	bc.lk.RLock()
+	defer bc.lk.RUnlock()
+
+	raw := make([]byte, 0, 2*len(value))
+	raw = append(raw, value[:hash.HashSize]...)
+
+	return bc.decoder.DecodeAll(value[hash.HashSize+1:], raw)
+}
+
+// addSample keeps the body as a sample, and trains the dictionary once there are enough samples.
+func (bc *blockCompressor) addSample(body []byte) {
+	bc.samples = append(bc.samples, append([]byte(nil), body...))
+	bc.samplesSize += len(body)
+	if len(bc.samples) < dictTrainBlocks && bc.samplesSize < dictSamplesSize {
+		return
+	}
+
+	bc.train()
+}
+
+// train trains the dictionary by the samples, saves it and uses it for the next bodies.
+func (bc *blockCompressor) train() {
+	dict, err := trainDict(bc.samples)
+	if err != nil {
+		// The bodies are still compressed without the dictionary, so it is not critical.
+		logger.Warn("unable to train the block dictionary", "error", err)
+		bc.samples = nil
+		bc.samplesSize = 0
+
+		return
+	}
+
+	// The dictionary is saved before any block is compressed by it.
+	if err := bc.db.Put(blockDictKey, dict, nil); err != nil {
+		logger.Warn("unable to save the block dictionary", "error", err)
+		bc.samples = nil
+		bc.samplesSize = 0
+
+		return
+	}
+	if err := bc.setDict(dict); err != nil {
+		logger.Panic("unable to load the block dictionary", "error", err)
+	}
+
+	logger.Info("block dictionary trained", "size", len(dict))
+}
+
+// trainDict builds a dictionary from the samples. The content of the dictionary is taken
+// from the end of the most recent samples, up to the maximum size of the dictionary.
+func trainDict(samples [][]byte) (dict []byte, err error) {
+	// BuildDict panics if the samples have too few sequences.
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("unable to build the dictionary: %v", r)
+		}
+	}()
+
+	first := len(samples)
+	size := 0
+	for first > 0 && size < dictMaxSize {
+		first--
+		size += len(samples[first])
+	}
+
+	history := make([]byte, 0, size)
+	for _, sample := range samples[first:] {
+		history = append(history, sample...)
+	}
+	if len(history) > dictMaxSize {
+		history = history[len(history)-dictMaxSize:]
+	}
+
+	return zstd.BuildDict(zstd.BuildDictOptions{
+		ID:       dictID,
+		Contents: samples,
+		History:  history,
+		Offsets:  [3]int{1, 4, 8},
+		Level:    zstd.SpeedDefault,
+	})
+}
@@ -0,0 +1,169 @@
+package store
+
+import (
+	"testing"
+
+	"github.com/pactus-project/pactus/crypto/hash"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func compressedConfig() *Config {
+	conf := testConfig()
+	conf.CompressBlocks = true
+
+	return conf
+}
+
+func (td *testData) checkBlocks(t *testing.T, from, to uint32) {
+	t.Helper()
+
+	for height := from; height <= to; height++ {
+		cb, err := td.store.Block(height)
+		require.NoError(t, err)
+		blk, err := cb.ToBlock()
+		require.NoError(t, err)
+		assert.Equal(t, cb.BlockHash, blk.Hash())
+
+		for _, trx := range blk.Transactions() {
+			ctx, err := td.store.Transaction(trx.ID())
+			require.NoError(t, err)
+			trx2, err := ctx.ToTx()
+			require.NoError(t, err)
+			assert.Equal(t, trx.ID(), trx2.ID())
+		}
+	}
+}
+
+func (td *testData) isCompressed(t *testing.T, height uint32) bool {
+	t.Helper()
+
+	data, err := td.store.db.Get(blockKey(height), nil)
+	require.NoError(t, err)
+
+	return data[hash.HashSize] == blockEncodingZstd
+}
+
+// saveBlocks saves the blocks up to the given height.
+// The test blocks are random, so many of them are needed to train a dictionary.
+func (td *testData) saveBlocks(t *testing.T, to uint32) {
+	t.Helper()
+
+	for height := td.store.LastCertificate().Height() + 1; height <= to; height++ {
+		blk, cert := td.GenerateTestBlock(height)
+		td.store.SaveBlock(blk, cert)
+		require.NoError(t, td.store.WriteBatch())
+	}
+}
+
+func (td *testData) reopen(t *testing.T, conf *Config) {
+	t.Helper()
+
+	require.NoError(t, td.store.Close())
+	s, err := NewStore(conf)
+	require.NoError(t, err)
+	td.store = s.(*store)
+}
+
+func TestCompressBlocks(t *testing.T) {
+	td := setup(t, compressedConfig())
+	bc := td.store.blockStore.compressor
+
+	t.Run("Should compress the blocks without the dictionary", func(t *testing.T) {
+		assert.True(t, td.isCompressed(t, 10))
+		assert.False(t, bc.trained)
+		assert.Len(t, bc.samples, 10)
+		td.checkBlocks(t, 1, 10)
+	})
+
+	t.Run("Should keep compressing if the dictionary can't be trained", func(t *testing.T) {
+		bc.lk.Lock()
+		bc.train()
+		bc.lk.Unlock()
+
+		assert.False(t, bc.trained)
+		assert.Empty(t, bc.samples)
+		assert.False(t, tryHas(td.store.db, blockDictKey))
+	})
+
+	t.Run("Should compress the blocks by the trained dictionary", func(t *testing.T) {
+		td.saveBlocks(t, 250)
+
+		bc.lk.Lock()
+		bc.train()
+		bc.lk.Unlock()
+
+		assert.True(t, bc.trained)
+		assert.Empty(t, bc.samples)
+		assert.True(t, tryHas(td.store.db, blockDictKey))
+
+		td.saveBlocks(t, 251)
+		assert.True(t, td.isCompressed(t, 251))
+		td.checkBlocks(t, 1, 251)
+	})
+
+	t.Run("Should load the dictionary on start", func(t *testing.T) {
+		td.reopen(t, td.store.config)
+
+		assert.True(t, td.store.blockStore.compressor.trained)
+		td.checkBlocks(t, 1, 251)
+	})
+
+	t.Run("Should read the compressed blocks if the compression is disabled", func(t *testing.T) {
+		conf := td.store.config
+		conf.CompressBlocks = false
+		td.reopen(t, conf)
+
+		td.saveBlocks(t, 252)
+		assert.False(t, td.isCompressed(t, 252))
+		td.checkBlocks(t, 1, 252)
+	})
+}
+
+func TestCompressBlocksOfRawStore(t *testing.T) {
+	td := setup(t, nil)
+
+	conf := td.store.config
+	conf.CompressBlocks = true
+	td.reopen(t, conf)
+
+	td.saveBlocks(t, 11)
+
+	// The stored blocks are kept as they are.
+	assert.False(t, td.isCompressed(t, 10))
+	assert.True(t, td.isCompressed(t, 11))
+	td.checkBlocks(t, 1, 11)
+}
+
+func TestMigrateBlockDict(t *testing.T) {
+	td := setup(t, nil)
+	td.saveBlocks(t, 250)
+
+	require.NoError(t, td.store.saveSchemaVersion(3))
+	conf := td.store.config
+	conf.CompressBlocks = true
+	td.reopen(t, conf)
+
+	version, _, _ := td.store.schemaVersion()
+	assert.Equal(t, lastStoreVersion, version)
+	assert.True(t, td.store.blockStore.compressor.trained)
+	assert.True(t, tryHas(td.store.db, blockDictKey))
+
+	td.saveBlocks(t, 251)
+	assert.True(t, td.isCompressed(t, 251))
+	td.checkBlocks(t, 1, 251)
+}
+
+func TestDecompressRawValue(t *testing.T) {
+	td := setup(t, nil)
+
+	data, err := td.store.db.Get(blockKey(1), nil)
+	require.NoError(t, err)
+
+	raw, err := td.store.blockStore.compressor.decompress(data)
+	require.NoError(t, err)
+	assert.Equal(t, data, raw)
+
+	// Compression is disabled, so the value is kept as it is.
+	assert.Equal(t, data, td.store.blockStore.compressor.compress(data))
+}
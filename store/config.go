@@ -1,28 +1,63 @@
 package store
 
 import (
+	"fmt"
 	"path/filepath"
 
 	"github.com/pactus-project/pactus/util"
 )
 
 type Config struct {
-	Path string `toml:"path"`
+	Path        string `toml:"path"`
+	MmapCacheMB int    `toml:"mmap_cache_mb"`
+
+	// InMemory keeps all the data in memory instead of the disk.
+	// It is useful for the tests and the local development networks.
+	// If Snapshot is set, the data is restored from the snapshot file on start
+	// and saved into it on close.
+	InMemory bool `toml:"in_memory"`
+	Snapshot bool `toml:"snapshot"`
+
+	// WarmupBlocks is the number of the recent blocks that are loaded into the caches on start,
+	// with the accounts that they touch.
+	WarmupBlocks uint32 `toml:"warmup_blocks"`
+
+	// CompressBlocks compresses the bodies of the new blocks by zstd, with a dictionary
+	// that is trained by the committed blocks.
+	CompressBlocks bool `toml:"compress_blocks"`
+
+	// Retention is the number of the recent blocks that each column family keeps.
+	Retention *RetentionConfig `toml:"retention"`
 
 	// Private configs
 	TxCacheSize        uint32 `toml:"-"`
 	SortitionCacheSize uint32 `toml:"-"`
 	AccountCacheSize   int    `toml:"-"`
 	PublicKeyCacheSize int    `toml:"-"`
+
+	// The free disk space thresholds, in bytes.
+	// Below the warning threshold a warning is logged,
+	// and below the critical threshold no new block is accepted.
+	DiskWarningThreshold  uint64 `toml:"-"`
+	DiskCriticalThreshold uint64 `toml:"-"`
 }
 
 func DefaultConfig() *Config {
 	return &Config{
 		Path:               "data",
+		MmapCacheMB:        0,
+		InMemory:           false,
+		Snapshot:           false,
+		WarmupBlocks:       1000,
+		CompressBlocks:     false,
+		Retention:          DefaultRetentionConfig(),
 		TxCacheSize:        1024,
 		SortitionCacheSize: 1024,
 		AccountCacheSize:   1024,
 		PublicKeyCacheSize: 1024,
+
+		DiskWarningThreshold:  5 << 30, // 5 GiB
+		DiskCriticalThreshold: 1 << 30, // 1 GiB
 	}
 }
 
@@ -34,6 +69,14 @@ func (conf *Config) StorePath() string {
 	return filepath.Join(conf.DataPath(), "store.db")
 }
 
+func (conf *Config) SnapshotPath() string {
+	return filepath.Join(conf.DataPath(), "store.snapshot")
+}
+
+func (conf *Config) MmapCachePath() string {
+	return filepath.Join(conf.DataPath(), "block_cache.mmap")
+}
+
 // BasicCheck performs basic checks on the configuration.
 func (conf *Config) BasicCheck() error {
 	if !util.IsValidDirPath(conf.Path) {
@@ -51,5 +94,84 @@ func (conf *Config) BasicCheck() error {
 		}
 	}
 
+	if conf.MmapCacheMB < 0 {
+		return InvalidConfigError{
+			Reason: "mmap cache size is negative",
+		}
+	}
+
+	if conf.Snapshot && !conf.InMemory {
+		return InvalidConfigError{
+			Reason: "snapshot is only supported by the in-memory store",
+		}
+	}
+
+	if conf.DiskCriticalThreshold > conf.DiskWarningThreshold {
+		return InvalidConfigError{
+			Reason: "disk critical threshold is greater than the warning threshold",
+		}
+	}
+
+	return conf.Retention.BasicCheck()
+}
+
+// RetentionConfig keeps the number of the recent blocks that each column family retains.
+// Zero means the family keeps the whole history.
+type RetentionConfig struct {
+	Blocks       uint32 `toml:"blocks"`
+	Txs          uint32 `toml:"txs"`
+	Certificates uint32 `toml:"certificates"`
+	Indexes      uint32 `toml:"indexes"`
+}
+
+func DefaultRetentionConfig() *RetentionConfig {
+	return &RetentionConfig{
+		Blocks:       0,
+		Txs:          0,
+		Certificates: 0,
+		Indexes:      0,
+	}
+}
+
+// Of returns the retention of the given column family.
+func (conf *RetentionConfig) Of(f Family) uint32 {
+	switch f {
+	case FamilyBlocks:
+		return conf.Blocks
+	case FamilyTxs:
+		return conf.Txs
+	case FamilyCertificates:
+		return conf.Certificates
+	case FamilyIndexes:
+		return conf.Indexes
+	default:
+		return 0
+	}
+}
+
+// BasicCheck performs basic checks on the configuration.
+func (conf *RetentionConfig) BasicCheck() error {
+	if conf.Blocks != 0 && conf.Blocks < MinBlockRetention {
+		return InvalidConfigError{
+			Reason: fmt.Sprintf("block retention should be zero or at least %d", MinBlockRetention),
+		}
+	}
+
+	// The transactions are indexed by their position in the block,
+	// so they should be pruned before the blocks.
+	if conf.Blocks != 0 && (conf.Txs == 0 || conf.Txs > conf.Blocks) {
+		return InvalidConfigError{
+			Reason: "transaction retention should not be more than the block retention",
+		}
+	}
+
+	if (conf.Txs != 0 && conf.Txs < MinRetention) ||
+		(conf.Certificates != 0 && conf.Certificates < MinRetention) ||
+		(conf.Indexes != 0 && conf.Indexes < MinRetention) {
+		return InvalidConfigError{
+			Reason: fmt.Sprintf("retention should be zero or at least %d", MinRetention),
+		}
+	}
+
 	return nil
 }
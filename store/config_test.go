@@ -1,6 +1,7 @@
 package store
 
 import (
+	"fmt"
 	"runtime"
 	"testing"
 
@@ -22,9 +23,56 @@ func TestDefaultConfigCheck(t *testing.T) {
 	conf.Path = util.TempDirPath()
 	assert.NoError(t, conf.BasicCheck())
 
+	conf.MmapCacheMB = -1
+	err = conf.BasicCheck()
+	assert.ErrorIs(t, InvalidConfigError{"mmap cache size is negative"}, err)
+	conf.MmapCacheMB = 0
+
+	conf.DiskCriticalThreshold = conf.DiskWarningThreshold + 1
+	err = conf.BasicCheck()
+	assert.ErrorIs(t, InvalidConfigError{"disk critical threshold is greater than the warning threshold"}, err)
+	conf.DiskCriticalThreshold = conf.DiskWarningThreshold
+
 	if runtime.GOOS != "windows" {
 		assert.Equal(t, conf.StorePath(), conf.Path+"/store.db")
 	} else {
 		assert.Equal(t, conf.StorePath(), conf.Path+"\\store.db")
 	}
 }
+
+func TestSnapshotConfigCheck(t *testing.T) {
+	conf := DefaultConfig()
+
+	conf.Snapshot = true
+	err := conf.BasicCheck()
+	assert.ErrorIs(t, InvalidConfigError{"snapshot is only supported by the in-memory store"}, err)
+
+	conf.InMemory = true
+	assert.NoError(t, conf.BasicCheck())
+}
+
+func TestRetentionConfigCheck(t *testing.T) {
+	conf := DefaultRetentionConfig()
+	assert.NoError(t, conf.BasicCheck())
+
+	conf.Blocks = MinBlockRetention - 1
+	conf.Txs = MinRetention
+	assert.ErrorIs(t, conf.BasicCheck(),
+		InvalidConfigError{fmt.Sprintf("block retention should be zero or at least %d", MinBlockRetention)})
+
+	conf.Blocks = MinBlockRetention
+	conf.Txs = 0
+	assert.ErrorIs(t, conf.BasicCheck(),
+		InvalidConfigError{"transaction retention should not be more than the block retention"})
+
+	conf.Txs = MinBlockRetention + 1
+	assert.ErrorIs(t, conf.BasicCheck(),
+		InvalidConfigError{"transaction retention should not be more than the block retention"})
+
+	conf.Txs = MinRetention
+	assert.NoError(t, conf.BasicCheck())
+
+	conf.Indexes = MinRetention - 1
+	assert.ErrorIs(t, conf.BasicCheck(),
+		InvalidConfigError{fmt.Sprintf("retention should be zero or at least %d", MinRetention)})
+}
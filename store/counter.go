@@ -0,0 +1,32 @@
+package store
+
+import (
+	"github.com/pactus-project/pactus/util"
+	"github.com/syndtr/goleveldb/leveldb"
+)
+
+func counterKey(name string) []byte {
+	return append(counterPrefix, []byte(name)...)
+}
+
+// Counter returns the value of the named counter, or zero if it is not saved.
+// The counters are the statistics of the node, they are not part of the chain.
+func (s *store) Counter(name string) int64 {
+	data, err := tryGet(s.db, counterKey(name))
+	if err != nil {
+		return 0
+	}
+
+	return util.SliceToInt64(data)
+}
+
+// SaveCounters saves the counters immediately, regardless of the batch of the blocks,
+// so they are kept when the node stops between two blocks.
+func (s *store) SaveCounters(counters map[string]int64) error {
+	batch := new(leveldb.Batch)
+	for name, value := range counters {
+		batch.Put(counterKey(name), util.Int64ToSlice(value))
+	}
+
+	return s.db.Write(batch, nil)
+}
@@ -0,0 +1,30 @@
+package store
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCounters(t *testing.T) {
+	td := setup(t, nil)
+
+	assert.Zero(t, td.store.Counter("blocks_proposed"))
+
+	require.NoError(t, td.store.SaveCounters(map[string]int64{
+		"blocks_proposed": 12,
+		"served_bytes":    1 << 40,
+	}))
+	assert.Equal(t, int64(12), td.store.Counter("blocks_proposed"))
+
+	t.Run("Counters are kept after reopening the store", func(t *testing.T) {
+		require.NoError(t, td.store.Close())
+		s, err := NewStore(td.store.config)
+		require.NoError(t, err)
+
+		assert.Equal(t, int64(12), s.Counter("blocks_proposed"))
+		assert.Equal(t, int64(1<<40), s.Counter("served_bytes"))
+		assert.Zero(t, s.Counter("missed_sortitions"))
+	})
+}
@@ -0,0 +1,100 @@
+package store
+
+import (
+	"time"
+
+	"github.com/pactus-project/pactus/util/disk"
+	"github.com/pactus-project/pactus/util/logger"
+)
+
+// diskCheckInterval is the minimum time between two checks of the free disk space.
+const diskCheckInterval = time.Second
+
+type diskLevel int
+
+const (
+	diskLevelNormal   = diskLevel(0)
+	diskLevelLow      = diskLevel(1)
+	diskLevelCritical = diskLevel(2)
+)
+
+// diskGuard monitors the free space of the disk that holds the database.
+// Running out of disk space in the middle of writing a batch can corrupt the database,
+// so the guard stops accepting new blocks when the free space is critically low.
+type diskGuard struct {
+	path              string
+	warningThreshold  uint64
+	criticalThreshold uint64
+	freeSpace         func(path string) (uint64, error)
+
+	level     diskLevel
+	free      uint64
+	lastCheck time.Time
+}
+
+func newDiskGuard(path string, warningThreshold, criticalThreshold uint64) *diskGuard {
+	return &diskGuard{
+		path:              path,
+		warningThreshold:  warningThreshold,
+		criticalThreshold: criticalThreshold,
+		freeSpace:         disk.FreeSpace,
+	}
+}
+
+// check returns an error if the free disk space is critically low.
+// The free space is queried at most once per `diskCheckInterval`.
+func (g *diskGuard) check(now time.Time) error {
+	if now.Sub(g.lastCheck) >= diskCheckInterval {
+		g.lastCheck = now
+		g.update()
+	}
+
+	if g.level == diskLevelCritical {
+		return InsufficientDiskSpaceError{
+			Path:     g.path,
+			Free:     g.free,
+			Required: g.criticalThreshold,
+		}
+	}
+
+	return nil
+}
+
+func (g *diskGuard) update() {
+	free, err := g.freeSpace(g.path)
+	if err != nil {
+		// Unable to determine the free space, don't block the node.
+		logger.Debug("unable to check free disk space", "path", g.path, "error", err)
+
+		return
+	}
+	g.free = free
+
+	level := diskLevelNormal
+	switch {
+	case free < g.criticalThreshold:
+		level = diskLevelCritical
+	case free < g.warningThreshold:
+		level = diskLevelLow
+	}
+
+	if level == g.level {
+		return
+	}
+
+	switch level {
+	case diskLevelCritical:
+		logger.Error("disk space is critically low, no new block will be accepted. "+
+			"Free up some space on the disk, the node continues automatically.",
+			"path", g.path, "free_mb", free>>20, "required_mb", g.criticalThreshold>>20)
+
+	case diskLevelLow:
+		logger.Warn("disk space is running low, free up some space on the disk",
+			"path", g.path, "free_mb", free>>20, "warning_mb", g.warningThreshold>>20)
+
+	case diskLevelNormal:
+		logger.Info("disk space is back to normal", "path", g.path, "free_mb", free>>20)
+	}
+
+	g.level = level
+}
@@ -0,0 +1,81 @@
+package store
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiskGuard(t *testing.T) {
+	free := uint64(0)
+	var freeErr error
+	guard := newDiskGuard("/data", 5<<30, 1<<30)
+	guard.freeSpace = func(_ string) (uint64, error) {
+		return free, freeErr
+	}
+
+	now := time.Now()
+
+	t.Run("Enough space", func(t *testing.T) {
+		free = 10 << 30
+		assert.NoError(t, guard.check(now))
+		assert.Equal(t, diskLevelNormal, guard.level)
+	})
+
+	t.Run("Low space", func(t *testing.T) {
+		now = now.Add(diskCheckInterval)
+		free = 2 << 30
+		assert.NoError(t, guard.check(now))
+		assert.Equal(t, diskLevelLow, guard.level)
+	})
+
+	t.Run("Critical space", func(t *testing.T) {
+		now = now.Add(diskCheckInterval)
+		free = 512 << 20
+		err := guard.check(now)
+		assert.ErrorIs(t, err, InsufficientDiskSpaceError{
+			Path:     "/data",
+			Free:     512 << 20,
+			Required: 1 << 30,
+		})
+		assert.Contains(t, err.Error(), "Free up some space")
+		assert.Equal(t, diskLevelCritical, guard.level)
+	})
+
+	t.Run("Free space is not queried before the interval", func(t *testing.T) {
+		free = 10 << 30
+		assert.Error(t, guard.check(now.Add(diskCheckInterval/2)))
+	})
+
+	t.Run("Unable to query the free space", func(t *testing.T) {
+		now = now.Add(diskCheckInterval)
+		freeErr = errors.New("not supported")
+
+		// The previous level is kept.
+		assert.Error(t, guard.check(now))
+		freeErr = nil
+	})
+
+	t.Run("Space is freed", func(t *testing.T) {
+		now = now.Add(diskCheckInterval)
+		free = 10 << 30
+		assert.NoError(t, guard.check(now))
+		assert.Equal(t, diskLevelNormal, guard.level)
+	})
+}
+
+func TestCheckDiskSpace(t *testing.T) {
+	conf := testConfig()
+	conf.InMemory = false
+	conf.Snapshot = false
+	td := setup(t, conf)
+
+	assert.NoError(t, td.store.CheckDiskSpace())
+
+	td.store.diskGuard.criticalThreshold = ^uint64(0)
+	td.store.diskGuard.lastCheck = time.Time{}
+	err := td.store.CheckDiskSpace()
+	assert.ErrorAs(t, err, &InsufficientDiskSpaceError{})
+}
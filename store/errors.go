@@ -25,3 +25,39 @@ func (e PublicKeyNotFoundError) Error() string {
 	return fmt.Sprintf("public key not found for: %s",
 		e.Address.String())
 }
+
+// InsufficientDiskSpaceError is returned when the free space of the disk that holds
+// the database is critically low.
+type InsufficientDiskSpaceError struct {
+	Path     string
+	Free     uint64
+	Required uint64
+}
+
+func (e InsufficientDiskSpaceError) Error() string {
+	return fmt.Sprintf("insufficient disk space on %s: %d MiB is free, at least %d MiB is required. "+
+		"Free up some space on the disk to continue",
+		e.Path, e.Free>>20, e.Required>>20)
+}
+
+// DowngradeError is returned when the database is upgraded by a newer version of the node.
+// The node can't downgrade the database, since it doesn't know the newer layout.
+type DowngradeError struct {
+	Version   int32
+	Supported int32
+}
+
+func (e DowngradeError) Error() string {
+	return fmt.Sprintf("the database schema version %d is newer than the supported version %d, "+
+		"downgrading the node is not supported", e.Version, e.Supported)
+}
+
+// SnapshotError is returned when the snapshot of the in-memory store can't be loaded.
+type SnapshotError struct {
+	Path   string
+	Reason string
+}
+
+func (e SnapshotError) Error() string {
+	return fmt.Sprintf("unable to load the store snapshot %s: %s", e.Path, e.Reason)
+}
@@ -0,0 +1,258 @@
+package store
+
+import (
+	"bytes"
+
+	"github.com/pactus-project/pactus/crypto/hash"
+	"github.com/pactus-project/pactus/types/block"
+	"github.com/pactus-project/pactus/types/certificate"
+	"github.com/pactus-project/pactus/util"
+	"github.com/pactus-project/pactus/util/logger"
+	lvlutil "github.com/syndtr/goleveldb/leveldb/util"
+)
+
+// Family is a logical group of the keys in the store that is retained and pruned independently.
+type Family int
+
+const (
+	FamilyBlocks       Family = 1
+	FamilyTxs          Family = 2
+	FamilyCertificates Family = 3
+	FamilyIndexes      Family = 4
+)
+
+const (
+	// MinBlockRetention is the minimum number of the recent blocks that a pruned store keeps.
+	// The state reads the recent blocks on start to restore the availability scores.
+	MinBlockRetention = uint32(60000)
+
+	// MinRetention is the minimum retention of the other families, about one day.
+	MinRetention = uint32(8640)
+
+	// maxPrunedHeights limits the number of the heights that are pruned per block,
+	// so enabling the retention on a long chain doesn't stall committing the blocks.
+	maxPrunedHeights = uint32(1000)
+)
+
+// Families contains all the column families of the store.
+var Families = []Family{FamilyBlocks, FamilyTxs, FamilyCertificates, FamilyIndexes}
+
+// pruneOrder is the order of pruning the families.
+// Transactions are found by decoding their block, so they are pruned before the blocks.
+var pruneOrder = []Family{FamilyTxs, FamilyBlocks, FamilyCertificates, FamilyIndexes}
+
+func (f Family) String() string {
+	switch f {
+	case FamilyBlocks:
+		return "blocks"
+	case FamilyTxs:
+		return "txs"
+	case FamilyCertificates:
+		return "certificates"
+	case FamilyIndexes:
+		return "indexes"
+	default:
+		return "unknown"
+	}
+}
+
+// prefixes returns the prefixes of the keys that belong to the family.
+func (f Family) prefixes() [][]byte {
+	switch f {
+	case FamilyBlocks:
+		return [][]byte{blockPrefix, blockHeightPrefix, headerProofPrefix}
+	case FamilyTxs:
+		return [][]byte{txPrefix, receiptPrefix}
+	case FamilyCertificates:
+		return [][]byte{lastInfoKey, certificatePrefix}
+	case FamilyIndexes:
+		return [][]byte{bloomPrefix, compactFilterPrefix, feeHistogramPrefix, publicKeyPrefix}
+	default:
+		return nil
+	}
+}
+
+// FamilyInfo contains the retention and the size of a column family.
+type FamilyInfo struct {
+	Family       Family
+	Retention    uint32 // Number of the recent blocks that are kept, zero means the whole history
+	PrunedHeight uint32 // The family is pruned up to this height, zero means nothing is pruned
+	Size         int64  // Approximate size of the family on the disk, in bytes
+}
+
+func certificateKey(height uint32) []byte {
+	return append(certificatePrefix, util.Uint32ToSlice(height)...)
+}
+
+func prunedHeightKey(f Family) []byte {
+	return append(prunedHeightPrefix, byte(f))
+}
+
+func (s *store) loadPrunedHeights() {
+	for _, f := range Families {
+		data, err := tryGet(s.db, prunedHeightKey(f))
+		if err == nil {
+			s.prunedHeights[f] = util.SliceToUint32(data)
+		}
+	}
+}
+
+// prune removes the data of the families that are older than their retention, in the current batch.
+func (s *store) prune(height uint32) {
+	for _, f := range pruneOrder {
+		retention := s.config.Retention.Of(f)
+		if retention == 0 || height <= retention {
+			continue
+		}
+
+		from := s.prunedHeights[f] + 1
+		to := util.Min(height-retention, from+maxPrunedHeights-1)
+		if from > to {
+			continue
+		}
+
+		for h := from; h <= to; h++ {
+			s.pruneHeight(f, h)
+		}
+
+		logger.Debug("column family pruned", "family", f, "from", from, "to", to)
+		s.prunedHeights[f] = to
+		s.batch.Put(prunedHeightKey(f), util.Uint32ToSlice(to))
+	}
+}
+
+func (s *store) pruneHeight(f Family, height uint32) {
+	switch f {
+	case FamilyBlocks:
+		// The first block is kept, since it is used to check the genesis document on start.
+		if height == 1 {
+			return
+		}
+		data, err := s.blockStore.block(height)
+		if err != nil {
+			return
+		}
+		blockHash, _ := hash.FromBytes(data[0:hash.HashSize])
+		blk, err := block.FromBytes(data[hash.HashSize:])
+		if err != nil {
+			logger.Warn("unable to decode the block for pruning", "height", height, "error", err)
+
+			return
+		}
+
+		// The header proof is retained, so the light clients can still verify the pruned block.
+		if err := s.retainHeaderProof(height, blk); err != nil {
+			logger.Warn("unable to retain the header proof of the pruned block", "height", height, "error", err)
+
+			return
+		}
+
+		s.batch.Delete(blockKey(height))
+		s.batch.Delete(blockHashKey(blockHash))
+		if s.mmapCache != nil {
+			s.mmapCache.remove(height)
+		}
+
+	case FamilyTxs:
+		data, err := s.blockStore.block(height)
+		if err != nil {
+			return
+		}
+		blk, err := block.FromBytes(data[hash.HashSize:])
+		if err != nil {
+			logger.Warn("unable to decode the block for pruning", "height", height, "error", err)
+
+			return
+		}
+
+		for _, trx := range blk.Transactions() {
+			s.batch.Delete(txKey(trx.ID()))
+			s.batch.Delete(receiptKey(trx.ID()))
+		}
+
+	case FamilyCertificates:
+		s.batch.Delete(certificateKey(height))
+
+	case FamilyIndexes:
+		s.batch.Delete(bloomKey(height))
+		s.batch.Delete(compactFilterKey(height))
+		s.batch.Delete(feeHistogramKey(height))
+	}
+}
+
+// Certificate returns the certificate of the block at the given height.
+// The certificates of the pruned blocks are read from the retained header proofs.
+// Blocks committed before the certificates were stored separately have no certificate.
+func (s *store) Certificate(height uint32) (*certificate.Certificate, error) {
+	s.lk.RLock()
+	defer s.lk.RUnlock()
+
+	return s.certificate(height)
+}
+
+func (s *store) certificate(height uint32) (*certificate.Certificate, error) {
+	data, err := tryGet(s.db, certificateKey(height))
+	if err != nil {
+		proof, err := s.retainedHeaderProof(height)
+		if err == nil {
+			return proof.Certificate, nil
+		}
+
+		return s.nextBlockCertificate(height)
+	}
+
+	cert := new(certificate.Certificate)
+	if err := cert.Decode(bytes.NewReader(data)); err != nil {
+		return nil, err
+	}
+
+	return cert, nil
+}
+
+// nextBlockCertificate returns the certificate of the block that is kept in the next block.
+// It is used when the certificate is pruned from its own column family,
+// but the header proof of the block is going to be retained.
+func (s *store) nextBlockCertificate(height uint32) (*certificate.Certificate, error) {
+	data, err := s.blockStore.block(height + 1)
+	if err != nil {
+		return nil, ErrNotFound
+	}
+
+	nextBlk, err := block.FromBytes(data[hash.HashSize:])
+	if err != nil {
+		return nil, err
+	}
+
+	return nextBlk.PrevCertificate(), nil
+}
+
+// FamilyInfos returns the retention and the approximate size of the column families.
+func (s *store) FamilyInfos() []FamilyInfo {
+	s.lk.RLock()
+	defer s.lk.RUnlock()
+
+	infos := make([]FamilyInfo, 0, len(Families))
+	for _, f := range Families {
+		ranges := make([]lvlutil.Range, 0, len(f.prefixes()))
+		for _, prefix := range f.prefixes() {
+			ranges = append(ranges, *lvlutil.BytesPrefix(prefix))
+		}
+
+		size := int64(0)
+		sizes, err := s.db.SizeOf(ranges)
+		if err != nil {
+			logger.Warn("unable to calculate the size of the column family", "family", f, "error", err)
+		} else {
+			size = sizes.Sum()
+		}
+
+		infos = append(infos, FamilyInfo{
+			Family:       f,
+			Retention:    s.config.Retention.Of(f),
+			PrunedHeight: s.prunedHeights[f],
+			Size:         size,
+		})
+	}
+
+	return infos
+}
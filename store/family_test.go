@@ -0,0 +1,122 @@
+package store
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCertificate(t *testing.T) {
+	td := setup(t, nil)
+
+	for height := uint32(1); height < 10; height++ {
+		committedBlock, err := td.store.Block(height + 1)
+		require.NoError(t, err)
+		nextBlk, err := committedBlock.ToBlock()
+		require.NoError(t, err)
+
+		cert, err := td.store.Certificate(height)
+		assert.NoError(t, err)
+		assert.Equal(t, nextBlk.PrevCertificate().Hash(), cert.Hash())
+	}
+
+	cert, err := td.store.Certificate(10)
+	assert.NoError(t, err)
+	assert.Equal(t, td.store.LastCertificate().Hash(), cert.Hash())
+
+	_, err = td.store.Certificate(11)
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestPruneFamilies(t *testing.T) {
+	conf := testConfig()
+	conf.Retention = &RetentionConfig{
+		Blocks:       6,
+		Txs:          4,
+		Certificates: 0,
+		Indexes:      5,
+	}
+	td := setup(t, conf)
+
+	committedBlock, err := td.store.Block(6)
+	require.NoError(t, err)
+	prunedBlk, err := committedBlock.ToBlock()
+	require.NoError(t, err)
+
+	committedBlock, err = td.store.Block(7)
+	require.NoError(t, err)
+	keptBlk, err := committedBlock.ToBlock()
+	require.NoError(t, err)
+
+	t.Run("The first block is kept", func(t *testing.T) {
+		_, err := td.store.Block(1)
+		assert.NoError(t, err)
+	})
+
+	t.Run("Old blocks are pruned", func(t *testing.T) {
+		for height := uint32(2); height <= 4; height++ {
+			_, err := td.store.Block(height)
+			assert.Error(t, err)
+		}
+		_, err := td.store.Block(5)
+		assert.NoError(t, err)
+	})
+
+	t.Run("Old transactions are pruned", func(t *testing.T) {
+		for _, trx := range prunedBlk.Transactions() {
+			_, err := td.store.Transaction(trx.ID())
+			assert.Error(t, err)
+		}
+		for _, trx := range keptBlk.Transactions() {
+			_, err := td.store.Transaction(trx.ID())
+			assert.NoError(t, err)
+		}
+	})
+
+	t.Run("Certificates are kept", func(t *testing.T) {
+		for height := uint32(1); height <= 10; height++ {
+			_, err := td.store.Certificate(height)
+			assert.NoError(t, err)
+		}
+	})
+
+	t.Run("Pruned heights are reported", func(t *testing.T) {
+		prunedHeights := map[Family]uint32{}
+		for _, info := range td.store.FamilyInfos() {
+			assert.Equal(t, conf.Retention.Of(info.Family), info.Retention)
+			prunedHeights[info.Family] = info.PrunedHeight
+		}
+
+		assert.Equal(t, uint32(4), prunedHeights[FamilyBlocks])
+		assert.Equal(t, uint32(6), prunedHeights[FamilyTxs])
+		assert.Equal(t, uint32(0), prunedHeights[FamilyCertificates])
+		assert.Equal(t, uint32(5), prunedHeights[FamilyIndexes])
+	})
+
+	t.Run("Pruned heights are loaded on start", func(t *testing.T) {
+		require.NoError(t, td.store.Close())
+
+		s, err := NewStore(conf)
+		require.NoError(t, err)
+		td.store = s.(*store)
+
+		assert.Equal(t, uint32(4), td.store.prunedHeights[FamilyBlocks])
+		assert.Equal(t, uint32(6), td.store.prunedHeights[FamilyTxs])
+	})
+}
+
+func TestFamilyInfos(t *testing.T) {
+	td := setup(t, nil)
+
+	infos := td.store.FamilyInfos()
+	require.Len(t, infos, len(Families))
+	for i, info := range infos {
+		assert.Equal(t, Families[i], info.Family)
+		assert.Zero(t, info.Retention)
+		assert.Zero(t, info.PrunedHeight)
+		assert.GreaterOrEqual(t, info.Size, int64(0))
+	}
+	assert.Equal(t, "blocks", FamilyBlocks.String())
+	assert.Equal(t, "certificates", FamilyCertificates.String())
+}
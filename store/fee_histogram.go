@@ -0,0 +1,70 @@
+package store
+
+import (
+	"encoding/binary"
+
+	"github.com/pactus-project/pactus/types/block"
+	"github.com/pactus-project/pactus/util/feehist"
+	lvlutil "github.com/syndtr/goleveldb/leveldb/util"
+)
+
+// feeHistogramKey is the key of the fee histogram of the block at the given height.
+// The height is encoded in big-endian, so the histograms of a range of the heights
+// can be read by a single iterator.
+func feeHistogramKey(height uint32) []byte {
+	key := make([]byte, len(feeHistogramPrefix)+4)
+	copy(key, feeHistogramPrefix)
+	binary.BigEndian.PutUint32(key[len(feeHistogramPrefix):], height)
+
+	return key
+}
+
+// blockFeeHistogram creates the histogram of the fees of the block transactions.
+// The subsidy transaction has no fee, so it is not counted.
+func blockFeeHistogram(blk *block.Block) *feehist.Histogram {
+	hist := feehist.New()
+	for _, trx := range blk.Transactions() {
+		if trx.IsSubsidyTx() {
+			continue
+		}
+		hist.Add(trx.Fee())
+	}
+
+	return hist
+}
+
+// IterateFeeHistograms iterates over the fee histograms of the blocks from the `from` height
+// up to the `to` height, both inclusive, in ascending order.
+// The pruned heights have no histogram, so they are skipped.
+func (s *store) IterateFeeHistograms(from, to uint32,
+	consumer func(height uint32, hist *feehist.Histogram) (stop bool),
+) {
+	if from > to {
+		return
+	}
+
+	s.lk.RLock()
+	defer s.lk.RUnlock()
+
+	rng := &lvlutil.Range{
+		Start: feeHistogramKey(from),
+		Limit: feeHistogramKey(to + 1),
+	}
+	if to == ^uint32(0) {
+		rng.Limit = lvlutil.BytesPrefix(feeHistogramPrefix).Limit
+	}
+
+	iter := s.db.NewIterator(rng, nil)
+	defer iter.Release()
+
+	for iter.Next() {
+		height := binary.BigEndian.Uint32(iter.Key()[len(feeHistogramPrefix):])
+		hist, err := feehist.FromBytes(iter.Value())
+		if err != nil {
+			return
+		}
+		if consumer(height, hist) {
+			return
+		}
+	}
+}
@@ -0,0 +1,92 @@
+package store
+
+import (
+	"testing"
+
+	"github.com/pactus-project/pactus/util/feehist"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func collectFeeHistograms(s *store, from, to uint32) map[uint32]*feehist.Histogram {
+	hists := map[uint32]*feehist.Histogram{}
+	s.IterateFeeHistograms(from, to, func(height uint32, hist *feehist.Histogram) bool {
+		hists[height] = hist
+
+		return false
+	})
+
+	return hists
+}
+
+func TestIterateFeeHistograms(t *testing.T) {
+	td := setup(t, nil)
+
+	t.Run("Whole range", func(t *testing.T) {
+		hists := collectFeeHistograms(td.store, 0, 100)
+		require.Len(t, hists, 10)
+		for height, hist := range hists {
+			cb, err := td.store.Block(height)
+			require.NoError(t, err)
+			blk, err := cb.ToBlock()
+			require.NoError(t, err)
+
+			expected := feehist.New()
+			for _, trx := range blk.Transactions() {
+				if !trx.IsSubsidyTx() {
+					expected.Add(trx.Fee())
+				}
+			}
+			assert.Equal(t, expected.Buckets(), hist.Buckets())
+		}
+	})
+
+	t.Run("Partial range", func(t *testing.T) {
+		hists := collectFeeHistograms(td.store, 3, 6)
+		assert.Len(t, hists, 4)
+		assert.Contains(t, hists, uint32(3))
+		assert.Contains(t, hists, uint32(6))
+	})
+
+	t.Run("Invalid range", func(t *testing.T) {
+		assert.Empty(t, collectFeeHistograms(td.store, 6, 3))
+	})
+
+	t.Run("Stop iterating", func(t *testing.T) {
+		count := 0
+		td.store.IterateFeeHistograms(1, 10, func(_ uint32, _ *feehist.Histogram) bool {
+			count++
+
+			return count == 2
+		})
+		assert.Equal(t, 2, count)
+	})
+}
+
+func TestFeeHistogramsOfPrunedIndexes(t *testing.T) {
+	conf := testConfig()
+	conf.Retention = &RetentionConfig{Indexes: 6}
+	td := setup(t, conf)
+
+	hists := collectFeeHistograms(td.store, 1, 10)
+	assert.Len(t, hists, 6)
+	assert.NotContains(t, hists, uint32(4))
+	assert.Contains(t, hists, uint32(5))
+}
+
+func TestMigrateFeeHistograms(t *testing.T) {
+	td := setup(t, nil)
+
+	expected := collectFeeHistograms(td.store, 1, 10)
+	for height := uint32(1); height <= 10; height++ {
+		require.NoError(t, td.store.db.Delete(feeHistogramKey(height), nil))
+	}
+	require.NoError(t, td.store.saveSchemaVersion(2))
+	assert.Empty(t, collectFeeHistograms(td.store, 1, 10))
+
+	require.NoError(t, td.store.migrate())
+
+	version, _, _ := td.store.schemaVersion()
+	assert.Equal(t, lastStoreVersion, version)
+	assert.Equal(t, expected, collectFeeHistograms(td.store, 1, 10))
+}
@@ -0,0 +1,130 @@
+package store
+
+import (
+	"encoding/binary"
+
+	"github.com/pactus-project/pactus/crypto/hash"
+	lvlutil "github.com/syndtr/goleveldb/leveldb/util"
+)
+
+// hashIndexKey is the key of the block hash in the height index.
+// The height is encoded in big-endian, so the keys are sorted by the height
+// and a range of the heights can be scanned by a single iterator.
+func hashIndexKey(height uint32) []byte {
+	key := make([]byte, len(hashIndexPrefix)+4)
+	copy(key, hashIndexPrefix)
+	binary.BigEndian.PutUint32(key[len(hashIndexPrefix):], height)
+
+	return key
+}
+
+// blockHash returns the hash of the block at the given height.
+// The blocks that are committed before the height index was introduced
+// are not indexed, so their hashes are read from the block data.
+func (s *store) blockHash(height uint32) (hash.Hash, error) {
+	data, err := tryGet(s.db, hashIndexKey(height))
+	if err == nil {
+		return hash.FromBytes(data)
+	}
+
+	data, err = s.blockStore.block(height)
+	if err != nil {
+		return hash.UndefHash, ErrNotFound
+	}
+
+	return hash.FromBytes(data[0:hash.HashSize])
+}
+
+// Ancestor returns the hash of the ancestor of the block at the given height,
+// that is `distance` blocks below it.
+// The blocks are final, so the ancestor is found by a lookup in the height index,
+// without walking through the blocks in between.
+// The height index is never pruned, so the ancestors of the pruned blocks are found too.
+func (s *store) Ancestor(height, distance uint32) (hash.Hash, error) {
+	if distance >= height {
+		return hash.UndefHash, ErrNotFound
+	}
+
+	s.lk.RLock()
+	defer s.lk.RUnlock()
+
+	if !tryHas(s.db, hashIndexKey(height)) && !s.blockStore.hasBlock(height) {
+		return hash.UndefHash, ErrNotFound
+	}
+
+	return s.blockHash(height - distance)
+}
+
+// IterateBlockHashes iterates over the block hashes from the `from` height
+// up to the `to` height, both inclusive, in ascending order.
+// The heights that are not indexed are read from the block data.
+func (s *store) IterateBlockHashes(from, to uint32,
+	consumer func(height uint32, blockHash hash.Hash) (stop bool),
+) {
+	if from == 0 {
+		from = 1
+	}
+	if from > to {
+		return
+	}
+
+	s.lk.RLock()
+	defer s.lk.RUnlock()
+
+	rng := &lvlutil.Range{
+		Start: hashIndexKey(from),
+		Limit: hashIndexKey(to + 1),
+	}
+	if to == ^uint32(0) {
+		rng.Limit = lvlutil.BytesPrefix(hashIndexPrefix).Limit
+	}
+
+	iter := s.db.NewIterator(rng, nil)
+	defer iter.Release()
+
+	next := from
+	for iter.Next() {
+		height := binary.BigEndian.Uint32(iter.Key()[len(hashIndexPrefix):])
+		if !s.iterateUnindexedHashes(next, height-1, consumer) {
+			return
+		}
+
+		blockHash, err := hash.FromBytes(iter.Value())
+		if err != nil {
+			return
+		}
+		if consumer(height, blockHash) {
+			return
+		}
+		next = height + 1
+	}
+
+	if next <= to {
+		s.iterateUnindexedHashes(next, to, consumer)
+	}
+}
+
+// iterateUnindexedHashes reads the hashes of the blocks that are not in the height index.
+// It returns false if the iteration should stop.
+func (s *store) iterateUnindexedHashes(from, to uint32,
+	consumer func(height uint32, blockHash hash.Hash) (stop bool),
+) bool {
+	for height := from; height <= to && height >= from; height++ {
+		data, err := s.blockStore.block(height)
+		if err != nil {
+			// The block is pruned or not committed yet.
+			if height > s.prunedHeights[FamilyBlocks] {
+				return false
+			}
+
+			continue
+		}
+
+		blockHash, _ := hash.FromBytes(data[0:hash.HashSize])
+		if consumer(height, blockHash) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,110 @@
+package store
+
+import (
+	"testing"
+
+	"github.com/pactus-project/pactus/crypto/hash"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func collectBlockHashes(s *store, from, to uint32) map[uint32]hash.Hash {
+	hashes := map[uint32]hash.Hash{}
+	s.IterateBlockHashes(from, to, func(height uint32, blockHash hash.Hash) bool {
+		hashes[height] = blockHash
+
+		return false
+	})
+
+	return hashes
+}
+
+func TestAncestor(t *testing.T) {
+	td := setup(t, nil)
+
+	for height := uint32(1); height <= 10; height++ {
+		for distance := uint32(0); distance < height; distance++ {
+			ancestor, err := td.store.Ancestor(height, distance)
+			assert.NoError(t, err)
+			assert.Equal(t, td.store.BlockHash(height-distance), ancestor)
+		}
+
+		_, err := td.store.Ancestor(height, height)
+		assert.ErrorIs(t, err, ErrNotFound)
+	}
+
+	_, err := td.store.Ancestor(11, 1)
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestIterateBlockHashes(t *testing.T) {
+	td := setup(t, nil)
+
+	t.Run("Whole range", func(t *testing.T) {
+		hashes := collectBlockHashes(td.store, 0, 100)
+		require.Len(t, hashes, 10)
+		for height, blockHash := range hashes {
+			committedBlock, err := td.store.Block(height)
+			require.NoError(t, err)
+			assert.Equal(t, committedBlock.BlockHash, blockHash)
+		}
+	})
+
+	t.Run("Partial range", func(t *testing.T) {
+		hashes := collectBlockHashes(td.store, 3, 6)
+		assert.Len(t, hashes, 4)
+		assert.Contains(t, hashes, uint32(3))
+		assert.Contains(t, hashes, uint32(6))
+	})
+
+	t.Run("Invalid range", func(t *testing.T) {
+		assert.Empty(t, collectBlockHashes(td.store, 6, 3))
+		assert.Empty(t, collectBlockHashes(td.store, 11, ^uint32(0)))
+	})
+
+	t.Run("Ascending order and stop", func(t *testing.T) {
+		heights := []uint32{}
+		td.store.IterateBlockHashes(1, ^uint32(0), func(height uint32, _ hash.Hash) bool {
+			heights = append(heights, height)
+
+			return height == 5
+		})
+		assert.Equal(t, []uint32{1, 2, 3, 4, 5}, heights)
+	})
+}
+
+func TestUnindexedBlockHashes(t *testing.T) {
+	td := setup(t, nil)
+
+	expected := collectBlockHashes(td.store, 1, 10)
+
+	// The blocks that are committed before the height index was introduced.
+	for height := uint32(1); height <= 4; height++ {
+		require.NoError(t, td.store.db.Delete(hashIndexKey(height), nil))
+	}
+	require.NoError(t, td.store.db.Delete(hashIndexKey(7), nil))
+
+	assert.Equal(t, expected, collectBlockHashes(td.store, 1, 10))
+	assert.Equal(t, expected[2], td.store.BlockHash(2))
+
+	ancestor, err := td.store.Ancestor(9, 7)
+	assert.NoError(t, err)
+	assert.Equal(t, expected[2], ancestor)
+}
+
+func TestBlockHashesOfPrunedBlocks(t *testing.T) {
+	conf := testConfig()
+	conf.Retention = &RetentionConfig{Blocks: 6, Txs: 6}
+	td := setup(t, conf)
+
+	_, err := td.store.Block(3)
+	require.Error(t, err)
+
+	hashes := collectBlockHashes(td.store, 1, 10)
+	assert.Len(t, hashes, 10)
+
+	ancestor, err := td.store.Ancestor(10, 7)
+	assert.NoError(t, err)
+	assert.Equal(t, hashes[3], ancestor)
+	assert.Equal(t, hashes[3], td.store.BlockHash(3))
+}
@@ -0,0 +1,150 @@
+package store
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+
+	"github.com/pactus-project/pactus/crypto/hash"
+	"github.com/pactus-project/pactus/types/block"
+	"github.com/pactus-project/pactus/types/certificate"
+	"github.com/pactus-project/pactus/util"
+	"github.com/pactus-project/pactus/util/encoding"
+)
+
+// HeaderProof contains the block header and the certificate that commits the block.
+// It is all that a light client needs to verify a block, without the transactions.
+// The header proofs of the pruned blocks are retained, so the pruned nodes
+// can still serve the light clients.
+type HeaderProof struct {
+	Height       uint32
+	Header       *block.Header
+	PrevCertHash hash.Hash // Undefined for the genesis block
+	TxsRoot      hash.Hash
+	TxsCount     int32
+	Certificate  *certificate.Certificate // Nil if the certificate is not found
+}
+
+// headerProofKey is the key of the retained header proof of a pruned block.
+// The height is encoded in big-endian, so the keys are sorted by the height.
+func headerProofKey(height uint32) []byte {
+	key := make([]byte, len(headerProofPrefix)+4)
+	copy(key, headerProofPrefix)
+	binary.BigEndian.PutUint32(key[len(headerProofPrefix):], height)
+
+	return key
+}
+
+func newHeaderProof(height uint32, blk *block.Block, cert *certificate.Certificate) *HeaderProof {
+	proof := &HeaderProof{
+		Height:      height,
+		Header:      blk.Header(),
+		TxsRoot:     blk.TxsRoot(),
+		TxsCount:    int32(blk.Transactions().Len()),
+		Certificate: cert,
+	}
+	if blk.PrevCertificate() != nil {
+		proof.PrevCertHash = blk.PrevCertificate().HashWithHasher(blk.Header().Hasher())
+	}
+
+	return proof
+}
+
+// BlockHash calculates the hash of the block, the same way as the block does.
+func (p *HeaderProof) BlockHash() hash.Hash {
+	w := &bytes.Buffer{}
+	if err := p.Header.Encode(w); err != nil {
+		return hash.UndefHash
+	}
+	// Genesis block has no certificate
+	if !p.PrevCertHash.IsUndef() {
+		w.Write(p.PrevCertHash.Bytes())
+	}
+	w.Write(p.TxsRoot.Bytes())
+	w.Write(util.Int32ToSlice(p.TxsCount))
+
+	return p.Header.Hasher().CalcHash(w.Bytes())
+}
+
+// encode writes the proof as:
+// [header: variant]+[prev certificate hash: 32 bytes]+[txs root: 32 bytes]+[txs count: 4 bytes]+[certificate: variant].
+// The height is kept in the key.
+func (p *HeaderProof) encode(w io.Writer) error {
+	if err := p.Header.Encode(w); err != nil {
+		return err
+	}
+	if err := encoding.WriteElements(w, &p.PrevCertHash, &p.TxsRoot, p.TxsCount); err != nil {
+		return err
+	}
+
+	return p.Certificate.Encode(w)
+}
+
+func (p *HeaderProof) decode(r io.Reader) error {
+	p.Header = new(block.Header)
+	if err := p.Header.Decode(r); err != nil {
+		return err
+	}
+	if err := encoding.ReadElements(r, &p.PrevCertHash, &p.TxsRoot, &p.TxsCount); err != nil {
+		return err
+	}
+	p.Certificate = new(certificate.Certificate)
+
+	return p.Certificate.Decode(r)
+}
+
+// retainHeaderProof keeps the header proof of a block that is being pruned, in the current batch.
+// The certificate is read before the block is pruned, from its own column family
+// or from the next block.
+func (s *store) retainHeaderProof(height uint32, blk *block.Block) error {
+	cert, err := s.certificate(height)
+	if err != nil {
+		return err
+	}
+
+	w := bytes.NewBuffer(make([]byte, 0, blk.Header().SerializeSize()+2*hash.HashSize+4+cert.SerializeSize()))
+	if err := newHeaderProof(height, blk, cert).encode(w); err != nil {
+		return err
+	}
+	s.batch.Put(headerProofKey(height), w.Bytes())
+
+	return nil
+}
+
+// retainedHeaderProof returns the retained header proof of a pruned block.
+func (s *store) retainedHeaderProof(height uint32) (*HeaderProof, error) {
+	data, err := tryGet(s.db, headerProofKey(height))
+	if err != nil {
+		return nil, ErrNotFound
+	}
+
+	proof := &HeaderProof{Height: height}
+	if err := proof.decode(bytes.NewReader(data)); err != nil {
+		return nil, err
+	}
+
+	return proof, nil
+}
+
+// HeaderProof returns the header proof of the block at the given height.
+// The proofs of the pruned blocks are read from the retained header proofs.
+func (s *store) HeaderProof(height uint32) (*HeaderProof, error) {
+	s.lk.Lock()
+	defer s.lk.Unlock()
+
+	data, err := s.blockData(height)
+	if err != nil {
+		return s.retainedHeaderProof(height)
+	}
+
+	blk, err := block.FromBytes(data[hash.HashSize:])
+	if err != nil {
+		return nil, err
+	}
+
+	// Blocks committed before the certificates were stored separately
+	// may have no certificate, so the certificate is optional.
+	cert, _ := s.certificate(height)
+
+	return newHeaderProof(height, blk, cert), nil
+}
@@ -0,0 +1,102 @@
+package store
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHeaderProof(t *testing.T) {
+	td := setup(t, nil)
+
+	for height := uint32(1); height <= 10; height++ {
+		committedBlock, err := td.store.Block(height)
+		require.NoError(t, err)
+		blk, err := committedBlock.ToBlock()
+		require.NoError(t, err)
+
+		proof, err := td.store.HeaderProof(height)
+		require.NoError(t, err)
+		assert.Equal(t, height, proof.Height)
+		assert.Equal(t, blk.Hash(), proof.BlockHash())
+		assert.Equal(t, blk.TxsRoot(), proof.TxsRoot)
+		assert.Equal(t, int32(blk.Transactions().Len()), proof.TxsCount)
+
+		cert, err := td.store.Certificate(height)
+		require.NoError(t, err)
+		assert.Equal(t, cert.Hash(), proof.Certificate.Hash())
+	}
+
+	_, err := td.store.HeaderProof(11)
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestHeaderProofEncoding(t *testing.T) {
+	td := setup(t, nil)
+
+	committedBlock, err := td.store.Block(td.RandUint32(10) + 1)
+	require.NoError(t, err)
+	blk, err := committedBlock.ToBlock()
+	require.NoError(t, err)
+
+	proof := newHeaderProof(committedBlock.Height, blk, td.GenerateTestCertificate(committedBlock.Height))
+	w := &bytes.Buffer{}
+	require.NoError(t, proof.encode(w))
+
+	decoded := &HeaderProof{Height: committedBlock.Height}
+	require.NoError(t, decoded.decode(bytes.NewReader(w.Bytes())))
+	assert.Equal(t, proof.BlockHash(), decoded.BlockHash())
+	assert.Equal(t, proof.Certificate.Hash(), decoded.Certificate.Hash())
+
+	assert.Error(t, decoded.decode(bytes.NewReader(w.Bytes()[:w.Len()-1])))
+}
+
+func TestHeaderProofsOfPrunedBlocks(t *testing.T) {
+	conf := testConfig()
+	// The certificates are pruned before the blocks,
+	// so the retained certificates are read from the next blocks.
+	conf.Retention = &RetentionConfig{Blocks: 6, Certificates: 4}
+	td := setup(t, conf)
+
+	for height := uint32(2); height <= 4; height++ {
+		_, err := td.store.Block(height)
+		require.Error(t, err)
+
+		proof, err := td.store.HeaderProof(height)
+		require.NoError(t, err)
+		assert.Equal(t, height, proof.Height)
+		assert.Equal(t, td.store.BlockHash(height), proof.BlockHash())
+		assert.Equal(t, height, proof.Certificate.Height())
+
+		cert, err := td.store.Certificate(height)
+		require.NoError(t, err)
+		assert.Equal(t, proof.Certificate.Hash(), cert.Hash())
+	}
+
+	t.Run("The certificates of the kept blocks are read from the next blocks", func(t *testing.T) {
+		for height := uint32(5); height <= 6; height++ {
+			committedBlock, err := td.store.Block(height + 1)
+			require.NoError(t, err)
+			nextBlk, err := committedBlock.ToBlock()
+			require.NoError(t, err)
+
+			cert, err := td.store.Certificate(height)
+			require.NoError(t, err)
+			assert.Equal(t, nextBlk.PrevCertificate().Hash(), cert.Hash())
+		}
+	})
+
+	t.Run("Header proofs are retained after restart", func(t *testing.T) {
+		require.NoError(t, td.store.Close())
+
+		s, err := NewStore(conf)
+		require.NoError(t, err)
+		td.store = s.(*store)
+
+		proof, err := td.store.HeaderProof(3)
+		require.NoError(t, err)
+		assert.Equal(t, td.store.BlockHash(3), proof.BlockHash())
+	})
+}
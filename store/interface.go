@@ -8,8 +8,12 @@ import (
 	"github.com/pactus-project/pactus/types/account"
 	"github.com/pactus-project/pactus/types/block"
 	"github.com/pactus-project/pactus/types/certificate"
+	"github.com/pactus-project/pactus/types/receipt"
 	"github.com/pactus-project/pactus/types/tx"
 	"github.com/pactus-project/pactus/types/validator"
+	"github.com/pactus-project/pactus/util/bloom"
+	"github.com/pactus-project/pactus/util/feehist"
+	"github.com/pactus-project/pactus/util/gcs"
 )
 
 // TODO: store blocks inside flat files (to reduce the size of levelDB)
@@ -82,9 +86,15 @@ type Reader interface {
 	Block(height uint32) (*CommittedBlock, error)
 	BlockHeight(h hash.Hash) uint32
 	BlockHash(height uint32) hash.Hash
+	Ancestor(height, distance uint32) (hash.Hash, error)
+	IterateBlockHashes(from, to uint32, consumer func(height uint32, blockHash hash.Hash) (stop bool))
+	Bloom(height uint32) (*bloom.Filter, error)
+	CompactFilter(height uint32) (*gcs.Filter, error)
+	IterateFeeHistograms(from, to uint32, consumer func(height uint32, hist *feehist.Histogram) (stop bool))
 	SortitionSeed(blockHeight uint32) *sortition.VerifiableSeed
 	Transaction(id tx.ID) (*CommittedTx, error)
 	AnyRecentTransaction(id tx.ID) bool
+	Receipt(id tx.ID) (*receipt.Receipt, error)
 	PublicKey(addr crypto.Address) (*bls.PublicKey, error)
 	HasAccount(crypto.Address) bool
 	Account(addr crypto.Address) (*account.Account, error)
@@ -96,7 +106,12 @@ type Reader interface {
 	IterateValidators(consumer func(*validator.Validator) (stop bool))
 	IterateAccounts(consumer func(crypto.Address, *account.Account) (stop bool))
 	TotalValidators() int32
+	IsAllowlisted(addr crypto.Address) bool
 	LastCertificate() *certificate.Certificate
+	Certificate(height uint32) (*certificate.Certificate, error)
+	HeaderProof(height uint32) (*HeaderProof, error)
+	FamilyInfos() []FamilyInfo
+	Counter(name string) int64
 }
 
 type Store interface {
@@ -104,7 +119,13 @@ type Store interface {
 
 	UpdateAccount(addr crypto.Address, acc *account.Account)
 	UpdateValidator(val *validator.Validator)
+	UpdateAllowlist(addr crypto.Address, allowed bool)
 	SaveBlock(blk *block.Block, cert *certificate.Certificate)
+	SaveReceipts(rcpts []*receipt.Receipt)
+	SaveBloom(height uint32, filter *bloom.Filter)
+	SaveCompactFilter(height uint32, filter *gcs.Filter)
+	SaveCounters(counters map[string]int64) error
+	CheckDiskSpace() error
 	WriteBatch() error
 	Close() error
 }
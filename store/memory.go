@@ -0,0 +1,105 @@
+package store
+
+import (
+	"bufio"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/pactus-project/pactus/util"
+	"github.com/pactus-project/pactus/util/encoding"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/opt"
+	"github.com/syndtr/goleveldb/leveldb/storage"
+)
+
+// openMemoryDB opens a database that keeps all the data in memory.
+// If the snapshot is enabled and the snapshot file exists, the data is restored from it.
+func openMemoryDB(conf *Config, options *opt.Options) (*leveldb.DB, error) {
+	db, err := leveldb.Open(storage.NewMemStorage(), options)
+	if err != nil {
+		return nil, err
+	}
+
+	if conf.Snapshot && util.PathExists(conf.SnapshotPath()) {
+		if err := loadSnapshot(db, conf.SnapshotPath()); err != nil {
+			_ = db.Close()
+
+			return nil, err
+		}
+	}
+
+	return db, nil
+}
+
+// loadSnapshot writes all the key-value pairs of the snapshot file into the database.
+func loadSnapshot(db *leveldb.DB, path string) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	r := bufio.NewReader(file)
+	batch := new(leveldb.Batch)
+	for {
+		key, err := encoding.ReadVarBytes(r)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+
+			return SnapshotError{Path: path, Reason: err.Error()}
+		}
+		value, err := encoding.ReadVarBytes(r)
+		if err != nil {
+			return SnapshotError{Path: path, Reason: err.Error()}
+		}
+		batch.Put(key, value)
+	}
+
+	return db.Write(batch, nil)
+}
+
+// saveSnapshot writes all the key-value pairs of the database into the snapshot file.
+// The snapshot is written into a temporary file first,
+// so a failure never leaves a broken snapshot behind.
+func saveSnapshot(db *leveldb.DB, path string) error {
+	if err := util.Mkdir(filepath.Dir(path)); err != nil {
+		return err
+	}
+
+	tmpPath := path + ".tmp"
+	file, err := os.Create(tmpPath)
+	if err != nil {
+		return err
+	}
+
+	w := bufio.NewWriter(file)
+	iter := db.NewIterator(nil, nil)
+	for err == nil && iter.Next() {
+		err = encoding.WriteVarBytes(w, iter.Key())
+		if err == nil {
+			err = encoding.WriteVarBytes(w, iter.Value())
+		}
+	}
+	iter.Release()
+
+	if err == nil {
+		err = iter.Error()
+	}
+	if err == nil {
+		err = w.Flush()
+	}
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		_ = os.Remove(tmpPath)
+
+		return err
+	}
+
+	return os.Rename(tmpPath, path)
+}
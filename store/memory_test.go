@@ -0,0 +1,57 @@
+package store
+
+import (
+	"testing"
+
+	"github.com/pactus-project/pactus/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMemoryStore(t *testing.T) {
+	conf := testConfig()
+	conf.Snapshot = false
+	td := setup(t, conf)
+
+	assert.Nil(t, td.store.diskGuard)
+	assert.NoError(t, td.store.CheckDiskSpace())
+	assert.Equal(t, uint32(10), td.store.LastCertificate().Height())
+
+	require.NoError(t, td.store.Close())
+	assert.False(t, util.PathExists(conf.SnapshotPath()))
+	assert.False(t, util.PathExists(conf.StorePath()))
+
+	s, err := NewStore(conf)
+	require.NoError(t, err)
+	assert.Nil(t, s.LastCertificate())
+}
+
+func TestMemoryStoreSnapshot(t *testing.T) {
+	td := setup(t, nil)
+
+	acc, addr := td.GenerateTestAccount(td.RandInt32(1000))
+	td.store.UpdateAccount(addr, acc)
+	require.NoError(t, td.store.WriteBatch())
+
+	lastCert := td.store.LastCertificate()
+	lastHash := td.store.BlockHash(lastCert.Height())
+	require.NoError(t, td.store.Close())
+	assert.True(t, util.PathExists(td.store.config.SnapshotPath()))
+
+	s, err := NewStore(td.store.config)
+	require.NoError(t, err)
+
+	assert.Equal(t, lastCert.Hash(), s.LastCertificate().Hash())
+	assert.Equal(t, lastHash, s.BlockHash(lastCert.Height()))
+	acc2, err := s.Account(addr)
+	require.NoError(t, err)
+	assert.Equal(t, acc, acc2)
+}
+
+func TestMemoryStoreInvalidSnapshot(t *testing.T) {
+	conf := testConfig()
+	require.NoError(t, util.WriteFile(conf.SnapshotPath(), []byte{0x05, 0x01}))
+
+	_, err := NewStore(conf)
+	assert.ErrorAs(t, err, &SnapshotError{})
+}
@@ -0,0 +1,258 @@
+package store
+
+import (
+	"bytes"
+	"time"
+
+	"github.com/pactus-project/pactus/crypto"
+	"github.com/pactus-project/pactus/crypto/hash"
+	"github.com/pactus-project/pactus/util"
+	"github.com/pactus-project/pactus/util/bloom"
+	"github.com/pactus-project/pactus/util/encoding"
+	"github.com/pactus-project/pactus/util/gcs"
+	"github.com/pactus-project/pactus/util/logger"
+	"github.com/syndtr/goleveldb/leveldb"
+)
+
+// progressInterval is the minimum interval between the progress logs of a migration.
+const progressInterval = 5 * time.Second
+
+// migrationBatchSize is the number of the blocks that a migration writes in one batch.
+const migrationBatchSize = 1000
+
+// migration upgrades the database from the previous version to its version.
+type migration struct {
+	version     int32
+	description string
+	migrate     func(s *store, progress func(done, total uint32)) error
+}
+
+// migrations should be sorted by version, and the last one should be the current version.
+var migrations = []migration{
+	{
+		version:     2,
+		description: "indexing the addresses touched by the blocks in bloom and compact filters",
+		migrate:     migrateBlockFilters,
+	},
+	{
+		version:     3,
+		description: "indexing the fee histograms of the blocks",
+		migrate:     migrateFeeHistograms,
+	},
+	{
+		version:     4,
+		description: "training the dictionary of the compressed block bodies",
+		migrate:     migrateBlockDict,
+	},
+}
+
+// schemaVersion returns the schema version of the database.
+// The databases before the schema version key keep the version in the last info.
+// It returns false if the database is empty.
+func (s *store) schemaVersion() (int32, bool, error) {
+	data, _ := tryGet(s.db, schemaVersionKey)
+	if data == nil {
+		data, _ = tryGet(s.db, lastInfoKey)
+		if data == nil {
+			return 0, false, nil
+		}
+	}
+
+	version := int32(0)
+	if err := encoding.ReadElement(bytes.NewReader(data), &version); err != nil {
+		return 0, false, err
+	}
+
+	return version, true, nil
+}
+
+func (s *store) saveSchemaVersion(version int32) error {
+	w := bytes.NewBuffer(make([]byte, 0, 4))
+	if err := encoding.WriteElement(w, version); err != nil {
+		return err
+	}
+
+	return s.db.Put(schemaVersionKey, w.Bytes(), nil)
+}
+
+// migrate upgrades the database to the current schema version.
+// The version is saved after each migration, so an interrupted upgrade continues from the last migration.
+// The databases of the newer versions are refused, since their layout is unknown.
+func (s *store) migrate() error {
+	version, found, err := s.schemaVersion()
+	if err != nil {
+		return err
+	}
+	if !found {
+		return s.saveSchemaVersion(lastStoreVersion)
+	}
+
+	if version > lastStoreVersion {
+		return DowngradeError{Version: version, Supported: lastStoreVersion}
+	}
+
+	for _, m := range migrations {
+		if m.version <= version {
+			continue
+		}
+
+		logger.Info("migrating the store", "from", version, "to", m.version, "migration", m.description)
+		started := time.Now()
+		lastLog := started
+		progress := func(done, total uint32) {
+			if time.Since(lastLog) < progressInterval {
+				return
+			}
+			lastLog = time.Now()
+
+			percent := float64(0)
+			if total > 0 {
+				percent = float64(done) * 100 / float64(total)
+			}
+			logger.Info("migrating the store", "version", m.version,
+				"done", done, "total", total, "progress", percent)
+		}
+
+		if err := m.migrate(s, progress); err != nil {
+			return err
+		}
+		if err := s.saveSchemaVersion(m.version); err != nil {
+			return err
+		}
+
+		logger.Info("store migrated", "version", m.version, "elapsed", time.Since(started))
+		version = m.version
+	}
+
+	return s.saveSchemaVersion(lastStoreVersion)
+}
+
+// migrateBlockFilters creates the bloom and compact filters of the blocks that are committed
+// before indexing the touched addresses.
+// The receipts of these blocks are not stored, so only the signers and receivers are indexed.
+func migrateBlockFilters(s *store, progress func(done, total uint32)) error {
+	lc := s.LastCertificate()
+	if lc == nil {
+		return nil
+	}
+
+	lastHeight := lc.Height()
+	batch := new(leveldb.Batch)
+	for height := uint32(1); height <= lastHeight; height++ {
+		if !tryHas(s.db, bloomKey(height)) {
+			cb, err := s.Block(height)
+			if err != nil {
+				return err
+			}
+			blk, err := cb.ToBlock()
+			if err != nil {
+				return err
+			}
+
+			addrs := make(map[crypto.Address]bool)
+			for _, trx := range blk.Transactions() {
+				addrs[trx.Payload().Signer()] = true
+				if receiver := trx.Payload().Receiver(); receiver != nil {
+					addrs[*receiver] = true
+				}
+			}
+
+			filter := bloom.New(len(addrs))
+			items := make([][]byte, 0, len(addrs))
+			for addr := range addrs {
+				filter.Add(addr.Bytes())
+				items = append(items, addr.Bytes())
+			}
+
+			batch.Put(bloomKey(height), append([]byte{filter.HashCount()}, filter.Bytes()...))
+			batch.Put(compactFilterKey(height), gcs.Build(blk.Hash(), items).Bytes())
+		}
+
+		if batch.Len() >= 2*migrationBatchSize {
+			if err := s.db.Write(batch, nil); err != nil {
+				return err
+			}
+			batch.Reset()
+		}
+		progress(height, lastHeight)
+	}
+
+	return s.db.Write(batch, nil)
+}
+
+// migrateFeeHistograms creates the fee histograms of the blocks that are committed
+// before indexing the fees. The pruned blocks are skipped.
+func migrateFeeHistograms(s *store, progress func(done, total uint32)) error {
+	lc := s.LastCertificate()
+	if lc == nil {
+		return nil
+	}
+
+	firstHeight := uint32(1)
+	if data, err := tryGet(s.db, prunedHeightKey(FamilyBlocks)); err == nil {
+		firstHeight = util.SliceToUint32(data) + 1
+	}
+
+	lastHeight := lc.Height()
+	batch := new(leveldb.Batch)
+	for height := firstHeight; height <= lastHeight; height++ {
+		if !tryHas(s.db, feeHistogramKey(height)) {
+			cb, err := s.Block(height)
+			if err != nil {
+				return err
+			}
+			blk, err := cb.ToBlock()
+			if err != nil {
+				return err
+			}
+
+			batch.Put(feeHistogramKey(height), blockFeeHistogram(blk).Bytes())
+		}
+
+		if batch.Len() >= migrationBatchSize {
+			if err := s.db.Write(batch, nil); err != nil {
+				return err
+			}
+			batch.Reset()
+		}
+		progress(height, lastHeight)
+	}
+
+	return s.db.Write(batch, nil)
+}
+
+// migrateBlockDict trains the dictionary of the block bodies by the recent blocks,
+// if the compression is enabled. Otherwise, the dictionary is trained when it is enabled,
+// by the new blocks. The databases of this version might have compressed blocks,
+// which the previous versions can't read.
+func migrateBlockDict(s *store, progress func(done, total uint32)) error {
+	bc := s.blockStore.compressor
+	lc := s.LastCertificate()
+	if !bc.enabled || bc.trained || lc == nil {
+		return nil
+	}
+
+	lastHeight := lc.Height()
+	firstHeight := uint32(1)
+	if data, err := tryGet(s.db, prunedHeightKey(FamilyBlocks)); err == nil {
+		firstHeight = util.SliceToUint32(data) + 1
+	}
+	if lastHeight >= dictTrainBlocks && lastHeight-dictTrainBlocks+1 > firstHeight {
+		firstHeight = lastHeight - dictTrainBlocks + 1
+	}
+
+	// The migrations run before the store is used, so the compressor is not locked.
+	for height := firstHeight; height <= lastHeight; height++ {
+		data, err := s.blockStore.block(height)
+		if err != nil {
+			return err
+		}
+		bc.addSample(data[hash.HashSize:])
+		progress(height-firstHeight+1, lastHeight-firstHeight+1)
+	}
+	if !bc.trained && len(bc.samples) > 0 {
+		bc.train()
+	}
+
+	return nil
+}
@@ -0,0 +1,100 @@
+package store
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/pactus-project/pactus/util/encoding"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// makeLegacyStore makes the store look like the databases of the first schema version.
+func (td *testData) makeLegacyStore(t *testing.T) {
+	t.Helper()
+
+	require.NoError(t, td.store.db.Delete(schemaVersionKey, nil))
+
+	data, err := td.store.db.Get(lastInfoKey, nil)
+	require.NoError(t, err)
+	w := bytes.NewBuffer(make([]byte, 0, len(data)))
+	require.NoError(t, encoding.WriteElement(w, int32(1)))
+	w.Write(data[4:])
+	require.NoError(t, td.store.db.Put(lastInfoKey, w.Bytes(), nil))
+}
+
+func TestSchemaVersionOfNewStore(t *testing.T) {
+	td := setup(t, nil)
+
+	version, found, err := td.store.schemaVersion()
+	require.NoError(t, err)
+	assert.True(t, found)
+	assert.Equal(t, lastStoreVersion, version)
+}
+
+func TestMigrateLegacyStore(t *testing.T) {
+	td := setup(t, nil)
+
+	td.makeLegacyStore(t)
+	version, _, _ := td.store.schemaVersion()
+	assert.Equal(t, int32(1), version)
+
+	// The blocks of the legacy store have no filters.
+	_, err := td.store.Bloom(5)
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	require.NoError(t, td.store.migrate())
+
+	version, _, _ = td.store.schemaVersion()
+	assert.Equal(t, lastStoreVersion, version)
+
+	for height := uint32(1); height <= 10; height++ {
+		cb, err := td.store.Block(height)
+		require.NoError(t, err)
+		blk, err := cb.ToBlock()
+		require.NoError(t, err)
+
+		filter, err := td.store.Bloom(height)
+		require.NoError(t, err)
+		compactFilter, err := td.store.CompactFilter(height)
+		require.NoError(t, err)
+
+		for _, trx := range blk.Transactions() {
+			signer := trx.Payload().Signer()
+			assert.True(t, filter.Contains(signer.Bytes()))
+			assert.True(t, compactFilter.Match(blk.Hash(), signer.Bytes()))
+		}
+	}
+
+	// Migrating again does nothing
+	assert.NoError(t, td.store.migrate())
+}
+
+func TestMigrationKeepsExistingFilters(t *testing.T) {
+	td := setup(t, nil)
+
+	td.makeLegacyStore(t)
+	savedData := []byte{1, 2, 3}
+	require.NoError(t, td.store.db.Put(bloomKey(3), savedData, nil))
+	require.NoError(t, td.store.migrate())
+
+	data, err := td.store.db.Get(bloomKey(3), nil)
+	require.NoError(t, err)
+	assert.Equal(t, savedData, data)
+
+	_, err = td.store.Bloom(4)
+	assert.NoError(t, err)
+}
+
+func TestRefuseDowngrade(t *testing.T) {
+	td := setup(t, nil)
+
+	require.NoError(t, td.store.saveSchemaVersion(lastStoreVersion+1))
+	require.NoError(t, td.store.Close())
+
+	_, err := NewStore(td.store.config)
+	downgradeErr := DowngradeError{}
+	require.ErrorAs(t, err, &downgradeErr)
+	assert.Equal(t, lastStoreVersion+1, downgradeErr.Version)
+	assert.Equal(t, lastStoreVersion, downgradeErr.Supported)
+}
@@ -0,0 +1,114 @@
+package store
+
+import (
+	"os"
+)
+
+type cacheRegion struct {
+	offset int
+	length int
+}
+
+// mmapCache is a read cache for the recent blocks that is backed by a memory-mapped file.
+// The cached data lives in the OS page cache instead of the Go heap,
+// so it doesn't add to the heap pressure and is not counted twice.
+// The file is used as a ring buffer: new blocks overwrite the oldest ones.
+// The cache is not persistent, the file is recreated on each start.
+// It is not thread-safe, the store lock should be held.
+type mmapCache struct {
+	file    *os.File
+	data    []byte
+	head    int
+	regions map[uint32]cacheRegion
+}
+
+func newMmapCache(path string, size int) (*mmapCache, error) {
+	_ = os.Remove(path)
+	file, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0o600)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := file.Truncate(int64(size)); err != nil {
+		_ = file.Close()
+
+		return nil, err
+	}
+
+	data, err := mmap(file, size)
+	if err != nil {
+		_ = file.Close()
+		_ = os.Remove(path)
+
+		return nil, err
+	}
+
+	return &mmapCache{
+		file:    file,
+		data:    data,
+		regions: make(map[uint32]cacheRegion),
+	}, nil
+}
+
+// get returns a copy of the cached data.
+// A copy is returned because the region can be overwritten by newer blocks.
+func (c *mmapCache) get(height uint32) ([]byte, bool) {
+	region, ok := c.regions[height]
+	if !ok {
+		return nil, false
+	}
+
+	data := make([]byte, region.length)
+	copy(data, c.data[region.offset:region.offset+region.length])
+
+	return data, true
+}
+
+// put adds the data to the cache, evicting the blocks that are overwritten.
+// Data larger than the cache is ignored.
+func (c *mmapCache) put(height uint32, data []byte) {
+	if len(data) > len(c.data) {
+		return
+	}
+	if _, ok := c.regions[height]; ok {
+		return
+	}
+
+	if c.head+len(data) > len(c.data) {
+		c.head = 0
+	}
+	offset := c.head
+	end := offset + len(data)
+
+	for h, r := range c.regions {
+		if r.offset < end && offset < r.offset+r.length {
+			delete(c.regions, h)
+		}
+	}
+
+	copy(c.data[offset:end], data)
+	c.regions[height] = cacheRegion{offset: offset, length: len(data)}
+	c.head = end
+}
+
+// remove evicts the block from the cache.
+func (c *mmapCache) remove(height uint32) {
+	delete(c.regions, height)
+}
+
+func (c *mmapCache) len() int {
+	return len(c.regions)
+}
+
+func (c *mmapCache) close() error {
+	c.regions = nil
+	if err := munmap(c.data); err != nil {
+		return err
+	}
+	c.data = nil
+	if err := c.file.Close(); err != nil {
+		return err
+	}
+
+	return os.Remove(c.file.Name())
+}
@@ -0,0 +1,121 @@
+package store
+
+import (
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/pactus-project/pactus/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setupMmapCache(t *testing.T, size int) *mmapCache {
+	t.Helper()
+
+	if runtime.GOOS == "windows" {
+		t.Skip("mmap cache is not supported on windows")
+	}
+
+	cache, err := newMmapCache(filepath.Join(util.TempDirPath(), "cache.mmap"), size)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = cache.close() })
+
+	return cache
+}
+
+func TestMmapCache(t *testing.T) {
+	cache := setupMmapCache(t, 10)
+
+	t.Run("Get and put", func(t *testing.T) {
+		cache.put(1, []byte{1, 1, 1})
+		cache.put(2, []byte{2, 2, 2})
+
+		data, ok := cache.get(1)
+		assert.True(t, ok)
+		assert.Equal(t, []byte{1, 1, 1}, data)
+
+		_, ok = cache.get(3)
+		assert.False(t, ok)
+	})
+
+	t.Run("Returned data is a copy", func(t *testing.T) {
+		data, _ := cache.get(2)
+		data[0] = 0
+
+		data, _ = cache.get(2)
+		assert.Equal(t, []byte{2, 2, 2}, data)
+	})
+
+	t.Run("Too large data is ignored", func(t *testing.T) {
+		cache.put(3, make([]byte, 11))
+
+		_, ok := cache.get(3)
+		assert.False(t, ok)
+	})
+
+	t.Run("Oldest blocks are overwritten", func(t *testing.T) {
+		cache.put(3, []byte{3, 3, 3})
+		assert.Equal(t, 3, cache.len())
+
+		// Wraps to the beginning and overwrites the first block.
+		cache.put(4, []byte{4, 4})
+		_, ok := cache.get(1)
+		assert.False(t, ok)
+
+		data, ok := cache.get(4)
+		assert.True(t, ok)
+		assert.Equal(t, []byte{4, 4}, data)
+
+		// Overwrites the second block only.
+		cache.put(5, []byte{5, 5})
+		_, ok = cache.get(2)
+		assert.False(t, ok)
+		data, ok = cache.get(3)
+		assert.True(t, ok)
+		assert.Equal(t, []byte{3, 3, 3}, data)
+	})
+}
+
+func TestMmapCacheClose(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("mmap cache is not supported on windows")
+	}
+
+	path := filepath.Join(util.TempDirPath(), "cache.mmap")
+	cache, err := newMmapCache(path, 10)
+	require.NoError(t, err)
+	assert.True(t, util.PathExists(path))
+
+	assert.NoError(t, cache.close())
+	assert.False(t, util.PathExists(path))
+}
+
+func TestBlockWithMmapCache(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("mmap cache is not supported on windows")
+	}
+
+	conf := testConfig()
+	conf.InMemory = false
+	conf.Snapshot = false
+	conf.MmapCacheMB = 1
+	td := setup(t, conf)
+	require.NotNil(t, td.store.mmapCache)
+
+	committed1, err := td.store.Block(1)
+	require.NoError(t, err)
+	assert.Equal(t, 1, td.store.mmapCache.len())
+
+	// Reading from the cache
+	committed2, err := td.store.Block(1)
+	require.NoError(t, err)
+	assert.Equal(t, committed1, committed2)
+
+	blk, err := committed2.ToBlock()
+	require.NoError(t, err)
+	assert.Equal(t, committed1.BlockHash, blk.Hash())
+
+	assert.NoError(t, td.store.Close())
+	assert.False(t, util.PathExists(conf.MmapCachePath()))
+}
@@ -0,0 +1,16 @@
+//go:build windows
+
+package store
+
+import (
+	"errors"
+	"os"
+)
+
+func mmap(_ *os.File, _ int) ([]byte, error) {
+	return nil, errors.New("memory-mapped cache is not supported on this platform")
+}
+
+func munmap(_ []byte) error {
+	return nil
+}
@@ -10,8 +10,13 @@ import (
 	"github.com/pactus-project/pactus/types/account"
 	"github.com/pactus-project/pactus/types/block"
 	"github.com/pactus-project/pactus/types/certificate"
+	"github.com/pactus-project/pactus/types/receipt"
 	"github.com/pactus-project/pactus/types/tx"
 	"github.com/pactus-project/pactus/types/validator"
+	"github.com/pactus-project/pactus/util"
+	"github.com/pactus-project/pactus/util/bloom"
+	"github.com/pactus-project/pactus/util/feehist"
+	"github.com/pactus-project/pactus/util/gcs"
 	"github.com/pactus-project/pactus/util/testsuite"
 )
 
@@ -23,8 +28,14 @@ type MockStore struct {
 	Blocks     map[uint32]*block.Block
 	Accounts   map[crypto.Address]*account.Account
 	Validators map[crypto.Address]*validator.Validator
+	Allowlist  map[crypto.Address]bool
+	Receipts   map[tx.ID]*receipt.Receipt
+	Blooms     map[uint32]*bloom.Filter
+	Filters    map[uint32]*gcs.Filter
+	Counters   map[string]int64
 	LastCert   *certificate.Certificate
 	LastHeight uint32
+	DiskError  error
 }
 
 func MockingStore(ts *testsuite.TestSuite) *MockStore {
@@ -33,6 +44,11 @@ func MockingStore(ts *testsuite.TestSuite) *MockStore {
 		Blocks:     make(map[uint32]*block.Block),
 		Accounts:   make(map[crypto.Address]*account.Account),
 		Validators: make(map[crypto.Address]*validator.Validator),
+		Allowlist:  make(map[crypto.Address]bool),
+		Receipts:   make(map[tx.ID]*receipt.Receipt),
+		Blooms:     make(map[uint32]*bloom.Filter),
+		Filters:    make(map[uint32]*gcs.Filter),
+		Counters:   make(map[string]int64),
 	}
 }
 
@@ -60,6 +76,68 @@ func (m *MockStore) BlockHash(height uint32) hash.Hash {
 	return hash.UndefHash
 }
 
+func (m *MockStore) Ancestor(height, distance uint32) (hash.Hash, error) {
+	if distance >= height {
+		return hash.UndefHash, ErrNotFound
+	}
+	if _, ok := m.Blocks[height]; !ok {
+		return hash.UndefHash, ErrNotFound
+	}
+	b, ok := m.Blocks[height-distance]
+	if !ok {
+		return hash.UndefHash, ErrNotFound
+	}
+
+	return b.Hash(), nil
+}
+
+func (m *MockStore) IterateBlockHashes(from, to uint32,
+	consumer func(height uint32, blockHash hash.Hash) (stop bool),
+) {
+	for height := util.Max(from, 1); height <= to && height >= from; height++ {
+		b, ok := m.Blocks[height]
+		if !ok {
+			return
+		}
+		if consumer(height, b.Hash()) {
+			return
+		}
+	}
+}
+
+func (m *MockStore) Bloom(height uint32) (*bloom.Filter, error) {
+	filter, ok := m.Blooms[height]
+	if ok {
+		return filter, nil
+	}
+
+	return nil, ErrNotFound
+}
+
+func (m *MockStore) CompactFilter(height uint32) (*gcs.Filter, error) {
+	filter, ok := m.Filters[height]
+	if ok {
+		return filter, nil
+	}
+
+	return nil, ErrNotFound
+}
+
+func (m *MockStore) IterateFeeHistograms(from, to uint32,
+	consumer func(height uint32, hist *feehist.Histogram) (stop bool),
+) {
+	to = util.Min(to, m.LastHeight)
+	for height := from; height <= to && height >= from; height++ {
+		b, ok := m.Blocks[height]
+		if !ok {
+			continue
+		}
+		if consumer(height, blockFeeHistogram(b)) {
+			return
+		}
+	}
+}
+
 func (m *MockStore) BlockHeight(h hash.Hash) uint32 {
 	for height, b := range m.Blocks {
 		if b.Hash() == h {
@@ -116,6 +194,15 @@ func (m *MockStore) Transaction(id tx.ID) (*CommittedTx, error) {
 	return nil, fmt.Errorf("not found")
 }
 
+func (m *MockStore) Receipt(id tx.ID) (*receipt.Receipt, error) {
+	rcpt, ok := m.Receipts[id]
+	if ok {
+		return rcpt, nil
+	}
+
+	return nil, ErrNotFound
+}
+
 func (m *MockStore) AnyRecentTransaction(id tx.ID) bool {
 	for _, block := range m.Blocks {
 		for _, trx := range block.Transactions() {
@@ -199,6 +286,18 @@ func (m *MockStore) UpdateValidator(val *validator.Validator) {
 	m.Validators[val.Address()] = val
 }
 
+func (m *MockStore) IsAllowlisted(addr crypto.Address) bool {
+	return m.Allowlist[addr]
+}
+
+func (m *MockStore) UpdateAllowlist(addr crypto.Address, allowed bool) {
+	if allowed {
+		m.Allowlist[addr] = true
+	} else {
+		delete(m.Allowlist, addr)
+	}
+}
+
 func (m *MockStore) TotalValidators() int32 {
 	return int32(len(m.Validators))
 }
@@ -235,6 +334,20 @@ func (m *MockStore) SaveBlock(b *block.Block, cert *certificate.Certificate) {
 	m.LastCert = cert
 }
 
+func (m *MockStore) SaveReceipts(rcpts []*receipt.Receipt) {
+	for _, rcpt := range rcpts {
+		m.Receipts[rcpt.TxID()] = rcpt
+	}
+}
+
+func (m *MockStore) SaveBloom(height uint32, filter *bloom.Filter) {
+	m.Blooms[height] = filter
+}
+
+func (m *MockStore) SaveCompactFilter(height uint32, filter *gcs.Filter) {
+	m.Filters[height] = filter
+}
+
 func (m *MockStore) LastCertificate() *certificate.Certificate {
 	if m.LastHeight == 0 {
 		return nil
@@ -243,10 +356,57 @@ func (m *MockStore) LastCertificate() *certificate.Certificate {
 	return m.LastCert
 }
 
+func (m *MockStore) Certificate(height uint32) (*certificate.Certificate, error) {
+	if height == m.LastHeight && m.LastCert != nil {
+		return m.LastCert, nil
+	}
+	b, ok := m.Blocks[height+1]
+	if ok {
+		return b.PrevCertificate(), nil
+	}
+
+	return nil, ErrNotFound
+}
+
+func (m *MockStore) HeaderProof(height uint32) (*HeaderProof, error) {
+	b, ok := m.Blocks[height]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	cert, _ := m.Certificate(height)
+
+	return newHeaderProof(height, b, cert), nil
+}
+
+func (m *MockStore) FamilyInfos() []FamilyInfo {
+	infos := make([]FamilyInfo, 0, len(Families))
+	for _, f := range Families {
+		infos = append(infos, FamilyInfo{Family: f})
+	}
+
+	return infos
+}
+
+func (m *MockStore) CheckDiskSpace() error {
+	return m.DiskError
+}
+
 func (m *MockStore) WriteBatch() error {
 	return nil
 }
 
+func (m *MockStore) Counter(name string) int64 {
+	return m.Counters[name]
+}
+
+func (m *MockStore) SaveCounters(counters map[string]int64) error {
+	for name, value := range counters {
+		m.Counters[name] = value
+	}
+
+	return nil
+}
+
 func (m *MockStore) AddTestValidator() *validator.Validator {
 	val, _ := m.ts.GenerateTestValidator(m.ts.RandInt32(10000))
 	m.UpdateValidator(val)
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"errors"
 	"sync"
+	"time"
 
 	"github.com/pactus-project/pactus/crypto"
 	"github.com/pactus-project/pactus/crypto/bls"
@@ -12,10 +13,13 @@ import (
 	"github.com/pactus-project/pactus/types/account"
 	"github.com/pactus-project/pactus/types/block"
 	"github.com/pactus-project/pactus/types/certificate"
+	"github.com/pactus-project/pactus/types/receipt"
 	"github.com/pactus-project/pactus/types/tx"
 	"github.com/pactus-project/pactus/types/validator"
 	"github.com/pactus-project/pactus/util"
+	"github.com/pactus-project/pactus/util/bloom"
 	"github.com/pactus-project/pactus/util/encoding"
+	"github.com/pactus-project/pactus/util/gcs"
 	"github.com/pactus-project/pactus/util/logger"
 	"github.com/syndtr/goleveldb/leveldb"
 	"github.com/syndtr/goleveldb/leveldb/opt"
@@ -27,17 +31,31 @@ var (
 )
 
 const (
-	lastStoreVersion = int32(1)
+	// lastStoreVersion is the schema version of the database.
+	// Changing the layout of the keys or values needs a new version and a migration for it.
+	lastStoreVersion = int32(4)
 )
 
 var (
-	lastInfoKey       = []byte{0x00}
-	blockPrefix       = []byte{0x01}
-	txPrefix          = []byte{0x03}
-	accountPrefix     = []byte{0x05}
-	validatorPrefix   = []byte{0x07}
-	blockHeightPrefix = []byte{0x09}
-	publicKeyPrefix   = []byte{0x0b}
+	lastInfoKey         = []byte{0x00}
+	blockPrefix         = []byte{0x01}
+	txPrefix            = []byte{0x03}
+	accountPrefix       = []byte{0x05}
+	validatorPrefix     = []byte{0x07}
+	blockHeightPrefix   = []byte{0x09}
+	publicKeyPrefix     = []byte{0x0b}
+	receiptPrefix       = []byte{0x0d}
+	bloomPrefix         = []byte{0x0f}
+	compactFilterPrefix = []byte{0x11}
+	schemaVersionKey    = []byte{0x13}
+	allowlistPrefix     = []byte{0x15}
+	certificatePrefix   = []byte{0x17}
+	prunedHeightPrefix  = []byte{0x19}
+	hashIndexPrefix     = []byte{0x1b}
+	counterPrefix       = []byte{0x1d}
+	feeHistogramPrefix  = []byte{0x1f}
+	blockDictKey        = []byte{0x21}
+	headerProofPrefix   = []byte{0x23}
 )
 
 func tryGet(db *leveldb.DB, key []byte) ([]byte, error) {
@@ -73,6 +91,9 @@ type store struct {
 	txStore        *txStore
 	accountStore   *accountStore
 	validatorStore *validatorStore
+	diskGuard      *diskGuard
+	mmapCache      *mmapCache
+	prunedHeights  map[Family]uint32
 }
 
 func NewStore(conf *Config) (Store, error) {
@@ -81,7 +102,13 @@ func NewStore(conf *Config) (Store, error) {
 		Compression: opt.NoCompression,
 	}
 
-	db, err := leveldb.OpenFile(conf.StorePath(), options)
+	var db *leveldb.DB
+	var err error
+	if conf.InMemory {
+		db, err = openMemoryDB(conf, options)
+	} else {
+		db, err = leveldb.OpenFile(conf.StorePath(), options)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -89,40 +116,44 @@ func NewStore(conf *Config) (Store, error) {
 		config:         conf,
 		db:             db,
 		batch:          new(leveldb.Batch),
-		blockStore:     newBlockStore(db, conf.SortitionCacheSize, conf.PublicKeyCacheSize),
+		blockStore:     newBlockStore(db, conf.SortitionCacheSize, conf.PublicKeyCacheSize, conf.CompressBlocks),
 		txStore:        newTxStore(db, conf.TxCacheSize),
 		accountStore:   newAccountStore(db, conf.AccountCacheSize),
 		validatorStore: newValidatorStore(db),
+		prunedHeights:  make(map[Family]uint32),
 	}
 
-	lc := s.LastCertificate()
-	if lc == nil {
-		return s, nil
+	// The in-memory store doesn't write on the disk,
+	// so there is no need to guard the disk space or to cache the blocks on the disk.
+	if !conf.InMemory {
+		s.diskGuard = newDiskGuard(conf.DataPath(),
+			conf.DiskWarningThreshold, conf.DiskCriticalThreshold)
 	}
 
-	currentHeight := lc.Height()
-	startHeight := uint32(1)
-	if currentHeight > conf.TxCacheSize {
-		startHeight = currentHeight - conf.TxCacheSize
-	}
-
-	for i := startHeight; i < currentHeight+1; i++ {
-		committedBlock, err := s.Block(i)
-		if err != nil {
-			return nil, err
-		}
-		blk, err := committedBlock.ToBlock()
+	if conf.MmapCacheMB > 0 && !conf.InMemory {
+		cache, err := newMmapCache(conf.MmapCachePath(), conf.MmapCacheMB<<20)
 		if err != nil {
-			return nil, err
+			// The cache is optional, the store can work without it.
+			logger.Warn("unable to create mmap block cache", "error", err)
+		} else {
+			s.mmapCache = cache
 		}
+	}
 
-		txs := blk.Transactions()
-		for _, transaction := range txs {
-			s.txStore.saveToCache(transaction.ID(), i)
-		}
+	if err := s.migrate(); err != nil {
+		_ = db.Close()
 
-		sortitionSeed := blk.Header().SortitionSeed()
-		s.blockStore.saveToCache(i, sortitionSeed)
+		return nil, err
+	}
+	s.loadPrunedHeights()
+
+	lc := s.LastCertificate()
+	if lc == nil {
+		return s, nil
+	}
+
+	if err := s.warmup(lc.Height()); err != nil {
+		return nil, err
 	}
 
 	return s, nil
@@ -132,6 +163,19 @@ func (s *store) Close() error {
 	s.lk.Lock()
 	defer s.lk.Unlock()
 
+	if s.mmapCache != nil {
+		if err := s.mmapCache.close(); err != nil {
+			logger.Warn("unable to close mmap block cache", "error", err)
+		}
+		s.mmapCache = nil
+	}
+
+	if s.config.InMemory && s.config.Snapshot {
+		if err := saveSnapshot(s.db, s.config.SnapshotPath()); err != nil {
+			logger.Error("unable to save the store snapshot", "error", err)
+		}
+	}
+
 	return s.db.Close()
 }
 
@@ -141,6 +185,8 @@ func (s *store) SaveBlock(blk *block.Block, cert *certificate.Certificate) {
 
 	height := cert.Height()
 	regs := s.blockStore.saveBlock(s.batch, height, blk)
+	s.batch.Put(hashIndexKey(height), blk.Hash().Bytes())
+	s.batch.Put(feeHistogramKey(height), blockFeeHistogram(blk).Bytes())
 	s.txStore.saveTxs(s.batch, blk.Transactions(), regs)
 	s.txStore.pruneCache(height)
 
@@ -156,13 +202,59 @@ func (s *store) SaveBlock(blk *block.Block, cert *certificate.Certificate) {
 	}
 
 	s.batch.Put(lastInfoKey, w.Bytes())
+	// The certificates are also kept in their own column family, so they outlive the pruned blocks.
+	// The certificate of the previous block is replaced by the one in the block,
+	// since it may have more signatures.
+	s.batch.Put(certificateKey(height), w.Bytes()[4:])
+	if prevCert := blk.PrevCertificate(); prevCert != nil {
+		data := bytes.NewBuffer(make([]byte, 0, prevCert.SerializeSize()))
+		if err := prevCert.Encode(data); err != nil {
+			panic(err)
+		}
+		s.batch.Put(certificateKey(prevCert.Height()), data.Bytes())
+	}
+
+	s.prune(height)
+}
+
+// SaveReceipts saves the receipts of the transactions in the current batch.
+// They are written alongside the block that includes the transactions.
+func (s *store) SaveReceipts(rcpts []*receipt.Receipt) {
+	s.lk.Lock()
+	defer s.lk.Unlock()
+
+	for _, rcpt := range rcpts {
+		data, err := rcpt.Bytes()
+		if err != nil {
+			panic(err)
+		}
+		s.batch.Put(receiptKey(rcpt.TxID()), data)
+	}
+}
+
+// SaveBloom saves the bloom filter of the addresses touched by the block in the current batch.
+// It is stored as: [hash count: 1 byte]+[bits: variant].
+func (s *store) SaveBloom(height uint32, filter *bloom.Filter) {
+	s.lk.Lock()
+	defer s.lk.Unlock()
+
+	data := append([]byte{filter.HashCount()}, filter.Bytes()...)
+	s.batch.Put(bloomKey(height), data)
+}
+
+// SaveCompactFilter saves the compact filter of the addresses touched by the block in the current batch.
+func (s *store) SaveCompactFilter(height uint32, filter *gcs.Filter) {
+	s.lk.Lock()
+	defer s.lk.Unlock()
+
+	s.batch.Put(compactFilterKey(height), filter.Bytes())
 }
 
 func (s *store) Block(height uint32) (*CommittedBlock, error) {
 	s.lk.Lock()
 	defer s.lk.Unlock()
 
-	data, err := s.blockStore.block(height)
+	data, err := s.blockData(height)
 	if err != nil {
 		return nil, err
 	}
@@ -180,6 +272,53 @@ func (s *store) Block(height uint32) (*CommittedBlock, error) {
 	}, nil
 }
 
+// blockData reads the block data from the mmap cache, if it is enabled, or from the database.
+func (s *store) blockData(height uint32) ([]byte, error) {
+	if s.mmapCache == nil {
+		return s.blockStore.block(height)
+	}
+
+	if data, ok := s.mmapCache.get(height); ok {
+		return data, nil
+	}
+
+	data, err := s.blockStore.block(height)
+	if err != nil {
+		return nil, err
+	}
+	s.mmapCache.put(height, data)
+
+	return data, nil
+}
+
+// Bloom returns the bloom filter of the block at the given height.
+// Blocks committed before the bloom filters were introduced have no filter.
+func (s *store) Bloom(height uint32) (*bloom.Filter, error) {
+	s.lk.RLock()
+	defer s.lk.RUnlock()
+
+	data, err := tryGet(s.db, bloomKey(height))
+	if err != nil {
+		return nil, ErrNotFound
+	}
+
+	return bloom.FromBytes(data[1:], data[0])
+}
+
+// CompactFilter returns the compact filter of the block at the given height.
+// Blocks committed before the compact filters were introduced have no filter.
+func (s *store) CompactFilter(height uint32) (*gcs.Filter, error) {
+	s.lk.RLock()
+	defer s.lk.RUnlock()
+
+	data, err := tryGet(s.db, compactFilterKey(height))
+	if err != nil {
+		return nil, ErrNotFound
+	}
+
+	return gcs.FromBytes(data)
+}
+
 func (s *store) BlockHeight(h hash.Hash) uint32 {
 	s.lk.Lock()
 	defer s.lk.Unlock()
@@ -191,14 +330,12 @@ func (s *store) BlockHash(height uint32) hash.Hash {
 	s.lk.Lock()
 	defer s.lk.Unlock()
 
-	data, err := s.blockStore.block(height)
-	if err == nil {
-		blockHash, _ := hash.FromBytes(data[0:hash.HashSize])
-
-		return blockHash
+	blockHash, err := s.blockHash(height)
+	if err != nil {
+		return hash.UndefHash
 	}
 
-	return hash.UndefHash
+	return blockHash
 }
 
 func (s *store) SortitionSeed(blockHeight uint32) *sortition.VerifiableSeed {
@@ -243,6 +380,20 @@ func (s *store) Transaction(id tx.ID) (*CommittedTx, error) {
 	}, nil
 }
 
+// Receipt returns the receipt of the committed transaction.
+// Transactions committed before the receipts were introduced have no receipt.
+func (s *store) Receipt(id tx.ID) (*receipt.Receipt, error) {
+	s.lk.RLock()
+	defer s.lk.RUnlock()
+
+	data, err := tryGet(s.db, receiptKey(id))
+	if err != nil {
+		return nil, ErrNotFound
+	}
+
+	return receipt.FromBytes(data)
+}
+
 func (s *store) AnyRecentTransaction(id tx.ID) bool {
 	s.lk.Lock()
 	defer s.lk.Unlock()
@@ -334,6 +485,26 @@ func (s *store) UpdateValidator(acc *validator.Validator) {
 	s.validatorStore.updateValidator(s.batch, acc)
 }
 
+// IsAllowlisted checks if the validator is in the allowlist of the permissioned network.
+func (s *store) IsAllowlisted(addr crypto.Address) bool {
+	s.lk.RLock()
+	defer s.lk.RUnlock()
+
+	return tryHas(s.db, allowlistKey(addr))
+}
+
+// UpdateAllowlist adds the validator to, or removes it from, the allowlist.
+func (s *store) UpdateAllowlist(addr crypto.Address, allowed bool) {
+	s.lk.Lock()
+	defer s.lk.Unlock()
+
+	if allowed {
+		s.batch.Put(allowlistKey(addr), []byte{1})
+	} else {
+		s.batch.Delete(allowlistKey(addr))
+	}
+}
+
 func (s *store) LastCertificate() *certificate.Certificate {
 	s.lk.Lock()
 	defer s.lk.Unlock()
@@ -358,6 +529,19 @@ func (s *store) LastCertificate() *certificate.Certificate {
 	return cert
 }
 
+// CheckDiskSpace returns an error if the free disk space is critically low.
+// Blocks shouldn't be committed in this case, to prevent corrupting the database.
+func (s *store) CheckDiskSpace() error {
+	s.lk.Lock()
+	defer s.lk.Unlock()
+
+	if s.diskGuard == nil {
+		return nil
+	}
+
+	return s.diskGuard.check(time.Now())
+}
+
 func (s *store) WriteBatch() error {
 	s.lk.Lock()
 	defer s.lk.Unlock()
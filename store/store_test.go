@@ -6,8 +6,11 @@ import (
 	"github.com/pactus-project/pactus/crypto/bls"
 	"github.com/pactus-project/pactus/crypto/hash"
 	"github.com/pactus-project/pactus/types/block"
+	"github.com/pactus-project/pactus/types/receipt"
 	"github.com/pactus-project/pactus/types/tx"
 	"github.com/pactus-project/pactus/util"
+	"github.com/pactus-project/pactus/util/bloom"
+	"github.com/pactus-project/pactus/util/gcs"
 	"github.com/pactus-project/pactus/util/testsuite"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -19,9 +22,14 @@ type testData struct {
 	store *store
 }
 
+// testConfig returns a configuration for the in-memory store.
+// The snapshot is enabled, so the tests can close and load the store again.
 func testConfig() *Config {
 	return &Config{
 		Path:               util.TempDirPath(),
+		InMemory:           true,
+		Snapshot:           true,
+		Retention:          DefaultRetentionConfig(),
 		TxCacheSize:        1024,
 		SortitionCacheSize: 1024,
 		AccountCacheSize:   1024,
@@ -114,6 +122,91 @@ func TestRetrieveBlockAndTransactions(t *testing.T) {
 	}
 }
 
+func TestReceipts(t *testing.T) {
+	td := setup(t, nil)
+
+	blk, cert := td.GenerateTestBlock(11)
+	rcpts := make([]*receipt.Receipt, 0, blk.Transactions().Len())
+	for i, trx := range blk.Transactions() {
+		rcpts = append(rcpts, receipt.NewReceipt(trx.ID(), 11, uint16(i), receipt.StatusSuccess, trx.Fee(),
+			[]receipt.Balance{{Address: trx.Payload().Signer(), Balance: td.RandAmount()}}, nil))
+	}
+	td.store.SaveBlock(blk, cert)
+	td.store.SaveReceipts(rcpts)
+
+	// Receipts are not available before writing the batch
+	_, err := td.store.Receipt(rcpts[0].TxID())
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	require.NoError(t, td.store.WriteBatch())
+
+	for _, rcpt := range rcpts {
+		rcpt2, err := td.store.Receipt(rcpt.TxID())
+		require.NoError(t, err)
+		assert.Equal(t, rcpt.TxID(), rcpt2.TxID())
+		assert.Equal(t, rcpt.Index(), rcpt2.Index())
+		assert.Equal(t, rcpt.Fee(), rcpt2.Fee())
+		assert.Equal(t, rcpt.Balances(), rcpt2.Balances())
+	}
+
+	_, err = td.store.Receipt(td.RandHash())
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestBlooms(t *testing.T) {
+	td := setup(t, nil)
+
+	addr := td.RandAccAddress()
+	filter := bloom.New(1)
+	filter.Add(addr.Bytes())
+
+	td.store.SaveBloom(11, filter)
+	require.NoError(t, td.store.WriteBatch())
+
+	filter2, err := td.store.Bloom(11)
+	require.NoError(t, err)
+	assert.Equal(t, filter, filter2)
+	assert.True(t, filter2.Contains(addr.Bytes()))
+
+	_, err = td.store.Bloom(12)
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestAllowlist(t *testing.T) {
+	td := setup(t, nil)
+
+	addr := td.RandValAddress()
+	assert.False(t, td.store.IsAllowlisted(addr))
+
+	td.store.UpdateAllowlist(addr, true)
+	assert.False(t, td.store.IsAllowlisted(addr), "not written yet")
+	require.NoError(t, td.store.WriteBatch())
+	assert.True(t, td.store.IsAllowlisted(addr))
+
+	td.store.UpdateAllowlist(addr, false)
+	require.NoError(t, td.store.WriteBatch())
+	assert.False(t, td.store.IsAllowlisted(addr))
+}
+
+func TestCompactFilters(t *testing.T) {
+	td := setup(t, nil)
+
+	key := td.RandHash()
+	addr := td.RandAccAddress()
+	filter := gcs.Build(key, [][]byte{addr.Bytes()})
+
+	td.store.SaveCompactFilter(11, filter)
+	require.NoError(t, td.store.WriteBatch())
+
+	filter2, err := td.store.CompactFilter(11)
+	require.NoError(t, err)
+	assert.Equal(t, filter.Bytes(), filter2.Bytes())
+	assert.True(t, filter2.Match(key, addr.Bytes()))
+
+	_, err = td.store.CompactFilter(12)
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
 func TestIndexingPublicKeys(t *testing.T) {
 	td := setup(t, nil)
 
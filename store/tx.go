@@ -10,6 +10,10 @@ import (
 	"github.com/syndtr/goleveldb/leveldb"
 )
 
+// blockRegion is the region of a transaction inside the body of its block.
+// Each transaction is stored only once, inside its block, and it is referenced by its ID
+// through the region. The transaction IDs are unique in the blockchain,
+// so there is nothing more to deduplicate.
 type blockRegion struct {
 	height uint32
 	offset uint32
@@ -18,6 +22,8 @@ type blockRegion struct {
 
 func txKey(id tx.ID) []byte { return append(txPrefix, id.Bytes()...) }
 
+func receiptKey(id tx.ID) []byte { return append(receiptPrefix, id.Bytes()...) }
+
 type txStore struct {
 	db          *leveldb.DB
 	txIDCache   *linkedmap.LinkedMap[tx.ID, uint32]
@@ -0,0 +1,85 @@
+package store
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/pactus-project/pactus/crypto"
+	"github.com/pactus-project/pactus/util"
+	"github.com/pactus-project/pactus/util/logger"
+)
+
+// warmup loads the recent blocks into the caches before the node joins the consensus.
+// The transaction IDs are cached for the transaction cache window, and the accounts
+// that are touched by the last `WarmupBlocks` blocks are loaded into the account cache.
+// Reading the blocks also fills the block cache, the public keys and the sortition seeds.
+// The validators are always kept in memory, so the committee is ready after this.
+func (s *store) warmup(currentHeight uint32) error {
+	startTime := time.Now()
+
+	txStartHeight := uint32(1)
+	if currentHeight > s.config.TxCacheSize {
+		txStartHeight = currentHeight - s.config.TxCacheSize
+	}
+	hotStartHeight := uint32(1)
+	if currentHeight > s.config.WarmupBlocks {
+		hotStartHeight = currentHeight - s.config.WarmupBlocks + 1
+	}
+
+	startHeight := util.Min(txStartHeight, hotStartHeight)
+	// The pruned blocks are not available anymore.
+	if startHeight <= s.prunedHeights[FamilyBlocks] {
+		startHeight = s.prunedHeights[FamilyBlocks] + 1
+	}
+
+	total := currentHeight - startHeight + 1
+	step := util.Max(total/10, 1)
+	for i := startHeight; i <= currentHeight; i++ {
+		committedBlock, err := s.Block(i)
+		if err != nil {
+			return err
+		}
+		blk, err := committedBlock.ToBlock()
+		if err != nil {
+			return err
+		}
+
+		if i >= txStartHeight {
+			for _, trx := range blk.Transactions() {
+				s.txStore.saveToCache(trx.ID(), i)
+			}
+		}
+
+		sortitionSeed := blk.Header().SortitionSeed()
+		s.blockStore.saveToCache(i, sortitionSeed)
+
+		if i >= hotStartHeight {
+			for _, trx := range blk.Transactions() {
+				s.warmupAccount(trx.Payload().Signer())
+				if receiver := trx.Payload().Receiver(); receiver != nil {
+					s.warmupAccount(*receiver)
+				}
+			}
+		}
+
+		if done := i - startHeight + 1; done%step == 0 {
+			logger.Info("warming up the caches",
+				"height", i, "progress", fmt.Sprintf("%d%%", done*100/total))
+		}
+	}
+
+	logger.Info("caches are warmed up",
+		"blocks", total,
+		"accounts", s.accountStore.accCache.Len(),
+		"duration", time.Since(startTime))
+
+	return nil
+}
+
+// warmupAccount loads the account into the account cache, if it exists.
+func (s *store) warmupAccount(addr crypto.Address) {
+	s.lk.Lock()
+	defer s.lk.Unlock()
+
+	_, _ = s.accountStore.account(addr)
+}
@@ -0,0 +1,44 @@
+package store
+
+import (
+	"testing"
+
+	"github.com/pactus-project/pactus/types/account"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWarmup(t *testing.T) {
+	conf := testConfig()
+	conf.WarmupBlocks = 1
+	td := setup(t, conf)
+
+	lastBlock, err := td.store.Block(10)
+	require.NoError(t, err)
+	hotBlk, err := lastBlock.ToBlock()
+	require.NoError(t, err)
+
+	oldBlock, err := td.store.Block(5)
+	require.NoError(t, err)
+	coldBlk, err := oldBlock.ToBlock()
+	require.NoError(t, err)
+
+	for _, trx := range append(hotBlk.Transactions(), coldBlk.Transactions()...) {
+		td.store.UpdateAccount(trx.Payload().Signer(), account.NewAccount(td.RandInt32(1000)))
+	}
+	require.NoError(t, td.store.WriteBatch())
+	require.NoError(t, td.store.Close())
+
+	s, err := NewStore(conf)
+	require.NoError(t, err)
+	td.store = s.(*store)
+
+	for _, trx := range hotBlk.Transactions() {
+		assert.True(t, td.store.accountStore.accCache.Contains(trx.Payload().Signer()))
+		assert.True(t, td.store.AnyRecentTransaction(trx.ID()))
+	}
+	for _, trx := range coldBlk.Transactions() {
+		assert.False(t, td.store.accountStore.accCache.Contains(trx.Payload().Signer()))
+		assert.True(t, td.store.AnyRecentTransaction(trx.ID()))
+	}
+}
@@ -0,0 +1,46 @@
+package message
+
+import (
+	"fmt"
+
+	"github.com/pactus-project/pactus/util/errors"
+)
+
+// FiltersRequestMessage requests the compact filters of the blocks,
+// starting from the given height.
+type FiltersRequestMessage struct {
+	SessionID int    `cbor:"1,keyasint"`
+	From      uint32 `cbor:"2,keyasint"`
+	Count     uint32 `cbor:"3,keyasint"`
+}
+
+func NewFiltersRequestMessage(sid int, from, count uint32) *FiltersRequestMessage {
+	return &FiltersRequestMessage{
+		SessionID: sid,
+		From:      from,
+		Count:     count,
+	}
+}
+
+func (m *FiltersRequestMessage) To() uint32 {
+	return m.From + m.Count - 1
+}
+
+func (m *FiltersRequestMessage) BasicCheck() error {
+	if m.From == 0 {
+		return errors.Errorf(errors.ErrInvalidHeight, "height is zero")
+	}
+	if m.Count == 0 {
+		return errors.Errorf(errors.ErrInvalidMessage, "count is zero")
+	}
+
+	return nil
+}
+
+func (m *FiltersRequestMessage) Type() Type {
+	return TypeFiltersRequest
+}
+
+func (m *FiltersRequestMessage) String() string {
+	return fmt.Sprintf("{⚓ %d %v:%v}", m.SessionID, m.From, m.To())
+}
@@ -0,0 +1,57 @@
+package message
+
+import (
+	"fmt"
+)
+
+// FiltersResponseMessage contains the compact filters of the consecutive blocks,
+// starting from the given height.
+// Each filter is keyed by the hash of its block.
+type FiltersResponseMessage struct {
+	ResponseCode ResponseCode `cbor:"1,keyasint"`
+	SessionID    int          `cbor:"2,keyasint"`
+	From         uint32       `cbor:"3,keyasint"`
+	Filters      [][]byte     `cbor:"4,keyasint"`
+	Reason       string       `cbor:"5,keyasint"`
+}
+
+func NewFiltersResponseMessage(code ResponseCode, reason string, sid int, from uint32,
+	filters [][]byte,
+) *FiltersResponseMessage {
+	return &FiltersResponseMessage{
+		ResponseCode: code,
+		SessionID:    sid,
+		From:         from,
+		Filters:      filters,
+		Reason:       reason,
+	}
+}
+
+func (m *FiltersResponseMessage) BasicCheck() error {
+	return nil
+}
+
+func (m *FiltersResponseMessage) Type() Type {
+	return TypeFiltersResponse
+}
+
+func (m *FiltersResponseMessage) Count() uint32 {
+	return uint32(len(m.Filters))
+}
+
+func (m *FiltersResponseMessage) To() uint32 {
+	// response message without any filter
+	if len(m.Filters) == 0 {
+		return 0
+	}
+
+	return m.From + m.Count() - 1
+}
+
+func (m *FiltersResponseMessage) String() string {
+	return fmt.Sprintf("{⚓ %d %s %v-%v}", m.SessionID, m.ResponseCode, m.From, m.To())
+}
+
+func (m *FiltersResponseMessage) IsRequestRejected() bool {
+	return m.ResponseCode == ResponseCodeRejected
+}
@@ -0,0 +1,35 @@
+package message
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFiltersResponseType(t *testing.T) {
+	m := &FiltersResponseMessage{}
+	assert.Equal(t, m.Type(), TypeFiltersResponse)
+}
+
+func TestFiltersResponseMessage(t *testing.T) {
+	sid := 123
+	t.Run("Rejected", func(t *testing.T) {
+		m := NewFiltersResponseMessage(ResponseCodeRejected, "rejected", sid, 0, nil)
+
+		assert.NoError(t, m.BasicCheck())
+		assert.True(t, m.IsRequestRejected())
+		assert.Zero(t, m.Count())
+		assert.Zero(t, m.To())
+	})
+
+	t.Run("OK", func(t *testing.T) {
+		m := NewFiltersResponseMessage(ResponseCodeOK, ResponseCodeOK.String(),
+			sid, 100, [][]byte{{0}, {1, 2, 3}})
+
+		assert.NoError(t, m.BasicCheck())
+		assert.False(t, m.IsRequestRejected())
+		assert.Equal(t, m.Count(), uint32(2))
+		assert.Equal(t, m.To(), uint32(101))
+		assert.Contains(t, m.String(), "100")
+	})
+}
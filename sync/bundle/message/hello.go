@@ -5,13 +5,19 @@ import (
 	"time"
 
 	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/pactus-project/pactus/crypto"
 	"github.com/pactus-project/pactus/crypto/bls"
 	"github.com/pactus-project/pactus/crypto/hash"
+	"github.com/pactus-project/pactus/sync/peerset/capability"
 	"github.com/pactus-project/pactus/sync/peerset/service"
 	"github.com/pactus-project/pactus/util/errors"
 	"github.com/pactus-project/pactus/version"
+	"golang.org/x/exp/slices"
 )
 
+// MaxAttestationSize is the maximum size of the attestation in the Hello message.
+const MaxAttestationSize = 8192
+
 type HelloMessage struct {
 	PeerID          peer.ID          `cbor:"1,keyasint"`
 	Agent           string           `cbor:"2,keyasint"`
@@ -23,10 +29,26 @@ type HelloMessage struct {
 	GenesisHash     hash.Hash        `cbor:"8,keyasint"`
 	BlockHash       hash.Hash        `cbor:"9,keyasint"`
 	MyTimeUnixMilli int64            `cbor:"10,keyasint"`
+
+	// The peers that don't advertise the protocol version and capabilities
+	// are running an older version of the protocol.
+	ProtocolVersion uint32                  `cbor:"11,keyasint,omitempty"`
+	Capabilities    capability.Capabilities `cbor:"12,keyasint,omitempty"`
+
+	// Attestation is an optional hardware attestation, like a TPM or SGX quote,
+	// about the environment that the validators are running in.
+	// It is signed by the validator keys, but it is only relayed to the RPC clients
+	// and consensus doesn't use it.
+	Attestation []byte `cbor:"13,keyasint,omitempty"`
+
+	// PausedValidators is a hint about the validators of the node that don't take part
+	// in the sortition for a while, without unbonding. Consensus doesn't use it.
+	PausedValidators []crypto.Address `cbor:"14,keyasint,omitempty"`
 }
 
 func NewHelloMessage(pid peer.ID, moniker string,
-	height uint32, services service.Services, blockHash, genesisHash hash.Hash,
+	height uint32, services service.Services, capabilities capability.Capabilities,
+	blockHash, genesisHash hash.Hash,
 ) *HelloMessage {
 	return &HelloMessage{
 		PeerID:          pid,
@@ -37,6 +59,8 @@ func NewHelloMessage(pid peer.ID, moniker string,
 		Height:          height,
 		Services:        services,
 		MyTimeUnixMilli: time.Now().UnixMilli(),
+		ProtocolVersion: version.ProtocolVersion(),
+		Capabilities:    capabilities,
 	}
 }
 
@@ -47,6 +71,17 @@ func (m *HelloMessage) BasicCheck() error {
 	if len(m.PublicKeys) == 0 {
 		return errors.Error(errors.ErrInvalidPublicKey)
 	}
+	if len(m.Attestation) > MaxAttestationSize {
+		return errors.Errorf(errors.ErrInvalidMessage, "attestation is too large: %v", len(m.Attestation))
+	}
+	for _, addr := range m.PausedValidators {
+		isSigner := slices.ContainsFunc(m.PublicKeys, func(pub *bls.PublicKey) bool {
+			return pub.ValidatorAddress() == addr
+		})
+		if !isSigner {
+			return errors.Errorf(errors.ErrInvalidMessage, "paused validator is not a signer: %v", addr)
+		}
+	}
 	aggPublicKey := bls.PublicKeyAggregate(m.PublicKeys...)
 
 	return aggPublicKey.Verify(m.SignBytes(), m.Signature)
@@ -57,7 +92,13 @@ func (m *HelloMessage) MyTime() time.Time {
 }
 
 func (m *HelloMessage) SignBytes() []byte {
-	return []byte(fmt.Sprintf("%s:%s:%s:%s", m.Type(), m.Agent, m.PeerID, m.GenesisHash.String()))
+	signBytes := fmt.Sprintf("%s:%s:%s:%s", m.Type(), m.Agent, m.PeerID, m.GenesisHash.String())
+	if len(m.Attestation) > 0 {
+		// The attestation is bound to the validator keys, so it can't be replaced by others.
+		signBytes += ":" + hash.CalcHash(m.Attestation).String()
+	}
+
+	return []byte(signBytes)
 }
 
 func (m *HelloMessage) Type() Type {
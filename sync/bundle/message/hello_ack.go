@@ -4,10 +4,50 @@ import (
 	"fmt"
 )
 
+// RejectCode is the structured reason of a rejected Hello message,
+// so the rejected peer can tell the operator what to fix.
+// The older peers ignore it and only log the reason text.
+type RejectCode int
+
+const (
+	RejectCodeUnspecified     = RejectCode(0)
+	RejectCodePeerIDMismatch  = RejectCode(1)
+	RejectCodeGenesisMismatch = RejectCode(2)
+	RejectCodeTimeDiscrepancy = RejectCode(3)
+	RejectCodeProtocolTooOld  = RejectCode(4)
+)
+
+func (c RejectCode) String() string {
+	switch c {
+	case RejectCodeUnspecified:
+		return "unspecified"
+
+	case RejectCodePeerIDMismatch:
+		return "peer-id-mismatch"
+
+	case RejectCodeGenesisMismatch:
+		return "genesis-mismatch"
+
+	case RejectCodeTimeDiscrepancy:
+		return "time-discrepancy"
+
+	case RejectCodeProtocolTooOld:
+		return "protocol-too-old"
+	}
+
+	return fmt.Sprintf("%d", c)
+}
+
 type HelloAckMessage struct {
 	ResponseCode ResponseCode `cbor:"1,keyasint"`
 	Reason       string       `cbor:"2,keyasint"`
 	Height       uint32       `cbor:"3,keyasint"`
+
+	// RejectCode is set if the Hello message is rejected.
+	RejectCode RejectCode `cbor:"4,keyasint,omitempty"`
+	// MinProtocolVersion is the minimum protocol version that is accepted,
+	// set if the Hello message is rejected for its protocol version.
+	MinProtocolVersion uint32 `cbor:"5,keyasint,omitempty"`
 }
 
 func NewHelloAckMessage(code ResponseCode, reason string, height uint32) *HelloAckMessage {
@@ -18,6 +58,15 @@ func NewHelloAckMessage(code ResponseCode, reason string, height uint32) *HelloA
 	}
 }
 
+// NewHelloRejectMessage creates a HelloAck message that rejects the Hello message.
+func NewHelloRejectMessage(rejectCode RejectCode, reason string) *HelloAckMessage {
+	return &HelloAckMessage{
+		ResponseCode: ResponseCodeRejected,
+		Reason:       reason,
+		RejectCode:   rejectCode,
+	}
+}
+
 func (m *HelloAckMessage) BasicCheck() error {
 	return nil
 }
@@ -27,5 +76,9 @@ func (m *HelloAckMessage) Type() Type {
 }
 
 func (m *HelloAckMessage) String() string {
+	if m.RejectCode != RejectCodeUnspecified {
+		return fmt.Sprintf("{%s: %s %s}", m.ResponseCode, m.RejectCode, m.Reason)
+	}
+
 	return fmt.Sprintf("{%s: %s}", m.ResponseCode, m.Reason)
 }
@@ -3,6 +3,7 @@ package message
 import (
 	"testing"
 
+	"github.com/fxamacker/cbor/v2"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -15,3 +16,26 @@ func TestHelloAckMessage(t *testing.T) {
 	m := NewHelloAckMessage(ResponseCodeRejected, "rejected", 0)
 	assert.NoError(t, m.BasicCheck())
 }
+
+func TestHelloRejectMessage(t *testing.T) {
+	m := NewHelloRejectMessage(RejectCodeProtocolTooOld, "too old")
+	assert.NoError(t, m.BasicCheck())
+	assert.Equal(t, ResponseCodeRejected, m.ResponseCode)
+	assert.Equal(t, RejectCodeProtocolTooOld, m.RejectCode)
+	assert.Equal(t, "{rejected: protocol-too-old too old}", m.String())
+
+	t.Run("Older peers can decode the rejection", func(t *testing.T) {
+		m.MinProtocolVersion = 2
+		data, err := cbor.Marshal(m)
+		assert.NoError(t, err)
+
+		legacy := struct {
+			ResponseCode ResponseCode `cbor:"1,keyasint"`
+			Reason       string       `cbor:"2,keyasint"`
+			Height       uint32       `cbor:"3,keyasint"`
+		}{}
+		assert.NoError(t, cbor.Unmarshal(data, &legacy))
+		assert.Equal(t, ResponseCodeRejected, legacy.ResponseCode)
+		assert.Equal(t, "too old", legacy.Reason)
+	})
+}
@@ -4,10 +4,13 @@ import (
 	"testing"
 	"time"
 
+	"github.com/fxamacker/cbor/v2"
 	"github.com/pactus-project/pactus/crypto"
 	"github.com/pactus-project/pactus/crypto/bls"
+	"github.com/pactus-project/pactus/sync/peerset/capability"
 	"github.com/pactus-project/pactus/util/errors"
 	"github.com/pactus-project/pactus/util/testsuite"
+	"github.com/pactus-project/pactus/version"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -21,7 +24,7 @@ func TestHelloMessage(t *testing.T) {
 
 	t.Run("Invalid signature", func(t *testing.T) {
 		valKey := ts.RandValKey()
-		m := NewHelloMessage(ts.RandPeerID(), "Oscar", 100, 0, ts.RandHash(), ts.RandHash())
+		m := NewHelloMessage(ts.RandPeerID(), "Oscar", 100, 0, 0, ts.RandHash(), ts.RandHash())
 		m.Sign([]*bls.ValidatorKey{valKey})
 		m.Signature = ts.RandBLSSignature()
 
@@ -30,7 +33,7 @@ func TestHelloMessage(t *testing.T) {
 
 	t.Run("Signature is nil", func(t *testing.T) {
 		valKey := ts.RandValKey()
-		m := NewHelloMessage(ts.RandPeerID(), "Oscar", 100, 0, ts.RandHash(), ts.RandHash())
+		m := NewHelloMessage(ts.RandPeerID(), "Oscar", 100, 0, 0, ts.RandHash(), ts.RandHash())
 		m.Sign([]*bls.ValidatorKey{valKey})
 		m.Signature = nil
 
@@ -39,18 +42,60 @@ func TestHelloMessage(t *testing.T) {
 
 	t.Run("PublicKeys are empty", func(t *testing.T) {
 		valKey := ts.RandValKey()
-		m := NewHelloMessage(ts.RandPeerID(), "Oscar", 100, 0, ts.RandHash(), ts.RandHash())
+		m := NewHelloMessage(ts.RandPeerID(), "Oscar", 100, 0, 0, ts.RandHash(), ts.RandHash())
 		m.Sign([]*bls.ValidatorKey{valKey})
 		m.PublicKeys = make([]*bls.PublicKey, 0)
 
 		assert.Equal(t, errors.Code(m.BasicCheck()), errors.ErrInvalidPublicKey)
 	})
 
+	t.Run("Attestation is too large", func(t *testing.T) {
+		valKey := ts.RandValKey()
+		m := NewHelloMessage(ts.RandPeerID(), "Oscar", 100, 0, 0, ts.RandHash(), ts.RandHash())
+		m.Attestation = ts.RandBytes(MaxAttestationSize + 1)
+		m.Sign([]*bls.ValidatorKey{valKey})
+
+		assert.Equal(t, errors.Code(m.BasicCheck()), errors.ErrInvalidMessage)
+	})
+
+	t.Run("Attestation is replaced", func(t *testing.T) {
+		valKey := ts.RandValKey()
+		m := NewHelloMessage(ts.RandPeerID(), "Oscar", 100, 0, 0, ts.RandHash(), ts.RandHash())
+		m.Attestation = ts.RandBytes(256)
+		m.Sign([]*bls.ValidatorKey{valKey})
+		assert.NoError(t, m.BasicCheck())
+
+		m.Attestation = ts.RandBytes(256)
+		assert.ErrorIs(t, crypto.ErrInvalidSignature, m.BasicCheck())
+
+		m.Attestation = nil
+		assert.ErrorIs(t, crypto.ErrInvalidSignature, m.BasicCheck())
+	})
+
+	t.Run("Paused validator is not a signer", func(t *testing.T) {
+		valKey := ts.RandValKey()
+		m := NewHelloMessage(ts.RandPeerID(), "Oscar", 100, 0, 0, ts.RandHash(), ts.RandHash())
+		m.PausedValidators = []crypto.Address{ts.RandValAddress()}
+		m.Sign([]*bls.ValidatorKey{valKey})
+
+		assert.Equal(t, errors.Code(m.BasicCheck()), errors.ErrInvalidMessage)
+	})
+
+	t.Run("Paused validator is a signer", func(t *testing.T) {
+		valKey1 := ts.RandValKey()
+		valKey2 := ts.RandValKey()
+		m := NewHelloMessage(ts.RandPeerID(), "Oscar", 100, 0, 0, ts.RandHash(), ts.RandHash())
+		m.PausedValidators = []crypto.Address{valKey2.Address()}
+		m.Sign([]*bls.ValidatorKey{valKey1, valKey2})
+
+		assert.NoError(t, m.BasicCheck())
+	})
+
 	t.Run("MyTimeUnixMilli of time1 is less or equal than hello message time", func(t *testing.T) {
 		time1 := time.Now()
 		myTimeUnixMilli := time1.UnixMilli()
 
-		m := NewHelloMessage(ts.RandPeerID(), "Alice", 100, 0, ts.RandHash(), ts.RandHash())
+		m := NewHelloMessage(ts.RandPeerID(), "Alice", 100, 0, 0, ts.RandHash(), ts.RandHash())
 
 		assert.LessOrEqual(t, m.MyTimeUnixMilli, time.Now().UnixMilli())
 		assert.GreaterOrEqual(t, m.MyTimeUnixMilli, myTimeUnixMilli)
@@ -58,10 +103,45 @@ func TestHelloMessage(t *testing.T) {
 
 	t.Run("Ok", func(t *testing.T) {
 		valKey := ts.RandValKey()
-		m := NewHelloMessage(ts.RandPeerID(), "Alice", 100, 0, ts.RandHash(), ts.RandHash())
+		m := NewHelloMessage(ts.RandPeerID(), "Alice", 100, 0, 0, ts.RandHash(), ts.RandHash())
 		m.Sign([]*bls.ValidatorKey{valKey})
 
 		assert.NoError(t, m.BasicCheck())
 		assert.Contains(t, m.String(), "Alice")
 	})
 }
+
+func TestHelloCapabilities(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	t.Run("Capabilities are encoded", func(t *testing.T) {
+		m := NewHelloMessage(ts.RandPeerID(), "Alice", 100, 0,
+			capability.New(capability.Compression, capability.Filters), ts.RandHash(), ts.RandHash())
+
+		bs, err := cbor.Marshal(m)
+		assert.NoError(t, err)
+
+		decoded := new(HelloMessage)
+		assert.NoError(t, cbor.Unmarshal(bs, decoded))
+		assert.Equal(t, version.ProtocolVersion(), decoded.ProtocolVersion)
+		assert.True(t, decoded.Capabilities.Has(capability.Compression))
+		assert.True(t, decoded.Capabilities.Has(capability.Filters))
+		assert.False(t, decoded.Capabilities.Has(capability.StateSync))
+	})
+
+	t.Run("Legacy message has no capabilities", func(t *testing.T) {
+		m := NewHelloMessage(ts.RandPeerID(), "Bob", 100, 0,
+			capability.New(capability.Compression), ts.RandHash(), ts.RandHash())
+		m.ProtocolVersion = 0
+		m.Capabilities = 0
+
+		bs, err := cbor.Marshal(m)
+		assert.NoError(t, err)
+
+		decoded := new(HelloMessage)
+		assert.NoError(t, cbor.Unmarshal(bs, decoded))
+		assert.Zero(t, decoded.ProtocolVersion)
+		assert.Zero(t, decoded.Capabilities)
+		assert.Equal(t, m.PeerID, decoded.PeerID)
+	})
+}
@@ -40,17 +40,21 @@ func (c ResponseCode) String() string {
 type Type int32
 
 const (
-	TypeUnspecified    = Type(0)
-	TypeHello          = Type(1)
-	TypeHelloAck       = Type(2)
-	TypeTransactions   = Type(3)
-	TypeQueryProposal  = Type(4)
-	TypeProposal       = Type(5)
-	TypeQueryVotes     = Type(6)
-	TypeVote           = Type(7)
-	TypeBlockAnnounce  = Type(8)
-	TypeBlocksRequest  = Type(9)
-	TypeBlocksResponse = Type(10)
+	TypeUnspecified     = Type(0)
+	TypeHello           = Type(1)
+	TypeHelloAck        = Type(2)
+	TypeTransactions    = Type(3)
+	TypeQueryProposal   = Type(4)
+	TypeProposal        = Type(5)
+	TypeQueryVotes      = Type(6)
+	TypeVote            = Type(7)
+	TypeBlockAnnounce   = Type(8)
+	TypeBlocksRequest   = Type(9)
+	TypeBlocksResponse  = Type(10)
+	TypeFiltersRequest  = Type(11)
+	TypeFiltersResponse = Type(12)
+	TypePing            = Type(13)
+	TypePong            = Type(14)
 )
 
 func (t Type) TopicID() network.TopicID {
@@ -102,6 +106,18 @@ func (t Type) String() string {
 
 	case TypeBlocksResponse:
 		return "blocks-res"
+
+	case TypeFiltersRequest:
+		return "filters-req"
+
+	case TypeFiltersResponse:
+		return "filters-res"
+
+	case TypePing:
+		return "ping"
+
+	case TypePong:
+		return "pong"
 	}
 
 	return fmt.Sprintf("%d", t)
@@ -138,6 +154,18 @@ func MakeMessage(t Type) Message {
 
 	case TypeBlocksResponse:
 		return &BlocksResponseMessage{}
+
+	case TypeFiltersRequest:
+		return &FiltersRequestMessage{}
+
+	case TypeFiltersResponse:
+		return &FiltersResponseMessage{}
+
+	case TypePing:
+		return &PingMessage{}
+
+	case TypePong:
+		return &PongMessage{}
 	}
 
 	//
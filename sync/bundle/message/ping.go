@@ -0,0 +1,38 @@
+package message
+
+import (
+	"fmt"
+
+	"github.com/pactus-project/pactus/util/errors"
+)
+
+// PingMessage is sent periodically to the peers to measure the round-trip time
+// and the apparent clock offset of them.
+// The time is the local time of the sender in Unix nanoseconds.
+type PingMessage struct {
+	Nonce uint64 `cbor:"1,keyasint"`
+	Time  int64  `cbor:"2,keyasint"`
+}
+
+func NewPingMessage(nonce uint64, unixNano int64) *PingMessage {
+	return &PingMessage{
+		Nonce: nonce,
+		Time:  unixNano,
+	}
+}
+
+func (m *PingMessage) BasicCheck() error {
+	if m.Nonce == 0 {
+		return errors.Errorf(errors.ErrInvalidMessage, "nonce is zero")
+	}
+
+	return nil
+}
+
+func (m *PingMessage) Type() Type {
+	return TypePing
+}
+
+func (m *PingMessage) String() string {
+	return fmt.Sprintf("{%d}", m.Nonce)
+}
@@ -0,0 +1,28 @@
+package message
+
+import (
+	"testing"
+
+	"github.com/pactus-project/pactus/util/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPingType(t *testing.T) {
+	m := &PingMessage{}
+	assert.Equal(t, m.Type(), TypePing)
+}
+
+func TestPingMessage(t *testing.T) {
+	t.Run("Invalid nonce", func(t *testing.T) {
+		m := NewPingMessage(0, 1)
+
+		assert.Equal(t, errors.Code(m.BasicCheck()), errors.ErrInvalidMessage)
+	})
+
+	t.Run("OK", func(t *testing.T) {
+		m := NewPingMessage(123, 1)
+
+		assert.NoError(t, m.BasicCheck())
+		assert.Contains(t, m.String(), "123")
+	})
+}
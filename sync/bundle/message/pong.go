@@ -0,0 +1,38 @@
+package message
+
+import (
+	"fmt"
+
+	"github.com/pactus-project/pactus/util/errors"
+)
+
+// PongMessage is the response to the ping message.
+// The time is the local time of the responder in Unix nanoseconds,
+// when the ping message is received.
+type PongMessage struct {
+	Nonce uint64 `cbor:"1,keyasint"`
+	Time  int64  `cbor:"2,keyasint"`
+}
+
+func NewPongMessage(nonce uint64, unixNano int64) *PongMessage {
+	return &PongMessage{
+		Nonce: nonce,
+		Time:  unixNano,
+	}
+}
+
+func (m *PongMessage) BasicCheck() error {
+	if m.Nonce == 0 {
+		return errors.Errorf(errors.ErrInvalidMessage, "nonce is zero")
+	}
+
+	return nil
+}
+
+func (m *PongMessage) Type() Type {
+	return TypePong
+}
+
+func (m *PongMessage) String() string {
+	return fmt.Sprintf("{%d}", m.Nonce)
+}
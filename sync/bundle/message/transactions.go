@@ -10,6 +10,9 @@ import (
 
 type TransactionsMessage struct {
 	Transactions []*tx.Tx `cbor:"1,keyasint"`
+	// Stem indicates that the transactions are in the stem phase of the relay,
+	// and they should not be broadcasted yet.
+	Stem bool `cbor:"2,keyasint,omitempty"`
 }
 
 func NewTransactionsMessage(trxs []*tx.Tx) *TransactionsMessage {
@@ -18,6 +21,14 @@ func NewTransactionsMessage(trxs []*tx.Tx) *TransactionsMessage {
 	}
 }
 
+// NewStemTransactionsMessage creates a message for relaying the transactions in the stem phase.
+func NewStemTransactionsMessage(trxs []*tx.Tx) *TransactionsMessage {
+	return &TransactionsMessage{
+		Transactions: trxs,
+		Stem:         true,
+	}
+}
+
 func (m *TransactionsMessage) BasicCheck() error {
 	if len(m.Transactions) == 0 {
 		return errors.Errorf(errors.ErrInvalidMessage, "no transaction")
@@ -42,6 +53,9 @@ func (m *TransactionsMessage) String() string {
 		builder.WriteString(fmt.Sprintf("%v ", tx.ID().ShortString()))
 	}
 	builder.WriteString(fmt.Sprintf("{%v: ⌘ [%v]}", len(m.Transactions), builder.String()))
+	if m.Stem {
+		builder.WriteString(" stem")
+	}
 
 	return builder.String()
 }
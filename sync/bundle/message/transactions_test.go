@@ -3,6 +3,7 @@ package message
 import (
 	"testing"
 
+	"github.com/fxamacker/cbor/v2"
 	"github.com/pactus-project/pactus/types/tx"
 	"github.com/pactus-project/pactus/util/errors"
 	"github.com/pactus-project/pactus/util/testsuite"
@@ -30,4 +31,21 @@ func TestTransactionsMessage(t *testing.T) {
 		assert.NoError(t, m.BasicCheck())
 		assert.Contains(t, m.String(), trx.ID().ShortString())
 	})
+
+	t.Run("Stem", func(t *testing.T) {
+		trx, _ := ts.GenerateTestTransferTx()
+		m := NewStemTransactionsMessage([]*tx.Tx{trx})
+
+		assert.NoError(t, m.BasicCheck())
+		assert.True(t, m.Stem)
+		assert.Contains(t, m.String(), "stem")
+
+		data, err := cbor.Marshal(m)
+		assert.NoError(t, err)
+
+		decoded := new(TransactionsMessage)
+		assert.NoError(t, cbor.Unmarshal(data, decoded))
+		assert.True(t, decoded.Stem)
+		assert.Equal(t, trx.ID(), decoded.Transactions[0].ID())
+	})
 }
@@ -1,6 +1,8 @@
 package cache
 
 import (
+	"slices"
+
 	lru "github.com/hashicorp/golang-lru/v2"
 	"github.com/pactus-project/pactus/types/block"
 	"github.com/pactus-project/pactus/types/certificate"
@@ -73,6 +75,38 @@ func (c *Cache) RemoveBlock(height uint32) {
 	c.certs.Remove(height)
 }
 
+// BlocksAfter returns the blocks in the cache that are above the height, ordered by their heights.
+func (c *Cache) BlocksAfter(height uint32) []*block.Block {
+	blocks := []*block.Block{}
+	for _, h := range heightsAfter(c.blocks.Keys(), height) {
+		if blk, ok := c.blocks.Peek(h); ok {
+			blocks = append(blocks, blk)
+		}
+	}
+
+	return blocks
+}
+
+// CertificatesAfter returns the certificates in the cache that are above the height,
+// ordered by their heights.
+func (c *Cache) CertificatesAfter(height uint32) []*certificate.Certificate {
+	certs := []*certificate.Certificate{}
+	for _, h := range heightsAfter(c.certs.Keys(), height) {
+		if cert, ok := c.certs.Peek(h); ok {
+			certs = append(certs, cert)
+		}
+	}
+
+	return certs
+}
+
+func heightsAfter(heights []uint32, height uint32) []uint32 {
+	heights = slices.DeleteFunc(heights, func(h uint32) bool { return h <= height })
+	slices.Sort(heights)
+
+	return heights
+}
+
 // Len returns the maximum number of items in the blocks and certificates cache.
 func (c *Cache) Len() int {
 	return util.Max(c.blocks.Len(), c.certs.Len())
@@ -3,6 +3,8 @@ package cache
 import (
 	"testing"
 
+	"github.com/pactus-project/pactus/types/block"
+	"github.com/pactus-project/pactus/types/certificate"
 	"github.com/pactus-project/pactus/util/testsuite"
 	"github.com/stretchr/testify/assert"
 )
@@ -106,3 +108,22 @@ func TestRemoveBlock(t *testing.T) {
 	assert.Nil(t, cache.GetBlock(height))
 	assert.Nil(t, cache.GetCertificate(height))
 }
+
+func TestBlocksAfter(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+	cache, _ := NewCache(10)
+
+	testHeight := ts.RandHeight()
+	blk3, cert3 := ts.GenerateTestBlock(testHeight + 3)
+	blk1, cert1 := ts.GenerateTestBlock(testHeight + 1)
+	blk2, _ := ts.GenerateTestBlock(testHeight + 2)
+	cache.AddBlock(blk3)
+	cache.AddBlock(blk1)
+	cache.AddBlock(blk2)
+	cache.AddCertificate(cert3)
+	cache.AddCertificate(cert1)
+
+	assert.Equal(t, []*block.Block{blk2, blk3}, cache.BlocksAfter(testHeight+1))
+	assert.Equal(t, []*certificate.Certificate{cert3}, cache.CertificatesAfter(testHeight+2))
+	assert.Empty(t, cache.BlocksAfter(testHeight+3))
+}
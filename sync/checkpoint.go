@@ -0,0 +1,143 @@
+package sync
+
+import (
+	"os"
+
+	"github.com/fxamacker/cbor/v2"
+	"github.com/pactus-project/pactus/sync/peerset/session"
+	"github.com/pactus-project/pactus/types/block"
+	"github.com/pactus-project/pactus/types/certificate"
+	"github.com/pactus-project/pactus/util"
+)
+
+// CheckpointFilename is the name of the checkpoint of the sync, inside the data directory.
+const CheckpointFilename = "sync_checkpoint.cbor"
+
+// checkpoint is the progress of the sync that is not committed yet.
+// The committed blocks are kept by the state, but the downloaded blocks that are
+// waiting in the cache, and the requests that are in flight, are lost on restart.
+type checkpoint struct {
+	VerifiedHeight uint32                     `cbor:"1,keyasint"`
+	Blocks         []*block.Block             `cbor:"2,keyasint"`
+	Certificates   []*certificate.Certificate `cbor:"3,keyasint"`
+	InFlight       []blockRange               `cbor:"4,keyasint"`
+}
+
+// blockRange is a range of the blocks that are requested from the peers.
+type blockRange struct {
+	From  uint32 `cbor:"1,keyasint"`
+	Count uint32 `cbor:"2,keyasint"`
+}
+
+// SaveCheckpoint writes the downloaded blocks that are not committed yet,
+// and the ranges of the blocks that are requested but not received yet, in the file.
+// It should be called after the synchronizer is stopped.
+// If there is nothing to resume, the file is removed.
+func (sync *synchronizer) SaveCheckpoint(path string) error {
+	verified := sync.stateHeight()
+	cp := checkpoint{
+		VerifiedHeight: verified,
+		Blocks:         sync.cache.BlocksAfter(verified),
+		Certificates:   sync.cache.CertificatesAfter(verified),
+		InFlight:       []blockRange{},
+	}
+	sync.peerSet.IterateSessions(func(ssn *session.Session) bool {
+		if ssn.Status != session.Completed && ssn.From+ssn.Count > verified+1 {
+			cp.InFlight = append(cp.InFlight, blockRange{From: ssn.From, Count: ssn.Count})
+		}
+
+		return false
+	})
+
+	if len(cp.Blocks) == 0 && len(cp.InFlight) == 0 {
+		if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+
+		return nil
+	}
+
+	data, err := cbor.Marshal(cp)
+	if err != nil {
+		return err
+	}
+
+	// The file is replaced at once, so a crash never leaves a partial checkpoint.
+	tmpPath := path + ".tmp"
+	if err := util.WriteFile(tmpPath, data); err != nil {
+		return err
+	}
+	if err := os.Rename(tmpPath, path); err != nil {
+		return err
+	}
+
+	sync.logger.Info("sync checkpoint saved", "verified_height", verified,
+		"blocks", len(cp.Blocks), "in_flight", len(cp.InFlight))
+
+	return nil
+}
+
+// LoadCheckpoint puts the downloaded blocks of the checkpoint back into the cache,
+// and resumes the requests that were in flight, so they are not downloaded again.
+// The blocks are not trusted, they are validated when they are committed.
+// It does nothing if the file doesn't exist. It should be called before starting the synchronizer.
+func (sync *synchronizer) LoadCheckpoint(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+
+		return err
+	}
+
+	cp := checkpoint{}
+	if err := cbor.Unmarshal(data, &cp); err != nil {
+		return err
+	}
+
+	// The state might have moved on, if the node has run without the checkpoint.
+	verified := sync.stateHeight()
+	loaded := 0
+	for _, blk := range cp.Blocks {
+		height := uint32(1)
+		if cert := blk.PrevCertificate(); cert != nil {
+			height = cert.Height() + 1
+		}
+		if height <= verified {
+			continue
+		}
+		sync.cache.AddBlock(blk)
+		loaded++
+	}
+	for _, cert := range cp.Certificates {
+		if cert.Height() > verified {
+			sync.cache.AddCertificate(cert)
+		}
+	}
+	for _, r := range cp.InFlight {
+		if r.From+r.Count > verified+1 {
+			sync.resumeRanges = append(sync.resumeRanges, r)
+		}
+	}
+
+	sync.logger.Info("resuming the sync from the checkpoint",
+		"checkpoint_height", cp.VerifiedHeight, "height", verified,
+		"blocks", loaded, "in_flight", len(sync.resumeRanges))
+
+	return nil
+}
+
+// resumeDownloads requests the ranges that were in flight before the restart.
+// The ranges that can't be requested yet, since there is no peer to request from, are kept.
+func (sync *synchronizer) resumeDownloads() {
+	verified := sync.stateHeight()
+	for len(sync.resumeRanges) > 0 {
+		r := sync.resumeRanges[0]
+		if r.From+r.Count > verified+1 &&
+			!sync.sendBlockRequestToRandomPeer(r.From, r.Count, true) {
+			return
+		}
+		sync.resumeRanges = sync.resumeRanges[1:]
+	}
+}
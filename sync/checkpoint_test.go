@@ -0,0 +1,104 @@
+package sync
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/pactus-project/pactus/sync/bundle/message"
+	"github.com/pactus-project/pactus/sync/checkpoints"
+	"github.com/pactus-project/pactus/sync/peerset/service"
+	"github.com/pactus-project/pactus/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckpoint(t *testing.T) {
+	path := filepath.Join(util.TempDirPath(), CheckpointFilename)
+
+	td := setup(t, nil)
+	td.state.CommitTestBlocks(10)
+	lastHeight := td.state.LastBlockHeight()
+
+	oldBlk, _ := td.GenerateTestBlock(lastHeight)
+	blk, cert := td.GenerateTestBlock(lastHeight + 2)
+	td.sync.cache.AddBlock(oldBlk)
+	td.sync.cache.AddBlock(blk)
+	td.sync.cache.AddCertificate(cert)
+	td.sync.peerSet.OpenSession(td.RandPeerID(), lastHeight+3, 10)
+
+	require.NoError(t, td.sync.SaveCheckpoint(path))
+	assert.True(t, util.PathExists(path))
+
+	t.Run("Should resume the sync from the checkpoint", func(t *testing.T) {
+		td := setup(t, nil)
+		td.state.CommitTestBlocks(10)
+
+		require.NoError(t, td.sync.LoadCheckpoint(path))
+		assert.False(t, td.sync.cache.HasBlockInCache(lastHeight))
+		assert.Equal(t, blk.Hash(), td.sync.cache.GetBlock(lastHeight+2).Hash())
+		assert.Equal(t, cert.Hash(), td.sync.cache.GetCertificate(lastHeight+2).Hash())
+		assert.Equal(t, []blockRange{{From: lastHeight + 3, Count: 10}}, td.sync.resumeRanges)
+
+		pub, _ := td.RandBLSKeyPair()
+		td.addPeer(t, pub, td.RandPeerID(), service.New(service.Network))
+		td.sync.resumeDownloads()
+
+		bdl := td.shouldPublishMessageWithThisType(t, message.TypeBlocksRequest)
+		assert.Equal(t, lastHeight+3, bdl.Message.(*message.BlocksRequestMessage).From)
+		assert.Empty(t, td.sync.resumeRanges)
+	})
+
+	t.Run("Should ignore the blocks that are committed after the checkpoint", func(t *testing.T) {
+		td := setup(t, nil)
+		td.state.CommitTestBlocks(30)
+
+		require.NoError(t, td.sync.LoadCheckpoint(path))
+		assert.Zero(t, td.sync.cache.Len())
+		assert.Empty(t, td.sync.resumeRanges)
+	})
+
+	t.Run("Should remove the checkpoint if there is nothing to resume", func(t *testing.T) {
+		td := setup(t, nil)
+
+		require.NoError(t, td.sync.SaveCheckpoint(path))
+		assert.False(t, util.PathExists(path))
+		assert.NoError(t, td.sync.LoadCheckpoint(path))
+	})
+}
+
+func TestTrustedCheckpoint(t *testing.T) {
+	td := setup(t, nil)
+	td.state.CommitTestBlocks(10)
+	lastHeight := td.state.LastBlockHeight()
+
+	blk, cert := td.GenerateTestBlock(lastHeight + 1)
+
+	t.Run("Should not commit the block that doesn't match the trusted checkpoint", func(t *testing.T) {
+		cp := checkpoints.Checkpoint{Height: lastHeight + 1, BlockHash: td.RandHash()}
+		require.True(t, td.sync.checkpoints.Add("foundation", cp))
+
+		td.sync.cache.AddBlock(blk)
+		td.sync.cache.AddCertificate(cert)
+
+		err := td.sync.tryCommitBlocks()
+		assert.ErrorIs(t, err, checkpoints.MismatchError{
+			Height:   lastHeight + 1,
+			Expected: cp.BlockHash,
+			Got:      blk.Hash(),
+		})
+		assert.False(t, td.sync.cache.HasBlockInCache(lastHeight+1))
+		assert.Equal(t, lastHeight, td.state.LastBlockHeight())
+	})
+
+	t.Run("Should commit the block that matches the trusted checkpoint", func(t *testing.T) {
+		td.sync.checkpoints = checkpoints.NewStore(1)
+		cp := checkpoints.Checkpoint{Height: lastHeight + 1, BlockHash: blk.Hash()}
+		require.True(t, td.sync.checkpoints.Add("foundation", cp))
+
+		td.sync.cache.AddBlock(blk)
+		td.sync.cache.AddCertificate(cert)
+
+		assert.NoError(t, td.sync.tryCommitBlocks())
+		assert.Equal(t, lastHeight+1, td.state.LastBlockHeight())
+	})
+}
@@ -0,0 +1,62 @@
+// Package checkpoints fetches the signed checkpoints from the trusted publishers.
+// A checkpoint is the hash of the block at a height. It is trusted when a quorum of
+// the publishers agree on it, and the synchronizer refuses to commit a block
+// that doesn't match a trusted checkpoint.
+package checkpoints
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+
+	"github.com/pactus-project/pactus/crypto/hash"
+)
+
+// signDomain separates the sign bytes of the checkpoints from the other signed data.
+const signDomain = "PACTUS_CHECKPOINTS"
+
+// maxCheckpointsPerUpdate is the maximum number of the checkpoints in an update.
+const maxCheckpointsPerUpdate = 1000
+
+// Checkpoint is the hash of the block at the height.
+type Checkpoint struct {
+	Height    uint32
+	BlockHash hash.Hash
+}
+
+// Update is the signed list of the checkpoints, that is served by a publisher as JSON.
+type Update struct {
+	Checkpoints []UpdateCheckpoint `json:"checkpoints"`
+	Signature   string             `json:"signature"` // Hex encoded BLS signature of the sign bytes
+}
+
+// UpdateCheckpoint is a checkpoint of an update.
+type UpdateCheckpoint struct {
+	Height    uint32 `json:"height"`
+	BlockHash string `json:"block_hash"` // Hex encoded block hash
+}
+
+// SignBytes returns the bytes that the publishers sign.
+// The genesis hash is included, so the checkpoints of a network can't be replayed on another one.
+func SignBytes(genesisHash hash.Hash, cps []Checkpoint) []byte {
+	buf := bytes.NewBufferString(signDomain)
+	buf.Write(genesisHash.Bytes())
+	for _, cp := range cps {
+		_ = binary.Write(buf, binary.LittleEndian, cp.Height)
+		buf.Write(cp.BlockHash.Bytes())
+	}
+
+	return buf.Bytes()
+}
+
+// MismatchError is returned when a block doesn't match the trusted checkpoint of its height.
+type MismatchError struct {
+	Height   uint32
+	Expected hash.Hash
+	Got      hash.Hash
+}
+
+func (e MismatchError) Error() string {
+	return fmt.Sprintf("block %d doesn't match the trusted checkpoint, expected %s, got %s",
+		e.Height, e.Expected, e.Got)
+}
@@ -0,0 +1,178 @@
+package checkpoints
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/pactus-project/pactus/crypto/bls"
+	"github.com/pactus-project/pactus/crypto/hash"
+	"github.com/pactus-project/pactus/util/logger"
+	"github.com/pactus-project/pactus/util/recovery"
+)
+
+// maxUpdateSize is the maximum size of an update, that is read from a publisher.
+const maxUpdateSize = 1 << 20
+
+type publisher struct {
+	name   string
+	url    string
+	pubKey *bls.PublicKey
+}
+
+// Client fetches the signed updates from the publishers periodically,
+// verifies the signatures and adds the checkpoints to the store.
+// The accepted and the rejected updates are logged, so the checkpoints can be audited.
+type Client struct {
+	config      *Config
+	genesisHash hash.Hash
+	store       *Store
+	publishers  []*publisher
+	onTrusted   func(cp Checkpoint)
+	client      *http.Client
+	logger      *logger.SubLogger
+}
+
+// NewClient creates a new client for the publishers in the config.
+// The onTrusted callback is called when a checkpoint becomes trusted, it can be nil.
+func NewClient(conf *Config, genesisHash hash.Hash, store *Store,
+	onTrusted func(cp Checkpoint),
+) (*Client, error) {
+	if err := conf.BasicCheck(); err != nil {
+		return nil, err
+	}
+
+	publishers := make([]*publisher, 0, len(conf.Publishers))
+	for _, pubConf := range conf.Publishers {
+		pubKey, err := bls.PublicKeyFromString(pubConf.PublicKey)
+		if err != nil {
+			return nil, err
+		}
+		publishers = append(publishers, &publisher{
+			name:   pubConf.Name,
+			url:    pubConf.URL,
+			pubKey: pubKey,
+		})
+	}
+
+	return &Client{
+		config:      conf,
+		genesisHash: genesisHash,
+		store:       store,
+		publishers:  publishers,
+		onTrusted:   onTrusted,
+		client:      &http.Client{Timeout: conf.Timeout},
+		logger:      logger.NewSubLogger("_checkpoints", nil),
+	}, nil
+}
+
+// Start fetches the updates from the publishers until the ctx is done.
+// It does nothing if the client is not enabled.
+func (c *Client) Start(ctx context.Context) {
+	if !c.config.Enable {
+		return
+	}
+
+	c.logger.Info("fetching the checkpoints from the publishers",
+		"publishers", len(c.publishers), "quorum", c.config.Quorum)
+
+	go recovery.Loop("checkpoints", c.logger, func() { c.pollLoop(ctx) })
+}
+
+func (c *Client) pollLoop(ctx context.Context) {
+	ticker := time.NewTicker(c.config.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		c.FetchAll(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// FetchAll fetches the updates from all the publishers once.
+func (c *Client) FetchAll(ctx context.Context) {
+	for _, pub := range c.publishers {
+		cps, err := c.fetch(ctx, pub)
+		if err != nil {
+			c.logger.Warn("unable to fetch the checkpoints", "publisher", pub.name, "error", err)
+
+			continue
+		}
+
+		c.logger.Debug("checkpoints update is verified", "publisher", pub.name, "checkpoints", len(cps))
+
+		for _, cp := range cps {
+			if c.store.Add(pub.name, cp) {
+				c.logger.Info("checkpoint is trusted", "height", cp.Height, "hash", cp.BlockHash,
+					"publisher", pub.name)
+
+				if c.onTrusted != nil {
+					c.onTrusted(cp)
+				}
+			} else if c.store.IsConflicted(cp.Height) {
+				c.logger.Error("publishers signed conflicting checkpoints", "height", cp.Height,
+					"hash", cp.BlockHash, "publisher", pub.name)
+			}
+		}
+	}
+}
+
+// fetch downloads the update of the publisher, and returns its checkpoints if the signature is valid.
+func (c *Client) fetch(ctx context.Context, pub *publisher) ([]Checkpoint, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, pub.url, http.NoBody)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	res, err := c.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("publisher responded with status %d", res.StatusCode)
+	}
+
+	update := Update{}
+	if err := json.NewDecoder(io.LimitReader(res.Body, maxUpdateSize)).Decode(&update); err != nil {
+		return nil, err
+	}
+
+	return c.verify(pub, &update)
+}
+
+// verify checks the update and its signature, and returns its checkpoints.
+func (c *Client) verify(pub *publisher, update *Update) ([]Checkpoint, error) {
+	if len(update.Checkpoints) > maxCheckpointsPerUpdate {
+		return nil, fmt.Errorf("update has too many checkpoints: %d", len(update.Checkpoints))
+	}
+
+	cps := make([]Checkpoint, 0, len(update.Checkpoints))
+	for _, updateCp := range update.Checkpoints {
+		blockHash, err := hash.FromString(updateCp.BlockHash)
+		if err != nil {
+			return nil, fmt.Errorf("invalid block hash at height %d: %w", updateCp.Height, err)
+		}
+		cps = append(cps, Checkpoint{Height: updateCp.Height, BlockHash: blockHash})
+	}
+
+	sig, err := bls.SignatureFromString(update.Signature)
+	if err != nil {
+		return nil, fmt.Errorf("invalid signature: %w", err)
+	}
+	if err := pub.pubKey.Verify(SignBytes(c.genesisHash, cps), sig); err != nil {
+		return nil, fmt.Errorf("invalid signature: %w", err)
+	}
+
+	return cps, nil
+}
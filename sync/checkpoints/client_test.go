@@ -0,0 +1,162 @@
+package checkpoints
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/pactus-project/pactus/crypto/bls"
+	"github.com/pactus-project/pactus/crypto/hash"
+	"github.com/pactus-project/pactus/util/testsuite"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testPublisher struct {
+	prv    *bls.PrivateKey
+	server *httptest.Server
+	update *Update
+	status int
+}
+
+func newTestPublisher(t *testing.T, ts *testsuite.TestSuite) *testPublisher {
+	t.Helper()
+
+	_, prv := ts.RandBLSKeyPair()
+	pub := &testPublisher{prv: prv, status: http.StatusOK}
+	pub.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		if pub.status != http.StatusOK {
+			w.WriteHeader(pub.status)
+
+			return
+		}
+		_ = json.NewEncoder(w).Encode(pub.update)
+	}))
+	t.Cleanup(pub.server.Close)
+
+	return pub
+}
+
+func (p *testPublisher) publish(genesisHash hash.Hash, cps []Checkpoint) {
+	update := &Update{}
+	for _, cp := range cps {
+		update.Checkpoints = append(update.Checkpoints, UpdateCheckpoint{
+			Height:    cp.Height,
+			BlockHash: cp.BlockHash.String(),
+		})
+	}
+	update.Signature = p.prv.Sign(SignBytes(genesisHash, cps)).String()
+	p.update = update
+}
+
+func TestClient(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	genesisHash := ts.RandHash()
+	foundation := newTestPublisher(t, ts)
+	community := newTestPublisher(t, ts)
+
+	conf := DefaultConfig()
+	conf.Enable = true
+	conf.Quorum = 2
+	conf.Publishers = []PublisherConfig{
+		{
+			Name:      "foundation",
+			URL:       foundation.server.URL,
+			PublicKey: foundation.prv.PublicKey().String(),
+		},
+		{
+			Name:      "community",
+			URL:       community.server.URL,
+			PublicKey: community.prv.PublicKey().String(),
+		},
+	}
+
+	store := NewStore(conf.Quorum)
+	trusted := []Checkpoint{}
+	client, err := NewClient(conf, genesisHash, store, func(cp Checkpoint) {
+		trusted = append(trusted, cp)
+	})
+	require.NoError(t, err)
+
+	cp1 := Checkpoint{Height: 1000, BlockHash: ts.RandHash()}
+	cp2 := Checkpoint{Height: 2000, BlockHash: ts.RandHash()}
+
+	t.Run("Publisher is not available", func(t *testing.T) {
+		foundation.publish(genesisHash, []Checkpoint{cp1})
+		community.status = http.StatusInternalServerError
+
+		client.FetchAll(context.Background())
+		assert.Empty(t, store.Trusted())
+		assert.Empty(t, trusted)
+	})
+
+	t.Run("Signed for another network", func(t *testing.T) {
+		community.status = http.StatusOK
+		community.publish(ts.RandHash(), []Checkpoint{cp1})
+
+		client.FetchAll(context.Background())
+		assert.Empty(t, store.Trusted())
+	})
+
+	t.Run("Checkpoint is changed after signing", func(t *testing.T) {
+		community.publish(genesisHash, []Checkpoint{cp1})
+		community.update.Checkpoints[0].BlockHash = ts.RandHash().String()
+
+		client.FetchAll(context.Background())
+		assert.Empty(t, store.Trusted())
+	})
+
+	t.Run("Quorum of the publishers sign the checkpoint", func(t *testing.T) {
+		community.publish(genesisHash, []Checkpoint{cp1, cp2})
+
+		client.FetchAll(context.Background())
+		assert.Equal(t, []Checkpoint{cp1}, store.Trusted())
+		assert.Equal(t, []Checkpoint{cp1}, trusted)
+
+		foundation.publish(genesisHash, []Checkpoint{cp1, cp2})
+
+		client.FetchAll(context.Background())
+		assert.Equal(t, []Checkpoint{cp1, cp2}, store.Trusted())
+		assert.Equal(t, []Checkpoint{cp1, cp2}, trusted)
+	})
+}
+
+func TestClientVerify(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	genesisHash := ts.RandHash()
+	pubKey, prv := ts.RandBLSKeyPair()
+	pub := &publisher{name: "foundation", pubKey: pubKey}
+	client := &Client{genesisHash: genesisHash}
+
+	t.Run("Invalid block hash", func(t *testing.T) {
+		update := &Update{
+			Checkpoints: []UpdateCheckpoint{{Height: 1, BlockHash: "invalid"}},
+			Signature:   prv.Sign(SignBytes(genesisHash, nil)).String(),
+		}
+		_, err := client.verify(pub, update)
+		assert.Error(t, err)
+	})
+
+	t.Run("Invalid signature", func(t *testing.T) {
+		update := &Update{Signature: "invalid"}
+		_, err := client.verify(pub, update)
+		assert.Error(t, err)
+	})
+
+	t.Run("Too many checkpoints", func(t *testing.T) {
+		update := &Update{Checkpoints: make([]UpdateCheckpoint, maxCheckpointsPerUpdate+1)}
+		_, err := client.verify(pub, update)
+		assert.Error(t, err)
+	})
+
+	t.Run("Empty update", func(t *testing.T) {
+		update := &Update{Signature: prv.Sign(SignBytes(genesisHash, nil)).String()}
+		cps, err := client.verify(pub, update)
+		assert.NoError(t, err)
+		assert.Empty(t, cps)
+	})
+}
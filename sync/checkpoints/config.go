@@ -0,0 +1,87 @@
+package checkpoints
+
+import (
+	"net/url"
+	"time"
+
+	"github.com/pactus-project/pactus/crypto/bls"
+	"github.com/pactus-project/pactus/util/errors"
+)
+
+type Config struct {
+	Enable       bool              `toml:"enable"`
+	Quorum       int               `toml:"quorum"`
+	PollInterval time.Duration     `toml:"poll_interval"`
+	Timeout      time.Duration     `toml:"timeout"`
+	Publishers   []PublisherConfig `toml:"publishers"`
+}
+
+// PublisherConfig is a publisher of the signed checkpoint updates, like the foundation
+// or a member of the community quorum. The updates are fetched from the URL, and they
+// should be signed by the BLS key of the publisher.
+type PublisherConfig struct {
+	Name      string `toml:"name"`
+	URL       string `toml:"url"`
+	PublicKey string `toml:"public_key"`
+}
+
+func DefaultConfig() *Config {
+	return &Config{
+		Enable:       false,
+		Quorum:       1,
+		PollInterval: time.Hour,
+		Timeout:      10 * time.Second,
+	}
+}
+
+// BasicCheck performs basic checks on the configuration.
+func (conf *Config) BasicCheck() error {
+	if !conf.Enable {
+		return nil
+	}
+
+	if len(conf.Publishers) == 0 {
+		return errors.Errorf(errors.ErrInvalidConfig, "checkpoints should have at least one publisher")
+	}
+	if conf.Quorum < 1 || conf.Quorum > len(conf.Publishers) {
+		return errors.Errorf(errors.ErrInvalidConfig,
+			"checkpoints quorum should be between 1 and the number of publishers (%d)", len(conf.Publishers))
+	}
+	if conf.PollInterval < time.Minute {
+		return errors.Errorf(errors.ErrInvalidConfig, "checkpoints poll interval should be at least one minute")
+	}
+	if conf.Timeout <= 0 {
+		return errors.Errorf(errors.ErrInvalidConfig, "checkpoints timeout should be positive")
+	}
+
+	names := make(map[string]bool)
+	pubKeys := make(map[string]bool)
+	for _, pubConf := range conf.Publishers {
+		if pubConf.Name == "" {
+			return errors.Errorf(errors.ErrInvalidConfig, "checkpoints publisher name is not set")
+		}
+		if names[pubConf.Name] {
+			return errors.Errorf(errors.ErrInvalidConfig, "duplicated checkpoints publisher: %s", pubConf.Name)
+		}
+		names[pubConf.Name] = true
+
+		u, err := url.Parse(pubConf.URL)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return errors.Errorf(errors.ErrInvalidConfig, "invalid checkpoints publisher url: %s", pubConf.URL)
+		}
+
+		pubKey, err := bls.PublicKeyFromString(pubConf.PublicKey)
+		if err != nil {
+			return errors.Errorf(errors.ErrInvalidConfig,
+				"invalid public key of checkpoints publisher %s: %s", pubConf.Name, err.Error())
+		}
+		// A publisher with two entries could reach the quorum alone.
+		if pubKeys[pubKey.String()] {
+			return errors.Errorf(errors.ErrInvalidConfig,
+				"duplicated public key of checkpoints publisher: %s", pubConf.Name)
+		}
+		pubKeys[pubKey.String()] = true
+	}
+
+	return nil
+}
@@ -0,0 +1,62 @@
+package checkpoints
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pactus-project/pactus/util/testsuite"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDefaultConfigCheck(t *testing.T) {
+	conf := DefaultConfig()
+	assert.NoError(t, conf.BasicCheck())
+}
+
+func TestConfigBasicCheck(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	pub1, _ := ts.RandBLSKeyPair()
+	pub2, _ := ts.RandBLSKeyPair()
+
+	validConfig := func() *Config {
+		conf := DefaultConfig()
+		conf.Enable = true
+		conf.Quorum = 2
+		conf.Publishers = []PublisherConfig{
+			{Name: "foundation", URL: "https://example.com/checkpoints.json", PublicKey: pub1.String()},
+			{Name: "community", URL: "http://127.0.0.1:8080/checkpoints", PublicKey: pub2.String()},
+		}
+
+		return conf
+	}
+
+	tests := []struct {
+		name   string
+		update func(conf *Config)
+	}{
+		{"No publisher", func(conf *Config) { conf.Publishers = nil }},
+		{"Zero quorum", func(conf *Config) { conf.Quorum = 0 }},
+		{"Quorum is more than publishers", func(conf *Config) { conf.Quorum = 3 }},
+		{"Short poll interval", func(conf *Config) { conf.PollInterval = time.Second }},
+		{"Zero timeout", func(conf *Config) { conf.Timeout = 0 }},
+		{"No name", func(conf *Config) { conf.Publishers[0].Name = "" }},
+		{"Duplicated name", func(conf *Config) { conf.Publishers[1].Name = "foundation" }},
+		{"Invalid url", func(conf *Config) { conf.Publishers[0].URL = "ftp://example.com" }},
+		{"Invalid public key", func(conf *Config) { conf.Publishers[0].PublicKey = "invalid" }},
+		{"Duplicated public key", func(conf *Config) { conf.Publishers[1].PublicKey = pub1.String() }},
+	}
+
+	assert.NoError(t, validConfig().BasicCheck())
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conf := validConfig()
+			tt.update(conf)
+			assert.Error(t, conf.BasicCheck())
+
+			conf.Enable = false
+			assert.NoError(t, conf.BasicCheck())
+		})
+	}
+}
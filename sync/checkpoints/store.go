@@ -0,0 +1,115 @@
+package checkpoints
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/pactus-project/pactus/crypto/hash"
+)
+
+// Store keeps the checkpoints that are signed by the publishers.
+// A checkpoint is trusted when at least the quorum of the publishers sign it.
+// If the publishers reach the quorum on two different hashes for a height,
+// the height is marked as conflicted and none of them is trusted.
+type Store struct {
+	lk sync.RWMutex
+
+	quorum     int
+	signers    map[uint32]map[hash.Hash]map[string]bool
+	trusted    map[uint32]hash.Hash
+	conflicted map[uint32]bool
+}
+
+// NewStore creates a new store for the checkpoints.
+func NewStore(quorum int) *Store {
+	return &Store{
+		quorum:     quorum,
+		signers:    make(map[uint32]map[hash.Hash]map[string]bool),
+		trusted:    make(map[uint32]hash.Hash),
+		conflicted: make(map[uint32]bool),
+	}
+}
+
+// Add records that the publisher has signed the checkpoint.
+// It returns true if the checkpoint becomes trusted by this signature.
+func (s *Store) Add(publisher string, cp Checkpoint) bool {
+	s.lk.Lock()
+	defer s.lk.Unlock()
+
+	if s.conflicted[cp.Height] {
+		return false
+	}
+
+	hashes, ok := s.signers[cp.Height]
+	if !ok {
+		hashes = make(map[hash.Hash]map[string]bool)
+		s.signers[cp.Height] = hashes
+	}
+	publishers, ok := hashes[cp.BlockHash]
+	if !ok {
+		publishers = make(map[string]bool)
+		hashes[cp.BlockHash] = publishers
+	}
+	if publishers[publisher] {
+		return false
+	}
+	publishers[publisher] = true
+
+	if len(publishers) < s.quorum {
+		return false
+	}
+
+	trustedHash, ok := s.trusted[cp.Height]
+	if !ok {
+		s.trusted[cp.Height] = cp.BlockHash
+
+		return true
+	}
+
+	if trustedHash != cp.BlockHash {
+		delete(s.trusted, cp.Height)
+		s.conflicted[cp.Height] = true
+	}
+
+	return false
+}
+
+// Check returns an error if the block hash doesn't match the trusted checkpoint of the height.
+// It returns nil if there is no trusted checkpoint for the height.
+func (s *Store) Check(height uint32, blockHash hash.Hash) error {
+	s.lk.RLock()
+	defer s.lk.RUnlock()
+
+	trustedHash, ok := s.trusted[height]
+	if ok && trustedHash != blockHash {
+		return MismatchError{
+			Height:   height,
+			Expected: trustedHash,
+			Got:      blockHash,
+		}
+	}
+
+	return nil
+}
+
+// IsConflicted checks if the publishers have reached the quorum on different hashes for the height.
+func (s *Store) IsConflicted(height uint32) bool {
+	s.lk.RLock()
+	defer s.lk.RUnlock()
+
+	return s.conflicted[height]
+}
+
+// Trusted returns the trusted checkpoints, sorted by height.
+func (s *Store) Trusted() []Checkpoint {
+	s.lk.RLock()
+	defer s.lk.RUnlock()
+
+	cps := make([]Checkpoint, 0, len(s.trusted))
+	for height, blockHash := range s.trusted {
+		cps = append(cps, Checkpoint{Height: height, BlockHash: blockHash})
+	}
+	sort.Slice(cps, func(i, j int) bool { return cps[i].Height < cps[j].Height })
+
+	return cps
+}
@@ -0,0 +1,61 @@
+package checkpoints
+
+import (
+	"testing"
+
+	"github.com/pactus-project/pactus/util/testsuite"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStore(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	store := NewStore(2)
+	height := ts.RandHeight()
+	cp := Checkpoint{Height: height, BlockHash: ts.RandHash()}
+
+	t.Run("Checkpoint is not trusted before the quorum", func(t *testing.T) {
+		assert.False(t, store.Add("foundation", cp))
+		assert.False(t, store.Add("foundation", cp), "signing twice shouldn't count")
+
+		assert.NoError(t, store.Check(height, ts.RandHash()))
+		assert.Empty(t, store.Trusted())
+	})
+
+	t.Run("Checkpoint is trusted by the quorum", func(t *testing.T) {
+		assert.True(t, store.Add("community", cp))
+		assert.False(t, store.Add("validator", cp), "already trusted")
+
+		assert.NoError(t, store.Check(height, cp.BlockHash))
+		assert.NoError(t, store.Check(height+1, ts.RandHash()))
+		assert.Equal(t, []Checkpoint{cp}, store.Trusted())
+
+		got := ts.RandHash()
+		assert.Equal(t, MismatchError{Height: height, Expected: cp.BlockHash, Got: got},
+			store.Check(height, got))
+	})
+
+	t.Run("Conflicting checkpoints are not trusted", func(t *testing.T) {
+		other := Checkpoint{Height: height, BlockHash: ts.RandHash()}
+		assert.False(t, store.Add("foundation", other))
+		assert.False(t, store.IsConflicted(height))
+		assert.False(t, store.Add("community", other))
+		assert.True(t, store.IsConflicted(height))
+
+		assert.NoError(t, store.Check(height, ts.RandHash()))
+		assert.Empty(t, store.Trusted())
+
+		assert.False(t, store.Add("validator", cp))
+	})
+
+	t.Run("Trusted checkpoints are sorted by height", func(t *testing.T) {
+		cp1 := Checkpoint{Height: height + 2, BlockHash: ts.RandHash()}
+		cp2 := Checkpoint{Height: height + 1, BlockHash: ts.RandHash()}
+		for _, cp := range []Checkpoint{cp1, cp2} {
+			store.Add("foundation", cp)
+			store.Add("community", cp)
+		}
+
+		assert.Equal(t, []Checkpoint{cp2, cp1}, store.Trusted())
+	})
+}
@@ -3,39 +3,153 @@ package sync
 import (
 	"time"
 
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/pactus-project/pactus/network"
+	"github.com/pactus-project/pactus/sync/bundle/message"
+	"github.com/pactus-project/pactus/sync/checkpoints"
 	"github.com/pactus-project/pactus/sync/firewall"
+	"github.com/pactus-project/pactus/sync/peerset/capability"
 	"github.com/pactus-project/pactus/sync/peerset/service"
 	"github.com/pactus-project/pactus/util"
+	"github.com/pactus-project/pactus/util/errors"
+	"github.com/pactus-project/pactus/version"
 )
 
 type Config struct {
-	Moniker        string           `toml:"moniker"`
-	SessionTimeout time.Duration    `toml:"session_timeout"`
-	NodeNetwork    bool             `toml:"node_network"`
-	Firewall       *firewall.Config `toml:"firewall"`
+	Moniker           string        `toml:"moniker"`
+	SessionTimeout    time.Duration `toml:"session_timeout"`
+	NodeNetwork       bool          `toml:"node_network"`
+	StemRelay         bool          `toml:"stem_relay"`
+	FreeloaderLimitMB int           `toml:"freeloader_limit_mb"`
+	VoteRelayRate     int           `toml:"vote_relay_rate"`
+	AttestationFile   string        `toml:"attestation_file"`
+	// MinProtocolVersion is the minimum protocol version of the peers.
+	// The minimum version in the genesis is applied, if it is higher.
+	MinProtocolVersion uint32           `toml:"min_protocol_version"`
+	Firewall           *firewall.Config `toml:"firewall"`
+	Replica            ReplicaConfig    `toml:"replica"`
+	// Checkpoints configures fetching the signed checkpoints from the trusted publishers.
+	Checkpoints *checkpoints.Config `toml:"checkpoints"`
 
 	// Private configs
-	MaxSessions         int    `toml:"-"`
-	LatestBlockInterval uint32 `toml:"-"`
-	BlockPerMessage     uint32 `toml:"-"`
+	MaxSessions         int           `toml:"-"`
+	LatestBlockInterval uint32        `toml:"-"`
+	BlockPerMessage     uint32        `toml:"-"`
+	StaleTipTimeout     time.Duration `toml:"-"`
+	PingInterval        time.Duration `toml:"-"`
+	TxRelayDelay        time.Duration `toml:"-"`
+	StemEmbargo         time.Duration `toml:"-"`
+	ReplicaPollInterval time.Duration `toml:"-"`
+	VoteRelayFairShare  int           `toml:"-"`
+}
+
+// ReplicaConfig runs the node as a read replica.
+// A replica doesn't join the gossip topics and syncs only from its trusted primaries,
+// by requesting the new blocks directly from them. It still validates the blocks and
+// the certificates, but it relies on the primaries to receive the new blocks and
+// to relay its transactions, so the primaries can withhold them.
+type ReplicaConfig struct {
+	Enable    bool     `toml:"enable"`
+	Primaries []string `toml:"primaries"`
 }
 
 func DefaultConfig() *Config {
 	return &Config{
 		SessionTimeout:      time.Second * 10,
 		NodeNetwork:         true,
+		FreeloaderLimitMB:   0,
+		VoteRelayRate:       200,
+		VoteRelayFairShare:  10,
 		BlockPerMessage:     60,
 		MaxSessions:         8,
 		LatestBlockInterval: 720,
+		StaleTipTimeout:     time.Minute * 5,
+		PingInterval:        time.Second * 30,
+		TxRelayDelay:        time.Second * 2,
+		StemEmbargo:         time.Second * 30,
+		ReplicaPollInterval: time.Second * 2,
 		Firewall:            firewall.DefaultConfig(),
+		Replica: ReplicaConfig{
+			Enable:    false,
+			Primaries: []string{},
+		},
+		Checkpoints: checkpoints.DefaultConfig(),
 	}
 }
 
 // BasicCheck performs basic checks on the configuration.
 func (conf *Config) BasicCheck() error {
+	if conf.FreeloaderLimitMB < 0 {
+		return errors.Errorf(errors.ErrInvalidConfig, "freeloader limit can't be negative")
+	}
+
+	if conf.VoteRelayRate < 0 {
+		return errors.Errorf(errors.ErrInvalidConfig, "vote relay rate can't be negative")
+	}
+
+	if conf.MinProtocolVersion > version.ProtocolVersion() {
+		return errors.Errorf(errors.ErrInvalidConfig,
+			"minimum protocol version can't be more than the node's protocol version %d", version.ProtocolVersion())
+	}
+
+	if conf.Replica.Enable {
+		if len(conf.Replica.Primaries) == 0 {
+			return errors.Errorf(errors.ErrInvalidConfig, "replica should have at least one primary")
+		}
+		if _, err := network.MakeAddrInfos(conf.Replica.Primaries); err != nil {
+			return errors.Errorf(errors.ErrInvalidConfig, "invalid primary address: %s", err.Error())
+		}
+	}
+
+	if err := conf.Checkpoints.BasicCheck(); err != nil {
+		return err
+	}
+
 	return nil
 }
 
+// PrimaryIDs returns the peer IDs of the primaries, if the node is a read replica.
+func (conf *Config) PrimaryIDs() []peer.ID {
+	if !conf.Replica.Enable {
+		return nil
+	}
+
+	addrInfos, _ := network.MakeAddrInfos(conf.Replica.Primaries)
+	pids := make([]peer.ID, 0, len(addrInfos))
+	for _, ai := range addrInfos {
+		pids = append(pids, ai.ID)
+	}
+
+	return pids
+}
+
+// Attestation reads the hardware attestation of the validators from the attestation file.
+// It returns nil if no attestation file is set.
+func (conf *Config) Attestation() ([]byte, error) {
+	if conf.AttestationFile == "" {
+		return nil, nil
+	}
+
+	attestation, err := util.ReadFile(conf.AttestationFile)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(attestation) > message.MaxAttestationSize {
+		return nil, errors.Errorf(errors.ErrInvalidConfig,
+			"attestation is too large, expected at most %v bytes, got %v",
+			message.MaxAttestationSize, len(attestation))
+	}
+
+	return attestation, nil
+}
+
+// FreeloaderLimit returns the number of bytes that can be served to a peer,
+// more than what the peer has served to us. Zero means no limit.
+func (conf *Config) FreeloaderLimit() int64 {
+	return int64(conf.FreeloaderLimitMB) << 20
+}
+
 func (conf *Config) CacheSize() int {
 	return util.LogScale(
 		int(conf.BlockPerMessage * conf.LatestBlockInterval))
@@ -49,3 +163,15 @@ func (conf *Config) Services() service.Services {
 
 	return s
 }
+
+// Capabilities returns the wire features that the node supports.
+// Compact blocks, state sync and serving the snapshots are not supported yet.
+// The node serves the whole history of the blocks, if it is a network node.
+func (conf *Config) Capabilities() capability.Capabilities {
+	c := capability.New(capability.Compression, capability.Filters, capability.Ping, capability.StemRelay)
+	if conf.NodeNetwork {
+		c.Append(capability.Archive)
+	}
+
+	return c
+}
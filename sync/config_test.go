@@ -3,6 +3,10 @@ package sync
 import (
 	"testing"
 
+	"github.com/pactus-project/pactus/sync/bundle/message"
+	"github.com/pactus-project/pactus/sync/peerset/capability"
+	"github.com/pactus-project/pactus/util"
+	"github.com/pactus-project/pactus/version"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -10,3 +14,62 @@ func TestDefaultConfigCheck(t *testing.T) {
 	c := DefaultConfig()
 	assert.NoError(t, c.BasicCheck())
 }
+
+func TestFreeloaderConfig(t *testing.T) {
+	c := DefaultConfig()
+	assert.Zero(t, c.FreeloaderLimit())
+
+	c.FreeloaderLimitMB = 2
+	assert.NoError(t, c.BasicCheck())
+	assert.Equal(t, int64(2*1024*1024), c.FreeloaderLimit())
+
+	c.FreeloaderLimitMB = -1
+	assert.Error(t, c.BasicCheck())
+}
+
+func TestVoteRelayConfig(t *testing.T) {
+	c := DefaultConfig()
+	c.VoteRelayRate = 0
+	assert.NoError(t, c.BasicCheck())
+
+	c.VoteRelayRate = -1
+	assert.Error(t, c.BasicCheck())
+}
+
+func TestMinProtocolVersionConfig(t *testing.T) {
+	c := DefaultConfig()
+	c.MinProtocolVersion = version.ProtocolVersion()
+	assert.NoError(t, c.BasicCheck())
+
+	c.MinProtocolVersion = version.ProtocolVersion() + 1
+	assert.Error(t, c.BasicCheck())
+}
+
+func TestCapabilities(t *testing.T) {
+	c := DefaultConfig()
+	assert.True(t, c.Capabilities().Has(capability.Archive))
+	assert.False(t, c.Capabilities().Has(capability.Snapshots))
+
+	c.NodeNetwork = false
+	assert.False(t, c.Capabilities().Has(capability.Archive))
+}
+
+func TestAttestationConfig(t *testing.T) {
+	c := DefaultConfig()
+	attestation, err := c.Attestation()
+	assert.NoError(t, err)
+	assert.Nil(t, attestation)
+
+	c.AttestationFile = util.TempFilePath()
+	_, err = c.Attestation()
+	assert.Error(t, err)
+
+	assert.NoError(t, util.WriteFile(c.AttestationFile, []byte("quote")))
+	attestation, err = c.Attestation()
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("quote"), attestation)
+
+	assert.NoError(t, util.WriteFile(c.AttestationFile, make([]byte, message.MaxAttestationSize+1)))
+	_, err = c.Attestation()
+	assert.Error(t, err)
+}
@@ -0,0 +1,106 @@
+package firewall
+
+import (
+	"sync"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+)
+
+const (
+	// evidenceLogSize is the number of the evidences that are kept, the oldest ones are dropped.
+	evidenceLogSize = 128
+	// maxEvidenceDataSize is the maximum size of the offending data that is kept in an evidence.
+	maxEvidenceDataSize = 16 * 1024
+)
+
+// The reasons of penalizing a peer.
+const (
+	EvidenceUndecodableBundle = "undecodable_bundle"
+	EvidenceInvalidBundle     = "invalid_bundle"
+	EvidenceHelloRejected     = "hello_rejected"
+)
+
+// Evidence records why a peer is penalized, with the offending data and the context,
+// so the reports of the malicious peers can be reproduced.
+type Evidence struct {
+	Time           time.Time
+	PeerID         peer.ID
+	Address        string
+	Agent          string
+	Reason         string
+	Error          string
+	Height         uint32 // The last block height of the node
+	InvalidBundles int    // The number of the invalid bundles that the peer has sent
+	Data           []byte // The offending data, truncated if it is larger than maxEvidenceDataSize
+	DataSize       int    // The size of the offending data before truncating
+}
+
+// IsTruncated checks if the offending data is truncated.
+func (e *Evidence) IsTruncated() bool {
+	return len(e.Data) < e.DataSize
+}
+
+// evidenceLog keeps the recent evidences in a ring buffer, so the memory is bounded.
+type evidenceLog struct {
+	lk sync.Mutex
+
+	entries []Evidence
+	next    int
+}
+
+func newEvidenceLog(size int) *evidenceLog {
+	return &evidenceLog{
+		entries: make([]Evidence, 0, size),
+	}
+}
+
+func (l *evidenceLog) add(evidence Evidence) {
+	l.lk.Lock()
+	defer l.lk.Unlock()
+
+	if len(l.entries) < cap(l.entries) {
+		l.entries = append(l.entries, evidence)
+
+		return
+	}
+
+	l.entries[l.next] = evidence
+	l.next = (l.next + 1) % len(l.entries)
+}
+
+// list returns the evidences of the peer, or all the evidences if the peer ID is empty,
+// from the oldest to the newest.
+func (l *evidenceLog) list(pid peer.ID) []Evidence {
+	l.lk.Lock()
+	defer l.lk.Unlock()
+
+	evidences := make([]Evidence, 0, len(l.entries))
+	for i := 0; i < len(l.entries); i++ {
+		evidence := l.entries[(l.next+i)%len(l.entries)]
+		if pid == "" || evidence.PeerID == pid {
+			evidences = append(evidences, evidence)
+		}
+	}
+
+	return evidences
+}
+
+// captureBuffer keeps the beginning of the data that is written to it,
+// to capture the offending data of a stream while it is decoded.
+type captureBuffer struct {
+	data []byte
+	size int
+}
+
+func (b *captureBuffer) Write(p []byte) (int, error) {
+	if room := maxEvidenceDataSize - len(b.data); room > 0 {
+		if len(p) < room {
+			room = len(p)
+		}
+		b.data = append(b.data, p[:room]...)
+	}
+	b.size += len(p)
+
+	return len(p), nil
+}
@@ -3,8 +3,10 @@ package firewall
 import (
 	"bytes"
 	"io"
+	"time"
 
 	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/pactus-project/pactus/consensus"
 	"github.com/pactus-project/pactus/genesis"
 	"github.com/pactus-project/pactus/network"
 	"github.com/pactus-project/pactus/state"
@@ -21,23 +23,29 @@ type Firewall struct {
 	network network.Network
 	peerSet *peerset.PeerSet
 	state   state.Facade
+	replay  *replayGuard
+	evidLog *evidenceLog
 	logger  *logger.SubLogger
 }
 
 func NewFirewall(conf *Config, net network.Network, peerSet *peerset.PeerSet, st state.Facade,
-	log *logger.SubLogger,
+	consMgr consensus.ManagerReader, log *logger.SubLogger,
 ) *Firewall {
 	return &Firewall{
 		config:  conf,
 		network: net,
 		peerSet: peerSet,
 		state:   st,
+		replay:  newReplayGuard(consMgr),
+		evidLog: newEvidenceLog(evidenceLogSize),
 		logger:  log,
 	}
 }
 
 func (f *Firewall) OpenGossipBundle(data []byte, from peer.ID) *bundle.Bundle {
-	bdl, err := f.openBundle(bytes.NewReader(data), from)
+	bdl, err := f.openBundle(bytes.NewReader(data), from, func() ([]byte, int) {
+		return data, len(data)
+	})
 	if err != nil {
 		f.logger.Debug("firewall: unable to open a gossip bundle",
 			"error", err, "bundle", bdl, "from", from)
@@ -45,6 +53,10 @@ func (f *Firewall) OpenGossipBundle(data []byte, from peer.ID) *bundle.Bundle {
 		return nil
 	}
 
+	if f.isReplayed(bdl, from) {
+		return nil
+	}
+
 	// TODO: check if gossip flag is set
 	// TODO: check if bundle is a gossip bundle
 
@@ -52,7 +64,11 @@ func (f *Firewall) OpenGossipBundle(data []byte, from peer.ID) *bundle.Bundle {
 }
 
 func (f *Firewall) OpenStreamBundle(r io.Reader, from peer.ID) *bundle.Bundle {
-	bdl, err := f.openBundle(r, from)
+	// The stream is read once, so the beginning of it is captured for the evidence.
+	capture := &captureBuffer{}
+	bdl, err := f.openBundle(io.TeeReader(r, capture), from, func() ([]byte, int) {
+		return capture.data, capture.size
+	})
 	if err != nil {
 		f.logger.Debug("firewall: unable to open a stream bundle",
 			"error", err, "bundle", bdl, "from", from)
@@ -60,13 +76,21 @@ func (f *Firewall) OpenStreamBundle(r io.Reader, from peer.ID) *bundle.Bundle {
 		return nil
 	}
 
+	if f.isReplayed(bdl, from) {
+		return nil
+	}
+
 	// TODO: check if gossip flag is NOT set
 	// TODO: check if bundle is a stream bundle
 
 	return bdl
 }
 
-func (f *Firewall) openBundle(r io.Reader, from peer.ID) (*bundle.Bundle, error) {
+// openBundle decodes and checks the bundle.
+// The offending function returns the received data, to record it as the evidence of an invalid bundle.
+func (f *Firewall) openBundle(r io.Reader, from peer.ID,
+	offending func() ([]byte, int),
+) (*bundle.Bundle, error) {
 	f.peerSet.UpdateLastReceived(from)
 	f.peerSet.IncreaseReceivedBundlesCounter(from)
 
@@ -79,12 +103,16 @@ func (f *Firewall) openBundle(r io.Reader, from peer.ID) (*bundle.Bundle, error)
 	bdl, err := f.decodeBundle(r, from)
 	if err != nil {
 		f.peerSet.IncreaseInvalidBundlesCounter(from)
+		data, size := offending()
+		f.recordEvidence(from, EvidenceUndecodableBundle, err, data, size)
 
 		return nil, err
 	}
 
 	if err := f.checkBundle(bdl); err != nil {
 		f.peerSet.IncreaseInvalidBundlesCounter(from)
+		data, size := offending()
+		f.recordEvidence(from, EvidenceInvalidBundle, err, data, size)
 
 		return bdl, err
 	}
@@ -133,6 +161,65 @@ func (f *Firewall) checkBundle(bdl *bundle.Bundle) error {
 	return nil
 }
 
+// isReplayed checks if the bundle carries a replayed vote.
+// Replaying a vote is not counted as an invalid bundle, since the honest peers
+// may relay the same vote too.
+func (f *Firewall) isReplayed(bdl *bundle.Bundle, from peer.ID) bool {
+	msg, ok := bdl.Message.(*message.VoteMessage)
+	if !ok {
+		return false
+	}
+
+	reason := f.replay.check(msg.Vote, f.state.LastBlockHeight())
+	if reason == "" {
+		return false
+	}
+
+	f.logger.Trace("firewall: replayed vote dropped",
+		"vote", msg.Vote, "reason", reason, "from", from)
+	replayedVotesCounter.WithLabelValues(reason).Inc()
+
+	return true
+}
+
+// RecordEvidence records the evidence of penalizing a peer, with the offending data.
+func (f *Firewall) RecordEvidence(pid peer.ID, reason string, err error, data []byte) {
+	f.recordEvidence(pid, reason, err, data, len(data))
+}
+
+func (f *Firewall) recordEvidence(pid peer.ID, reason string, err error, data []byte, size int) {
+	if len(data) > maxEvidenceDataSize {
+		data = data[:maxEvidenceDataSize]
+	}
+
+	evidence := Evidence{
+		Time:     time.Now(),
+		PeerID:   pid,
+		Reason:   reason,
+		Height:   f.state.LastBlockHeight(),
+		Data:     append([]byte(nil), data...),
+		DataSize: size,
+	}
+	if err != nil {
+		evidence.Error = err.Error()
+	}
+	if p := f.peerSet.GetPeer(pid); p != nil {
+		evidence.Address = p.Address
+		evidence.Agent = p.Agent
+		evidence.InvalidBundles = p.InvalidBundles
+	}
+
+	f.logger.Debug("firewall: evidence recorded",
+		"pid", pid, "reason", reason, "error", evidence.Error)
+	f.evidLog.add(evidence)
+}
+
+// Evidences returns the recorded evidences of the peer, or all the evidences if the peer ID is empty,
+// from the oldest to the newest.
+func (f *Firewall) Evidences(pid peer.ID) []Evidence {
+	return f.evidLog.list(pid)
+}
+
 func (f *Firewall) isPeerBanned(pid peer.ID) bool {
 	if !f.config.Enabled {
 		return false
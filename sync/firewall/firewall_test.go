@@ -6,15 +6,19 @@ import (
 	"time"
 
 	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/pactus-project/pactus/consensus"
+	"github.com/pactus-project/pactus/crypto/bls"
 	"github.com/pactus-project/pactus/network"
 	"github.com/pactus-project/pactus/state"
 	"github.com/pactus-project/pactus/sync/bundle"
 	"github.com/pactus-project/pactus/sync/bundle/message"
 	"github.com/pactus-project/pactus/sync/peerset"
+	"github.com/pactus-project/pactus/types/vote"
 	"github.com/pactus-project/pactus/util"
 	"github.com/pactus-project/pactus/util/logger"
 	"github.com/pactus-project/pactus/util/testsuite"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 type testData struct {
@@ -26,6 +30,7 @@ type testData struct {
 	unknownPeerID peer.ID
 	network       *network.MockNetwork
 	state         *state.MockState
+	consMgr       consensus.Manager
 }
 
 func setup(t *testing.T) *testData {
@@ -37,9 +42,10 @@ func setup(t *testing.T) *testData {
 	peerSet := peerset.NewPeerSet(1 * time.Minute)
 	st := state.MockingState(ts)
 	net := network.MockingNetwork(ts, ts.RandPeerID())
+	consMgr, _ := consensus.MockingManager(ts, []*bls.ValidatorKey{ts.RandValKey()})
 	conf := DefaultConfig()
 	conf.Enabled = true
-	firewall := NewFirewall(conf, net, peerSet, st, subLogger)
+	firewall := NewFirewall(conf, net, peerSet, st, consMgr, subLogger)
 	assert.NotNil(t, firewall)
 	badPeerID := ts.RandPeerID()
 	goodPeerID := ts.RandPeerID()
@@ -57,6 +63,7 @@ func setup(t *testing.T) *testData {
 		firewall:      firewall,
 		network:       net,
 		state:         st,
+		consMgr:       consMgr,
 		badPeerID:     badPeerID,
 		goodPeerID:    goodPeerID,
 		unknownPeerID: unknownPeerID,
@@ -199,3 +206,154 @@ func TestNetworkFlags(t *testing.T) {
 	bdl.Flags = 1
 	assert.Error(t, td.firewall.checkBundle(bdl))
 }
+
+func TestReplayedVotes(t *testing.T) {
+	td := setup(t)
+	td.state.CommitTestBlocks(10)
+	lastHeight := td.state.LastBlockHeight()
+
+	encodeVote := func(v *vote.Vote) []byte {
+		bdl := bundle.NewBundle(message.NewVoteMessage(v))
+		bdl.Flags = util.SetFlag(bdl.Flags, bundle.BundleFlagNetworkTestnet)
+		d, _ := bdl.Encode()
+
+		return d
+	}
+
+	t.Run("Stale vote => should be dropped", func(t *testing.T) {
+		v, _ := td.GenerateTestPrepareVote(lastHeight, 0)
+		d := encodeVote(v)
+
+		assert.Nil(t, td.firewall.OpenGossipBundle(d, td.goodPeerID))
+		assert.Nil(t, td.firewall.OpenStreamBundle(bytes.NewReader(d), td.goodPeerID))
+		assert.False(t, td.network.IsClosed(td.goodPeerID))
+	})
+
+	t.Run("Duplicated vote => should be dropped", func(t *testing.T) {
+		v, _ := td.GenerateTestPrepareVote(lastHeight+1, 0)
+		d := encodeVote(v)
+
+		assert.NotNil(t, td.firewall.OpenGossipBundle(d, td.goodPeerID))
+		td.consMgr.Instances()[0].(consensus.Consensus).AddVote(v)
+
+		assert.Nil(t, td.firewall.OpenGossipBundle(d, td.goodPeerID))
+		assert.Nil(t, td.firewall.OpenStreamBundle(bytes.NewReader(d), td.unknownPeerID))
+		assert.False(t, td.network.IsClosed(td.goodPeerID))
+	})
+
+	t.Run("Ignored vote => should NOT be dropped", func(t *testing.T) {
+		v, _ := td.GenerateTestPrepareVote(lastHeight+2, 0)
+		d := encodeVote(v)
+
+		assert.NotNil(t, td.firewall.OpenGossipBundle(d, td.goodPeerID))
+		assert.NotNil(t, td.firewall.OpenGossipBundle(d, td.goodPeerID))
+	})
+
+	t.Run("Replays are not counted as invalid bundles", func(t *testing.T) {
+		p := td.firewall.peerSet.GetPeer(td.goodPeerID)
+		assert.Zero(t, p.InvalidBundles)
+	})
+}
+
+func TestReplayGuard(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+	consMgr, consMocks := consensus.MockingManager(ts, []*bls.ValidatorKey{ts.RandValKey()})
+	guard := newReplayGuard(consMgr)
+
+	v1, _ := ts.GenerateTestPrepareVote(101, 0)
+	v2, _ := ts.GenerateTestPrecommitVote(101, 0)
+	v3, _ := ts.GenerateTestPrepareVote(100, 0)
+
+	assert.Equal(t, "", guard.check(v1, 100))
+	consMocks[0].AddVote(v1)
+
+	assert.Equal(t, replayReasonDuplicate, guard.check(v1, 100))
+	assert.Equal(t, "", guard.check(v2, 100))
+	assert.Equal(t, replayReasonStale, guard.check(v3, 100))
+	assert.Equal(t, replayReasonStale, guard.check(v1, 101))
+}
+
+func TestEvidences(t *testing.T) {
+	td := setup(t)
+
+	t.Run("Undecodable bundle => should record the evidence", func(t *testing.T) {
+		assert.Nil(t, td.firewall.OpenGossipBundle([]byte("bad"), td.unknownPeerID))
+
+		evidences := td.firewall.Evidences(td.unknownPeerID)
+		require.Len(t, evidences, 1)
+		assert.Equal(t, EvidenceUndecodableBundle, evidences[0].Reason)
+		assert.Equal(t, []byte("bad"), evidences[0].Data)
+		assert.Equal(t, 1, evidences[0].InvalidBundles)
+		assert.Equal(t, td.state.LastBlockHeight(), evidences[0].Height)
+		assert.NotEmpty(t, evidences[0].Error)
+	})
+
+	t.Run("Invalid bundle from a stream => should record the evidence", func(t *testing.T) {
+		bdl := bundle.NewBundle(message.NewBlocksRequestMessage(td.RandInt(100), 1, 100))
+		bdl.Flags = util.SetFlag(bdl.Flags, bundle.BundleFlagNetworkMainnet)
+		d, _ := bdl.Encode()
+
+		assert.Nil(t, td.firewall.OpenStreamBundle(bytes.NewReader(d), td.goodPeerID))
+
+		evidences := td.firewall.Evidences(td.goodPeerID)
+		require.Len(t, evidences, 1)
+		assert.Equal(t, EvidenceInvalidBundle, evidences[0].Reason)
+		assert.Equal(t, d, evidences[0].Data)
+		assert.False(t, evidences[0].IsTruncated())
+	})
+
+	t.Run("Valid bundle => should NOT record any evidence", func(t *testing.T) {
+		bdl := bundle.NewBundle(message.NewQueryVotesMessage(td.RandHeight(), td.RandRound(), td.RandValAddress()))
+		bdl.Flags = util.SetFlag(bdl.Flags, bundle.BundleFlagNetworkTestnet)
+		d, _ := bdl.Encode()
+
+		assert.NotNil(t, td.firewall.OpenGossipBundle(d, td.goodPeerID))
+		assert.Len(t, td.firewall.Evidences(td.goodPeerID), 1)
+	})
+
+	t.Run("Large data => should be truncated", func(t *testing.T) {
+		data := td.RandBytes(maxEvidenceDataSize + 1)
+		td.firewall.RecordEvidence(td.badPeerID, EvidenceInvalidBundle, nil, data)
+
+		evidences := td.firewall.Evidences(td.badPeerID)
+		require.Len(t, evidences, 1)
+		assert.Equal(t, data[:maxEvidenceDataSize], evidences[0].Data)
+		assert.Equal(t, maxEvidenceDataSize+1, evidences[0].DataSize)
+		assert.True(t, evidences[0].IsTruncated())
+	})
+
+	t.Run("List all the evidences", func(t *testing.T) {
+		assert.Len(t, td.firewall.Evidences(""), 3)
+	})
+}
+
+func TestEvidenceLog(t *testing.T) {
+	log := newEvidenceLog(3)
+
+	for i := 1; i <= 5; i++ {
+		log.add(Evidence{Height: uint32(i)})
+	}
+
+	evidences := log.list("")
+	require.Len(t, evidences, 3)
+	assert.Equal(t, uint32(3), evidences[0].Height)
+	assert.Equal(t, uint32(4), evidences[1].Height)
+	assert.Equal(t, uint32(5), evidences[2].Height)
+}
+
+func TestCaptureBuffer(t *testing.T) {
+	buf := &captureBuffer{}
+
+	data := make([]byte, maxEvidenceDataSize-1)
+	n, err := buf.Write(data)
+	assert.NoError(t, err)
+	assert.Equal(t, len(data), n)
+
+	n, err = buf.Write([]byte{1, 2, 3})
+	assert.NoError(t, err)
+	assert.Equal(t, 3, n)
+
+	assert.Len(t, buf.data, maxEvidenceDataSize)
+	assert.Equal(t, byte(1), buf.data[maxEvidenceDataSize-1])
+	assert.Equal(t, maxEvidenceDataSize+2, buf.size)
+}
@@ -0,0 +1,55 @@
+package firewall
+
+import (
+	"github.com/pactus-project/pactus/consensus"
+	"github.com/pactus-project/pactus/types/vote"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// The reasons of dropping a replayed vote.
+const (
+	replayReasonStale     = "stale"
+	replayReasonDuplicate = "duplicate"
+)
+
+var replayedVotesCounter = prometheus.NewCounterVec(prometheus.CounterOpts{
+	Namespace: "pactus",
+	Subsystem: "sync",
+	Name:      "firewall_replayed_votes_total",
+	Help:      "The number of the replayed votes that are dropped by the firewall.",
+}, []string{"reason"})
+
+func init() {
+	prometheus.MustRegister(replayedVotesCounter)
+}
+
+// replayGuard detects the votes that are replayed, before verifying their signatures.
+// The hash of a vote binds it to the height, round, type, signer and signature,
+// so the same hash is seen only when the vote is replayed.
+type replayGuard struct {
+	consMgr consensus.ManagerReader
+}
+
+func newReplayGuard(consMgr consensus.ManagerReader) *replayGuard {
+	return &replayGuard{
+		consMgr: consMgr,
+	}
+}
+
+// check returns the reason if the vote is replayed, otherwise it returns an empty string.
+// The votes for the committed heights are stale, as the consensus only accepts the votes
+// for the next height. A vote is duplicated if the consensus already has it.
+// The consensus is asked instead of keeping the seen votes here, since the votes
+// that are ignored by the consensus, like the votes for the future heights,
+// should be accepted once they are received again.
+func (g *replayGuard) check(v *vote.Vote, lastHeight uint32) string {
+	if v.Height() <= lastHeight {
+		return replayReasonStale
+	}
+
+	if g.consMgr.HasVote(v.Hash()) {
+		return replayReasonDuplicate
+	}
+
+	return ""
+}
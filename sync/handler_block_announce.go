@@ -1,6 +1,8 @@
 package sync
 
 import (
+	"time"
+
 	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/pactus-project/pactus/sync/bundle"
 	"github.com/pactus-project/pactus/sync/bundle/message"
@@ -20,6 +22,9 @@ func (handler *blockAnnounceHandler) ParseMessage(m message.Message, pid peer.ID
 	msg := m.(*message.BlockAnnounceMessage)
 	handler.logger.Trace("parsing BlockAnnounce message", "msg", msg)
 
+	handler.propagation.record(PropagationBlock, msg.Height(), msg.Certificate.Round(),
+		msg.Block, pid, time.Now())
+
 	handler.cache.AddCertificate(msg.Certificate)
 	handler.cache.AddBlock(msg.Block)
 
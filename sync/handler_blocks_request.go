@@ -42,6 +42,15 @@ func (handler *blocksRequestHandler) ParseMessage(m message.Message, pid peer.ID
 		return nil
 	}
 
+	if limit := handler.config.FreeloaderLimit(); limit > 0 && handler.peerSet.ServingBalance(pid) >= limit {
+		response := message.NewBlocksResponseMessage(message.ResponseCodeRejected,
+			fmt.Sprintf("serving limit is reached (%s)", pid.String()), msg.SessionID, 0, nil, nil)
+
+		handler.respond(response, pid)
+
+		return nil
+	}
+
 	if !handler.config.NodeNetwork {
 		ourHeight := handler.state.LastBlockHeight()
 		if ourHeight > handler.config.LatestBlockInterval && msg.From < ourHeight-handler.config.LatestBlockInterval {
@@ -3,8 +3,11 @@ package sync
 import (
 	"testing"
 
+	"github.com/pactus-project/pactus/sync/bundle"
 	"github.com/pactus-project/pactus/sync/bundle/message"
+	"github.com/pactus-project/pactus/sync/peerset/capability"
 	"github.com/pactus-project/pactus/sync/peerset/service"
+	"github.com/pactus-project/pactus/util"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -108,5 +111,68 @@ func TestLatestBlocksRequestMessages(t *testing.T) {
 			msg2 := td.shouldPublishMessageWithThisType(t, message.TypeBlocksResponse)
 			assert.Equal(t, msg2.Message.(*message.BlocksResponseMessage).ResponseCode, message.ResponseCodeNoMoreBlocks)
 		})
+
+		t.Run("Compression is negotiated", func(t *testing.T) {
+			msg := message.NewBlocksRequestMessage(sid, 1, 1)
+			assert.NoError(t, td.receivingNewMessage(td.sync, msg, pid))
+
+			bdl := td.shouldPublishMessageWithThisType(t, message.TypeBlocksResponse)
+			assert.True(t, util.IsFlagSet(bdl.Flags, bundle.BundleFlagCompressed))
+			td.shouldPublishMessageWithThisType(t, message.TypeBlocksResponse)
+		})
+
+		t.Run("Compression is not negotiated", func(t *testing.T) {
+			td.sync.peerSet.UpdateCapabilities(pid, 1,
+				capability.New(capability.Filters), capability.New(capability.Filters))
+
+			msg := message.NewBlocksRequestMessage(sid, 1, 1)
+			assert.NoError(t, td.receivingNewMessage(td.sync, msg, pid))
+
+			bdl := td.shouldPublishMessageWithThisType(t, message.TypeBlocksResponse)
+			assert.False(t, util.IsFlagSet(bdl.Flags, bundle.BundleFlagCompressed))
+			td.shouldPublishMessageWithThisType(t, message.TypeBlocksResponse)
+		})
+	})
+}
+
+func TestFreeloaderLimit(t *testing.T) {
+	config := testConfig()
+	config.FreeloaderLimitMB = 1
+
+	td := setup(t, config)
+	sid := td.RandInt(100)
+	pid := td.RandPeerID()
+
+	td.state.CommitTestBlocks(10)
+	pub, _ := td.RandBLSKeyPair()
+	td.addPeer(t, pub, pid, service.New(service.None))
+
+	t.Run("Serve the peer under the limit", func(t *testing.T) {
+		msg := message.NewBlocksRequestMessage(sid, 1, 1)
+		assert.NoError(t, td.receivingNewMessage(td.sync, msg, pid))
+
+		bdl := td.shouldPublishMessageWithThisType(t, message.TypeBlocksResponse)
+		assert.Equal(t, message.ResponseCodeMoreBlocks, bdl.Message.(*message.BlocksResponseMessage).ResponseCode)
+		td.shouldPublishMessageWithThisType(t, message.TypeBlocksResponse)
+	})
+
+	t.Run("Reject the peer over the limit", func(t *testing.T) {
+		td.sync.peerSet.IncreaseSentCounters(message.TypeBlocksResponse, config.FreeloaderLimit(), &pid)
+
+		msg := message.NewBlocksRequestMessage(sid, 1, 1)
+		assert.NoError(t, td.receivingNewMessage(td.sync, msg, pid))
+
+		bdl := td.shouldPublishMessageWithThisType(t, message.TypeBlocksResponse)
+		assert.Equal(t, message.ResponseCodeRejected, bdl.Message.(*message.BlocksResponseMessage).ResponseCode)
+	})
+
+	t.Run("Serve the peer that serves us", func(t *testing.T) {
+		td.sync.peerSet.IncreaseReceivedBytesCounter(pid, message.TypeBlocksResponse, config.FreeloaderLimit())
+
+		msg := message.NewBlocksRequestMessage(sid, 1, 1)
+		assert.NoError(t, td.receivingNewMessage(td.sync, msg, pid))
+
+		bdl := td.shouldPublishMessageWithThisType(t, message.TypeBlocksResponse)
+		assert.Equal(t, message.ResponseCodeMoreBlocks, bdl.Message.(*message.BlocksResponseMessage).ResponseCode)
 	})
 }
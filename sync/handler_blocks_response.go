@@ -21,6 +21,12 @@ func (handler *blocksResponseHandler) ParseMessage(m message.Message, pid peer.I
 	msg := m.(*message.BlocksResponseMessage)
 	handler.logger.Trace("parsing BlocksResponse message", "msg", msg)
 
+	if handler.isReplica() && !handler.isPrimary(pid) {
+		handler.logger.Debug("ignoring blocks from a non-primary peer", "pid", pid)
+
+		return nil
+	}
+
 	if msg.IsRequestRejected() {
 		handler.logger.Warn("blocks request is rejected", "pid", pid, "reason", msg.Reason, "sid", msg.SessionID)
 	} else {
@@ -1,6 +1,8 @@
 package sync
 
 import (
+	"context"
+
 	"fmt"
 	"io"
 	"testing"
@@ -199,7 +201,7 @@ func makeAliceAndBobNetworks(t *testing.T) *networkAliceBob {
 	networkAlice.AddAnotherNetwork(networkBob)
 	networkBob.AddAnotherNetwork(networkAlice)
 
-	sync1, err := NewSynchronizer(configAlice,
+	sync1, err := NewSynchronizer(context.Background(), configAlice,
 		valKeyAlice,
 		stateAlice,
 		consMgrAlice,
@@ -209,7 +211,7 @@ func makeAliceAndBobNetworks(t *testing.T) *networkAliceBob {
 	assert.NoError(t, err)
 	syncAlice := sync1.(*synchronizer)
 
-	sync2, err := NewSynchronizer(configBob,
+	sync2, err := NewSynchronizer(context.Background(), configBob,
 		valKeyBob,
 		stateBob,
 		consMgrBob,
@@ -0,0 +1,84 @@
+package sync
+
+import (
+	"fmt"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/pactus-project/pactus/sync/bundle"
+	"github.com/pactus-project/pactus/sync/bundle/message"
+)
+
+type filtersRequestHandler struct {
+	*synchronizer
+}
+
+func newFiltersRequestHandler(sync *synchronizer) messageHandler {
+	return &filtersRequestHandler{
+		sync,
+	}
+}
+
+func (handler *filtersRequestHandler) ParseMessage(m message.Message, pid peer.ID) error {
+	msg := m.(*message.FiltersRequestMessage)
+	handler.logger.Trace("parsing FiltersRequest message", "msg", msg)
+
+	p := handler.peerSet.GetPeer(pid)
+	if p == nil {
+		response := message.NewFiltersResponseMessage(message.ResponseCodeRejected,
+			fmt.Sprintf("unknown peer (%s)", pid.String()), msg.SessionID, 0, nil)
+
+		handler.respond(response, pid)
+
+		return nil
+	}
+
+	if !p.IsKnownOrTrusty() {
+		response := message.NewFiltersResponseMessage(message.ResponseCodeRejected,
+			fmt.Sprintf("not handshaked (%s)", p.Status.String()), msg.SessionID, 0, nil)
+
+		handler.respond(response, pid)
+
+		return nil
+	}
+
+	if msg.Count > handler.config.BlockPerMessage {
+		response := message.NewFiltersResponseMessage(message.ResponseCodeRejected,
+			fmt.Sprintf("too many filters requested: %v-%v", msg.From, msg.Count), msg.SessionID, 0, nil)
+
+		handler.respond(response, pid)
+
+		return nil
+	}
+
+	// Filters are sent for the consecutive blocks, up to the first block without a filter.
+	filters := make([][]byte, 0, msg.Count)
+	for height := msg.From; height <= msg.To(); height++ {
+		filter := handler.state.CompactFilter(height)
+		if filter == nil {
+			break
+		}
+		filters = append(filters, filter.Bytes())
+	}
+
+	response := message.NewFiltersResponseMessage(message.ResponseCodeOK,
+		message.ResponseCodeOK.String(), msg.SessionID, msg.From, filters)
+
+	handler.respond(response, pid)
+
+	return nil
+}
+
+func (handler *filtersRequestHandler) PrepareBundle(m message.Message) *bundle.Bundle {
+	return bundle.NewBundle(m)
+}
+
+func (handler *filtersRequestHandler) respond(msg *message.FiltersResponseMessage, to peer.ID) {
+	if msg.ResponseCode == message.ResponseCodeRejected {
+		handler.logger.Debug("rejecting filters request message", "msg", msg,
+			"to", to, "reason", msg.Reason)
+	} else {
+		handler.logger.Debug("responding filters request message", "msg", msg, "to", to)
+	}
+
+	handler.sendTo(msg, to)
+}
@@ -0,0 +1,67 @@
+package sync
+
+import (
+	"testing"
+
+	"github.com/pactus-project/pactus/sync/bundle/message"
+	"github.com/pactus-project/pactus/sync/peerset/service"
+	"github.com/pactus-project/pactus/util/gcs"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFiltersRequestMessages(t *testing.T) {
+	td := setup(t, nil)
+	sid := td.RandInt(100)
+	pid := td.RandPeerID()
+
+	td.state.CommitTestBlocks(10)
+	for height := uint32(1); height <= 8; height++ {
+		key := td.state.BlockHash(height)
+		td.state.TestStore.Filters[height] = gcs.Build(key, [][]byte{td.RandAccAddress().Bytes()})
+	}
+
+	t.Run("Reject request from unknown peers", func(t *testing.T) {
+		msg := message.NewFiltersRequestMessage(sid, 1, 2)
+		assert.NoError(t, td.receivingNewMessage(td.sync, msg, pid))
+
+		bdl := td.shouldPublishMessageWithThisType(t, message.TypeFiltersResponse)
+		assert.Equal(t, message.ResponseCodeRejected, bdl.Message.(*message.FiltersResponseMessage).ResponseCode)
+		assert.Zero(t, bdl.Message.(*message.FiltersResponseMessage).Count())
+	})
+
+	pub, _ := td.RandBLSKeyPair()
+	td.addPeer(t, pub, pid, service.New(service.None))
+
+	t.Run("Reject requesting too many filters", func(t *testing.T) {
+		msg := message.NewFiltersRequestMessage(sid, 1, td.sync.config.BlockPerMessage+1)
+		assert.NoError(t, td.receivingNewMessage(td.sync, msg, pid))
+
+		bdl := td.shouldPublishMessageWithThisType(t, message.TypeFiltersResponse)
+		assert.Equal(t, message.ResponseCodeRejected, bdl.Message.(*message.FiltersResponseMessage).ResponseCode)
+	})
+
+	t.Run("Respond the filters", func(t *testing.T) {
+		msg := message.NewFiltersRequestMessage(sid, 2, 3)
+		assert.NoError(t, td.receivingNewMessage(td.sync, msg, pid))
+
+		bdl := td.shouldPublishMessageWithThisType(t, message.TypeFiltersResponse)
+		res := bdl.Message.(*message.FiltersResponseMessage)
+		assert.Equal(t, message.ResponseCodeOK, res.ResponseCode)
+		assert.Equal(t, uint32(2), res.From)
+		assert.Equal(t, uint32(4), res.To())
+		for i, data := range res.Filters {
+			assert.Equal(t, td.state.CompactFilter(res.From+uint32(i)).Bytes(), data)
+		}
+	})
+
+	t.Run("Stop at the first block without filter", func(t *testing.T) {
+		msg := message.NewFiltersRequestMessage(sid, 7, 4)
+		assert.NoError(t, td.receivingNewMessage(td.sync, msg, pid))
+
+		bdl := td.shouldPublishMessageWithThisType(t, message.TypeFiltersResponse)
+		res := bdl.Message.(*message.FiltersResponseMessage)
+		assert.Equal(t, message.ResponseCodeOK, res.ResponseCode)
+		assert.Equal(t, uint32(2), res.Count())
+		assert.Equal(t, uint32(8), res.To())
+	})
+}
@@ -0,0 +1,34 @@
+package sync
+
+import (
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/pactus-project/pactus/sync/bundle"
+	"github.com/pactus-project/pactus/sync/bundle/message"
+)
+
+type filtersResponseHandler struct {
+	*synchronizer
+}
+
+func newFiltersResponseHandler(sync *synchronizer) messageHandler {
+	return &filtersResponseHandler{
+		sync,
+	}
+}
+
+// ParseMessage only logs the response.
+// Compact filters are requested by light clients, full nodes don't use them.
+func (handler *filtersResponseHandler) ParseMessage(m message.Message, pid peer.ID) error {
+	msg := m.(*message.FiltersResponseMessage)
+	handler.logger.Trace("parsing FiltersResponse message", "msg", msg)
+
+	if msg.IsRequestRejected() {
+		handler.logger.Warn("filters request is rejected", "pid", pid, "reason", msg.Reason, "sid", msg.SessionID)
+	}
+
+	return nil
+}
+
+func (handler *filtersResponseHandler) PrepareBundle(m message.Message) *bundle.Bundle {
+	return bundle.NewBundle(m)
+}
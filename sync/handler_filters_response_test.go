@@ -0,0 +1,19 @@
+package sync
+
+import (
+	"testing"
+
+	"github.com/pactus-project/pactus/sync/bundle/message"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParsingFiltersResponseMessages(t *testing.T) {
+	td := setup(t, nil)
+
+	sid := td.RandInt(100)
+	pid := td.RandPeerID()
+	msg := message.NewFiltersResponseMessage(message.ResponseCodeRejected, "rejected", sid, 0, nil)
+	assert.NoError(t, td.receivingNewMessage(td.sync, msg, pid))
+
+	td.shouldNotPublishMessageWithThisType(t, message.TypeFiltersRequest)
+}
@@ -1,15 +1,20 @@
 package sync
 
 import (
+	"errors"
 	"fmt"
 	"math"
+	"strconv"
 	"time"
 
 	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/pactus-project/pactus/sync/bundle"
 	"github.com/pactus-project/pactus/sync/bundle/message"
+	"github.com/pactus-project/pactus/sync/firewall"
 	"github.com/pactus-project/pactus/sync/peerset"
+	"github.com/pactus-project/pactus/sync/peerset/capability"
 	"github.com/pactus-project/pactus/util"
+	"github.com/pactus-project/pactus/version"
 )
 
 type helloHandler struct {
@@ -36,11 +41,13 @@ func (handler *helloHandler) ParseMessage(m message.Message, pid peer.ID) error
 		msg.Agent,
 		msg.PublicKeys,
 		msg.Services)
+	handler.peerSet.UpdateAttestation(pid, msg.Attestation)
+	handler.peerSet.UpdatePausedValidators(pid, msg.PausedValidators)
 
 	if msg.PeerID != pid {
-		response := message.NewHelloAckMessage(message.ResponseCodeRejected,
+		response := message.NewHelloRejectMessage(message.RejectCodePeerIDMismatch,
 			fmt.Sprintf("peer ID is not matched, expected: %v, got: %v",
-				msg.PeerID, pid), 0)
+				msg.PeerID, pid))
 
 		handler.acknowledge(response, pid)
 
@@ -48,9 +55,9 @@ func (handler *helloHandler) ParseMessage(m message.Message, pid peer.ID) error
 	}
 
 	if msg.GenesisHash != handler.state.Genesis().Hash() {
-		response := message.NewHelloAckMessage(message.ResponseCodeRejected,
+		response := message.NewHelloRejectMessage(message.RejectCodeGenesisMismatch,
 			fmt.Sprintf("invalid genesis hash, expected: %v, got: %v",
-				handler.state.Genesis().Hash(), msg.GenesisHash), 0)
+				handler.state.Genesis().Hash(), msg.GenesisHash))
 
 		handler.acknowledge(response, pid)
 
@@ -58,14 +65,30 @@ func (handler *helloHandler) ParseMessage(m message.Message, pid peer.ID) error
 	}
 
 	if math.Abs(time.Since(msg.MyTime()).Seconds()) > 10 {
-		response := message.NewHelloAckMessage(message.ResponseCodeRejected,
-			"time discrepancy exceeds 10 seconds", 0)
+		response := message.NewHelloRejectMessage(message.RejectCodeTimeDiscrepancy,
+			"time discrepancy exceeds 10 seconds")
 
 		handler.acknowledge(response, pid)
 
 		return nil
 	}
 
+	// The peers that don't advertise the protocol version speak the first version.
+	peerVersion := util.Max(msg.ProtocolVersion, 1)
+	if minVersion := handler.minProtocolVersion(); peerVersion < minVersion {
+		rejectedVersionsCounter.WithLabelValues(strconv.FormatUint(uint64(peerVersion), 10)).Inc()
+
+		response := message.NewHelloRejectMessage(message.RejectCodeProtocolTooOld,
+			fmt.Sprintf("protocol version %d is not supported anymore, the minimum is %d, please upgrade",
+				peerVersion, minVersion))
+		response.MinProtocolVersion = minVersion
+
+		handler.acknowledge(response, pid)
+
+		return nil
+	}
+
+	handler.negotiate(pid, msg)
 	handler.peerSet.UpdateHeight(pid, msg.Height, msg.BlockHash)
 	handler.peerSet.UpdateStatus(pid, peerset.StatusCodeKnown)
 
@@ -79,6 +102,27 @@ func (handler *helloHandler) ParseMessage(m message.Message, pid peer.ID) error
 	return nil
 }
 
+// negotiate finds the protocol version and the capabilities that both sides support.
+// The peers that don't advertise the protocol version have the legacy capabilities.
+func (handler *helloHandler) negotiate(pid peer.ID, msg *message.HelloMessage) {
+	protocolVersion := msg.ProtocolVersion
+	capabilities := msg.Capabilities
+	if protocolVersion == 0 {
+		protocolVersion = 1
+		capabilities = capability.Legacy
+	}
+	protocolVersion = util.Min(protocolVersion, version.ProtocolVersion())
+	negotiated := handler.config.Capabilities().Negotiate(capabilities)
+
+	handler.logger.Debug("capabilities negotiated",
+		"pid", pid,
+		"protocol_version", protocolVersion,
+		"capabilities", capabilities,
+		"negotiated", negotiated)
+
+	handler.peerSet.UpdateCapabilities(pid, protocolVersion, capabilities, negotiated)
+}
+
 func (handler *helloHandler) PrepareBundle(m message.Message) *bundle.Bundle {
 	bdl := bundle.NewBundle(m)
 	bdl.Flags = util.SetFlag(bdl.Flags, bundle.BundleFlagHandshaking)
@@ -93,6 +137,7 @@ func (handler *helloHandler) acknowledge(msg *message.HelloAckMessage, to peer.I
 
 		handler.sendTo(msg, to)
 		handler.peerSet.UpdateStatus(to, peerset.StatusCodeBanned)
+		handler.firewall.RecordEvidence(to, firewall.EvidenceHelloRejected, errors.New(msg.Reason), nil)
 		handler.network.CloseConnection(to)
 	} else {
 		handler.logger.Info("acknowledging hello message", "msg", msg,
@@ -6,6 +6,7 @@ import (
 	"github.com/pactus-project/pactus/sync/bundle/message"
 	"github.com/pactus-project/pactus/sync/peerset"
 	"github.com/pactus-project/pactus/util"
+	"github.com/pactus-project/pactus/version"
 )
 
 type helloAckHandler struct {
@@ -23,8 +24,13 @@ func (handler *helloAckHandler) ParseMessage(m message.Message, pid peer.ID) err
 	handler.logger.Trace("parsing HelloAck message", "msg", msg)
 
 	if msg.ResponseCode != message.ResponseCodeOK {
-		handler.logger.Warn("hello message rejected",
-			"from", pid, "reason", msg.Reason)
+		if msg.RejectCode == message.RejectCodeProtocolTooOld {
+			handler.logger.Error("the peer doesn't support our protocol version anymore, please upgrade the node",
+				"from", pid, "ours", version.ProtocolVersion(), "min", msg.MinProtocolVersion)
+		} else {
+			handler.logger.Warn("hello message rejected",
+				"from", pid, "code", msg.RejectCode, "reason", msg.Reason)
+		}
 
 		handler.network.CloseConnection(pid)
 
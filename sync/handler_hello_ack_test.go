@@ -19,6 +19,16 @@ func TestParsingHelloAckMessages(t *testing.T) {
 			assert.NoError(t, td.receivingNewMessage(td.sync, msg, pid))
 		})
 
+	t.Run("Receiving HelloAck message: Rejected for the protocol version",
+		func(t *testing.T) {
+			pid := td.RandPeerID()
+			msg := message.NewHelloRejectMessage(message.RejectCodeProtocolTooOld, "too old")
+			msg.MinProtocolVersion = 2
+
+			assert.NoError(t, td.receivingNewMessage(td.sync, msg, pid))
+			assert.True(t, td.network.IsClosed(pid))
+		})
+
 	t.Run("Receiving HelloAck message: OK hello",
 		func(t *testing.T) {
 			pid := td.RandPeerID()
@@ -4,14 +4,19 @@ import (
 	"testing"
 	"time"
 
+	"github.com/pactus-project/pactus/crypto"
 	"github.com/pactus-project/pactus/crypto/bls"
 	"github.com/pactus-project/pactus/sync/bundle"
 	"github.com/pactus-project/pactus/sync/bundle/message"
+	"github.com/pactus-project/pactus/sync/firewall"
 	"github.com/pactus-project/pactus/sync/peerset"
+	"github.com/pactus-project/pactus/sync/peerset/capability"
 	"github.com/pactus-project/pactus/sync/peerset/service"
 	"github.com/pactus-project/pactus/util"
 	"github.com/pactus-project/pactus/version"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestParsingHelloMessages(t *testing.T) {
@@ -23,7 +28,7 @@ func TestParsingHelloMessages(t *testing.T) {
 		func(t *testing.T) {
 			valKey := td.RandValKey()
 			pid := td.RandPeerID()
-			msg := message.NewHelloMessage(pid, "unknown-peer", 0, 0,
+			msg := message.NewHelloMessage(pid, "unknown-peer", 0, 0, 0,
 				td.state.LastBlockHash(), td.state.Genesis().Hash())
 			msg.Sign([]*bls.ValidatorKey{valKey})
 
@@ -38,7 +43,7 @@ func TestParsingHelloMessages(t *testing.T) {
 			invGenHash := td.RandHash()
 			valKey := td.RandValKey()
 			pid := td.RandPeerID()
-			msg := message.NewHelloMessage(pid, "bad-genesis", 0, 0,
+			msg := message.NewHelloMessage(pid, "bad-genesis", 0, 0, 0,
 				td.state.LastBlockHash(), invGenHash)
 			msg.Sign([]*bls.ValidatorKey{valKey})
 
@@ -46,6 +51,11 @@ func TestParsingHelloMessages(t *testing.T) {
 			td.checkPeerStatus(t, pid, peerset.StatusCodeBanned)
 			bdl := td.shouldPublishMessageWithThisType(t, message.TypeHelloAck)
 			assert.Equal(t, bdl.Message.(*message.HelloAckMessage).ResponseCode, message.ResponseCodeRejected)
+
+			evidences := td.sync.PeerEvidences(pid)
+			require.Len(t, evidences, 1)
+			assert.Equal(t, firewall.EvidenceHelloRejected, evidences[0].Reason)
+			assert.Contains(t, evidences[0].Error, "invalid genesis hash")
 		})
 
 	t.Run("Receiving a Hello message from a peer. The time difference is greater than or equal to -10",
@@ -54,7 +64,7 @@ func TestParsingHelloMessages(t *testing.T) {
 			height := td.RandUint32NonZero(td.state.LastBlockHeight())
 			pid := td.RandPeerID()
 			msg := message.NewHelloMessage(pid, "kitty", height, service.New(service.Network),
-				td.state.LastBlockHash(), td.state.Genesis().Hash())
+				td.sync.Capabilities(), td.state.LastBlockHash(), td.state.Genesis().Hash())
 			msg.Sign([]*bls.ValidatorKey{valKey})
 
 			msg.MyTimeUnixMilli = msg.MyTime().Add(-10 * time.Second).UnixMilli()
@@ -70,7 +80,7 @@ func TestParsingHelloMessages(t *testing.T) {
 			height := td.RandUint32NonZero(td.state.LastBlockHeight())
 			pid := td.RandPeerID()
 			msg := message.NewHelloMessage(pid, "kitty", height, service.New(service.Network),
-				td.state.LastBlockHash(), td.state.Genesis().Hash())
+				td.sync.Capabilities(), td.state.LastBlockHash(), td.state.Genesis().Hash())
 			msg.Sign([]*bls.ValidatorKey{valKey})
 
 			msg.MyTimeUnixMilli = msg.MyTime().Add(20 * time.Second).UnixMilli()
@@ -86,7 +96,7 @@ func TestParsingHelloMessages(t *testing.T) {
 			height := td.RandUint32NonZero(td.state.LastBlockHeight())
 			pid := td.RandPeerID()
 			msg := message.NewHelloMessage(pid, "kitty", height, service.New(service.Network),
-				td.state.LastBlockHash(), td.state.Genesis().Hash())
+				td.sync.Capabilities(), td.state.LastBlockHash(), td.state.Genesis().Hash())
 			msg.Sign([]*bls.ValidatorKey{valKey})
 
 			assert.NoError(t, td.receivingNewMessage(td.sync, msg, pid))
@@ -105,6 +115,123 @@ func TestParsingHelloMessages(t *testing.T) {
 			assert.Equal(t, p.PeerID, pid)
 			assert.Equal(t, p.Height, height)
 			assert.True(t, p.HasNetworkService())
+			assert.Equal(t, version.ProtocolVersion(), p.ProtocolVersion)
+			assert.Equal(t, td.sync.Capabilities(), p.Negotiated)
+		})
+
+	t.Run("Receiving Hello message from a peer with attestation. It should keep the attestation",
+		func(t *testing.T) {
+			valKey := td.RandValKey()
+			pid := td.RandPeerID()
+			attestation := td.RandBytes(256)
+			msg := message.NewHelloMessage(pid, "kitty", 0, service.New(service.Network),
+				td.sync.Capabilities(), td.state.LastBlockHash(), td.state.Genesis().Hash())
+			msg.Attestation = attestation
+			msg.Sign([]*bls.ValidatorKey{valKey})
+
+			assert.NoError(t, td.receivingNewMessage(td.sync, msg, pid))
+
+			p := td.sync.peerSet.GetPeer(pid)
+			assert.Equal(t, attestation, p.Attestation)
+		})
+
+	t.Run("Receiving Hello message from a peer with paused validators. It should keep the hint",
+		func(t *testing.T) {
+			valKey := td.RandValKey()
+			pid := td.RandPeerID()
+			msg := message.NewHelloMessage(pid, "kitty", 0, service.New(service.Network),
+				td.sync.Capabilities(), td.state.LastBlockHash(), td.state.Genesis().Hash())
+			msg.PausedValidators = []crypto.Address{valKey.Address()}
+			msg.Sign([]*bls.ValidatorKey{valKey})
+
+			assert.NoError(t, td.receivingNewMessage(td.sync, msg, pid))
+
+			p := td.sync.peerSet.GetPeer(pid)
+			assert.Equal(t, []crypto.Address{valKey.Address()}, p.PausedValidators)
+		})
+
+	t.Run("Receiving Hello message from a peer with different capabilities. It should negotiate the capabilities",
+		func(t *testing.T) {
+			valKey := td.RandValKey()
+			height := td.RandUint32NonZero(td.state.LastBlockHeight())
+			pid := td.RandPeerID()
+			caps := capability.New(capability.Filters, capability.StateSync)
+			msg := message.NewHelloMessage(pid, "kitty", height, service.New(service.Network),
+				caps, td.state.LastBlockHash(), td.state.Genesis().Hash())
+			msg.ProtocolVersion = version.ProtocolVersion() + 1
+			msg.Sign([]*bls.ValidatorKey{valKey})
+
+			assert.NoError(t, td.receivingNewMessage(td.sync, msg, pid))
+
+			bdl := td.shouldPublishMessageWithThisType(t, message.TypeHelloAck)
+			assert.Equal(t, bdl.Message.(*message.HelloAckMessage).ResponseCode, message.ResponseCodeOK)
+
+			p := td.sync.peerSet.GetPeer(pid)
+			assert.Equal(t, version.ProtocolVersion(), p.ProtocolVersion)
+			assert.Equal(t, caps, p.Capabilities)
+			assert.Equal(t, capability.New(capability.Filters), p.Negotiated)
+			assert.False(t, p.HasCapability(capability.Compression))
+		})
+
+	t.Run("Receiving Hello message from a legacy peer. It should have the legacy capabilities",
+		func(t *testing.T) {
+			valKey := td.RandValKey()
+			height := td.RandUint32NonZero(td.state.LastBlockHeight())
+			pid := td.RandPeerID()
+			msg := message.NewHelloMessage(pid, "kitty", height, service.New(service.Network),
+				0, td.state.LastBlockHash(), td.state.Genesis().Hash())
+			msg.ProtocolVersion = 0
+			msg.Sign([]*bls.ValidatorKey{valKey})
+
+			assert.NoError(t, td.receivingNewMessage(td.sync, msg, pid))
+
+			p := td.sync.peerSet.GetPeer(pid)
+			assert.Equal(t, uint32(1), p.ProtocolVersion)
+			assert.Equal(t, capability.Legacy, p.Capabilities)
+			assert.Equal(t, capability.Legacy, p.Negotiated)
+		})
+}
+
+func TestRejectingOldProtocolVersions(t *testing.T) {
+	td := setup(t, nil)
+
+	t.Run("Receiving Hello message from a peer with an old protocol version. It should be rejected",
+		func(t *testing.T) {
+			// The node's protocol version is the first one, so the minimum is set above it.
+			td.sync.config.MinProtocolVersion = version.ProtocolVersion() + 1
+			defer func() { td.sync.config.MinProtocolVersion = 0 }()
+
+			valKey := td.RandValKey()
+			pid := td.RandPeerID()
+			msg := message.NewHelloMessage(pid, "kitty", 0, service.New(service.Network),
+				0, td.state.LastBlockHash(), td.state.Genesis().Hash())
+			msg.ProtocolVersion = 0
+			msg.Sign([]*bls.ValidatorKey{valKey})
+
+			rejectedBefore := testutil.ToFloat64(rejectedVersionsCounter.WithLabelValues("1"))
+			assert.NoError(t, td.receivingNewMessage(td.sync, msg, pid))
+
+			bdl := td.shouldPublishMessageWithThisType(t, message.TypeHelloAck)
+			ack := bdl.Message.(*message.HelloAckMessage)
+			assert.Equal(t, message.ResponseCodeRejected, ack.ResponseCode)
+			assert.Equal(t, message.RejectCodeProtocolTooOld, ack.RejectCode)
+			assert.Equal(t, version.ProtocolVersion()+1, ack.MinProtocolVersion)
+			assert.Equal(t, rejectedBefore+1, testutil.ToFloat64(rejectedVersionsCounter.WithLabelValues("1")))
+			td.checkPeerStatus(t, pid, peerset.StatusCodeBanned)
+		})
+
+	t.Run("The minimum protocol version of the genesis is applied, if it is higher",
+		func(t *testing.T) {
+			params := td.state.Genesis().Params()
+			defer func() { params.MinProtocolVersion = 0 }()
+
+			assert.Zero(t, td.sync.minProtocolVersion())
+
+			params.MinProtocolVersion = 3
+			assert.Equal(t, uint32(3), td.sync.minProtocolVersion())
+
+			td.sync.config.MinProtocolVersion = 1
+			assert.Equal(t, uint32(3), td.sync.minProtocolVersion())
 		})
 }
 
@@ -117,4 +244,6 @@ func TestSendingHelloMessage(t *testing.T) {
 	bdl := td.shouldPublishMessageWithThisType(t, message.TypeHello)
 	assert.True(t, util.IsFlagSet(bdl.Flags, bundle.BundleFlagHandshaking))
 	assert.True(t, util.IsFlagSet(bdl.Message.(*message.HelloMessage).Services, service.New(service.Network)))
+	assert.Equal(t, td.sync.Capabilities(), bdl.Message.(*message.HelloMessage).Capabilities)
+	assert.Equal(t, version.ProtocolVersion(), bdl.Message.(*message.HelloMessage).ProtocolVersion)
 }
@@ -0,0 +1,40 @@
+package sync
+
+import (
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/pactus-project/pactus/sync/bundle"
+	"github.com/pactus-project/pactus/sync/bundle/message"
+)
+
+type pingHandler struct {
+	*synchronizer
+}
+
+func newPingHandler(sync *synchronizer) messageHandler {
+	return &pingHandler{
+		sync,
+	}
+}
+
+func (handler *pingHandler) ParseMessage(m message.Message, pid peer.ID) error {
+	msg := m.(*message.PingMessage)
+	handler.logger.Trace("parsing Ping message", "msg", msg)
+
+	p := handler.peerSet.GetPeer(pid)
+	if p == nil || !p.IsKnownOrTrusty() {
+		handler.logger.Debug("ignoring ping from not handshaked peer", "pid", pid)
+
+		return nil
+	}
+
+	response := message.NewPongMessage(msg.Nonce, time.Now().UnixNano())
+	handler.sendTo(response, pid)
+
+	return nil
+}
+
+func (handler *pingHandler) PrepareBundle(m message.Message) *bundle.Bundle {
+	return bundle.NewBundle(m)
+}
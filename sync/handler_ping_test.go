@@ -0,0 +1,57 @@
+package sync
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pactus-project/pactus/sync/bundle/message"
+	"github.com/pactus-project/pactus/sync/peerset/capability"
+	"github.com/pactus-project/pactus/sync/peerset/service"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParsingPingMessages(t *testing.T) {
+	td := setup(t, nil)
+	pid := td.RandPeerID()
+
+	t.Run("Ignore ping from unknown peers", func(t *testing.T) {
+		msg := message.NewPingMessage(1, time.Now().UnixNano())
+		assert.NoError(t, td.receivingNewMessage(td.sync, msg, pid))
+
+		td.shouldNotPublishMessageWithThisType(t, message.TypePong)
+	})
+
+	pub, _ := td.RandBLSKeyPair()
+	td.addPeer(t, pub, pid, service.New(service.None))
+
+	t.Run("Respond with pong", func(t *testing.T) {
+		msg := message.NewPingMessage(2, time.Now().UnixNano())
+		assert.NoError(t, td.receivingNewMessage(td.sync, msg, pid))
+
+		bdl := td.shouldPublishMessageWithThisType(t, message.TypePong)
+		assert.Equal(t, uint64(2), bdl.Message.(*message.PongMessage).Nonce)
+	})
+}
+
+func TestSendPings(t *testing.T) {
+	td := setup(t, nil)
+
+	pid1 := td.RandPeerID()
+	pid2 := td.RandPeerID()
+	pub1, _ := td.RandBLSKeyPair()
+	pub2, _ := td.RandBLSKeyPair()
+	td.addPeer(t, pub1, pid1, service.New(service.None))
+	td.addPeer(t, pub2, pid2, service.New(service.None))
+	td.sync.peerSet.UpdateCapabilities(pid1, 1,
+		capability.New(capability.Ping), capability.New(capability.Ping))
+
+	now := time.Now()
+	td.sync.sendPings(now)
+
+	bdl := td.shouldPublishMessageWithThisType(t, message.TypePing)
+	ping := bdl.Message.(*message.PingMessage)
+	assert.Equal(t, now.UnixNano(), ping.Time)
+	assert.Equal(t, ping.Nonce, td.sync.peerSet.GetPeer(pid1).PingNonce)
+	assert.Zero(t, td.sync.peerSet.GetPeer(pid2).PingNonce, "peer doesn't support ping")
+	td.shouldNotPublishMessageWithThisType(t, message.TypePing)
+}
@@ -0,0 +1,40 @@
+package sync
+
+import (
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/pactus-project/pactus/sync/bundle"
+	"github.com/pactus-project/pactus/sync/bundle/message"
+)
+
+type pongHandler struct {
+	*synchronizer
+}
+
+func newPongHandler(sync *synchronizer) messageHandler {
+	return &pongHandler{
+		sync,
+	}
+}
+
+func (handler *pongHandler) ParseMessage(m message.Message, pid peer.ID) error {
+	msg := m.(*message.PongMessage)
+	handler.logger.Trace("parsing Pong message", "msg", msg)
+
+	if !handler.peerSet.UpdateLatency(pid, msg.Nonce, time.Unix(0, msg.Time), time.Now()) {
+		handler.logger.Debug("unexpected pong message", "msg", msg, "pid", pid)
+
+		return nil
+	}
+
+	p := handler.peerSet.GetPeer(pid)
+	handler.logger.Debug("peer latency updated",
+		"pid", pid, "rtt", p.RTT, "clockOffset", p.ClockOffset)
+
+	return nil
+}
+
+func (handler *pongHandler) PrepareBundle(m message.Message) *bundle.Bundle {
+	return bundle.NewBundle(m)
+}
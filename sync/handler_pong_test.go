@@ -0,0 +1,36 @@
+package sync
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pactus-project/pactus/sync/bundle/message"
+	"github.com/pactus-project/pactus/sync/peerset/service"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParsingPongMessages(t *testing.T) {
+	td := setup(t, nil)
+	pid := td.RandPeerID()
+	pub, _ := td.RandBLSKeyPair()
+	td.addPeer(t, pub, pid, service.New(service.None))
+
+	t.Run("Ignore unexpected pong", func(t *testing.T) {
+		msg := message.NewPongMessage(1, time.Now().UnixNano())
+		assert.NoError(t, td.receivingNewMessage(td.sync, msg, pid))
+
+		assert.Zero(t, td.sync.peerSet.GetPeer(pid).RTT)
+	})
+
+	t.Run("Update the latency", func(t *testing.T) {
+		sentAt := time.Now().Add(-100 * time.Millisecond)
+		td.sync.peerSet.UpdatePingSent(pid, 2, sentAt)
+
+		msg := message.NewPongMessage(2, time.Now().UnixNano())
+		assert.NoError(t, td.receivingNewMessage(td.sync, msg, pid))
+
+		p := td.sync.peerSet.GetPeer(pid)
+		assert.GreaterOrEqual(t, p.RTT, 100*time.Millisecond)
+		assert.Zero(t, p.PingNonce)
+	})
+}
@@ -1,6 +1,8 @@
 package sync
 
 import (
+	"time"
+
 	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/pactus-project/pactus/sync/bundle"
 	"github.com/pactus-project/pactus/sync/bundle/message"
@@ -16,10 +18,13 @@ func newProposalHandler(sync *synchronizer) messageHandler {
 	}
 }
 
-func (handler *proposalHandler) ParseMessage(m message.Message, _ peer.ID) error {
+func (handler *proposalHandler) ParseMessage(m message.Message, pid peer.ID) error {
 	msg := m.(*message.ProposalMessage)
 	handler.logger.Trace("parsing Proposal message", "msg", msg)
 
+	handler.propagation.record(PropagationProposal, msg.Proposal.Height(), msg.Proposal.Round(),
+		msg.Proposal.Block(), pid, time.Now())
+
 	handler.consMgr.SetProposal(msg.Proposal)
 
 	return nil
@@ -4,6 +4,7 @@ import (
 	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/pactus-project/pactus/sync/bundle"
 	"github.com/pactus-project/pactus/sync/bundle/message"
+	"github.com/pactus-project/pactus/types/tx"
 )
 
 type transactionsHandler struct {
@@ -16,14 +17,35 @@ func newTransactionsHandler(sync *synchronizer) messageHandler {
 	}
 }
 
-func (handler *transactionsHandler) ParseMessage(m message.Message, _ peer.ID) error {
+func (handler *transactionsHandler) ParseMessage(m message.Message, pid peer.ID) error {
 	msg := m.(*message.TransactionsMessage)
 	handler.logger.Trace("parsing Transactions message", "msg", msg)
 
+	stemmed := make([]*tx.Tx, 0)
 	for _, trx := range msg.Transactions {
+		if msg.Stem {
+			if handler.state.PendingTx(trx.ID()) != nil {
+				// We have seen this transaction before, it might be a loop in the stem path.
+				continue
+			}
+		} else {
+			// The transaction is broadcasted, no need to keep the embargo.
+			handler.relay.Lift(trx.ID())
+		}
+
 		if err := handler.state.AddPendingTx(trx); err != nil {
 			handler.logger.Debug("cannot append transaction", "tx", trx, "error", err)
+
+			continue
 		}
+
+		if msg.Stem {
+			stemmed = append(stemmed, trx)
+		}
+	}
+
+	if len(stemmed) > 0 {
+		handler.relayStemTransactions(stemmed, pid)
 	}
 
 	return nil
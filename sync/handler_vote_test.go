@@ -3,8 +3,13 @@ package sync
 import (
 	"testing"
 
+	"github.com/pactus-project/pactus/network"
+	"github.com/pactus-project/pactus/sync/bundle"
 	"github.com/pactus-project/pactus/sync/bundle/message"
+	"github.com/pactus-project/pactus/sync/relay"
+	"github.com/pactus-project/pactus/types/vote"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestParsingVoteMessages(t *testing.T) {
@@ -19,3 +24,55 @@ func TestParsingVoteMessages(t *testing.T) {
 		assert.Equal(t, td.consMgr.PickRandomVote(0).Hash(), v.Hash())
 	})
 }
+
+func TestVoteRelayPriority(t *testing.T) {
+	td := setup(t, nil)
+
+	// The bucket holds 10 votes, and it is under the pressure when less than 5 votes are left.
+	td.sync.voteLimiter = relay.NewVoteLimiter(5, 0)
+
+	height := td.state.LastBlockHeight() + 1
+	committeeKey := td.state.TestValKeys[0]
+	committeeVote := vote.NewPrecommitVote(td.RandHash(), height, 0, committeeKey.Address())
+	td.HelperSignVote(committeeKey, committeeVote)
+	otherVote, _ := td.GenerateTestPrecommitVote(height, 0)
+
+	gossipMessage := func(msg message.Message, fromSelf bool) *network.GossipMessage {
+		data, err := bundle.NewBundle(msg).Encode()
+		require.NoError(t, err)
+
+		pid := td.RandPeerID()
+		if fromSelf {
+			pid = td.sync.SelfID()
+		}
+
+		return &network.GossipMessage{From: pid, Data: data}
+	}
+
+	t.Run("Committee votes have higher priority", func(t *testing.T) {
+		assert.GreaterOrEqual(t, td.sync.votePriority(committeeVote), 0.5)
+		assert.Less(t, td.sync.votePriority(otherVote), 0.5)
+	})
+
+	t.Run("Relaying the votes under the pressure", func(t *testing.T) {
+		for i := 0; i < 5; i++ {
+			assert.True(t, td.sync.shouldPropagateConsensusMessage(
+				gossipMessage(message.NewVoteMessage(otherVote), false)))
+		}
+		assert.False(t, td.sync.shouldPropagateConsensusMessage(
+			gossipMessage(message.NewVoteMessage(otherVote), false)))
+
+		assert.True(t, td.sync.shouldPropagateConsensusMessage(
+			gossipMessage(message.NewVoteMessage(committeeVote), false)))
+	})
+
+	t.Run("Our own votes are always published", func(t *testing.T) {
+		assert.True(t, td.sync.shouldPropagateConsensusMessage(
+			gossipMessage(message.NewVoteMessage(otherVote), true)))
+	})
+
+	t.Run("Other consensus messages are not limited", func(t *testing.T) {
+		assert.True(t, td.sync.shouldPropagateConsensusMessage(
+			gossipMessage(message.NewQueryVotesMessage(height, 0, td.RandValAddress()), false)))
+	})
+}
@@ -2,15 +2,24 @@ package sync
 
 import (
 	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/pactus-project/pactus/sync/firewall"
 	"github.com/pactus-project/pactus/sync/peerset"
+	"github.com/pactus-project/pactus/sync/peerset/capability"
 	"github.com/pactus-project/pactus/sync/peerset/service"
 )
 
 type Synchronizer interface {
 	Start() error
 	Stop()
+	SaveCheckpoint(path string) error
+	LoadCheckpoint(path string) error
 	Moniker() string
 	SelfID() peer.ID
 	PeerSet() *peerset.PeerSet
 	Services() service.Services
+	Capabilities() capability.Capabilities
+	Attestation() []byte
+	PropagationRecords() []PropagationRecord
+	PeerEvidences(pid peer.ID) []firewall.Evidence
+	Primaries() []peer.ID
 }
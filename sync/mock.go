@@ -5,7 +5,9 @@ import (
 
 	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/pactus-project/pactus/crypto/bls"
+	"github.com/pactus-project/pactus/sync/firewall"
 	"github.com/pactus-project/pactus/sync/peerset"
+	"github.com/pactus-project/pactus/sync/peerset/capability"
 	"github.com/pactus-project/pactus/sync/peerset/service"
 	"github.com/pactus-project/pactus/util/testsuite"
 	"github.com/pactus-project/pactus/version"
@@ -14,9 +16,14 @@ import (
 var _ Synchronizer = &MockSync{}
 
 type MockSync struct {
-	TestID       peer.ID
-	TestPeerSet  *peerset.PeerSet
-	TestServices service.Services
+	TestID           peer.ID
+	TestPeerSet      *peerset.PeerSet
+	TestServices     service.Services
+	TestCapabilities capability.Capabilities
+	TestPropagation  []PropagationRecord
+	TestEvidences    []firewall.Evidence
+	TestAttestation  []byte
+	TestPrimaries    []peer.ID
 }
 
 func MockingSync(ts *testsuite.TestSuite) *MockSync {
@@ -32,6 +39,9 @@ func MockingSync(ts *testsuite.TestSuite) *MockSync {
 		[]*bls.PublicKey{pub1},
 		service.New(service.Network))
 	ps.UpdateHeight(pid1, ts.RandHeight(), ts.RandHash())
+	sentAt := time.Now()
+	ps.UpdatePingSent(pid1, 1, sentAt)
+	ps.UpdateLatency(pid1, 1, sentAt.Add(60*time.Millisecond), sentAt.Add(100*time.Millisecond))
 
 	ps.UpdateInfo(
 		pid2,
@@ -44,9 +54,10 @@ func MockingSync(ts *testsuite.TestSuite) *MockSync {
 	services := service.New()
 
 	return &MockSync{
-		TestID:       ts.RandPeerID(),
-		TestPeerSet:  ps,
-		TestServices: services,
+		TestID:           ts.RandPeerID(),
+		TestPeerSet:      ps,
+		TestServices:     services,
+		TestCapabilities: capability.New(capability.Compression),
 	}
 }
 
@@ -57,6 +68,14 @@ func (m *MockSync) Start() error {
 func (m *MockSync) Stop() {
 }
 
+func (m *MockSync) SaveCheckpoint(_ string) error {
+	return nil
+}
+
+func (m *MockSync) LoadCheckpoint(_ string) error {
+	return nil
+}
+
 func (m *MockSync) SelfID() peer.ID {
 	return m.TestID
 }
@@ -72,3 +91,30 @@ func (m *MockSync) PeerSet() *peerset.PeerSet {
 func (m *MockSync) Services() service.Services {
 	return m.TestServices
 }
+
+func (m *MockSync) Capabilities() capability.Capabilities {
+	return m.TestCapabilities
+}
+
+func (m *MockSync) Attestation() []byte {
+	return m.TestAttestation
+}
+
+func (m *MockSync) PropagationRecords() []PropagationRecord {
+	return m.TestPropagation
+}
+
+func (m *MockSync) PeerEvidences(pid peer.ID) []firewall.Evidence {
+	evidences := make([]firewall.Evidence, 0)
+	for _, evidence := range m.TestEvidences {
+		if pid == "" || evidence.PeerID == pid {
+			evidences = append(evidences, evidence)
+		}
+	}
+
+	return evidences
+}
+
+func (m *MockSync) Primaries() []peer.ID {
+	return m.TestPrimaries
+}
@@ -0,0 +1,100 @@
+// Package capability defines the optional wire features that the peers
+// advertise in the handshake. A feature is only used with a peer,
+// if both sides support it, so the new features can be rolled out gradually.
+// The serving capabilities, like Snapshots and Archive, only announce the data
+// that the peer serves, and they are checked on the advertised capabilities.
+package capability
+
+import (
+	"fmt"
+
+	"github.com/pactus-project/pactus/util"
+)
+
+type (
+	Capabilities int
+	Capability   int
+)
+
+const (
+	None          Capability = 0x00
+	Compression   Capability = 0x01
+	CompactBlocks Capability = 0x02
+	StateSync     Capability = 0x04
+	Filters       Capability = 0x08
+	Ping          Capability = 0x10
+	StemRelay     Capability = 0x20
+	Snapshots     Capability = 0x40 // The peer serves the state snapshots
+	Archive       Capability = 0x80 // The peer serves the whole history of the blocks
+)
+
+// Legacy is the set of capabilities that is assumed for the peers
+// that don't advertise their capabilities in the handshake.
+var Legacy = New(Compression)
+
+var names = []struct {
+	capability Capability
+	name       string
+}{
+	{Compression, "COMPRESSION"},
+	{CompactBlocks, "COMPACT_BLOCKS"},
+	{StateSync, "STATE_SYNC"},
+	{Filters, "FILTERS"},
+	{Ping, "PING"},
+	{StemRelay, "STEM_RELAY"},
+	{Snapshots, "SNAPSHOTS"},
+	{Archive, "ARCHIVE"},
+}
+
+func New(flags ...Capability) Capabilities {
+	c := None
+	for _, f := range flags {
+		c = util.SetFlag(c, f)
+	}
+
+	return Capabilities(c)
+}
+
+func (c *Capabilities) Append(flag Capability) {
+	*c = util.SetFlag(*c, Capabilities(flag))
+}
+
+// Has checks if the capability is in the set.
+func (c Capabilities) Has(flag Capability) bool {
+	return util.IsFlagSet(c, Capabilities(flag))
+}
+
+// Negotiate returns the capabilities that are supported by both sides.
+func (c Capabilities) Negotiate(other Capabilities) Capabilities {
+	return c & other
+}
+
+// Names returns the names of the known capabilities in the set.
+func (c Capabilities) Names() []string {
+	result := make([]string, 0)
+	for _, n := range names {
+		if c.Has(n.capability) {
+			result = append(result, n.name)
+		}
+	}
+
+	return result
+}
+
+func (c Capabilities) String() string {
+	capabilities := ""
+	for _, n := range names {
+		if c.Has(n.capability) {
+			capabilities += n.name + " | "
+			c = util.UnsetFlag(c, Capabilities(n.capability))
+		}
+	}
+
+	if c != 0 {
+		capabilities += fmt.Sprintf("%d", c)
+	} else if len(capabilities) > 0 {
+		capabilities = capabilities[:len(capabilities)-3]
+	}
+
+	return capabilities
+}
@@ -0,0 +1,41 @@
+package capability
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCapabilitiesString(t *testing.T) {
+	assert.Equal(t, "", New(None).String())
+	assert.Equal(t, "COMPRESSION", New(Compression).String())
+	assert.Equal(t, "COMPRESSION | FILTERS", New(Filters, Compression).String())
+	assert.Equal(t, "COMPRESSION | COMPACT_BLOCKS | STATE_SYNC | FILTERS",
+		New(Compression, CompactBlocks, StateSync, Filters).String())
+	assert.Equal(t, "SNAPSHOTS | ARCHIVE", New(Archive, Snapshots).String())
+	assert.Equal(t, "STATE_SYNC | 256", New(StateSync, 256).String())
+}
+
+func TestAppend(t *testing.T) {
+	c := New(Compression)
+	assert.True(t, c.Has(Compression))
+	assert.False(t, c.Has(Filters))
+
+	c.Append(Filters)
+	assert.True(t, c.Has(Compression))
+	assert.True(t, c.Has(Filters))
+}
+
+func TestNegotiate(t *testing.T) {
+	local := New(Compression, Filters)
+
+	assert.Equal(t, New(Compression, Filters), local.Negotiate(New(Compression, Filters, StateSync)))
+	assert.Equal(t, New(Filters), local.Negotiate(New(Filters, CompactBlocks)))
+	assert.Equal(t, New(Compression), local.Negotiate(Legacy))
+	assert.Equal(t, New(None), local.Negotiate(New(None)))
+}
+
+func TestNames(t *testing.T) {
+	assert.Empty(t, New(None).Names())
+	assert.Equal(t, []string{"COMPRESSION", "STATE_SYNC"}, New(StateSync, Compression, 256).Names())
+}
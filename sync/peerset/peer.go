@@ -4,12 +4,17 @@ import (
 	"time"
 
 	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/pactus-project/pactus/crypto"
 	"github.com/pactus-project/pactus/crypto/bls"
 	"github.com/pactus-project/pactus/crypto/hash"
 	"github.com/pactus-project/pactus/sync/bundle/message"
+	"github.com/pactus-project/pactus/sync/peerset/capability"
 	"github.com/pactus-project/pactus/sync/peerset/service"
 )
 
+// servingTypes are the message types that carry the data that the peers serve to each other.
+var servingTypes = []message.Type{message.TypeBlocksResponse, message.TypeFiltersResponse}
+
 type Peer struct {
 	Status            StatusCode
 	Moniker           string
@@ -20,6 +25,11 @@ type Peer struct {
 	PeerID            peer.ID
 	ConsensusKeys     []*bls.PublicKey
 	Services          service.Services
+	ProtocolVersion   uint32                  // The negotiated protocol version
+	Capabilities      capability.Capabilities // The advertised capabilities of the peer
+	Negotiated        capability.Capabilities // The capabilities that both sides support
+	Attestation       []byte                  // The hardware attestation of the peer, if any
+	PausedValidators  []crypto.Address        // The validators of the peer that don't take part in the sortition
 	LastSent          time.Time
 	LastReceived      time.Time
 	LastBlockHash     hash.Hash
@@ -30,6 +40,10 @@ type Peer struct {
 	CompletedSessions int
 	ReceivedBytes     map[message.Type]int64
 	SentBytes         map[message.Type]int64
+	RTT               time.Duration // The smoothed round-trip time, zero if not measured yet
+	ClockOffset       time.Duration // The smoothed apparent clock offset of the peer
+	PingNonce         uint64        // The nonce of the ping that is waiting for the pong
+	PingSentAt        time.Time
 }
 
 func NewPeer(peerID peer.ID) *Peer {
@@ -40,6 +54,8 @@ func NewPeer(peerID peer.ID) *Peer {
 		ReceivedBytes: make(map[message.Type]int64),
 		SentBytes:     make(map[message.Type]int64),
 		Protocols:     make([]string, 0),
+		Capabilities:  capability.Legacy,
+		Negotiated:    capability.Legacy,
 	}
 }
 
@@ -51,6 +67,42 @@ func (p *Peer) IsBanned() bool {
 	return p.Status == StatusCodeBanned
 }
 
+// HasCapability checks if the capability is negotiated with the peer.
+// Until the handshake is done, the peer is assumed to have the legacy capabilities.
+func (p *Peer) HasCapability(c capability.Capability) bool {
+	return p.Negotiated.Has(c)
+}
+
 func (p *Peer) HasNetworkService() bool {
 	return p.Services.IsNetwork()
 }
+
+// ServesSnapshots checks if the peer has advertised that it serves the state snapshots.
+func (p *Peer) ServesSnapshots() bool {
+	return p.Capabilities.Has(capability.Snapshots)
+}
+
+// ServesArchive checks if the peer has advertised that it serves the whole history of the blocks.
+func (p *Peer) ServesArchive() bool {
+	return p.Capabilities.Has(capability.Archive)
+}
+
+// ServedBytes returns the number of bytes that we have served to the peer.
+func (p *Peer) ServedBytes() int64 {
+	served := int64(0)
+	for _, t := range servingTypes {
+		served += p.SentBytes[t]
+	}
+
+	return served
+}
+
+// ConsumedBytes returns the number of bytes that the peer has served to us.
+func (p *Peer) ConsumedBytes() int64 {
+	consumed := int64(0)
+	for _, t := range servingTypes {
+		consumed += p.ReceivedBytes[t]
+	}
+
+	return consumed
+}
@@ -2,16 +2,22 @@ package peerset
 
 import (
 	"fmt"
+	"sort"
+	"strings"
 	"sync"
 	"time"
 
 	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/pactus-project/pactus/crypto"
 	"github.com/pactus-project/pactus/crypto/bls"
 	"github.com/pactus-project/pactus/crypto/hash"
 	"github.com/pactus-project/pactus/sync/bundle/message"
+	"github.com/pactus-project/pactus/sync/peerset/capability"
 	"github.com/pactus-project/pactus/sync/peerset/service"
 	"github.com/pactus-project/pactus/sync/peerset/session"
 	"github.com/pactus-project/pactus/util"
+	"github.com/pactus-project/pactus/version"
+	"golang.org/x/exp/slices"
 )
 
 // TODO:
@@ -252,6 +258,83 @@ func (ps *PeerSet) UpdateInfo(
 	p.Services = services
 }
 
+// UpdateCapabilities updates the protocol version and the capabilities of the peer,
+// along with the result of the negotiation.
+func (ps *PeerSet) UpdateCapabilities(pid peer.ID, protocolVersion uint32,
+	capabilities, negotiated capability.Capabilities,
+) {
+	ps.lk.Lock()
+	defer ps.lk.Unlock()
+
+	p := ps.mustGetPeer(pid)
+	p.ProtocolVersion = protocolVersion
+	p.Capabilities = capabilities
+	p.Negotiated = negotiated
+}
+
+// UpdateAttestation updates the hardware attestation that the peer has advertised.
+func (ps *PeerSet) UpdateAttestation(pid peer.ID, attestation []byte) {
+	ps.lk.Lock()
+	defer ps.lk.Unlock()
+
+	p := ps.mustGetPeer(pid)
+	p.Attestation = attestation
+}
+
+// UpdatePausedValidators updates the validators that the peer has advertised as paused.
+func (ps *PeerSet) UpdatePausedValidators(pid peer.ID, addrs []crypto.Address) {
+	ps.lk.Lock()
+	defer ps.lk.Unlock()
+
+	p := ps.mustGetPeer(pid)
+	p.PausedValidators = addrs
+}
+
+// HasCapability checks if the capability is negotiated with the peer.
+// The unknown peers are assumed to have the legacy capabilities.
+func (ps *PeerSet) HasCapability(pid peer.ID, c capability.Capability) bool {
+	ps.lk.RLock()
+	defer ps.lk.RUnlock()
+
+	p := ps.getPeer(pid)
+	if p == nil {
+		return capability.Legacy.Has(c)
+	}
+
+	return p.HasCapability(c)
+}
+
+// ServingPeers returns the IDs of the handshaked peers that have advertised
+// the serving capability, like Snapshots or Archive, sorted by their IDs.
+func (ps *PeerSet) ServingPeers(c capability.Capability) []peer.ID {
+	ps.lk.RLock()
+	defer ps.lk.RUnlock()
+
+	pids := make([]peer.ID, 0)
+	for _, p := range ps.peers {
+		if p.IsKnownOrTrusty() && p.Capabilities.Has(c) {
+			pids = append(pids, p.PeerID)
+		}
+	}
+	slices.Sort(pids)
+
+	return pids
+}
+
+// ServingBalance returns the number of bytes that we have served to the peer,
+// more than what the peer has served to us.
+func (ps *PeerSet) ServingBalance(pid peer.ID) int64 {
+	ps.lk.RLock()
+	defer ps.lk.RUnlock()
+
+	p := ps.getPeer(pid)
+	if p == nil {
+		return 0
+	}
+
+	return p.ServedBytes() - p.ConsumedBytes()
+}
+
 func (ps *PeerSet) UpdateHeight(pid peer.ID, height uint32, lastBlockHash hash.Hash) {
 	ps.lk.Lock()
 	defer ps.lk.Unlock()
@@ -261,6 +344,65 @@ func (ps *PeerSet) UpdateHeight(pid peer.ID, height uint32, lastBlockHash hash.H
 	p.LastBlockHash = lastBlockHash
 }
 
+// UpdatePingSent records the ping that is sent to the peer,
+// to match it with the pong when it arrives.
+func (ps *PeerSet) UpdatePingSent(pid peer.ID, nonce uint64, sentAt time.Time) {
+	ps.lk.Lock()
+	defer ps.lk.Unlock()
+
+	p := ps.mustGetPeer(pid)
+	p.PingNonce = nonce
+	p.PingSentAt = sentAt
+}
+
+// UpdateLatency updates the round-trip time and the clock offset of the peer,
+// once the pong for the last ping is received.
+// The peer time is the time that the peer has received the ping, in its own clock.
+// It returns false if the pong doesn't match the last ping.
+func (ps *PeerSet) UpdateLatency(pid peer.ID, nonce uint64, peerTime, receivedAt time.Time) bool {
+	ps.lk.Lock()
+	defer ps.lk.Unlock()
+
+	p := ps.getPeer(pid)
+	if p == nil || p.PingNonce == 0 || p.PingNonce != nonce {
+		return false
+	}
+	p.PingNonce = 0
+
+	rtt := receivedAt.Sub(p.PingSentAt)
+	if rtt < 0 {
+		rtt = 0
+	}
+	// Assuming the paths are symmetric, the peer has received the ping in the middle of the round trip.
+	offset := peerTime.Sub(p.PingSentAt.Add(rtt / 2))
+
+	if p.RTT == 0 {
+		p.RTT = rtt
+		p.ClockOffset = offset
+	} else {
+		// Smoothing the measurements, the same as the TCP does for the round-trip time.
+		p.RTT = (7*p.RTT + rtt) / 8
+		p.ClockOffset = (7*p.ClockOffset + offset) / 8
+	}
+
+	return true
+}
+
+// MaxClaimedHeight returns the highest height that the handshaked peers have claimed.
+func (ps *PeerSet) MaxClaimedHeight() uint32 {
+	ps.lk.RLock()
+	defer ps.lk.RUnlock()
+
+	maxHeight := uint32(0)
+	for _, p := range ps.peers {
+		if p.IsKnownOrTrusty() && p.Height > maxHeight {
+			maxHeight = p.Height
+		}
+	}
+
+	return maxHeight
+}
+
 func (ps *PeerSet) UpdateAddress(pid peer.ID, addr, direction string) {
 	ps.lk.Lock()
 	defer ps.lk.Unlock()
@@ -364,6 +506,67 @@ func (ps *PeerSet) TotalReceivedBytes() int64 {
 	return ps.totalReceivedBytes
 }
 
+// TotalServedBytes returns the number of bytes that we have served to all the peers,
+// since the node has started.
+func (ps *PeerSet) TotalServedBytes() int64 {
+	ps.lk.RLock()
+	defer ps.lk.RUnlock()
+
+	served := int64(0)
+	for _, t := range servingTypes {
+		served += ps.sentBytes[t]
+	}
+
+	return served
+}
+
+// VersionStat is the number of the peers that run the same version of the node.
+type VersionStat struct {
+	Version         string
+	Commit          string
+	ProtocolVersion uint32
+	Count           int
+}
+
+// VersionStats returns the number of the known peers by the version that they advertise
+// in the handshake, from the most common version to the least common one.
+func (ps *PeerSet) VersionStats() []VersionStat {
+	ps.lk.RLock()
+	defer ps.lk.RUnlock()
+
+	counts := make(map[VersionStat]int)
+	for _, p := range ps.peers {
+		if !p.IsKnownOrTrusty() {
+			continue
+		}
+
+		info := version.ParseAgent(p.Agent)
+		counts[VersionStat{
+			Version:         info.Version,
+			Commit:          info.Commit,
+			ProtocolVersion: info.ProtocolVersion,
+		}]++
+	}
+
+	stats := make([]VersionStat, 0, len(counts))
+	for stat, count := range counts {
+		stat.Count = count
+		stats = append(stats, stat)
+	}
+	sort.Slice(stats, func(i, j int) bool {
+		if stats[i].Count != stats[j].Count {
+			return stats[i].Count > stats[j].Count
+		}
+		if stats[i].Version != stats[j].Version {
+			return stats[i].Version > stats[j].Version
+		}
+
+		return stats[i].Commit < stats[j].Commit
+	})
+
+	return stats
+}
+
 func (ps *PeerSet) SentBytesMessageType(msgType message.Type) int64 {
 	if sentBytes, ok := ps.sentBytes[msgType]; ok {
 		return sentBytes
@@ -413,8 +616,23 @@ func (ps *PeerSet) IterateSessions(consumer func(s *session.Session) (stop bool)
 	}
 }
 
+// latencyReference is the round-trip time that halves the weight of a peer.
+const latencyReference = 250 * time.Millisecond
+
+// latencyWeight reduces the weight of the peer in proportion to its round-trip time.
+// The peers that are not measured yet keep their weight.
+func latencyWeight(weight int, rtt time.Duration) int {
+	if rtt <= 0 || weight == 0 {
+		return weight
+	}
+	weight = int(int64(weight) * int64(latencyReference) / int64(latencyReference+rtt))
+
+	return util.Max(weight, 1)
+}
+
 // GetRandomPeer selects a random peer from the peer set based on their weights.
-// The weight of each peer is determined by the number of failed and total bundles.
+// The weight of each peer is determined by the number of failed and total bundles,
+// and it is reduced for the peers with the high round-trip time.
 // Peers with higher weights are more likely to be selected.
 func (ps *PeerSet) GetRandomPeer() *Peer {
 	ps.lk.RLock()
@@ -434,6 +652,7 @@ func (ps *PeerSet) GetRandomPeer() *Peer {
 		}
 
 		weight := (p.CompletedSessions + 1) * 100 / (p.TotalSessions + 1)
+		weight = latencyWeight(weight, p.RTT)
 		totalWeight += weight
 		peers = append(peers, weightedPeer{
 			peer:   p,
@@ -445,6 +664,11 @@ func (ps *PeerSet) GetRandomPeer() *Peer {
 		return nil
 	}
 
+	// Sorting peers makes the selection reproducible with a deterministic random source.
+	slices.SortFunc(peers, func(a, b weightedPeer) int {
+		return strings.Compare(string(a.peer.PeerID), string(b.peer.PeerID))
+	})
+
 	rnd := int(util.RandUint32(uint32(totalWeight)))
 
 	// Find the index where the random number falls
@@ -8,6 +8,7 @@ import (
 	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/pactus-project/pactus/crypto/bls"
 	"github.com/pactus-project/pactus/sync/bundle/message"
+	"github.com/pactus-project/pactus/sync/peerset/capability"
 	"github.com/pactus-project/pactus/sync/peerset/service"
 	"github.com/pactus-project/pactus/sync/peerset/session"
 	"github.com/pactus-project/pactus/util/testsuite"
@@ -103,6 +104,10 @@ func TestPeerSet(t *testing.T) {
 		assert.Equal(t, peerSet.SentBytesMessageType(message.TypeBlocksRequest), int64(450))
 		assert.Equal(t, peerSet.SentBytes(), sentBytes)
 		assert.Equal(t, peerSet.TotalSentBundles(), 2)
+		assert.Zero(t, peerSet.TotalServedBytes())
+
+		peerSet.IncreaseSentCounters(message.TypeBlocksResponse, 300, &pid1)
+		assert.Equal(t, int64(300), peerSet.TotalServedBytes())
 	})
 
 	t.Run("Testing UpdateHeight", func(t *testing.T) {
@@ -293,6 +298,47 @@ func TestGetRandomWeightedPeer(t *testing.T) {
 	assert.GreaterOrEqual(t, hits[peer.ID("peer_6")], 0)
 }
 
+func TestMaxClaimedHeight(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	peerSet := NewPeerSet(time.Minute)
+	assert.Zero(t, peerSet.MaxClaimedHeight())
+
+	pidKnown := peer.ID("known")
+	pidConnected := peer.ID("connected")
+	peerSet.UpdateStatus(pidKnown, StatusCodeKnown)
+	peerSet.UpdateStatus(pidConnected, StatusCodeConnected)
+	peerSet.UpdateHeight(pidKnown, 100, ts.RandHash())
+	peerSet.UpdateHeight(pidConnected, 200, ts.RandHash())
+
+	assert.Equal(t, uint32(100), peerSet.MaxClaimedHeight())
+}
+
+func TestGetRandomPeerDeterministic(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	peerSet := NewPeerSet(time.Minute)
+	for i := 0; i < 6; i++ {
+		pid := peer.ID(fmt.Sprintf("peer_%v", i+1))
+		peerSet.UpdateInfo(pid, fmt.Sprintf("Moniker_%v", i+1), "Agent1", nil, service.New())
+		peerSet.UpdateStatus(pid, StatusCodeKnown)
+	}
+
+	pickPeers := func() []peer.ID {
+		ts.UseDeterministicRand(t)
+
+		pids := make([]peer.ID, 0, 20)
+		for i := 0; i < 20; i++ {
+			pids = append(pids, peerSet.GetRandomPeer().PeerID)
+		}
+
+		return pids
+	}
+
+	// The same seed should pick the same peers
+	assert.Equal(t, pickPeers(), pickPeers())
+}
+
 func TestGetRandomPeerConnected(t *testing.T) {
 	peerSet := NewPeerSet(time.Minute)
 
@@ -359,3 +405,159 @@ func TestUpdateProtocols(t *testing.T) {
 	p := ps.GetPeer(pid)
 	assert.Equal(t, p.Protocols, protocols)
 }
+
+func TestUpdateCapabilities(t *testing.T) {
+	peerSet := NewPeerSet(time.Minute)
+
+	pid := peer.ID("peer1")
+	peerSet.UpdateInfo(pid, "Moniker1", "Agent1", nil, service.New(service.Network))
+
+	t.Run("Legacy capabilities before handshake", func(t *testing.T) {
+		assert.True(t, peerSet.HasCapability(pid, capability.Compression))
+		assert.False(t, peerSet.HasCapability(pid, capability.Filters))
+		assert.True(t, peerSet.HasCapability(peer.ID("unknown"), capability.Compression))
+	})
+
+	t.Run("Negotiated capabilities", func(t *testing.T) {
+		peerSet.UpdateCapabilities(pid, 1,
+			capability.New(capability.Filters, capability.StateSync),
+			capability.New(capability.Filters))
+
+		p := peerSet.GetPeer(pid)
+		assert.Equal(t, uint32(1), p.ProtocolVersion)
+		assert.Equal(t, capability.New(capability.Filters, capability.StateSync), p.Capabilities)
+		assert.True(t, peerSet.HasCapability(pid, capability.Filters))
+		assert.False(t, peerSet.HasCapability(pid, capability.Compression))
+		assert.False(t, peerSet.HasCapability(pid, capability.StateSync))
+	})
+}
+
+func TestServingPeers(t *testing.T) {
+	ps := NewPeerSet(time.Minute)
+
+	pid1 := peer.ID("peer1")
+	pid2 := peer.ID("peer2")
+	pid3 := peer.ID("peer3")
+	ps.UpdateCapabilities(pid1, 1, capability.New(capability.Archive, capability.Snapshots), capability.New())
+	ps.UpdateCapabilities(pid2, 1, capability.New(capability.Archive), capability.New())
+	ps.UpdateCapabilities(pid3, 1, capability.New(capability.Snapshots), capability.New())
+	ps.UpdateStatus(pid1, StatusCodeKnown)
+	ps.UpdateStatus(pid2, StatusCodeTrusty)
+
+	assert.Equal(t, []peer.ID{pid1, pid2}, ps.ServingPeers(capability.Archive))
+	assert.Equal(t, []peer.ID{pid1}, ps.ServingPeers(capability.Snapshots))
+
+	ps.UpdateStatus(pid3, StatusCodeKnown)
+	assert.Equal(t, []peer.ID{pid1, pid3}, ps.ServingPeers(capability.Snapshots))
+	assert.Empty(t, ps.ServingPeers(capability.StateSync))
+}
+
+func TestServedAndConsumedBytes(t *testing.T) {
+	ps := NewPeerSet(time.Minute)
+
+	pid := peer.ID("peer1")
+	ps.IncreaseSentCounters(message.TypeBlocksResponse, 100, &pid)
+	ps.IncreaseSentCounters(message.TypeFiltersResponse, 20, &pid)
+	ps.IncreaseSentCounters(message.TypeBlocksRequest, 5, &pid)
+	ps.IncreaseReceivedBytesCounter(pid, message.TypeBlocksResponse, 30)
+	ps.IncreaseReceivedBytesCounter(pid, message.TypeTransactions, 7)
+
+	p := ps.GetPeer(pid)
+	assert.Equal(t, int64(120), p.ServedBytes())
+	assert.Equal(t, int64(30), p.ConsumedBytes())
+	assert.Equal(t, int64(90), ps.ServingBalance(pid))
+	assert.Zero(t, ps.ServingBalance(peer.ID("unknown")))
+}
+
+func TestVersionStats(t *testing.T) {
+	ps := NewPeerSet(time.Minute)
+
+	agents := []string{
+		"node=daemon/node-version=v1.2.0/protocol-version=2/os=linux/arch=amd64/commit=bbbbbbbbbbbb",
+		"node=gui/node-version=v1.2.0/protocol-version=2/os=darwin/arch=arm64/commit=bbbbbbbbbbbb",
+		"node=daemon/node-version=v1.1.0/protocol-version=1/os=linux/arch=amd64",
+		"node=daemon/node-version=v1.3.0/protocol-version=2/os=linux/arch=amd64/commit=cccccccccccc",
+	}
+	for i, agent := range agents {
+		pid := peer.ID(fmt.Sprintf("peer%d", i))
+		ps.UpdateInfo(pid, "moniker", agent, nil, service.New())
+		ps.UpdateStatus(pid, StatusCodeKnown)
+	}
+
+	// The peers without handshake are not counted.
+	ps.UpdateInfo(peer.ID("connected"), "moniker", agents[2], nil, service.New())
+	ps.UpdateStatus(peer.ID("connected"), StatusCodeConnected)
+
+	assert.Equal(t, []VersionStat{
+		{Version: "1.2.0", Commit: "bbbbbbbbbbbb", ProtocolVersion: 2, Count: 2},
+		{Version: "1.3.0", Commit: "cccccccccccc", ProtocolVersion: 2, Count: 1},
+		{Version: "1.1.0", ProtocolVersion: 1, Count: 1},
+	}, ps.VersionStats())
+}
+
+func TestUpdateLatency(t *testing.T) {
+	ps := NewPeerSet(time.Minute)
+	pid := peer.ID("peer")
+	sentAt := time.Now()
+
+	t.Run("No ping is sent", func(t *testing.T) {
+		assert.False(t, ps.UpdateLatency(pid, 1, sentAt, sentAt))
+	})
+
+	ps.UpdatePingSent(pid, 1, sentAt)
+
+	t.Run("Nonce mismatch", func(t *testing.T) {
+		assert.False(t, ps.UpdateLatency(pid, 2, sentAt, sentAt))
+	})
+
+	t.Run("First measurement", func(t *testing.T) {
+		// The peer clock is two seconds ahead.
+		peerTime := sentAt.Add(50*time.Millisecond + 2*time.Second)
+		assert.True(t, ps.UpdateLatency(pid, 1, peerTime, sentAt.Add(100*time.Millisecond)))
+
+		p := ps.GetPeer(pid)
+		assert.Equal(t, 100*time.Millisecond, p.RTT)
+		assert.Equal(t, 2*time.Second, p.ClockOffset)
+		assert.Zero(t, p.PingNonce)
+	})
+
+	t.Run("Pong is received twice", func(t *testing.T) {
+		assert.False(t, ps.UpdateLatency(pid, 1, sentAt, sentAt))
+	})
+
+	t.Run("Smoothing", func(t *testing.T) {
+		ps.UpdatePingSent(pid, 3, sentAt)
+		peerTime := sentAt.Add(450*time.Millisecond + 2*time.Second)
+		assert.True(t, ps.UpdateLatency(pid, 3, peerTime, sentAt.Add(900*time.Millisecond)))
+
+		p := ps.GetPeer(pid)
+		assert.Equal(t, 200*time.Millisecond, p.RTT)
+		assert.Equal(t, 2*time.Second, p.ClockOffset)
+	})
+}
+
+func TestLatencyWeight(t *testing.T) {
+	assert.Equal(t, 100, latencyWeight(100, 0))
+	assert.Equal(t, 0, latencyWeight(0, time.Second))
+	assert.Equal(t, 50, latencyWeight(100, latencyReference))
+	assert.Equal(t, 1, latencyWeight(1, time.Hour))
+}
+
+func TestGetRandomPeerLatency(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+	ts.UseDeterministicRand(t)
+
+	peerSet := NewPeerSet(time.Minute)
+	pidFast := peer.ID("fast")
+	pidSlow := peer.ID("slow")
+	peerSet.UpdateStatus(pidFast, StatusCodeKnown)
+	peerSet.UpdateStatus(pidSlow, StatusCodeKnown)
+	peerSet.GetPeer(pidFast).RTT = 10 * time.Millisecond
+	peerSet.GetPeer(pidSlow).RTT = 5 * time.Second
+
+	picked := map[peer.ID]int{}
+	for i := 0; i < 100; i++ {
+		picked[peerSet.GetRandomPeer().PeerID]++
+	}
+	assert.Greater(t, picked[pidFast], picked[pidSlow])
+}
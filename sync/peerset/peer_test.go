@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/pactus-project/pactus/sync/peerset/capability"
 	"github.com/pactus-project/pactus/sync/peerset/service"
 	"github.com/stretchr/testify/assert"
 )
@@ -21,6 +22,8 @@ func TestPeer(t *testing.T) {
 	p3.Status = StatusCodeBanned
 
 	p1.Services = service.New(service.Network)
+	p1.Capabilities = capability.New(capability.Compression, capability.Archive)
+	p2.Capabilities = capability.New(capability.Snapshots)
 
 	t.Run("NewPeer", func(t *testing.T) {
 		assert.NotNil(t, p1)
@@ -41,4 +44,12 @@ func TestPeer(t *testing.T) {
 		assert.True(t, p1.HasNetworkService())
 		assert.False(t, p2.HasNetworkService())
 	})
+
+	t.Run("serving capabilities", func(t *testing.T) {
+		assert.True(t, p1.ServesArchive())
+		assert.False(t, p1.ServesSnapshots())
+		assert.False(t, p2.ServesArchive())
+		assert.True(t, p2.ServesSnapshots())
+		assert.False(t, p3.ServesArchive())
+	})
 }
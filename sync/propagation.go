@@ -0,0 +1,151 @@
+package sync
+
+import (
+	"time"
+
+	lru "github.com/hashicorp/golang-lru/v2"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/pactus-project/pactus/crypto/hash"
+	"github.com/pactus-project/pactus/types/block"
+	"github.com/prometheus/client_golang/prometheus"
+	"golang.org/x/exp/slices"
+)
+
+// propagationRecordsSize is the number of the blocks and proposals that their
+// first-seen records are kept.
+const propagationRecordsSize = 256
+
+var propagationLatencyHistogram = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+	Namespace: "pactus",
+	Subsystem: "sync",
+	Name:      "propagation_latency_seconds",
+	Help:      "The time from the block time until the block or the proposal is first seen by the node.",
+	Buckets:   []float64{0.1, 0.25, 0.5, 1, 2, 4, 8, 16, 32},
+}, []string{"kind"})
+
+func init() {
+	prometheus.MustRegister(propagationLatencyHistogram)
+}
+
+// PropagationKind is the kind of the message that carries the block.
+type PropagationKind int
+
+const (
+	PropagationBlock    = PropagationKind(0) // Block announcement
+	PropagationProposal = PropagationKind(1) // Proposal
+)
+
+func (k PropagationKind) String() string {
+	switch k {
+	case PropagationBlock:
+		return "block"
+	case PropagationProposal:
+		return "proposal"
+	default:
+		return "unknown"
+	}
+}
+
+// PropagationRecord records when a block or a proposal is first seen by the node,
+// and the peer that delivered it.
+type PropagationRecord struct {
+	Kind      PropagationKind
+	Height    uint32
+	Round     int16
+	BlockHash hash.Hash
+	BlockTime time.Time
+	FirstSeen time.Time
+	PeerID    peer.ID
+}
+
+// Latency returns the time from the block time until the node first saw it.
+// The block time is set by the proposer, so the clock offset of the proposer is included.
+func (r PropagationRecord) Latency() time.Duration {
+	return r.FirstSeen.Sub(r.BlockTime)
+}
+
+// PropagationStats summarizes the propagation latencies of the recorded blocks or proposals.
+type PropagationStats struct {
+	Count  int
+	Min    time.Duration
+	Median time.Duration
+	P90    time.Duration
+	Max    time.Duration
+}
+
+// CalcPropagationStats calculates the statistics of the records of the given kind.
+func CalcPropagationStats(records []PropagationRecord, kind PropagationKind) PropagationStats {
+	latencies := make([]time.Duration, 0, len(records))
+	for _, r := range records {
+		if r.Kind == kind {
+			latencies = append(latencies, r.Latency())
+		}
+	}
+
+	if len(latencies) == 0 {
+		return PropagationStats{}
+	}
+
+	slices.Sort(latencies)
+	percentile := func(p int) time.Duration {
+		return latencies[(len(latencies)-1)*p/100]
+	}
+
+	return PropagationStats{
+		Count:  len(latencies),
+		Min:    latencies[0],
+		Median: percentile(50),
+		P90:    percentile(90),
+		Max:    latencies[len(latencies)-1],
+	}
+}
+
+type propagationKey struct {
+	kind      PropagationKind
+	blockHash hash.Hash
+}
+
+// propagationTracker keeps the first-seen records of the recent blocks and proposals.
+// It is thread-safe, since it is read by the RPC servers.
+type propagationTracker struct {
+	records *lru.Cache[propagationKey, PropagationRecord]
+}
+
+func newPropagationTracker(size int) *propagationTracker {
+	records, _ := lru.New[propagationKey, PropagationRecord](size)
+
+	return &propagationTracker{
+		records: records,
+	}
+}
+
+// record records the block as seen at the given time, if it is not seen before.
+// It returns false if the block is already seen.
+func (t *propagationTracker) record(kind PropagationKind, height uint32, round int16,
+	blk *block.Block, pid peer.ID, seenAt time.Time,
+) bool {
+	key := propagationKey{kind: kind, blockHash: blk.Hash()}
+	rec := PropagationRecord{
+		Kind:      kind,
+		Height:    height,
+		Round:     round,
+		BlockHash: blk.Hash(),
+		BlockTime: blk.Header().Time(),
+		FirstSeen: seenAt,
+		PeerID:    pid,
+	}
+
+	found, _ := t.records.ContainsOrAdd(key, rec)
+	if found {
+		return false
+	}
+
+	propagationLatencyHistogram.WithLabelValues(kind.String()).Observe(rec.Latency().Seconds())
+
+	return true
+}
+
+// list returns the records, from the oldest to the newest.
+func (t *propagationTracker) list() []PropagationRecord {
+	return t.records.Values()
+}
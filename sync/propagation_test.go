@@ -0,0 +1,96 @@
+package sync
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pactus-project/pactus/sync/bundle/message"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPropagationTracker(t *testing.T) {
+	td := setup(t, nil)
+
+	tracker := newPropagationTracker(2)
+	blk1, _ := td.GenerateTestBlock(td.RandHeight())
+	blk2, _ := td.GenerateTestBlock(td.RandHeight())
+	blk3, _ := td.GenerateTestBlock(td.RandHeight())
+	pid1 := td.RandPeerID()
+	pid2 := td.RandPeerID()
+	seenAt := blk1.Header().Time().Add(time.Second)
+
+	assert.True(t, tracker.record(PropagationBlock, 1, 0, blk1, pid1, seenAt))
+	assert.False(t, tracker.record(PropagationBlock, 1, 0, blk1, pid2, seenAt.Add(time.Second)),
+		"the block is already seen")
+	assert.True(t, tracker.record(PropagationProposal, 1, 0, blk1, pid2, seenAt),
+		"the proposal is recorded separately")
+
+	records := tracker.list()
+	require.Len(t, records, 2)
+	assert.Equal(t, pid1, records[0].PeerID)
+	assert.Equal(t, time.Second, records[0].Latency())
+
+	assert.True(t, tracker.record(PropagationBlock, 2, 0, blk2, pid1, seenAt))
+	assert.True(t, tracker.record(PropagationBlock, 3, 0, blk3, pid1, seenAt))
+
+	records = tracker.list()
+	require.Len(t, records, 2, "the oldest records are evicted")
+	assert.Equal(t, blk2.Hash(), records[0].BlockHash)
+	assert.Equal(t, blk3.Hash(), records[1].BlockHash)
+}
+
+func TestCalcPropagationStats(t *testing.T) {
+	now := time.Now()
+	records := []PropagationRecord{}
+	for i := 1; i <= 10; i++ {
+		records = append(records, PropagationRecord{
+			Kind:      PropagationBlock,
+			BlockTime: now,
+			FirstSeen: now.Add(time.Duration(i) * time.Second),
+		})
+	}
+	records = append(records, PropagationRecord{
+		Kind:      PropagationProposal,
+		BlockTime: now,
+		FirstSeen: now.Add(time.Hour),
+	})
+
+	stats := CalcPropagationStats(records, PropagationBlock)
+	assert.Equal(t, 10, stats.Count)
+	assert.Equal(t, 1*time.Second, stats.Min)
+	assert.Equal(t, 5*time.Second, stats.Median)
+	assert.Equal(t, 9*time.Second, stats.P90)
+	assert.Equal(t, 10*time.Second, stats.Max)
+
+	stats = CalcPropagationStats(records, PropagationProposal)
+	assert.Equal(t, 1, stats.Count)
+	assert.Equal(t, time.Hour, stats.Median)
+
+	assert.Zero(t, CalcPropagationStats(nil, PropagationBlock))
+}
+
+func TestRecordingPropagation(t *testing.T) {
+	td := setup(t, nil)
+
+	pid := td.RandPeerID()
+	height := td.state.LastBlockHeight() + 1
+	prop, _ := td.GenerateTestProposal(height, 1)
+	blk, cert := td.GenerateTestBlock(height)
+
+	assert.NoError(t, td.receivingNewMessage(td.sync, message.NewProposalMessage(prop), pid))
+	assert.NoError(t, td.receivingNewMessage(td.sync, message.NewBlockAnnounceMessage(blk, cert), pid))
+
+	records := td.sync.PropagationRecords()
+	require.Len(t, records, 2)
+
+	assert.Equal(t, PropagationProposal, records[0].Kind)
+	assert.Equal(t, height, records[0].Height)
+	assert.Equal(t, int16(1), records[0].Round)
+	assert.Equal(t, prop.Block().Hash(), records[0].BlockHash)
+	assert.Equal(t, pid, records[0].PeerID)
+
+	assert.Equal(t, PropagationBlock, records[1].Kind)
+	assert.Equal(t, blk.Hash(), records[1].BlockHash)
+	assert.Equal(t, pid, records[1].PeerID)
+}
@@ -0,0 +1,137 @@
+// Package relay keeps the state of the Dandelion-style transaction relay,
+// and the limiter of the relayed votes.
+//
+// In the stem phase, the transactions are sent to a single peer, the stem peer,
+// which either forwards them to its own stem peer or broadcasts them (the fluff phase).
+// This way, the node that broadcasts the transactions is likely not their origin.
+// The stemmed transactions are embargoed. If they are not seen in the network
+// before the embargo expires, for example when a peer on the stem path drops them,
+// the node broadcasts them itself.
+package relay
+
+import (
+	"math"
+	"sync"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/pactus-project/pactus/types/tx"
+	"github.com/pactus-project/pactus/util"
+)
+
+const (
+	// StemRotationInterval is how often the stem peer is changed.
+	StemRotationInterval = 10 * time.Minute
+
+	// fluffPercent is the chance, in percent, that a relaying node ends the stem phase.
+	fluffPercent = 10
+)
+
+type Relay struct {
+	lk sync.Mutex
+
+	embargoTimeout time.Duration
+	stemPeer       peer.ID
+	stemChosenAt   time.Time
+	embargoes      map[tx.ID]time.Time
+}
+
+func NewRelay(embargoTimeout time.Duration) *Relay {
+	return &Relay{
+		embargoTimeout: embargoTimeout,
+		embargoes:      make(map[tx.ID]time.Time),
+	}
+}
+
+// StemPeer returns the current stem peer.
+// A new stem peer is chosen randomly from the candidates, if the current one
+// is not a candidate anymore, or it is chosen more than `StemRotationInterval` ago.
+// It returns false if there is no candidate.
+func (r *Relay) StemPeer(candidates []peer.ID, now time.Time) (peer.ID, bool) {
+	r.lk.Lock()
+	defer r.lk.Unlock()
+
+	if len(candidates) == 0 {
+		r.stemPeer = ""
+
+		return "", false
+	}
+
+	if r.stemPeer != "" &&
+		now.Sub(r.stemChosenAt) < StemRotationInterval {
+		for _, pid := range candidates {
+			if pid == r.stemPeer {
+				return r.stemPeer, true
+			}
+		}
+	}
+
+	r.stemPeer = candidates[util.RandInt32(int32(len(candidates)))]
+	r.stemChosenAt = now
+
+	return r.stemPeer, true
+}
+
+// Embargo sets the embargo of the transaction.
+// The embargo expires after a random time between `embargoTimeout` and twice of it,
+// so the peers can't infer the length of the stem path from the expiry time.
+func (r *Relay) Embargo(id tx.ID, now time.Time) {
+	r.lk.Lock()
+	defer r.lk.Unlock()
+
+	jitter := time.Duration(util.RandInt64(int64(r.embargoTimeout) + 1))
+	r.embargoes[id] = now.Add(r.embargoTimeout + jitter)
+}
+
+// Lift removes the embargo of the transaction, if any.
+func (r *Relay) Lift(id tx.ID) {
+	r.lk.Lock()
+	defer r.lk.Unlock()
+
+	delete(r.embargoes, id)
+}
+
+// IsEmbargoed checks if the transaction is embargoed.
+func (r *Relay) IsEmbargoed(id tx.ID) bool {
+	r.lk.Lock()
+	defer r.lk.Unlock()
+
+	_, ok := r.embargoes[id]
+
+	return ok
+}
+
+// Expired removes the expired embargoes and returns their transaction IDs.
+func (r *Relay) Expired(now time.Time) []tx.ID {
+	r.lk.Lock()
+	defer r.lk.Unlock()
+
+	expired := make([]tx.ID, 0)
+	for id, deadline := range r.embargoes {
+		if !now.Before(deadline) {
+			expired = append(expired, id)
+			delete(r.embargoes, id)
+		}
+	}
+
+	return expired
+}
+
+// ShouldFluff decides randomly whether a relaying node should end the stem phase.
+func ShouldFluff() bool {
+	return util.RandInt32(100) < fluffPercent
+}
+
+// DiffusionDelay returns a random delay that is exponentially distributed with the given mean.
+// The random delays make it harder to find the origin of a transaction
+// by observing which node relays it first.
+func DiffusionDelay(mean time.Duration) time.Duration {
+	if mean <= 0 {
+		return 0
+	}
+
+	// A uniform random number in (0, 1], to avoid log(0) which is infinity.
+	uniform := (float64(util.RandUint64(1<<53)) + 1) / (1 << 53)
+
+	return time.Duration(-math.Log(uniform) * float64(mean))
+}
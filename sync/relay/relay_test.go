@@ -0,0 +1,105 @@
+package relay
+
+import (
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/pactus-project/pactus/types/tx"
+	"github.com/pactus-project/pactus/util/testsuite"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStemPeer(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+	r := NewRelay(time.Second)
+	now := time.Now()
+
+	_, ok := r.StemPeer(nil, now)
+	assert.False(t, ok)
+
+	pid1 := ts.RandPeerID()
+	pid2 := ts.RandPeerID()
+	pid3 := ts.RandPeerID()
+
+	stemPeer, ok := r.StemPeer([]peer.ID{pid1, pid2}, now)
+	assert.True(t, ok)
+	assert.Contains(t, []peer.ID{pid1, pid2}, stemPeer)
+
+	t.Run("Keep the stem peer in the same epoch", func(t *testing.T) {
+		for i := 0; i < 10; i++ {
+			pid, _ := r.StemPeer([]peer.ID{pid1, pid2, pid3}, now.Add(time.Minute))
+			assert.Equal(t, stemPeer, pid)
+		}
+	})
+
+	t.Run("Change the stem peer if it is not a candidate anymore", func(t *testing.T) {
+		pid, _ := r.StemPeer([]peer.ID{pid3}, now.Add(time.Minute))
+		assert.Equal(t, pid3, pid)
+	})
+
+	t.Run("Rotate the stem peer after the rotation interval", func(t *testing.T) {
+		r.StemPeer([]peer.ID{pid1}, now)
+		pid, _ := r.StemPeer([]peer.ID{pid2}, now.Add(time.Minute))
+		assert.Equal(t, pid2, pid)
+
+		candidates := []peer.ID{pid1, pid2, pid3}
+		chosen := map[peer.ID]bool{}
+		for i := 0; i < 100; i++ {
+			now = now.Add(StemRotationInterval)
+			pid, _ := r.StemPeer(candidates, now)
+			chosen[pid] = true
+		}
+		assert.Len(t, chosen, 3)
+	})
+}
+
+func TestEmbargo(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+	r := NewRelay(time.Minute)
+	now := time.Now()
+
+	id1 := ts.RandHash()
+	id2 := ts.RandHash()
+	r.Embargo(id1, now)
+	r.Embargo(id2, now)
+	assert.True(t, r.IsEmbargoed(id1))
+	assert.True(t, r.IsEmbargoed(id2))
+
+	assert.Empty(t, r.Expired(now.Add(time.Minute-time.Second)))
+
+	r.Lift(id2)
+	assert.False(t, r.IsEmbargoed(id2))
+
+	assert.Equal(t, []tx.ID{id1}, r.Expired(now.Add(2*time.Minute)))
+	assert.False(t, r.IsEmbargoed(id1))
+	assert.Empty(t, r.Expired(now.Add(2*time.Minute)))
+}
+
+func TestShouldFluff(t *testing.T) {
+	fluffs := 0
+	for i := 0; i < 1000; i++ {
+		if ShouldFluff() {
+			fluffs++
+		}
+	}
+
+	assert.Positive(t, fluffs)
+	assert.Less(t, fluffs, 500)
+}
+
+func TestDiffusionDelay(t *testing.T) {
+	assert.Zero(t, DiffusionDelay(0))
+
+	mean := time.Second
+	total := time.Duration(0)
+	count := 10000
+	for i := 0; i < count; i++ {
+		delay := DiffusionDelay(mean)
+		assert.GreaterOrEqual(t, delay, time.Duration(0))
+		total += delay
+	}
+
+	avg := total / time.Duration(count)
+	assert.InDelta(t, mean, avg, float64(100*time.Millisecond))
+}
@@ -0,0 +1,90 @@
+package relay
+
+import (
+	"sync"
+	"time"
+)
+
+// VoteLimiter bounds the rate of the votes that are relayed through the gossip.
+//
+// The budget is a token bucket that is refilled at `rate` votes per second.
+// While more than half of the bucket is left, all the votes are relayed.
+// Under the pressure, a vote is relayed only if its priority is high enough for the
+// remaining tokens: the emptier the bucket, the higher the priority that is needed.
+// To keep the relay fair, a share of the rate is reserved for the low priority votes,
+// with a priority less than one half, so they are never starved by the others.
+type VoteLimiter struct {
+	lk sync.Mutex
+
+	sharedBurst    float64
+	sharedRate     float64
+	sharedTokens   float64
+	reservedBurst  float64
+	reservedRate   float64
+	reservedTokens float64
+	lastRefill     time.Time
+}
+
+// NewVoteLimiter creates a new vote limiter that relays `rate` votes per second on average,
+// with `fairPercent` percent of the rate reserved for the low priority votes.
+// The bucket can hold the votes of two seconds. A zero rate means no limit.
+func NewVoteLimiter(rate, fairPercent int) *VoteLimiter {
+	fairShare := float64(fairPercent) / 100
+	burst := float64(rate) * 2
+
+	return &VoteLimiter{
+		sharedBurst:    burst * (1 - fairShare),
+		sharedRate:     float64(rate) * (1 - fairShare),
+		sharedTokens:   burst * (1 - fairShare),
+		reservedBurst:  burst * fairShare,
+		reservedRate:   float64(rate) * fairShare,
+		reservedTokens: burst * fairShare,
+	}
+}
+
+// Allow reports whether a vote with the given priority, between zero and one, should be relayed.
+// The votes with the priority of one can use the whole shared bucket, while the votes
+// with the priority of zero can only use its upper half.
+func (l *VoteLimiter) Allow(priority float64, now time.Time) bool {
+	l.lk.Lock()
+	defer l.lk.Unlock()
+
+	if l.sharedRate == 0 && l.reservedRate == 0 {
+		return true
+	}
+
+	l.refill(now)
+
+	priority = min(max(priority, 0), 1)
+	threshold := (1 - priority) * l.sharedBurst / 2
+	if l.sharedTokens-1 >= threshold {
+		l.sharedTokens--
+
+		return true
+	}
+
+	if priority < 0.5 && l.reservedTokens >= 1 {
+		l.reservedTokens--
+
+		return true
+	}
+
+	return false
+}
+
+func (l *VoteLimiter) refill(now time.Time) {
+	if l.lastRefill.IsZero() {
+		l.lastRefill = now
+
+		return
+	}
+
+	elapsed := now.Sub(l.lastRefill).Seconds()
+	if elapsed <= 0 {
+		return
+	}
+	l.lastRefill = now
+
+	l.sharedTokens = min(l.sharedTokens+elapsed*l.sharedRate, l.sharedBurst)
+	l.reservedTokens = min(l.reservedTokens+elapsed*l.reservedRate, l.reservedBurst)
+}
@@ -0,0 +1,79 @@
+package relay
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVoteLimiterNoLimit(t *testing.T) {
+	l := NewVoteLimiter(0, 10)
+	now := time.Now()
+
+	for i := 0; i < 1000; i++ {
+		assert.True(t, l.Allow(0, now))
+	}
+}
+
+func TestVoteLimiterPriority(t *testing.T) {
+	// The shared bucket holds 18 votes and the reserved bucket holds 2 votes.
+	l := NewVoteLimiter(10, 10)
+	now := time.Now()
+
+	t.Run("No pressure, all votes are relayed", func(t *testing.T) {
+		for i := 0; i < 9; i++ {
+			assert.True(t, l.Allow(0, now))
+		}
+	})
+
+	t.Run("Under pressure, low priority votes use the reserved share", func(t *testing.T) {
+		assert.True(t, l.Allow(0, now))
+		assert.True(t, l.Allow(0, now))
+		assert.False(t, l.Allow(0, now))
+	})
+
+	t.Run("Under pressure, higher priority votes use more of the bucket", func(t *testing.T) {
+		for i := 0; i < 4; i++ {
+			assert.True(t, l.Allow(0.5, now))
+		}
+		assert.False(t, l.Allow(0.5, now))
+
+		for i := 0; i < 5; i++ {
+			assert.True(t, l.Allow(1, now))
+		}
+		assert.False(t, l.Allow(1, now))
+	})
+
+	t.Run("The budget is refilled", func(t *testing.T) {
+		later := now.Add(2 * time.Second)
+		for i := 0; i < 11; i++ {
+			assert.True(t, l.Allow(0, later))
+		}
+		assert.False(t, l.Allow(0, later))
+
+		for i := 0; i < 9; i++ {
+			assert.True(t, l.Allow(1, later))
+		}
+		assert.False(t, l.Allow(1, later))
+	})
+}
+
+func TestVoteLimiterFairness(t *testing.T) {
+	l := NewVoteLimiter(10, 10)
+	now := time.Now()
+
+	// High priority votes keep the bucket under the pressure.
+	relayedLow := 0
+	for sec := 0; sec < 10; sec++ {
+		at := now.Add(time.Duration(sec) * time.Second)
+		for i := 0; i < 20; i++ {
+			l.Allow(1, at)
+		}
+		if l.Allow(0, at) {
+			relayedLow++
+		}
+	}
+
+	assert.Equal(t, 10, relayedLow)
+}
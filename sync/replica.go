@@ -0,0 +1,70 @@
+package sync
+
+import (
+	"slices"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/pactus-project/pactus/sync/bundle/message"
+	"github.com/pactus-project/pactus/sync/peerset"
+	"github.com/pactus-project/pactus/types/tx"
+	"github.com/pactus-project/pactus/util"
+)
+
+// Primaries returns the trusted primaries of the node, if it is a read replica.
+func (sync *synchronizer) Primaries() []peer.ID {
+	return sync.primaries
+}
+
+func (sync *synchronizer) isReplica() bool {
+	return len(sync.primaries) > 0
+}
+
+func (sync *synchronizer) isPrimary(pid peer.ID) bool {
+	return slices.Contains(sync.primaries, pid)
+}
+
+// randomPrimary returns one of the handshaked primaries randomly,
+// or nil if the replica has no handshaked primary.
+func (sync *synchronizer) randomPrimary() *peerset.Peer {
+	primaries := make([]*peerset.Peer, 0, len(sync.primaries))
+	for _, pid := range sync.primaries {
+		p := sync.peerSet.GetPeer(pid)
+		if p != nil && p.IsKnownOrTrusty() {
+			primaries = append(primaries, p)
+		}
+	}
+
+	if len(primaries) == 0 {
+		return nil
+	}
+
+	return primaries[util.RandInt32(int32(len(primaries)))]
+}
+
+// followPrimaries requests the new blocks from the primaries.
+// The replica doesn't receive the block announcements, since it doesn't join the gossip topics.
+// The primaries respond with the blocks that they have, and the last certificate.
+func (sync *synchronizer) followPrimaries() {
+	_ = sync.tryCommitBlocks()
+
+	sync.peerSet.SetExpiredSessionsAsUncompleted()
+	if sync.peerSet.HasAnyOpenSession() {
+		return
+	}
+	sync.peerSet.RemoveAllSessions()
+
+	sync.downloadBlocks(sync.stateHeight()+1, false)
+}
+
+// forwardTransactions sends the transactions that are created by the replica to a primary.
+// They are sent in the stem phase, so the primary relays them to the network.
+func (sync *synchronizer) forwardTransactions(trxs []*tx.Tx) {
+	primary := sync.randomPrimary()
+	if primary == nil {
+		sync.logger.Warn("no primary is connected, transactions are not relayed", "count", len(trxs))
+
+		return
+	}
+
+	sync.sendTo(message.NewStemTransactionsMessage(trxs), primary.PeerID)
+}
@@ -0,0 +1,132 @@
+package sync
+
+import (
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/pactus-project/pactus/sync/bundle/message"
+	"github.com/pactus-project/pactus/sync/peerset/service"
+	"github.com/pactus-project/pactus/types/tx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testPrimaryAddr = "/ip4/127.0.0.1/tcp/21888/p2p/12D3KooWDEWpKkZVxpc8hbLKQL1jvFfyBQDit9AR3ToU4k951Jyi"
+
+func setupReplica(t *testing.T) (*testData, peer.ID) {
+	t.Helper()
+
+	conf := testConfig()
+	conf.ReplicaPollInterval = time.Minute
+	conf.Replica = ReplicaConfig{
+		Enable:    true,
+		Primaries: []string{testPrimaryAddr},
+	}
+	primary := conf.PrimaryIDs()[0]
+	td := setup(t, conf)
+
+	return td, primary
+}
+
+func TestReplicaConfig(t *testing.T) {
+	conf := DefaultConfig()
+	assert.Nil(t, conf.PrimaryIDs())
+
+	conf.Replica.Enable = true
+	assert.ErrorContains(t, conf.BasicCheck(), "at least one primary")
+
+	conf.Replica.Primaries = []string{"/ip4/127.0.0.1/tcp/21888"}
+	assert.ErrorContains(t, conf.BasicCheck(), "invalid primary address")
+
+	conf.Replica.Primaries = []string{testPrimaryAddr}
+	assert.NoError(t, conf.BasicCheck())
+	require.Len(t, conf.PrimaryIDs(), 1)
+	assert.Equal(t, "12D3KooWDEWpKkZVxpc8hbLKQL1jvFfyBQDit9AR3ToU4k951Jyi", conf.PrimaryIDs()[0].String())
+}
+
+func TestReplicaFollowPrimaries(t *testing.T) {
+	td, primary := setupReplica(t)
+	assert.Equal(t, []peer.ID{primary}, td.sync.Primaries())
+
+	other := td.RandPeerID()
+	pub, _ := td.RandBLSKeyPair()
+	td.addPeer(t, pub, other, service.New(service.Network))
+
+	t.Run("No primary is handshaked", func(t *testing.T) {
+		td.sync.followPrimaries()
+
+		td.shouldNotPublishMessageWithThisType(t, message.TypeBlocksRequest)
+		assert.False(t, td.sync.peerSet.HasAnyOpenSession())
+	})
+
+	pub, _ = td.RandBLSKeyPair()
+	td.addPeer(t, pub, primary, service.New(service.None))
+
+	t.Run("Requesting the new blocks from the primary", func(t *testing.T) {
+		td.sync.followPrimaries()
+
+		bdl := td.shouldPublishMessageWithThisType(t, message.TypeBlocksRequest)
+		msg := bdl.Message.(*message.BlocksRequestMessage)
+		assert.Equal(t, td.state.LastBlockHeight()+1, msg.From)
+		assert.True(t, td.sync.peerSet.HasOpenSession(primary))
+		assert.False(t, td.sync.peerSet.HasOpenSession(other))
+	})
+
+	t.Run("Waiting for the open session", func(t *testing.T) {
+		td.sync.followPrimaries()
+
+		td.shouldNotPublishMessageWithThisType(t, message.TypeBlocksRequest)
+	})
+}
+
+func TestReplicaBlocksResponse(t *testing.T) {
+	td, primary := setupReplica(t)
+
+	lastHeight := td.state.LastBlockHeight()
+	blk, cert := td.GenerateTestBlock(lastHeight + 1)
+	data, _ := blk.Bytes()
+	msg := message.NewBlocksResponseMessage(message.ResponseCodeSynced, t.Name(), td.RandInt(1000),
+		lastHeight+1, [][]byte{data}, cert)
+
+	t.Run("Ignoring the blocks of the other peers", func(t *testing.T) {
+		assert.NoError(t, td.receivingNewMessage(td.sync, msg, td.RandPeerID()))
+
+		assert.Equal(t, lastHeight, td.state.LastBlockHeight())
+	})
+
+	t.Run("Committing the blocks of the primaries", func(t *testing.T) {
+		assert.NoError(t, td.receivingNewMessage(td.sync, msg, primary))
+
+		assert.Equal(t, lastHeight+1, td.state.LastBlockHeight())
+	})
+}
+
+func TestReplicaForwardTransactions(t *testing.T) {
+	td, primary := setupReplica(t)
+
+	trx, _ := td.GenerateTestTransferTx()
+
+	t.Run("No primary is handshaked", func(t *testing.T) {
+		td.broadcastCh <- message.NewTransactionsMessage([]*tx.Tx{trx})
+
+		td.shouldNotPublishMessageWithThisType(t, message.TypeTransactions)
+	})
+
+	pub, _ := td.RandBLSKeyPair()
+	td.addPeer(t, pub, primary, service.New(service.Network))
+
+	t.Run("Forwarding to the primary", func(t *testing.T) {
+		td.broadcastCh <- message.NewTransactionsMessage([]*tx.Tx{trx})
+
+		bdl := td.shouldPublishMessageWithThisType(t, message.TypeTransactions)
+		assert.True(t, bdl.Message.(*message.TransactionsMessage).Stem)
+	})
+
+	t.Run("Not broadcasting the other messages", func(t *testing.T) {
+		blk, cert := td.GenerateTestBlock(td.RandHeight())
+		td.broadcastCh <- message.NewBlockAnnounceMessage(blk, cert)
+
+		td.shouldNotPublishMessageWithThisType(t, message.TypeBlockAnnounce)
+	})
+}
@@ -0,0 +1,88 @@
+package sync
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pactus-project/pactus/sync/peerset"
+)
+
+// staleTipCheckInterval is how often the synchronizer checks whether the local tip is stale.
+const staleTipCheckInterval = 10 * time.Second
+
+// staleTip keeps track of the local tip, to detect when it stops advancing.
+// It is only accessed from the receive loop, so it doesn't need any lock.
+type staleTip struct {
+	height    uint32
+	changedAt time.Time
+}
+
+// checkStaleTip checks if the local tip has not advanced for the `StaleTipTimeout`
+// while the peers claim higher heights.
+// In this case, the node is likely wedged behind a bad set of peers.
+// A diagnostic report is logged and the connections to the current peers are closed,
+// so that the node can connect to new peers and continue syncing.
+func (sync *synchronizer) checkStaleTip(now time.Time) {
+	ourHeight := sync.stateHeight()
+	if ourHeight != sync.staleTip.height || sync.staleTip.changedAt.IsZero() {
+		sync.staleTip.height = ourHeight
+		sync.staleTip.changedAt = now
+
+		return
+	}
+
+	stalledFor := now.Sub(sync.staleTip.changedAt)
+	if stalledFor < sync.config.StaleTipTimeout {
+		return
+	}
+
+	peersHeight := sync.peerSet.MaxClaimedHeight()
+	if peersHeight <= ourHeight {
+		// No peer is ahead of us, the network might be halted.
+		return
+	}
+
+	sync.logger.Warn("local tip is stale, rotating peers",
+		"height", ourHeight, "peersHeight", peersHeight, "stalledFor", stalledFor,
+		"sessions", sync.peerSet.SessionStats(), "report", sync.staleTipReport())
+
+	// The replica syncs only from its primaries, so rotating the peers doesn't help.
+	if !sync.isReplica() {
+		sync.rotatePeers()
+	}
+
+	// Give the new peers time to help us catch up before checking again.
+	sync.staleTip.changedAt = now
+}
+
+// staleTipReport returns a diagnostic report of the handshaked peers.
+func (sync *synchronizer) staleTipReport() string {
+	builder := strings.Builder{}
+	sync.peerSet.IteratePeers(func(p *peerset.Peer) bool {
+		if p.IsKnownOrTrusty() {
+			builder.WriteString(fmt.Sprintf("{%s %s height: %d sessions: %d/%d invalid: %d} ",
+				p.PeerID.ShortString(), p.Moniker, p.Height,
+				p.CompletedSessions, p.TotalSessions, p.InvalidBundles))
+		}
+
+		return false
+	})
+
+	return strings.TrimSpace(builder.String())
+}
+
+// rotatePeers drops all the sessions and closes the connections to the handshaked peers.
+// The network module then connects to new peers.
+func (sync *synchronizer) rotatePeers() {
+	sync.peerSet.RemoveAllSessions()
+
+	sync.peerSet.IteratePeers(func(p *peerset.Peer) bool {
+		if p.IsKnownOrTrusty() {
+			sync.logger.Debug("closing connection to rotate peer", "pid", p.PeerID)
+			sync.network.CloseConnection(p.PeerID)
+		}
+
+		return false
+	})
+}
@@ -0,0 +1,88 @@
+package sync
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pactus-project/pactus/network"
+	"github.com/pactus-project/pactus/sync/peerset"
+	"github.com/pactus-project/pactus/sync/peerset/service"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStaleTip(t *testing.T) {
+	td := setup(t, nil)
+
+	td.state.CommitTestBlocks(10)
+	ourHeight := td.state.LastBlockHeight()
+
+	pub1, _ := td.RandBLSKeyPair()
+	pub2, _ := td.RandBLSKeyPair()
+	pid1 := td.RandPeerID()
+	pid2 := td.RandPeerID()
+	td.addPeer(t, pub1, pid1, service.New(service.Network))
+	td.addPeer(t, pub2, pid2, service.New(service.Network))
+	td.network.AddAnotherNetwork(network.MockingNetwork(td.TestSuite, pid1))
+	td.network.AddAnotherNetwork(network.MockingNetwork(td.TestSuite, pid2))
+	td.sync.peerSet.UpdateHeight(pid1, ourHeight, td.RandHash())
+
+	now := time.Now()
+	timeout := td.sync.config.StaleTipTimeout
+
+	t.Run("Tip is tracked on the first check", func(t *testing.T) {
+		td.sync.checkStaleTip(now)
+
+		assert.Equal(t, ourHeight, td.sync.staleTip.height)
+		assert.Equal(t, now, td.sync.staleTip.changedAt)
+	})
+
+	t.Run("No peer is ahead of us", func(t *testing.T) {
+		td.sync.checkStaleTip(now.Add(timeout))
+
+		assert.False(t, td.network.IsClosed(pid1))
+		assert.False(t, td.network.IsClosed(pid2))
+	})
+
+	td.sync.peerSet.UpdateHeight(pid2, ourHeight+100, td.RandHash())
+
+	t.Run("Tip is not stale yet", func(t *testing.T) {
+		td.sync.checkStaleTip(now.Add(timeout - time.Second))
+
+		assert.False(t, td.network.IsClosed(pid1))
+		assert.False(t, td.network.IsClosed(pid2))
+	})
+
+	t.Run("Tip is stale, rotating peers", func(t *testing.T) {
+		td.sync.peerSet.OpenSession(pid2, ourHeight+1, 10)
+		td.sync.checkStaleTip(now.Add(timeout))
+
+		assert.True(t, td.network.IsClosed(pid1))
+		assert.True(t, td.network.IsClosed(pid2))
+		assert.Zero(t, td.sync.peerSet.NumberOfSessions())
+		assert.Equal(t, now.Add(timeout), td.sync.staleTip.changedAt)
+	})
+
+	t.Run("Tip advanced", func(t *testing.T) {
+		td.state.CommitTestBlocks(1)
+		td.sync.checkStaleTip(now.Add(2 * timeout))
+
+		assert.Equal(t, ourHeight+1, td.sync.staleTip.height)
+		assert.Equal(t, now.Add(2*timeout), td.sync.staleTip.changedAt)
+	})
+}
+
+func TestStaleTipReport(t *testing.T) {
+	td := setup(t, nil)
+
+	pub, _ := td.RandBLSKeyPair()
+	pidKnown := td.RandPeerID()
+	pidConnected := td.RandPeerID()
+	td.addPeer(t, pub, pidKnown, service.New(service.Network))
+	td.sync.peerSet.UpdateHeight(pidKnown, 123, td.RandHash())
+	td.sync.peerSet.UpdateStatus(pidConnected, peerset.StatusCodeConnected)
+
+	report := td.sync.staleTipReport()
+	assert.Contains(t, report, pidKnown.ShortString())
+	assert.Contains(t, report, "height: 123")
+	assert.NotContains(t, report, pidConnected.ShortString())
+}
@@ -1,8 +1,10 @@
 package sync
 
 import (
+	"bytes"
 	"context"
 	"fmt"
+	"math"
 	"time"
 
 	"github.com/libp2p/go-libp2p/core/peer"
@@ -14,12 +16,18 @@ import (
 	"github.com/pactus-project/pactus/sync/bundle"
 	"github.com/pactus-project/pactus/sync/bundle/message"
 	"github.com/pactus-project/pactus/sync/cache"
+	"github.com/pactus-project/pactus/sync/checkpoints"
 	"github.com/pactus-project/pactus/sync/firewall"
 	"github.com/pactus-project/pactus/sync/peerset"
+	"github.com/pactus-project/pactus/sync/peerset/capability"
 	"github.com/pactus-project/pactus/sync/peerset/service"
 	"github.com/pactus-project/pactus/sync/peerset/session"
+	"github.com/pactus-project/pactus/sync/relay"
+	"github.com/pactus-project/pactus/types/vote"
 	"github.com/pactus-project/pactus/util"
 	"github.com/pactus-project/pactus/util/logger"
+	"github.com/pactus-project/pactus/util/recovery"
+	"github.com/pactus-project/pactus/version"
 )
 
 // IMPORTANT NOTES:
@@ -32,21 +40,37 @@ import (
 
 type synchronizer struct {
 	ctx         context.Context
+	cancel      context.CancelFunc
 	config      *Config
 	valKeys     []*bls.ValidatorKey
+	attestation []byte
+	primaries   []peer.ID
 	state       state.Facade
 	consMgr     consensus.Manager
 	peerSet     *peerset.PeerSet
 	firewall    *firewall.Firewall
 	cache       *cache.Cache
+	relay       *relay.Relay
+	voteLimiter *relay.VoteLimiter
 	handlers    map[message.Type]messageHandler
-	broadcastCh <-chan message.Message
-	networkCh   <-chan network.Event
-	network     network.Network
-	logger      *logger.SubLogger
+	staleTip    staleTip
+	// dominantVersion is the most common version of the peers, when the versions are observed last.
+	dominantVersion string
+	// resumeRanges are the ranges that were in flight before the restart, loaded from the checkpoint.
+	resumeRanges []blockRange
+	propagation  *propagationTracker
+	checkpoints  *checkpoints.Store
+	cpClient     *checkpoints.Client
+	broadcastCh  <-chan message.Message
+	networkCh    <-chan network.Event
+	network      network.Network
+	logger       *logger.SubLogger
 }
 
+// NewSynchronizer creates a new synchronizer.
+// The synchronizer stops working when the ctx is done or when it is stopped.
 func NewSynchronizer(
+	ctx context.Context,
 	conf *Config,
 	valKeys []*bls.ValidatorKey,
 	st state.Facade,
@@ -54,10 +78,19 @@ func NewSynchronizer(
 	net network.Network,
 	broadcastCh <-chan message.Message,
 ) (Synchronizer, error) {
+	attestation, err := conf.Attestation()
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
 	sync := &synchronizer{
-		ctx:         context.Background(), // TODO, set proper context
+		ctx:         ctx,
+		cancel:      cancel,
 		config:      conf,
 		valKeys:     valKeys,
+		attestation: attestation,
+		primaries:   conf.PrimaryIDs(),
 		state:       st,
 		consMgr:     consMgr,
 		network:     net,
@@ -66,12 +99,27 @@ func NewSynchronizer(
 	}
 
 	sync.peerSet = peerset.NewPeerSet(conf.SessionTimeout)
+	sync.relay = relay.NewRelay(conf.StemEmbargo)
+	sync.voteLimiter = relay.NewVoteLimiter(conf.VoteRelayRate, conf.VoteRelayFairShare)
+	sync.propagation = newPropagationTracker(propagationRecordsSize)
 	sync.logger = logger.NewSubLogger("_sync", sync)
-	sync.firewall = firewall.NewFirewall(conf.Firewall, net, sync.peerSet, st, sync.logger)
+	sync.firewall = firewall.NewFirewall(conf.Firewall, net, sync.peerSet, st, consMgr, sync.logger)
+	sync.checkpoints = checkpoints.NewStore(conf.Checkpoints.Quorum)
+
+	cpClient, err := checkpoints.NewClient(conf.Checkpoints, st.Genesis().Hash(),
+		sync.checkpoints, sync.checkCommittedBlock)
+	if err != nil {
+		cancel()
+
+		return nil, err
+	}
+	sync.cpClient = cpClient
 
 	cacheSize := conf.CacheSize()
 	ca, err := cache.NewCache(conf.CacheSize())
 	if err != nil {
+		cancel()
+
 		return nil, err
 	}
 	sync.cache = ca
@@ -89,6 +137,10 @@ func NewSynchronizer(
 	handlers[message.TypeBlockAnnounce] = newBlockAnnounceHandler(sync)
 	handlers[message.TypeBlocksRequest] = newBlocksRequestHandler(sync)
 	handlers[message.TypeBlocksResponse] = newBlocksResponseHandler(sync)
+	handlers[message.TypeFiltersRequest] = newFiltersRequestHandler(sync)
+	handlers[message.TypeFiltersResponse] = newFiltersResponseHandler(sync)
+	handlers[message.TypePing] = newPingHandler(sync)
+	handlers[message.TypePong] = newPongHandler(sync)
 
 	sync.handlers = handlers
 
@@ -96,6 +148,25 @@ func NewSynchronizer(
 }
 
 func (sync *synchronizer) Start() error {
+	sync.cpClient.Start(sync.ctx)
+
+	if minVersion := sync.state.Genesis().Params().MinProtocolVersion; minVersion > version.ProtocolVersion() {
+		sync.logger.Error("the network doesn't accept our protocol version, please upgrade the node",
+			"min", minVersion, "ours", version.ProtocolVersion())
+	}
+
+	if sync.isReplica() {
+		// The replica doesn't join the gossip topics and syncs only from the primaries.
+		// It still validates the blocks, but it relies on the primaries to receive them.
+		sync.logger.Warn("running as a read replica, trusting the primaries for the new blocks",
+			"primaries", sync.primaries)
+
+		go recovery.Loop("sync", sync.logger, sync.receiveLoop)
+		go recovery.Loop("sync", sync.logger, sync.broadcastLoop)
+
+		return nil
+	}
+
 	if err := sync.network.JoinGeneralTopic(sync.shouldPropagateGeneralMessage); err != nil {
 		return err
 	}
@@ -104,14 +175,14 @@ func (sync *synchronizer) Start() error {
 		return err
 	}
 
-	go sync.receiveLoop()
-	go sync.broadcastLoop()
+	go recovery.Loop("sync", sync.logger, sync.receiveLoop)
+	go recovery.Loop("sync", sync.logger, sync.broadcastLoop)
 
 	return nil
 }
 
 func (sync *synchronizer) Stop() {
-	sync.ctx.Done()
+	sync.cancel()
 }
 
 func (sync *synchronizer) stateHeight() uint32 {
@@ -161,9 +232,13 @@ func (sync *synchronizer) prepareBundle(msg message.Message) *bundle.Bundle {
 func (sync *synchronizer) sendTo(msg message.Message, to peer.ID) {
 	bdl := sync.prepareBundle(msg)
 	if bdl != nil {
+		if util.IsFlagSet(bdl.Flags, bundle.BundleFlagCompressed) &&
+			!sync.peerSet.HasCapability(to, capability.Compression) {
+			bdl.Flags = util.UnsetFlag(bdl.Flags, bundle.BundleFlagCompressed)
+		}
 		data, _ := bdl.Encode()
 
-		err := sync.network.SendTo(data, to)
+		err := sync.network.SendTo(data, to, sendPriority(msg.Type()))
 		if err != nil {
 			sync.logger.Debug("error on sending the bundle, closing the connection",
 				"bundle", bdl, "to", to, "error", err)
@@ -179,6 +254,45 @@ func (sync *synchronizer) sendTo(msg message.Message, to peer.ID) {
 	}
 }
 
+// sendPings sends a ping to the handshaked peers that support it,
+// to measure their round-trip time and clock offset.
+func (sync *synchronizer) sendPings(now time.Time) {
+	pids := []peer.ID{}
+	sync.peerSet.IteratePeers(func(p *peerset.Peer) bool {
+		if p.IsKnownOrTrusty() && p.HasCapability(capability.Ping) {
+			pids = append(pids, p.PeerID)
+		}
+
+		return false
+	})
+
+	for _, pid := range pids {
+		// The nonce is never zero, since the zero nonce means no ping is sent.
+		nonce := util.RandUint64(math.MaxUint64) + 1
+		sync.peerSet.UpdatePingSent(pid, nonce, now)
+		sync.sendTo(message.NewPingMessage(nonce, now.UnixNano()), pid)
+	}
+}
+
+// sendPriority returns the priority of the direct message in the outbound queue of the peer.
+// The handshake and consensus messages are time sensitive, while the blocks and filters
+// are bulky and can wait.
+func sendPriority(msgType message.Type) network.Priority {
+	switch msgType {
+	case message.TypeHello, message.TypeHelloAck,
+		message.TypePing, message.TypePong,
+		message.TypeQueryProposal, message.TypeProposal,
+		message.TypeQueryVotes, message.TypeVote:
+		return network.PriorityHigh
+
+	case message.TypeBlocksResponse, message.TypeFiltersResponse:
+		return network.PriorityLow
+
+	default:
+		return network.PriorityNormal
+	}
+}
+
 func (sync *synchronizer) broadcast(msg message.Message) {
 	if msg.Type() == message.TypeBlockAnnounce {
 		m := msg.(*message.BlockAnnounceMessage)
@@ -221,15 +335,38 @@ func (sync *synchronizer) Services() service.Services {
 	return sync.config.Services()
 }
 
+func (sync *synchronizer) Capabilities() capability.Capabilities {
+	return sync.config.Capabilities()
+}
+
+func (sync *synchronizer) Attestation() []byte {
+	return sync.attestation
+}
+
+// PropagationRecords returns the first-seen records of the recent blocks and proposals,
+// from the oldest to the newest.
+func (sync *synchronizer) PropagationRecords() []PropagationRecord {
+	return sync.propagation.list()
+}
+
+// PeerEvidences returns the evidences of penalizing the peer, or all the peers if the peer ID is empty,
+// from the oldest to the newest.
+func (sync *synchronizer) PeerEvidences(pid peer.ID) []firewall.Evidence {
+	return sync.firewall.Evidences(pid)
+}
+
 func (sync *synchronizer) sayHello(to peer.ID) {
 	msg := message.NewHelloMessage(
 		sync.SelfID(),
 		sync.config.Moniker,
 		sync.stateHeight(),
 		sync.config.Services(),
+		sync.config.Capabilities(),
 		sync.state.LastBlockHash(),
 		sync.state.Genesis().Hash(),
 	)
+	msg.Attestation = sync.attestation
+	msg.PausedValidators = sync.state.PausedValidators()
 	msg.Sign(sync.valKeys)
 
 	sync.logger.Info("sending Hello message", "to", to)
@@ -243,17 +380,58 @@ func (sync *synchronizer) broadcastLoop() {
 			return
 
 		case msg := <-sync.broadcastCh:
-			sync.broadcast(msg)
+			m, isTransactions := msg.(*message.TransactionsMessage)
+			switch {
+			case sync.isReplica():
+				// The replica doesn't gossip, the transactions are relayed by the primaries.
+				if isTransactions {
+					sync.forwardTransactions(m.Transactions)
+				}
+			case isTransactions:
+				sync.relayLocalTransactions(m.Transactions)
+			default:
+				sync.broadcast(msg)
+			}
 		}
 	}
 }
 
 func (sync *synchronizer) receiveLoop() {
+	ticker := time.NewTicker(staleTipCheckInterval)
+	defer ticker.Stop()
+
+	pingTicker := time.NewTicker(sync.config.PingInterval)
+	defer pingTicker.Stop()
+
+	embargoTicker := time.NewTicker(embargoCheckInterval)
+	defer embargoTicker.Stop()
+
+	var replicaCh <-chan time.Time
+	if sync.isReplica() {
+		replicaTicker := time.NewTicker(sync.config.ReplicaPollInterval)
+		defer replicaTicker.Stop()
+
+		replicaCh = replicaTicker.C
+	}
+
 	for {
 		select {
 		case <-sync.ctx.Done():
 			return
 
+		case now := <-ticker.C:
+			sync.checkStaleTip(now)
+			sync.observeVersions()
+
+		case now := <-pingTicker.C:
+			sync.sendPings(now)
+
+		case now := <-embargoTicker.C:
+			sync.checkEmbargoes(now)
+
+		case <-replicaCh:
+			sync.followPrimaries()
+
 		case e := <-sync.networkCh:
 			switch e.Type() {
 			case network.EventTypeGossip:
@@ -369,6 +547,8 @@ func (sync *synchronizer) updateBlockchain() {
 		downloadHeight++
 	}
 
+	sync.resumeDownloads()
+
 	// Check if we have any expired sessions
 	sync.peerSet.SetExpiredSessionsAsUncompleted()
 
@@ -435,7 +615,13 @@ func (sync *synchronizer) downloadBlocks(from uint32, onlyNodeNetwork bool) {
 
 func (sync *synchronizer) sendBlockRequestToRandomPeer(from, count uint32, onlyNodeNetwork bool) bool {
 	for i := sync.peerSet.NumberOfSessions(); i < sync.config.MaxSessions; i++ {
-		p := sync.peerSet.GetRandomPeer()
+		var p *peerset.Peer
+		if sync.isReplica() {
+			// The replica downloads the blocks only from its primaries.
+			p = sync.randomPrimary()
+		} else {
+			p = sync.peerSet.GetRandomPeer()
+		}
 		if p == nil {
 			break
 		}
@@ -453,7 +639,7 @@ func (sync *synchronizer) sendBlockRequestToRandomPeer(from, count uint32, onlyN
 			continue
 		}
 
-		if onlyNodeNetwork && !p.HasNetworkService() {
+		if onlyNodeNetwork && !p.HasNetworkService() && !sync.isReplica() {
 			if onlyNodeNetwork {
 				sync.network.CloseConnection(p.PeerID)
 			}
@@ -537,6 +723,11 @@ func (sync *synchronizer) tryCommitBlocks() error {
 
 			return err
 		}
+		if err := sync.checkpoints.Check(height, blk.Hash()); err != nil {
+			onError(height, err)
+
+			return err
+		}
 		if err := cert.BasicCheck(); err != nil {
 			onError(height, err)
 
@@ -555,6 +746,19 @@ func (sync *synchronizer) tryCommitBlocks() error {
 	return nil
 }
 
+// checkCommittedBlock is called when a checkpoint becomes trusted.
+// The committed blocks can't be reverted, so a mismatch is only reported.
+func (sync *synchronizer) checkCommittedBlock(cp checkpoints.Checkpoint) {
+	if cp.Height > sync.stateHeight() {
+		return
+	}
+
+	if blockHash := sync.state.BlockHash(cp.Height); blockHash != cp.BlockHash {
+		sync.logger.Error("committed block doesn't match the trusted checkpoint",
+			"height", cp.Height, "expected", cp.BlockHash, "got", blockHash)
+	}
+}
+
 func (sync *synchronizer) prepareBlocks(from, count uint32) [][]byte {
 	ourHeight := sync.stateHeight()
 
@@ -584,12 +788,46 @@ func (sync *synchronizer) prepareBlocks(from, count uint32) [][]byte {
 	return blocks
 }
 
-// weAreInTheCommittee checks if one of the validators is a member of the committee
-// at the current height.
 func (sync *synchronizer) shouldPropagateGeneralMessage(_ *network.GossipMessage) bool {
 	return true
 }
 
-func (sync *synchronizer) shouldPropagateConsensusMessage(_ *network.GossipMessage) bool {
-	return true
+// shouldPropagateConsensusMessage limits the rate of the relayed votes.
+// Under the pressure, the votes with the higher priority are relayed first.
+// The votes that are not relayed are still processed by this node.
+func (sync *synchronizer) shouldPropagateConsensusMessage(msg *network.GossipMessage) bool {
+	if msg.From == sync.SelfID() {
+		// Our own messages are always published.
+		return true
+	}
+
+	bdl := new(bundle.Bundle)
+	if _, err := bdl.Decode(bytes.NewReader(msg.Data)); err != nil {
+		return true
+	}
+
+	voteMsg, ok := bdl.Message.(*message.VoteMessage)
+	if !ok || voteMsg.Vote == nil {
+		return true
+	}
+
+	return sync.voteLimiter.Allow(sync.votePriority(voteMsg.Vote), time.Now())
+}
+
+// votePriority returns the priority of relaying the vote, between zero and one.
+// The votes of the committee members for the next height have the higher priority,
+// and within each group, the votes are weighted by the stake of the voter.
+func (sync *synchronizer) votePriority(v *vote.Vote) float64 {
+	weight := 0.0
+	val := sync.state.ValidatorByAddress(v.Signer())
+	if val != nil {
+		weight = min(float64(val.Stake())/float64(sync.state.Params().MaximumStake), 1)
+	}
+
+	if v.Height() == sync.stateHeight()+1 &&
+		sync.state.IsInCommittee(v.Signer()) {
+		return 0.5 + weight/2
+	}
+
+	return weight / 2
 }
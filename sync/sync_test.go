@@ -1,6 +1,8 @@
 package sync
 
 import (
+	"context"
+
 	"bytes"
 	"fmt"
 	"testing"
@@ -14,6 +16,7 @@ import (
 	"github.com/pactus-project/pactus/state"
 	"github.com/pactus-project/pactus/sync/bundle"
 	"github.com/pactus-project/pactus/sync/bundle/message"
+	"github.com/pactus-project/pactus/sync/checkpoints"
 	"github.com/pactus-project/pactus/sync/firewall"
 	"github.com/pactus-project/pactus/sync/peerset"
 	"github.com/pactus-project/pactus/sync/peerset/service"
@@ -47,7 +50,11 @@ func testConfig() *Config {
 		BlockPerMessage:     11,
 		MaxSessions:         8,
 		LatestBlockInterval: 23,
+		StaleTipTimeout:     time.Minute,
+		PingInterval:        time.Minute,
+		StemEmbargo:         time.Minute,
 		Firewall:            firewall.DefaultConfig(),
+		Checkpoints:         checkpoints.DefaultConfig(),
 	}
 }
 
@@ -69,7 +76,7 @@ func setup(t *testing.T, config *Config) *testData {
 	broadcastCh := make(chan message.Message, 1000)
 	mockNetwork := network.MockingNetwork(ts, ts.RandPeerID())
 
-	Sync, err := NewSynchronizer(config,
+	Sync, err := NewSynchronizer(context.Background(), config,
 		valKeys,
 		mockState,
 		consMgr,
@@ -224,6 +231,7 @@ func TestStop(t *testing.T) {
 
 	// Should stop gracefully.
 	td.sync.Stop()
+	assert.ErrorIs(t, td.sync.ctx.Err(), context.Canceled)
 }
 
 func TestConnectEvent(t *testing.T) {
@@ -411,3 +419,13 @@ func TestBundleSequenceNo(t *testing.T) {
 	bdl2 := td.shouldPublishMessageWithThisType(t, message.TypeQueryProposal)
 	assert.Equal(t, 1, bdl2.SequenceNo)
 }
+
+func TestSendPriority(t *testing.T) {
+	assert.Equal(t, network.PriorityHigh, sendPriority(message.TypeHello))
+	assert.Equal(t, network.PriorityHigh, sendPriority(message.TypeVote))
+	assert.Equal(t, network.PriorityHigh, sendPriority(message.TypeProposal))
+	assert.Equal(t, network.PriorityNormal, sendPriority(message.TypeBlocksRequest))
+	assert.Equal(t, network.PriorityNormal, sendPriority(message.TypeTransactions))
+	assert.Equal(t, network.PriorityLow, sendPriority(message.TypeBlocksResponse))
+	assert.Equal(t, network.PriorityLow, sendPriority(message.TypeFiltersResponse))
+}
@@ -0,0 +1,129 @@
+package sync
+
+import (
+	"time"
+
+	lp2pnetwork "github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/pactus-project/pactus/sync/bundle/message"
+	"github.com/pactus-project/pactus/sync/peerset"
+	"github.com/pactus-project/pactus/sync/peerset/capability"
+	"github.com/pactus-project/pactus/sync/relay"
+	"github.com/pactus-project/pactus/types/tx"
+	"github.com/pactus-project/pactus/util/txtrace"
+)
+
+// embargoCheckInterval is how often the synchronizer checks for the expired embargoes.
+const embargoCheckInterval = time.Second
+
+// relayLocalTransactions relays the transactions that are created by this node.
+// If the stem relay is enabled, they are sent to the stem peer. Otherwise, they are broadcasted.
+func (sync *synchronizer) relayLocalTransactions(trxs []*tx.Tx) {
+	if sync.config.StemRelay {
+		if stemPeer, ok := sync.relay.StemPeer(sync.stemCandidates(), time.Now()); ok {
+			sync.stemTransactions(trxs, stemPeer)
+
+			return
+		}
+		sync.logger.Debug("no stem peer, broadcasting the transactions")
+	}
+
+	sync.fluffTransactions(trxs)
+}
+
+// relayStemTransactions relays the transactions that are received in the stem phase.
+// They are either forwarded to the stem peer, or broadcasted to the network.
+func (sync *synchronizer) relayStemTransactions(trxs []*tx.Tx, from peer.ID) {
+	if !relay.ShouldFluff() {
+		stemPeer, ok := sync.relay.StemPeer(sync.stemCandidates(), time.Now())
+		if ok && stemPeer != from {
+			sync.stemTransactions(trxs, stemPeer)
+
+			return
+		}
+	}
+
+	sync.fluffTransactions(trxs)
+}
+
+// stemTransactions embargoes the transactions and sends them to the stem peer.
+func (sync *synchronizer) stemTransactions(trxs []*tx.Tx, stemPeer peer.ID) {
+	now := time.Now()
+	for _, trx := range trxs {
+		sync.relay.Embargo(trx.ID(), now)
+	}
+
+	msg := message.NewStemTransactionsMessage(trxs)
+	sync.afterDiffusionDelay(func() {
+		sync.sendTo(msg, stemPeer)
+		traceGossiped(trxs)
+	})
+}
+
+// fluffTransactions broadcasts the transactions to the network.
+func (sync *synchronizer) fluffTransactions(trxs []*tx.Tx) {
+	for _, trx := range trxs {
+		sync.relay.Lift(trx.ID())
+	}
+
+	msg := message.NewTransactionsMessage(trxs)
+	sync.afterDiffusionDelay(func() {
+		sync.broadcast(msg)
+		traceGossiped(trxs)
+	})
+}
+
+func traceGossiped(trxs []*tx.Tx) {
+	for _, trx := range trxs {
+		txtrace.Record(trx.ID(), txtrace.StageGossiped)
+	}
+}
+
+// afterDiffusionDelay calls the function after a random delay.
+func (sync *synchronizer) afterDiffusionDelay(fn func()) {
+	delay := relay.DiffusionDelay(sync.config.TxRelayDelay)
+	if delay == 0 {
+		fn()
+
+		return
+	}
+
+	time.AfterFunc(delay, func() {
+		if sync.ctx.Err() == nil {
+			fn()
+		}
+	})
+}
+
+// stemCandidates returns the handshaked outbound peers that support the stem relay.
+// The inbound peers are not used, since an attacker can easily connect to the node.
+func (sync *synchronizer) stemCandidates() []peer.ID {
+	pids := []peer.ID{}
+	sync.peerSet.IteratePeers(func(p *peerset.Peer) bool {
+		if p.IsKnownOrTrusty() &&
+			p.Direction == lp2pnetwork.DirOutbound.String() &&
+			p.HasCapability(capability.StemRelay) {
+			pids = append(pids, p.PeerID)
+		}
+
+		return false
+	})
+
+	return pids
+}
+
+// checkEmbargoes broadcasts the embargoed transactions that are not seen in the network on time.
+func (sync *synchronizer) checkEmbargoes(now time.Time) {
+	trxs := []*tx.Tx{}
+	for _, id := range sync.relay.Expired(now) {
+		trx := sync.state.PendingTx(id)
+		if trx != nil {
+			trxs = append(trxs, trx)
+		}
+	}
+
+	if len(trxs) > 0 {
+		sync.logger.Info("embargo expired, broadcasting the transactions", "count", len(trxs))
+		sync.broadcast(message.NewTransactionsMessage(trxs))
+	}
+}
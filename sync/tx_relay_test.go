@@ -0,0 +1,165 @@
+package sync
+
+import (
+	"testing"
+	"time"
+
+	lp2pnetwork "github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/pactus-project/pactus/sync/bundle/message"
+	"github.com/pactus-project/pactus/sync/peerset/capability"
+	"github.com/pactus-project/pactus/sync/peerset/service"
+	"github.com/pactus-project/pactus/types/tx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func (td *testData) addStemPeer(t *testing.T, pid peer.ID) {
+	t.Helper()
+
+	pub, _ := td.RandBLSKeyPair()
+	td.addPeer(t, pub, pid, service.New(service.None))
+	td.sync.peerSet.UpdateAddress(pid, "", lp2pnetwork.DirOutbound.String())
+	td.sync.peerSet.UpdateCapabilities(pid, 1,
+		capability.New(capability.StemRelay), capability.New(capability.StemRelay))
+}
+
+func TestStemCandidates(t *testing.T) {
+	td := setup(t, nil)
+
+	pid1 := td.RandPeerID()
+	pid2 := td.RandPeerID()
+	pid3 := td.RandPeerID()
+	td.addStemPeer(t, pid1)
+	td.addStemPeer(t, pid2)
+	td.addStemPeer(t, pid3)
+	// Inbound peer
+	td.sync.peerSet.UpdateAddress(pid2, "", lp2pnetwork.DirInbound.String())
+	// Doesn't support the stem relay
+	td.sync.peerSet.UpdateCapabilities(pid3, 1, capability.New(capability.None), capability.New(capability.None))
+
+	assert.Equal(t, []peer.ID{pid1}, td.sync.stemCandidates())
+}
+
+func TestRelayLocalTransactions(t *testing.T) {
+	t.Run("Stem relay is disabled", func(t *testing.T) {
+		td := setup(t, nil)
+
+		td.addStemPeer(t, td.RandPeerID())
+		trx, _ := td.GenerateTestTransferTx()
+		td.broadcastCh <- message.NewTransactionsMessage([]*tx.Tx{trx})
+
+		bdl := td.shouldPublishMessageWithThisType(t, message.TypeTransactions)
+		assert.False(t, bdl.Message.(*message.TransactionsMessage).Stem)
+		assert.False(t, td.sync.relay.IsEmbargoed(trx.ID()))
+	})
+
+	t.Run("No stem peer", func(t *testing.T) {
+		conf := testConfig()
+		conf.StemRelay = true
+		td := setup(t, conf)
+
+		trx, _ := td.GenerateTestTransferTx()
+		td.broadcastCh <- message.NewTransactionsMessage([]*tx.Tx{trx})
+
+		bdl := td.shouldPublishMessageWithThisType(t, message.TypeTransactions)
+		assert.False(t, bdl.Message.(*message.TransactionsMessage).Stem)
+		assert.False(t, td.sync.relay.IsEmbargoed(trx.ID()))
+	})
+
+	t.Run("Sending to the stem peer", func(t *testing.T) {
+		conf := testConfig()
+		conf.StemRelay = true
+		td := setup(t, conf)
+
+		pid := td.RandPeerID()
+		td.addStemPeer(t, pid)
+		trx, _ := td.GenerateTestTransferTx()
+		td.broadcastCh <- message.NewTransactionsMessage([]*tx.Tx{trx})
+
+		bdl := td.shouldPublishMessageWithThisType(t, message.TypeTransactions)
+		assert.True(t, bdl.Message.(*message.TransactionsMessage).Stem)
+		assert.True(t, td.sync.relay.IsEmbargoed(trx.ID()))
+		assert.Positive(t, td.sync.peerSet.GetPeer(pid).SentBytes[message.TypeTransactions])
+	})
+}
+
+func TestParsingStemTransactions(t *testing.T) {
+	td := setup(t, nil)
+
+	t.Run("Fluff when there is no other stem peer", func(t *testing.T) {
+		pid := td.RandPeerID()
+		td.addStemPeer(t, pid)
+
+		trx, _ := td.GenerateTestTransferTx()
+		msg := message.NewStemTransactionsMessage([]*tx.Tx{trx})
+		assert.NoError(t, td.receivingNewMessage(td.sync, msg, pid))
+
+		assert.NotNil(t, td.sync.state.PendingTx(trx.ID()))
+		bdl := td.shouldPublishMessageWithThisType(t, message.TypeTransactions)
+		assert.False(t, bdl.Message.(*message.TransactionsMessage).Stem)
+		assert.False(t, td.sync.relay.IsEmbargoed(trx.ID()))
+	})
+
+	t.Run("Ignore the known transactions", func(t *testing.T) {
+		trx, _ := td.GenerateTestTransferTx()
+		require.NoError(t, td.sync.state.AddPendingTx(trx))
+
+		msg := message.NewStemTransactionsMessage([]*tx.Tx{trx})
+		assert.NoError(t, td.receivingNewMessage(td.sync, msg, td.RandPeerID()))
+
+		td.shouldNotPublishMessageWithThisType(t, message.TypeTransactions)
+	})
+
+	t.Run("Stem or fluff", func(t *testing.T) {
+		stemPeer := td.RandPeerID()
+		td.addStemPeer(t, stemPeer)
+
+		trx, _ := td.GenerateTestTransferTx()
+		msg := message.NewStemTransactionsMessage([]*tx.Tx{trx})
+		assert.NoError(t, td.receivingNewMessage(td.sync, msg, td.RandPeerID()))
+
+		bdl := td.shouldPublishMessageWithThisType(t, message.TypeTransactions)
+		if bdl.Message.(*message.TransactionsMessage).Stem {
+			assert.True(t, td.sync.relay.IsEmbargoed(trx.ID()))
+		} else {
+			assert.False(t, td.sync.relay.IsEmbargoed(trx.ID()))
+		}
+	})
+}
+
+func TestEmbargo(t *testing.T) {
+	td := setup(t, nil)
+
+	t.Run("Broadcasted transactions lift the embargo", func(t *testing.T) {
+		trx, _ := td.GenerateTestTransferTx()
+		td.sync.relay.Embargo(trx.ID(), time.Now())
+
+		msg := message.NewTransactionsMessage([]*tx.Tx{trx})
+		assert.NoError(t, td.receivingNewMessage(td.sync, msg, td.RandPeerID()))
+
+		assert.False(t, td.sync.relay.IsEmbargoed(trx.ID()))
+		td.shouldNotPublishMessageWithThisType(t, message.TypeTransactions)
+	})
+
+	t.Run("Broadcast the transactions when the embargo expires", func(t *testing.T) {
+		trx1, _ := td.GenerateTestTransferTx()
+		trx2, _ := td.GenerateTestTransferTx()
+		require.NoError(t, td.sync.state.AddPendingTx(trx1))
+		now := time.Now()
+		td.sync.relay.Embargo(trx1.ID(), now)
+		// Not in the pool anymore
+		td.sync.relay.Embargo(trx2.ID(), now)
+
+		td.sync.checkEmbargoes(now)
+		td.shouldNotPublishMessageWithThisType(t, message.TypeTransactions)
+
+		td.sync.checkEmbargoes(now.Add(2 * td.config.StemEmbargo))
+		bdl := td.shouldPublishMessageWithThisType(t, message.TypeTransactions)
+		trxs := bdl.Message.(*message.TransactionsMessage).Transactions
+		require.Len(t, trxs, 1)
+		assert.Equal(t, trx1.ID(), trxs[0].ID())
+		assert.False(t, td.sync.relay.IsEmbargoed(trx1.ID()))
+		assert.False(t, td.sync.relay.IsEmbargoed(trx2.ID()))
+	})
+}
@@ -0,0 +1,70 @@
+package sync
+
+import (
+	"strconv"
+
+	"github.com/pactus-project/pactus/util"
+	"github.com/pactus-project/pactus/version"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var peerVersionsGauge = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+	Namespace: "pactus",
+	Subsystem: "sync",
+	Name:      "peer_versions",
+	Help:      "The number of the known peers by the version of the node, the commit and the protocol version.",
+}, []string{"version", "commit", "protocol_version"})
+
+var rejectedVersionsCounter = prometheus.NewCounterVec(prometheus.CounterOpts{
+	Namespace: "pactus",
+	Subsystem: "sync",
+	Name:      "rejected_peer_versions_total",
+	Help:      "The number of the peers that are rejected for their old protocol version, by the protocol version.",
+}, []string{"protocol_version"})
+
+func init() {
+	prometheus.MustRegister(peerVersionsGauge)
+	prometheus.MustRegister(rejectedVersionsCounter)
+}
+
+// minProtocolVersion returns the minimum protocol version of the peers,
+// the higher one of the config and the genesis.
+func (sync *synchronizer) minProtocolVersion() uint32 {
+	return util.Max(sync.config.MinProtocolVersion, sync.state.Genesis().Params().MinProtocolVersion)
+}
+
+// observeVersions updates the version mix of the peers in the metrics,
+// so the operators can alert on the shifts of the mix during the upgrade campaigns.
+// A warning is logged when the most common version of the peers changes.
+// It is only called from the receive loop, so it doesn't need any lock.
+func (sync *synchronizer) observeVersions() {
+	stats := sync.peerSet.VersionStats()
+
+	peerVersionsGauge.Reset()
+	total := 0
+	for _, stat := range stats {
+		peerVersionsGauge.WithLabelValues(stat.Version, stat.Commit,
+			strconv.FormatUint(uint64(stat.ProtocolVersion), 10)).Set(float64(stat.Count))
+		total += stat.Count
+	}
+
+	if len(stats) == 0 {
+		return
+	}
+
+	dominant := stats[0]
+	if dominant.Version == sync.dominantVersion {
+		return
+	}
+
+	if sync.dominantVersion != "" {
+		sync.logger.Warn("the most common version of the peers has changed",
+			"from", sync.dominantVersion, "to", dominant.Version,
+			"peers", dominant.Count, "total", total, "ours", version.Version())
+	}
+	if dominant.ProtocolVersion > version.ProtocolVersion() {
+		sync.logger.Warn("most of the peers speak a newer protocol, consider upgrading the node",
+			"protocolVersion", dominant.ProtocolVersion, "ours", version.ProtocolVersion())
+	}
+	sync.dominantVersion = dominant.Version
+}
@@ -0,0 +1,53 @@
+package sync
+
+import (
+	"testing"
+
+	"github.com/pactus-project/pactus/sync/peerset"
+	"github.com/pactus-project/pactus/sync/peerset/service"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestObserveVersions(t *testing.T) {
+	td := setup(t, nil)
+
+	addPeer := func(agent string) {
+		pid := td.RandPeerID()
+		td.sync.peerSet.UpdateInfo(pid, "moniker", agent, nil, service.New())
+		td.sync.peerSet.UpdateStatus(pid, peerset.StatusCodeKnown)
+	}
+
+	oldAgent := "node=daemon/node-version=v1.1.0/protocol-version=1/os=linux/arch=amd64"
+	newAgent := "node=daemon/node-version=v1.2.0/protocol-version=1/os=linux/arch=amd64/commit=0123456789ab"
+
+	t.Run("No peer", func(t *testing.T) {
+		td.sync.observeVersions()
+
+		assert.Empty(t, td.sync.dominantVersion)
+	})
+
+	addPeer(oldAgent)
+	addPeer(oldAgent)
+	addPeer(newAgent)
+
+	t.Run("Most of the peers run the old version", func(t *testing.T) {
+		td.sync.observeVersions()
+
+		assert.Equal(t, "1.1.0", td.sync.dominantVersion)
+		assert.Equal(t, float64(2), testutil.ToFloat64(peerVersionsGauge.WithLabelValues("1.1.0", "", "1")))
+		assert.Equal(t, float64(1),
+			testutil.ToFloat64(peerVersionsGauge.WithLabelValues("1.2.0", "0123456789ab", "1")))
+	})
+
+	addPeer(newAgent)
+	addPeer(newAgent)
+
+	t.Run("The mix has shifted to the new version", func(t *testing.T) {
+		td.sync.observeVersions()
+
+		assert.Equal(t, "1.2.0", td.sync.dominantVersion)
+		assert.Equal(t, float64(3),
+			testutil.ToFloat64(peerVersionsGauge.WithLabelValues("1.2.0", "0123456789ab", "1")))
+	})
+}
@@ -0,0 +1,232 @@
+package main
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/pactus-project/pactus/config"
+	"github.com/pactus-project/pactus/crypto"
+	"github.com/pactus-project/pactus/crypto/bls"
+	"github.com/pactus-project/pactus/genesis"
+	"github.com/pactus-project/pactus/node"
+	"github.com/pactus-project/pactus/types/account"
+	"github.com/pactus-project/pactus/types/param"
+	"github.com/pactus-project/pactus/types/validator"
+	"github.com/pactus-project/pactus/util"
+	"github.com/pactus-project/pactus/util/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+var (
+	tNode      *node.Node
+	tFaucetKey *bls.PrivateKey
+)
+
+func TestMain(m *testing.M) {
+	// Prevent log from messing the workspace
+	logger.LogFilename = util.TempFilePath()
+
+	if err := setup(); err != nil {
+		panic(err)
+	}
+
+	exitCode := m.Run()
+
+	tNode.Stop()
+
+	os.Exit(exitCode)
+}
+
+// setup starts a tiny in-process network with a single node, and funds the faucet account in the genesis.
+func setup() error {
+	valKey, err := randValidatorKey()
+	if err != nil {
+		return err
+	}
+	tFaucetKey, err = randPrivateKey()
+	if err != nil {
+		return err
+	}
+
+	treasury := account.NewAccount(0)
+	treasury.AddToBalance(21 * 1e15)
+	faucet := account.NewAccount(1)
+	faucet.AddToBalance(1000e9)
+	accs := map[crypto.Address]*account.Account{
+		crypto.TreasuryAddress:                        treasury,
+		tFaucetKey.PublicKeyNative().AccountAddress(): faucet,
+	}
+	vals := []*validator.Validator{validator.NewValidator(valKey.PublicKey(), 0)}
+
+	params := param.DefaultParams()
+	params.BlockIntervalInSecond = 1
+	params.CommitteeSize = 4
+	genDoc := genesis.MakeGenesis(util.Now(), accs, vals, params)
+	crypto.SetSignDomain(genDoc.SignDomain())
+
+	tNode, err = node.NewNode(genDoc, nodeConfig(),
+		[]*bls.ValidatorKey{valKey},
+		[]crypto.Address{valKey.PublicKey().AccountAddress()})
+	if err != nil {
+		return err
+	}
+	if err := tNode.Start(); err != nil {
+		return err
+	}
+
+	// Wait for a few blocks, so the previous block of the last block is not the genesis.
+	for tNode.State().LastBlockHeight() < 2 {
+		time.Sleep(100 * time.Millisecond)
+	}
+
+	return nil
+}
+
+func nodeConfig() *config.Config {
+	conf := config.DefaultConfigLocalnet()
+
+	conf.Store.Path = util.TempDirPath()
+	conf.Logger.Levels["default"] = "error"
+	conf.Logger.Levels["_state"] = "error"
+	conf.Logger.Levels["_sync"] = "error"
+	conf.Logger.Levels["_consensus"] = "error"
+	conf.Logger.Levels["_network"] = "error"
+	conf.Logger.Levels["_pool"] = "error"
+	conf.Sync.NodeNetwork = false
+	conf.Sync.Firewall.Enabled = false
+	conf.Network.EnableMdns = false
+	conf.Network.EnableRelay = false
+	conf.Network.DefaultBootstrapAddrStrings = []string{}
+	conf.Network.BootstrapAddrStrings = []string{}
+	conf.Network.ForcePrivateNetwork = true
+	conf.Network.NetworkKey = util.TempFilePath()
+	conf.Network.AnchorsFile = util.TempFilePath()
+	conf.Network.AddrBookFile = util.TempFilePath()
+	conf.Network.NetworkName = "conformance-test"
+	conf.Network.ListenAddrStrings = []string{"/ip4/127.0.0.1/tcp/0"}
+	conf.GRPC.Enable = true
+	conf.GRPC.Listen = "127.0.0.1:0"
+	conf.GRPC.UnixSocket = ""
+	conf.GRPC.Gateway.Enable = false
+	conf.HTTP.Enable = false
+	conf.Nanomsg.Enable = false
+
+	return conf
+}
+
+func randPrivateKey() (*bls.PrivateKey, error) {
+	ikm := make([]byte, 32)
+	if _, err := rand.Read(ikm); err != nil {
+		return nil, err
+	}
+
+	return bls.KeyGen(ikm, nil)
+}
+
+func randValidatorKey() (*bls.ValidatorKey, error) {
+	prv, err := randPrivateKey()
+	if err != nil {
+		return nil, err
+	}
+
+	return bls.NewValidatorKey(prv), nil
+}
+
+func setupRunner(t *testing.T, faucetKey *bls.PrivateKey) *runner {
+	t.Helper()
+
+	conn, err := grpc.Dial(tNode.GRPC().Address(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+
+	return newRunner(conn, runnerConfig{
+		CallTimeout:    10 * time.Second,
+		TxTimeout:      time.Minute,
+		TransferAmount: 1e6,
+		FaucetKey:      faucetKey,
+	})
+}
+
+func TestAllScenarios(t *testing.T) {
+	rnr := setupRunner(t, tFaucetKey)
+
+	report := rnr.Run(context.Background(), tNode.GRPC().Address(), allScenarios())
+
+	for _, res := range report.Results {
+		assert.Equal(t, StatusPassed, res.Status, "scenario %s: %s", res.Name, res.Reason)
+	}
+	assert.True(t, report.Compatible())
+	assert.Equal(t, len(allScenarios()), report.Passed)
+	assert.NotEmpty(t, report.Agent)
+}
+
+func TestSkipFundedScenarios(t *testing.T) {
+	rnr := setupRunner(t, nil)
+
+	report := rnr.Run(context.Background(), tNode.GRPC().Address(), []scenario{
+		{Name: "node_info", Run: checkNodeInfo},
+		{Name: "transfer", Funded: true, Run: checkTransfer},
+	})
+
+	assert.True(t, report.Compatible())
+	assert.Equal(t, 1, report.Passed)
+	assert.Equal(t, 1, report.Skipped)
+	assert.Equal(t, StatusSkipped, report.Results[1].Status)
+	assert.Contains(t, report.Results[1].Reason, faucetKeyEnv)
+}
+
+func TestFailedScenario(t *testing.T) {
+	rnr := setupRunner(t, nil)
+
+	report := rnr.Run(context.Background(), tNode.GRPC().Address(), []scenario{
+		{Name: "unexpected_success", Run: func(_ context.Context, _ *runner) error {
+			return expectCode("Call", nil, codes.NotFound)
+		}},
+		{Name: "block_not_found", Run: checkBlockNotFound},
+	})
+
+	assert.False(t, report.Compatible())
+	assert.Equal(t, 1, report.Passed)
+	assert.Equal(t, 1, report.Failed)
+	assert.Equal(t, StatusFailed, report.Results[0].Status)
+	assert.Contains(t, report.Results[0].Reason, "succeeded")
+}
+
+func TestReport(t *testing.T) {
+	report := &Report{Endpoint: "localhost:50052", Agent: "pactus"}
+	report.add(Result{Name: "passed", Status: StatusPassed})
+	report.add(Result{Name: "failed", Status: StatusFailed, Reason: "some reason"})
+	report.add(Result{Name: "skipped", Status: StatusSkipped})
+
+	t.Run("Print", func(t *testing.T) {
+		buf := &strings.Builder{}
+		report.Print(buf)
+
+		assert.Contains(t, buf.String(), "failed   failed: some reason")
+		assert.Contains(t, buf.String(), "Passed: 1, Failed: 1, Skipped: 1")
+		assert.Contains(t, buf.String(), "NOT compatible")
+	})
+
+	t.Run("Save", func(t *testing.T) {
+		path := filepath.Join(util.TempDirPath(), "report.json")
+		require.NoError(t, report.Save(path))
+
+		data, err := util.ReadFile(path)
+		require.NoError(t, err)
+
+		loaded := &Report{}
+		require.NoError(t, json.Unmarshal(data, loaded))
+		assert.Equal(t, report.Results, loaded.Results)
+		assert.Equal(t, 1, loaded.Failed)
+	})
+}
@@ -0,0 +1,156 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"fmt"
+	"time"
+
+	"github.com/pactus-project/pactus/crypto/bls"
+	"github.com/pactus-project/pactus/types/tx"
+	"github.com/pactus-project/pactus/types/tx/payload"
+	"github.com/pactus-project/pactus/types/tx/txbuilder"
+	pactus "github.com/pactus-project/pactus/www/grpc/gen/go"
+)
+
+// pollInterval is the interval of checking if a transaction is committed.
+const pollInterval = 2 * time.Second
+
+// txProvider provides the default lock time and fee of the transactions through the node.
+type txProvider struct {
+	ctx context.Context
+	rnr *runner
+}
+
+func (p *txProvider) LastBlockHeight() (uint32, error) {
+	height, _, err := p.rnr.lastBlock(p.ctx)
+
+	return height, err
+}
+
+func (p *txProvider) CalculateFee(amount int64, payloadType payload.Type) (int64, error) {
+	res, err := p.rnr.transaction.CalculateFee(p.ctx, &pactus.CalculateFeeRequest{
+		Amount:      amount,
+		PayloadType: pactus.PayloadType(payloadType),
+	})
+	if err != nil {
+		return 0, fmt.Errorf("CalculateFee: %w", err)
+	}
+
+	return res.Fee, nil
+}
+
+// checkTransfer sends a transfer from the faucet account to a new account,
+// and checks that it is committed and the new account receives the amount.
+func checkTransfer(ctx context.Context, rnr *runner) error {
+	faucetKey := rnr.config.FaucetKey
+	sender := faucetKey.PublicKeyNative().AccountAddress()
+	receiver, err := randPublicKey()
+	if err != nil {
+		return err
+	}
+	amount := rnr.config.TransferAmount
+
+	trx, err := txbuilder.New(&txProvider{ctx: ctx, rnr: rnr}).
+		Transfer(sender, receiver.AccountAddress(), amount).
+		Memo("conformance").
+		Build()
+	if err != nil {
+		return fmt.Errorf("unable to build the transfer: %w", err)
+	}
+
+	senderRes, err := rnr.blockchain.GetAccount(ctx, &pactus.GetAccountRequest{Address: sender.String()})
+	if err != nil {
+		return fmt.Errorf("GetAccount of the faucet account %s: %w", sender, err)
+	}
+	if senderRes.Account.Balance < amount+trx.Fee() {
+		return fmt.Errorf("faucet account %s has insufficient balance: %d", sender, senderRes.Account.Balance)
+	}
+
+	signedTx, err := signTx(trx, faucetKey)
+	if err != nil {
+		return err
+	}
+
+	// Broadcasting twice with the same idempotency key should return the same transaction.
+	idempotencyKey := trx.ID().String()
+	for i := 0; i < 2; i++ {
+		res, err := rnr.transaction.BroadcastTransaction(ctx, &pactus.BroadcastTransactionRequest{
+			SignedRawTransaction: signedTx,
+			IdempotencyKey:       idempotencyKey,
+		})
+		if err != nil {
+			return fmt.Errorf("BroadcastTransaction: %w", err)
+		}
+		if !bytes.Equal(res.Id, trx.ID().Bytes()) {
+			return fmt.Errorf("BroadcastTransaction: expected transaction %s, got %x", trx.ID(), res.Id)
+		}
+	}
+
+	if err := rnr.waitForTx(ctx, trx); err != nil {
+		return err
+	}
+
+	receiverRes, err := rnr.blockchain.GetAccount(ctx, &pactus.GetAccountRequest{
+		Address: receiver.AccountAddress().String(),
+	})
+	if err != nil {
+		return fmt.Errorf("GetAccount of the receiver: %w", err)
+	}
+	if receiverRes.Account.Balance != amount {
+		return fmt.Errorf("GetAccount: expected the receiver balance %d, got %d",
+			amount, receiverRes.Account.Balance)
+	}
+
+	return nil
+}
+
+// waitForTx waits until the transaction is committed, or the context is done.
+func (r *runner) waitForTx(ctx context.Context, trx *tx.Tx) error {
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	for {
+		res, err := r.transaction.GetTransaction(ctx, &pactus.GetTransactionRequest{
+			Id:        trx.ID().Bytes(),
+			Verbosity: pactus.TransactionVerbosity_TRANSACTION_DATA,
+		})
+		if err == nil {
+			if res.BlockHeight == 0 {
+				return fmt.Errorf("GetTransaction: block height of the transaction %s is not set", trx.ID())
+			}
+
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("transaction %s is not committed in time: %w", trx.ID(), ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
+
+func signTx(trx *tx.Tx, prv *bls.PrivateKey) ([]byte, error) {
+	trx.SetSignature(prv.Sign(trx.SignBytes()))
+	trx.SetPublicKey(prv.PublicKeyNative())
+	if err := trx.BasicCheck(); err != nil {
+		return nil, fmt.Errorf("unable to sign the transaction: %w", err)
+	}
+
+	return trx.Bytes()
+}
+
+func randPublicKey() (*bls.PublicKey, error) {
+	ikm := make([]byte, 32)
+	if _, err := rand.Read(ikm); err != nil {
+		return nil, err
+	}
+	prv, err := bls.KeyGen(ikm, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return prv.PublicKeyNative(), nil
+}
@@ -0,0 +1,90 @@
+// Conformance runs the conformance scenarios against a live node, through its gRPC endpoint,
+// and checks that the behavior of the RPCs matches the expectations of this repository.
+// It is meant for the RPC providers, to certify their deployments before serving the users.
+//
+// The read-only scenarios don't change anything on the chain. The funded scenarios send
+// small transactions, so they need a funded account. The private key of this account is read
+// from the PACTUS_CONFORMANCE_FAUCET_KEY environment variable, and if it is not set,
+// the funded scenarios are skipped.
+//
+// To run it:
+//
+//	go run ./tests/conformance -endpoint <host:port> -report report.json
+//
+// It exits with a non-zero code if any scenario fails.
+package main
+
+import (
+	"context"
+	"crypto/tls"
+	"flag"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/pactus-project/pactus/crypto/bls"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// faucetKeyEnv is the environment variable that holds the private key of the funded account.
+// It is not a flag, so the key doesn't show up in the process list or the shell history.
+const faucetKeyEnv = "PACTUS_CONFORMANCE_FAUCET_KEY"
+
+func main() {
+	endpointOpt := flag.String("endpoint", "", "the gRPC endpoint of the node, like host:port")
+	tlsOpt := flag.Bool("tls", false, "connect to the endpoint through TLS")
+	reportOpt := flag.String("report", "", "the path to save the JSON report, if set")
+	callTimeoutOpt := flag.Duration("call-timeout", 10*time.Second, "timeout of each scenario")
+	txTimeoutOpt := flag.Duration("tx-timeout", 2*time.Minute, "how long to wait for a transaction to be committed")
+	amountOpt := flag.Int64("amount", 1e6, "the amount of the funded transfers, in NanoPAC")
+	flag.Parse()
+
+	if *endpointOpt == "" {
+		fmt.Fprintln(os.Stderr, "the endpoint is not set")
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	creds := insecure.NewCredentials()
+	if *tlsOpt {
+		creds = credentials.NewTLS(&tls.Config{MinVersion: tls.VersionTLS12})
+	}
+	conn, err := grpc.Dial(*endpointOpt, grpc.WithTransportCredentials(creds))
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "unable to connect to %s: %v\n", *endpointOpt, err)
+		os.Exit(2)
+	}
+	defer conn.Close()
+
+	var faucetKey *bls.PrivateKey
+	if keyStr := os.Getenv(faucetKeyEnv); keyStr != "" {
+		faucetKey, err = bls.PrivateKeyFromString(keyStr)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "invalid faucet key: %v\n", err)
+			os.Exit(2)
+		}
+	}
+
+	rnr := newRunner(conn, runnerConfig{
+		CallTimeout:    *callTimeoutOpt,
+		TxTimeout:      *txTimeoutOpt,
+		TransferAmount: *amountOpt,
+		FaucetKey:      faucetKey,
+	})
+	report := rnr.Run(context.Background(), *endpointOpt, allScenarios())
+
+	report.Print(os.Stdout)
+	if *reportOpt != "" {
+		if err := report.Save(*reportOpt); err != nil {
+			fmt.Fprintf(os.Stderr, "unable to save the report: %v\n", err)
+			os.Exit(2)
+		}
+		fmt.Printf("The report is saved at: %s\n", *reportOpt)
+	}
+
+	if !report.Compatible() {
+		os.Exit(1)
+	}
+}
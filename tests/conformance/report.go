@@ -0,0 +1,84 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/pactus-project/pactus/util"
+)
+
+const (
+	StatusPassed  = "passed"
+	StatusFailed  = "failed"
+	StatusSkipped = "skipped"
+)
+
+// Result holds the result of a scenario.
+type Result struct {
+	Name       string `json:"name"`
+	Status     string `json:"status"`
+	Reason     string `json:"reason,omitempty"`
+	DurationMs int64  `json:"duration_ms"`
+}
+
+// Report is the compatibility report of an endpoint.
+type Report struct {
+	Endpoint  string        `json:"endpoint"`
+	Agent     string        `json:"agent"`
+	Commit    string        `json:"commit"`
+	StartedAt time.Time     `json:"started_at"`
+	Duration  time.Duration `json:"duration"`
+	Passed    int           `json:"passed"`
+	Failed    int           `json:"failed"`
+	Skipped   int           `json:"skipped"`
+	Results   []Result      `json:"results"`
+}
+
+func (r *Report) add(res Result) {
+	switch res.Status {
+	case StatusPassed:
+		r.Passed++
+	case StatusFailed:
+		r.Failed++
+	case StatusSkipped:
+		r.Skipped++
+	}
+	r.Results = append(r.Results, res)
+}
+
+// Compatible checks if all the scenarios that have been run are passed.
+// The skipped scenarios don't make the endpoint incompatible.
+func (r *Report) Compatible() bool {
+	return r.Failed == 0
+}
+
+// Print prints the report in a human-readable format.
+func (r *Report) Print(w io.Writer) {
+	fmt.Fprintf(w, "Endpoint: %s\n", r.Endpoint)
+	fmt.Fprintf(w, "Agent:    %s\n", r.Agent)
+	for _, res := range r.Results {
+		line := fmt.Sprintf("  %-8s %s", res.Status, res.Name)
+		if res.Reason != "" {
+			line += ": " + res.Reason
+		}
+		fmt.Fprintln(w, line)
+	}
+	fmt.Fprintf(w, "Passed: %d, Failed: %d, Skipped: %d\n", r.Passed, r.Failed, r.Skipped)
+	if r.Compatible() {
+		fmt.Fprintln(w, "The endpoint is compatible.")
+	} else {
+		fmt.Fprintln(w, "The endpoint is NOT compatible.")
+	}
+}
+
+// Save saves the report as JSON.
+func (r *Report) Save(path string) error {
+	data, err := json.MarshalIndent(r, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return util.WriteFile(path, data)
+}
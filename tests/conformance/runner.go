@@ -0,0 +1,111 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pactus-project/pactus/crypto/bls"
+	pactus "github.com/pactus-project/pactus/www/grpc/gen/go"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// scenario is a check of the behavior of one or a few related RPCs.
+type scenario struct {
+	Name string
+	// Funded scenarios send transactions, so they need the faucet key.
+	Funded bool
+	Run    func(ctx context.Context, rnr *runner) error
+}
+
+type runnerConfig struct {
+	// CallTimeout is the timeout of each scenario. The funded scenarios have
+	// the TxTimeout on top of it, since they wait for the transactions to be committed.
+	CallTimeout    time.Duration
+	TxTimeout      time.Duration
+	TransferAmount int64
+	// FaucetKey is the private key of the funded account, nil if there is no funded account.
+	FaucetKey *bls.PrivateKey
+}
+
+type runner struct {
+	config      runnerConfig
+	blockchain  pactus.BlockchainClient
+	transaction pactus.TransactionClient
+	network     pactus.NetworkClient
+}
+
+func newRunner(conn grpc.ClientConnInterface, conf runnerConfig) *runner {
+	return &runner{
+		config:      conf,
+		blockchain:  pactus.NewBlockchainClient(conn),
+		transaction: pactus.NewTransactionClient(conn),
+		network:     pactus.NewNetworkClient(conn),
+	}
+}
+
+// Run runs the scenarios one by one and reports their results.
+// A failed scenario doesn't stop the others.
+func (r *runner) Run(ctx context.Context, endpoint string, scenarios []scenario) *Report {
+	report := &Report{
+		Endpoint:  endpoint,
+		StartedAt: time.Now(),
+	}
+
+	if info, err := r.network.GetNodeInfo(ctx, &pactus.GetNodeInfoRequest{}); err == nil {
+		report.Agent = info.Agent
+		report.Commit = info.Commit
+	}
+
+	for _, sc := range scenarios {
+		report.add(r.runScenario(ctx, sc))
+	}
+	report.Duration = time.Since(report.StartedAt)
+
+	return report
+}
+
+func (r *runner) runScenario(ctx context.Context, sc scenario) Result {
+	if sc.Funded && r.config.FaucetKey == nil {
+		return Result{
+			Name:   sc.Name,
+			Status: StatusSkipped,
+			Reason: fmt.Sprintf("%s is not set", faucetKeyEnv),
+		}
+	}
+
+	timeout := r.config.CallTimeout
+	if sc.Funded {
+		timeout += r.config.TxTimeout
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	start := time.Now()
+	err := sc.Run(ctx, r)
+	res := Result{
+		Name:       sc.Name,
+		Status:     StatusPassed,
+		DurationMs: time.Since(start).Milliseconds(),
+	}
+	if err != nil {
+		res.Status = StatusFailed
+		res.Reason = err.Error()
+	}
+
+	return res
+}
+
+// expectCode checks that the call has failed with the expected gRPC code.
+func expectCode(call string, err error, code codes.Code) error {
+	if err == nil {
+		return fmt.Errorf("%s: expected %s error, but it succeeded", call, code)
+	}
+	if status.Code(err) != code {
+		return fmt.Errorf("%s: expected %s error, got %w", call, code, err)
+	}
+
+	return nil
+}
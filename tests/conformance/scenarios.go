@@ -0,0 +1,323 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+
+	"github.com/pactus-project/pactus/crypto"
+	"github.com/pactus-project/pactus/crypto/hash"
+	"github.com/pactus-project/pactus/types/block"
+	pactus "github.com/pactus-project/pactus/www/grpc/gen/go"
+	"google.golang.org/grpc/codes"
+)
+
+// farHeight is added to the last height to get a height that is surely not committed yet.
+const farHeight = 1_000_000
+
+// allScenarios returns the scenarios in the order they should run.
+// The funded scenarios are at the end, so a slow transaction doesn't delay the read-only ones.
+func allScenarios() []scenario {
+	return []scenario{
+		{Name: "node_info", Run: checkNodeInfo},
+		{Name: "blockchain_info", Run: checkBlockchainInfo},
+		{Name: "block_hash_and_height", Run: checkBlockHashAndHeight},
+		{Name: "block_data", Run: checkBlockData},
+		{Name: "block_transactions", Run: checkBlockTransactions},
+		{Name: "block_not_found", Run: checkBlockNotFound},
+		{Name: "transaction", Run: checkTransaction},
+		{Name: "transaction_not_found", Run: checkTransactionNotFound},
+		{Name: "account", Run: checkAccount},
+		{Name: "validator", Run: checkValidator},
+		{Name: "calculate_fee", Run: checkCalculateFee},
+		{Name: "transfer", Funded: true, Run: checkTransfer},
+	}
+}
+
+func checkNodeInfo(ctx context.Context, rnr *runner) error {
+	info, err := rnr.network.GetNodeInfo(ctx, &pactus.GetNodeInfoRequest{})
+	if err != nil {
+		return fmt.Errorf("GetNodeInfo: %w", err)
+	}
+	if info.Agent == "" {
+		return errors.New("GetNodeInfo: agent is empty")
+	}
+	if len(info.PeerId) == 0 {
+		return errors.New("GetNodeInfo: peer ID is empty")
+	}
+	if info.StartedAt == 0 {
+		return errors.New("GetNodeInfo: start time is not set")
+	}
+
+	return nil
+}
+
+func checkBlockchainInfo(ctx context.Context, rnr *runner) error {
+	info, err := rnr.blockchain.GetBlockchainInfo(ctx, &pactus.GetBlockchainInfoRequest{})
+	if err != nil {
+		return fmt.Errorf("GetBlockchainInfo: %w", err)
+	}
+	if info.LastBlockHeight == 0 {
+		return errors.New("GetBlockchainInfo: no block is committed")
+	}
+	if len(info.LastBlockHash) != hash.HashSize {
+		return fmt.Errorf("GetBlockchainInfo: invalid last block hash: %x", info.LastBlockHash)
+	}
+	if len(info.CommitteeValidators) == 0 {
+		return errors.New("GetBlockchainInfo: committee is empty")
+	}
+	if info.TotalValidators < int32(len(info.CommitteeValidators)) {
+		return fmt.Errorf("GetBlockchainInfo: total validators %d is less than the committee size %d",
+			info.TotalValidators, len(info.CommitteeValidators))
+	}
+	if info.CommitteePower > info.TotalPower {
+		return fmt.Errorf("GetBlockchainInfo: committee power %d is more than the total power %d",
+			info.CommitteePower, info.TotalPower)
+	}
+
+	return nil
+}
+
+// lastBlock returns the height and the hash of the last block, as the node reports it.
+func (r *runner) lastBlock(ctx context.Context) (uint32, []byte, error) {
+	info, err := r.blockchain.GetBlockchainInfo(ctx, &pactus.GetBlockchainInfoRequest{})
+	if err != nil {
+		return 0, nil, fmt.Errorf("GetBlockchainInfo: %w", err)
+	}
+
+	return info.LastBlockHeight, info.LastBlockHash, nil
+}
+
+func checkBlockHashAndHeight(ctx context.Context, rnr *runner) error {
+	height, lastHash, err := rnr.lastBlock(ctx)
+	if err != nil {
+		return err
+	}
+
+	// The hash of a committed block never changes, even if the new blocks are committed meanwhile.
+	hashRes, err := rnr.blockchain.GetBlockHash(ctx, &pactus.GetBlockHashRequest{Height: height})
+	if err != nil {
+		return fmt.Errorf("GetBlockHash: %w", err)
+	}
+	if !bytes.Equal(hashRes.Hash, lastHash) {
+		return fmt.Errorf("GetBlockHash: expected %x, got %x", lastHash, hashRes.Hash)
+	}
+
+	heightRes, err := rnr.blockchain.GetBlockHeight(ctx, &pactus.GetBlockHeightRequest{Hash: lastHash})
+	if err != nil {
+		return fmt.Errorf("GetBlockHeight: %w", err)
+	}
+	if heightRes.Height != height {
+		return fmt.Errorf("GetBlockHeight: expected %d, got %d", height, heightRes.Height)
+	}
+
+	_, err = rnr.blockchain.GetBlockHeight(ctx, &pactus.GetBlockHeightRequest{Hash: []byte{1, 2, 3}})
+
+	return expectCode("GetBlockHeight with an invalid hash", err, codes.InvalidArgument)
+}
+
+func checkBlockData(ctx context.Context, rnr *runner) error {
+	height, lastHash, err := rnr.lastBlock(ctx)
+	if err != nil {
+		return err
+	}
+
+	res, err := rnr.blockchain.GetBlock(ctx, &pactus.GetBlockRequest{
+		Height:    height,
+		Verbosity: pactus.BlockVerbosity_BLOCK_DATA,
+	})
+	if err != nil {
+		return fmt.Errorf("GetBlock: %w", err)
+	}
+	if res.Height != height || !bytes.Equal(res.Hash, lastHash) {
+		return fmt.Errorf("GetBlock: expected block %d:%x, got %d:%x", height, lastHash, res.Height, res.Hash)
+	}
+
+	blk, err := block.FromBytes(res.Data)
+	if err != nil {
+		return fmt.Errorf("GetBlock: unable to decode the block data: %w", err)
+	}
+	if !bytes.Equal(blk.Hash().Bytes(), lastHash) {
+		return fmt.Errorf("GetBlock: hash of the block data is %s, expected %x", blk.Hash(), lastHash)
+	}
+
+	return nil
+}
+
+func checkBlockTransactions(ctx context.Context, rnr *runner) error {
+	height, _, err := rnr.lastBlock(ctx)
+	if err != nil {
+		return err
+	}
+
+	res, err := rnr.blockchain.GetBlock(ctx, &pactus.GetBlockRequest{
+		Height:    height,
+		Verbosity: pactus.BlockVerbosity_BLOCK_TRANSACTIONS,
+	})
+	if err != nil {
+		return fmt.Errorf("GetBlock: %w", err)
+	}
+	if res.Header == nil {
+		return errors.New("GetBlock: header is not set")
+	}
+	if res.BlockTime == 0 {
+		return errors.New("GetBlock: block time is not set")
+	}
+	if len(res.Txs) == 0 {
+		return errors.New("GetBlock: block has no transaction, the subsidy transaction is missing")
+	}
+
+	if height > 1 {
+		prevRes, err := rnr.blockchain.GetBlockHash(ctx, &pactus.GetBlockHashRequest{Height: height - 1})
+		if err != nil {
+			return fmt.Errorf("GetBlockHash: %w", err)
+		}
+		if !bytes.Equal(res.Header.PrevBlockHash, prevRes.Hash) {
+			return fmt.Errorf("GetBlock: previous block hash is %x, expected %x",
+				res.Header.PrevBlockHash, prevRes.Hash)
+		}
+	}
+
+	return nil
+}
+
+func checkBlockNotFound(ctx context.Context, rnr *runner) error {
+	height, _, err := rnr.lastBlock(ctx)
+	if err != nil {
+		return err
+	}
+
+	_, err = rnr.blockchain.GetBlock(ctx, &pactus.GetBlockRequest{Height: height + farHeight})
+	if err := expectCode("GetBlock with an uncommitted height", err, codes.NotFound); err != nil {
+		return err
+	}
+
+	_, err = rnr.blockchain.GetBlockHash(ctx, &pactus.GetBlockHashRequest{Height: height + farHeight})
+
+	return expectCode("GetBlockHash with an uncommitted height", err, codes.NotFound)
+}
+
+func checkTransaction(ctx context.Context, rnr *runner) error {
+	height, _, err := rnr.lastBlock(ctx)
+	if err != nil {
+		return err
+	}
+
+	blkRes, err := rnr.blockchain.GetBlock(ctx, &pactus.GetBlockRequest{
+		Height:    height,
+		Verbosity: pactus.BlockVerbosity_BLOCK_INFO,
+	})
+	if err != nil {
+		return fmt.Errorf("GetBlock: %w", err)
+	}
+	if len(blkRes.Txs) == 0 {
+		return errors.New("GetBlock: block has no transaction")
+	}
+	txID := blkRes.Txs[0].Id
+
+	res, err := rnr.transaction.GetTransaction(ctx, &pactus.GetTransactionRequest{
+		Id:        txID,
+		Verbosity: pactus.TransactionVerbosity_TRANSACTION_INFO,
+	})
+	if err != nil {
+		return fmt.Errorf("GetTransaction: %w", err)
+	}
+	if res.BlockHeight != height {
+		return fmt.Errorf("GetTransaction: expected block height %d, got %d", height, res.BlockHeight)
+	}
+	if res.Transaction == nil || !bytes.Equal(res.Transaction.Id, txID) {
+		return fmt.Errorf("GetTransaction: expected transaction %x", txID)
+	}
+
+	return nil
+}
+
+func checkTransactionNotFound(ctx context.Context, rnr *runner) error {
+	data := make([]byte, 32)
+	_, _ = rand.Read(data)
+	txID := hash.CalcHash(data)
+
+	_, err := rnr.transaction.GetTransaction(ctx, &pactus.GetTransactionRequest{Id: txID.Bytes()})
+	if err := expectCode("GetTransaction with an unknown ID", err, codes.InvalidArgument); err != nil {
+		return err
+	}
+
+	_, err = rnr.transaction.GetTransaction(ctx, &pactus.GetTransactionRequest{Id: []byte{1, 2, 3}})
+
+	return expectCode("GetTransaction with an invalid ID", err, codes.InvalidArgument)
+}
+
+func checkAccount(ctx context.Context, rnr *runner) error {
+	res, err := rnr.blockchain.GetAccount(ctx, &pactus.GetAccountRequest{
+		Address: crypto.TreasuryAddress.String(),
+	})
+	if err != nil {
+		return fmt.Errorf("GetAccount: %w", err)
+	}
+	if res.Account.Address != crypto.TreasuryAddress.String() {
+		return fmt.Errorf("GetAccount: expected the treasury account, got %s", res.Account.Address)
+	}
+
+	_, err = rnr.blockchain.GetAccount(ctx, &pactus.GetAccountRequest{Address: "invalid-address"})
+
+	return expectCode("GetAccount with an invalid address", err, codes.InvalidArgument)
+}
+
+func checkValidator(ctx context.Context, rnr *runner) error {
+	info, err := rnr.blockchain.GetBlockchainInfo(ctx, &pactus.GetBlockchainInfoRequest{})
+	if err != nil {
+		return fmt.Errorf("GetBlockchainInfo: %w", err)
+	}
+	if len(info.CommitteeValidators) == 0 {
+		return errors.New("GetBlockchainInfo: committee is empty")
+	}
+	val := info.CommitteeValidators[0]
+
+	res, err := rnr.blockchain.GetValidator(ctx, &pactus.GetValidatorRequest{Address: val.Address})
+	if err != nil {
+		return fmt.Errorf("GetValidator: %w", err)
+	}
+	if res.Validator.Number != val.Number || res.Validator.PublicKey != val.PublicKey {
+		return fmt.Errorf("GetValidator: validator %s doesn't match the committee", val.Address)
+	}
+
+	resByNum, err := rnr.blockchain.GetValidatorByNumber(ctx, &pactus.GetValidatorByNumberRequest{
+		Number: val.Number,
+	})
+	if err != nil {
+		return fmt.Errorf("GetValidatorByNumber: %w", err)
+	}
+	if resByNum.Validator.Address != val.Address {
+		return fmt.Errorf("GetValidatorByNumber: expected %s, got %s", val.Address, resByNum.Validator.Address)
+	}
+
+	return nil
+}
+
+func checkCalculateFee(ctx context.Context, rnr *runner) error {
+	smallFee, err := rnr.transaction.CalculateFee(ctx, &pactus.CalculateFeeRequest{
+		Amount:      1e9,
+		PayloadType: pactus.PayloadType_TRANSFER_PAYLOAD,
+	})
+	if err != nil {
+		return fmt.Errorf("CalculateFee: %w", err)
+	}
+	largeFee, err := rnr.transaction.CalculateFee(ctx, &pactus.CalculateFeeRequest{
+		Amount:      1e15,
+		PayloadType: pactus.PayloadType_TRANSFER_PAYLOAD,
+	})
+	if err != nil {
+		return fmt.Errorf("CalculateFee: %w", err)
+	}
+
+	if smallFee.Fee <= 0 {
+		return fmt.Errorf("CalculateFee: fee should be positive, got %d", smallFee.Fee)
+	}
+	if largeFee.Fee < smallFee.Fee {
+		return fmt.Errorf("CalculateFee: fee of the larger amount %d is less than %d", largeFee.Fee, smallFee.Fee)
+	}
+
+	return nil
+}
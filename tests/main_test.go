@@ -85,6 +85,8 @@ func TestMain(m *testing.M) {
 		tConfigs[i].Network.DefaultBootstrapAddrStrings = []string{}
 		tConfigs[i].Network.ForcePrivateNetwork = true
 		tConfigs[i].Network.NetworkKey = util.TempFilePath()
+		tConfigs[i].Network.AnchorsFile = util.TempFilePath()
+		tConfigs[i].Network.AddrBookFile = util.TempFilePath()
 		tConfigs[i].Network.NetworkName = "test"
 		tConfigs[i].Network.ListenAddrStrings = []string{"/ip4/127.0.0.1/tcp/0", "/ip4/127.0.0.1/udp/0/quic-v1"}
 		tConfigs[i].Network.BootstrapAddrStrings = []string{}
@@ -0,0 +1,8 @@
+// Package soak runs a multi-node in-process network for a long time,
+// randomly restarting and isolating nodes, while it checks that the network
+// never forks, never gets stuck and keeps the memory usage bounded.
+//
+// It is excluded from the normal test run. To run it:
+//
+//	go test -tags soak -timeout 0 ./tests/soak -soak.duration=4h
+package soak
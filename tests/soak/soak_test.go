@@ -0,0 +1,299 @@
+//go:build soak
+
+package soak
+
+import (
+	"flag"
+	"fmt"
+	"math/rand"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/pactus-project/pactus/config"
+	"github.com/pactus-project/pactus/crypto"
+	"github.com/pactus-project/pactus/crypto/bls"
+	"github.com/pactus-project/pactus/crypto/hash"
+	"github.com/pactus-project/pactus/genesis"
+	"github.com/pactus-project/pactus/node"
+	"github.com/pactus-project/pactus/types/account"
+	"github.com/pactus-project/pactus/types/param"
+	"github.com/pactus-project/pactus/types/validator"
+	"github.com/pactus-project/pactus/util"
+	"github.com/pactus-project/pactus/util/logger"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	durationOpt       = flag.Duration("soak.duration", time.Hour, "how long the soak test runs")
+	seedOpt           = flag.Int64("soak.seed", 0, "seed for random actions, zero means a random seed")
+	actionIntervalOpt = flag.Duration("soak.action-interval", 30*time.Second, "interval between random actions")
+	stallTimeoutOpt   = flag.Duration("soak.stall-timeout", 2*time.Minute, "maximum time without a new block")
+	maxHeapOpt        = flag.Uint64("soak.max-heap", 2<<30, "maximum heap size in bytes")
+)
+
+const (
+	totalNodes    = 4
+	checkInterval = 2 * time.Second
+)
+
+type soakNode struct {
+	valKey  *bls.ValidatorKey
+	config  *config.Config
+	node    *node.Node
+	running bool
+	// the last height that is checked against forks
+	checkedHeight uint32
+	// the time that an isolated node should come back
+	reviveAt time.Time
+}
+
+type soakNetwork struct {
+	t      *testing.T
+	rnd    *rand.Rand
+	genDoc *genesis.Genesis
+	nodes  []*soakNode
+	hashes map[uint32]hash.Hash
+
+	lastHeight     uint32
+	lastHeightTime time.Time
+}
+
+func newSoakNetwork(t *testing.T, seed int64) *soakNetwork {
+	t.Helper()
+
+	// Prevent log from messing the workspace
+	logger.LogFilename = util.TempFilePath()
+
+	rnd := rand.New(rand.NewSource(seed))
+	nodes := make([]*soakNode, totalNodes)
+	vals := make([]*validator.Validator, totalNodes)
+	for i := 0; i < totalNodes; i++ {
+		ikm := make([]byte, 32)
+		_, _ = rnd.Read(ikm)
+		key, err := bls.KeyGen(ikm, nil)
+		require.NoError(t, err)
+		valKey := bls.NewValidatorKey(key)
+
+		nodes[i] = &soakNode{
+			valKey: valKey,
+			config: nodeConfig(),
+		}
+		vals[i] = validator.NewValidator(valKey.PublicKey(), int32(i))
+	}
+
+	acc := account.NewAccount(0)
+	acc.AddToBalance(21 * 1e14)
+	accs := map[crypto.Address]*account.Account{crypto.TreasuryAddress: acc}
+
+	params := param.DefaultParams()
+	params.BlockIntervalInSecond = 2
+	params.CommitteeSize = totalNodes
+	genDoc := genesis.MakeGenesis(util.Now(), accs, vals, params)
+
+	return &soakNetwork{
+		t:      t,
+		rnd:    rnd,
+		genDoc: genDoc,
+		nodes:  nodes,
+		hashes: make(map[uint32]hash.Hash),
+	}
+}
+
+func nodeConfig() *config.Config {
+	conf := config.DefaultConfigLocalnet()
+
+	conf.Store.Path = util.TempDirPath()
+	conf.Consensus.ChangeProposerTimeout = 4 * time.Second
+	conf.Logger.Levels["default"] = "error"
+	conf.Logger.Levels["_state"] = "error"
+	conf.Logger.Levels["_sync"] = "error"
+	conf.Logger.Levels["_consensus"] = "error"
+	conf.Logger.Levels["_network"] = "error"
+	conf.Logger.Levels["_pool"] = "error"
+	conf.Sync.NodeNetwork = true
+	conf.Sync.Firewall.Enabled = false
+	conf.Sync.LatestBlockInterval = 10
+	conf.Network.EnableMdns = true
+	conf.Network.ForcePrivateNetwork = true
+	conf.Network.NetworkKey = util.TempFilePath()
+	conf.Network.AnchorsFile = util.TempFilePath()
+	conf.Network.AddrBookFile = util.TempFilePath()
+	conf.Network.NetworkName = "soak"
+	conf.Network.ListenAddrStrings = []string{"/ip4/127.0.0.1/tcp/0", "/ip4/127.0.0.1/udp/0/quic-v1"}
+	conf.Network.MaxConns = 8
+	conf.GRPC.Enable = false
+	conf.HTTP.Enable = false
+	conf.Nanomsg.Enable = false
+
+	return conf
+}
+
+func (sn *soakNetwork) startNode(idx int) {
+	sn.t.Helper()
+
+	n := sn.nodes[idx]
+	nd, err := node.NewNode(sn.genDoc, n.config,
+		[]*bls.ValidatorKey{n.valKey},
+		[]crypto.Address{n.valKey.PublicKey().AccountAddress()})
+	require.NoError(sn.t, err)
+	require.NoError(sn.t, nd.Start())
+
+	n.node = nd
+	n.running = true
+	n.reviveAt = time.Time{}
+}
+
+func (sn *soakNetwork) stopNode(idx int) {
+	n := sn.nodes[idx]
+	n.node.Stop()
+	n.node = nil
+	n.running = false
+}
+
+func (sn *soakNetwork) runningNodes() []int {
+	running := make([]int, 0, totalNodes)
+	for i, n := range sn.nodes {
+		if n.running {
+			running = append(running, i)
+		}
+	}
+
+	return running
+}
+
+// doRandomAction restarts or isolates a random node. To keep the network live,
+// at most one node (less than one third of the committee) is down at any time.
+func (sn *soakNetwork) doRandomAction() {
+	running := sn.runningNodes()
+	if len(running) < totalNodes {
+		return
+	}
+
+	idx := running[sn.rnd.Intn(len(running))]
+	switch sn.rnd.Intn(3) {
+	case 0:
+		sn.t.Logf("restarting node %d", idx)
+		sn.stopNode(idx)
+		sn.startNode(idx)
+
+	case 1:
+		// Isolating the node from the rest of the network by stopping it.
+		// It should catch up with the network once it comes back.
+		downtime := time.Duration(sn.rnd.Int63n(int64(*actionIntervalOpt)))
+		sn.t.Logf("isolating node %d for %v", idx, downtime)
+		sn.stopNode(idx)
+		sn.nodes[idx].reviveAt = time.Now().Add(downtime)
+
+	default:
+		// Letting the network run without disruption
+	}
+}
+
+func (sn *soakNetwork) reviveNodes() {
+	for i, n := range sn.nodes {
+		if !n.running && time.Now().After(n.reviveAt) {
+			sn.t.Logf("reviving node %d", i)
+			sn.startNode(i)
+		}
+	}
+}
+
+// checkNoFork checks that all nodes have committed the same blocks.
+func (sn *soakNetwork) checkNoFork() {
+	sn.t.Helper()
+
+	for i, n := range sn.nodes {
+		if !n.running {
+			continue
+		}
+
+		st := n.node.State()
+		lastHeight := st.LastBlockHeight()
+		for h := n.checkedHeight + 1; h <= lastHeight; h++ {
+			blockHash := st.BlockHash(h)
+			expected, ok := sn.hashes[h]
+			if !ok {
+				sn.hashes[h] = blockHash
+
+				continue
+			}
+			require.Equal(sn.t, expected, blockHash,
+				"fork detected at height %d on node %d", h, i)
+		}
+		n.checkedHeight = lastHeight
+	}
+}
+
+// checkNotStuck checks that the network commits new blocks.
+func (sn *soakNetwork) checkNotStuck() {
+	sn.t.Helper()
+
+	height := uint32(0)
+	for _, n := range sn.nodes {
+		if n.running {
+			height = util.Max(height, n.node.State().LastBlockHeight())
+		}
+	}
+
+	if height > sn.lastHeight {
+		sn.lastHeight = height
+		sn.lastHeightTime = time.Now()
+
+		return
+	}
+
+	require.Less(sn.t, time.Since(sn.lastHeightTime), *stallTimeoutOpt,
+		"network is stuck at height %d", height)
+}
+
+// checkMemory checks that the memory usage is bounded.
+func (sn *soakNetwork) checkMemory() {
+	sn.t.Helper()
+
+	var m runtime.MemStats
+	runtime.ReadMemStats(&m)
+	require.Less(sn.t, m.HeapAlloc, *maxHeapOpt,
+		"heap size exceeded: %d bytes", m.HeapAlloc)
+}
+
+func TestSoak(t *testing.T) {
+	seed := *seedOpt
+	if seed == 0 {
+		seed = time.Now().UnixNano()
+	}
+	fmt.Printf("=== soak test, seed: %d, duration: %v\n", seed, *durationOpt)
+
+	sn := newSoakNetwork(t, seed)
+	for i := range sn.nodes {
+		sn.startNode(i)
+	}
+	defer func() {
+		for _, i := range sn.runningNodes() {
+			sn.stopNode(i)
+		}
+	}()
+	sn.lastHeightTime = time.Now()
+
+	deadline := time.Now().Add(*durationOpt)
+	checkTicker := time.NewTicker(checkInterval)
+	defer checkTicker.Stop()
+	actionTicker := time.NewTicker(*actionIntervalOpt)
+	defer actionTicker.Stop()
+
+	for time.Now().Before(deadline) {
+		select {
+		case <-checkTicker.C:
+			sn.reviveNodes()
+			sn.checkNoFork()
+			sn.checkNotStuck()
+			sn.checkMemory()
+
+		case <-actionTicker.C:
+			sn.doRandomAction()
+		}
+	}
+
+	require.Positive(t, sn.lastHeight, "no block committed")
+	t.Logf("soak test finished at height %d", sn.lastHeight)
+}
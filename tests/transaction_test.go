@@ -7,6 +7,7 @@ import (
 	"github.com/pactus-project/pactus/crypto"
 	"github.com/pactus-project/pactus/crypto/bls"
 	"github.com/pactus-project/pactus/types/tx"
+	"github.com/pactus-project/pactus/types/tx/payload"
 	"github.com/pactus-project/pactus/util/testsuite"
 	pactus "github.com/pactus-project/pactus/www/grpc/gen/go"
 	"github.com/stretchr/testify/assert"
@@ -37,11 +38,13 @@ func broadcastSendTransaction(t *testing.T, sender *bls.ValidatorKey, receiver c
 	return sendRawTx(t, d)
 }
 
-func broadcastBondTransaction(t *testing.T, sender *bls.ValidatorKey, pub *bls.PublicKey, stake, fee int64) error {
+func broadcastBondTransaction(t *testing.T, sender, receiver *bls.ValidatorKey, stake, fee int64) error {
 	t.Helper()
 
 	lockTime := lastHeight() + 1
+	pub := receiver.PublicKey()
 	trx := tx.NewBondTx(lockTime, sender.PublicKey().AccountAddress(), pub.ValidatorAddress(), pub, stake, fee, "")
+	trx.Payload().(*payload.BondPayload).ProofOfPossession = receiver.ProofOfPossession()
 	sig := sender.Sign(trx.SignBytes())
 
 	trx.SetPublicKey(sender.PublicKey())
@@ -91,10 +94,10 @@ func TestTransactions(t *testing.T) {
 			fee := int64(1000)
 			valKey := tValKeys[tNodeIdx1][0]
 
-			require.NoError(t, broadcastBondTransaction(t, valKey, tValKeys[i][1].PublicKey(), amt, fee))
+			require.NoError(t, broadcastBondTransaction(t, valKey, tValKeys[i][1], amt, fee))
 			fmt.Printf("Staking %v to %v\n", amt, tValKeys[i][1].Address())
 
-			require.NoError(t, broadcastBondTransaction(t, valKey, tValKeys[i][2].PublicKey(), amt, fee))
+			require.NoError(t, broadcastBondTransaction(t, valKey, tValKeys[i][2], amt, fee))
 			fmt.Printf("Staking %v to %v\n", amt, tValKeys[i][2].Address())
 		}
 	})
@@ -0,0 +1,6 @@
+// Package wallet runs the wallet flows end to end against a tiny in-process
+// network. The network has a single node that is connected to the wallet
+// through the gRPC server, so the transactions are built, signed and
+// broadcasted exactly as a user does it, and the results are checked
+// against the state of the node.
+package wallet
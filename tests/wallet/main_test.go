@@ -0,0 +1,162 @@
+package wallet
+
+import (
+	"crypto/rand"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/pactus-project/pactus/config"
+	"github.com/pactus-project/pactus/crypto"
+	"github.com/pactus-project/pactus/crypto/bls"
+	"github.com/pactus-project/pactus/genesis"
+	"github.com/pactus-project/pactus/node"
+	"github.com/pactus-project/pactus/types/account"
+	"github.com/pactus-project/pactus/types/param"
+	"github.com/pactus-project/pactus/types/validator"
+	"github.com/pactus-project/pactus/util"
+	"github.com/pactus-project/pactus/util/logger"
+	"github.com/pactus-project/pactus/wallet"
+)
+
+var (
+	tNode   *node.Node
+	tWallet *wallet.Wallet
+	tGenDoc *genesis.Genesis
+
+	// The account that is funded in the genesis.
+	tAccountAddr string
+	// The validator that its key is loaded into the node, so it can join the committee.
+	tValAddr1 string
+	// The validator that its key is not loaded into the node, so it never joins the committee.
+	tValAddr2 string
+)
+
+const (
+	tPassword       = "secret"
+	tAccountBalance = 1000e9
+	tBondInterval   = 4
+	tUnbondInterval = 10
+)
+
+func TestMain(m *testing.M) {
+	// Prevent log from messing the workspace
+	logger.LogFilename = util.TempFilePath()
+
+	if err := setup(); err != nil {
+		panic(err)
+	}
+
+	fmt.Println("Running tests")
+
+	exitCode := m.Run()
+
+	tNode.Stop()
+
+	os.Exit(exitCode)
+}
+
+func setup() error {
+	mnemonic, _ := wallet.GenerateMnemonic(128)
+	wlt, err := wallet.Create(filepath.Join(util.TempDirPath(), "wallet"),
+		mnemonic, tPassword, genesis.Localnet)
+	if err != nil {
+		return err
+	}
+
+	tAccountAddr, _ = wlt.NewBLSAccountAddress("account")
+	tValAddr1, _ = wlt.NewValidatorAddress("validator 1")
+	tValAddr2, _ = wlt.NewValidatorAddress("validator 2")
+
+	prv, err := wlt.PrivateKey(tPassword, tValAddr1)
+	if err != nil {
+		return err
+	}
+	walletValKey := bls.NewValidatorKey(prv.(*bls.PrivateKey))
+
+	ikm := make([]byte, 32)
+	_, _ = rand.Read(ikm)
+	genKey, err := bls.KeyGen(ikm, nil)
+	if err != nil {
+		return err
+	}
+	genValKey := bls.NewValidatorKey(genKey)
+
+	tGenDoc = makeGenesis(genValKey.PublicKey())
+	crypto.SetSignDomain(tGenDoc.SignDomain())
+
+	tNode, err = node.NewNode(tGenDoc, nodeConfig(),
+		[]*bls.ValidatorKey{genValKey, walletValKey},
+		[]crypto.Address{genValKey.PublicKey().AccountAddress(), genValKey.PublicKey().AccountAddress()})
+	if err != nil {
+		return err
+	}
+
+	if err := tNode.Start(); err != nil {
+		return err
+	}
+
+	if err := wlt.Connect(tNode.GRPC().Address()); err != nil {
+		return err
+	}
+	tWallet = wlt
+
+	return nil
+}
+
+func makeGenesis(genValPub *bls.PublicKey) *genesis.Genesis {
+	treasury := account.NewAccount(0)
+	treasury.AddToBalance(21 * 1e15)
+	acc := account.NewAccount(1)
+	acc.AddToBalance(tAccountBalance)
+
+	addr, _ := crypto.AddressFromString(tAccountAddr)
+	accs := map[crypto.Address]*account.Account{
+		crypto.TreasuryAddress: treasury,
+		addr:                   acc,
+	}
+	vals := []*validator.Validator{validator.NewValidator(genValPub, 0)}
+
+	params := param.DefaultParams()
+	params.BlockIntervalInSecond = 1
+	params.CommitteeSize = 4
+	params.BondInterval = tBondInterval
+	params.UnbondInterval = tUnbondInterval
+
+	return genesis.MakeGenesis(util.Now(), accs, vals, params)
+}
+
+func nodeConfig() *config.Config {
+	conf := config.DefaultConfigLocalnet()
+
+	conf.Store.Path = util.TempDirPath()
+	conf.Consensus.ChangeProposerTimeout = 2 * time.Second
+	conf.Logger.Levels["default"] = "error"
+	conf.Logger.Levels["_state"] = "error"
+	conf.Logger.Levels["_sync"] = "error"
+	conf.Logger.Levels["_consensus"] = "error"
+	conf.Logger.Levels["_network"] = "error"
+	conf.Logger.Levels["_pool"] = "error"
+	conf.Sync.NodeNetwork = false
+	conf.Sync.Firewall.Enabled = false
+	conf.Network.EnableMdns = false
+	conf.Network.EnableRelay = false
+	conf.Network.DefaultBootstrapAddrStrings = []string{}
+	conf.Network.BootstrapAddrStrings = []string{}
+	conf.Network.ForcePrivateNetwork = true
+	conf.Network.NetworkKey = util.TempFilePath()
+	conf.Network.AnchorsFile = util.TempFilePath()
+	conf.Network.AddrBookFile = util.TempFilePath()
+	conf.Network.NetworkName = "wallet-test"
+	conf.Network.ListenAddrStrings = []string{"/ip4/127.0.0.1/tcp/0"}
+	conf.GRPC.Enable = true
+	conf.GRPC.Listen = "127.0.0.1:0"
+	conf.GRPC.UnixSocket = ""
+	conf.GRPC.Gateway.Enable = false
+	conf.HTTP.Enable = false
+	conf.Nanomsg.Enable = false
+
+	return conf
+}
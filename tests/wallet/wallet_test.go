@@ -0,0 +1,139 @@
+package wallet
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pactus-project/pactus/crypto"
+	"github.com/pactus-project/pactus/types/tx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	tWaitTimeout = 30 * time.Second
+	tWaitTick    = 200 * time.Millisecond
+)
+
+func signAndBroadcast(t *testing.T, trx *tx.Tx) {
+	t.Helper()
+
+	require.NoError(t, tWallet.SignTransaction(tPassword, trx))
+	_, err := tWallet.BroadcastTransaction(trx)
+	require.NoError(t, err)
+}
+
+func waitForConfirmation(t *testing.T, trx *tx.Tx) {
+	t.Helper()
+
+	require.Eventually(t, func() bool {
+		return tNode.State().CommittedTx(trx.ID()) != nil
+	}, tWaitTimeout, tWaitTick, "transaction %s is not confirmed", trx.ID())
+}
+
+func waitForHeight(t *testing.T, height uint32) {
+	t.Helper()
+
+	require.Eventually(t, func() bool {
+		return tNode.State().LastBlockHeight() >= height
+	}, tWaitTimeout, tWaitTick, "height %d is not reached", height)
+}
+
+func validatorAddress(t *testing.T, addrStr string) crypto.Address {
+	t.Helper()
+
+	addr, err := crypto.AddressFromString(addrStr)
+	require.NoError(t, err)
+
+	return addr
+}
+
+// TestWalletFlows runs the wallet flows in order, since each flow depends on the previous ones.
+func TestWalletFlows(t *testing.T) {
+	receiverAddr, err := tWallet.NewBLSAccountAddress("receiver")
+	require.NoError(t, err)
+
+	stake := int64(10e9)
+
+	t.Run("Transfer", func(t *testing.T) {
+		amt := int64(5e9)
+		trx, err := tWallet.MakeTransferTx(tAccountAddr, receiverAddr, amt)
+		require.NoError(t, err)
+		signAndBroadcast(t, trx)
+		waitForConfirmation(t, trx)
+
+		balance, err := tWallet.Balance(receiverAddr)
+		require.NoError(t, err)
+		assert.Equal(t, amt, balance)
+
+		balance, err = tWallet.Balance(tAccountAddr)
+		require.NoError(t, err)
+		assert.Equal(t, int64(tAccountBalance)-amt-trx.Fee(), balance)
+	})
+
+	t.Run("Bond", func(t *testing.T) {
+		for _, valAddr := range []string{tValAddr1, tValAddr2} {
+			trx, err := tWallet.MakeBondTx(tAccountAddr, valAddr, "", stake)
+			require.NoError(t, err)
+			signAndBroadcast(t, trx)
+			waitForConfirmation(t, trx)
+
+			valStake, err := tWallet.Stake(valAddr)
+			require.NoError(t, err)
+			assert.Equal(t, stake, valStake)
+		}
+	})
+
+	t.Run("Sortition", func(t *testing.T) {
+		// The first validator joins the committee by evaluating the sortition,
+		// once the bond interval is passed.
+		valAddr := validatorAddress(t, tValAddr1)
+		require.Eventually(t, func() bool {
+			return tNode.State().IsInCommittee(valAddr)
+		}, tWaitTimeout, tWaitTick, "validator %s is not joined the committee", tValAddr1)
+
+		assert.False(t, tNode.State().IsInCommittee(validatorAddress(t, tValAddr2)))
+	})
+
+	t.Run("Unbond", func(t *testing.T) {
+		trx, err := tWallet.MakeUnbondTx(tValAddr2)
+		require.NoError(t, err)
+		signAndBroadcast(t, trx)
+		waitForConfirmation(t, trx)
+
+		val := tNode.State().ValidatorByAddress(validatorAddress(t, tValAddr2))
+		require.NotNil(t, val)
+		assert.NotZero(t, val.UnbondingHeight())
+	})
+
+	t.Run("Withdraw before the unbond interval", func(t *testing.T) {
+		trx, err := tWallet.MakeWithdrawTx(tValAddr2, receiverAddr, stake/2)
+		require.NoError(t, err)
+		require.NoError(t, tWallet.SignTransaction(tPassword, trx))
+
+		_, err = tWallet.BroadcastTransaction(trx)
+		assert.Error(t, err)
+	})
+
+	t.Run("Withdraw", func(t *testing.T) {
+		val := tNode.State().ValidatorByAddress(validatorAddress(t, tValAddr2))
+		require.NotNil(t, val)
+		waitForHeight(t, val.UnbondingHeight()+tUnbondInterval)
+
+		balanceBefore, err := tWallet.Balance(receiverAddr)
+		require.NoError(t, err)
+
+		trx, err := tWallet.MakeWithdrawTx(tValAddr2, receiverAddr, stake/2)
+		require.NoError(t, err)
+		signAndBroadcast(t, trx)
+		waitForConfirmation(t, trx)
+
+		balanceAfter, err := tWallet.Balance(receiverAddr)
+		require.NoError(t, err)
+		assert.Equal(t, balanceBefore+stake/2, balanceAfter)
+
+		valStake, err := tWallet.Stake(tValAddr2)
+		require.NoError(t, err)
+		assert.Equal(t, stake/2-trx.Fee(), valStake)
+	})
+}
@@ -1,16 +1,56 @@
 package txpool
 
 import (
+	"time"
+
+	"github.com/pactus-project/pactus/types/tx"
 	"github.com/pactus-project/pactus/util/errors"
 )
 
 type Config struct {
-	MaxSize int `toml:"max_size"`
+	MaxSize    int `toml:"max_size"`
+	OrphanSize int `toml:"orphan_size"`
+	// MaxRebroadcastInterval is the maximum number of blocks between the rebroadcasts
+	// of the local transactions. Zero disables the rebroadcasts.
+	MaxRebroadcastInterval uint32       `toml:"max_rebroadcast_interval"`
+	Filter                 FilterConfig `toml:"filter"`
+	Memo                   MemoConfig   `toml:"memo"`
+}
+
+// FilterConfig defines the external filters that decide whether
+// the transactions should be accepted into the pool.
+type FilterConfig struct {
+	Plugins   []string      `toml:"plugins"`
+	Callbacks []string      `toml:"callbacks"`
+	Timeout   time.Duration `toml:"timeout"`
+}
+
+// MemoConfig defines the policy for the memos of the transactions that are accepted into the pool.
+// The protocol only limits the length of the memos, so the blocks may still contain
+// the transactions that don't comply with this policy.
+type MemoConfig struct {
+	MaxLength    int      `toml:"max_length"`
+	Encoding     string   `toml:"encoding"`
+	BlockedWords []string `toml:"blocked_words"`
+	RejectPII    bool     `toml:"reject_pii"`
 }
 
 func DefaultConfig() *Config {
 	return &Config{
-		MaxSize: 2000,
+		MaxSize:                2000,
+		OrphanSize:             200,
+		MaxRebroadcastInterval: 32,
+		Filter: FilterConfig{
+			Plugins:   []string{},
+			Callbacks: []string{},
+			Timeout:   time.Second,
+		},
+		Memo: MemoConfig{
+			MaxLength:    0,
+			Encoding:     MemoEncodingAny,
+			BlockedWords: []string{},
+			RejectPII:    false,
+		},
 	}
 }
 
@@ -20,6 +60,25 @@ func (conf *Config) BasicCheck() error {
 		return errors.Errorf(errors.ErrInvalidConfig, "maxSize can't be negative or zero")
 	}
 
+	if conf.OrphanSize < 0 {
+		return errors.Errorf(errors.ErrInvalidConfig, "orphanSize can't be negative")
+	}
+
+	if len(conf.Filter.Callbacks) > 0 && conf.Filter.Timeout <= 0 {
+		return errors.Errorf(errors.ErrInvalidConfig, "filter timeout should be positive")
+	}
+
+	if conf.Memo.MaxLength < 0 || conf.Memo.MaxLength > tx.MaxMemoLength {
+		return errors.Errorf(errors.ErrInvalidConfig,
+			"memo max length should be between 0 and %d", tx.MaxMemoLength)
+	}
+
+	switch conf.Memo.Encoding {
+	case MemoEncodingAny, MemoEncodingUTF8, MemoEncodingASCII:
+	default:
+		return errors.Errorf(errors.ErrInvalidConfig, "invalid memo encoding: %s", conf.Memo.Encoding)
+	}
+
 	return nil
 }
 
@@ -39,6 +98,40 @@ func (conf *Config) withdrawPoolSize() int {
 	return int(float32(conf.MaxSize) * 0.05)
 }
 
+func (conf *Config) escrowPoolSize() int {
+	return int(float32(conf.MaxSize) * 0.05)
+}
+
+func (conf *Config) dataPoolSize() int {
+	return int(float32(conf.MaxSize) * 0.05)
+}
+
+func (conf *Config) allowlistPoolSize() int {
+	return int(float32(conf.MaxSize) * 0.01)
+}
+
+func (conf *Config) conditionPoolSize() int {
+	return int(float32(conf.MaxSize) * 0.01)
+}
+
+func (conf *Config) delegatePoolSize() int {
+	return int(float32(conf.MaxSize) * 0.01)
+}
+
+func (conf *Config) rewardSplitPoolSize() int {
+	return int(float32(conf.MaxSize) * 0.01)
+}
+
+// customPoolSize is the size of the pool of each custom payload type.
+func (conf *Config) customPoolSize() int {
+	return int(float32(conf.MaxSize) * 0.05)
+}
+
 func (conf *Config) sendPoolSize() int {
-	return int(float32(conf.MaxSize) * 0.8)
+	return int(float32(conf.MaxSize) * 0.66)
+}
+
+// journalSize is the number of the last changes of the pool that are kept.
+func (conf *Config) journalSize() int {
+	return conf.MaxSize * 2
 }
@@ -2,6 +2,7 @@ package txpool
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -15,8 +16,53 @@ func TestDefaultConfigCheck(t *testing.T) {
 			c.bondPoolSize()+
 			c.unbondPoolSize()+
 			c.withdrawPoolSize()+
-			c.sortitionPoolSize(), c.MaxSize)
+			c.sortitionPoolSize()+
+			c.escrowPoolSize()+
+			c.dataPoolSize()+
+			c.allowlistPoolSize()+
+			c.conditionPoolSize()+
+			c.delegatePoolSize()+
+			c.rewardSplitPoolSize(), c.MaxSize)
 
 	c.MaxSize = 0
 	assert.Error(t, c.BasicCheck())
 }
+
+func TestFilterConfigCheck(t *testing.T) {
+	c := DefaultConfig()
+	c.Filter.Timeout = 0
+	assert.NoError(t, c.BasicCheck())
+
+	c.Filter.Callbacks = []string{"127.0.0.1:50055"}
+	assert.Error(t, c.BasicCheck())
+
+	c.Filter.Timeout = time.Second
+	assert.NoError(t, c.BasicCheck())
+}
+
+func TestOrphanConfigCheck(t *testing.T) {
+	c := DefaultConfig()
+	c.OrphanSize = 0
+	assert.NoError(t, c.BasicCheck())
+
+	c.OrphanSize = -1
+	assert.Error(t, c.BasicCheck())
+}
+
+func TestMemoConfigCheck(t *testing.T) {
+	c := DefaultConfig()
+	c.Memo.MaxLength = 65
+	assert.Error(t, c.BasicCheck())
+
+	c.Memo.MaxLength = -1
+	assert.Error(t, c.BasicCheck())
+
+	c.Memo.MaxLength = 32
+	assert.NoError(t, c.BasicCheck())
+
+	c.Memo.Encoding = "latin1"
+	assert.Error(t, c.BasicCheck())
+
+	c.Memo.Encoding = MemoEncodingUTF8
+	assert.NoError(t, c.BasicCheck())
+}
@@ -0,0 +1,32 @@
+package txpool
+
+import (
+	"fmt"
+
+	"github.com/pactus-project/pactus/types/tx"
+	"github.com/pactus-project/pactus/util/errors"
+)
+
+// Filter decides whether a transaction should be accepted into the transaction pool.
+// Operators can implement it to enforce their own policies, like memo policies,
+// on the transactions without forking the node.
+type Filter interface {
+	// Name returns the name of the filter.
+	Name() string
+	// Accept returns an error if the transaction should be rejected.
+	Accept(trx *tx.Tx) error
+}
+
+// RejectedTxError is returned when a filter rejects a transaction.
+type RejectedTxError struct {
+	Filter string
+	Reason string
+}
+
+func (e RejectedTxError) Error() string {
+	return fmt.Sprintf("transaction rejected by %s filter: %s", e.Filter, e.Reason)
+}
+
+func (e RejectedTxError) Code() int {
+	return errors.ErrRejectedTx
+}
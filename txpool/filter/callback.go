@@ -0,0 +1,71 @@
+package filter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/pactus-project/pactus/txpool"
+	"github.com/pactus-project/pactus/types/tx"
+	pactus "github.com/pactus-project/pactus/www/grpc/gen/go"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// callbackFilter asks an external gRPC service to decide about the transactions.
+type callbackFilter struct {
+	address string
+	timeout time.Duration
+	conn    *grpc.ClientConn
+	client  pactus.TransactionFilterClient
+}
+
+// NewCallbackFilter creates a filter that calls the `TransactionFilter` service at the given address.
+// The connection is established lazily, so the service doesn't need to be up when the node starts.
+func NewCallbackFilter(address string, timeout time.Duration) (txpool.Filter, error) {
+	conn, err := grpc.Dial(address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, fmt.Errorf("unable to connect to filter service %s: %w", address, err)
+	}
+
+	return &callbackFilter{
+		address: address,
+		timeout: timeout,
+		conn:    conn,
+		client:  pactus.NewTransactionFilterClient(conn),
+	}, nil
+}
+
+func (f *callbackFilter) Name() string {
+	return f.address
+}
+
+// Accept rejects the transaction if the service rejects it or if the service is not reachable.
+func (f *callbackFilter) Accept(trx *tx.Tx) error {
+	data, err := trx.Bytes()
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), f.timeout)
+	defer cancel()
+
+	res, err := f.client.FilterTransaction(ctx, &pactus.FilterTransactionRequest{
+		Id:   trx.ID().Bytes(),
+		Data: data,
+	})
+	if err != nil {
+		return fmt.Errorf("unable to call filter service: %w", err)
+	}
+
+	if !res.Accept {
+		if res.Reason == "" {
+			return errors.New("no reason is given")
+		}
+
+		return errors.New(res.Reason)
+	}
+
+	return nil
+}
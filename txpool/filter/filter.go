@@ -0,0 +1,29 @@
+// Package filter loads the transaction pool filters that are implemented
+// by Go plugins or external gRPC services.
+package filter
+
+import (
+	"github.com/pactus-project/pactus/txpool"
+)
+
+// Load loads all the filters defined in the configuration.
+func Load(conf *txpool.FilterConfig) ([]txpool.Filter, error) {
+	filters := make([]txpool.Filter, 0, len(conf.Plugins)+len(conf.Callbacks))
+	for _, path := range conf.Plugins {
+		f, err := LoadPlugin(path)
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, f)
+	}
+
+	for _, address := range conf.Callbacks {
+		f, err := NewCallbackFilter(address, conf.Timeout)
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, f)
+	}
+
+	return filters, nil
+}
@@ -0,0 +1,102 @@
+package filter
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/pactus-project/pactus/txpool"
+	"github.com/pactus-project/pactus/types/tx"
+	"github.com/pactus-project/pactus/util/testsuite"
+	pactus "github.com/pactus-project/pactus/www/grpc/gen/go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+type filterServer struct {
+	blockedMemo string
+}
+
+func (s *filterServer) FilterTransaction(_ context.Context,
+	req *pactus.FilterTransactionRequest,
+) (*pactus.FilterTransactionResponse, error) {
+	trx, err := tx.FromBytes(req.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	if trx.Memo() == s.blockedMemo {
+		return &pactus.FilterTransactionResponse{Accept: false, Reason: "memo is not allowed"}, nil
+	}
+
+	return &pactus.FilterTransactionResponse{Accept: true}, nil
+}
+
+func startFilterServer(t *testing.T) string {
+	t.Helper()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	server := grpc.NewServer()
+	pactus.RegisterTransactionFilterServer(server, &filterServer{blockedMemo: "blocked"})
+	go func() {
+		_ = server.Serve(listener)
+	}()
+	t.Cleanup(server.Stop)
+
+	return listener.Addr().String()
+}
+
+func TestLoadEmptyConfig(t *testing.T) {
+	filters, err := Load(&txpool.DefaultConfig().Filter)
+	assert.NoError(t, err)
+	assert.Empty(t, filters)
+}
+
+func TestLoadInvalidPlugin(t *testing.T) {
+	conf := txpool.DefaultConfig().Filter
+	conf.Plugins = []string{"/not/exists/filter.so"}
+
+	_, err := Load(&conf)
+	assert.Error(t, err)
+}
+
+func TestCallbackFilter(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	address := startFilterServer(t)
+	conf := txpool.DefaultConfig().Filter
+	conf.Callbacks = []string{address}
+
+	filters, err := Load(&conf)
+	require.NoError(t, err)
+	require.Len(t, filters, 1)
+	f := filters[0]
+	assert.Equal(t, address, f.Name())
+
+	t.Run("Should accept the transaction", func(t *testing.T) {
+		trx := tx.NewSubsidyTx(ts.RandHeight(), ts.RandAccAddress(), 1, "accepted")
+
+		assert.NoError(t, f.Accept(trx))
+	})
+
+	t.Run("Should reject the transaction", func(t *testing.T) {
+		trx := tx.NewSubsidyTx(ts.RandHeight(), ts.RandAccAddress(), 1, "blocked")
+
+		err := f.Accept(trx)
+		assert.EqualError(t, err, "memo is not allowed")
+	})
+}
+
+func TestCallbackFilterUnreachable(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	f, err := NewCallbackFilter("127.0.0.1:1", 100*time.Millisecond)
+	require.NoError(t, err)
+
+	trx := tx.NewSubsidyTx(ts.RandHeight(), ts.RandAccAddress(), 1, "accepted")
+	assert.Error(t, f.Accept(trx))
+}
@@ -0,0 +1,32 @@
+package filter
+
+import (
+	"fmt"
+	"plugin"
+
+	"github.com/pactus-project/pactus/txpool"
+)
+
+// NewFilterSymbol is the name of the function that the plugins should export.
+// Its type should be `func() (txpool.Filter, error)`.
+const NewFilterSymbol = "NewFilter"
+
+// LoadPlugin opens the Go plugin at the given path and creates a new filter from it.
+func LoadPlugin(path string) (txpool.Filter, error) {
+	p, err := plugin.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("unable to open filter plugin %s: %w", path, err)
+	}
+
+	sym, err := p.Lookup(NewFilterSymbol)
+	if err != nil {
+		return nil, fmt.Errorf("unable to find %s in filter plugin %s: %w", NewFilterSymbol, path, err)
+	}
+
+	newFilter, ok := sym.(func() (txpool.Filter, error))
+	if !ok {
+		return nil, fmt.Errorf("invalid %s function in filter plugin %s: %T", NewFilterSymbol, path, sym)
+	}
+
+	return newFilter()
+}
@@ -0,0 +1,65 @@
+package txpool
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/pactus-project/pactus/types/tx"
+	"github.com/pactus-project/pactus/util/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+type memoFilter struct {
+	blockedMemo string
+	calls       int
+}
+
+func (f *memoFilter) Name() string {
+	return "memo"
+}
+
+func (f *memoFilter) Accept(trx *tx.Tx) error {
+	f.calls++
+	if trx.Memo() == f.blockedMemo {
+		return fmt.Errorf("memo is not allowed")
+	}
+
+	return nil
+}
+
+func TestFilterTransactions(t *testing.T) {
+	td := setup(t)
+
+	f := &memoFilter{blockedMemo: "blocked"}
+	td.pool.filters = []Filter{f}
+
+	height := td.RandHeight()
+	td.sandbox.TestStore.AddTestBlock(height)
+	blockedTrx := tx.NewSubsidyTx(height+1, td.RandAccAddress(), 1, "blocked")
+	acceptedTrx := tx.NewSubsidyTx(height+1, td.RandAccAddress(), 1, "accepted")
+
+	t.Run("Should reject the transaction", func(t *testing.T) {
+		err := td.pool.AppendTx(blockedTrx)
+		assert.ErrorIs(t, err, RejectedTxError{
+			Filter: "memo",
+			Reason: "memo is not allowed",
+		})
+		assert.Equal(t, errors.ErrRejectedTx, errors.Code(err))
+		assert.False(t, td.pool.HasTx(blockedTrx.ID()))
+
+		err = td.pool.AppendTxAndBroadcast(blockedTrx)
+		assert.Equal(t, errors.ErrRejectedTx, errors.Code(err))
+	})
+
+	t.Run("Should accept the transaction", func(t *testing.T) {
+		assert.NoError(t, td.pool.AppendTxAndBroadcast(acceptedTrx))
+		td.shouldPublishTransaction(t, acceptedTrx.ID())
+		assert.True(t, td.pool.HasTx(acceptedTrx.ID()))
+	})
+
+	t.Run("Should not filter the transactions inside the pool again", func(t *testing.T) {
+		calls := f.calls
+		assert.NoError(t, td.pool.AppendTx(acceptedTrx))
+		assert.Equal(t, calls, f.calls)
+	})
+}
@@ -1,6 +1,7 @@
 package txpool
 
 import (
+	"github.com/pactus-project/pactus/execution"
 	"github.com/pactus-project/pactus/sandbox"
 	"github.com/pactus-project/pactus/types/block"
 	"github.com/pactus-project/pactus/types/tx"
@@ -11,12 +12,16 @@ type Reader interface {
 	PendingTx(id tx.ID) *tx.Tx
 	HasTx(id tx.ID) bool
 	Size() int
+	Snapshot() (uint64, []tx.ID)
+	ChangesSince(seq uint64) ([]Change, uint64, bool)
 }
 
 type TxPool interface {
 	Reader
 
+	SetChecker(checker execution.Executor)
 	SetNewSandboxAndRecheck(sb sandbox.Sandbox)
+	Reconcile(blk *block.Block, sb sandbox.Sandbox) Reconciliation
 	AppendTxAndBroadcast(trx *tx.Tx) error
 	AppendTx(tx *tx.Tx) error
 	RemoveTx(id tx.ID)
@@ -0,0 +1,61 @@
+package txpool
+
+import (
+	"github.com/pactus-project/pactus/types/tx"
+)
+
+// Change is an addition or a removal of a transaction in the pool.
+type Change struct {
+	// Sequence is increased by one for each change of the pool.
+	Sequence uint64
+	ID       tx.ID
+	Added    bool
+}
+
+// journal keeps the last changes of the pool, so the external services can mirror the pool
+// by fetching the changes since their last known sequence, instead of fetching the whole pool.
+// The changes are kept in a ring, where the change of sequence `s` is at index `(s-1) % capacity`.
+type journal struct {
+	sequence uint64
+	changes  []Change
+}
+
+func newJournal(capacity int) *journal {
+	return &journal{
+		changes: make([]Change, capacity),
+	}
+}
+
+func (j *journal) record(id tx.ID, added bool) {
+	if len(j.changes) == 0 {
+		return
+	}
+
+	j.sequence++
+	j.changes[(j.sequence-1)%uint64(len(j.changes))] = Change{
+		Sequence: j.sequence,
+		ID:       id,
+		Added:    added,
+	}
+}
+
+// since returns the changes after the sequence.
+// It returns false if some changes after the sequence are dropped,
+// or the sequence is ahead of the journal, like when the node is restarted.
+func (j *journal) since(seq uint64) ([]Change, bool) {
+	if seq > j.sequence {
+		return nil, false
+	}
+
+	capacity := uint64(len(j.changes))
+	if j.sequence-seq > capacity {
+		return nil, false
+	}
+
+	changes := make([]Change, 0, j.sequence-seq)
+	for s := seq + 1; s <= j.sequence; s++ {
+		changes = append(changes, j.changes[(s-1)%capacity])
+	}
+
+	return changes, true
+}
@@ -0,0 +1,103 @@
+package txpool
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/pactus-project/pactus/util/errors"
+)
+
+// The encodings that the memo policy can enforce.
+const (
+	MemoEncodingAny   = "any"   // Any bytes are allowed, as the protocol does
+	MemoEncodingUTF8  = "utf8"  // Valid UTF-8, without the control characters
+	MemoEncodingASCII = "ascii" // Printable ASCII characters only
+)
+
+// MemoRule is the rule of the memo policy that has rejected a memo.
+type MemoRule string
+
+const (
+	MemoRuleTooLong     = MemoRule("too_long")
+	MemoRuleInvalidUTF8 = MemoRule("invalid_utf8")
+	MemoRuleControlChar = MemoRule("control_char")
+	MemoRuleNonASCII    = MemoRule("non_ascii")
+	MemoRuleBlockedWord = MemoRule("blocked_word")
+	MemoRulePII         = MemoRule("pii")
+)
+
+var (
+	emailRegexp = regexp.MustCompile(`[A-Za-z0-9._%+-]+@[A-Za-z0-9.-]+\.[A-Za-z]{2,}`)
+	phoneRegexp = regexp.MustCompile(`\+\d[\d\s().-]{6,}\d`)
+)
+
+// MemoRejectedError is returned when the memo of a transaction doesn't comply with the memo policy.
+type MemoRejectedError struct {
+	Rule   MemoRule
+	Reason string
+}
+
+func (e MemoRejectedError) Error() string {
+	return fmt.Sprintf("memo rejected by %s rule: %s", e.Rule, e.Reason)
+}
+
+func (MemoRejectedError) Code() int {
+	return errors.ErrInvalidMemo
+}
+
+// checkMemo checks the memo against the memo policy of the pool.
+func (conf *MemoConfig) checkMemo(memo string) error {
+	if conf.MaxLength > 0 && len(memo) > conf.MaxLength {
+		return MemoRejectedError{
+			Rule:   MemoRuleTooLong,
+			Reason: fmt.Sprintf("length is %d, the maximum is %d", len(memo), conf.MaxLength),
+		}
+	}
+
+	switch conf.Encoding {
+	case MemoEncodingUTF8:
+		if !utf8.ValidString(memo) {
+			return MemoRejectedError{Rule: MemoRuleInvalidUTF8, Reason: "memo is not a valid UTF-8 string"}
+		}
+		for _, r := range memo {
+			if unicode.IsControl(r) {
+				return MemoRejectedError{
+					Rule:   MemoRuleControlChar,
+					Reason: fmt.Sprintf("control character %U is not allowed", r),
+				}
+			}
+		}
+
+	case MemoEncodingASCII:
+		for i := 0; i < len(memo); i++ {
+			if memo[i] < 0x20 || memo[i] > 0x7e {
+				return MemoRejectedError{
+					Rule:   MemoRuleNonASCII,
+					Reason: fmt.Sprintf("byte 0x%02x at %d is not a printable ASCII character", memo[i], i),
+				}
+			}
+		}
+	}
+
+	lower := strings.ToLower(memo)
+	for _, word := range conf.BlockedWords {
+		if word != "" && strings.Contains(lower, strings.ToLower(word)) {
+			// The blocked word is not reported back, not to echo it in the logs and the clients.
+			return MemoRejectedError{Rule: MemoRuleBlockedWord, Reason: "memo contains a blocked word"}
+		}
+	}
+
+	if conf.RejectPII {
+		if emailRegexp.MatchString(memo) {
+			return MemoRejectedError{Rule: MemoRulePII, Reason: "memo contains an email address"}
+		}
+		if phoneRegexp.MatchString(memo) {
+			return MemoRejectedError{Rule: MemoRulePII, Reason: "memo contains a phone number"}
+		}
+	}
+
+	return nil
+}
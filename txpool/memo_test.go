@@ -0,0 +1,74 @@
+package txpool
+
+import (
+	"testing"
+
+	"github.com/pactus-project/pactus/types/tx"
+	"github.com/pactus-project/pactus/util/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckMemo(t *testing.T) {
+	tests := []struct {
+		name string
+		conf MemoConfig
+		memo string
+		rule MemoRule
+	}{
+		{"Any bytes", MemoConfig{Encoding: MemoEncodingAny}, "\xff\x00", ""},
+		{"Too long", MemoConfig{Encoding: MemoEncodingAny, MaxLength: 4}, "hello", MemoRuleTooLong},
+		{"Valid UTF-8", MemoConfig{Encoding: MemoEncodingUTF8}, "héllo wörld", ""},
+		{"Invalid UTF-8", MemoConfig{Encoding: MemoEncodingUTF8}, "hello\xff", MemoRuleInvalidUTF8},
+		{"Control character", MemoConfig{Encoding: MemoEncodingUTF8}, "hello\x1b[31m", MemoRuleControlChar},
+		{"Printable ASCII", MemoConfig{Encoding: MemoEncodingASCII}, "deposit #123", ""},
+		{"Non ASCII", MemoConfig{Encoding: MemoEncodingASCII}, "héllo", MemoRuleNonASCII},
+		{
+			"Blocked word", MemoConfig{Encoding: MemoEncodingAny, BlockedWords: []string{"Scam"}},
+			"not a SCAM", MemoRuleBlockedWord,
+		},
+		{"Email, not checked", MemoConfig{Encoding: MemoEncodingAny}, "alice@example.com", ""},
+		{"Email", MemoConfig{Encoding: MemoEncodingAny, RejectPII: true}, "to alice@example.com", MemoRulePII},
+		{
+			"Phone number", MemoConfig{Encoding: MemoEncodingAny, RejectPII: true},
+			"call +1 (555) 010-9999", MemoRulePII,
+		},
+		{"Deposit tag", MemoConfig{Encoding: MemoEncodingAny, RejectPII: true}, "1234567890", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.conf.checkMemo(tt.memo)
+			if tt.rule == "" {
+				assert.NoError(t, err)
+
+				return
+			}
+
+			var memoErr MemoRejectedError
+			assert.ErrorAs(t, err, &memoErr)
+			assert.Equal(t, tt.rule, memoErr.Rule)
+			assert.Equal(t, errors.ErrInvalidMemo, errors.Code(err))
+		})
+	}
+}
+
+func TestMemoPolicy(t *testing.T) {
+	td := setup(t)
+
+	td.pool.config.Memo.Encoding = MemoEncodingASCII
+
+	height := td.RandHeight()
+	td.sandbox.TestStore.AddTestBlock(height)
+	rejectedTrx := tx.NewSubsidyTx(height+1, td.RandAccAddress(), 1, "\x00\x01")
+	acceptedTrx := tx.NewSubsidyTx(height+1, td.RandAccAddress(), 1, "ascii")
+
+	err := td.pool.AppendTxAndBroadcast(rejectedTrx)
+	assert.ErrorIs(t, err, MemoRejectedError{
+		Rule:   MemoRuleNonASCII,
+		Reason: "byte 0x00 at 0 is not a printable ASCII character",
+	})
+	assert.False(t, td.pool.HasTx(rejectedTrx.ID()))
+
+	assert.NoError(t, td.pool.AppendTxAndBroadcast(acceptedTrx))
+	assert.True(t, td.pool.HasTx(acceptedTrx.ID()))
+}
@@ -2,6 +2,7 @@ package txpool
 
 import (
 	"github.com/pactus-project/pactus/crypto/hash"
+	"github.com/pactus-project/pactus/execution"
 	"github.com/pactus-project/pactus/sandbox"
 	"github.com/pactus-project/pactus/types/block"
 	"github.com/pactus-project/pactus/types/tx"
@@ -12,6 +13,8 @@ var _ TxPool = &MockTxPool{}
 // MockTxPool is a testing mock.
 type MockTxPool struct {
 	Txs []*tx.Tx
+	// AppendErr, if set, is returned when appending a transaction.
+	AppendErr error
 }
 
 func MockingTxPool() *MockTxPool {
@@ -19,7 +22,14 @@ func MockingTxPool() *MockTxPool {
 		Txs: make([]*tx.Tx, 0),
 	}
 }
+func (m *MockTxPool) SetChecker(_ execution.Executor)           {}
 func (m *MockTxPool) SetNewSandboxAndRecheck(_ sandbox.Sandbox) {}
+
+func (m *MockTxPool) Reconcile(_ *block.Block, _ sandbox.Sandbox) Reconciliation {
+	// Like RemoveTx, the transactions are kept, since this test pool is shared.
+	return Reconciliation{}
+}
+
 func (m *MockTxPool) PendingTx(id tx.ID) *tx.Tx {
 	for _, t := range m.Txs {
 		if t.ID() == id {
@@ -48,17 +58,48 @@ func (m *MockTxPool) Size() int {
 	return len(m.Txs)
 }
 
+// Snapshot returns the IDs of the transactions, considering each appended transaction as a change.
+func (m *MockTxPool) Snapshot() (uint64, []tx.ID) {
+	ids := make([]tx.ID, 0, len(m.Txs))
+	for _, t := range m.Txs {
+		ids = append(ids, t.ID())
+	}
+
+	return uint64(len(m.Txs)), ids
+}
+
+func (m *MockTxPool) ChangesSince(seq uint64) ([]Change, uint64, bool) {
+	if seq > uint64(len(m.Txs)) {
+		return nil, uint64(len(m.Txs)), false
+	}
+
+	changes := make([]Change, 0)
+	for i := seq; i < uint64(len(m.Txs)); i++ {
+		changes = append(changes, Change{Sequence: i + 1, ID: m.Txs[i].ID(), Added: true})
+	}
+
+	return changes, uint64(len(m.Txs)), true
+}
+
 func (m *MockTxPool) String() string {
 	return ""
 }
 
 func (m *MockTxPool) AppendTx(trx *tx.Tx) error {
+	if m.AppendErr != nil {
+		return m.AppendErr
+	}
+
 	m.Txs = append(m.Txs, trx)
 
 	return nil
 }
 
 func (m *MockTxPool) AppendTxAndBroadcast(trx *tx.Tx) error {
+	if m.AppendErr != nil {
+		return m.AppendErr
+	}
+
 	m.Txs = append(m.Txs, trx)
 
 	return nil
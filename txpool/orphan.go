@@ -0,0 +1,12 @@
+package txpool
+
+import "github.com/pactus-project/pactus/types/tx"
+
+// orphanTx is a transaction that can't be validated yet, since the node is not caught up.
+// Orphan transactions are retried whenever a new block is committed.
+type orphanTx struct {
+	trx *tx.Tx
+	// broadcast indicates whether the transaction should be broadcast
+	// once it is appended into the pool.
+	broadcast bool
+}
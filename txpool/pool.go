@@ -10,23 +10,31 @@ import (
 	"github.com/pactus-project/pactus/types/block"
 	"github.com/pactus-project/pactus/types/tx"
 	"github.com/pactus-project/pactus/types/tx/payload"
+	"github.com/pactus-project/pactus/util/errors"
 	"github.com/pactus-project/pactus/util/linkedlist"
 	"github.com/pactus-project/pactus/util/linkedmap"
 	"github.com/pactus-project/pactus/util/logger"
+	"github.com/pactus-project/pactus/util/txtrace"
 )
 
 type txPool struct {
 	lk sync.RWMutex
 
 	config      *Config
-	checker     *execution.Execution
+	checker     execution.Executor
 	sandbox     sandbox.Sandbox
 	pools       map[payload.Type]*linkedmap.LinkedMap[tx.ID, *tx.Tx]
+	orphans     *linkedmap.LinkedMap[tx.ID, *orphanTx]
+	locals      map[tx.ID]*localTx
+	journal     *journal
+	filters     []Filter
 	broadcastCh chan message.Message
 	logger      *logger.SubLogger
 }
 
-func NewTxPool(conf *Config, broadcastCh chan message.Message) TxPool {
+// NewTxPool creates a new transaction pool.
+// The new transactions are accepted only if all the filters accept them.
+func NewTxPool(conf *Config, broadcastCh chan message.Message, filters ...Filter) TxPool {
 	pending := make(map[payload.Type]*linkedmap.LinkedMap[tx.ID, *tx.Tx])
 
 	pending[payload.TypeTransfer] = linkedmap.New[tx.ID, *tx.Tx](conf.sendPoolSize())
@@ -34,11 +42,25 @@ func NewTxPool(conf *Config, broadcastCh chan message.Message) TxPool {
 	pending[payload.TypeUnbond] = linkedmap.New[tx.ID, *tx.Tx](conf.unbondPoolSize())
 	pending[payload.TypeWithdraw] = linkedmap.New[tx.ID, *tx.Tx](conf.withdrawPoolSize())
 	pending[payload.TypeSortition] = linkedmap.New[tx.ID, *tx.Tx](conf.sortitionPoolSize())
+	pending[payload.TypeEscrow] = linkedmap.New[tx.ID, *tx.Tx](conf.escrowPoolSize())
+	pending[payload.TypeData] = linkedmap.New[tx.ID, *tx.Tx](conf.dataPoolSize())
+	pending[payload.TypeAllowlist] = linkedmap.New[tx.ID, *tx.Tx](conf.allowlistPoolSize())
+	pending[payload.TypeCondition] = linkedmap.New[tx.ID, *tx.Tx](conf.conditionPoolSize())
+	pending[payload.TypeDelegate] = linkedmap.New[tx.ID, *tx.Tx](conf.delegatePoolSize())
+	pending[payload.TypeRewardSplit] = linkedmap.New[tx.ID, *tx.Tx](conf.rewardSplitPoolSize())
+
+	for _, typ := range payload.CustomTypes() {
+		pending[typ] = linkedmap.New[tx.ID, *tx.Tx](conf.customPoolSize())
+	}
 
 	pool := &txPool{
 		config:      conf,
 		checker:     execution.NewChecker(),
 		pools:       pending,
+		orphans:     linkedmap.New[tx.ID, *orphanTx](conf.OrphanSize),
+		locals:      make(map[tx.ID]*localTx),
+		journal:     newJournal(conf.journalSize()),
+		filters:     filters,
 		broadcastCh: broadcastCh,
 	}
 
@@ -47,6 +69,15 @@ func NewTxPool(conf *Config, broadcastCh chan message.Message) TxPool {
 	return pool
 }
 
+// SetChecker sets the executor that checks the transactions before they enter the pool.
+// By default, the checker of the default execution engine is used.
+func (p *txPool) SetChecker(checker execution.Executor) {
+	p.lk.Lock()
+	defer p.lk.Unlock()
+
+	p.checker = checker
+}
+
 func (p *txPool) SetNewSandboxAndRecheck(sb sandbox.Sandbox) {
 	p.lk.Lock()
 	defer p.lk.Unlock()
@@ -54,16 +85,36 @@ func (p *txPool) SetNewSandboxAndRecheck(sb sandbox.Sandbox) {
 	p.sandbox = sb
 	p.logger.Debug("set new sandbox")
 
-	var next *linkedlist.Element[linkedmap.Pair[tx.ID, *tx.Tx]]
-	for _, pool := range p.pools {
-		for e := pool.HeadNode(); e != nil; e = next {
-			next = e.Next
-			trx := e.Data.Value
+	p.recheck(&Reconciliation{})
+}
 
-			if err := p.checkTx(trx); err != nil {
-				p.logger.Debug("invalid transaction after rechecking", "id", trx.ID())
-				pool.Remove(trx.ID())
-			}
+// recheckOrphans retries the orphan transactions against the new sandbox.
+// The valid ones are moved into the pool, and the ones that can't be orphan anymore are dropped.
+func (p *txPool) recheckOrphans(res *Reconciliation) {
+	var next *linkedlist.Element[linkedmap.Pair[tx.ID, *orphanTx]]
+	for e := p.orphans.HeadNode(); e != nil; e = next {
+		next = e.Next
+		orphan := e.Data.Value
+
+		err := p.checkTx(orphan.trx)
+		if err != nil && isOrphanError(err) {
+			continue
+		}
+
+		p.orphans.Remove(orphan.trx.ID())
+		if err != nil {
+			p.logger.Debug("orphan transaction dropped", "id", orphan.trx.ID(), "error", err)
+			res.Dropped++
+
+			continue
+		}
+
+		p.pushTx(orphan.trx)
+		p.logger.Debug("orphan transaction appended into pool", "tx", orphan.trx)
+		res.Promoted++
+
+		if orphan.broadcast {
+			p.broadcastTx(orphan.trx)
 		}
 	}
 }
@@ -71,47 +122,95 @@ func (p *txPool) SetNewSandboxAndRecheck(sb sandbox.Sandbox) {
 // AppendTx validates the transaction and add it into the transaction pool
 // without broadcast it.
 func (p *txPool) AppendTx(trx *tx.Tx) error {
+	if err := p.filterTx(trx); err != nil {
+		return err
+	}
+
 	p.lk.Lock()
 	defer p.lk.Unlock()
 
-	return p.appendTx(trx)
+	return p.appendTx(trx, false)
 }
 
 // AppendTxAndBroadcast validates the transaction, add it into the transaction pool
 // and broadcast it.
 func (p *txPool) AppendTxAndBroadcast(trx *tx.Tx) error {
+	if err := p.filterTx(trx); err != nil {
+		return err
+	}
+
 	p.lk.Lock()
 	defer p.lk.Unlock()
 
-	if err := p.appendTx(trx); err != nil {
+	if err := p.appendTx(trx, true); err != nil {
 		return err
 	}
+	p.trackLocal(trx)
+
+	// The orphan transactions are broadcast once they are appended into the pool.
+	if !p.orphans.Has(trx.ID()) {
+		p.broadcastTx(trx)
+	}
+
+	return nil
+}
 
+func (p *txPool) broadcastTx(trx *tx.Tx) {
 	go func(t *tx.Tx) {
 		p.broadcastCh <- message.NewTransactionsMessage([]*tx.Tx{t})
 	}(trx)
-
-	return nil
 }
 
-func (p *txPool) appendTx(trx *tx.Tx) error {
-	pool := p.pools[trx.Payload().Type()]
+func (p *txPool) appendTx(trx *tx.Tx, broadcast bool) error {
+	txtrace.Record(trx.ID(), txtrace.StageReceived)
+
+	pool, ok := p.pools[trx.Payload().Type()]
+	if !ok {
+		return execution.UnknownPayloadTypeError{
+			PayloadType: trx.Payload().Type(),
+		}
+	}
 	if pool.Has(trx.ID()) {
 		p.logger.Trace("transaction is already in pool", "id", trx.ID())
 
 		return nil
 	}
 
+	if p.orphans.Has(trx.ID()) {
+		p.logger.Trace("transaction is already in orphan pool", "id", trx.ID())
+
+		return nil
+	}
+
 	if err := p.checkTx(trx); err != nil {
+		if p.config.OrphanSize > 0 && isOrphanError(err) {
+			// The oldest orphan transaction is pruned by pushing the new one.
+			p.orphans.PushBack(trx.ID(), &orphanTx{trx: trx, broadcast: broadcast})
+			p.logger.Debug("transaction appended into orphan pool", "tx", trx, "error", err)
+
+			return nil
+		}
+
 		return err
 	}
 
-	pool.PushBack(trx.ID(), trx)
+	p.pushTx(trx)
 	p.logger.Debug("transaction appended into pool", "tx", trx)
 
 	return nil
 }
 
+func (p *txPool) pushTx(trx *tx.Tx) {
+	pool := p.pools[trx.Payload().Type()]
+	if pool.Capacity() > 0 && pool.Full() {
+		// The oldest transaction is pruned by pushing the new one.
+		p.journal.record(pool.HeadNode().Data.Key, false)
+	}
+	pool.PushBack(trx.ID(), trx)
+	p.journal.record(trx.ID(), true)
+	txtrace.Record(trx.ID(), txtrace.StagePoolAccepted)
+}
+
 func (p *txPool) checkTx(trx *tx.Tx) error {
 	if err := p.checker.Execute(trx, p.sandbox); err != nil {
 		p.logger.Debug("invalid transaction", "tx", trx, "error", err)
@@ -122,15 +221,74 @@ func (p *txPool) checkTx(trx *tx.Tx) error {
 	return nil
 }
 
+// isOrphanError checks whether the transaction might be valid after the node catches up,
+// like when the account of the signer is not known locally or the lock time is ahead of the node.
+func isOrphanError(err error) bool {
+	code := errors.Code(err)
+
+	return code == errors.ErrInvalidAddress || code == errors.ErrFutureLockTime
+}
+
+// filterTx checks the new transactions against the memo policy and the filters.
+// It doesn't hold the lock, since the filters might call external services.
+func (p *txPool) filterTx(trx *tx.Tx) error {
+	if p.HasTx(trx.ID()) {
+		return nil
+	}
+
+	if err := p.config.Memo.checkMemo(trx.Memo()); err != nil {
+		p.logger.Debug("transaction rejected by memo policy", "tx", trx, "error", err)
+
+		return err
+	}
+
+	for _, f := range p.filters {
+		if err := f.Accept(trx); err != nil {
+			p.logger.Debug("transaction rejected by filter", "tx", trx, "filter", f.Name(), "error", err)
+
+			return RejectedTxError{
+				Filter: f.Name(),
+				Reason: err.Error(),
+			}
+		}
+	}
+
+	return nil
+}
+
 func (p *txPool) RemoveTx(id tx.ID) {
 	p.lk.Lock()
 	defer p.lk.Unlock()
 
+	p.removeTx(id)
+}
+
+// Snapshot returns the IDs of the transactions in the pool,
+// and the sequence of the last change of the pool.
+func (p *txPool) Snapshot() (uint64, []tx.ID) {
+	p.lk.RLock()
+	defer p.lk.RUnlock()
+
+	ids := make([]tx.ID, 0, p.size())
 	for _, pool := range p.pools {
-		if pool.Remove(id) {
-			break
+		for n := pool.HeadNode(); n != nil; n = n.Next {
+			ids = append(ids, n.Data.Key)
 		}
 	}
+
+	return p.journal.sequence, ids
+}
+
+// ChangesSince returns the changes of the pool after the sequence,
+// and the sequence of the last change of the pool.
+// It returns false if the changes are not kept anymore, then a new snapshot should be taken.
+func (p *txPool) ChangesSince(seq uint64) ([]Change, uint64, bool) {
+	p.lk.RLock()
+	defer p.lk.RUnlock()
+
+	changes, ok := p.journal.since(seq)
+
+	return changes, p.journal.sequence, ok
 }
 
 // PendingTx searches inside the transaction pool and returns the associated transaction.
@@ -155,6 +313,12 @@ func (p *txPool) PrepareBlockTransactions() block.Txs {
 	p.lk.RLock()
 	defer p.lk.RUnlock()
 
+	// Appending allowlist transactions, before the bond and sortition transactions that rely on them
+	poolAllowlist := p.pools[payload.TypeAllowlist]
+	for n := poolAllowlist.HeadNode(); n != nil; n = n.Next {
+		trxs = append(trxs, n.Data.Value)
+	}
+
 	// Appending one sortition transaction
 	poolSortition := p.pools[payload.TypeSortition]
 	for n := poolSortition.HeadNode(); n != nil; n = n.Next {
@@ -167,18 +331,62 @@ func (p *txPool) PrepareBlockTransactions() block.Txs {
 		trxs = append(trxs, n.Data.Value)
 	}
 
+	// Appending delegate transactions
+	poolDelegate := p.pools[payload.TypeDelegate]
+	for n := poolDelegate.HeadNode(); n != nil; n = n.Next {
+		trxs = append(trxs, n.Data.Value)
+	}
+
 	// Appending unbond transactions
 	poolUnbond := p.pools[payload.TypeUnbond]
 	for n := poolUnbond.HeadNode(); n != nil; n = n.Next {
 		trxs = append(trxs, n.Data.Value)
 	}
 
+	// Appending reward split transactions
+	poolRewardSplit := p.pools[payload.TypeRewardSplit]
+	for n := poolRewardSplit.HeadNode(); n != nil; n = n.Next {
+		trxs = append(trxs, n.Data.Value)
+	}
+
 	// Appending withdraw transactions
 	poolWithdraw := p.pools[payload.TypeWithdraw]
 	for n := poolWithdraw.HeadNode(); n != nil; n = n.Next {
 		trxs = append(trxs, n.Data.Value)
 	}
 
+	// Appending escrow transactions
+	poolEscrow := p.pools[payload.TypeEscrow]
+	for n := poolEscrow.HeadNode(); n != nil; n = n.Next {
+		trxs = append(trxs, n.Data.Value)
+	}
+
+	// Appending data transactions, up to the maximum data size of a block
+	poolData := p.pools[payload.TypeData]
+	dataSize := 0
+	for n := poolData.HeadNode(); n != nil; n = n.Next {
+		size := len(n.Data.Value.Payload().(*payload.DataPayload).Data)
+		if dataSize+size > payload.MaxBlockDataSize {
+			continue
+		}
+		dataSize += size
+		trxs = append(trxs, n.Data.Value)
+	}
+
+	// Appending custom transactions
+	for _, typ := range payload.CustomTypes() {
+		poolCustom := p.pools[typ]
+		for n := poolCustom.HeadNode(); n != nil; n = n.Next {
+			trxs = append(trxs, n.Data.Value)
+		}
+	}
+
+	// Appending condition transactions
+	poolCondition := p.pools[payload.TypeCondition]
+	for n := poolCondition.HeadNode(); n != nil; n = n.Next {
+		trxs = append(trxs, n.Data.Value)
+	}
+
 	// Appending transfer transactions
 	poolSend := p.pools[payload.TypeTransfer]
 	for n := poolSend.HeadNode(); n != nil; n = n.Next {
@@ -192,6 +400,10 @@ func (p *txPool) HasTx(id tx.ID) bool {
 	p.lk.RLock()
 	defer p.lk.RUnlock()
 
+	return p.hasTx(id)
+}
+
+func (p *txPool) hasTx(id tx.ID) bool {
 	for _, pool := range p.pools {
 		if pool.Has(id) {
 			return true
@@ -205,6 +417,10 @@ func (p *txPool) Size() int {
 	p.lk.RLock()
 	defer p.lk.RUnlock()
 
+	return p.size()
+}
+
+func (p *txPool) size() int {
 	size := 0
 	for _, pool := range p.pools {
 		size += pool.Size()
@@ -214,11 +430,17 @@ func (p *txPool) Size() int {
 }
 
 func (p *txPool) String() string {
-	return fmt.Sprintf("{💸 %v 🔐 %v 🔓 %v 🎯 %v 🧾 %v}",
+	return fmt.Sprintf("{💸 %v 🔐 %v 🔓 %v 🎯 %v 🧾 %v 🤝 %v 📝 %v 🛂 %v 🔏 %v 🪢 %v 🍰 %v}",
 		p.pools[payload.TypeTransfer].Size(),
 		p.pools[payload.TypeBond].Size(),
 		p.pools[payload.TypeUnbond].Size(),
 		p.pools[payload.TypeSortition].Size(),
 		p.pools[payload.TypeWithdraw].Size(),
+		p.pools[payload.TypeEscrow].Size(),
+		p.pools[payload.TypeData].Size(),
+		p.pools[payload.TypeAllowlist].Size(),
+		p.pools[payload.TypeCondition].Size(),
+		p.pools[payload.TypeDelegate].Size(),
+		p.pools[payload.TypeRewardSplit].Size(),
 	)
 }
@@ -10,6 +10,7 @@ import (
 	"github.com/pactus-project/pactus/sync/bundle/message"
 	"github.com/pactus-project/pactus/types/account"
 	"github.com/pactus-project/pactus/types/tx"
+	"github.com/pactus-project/pactus/types/tx/payload"
 	"github.com/pactus-project/pactus/types/validator"
 	"github.com/pactus-project/pactus/util/logger"
 	"github.com/pactus-project/pactus/util/testsuite"
@@ -163,9 +164,10 @@ func TestPrepareBlockTransactions(t *testing.T) {
 		td.RandAccAddress(), 1000, 1000, "send-tx")
 	td.HelperSignTransaction(acc1PrvKey, transferTx)
 
-	pub, _ := td.RandBLSKeyPair()
+	pub, prv := td.RandBLSKeyPair()
 	bondTx := tx.NewBondTx(randHeight+2, acc1Addr,
 		pub.ValidatorAddress(), pub, 1000000000, 100000, "bond-tx")
+	td.HelperSetProofOfPossession(prv, bondTx)
 	td.HelperSignTransaction(acc1PrvKey, bondTx)
 
 	unbondTx := tx.NewUnbondTx(randHeight+3, val1.Address(), "unbond-tx")
@@ -195,6 +197,34 @@ func TestPrepareBlockTransactions(t *testing.T) {
 	assert.Equal(t, trxs[4].ID(), transferTx.ID())
 }
 
+func TestPrepareBlockDataTransactions(t *testing.T) {
+	td := setup(t)
+
+	randHeight := td.RandHeight()
+	_ = td.sandbox.TestStore.AddTestBlock(randHeight)
+
+	accPubKey, accPrvKey := td.RandBLSKeyPair()
+	accAddr := accPubKey.AccountAddress()
+	acc := account.NewAccount(0)
+	acc.AddToBalance(10000000000)
+	td.sandbox.UpdateAccount(accAddr, acc)
+
+	count := payload.MaxBlockDataSize/payload.MaxDataSize + 2
+	fee := execution.CalculateDataFee(payload.MaxDataSize, td.sandbox.Params())
+	for i := 0; i < count; i++ {
+		dataTx := tx.NewDataTx(randHeight+1, accAddr,
+			td.RandBytes(payload.MaxDataSize), fee, "data-tx")
+		td.HelperSignTransaction(accPrvKey, dataTx)
+
+		assert.NoError(t, td.pool.AppendTx(dataTx))
+	}
+
+	// Data transactions exceeding the maximum data size of a block are left in the pool
+	trxs := td.pool.PrepareBlockTransactions()
+	assert.Len(t, trxs, payload.MaxBlockDataSize/payload.MaxDataSize)
+	assert.Equal(t, count, td.pool.Size())
+}
+
 func TestAppendAndBroadcast(t *testing.T) {
 	td := setup(t)
 
@@ -234,3 +264,165 @@ func TestAddSubsidyTransactions(t *testing.T) {
 	td.pool.SetNewSandboxAndRecheck(td.sandbox)
 	assert.Zero(t, td.pool.Size())
 }
+
+func TestSnapshotAndChanges(t *testing.T) {
+	td := setup(t)
+
+	randHeight := td.RandHeight()
+	_ = td.sandbox.TestStore.AddTestBlock(randHeight)
+	valKey := td.RandValKey()
+	acc := account.NewAccount(0)
+	acc.AddToBalance(10000000000)
+	td.sandbox.UpdateAccount(valKey.Address(), acc)
+
+	seq, ids := td.pool.Snapshot()
+	assert.Zero(t, seq)
+	assert.Empty(t, ids)
+
+	trxs := make([]*tx.Tx, td.pool.config.sendPoolSize()+1)
+	for i := 0; i < len(trxs); i++ {
+		trx := tx.NewTransferTx(randHeight+1, valKey.Address(),
+			td.RandAccAddress(), 1000, 1000, "ok")
+		valKey.Sign(trx.SignBytes())
+		assert.NoError(t, td.pool.AppendTx(trx))
+		trxs[i] = trx
+	}
+	td.pool.RemoveTx(trxs[1].ID())
+
+	seq, ids = td.pool.Snapshot()
+	assert.Equal(t, uint64(len(trxs)+2), seq)
+	assert.Len(t, ids, td.pool.Size())
+	assert.NotContains(t, ids, trxs[0].ID())
+	assert.NotContains(t, ids, trxs[1].ID())
+
+	changes, last, ok := td.pool.ChangesSince(seq - 4)
+	assert.True(t, ok)
+	assert.Equal(t, seq, last)
+	assert.Equal(t, []Change{
+		{Sequence: seq - 3, ID: trxs[len(trxs)-2].ID(), Added: true},
+		{Sequence: seq - 2, ID: trxs[0].ID(), Added: false}, // pruned
+		{Sequence: seq - 1, ID: trxs[len(trxs)-1].ID(), Added: true},
+		{Sequence: seq, ID: trxs[1].ID(), Added: false},
+	}, changes)
+
+	changes, _, ok = td.pool.ChangesSince(seq)
+	assert.True(t, ok)
+	assert.Empty(t, changes)
+
+	_, _, ok = td.pool.ChangesSince(seq + 1)
+	assert.False(t, ok, "sequence is ahead of the pool")
+}
+
+func TestJournalOverflow(t *testing.T) {
+	td := setup(t)
+
+	j := newJournal(3)
+	ids := []tx.ID{td.RandHash(), td.RandHash(), td.RandHash(), td.RandHash(), td.RandHash()}
+	for _, id := range ids {
+		j.record(id, true)
+	}
+
+	_, ok := j.since(1)
+	assert.False(t, ok, "the second change is dropped")
+
+	changes, ok := j.since(2)
+	assert.True(t, ok)
+	assert.Equal(t, []Change{
+		{Sequence: 3, ID: ids[2], Added: true},
+		{Sequence: 4, ID: ids[3], Added: true},
+		{Sequence: 5, ID: ids[4], Added: true},
+	}, changes)
+}
+
+func TestOrphanTransaction(t *testing.T) {
+	td := setup(t)
+
+	randHeight := td.RandHeight()
+	_ = td.sandbox.TestStore.AddTestBlock(randHeight)
+
+	valKey := td.RandValKey()
+	trx := tx.NewTransferTx(randHeight+1, valKey.Address(),
+		td.RandAccAddress(), 1000, 1000, "orphan")
+	valKey.Sign(trx.SignBytes())
+
+	// The sender's account is not known yet.
+	assert.NoError(t, td.pool.AppendTxAndBroadcast(trx))
+	assert.False(t, td.pool.HasTx(trx.ID()))
+	assert.Equal(t, 1, td.pool.orphans.Size())
+	assert.Empty(t, td.ch)
+
+	// Appending the same transaction again, should not return any error
+	assert.NoError(t, td.pool.AppendTx(trx))
+	assert.Equal(t, 1, td.pool.orphans.Size())
+
+	// Still orphan after rechecking
+	td.pool.SetNewSandboxAndRecheck(td.sandbox)
+	assert.False(t, td.pool.HasTx(trx.ID()))
+	assert.Equal(t, 1, td.pool.orphans.Size())
+
+	acc := account.NewAccount(0)
+	acc.AddToBalance(10000000000)
+	td.sandbox.UpdateAccount(valKey.Address(), acc)
+	td.pool.SetNewSandboxAndRecheck(td.sandbox)
+
+	assert.True(t, td.pool.HasTx(trx.ID()))
+	assert.Zero(t, td.pool.orphans.Size())
+	td.shouldPublishTransaction(t, trx.ID())
+}
+
+func TestDropInvalidOrphanTransaction(t *testing.T) {
+	td := setup(t)
+
+	randHeight := td.RandHeight()
+	_ = td.sandbox.TestStore.AddTestBlock(randHeight)
+
+	valKey := td.RandValKey()
+	trx := tx.NewTransferTx(randHeight+1, valKey.Address(),
+		td.RandAccAddress(), 1000, 1000, "orphan")
+	valKey.Sign(trx.SignBytes())
+
+	assert.NoError(t, td.pool.AppendTx(trx))
+	assert.Equal(t, 1, td.pool.orphans.Size())
+
+	// The sender's account has no balance.
+	td.sandbox.UpdateAccount(valKey.Address(), account.NewAccount(0))
+	td.pool.SetNewSandboxAndRecheck(td.sandbox)
+
+	assert.False(t, td.pool.HasTx(trx.ID()))
+	assert.Zero(t, td.pool.orphans.Size())
+}
+
+func TestFullOrphanPool(t *testing.T) {
+	td := setup(t)
+
+	randHeight := td.RandHeight()
+	_ = td.sandbox.TestStore.AddTestBlock(randHeight)
+
+	for i := 0; i < td.pool.config.OrphanSize+1; i++ {
+		valKey := td.RandValKey()
+		trx := tx.NewTransferTx(randHeight+1, valKey.Address(),
+			td.RandAccAddress(), 1000, 1000, "orphan")
+		valKey.Sign(trx.SignBytes())
+
+		assert.NoError(t, td.pool.AppendTx(trx))
+	}
+
+	assert.Equal(t, td.pool.config.OrphanSize, td.pool.orphans.Size())
+}
+
+func TestDisabledOrphanPool(t *testing.T) {
+	td := setup(t)
+
+	td.pool.config.OrphanSize = 0
+
+	randHeight := td.RandHeight()
+	_ = td.sandbox.TestStore.AddTestBlock(randHeight)
+
+	valKey := td.RandValKey()
+	trx := tx.NewTransferTx(randHeight+1, valKey.Address(),
+		td.RandAccAddress(), 1000, 1000, "orphan")
+	valKey.Sign(trx.SignBytes())
+
+	assert.Error(t, td.pool.AppendTx(trx))
+	assert.Zero(t, td.pool.orphans.Size())
+}
@@ -0,0 +1,77 @@
+package txpool
+
+import (
+	"github.com/pactus-project/pactus/types/tx"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// firstRebroadcastInterval is the number of blocks after that a local transaction
+// is broadcast again for the first time. The interval is doubled after each rebroadcast.
+const firstRebroadcastInterval = 2
+
+var rebroadcastCounter = prometheus.NewCounter(prometheus.CounterOpts{
+	Namespace: "pactus",
+	Subsystem: "txpool",
+	Name:      "rebroadcast_total",
+	Help:      "The number of times that the local transactions are broadcast again, since they are not committed yet.",
+})
+
+func init() {
+	prometheus.MustRegister(rebroadcastCounter)
+}
+
+// localTx is a transaction that is originated by this node.
+// It is broadcast again, with a backoff, until it leaves the pool,
+// which is when it is committed, expired or not valid anymore.
+type localTx struct {
+	trx        *tx.Tx
+	interval   uint32
+	nextHeight uint32
+}
+
+// trackLocal starts tracking the transaction to broadcast it again, if it is not committed in time.
+func (p *txPool) trackLocal(trx *tx.Tx) {
+	if p.config.MaxRebroadcastInterval == 0 || p.sandbox == nil {
+		return
+	}
+	if _, ok := p.locals[trx.ID()]; ok {
+		return
+	}
+
+	p.locals[trx.ID()] = &localTx{
+		trx:        trx,
+		interval:   firstRebroadcastInterval,
+		nextHeight: p.sandbox.CurrentHeight() + firstRebroadcastInterval,
+	}
+}
+
+// rebroadcastLocals broadcasts the local transactions that are still in the pool, once their time has come.
+// The local transactions that are not in the pool anymore are not tracked.
+// It returns the number of the transactions that are broadcast again.
+func (p *txPool) rebroadcastLocals(height uint32) int {
+	count := 0
+	for id, local := range p.locals {
+		if p.orphans.Has(id) {
+			// The orphan transactions are broadcast once they are appended into the pool.
+			continue
+		}
+		if !p.hasTx(id) {
+			delete(p.locals, id)
+
+			continue
+		}
+		if height < local.nextHeight {
+			continue
+		}
+
+		p.logger.Debug("broadcasting the local transaction again", "id", id, "interval", local.interval)
+		p.broadcastTx(local.trx)
+		rebroadcastCounter.Inc()
+		count++
+
+		local.interval = min(local.interval*2, p.config.MaxRebroadcastInterval)
+		local.nextHeight = height + local.interval
+	}
+
+	return count
+}
@@ -0,0 +1,64 @@
+package txpool
+
+import (
+	"testing"
+
+	"github.com/pactus-project/pactus/types/account"
+	"github.com/pactus-project/pactus/types/tx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRebroadcastLocals(t *testing.T) {
+	td := setup(t)
+
+	randHeight := td.RandHeight()
+	_ = td.sandbox.TestStore.AddTestBlock(randHeight)
+
+	valKey := td.RandValKey()
+	acc := account.NewAccount(0)
+	acc.AddToBalance(10000000000)
+	td.sandbox.UpdateAccount(valKey.Address(), acc)
+
+	newTransfer := func(memo string) *tx.Tx {
+		trx := tx.NewTransferTx(randHeight+1, valKey.Address(), td.RandAccAddress(), 1000, 1000, memo)
+		valKey.Sign(trx.SignBytes())
+
+		return trx
+	}
+
+	localTrx := newTransfer("local")
+	remoteTrx := newTransfer("remote")
+	require.NoError(t, td.pool.AppendTxAndBroadcast(localTrx))
+	td.shouldPublishTransaction(t, localTrx.ID())
+	require.NoError(t, td.pool.AppendTx(remoteTrx))
+
+	height := td.sandbox.CurrentHeight()
+
+	t.Run("Should not broadcast before the interval", func(t *testing.T) {
+		assert.Zero(t, td.pool.rebroadcastLocals(height+1))
+	})
+
+	t.Run("Should broadcast the local transaction again, with a backoff", func(t *testing.T) {
+		assert.Equal(t, 1, td.pool.rebroadcastLocals(height+2))
+		td.shouldPublishTransaction(t, localTrx.ID())
+
+		assert.Zero(t, td.pool.rebroadcastLocals(height+5))
+		assert.Equal(t, 1, td.pool.rebroadcastLocals(height+6))
+		td.shouldPublishTransaction(t, localTrx.ID())
+		assert.Equal(t, uint32(8), td.pool.locals[localTrx.ID()].interval)
+	})
+
+	t.Run("Should not exceed the maximum interval", func(t *testing.T) {
+		td.pool.config.MaxRebroadcastInterval = 10
+		assert.Equal(t, 1, td.pool.rebroadcastLocals(height+100))
+		td.shouldPublishTransaction(t, localTrx.ID())
+		assert.Equal(t, uint32(10), td.pool.locals[localTrx.ID()].interval)
+	})
+
+	t.Run("Should stop tracking when the transaction leaves the pool", func(t *testing.T) {
+		td.pool.RemoveTx(localTrx.ID())
+		assert.Zero(t, td.pool.rebroadcastLocals(height+1000))
+		assert.Empty(t, td.pool.locals)
+	})
+}
@@ -0,0 +1,113 @@
+package txpool
+
+import (
+	"github.com/pactus-project/pactus/sandbox"
+	"github.com/pactus-project/pactus/types/block"
+	"github.com/pactus-project/pactus/types/tx"
+	"github.com/pactus-project/pactus/util/errors"
+	"github.com/pactus-project/pactus/util/linkedlist"
+	"github.com/pactus-project/pactus/util/linkedmap"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var reconciledCounter = prometheus.NewCounterVec(prometheus.CounterOpts{
+	Namespace: "pactus",
+	Subsystem: "txpool",
+	Name:      "reconciled_total",
+	Help:      "The number of transactions that are removed or promoted by reconciling the pool with the blocks.",
+}, []string{"category"})
+
+func init() {
+	prometheus.MustRegister(reconciledCounter)
+}
+
+// Reconciliation is the result of reconciling the pool with a committed block.
+type Reconciliation struct {
+	Included    int // Removed, since they are included in the block
+	Committed   int // Removed, since they are committed in the previous blocks
+	Expired     int // Removed, since their lock time has passed
+	Conflicting int // Removed, since they are not valid anymore, like spending the same funds
+	Promoted    int // Orphans that are valid now and moved into the pool
+	Dropped     int // Orphans that can't be valid anymore
+}
+
+func (r Reconciliation) observe() {
+	reconciledCounter.WithLabelValues("included").Add(float64(r.Included))
+	reconciledCounter.WithLabelValues("committed").Add(float64(r.Committed))
+	reconciledCounter.WithLabelValues("expired").Add(float64(r.Expired))
+	reconciledCounter.WithLabelValues("conflicting").Add(float64(r.Conflicting))
+	reconciledCounter.WithLabelValues("promoted").Add(float64(r.Promoted))
+	reconciledCounter.WithLabelValues("dropped").Add(float64(r.Dropped))
+}
+
+// Reconcile brings the pool in line with the committed block.
+// The transactions of the block are removed, both from the pool and the orphans,
+// then the remaining transactions are rechecked against the new sandbox,
+// so no transaction that is committed or conflicts with the block is left in the pool.
+//
+// There is no rollback of the blocks yet, so the transactions of the reverted blocks
+// are never returned into the pool.
+func (p *txPool) Reconcile(blk *block.Block, sb sandbox.Sandbox) Reconciliation {
+	p.lk.Lock()
+	defer p.lk.Unlock()
+
+	res := Reconciliation{}
+	for _, trx := range blk.Transactions() {
+		if p.removeTx(trx.ID()) {
+			res.Included++
+		}
+	}
+
+	p.sandbox = sb
+	p.recheck(&res)
+	res.observe()
+	rebroadcast := p.rebroadcastLocals(sb.CurrentHeight())
+
+	p.logger.Debug("pool reconciled", "height", sb.CurrentHeight(), "result", res, "rebroadcast", rebroadcast)
+
+	return res
+}
+
+func (p *txPool) removeTx(id tx.ID) bool {
+	for _, pool := range p.pools {
+		if pool.Remove(id) {
+			p.journal.record(id, false)
+
+			return true
+		}
+	}
+
+	return p.orphans.Remove(id)
+}
+
+// recheck checks the transactions against the current sandbox and removes the invalid ones.
+// Then the orphans are retried.
+func (p *txPool) recheck(res *Reconciliation) {
+	var next *linkedlist.Element[linkedmap.Pair[tx.ID, *tx.Tx]]
+	for _, pool := range p.pools {
+		for e := pool.HeadNode(); e != nil; e = next {
+			next = e.Next
+			trx := e.Data.Value
+
+			err := p.checkTx(trx)
+			if err == nil {
+				continue
+			}
+
+			p.logger.Debug("invalid transaction after rechecking", "id", trx.ID(), "error", err)
+			pool.Remove(trx.ID())
+			p.journal.record(trx.ID(), false)
+
+			switch errors.Code(err) {
+			case errors.ErrTxCommitted:
+				res.Committed++
+			case errors.ErrPastLockTime:
+				res.Expired++
+			default:
+				res.Conflicting++
+			}
+		}
+	}
+
+	p.recheckOrphans(res)
+}
@@ -0,0 +1,80 @@
+package txpool
+
+import (
+	"testing"
+
+	"github.com/pactus-project/pactus/crypto/bls"
+	"github.com/pactus-project/pactus/sandbox"
+	"github.com/pactus-project/pactus/types/account"
+	"github.com/pactus-project/pactus/types/block"
+	"github.com/pactus-project/pactus/types/tx"
+	"github.com/pactus-project/pactus/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReconcile(t *testing.T) {
+	td := setup(t)
+
+	randHeight := td.RandHeight()
+	_ = td.sandbox.TestStore.AddTestBlock(randHeight)
+
+	fundedKey := td.RandValKey()
+	acc := account.NewAccount(0)
+	acc.AddToBalance(10000000000)
+	td.sandbox.UpdateAccount(fundedKey.Address(), acc)
+
+	spenderKey := td.RandValKey()
+	td.sandbox.UpdateAccount(spenderKey.Address(), acc.Clone())
+
+	orphanKey := td.RandValKey()
+
+	newTransfer := func(valKey *bls.ValidatorKey, memo string) *tx.Tx {
+		trx := tx.NewTransferTx(randHeight+1, valKey.Address(), td.RandAccAddress(), 1000, 1000, memo)
+		valKey.Sign(trx.SignBytes())
+
+		return trx
+	}
+
+	trxIncluded := newTransfer(fundedKey, "included")
+	trxCommitted := newTransfer(fundedKey, "committed")
+	trxConflicting := newTransfer(spenderKey, "spent")
+	trxPending := newTransfer(fundedKey, "pending")
+	trxOrphan := newTransfer(orphanKey, "orphan")
+
+	for _, trx := range []*tx.Tx{trxIncluded, trxCommitted, trxConflicting, trxPending, trxOrphan} {
+		require.NoError(t, td.pool.AppendTx(trx))
+	}
+	assert.Equal(t, 4, td.pool.Size())
+	assert.Equal(t, 1, td.pool.orphans.Size())
+
+	// The block includes one of the transactions,
+	// another one is committed before, and the funds of the spender are spent.
+	// The account of the orphan transaction is known now.
+	txs := block.NewTxs()
+	txs.Append(trxIncluded)
+	blk := block.MakeBlock(1, util.Now(), txs, td.RandHash(), td.RandHash(),
+		td.GenerateTestCertificate(randHeight), td.RandSeed(), td.RandValAddress())
+
+	// The pool gets a new sandbox for the new state.
+	sb := sandbox.MockingSandbox(td.TestSuite)
+	sb.TestStore = td.sandbox.TestStore
+	sb.CommitTransaction(trxCommitted)
+	sb.UpdateAccount(spenderKey.Address(), account.NewAccount(0))
+	sb.UpdateAccount(orphanKey.Address(), acc.Clone())
+
+	res := td.pool.Reconcile(blk, sb)
+	assert.Equal(t, Reconciliation{
+		Included:    1,
+		Committed:   1,
+		Conflicting: 1,
+		Promoted:    1,
+	}, res)
+
+	assert.False(t, td.pool.HasTx(trxIncluded.ID()))
+	assert.False(t, td.pool.HasTx(trxCommitted.ID()))
+	assert.False(t, td.pool.HasTx(trxConflicting.ID()))
+	assert.True(t, td.pool.HasTx(trxPending.ID()))
+	assert.True(t, td.pool.HasTx(trxOrphan.ID()))
+	assert.Zero(t, td.pool.orphans.Size())
+}
@@ -10,7 +10,8 @@ import (
 
 // The Account struct represents an account object.
 type Account struct {
-	data accountData
+	data      accountData
+	condition *Condition
 }
 
 // accountData contains the data associated with an account.
@@ -39,6 +40,16 @@ func FromBytes(data []byte) (*Account, error) {
 		return nil, err
 	}
 
+	// The spending condition is optional and it is encoded after the balance.
+	// This keeps the encoding of the accounts without a condition unchanged.
+	if r.Len() > 0 {
+		cond := new(Condition)
+		if err := cond.Decode(r); err != nil {
+			return nil, err
+		}
+		acc.condition = cond
+	}
+
 	return acc, nil
 }
 
@@ -52,6 +63,22 @@ func (acc Account) Balance() int64 {
 	return acc.data.Balance
 }
 
+// Condition returns the spending condition of the account, or nil if it has no condition.
+func (acc Account) Condition() *Condition {
+	return acc.condition
+}
+
+// SetCondition sets the spending condition of the account.
+// Setting a nil or an empty condition removes the current condition.
+func (acc *Account) SetCondition(cond *Condition) {
+	if cond == nil || cond.IsEmpty() {
+		acc.condition = nil
+
+		return
+	}
+	acc.condition = cond.Clone()
+}
+
 // SubtractFromBalance subtracts the given amount from the account's balance.
 func (acc *Account) SubtractFromBalance(amt int64) {
 	acc.data.Balance -= amt
@@ -74,7 +101,12 @@ func (acc *Account) Hash() hash.Hash {
 
 // SerializeSize returns the size in bytes required to serialize the account.
 func (acc *Account) SerializeSize() int {
-	return 12 // 4+8
+	size := 12 // 4+8
+	if acc.condition != nil {
+		size += acc.condition.SerializeSize()
+	}
+
+	return size
 }
 
 // Bytes returns the serialized byte representation of the account.
@@ -87,6 +119,12 @@ func (acc *Account) Bytes() ([]byte, error) {
 		return nil, err
 	}
 
+	if acc.condition != nil {
+		if err := acc.condition.Encode(w); err != nil {
+			return nil, err
+		}
+	}
+
 	return w.Bytes(), nil
 }
 
@@ -94,6 +132,9 @@ func (acc *Account) Bytes() ([]byte, error) {
 func (acc *Account) Clone() *Account {
 	cloned := new(Account)
 	*cloned = *acc
+	if acc.condition != nil {
+		cloned.condition = acc.condition.Clone()
+	}
 
 	return cloned
 }
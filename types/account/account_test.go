@@ -4,6 +4,7 @@ import (
 	"encoding/hex"
 	"testing"
 
+	"github.com/pactus-project/pactus/crypto"
 	"github.com/pactus-project/pactus/crypto/hash"
 	"github.com/pactus-project/pactus/types/account"
 	"github.com/pactus-project/pactus/util/testsuite"
@@ -70,3 +71,80 @@ func TestClone(t *testing.T) {
 
 	assert.NotEqual(t, acc.Balance(), cloned.Balance())
 }
+
+func TestCondition(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	acc, _ := ts.GenerateTestAccount(ts.RandInt32(10000))
+	bs1, _ := acc.Bytes()
+	assert.Nil(t, acc.Condition())
+
+	cond := &account.Condition{
+		UnlockHeight: ts.RandHeight(),
+		MaxAmount:    ts.RandInt64(1e9),
+		Threshold:    1,
+		Cosigners:    []crypto.Address{ts.RandAccAddress(), ts.RandAccAddress()},
+	}
+	require.NoError(t, cond.BasicCheck())
+	acc.SetCondition(cond)
+
+	t.Run("Encoding", func(t *testing.T) {
+		bs, err := acc.Bytes()
+		require.NoError(t, err)
+		assert.Equal(t, acc.SerializeSize(), len(bs))
+		assert.Equal(t, bs1, bs[:len(bs1)])
+
+		acc2, err := account.FromBytes(bs)
+		require.NoError(t, err)
+		assert.Equal(t, acc, acc2)
+		assert.Equal(t, cond, acc2.Condition())
+
+		_, err = account.FromBytes(bs[:len(bs)-1])
+		assert.Error(t, err)
+	})
+
+	t.Run("Clone", func(t *testing.T) {
+		cloned := acc.Clone()
+		cloned.Condition().Cosigners[0] = ts.RandAccAddress()
+
+		assert.Equal(t, cond, acc.Condition())
+	})
+
+	t.Run("Empty condition removes the condition", func(t *testing.T) {
+		cloned := acc.Clone()
+		cloned.SetCondition(&account.Condition{})
+
+		assert.Nil(t, cloned.Condition())
+		bs, _ := cloned.Bytes()
+		assert.Equal(t, bs1, bs)
+	})
+}
+
+func TestConditionBasicCheck(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	addr := ts.RandAccAddress()
+	tests := []struct {
+		cond *account.Condition
+		err  string
+	}{
+		{&account.Condition{MaxAmount: -1}, "maximum amount can't be negative: -1"},
+		{&account.Condition{Threshold: 1}, "threshold 1 is more than the number of cosigners"},
+		{
+			&account.Condition{Cosigners: []crypto.Address{ts.RandValAddress()}},
+			"cosigner is not an account address",
+		},
+		{
+			&account.Condition{Cosigners: []crypto.Address{addr, addr}},
+			"duplicated cosigner: " + addr.String(),
+		},
+		{
+			&account.Condition{Cosigners: make([]crypto.Address, account.MaxCosigners+1)},
+			"too many cosigners: 17",
+		},
+	}
+
+	for _, test := range tests {
+		assert.ErrorContains(t, test.cond.BasicCheck(), test.err)
+	}
+}
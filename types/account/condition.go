@@ -0,0 +1,123 @@
+package account
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/pactus-project/pactus/crypto"
+	"github.com/pactus-project/pactus/util/encoding"
+	"golang.org/x/exp/slices"
+)
+
+// MaxCosigners is the maximum number of cosigners that a condition can have.
+const MaxCosigners = 16
+
+// Condition defines the spending conditions of an account.
+// The conditions are evaluated for every transaction that the account signs,
+// and a zero field means that the condition is not set.
+type Condition struct {
+	UnlockHeight uint32           // the account can't sign any transaction before this height
+	MaxAmount    int64            // the maximum value of each transaction
+	Threshold    uint8            // the number of cosigners that should approve the transactions
+	Cosigners    []crypto.Address // the accounts that can approve the transactions
+}
+
+// IsEmpty returns true if no condition is set.
+func (c *Condition) IsEmpty() bool {
+	return c.UnlockHeight == 0 &&
+		c.MaxAmount == 0 &&
+		c.Threshold == 0 &&
+		len(c.Cosigners) == 0
+}
+
+// BasicCheck performs basic checks on the condition.
+func (c *Condition) BasicCheck() error {
+	if c.MaxAmount < 0 {
+		return fmt.Errorf("maximum amount can't be negative: %v", c.MaxAmount)
+	}
+	if len(c.Cosigners) > MaxCosigners {
+		return fmt.Errorf("too many cosigners: %v", len(c.Cosigners))
+	}
+	if int(c.Threshold) > len(c.Cosigners) {
+		return fmt.Errorf("threshold %v is more than the number of cosigners", c.Threshold)
+	}
+	for i, addr := range c.Cosigners {
+		if !addr.IsAccountAddress() {
+			return fmt.Errorf("cosigner is not an account address: %v", addr)
+		}
+		for _, other := range c.Cosigners[:i] {
+			if addr == other {
+				return fmt.Errorf("duplicated cosigner: %v", addr)
+			}
+		}
+	}
+
+	return nil
+}
+
+// IsCosigner returns true if the address is one of the cosigners.
+func (c *Condition) IsCosigner(addr crypto.Address) bool {
+	return slices.Contains(c.Cosigners, addr)
+}
+
+// SerializeSize returns the size in bytes required to serialize the condition.
+func (c *Condition) SerializeSize() int {
+	size := 14 // 4+8+1+1
+	for _, addr := range c.Cosigners {
+		size += addr.SerializeSize()
+	}
+
+	return size
+}
+
+// Encode writes the condition to the writer.
+func (c *Condition) Encode(w io.Writer) error {
+	err := encoding.WriteElements(w,
+		c.UnlockHeight,
+		c.MaxAmount,
+		c.Threshold,
+		uint8(len(c.Cosigners)))
+	if err != nil {
+		return err
+	}
+
+	for _, addr := range c.Cosigners {
+		if err := addr.Encode(w); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Decode reads the condition from the reader.
+func (c *Condition) Decode(r io.Reader) error {
+	var count uint8
+	err := encoding.ReadElements(r,
+		&c.UnlockHeight,
+		&c.MaxAmount,
+		&c.Threshold,
+		&count)
+	if err != nil {
+		return err
+	}
+
+	c.Cosigners = make([]crypto.Address, count)
+	for i := range c.Cosigners {
+		if err := c.Cosigners[i].Decode(r); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Clone creates a deep copy of the condition.
+func (c *Condition) Clone() *Condition {
+	cloned := new(Condition)
+	*cloned = *c
+	cloned.Cosigners = make([]crypto.Address, len(c.Cosigners))
+	copy(cloned.Cosigners, c.Cosigners)
+
+	return cloned
+}
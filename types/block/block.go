@@ -101,34 +101,54 @@ func (b *Block) BasicCheck() error {
 		}
 	}
 
+	// Duplicated transactions are rejected here, since the Merkle root of the transactions
+	// doesn't change by duplicating the last ones (CVE-2012-2459).
+	ids := make(map[tx.ID]struct{}, b.Transactions().Len())
 	for _, trx := range b.Transactions() {
 		if err := trx.BasicCheck(); err != nil {
 			return BasicCheckError{
 				Reason: fmt.Sprintf("invalid transaction: %s", err.Error()),
 			}
 		}
+
+		if _, ok := ids[trx.ID()]; ok {
+			return BasicCheckError{
+				Reason: fmt.Sprintf("duplicated transaction: %s", trx.ID()),
+			}
+		}
+		ids[trx.ID()] = struct{}{}
 	}
 
 	return nil
 }
 
+// TxsRoot returns the Merkle root of the transactions, as it is committed in the block hash.
+func (b *Block) TxsRoot() hash.Hash {
+	return b.data.Txs.RootWithHasher(b.data.Header.Hasher())
+}
+
+// Hash returns the hash of the block.
+// The number of the transactions is committed in the hash after the Merkle root,
+// so a mutated list of the transactions with a duplicated last transaction, that has
+// the same Merkle root (CVE-2012-2459), doesn't have the same block hash.
 func (b *Block) Hash() hash.Hash {
 	if b.memorizedHash != nil {
 		return *b.memorizedHash
 	}
 
+	hasher := b.data.Header.Hasher()
 	w := &bytes.Buffer{}
 	if err := b.data.Header.Encode(w); err != nil {
 		return hash.UndefHash
 	}
 	// Genesis block has no certificate
 	if b.data.PrevCert != nil {
-		w.Write(b.data.PrevCert.Hash().Bytes())
+		w.Write(b.data.PrevCert.HashWithHasher(hasher).Bytes())
 	}
-	w.Write(b.data.Txs.Root().Bytes())
+	w.Write(b.TxsRoot().Bytes())
 	w.Write(util.Int32ToSlice(int32(b.data.Txs.Len())))
 
-	h := hash.CalcHash(w.Bytes())
+	h := hasher.CalcHash(w.Bytes())
 	b.memorizedHash = &h
 
 	return h
@@ -163,6 +183,8 @@ func (b *Block) UnmarshalCBOR(bs []byte) error {
 	return b.Decode(buf)
 }
 
+// Encode writes the block to the writer.
+// The previous certificate is not encoded for the first block, that has no previous block hash.
 func (b *Block) Encode(w io.Writer) error {
 	if err := b.data.Header.Encode(w); err != nil {
 		return err
@@ -2,7 +2,9 @@ package block_test
 
 import (
 	"encoding/hex"
+	"fmt"
 	"testing"
+	"time"
 
 	"github.com/fxamacker/cbor/v2"
 	"github.com/pactus-project/pactus/crypto/hash"
@@ -11,6 +13,7 @@ import (
 	"github.com/pactus-project/pactus/util/simplemerkle"
 	"github.com/pactus-project/pactus/util/testsuite"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestBasicCheck(t *testing.T) {
@@ -119,6 +122,31 @@ func TestBasicCheck(t *testing.T) {
 		})
 	})
 
+	t.Run("Duplicated transactions", func(t *testing.T) {
+		b0, _ := ts.GenerateTestBlock(ts.RandHeight())
+		trx1, _ := ts.GenerateTestTransferTx()
+		trx2, _ := ts.GenerateTestTransferTx()
+		trx3, _ := ts.GenerateTestTransferTx()
+		txs := block.Txs{trx1, trx2, trx3}
+		mutatedTxs := block.Txs{trx1, trx2, trx3, trx3}
+
+		// The mutated list has the same Merkle root (CVE-2012-2459).
+		assert.Equal(t, txs.Root(), mutatedTxs.Root())
+
+		b := block.NewBlock(b0.Header(), b0.PrevCertificate(), txs)
+		assert.NoError(t, b.BasicCheck())
+
+		mutated := block.NewBlock(b0.Header(), b0.PrevCertificate(), mutatedTxs)
+		// The block hash commits to the number of the transactions, so it changes.
+		assert.Equal(t, b.TxsRoot(), mutated.TxsRoot())
+		assert.NotEqual(t, b.Hash(), mutated.Hash())
+
+		err := mutated.BasicCheck()
+		assert.ErrorIs(t, err, block.BasicCheckError{
+			Reason: fmt.Sprintf("duplicated transaction: %s", trx3.ID()),
+		})
+	})
+
 	t.Run("Invalid previous block hash", func(t *testing.T) {
 		d := ts.DecodingHex(
 			"01" + // Version
@@ -341,3 +369,71 @@ func TestMakeBlock(t *testing.T) {
 
 	assert.Equal(t, blk0.Hash(), blk1.Hash())
 }
+
+func TestHeaderHasher(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	blk, _ := ts.GenerateTestBlock(ts.RandHeight())
+	assert.Equal(t, uint8(1), blk.Header().Version())
+	assert.Equal(t, hash.VersionBlake2b, blk.Header().Hasher().Version())
+
+	unknownVersion := block.MakeBlock(99, blk.Header().Time(), blk.Transactions(),
+		blk.Header().PrevBlockHash(), blk.Header().StateRoot(), blk.PrevCertificate(),
+		blk.Header().SortitionSeed(), blk.Header().ProposerAddress())
+	assert.Equal(t, hash.DefaultHasher(), unknownVersion.Header().Hasher())
+}
+
+func TestMillisecondTime(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	tme := time.Unix(1700000000, 123456789)
+	makeBlock := func(version uint8) *block.Block {
+		trx, _ := ts.GenerateTestTransferTx()
+		txs := block.NewTxs()
+		txs.Append(trx)
+
+		return block.MakeBlock(version, tme, txs, hash.UndefHash, ts.RandHash(),
+			nil, ts.RandSeed(), ts.RandValAddress())
+	}
+
+	t.Run("Seconds before the millisecond time version", func(t *testing.T) {
+		blk := makeBlock(block.MillisecondTimeVersion - 1)
+		bs, err := blk.Bytes()
+		assert.NoError(t, err)
+
+		decoded, err := block.FromBytes(bs)
+		require.NoError(t, err)
+		assert.Equal(t, time.Unix(1700000000, 0), decoded.Header().Time())
+		assert.Equal(t, int64(1700000000000), decoded.Header().UnixMilli())
+		assert.Equal(t, 138, decoded.Header().SerializeSize())
+		assert.Equal(t, blk.Hash(), decoded.Hash())
+	})
+
+	t.Run("Milliseconds from the millisecond time version", func(t *testing.T) {
+		blk := makeBlock(block.MillisecondTimeVersion)
+		bs, err := blk.Bytes()
+		assert.NoError(t, err)
+		assert.Equal(t, blk.SerializeSize(), len(bs))
+
+		decoded, err := block.FromBytes(bs)
+		require.NoError(t, err)
+		assert.Equal(t, time.Unix(1700000000, 123000000), decoded.Header().Time())
+		assert.Equal(t, int64(1700000000123), decoded.Header().UnixMilli())
+		assert.Equal(t, uint32(1700000000), decoded.Header().UnixTime())
+		assert.Equal(t, 140, decoded.Header().SerializeSize())
+		assert.Equal(t, blk.Hash(), decoded.Hash())
+	})
+
+	t.Run("Invalid milliseconds", func(t *testing.T) {
+		blk := makeBlock(block.MillisecondTimeVersion)
+		bs, _ := blk.Bytes()
+		// The milliseconds are encoded after the version and the Unix time.
+		bs[5] = 0xe8
+		bs[6] = 0x03
+
+		decoded, err := block.FromBytes(bs)
+		require.NoError(t, err)
+		err = decoded.BasicCheck()
+		assert.ErrorIs(t, err, block.BasicCheckError{Reason: "invalid milliseconds: 1000"})
+	})
+}
@@ -18,6 +18,7 @@ type Header struct {
 type headerData struct {
 	Version         uint8
 	UnixTime        uint32
+	UnixMilli       uint16 // The milliseconds of the time, since MillisecondTimeVersion
 	PrevBlockHash   hash.Hash
 	StateRoot       hash.Hash
 	SortitionSeed   sortition.VerifiableSeed
@@ -29,16 +30,52 @@ func (h *Header) Version() uint8 {
 	return h.data.Version
 }
 
+// hashVersions maps the block versions to the hash versions.
+// A new hash function is activated by mapping a new block version to its hash version.
+var hashVersions = map[uint8]hash.Version{
+	1: hash.VersionBlake2b,
+	2: hash.VersionBlake2b,
+}
+
+// MillisecondTimeVersion is the first block version that keeps the milliseconds of the block time.
+// The milliseconds are encoded after the Unix time, so the headers of the older versions don't change.
+// The block times of this version are not rounded to the block interval.
+// It is activated when the `BlockVersion` parameter is upgraded to this version.
+const MillisecondTimeVersion = 2
+
+// Hasher returns the hasher of the block version.
+// The unknown block versions are hashed by the default hasher,
+// since they are rejected by the state anyway.
+func (h *Header) Hasher() hash.Hasher {
+	ver, ok := hashVersions[h.data.Version]
+	if !ok {
+		return hash.DefaultHasher()
+	}
+
+	hasher, err := hash.NewHasher(ver)
+	if err != nil {
+		return hash.DefaultHasher()
+	}
+
+	return hasher
+}
+
 // Time returns the block time.
 func (h *Header) Time() time.Time {
-	return time.Unix(int64(h.data.UnixTime), 0)
+	return time.Unix(int64(h.data.UnixTime), int64(h.data.UnixMilli)*int64(time.Millisecond))
 }
 
-// UnixTime returns the block time in Unix value.
+// UnixTime returns the block time in Unix value, in seconds.
 func (h *Header) UnixTime() uint32 {
 	return h.data.UnixTime
 }
 
+// UnixMilli returns the block time in Unix value, in milliseconds.
+// The milliseconds are zero for the blocks before MillisecondTimeVersion.
+func (h *Header) UnixMilli() int64 {
+	return int64(h.data.UnixTime)*1000 + int64(h.data.UnixMilli)
+}
+
 // StateRoot returns the state root hash.
 func (h *Header) StateRoot() hash.Hash {
 	return h.data.StateRoot
@@ -62,7 +99,7 @@ func (h *Header) ProposerAddress() crypto.Address {
 func NewHeader(version uint8, tme time.Time, stateRoot, prevBlockHash hash.Hash,
 	sortitionSeed sortition.VerifiableSeed, proposerAddress crypto.Address,
 ) *Header {
-	return &Header{
+	header := &Header{
 		data: headerData{
 			Version:         version,
 			UnixTime:        uint32(tme.Unix()),
@@ -72,6 +109,11 @@ func NewHeader(version uint8, tme time.Time, stateRoot, prevBlockHash hash.Hash,
 			SortitionSeed:   sortitionSeed,
 		},
 	}
+	if version >= MillisecondTimeVersion {
+		header.data.UnixMilli = uint16(tme.Nanosecond() / int(time.Millisecond))
+	}
+
+	return header
 }
 
 func (h *Header) BasicCheck() error {
@@ -82,18 +124,39 @@ func (h *Header) BasicCheck() error {
 		}
 	}
 
+	if h.data.UnixMilli >= 1000 {
+		return BasicCheckError{
+			Reason: fmt.Sprintf("invalid milliseconds: %d", h.data.UnixMilli),
+		}
+	}
+
 	return nil
 }
 
 // SerializeSize returns the number of bytes it would take to serialize the header.
 func (h *Header) SerializeSize() int {
+	// The header of an empty block, before decoding, is nil.
+	if h != nil && h.data.Version >= MillisecondTimeVersion {
+		return 140 // 7 + (2 * 32) + 48 + 21
+	}
+
 	return 138 // 5 + (2 * 32) + 48 + 21
 }
 
 func (h *Header) Encode(w io.Writer) error {
-	return encoding.WriteElements(w,
+	err := encoding.WriteElements(w,
 		h.data.Version,
-		h.data.UnixTime,
+		h.data.UnixTime)
+	if err != nil {
+		return err
+	}
+	if h.data.Version >= MillisecondTimeVersion {
+		if err := encoding.WriteElement(w, h.data.UnixMilli); err != nil {
+			return err
+		}
+	}
+
+	return encoding.WriteElements(w,
 		h.data.PrevBlockHash,
 		h.data.StateRoot,
 		h.data.SortitionSeed,
@@ -101,9 +164,19 @@ func (h *Header) Encode(w io.Writer) error {
 }
 
 func (h *Header) Decode(r io.Reader) error {
-	return encoding.ReadElements(r,
+	err := encoding.ReadElements(r,
 		&h.data.Version,
-		&h.data.UnixTime,
+		&h.data.UnixTime)
+	if err != nil {
+		return err
+	}
+	if h.data.Version >= MillisecondTimeVersion {
+		if err := encoding.ReadElement(r, &h.data.UnixMilli); err != nil {
+			return err
+		}
+	}
+
+	return encoding.ReadElements(r,
 		&h.data.PrevBlockHash,
 		&h.data.StateRoot,
 		&h.data.SortitionSeed,
@@ -30,11 +30,16 @@ func (txs *Txs) Remove(i int) {
 }
 
 func (txs Txs) Root() hash.Hash {
+	return txs.RootWithHasher(hash.DefaultHasher())
+}
+
+// RootWithHasher calculates the Merkle root of the transactions by the hash function of the hasher.
+func (txs Txs) RootWithHasher(hasher hash.Hasher) hash.Hash {
 	hashes := make([]hash.Hash, txs.Len())
 	for i, trx := range txs {
 		hashes[i] = trx.ID()
 	}
-	merkle := simplemerkle.NewTreeFromHashes(hashes)
+	merkle := simplemerkle.NewTreeFromHashesWithHasher(hasher, hashes)
 
 	return merkle.Root()
 }
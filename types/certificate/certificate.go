@@ -6,6 +6,7 @@ import (
 	"io"
 
 	"github.com/fxamacker/cbor/v2"
+	"github.com/pactus-project/pactus/crypto"
 	"github.com/pactus-project/pactus/crypto/bls"
 	"github.com/pactus-project/pactus/crypto/hash"
 	"github.com/pactus-project/pactus/types/validator"
@@ -13,6 +14,8 @@ import (
 	"github.com/pactus-project/pactus/util/encoding"
 )
 
+const beaconPrefix = "pactus-beacon"
+
 type Certificate struct {
 	data certificateData
 }
@@ -58,6 +61,18 @@ func (cert *Certificate) Signature() *bls.Signature {
 	return cert.data.Signature
 }
 
+// Beacon returns the randomness beacon that is derived from the aggregated signature of the certificate.
+// BLS signatures are unique, therefore the beacon is determined once the set of the committers is fixed.
+// The only party that can influence it is the proposer of the next block, who chooses which votes are
+// aggregated into the certificate. It can either drop some votes, as long as the certificate keeps
+// more than two-thirds of the power, or withhold its block, and both are visible on chain.
+func (cert *Certificate) Beacon() hash.Hash {
+	w := bytes.NewBufferString(beaconPrefix)
+	w.Write(cert.Signature().Bytes())
+
+	return hash.CalcHash(w.Bytes())
+}
+
 func (cert *Certificate) BasicCheck() error {
 	if cert.Height() <= 0 {
 		return BasicCheckError{
@@ -95,12 +110,18 @@ func (cert *Certificate) BasicCheck() error {
 }
 
 func (cert *Certificate) Hash() hash.Hash {
+	return cert.HashWithHasher(hash.DefaultHasher())
+}
+
+// HashWithHasher calculates the hash of the certificate by the hash function of the hasher.
+// The certificates of the blocks are hashed by the hash version of the block.
+func (cert *Certificate) HashWithHasher(hasher hash.Hasher) hash.Hash {
 	w := bytes.NewBuffer(make([]byte, 0, cert.SerializeSize()))
 	if err := cert.Encode(w); err != nil {
 		return hash.UndefHash
 	}
 
-	return hash.CalcHash(w.Bytes())
+	return hasher.CalcHash(w.Bytes())
 }
 
 func (cert *Certificate) Clone() *Certificate {
@@ -290,10 +311,12 @@ func (cert *Certificate) AddSignature(valNum int32, sig *bls.Signature) {
 	}
 }
 
+// BlockCertificateSignBytes returns the bytes that the committee members sign for the block.
+// The chain ID is included when the sign domain is active at the given height.
 func BlockCertificateSignBytes(blockHash hash.Hash, height uint32, round int16) []byte {
 	sb := blockHash.Bytes()
 	sb = append(sb, util.Uint32ToSlice(height)...)
 	sb = append(sb, util.Int16ToSlice(round)...)
 
-	return sb
+	return crypto.CurrentSignDomain().Apply(height, sb)
 }
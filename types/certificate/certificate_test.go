@@ -319,3 +319,17 @@ func TestClone(t *testing.T) {
 	cert2.AddSignature(cert2.Absentees()[0], ts.RandBLSSignature())
 	assert.NotEqual(t, cert1, cert2)
 }
+
+func TestBeacon(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	cert1 := ts.GenerateTestCertificate(ts.RandHeight())
+	cert2 := certificate.NewCertificate(cert1.Height(), cert1.Round(),
+		cert1.Committers(), cert1.Absentees(), cert1.Signature())
+	cert3 := ts.GenerateTestCertificate(cert1.Height())
+
+	assert.False(t, cert1.Beacon().IsUndef())
+	assert.Equal(t, cert1.Beacon(), cert2.Beacon())
+	assert.NotEqual(t, cert1.Beacon(), cert3.Beacon())
+	assert.NotEqual(t, cert1.Beacon(), cert1.Hash())
+}
@@ -0,0 +1,22 @@
+package verifier
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/pactus-project/pactus/crypto/hash"
+)
+
+// ErrNoCertificate is returned when the next block has no certificate for the previous block.
+var ErrNoCertificate = errors.New("no certificate for the previous block")
+
+// BlockMismatchError is returned when the next block doesn't refer to the block.
+type BlockMismatchError struct {
+	Expected hash.Hash
+	Got      hash.Hash
+}
+
+func (e BlockMismatchError) Error() string {
+	return fmt.Sprintf("next block refers to another block (expected %s got %s)",
+		e.Expected, e.Got)
+}
@@ -0,0 +1,86 @@
+// Package verifier verifies the finality of blocks without running a node.
+// It validates the block certificates against a snapshot of the committee,
+// and depends only on the types and the crypto packages,
+// therefore it can be used by the third-party applications like exchanges
+// to check the finality of the deposits without trusting the RPC nodes.
+package verifier
+
+import (
+	"github.com/pactus-project/pactus/crypto/hash"
+	"github.com/pactus-project/pactus/types/block"
+	"github.com/pactus-project/pactus/types/certificate"
+	"github.com/pactus-project/pactus/types/validator"
+)
+
+// Committee is a snapshot of the committee validators at a specific height.
+// The caller is responsible for keeping the snapshot up to date,
+// since the committee might change after each block.
+type Committee struct {
+	validators map[int32]*validator.Validator
+}
+
+// NewCommittee creates a committee snapshot from the given validators.
+func NewCommittee(validators []*validator.Validator) *Committee {
+	vals := make(map[int32]*validator.Validator, len(validators))
+	for _, val := range validators {
+		vals[val.Number()] = val
+	}
+
+	return &Committee{
+		validators: vals,
+	}
+}
+
+// VerifyCertificate verifies the certificate of the block with the given hash.
+// It ensures that the committers of the certificate are the same as the committee members
+// and that the signers hold more than 2/3 of the committee power.
+func (c *Committee) VerifyCertificate(blockHash hash.Hash, cert *certificate.Certificate) error {
+	if err := cert.BasicCheck(); err != nil {
+		return err
+	}
+
+	if len(cert.Committers()) != len(c.validators) {
+		return certificate.UnexpectedCommittersError{
+			Committers: cert.Committers(),
+		}
+	}
+
+	// The validators should be ordered the same as the committers in the certificate.
+	vals := make([]*validator.Validator, 0, len(cert.Committers()))
+	for _, num := range cert.Committers() {
+		val, ok := c.validators[num]
+		if !ok {
+			return certificate.UnexpectedCommittersError{
+				Committers: cert.Committers(),
+			}
+		}
+		vals = append(vals, val)
+	}
+
+	signBytes := certificate.BlockCertificateSignBytes(blockHash, cert.Height(), cert.Round())
+
+	return cert.Validate(cert.Height(), vals, signBytes)
+}
+
+// VerifyBlock verifies the finality of the block using the certificate
+// that is included in the next block.
+// The committee should be the committee at the time the block is committed.
+func (c *Committee) VerifyBlock(blk, nextBlk *block.Block) error {
+	if err := blk.BasicCheck(); err != nil {
+		return err
+	}
+
+	if nextBlk.Header().PrevBlockHash() != blk.Hash() {
+		return BlockMismatchError{
+			Expected: blk.Hash(),
+			Got:      nextBlk.Header().PrevBlockHash(),
+		}
+	}
+
+	cert := nextBlk.PrevCertificate()
+	if cert == nil {
+		return ErrNoCertificate
+	}
+
+	return c.VerifyCertificate(blk.Hash(), cert)
+}
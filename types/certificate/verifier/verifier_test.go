@@ -0,0 +1,148 @@
+package verifier_test
+
+import (
+	"testing"
+
+	"github.com/pactus-project/pactus/crypto"
+	"github.com/pactus-project/pactus/crypto/bls"
+	"github.com/pactus-project/pactus/crypto/hash"
+	"github.com/pactus-project/pactus/types/block"
+	"github.com/pactus-project/pactus/types/certificate"
+	"github.com/pactus-project/pactus/types/certificate/verifier"
+	"github.com/pactus-project/pactus/types/validator"
+	"github.com/pactus-project/pactus/util"
+	"github.com/pactus-project/pactus/util/testsuite"
+	"github.com/stretchr/testify/assert"
+)
+
+type testData struct {
+	*testsuite.TestSuite
+
+	valKeys    []*bls.ValidatorKey
+	validators []*validator.Validator
+	committee  *verifier.Committee
+}
+
+func setup(t *testing.T) *testData {
+	t.Helper()
+
+	ts := testsuite.NewTestSuite(t)
+
+	valKeys := make([]*bls.ValidatorKey, 0, 4)
+	validators := make([]*validator.Validator, 0, 4)
+	for i := int32(0); i < 4; i++ {
+		valKey := ts.RandValKey()
+		valKeys = append(valKeys, valKey)
+		validators = append(validators, validator.NewValidator(valKey.PublicKey(), i))
+	}
+
+	return &testData{
+		TestSuite:  ts,
+		valKeys:    valKeys,
+		validators: validators,
+		committee:  verifier.NewCommittee(validators),
+	}
+}
+
+// makeCertificate creates a certificate for the block, signed by the given signers.
+func (td *testData) makeCertificate(blockHash hash.Hash, height uint32, signers ...int) *certificate.Certificate {
+	round := td.RandRound()
+	signBytes := certificate.BlockCertificateSignBytes(blockHash, height, round)
+
+	committers := []int32{0, 1, 2, 3}
+	absentees := []int32{0, 1, 2, 3}
+	sigs := make([]*bls.Signature, 0, len(signers))
+	for _, signer := range signers {
+		sigs = append(sigs, td.valKeys[signer].Sign(signBytes))
+		absentees, _ = util.RemoveFirstOccurrenceOf(absentees, int32(signer))
+	}
+
+	return certificate.NewCertificate(height, round, committers, absentees,
+		bls.SignatureAggregate(sigs...))
+}
+
+func (td *testData) makeNextBlock(prevBlockHash hash.Hash, prevCert *certificate.Certificate) *block.Block {
+	trx, _ := td.GenerateTestTransferTx()
+	txs := block.NewTxs()
+	txs.Append(trx)
+
+	return block.MakeBlock(1, util.Now(), txs, prevBlockHash, td.RandHash(),
+		prevCert, td.RandSeed(), td.RandValAddress())
+}
+
+func TestVerifyCertificate(t *testing.T) {
+	td := setup(t)
+
+	blockHash := td.RandHash()
+	height := td.RandHeight()
+
+	t.Run("Valid certificate", func(t *testing.T) {
+		cert := td.makeCertificate(blockHash, height, 0, 1, 3)
+
+		assert.NoError(t, td.committee.VerifyCertificate(blockHash, cert))
+	})
+
+	t.Run("Certificate for another block", func(t *testing.T) {
+		cert := td.makeCertificate(blockHash, height, 0, 1, 3)
+
+		assert.ErrorIs(t, td.committee.VerifyCertificate(td.RandHash(), cert), crypto.ErrInvalidSignature)
+	})
+
+	t.Run("Insufficient power", func(t *testing.T) {
+		cert := td.makeCertificate(blockHash, height, 0, 1)
+
+		assert.ErrorIs(t, td.committee.VerifyCertificate(blockHash, cert), certificate.InsufficientPowerError{
+			SignedPower:   2,
+			RequiredPower: 3,
+		})
+	})
+
+	t.Run("Committers are a subset of the committee", func(t *testing.T) {
+		round := td.RandRound()
+		signBytes := certificate.BlockCertificateSignBytes(blockHash, height, round)
+		sig := bls.SignatureAggregate(td.valKeys[0].Sign(signBytes), td.valKeys[1].Sign(signBytes))
+		cert := certificate.NewCertificate(height, round, []int32{0, 1}, []int32{}, sig)
+
+		assert.ErrorIs(t, td.committee.VerifyCertificate(blockHash, cert), certificate.UnexpectedCommittersError{
+			Committers: []int32{0, 1},
+		})
+	})
+
+	t.Run("Unknown committer", func(t *testing.T) {
+		cert := certificate.NewCertificate(height, 0, []int32{0, 1, 2, 4}, []int32{}, td.RandBLSSignature())
+
+		assert.ErrorIs(t, td.committee.VerifyCertificate(blockHash, cert), certificate.UnexpectedCommittersError{
+			Committers: []int32{0, 1, 2, 4},
+		})
+	})
+}
+
+func TestVerifyBlock(t *testing.T) {
+	td := setup(t)
+
+	blk, _ := td.GenerateTestBlock(td.RandHeight())
+	height := td.RandHeight()
+	cert := td.makeCertificate(blk.Hash(), height, 0, 1, 2, 3)
+
+	t.Run("Valid block", func(t *testing.T) {
+		nextBlk := td.makeNextBlock(blk.Hash(), cert)
+
+		assert.NoError(t, td.committee.VerifyBlock(blk, nextBlk))
+	})
+
+	t.Run("Next block refers to another block", func(t *testing.T) {
+		prevBlockHash := td.RandHash()
+		nextBlk := td.makeNextBlock(prevBlockHash, cert)
+
+		assert.ErrorIs(t, td.committee.VerifyBlock(blk, nextBlk), verifier.BlockMismatchError{
+			Expected: blk.Hash(),
+			Got:      prevBlockHash,
+		})
+	})
+
+	t.Run("Next block has no certificate", func(t *testing.T) {
+		nextBlk := td.makeNextBlock(blk.Hash(), nil)
+
+		assert.ErrorIs(t, td.committee.VerifyBlock(blk, nextBlk), verifier.ErrNoCertificate)
+	})
+}
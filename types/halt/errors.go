@@ -0,0 +1,22 @@
+package halt
+
+import "fmt"
+
+// BasicCheckError is returned when the basic check on the halt artifact fails.
+type BasicCheckError struct {
+	Reason string
+}
+
+func (e BasicCheckError) Error() string {
+	return e.Reason
+}
+
+// NotCommitterError is returned when the halt artifact is signed by
+// a validator that is not in the committee.
+type NotCommitterError struct {
+	Address string
+}
+
+func (e NotCommitterError) Error() string {
+	return fmt.Sprintf("validator %s is not a committer", e.Address)
+}
@@ -0,0 +1,174 @@
+package halt_test
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/pactus-project/pactus/crypto/bls"
+	"github.com/pactus-project/pactus/types/certificate"
+	"github.com/pactus-project/pactus/types/halt"
+	"github.com/pactus-project/pactus/types/validator"
+	"github.com/pactus-project/pactus/util/testsuite"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func generateCommittee(ts *testsuite.TestSuite, num int) ([]*validator.Validator, []*bls.ValidatorKey, []int32) {
+	cmt, valKeys := ts.GenerateTestCommittee(num)
+
+	return cmt.Validators(), valKeys, cmt.Committers()
+}
+
+func TestOrderSignAndVerify(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	vals, valKeys, committers := generateCommittee(ts, 4)
+	ord := halt.NewOrder(120, "critical bug", 100, committers)
+	assert.NoError(t, ord.BasicCheck())
+
+	ord.Sign(valKeys[0])
+	ord.Sign(valKeys[1])
+	err := ord.Verify(vals)
+	assert.ErrorIs(t, err, certificate.InsufficientPowerError{SignedPower: 20e9, RequiredPower: 26666666667})
+
+	ord.Sign(valKeys[2])
+	assert.NoError(t, ord.Verify(vals))
+
+	t.Run("Tampered order", func(t *testing.T) {
+		bs, err := ord.MarshalJSON()
+		require.NoError(t, err)
+
+		tampered := strings.Replace(string(bs), `"height": 120`, `"height": 121`, 1)
+		require.NotEqual(t, string(bs), tampered)
+
+		ord2 := new(halt.Order)
+		require.NoError(t, ord2.UnmarshalJSON([]byte(tampered)))
+		assert.Equal(t, uint32(121), ord2.Height())
+		assert.Error(t, ord2.Verify(vals))
+	})
+
+	t.Run("Not a committer", func(t *testing.T) {
+		bs, err := ord.MarshalJSON()
+		require.NoError(t, err)
+
+		ord2 := new(halt.Order)
+		require.NoError(t, ord2.UnmarshalJSON(bs))
+		valKey := ts.RandValKey()
+		ord2.Sign(valKey)
+		err = ord2.Verify(vals)
+		assert.ErrorIs(t, err, halt.NotCommitterError{Address: valKey.Address().String()})
+	})
+
+	t.Run("Unexpected committee", func(t *testing.T) {
+		otherVals, _, _ := generateCommittee(ts, 5)
+		assert.Error(t, ord.Verify(otherVals))
+	})
+}
+
+func TestOrderNoSignature(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	vals, _, committers := generateCommittee(ts, 4)
+	ord := halt.NewOrder(120, "critical bug", 100, committers)
+	assert.Error(t, ord.Verify(vals))
+}
+
+func TestOrderBasicCheck(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	_, _, committers := generateCommittee(ts, 4)
+
+	t.Run("Halt height is less than committee height", func(t *testing.T) {
+		ord := halt.NewOrder(99, "critical bug", 100, committers)
+		assert.ErrorIs(t, ord.BasicCheck(), halt.BasicCheckError{
+			Reason: "halt height is less than the committee height",
+		})
+	})
+
+	t.Run("No reason", func(t *testing.T) {
+		ord := halt.NewOrder(100, "", 100, committers)
+		assert.ErrorIs(t, ord.BasicCheck(), halt.BasicCheckError{
+			Reason: "reason is missing",
+		})
+	})
+
+	t.Run("No committee", func(t *testing.T) {
+		ord := halt.NewOrder(100, "critical bug", 100, nil)
+		assert.ErrorIs(t, ord.BasicCheck(), halt.BasicCheckError{
+			Reason: "committers is missing",
+		})
+	})
+
+	t.Run("Zero committee height", func(t *testing.T) {
+		ord := halt.NewOrder(100, "critical bug", 0, committers)
+		assert.ErrorIs(t, ord.BasicCheck(), halt.BasicCheckError{
+			Reason: "committee height is not positive",
+		})
+	})
+}
+
+func TestOrderFile(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	vals, valKeys, committers := generateCommittee(ts, 4)
+	ord := halt.NewOrder(120, "critical bug", 100, committers)
+	ord.Sign(valKeys[3])
+
+	file := filepath.Join(t.TempDir(), "halt.json")
+	require.NoError(t, ord.SaveToFile(file))
+
+	// Collecting the signatures from the other validators
+	for i := 0; i < 2; i++ {
+		ord2, err := halt.LoadOrderFromFile(file)
+		require.NoError(t, err)
+		ord2.Sign(valKeys[i])
+		require.NoError(t, ord2.SaveToFile(file))
+	}
+
+	loaded, err := halt.LoadOrderFromFile(file)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(120), loaded.Height())
+	assert.Equal(t, "critical bug", loaded.Reason())
+	assert.Equal(t, uint32(100), loaded.CommitteeHeight())
+	assert.Equal(t, committers, loaded.Committers())
+	assert.NoError(t, loaded.BasicCheck())
+	assert.NoError(t, loaded.Verify(vals))
+
+	_, err = halt.LoadOrderFromFile(filepath.Join(t.TempDir(), "not-exists.json"))
+	assert.Error(t, err)
+}
+
+func TestResume(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	vals, valKeys, committers := generateCommittee(ts, 4)
+	stateRoot := ts.RandHash()
+	rsm := halt.NewResume(120, stateRoot, 120, committers)
+	assert.NoError(t, rsm.BasicCheck())
+	assert.Equal(t, stateRoot, rsm.StateRoot())
+
+	for i := 0; i < 3; i++ {
+		rsm.Sign(valKeys[i])
+	}
+	assert.NoError(t, rsm.Verify(vals))
+
+	file := filepath.Join(t.TempDir(), "resume.json")
+	require.NoError(t, rsm.SaveToFile(file))
+
+	loaded, err := halt.LoadResumeFromFile(file)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(120), loaded.Height())
+	assert.Equal(t, stateRoot, loaded.StateRoot())
+	assert.NoError(t, loaded.Verify(vals))
+
+	t.Run("Halt and resume sign bytes are different", func(t *testing.T) {
+		ord := halt.NewOrder(120, "critical bug", 120, committers)
+		assert.NotEqual(t, ord.SignBytes(), rsm.SignBytes())
+	})
+
+	t.Run("Different state root", func(t *testing.T) {
+		rsm2 := halt.NewResume(120, ts.RandHash(), 120, committers)
+		assert.NotEqual(t, rsm.SignBytes(), rsm2.SignBytes())
+	})
+}
@@ -0,0 +1,128 @@
+// Package halt provides the artifacts for halting the chain at a specific height
+// in case of emergency, and resuming it once the incident is resolved.
+// Both artifacts should be signed by the committee members with more than 2/3
+// of the committee power.
+package halt
+
+import (
+	"encoding/json"
+	"os"
+
+	"github.com/pactus-project/pactus/crypto/bls"
+	"github.com/pactus-project/pactus/crypto/hash"
+	"github.com/pactus-project/pactus/types/validator"
+	"github.com/pactus-project/pactus/util"
+)
+
+// Order asks the nodes to stop committing blocks after the halt height.
+type Order struct {
+	data orderData
+}
+
+type orderData struct {
+	Height uint32 `json:"height"`
+	Reason string `json:"reason"`
+	committeeSignatures
+}
+
+// NewOrder creates a new halt order without any signature.
+// The committers are the numbers of the validators that committed
+// the block at the committee height.
+func NewOrder(height uint32, reason string, committeeHeight uint32, committers []int32) *Order {
+	return &Order{
+		data: orderData{
+			Height:              height,
+			Reason:              reason,
+			committeeSignatures: newCommitteeSignatures(committeeHeight, committers),
+		},
+	}
+}
+
+// Height returns the last height that the nodes commit before halting.
+func (o *Order) Height() uint32 {
+	return o.data.Height
+}
+
+// Reason returns the reason for halting the chain.
+func (o *Order) Reason() string {
+	return o.data.Reason
+}
+
+// CommitteeHeight returns the height of the block whose committee signs the order.
+func (o *Order) CommitteeHeight() uint32 {
+	return o.data.CommitteeHeight
+}
+
+// Committers returns the numbers of the committee members.
+func (o *Order) Committers() []int32 {
+	return o.data.Committers
+}
+
+// BasicCheck performs a basic check on the halt order.
+func (o *Order) BasicCheck() error {
+	if o.data.Height < o.data.CommitteeHeight {
+		return BasicCheckError{
+			Reason: "halt height is less than the committee height",
+		}
+	}
+	if o.data.Reason == "" {
+		return BasicCheckError{
+			Reason: "reason is missing",
+		}
+	}
+
+	return o.data.basicCheck()
+}
+
+// SignBytes returns the bytes that the committee members sign.
+func (o *Order) SignBytes() []byte {
+	sb := util.StringToBytes("halt")
+	sb = append(sb, util.Uint32ToSlice(o.data.Height)...)
+	sb = append(sb, hash.CalcHash(util.StringToBytes(o.data.Reason)).Bytes()...)
+
+	return sb
+}
+
+// Sign signs the order by the given validator key.
+// Signing by a validator that is not in the committee makes the order invalid.
+func (o *Order) Sign(valKey *bls.ValidatorKey) {
+	o.data.addSignature(valKey, o.SignBytes())
+}
+
+// Verify checks if the order is signed by the committee members
+// with more than 2/3 of the committee power.
+func (o *Order) Verify(validators []*validator.Validator) error {
+	return o.data.verify(validators, o.SignBytes())
+}
+
+func (o *Order) MarshalJSON() ([]byte, error) {
+	return json.MarshalIndent(&o.data, "", "  ")
+}
+
+func (o *Order) UnmarshalJSON(bs []byte) error {
+	return json.Unmarshal(bs, &o.data)
+}
+
+// LoadOrderFromFile loads the halt order from a JSON file.
+func LoadOrderFromFile(file string) (*Order, error) {
+	dat, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	var ord Order
+	if err := json.Unmarshal(dat, &ord); err != nil {
+		return nil, err
+	}
+
+	return &ord, nil
+}
+
+// SaveToFile saves the halt order into a JSON file.
+func (o *Order) SaveToFile(file string) error {
+	j, err := o.MarshalJSON()
+	if err != nil {
+		return err
+	}
+
+	return util.WriteFile(file, j)
+}
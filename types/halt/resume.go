@@ -0,0 +1,126 @@
+package halt
+
+import (
+	"encoding/json"
+	"os"
+
+	"github.com/pactus-project/pactus/crypto/bls"
+	"github.com/pactus-project/pactus/crypto/hash"
+	"github.com/pactus-project/pactus/types/validator"
+	"github.com/pactus-project/pactus/util"
+)
+
+// Resume lets the halted nodes commit blocks again.
+// The nodes only resume if their state root at the halt height matches the
+// state root in the artifact, which ensures all of them restart from the same state.
+type Resume struct {
+	data resumeData
+}
+
+type resumeData struct {
+	Height    uint32 `json:"height"`
+	StateRoot string `json:"state_root"`
+	committeeSignatures
+}
+
+// NewResume creates a new resume artifact without any signature.
+func NewResume(height uint32, stateRoot hash.Hash, committeeHeight uint32, committers []int32) *Resume {
+	return &Resume{
+		data: resumeData{
+			Height:              height,
+			StateRoot:           stateRoot.String(),
+			committeeSignatures: newCommitteeSignatures(committeeHeight, committers),
+		},
+	}
+}
+
+// Height returns the height that the chain is halted at.
+func (r *Resume) Height() uint32 {
+	return r.data.Height
+}
+
+// StateRoot returns the expected state root at the halt height.
+func (r *Resume) StateRoot() hash.Hash {
+	h, _ := hash.FromString(r.data.StateRoot)
+
+	return h
+}
+
+// CommitteeHeight returns the height of the block whose committee signs the artifact.
+func (r *Resume) CommitteeHeight() uint32 {
+	return r.data.CommitteeHeight
+}
+
+// Committers returns the numbers of the committee members.
+func (r *Resume) Committers() []int32 {
+	return r.data.Committers
+}
+
+// BasicCheck performs a basic check on the resume artifact.
+func (r *Resume) BasicCheck() error {
+	if r.data.Height < r.data.CommitteeHeight {
+		return BasicCheckError{
+			Reason: "halt height is less than the committee height",
+		}
+	}
+	if _, err := hash.FromString(r.data.StateRoot); err != nil {
+		return BasicCheckError{
+			Reason: "invalid state root: " + err.Error(),
+		}
+	}
+
+	return r.data.basicCheck()
+}
+
+// SignBytes returns the bytes that the committee members sign.
+func (r *Resume) SignBytes() []byte {
+	sb := util.StringToBytes("resume")
+	sb = append(sb, util.Uint32ToSlice(r.data.Height)...)
+	sb = append(sb, r.StateRoot().Bytes()...)
+
+	return sb
+}
+
+// Sign signs the artifact by the given validator key.
+// Signing by a validator that is not in the committee makes the artifact invalid.
+func (r *Resume) Sign(valKey *bls.ValidatorKey) {
+	r.data.addSignature(valKey, r.SignBytes())
+}
+
+// Verify checks if the artifact is signed by the committee members
+// with more than 2/3 of the committee power.
+func (r *Resume) Verify(validators []*validator.Validator) error {
+	return r.data.verify(validators, r.SignBytes())
+}
+
+func (r *Resume) MarshalJSON() ([]byte, error) {
+	return json.MarshalIndent(&r.data, "", "  ")
+}
+
+func (r *Resume) UnmarshalJSON(bs []byte) error {
+	return json.Unmarshal(bs, &r.data)
+}
+
+// LoadResumeFromFile loads the resume artifact from a JSON file.
+func LoadResumeFromFile(file string) (*Resume, error) {
+	dat, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	var rsm Resume
+	if err := json.Unmarshal(dat, &rsm); err != nil {
+		return nil, err
+	}
+
+	return &rsm, nil
+}
+
+// SaveToFile saves the resume artifact into a JSON file.
+func (r *Resume) SaveToFile(file string) error {
+	j, err := r.MarshalJSON()
+	if err != nil {
+		return err
+	}
+
+	return util.WriteFile(file, j)
+}
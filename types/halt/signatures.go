@@ -0,0 +1,98 @@
+package halt
+
+import (
+	"fmt"
+
+	"github.com/pactus-project/pactus/crypto/bls"
+	"github.com/pactus-project/pactus/types/certificate"
+	"github.com/pactus-project/pactus/types/validator"
+)
+
+// committeeSignatures keeps the signatures of the committee members.
+// Each validator signs the artifact separately, and the signatures are
+// aggregated into a certificate once enough of them are collected.
+// The signatures are keyed by the validator addresses, since the validators
+// usually don't know their numbers.
+type committeeSignatures struct {
+	CommitteeHeight uint32            `json:"committee_height"`
+	Committers      []int32           `json:"committers"`
+	Signatures      map[string]string `json:"signatures"`
+}
+
+func newCommitteeSignatures(committeeHeight uint32, committers []int32) committeeSignatures {
+	return committeeSignatures{
+		CommitteeHeight: committeeHeight,
+		Committers:      committers,
+		Signatures:      make(map[string]string),
+	}
+}
+
+func (s *committeeSignatures) basicCheck() error {
+	if s.CommitteeHeight == 0 {
+		return BasicCheckError{
+			Reason: "committee height is not positive",
+		}
+	}
+	if len(s.Committers) == 0 {
+		return BasicCheckError{
+			Reason: "committers is missing",
+		}
+	}
+	for addr, sig := range s.Signatures {
+		if _, err := bls.SignatureFromString(sig); err != nil {
+			return BasicCheckError{
+				Reason: fmt.Sprintf("invalid signature of %s: %s", addr, err.Error()),
+			}
+		}
+	}
+
+	return nil
+}
+
+func (s *committeeSignatures) addSignature(valKey *bls.ValidatorKey, signBytes []byte) {
+	if s.Signatures == nil {
+		s.Signatures = make(map[string]string)
+	}
+	s.Signatures[valKey.Address().String()] = valKey.Sign(signBytes).String()
+}
+
+// verify checks if the committee members with more than 2/3 of the
+// committee power have signed the sign bytes.
+// The validators should be the committee members at the committee height.
+func (s *committeeSignatures) verify(validators []*validator.Validator, signBytes []byte) error {
+	signed := make(map[string]bool, len(s.Signatures))
+	sigs := make([]*bls.Signature, 0, len(s.Signatures))
+	absentees := make([]int32, 0)
+	for _, val := range validators {
+		addr := val.Address().String()
+		str, ok := s.Signatures[addr]
+		if !ok {
+			absentees = append(absentees, val.Number())
+
+			continue
+		}
+		sig, err := bls.SignatureFromString(str)
+		if err != nil {
+			return err
+		}
+		sigs = append(sigs, sig)
+		signed[addr] = true
+	}
+
+	for addr := range s.Signatures {
+		if !signed[addr] {
+			return NotCommitterError{Address: addr}
+		}
+	}
+
+	if len(sigs) == 0 {
+		return BasicCheckError{
+			Reason: "no signature",
+		}
+	}
+
+	cert := certificate.NewCertificate(s.CommitteeHeight, 0,
+		s.Committers, absentees, bls.SignatureAggregate(sigs...))
+
+	return cert.Validate(s.CommitteeHeight, validators, signBytes)
+}
@@ -1,6 +1,12 @@
 package param
 
-import "time"
+import (
+	"time"
+
+	"github.com/pactus-project/pactus/crypto"
+	"github.com/pactus-project/pactus/types/tx/payload"
+	"golang.org/x/exp/slices"
+)
 
 type Params struct {
 	BlockVersion              uint8   `cbor:"1,keyasint"  json:"block_version"`
@@ -16,6 +22,42 @@ type Params struct {
 	MaximumFee                int64   `cbor:"11,keyasint" json:"maximum_fee"`
 	MinimumStake              int64   `cobr:"12,keyasint" json:"minimum_stake"`
 	MaximumStake              int64   `cbor:"13,keyasint" json:"maximum_stake"`
+	FeePerWeight              int64   `cbor:"14,keyasint,omitempty" json:"fee_per_weight,omitempty"`
+	MaxBlockWeight            int64   `cbor:"15,keyasint,omitempty" json:"max_block_weight,omitempty"`
+
+	// In a permissioned network, only the allowlisted validators can bond or join the committee.
+	// The governors manage the allowlist through the allowlist transactions.
+	Permissioned bool     `cbor:"16,keyasint,omitempty" json:"permissioned,omitempty"`
+	Governors    []string `cbor:"17,keyasint,omitempty" json:"governors,omitempty"`
+
+	// MinProtocolVersion is the minimum protocol version of the peers that the nodes of this network accept.
+	// Zero means no minimum. The nodes can raise it in their config, but not lower it.
+	MinProtocolVersion uint32 `cbor:"18,keyasint,omitempty" json:"min_protocol_version,omitempty"`
+
+	// BlockTimeToleranceInSecond is how far the block time can be ahead of the expected time.
+	// It is a consensus rule, so all the validators should have the same tolerance.
+	// Zero means the default tolerance, so the genesis of the existing networks doesn't change.
+	BlockTimeToleranceInSecond int `cbor:"19,keyasint,omitempty" json:"block_time_tolerance_in_second,omitempty"`
+
+	// MedianTimeSpan is the number of the recent blocks that the median time past is calculated from.
+	// It is a consensus rule, since the expected time of the next block is smoothed by the median time past.
+	// Zero means the default span, so the genesis of the existing networks doesn't change.
+	MedianTimeSpan int `cbor:"20,keyasint,omitempty" json:"median_time_span,omitempty"`
+
+	// ProofOfPossessionHeight is the height that the bond transactions of the new validators
+	// should have the proof of possession of their public key.
+	// It is not a part of the genesis, and it is set by the state from the chain type.
+	ProofOfPossessionHeight uint32 `cbor:"-" json:"-"`
+
+	// PayloadActivationHeights are the heights that the payload types, added after the launch,
+	// are accepted from. The payload types that are not in the map are accepted from the genesis.
+	// It is not a part of the genesis, and it is set by the state from the chain type.
+	PayloadActivationHeights map[payload.Type]uint32 `cbor:"-" json:"-"`
+
+	// TimestampLockTimeHeight is the height that the lock times at or above the threshold
+	// are interpreted as Unix timestamps. Before it, all the lock times are block heights.
+	// It is not a part of the genesis, and it is set by the state from the chain type.
+	TimestampLockTimeHeight uint32 `cbor:"-" json:"-"`
 }
 
 func DefaultParams() *Params {
@@ -33,9 +75,63 @@ func DefaultParams() *Params {
 		MaximumFee:                1000000,
 		MinimumStake:              1000000000,
 		MaximumStake:              1000000000000,
+		FeePerWeight:              0,       // fee is based on the amount only
+		MaxBlockWeight:            2500000, // zero means unlimited
 	}
 }
 
+// defaultBlockTimeTolerance is the block time tolerance of the networks that don't set it.
+const defaultBlockTimeTolerance = 5 * time.Second
+
+// defaultMedianTimeSpan is the median time span of the networks that don't set it.
+const defaultMedianTimeSpan = 11
+
 func (p *Params) BlockInterval() time.Duration {
 	return time.Duration(p.BlockIntervalInSecond) * time.Second
 }
+
+// BlockTimeTolerance returns how far the block time can be ahead of the expected time.
+func (p *Params) BlockTimeTolerance() time.Duration {
+	if p.BlockTimeToleranceInSecond <= 0 {
+		return defaultBlockTimeTolerance
+	}
+
+	return time.Duration(p.BlockTimeToleranceInSecond) * time.Second
+}
+
+// MedianTimeBlocks returns the number of the recent blocks that the median time past is calculated from.
+func (p *Params) MedianTimeBlocks() int {
+	if p.MedianTimeSpan <= 0 {
+		return defaultMedianTimeSpan
+	}
+
+	return p.MedianTimeSpan
+}
+
+// IsProofOfPossessionRequired checks if the new validators should have the proof of possession
+// of their public key at the given height.
+func (p *Params) IsProofOfPossessionRequired(height uint32) bool {
+	return height >= p.ProofOfPossessionHeight
+}
+
+// IsPayloadActive checks if the transactions with the given payload type are accepted
+// at the given height.
+func (p *Params) IsPayloadActive(typ payload.Type, height uint32) bool {
+	activationHeight, ok := p.PayloadActivationHeights[typ]
+	if !ok {
+		return true
+	}
+
+	return height >= activationHeight
+}
+
+// IsTimestampLockTimeActive checks if the lock times at or above the threshold
+// are interpreted as Unix timestamps at the given height.
+func (p *Params) IsTimestampLockTimeActive(height uint32) bool {
+	return height >= p.TimestampLockTimeHeight
+}
+
+// IsGovernor checks if the account is a governor of the permissioned network.
+func (p *Params) IsGovernor(addr crypto.Address) bool {
+	return slices.Contains(p.Governors, addr.String())
+}
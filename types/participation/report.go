@@ -0,0 +1,193 @@
+// Package participation defines the participation report of a validator.
+// A validator signs the report to prove its performance, for example to the delegators.
+// The numbers in the report are derived from the blockchain,
+// so anyone can verify them against the blocks and certificates.
+package participation
+
+import (
+	"fmt"
+
+	"github.com/fxamacker/cbor/v2"
+	"github.com/pactus-project/pactus/crypto"
+	"github.com/pactus-project/pactus/crypto/bls"
+	"github.com/pactus-project/pactus/crypto/hash"
+	"github.com/pactus-project/pactus/util"
+	"github.com/pactus-project/pactus/util/errors"
+)
+
+// signPrefix separates the signature of a report from the signatures of the proposals and votes,
+// so a signed report can never be used as a consensus message.
+const signPrefix = "PACTUS_PARTICIPATION_REPORT"
+
+// Report contains the participation of a validator in a range of blocks.
+type Report struct {
+	data reportData
+}
+
+type reportData struct {
+	Validator      crypto.Address `cbor:"1,keyasint"`
+	FromHeight     uint32         `cbor:"2,keyasint"`
+	ToHeight       uint32         `cbor:"3,keyasint"`
+	InCommittee    uint32         `cbor:"4,keyasint"`
+	VotesCast      uint32         `cbor:"5,keyasint"`
+	BlocksProposed uint32         `cbor:"6,keyasint"`
+	Signature      *bls.Signature `cbor:"7,keyasint"`
+}
+
+// NewReport creates a new unsigned participation report.
+func NewReport(validator crypto.Address, fromHeight, toHeight uint32,
+	inCommittee, votesCast, blocksProposed uint32,
+) *Report {
+	return &Report{
+		data: reportData{
+			Validator:      validator,
+			FromHeight:     fromHeight,
+			ToHeight:       toHeight,
+			InCommittee:    inCommittee,
+			VotesCast:      votesCast,
+			BlocksProposed: blocksProposed,
+		},
+	}
+}
+
+// FromBytes decodes the report from its CBOR encoding.
+func FromBytes(data []byte) (*Report, error) {
+	r := new(Report)
+	if err := r.UnmarshalCBOR(data); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// Validator returns the address of the validator.
+func (r *Report) Validator() crypto.Address {
+	return r.data.Validator
+}
+
+// FromHeight returns the first height of the report, inclusive.
+func (r *Report) FromHeight() uint32 {
+	return r.data.FromHeight
+}
+
+// ToHeight returns the last height of the report, inclusive.
+func (r *Report) ToHeight() uint32 {
+	return r.data.ToHeight
+}
+
+// InCommittee returns the number of blocks that the validator was a member of the committee.
+func (r *Report) InCommittee() uint32 {
+	return r.data.InCommittee
+}
+
+// VotesCast returns the number of blocks that the validator signed their certificate.
+func (r *Report) VotesCast() uint32 {
+	return r.data.VotesCast
+}
+
+// BlocksProposed returns the number of blocks that are proposed by the validator.
+func (r *Report) BlocksProposed() uint32 {
+	return r.data.BlocksProposed
+}
+
+// Uptime returns the ratio of the votes cast to the blocks that the validator was in the committee,
+// between 0 and 1. It is 1 if the validator was not in the committee.
+func (r *Report) Uptime() float64 {
+	if r.data.InCommittee == 0 {
+		return 1.0
+	}
+
+	return float64(r.data.VotesCast) / float64(r.data.InCommittee)
+}
+
+func (r *Report) Signature() *bls.Signature {
+	return r.data.Signature
+}
+
+func (r *Report) SetSignature(sig *bls.Signature) {
+	r.data.Signature = sig
+}
+
+// SignBytes returns the bytes to be signed for the report.
+// The chain ID is included, so a report of one network is not valid on another one.
+func (r *Report) SignBytes() []byte {
+	sb := util.StringToBytes(signPrefix)
+	sb = append(sb, byte(len(crypto.CurrentSignDomain().ChainID)))
+	sb = append(sb, crypto.CurrentSignDomain().ChainID...)
+	sb = append(sb, r.data.Validator.Bytes()...)
+	sb = append(sb, util.Uint32ToSlice(r.data.FromHeight)...)
+	sb = append(sb, util.Uint32ToSlice(r.data.ToHeight)...)
+	sb = append(sb, util.Uint32ToSlice(r.data.InCommittee)...)
+	sb = append(sb, util.Uint32ToSlice(r.data.VotesCast)...)
+	sb = append(sb, util.Uint32ToSlice(r.data.BlocksProposed)...)
+
+	return sb
+}
+
+// Hash returns the hash of the sign bytes of the report.
+func (r *Report) Hash() hash.Hash {
+	return hash.CalcHash(r.SignBytes())
+}
+
+// BasicCheck performs a basic check on the report.
+func (r *Report) BasicCheck() error {
+	if !r.data.Validator.IsValidatorAddress() {
+		return errors.Errorf(errors.ErrInvalidAddress, "not a validator address")
+	}
+	if r.data.FromHeight == 0 || r.data.FromHeight > r.data.ToHeight {
+		return errors.Errorf(errors.ErrInvalidHeight,
+			"invalid range: %d-%d", r.data.FromHeight, r.data.ToHeight)
+	}
+	if r.data.VotesCast > r.data.InCommittee {
+		return errors.Errorf(errors.ErrGeneric, "more votes than the committee memberships")
+	}
+	if r.data.BlocksProposed > r.data.VotesCast {
+		return errors.Errorf(errors.ErrGeneric, "more proposed blocks than the votes")
+	}
+	if r.data.Signature == nil {
+		return errors.Errorf(errors.ErrInvalidSignature, "no signature")
+	}
+
+	return nil
+}
+
+// Verify checks the signature of the report with the public key of the validator.
+func (r *Report) Verify(pubKey *bls.PublicKey) error {
+	if r.data.Signature == nil {
+		return errors.Errorf(errors.ErrInvalidSignature, "no signature")
+	}
+	if err := pubKey.VerifyAddress(r.data.Validator); err != nil {
+		return err
+	}
+
+	return pubKey.Verify(r.SignBytes(), r.data.Signature)
+}
+
+// Equal checks if the numbers of two reports are the same, ignoring the signatures.
+func (r *Report) Equal(other *Report) bool {
+	return r.data.Validator == other.data.Validator &&
+		r.data.FromHeight == other.data.FromHeight &&
+		r.data.ToHeight == other.data.ToHeight &&
+		r.data.InCommittee == other.data.InCommittee &&
+		r.data.VotesCast == other.data.VotesCast &&
+		r.data.BlocksProposed == other.data.BlocksProposed
+}
+
+func (r *Report) MarshalCBOR() ([]byte, error) {
+	return cbor.Marshal(r.data)
+}
+
+func (r *Report) UnmarshalCBOR(bs []byte) error {
+	return cbor.Unmarshal(bs, &r.data)
+}
+
+// Bytes returns the CBOR encoding of the report.
+func (r *Report) Bytes() ([]byte, error) {
+	return r.MarshalCBOR()
+}
+
+func (r *Report) String() string {
+	return fmt.Sprintf("{⌘ %s %d-%d 🗳 %d/%d 🗃 %d}",
+		r.data.Validator.ShortString(), r.data.FromHeight, r.data.ToHeight,
+		r.data.VotesCast, r.data.InCommittee, r.data.BlocksProposed)
+}
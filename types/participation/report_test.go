@@ -0,0 +1,102 @@
+package participation_test
+
+import (
+	"testing"
+
+	"github.com/pactus-project/pactus/crypto"
+	"github.com/pactus-project/pactus/types/participation"
+	"github.com/pactus-project/pactus/util/errors"
+	"github.com/pactus-project/pactus/util/testsuite"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReportEncoding(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	valKey := ts.RandValKey()
+	r1 := participation.NewReport(valKey.Address(), 1, 100, 50, 45, 10)
+	r1.SetSignature(valKey.Sign(r1.SignBytes()))
+
+	bs, err := r1.Bytes()
+	require.NoError(t, err)
+
+	r2, err := participation.FromBytes(bs)
+	require.NoError(t, err)
+	assert.True(t, r1.Equal(r2))
+	assert.Equal(t, r1.Signature().Bytes(), r2.Signature().Bytes())
+	assert.Equal(t, r1.Hash(), r2.Hash())
+	assert.NoError(t, r2.BasicCheck())
+	assert.NoError(t, r2.Verify(valKey.PublicKey()))
+
+	_, err = participation.FromBytes([]byte{1})
+	assert.Error(t, err)
+}
+
+func TestReportUptime(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	addr := ts.RandValAddress()
+	assert.Equal(t, 0.9, participation.NewReport(addr, 1, 100, 50, 45, 10).Uptime())
+	assert.Equal(t, 1.0, participation.NewReport(addr, 1, 100, 0, 0, 0).Uptime())
+}
+
+func TestReportSignature(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	valKey := ts.RandValKey()
+	otherKey := ts.RandValKey()
+	r := participation.NewReport(valKey.Address(), 1, 100, 50, 45, 10)
+
+	err := r.Verify(valKey.PublicKey())
+	assert.Equal(t, errors.ErrInvalidSignature, errors.Code(err))
+
+	r.SetSignature(otherKey.Sign(r.SignBytes()))
+	err = r.Verify(otherKey.PublicKey())
+	assert.ErrorIs(t, err, crypto.AddressMismatchError{
+		Expected: otherKey.Address(),
+		Got:      valKey.Address(),
+	})
+	assert.ErrorIs(t, r.Verify(valKey.PublicKey()), crypto.ErrInvalidSignature)
+
+	r.SetSignature(valKey.Sign(r.SignBytes()))
+	assert.NoError(t, r.Verify(valKey.PublicKey()))
+
+	t.Run("Signature of another network", func(t *testing.T) {
+		crypto.SetSignDomain(crypto.SignDomain{ChainID: "pactus-testnet"})
+		defer crypto.SetSignDomain(crypto.SignDomain{})
+
+		assert.ErrorIs(t, r.Verify(valKey.PublicKey()), crypto.ErrInvalidSignature)
+	})
+}
+
+func TestReportBasicCheck(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	valAddr := ts.RandValAddress()
+	sig := ts.RandBLSSignature()
+
+	tests := []struct {
+		name   string
+		report *participation.Report
+		code   int
+	}{
+		{"Account address", participation.NewReport(ts.RandAccAddress(), 1, 100, 0, 0, 0), errors.ErrInvalidAddress},
+		{"Zero from height", participation.NewReport(valAddr, 0, 100, 0, 0, 0), errors.ErrInvalidHeight},
+		{"Inverted range", participation.NewReport(valAddr, 100, 1, 0, 0, 0), errors.ErrInvalidHeight},
+		{"Too many votes", participation.NewReport(valAddr, 1, 100, 10, 11, 0), errors.ErrGeneric},
+		{"Too many proposals", participation.NewReport(valAddr, 1, 100, 10, 5, 6), errors.ErrGeneric},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.report.SetSignature(sig)
+			assert.Equal(t, tt.code, errors.Code(tt.report.BasicCheck()))
+		})
+	}
+
+	t.Run("No signature", func(t *testing.T) {
+		r := participation.NewReport(valAddr, 1, 100, 0, 0, 0)
+		assert.Equal(t, errors.ErrInvalidSignature, errors.Code(r.BasicCheck()))
+	})
+}
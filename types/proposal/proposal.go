@@ -72,12 +72,14 @@ func (p *Proposal) SetSignature(sig *bls.Signature) {
 	p.data.Signature = sig
 }
 
+// SignBytes returns the bytes that the proposer signs for the proposal.
+// The chain ID is included when the sign domain is active at the height of the proposal.
 func (p *Proposal) SignBytes() []byte {
 	sb := p.Block().Hash().Bytes()
 	sb = append(sb, util.Uint32ToSlice(p.Height())...)
 	sb = append(sb, util.Int16ToSlice(p.Round())...)
 
-	return sb
+	return crypto.CurrentSignDomain().Apply(p.Height(), sb)
 }
 
 func (p *Proposal) MarshalCBOR() ([]byte, error) {
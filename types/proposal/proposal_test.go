@@ -56,6 +56,29 @@ func TestProposalSignature(t *testing.T) {
 	assert.ErrorIs(t, crypto.ErrInvalidSignature, err)
 }
 
+func TestProposalSignBytesWithChainID(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	current := crypto.CurrentSignDomain()
+	t.Cleanup(func() { crypto.SetSignDomain(current) })
+
+	p, valKey := ts.GenerateTestProposal(100, 0)
+	legacySignBytes := p.SignBytes()
+
+	crypto.SetSignDomain(crypto.SignDomain{ChainID: "net1", ActivationHeight: 101})
+	assert.Equal(t, legacySignBytes, p.SignBytes())
+
+	crypto.SetSignDomain(crypto.SignDomain{ChainID: "net1", ActivationHeight: 100})
+	assert.NotEqual(t, legacySignBytes, p.SignBytes())
+
+	ts.HelperSignProposal(valKey, p)
+	assert.NoError(t, p.Verify(valKey.PublicKey()))
+
+	// The proposal is not valid on other chains.
+	crypto.SetSignDomain(crypto.SignDomain{ChainID: "net2", ActivationHeight: 0})
+	assert.ErrorIs(t, p.Verify(valKey.PublicKey()), crypto.ErrInvalidSignature)
+}
+
 func TestBasicCheck(t *testing.T) {
 	ts := testsuite.NewTestSuite(t)
 
@@ -0,0 +1,259 @@
+// Package receipt provides the execution result of the committed transactions.
+package receipt
+
+import (
+	"bytes"
+	"io"
+
+	"github.com/pactus-project/pactus/crypto"
+	"github.com/pactus-project/pactus/types/tx"
+	"github.com/pactus-project/pactus/util/encoding"
+)
+
+// Status is the execution status of a committed transaction.
+type Status uint8
+
+const (
+	// StatusSuccess means the transaction is executed successfully.
+	// Blocks with failed transactions are rejected, therefore all the committed
+	// transactions have this status for now.
+	StatusSuccess = Status(1)
+)
+
+func (s Status) String() string {
+	switch s {
+	case StatusSuccess:
+		return "success"
+	}
+
+	return "unknown"
+}
+
+// EventType is the type of the events that a transaction emits.
+type EventType uint8
+
+const (
+	EventBalanceChange = EventType(1) // The balance of an account is changed.
+	EventStakeChange   = EventType(2) // The stake of a validator is changed.
+)
+
+func (t EventType) String() string {
+	switch t {
+	case EventBalanceChange:
+		return "balance_change"
+	case EventStakeChange:
+		return "stake_change"
+	}
+
+	return "unknown"
+}
+
+// Balance is the balance of an account after executing the transaction.
+type Balance struct {
+	Address crypto.Address
+	Balance int64
+}
+
+// Event is a change that the transaction makes in the state.
+type Event struct {
+	Type     EventType
+	Address  crypto.Address
+	OldValue int64
+	NewValue int64
+}
+
+// Receipt contains the execution result of a committed transaction.
+type Receipt struct {
+	data receiptData
+}
+
+type receiptData struct {
+	TxID     tx.ID
+	Height   uint32
+	Index    uint16
+	Status   Status
+	Fee      int64
+	Balances []Balance
+	Events   []Event
+}
+
+// NewReceipt constructs a new receipt for the transaction at the given index of the block.
+func NewReceipt(txID tx.ID, height uint32, index uint16, status Status, fee int64,
+	balances []Balance, events []Event,
+) *Receipt {
+	return &Receipt{
+		data: receiptData{
+			TxID:     txID,
+			Height:   height,
+			Index:    index,
+			Status:   status,
+			Fee:      fee,
+			Balances: balances,
+			Events:   events,
+		},
+	}
+}
+
+// FromBytes constructs a new receipt from byte array.
+func FromBytes(data []byte) (*Receipt, error) {
+	rcpt := new(Receipt)
+	if err := rcpt.Decode(bytes.NewReader(data)); err != nil {
+		return nil, err
+	}
+
+	return rcpt, nil
+}
+
+// TxID returns the ID of the transaction.
+func (r *Receipt) TxID() tx.ID {
+	return r.data.TxID
+}
+
+// Height returns the height of the block that includes the transaction.
+func (r *Receipt) Height() uint32 {
+	return r.data.Height
+}
+
+// Index returns the position of the transaction in the block.
+func (r *Receipt) Index() uint16 {
+	return r.data.Index
+}
+
+// Status returns the execution status of the transaction.
+func (r *Receipt) Status() Status {
+	return r.data.Status
+}
+
+// Fee returns the fee that is charged for the transaction.
+func (r *Receipt) Fee() int64 {
+	return r.data.Fee
+}
+
+// Balances returns the resulting balances of the accounts that the transaction touched.
+func (r *Receipt) Balances() []Balance {
+	return r.data.Balances
+}
+
+// Events returns the events that the transaction emitted.
+func (r *Receipt) Events() []Event {
+	return r.data.Events
+}
+
+// SerializeSize returns the size in bytes required to serialize the receipt.
+func (r *Receipt) SerializeSize() int {
+	n := 32 + 4 + 2 + 1 + 8 // TxID + Height + Index + Status + Fee
+	n += encoding.VarIntSerializeSize(uint64(len(r.data.Balances)))
+	for _, b := range r.data.Balances {
+		n += b.Address.SerializeSize() + 8
+	}
+	n += encoding.VarIntSerializeSize(uint64(len(r.data.Events)))
+	for _, e := range r.data.Events {
+		n += 1 + e.Address.SerializeSize() + 16
+	}
+
+	return n
+}
+
+// Encode writes the receipt to the writer.
+func (r *Receipt) Encode(w io.Writer) error {
+	err := encoding.WriteElements(w,
+		&r.data.TxID,
+		r.data.Height,
+		r.data.Index,
+		uint8(r.data.Status),
+		r.data.Fee)
+	if err != nil {
+		return err
+	}
+
+	if err := encoding.WriteVarInt(w, uint64(len(r.data.Balances))); err != nil {
+		return err
+	}
+	for _, b := range r.data.Balances {
+		if err := b.Address.Encode(w); err != nil {
+			return err
+		}
+		if err := encoding.WriteElement(w, b.Balance); err != nil {
+			return err
+		}
+	}
+
+	if err := encoding.WriteVarInt(w, uint64(len(r.data.Events))); err != nil {
+		return err
+	}
+	for _, e := range r.data.Events {
+		if err := encoding.WriteElement(w, uint8(e.Type)); err != nil {
+			return err
+		}
+		if err := e.Address.Encode(w); err != nil {
+			return err
+		}
+		if err := encoding.WriteElements(w, e.OldValue, e.NewValue); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Decode reads the receipt from the reader.
+func (r *Receipt) Decode(rd io.Reader) error {
+	status := uint8(0)
+	err := encoding.ReadElements(rd,
+		&r.data.TxID,
+		&r.data.Height,
+		&r.data.Index,
+		&status,
+		&r.data.Fee)
+	if err != nil {
+		return err
+	}
+	r.data.Status = Status(status)
+
+	numBalances, err := encoding.ReadVarInt(rd)
+	if err != nil {
+		return err
+	}
+	r.data.Balances = make([]Balance, numBalances)
+	for i := range r.data.Balances {
+		b := &r.data.Balances[i]
+		if err := b.Address.Decode(rd); err != nil {
+			return err
+		}
+		if err := encoding.ReadElement(rd, &b.Balance); err != nil {
+			return err
+		}
+	}
+
+	numEvents, err := encoding.ReadVarInt(rd)
+	if err != nil {
+		return err
+	}
+	r.data.Events = make([]Event, numEvents)
+	for i := range r.data.Events {
+		e := &r.data.Events[i]
+		eventType := uint8(0)
+		if err := encoding.ReadElement(rd, &eventType); err != nil {
+			return err
+		}
+		e.Type = EventType(eventType)
+		if err := e.Address.Decode(rd); err != nil {
+			return err
+		}
+		if err := encoding.ReadElements(rd, &e.OldValue, &e.NewValue); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Bytes returns the serialized byte representation of the receipt.
+func (r *Receipt) Bytes() ([]byte, error) {
+	w := bytes.NewBuffer(make([]byte, 0, r.SerializeSize()))
+	if err := r.Encode(w); err != nil {
+		return nil, err
+	}
+
+	return w.Bytes(), nil
+}
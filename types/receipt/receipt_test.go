@@ -0,0 +1,83 @@
+package receipt_test
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/pactus-project/pactus/crypto"
+	"github.com/pactus-project/pactus/types/receipt"
+	"github.com/pactus-project/pactus/util/testsuite"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFromBytes(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	rcpt := receipt.NewReceipt(ts.RandHash(), ts.RandHeight(), ts.RandUint16(100),
+		receipt.StatusSuccess, ts.RandAmount(),
+		[]receipt.Balance{
+			{Address: ts.RandAccAddress(), Balance: ts.RandAmount()},
+			{Address: crypto.TreasuryAddress, Balance: ts.RandAmount()},
+		},
+		[]receipt.Event{
+			{
+				Type:     receipt.EventBalanceChange,
+				Address:  ts.RandAccAddress(),
+				OldValue: ts.RandAmount(),
+				NewValue: ts.RandAmount(),
+			},
+			{
+				Type:     receipt.EventStakeChange,
+				Address:  ts.RandValAddress(),
+				OldValue: ts.RandAmount(),
+				NewValue: ts.RandAmount(),
+			},
+		})
+
+	bs, err := rcpt.Bytes()
+	require.NoError(t, err)
+	assert.Equal(t, rcpt.SerializeSize(), len(bs))
+
+	rcpt2, err := receipt.FromBytes(bs)
+	require.NoError(t, err)
+	assert.Equal(t, rcpt, rcpt2)
+
+	_, err = receipt.FromBytes(bs[:len(bs)-1])
+	assert.Error(t, err)
+}
+
+func TestDecoding(t *testing.T) {
+	d, _ := hex.DecodeString(
+		"0102030405060708090a0b0c0d0e0f10" +
+			"1112131415161718191a1b1c1d1e1f20" + // tx id
+			"0a000000" + // height
+			"0200" + // index
+			"01" + // status
+			"e803000000000000" + // fee
+			"01" + // number of balances
+			"00" + // treasury address
+			"1027000000000000" + // balance
+			"00") // number of events
+
+	rcpt, err := receipt.FromBytes(d)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(10), rcpt.Height())
+	assert.Equal(t, uint16(2), rcpt.Index())
+	assert.Equal(t, receipt.StatusSuccess, rcpt.Status())
+	assert.Equal(t, int64(1000), rcpt.Fee())
+	assert.Equal(t, []receipt.Balance{{Address: crypto.TreasuryAddress, Balance: 10000}}, rcpt.Balances())
+	assert.Empty(t, rcpt.Events())
+	assert.Equal(t, rcpt.SerializeSize(), len(d))
+
+	d2, _ := rcpt.Bytes()
+	assert.Equal(t, d, d2)
+}
+
+func TestStrings(t *testing.T) {
+	assert.Equal(t, "success", receipt.StatusSuccess.String())
+	assert.Equal(t, "unknown", receipt.Status(0).String())
+	assert.Equal(t, "balance_change", receipt.EventBalanceChange.String())
+	assert.Equal(t, "stake_change", receipt.EventStakeChange.String())
+	assert.Equal(t, "unknown", receipt.EventType(0).String())
+}
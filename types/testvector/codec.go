@@ -0,0 +1,204 @@
+package testvector
+
+import (
+	"bytes"
+	"encoding/hex"
+	"strconv"
+	"strings"
+
+	"github.com/pactus-project/pactus/crypto"
+	"github.com/pactus-project/pactus/types/block"
+	"github.com/pactus-project/pactus/types/certificate"
+	"github.com/pactus-project/pactus/types/tx"
+	"github.com/pactus-project/pactus/types/vote"
+)
+
+// codec decodes the data of a test vector and returns the described fields
+// along with the re-encoded data.
+type codec func(data []byte) (map[string]string, []byte, error)
+
+var codecs = map[string]codec{
+	TypeAddress:     decodeAddress,
+	TypeTransaction: decodeTransaction,
+	TypeBlock:       decodeBlock,
+	TypeCertificate: decodeCertificate,
+	TypeVote:        decodeVote,
+}
+
+func decodeAddress(data []byte) (map[string]string, []byte, error) {
+	addr := crypto.Address{}
+	if err := addr.Decode(bytes.NewReader(data)); err != nil {
+		return nil, nil, err
+	}
+
+	return describeAddress(addr), encodeAddress(addr), nil
+}
+
+func decodeTransaction(data []byte) (map[string]string, []byte, error) {
+	trx, err := tx.FromBytes(data)
+	if err != nil {
+		return nil, nil, err
+	}
+	encoded, err := trx.Bytes()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return describeTransaction(trx), encoded, nil
+}
+
+func decodeBlock(data []byte) (map[string]string, []byte, error) {
+	blk, err := block.FromBytes(data)
+	if err != nil {
+		return nil, nil, err
+	}
+	encoded, err := blk.Bytes()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return describeBlock(blk), encoded, nil
+}
+
+func decodeCertificate(data []byte) (map[string]string, []byte, error) {
+	cert := new(certificate.Certificate)
+	if err := cert.Decode(bytes.NewReader(data)); err != nil {
+		return nil, nil, err
+	}
+	encoded, err := encodeCertificate(cert)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return describeCertificate(cert), encoded, nil
+}
+
+func decodeVote(data []byte) (map[string]string, []byte, error) {
+	v := new(vote.Vote)
+	if err := v.UnmarshalCBOR(data); err != nil {
+		return nil, nil, err
+	}
+	encoded, err := v.MarshalCBOR()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return describeVote(v), encoded, nil
+}
+
+func encodeAddress(addr crypto.Address) []byte {
+	w := bytes.NewBuffer(make([]byte, 0, addr.SerializeSize()))
+	_ = addr.Encode(w)
+
+	return w.Bytes()
+}
+
+func encodeCertificate(cert *certificate.Certificate) ([]byte, error) {
+	w := bytes.NewBuffer(make([]byte, 0, cert.SerializeSize()))
+	if err := cert.Encode(w); err != nil {
+		return nil, err
+	}
+
+	return w.Bytes(), nil
+}
+
+func describeAddress(addr crypto.Address) map[string]string {
+	return map[string]string{
+		"type":   strconv.Itoa(int(addr.Type())),
+		"string": addr.String(),
+	}
+}
+
+func describeTransaction(trx *tx.Tx) map[string]string {
+	pld := trx.Payload()
+	fields := map[string]string{
+		"id":           trx.ID().String(),
+		"version":      strconv.Itoa(int(trx.Version())),
+		"lock_time":    strconv.FormatUint(uint64(trx.LockTime()), 10),
+		"fee":          strconv.FormatInt(trx.Fee(), 10),
+		"memo":         trx.Memo(),
+		"payload_type": pld.Type().String(),
+		"signer":       pld.Signer().String(),
+		"value":        strconv.FormatInt(pld.Value(), 10),
+		"sign_bytes":   hex.EncodeToString(trx.SignBytes()),
+	}
+	if receiver := pld.Receiver(); receiver != nil {
+		fields["receiver"] = receiver.String()
+	}
+	if trx.PublicKey() != nil {
+		fields["public_key"] = trx.PublicKey().String()
+	}
+	if trx.Signature() != nil {
+		fields["signature"] = trx.Signature().String()
+	}
+
+	return fields
+}
+
+func describeBlock(blk *block.Block) map[string]string {
+	header := blk.Header()
+	seed := header.SortitionSeed()
+	ids := make([]string, 0, blk.Transactions().Len())
+	for _, trx := range blk.Transactions() {
+		ids = append(ids, trx.ID().String())
+	}
+
+	fields := map[string]string{
+		"hash":            blk.Hash().String(),
+		"version":         strconv.Itoa(int(header.Version())),
+		"unix_time":       strconv.FormatUint(uint64(header.UnixTime()), 10),
+		"prev_block_hash": header.PrevBlockHash().String(),
+		"state_root":      header.StateRoot().String(),
+		"sortition_seed":  hex.EncodeToString(seed[:]),
+		"proposer":        header.ProposerAddress().String(),
+		"tx_root":         blk.Transactions().Root().String(),
+		"tx_ids":          strings.Join(ids, ","),
+	}
+	if cert := blk.PrevCertificate(); cert != nil {
+		fields["prev_certificate_hash"] = cert.Hash().String()
+	}
+
+	return fields
+}
+
+func describeCertificate(cert *certificate.Certificate) map[string]string {
+	return map[string]string{
+		"hash":       cert.Hash().String(),
+		"height":     strconv.FormatUint(uint64(cert.Height()), 10),
+		"round":      strconv.Itoa(int(cert.Round())),
+		"committers": joinNumbers(cert.Committers()),
+		"absentees":  joinNumbers(cert.Absentees()),
+		"signature":  cert.Signature().String(),
+	}
+}
+
+func describeVote(v *vote.Vote) map[string]string {
+	fields := map[string]string{
+		"hash":       v.Hash().String(),
+		"type":       v.Type().String(),
+		"height":     strconv.FormatUint(uint64(v.Height()), 10),
+		"round":      strconv.Itoa(int(v.Round())),
+		"block_hash": v.BlockHash().String(),
+		"signer":     v.Signer().String(),
+		"sign_bytes": hex.EncodeToString(v.SignBytes()),
+	}
+	if v.Signature() != nil {
+		fields["signature"] = v.Signature().String()
+	}
+	if v.IsCPVote() {
+		fields["cp_round"] = strconv.Itoa(int(v.CPRound()))
+		fields["cp_value"] = v.CPValue().String()
+		fields["cp_just_type"] = v.CPJust().Type().String()
+	}
+
+	return fields
+}
+
+func joinNumbers(nums []int32) string {
+	strs := make([]string, 0, len(nums))
+	for _, n := range nums {
+		strs = append(strs, strconv.Itoa(int(n)))
+	}
+
+	return strings.Join(strs, ",")
+}
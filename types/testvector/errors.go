@@ -0,0 +1,34 @@
+package testvector
+
+import "fmt"
+
+// UnsupportedVersionError is returned when the version of the test vectors is not supported.
+type UnsupportedVersionError struct {
+	Version int
+}
+
+func (e UnsupportedVersionError) Error() string {
+	return fmt.Sprintf("unsupported test vector version: %d", e.Version)
+}
+
+// UnknownTypeError is returned when the type of a test vector is unknown.
+type UnknownTypeError struct {
+	Type string
+}
+
+func (e UnknownTypeError) Error() string {
+	return fmt.Sprintf("unknown test vector type: %s", e.Type)
+}
+
+// MismatchError is returned when a test vector doesn't match the decoded value.
+type MismatchError struct {
+	Name     string
+	Field    string
+	Expected string
+	Got      string
+}
+
+func (e MismatchError) Error() string {
+	return fmt.Sprintf("test vector %s: %s mismatch, expected %q, got %q",
+		e.Name, e.Field, e.Expected, e.Got)
+}
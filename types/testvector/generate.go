@@ -0,0 +1,174 @@
+package testvector
+
+import (
+	"bytes"
+	"encoding/hex"
+	"time"
+
+	"github.com/pactus-project/pactus/crypto"
+	"github.com/pactus-project/pactus/crypto/bls"
+	"github.com/pactus-project/pactus/crypto/hash"
+	"github.com/pactus-project/pactus/sortition"
+	"github.com/pactus-project/pactus/types/block"
+	"github.com/pactus-project/pactus/types/certificate"
+	"github.com/pactus-project/pactus/types/tx"
+	"github.com/pactus-project/pactus/types/vote"
+)
+
+// generator builds the test vectors from the deterministic keys,
+// so the same vectors are generated on every run.
+type generator struct {
+	suite *Suite
+	keys  []*bls.PrivateKey
+}
+
+// Generate generates the canonical test vectors of the serialized types.
+func Generate() (*Suite, error) {
+	gen := &generator{
+		suite: &Suite{
+			Version: VersionLatest,
+			Vectors: make([]*Vector, 0),
+		},
+	}
+	for i := 1; i <= 3; i++ {
+		// The input keying material should be at least 32 bytes.
+		prv, err := bls.KeyGen(bytes.Repeat([]byte{byte(i)}, 32), nil)
+		if err != nil {
+			return nil, err
+		}
+		gen.keys = append(gen.keys, prv)
+	}
+
+	gen.addresses()
+	txs := gen.transactions()
+	cert := gen.certificate()
+	if err := gen.block(txs, cert); err != nil {
+		return nil, err
+	}
+	gen.votes(cert)
+
+	// Vectors are generated by the reference implementation, so they should always be valid.
+	for _, v := range gen.suite.Vectors {
+		if err := verifyVector(v); err != nil {
+			return nil, err
+		}
+	}
+
+	return gen.suite, nil
+}
+
+func (g *generator) add(typ, name string, data []byte, fields map[string]string) {
+	g.suite.Vectors = append(g.suite.Vectors, &Vector{
+		Type:   typ,
+		Name:   name,
+		Hex:    hex.EncodeToString(data),
+		Fields: fields,
+	})
+}
+
+func (g *generator) pub(index int) *bls.PublicKey {
+	return g.keys[index].PublicKeyNative()
+}
+
+func (g *generator) addresses() {
+	add := func(name string, addr crypto.Address) {
+		g.add(TypeAddress, name, encodeAddress(addr), describeAddress(addr))
+	}
+
+	add("treasury", crypto.TreasuryAddress)
+	add("validator", g.pub(0).ValidatorAddress())
+	add("bls_account", g.pub(0).AccountAddress())
+}
+
+func (g *generator) transactions() block.Txs {
+	accAddr := g.pub(0).AccountAddress()
+	valAddr := g.pub(1).ValidatorAddress()
+	seed := g.seed()
+	proof, _ := sortition.ProofFromBytes(g.keys[1].SignNative(seed[:]).Bytes())
+
+	trxs := []struct {
+		name   string
+		trx    *tx.Tx
+		signer int
+	}{
+		{"subsidy", tx.NewSubsidyTx(1, accAddr, 1_000_000_000, ""), -1},
+		{"transfer", tx.NewTransferTx(1, accAddr, g.pub(2).AccountAddress(),
+			1_000_000_000_000, 1_000_000, "transfer"), 0},
+		{"bond", tx.NewBondTx(1, accAddr, valAddr, g.pub(1),
+			10_000_000_000_000, 1_000_000, "bond"), 0},
+		{"unbond", tx.NewUnbondTx(1, valAddr, "unbond"), 1},
+		{"withdraw", tx.NewWithdrawTx(1, valAddr, accAddr,
+			5_000_000_000_000, 1_000_000, "withdraw"), 1},
+		{"sortition", tx.NewSortitionTx(1, valAddr, proof), 1},
+	}
+
+	txs := block.Txs{}
+	for _, t := range trxs {
+		if t.signer >= 0 {
+			t.trx.SetSignature(g.keys[t.signer].Sign(t.trx.SignBytes()))
+			t.trx.SetPublicKey(g.pub(t.signer))
+		}
+		bs, _ := t.trx.Bytes()
+		g.add(TypeTransaction, t.name, bs, describeTransaction(t.trx))
+
+		txs = append(txs, t.trx)
+	}
+
+	return txs
+}
+
+func (g *generator) certificate() *certificate.Certificate {
+	blockHash := hash.CalcHash([]byte("block"))
+	signBytes := certificate.BlockCertificateSignBytes(blockHash, 100, 0)
+	sig := bls.SignatureAggregate(
+		g.keys[0].SignNative(signBytes),
+		g.keys[1].SignNative(signBytes))
+	cert := certificate.NewCertificate(100, 0, []int32{0, 1, 2}, []int32{2}, sig)
+
+	bs, _ := encodeCertificate(cert)
+	g.add(TypeCertificate, "block_certificate", bs, describeCertificate(cert))
+
+	return cert
+}
+
+func (g *generator) block(txs block.Txs, prevCert *certificate.Certificate) error {
+	blk := block.MakeBlock(1, time.Unix(1_700_000_000, 0), txs,
+		hash.CalcHash([]byte("prev_block")), hash.CalcHash([]byte("state_root")),
+		prevCert, g.seed(), g.pub(0).ValidatorAddress())
+
+	bs, err := blk.Bytes()
+	if err != nil {
+		return err
+	}
+	g.add(TypeBlock, "block", bs, describeBlock(blk))
+
+	return nil
+}
+
+func (g *generator) votes(cert *certificate.Certificate) {
+	blockHash := hash.CalcHash([]byte("block"))
+	signer := g.pub(0).ValidatorAddress()
+
+	votes := []struct {
+		name string
+		v    *vote.Vote
+	}{
+		{"prepare", vote.NewPrepareVote(blockHash, 101, 0, signer)},
+		{"precommit", vote.NewPrecommitVote(blockHash, 101, 0, signer)},
+		{"cp_pre_vote", vote.NewCPPreVote(hash.UndefHash, 101, 0, 0, vote.CPValueOne,
+			&vote.JustInitOne{}, signer)},
+		{"cp_main_vote", vote.NewCPMainVote(hash.UndefHash, 101, 0, 0, vote.CPValueOne,
+			&vote.JustMainVoteNoConflict{QCert: cert}, signer)},
+	}
+
+	for _, t := range votes {
+		t.v.SetSignature(g.keys[0].SignNative(t.v.SignBytes()))
+		bs, _ := t.v.MarshalCBOR()
+		g.add(TypeVote, t.name, bs, describeVote(t.v))
+	}
+}
+
+// seed returns the sortition seed that is generated from the first key.
+func (g *generator) seed() sortition.VerifiableSeed {
+	return sortition.UndefVerifiableSeed.GenerateNext(g.keys[0])
+}
@@ -0,0 +1,74 @@
+// Package testvector generates and verifies the canonical test vectors of the serialized types.
+// The test vectors help the SDKs in other languages to check their implementation
+// against the reference implementation.
+package testvector
+
+import (
+	"encoding/json"
+	"os"
+
+	"github.com/pactus-project/pactus/util"
+)
+
+// VersionLatest is the version of the test vectors that are generated by this package.
+// It should be increased whenever the canonical encoding of any type changes.
+const VersionLatest = 1
+
+// The types of the test vectors.
+const (
+	TypeAddress     = "address"
+	TypeTransaction = "transaction"
+	TypeBlock       = "block"
+	TypeCertificate = "certificate"
+	TypeVote        = "vote"
+)
+
+// Vector is a single test vector.
+// The fields are the expected values of the decoded data, formatted as strings,
+// so they can be compared in any language without losing the precision.
+type Vector struct {
+	Type   string            `json:"type"`
+	Name   string            `json:"name"`
+	Hex    string            `json:"hex"`
+	Fields map[string]string `json:"fields"`
+}
+
+// Suite is a versioned set of test vectors.
+type Suite struct {
+	Version int       `json:"version"`
+	Vectors []*Vector `json:"vectors"`
+}
+
+// LoadFromFile loads the test vectors from a JSON file.
+func LoadFromFile(file string) (*Suite, error) {
+	dat, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	var suite Suite
+	if err := json.Unmarshal(dat, &suite); err != nil {
+		return nil, err
+	}
+
+	return &suite, nil
+}
+
+// SaveToFile saves the test vectors into a JSON file.
+func (s *Suite) SaveToFile(file string) error {
+	j, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return util.WriteFile(file, j)
+}
+
+// Count returns the number of test vectors for each type.
+func (s *Suite) Count() map[string]int {
+	count := make(map[string]int)
+	for _, v := range s.Vectors {
+		count[v.Type]++
+	}
+
+	return count
+}
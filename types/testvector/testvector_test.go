@@ -0,0 +1,103 @@
+package testvector
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerate(t *testing.T) {
+	suite1, err := Generate()
+	require.NoError(t, err)
+
+	suite2, err := Generate()
+	require.NoError(t, err)
+
+	assert.Equal(t, VersionLatest, suite1.Version)
+	assert.Equal(t, suite1, suite2, "test vectors should be deterministic")
+
+	count := suite1.Count()
+	assert.Equal(t, 3, count[TypeAddress])
+	assert.Equal(t, 6, count[TypeTransaction])
+	assert.Equal(t, 1, count[TypeBlock])
+	assert.Equal(t, 1, count[TypeCertificate])
+	assert.Equal(t, 4, count[TypeVote])
+
+	assert.Empty(t, Verify(suite1))
+}
+
+func TestSaveAndLoad(t *testing.T) {
+	suite, err := Generate()
+	require.NoError(t, err)
+
+	file := filepath.Join(t.TempDir(), "vectors.json")
+	require.NoError(t, suite.SaveToFile(file))
+
+	loaded, err := LoadFromFile(file)
+	require.NoError(t, err)
+	assert.Equal(t, suite, loaded)
+	assert.Empty(t, Verify(loaded))
+}
+
+func TestVerify(t *testing.T) {
+	t.Run("Unsupported version", func(t *testing.T) {
+		suite, _ := Generate()
+		suite.Version = VersionLatest + 1
+
+		errs := Verify(suite)
+		require.Len(t, errs, 1)
+		assert.ErrorIs(t, errs[0], UnsupportedVersionError{Version: VersionLatest + 1})
+	})
+
+	t.Run("Unknown type", func(t *testing.T) {
+		suite, _ := Generate()
+		suite.Vectors[0].Type = "unknown"
+
+		errs := Verify(suite)
+		require.Len(t, errs, 1)
+		assert.ErrorIs(t, errs[0], UnknownTypeError{Type: "unknown"})
+	})
+
+	t.Run("Invalid hex", func(t *testing.T) {
+		suite, _ := Generate()
+		suite.Vectors[0].Hex = "zz"
+
+		errs := Verify(suite)
+		assert.Len(t, errs, 1)
+	})
+
+	t.Run("Malformed data", func(t *testing.T) {
+		suite, _ := Generate()
+		for _, v := range suite.Vectors {
+			v.Hex = v.Hex[:len(v.Hex)/2]
+		}
+
+		errs := Verify(suite)
+		assert.Len(t, errs, len(suite.Vectors))
+	})
+
+	t.Run("Field mismatch", func(t *testing.T) {
+		suite, _ := Generate()
+		for _, v := range suite.Vectors {
+			if v.Type == TypeTransaction && v.Name == "transfer" {
+				v.Fields["fee"] = "1"
+			}
+		}
+
+		errs := Verify(suite)
+		require.Len(t, errs, 1)
+		assert.ErrorIs(t, errs[0], MismatchError{
+			Name: "transfer", Field: "fee", Expected: "1", Got: "1000000",
+		})
+	})
+
+	t.Run("Missing field", func(t *testing.T) {
+		suite, _ := Generate()
+		delete(suite.Vectors[0].Fields, "string")
+
+		errs := Verify(suite)
+		assert.Len(t, errs, 1)
+	})
+}
@@ -0,0 +1,64 @@
+package testvector
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
+)
+
+// Verify checks that every test vector in the suite is decoded to the expected fields
+// and is encoded back to the same bytes.
+// It returns the errors of all the failed test vectors.
+func Verify(suite *Suite) []error {
+	if suite.Version != VersionLatest {
+		return []error{UnsupportedVersionError{Version: suite.Version}}
+	}
+
+	errs := make([]error, 0)
+	for _, v := range suite.Vectors {
+		if err := verifyVector(v); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errs
+}
+
+func verifyVector(v *Vector) error {
+	decode, ok := codecs[v.Type]
+	if !ok {
+		return UnknownTypeError{Type: v.Type}
+	}
+
+	data, err := hex.DecodeString(v.Hex)
+	if err != nil {
+		return fmt.Errorf("test vector %s: %w", v.Name, err)
+	}
+
+	fields, encoded, err := decode(data)
+	if err != nil {
+		return fmt.Errorf("test vector %s: %w", v.Name, err)
+	}
+
+	if got := hex.EncodeToString(encoded); got != v.Hex {
+		return MismatchError{Name: v.Name, Field: "hex", Expected: v.Hex, Got: got}
+	}
+
+	// Iterate over the sorted keys to report the mismatches in the same order.
+	keys := maps.Keys(v.Fields)
+	slices.Sort(keys)
+	for _, key := range keys {
+		if got := fields[key]; got != v.Fields[key] {
+			return MismatchError{Name: v.Name, Field: key, Expected: v.Fields[key], Got: got}
+		}
+	}
+
+	if len(fields) != len(v.Fields) {
+		return fmt.Errorf("test vector %s: expected %d fields, got %d",
+			v.Name, len(v.Fields), len(fields))
+	}
+
+	return nil
+}
@@ -1,6 +1,9 @@
 package tx
 
-import "github.com/pactus-project/pactus/types/tx/payload"
+import (
+	"github.com/pactus-project/pactus/types/tx/payload"
+	"github.com/pactus-project/pactus/util/errors"
+)
 
 // BasicCheckError is returned when the basic check on the transaction fails.
 type BasicCheckError struct {
@@ -11,6 +14,10 @@ func (e BasicCheckError) Error() string {
 	return e.Reason
 }
 
+func (e BasicCheckError) Code() int {
+	return errors.ErrInvalidTx
+}
+
 // InvalidPayloadTypeError is returned when the payload type is not valid.
 type InvalidPayloadTypeError struct {
 	PayloadType payload.Type
@@ -19,3 +26,7 @@ type InvalidPayloadTypeError struct {
 func (e InvalidPayloadTypeError) Error() string {
 	return "invalid payload type: " + e.PayloadType.String()
 }
+
+func (e InvalidPayloadTypeError) Code() int {
+	return errors.ErrUnknownPayloadType
+}
@@ -4,7 +4,9 @@ import (
 	"github.com/pactus-project/pactus/crypto"
 	"github.com/pactus-project/pactus/crypto/bls"
 	"github.com/pactus-project/pactus/sortition"
+	"github.com/pactus-project/pactus/types/account"
 	"github.com/pactus-project/pactus/types/tx/payload"
+	"github.com/pactus-project/pactus/types/validator"
 )
 
 func NewSubsidyTx(lockTime uint32,
@@ -47,6 +49,26 @@ func NewBondTx(lockTime uint32,
 	return newTx(lockTime, pld, fee, memo)
 }
 
+// NewDelegateTx creates a transaction that bonds the stake of the delegator to the validator of an operator.
+// The operator signature is the approval of the operator, signed by the validator key.
+func NewDelegateTx(lockTime uint32,
+	delegator, validator crypto.Address,
+	pubKey *bls.PublicKey, pop *bls.Signature,
+	stake int64, operatorSig *bls.Signature,
+	fee int64, memo string,
+) *Tx {
+	pld := &payload.DelegatePayload{
+		From:              delegator,
+		To:                validator,
+		PublicKey:         pubKey,
+		ProofOfPossession: pop,
+		Stake:             stake,
+		OperatorSignature: operatorSig,
+	}
+
+	return newTx(lockTime, pld, fee, memo)
+}
+
 func NewUnbondTx(lockTime uint32,
 	val crypto.Address,
 	memo string,
@@ -58,6 +80,20 @@ func NewUnbondTx(lockTime uint32,
 	return newTx(lockTime, pld, 0, memo)
 }
 
+func NewRewardSplitTx(lockTime uint32,
+	val crypto.Address,
+	split *validator.RewardSplit,
+	fee int64,
+	memo string,
+) *Tx {
+	pld := &payload.RewardSplitPayload{
+		Validator: val,
+		Split:     *split,
+	}
+
+	return newTx(lockTime, pld, fee, memo)
+}
+
 func NewWithdrawTx(lockTime uint32,
 	val crypto.Address,
 	acc crypto.Address,
@@ -84,3 +120,77 @@ func NewSortitionTx(lockTime uint32,
 
 	return newTx(lockTime, pld, 0, "")
 }
+
+func NewEscrowTx(lockTime uint32,
+	buyer, seller, arbiter crypto.Address, nonce uint32,
+	sender, receiver crypto.Address,
+	approverPubKey *bls.PublicKey, approverSig *bls.Signature,
+	amount, fee int64, memo string,
+) *Tx {
+	pld := &payload.EscrowPayload{
+		Buyer:             buyer,
+		Seller:            seller,
+		Arbiter:           arbiter,
+		Nonce:             nonce,
+		From:              sender,
+		To:                receiver,
+		Amount:            amount,
+		ApproverPublicKey: approverPubKey,
+		ApproverSignature: approverSig,
+	}
+
+	return newTx(lockTime, pld, fee, memo)
+}
+
+func NewDataTx(lockTime uint32,
+	sender crypto.Address,
+	data []byte,
+	fee int64, memo string,
+) *Tx {
+	pld := &payload.DataPayload{
+		From: sender,
+		Data: data,
+	}
+
+	return newTx(lockTime, pld, fee, memo)
+}
+
+func NewAllowlistTx(lockTime uint32,
+	governor, validator crypto.Address,
+	allow bool,
+	fee int64, memo string,
+) *Tx {
+	pld := &payload.AllowlistPayload{
+		Governor:  governor,
+		Validator: validator,
+		Allow:     allow,
+	}
+
+	return newTx(lockTime, pld, fee, memo)
+}
+
+// NewConditionTx creates a transaction that spends from an account with spending conditions.
+// The approvals of the cosigners should be added to the payload before signing the transaction.
+func NewConditionTx(lockTime uint32,
+	sender, receiver crypto.Address,
+	amount int64, cond *account.Condition,
+	fee int64, memo string,
+) *Tx {
+	pld := &payload.ConditionPayload{
+		From:      sender,
+		To:        receiver,
+		Amount:    amount,
+		Condition: cond,
+	}
+
+	return newTx(lockTime, pld, fee, memo)
+}
+
+// NewCustomTx creates a transaction with a custom payload.
+// The payload type should be registered by `payload.Register`.
+func NewCustomTx(lockTime uint32,
+	pld payload.Payload,
+	fee int64, memo string,
+) *Tx {
+	return newTx(lockTime, pld, fee, memo)
+}
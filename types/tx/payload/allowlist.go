@@ -0,0 +1,88 @@
+package payload
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/pactus-project/pactus/crypto"
+	"github.com/pactus-project/pactus/util/encoding"
+)
+
+// AllowlistPayload adds a validator to, or removes it from, the allowlist of a permissioned network.
+// Only the governors, defined in the genesis, can sign the allowlist transactions.
+type AllowlistPayload struct {
+	Governor  crypto.Address // the governor account that signs and pays the fee
+	Validator crypto.Address
+	Allow     bool
+}
+
+func (p *AllowlistPayload) Type() Type {
+	return TypeAllowlist
+}
+
+func (p *AllowlistPayload) Signer() crypto.Address {
+	return p.Governor
+}
+
+func (p *AllowlistPayload) Value() int64 {
+	return 0
+}
+
+func (p *AllowlistPayload) BasicCheck() error {
+	if !p.Governor.IsAccountAddress() {
+		return BasicCheckError{
+			Reason: "governor is not an account address: " + p.Governor.String(),
+		}
+	}
+
+	if !p.Validator.IsValidatorAddress() {
+		return BasicCheckError{
+			Reason: "address is not a validator address: " + p.Validator.String(),
+		}
+	}
+
+	return nil
+}
+
+func (p *AllowlistPayload) SerializeSize() int {
+	return 43
+}
+
+func (p *AllowlistPayload) Encode(w io.Writer) error {
+	err := p.Governor.Encode(w)
+	if err != nil {
+		return err
+	}
+
+	err = p.Validator.Encode(w)
+	if err != nil {
+		return err
+	}
+
+	return encoding.WriteElement(w, p.Allow)
+}
+
+func (p *AllowlistPayload) Decode(r io.Reader) error {
+	err := p.Governor.Decode(r)
+	if err != nil {
+		return err
+	}
+
+	err = p.Validator.Decode(r)
+	if err != nil {
+		return err
+	}
+
+	return encoding.ReadElement(r, &p.Allow)
+}
+
+func (p *AllowlistPayload) String() string {
+	return fmt.Sprintf("{Allowlist 🛂 %v %v %v}",
+		p.Governor.ShortString(),
+		p.Validator.ShortString(),
+		p.Allow)
+}
+
+func (p *AllowlistPayload) Receiver() *crypto.Address {
+	return nil
+}
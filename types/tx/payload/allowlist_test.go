@@ -0,0 +1,67 @@
+package payload
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/pactus-project/pactus/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAllowlistType(t *testing.T) {
+	pld := AllowlistPayload{}
+	assert.Equal(t, pld.Type(), TypeAllowlist)
+	assert.Equal(t, "allowlist", TypeAllowlist.String())
+}
+
+func TestAllowlistEncoding(t *testing.T) {
+	pub, _ := randBLSKeyPair(t)
+	pld := &AllowlistPayload{
+		Governor:  pub.AccountAddress(),
+		Validator: pub.ValidatorAddress(),
+		Allow:     true,
+	}
+	require.NoError(t, pld.BasicCheck())
+
+	w := new(bytes.Buffer)
+	require.NoError(t, pld.Encode(w))
+	assert.Equal(t, pld.SerializeSize(), w.Len())
+	bs := w.Bytes()
+
+	pld2 := new(AllowlistPayload)
+	require.NoError(t, pld2.Decode(bytes.NewReader(bs)))
+	assert.Equal(t, pld, pld2)
+	assert.Nil(t, pld2.Receiver())
+	assert.Zero(t, pld2.Value())
+
+	t.Run("Short data", func(t *testing.T) {
+		err := new(AllowlistPayload).Decode(bytes.NewReader(bs[:42]))
+		assert.ErrorIs(t, err, io.EOF)
+	})
+}
+
+func TestAllowlistBasicCheck(t *testing.T) {
+	pub, _ := randBLSKeyPair(t)
+
+	t.Run("Invalid governor", func(t *testing.T) {
+		pld := &AllowlistPayload{
+			Governor:  pub.ValidatorAddress(),
+			Validator: pub.ValidatorAddress(),
+		}
+		assert.ErrorIs(t, pld.BasicCheck(), BasicCheckError{
+			Reason: "governor is not an account address: " + pub.ValidatorAddress().String(),
+		})
+	})
+
+	t.Run("Invalid validator", func(t *testing.T) {
+		pld := &AllowlistPayload{
+			Governor:  pub.AccountAddress(),
+			Validator: crypto.TreasuryAddress,
+		}
+		assert.ErrorIs(t, pld.BasicCheck(), BasicCheckError{
+			Reason: "address is not a validator address: " + crypto.TreasuryAddress.String(),
+		})
+	})
+}
@@ -9,11 +9,18 @@ import (
 	"github.com/pactus-project/pactus/util/encoding"
 )
 
+// popKeySize is the size of the public key field when it is followed by the proof of possession.
+// The legacy bond payloads have only the public key, so they can be decoded as before.
+const popKeySize = bls.PublicKeySize + bls.SignatureSize
+
 type BondPayload struct {
 	From      crypto.Address
 	To        crypto.Address
 	PublicKey *bls.PublicKey
-	Stake     int64
+	// ProofOfPossession proves that the owner of the public key has its private key.
+	// It is set along with the public key, when the validator is new.
+	ProofOfPossession *bls.Signature
+	Stake             int64
 }
 
 func (p *BondPayload) Type() Type {
@@ -45,12 +52,19 @@ func (p *BondPayload) BasicCheck() error {
 		if err := p.PublicKey.VerifyAddress(p.To); err != nil {
 			return err
 		}
+	} else if p.ProofOfPossession != nil {
+		return BasicCheckError{
+			Reason: "proof of possession is set without the public key",
+		}
 	}
 
 	return nil
 }
 
 func (p *BondPayload) SerializeSize() int {
+	if p.PublicKey != nil && p.ProofOfPossession != nil {
+		return 188 + encoding.VarIntSerializeSize(uint64(p.Stake))
+	}
 	if p.PublicKey != nil {
 		return 139 + encoding.VarIntSerializeSize(uint64(p.Stake))
 	}
@@ -69,7 +83,20 @@ func (p *BondPayload) Encode(w io.Writer) error {
 		return err
 	}
 
-	if p.PublicKey != nil {
+	if p.PublicKey != nil && p.ProofOfPossession != nil {
+		err := encoding.WriteVarInt(w, uint64(popKeySize))
+		if err != nil {
+			return err
+		}
+		err = p.PublicKey.Encode(w)
+		if err != nil {
+			return err
+		}
+		err = p.ProofOfPossession.Encode(w)
+		if err != nil {
+			return err
+		}
+	} else if p.PublicKey != nil {
 		err := encoding.WriteElements(w, uint8(bls.PublicKeySize))
 		if err != nil {
 			return err
@@ -103,7 +130,7 @@ func (p *BondPayload) Decode(r io.Reader) error {
 	if err != nil {
 		return err
 	}
-	if pubKeySize == bls.PublicKeySize {
+	if pubKeySize == bls.PublicKeySize || pubKeySize == popKeySize {
 		p.PublicKey = new(bls.PublicKey)
 		err = p.PublicKey.Decode(r)
 		if err != nil {
@@ -112,6 +139,13 @@ func (p *BondPayload) Decode(r io.Reader) error {
 	} else if pubKeySize != 0 {
 		return ErrInvalidPublicKeySize
 	}
+	if pubKeySize == popKeySize {
+		p.ProofOfPossession = new(bls.Signature)
+		err = p.ProofOfPossession.Decode(r)
+		if err != nil {
+			return err
+		}
+	}
 
 	stake, err := encoding.ReadVarInt(r)
 	if err != nil {
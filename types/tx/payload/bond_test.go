@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/pactus-project/pactus/crypto"
+	"github.com/pactus-project/pactus/crypto/bls"
 	"github.com/pactus-project/pactus/util"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -268,3 +269,37 @@ func TestBondDecoding(t *testing.T) {
 		}
 	}
 }
+
+func TestBondProofOfPossession(t *testing.T) {
+	prv, err := bls.KeyGen(make([]byte, 32), nil)
+	require.NoError(t, err)
+	pub := prv.PublicKeyNative()
+
+	pld := BondPayload{
+		From:              crypto.NewAddress(crypto.AddressTypeBLSAccount, make([]byte, 20)),
+		To:                pub.ValidatorAddress(),
+		PublicKey:         pub,
+		ProofOfPossession: prv.ProofOfPossession(),
+		Stake:             0x200000,
+	}
+	require.NoError(t, pld.BasicCheck())
+
+	w := util.NewFixedWriter(pld.SerializeSize())
+	require.NoError(t, pld.Encode(w))
+	assert.Equal(t, pld.SerializeSize(), len(w.Bytes()))
+	assert.Equal(t, []byte{0x90, 0x01}, w.Bytes()[42:44], "public key size")
+
+	decoded := BondPayload{}
+	require.NoError(t, decoded.Decode(util.NewFixedReader(len(w.Bytes()), w.Bytes())))
+	assert.True(t, pld.PublicKey.EqualsTo(decoded.PublicKey))
+	assert.True(t, pld.ProofOfPossession.EqualsTo(decoded.ProofOfPossession))
+	assert.Equal(t, pld.Stake, decoded.Stake)
+
+	t.Run("Proof of possession without public key", func(t *testing.T) {
+		pld.PublicKey = nil
+
+		assert.ErrorIs(t, pld.BasicCheck(), BasicCheckError{
+			Reason: "proof of possession is set without the public key",
+		})
+	})
+}
@@ -0,0 +1,226 @@
+package payload
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+
+	"github.com/pactus-project/pactus/crypto"
+	"github.com/pactus-project/pactus/crypto/bls"
+	"github.com/pactus-project/pactus/types/account"
+	"github.com/pactus-project/pactus/util/encoding"
+)
+
+const conditionApprovalPrefix = "condition-approval"
+
+// Approval is the consent of a cosigner to a conditional transaction.
+type Approval struct {
+	PublicKey *bls.PublicKey
+	Signature *bls.Signature
+}
+
+// ConditionPayload spends from an account that has spending conditions,
+// and optionally replaces the conditions of the account.
+// The transactions of an account that requires cosigners can only be
+// made by this payload, because the approvals are carried by it.
+type ConditionPayload struct {
+	From      crypto.Address     // the account that signs the transaction
+	To        crypto.Address     // the receiver, it can be the sender itself
+	Amount    int64              // the amount to transfer, it can be zero
+	Condition *account.Condition // the new condition of the sender, nil keeps the current one
+	Approvals []Approval
+}
+
+func (p *ConditionPayload) Type() Type {
+	return TypeCondition
+}
+
+func (p *ConditionPayload) Signer() crypto.Address {
+	return p.From
+}
+
+func (p *ConditionPayload) Value() int64 {
+	return p.Amount
+}
+
+// ApprovalSignBytes returns the bytes that the cosigners should sign
+// to approve the transaction.
+// The chain ID is always included, since the condition transactions are accepted
+// after the sign domain is active.
+func (p *ConditionPayload) ApprovalSignBytes(lockTime uint32) []byte {
+	w := bytes.NewBufferString(conditionApprovalPrefix)
+	_ = encoding.WriteElements(w, p.From, p.To, lockTime)
+	_ = encoding.WriteVarInt(w, uint64(p.Amount))
+	_ = p.encodeCondition(w)
+
+	return crypto.CurrentSignDomain().Wrap(w.Bytes())
+}
+
+func (p *ConditionPayload) BasicCheck() error {
+	if !p.From.IsAccountAddress() {
+		return BasicCheckError{
+			Reason: "sender is not an account address: " + p.From.String(),
+		}
+	}
+	if !p.To.IsAccountAddress() {
+		return BasicCheckError{
+			Reason: "receiver is not an account address: " + p.To.String(),
+		}
+	}
+	if p.Amount < 0 {
+		return BasicCheckError{
+			Reason: "amount can't be negative",
+		}
+	}
+	if p.Condition != nil {
+		if err := p.Condition.BasicCheck(); err != nil {
+			return BasicCheckError{
+				Reason: err.Error(),
+			}
+		}
+	}
+	if len(p.Approvals) > account.MaxCosigners {
+		return BasicCheckError{
+			Reason: fmt.Sprintf("too many approvals: %v", len(p.Approvals)),
+		}
+	}
+	for i, approval := range p.Approvals {
+		if approval.PublicKey == nil || approval.Signature == nil {
+			return BasicCheckError{
+				Reason: "approval is not set",
+			}
+		}
+		for _, other := range p.Approvals[:i] {
+			if approval.PublicKey.EqualsTo(other.PublicKey) {
+				return BasicCheckError{
+					Reason: "duplicated approval: " + approval.PublicKey.AccountAddress().String(),
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+func (p *ConditionPayload) SerializeSize() int {
+	size := p.From.SerializeSize() + p.To.SerializeSize() +
+		encoding.VarIntSerializeSize(uint64(p.Amount)) +
+		1 + // condition flag
+		1 + // number of approvals
+		len(p.Approvals)*(bls.PublicKeySize+bls.SignatureSize)
+	if p.Condition != nil {
+		size += p.Condition.SerializeSize()
+	}
+
+	return size
+}
+
+func (p *ConditionPayload) encodeCondition(w io.Writer) error {
+	if p.Condition == nil {
+		return encoding.WriteElement(w, false)
+	}
+
+	err := encoding.WriteElement(w, true)
+	if err != nil {
+		return err
+	}
+
+	return p.Condition.Encode(w)
+}
+
+func (p *ConditionPayload) Encode(w io.Writer) error {
+	err := encoding.WriteElements(w, p.From, p.To)
+	if err != nil {
+		return err
+	}
+
+	err = encoding.WriteVarInt(w, uint64(p.Amount))
+	if err != nil {
+		return err
+	}
+
+	err = p.encodeCondition(w)
+	if err != nil {
+		return err
+	}
+
+	err = encoding.WriteElement(w, uint8(len(p.Approvals)))
+	if err != nil {
+		return err
+	}
+
+	for _, approval := range p.Approvals {
+		err = approval.PublicKey.Encode(w)
+		if err != nil {
+			return err
+		}
+
+		err = approval.Signature.Encode(w)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (p *ConditionPayload) Decode(r io.Reader) error {
+	err := encoding.ReadElements(r, &p.From, &p.To)
+	if err != nil {
+		return err
+	}
+
+	amount, err := encoding.ReadVarInt(r)
+	if err != nil {
+		return err
+	}
+	p.Amount = int64(amount)
+
+	var hasCondition bool
+	err = encoding.ReadElement(r, &hasCondition)
+	if err != nil {
+		return err
+	}
+
+	if hasCondition {
+		p.Condition = new(account.Condition)
+		err = p.Condition.Decode(r)
+		if err != nil {
+			return err
+		}
+	}
+
+	var count uint8
+	err = encoding.ReadElement(r, &count)
+	if err != nil {
+		return err
+	}
+
+	p.Approvals = make([]Approval, count)
+	for i := range p.Approvals {
+		p.Approvals[i].PublicKey = new(bls.PublicKey)
+		err = p.Approvals[i].PublicKey.Decode(r)
+		if err != nil {
+			return err
+		}
+
+		p.Approvals[i].Signature = new(bls.Signature)
+		err = p.Approvals[i].Signature.Decode(r)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (p *ConditionPayload) String() string {
+	return fmt.Sprintf("{Condition 🔏 %v->%v %v}",
+		p.From.ShortString(),
+		p.To.ShortString(),
+		p.Amount)
+}
+
+func (p *ConditionPayload) Receiver() *crypto.Address {
+	return &p.To
+}
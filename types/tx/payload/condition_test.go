@@ -0,0 +1,140 @@
+package payload
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/pactus-project/pactus/crypto"
+	"github.com/pactus-project/pactus/crypto/bls"
+	"github.com/pactus-project/pactus/types/account"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConditionType(t *testing.T) {
+	pld := ConditionPayload{}
+	assert.Equal(t, pld.Type(), TypeCondition)
+	assert.Equal(t, "condition", TypeCondition.String())
+}
+
+func TestConditionEncoding(t *testing.T) {
+	pub1, prv1 := randBLSKeyPair(t)
+	pub2, prv2 := randBLSKeyPair(t)
+	pub3, _ := randBLSKeyPair(t)
+
+	tests := []*ConditionPayload{
+		{
+			From:   pub1.AccountAddress(),
+			To:     pub3.AccountAddress(),
+			Amount: 1e9,
+		},
+		{
+			From:   pub1.AccountAddress(),
+			To:     pub1.AccountAddress(),
+			Amount: 0,
+			Condition: &account.Condition{
+				UnlockHeight: 1000,
+				MaxAmount:    1e9,
+				Threshold:    2,
+				Cosigners:    []crypto.Address{pub1.AccountAddress(), pub2.AccountAddress()},
+			},
+		},
+	}
+
+	for _, pld := range tests {
+		for _, prv := range []*bls.PrivateKey{prv1, prv2} {
+			pld.Approvals = append(pld.Approvals, Approval{
+				PublicKey: prv.PublicKeyNative(),
+				Signature: prv.SignNative(pld.ApprovalSignBytes(1)),
+			})
+		}
+		require.NoError(t, pld.BasicCheck())
+
+		w := new(bytes.Buffer)
+		require.NoError(t, pld.Encode(w))
+		assert.Equal(t, pld.SerializeSize(), w.Len())
+		bs := w.Bytes()
+
+		pld2 := new(ConditionPayload)
+		require.NoError(t, pld2.Decode(bytes.NewReader(bs)))
+		w2 := new(bytes.Buffer)
+		require.NoError(t, pld2.Encode(w2))
+		assert.Equal(t, bs, w2.Bytes())
+		assert.Equal(t, pld.Condition, pld2.Condition)
+		assert.Equal(t, pld.To, *pld2.Receiver())
+		assert.Equal(t, pld.Amount, pld2.Value())
+
+		err := new(ConditionPayload).Decode(bytes.NewReader(bs[:len(bs)-1]))
+		assert.Error(t, err)
+	}
+}
+
+func TestConditionBasicCheck(t *testing.T) {
+	pub, prv := randBLSKeyPair(t)
+
+	t.Run("Invalid sender", func(t *testing.T) {
+		pld := &ConditionPayload{
+			From: pub.ValidatorAddress(),
+			To:   pub.AccountAddress(),
+		}
+		assert.ErrorIs(t, pld.BasicCheck(), BasicCheckError{
+			Reason: "sender is not an account address: " + pub.ValidatorAddress().String(),
+		})
+	})
+
+	t.Run("Invalid receiver", func(t *testing.T) {
+		pld := &ConditionPayload{
+			From: pub.AccountAddress(),
+			To:   pub.ValidatorAddress(),
+		}
+		assert.ErrorIs(t, pld.BasicCheck(), BasicCheckError{
+			Reason: "receiver is not an account address: " + pub.ValidatorAddress().String(),
+		})
+	})
+
+	t.Run("Invalid condition", func(t *testing.T) {
+		pld := &ConditionPayload{
+			From:      pub.AccountAddress(),
+			To:        pub.AccountAddress(),
+			Condition: &account.Condition{Threshold: 1},
+		}
+		assert.ErrorIs(t, pld.BasicCheck(), BasicCheckError{
+			Reason: "threshold 1 is more than the number of cosigners",
+		})
+	})
+
+	t.Run("Duplicated approval", func(t *testing.T) {
+		approval := Approval{
+			PublicKey: pub,
+			Signature: prv.SignNative([]byte("approval")),
+		}
+		pld := &ConditionPayload{
+			From:      pub.AccountAddress(),
+			To:        pub.AccountAddress(),
+			Approvals: []Approval{approval, approval},
+		}
+		assert.ErrorIs(t, pld.BasicCheck(), BasicCheckError{
+			Reason: "duplicated approval: " + pub.AccountAddress().String(),
+		})
+	})
+}
+
+func TestConditionApprovalSignDomain(t *testing.T) {
+	current := crypto.CurrentSignDomain()
+	t.Cleanup(func() { crypto.SetSignDomain(current) })
+
+	pub1, _ := randBLSKeyPair(t)
+	pub2, _ := randBLSKeyPair(t)
+	pld := &ConditionPayload{
+		From:   pub1.AccountAddress(),
+		To:     pub2.AccountAddress(),
+		Amount: 1e9,
+	}
+
+	crypto.SetSignDomain(crypto.SignDomain{ChainID: "net1"})
+	bs1 := pld.ApprovalSignBytes(1)
+	crypto.SetSignDomain(crypto.SignDomain{ChainID: "net2"})
+	bs2 := pld.ApprovalSignBytes(1)
+
+	assert.NotEqual(t, bs1, bs2)
+}
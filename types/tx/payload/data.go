@@ -0,0 +1,92 @@
+package payload
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/pactus-project/pactus/crypto"
+	"github.com/pactus-project/pactus/util/encoding"
+)
+
+const (
+	// MaxDataSize is the maximum size of data, in bytes, that a data payload can carry.
+	MaxDataSize = 1024
+
+	// MaxBlockDataSize is the maximum size of data, in bytes,
+	// that all data payloads in a block can carry together.
+	MaxBlockDataSize = 16 * 1024
+)
+
+// DataPayload anchors arbitrary data on the chain without transferring any value.
+// The fee of a data transaction scales with the size of its data.
+type DataPayload struct {
+	From crypto.Address // the account that pays the fee
+	Data []byte
+}
+
+func (p *DataPayload) Type() Type {
+	return TypeData
+}
+
+func (p *DataPayload) Signer() crypto.Address {
+	return p.From
+}
+
+func (p *DataPayload) Value() int64 {
+	return 0
+}
+
+func (p *DataPayload) BasicCheck() error {
+	if !p.From.IsAccountAddress() {
+		return BasicCheckError{
+			Reason: "sender is not an account address: " + p.From.String(),
+		}
+	}
+	if len(p.Data) == 0 {
+		return BasicCheckError{
+			Reason: "data is empty",
+		}
+	}
+	if len(p.Data) > MaxDataSize {
+		return BasicCheckError{
+			Reason: fmt.Sprintf("data size exceeded: %d", len(p.Data)),
+		}
+	}
+
+	return nil
+}
+
+func (p *DataPayload) SerializeSize() int {
+	return p.From.SerializeSize() +
+		encoding.VarBytesSerializeSize(p.Data)
+}
+
+func (p *DataPayload) Encode(w io.Writer) error {
+	err := p.From.Encode(w)
+	if err != nil {
+		return err
+	}
+
+	return encoding.WriteVarBytes(w, p.Data)
+}
+
+func (p *DataPayload) Decode(r io.Reader) error {
+	err := p.From.Decode(r)
+	if err != nil {
+		return err
+	}
+
+	p.Data, err = encoding.ReadVarBytes(r)
+
+	return err
+}
+
+func (p *DataPayload) String() string {
+	return fmt.Sprintf("{Data 📝 %v %d bytes",
+		p.From.ShortString(),
+		len(p.Data))
+}
+
+func (p *DataPayload) Receiver() *crypto.Address {
+	return nil
+}
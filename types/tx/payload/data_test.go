@@ -0,0 +1,81 @@
+package payload
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/pactus-project/pactus/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDataType(t *testing.T) {
+	pld := DataPayload{}
+	assert.Equal(t, pld.Type(), TypeData)
+}
+
+func TestDataEncoding(t *testing.T) {
+	pub, _ := randBLSKeyPair(t)
+	pld := &DataPayload{
+		From: pub.AccountAddress(),
+		Data: []byte("hello pactus"),
+	}
+
+	w := bytes.NewBuffer(nil)
+	require.NoError(t, pld.Encode(w))
+	assert.Equal(t, pld.SerializeSize(), w.Len())
+
+	pld2 := new(DataPayload)
+	require.NoError(t, pld2.Decode(bytes.NewReader(w.Bytes())))
+	assert.Equal(t, pld, pld2)
+	assert.NoError(t, pld2.BasicCheck())
+	assert.Zero(t, pld2.Value())
+	assert.Equal(t, pld.From, pld2.Signer())
+	assert.Nil(t, pld2.Receiver())
+}
+
+func TestDataBasicCheck(t *testing.T) {
+	pub, _ := randBLSKeyPair(t)
+
+	t.Run("Sender is not an account address", func(t *testing.T) {
+		pld := &DataPayload{
+			From: pub.ValidatorAddress(),
+			Data: []byte("data"),
+		}
+
+		assert.ErrorIs(t, pld.BasicCheck(), BasicCheckError{
+			Reason: "sender is not an account address: " + pld.From.String(),
+		})
+	})
+
+	t.Run("Empty data", func(t *testing.T) {
+		pld := &DataPayload{
+			From: pub.AccountAddress(),
+		}
+
+		assert.ErrorIs(t, pld.BasicCheck(), BasicCheckError{
+			Reason: "data is empty",
+		})
+	})
+
+	t.Run("Data size exceeded", func(t *testing.T) {
+		pld := &DataPayload{
+			From: pub.AccountAddress(),
+			Data: []byte(strings.Repeat("a", MaxDataSize+1)),
+		}
+
+		assert.ErrorIs(t, pld.BasicCheck(), BasicCheckError{
+			Reason: "data size exceeded: 1025",
+		})
+	})
+
+	t.Run("Ok", func(t *testing.T) {
+		pld := &DataPayload{
+			From: crypto.TreasuryAddress,
+			Data: []byte(strings.Repeat("a", MaxDataSize)),
+		}
+
+		assert.NoError(t, pld.BasicCheck())
+	})
+}
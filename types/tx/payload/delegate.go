@@ -0,0 +1,188 @@
+package payload
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+
+	"github.com/pactus-project/pactus/crypto"
+	"github.com/pactus-project/pactus/crypto/bls"
+	"github.com/pactus-project/pactus/util/encoding"
+)
+
+const delegateApprovalPrefix = "delegate-approval"
+
+// DelegatePayload bonds the stake of a delegator to the validator of an operator.
+// The delegator signs the transaction and funds the stake, and the operator approves it
+// by signing the approval bytes with the validator key.
+// The stake of a delegated validator can only be withdrawn to the delegator,
+// so the operator runs the validator without being able to take the stake.
+type DelegatePayload struct {
+	From      crypto.Address // the delegator who signs the transaction
+	To        crypto.Address // the validator of the operator
+	PublicKey *bls.PublicKey
+	// ProofOfPossession proves that the owner of the public key has its private key.
+	// It is set along with the public key, when the validator is new.
+	ProofOfPossession *bls.Signature
+	Stake             int64
+	// OperatorSignature is the approval of the operator, signed by the validator key.
+	OperatorSignature *bls.Signature
+}
+
+func (p *DelegatePayload) Type() Type {
+	return TypeDelegate
+}
+
+func (p *DelegatePayload) Signer() crypto.Address {
+	return p.From
+}
+
+func (p *DelegatePayload) Value() int64 {
+	return p.Stake
+}
+
+// ApprovalSignBytes returns the bytes that the operator should sign
+// by the validator key to approve the delegation.
+// The chain ID is always included, since the delegate transactions are accepted
+// after the sign domain is active.
+func (p *DelegatePayload) ApprovalSignBytes(lockTime uint32) []byte {
+	w := bytes.NewBufferString(delegateApprovalPrefix)
+	_ = encoding.WriteElements(w, p.From, p.To, lockTime)
+	_ = encoding.WriteVarInt(w, uint64(p.Stake))
+
+	return crypto.CurrentSignDomain().Wrap(w.Bytes())
+}
+
+func (p *DelegatePayload) BasicCheck() error {
+	if !p.From.IsAccountAddress() || p.From.IsTreasuryAddress() {
+		return BasicCheckError{
+			Reason: "delegator is not an account address: " + p.From.String(),
+		}
+	}
+
+	if !p.To.IsValidatorAddress() {
+		return BasicCheckError{
+			Reason: "receiver is not a validator address: " + p.To.String(),
+		}
+	}
+
+	if p.PublicKey != nil {
+		if err := p.PublicKey.VerifyAddress(p.To); err != nil {
+			return err
+		}
+	} else if p.ProofOfPossession != nil {
+		return BasicCheckError{
+			Reason: "proof of possession is set without the public key",
+		}
+	}
+
+	if p.OperatorSignature == nil {
+		return BasicCheckError{
+			Reason: ErrNoOperatorApproval.Error(),
+		}
+	}
+
+	return nil
+}
+
+func (p *DelegatePayload) pubKeySize() int {
+	if p.PublicKey != nil && p.ProofOfPossession != nil {
+		return popKeySize
+	}
+	if p.PublicKey != nil {
+		return bls.PublicKeySize
+	}
+
+	return 0
+}
+
+func (p *DelegatePayload) SerializeSize() int {
+	return 42 + // two addresses
+		encoding.VarIntSerializeSize(uint64(p.pubKeySize())) + p.pubKeySize() +
+		encoding.VarIntSerializeSize(uint64(p.Stake)) +
+		bls.SignatureSize
+}
+
+func (p *DelegatePayload) Encode(w io.Writer) error {
+	if p.OperatorSignature == nil {
+		return ErrNoOperatorApproval
+	}
+
+	err := encoding.WriteElements(w, p.From, p.To)
+	if err != nil {
+		return err
+	}
+
+	err = encoding.WriteVarInt(w, uint64(p.pubKeySize()))
+	if err != nil {
+		return err
+	}
+	if p.PublicKey != nil {
+		err = p.PublicKey.Encode(w)
+		if err != nil {
+			return err
+		}
+		if p.ProofOfPossession != nil {
+			err = p.ProofOfPossession.Encode(w)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	err = encoding.WriteVarInt(w, uint64(p.Stake))
+	if err != nil {
+		return err
+	}
+
+	return p.OperatorSignature.Encode(w)
+}
+
+func (p *DelegatePayload) Decode(r io.Reader) error {
+	err := encoding.ReadElements(r, &p.From, &p.To)
+	if err != nil {
+		return err
+	}
+
+	pubKeySize, err := encoding.ReadVarInt(r)
+	if err != nil {
+		return err
+	}
+	if pubKeySize == bls.PublicKeySize || pubKeySize == popKeySize {
+		p.PublicKey = new(bls.PublicKey)
+		err = p.PublicKey.Decode(r)
+		if err != nil {
+			return err
+		}
+	} else if pubKeySize != 0 {
+		return ErrInvalidPublicKeySize
+	}
+	if pubKeySize == popKeySize {
+		p.ProofOfPossession = new(bls.Signature)
+		err = p.ProofOfPossession.Decode(r)
+		if err != nil {
+			return err
+		}
+	}
+
+	stake, err := encoding.ReadVarInt(r)
+	if err != nil {
+		return err
+	}
+	p.Stake = int64(stake)
+
+	p.OperatorSignature = new(bls.Signature)
+
+	return p.OperatorSignature.Decode(r)
+}
+
+func (p *DelegatePayload) String() string {
+	return fmt.Sprintf("{Delegate 🪢 %v->%v %v",
+		p.From.ShortString(),
+		p.To.ShortString(),
+		p.Stake)
+}
+
+func (p *DelegatePayload) Receiver() *crypto.Address {
+	return &p.To
+}
@@ -0,0 +1,156 @@
+package payload
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/pactus-project/pactus/crypto"
+	"github.com/pactus-project/pactus/crypto/bls"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestDelegate creates a delegate payload for a new validator that the operator approves.
+func newTestDelegate(t *testing.T, lockTime uint32) (*DelegatePayload, *bls.PrivateKey) {
+	t.Helper()
+
+	delegatorPub, _ := randBLSKeyPair(t)
+	valPub, valPrv := randBLSKeyPair(t)
+
+	pld := &DelegatePayload{
+		From:              delegatorPub.AccountAddress(),
+		To:                valPub.ValidatorAddress(),
+		PublicKey:         valPub,
+		ProofOfPossession: valPrv.ProofOfPossession(),
+		Stake:             1e9,
+	}
+	pld.OperatorSignature = valPrv.SignNative(pld.ApprovalSignBytes(lockTime))
+
+	return pld, valPrv
+}
+
+func TestDelegateType(t *testing.T) {
+	pld := DelegatePayload{}
+	assert.Equal(t, TypeDelegate, pld.Type())
+	assert.Equal(t, "delegate", TypeDelegate.String())
+}
+
+func TestDelegateEncoding(t *testing.T) {
+	pld, _ := newTestDelegate(t, 1)
+
+	tests := []struct {
+		name   string
+		modify func(pld *DelegatePayload)
+	}{
+		{"With proof of possession", func(_ *DelegatePayload) {}},
+		{"Without proof of possession", func(pld *DelegatePayload) { pld.ProofOfPossession = nil }},
+		{"Without public key", func(pld *DelegatePayload) {
+			pld.PublicKey = nil
+			pld.ProofOfPossession = nil
+		}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cloned := *pld
+			test.modify(&cloned)
+
+			w := bytes.NewBuffer(nil)
+			require.NoError(t, cloned.Encode(w))
+			assert.Equal(t, cloned.SerializeSize(), w.Len())
+			bs := w.Bytes()
+
+			pld2 := new(DelegatePayload)
+			require.NoError(t, pld2.Decode(bytes.NewReader(bs)))
+			w2 := bytes.NewBuffer(nil)
+			require.NoError(t, pld2.Encode(w2))
+			assert.Equal(t, bs, w2.Bytes())
+			assert.NoError(t, pld2.BasicCheck())
+			assert.Equal(t, cloned.Stake, pld2.Value())
+			assert.Equal(t, cloned.From, pld2.Signer())
+			assert.Equal(t, cloned.To, *pld2.Receiver())
+		})
+	}
+}
+
+func TestDelegateEncodingNoApproval(t *testing.T) {
+	pld, _ := newTestDelegate(t, 1)
+	pld.OperatorSignature = nil
+
+	w := bytes.NewBuffer(nil)
+	assert.ErrorIs(t, pld.Encode(w), ErrNoOperatorApproval)
+	assert.ErrorIs(t, pld.BasicCheck(), BasicCheckError{
+		Reason: ErrNoOperatorApproval.Error(),
+	})
+}
+
+func TestDelegateBasicCheck(t *testing.T) {
+	t.Run("Delegator is not an account address", func(t *testing.T) {
+		pld, _ := newTestDelegate(t, 1)
+		pld.From = pld.To
+
+		assert.ErrorIs(t, pld.BasicCheck(), BasicCheckError{
+			Reason: "delegator is not an account address: " + pld.From.String(),
+		})
+	})
+
+	t.Run("Receiver is not a validator address", func(t *testing.T) {
+		pld, _ := newTestDelegate(t, 1)
+		pld.To = pld.From
+
+		assert.ErrorIs(t, pld.BasicCheck(), BasicCheckError{
+			Reason: "receiver is not a validator address: " + pld.From.String(),
+		})
+	})
+
+	t.Run("Proof of possession without public key", func(t *testing.T) {
+		pld, _ := newTestDelegate(t, 1)
+		pld.PublicKey = nil
+
+		assert.ErrorIs(t, pld.BasicCheck(), BasicCheckError{
+			Reason: "proof of possession is set without the public key",
+		})
+	})
+
+	t.Run("Public key doesn't match the validator", func(t *testing.T) {
+		pld, _ := newTestDelegate(t, 1)
+		pub, _ := randBLSKeyPair(t)
+		pld.PublicKey = pub
+
+		assert.Error(t, pld.BasicCheck())
+	})
+
+	t.Run("Ok", func(t *testing.T) {
+		pld, _ := newTestDelegate(t, 1)
+
+		assert.NoError(t, pld.BasicCheck())
+	})
+}
+
+func TestDelegateApprovalSignBytes(t *testing.T) {
+	pld, _ := newTestDelegate(t, 1)
+	bs := pld.ApprovalSignBytes(1)
+
+	assert.NotEqual(t, bs, pld.ApprovalSignBytes(2))
+
+	pld.Stake++
+	assert.NotEqual(t, bs, pld.ApprovalSignBytes(1))
+
+	pub, _ := randBLSKeyPair(t)
+	pld.Stake--
+	pld.From = pub.AccountAddress()
+	assert.NotEqual(t, bs, pld.ApprovalSignBytes(1))
+}
+
+func TestDelegateApprovalSignDomain(t *testing.T) {
+	current := crypto.CurrentSignDomain()
+	t.Cleanup(func() { crypto.SetSignDomain(current) })
+
+	pld, _ := newTestDelegate(t, 1)
+
+	crypto.SetSignDomain(crypto.SignDomain{ChainID: "net1"})
+	bs1 := pld.ApprovalSignBytes(1)
+	crypto.SetSignDomain(crypto.SignDomain{ChainID: "net2"})
+	bs2 := pld.ApprovalSignBytes(1)
+
+	assert.NotEqual(t, bs1, bs2)
+}
@@ -5,6 +5,12 @@ import "errors"
 // ErrInvalidPublicKeySize is returned when the public key size is not valid.
 var ErrInvalidPublicKeySize = errors.New("invalid public key size")
 
+// ErrNoEscrowApproval is returned when the escrow payload has no approval.
+var ErrNoEscrowApproval = errors.New("no escrow approval")
+
+// ErrNoOperatorApproval is returned when the delegate payload has no approval of the operator.
+var ErrNoOperatorApproval = errors.New("no operator approval")
+
 // BasicCheckError describes is returned when the basic check on the transaction's payload fails.
 type BasicCheckError struct {
 	Reason string
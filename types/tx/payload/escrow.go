@@ -0,0 +1,185 @@
+package payload
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+
+	"github.com/pactus-project/pactus/crypto"
+	"github.com/pactus-project/pactus/crypto/bls"
+	"github.com/pactus-project/pactus/crypto/hash"
+	"github.com/pactus-project/pactus/util/encoding"
+)
+
+const (
+	escrowAddressPrefix  = "escrow"
+	escrowApprovalPrefix = "escrow-approval"
+)
+
+// EscrowAddress returns the address that holds the funds of a 2-of-3 escrow.
+// Nobody owns the private key of this address, so the funds can only be
+// moved by an escrow transaction.
+// The nonce allows the same parties to have more than one escrow.
+func EscrowAddress(buyer, seller, arbiter crypto.Address, nonce uint32) crypto.Address {
+	w := bytes.NewBufferString(escrowAddressPrefix)
+	_ = encoding.WriteElements(w, buyer, seller, arbiter, nonce)
+	data := hash.Hash160(hash.Hash256(w.Bytes()))
+
+	return crypto.NewAddress(crypto.AddressTypeBLSAccount, data)
+}
+
+// EscrowPayload resolves a 2-of-3 escrow between a buyer, a seller and an arbiter.
+// The escrow is funded by transferring coins to its escrow address.
+// Any party can resolve it to the buyer (refund) or the seller (release)
+// by signing the transaction, provided that another party approves it.
+type EscrowPayload struct {
+	Buyer             crypto.Address
+	Seller            crypto.Address
+	Arbiter           crypto.Address
+	Nonce             uint32
+	From              crypto.Address // the party who signs the transaction
+	To                crypto.Address // the buyer or the seller
+	Amount            int64
+	ApproverPublicKey *bls.PublicKey
+	ApproverSignature *bls.Signature
+}
+
+func (p *EscrowPayload) Type() Type {
+	return TypeEscrow
+}
+
+func (p *EscrowPayload) Signer() crypto.Address {
+	return p.From
+}
+
+func (p *EscrowPayload) Value() int64 {
+	return p.Amount
+}
+
+// Escrow returns the escrow address.
+func (p *EscrowPayload) Escrow() crypto.Address {
+	return EscrowAddress(p.Buyer, p.Seller, p.Arbiter, p.Nonce)
+}
+
+// IsParty returns true if the address is one of the escrow parties.
+func (p *EscrowPayload) IsParty(addr crypto.Address) bool {
+	return addr == p.Buyer || addr == p.Seller || addr == p.Arbiter
+}
+
+// ApprovalSignBytes returns the bytes that the approver should sign
+// to approve resolving the escrow.
+// The chain ID is always included, since the escrow transactions are accepted
+// after the sign domain is active.
+func (p *EscrowPayload) ApprovalSignBytes(lockTime uint32) []byte {
+	w := bytes.NewBufferString(escrowApprovalPrefix)
+	_ = encoding.WriteElements(w, p.Escrow(), p.To, lockTime)
+	_ = encoding.WriteVarInt(w, uint64(p.Amount))
+
+	return crypto.CurrentSignDomain().Wrap(w.Bytes())
+}
+
+func (p *EscrowPayload) BasicCheck() error {
+	for _, addr := range []crypto.Address{p.Buyer, p.Seller, p.Arbiter} {
+		if !addr.IsAccountAddress() {
+			return BasicCheckError{
+				Reason: "party is not an account address: " + addr.String(),
+			}
+		}
+	}
+	if p.Buyer == p.Seller || p.Buyer == p.Arbiter || p.Seller == p.Arbiter {
+		return BasicCheckError{
+			Reason: "escrow parties are not distinct",
+		}
+	}
+	if !p.IsParty(p.From) {
+		return BasicCheckError{
+			Reason: "sender is not an escrow party: " + p.From.String(),
+		}
+	}
+	if p.To != p.Buyer && p.To != p.Seller {
+		return BasicCheckError{
+			Reason: "receiver is neither the buyer nor the seller: " + p.To.String(),
+		}
+	}
+	if p.ApproverPublicKey == nil || p.ApproverSignature == nil {
+		return BasicCheckError{
+			Reason: ErrNoEscrowApproval.Error(),
+		}
+	}
+	approver := p.ApproverPublicKey.AccountAddress()
+	if !p.IsParty(approver) {
+		return BasicCheckError{
+			Reason: "approver is not an escrow party: " + approver.String(),
+		}
+	}
+	if approver == p.From {
+		return BasicCheckError{
+			Reason: "approver and sender are the same",
+		}
+	}
+
+	return nil
+}
+
+func (p *EscrowPayload) SerializeSize() int {
+	return 109 + // five addresses and the nonce
+		encoding.VarIntSerializeSize(uint64(p.Amount)) +
+		bls.PublicKeySize + bls.SignatureSize
+}
+
+func (p *EscrowPayload) Encode(w io.Writer) error {
+	if p.ApproverPublicKey == nil || p.ApproverSignature == nil {
+		return ErrNoEscrowApproval
+	}
+
+	err := encoding.WriteElements(w, p.Buyer, p.Seller, p.Arbiter, p.Nonce, p.From, p.To)
+	if err != nil {
+		return err
+	}
+
+	err = encoding.WriteVarInt(w, uint64(p.Amount))
+	if err != nil {
+		return err
+	}
+
+	err = p.ApproverPublicKey.Encode(w)
+	if err != nil {
+		return err
+	}
+
+	return p.ApproverSignature.Encode(w)
+}
+
+func (p *EscrowPayload) Decode(r io.Reader) error {
+	err := encoding.ReadElements(r, &p.Buyer, &p.Seller, &p.Arbiter, &p.Nonce, &p.From, &p.To)
+	if err != nil {
+		return err
+	}
+
+	amount, err := encoding.ReadVarInt(r)
+	if err != nil {
+		return err
+	}
+	p.Amount = int64(amount)
+
+	p.ApproverPublicKey = new(bls.PublicKey)
+	err = p.ApproverPublicKey.Decode(r)
+	if err != nil {
+		return err
+	}
+
+	p.ApproverSignature = new(bls.Signature)
+
+	return p.ApproverSignature.Decode(r)
+}
+
+func (p *EscrowPayload) String() string {
+	return fmt.Sprintf("{Escrow 🤝 %v->%v %v",
+		p.Escrow().ShortString(),
+		p.To.ShortString(),
+		p.Amount)
+}
+
+func (p *EscrowPayload) Receiver() *crypto.Address {
+	return &p.To
+}
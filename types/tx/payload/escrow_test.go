@@ -0,0 +1,196 @@
+package payload
+
+import (
+	"bytes"
+	"crypto/rand"
+	"testing"
+
+	"github.com/pactus-project/pactus/crypto"
+	"github.com/pactus-project/pactus/crypto/bls"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func randBLSKeyPair(t *testing.T) (*bls.PublicKey, *bls.PrivateKey) {
+	t.Helper()
+
+	buf := make([]byte, bls.PrivateKeySize)
+	_, err := rand.Read(buf)
+	require.NoError(t, err)
+	prv, err := bls.PrivateKeyFromBytes(buf)
+	require.NoError(t, err)
+
+	return prv.PublicKeyNative(), prv
+}
+
+type escrowParties struct {
+	buyerPrv, sellerPrv, arbiterPrv *bls.PrivateKey
+	pld                             *EscrowPayload
+}
+
+// newTestEscrow creates an escrow payload in which the buyer releases
+// the escrow to the seller and the arbiter approves it.
+func newTestEscrow(t *testing.T, lockTime uint32) *escrowParties {
+	t.Helper()
+
+	buyerPub, buyerPrv := randBLSKeyPair(t)
+	sellerPub, sellerPrv := randBLSKeyPair(t)
+	arbiterPub, arbiterPrv := randBLSKeyPair(t)
+
+	pld := &EscrowPayload{
+		Buyer:   buyerPub.AccountAddress(),
+		Seller:  sellerPub.AccountAddress(),
+		Arbiter: arbiterPub.AccountAddress(),
+		Nonce:   1,
+		From:    buyerPub.AccountAddress(),
+		To:      sellerPub.AccountAddress(),
+		Amount:  1e9,
+	}
+	pld.ApproverPublicKey = arbiterPub
+	pld.ApproverSignature = arbiterPrv.SignNative(pld.ApprovalSignBytes(lockTime))
+
+	return &escrowParties{
+		buyerPrv:   buyerPrv,
+		sellerPrv:  sellerPrv,
+		arbiterPrv: arbiterPrv,
+		pld:        pld,
+	}
+}
+
+func TestEscrowType(t *testing.T) {
+	pld := EscrowPayload{}
+	assert.Equal(t, pld.Type(), TypeEscrow)
+}
+
+func TestEscrowAddress(t *testing.T) {
+	e := newTestEscrow(t, 1)
+	pld := e.pld
+
+	addr1 := EscrowAddress(pld.Buyer, pld.Seller, pld.Arbiter, 1)
+	addr2 := EscrowAddress(pld.Buyer, pld.Seller, pld.Arbiter, 2)
+	addr3 := EscrowAddress(pld.Seller, pld.Buyer, pld.Arbiter, 1)
+
+	assert.True(t, addr1.IsAccountAddress())
+	assert.Equal(t, addr1, pld.Escrow())
+	assert.NotEqual(t, addr1, addr2)
+	assert.NotEqual(t, addr1, addr3)
+}
+
+func TestEscrowEncoding(t *testing.T) {
+	e := newTestEscrow(t, 1)
+
+	w := bytes.NewBuffer(nil)
+	require.NoError(t, e.pld.Encode(w))
+	assert.Equal(t, e.pld.SerializeSize(), w.Len())
+	bs := w.Bytes()
+
+	pld2 := new(EscrowPayload)
+	require.NoError(t, pld2.Decode(bytes.NewReader(bs)))
+	w2 := bytes.NewBuffer(nil)
+	require.NoError(t, pld2.Encode(w2))
+	assert.Equal(t, bs, w2.Bytes())
+	assert.Equal(t, e.pld.Escrow(), pld2.Escrow())
+	assert.NoError(t, pld2.BasicCheck())
+	assert.Equal(t, e.pld.Amount, pld2.Value())
+	assert.Equal(t, e.pld.From, pld2.Signer())
+	assert.Equal(t, e.pld.To, *pld2.Receiver())
+}
+
+func TestEscrowEncodingNoApproval(t *testing.T) {
+	e := newTestEscrow(t, 1)
+	e.pld.ApproverSignature = nil
+
+	w := bytes.NewBuffer(nil)
+	assert.ErrorIs(t, e.pld.Encode(w), ErrNoEscrowApproval)
+	assert.ErrorIs(t, e.pld.BasicCheck(), BasicCheckError{
+		Reason: ErrNoEscrowApproval.Error(),
+	})
+}
+
+func TestEscrowBasicCheck(t *testing.T) {
+	t.Run("Party is not an account address", func(t *testing.T) {
+		e := newTestEscrow(t, 1)
+		e.pld.Arbiter = e.arbiterPrv.PublicKeyNative().ValidatorAddress()
+
+		assert.ErrorIs(t, e.pld.BasicCheck(), BasicCheckError{
+			Reason: "party is not an account address: " + e.pld.Arbiter.String(),
+		})
+	})
+
+	t.Run("Parties are not distinct", func(t *testing.T) {
+		e := newTestEscrow(t, 1)
+		e.pld.Seller = e.pld.Buyer
+
+		assert.ErrorIs(t, e.pld.BasicCheck(), BasicCheckError{
+			Reason: "escrow parties are not distinct",
+		})
+	})
+
+	t.Run("Sender is not a party", func(t *testing.T) {
+		e := newTestEscrow(t, 1)
+		pub, _ := randBLSKeyPair(t)
+		e.pld.From = pub.AccountAddress()
+
+		assert.ErrorIs(t, e.pld.BasicCheck(), BasicCheckError{
+			Reason: "sender is not an escrow party: " + e.pld.From.String(),
+		})
+	})
+
+	t.Run("Receiver is the arbiter", func(t *testing.T) {
+		e := newTestEscrow(t, 1)
+		e.pld.To = e.pld.Arbiter
+
+		assert.ErrorIs(t, e.pld.BasicCheck(), BasicCheckError{
+			Reason: "receiver is neither the buyer nor the seller: " + e.pld.Arbiter.String(),
+		})
+	})
+
+	t.Run("Approver is not a party", func(t *testing.T) {
+		e := newTestEscrow(t, 1)
+		pub, _ := randBLSKeyPair(t)
+		e.pld.ApproverPublicKey = pub
+
+		assert.ErrorIs(t, e.pld.BasicCheck(), BasicCheckError{
+			Reason: "approver is not an escrow party: " + pub.AccountAddress().String(),
+		})
+	})
+
+	t.Run("Approver is the sender", func(t *testing.T) {
+		e := newTestEscrow(t, 1)
+		e.pld.ApproverPublicKey = e.buyerPrv.PublicKeyNative()
+
+		assert.ErrorIs(t, e.pld.BasicCheck(), BasicCheckError{
+			Reason: "approver and sender are the same",
+		})
+	})
+
+	t.Run("Ok", func(t *testing.T) {
+		e := newTestEscrow(t, 1)
+
+		assert.NoError(t, e.pld.BasicCheck())
+	})
+}
+
+func TestEscrowApprovalSignBytes(t *testing.T) {
+	e := newTestEscrow(t, 1)
+	bs := e.pld.ApprovalSignBytes(1)
+
+	assert.NotEqual(t, bs, e.pld.ApprovalSignBytes(2))
+
+	e.pld.Amount++
+	assert.NotEqual(t, bs, e.pld.ApprovalSignBytes(1))
+}
+
+func TestEscrowApprovalSignDomain(t *testing.T) {
+	current := crypto.CurrentSignDomain()
+	t.Cleanup(func() { crypto.SetSignDomain(current) })
+
+	e := newTestEscrow(t, 1)
+
+	crypto.SetSignDomain(crypto.SignDomain{ChainID: "net1"})
+	bs1 := e.pld.ApprovalSignBytes(1)
+	crypto.SetSignDomain(crypto.SignDomain{ChainID: "net2"})
+	bs2 := e.pld.ApprovalSignBytes(1)
+
+	assert.NotEqual(t, bs1, bs2)
+}
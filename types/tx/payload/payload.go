@@ -10,25 +10,22 @@ import (
 type Type uint8
 
 const (
-	TypeTransfer  = Type(1)
-	TypeBond      = Type(2)
-	TypeSortition = Type(3)
-	TypeUnbond    = Type(4)
-	TypeWithdraw  = Type(5)
+	TypeTransfer    = Type(1)
+	TypeBond        = Type(2)
+	TypeSortition   = Type(3)
+	TypeUnbond      = Type(4)
+	TypeWithdraw    = Type(5)
+	TypeEscrow      = Type(6)
+	TypeData        = Type(7)
+	TypeAllowlist   = Type(8)
+	TypeCondition   = Type(9)
+	TypeDelegate    = Type(10)
+	TypeRewardSplit = Type(11)
 )
 
 func (t Type) String() string {
-	switch t {
-	case TypeTransfer:
-		return "transfer"
-	case TypeBond:
-		return "bond"
-	case TypeUnbond:
-		return "unbond"
-	case TypeWithdraw:
-		return "withdraw"
-	case TypeSortition:
-		return "sortition"
+	if reg, ok := registry[t]; ok {
+		return reg.name
 	}
 
 	return fmt.Sprintf("%d", t)
@@ -0,0 +1,88 @@
+package payload
+
+import (
+	"fmt"
+
+	"golang.org/x/exp/slices"
+)
+
+// The payload types from TypeCustomMin to TypeCustomMax are reserved for the custom chains.
+// The Pactus chain never defines a payload in this range, so the forks and
+// private deployments can add their own payloads without conflicting with
+// the payloads that are added to Pactus later.
+const (
+	TypeCustomMin = Type(0x80)
+	TypeCustomMax = Type(0xFF)
+)
+
+type registration struct {
+	name       string
+	newPayload func() Payload
+}
+
+// registry keeps the known payload types, including the custom ones.
+// It is only updated at initialization time, so it is not guarded by a lock.
+var registry = map[Type]registration{
+	TypeTransfer:    {name: "transfer", newPayload: func() Payload { return new(TransferPayload) }},
+	TypeBond:        {name: "bond", newPayload: func() Payload { return new(BondPayload) }},
+	TypeSortition:   {name: "sortition", newPayload: func() Payload { return new(SortitionPayload) }},
+	TypeUnbond:      {name: "unbond", newPayload: func() Payload { return new(UnbondPayload) }},
+	TypeWithdraw:    {name: "withdraw", newPayload: func() Payload { return new(WithdrawPayload) }},
+	TypeEscrow:      {name: "escrow", newPayload: func() Payload { return new(EscrowPayload) }},
+	TypeData:        {name: "data", newPayload: func() Payload { return new(DataPayload) }},
+	TypeAllowlist:   {name: "allowlist", newPayload: func() Payload { return new(AllowlistPayload) }},
+	TypeCondition:   {name: "condition", newPayload: func() Payload { return new(ConditionPayload) }},
+	TypeDelegate:    {name: "delegate", newPayload: func() Payload { return new(DelegatePayload) }},
+	TypeRewardSplit: {name: "reward-split", newPayload: func() Payload { return new(RewardSplitPayload) }},
+}
+
+// Register registers a custom payload type. The newPayload function returns an empty
+// payload of this type, that is used to decode the transactions.
+// It should be called in the `init` function of the package that defines the payload,
+// and it panics if the type is not in the custom range or it is already registered.
+func Register(typ Type, name string, newPayload func() Payload) {
+	if !typ.IsCustom() {
+		panic(fmt.Sprintf("payload type %d is not in the custom range", typ))
+	}
+	if _, ok := registry[typ]; ok {
+		panic(fmt.Sprintf("payload type %d is already registered", typ))
+	}
+
+	registry[typ] = registration{name: name, newPayload: newPayload}
+}
+
+// New returns an empty payload of the given type.
+// It returns false if the payload type is not registered.
+func New(typ Type) (Payload, bool) {
+	reg, ok := registry[typ]
+	if !ok {
+		return nil, false
+	}
+
+	return reg.newPayload(), true
+}
+
+// IsRegistered checks if the payload type is defined or registered.
+func (t Type) IsRegistered() bool {
+	_, ok := registry[t]
+
+	return ok
+}
+
+// IsCustom checks if the payload type is in the range that is reserved for the custom chains.
+func (t Type) IsCustom() bool {
+	return t >= TypeCustomMin && t <= TypeCustomMax
+}
+
+// CustomTypes returns the registered custom payload types, sorted by type.
+func CustomTypes() []Type {
+	types := make([]Type, 0)
+	for t := range registry {
+		if t.IsCustom() {
+			types = append(types, t)
+		}
+	}
+	slices.Sort(types)
+
+	return types
+}
@@ -0,0 +1,67 @@
+package payload
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const typeNote = TypeCustomMin + 1
+
+// notePayload is a custom payload that carries a note, like a data payload.
+type notePayload struct {
+	DataPayload
+}
+
+func (p *notePayload) Type() Type {
+	return typeNote
+}
+
+func TestRegister(t *testing.T) {
+	Register(typeNote, "note", func() Payload { return new(notePayload) })
+
+	t.Run("Builtin type", func(t *testing.T) {
+		assert.Panics(t, func() {
+			Register(TypeData, "data", func() Payload { return new(DataPayload) })
+		})
+	})
+
+	t.Run("Not in custom range", func(t *testing.T) {
+		assert.Panics(t, func() {
+			Register(Type(0x10), "note", func() Payload { return new(notePayload) })
+		})
+	})
+
+	t.Run("Already registered", func(t *testing.T) {
+		assert.Panics(t, func() {
+			Register(typeNote, "note", func() Payload { return new(notePayload) })
+		})
+	})
+
+	t.Run("New payload", func(t *testing.T) {
+		pld, ok := New(typeNote)
+		assert.True(t, ok)
+		assert.Equal(t, typeNote, pld.Type())
+
+		pld, ok = New(TypeTransfer)
+		assert.True(t, ok)
+		assert.IsType(t, &TransferPayload{}, pld)
+
+		_, ok = New(TypeCustomMax)
+		assert.False(t, ok)
+	})
+
+	t.Run("Type properties", func(t *testing.T) {
+		assert.Equal(t, "note", typeNote.String())
+		assert.Equal(t, "transfer", TypeTransfer.String())
+		assert.Equal(t, "255", TypeCustomMax.String())
+
+		assert.True(t, typeNote.IsCustom())
+		assert.True(t, typeNote.IsRegistered())
+		assert.False(t, TypeData.IsCustom())
+		assert.True(t, TypeData.IsRegistered())
+		assert.False(t, TypeCustomMax.IsRegistered())
+
+		assert.Equal(t, []Type{typeNote}, CustomTypes())
+	})
+}
@@ -0,0 +1,77 @@
+package payload
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/pactus-project/pactus/crypto"
+	"github.com/pactus-project/pactus/types/validator"
+)
+
+// RewardSplitPayload registers the reward split of a validator.
+// The rewards of the blocks that the validator proposes are split
+// among the shares at payout, and an empty split removes the current one.
+type RewardSplitPayload struct {
+	Validator crypto.Address // the validator that signs the transaction
+	Split     validator.RewardSplit
+}
+
+func (p *RewardSplitPayload) Type() Type {
+	return TypeRewardSplit
+}
+
+func (p *RewardSplitPayload) Signer() crypto.Address {
+	return p.Validator
+}
+
+func (p *RewardSplitPayload) Value() int64 {
+	return 0
+}
+
+func (p *RewardSplitPayload) BasicCheck() error {
+	if !p.Validator.IsValidatorAddress() {
+		return BasicCheckError{
+			Reason: "address is not a validator address: " + p.Validator.String(),
+		}
+	}
+
+	if err := p.Split.BasicCheck(); err != nil {
+		return BasicCheckError{
+			Reason: err.Error(),
+		}
+	}
+
+	return nil
+}
+
+func (p *RewardSplitPayload) SerializeSize() int {
+	return 21 + p.Split.SerializeSize()
+}
+
+func (p *RewardSplitPayload) Encode(w io.Writer) error {
+	err := p.Validator.Encode(w)
+	if err != nil {
+		return err
+	}
+
+	return p.Split.Encode(w)
+}
+
+func (p *RewardSplitPayload) Decode(r io.Reader) error {
+	err := p.Validator.Decode(r)
+	if err != nil {
+		return err
+	}
+
+	return p.Split.Decode(r)
+}
+
+func (p *RewardSplitPayload) String() string {
+	return fmt.Sprintf("{RewardSplit 🍰 %v %v}",
+		p.Validator.ShortString(),
+		len(p.Split.Shares))
+}
+
+func (p *RewardSplitPayload) Receiver() *crypto.Address {
+	return nil
+}
@@ -0,0 +1,90 @@
+package payload
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/pactus-project/pactus/types/validator"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRewardSplitType(t *testing.T) {
+	pld := RewardSplitPayload{}
+	assert.Equal(t, TypeRewardSplit, pld.Type())
+	assert.Equal(t, "reward-split", TypeRewardSplit.String())
+}
+
+func TestRewardSplitEncoding(t *testing.T) {
+	valPub, _ := randBLSKeyPair(t)
+	pub1, _ := randBLSKeyPair(t)
+	pub2, _ := randBLSKeyPair(t)
+	pld := &RewardSplitPayload{
+		Validator: valPub.ValidatorAddress(),
+		Split: validator.RewardSplit{
+			Shares: []validator.RewardShare{
+				{Address: pub1.AccountAddress(), Percent: 70},
+				{Address: pub2.AccountAddress(), Percent: 30},
+			},
+		},
+	}
+	require.NoError(t, pld.BasicCheck())
+
+	w := new(bytes.Buffer)
+	require.NoError(t, pld.Encode(w))
+	assert.Equal(t, pld.SerializeSize(), w.Len())
+	bs := w.Bytes()
+
+	pld2 := new(RewardSplitPayload)
+	require.NoError(t, pld2.Decode(bytes.NewReader(bs)))
+	assert.Equal(t, pld, pld2)
+	assert.Equal(t, pld.Validator, pld2.Signer())
+	assert.Nil(t, pld2.Receiver())
+	assert.Zero(t, pld2.Value())
+
+	t.Run("Short data", func(t *testing.T) {
+		err := new(RewardSplitPayload).Decode(bytes.NewReader(bs[:len(bs)-1]))
+		assert.ErrorIs(t, err, io.EOF)
+	})
+
+	t.Run("Empty split", func(t *testing.T) {
+		pld := &RewardSplitPayload{Validator: valPub.ValidatorAddress()}
+		require.NoError(t, pld.BasicCheck())
+
+		w := new(bytes.Buffer)
+		require.NoError(t, pld.Encode(w))
+		assert.Equal(t, pld.SerializeSize(), w.Len())
+
+		pld2 := new(RewardSplitPayload)
+		require.NoError(t, pld2.Decode(bytes.NewReader(w.Bytes())))
+		assert.True(t, pld2.Split.IsEmpty())
+	})
+}
+
+func TestRewardSplitBasicCheck(t *testing.T) {
+	pub, _ := randBLSKeyPair(t)
+
+	t.Run("Invalid validator", func(t *testing.T) {
+		pld := &RewardSplitPayload{
+			Validator: pub.AccountAddress(),
+		}
+		assert.ErrorIs(t, pld.BasicCheck(), BasicCheckError{
+			Reason: "address is not a validator address: " + pub.AccountAddress().String(),
+		})
+	})
+
+	t.Run("Invalid split", func(t *testing.T) {
+		pld := &RewardSplitPayload{
+			Validator: pub.ValidatorAddress(),
+			Split: validator.RewardSplit{
+				Shares: []validator.RewardShare{
+					{Address: pub.AccountAddress(), Percent: 101},
+				},
+			},
+		}
+		assert.ErrorIs(t, pld.BasicCheck(), BasicCheckError{
+			Reason: "total percent is more than 100: 101",
+		})
+	})
+}
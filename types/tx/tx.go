@@ -4,23 +4,37 @@ import (
 	"bytes"
 	"fmt"
 	"io"
+	"math"
 
 	"github.com/fxamacker/cbor/v2"
 	"github.com/pactus-project/pactus/crypto"
 	"github.com/pactus-project/pactus/crypto/bls"
 	"github.com/pactus-project/pactus/crypto/hash"
+	"github.com/pactus-project/pactus/types/param"
 	"github.com/pactus-project/pactus/types/tx/payload"
 	"github.com/pactus-project/pactus/util"
 	"github.com/pactus-project/pactus/util/encoding"
 )
 
 const (
-	versionLatest        = 0x01
+	// versionLegacy is the version of the transactions that are signed without the chain ID.
+	versionLegacy = 0x01
+	// versionSignDomain is the version of the transactions that are signed with the chain ID.
+	versionSignDomain = 0x02
+	versionLatest     = versionSignDomain
+
 	flagStripedPublicKey = 0x01
 	flagNotSigned        = 0x02
-	maxMemoLength        = 64
 )
 
+// MaxMemoLength is the maximum length of the memo in bytes, that is allowed by the protocol.
+const MaxMemoLength = 64
+
+// LockTimeThreshold separates height-based lock times from timestamp-based ones.
+// Lock times below this value are interpreted as block heights,
+// while lock times at or above it are interpreted as Unix timestamps in seconds.
+const LockTimeThreshold uint32 = 500_000_000
+
 type ID = hash.Hash
 
 type Tx struct {
@@ -48,7 +62,7 @@ func newTx(lockTime uint32, pld payload.Payload, fee int64,
 		data: txData{
 			Flags:    flagNotSigned,
 			LockTime: lockTime,
-			Version:  versionLatest,
+			Version:  defaultVersion(lockTime),
 			Payload:  pld,
 			Fee:      fee,
 			Memo:     memo,
@@ -69,14 +83,72 @@ func FromBytes(bs []byte) (*Tx, error) {
 	return tx, nil
 }
 
+// defaultVersion returns the version of a new transaction with the given lock time.
+// The transactions are signed with the chain ID if the sign domain is active at their lock time.
+// It is only an estimation of the height that the transaction is executed at,
+// and the execution checks the version at that height.
+func defaultVersion(lockTime uint32) uint8 {
+	domain := crypto.CurrentSignDomain()
+	if domain.ChainID != "" && (IsTimestampLockTime(lockTime) || domain.IsActive(lockTime)) {
+		return versionSignDomain
+	}
+
+	return versionLegacy
+}
+
 func (tx *Tx) Version() uint8 {
 	return tx.data.Version & 0x0f
 }
 
+// IsSignedWithChainID returns true if the chain ID is included in the sign bytes of the transaction.
+func (tx *Tx) IsSignedWithChainID() bool {
+	return tx.Version() >= versionSignDomain
+}
+
 func (tx *Tx) LockTime() uint32 {
 	return tx.data.LockTime
 }
 
+// IsTimestampLocked returns true if the lock time of the transaction
+// is a Unix timestamp rather than a block height.
+func (tx *Tx) IsTimestampLocked() bool {
+	return IsTimestampLockTime(tx.LockTime())
+}
+
+// IsTimestampLockTime returns true if the given lock time is a Unix timestamp.
+func IsTimestampLockTime(lockTime uint32) bool {
+	return lockTime >= LockTimeThreshold
+}
+
+// LiveInterval returns the number of blocks that the transaction stays valid after its lock time.
+func (tx *Tx) LiveInterval(params *param.Params) uint32 {
+	switch {
+	case tx.IsSubsidyTx():
+		return 0
+	case tx.IsSortitionTx():
+		return params.SortitionInterval
+	default:
+		return params.TransactionToLiveInterval
+	}
+}
+
+// Expiry returns the last block height at which the transaction can be committed.
+// For the timestamp lock times, it returns the last Unix timestamp of the previous block
+// at which the transaction can be committed.
+func (tx *Tx) Expiry(params *param.Params) uint32 {
+	interval := uint64(tx.LiveInterval(params))
+	if tx.IsTimestampLocked() {
+		interval *= uint64(params.BlockIntervalInSecond)
+	}
+
+	expiry := uint64(tx.LockTime()) + interval
+	if expiry > math.MaxUint32 {
+		return math.MaxUint32
+	}
+
+	return uint32(expiry)
+}
+
 func (tx *Tx) Payload() payload.Payload {
 	return tx.data.Payload
 }
@@ -124,7 +196,22 @@ func (tx *Tx) BasicCheck() error {
 	if tx.basicChecked {
 		return nil
 	}
-	if tx.Version() != versionLatest {
+	if err := tx.CheckUnsigned(); err != nil {
+		return err
+	}
+	if err := tx.checkSignature(); err != nil {
+		return err
+	}
+
+	tx.basicChecked = true
+
+	return nil
+}
+
+// CheckUnsigned performs the basic checks on the transaction, except the signature.
+// It can be used to check a transaction before signing it.
+func (tx *Tx) CheckUnsigned() error {
+	if tx.Version() < versionLegacy || tx.Version() > versionLatest {
 		return BasicCheckError{
 			Reason: fmt.Sprintf("invalid version: %d", tx.Version()),
 		}
@@ -134,6 +221,11 @@ func (tx *Tx) BasicCheck() error {
 			Reason: "lock time is not defined",
 		}
 	}
+	if tx.IsTimestampLocked() && (tx.IsSubsidyTx() || tx.IsSortitionTx()) {
+		return BasicCheckError{
+			Reason: fmt.Sprintf("timestamp lock time is not allowed: %d", tx.LockTime()),
+		}
+	}
 	// TODO: Define it globally (  42*1e15 )?
 	if tx.Payload().Value() < 0 || tx.Payload().Value() > 42*1e15 {
 		return BasicCheckError{
@@ -145,7 +237,7 @@ func (tx *Tx) BasicCheck() error {
 			Reason: fmt.Sprintf("invalid fee: %d", tx.Fee()),
 		}
 	}
-	if len(tx.Memo()) > maxMemoLength {
+	if len(tx.Memo()) > MaxMemoLength {
 		return BasicCheckError{
 			Reason: fmt.Sprintf("memo length exceeded: %d", len(tx.Memo())),
 		}
@@ -155,11 +247,6 @@ func (tx *Tx) BasicCheck() error {
 			Reason: fmt.Sprintf("invalid payload: %s", err.Error()),
 		}
 	}
-	if err := tx.checkSignature(); err != nil {
-		return err
-	}
-
-	tx.basicChecked = true
 
 	return nil
 }
@@ -255,6 +342,9 @@ func (tx *Tx) SerializeSize() int {
 	return n
 }
 
+// Encode writes the transaction to the writer.
+// The signature is not encoded if the transaction is not signed,
+// and the public key is not encoded if it is striped, as the flags indicate.
 func (tx *Tx) Encode(w io.Writer) error {
 	err := tx.encodeWithNoSignatory(w)
 	if err != nil {
@@ -325,23 +415,13 @@ func (tx *Tx) Decode(r io.Reader) error {
 		return err
 	}
 
-	switch t := payload.Type(payloadType); t {
-	case payload.TypeTransfer:
-		tx.data.Payload = new(payload.TransferPayload)
-	case payload.TypeBond:
-		tx.data.Payload = new(payload.BondPayload)
-	case payload.TypeUnbond:
-		tx.data.Payload = new(payload.UnbondPayload)
-	case payload.TypeWithdraw:
-		tx.data.Payload = new(payload.WithdrawPayload)
-	case payload.TypeSortition:
-		tx.data.Payload = new(payload.SortitionPayload)
-
-	default:
+	pld, ok := payload.New(payload.Type(payloadType))
+	if !ok {
 		return InvalidPayloadTypeError{
-			PayloadType: t,
+			PayloadType: payload.Type(payloadType),
 		}
 	}
+	tx.data.Payload = pld
 
 	err = tx.data.Payload.Decode(r)
 	if err != nil {
@@ -376,7 +456,22 @@ func (tx *Tx) String() string {
 		tx.data.Payload.String())
 }
 
+// SignBytes returns the bytes to be signed for the transaction.
+// The chain ID is included in the sign bytes of the transactions that are signed with the chain ID.
+// The version, not the lock time, decides it, so a transaction has only one valid signature,
+// and the execution checks the version against the sign domain at the height of the block.
 func (tx *Tx) SignBytes() []byte {
+	signBytes := tx.rawSignBytes()
+	if tx.IsSignedWithChainID() {
+		signBytes = crypto.CurrentSignDomain().Wrap(signBytes)
+	}
+
+	return signBytes
+}
+
+// rawSignBytes returns the sign bytes without the chain ID.
+// The transaction ID is calculated from them, so it is the same on all networks.
+func (tx *Tx) rawSignBytes() []byte {
 	buf := bytes.Buffer{}
 	err := tx.encodeWithNoSignatory(&buf)
 	if err != nil {
@@ -390,7 +485,7 @@ func (tx *Tx) ID() ID {
 	if tx.memorizedID != nil {
 		return *tx.memorizedID
 	}
-	id := hash.CalcHash(tx.SignBytes())
+	id := hash.CalcHash(tx.rawSignBytes())
 	tx.memorizedID = &id
 
 	return id
@@ -422,6 +517,22 @@ func (tx *Tx) IsWithdrawTx() bool {
 	return tx.Payload().Type() == payload.TypeWithdraw
 }
 
+func (tx *Tx) IsEscrowTx() bool {
+	return tx.Payload().Type() == payload.TypeEscrow
+}
+
+func (tx *Tx) IsDelegateTx() bool {
+	return tx.Payload().Type() == payload.TypeDelegate
+}
+
+func (tx *Tx) IsRewardSplitTx() bool {
+	return tx.Payload().Type() == payload.TypeRewardSplit
+}
+
+func (tx *Tx) IsDataTx() bool {
+	return tx.Payload().Type() == payload.TypeData
+}
+
 // IsFreeTx will checks if transaction fee is 0.
 func (tx *Tx) IsFreeTx() bool {
 	return tx.IsSubsidyTx() || tx.IsSortitionTx() || tx.IsUnbondTx()
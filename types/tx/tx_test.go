@@ -3,12 +3,15 @@ package tx_test
 import (
 	"encoding/hex"
 	"fmt"
+	"math"
 	"strings"
 	"testing"
 
 	"github.com/fxamacker/cbor/v2"
+	"github.com/pactus-project/pactus/crypto"
 	"github.com/pactus-project/pactus/crypto/bls"
 	"github.com/pactus-project/pactus/crypto/hash"
+	"github.com/pactus-project/pactus/types/param"
 	"github.com/pactus-project/pactus/types/tx"
 	"github.com/pactus-project/pactus/types/tx/payload"
 	"github.com/pactus-project/pactus/util"
@@ -38,19 +41,25 @@ func TestEncodingTx(t *testing.T) {
 	trx3, _ := ts.GenerateTestUnbondTx()
 	trx4, _ := ts.GenerateTestWithdrawTx()
 	trx5, _ := ts.GenerateTestSortitionTx()
+	trx6, _ := ts.GenerateTestEscrowTx()
+	trx7, _ := ts.GenerateTestDataTx()
 	assert.True(t, trx1.IsTransferTx())
 	assert.True(t, trx2.IsBondTx())
 	assert.True(t, trx3.IsUnbondTx())
 	assert.True(t, trx4.IsWithdrawTx())
 	assert.True(t, trx5.IsSortitionTx())
+	assert.True(t, trx6.IsEscrowTx())
+	assert.True(t, trx7.IsDataTx())
 
 	assert.False(t, trx1.IsFreeTx())
 	assert.False(t, trx2.IsFreeTx())
 	assert.True(t, trx3.IsFreeTx())
 	assert.False(t, trx4.IsFreeTx())
 	assert.True(t, trx5.IsFreeTx())
+	assert.False(t, trx6.IsFreeTx())
+	assert.False(t, trx7.IsFreeTx())
 
-	tests := []*tx.Tx{trx1, trx2, trx3, trx4, trx5}
+	tests := []*tx.Tx{trx1, trx2, trx3, trx4, trx5, trx6, trx7}
 	for _, trx := range tests {
 		assert.NoError(t, trx.BasicCheck())
 		assert.NoError(t, trx.BasicCheck()) // double basic check
@@ -105,6 +114,16 @@ func TestBasicCheck(t *testing.T) {
 		})
 	})
 
+	t.Run("Sortition transaction with timestamp lock time", func(t *testing.T) {
+		lockTime := tx.LockTimeThreshold + ts.RandUint32(1e6)
+		trx := tx.NewSortitionTx(lockTime, ts.RandValAddress(), ts.RandProof())
+
+		err := trx.BasicCheck()
+		assert.ErrorIs(t, err, tx.BasicCheckError{
+			Reason: fmt.Sprintf("timestamp lock time is not allowed: %d", lockTime),
+		})
+	})
+
 	t.Run("Big memo, Should returns error", func(t *testing.T) {
 		bigMemo := strings.Repeat("a", 65)
 
@@ -187,7 +206,7 @@ func TestBasicCheck(t *testing.T) {
 	t.Run("Invalid version", func(t *testing.T) {
 		d := ts.DecodingHex(
 			"02" + // Flags
-				"02" + // Version
+				"03" + // Version
 				"01020304" + // LockTime
 				"01" + // Fee
 				"00" + // Memo
@@ -200,7 +219,7 @@ func TestBasicCheck(t *testing.T) {
 		assert.NoError(t, err)
 		err = trx.BasicCheck()
 		assert.ErrorIs(t, err, tx.BasicCheckError{
-			Reason: "invalid version: 2",
+			Reason: "invalid version: 3",
 		})
 		assert.Equal(t, trx.SerializeSize(), len(d))
 	})
@@ -215,17 +234,52 @@ func TestInvalidPayloadType(t *testing.T) {
 			"01020300" + // LockTime
 			"01" + // Fee
 			"00" + // Memo
-			"06" + // PayloadType
+			"7f" + // PayloadType
 			"00" + // Sender (treasury)
 			"012222222222222222222222222222222222222222" + // Receiver
 			"01") // Amount
 
 	_, err := tx.FromBytes(d)
 	assert.ErrorIs(t, err, tx.InvalidPayloadTypeError{
-		PayloadType: payload.Type(6),
+		PayloadType: payload.Type(0x7F),
 	})
 }
 
+func TestLockTimeType(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	heightLocked := tx.NewTransferTx(tx.LockTimeThreshold-1,
+		ts.RandAccAddress(), ts.RandAccAddress(), ts.RandInt64(1e9), ts.RandInt64(1e6), "")
+	timestampLocked := tx.NewTransferTx(tx.LockTimeThreshold,
+		ts.RandAccAddress(), ts.RandAccAddress(), ts.RandInt64(1e9), ts.RandInt64(1e6), "")
+
+	assert.False(t, heightLocked.IsTimestampLocked())
+	assert.True(t, timestampLocked.IsTimestampLocked())
+}
+
+func TestExpiry(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	params := param.DefaultParams()
+	pub, _ := ts.RandBLSKeyPair()
+
+	transferTx := tx.NewTransferTx(100,
+		ts.RandAccAddress(), ts.RandAccAddress(), ts.RandInt64(1e9), ts.RandInt64(1e6), "")
+	subsidyTx := tx.NewSubsidyTx(100, ts.RandAccAddress(), 2500, "")
+	sortitionTx := tx.NewSortitionTx(100, pub.ValidatorAddress(), ts.RandProof())
+	timestampTx := tx.NewTransferTx(tx.LockTimeThreshold,
+		ts.RandAccAddress(), ts.RandAccAddress(), ts.RandInt64(1e9), ts.RandInt64(1e6), "")
+	farTx := tx.NewTransferTx(math.MaxUint32,
+		ts.RandAccAddress(), ts.RandAccAddress(), ts.RandInt64(1e9), ts.RandInt64(1e6), "")
+
+	assert.Equal(t, 100+params.TransactionToLiveInterval, transferTx.Expiry(params))
+	assert.Equal(t, uint32(100), subsidyTx.Expiry(params))
+	assert.Equal(t, 100+params.SortitionInterval, sortitionTx.Expiry(params))
+	assert.Equal(t, tx.LockTimeThreshold+
+		params.TransactionToLiveInterval*uint32(params.BlockIntervalInSecond), timestampTx.Expiry(params))
+	assert.Equal(t, uint32(math.MaxUint32), farTx.Expiry(params))
+}
+
 func TestSubsidyTx(t *testing.T) {
 	ts := testsuite.NewTestSuite(t)
 
@@ -376,6 +430,69 @@ func TestSignBytes(t *testing.T) {
 	assert.Equal(t, trx.LockTime(), uint32(0x04030201))
 }
 
+func TestSignBytesWithChainID(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	current := crypto.CurrentSignDomain()
+	t.Cleanup(func() { crypto.SetSignDomain(current) })
+
+	// decode returns a copy of the transaction that is not checked yet.
+	decode := func(trx *tx.Tx) *tx.Tx {
+		bs, _ := trx.Bytes()
+		decoded, _ := tx.FromBytes(bs)
+
+		return decoded
+	}
+
+	t.Run("Before activation, the legacy transactions are made", func(t *testing.T) {
+		crypto.SetSignDomain(crypto.SignDomain{ChainID: "net1", ActivationHeight: util.MaxUint32 - 1})
+
+		trx, _ := ts.GenerateTestTransferTx()
+		assert.Equal(t, uint8(1), trx.Version())
+		assert.False(t, trx.IsSignedWithChainID())
+
+		crypto.SetSignDomain(crypto.SignDomain{})
+		assert.Equal(t, trx.SignBytes(), decode(trx).SignBytes())
+		assert.NoError(t, decode(trx).BasicCheck())
+	})
+
+	t.Run("After activation, the chain ID is included", func(t *testing.T) {
+		crypto.SetSignDomain(crypto.SignDomain{ChainID: "net1", ActivationHeight: 0})
+
+		trx, _ := ts.GenerateTestTransferTx()
+		assert.Equal(t, uint8(2), trx.Version())
+		assert.True(t, trx.IsSignedWithChainID())
+		assert.NoError(t, decode(trx).BasicCheck())
+
+		// The version decides the sign bytes, not the activation height.
+		crypto.SetSignDomain(crypto.SignDomain{ChainID: "net1", ActivationHeight: util.MaxUint32 - 1})
+		assert.NoError(t, decode(trx).BasicCheck())
+
+		// The signature is not valid on other chains.
+		crypto.SetSignDomain(crypto.SignDomain{ChainID: "net2", ActivationHeight: 0})
+		err := decode(trx).BasicCheck()
+		assert.ErrorIs(t, err, tx.BasicCheckError{Reason: "invalid signature"})
+	})
+
+	t.Run("Timestamp lock times are signed with the chain ID", func(t *testing.T) {
+		crypto.SetSignDomain(crypto.SignDomain{ChainID: "net1", ActivationHeight: util.MaxUint32 - 1})
+
+		trx := tx.NewTransferTx(tx.LockTimeThreshold, ts.RandAccAddress(), ts.RandAccAddress(), 1, 1, "")
+		assert.True(t, trx.IsSignedWithChainID())
+	})
+
+	t.Run("The versions have different IDs", func(t *testing.T) {
+		sender, receiver := ts.RandAccAddress(), ts.RandAccAddress()
+
+		crypto.SetSignDomain(crypto.SignDomain{})
+		trx1 := tx.NewTransferTx(1, sender, receiver, 1, 1, "")
+		crypto.SetSignDomain(crypto.SignDomain{ChainID: "net1", ActivationHeight: 0})
+		trx2 := tx.NewTransferTx(1, sender, receiver, 1, 1, "")
+
+		assert.NotEqual(t, trx1.ID(), trx2.ID())
+	})
+}
+
 func TestStripPublicKey(t *testing.T) {
 	ts := testsuite.NewTestSuite(t)
 
@@ -413,3 +530,25 @@ func TestFlagNotSigned(t *testing.T) {
 	trx.SetSignature(nil)
 	assert.False(t, trx.IsSigned(), "FlagNotSigned should not be set when the signature is set to nil")
 }
+
+func TestCheckUnsigned(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	t.Run("Unsigned transaction", func(t *testing.T) {
+		trx := tx.NewTransferTx(ts.RandHeight(), ts.RandAccAddress(), ts.RandAccAddress(),
+			ts.RandAmount(), ts.RandAmount(), "")
+
+		assert.NoError(t, trx.CheckUnsigned())
+		assert.Error(t, trx.BasicCheck())
+	})
+
+	t.Run("Invalid payload", func(t *testing.T) {
+		trx := tx.NewTransferTx(ts.RandHeight(), ts.RandAccAddress(), ts.RandValAddress(),
+			ts.RandAmount(), ts.RandAmount(), "")
+
+		err := trx.CheckUnsigned()
+		assert.ErrorIs(t, err, tx.BasicCheckError{
+			Reason: "invalid payload: receiver is not an account address: " + trx.Payload().Receiver().String(),
+		})
+	})
+}
@@ -0,0 +1,336 @@
+// Package txbuilder provides a fluent builder for the unsigned transactions.
+//
+// The builder sets the default lock time and fee through a provider, and
+// validates the transaction before returning it.
+// The lock time bounds how long the transaction can be committed, so a transaction
+// that is not committed in time expires instead of being committed months later:
+//
+//	trx, err := txbuilder.New(provider).
+//		Transfer(sender, receiver, amount).
+//		Memo("payment").
+//		Expiry(360).
+//		Build()
+package txbuilder
+
+import (
+	"github.com/pactus-project/pactus/crypto"
+	"github.com/pactus-project/pactus/crypto/bls"
+	"github.com/pactus-project/pactus/sortition"
+	"github.com/pactus-project/pactus/types/param"
+	"github.com/pactus-project/pactus/types/tx"
+	"github.com/pactus-project/pactus/types/tx/payload"
+	"github.com/pactus-project/pactus/types/validator"
+)
+
+// Provider provides the chain information that the builder needs for the default values.
+type Provider interface {
+	// LastBlockHeight returns the height of the last committed block.
+	LastBlockHeight() (uint32, error)
+	// CalculateFee returns the fee of a transaction with the given amount and payload type.
+	CalculateFee(amount int64, payloadType payload.Type) (int64, error)
+}
+
+// Builder builds an unsigned transaction.
+// A zero lock time or fee means the default value, which is set through the provider.
+type Builder struct {
+	provider Provider
+	pld      payload.Payload
+	lockTime uint32
+	expiry   uint32
+	ttl      uint32
+	fee      int64
+	memo     string
+	pop      *bls.Signature
+	approve  ApproveFunc
+}
+
+// ApproveFunc adds the approvals of the other parties to the payload.
+// The approvals are signed over the lock time, so they are added after the lock time is set.
+type ApproveFunc func(pld payload.Payload, lockTime uint32) error
+
+// New creates a new transaction builder.
+// The provider can be nil if the lock time and the fee are set explicitly.
+func New(provider Provider) *Builder {
+	return &Builder{
+		provider: provider,
+		ttl:      param.DefaultParams().TransactionToLiveInterval,
+	}
+}
+
+// Transfer sets a transfer payload, which sends the amount from the sender to the receiver account.
+func (b *Builder) Transfer(sender, receiver crypto.Address, amount int64) *Builder {
+	b.pld = &payload.TransferPayload{
+		From:   sender,
+		To:     receiver,
+		Amount: amount,
+	}
+
+	return b
+}
+
+// Bond sets a bond payload, which stakes the amount from the sender account to the validator.
+// The public key is required only if the validator doesn't exist yet.
+func (b *Builder) Bond(sender, validator crypto.Address, pubKey *bls.PublicKey, stake int64) *Builder {
+	b.pld = &payload.BondPayload{
+		From:      sender,
+		To:        validator,
+		PublicKey: pubKey,
+		Stake:     stake,
+	}
+
+	return b
+}
+
+// Delegate sets a delegate payload, which stakes the amount from the delegator account to
+// the validator of an operator. The stake can only be withdrawn to the delegator.
+// The public key is required only if the validator doesn't exist yet,
+// and the operator should approve the payload by `Approve`.
+func (b *Builder) Delegate(delegator, validator crypto.Address, pubKey *bls.PublicKey, stake int64) *Builder {
+	b.pld = &payload.DelegatePayload{
+		From:      delegator,
+		To:        validator,
+		PublicKey: pubKey,
+		Stake:     stake,
+	}
+
+	return b
+}
+
+// Unbond sets an unbond payload, which unbonds the validator.
+func (b *Builder) Unbond(validator crypto.Address) *Builder {
+	b.pld = &payload.UnbondPayload{
+		Validator: validator,
+	}
+
+	return b
+}
+
+// RewardSplit sets a reward split payload, which registers the reward split of the validator.
+func (b *Builder) RewardSplit(val crypto.Address, split *validator.RewardSplit) *Builder {
+	b.pld = &payload.RewardSplitPayload{
+		Validator: val,
+		Split:     *split,
+	}
+
+	return b
+}
+
+// Withdraw sets a withdraw payload, which withdraws the amount from the unbonded validator to the account.
+func (b *Builder) Withdraw(validator, receiver crypto.Address, amount int64) *Builder {
+	b.pld = &payload.WithdrawPayload{
+		From:   validator,
+		To:     receiver,
+		Amount: amount,
+	}
+
+	return b
+}
+
+// Sortition sets a sortition payload, which proves that the validator is selected to join the committee.
+func (b *Builder) Sortition(validator crypto.Address, proof sortition.Proof) *Builder {
+	b.pld = &payload.SortitionPayload{
+		Validator: validator,
+		Proof:     proof,
+	}
+
+	return b
+}
+
+// Allowlist sets an allowlist payload, which adds the validator to, or removes it from,
+// the allowlist of a permissioned network. The governor signs the transaction and pays the fee.
+func (b *Builder) Allowlist(governor, validator crypto.Address, allow bool) *Builder {
+	b.pld = &payload.AllowlistPayload{
+		Governor:  governor,
+		Validator: validator,
+		Allow:     allow,
+	}
+
+	return b
+}
+
+// Custom sets a custom payload. The payload type should be registered by `payload.Register`.
+func (b *Builder) Custom(pld payload.Payload) *Builder {
+	b.pld = pld
+
+	return b
+}
+
+// LockTime sets the lock time of the transaction.
+// If it is not set, the next block height is used, and the transaction expires
+// after the time-to-live interval.
+func (b *Builder) LockTime(lockTime uint32) *Builder {
+	b.lockTime = lockTime
+
+	return b
+}
+
+// Expiry sets the number of blocks, after the next block, that the transaction can be committed.
+// It sets the lock time backward, so it can't be more than the time-to-live interval,
+// and it can't be set together with the lock time.
+// If it is not set, the transaction expires after the time-to-live interval.
+func (b *Builder) Expiry(blocks uint32) *Builder {
+	b.expiry = blocks
+
+	return b
+}
+
+// TTL sets the time-to-live interval of the network, in blocks.
+// If it is not set, the interval of the default parameters is used.
+func (b *Builder) TTL(interval uint32) *Builder {
+	b.ttl = interval
+
+	return b
+}
+
+// Fee sets the fee of the transaction.
+// If it is not set, the fee is calculated by the provider.
+// Unbond and sortition transactions are free and their fee is ignored.
+func (b *Builder) Fee(fee int64) *Builder {
+	b.fee = fee
+
+	return b
+}
+
+// Memo sets the memo of the transaction.
+func (b *Builder) Memo(memo string) *Builder {
+	b.memo = memo
+
+	return b
+}
+
+// ProofOfPossession sets the proof of possession of the public key in the bond or
+// the delegate transaction. It is required when the validator doesn't exist yet.
+func (b *Builder) ProofOfPossession(pop *bls.Signature) *Builder {
+	b.pop = pop
+
+	return b
+}
+
+// Approve sets the function that adds the approvals of the other parties to the payload,
+// like the approval of the operator in the delegate transaction.
+// It is called after the default values are set and before the transaction is checked.
+func (b *Builder) Approve(approve ApproveFunc) *Builder {
+	b.approve = approve
+
+	return b
+}
+
+// Build sets the default values and returns the unsigned transaction.
+// It returns an error if the transaction is not valid.
+func (b *Builder) Build() (*tx.Tx, error) {
+	if b.pld == nil {
+		return nil, ErrNoPayload
+	}
+
+	lockTime, err := b.defaultLockTime()
+	if err != nil {
+		return nil, err
+	}
+
+	fee, err := b.defaultFee()
+	if err != nil {
+		return nil, err
+	}
+
+	var trx *tx.Tx
+	switch pld := b.pld.(type) {
+	case *payload.TransferPayload:
+		trx = tx.NewTransferTx(lockTime, pld.From, pld.To, pld.Amount, fee, b.memo)
+	case *payload.BondPayload:
+		trx = tx.NewBondTx(lockTime, pld.From, pld.To, pld.PublicKey, pld.Stake, fee, b.memo)
+		trx.Payload().(*payload.BondPayload).ProofOfPossession = b.pop
+	case *payload.DelegatePayload:
+		trx = tx.NewDelegateTx(lockTime, pld.From, pld.To, pld.PublicKey, b.pop,
+			pld.Stake, pld.OperatorSignature, fee, b.memo)
+	case *payload.UnbondPayload:
+		trx = tx.NewUnbondTx(lockTime, pld.Validator, b.memo)
+	case *payload.RewardSplitPayload:
+		trx = tx.NewRewardSplitTx(lockTime, pld.Validator, &pld.Split, fee, b.memo)
+	case *payload.WithdrawPayload:
+		trx = tx.NewWithdrawTx(lockTime, pld.From, pld.To, pld.Amount, fee, b.memo)
+	case *payload.SortitionPayload:
+		trx = tx.NewSortitionTx(lockTime, pld.Validator, pld.Proof)
+	case *payload.AllowlistPayload:
+		trx = tx.NewAllowlistTx(lockTime, pld.Governor, pld.Validator, pld.Allow, fee, b.memo)
+	default:
+		if !b.pld.Type().IsCustom() || !b.pld.Type().IsRegistered() {
+			return nil, tx.InvalidPayloadTypeError{PayloadType: b.pld.Type()}
+		}
+		trx = tx.NewCustomTx(lockTime, b.pld, fee, b.memo)
+	}
+
+	if b.pop != nil && !trx.IsBondTx() && !trx.IsDelegateTx() {
+		return nil, tx.BasicCheckError{Reason: "proof of possession is supported only for the bond transactions"}
+	}
+
+	if b.approve != nil {
+		if err := b.approve(trx.Payload(), lockTime); err != nil {
+			return nil, err
+		}
+	}
+
+	// The sortition transaction has no memo.
+	if trx.Memo() != b.memo {
+		return nil, tx.BasicCheckError{Reason: "memo is not supported for this transaction"}
+	}
+
+	if err := trx.CheckUnsigned(); err != nil {
+		return nil, err
+	}
+
+	return trx, nil
+}
+
+func (b *Builder) defaultLockTime() (uint32, error) {
+	if b.expiry != 0 {
+		if b.lockTime != 0 {
+			return 0, ErrLockTimeAndExpiry
+		}
+		if b.expiry > b.ttl {
+			return 0, ErrExpiryTooFar
+		}
+	}
+	if b.lockTime != 0 {
+		return b.lockTime, nil
+	}
+	if b.provider == nil {
+		return 0, ErrNoProvider
+	}
+
+	lastHeight, err := b.provider.LastBlockHeight()
+	if err != nil {
+		return 0, err
+	}
+	nextHeight := lastHeight + 1
+
+	if b.expiry != 0 {
+		// The transaction is valid until the lock time plus the time-to-live interval.
+		// Early in the chain, the lock time can't go back enough and the expiry is later.
+		if nextHeight+b.expiry <= b.ttl {
+			return 1, nil
+		}
+
+		return nextHeight + b.expiry - b.ttl, nil
+	}
+
+	return nextHeight, nil
+}
+
+func (b *Builder) defaultFee() (int64, error) {
+	if isFree(b.pld.Type()) {
+		return 0, nil
+	}
+	if b.fee != 0 {
+		return b.fee, nil
+	}
+	if b.provider == nil {
+		return 0, ErrNoProvider
+	}
+
+	return b.provider.CalculateFee(b.pld.Value(), b.pld.Type())
+}
+
+// isFree checks if the transactions with this payload type have no fee.
+func isFree(typ payload.Type) bool {
+	return typ == payload.TypeUnbond || typ == payload.TypeSortition
+}
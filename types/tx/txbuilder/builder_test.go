@@ -0,0 +1,319 @@
+package txbuilder
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/pactus-project/pactus/types/param"
+	"github.com/pactus-project/pactus/types/tx"
+	"github.com/pactus-project/pactus/types/tx/payload"
+	"github.com/pactus-project/pactus/types/validator"
+	"github.com/pactus-project/pactus/util/testsuite"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mockProvider struct {
+	lastHeight uint32
+	fee        int64
+	err        error
+}
+
+func (p *mockProvider) LastBlockHeight() (uint32, error) {
+	return p.lastHeight, p.err
+}
+
+func (p *mockProvider) CalculateFee(_ int64, _ payload.Type) (int64, error) {
+	return p.fee, p.err
+}
+
+func TestDefaultValues(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	provider := &mockProvider{lastHeight: ts.RandHeight(), fee: ts.RandAmount()}
+	amount := ts.RandAmount()
+
+	t.Run("Should set the default lock time and fee", func(t *testing.T) {
+		trx, err := New(provider).
+			Transfer(ts.RandAccAddress(), ts.RandAccAddress(), amount).
+			Build()
+		require.NoError(t, err)
+
+		assert.Equal(t, provider.lastHeight+1, trx.LockTime())
+		assert.Equal(t, provider.fee, trx.Fee())
+		assert.Equal(t, amount, trx.Payload().Value())
+		assert.Empty(t, trx.Memo())
+		assert.False(t, trx.IsSigned())
+	})
+
+	t.Run("Should not change the values that are set", func(t *testing.T) {
+		lockTime := ts.RandHeight()
+		fee := ts.RandAmount()
+		trx, err := New(provider).
+			Transfer(ts.RandAccAddress(), ts.RandAccAddress(), amount).
+			LockTime(lockTime).
+			Fee(fee).
+			Memo("memo").
+			Build()
+		require.NoError(t, err)
+
+		assert.Equal(t, lockTime, trx.LockTime())
+		assert.Equal(t, fee, trx.Fee())
+		assert.Equal(t, "memo", trx.Memo())
+	})
+
+	t.Run("Should return error if there is no provider", func(t *testing.T) {
+		_, err := New(nil).
+			Transfer(ts.RandAccAddress(), ts.RandAccAddress(), amount).
+			Fee(ts.RandAmount()).
+			Build()
+		assert.ErrorIs(t, err, ErrNoProvider)
+
+		_, err = New(nil).
+			Transfer(ts.RandAccAddress(), ts.RandAccAddress(), amount).
+			LockTime(ts.RandHeight()).
+			Build()
+		assert.ErrorIs(t, err, ErrNoProvider)
+	})
+
+	t.Run("Should return the provider error", func(t *testing.T) {
+		errProvider := errors.New("provider error")
+		_, err := New(&mockProvider{err: errProvider}).
+			Transfer(ts.RandAccAddress(), ts.RandAccAddress(), amount).
+			Build()
+		assert.ErrorIs(t, err, errProvider)
+	})
+
+	t.Run("Should return error if the payload is not set", func(t *testing.T) {
+		_, err := New(provider).Build()
+		assert.ErrorIs(t, err, ErrNoPayload)
+	})
+}
+
+func TestExpiry(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	ttl := param.DefaultParams().TransactionToLiveInterval
+	provider := &mockProvider{lastHeight: ttl + ts.RandHeight(), fee: ts.RandAmount()}
+	nextHeight := provider.lastHeight + 1
+
+	t.Run("Should expire after the time-to-live interval by default", func(t *testing.T) {
+		trx, err := New(provider).
+			Transfer(ts.RandAccAddress(), ts.RandAccAddress(), ts.RandAmount()).
+			Build()
+		require.NoError(t, err)
+
+		assert.Equal(t, nextHeight+ttl, trx.Expiry(param.DefaultParams()))
+	})
+
+	t.Run("Should set the lock time backward for the expiry", func(t *testing.T) {
+		trx, err := New(provider).
+			Transfer(ts.RandAccAddress(), ts.RandAccAddress(), ts.RandAmount()).
+			Expiry(360).
+			Build()
+		require.NoError(t, err)
+
+		assert.Equal(t, nextHeight+360-ttl, trx.LockTime())
+		assert.Equal(t, nextHeight+360, trx.Expiry(param.DefaultParams()))
+	})
+
+	t.Run("Should use the given time-to-live interval", func(t *testing.T) {
+		trx, err := New(provider).
+			Transfer(ts.RandAccAddress(), ts.RandAccAddress(), ts.RandAmount()).
+			TTL(720).
+			Expiry(360).
+			Build()
+		require.NoError(t, err)
+
+		assert.Equal(t, nextHeight-360, trx.LockTime())
+	})
+
+	t.Run("Should not set the lock time before the first block", func(t *testing.T) {
+		trx, err := New(&mockProvider{lastHeight: 10}).
+			Transfer(ts.RandAccAddress(), ts.RandAccAddress(), ts.RandAmount()).
+			Fee(ts.RandAmount()).
+			Expiry(360).
+			Build()
+		require.NoError(t, err)
+
+		assert.Equal(t, uint32(1), trx.LockTime())
+	})
+
+	t.Run("Should return error if the expiry is more than the time-to-live interval", func(t *testing.T) {
+		_, err := New(provider).
+			Transfer(ts.RandAccAddress(), ts.RandAccAddress(), ts.RandAmount()).
+			Expiry(ttl + 1).
+			Build()
+		assert.ErrorIs(t, err, ErrExpiryTooFar)
+	})
+
+	t.Run("Should return error if both lock time and expiry are set", func(t *testing.T) {
+		_, err := New(provider).
+			Transfer(ts.RandAccAddress(), ts.RandAccAddress(), ts.RandAmount()).
+			LockTime(nextHeight).
+			Expiry(360).
+			Build()
+		assert.ErrorIs(t, err, ErrLockTimeAndExpiry)
+	})
+}
+
+func TestPayloads(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	provider := &mockProvider{lastHeight: ts.RandHeight(), fee: ts.RandAmount()}
+
+	t.Run("Bond", func(t *testing.T) {
+		pub, prv := ts.RandBLSKeyPair()
+		pop := prv.ProofOfPossession()
+		trx, err := New(provider).
+			Bond(ts.RandAccAddress(), pub.ValidatorAddress(), pub, ts.RandAmount()).
+			ProofOfPossession(pop).
+			Build()
+		require.NoError(t, err)
+
+		assert.True(t, trx.IsBondTx())
+		assert.Equal(t, provider.fee, trx.Fee())
+		assert.Equal(t, pop, trx.Payload().(*payload.BondPayload).ProofOfPossession)
+	})
+
+	t.Run("Delegate", func(t *testing.T) {
+		pub, prv := ts.RandBLSKeyPair()
+		trx, err := New(provider).
+			Delegate(ts.RandAccAddress(), pub.ValidatorAddress(), pub, ts.RandAmount()).
+			ProofOfPossession(prv.ProofOfPossession()).
+			Approve(func(pld payload.Payload, lockTime uint32) error {
+				delegatePld := pld.(*payload.DelegatePayload)
+				delegatePld.OperatorSignature = prv.SignNative(delegatePld.ApprovalSignBytes(lockTime))
+
+				return nil
+			}).
+			Build()
+		require.NoError(t, err)
+
+		pld := trx.Payload().(*payload.DelegatePayload)
+		assert.True(t, trx.IsDelegateTx())
+		assert.Equal(t, provider.lastHeight+1, trx.LockTime())
+		assert.NoError(t, pub.Verify(pld.ApprovalSignBytes(trx.LockTime()), pld.OperatorSignature))
+	})
+
+	t.Run("Delegate without approval", func(t *testing.T) {
+		pub, _ := ts.RandBLSKeyPair()
+		_, err := New(provider).
+			Delegate(ts.RandAccAddress(), pub.ValidatorAddress(), pub, ts.RandAmount()).
+			Build()
+		assert.ErrorAs(t, err, &tx.BasicCheckError{})
+	})
+
+	t.Run("Unbond", func(t *testing.T) {
+		trx, err := New(provider).
+			Unbond(ts.RandValAddress()).
+			Fee(ts.RandAmount()).
+			Build()
+		require.NoError(t, err)
+
+		assert.True(t, trx.IsUnbondTx())
+		assert.Zero(t, trx.Fee())
+	})
+
+	t.Run("RewardSplit", func(t *testing.T) {
+		split := &validator.RewardSplit{
+			Shares: []validator.RewardShare{{Address: ts.RandAccAddress(), Percent: 40}},
+		}
+		fee := ts.RandAmount()
+		trx, err := New(provider).
+			RewardSplit(ts.RandValAddress(), split).
+			Fee(fee).
+			Build()
+		require.NoError(t, err)
+
+		assert.True(t, trx.IsRewardSplitTx())
+		assert.Equal(t, *split, trx.Payload().(*payload.RewardSplitPayload).Split)
+		assert.Equal(t, fee, trx.Fee())
+	})
+
+	t.Run("Withdraw", func(t *testing.T) {
+		trx, err := New(provider).
+			Withdraw(ts.RandValAddress(), ts.RandAccAddress(), ts.RandAmount()).
+			Build()
+		require.NoError(t, err)
+
+		assert.True(t, trx.IsWithdrawTx())
+		assert.Equal(t, provider.fee, trx.Fee())
+	})
+
+	t.Run("Sortition", func(t *testing.T) {
+		trx, err := New(provider).
+			Sortition(ts.RandValAddress(), ts.RandProof()).
+			Build()
+		require.NoError(t, err)
+
+		assert.True(t, trx.IsSortitionTx())
+		assert.Zero(t, trx.Fee())
+	})
+}
+
+func TestValidation(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	provider := &mockProvider{lastHeight: ts.RandHeight(), fee: ts.RandAmount()}
+
+	t.Run("Transfer to a validator address", func(t *testing.T) {
+		_, err := New(provider).
+			Transfer(ts.RandAccAddress(), ts.RandValAddress(), ts.RandAmount()).
+			Build()
+		assert.ErrorAs(t, err, &tx.BasicCheckError{})
+	})
+
+	t.Run("Bond with mismatched public key", func(t *testing.T) {
+		pub, _ := ts.RandBLSKeyPair()
+		_, err := New(provider).
+			Bond(ts.RandAccAddress(), ts.RandValAddress(), pub, ts.RandAmount()).
+			Build()
+		assert.ErrorAs(t, err, &tx.BasicCheckError{})
+	})
+
+	t.Run("Proof of possession for a transfer", func(t *testing.T) {
+		_, err := New(provider).
+			Transfer(ts.RandAccAddress(), ts.RandAccAddress(), ts.RandAmount()).
+			ProofOfPossession(ts.RandBLSSignature()).
+			Build()
+		assert.ErrorAs(t, err, &tx.BasicCheckError{})
+	})
+
+	t.Run("Unbond an account address", func(t *testing.T) {
+		_, err := New(provider).
+			Unbond(ts.RandAccAddress()).
+			Build()
+		assert.ErrorAs(t, err, &tx.BasicCheckError{})
+	})
+
+	t.Run("Withdraw from an account address", func(t *testing.T) {
+		_, err := New(provider).
+			Withdraw(ts.RandAccAddress(), ts.RandAccAddress(), ts.RandAmount()).
+			Build()
+		assert.ErrorAs(t, err, &tx.BasicCheckError{})
+	})
+
+	t.Run("Negative amount", func(t *testing.T) {
+		_, err := New(provider).
+			Transfer(ts.RandAccAddress(), ts.RandAccAddress(), -1).
+			Build()
+		assert.ErrorAs(t, err, &tx.BasicCheckError{})
+	})
+
+	t.Run("Long memo", func(t *testing.T) {
+		_, err := New(provider).
+			Transfer(ts.RandAccAddress(), ts.RandAccAddress(), ts.RandAmount()).
+			Memo(ts.RandString(65)).
+			Build()
+		assert.ErrorAs(t, err, &tx.BasicCheckError{})
+	})
+
+	t.Run("Sortition with memo", func(t *testing.T) {
+		_, err := New(provider).
+			Sortition(ts.RandValAddress(), ts.RandProof()).
+			Memo("memo").
+			Build()
+		assert.ErrorAs(t, err, &tx.BasicCheckError{})
+	})
+}
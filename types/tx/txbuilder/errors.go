@@ -0,0 +1,19 @@
+package txbuilder
+
+import (
+	"errors"
+)
+
+var (
+	// ErrNoPayload is returned when the payload of the transaction is not set.
+	ErrNoPayload = errors.New("transaction payload is not set")
+
+	// ErrNoProvider is returned when a default value is needed, but no provider is set.
+	ErrNoProvider = errors.New("no provider to set the default lock time or fee")
+
+	// ErrLockTimeAndExpiry is returned when both the lock time and the expiry are set.
+	ErrLockTimeAndExpiry = errors.New("lock time and expiry can't be set together")
+
+	// ErrExpiryTooFar is returned when the expiry is more than the time-to-live interval.
+	ErrExpiryTooFar = errors.New("expiry is more than the time-to-live interval")
+)
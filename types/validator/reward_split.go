@@ -0,0 +1,122 @@
+package validator
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/pactus-project/pactus/crypto"
+	"github.com/pactus-project/pactus/util/encoding"
+)
+
+// MaxRewardShares is the maximum number of shares that a reward split can have.
+const MaxRewardShares = 8
+
+// RewardShare is the percentage of the block rewards that is paid to an account.
+type RewardShare struct {
+	Address crypto.Address
+	Percent uint8
+}
+
+// ShareAmount returns the amount of the reward that is paid to the share.
+// The amount is rounded down, and the remainder is paid to the reward address.
+func (share RewardShare) ShareAmount(reward int64) int64 {
+	return reward * int64(share.Percent) / 100
+}
+
+// RewardSplit defines how the rewards of the blocks that the validator proposes
+// are split among the accounts. The remaining percentage is paid to
+// the reward address of the block.
+type RewardSplit struct {
+	Shares []RewardShare
+}
+
+// IsEmpty returns true if the reward split has no share.
+func (s *RewardSplit) IsEmpty() bool {
+	return len(s.Shares) == 0
+}
+
+// BasicCheck performs basic checks on the reward split.
+func (s *RewardSplit) BasicCheck() error {
+	if len(s.Shares) > MaxRewardShares {
+		return fmt.Errorf("too many shares: %v", len(s.Shares))
+	}
+
+	total := 0
+	for i, share := range s.Shares {
+		if !share.Address.IsAccountAddress() || share.Address.IsTreasuryAddress() {
+			return fmt.Errorf("share address is not an account address: %v", share.Address)
+		}
+		if share.Percent == 0 {
+			return fmt.Errorf("share percent is zero: %v", share.Address)
+		}
+		for _, other := range s.Shares[:i] {
+			if share.Address == other.Address {
+				return fmt.Errorf("duplicated share address: %v", share.Address)
+			}
+		}
+		total += int(share.Percent)
+	}
+
+	if total > 100 {
+		return fmt.Errorf("total percent is more than 100: %v", total)
+	}
+
+	return nil
+}
+
+// SerializeSize returns the size in bytes required to serialize the reward split.
+func (s *RewardSplit) SerializeSize() int {
+	size := 1 // number of shares
+	for _, share := range s.Shares {
+		size += share.Address.SerializeSize() + 1
+	}
+
+	return size
+}
+
+// Encode writes the reward split to the writer.
+func (s *RewardSplit) Encode(w io.Writer) error {
+	if err := encoding.WriteElement(w, uint8(len(s.Shares))); err != nil {
+		return err
+	}
+
+	for _, share := range s.Shares {
+		if err := share.Address.Encode(w); err != nil {
+			return err
+		}
+		if err := encoding.WriteElement(w, share.Percent); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Decode reads the reward split from the reader.
+func (s *RewardSplit) Decode(r io.Reader) error {
+	var count uint8
+	if err := encoding.ReadElement(r, &count); err != nil {
+		return err
+	}
+
+	s.Shares = make([]RewardShare, count)
+	for i := range s.Shares {
+		if err := s.Shares[i].Address.Decode(r); err != nil {
+			return err
+		}
+		if err := encoding.ReadElement(r, &s.Shares[i].Percent); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Clone creates a deep copy of the reward split.
+func (s *RewardSplit) Clone() *RewardSplit {
+	cloned := new(RewardSplit)
+	cloned.Shares = make([]RewardShare, len(s.Shares))
+	copy(cloned.Shares, s.Shares)
+
+	return cloned
+}
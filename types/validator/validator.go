@@ -12,7 +12,9 @@ import (
 
 // The Validator struct represents a validator object.
 type Validator struct {
-	data validatorData
+	data        validatorData
+	delegator   *crypto.Address
+	rewardSplit *RewardSplit
 }
 
 // validatorData contains the data associated with a validator.
@@ -58,6 +60,29 @@ func FromBytes(data []byte) (*Validator, error) {
 		return nil, err
 	}
 
+	// The delegator is optional and it is encoded after the last sortition height.
+	// This keeps the encoding of the validators without a delegator unchanged.
+	// The treasury address is encoded in place of the delegator,
+	// when the validator is not delegated but it has a reward split.
+	if r.Len() > 0 {
+		delegator := new(crypto.Address)
+		if err := delegator.Decode(r); err != nil {
+			return nil, err
+		}
+		if !delegator.IsTreasuryAddress() {
+			acc.delegator = delegator
+		}
+	}
+
+	// The reward split is optional and it is encoded after the delegator.
+	if r.Len() > 0 {
+		split := new(RewardSplit)
+		if err := split.Decode(r); err != nil {
+			return nil, err
+		}
+		acc.rewardSplit = split
+	}
+
 	return acc, nil
 }
 
@@ -96,6 +121,38 @@ func (val *Validator) LastSortitionHeight() uint32 {
 	return val.data.LastSortitionHeight
 }
 
+// Delegator returns the account that funded the validator by a delegation, or nil if it is not delegated.
+// The stake of a delegated validator can only be withdrawn to its delegator.
+func (val *Validator) Delegator() *crypto.Address {
+	return val.delegator
+}
+
+// SetDelegator sets the delegator of the validator.
+func (val *Validator) SetDelegator(addr crypto.Address) {
+	val.delegator = &addr
+}
+
+// IsDelegated returns true if the validator is funded by a delegation.
+func (val *Validator) IsDelegated() bool {
+	return val.delegator != nil
+}
+
+// RewardSplit returns the reward split of the validator, or nil if it has no reward split.
+func (val *Validator) RewardSplit() *RewardSplit {
+	return val.rewardSplit
+}
+
+// SetRewardSplit sets the reward split of the validator.
+// Setting a nil or an empty reward split removes the current reward split.
+func (val *Validator) SetRewardSplit(split *RewardSplit) {
+	if split == nil || split.IsEmpty() {
+		val.rewardSplit = nil
+
+		return
+	}
+	val.rewardSplit = split.Clone()
+}
+
 // Power returns the power of the validator.
 func (val Validator) Power() int64 {
 	if val.data.UnbondingHeight > 0 {
@@ -146,7 +203,17 @@ func (val *Validator) Hash() hash.Hash {
 
 // SerializeSize returns the size in bytes required to serialize the validator.
 func (val *Validator) SerializeSize() int {
-	return 120 // 96+4+4+8+4+4
+	size := 120 // 96+4+4+8+4+4
+	if val.delegator != nil {
+		size += val.delegator.SerializeSize()
+	} else if val.rewardSplit != nil {
+		size += crypto.TreasuryAddress.SerializeSize()
+	}
+	if val.rewardSplit != nil {
+		size += val.rewardSplit.SerializeSize()
+	}
+
+	return size
 }
 
 // Bytes returns the serialized byte representation of the validator.
@@ -167,6 +234,22 @@ func (val *Validator) Bytes() ([]byte, error) {
 		return nil, err
 	}
 
+	if val.delegator != nil {
+		if err := val.delegator.Encode(w); err != nil {
+			return nil, err
+		}
+	} else if val.rewardSplit != nil {
+		if err := crypto.TreasuryAddress.Encode(w); err != nil {
+			return nil, err
+		}
+	}
+
+	if val.rewardSplit != nil {
+		if err := val.rewardSplit.Encode(w); err != nil {
+			return nil, err
+		}
+	}
+
 	return w.Bytes(), nil
 }
 
@@ -174,6 +257,13 @@ func (val *Validator) Bytes() ([]byte, error) {
 func (val *Validator) Clone() *Validator {
 	cloned := new(Validator)
 	*cloned = *val
+	if val.delegator != nil {
+		delegator := *val.delegator
+		cloned.delegator = &delegator
+	}
+	if val.rewardSplit != nil {
+		cloned.rewardSplit = val.rewardSplit.Clone()
+	}
 
 	return cloned
 }
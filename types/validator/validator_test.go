@@ -108,3 +108,140 @@ func TestClone(t *testing.T) {
 
 	assert.NotEqual(t, val.Stake(), cloned.Stake())
 }
+
+func TestDelegator(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	val, _ := ts.GenerateTestValidator(ts.RandInt32(1000000))
+	assert.False(t, val.IsDelegated())
+	assert.Nil(t, val.Delegator())
+
+	bs, _ := val.Bytes()
+	delegator := ts.RandAccAddress()
+	val.SetDelegator(delegator)
+	assert.True(t, val.IsDelegated())
+	assert.Equal(t, delegator, *val.Delegator())
+
+	bsDelegated, err := val.Bytes()
+	require.NoError(t, err)
+	assert.Equal(t, val.SerializeSize(), len(bsDelegated))
+	assert.Equal(t, bs, bsDelegated[:len(bs)], "delegator should be encoded at the end")
+
+	val2, err := validator.FromBytes(bsDelegated)
+	require.NoError(t, err)
+	assert.Equal(t, delegator, *val2.Delegator())
+	assert.Equal(t, val.Hash(), val2.Hash())
+
+	cloned := val.Clone()
+	cloned.SetDelegator(ts.RandAccAddress())
+	assert.Equal(t, delegator, *val.Delegator())
+
+	_, err = validator.FromBytes(bsDelegated[:len(bsDelegated)-1])
+	assert.Error(t, err)
+}
+
+func TestRewardSplit(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	val, _ := ts.GenerateTestValidator(ts.RandInt32(1000000))
+	assert.Nil(t, val.RewardSplit())
+
+	bs, _ := val.Bytes()
+	split := &validator.RewardSplit{
+		Shares: []validator.RewardShare{
+			{Address: ts.RandAccAddress(), Percent: 30},
+			{Address: ts.RandAccAddress(), Percent: 20},
+		},
+	}
+	val.SetRewardSplit(split)
+	assert.Equal(t, split, val.RewardSplit())
+
+	t.Run("Not delegated", func(t *testing.T) {
+		bsSplit, err := val.Bytes()
+		require.NoError(t, err)
+		assert.Equal(t, val.SerializeSize(), len(bsSplit))
+		assert.Equal(t, bs, bsSplit[:len(bs)])
+
+		val2, err := validator.FromBytes(bsSplit)
+		require.NoError(t, err)
+		assert.False(t, val2.IsDelegated())
+		assert.Equal(t, split, val2.RewardSplit())
+		assert.Equal(t, val.Hash(), val2.Hash())
+	})
+
+	t.Run("Delegated", func(t *testing.T) {
+		delegated := val.Clone()
+		delegator := ts.RandAccAddress()
+		delegated.SetDelegator(delegator)
+
+		bsSplit, err := delegated.Bytes()
+		require.NoError(t, err)
+		assert.Equal(t, delegated.SerializeSize(), len(bsSplit))
+
+		val2, err := validator.FromBytes(bsSplit)
+		require.NoError(t, err)
+		assert.Equal(t, delegator, *val2.Delegator())
+		assert.Equal(t, split, val2.RewardSplit())
+	})
+
+	t.Run("Clone", func(t *testing.T) {
+		cloned := val.Clone()
+		cloned.RewardSplit().Shares[0].Percent = 10
+		assert.Equal(t, uint8(30), val.RewardSplit().Shares[0].Percent)
+	})
+
+	t.Run("Remove reward split", func(t *testing.T) {
+		val.SetRewardSplit(&validator.RewardSplit{})
+		assert.Nil(t, val.RewardSplit())
+
+		bs2, _ := val.Bytes()
+		assert.Equal(t, bs, bs2)
+	})
+}
+
+func TestRewardSplitBasicCheck(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	addr1 := ts.RandAccAddress()
+	addr2 := ts.RandAccAddress()
+	tests := []struct {
+		name   string
+		shares []validator.RewardShare
+		errMsg string
+	}{
+		{"Validator address", []validator.RewardShare{{Address: ts.RandValAddress(), Percent: 10}},
+			"share address is not an account address"},
+		{"Zero percent", []validator.RewardShare{{Address: addr1, Percent: 0}},
+			"share percent is zero"},
+		{"Duplicated address", []validator.RewardShare{{Address: addr1, Percent: 10}, {Address: addr1, Percent: 10}},
+			"duplicated share address"},
+		{"More than 100 percent", []validator.RewardShare{{Address: addr1, Percent: 60}, {Address: addr2, Percent: 41}},
+			"total percent is more than 100: 101"},
+		{"Ok", []validator.RewardShare{{Address: addr1, Percent: 60}, {Address: addr2, Percent: 40}}, ""},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			split := &validator.RewardSplit{Shares: test.shares}
+			err := split.BasicCheck()
+			if test.errMsg == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, test.errMsg)
+			}
+		})
+	}
+
+	t.Run("Too many shares", func(t *testing.T) {
+		split := &validator.RewardSplit{}
+		for i := 0; i <= validator.MaxRewardShares; i++ {
+			split.Shares = append(split.Shares, validator.RewardShare{Address: ts.RandAccAddress(), Percent: 1})
+		}
+		assert.ErrorContains(t, split.BasicCheck(), "too many shares")
+	})
+
+	t.Run("Share amount", func(t *testing.T) {
+		share := validator.RewardShare{Address: addr1, Percent: 30}
+		assert.Equal(t, int64(299), share.ShareAmount(999))
+	})
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/hex"
 	"testing"
 
+	"github.com/pactus-project/pactus/crypto"
 	"github.com/pactus-project/pactus/crypto/bls"
 	"github.com/pactus-project/pactus/crypto/hash"
 	"github.com/pactus-project/pactus/types/certificate"
@@ -516,3 +517,29 @@ func TestCPInvalidJustType(t *testing.T) {
 	err := v.UnmarshalCBOR(voteData)
 	assert.Error(t, err)
 }
+
+func TestSignBytesWithChainID(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	current := crypto.CurrentSignDomain()
+	t.Cleanup(func() { crypto.SetSignDomain(current) })
+
+	valKey := ts.RandValKey()
+	blockHash := ts.RandHash()
+	v := vote.NewPrecommitVote(blockHash, 100, 0, valKey.Address())
+	legacySignBytes := v.SignBytes()
+
+	crypto.SetSignDomain(crypto.SignDomain{ChainID: "net1", ActivationHeight: 101})
+	assert.Equal(t, legacySignBytes, v.SignBytes())
+
+	crypto.SetSignDomain(crypto.SignDomain{ChainID: "net1", ActivationHeight: 100})
+	assert.NotEqual(t, legacySignBytes, v.SignBytes())
+	assert.Equal(t, certificate.BlockCertificateSignBytes(blockHash, 100, 0), v.SignBytes())
+
+	v.SetSignature(valKey.Sign(v.SignBytes()))
+	assert.NoError(t, v.Verify(valKey.PublicKey()))
+
+	// The vote is not valid on other chains.
+	crypto.SetSignDomain(crypto.SignDomain{ChainID: "net2", ActivationHeight: 0})
+	assert.ErrorIs(t, v.Verify(valKey.PublicKey()), crypto.ErrInvalidSignature)
+}
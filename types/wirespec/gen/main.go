@@ -0,0 +1,44 @@
+// The gen command generates the specification of the wire format and the sign bytes
+// into the output directory. It is called by `go generate`.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/pactus-project/pactus/types/wirespec"
+)
+
+func main() {
+	rootOpt := flag.String("root", ".", "the root directory of the module")
+	outOpt := flag.String("out", "docs/spec", "the output directory")
+	flag.Parse()
+
+	if err := generate(*rootOpt, *outOpt); err != nil {
+		fmt.Fprintf(os.Stderr, "unable to generate the specification: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func generate(root, out string) error {
+	spec, err := wirespec.Generate(root)
+	if err != nil {
+		return err
+	}
+
+	data, err := spec.JSON()
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(out, 0o750); err != nil {
+		return err
+	}
+	if err := os.WriteFile(filepath.Join(out, wirespec.JSONFile), data, 0o600); err != nil {
+		return err
+	}
+
+	return os.WriteFile(filepath.Join(out, wirespec.MarkdownFile), spec.Markdown(), 0o600)
+}
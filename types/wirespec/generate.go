@@ -0,0 +1,343 @@
+package wirespec
+
+import (
+	"fmt"
+	"go/ast"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// primitiveTypes are the fixed-size types that are encoded as raw bytes.
+// The size is read from the length of the array type, or from the size constant.
+var primitiveTypes = []struct {
+	pkg       string
+	name      string
+	sizeConst string
+	doc       string
+}{
+	{"crypto/hash", "Hash", "", "Blake2b-256 hash."},
+	{"crypto/bls", "PublicKey", "PublicKeySize", "BLS12-381 public key, compressed G2 point."},
+	{"crypto/bls", "Signature", "SignatureSize", "BLS12-381 signature, compressed G1 point."},
+	{"sortition", "VerifiableSeed", "", "Sortition seed, the signature of the previous seed."},
+	{"sortition", "Proof", "", "Sortition proof, the signature of the seed."},
+}
+
+// interfaceImpls are the interfaces that have only one implementation on the wire.
+var interfaceImpls = map[string]string{
+	"crypto.PublicKey": "bls.PublicKey",
+	"crypto.Signature": "bls.Signature",
+}
+
+// payloadInterface is the interface of the payloads. It is encoded by the layout of its type.
+const payloadInterface = "payload.Payload"
+
+// rootLayouts are the types that the specification starts from.
+// The types that they encode are added to the specification in order.
+var rootLayouts = []struct {
+	pkg  string
+	name string
+}{
+	{"types/block", "Block"},
+	{"types/tx", "Tx"},
+}
+
+// signBytesFuncs are the functions that define the sign bytes.
+var signBytesFuncs = []struct {
+	pkg string
+	key string
+}{
+	{"types/tx", "Tx.SignBytes"},
+	{"types/certificate", "BlockCertificateSignBytes"},
+	{"types/vote", "Vote.SignBytes"},
+	{"types/proposal", "Proposal.SignBytes"},
+	{"types/tx/payload", "EscrowPayload.ApprovalSignBytes"},
+	{"types/tx/payload", "ConditionPayload.ApprovalSignBytes"},
+	{"types/tx/payload", "DelegatePayload.ApprovalSignBytes"},
+	{"types/halt", "Order.SignBytes"},
+	{"types/halt", "Resume.SignBytes"},
+	{"types/participation", "Report.SignBytes"},
+}
+
+type generator struct {
+	loader     *loader
+	spec       *Spec
+	primitives map[string]*Primitive
+	layouts    map[string]bool
+	signBytes  map[string]bool
+}
+
+// Generate generates the specification from the source code of the module in the root directory.
+func Generate(root string) (*Spec, error) {
+	g := &generator{
+		loader: newLoader(root),
+		spec: &Spec{
+			Primitives: make([]*Primitive, 0),
+			Layouts:    make([]*Layout, 0),
+			Payloads:   make([]*Payload, 0),
+			SignBytes:  make([]*Layout, 0),
+		},
+		primitives: make(map[string]*Primitive),
+		layouts:    make(map[string]bool),
+		signBytes:  make(map[string]bool),
+	}
+
+	if err := g.loadPrimitives(); err != nil {
+		return nil, err
+	}
+
+	for _, root := range rootLayouts {
+		pkg, err := g.loader.load(modulePath + "/" + root.pkg)
+		if err != nil {
+			return nil, err
+		}
+		if err := g.addLayout(&typeRef{pkg: pkg, expr: ast.NewIdent(root.name)}); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := g.loadPayloads(); err != nil {
+		return nil, err
+	}
+
+	for _, fn := range signBytesFuncs {
+		pkg, err := g.loader.load(modulePath + "/" + fn.pkg)
+		if err != nil {
+			return nil, err
+		}
+		if err := g.addSignBytes(pkg, fn.key); err != nil {
+			return nil, err
+		}
+	}
+
+	return g.spec, nil
+}
+
+func (g *generator) loadPrimitives() error {
+	for _, p := range primitiveTypes {
+		pkg, err := g.loader.load(modulePath + "/" + p.pkg)
+		if err != nil {
+			return err
+		}
+
+		var size int
+		if p.sizeConst != "" {
+			val, ok := pkg.consts[p.sizeConst]
+			if !ok {
+				return fmt.Errorf("constant %s.%s not found", pkg.name, p.sizeConst)
+			}
+			str, _ := g.loader.constValue(pkg, val)
+			size, err = strconv.Atoi(str)
+			if err != nil {
+				return err
+			}
+		} else {
+			var ok bool
+			size, ok = g.loader.arraySize(&typeRef{pkg: pkg, expr: ast.NewIdent(p.name)})
+			if !ok {
+				return fmt.Errorf("type %s.%s is not a byte array", pkg.name, p.name)
+			}
+		}
+
+		prim := &Primitive{Name: pkg.name + "." + p.name, Size: size, Doc: p.doc}
+		g.primitives[prim.Name] = prim
+		g.spec.Primitives = append(g.spec.Primitives, prim)
+	}
+
+	return nil
+}
+
+// addLayout adds the layout of the type and the layouts of the types that it encodes.
+// It does nothing for the interfaces, like the payloads, that their layout depends on their type.
+func (g *generator) addLayout(t *typeRef) error {
+	pkg, name := g.loader.named(t)
+	if pkg == nil {
+		return fmt.Errorf("unknown type: %s", exprString(t.expr))
+	}
+	qualified := pkg.name + "." + name
+	if g.layouts[qualified] || g.primitives[qualified] != nil {
+		return nil
+	}
+	g.layouts[qualified] = true
+
+	if spec, ok := pkg.types[name]; ok {
+		if _, isInterface := spec.Type.(*ast.InterfaceType); isInterface {
+			return nil
+		}
+	}
+
+	key := name + ".Encode"
+	fn, ok := pkg.funcs[key]
+	if !ok {
+		return fmt.Errorf("type %s has no Encode method", qualified)
+	}
+
+	layout := &Layout{
+		Name:   qualified,
+		Source: g.loader.source(pkg, key),
+		Doc:    docOf(fn),
+	}
+	// The layout is added before walking, so the nested layouts come after it.
+	g.spec.Layouts = append(g.spec.Layouts, layout)
+
+	w := newWalker(g, pkg, fn)
+	w.stmts(fn.Body.List)
+	if w.err != nil {
+		return w.err
+	}
+	layout.Fields = w.fields
+
+	return nil
+}
+
+// addSignBytes adds the sign bytes that are defined by the function or the method.
+func (g *generator) addSignBytes(pkg *srcPackage, key string) error {
+	name := pkg.name + "." + key
+	if g.signBytes[name] {
+		return nil
+	}
+	g.signBytes[name] = true
+
+	fn, ok := pkg.funcs[key]
+	if !ok {
+		return fmt.Errorf("function %s not found", name)
+	}
+
+	layout := &Layout{
+		Name:   name,
+		Source: g.loader.source(pkg, key),
+		Doc:    docOf(fn),
+	}
+	w := newWalker(g, pkg, fn)
+	w.stmts(fn.Body.List)
+	if w.err != nil {
+		return w.err
+	}
+	layout.Fields = w.fields
+	g.spec.SignBytes = append(g.spec.SignBytes, layout)
+
+	return nil
+}
+
+// loadPayloads reads the payload types from the registry of the payloads.
+func (g *generator) loadPayloads() error {
+	pkg, err := g.loader.load(modulePath + "/types/tx/payload")
+	if err != nil {
+		return err
+	}
+	registry, ok := pkg.vars["registry"].(*ast.CompositeLit)
+	if !ok {
+		return fmt.Errorf("payload registry not found")
+	}
+
+	for _, elt := range registry.Elts {
+		kv := elt.(*ast.KeyValueExpr)
+		typStr, ok := g.loader.constValue(pkg, kv.Key)
+		if !ok {
+			return fmt.Errorf("unknown payload type: %s", exprString(kv.Key))
+		}
+		typ, err := strconv.Atoi(typStr)
+		if err != nil {
+			return err
+		}
+
+		payload := &Payload{Type: typ}
+		for _, field := range kv.Value.(*ast.CompositeLit).Elts {
+			fkv := field.(*ast.KeyValueExpr)
+			switch exprString(fkv.Key) {
+			case "name":
+				payload.Name, _ = g.loader.constValue(pkg, fkv.Value)
+
+			case "newPayload":
+				// func() Payload { return new(XPayload) }
+				typ := newPayloadType(fkv.Value)
+				if typ == nil {
+					return fmt.Errorf("unknown payload constructor: %s", exprString(fkv.Value))
+				}
+				payload.Layout = pkg.name + "." + exprString(typ)
+				if err := g.addLayout(&typeRef{pkg: pkg, expr: typ}); err != nil {
+					return err
+				}
+			}
+		}
+		g.spec.Payloads = append(g.spec.Payloads, payload)
+	}
+
+	sort.Slice(g.spec.Payloads, func(i, j int) bool {
+		return g.spec.Payloads[i].Type < g.spec.Payloads[j].Type
+	})
+
+	return nil
+}
+
+// newPayloadType returns the type of the payload that is created by the constructor.
+func newPayloadType(constructor ast.Expr) ast.Expr {
+	var typ ast.Expr
+	ast.Inspect(constructor, func(n ast.Node) bool {
+		if call, ok := n.(*ast.CallExpr); ok && exprString(call.Fun) == "new" && len(call.Args) == 1 {
+			typ = call.Args[0]
+		}
+
+		return typ == nil
+	})
+
+	return typ
+}
+
+// encodeType sets the encoding of a field that is written by `WriteElement`,
+// based on its type. It returns false if the type is not supported.
+func (g *generator) encodeType(f *Field, t *typeRef) bool {
+	if t == nil {
+		return false
+	}
+
+	name := g.loader.qualifiedName(t)
+	if prim, ok := g.primitives[name]; ok {
+		f.Encoding = EncodingBytes
+		f.Type = name
+		f.Size = prim.Size
+
+		return true
+	}
+
+	if size, ok := g.loader.arraySize(t); ok {
+		f.Encoding = EncodingBytes
+		f.Type = name
+		f.Size = size
+
+		return true
+	}
+
+	u := g.loader.underlying(t)
+	if u == nil {
+		return false
+	}
+	basic := exprString(u.expr)
+	switch {
+	case basic == "bool":
+		f.Encoding = EncodingBool
+		f.Type = basic
+		f.Size = 1
+
+	case basic == "[]byte":
+		f.Encoding = EncodingBytes
+		f.Type = basic
+
+	case basicSizes[basic] > 0:
+		if basic == "byte" {
+			basic = "uint8"
+		}
+		f.Encoding = EncodingInt
+		f.Type = basic
+		f.Size = basicSizes[basic]
+
+	default:
+		return false
+	}
+
+	return true
+}
+
+func docOf(fn *ast.FuncDecl) string {
+	return strings.Join(strings.Fields(fn.Doc.Text()), " ")
+}
@@ -0,0 +1,385 @@
+package wirespec
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+const modulePath = "github.com/pactus-project/pactus"
+
+// srcPackage is a parsed package of the module.
+type srcPackage struct {
+	path    string // import path
+	dir     string // directory, relative to the module root
+	name    string
+	imports map[string]string // import name to import path
+	types   map[string]*ast.TypeSpec
+	consts  map[string]ast.Expr
+	vars    map[string]ast.Expr
+	funcs   map[string]*ast.FuncDecl // "Func" or "Type.Method"
+	files   map[string]string        // function key to the file that declares it
+}
+
+// typeRef is a Go type expression in the scope of a package.
+type typeRef struct {
+	pkg  *srcPackage
+	expr ast.Expr
+}
+
+var basicSizes = map[string]int{
+	"bool": 1, "int8": 1, "uint8": 1, "byte": 1,
+	"int16": 2, "uint16": 2,
+	"int32": 4, "uint32": 4,
+	"int64": 8, "uint64": 8,
+	"int": 0, "string": 0,
+}
+
+func basicRef(name string) *typeRef {
+	return &typeRef{expr: ast.NewIdent(name)}
+}
+
+type loader struct {
+	root     string
+	fset     *token.FileSet
+	packages map[string]*srcPackage
+}
+
+func newLoader(root string) *loader {
+	return &loader{
+		root:     root,
+		fset:     token.NewFileSet(),
+		packages: make(map[string]*srcPackage),
+	}
+}
+
+// load parses the package of the module, by its import path.
+// It returns nil for the packages out of the module.
+func (l *loader) load(path string) (*srcPackage, error) {
+	if pkg, ok := l.packages[path]; ok {
+		return pkg, nil
+	}
+	if path != modulePath && !strings.HasPrefix(path, modulePath+"/") {
+		return nil, nil
+	}
+
+	dir := strings.TrimPrefix(strings.TrimPrefix(path, modulePath), "/")
+	entries, err := os.ReadDir(filepath.Join(l.root, dir))
+	if err != nil {
+		return nil, err
+	}
+
+	pkg := &srcPackage{
+		path:    path,
+		dir:     dir,
+		imports: make(map[string]string),
+		types:   make(map[string]*ast.TypeSpec),
+		consts:  make(map[string]ast.Expr),
+		vars:    make(map[string]ast.Expr),
+		funcs:   make(map[string]*ast.FuncDecl),
+		files:   make(map[string]string),
+	}
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasSuffix(name, ".go") || strings.HasSuffix(name, "_test.go") {
+			continue
+		}
+
+		file, err := parser.ParseFile(l.fset, filepath.Join(l.root, dir, name), nil, parser.ParseComments)
+		if err != nil {
+			return nil, err
+		}
+		pkg.name = file.Name.Name
+		pkg.addFile(filepath.ToSlash(filepath.Join(dir, name)), file)
+	}
+	l.packages[path] = pkg
+
+	return pkg, nil
+}
+
+func (pkg *srcPackage) addFile(fileName string, file *ast.File) {
+	for _, imp := range file.Imports {
+		path, _ := strconv.Unquote(imp.Path.Value)
+		name := path[strings.LastIndex(path, "/")+1:]
+		if imp.Name != nil {
+			name = imp.Name.Name
+		}
+		pkg.imports[name] = path
+	}
+
+	for _, decl := range file.Decls {
+		switch d := decl.(type) {
+		case *ast.GenDecl:
+			for _, spec := range d.Specs {
+				switch s := spec.(type) {
+				case *ast.TypeSpec:
+					pkg.types[s.Name.Name] = s
+
+				case *ast.ValueSpec:
+					values := pkg.consts
+					if d.Tok == token.VAR {
+						values = pkg.vars
+					}
+					for i, name := range s.Names {
+						if i < len(s.Values) {
+							values[name.Name] = s.Values[i]
+						}
+					}
+				}
+			}
+
+		case *ast.FuncDecl:
+			key := d.Name.Name
+			if d.Recv != nil && len(d.Recv.List) == 1 {
+				key = receiverTypeName(d.Recv.List[0].Type) + "." + key
+			}
+			pkg.funcs[key] = d
+			pkg.files[key] = fileName
+		}
+	}
+}
+
+func receiverTypeName(expr ast.Expr) string {
+	if star, ok := expr.(*ast.StarExpr); ok {
+		expr = star.X
+	}
+	if ident, ok := expr.(*ast.Ident); ok {
+		return ident.Name
+	}
+
+	return ""
+}
+
+// importedPackage returns the package that is imported by the name in the package scope.
+func (l *loader) importedPackage(pkg *srcPackage, name string) *srcPackage {
+	path, ok := pkg.imports[name]
+	if !ok {
+		return nil
+	}
+	imported, _ := l.load(path)
+
+	return imported
+}
+
+// named returns the package and the name of a named type, dereferencing the pointers.
+func (l *loader) named(t *typeRef) (*srcPackage, string) {
+	if t == nil {
+		return nil, ""
+	}
+	expr := t.expr
+	if star, ok := expr.(*ast.StarExpr); ok {
+		expr = star.X
+	}
+
+	switch e := expr.(type) {
+	case *ast.Ident:
+		if _, basic := basicSizes[e.Name]; basic || t.pkg == nil {
+			return nil, ""
+		}
+
+		return t.pkg, e.Name
+
+	case *ast.SelectorExpr:
+		if x, ok := e.X.(*ast.Ident); ok {
+			if pkg := l.importedPackage(t.pkg, x.Name); pkg != nil {
+				return pkg, e.Sel.Name
+			}
+		}
+	}
+
+	return nil, ""
+}
+
+// qualifiedName returns the name of the type, qualified by the package name.
+func (l *loader) qualifiedName(t *typeRef) string {
+	if pkg, name := l.named(t); pkg != nil {
+		return pkg.name + "." + name
+	}
+	if t == nil {
+		return ""
+	}
+	expr := t.expr
+	if star, ok := expr.(*ast.StarExpr); ok {
+		expr = star.X
+	}
+
+	return exprString(expr)
+}
+
+// underlying returns the underlying type of a named type, or the type itself.
+func (l *loader) underlying(t *typeRef) *typeRef {
+	for i := 0; t != nil && i < 10; i++ {
+		pkg, name := l.named(t)
+		if pkg == nil {
+			if star, ok := t.expr.(*ast.StarExpr); ok {
+				return &typeRef{pkg: t.pkg, expr: star.X}
+			}
+
+			return t
+		}
+		spec, ok := pkg.types[name]
+		if !ok {
+			return nil
+		}
+		t = &typeRef{pkg: pkg, expr: spec.Type}
+	}
+
+	return t
+}
+
+// fieldType returns the type of the field of a struct type.
+func (l *loader) fieldType(t *typeRef, field string) *typeRef {
+	u := l.underlying(t)
+	if u == nil {
+		return nil
+	}
+	st, ok := u.expr.(*ast.StructType)
+	if !ok {
+		return nil
+	}
+	for _, f := range st.Fields.List {
+		for _, name := range f.Names {
+			if name.Name == field {
+				return &typeRef{pkg: u.pkg, expr: f.Type}
+			}
+		}
+	}
+
+	return nil
+}
+
+// methodResult returns the type of the first result of the method.
+func (l *loader) methodResult(t *typeRef, method string) *typeRef {
+	pkg, name := l.named(t)
+	if pkg == nil {
+		return nil
+	}
+
+	return funcResult(pkg, pkg.funcs[name+"."+method])
+}
+
+func funcResult(pkg *srcPackage, fn *ast.FuncDecl) *typeRef {
+	if fn == nil || fn.Type.Results == nil || len(fn.Type.Results.List) == 0 {
+		return nil
+	}
+
+	return &typeRef{pkg: pkg, expr: fn.Type.Results.List[0].Type}
+}
+
+// elem returns the element type of a slice or an array type.
+func (l *loader) elem(t *typeRef) *typeRef {
+	u := l.underlying(t)
+	if u == nil {
+		return nil
+	}
+	if arr, ok := u.expr.(*ast.ArrayType); ok {
+		return &typeRef{pkg: u.pkg, expr: arr.Elt}
+	}
+
+	return nil
+}
+
+// constValue evaluates a constant expression to its literal value.
+func (l *loader) constValue(pkg *srcPackage, expr ast.Expr) (string, bool) {
+	switch e := expr.(type) {
+	case *ast.BasicLit:
+		if e.Kind == token.STRING {
+			s, err := strconv.Unquote(e.Value)
+
+			return s, err == nil
+		}
+
+		return e.Value, true
+
+	case *ast.Ident:
+		if e.Name == "true" || e.Name == "false" {
+			return e.Name, true
+		}
+		if val, ok := pkg.consts[e.Name]; ok {
+			return l.constValue(pkg, val)
+		}
+
+	case *ast.SelectorExpr:
+		if x, ok := e.X.(*ast.Ident); ok {
+			if imported := l.importedPackage(pkg, x.Name); imported != nil {
+				return l.constValue(imported, e.Sel)
+			}
+		}
+
+	case *ast.CallExpr:
+		// Type conversion of a constant, like `Type(1)`.
+		if len(e.Args) == 1 {
+			if _, isType := l.conversionType(pkg, e.Fun); isType {
+				return l.constValue(pkg, e.Args[0])
+			}
+		}
+
+	case *ast.ParenExpr:
+		return l.constValue(pkg, e.X)
+	}
+
+	return "", false
+}
+
+// conversionType returns the type if the expression is a type name.
+func (l *loader) conversionType(pkg *srcPackage, expr ast.Expr) (*typeRef, bool) {
+	switch e := expr.(type) {
+	case *ast.Ident:
+		if _, basic := basicSizes[e.Name]; basic {
+			return basicRef(e.Name), true
+		}
+		if _, ok := pkg.types[e.Name]; ok {
+			return &typeRef{pkg: pkg, expr: e}, true
+		}
+
+	case *ast.SelectorExpr:
+		if x, ok := e.X.(*ast.Ident); ok {
+			if imported := l.importedPackage(pkg, x.Name); imported != nil {
+				if _, ok := imported.types[e.Sel.Name]; ok {
+					return &typeRef{pkg: pkg, expr: e}, true
+				}
+			}
+		}
+	}
+
+	return nil, false
+}
+
+// arraySize returns the length of a byte array type.
+func (l *loader) arraySize(t *typeRef) (int, bool) {
+	u := l.underlying(t)
+	if u == nil {
+		return 0, false
+	}
+	arr, ok := u.expr.(*ast.ArrayType)
+	if !ok || arr.Len == nil {
+		return 0, false
+	}
+	if elt, ok := arr.Elt.(*ast.Ident); !ok || (elt.Name != "byte" && elt.Name != "uint8") {
+		return 0, false
+	}
+	val, ok := l.constValue(u.pkg, arr.Len)
+	if !ok {
+		return 0, false
+	}
+	size, err := strconv.Atoi(val)
+
+	return size, err == nil
+}
+
+func (l *loader) source(pkg *srcPackage, key string) string {
+	return fmt.Sprintf("%s#%s", pkg.files[key], key)
+}
+
+func exprString(expr ast.Expr) string {
+	b := &strings.Builder{}
+	_ = printer.Fprint(b, token.NewFileSet(), expr)
+
+	return b.String()
+}
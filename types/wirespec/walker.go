@@ -0,0 +1,773 @@
+package wirespec
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+const encodingPackage = modulePath + "/util/encoding"
+
+// walker walks the body of an `Encode` method or a sign bytes function,
+// and extracts the encoded fields in order.
+type walker struct {
+	g        *generator
+	pkg      *srcPackage
+	recv     string
+	env      map[string]*typeRef // local variables
+	aliases  map[string]ast.Expr // local variables that are aliases of expressions
+	writers  map[string]bool     // io.Writer variables
+	buffers  map[string]bool     // byte slices that the sign bytes are appended to
+	bytesFn  bool                // whether the function returns the sign bytes
+	conds    []string
+	repeated string
+	fields   []*Field
+	err      error
+}
+
+func newWalker(g *generator, pkg *srcPackage, fn *ast.FuncDecl) *walker {
+	w := &walker{
+		g:       g,
+		pkg:     pkg,
+		env:     make(map[string]*typeRef),
+		aliases: make(map[string]ast.Expr),
+		writers: make(map[string]bool),
+		buffers: make(map[string]bool),
+	}
+	w.bindFunc(fn)
+
+	return w
+}
+
+// bindFunc defines the receiver and the parameters of the function as local variables.
+func (w *walker) bindFunc(fn *ast.FuncDecl) {
+	w.bytesFn = isBytesFunc(fn)
+	if fn.Recv != nil {
+		for _, f := range fn.Recv.List {
+			for _, name := range f.Names {
+				w.recv = name.Name
+				w.env[name.Name] = &typeRef{pkg: w.pkg, expr: f.Type}
+			}
+		}
+	}
+	for _, f := range fn.Type.Params.List {
+		for _, name := range f.Names {
+			if exprString(f.Type) == "io.Writer" {
+				w.writers[name.Name] = true
+
+				continue
+			}
+			w.env[name.Name] = &typeRef{pkg: w.pkg, expr: f.Type}
+		}
+	}
+}
+
+func (w *walker) fail(node ast.Node, format string, args ...any) {
+	if w.err == nil {
+		w.err = fmt.Errorf("%s: %s", w.g.loader.fset.Position(node.Pos()), fmt.Sprintf(format, args...))
+	}
+}
+
+func (w *walker) add(f *Field) {
+	if len(w.conds) > 0 {
+		f.Condition = strings.Join(w.conds, " && ")
+	}
+	f.Repeated = w.repeated
+	w.fields = append(w.fields, f)
+}
+
+func (w *walker) stmts(list []ast.Stmt) {
+	pushed := 0
+	for _, stmt := range list {
+		if ifStmt, ok := stmt.(*ast.IfStmt); ok && ifStmt.Else == nil && !isErrCheck(ifStmt.Cond) {
+			// The rest of the block is executed only if the condition is false,
+			// when the body returns after encoding some fields.
+			count := len(w.fields)
+			w.stmt(stmt)
+			if len(w.fields) > count && terminates(ifStmt.Body) {
+				w.conds = append(w.conds, w.negate(ifStmt.Cond))
+				pushed++
+			}
+
+			continue
+		}
+		w.stmt(stmt)
+	}
+	w.conds = w.conds[:len(w.conds)-pushed]
+}
+
+func (w *walker) stmt(stmt ast.Stmt) {
+	switch s := stmt.(type) {
+	case *ast.ExprStmt:
+		w.expr(s.X)
+
+	case *ast.AssignStmt:
+		w.assign(s)
+
+	case *ast.ReturnStmt:
+		for _, res := range s.Results {
+			w.returned(res)
+		}
+
+	case *ast.BlockStmt:
+		w.stmts(s.List)
+
+	case *ast.IfStmt:
+		if s.Init != nil {
+			w.stmt(s.Init)
+		}
+		if isErrCheck(s.Cond) {
+			return
+		}
+		w.withCond(w.clean(s.Cond), func() { w.stmts(s.Body.List) })
+		if s.Else != nil {
+			w.withCond(w.negate(s.Cond), func() { w.stmt(s.Else) })
+		}
+
+	case *ast.RangeStmt:
+		w.rangeStmt(s)
+
+	case *ast.SwitchStmt:
+		w.switchStmt(s)
+
+	case *ast.DeclStmt, *ast.EmptyStmt:
+
+	default:
+		w.fail(stmt, "unsupported statement")
+	}
+}
+
+func (w *walker) withCond(cond string, fn func()) {
+	w.conds = append(w.conds, cond)
+	fn()
+	w.conds = w.conds[:len(w.conds)-1]
+}
+
+func (w *walker) assign(s *ast.AssignStmt) {
+	for i, rhs := range s.Rhs {
+		var lhs string
+		if i < len(s.Lhs) {
+			if ident, ok := s.Lhs[i].(*ast.Ident); ok {
+				lhs = ident.Name
+			}
+		}
+
+		switch {
+		case w.isBufferConstructor(rhs):
+			// The sign bytes are written to a buffer.
+			w.writers[lhs] = true
+			if call, ok := rhs.(*ast.CallExpr); ok && len(call.Args) == 1 {
+				w.bytes(call.Args[0], true)
+			}
+
+		case w.buffers[lhs] || (s.Tok == token.DEFINE && w.isByteSlice(rhs)):
+			// The sign bytes are appended to a byte slice.
+			w.buffers[lhs] = true
+			w.bytes(rhs, true)
+
+		case s.Tok == token.DEFINE && lhs != "" && lhs != "err" && lhs != "_":
+			w.aliases[lhs] = rhs
+			if t := w.typeOf(rhs); t != nil {
+				w.env[lhs] = t
+			}
+
+		default:
+			w.expr(rhs)
+		}
+	}
+}
+
+func (w *walker) isBufferConstructor(expr ast.Expr) bool {
+	s := exprString(expr)
+
+	return s == "bytes.Buffer{}" || strings.HasPrefix(s, "bytes.NewBuffer")
+}
+
+func (w *walker) isByteSlice(expr ast.Expr) bool {
+	t := w.typeOf(expr)
+
+	return t != nil && exprString(t.expr) == "[]byte"
+}
+
+func (w *walker) rangeStmt(s *ast.RangeStmt) {
+	if ident, ok := s.Value.(*ast.Ident); ok {
+		w.env[ident.Name] = w.g.loader.elem(w.typeOf(s.X))
+	}
+
+	saved := w.repeated
+	w.repeated = w.clean(s.X)
+	w.stmts(s.Body.List)
+	w.repeated = saved
+}
+
+func (w *walker) switchStmt(s *ast.SwitchStmt) {
+	if s.Init != nil {
+		w.stmt(s.Init)
+	}
+
+	tag := ""
+	if s.Tag != nil {
+		tag = w.clean(s.Tag)
+	}
+	for _, clause := range s.Body.List {
+		cc := clause.(*ast.CaseClause)
+		if cc.List == nil {
+			// The default case is an error.
+			continue
+		}
+
+		conds := make([]string, 0, len(cc.List))
+		for _, c := range cc.List {
+			if tag == "" {
+				conds = append(conds, w.clean(c))
+			} else {
+				conds = append(conds, fmt.Sprintf("%s == %s", tag, w.clean(c)))
+			}
+		}
+		cond := strings.Join(conds, " || ")
+		if len(conds) > 1 {
+			cond = "(" + cond + ")"
+		}
+		w.withCond(cond, func() { w.stmts(cc.Body) })
+	}
+}
+
+// expr handles an expression that is evaluated for its side effects.
+func (w *walker) expr(expr ast.Expr) {
+	call, ok := expr.(*ast.CallExpr)
+	if !ok {
+		return
+	}
+	w.call(call)
+}
+
+// returned handles the returned expression of the function.
+func (w *walker) returned(expr ast.Expr) {
+	if w.bytesFn {
+		w.bytes(expr, true)
+
+		return
+	}
+	w.expr(expr)
+}
+
+func isBytesFunc(fn *ast.FuncDecl) bool {
+	res := fn.Type.Results
+
+	return res != nil && len(res.List) == 1 && exprString(res.List[0].Type) == "[]byte"
+}
+
+// call handles a call that may write to a writer.
+func (w *walker) call(call *ast.CallExpr) {
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok {
+		if w.writesTo(call) {
+			w.fail(call, "unsupported call: %s", exprString(call))
+		}
+
+		return
+	}
+
+	if x, ok := sel.X.(*ast.Ident); ok && w.pkg.imports[x.Name] == encodingPackage && w.env[x.Name] == nil {
+		w.encodingCall(call, sel.Sel.Name)
+
+		return
+	}
+
+	if x, ok := sel.X.(*ast.Ident); ok && w.writers[x.Name] {
+		if sel.Sel.Name == "Write" || sel.Sel.Name == "WriteString" {
+			for _, arg := range call.Args {
+				w.bytes(arg, true)
+			}
+
+			return
+		}
+	}
+
+	if !w.writesTo(call) {
+		return
+	}
+
+	if x, ok := sel.X.(*ast.Ident); ok && x.Name == w.recv {
+		if method := w.method(sel.Sel.Name); method != nil {
+			w.inline(call, method)
+
+			return
+		}
+	}
+
+	if sel.Sel.Name == "Encode" {
+		w.encodeCall(sel.X)
+
+		return
+	}
+
+	w.fail(call, "unsupported call: %s", exprString(call))
+}
+
+// writesTo checks if any argument of the call is a writer.
+func (w *walker) writesTo(call *ast.CallExpr) bool {
+	for _, arg := range call.Args {
+		if ident := writerIdent(arg); ident != "" && w.writers[ident] {
+			return true
+		}
+	}
+
+	return false
+}
+
+func writerIdent(expr ast.Expr) string {
+	if unary, ok := expr.(*ast.UnaryExpr); ok && unary.Op == token.AND {
+		expr = unary.X
+	}
+	if ident, ok := expr.(*ast.Ident); ok {
+		return ident.Name
+	}
+
+	return ""
+}
+
+func (w *walker) encodingCall(call *ast.CallExpr, fn string) {
+	if len(call.Args) == 0 || !w.writers[writerIdent(call.Args[0])] {
+		return
+	}
+	args := call.Args[1:]
+
+	switch fn {
+	case "WriteElement", "WriteElements":
+		for _, arg := range args {
+			f := w.field(arg)
+			if !w.g.encodeType(f, w.typeOf(arg)) {
+				w.fail(arg, "unsupported element type: %s", exprString(arg))
+			}
+			w.add(f)
+		}
+
+	case "WriteVarInt":
+		f := w.field(args[0])
+		f.Encoding = EncodingVarInt
+		w.add(f)
+
+	case "WriteVarString":
+		f := w.field(args[0])
+		f.Encoding = EncodingVarString
+		w.add(f)
+
+	case "WriteVarBytes":
+		f := w.field(args[0])
+		f.Encoding = EncodingVarBytes
+		w.add(f)
+
+	default:
+		w.fail(call, "unsupported encoding function: %s", fn)
+	}
+}
+
+// encodeCall handles calling the `Encode` method of a field.
+func (w *walker) encodeCall(x ast.Expr) {
+	t := w.typeOf(x)
+	name := w.g.loader.qualifiedName(t)
+	if name == "" {
+		w.fail(x, "unknown type: %s", exprString(x))
+
+		return
+	}
+
+	if impl, ok := interfaceImpls[name]; ok {
+		name = impl
+	}
+	f := &Field{Name: w.clean(x), Encoding: EncodingLayout, Type: name}
+	if prim, ok := w.g.primitives[name]; ok {
+		f.Size = prim.Size
+	} else if err := w.g.addLayout(t); err != nil {
+		w.fail(x, "%v", err)
+	}
+	w.add(f)
+}
+
+// method returns the method of the receiver type.
+func (w *walker) method(name string) *ast.FuncDecl {
+	_, typeName := w.g.loader.named(w.env[w.recv])
+
+	return w.pkg.funcs[typeName+"."+name]
+}
+
+// inline walks the body of a helper method in place of the call.
+func (w *walker) inline(call *ast.CallExpr, method *ast.FuncDecl) {
+	savedRecv := w.recv
+	savedWriters := w.writers
+	savedBytesFn := w.bytesFn
+	w.bytesFn = isBytesFunc(method)
+
+	w.writers = make(map[string]bool)
+	for name := range savedWriters {
+		w.writers[name] = true
+	}
+	index := 0
+	for _, f := range method.Type.Params.List {
+		for _, name := range f.Names {
+			if index < len(call.Args) && savedWriters[writerIdent(call.Args[index])] {
+				w.writers[name.Name] = true
+			}
+			index++
+		}
+	}
+	if method.Recv != nil && len(method.Recv.List[0].Names) == 1 {
+		name := method.Recv.List[0].Names[0].Name
+		w.env[name] = w.env[savedRecv]
+		w.recv = name
+	}
+
+	w.stmts(method.Body.List)
+
+	w.recv = savedRecv
+	w.writers = savedWriters
+	w.bytesFn = savedBytesFn
+}
+
+// bytes handles an expression that is appended to the sign bytes.
+// The spread is true if the expression is a byte slice or a string,
+// otherwise it is a single byte.
+func (w *walker) bytes(expr ast.Expr, spread bool) {
+	if !spread {
+		f := w.field(expr)
+		f.Encoding = EncodingInt
+		f.Type = "uint8"
+		f.Size = 1
+		w.add(f)
+
+		return
+	}
+
+	switch e := expr.(type) {
+	case *ast.Ident:
+		if w.buffers[e.Name] {
+			return
+		}
+
+	case *ast.BasicLit:
+		w.constString(e)
+
+		return
+
+	case *ast.SliceExpr:
+		// Skipping the first bytes of the buffer.
+		if e.High == nil && e.Low != nil {
+			if low, ok := w.g.loader.constValue(w.pkg, e.Low); ok {
+				w.bytes(e.X, true)
+				w.skip(e, low)
+
+				return
+			}
+		}
+
+	case *ast.CallExpr:
+		if w.bytesCall(e) {
+			return
+		}
+	}
+
+	t := w.typeOf(expr)
+	if t != nil && exprString(t.expr) == "string" {
+		f := w.field(expr)
+		f.Encoding = EncodingString
+		w.add(f)
+
+		return
+	}
+	if _, ok := w.g.loader.constValue(w.pkg, expr); ok {
+		w.constString(expr)
+
+		return
+	}
+
+	w.fail(expr, "unsupported sign bytes expression: %s", exprString(expr))
+}
+
+func (w *walker) constString(expr ast.Expr) {
+	val, _ := w.g.loader.constValue(w.pkg, expr)
+	f := w.field(expr)
+	f.Encoding = EncodingString
+	f.Value = val
+	f.Size = len(val)
+	w.add(f)
+}
+
+// skip removes the first fields of the sign bytes that their size is the given size.
+func (w *walker) skip(node ast.Node, sizeStr string) {
+	size := 0
+	_, _ = fmt.Sscan(sizeStr, &size)
+	for size > 0 && len(w.fields) > 0 {
+		if w.fields[0].Size == 0 || w.fields[0].Size > size {
+			break
+		}
+		size -= w.fields[0].Size
+		w.fields = w.fields[1:]
+	}
+	if size != 0 {
+		w.fail(node, "unable to skip %s bytes", sizeStr)
+	}
+}
+
+var sliceFuncs = map[string]string{
+	"Uint16ToSlice": "uint16", "Int16ToSlice": "int16",
+	"Uint32ToSlice": "uint32", "Int32ToSlice": "int32",
+	"Uint64ToSlice": "uint64", "Int64ToSlice": "int64",
+}
+
+// bytesCall handles a call that returns bytes for the sign bytes.
+func (w *walker) bytesCall(call *ast.CallExpr) bool {
+	if _, ok := w.g.loader.conversionType(w.pkg, call.Fun); ok && len(call.Args) == 1 {
+		if exprString(call.Fun) == "byte" || exprString(call.Fun) == "uint8" {
+			w.bytes(call.Args[0], false)
+
+			return true
+		}
+
+		return false
+	}
+
+	if ident, ok := call.Fun.(*ast.Ident); ok && ident.Name == "append" {
+		for i, arg := range call.Args[1:] {
+			w.bytes(arg, call.Ellipsis.IsValid() && i == len(call.Args)-2)
+		}
+
+		return true
+	}
+
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok {
+		return false
+	}
+	x, _ := sel.X.(*ast.Ident)
+	isPkg := x != nil && w.env[x.Name] == nil && w.pkg.imports[x.Name] != ""
+
+	switch {
+	case isPkg && sliceFuncs[sel.Sel.Name] != "":
+		typ := sliceFuncs[sel.Sel.Name]
+		f := w.field(call.Args[0])
+		f.Encoding = EncodingInt
+		f.Type = typ
+		f.Size = basicSizes[typ]
+		w.add(f)
+
+	case isPkg && sel.Sel.Name == "StringToBytes":
+		w.bytes(call.Args[0], true)
+
+	case isPkg && strings.HasSuffix(sel.Sel.Name, "SignBytes"):
+		pkg := w.g.loader.importedPackage(w.pkg, x.Name)
+		name := pkg.name + "." + sel.Sel.Name
+		if err := w.g.addSignBytes(pkg, sel.Sel.Name); err != nil {
+			w.fail(call, "%v", err)
+		}
+		args := make([]string, 0, len(call.Args))
+		for _, arg := range call.Args {
+			args = append(args, w.clean(arg))
+		}
+		w.add(&Field{
+			Name:     fmt.Sprintf("%s(%s)", name, strings.Join(args, ", ")),
+			Encoding: EncodingSignBytes,
+			Type:     name,
+		})
+
+	case (sel.Sel.Name == "Apply" || sel.Sel.Name == "Wrap") && strings.Contains(exprString(sel.X), "SignDomain"):
+		// The sign domain is prepended to the sign bytes.
+		// Apply takes the height that the domain should be active at, and Wrap doesn't check it.
+		signBytes := call.Args[len(call.Args)-1]
+		start := len(w.fields)
+		if w.isWrittenBytes(signBytes) {
+			start = 0
+		}
+		w.bytes(signBytes, true)
+		domain := &Field{Encoding: EncodingDomain}
+		if sel.Sel.Name == "Apply" {
+			domain.Name = w.clean(call.Args[0])
+		}
+		if len(w.conds) > 0 {
+			domain.Condition = strings.Join(w.conds, " && ")
+		}
+		w.fields = slices.Insert(w.fields, start, domain)
+
+	case x != nil && w.writers[x.Name] && sel.Sel.Name == "Bytes":
+		// The buffer of the writer.
+
+	case x != nil && x.Name == w.recv && w.method(sel.Sel.Name) != nil && len(call.Args) == 0:
+		w.inline(call, w.method(sel.Sel.Name))
+
+	case sel.Sel.Name == "Bytes" && len(call.Args) == 0:
+		f := w.field(sel.X)
+		if !w.g.encodeType(f, w.typeOf(sel.X)) {
+			return false
+		}
+		w.add(f)
+
+	default:
+		return false
+	}
+
+	return true
+}
+
+// isWrittenBytes returns true if the expression is a byte slice or a writer,
+// that the sign bytes are written to before.
+func (w *walker) isWrittenBytes(expr ast.Expr) bool {
+	if ident, ok := expr.(*ast.Ident); ok {
+		return w.buffers[ident.Name]
+	}
+	if call, ok := expr.(*ast.CallExpr); ok {
+		if sel, ok := call.Fun.(*ast.SelectorExpr); ok && sel.Sel.Name == "Bytes" {
+			x, _ := sel.X.(*ast.Ident)
+
+			return x != nil && w.writers[x.Name]
+		}
+	}
+
+	return false
+}
+
+// field creates a field for the expression, with its name and its constant value.
+func (w *walker) field(expr ast.Expr) *Field {
+	f := &Field{Name: w.clean(stripConversion(w, expr))}
+	if val, ok := w.g.loader.constValue(w.pkg, expr); ok {
+		f.Value = val
+		switch lit := stripConversion(w, expr).(type) {
+		case *ast.BasicLit:
+			f.Name = ""
+		case *ast.Ident:
+			if lit.Name == "true" || lit.Name == "false" {
+				f.Name = ""
+			}
+		}
+	}
+
+	return f
+}
+
+// stripConversion removes the type conversion and the address operator of the expression.
+func stripConversion(w *walker, expr ast.Expr) ast.Expr {
+	for {
+		switch e := expr.(type) {
+		case *ast.UnaryExpr:
+			if e.Op != token.AND {
+				return expr
+			}
+			expr = e.X
+
+		case *ast.CallExpr:
+			if _, ok := w.g.loader.conversionType(w.pkg, e.Fun); !ok || len(e.Args) != 1 {
+				return expr
+			}
+			expr = e.Args[0]
+
+		default:
+			return expr
+		}
+	}
+}
+
+// typeOf returns the type of the expression, or nil if it is unknown.
+func (w *walker) typeOf(expr ast.Expr) *typeRef {
+	l := w.g.loader
+
+	switch e := expr.(type) {
+	case *ast.Ident:
+		if t, ok := w.env[e.Name]; ok {
+			return t
+		}
+		if e.Name == "true" || e.Name == "false" {
+			return basicRef("bool")
+		}
+
+	case *ast.SelectorExpr:
+		if x, ok := e.X.(*ast.Ident); ok && w.env[x.Name] == nil {
+			return nil
+		}
+
+		return l.fieldType(w.typeOf(e.X), e.Sel.Name)
+
+	case *ast.CallExpr:
+		if t, ok := l.conversionType(w.pkg, e.Fun); ok {
+			return t
+		}
+		switch fn := e.Fun.(type) {
+		case *ast.Ident:
+			if fn.Name == "len" {
+				return basicRef("int")
+			}
+
+			return funcResult(w.pkg, w.pkg.funcs[fn.Name])
+
+		case *ast.SelectorExpr:
+			if x, ok := fn.X.(*ast.Ident); ok && w.env[x.Name] == nil {
+				if pkg := l.importedPackage(w.pkg, x.Name); pkg != nil {
+					return funcResult(pkg, pkg.funcs[fn.Sel.Name])
+				}
+			}
+
+			return l.methodResult(w.typeOf(fn.X), fn.Sel.Name)
+		}
+
+	case *ast.UnaryExpr:
+		return w.typeOf(e.X)
+
+	case *ast.StarExpr:
+		return w.typeOf(e.X)
+
+	case *ast.ParenExpr:
+		return w.typeOf(e.X)
+
+	case *ast.IndexExpr:
+		return l.elem(w.typeOf(e.X))
+
+	case *ast.SliceExpr:
+		return w.typeOf(e.X)
+	}
+
+	return nil
+}
+
+// clean returns the expression as a string, without the receiver and the inner data.
+func (w *walker) clean(expr ast.Expr) string {
+	s := exprString(expr)
+	for name, alias := range w.aliases {
+		re := regexp.MustCompile(`\b` + regexp.QuoteMeta(name) + `\b`)
+		s = re.ReplaceAllString(s, exprString(alias))
+	}
+	if w.recv != "" {
+		re := regexp.MustCompile(`(^|[^.\w])` + regexp.QuoteMeta(w.recv) + `\.(data\.)?`)
+		s = re.ReplaceAllString(s, "$1")
+	}
+
+	return s
+}
+
+func (w *walker) negate(cond ast.Expr) string {
+	if bin, ok := cond.(*ast.BinaryExpr); ok {
+		switch bin.Op {
+		case token.EQL:
+			return w.clean(&ast.BinaryExpr{X: bin.X, Op: token.NEQ, Y: bin.Y})
+		case token.NEQ:
+			return w.clean(&ast.BinaryExpr{X: bin.X, Op: token.EQL, Y: bin.Y})
+		}
+	}
+
+	return "!(" + w.clean(cond) + ")"
+}
+
+func isErrCheck(cond ast.Expr) bool {
+	bin, ok := cond.(*ast.BinaryExpr)
+
+	return ok && bin.Op == token.NEQ && exprString(bin.X) == "err" && exprString(bin.Y) == "nil"
+}
+
+func terminates(body *ast.BlockStmt) bool {
+	if len(body.List) == 0 {
+		return false
+	}
+	_, ok := body.List[len(body.List)-1].(*ast.ReturnStmt)
+
+	return ok
+}
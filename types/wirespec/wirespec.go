@@ -0,0 +1,235 @@
+// Package wirespec generates the specification of the wire format and the sign bytes
+// directly from the serialization code, so the SDK implementers don't need to
+// reverse-engineer the encodings from the Go source.
+//
+// The generator parses the `Encode` methods and the sign bytes functions of the types,
+// and extracts the sequence of the encoded fields from the calls to the `encoding` package,
+// the nested `Encode` calls and the `append` calls.
+// The specification is written as JSON and Markdown into the `docs/spec` directory,
+// and it ships with the releases.
+//
+// The generator fails if it can't understand a statement that writes to the encoder,
+// so a change in the serialization code can't silently make the specification outdated.
+package wirespec
+
+//go:generate go run ./gen -root ../.. -out ../../docs/spec
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// The names of the generated files.
+const (
+	JSONFile     = "wire.json"
+	MarkdownFile = "wire.md"
+)
+
+// The encodings of the fields.
+const (
+	EncodingBool      = "bool"       // One byte, 0x00 or 0x01
+	EncodingInt       = "int"        // Fixed-size little-endian integer, Type is the integer type
+	EncodingBytes     = "bytes"      // Raw bytes, fixed-size if Size is set
+	EncodingString    = "string"     // Raw UTF-8 bytes of the string, without length prefix
+	EncodingVarInt    = "varint"     // Variable-length unsigned integer
+	EncodingVarString = "varstring"  // Varint length prefix followed by the UTF-8 bytes
+	EncodingVarBytes  = "varbytes"   // Varint length prefix followed by the bytes
+	EncodingLayout    = "layout"     // Encoded by the layout or the primitive of the Type
+	EncodingSignBytes = "sign_bytes" // The sign bytes of the Type, defined in the sign bytes
+	EncodingDomain    = "sign_domain"
+)
+
+// Spec is the specification of the wire format and the sign bytes.
+type Spec struct {
+	Primitives []*Primitive `json:"primitives"`
+	Layouts    []*Layout    `json:"layouts"`
+	Payloads   []*Payload   `json:"payloads"`
+	SignBytes  []*Layout    `json:"sign_bytes"`
+}
+
+// Primitive is a fixed-size type that is encoded as raw bytes.
+type Primitive struct {
+	Name string `json:"name"`
+	Size int    `json:"size"`
+	Doc  string `json:"doc"`
+}
+
+// Layout is the sequence of the fields that a type is encoded to,
+// or the sign bytes are built from.
+type Layout struct {
+	Name   string   `json:"name"`
+	Source string   `json:"source"`
+	Doc    string   `json:"doc,omitempty"`
+	Fields []*Field `json:"fields"`
+}
+
+// Field is a single encoded field.
+type Field struct {
+	// Name is the Go expression of the field, without the receiver.
+	Name     string `json:"name,omitempty"`
+	Encoding string `json:"encoding"`
+	// Type is the Go type of the field, or the layout that encodes it.
+	Type string `json:"type,omitempty"`
+	// Size is the number of bytes for the fixed-size fields.
+	Size int `json:"size,omitempty"`
+	// Value is the constant value of the field, if any.
+	Value string `json:"value,omitempty"`
+	// Condition is the Go expression that the field is encoded only when it is true.
+	Condition string `json:"condition,omitempty"`
+	// Repeated is the Go expression of the collection that the field is encoded for its elements.
+	Repeated string `json:"repeated,omitempty"`
+}
+
+// Payload is a payload type of the transactions.
+type Payload struct {
+	Type   int    `json:"type"`
+	Name   string `json:"name"`
+	Layout string `json:"layout"`
+}
+
+// Layout returns the layout with the given name, or nil if it doesn't exist.
+func (s *Spec) Layout(name string) *Layout {
+	for _, l := range s.Layouts {
+		if l.Name == name {
+			return l
+		}
+	}
+
+	return nil
+}
+
+// SignBytesOf returns the sign bytes with the given name, or nil if they don't exist.
+func (s *Spec) SignBytesOf(name string) *Layout {
+	for _, l := range s.SignBytes {
+		if l.Name == name {
+			return l
+		}
+	}
+
+	return nil
+}
+
+// JSON returns the machine-readable specification.
+func (s *Spec) JSON() ([]byte, error) {
+	data, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	return append(data, '\n'), nil
+}
+
+// Markdown returns the human-readable specification.
+func (s *Spec) Markdown() []byte {
+	b := &strings.Builder{}
+
+	b.WriteString("<!-- Code generated by types/wirespec. DO NOT EDIT. -->\n\n")
+	b.WriteString("# Pactus Wire Format\n\n")
+	b.WriteString("This document is generated from the serialization code. ")
+	b.WriteString("The integers are little-endian, unless they are varints.\n\n")
+
+	b.WriteString("## Encodings\n\n")
+	b.WriteString("| Encoding | Description |\n|---|---|\n")
+	for _, enc := range encodingDocs {
+		fmt.Fprintf(b, "| `%s` | %s |\n", enc[0], enc[1])
+	}
+
+	b.WriteString("\n## Primitives\n\n")
+	b.WriteString("| Type | Size | Description |\n|---|---|---|\n")
+	for _, p := range s.Primitives {
+		fmt.Fprintf(b, "| `%s` | %d | %s |\n", p.Name, p.Size, p.Doc)
+	}
+
+	b.WriteString("\n## Payload Types\n\n")
+	b.WriteString("| Type | Name | Layout |\n|---|---|---|\n")
+	for _, p := range s.Payloads {
+		fmt.Fprintf(b, "| %d | %s | [%s](#%s) |\n", p.Type, p.Name, p.Layout, anchor(p.Layout))
+	}
+
+	b.WriteString("\n## Layouts\n")
+	for _, l := range s.Layouts {
+		s.writeLayout(b, l)
+	}
+
+	b.WriteString("\n## Sign Bytes\n")
+	for _, l := range s.SignBytes {
+		s.writeLayout(b, l)
+	}
+
+	return []byte(b.String())
+}
+
+var encodingDocs = [][2]string{
+	{EncodingBool, "One byte, `0x00` for false and `0x01` for true."},
+	{EncodingInt, "Fixed-size little-endian integer of the type."},
+	{EncodingBytes, "Raw bytes, the size is fixed if it is specified."},
+	{EncodingString, "Raw UTF-8 bytes of the string, without length prefix."},
+	{EncodingVarInt, "Variable-length unsigned integer, like the Bitcoin `CompactSize`."},
+	{EncodingVarString, "Varint length followed by the UTF-8 bytes of the string."},
+	{EncodingVarBytes, "Varint length followed by the bytes."},
+	{EncodingLayout, "Encoded by the layout or the primitive of the type."},
+	{EncodingSignBytes, "The sign bytes of the type."},
+	{EncodingDomain, "If the sign domain is active at the height, one byte length of the chain ID " +
+		"followed by the chain ID. Otherwise, nothing. Without a height, it is always included."},
+}
+
+func (s *Spec) writeLayout(b *strings.Builder, l *Layout) {
+	fmt.Fprintf(b, "\n### %s\n\n", l.Name)
+	if l.Doc != "" {
+		fmt.Fprintf(b, "%s\n\n", l.Doc)
+	}
+	fmt.Fprintf(b, "Source: `%s`\n\n", l.Source)
+
+	b.WriteString("| # | Field | Encoding | Type | Size | Notes |\n|---|---|---|---|---|---|\n")
+	for i, f := range l.Fields {
+		typ := f.Type
+		if f.Encoding == EncodingLayout || f.Encoding == EncodingSignBytes {
+			typ = fmt.Sprintf("[%s](#%s)", f.Type, s.anchorOf(f.Type))
+		} else if typ != "" {
+			typ = fmt.Sprintf("`%s`", typ)
+		}
+		size := ""
+		if f.Size > 0 {
+			size = fmt.Sprintf("%d", f.Size)
+		}
+		notes := []string{}
+		if f.Value != "" {
+			notes = append(notes, fmt.Sprintf("value: `%s`", f.Value))
+		}
+		if f.Condition != "" {
+			notes = append(notes, fmt.Sprintf("if `%s`", f.Condition))
+		}
+		if f.Repeated != "" {
+			notes = append(notes, fmt.Sprintf("for each in `%s`", f.Repeated))
+		}
+		name := ""
+		if f.Name != "" {
+			name = fmt.Sprintf("`%s`", f.Name)
+		}
+		fmt.Fprintf(b, "| %d | %s | `%s` | %s | %s | %s |\n",
+			i+1, escapeCell(name), f.Encoding, typ, size, escapeCell(strings.Join(notes, ", ")))
+	}
+}
+
+// anchorOf returns the anchor of the type in the Markdown document.
+func (s *Spec) anchorOf(typ string) string {
+	if typ == payloadInterface {
+		return "payload-types"
+	}
+	for _, p := range s.Primitives {
+		if p.Name == typ {
+			return "primitives"
+		}
+	}
+
+	return anchor(typ)
+}
+
+func anchor(name string) string {
+	return strings.ToLower(strings.NewReplacer(".", "", " ", "-").Replace(name))
+}
+
+func escapeCell(s string) string {
+	return strings.ReplaceAll(s, "|", "\\|")
+}
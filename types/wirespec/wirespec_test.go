@@ -0,0 +1,70 @@
+package wirespec
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSpecIsUpToDate(t *testing.T) {
+	spec, err := Generate("../..")
+	require.NoError(t, err)
+
+	data, err := spec.JSON()
+	require.NoError(t, err)
+	expected, err := os.ReadFile(filepath.Join("../../docs/spec", JSONFile))
+	require.NoError(t, err)
+	assert.Equal(t, string(expected), string(data), "run `go generate ./types/wirespec`")
+
+	expected, err = os.ReadFile(filepath.Join("../../docs/spec", MarkdownFile))
+	require.NoError(t, err)
+	assert.Equal(t, string(expected), string(spec.Markdown()), "run `go generate ./types/wirespec`")
+}
+
+func TestPrimitives(t *testing.T) {
+	spec, err := Generate("../..")
+	require.NoError(t, err)
+
+	sizes := map[string]int{}
+	for _, p := range spec.Primitives {
+		sizes[p.Name] = p.Size
+	}
+	assert.Equal(t, 32, sizes["hash.Hash"])
+	assert.Equal(t, 96, sizes["bls.PublicKey"])
+	assert.Equal(t, 48, sizes["bls.Signature"])
+}
+
+func TestPayloads(t *testing.T) {
+	spec, err := Generate("../..")
+	require.NoError(t, err)
+
+	for i, p := range spec.Payloads {
+		assert.Equal(t, i+1, p.Type)
+		assert.NotNil(t, spec.Layout(p.Layout), "layout of %s", p.Name)
+	}
+
+	transfer := spec.Layout("payload.TransferPayload")
+	require.NotNil(t, transfer)
+	require.Len(t, transfer.Fields, 3)
+	assert.Equal(t, "From", transfer.Fields[0].Name)
+	assert.Equal(t, EncodingLayout, transfer.Fields[0].Encoding)
+	assert.Equal(t, "crypto.Address", transfer.Fields[0].Type)
+	assert.Equal(t, "To", transfer.Fields[1].Name)
+	assert.Equal(t, "Amount", transfer.Fields[2].Name)
+	assert.Equal(t, EncodingVarInt, transfer.Fields[2].Encoding)
+}
+
+func TestTxSignBytes(t *testing.T) {
+	spec, err := Generate("../..")
+	require.NoError(t, err)
+
+	signBytes := spec.SignBytesOf("tx.Tx.SignBytes")
+	require.NotNil(t, signBytes)
+	assert.Equal(t, EncodingDomain, signBytes.Fields[0].Encoding)
+	for _, f := range signBytes.Fields {
+		assert.NotEqual(t, "Flags", f.Name, "flags are not signed")
+	}
+}
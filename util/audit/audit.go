@@ -0,0 +1,206 @@
+// Package audit keeps an append-only log of the signatures that are produced by the node.
+//
+// Each entry records what was signed, the digest of the sign bytes, the height and round,
+// and who requested the signature. The entries are chained by their hashes,
+// so removing or changing an entry breaks the chain and is detected by Verify.
+package audit
+
+import (
+	"bufio"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/pactus-project/pactus/crypto/hash"
+	"github.com/pactus-project/pactus/util/logger"
+)
+
+// Filename is the name of the audit log file of the node, inside the data directory.
+const Filename = "audit.log"
+
+// Kind is what has been signed.
+type Kind string
+
+const (
+	KindProposal    Kind = "proposal"
+	KindVote        Kind = "vote"
+	KindTransaction Kind = "transaction"
+)
+
+// Entry is a record of a signature in the audit log.
+type Entry struct {
+	Seq       uint64    `json:"seq"`
+	Time      time.Time `json:"time"`
+	Kind      Kind      `json:"kind"`
+	Subject   string    `json:"subject,omitempty"` // Like the type of the vote, or the ID of the transaction
+	Signer    string    `json:"signer"`
+	Digest    string    `json:"digest"` // Hash of the sign bytes
+	Height    uint32    `json:"height,omitempty"`
+	Round     int16     `json:"round,omitempty"`
+	Requester string    `json:"requester"`
+	PrevHash  string    `json:"prev_hash"`
+	Hash      string    `json:"hash"`
+}
+
+// calcHash calculates the hash of the entry, which covers all the fields except the hash itself.
+func (e *Entry) calcHash() (string, error) {
+	cpy := *e
+	cpy.Hash = ""
+	data, err := json.Marshal(cpy)
+	if err != nil {
+		return "", err
+	}
+	h := hash.CalcHash(data)
+
+	return hex.EncodeToString(h.Bytes()), nil
+}
+
+// Digest returns the digest of the sign bytes that is recorded in the entries.
+func Digest(signBytes []byte) string {
+	h := hash.CalcHash(signBytes)
+
+	return hex.EncodeToString(h.Bytes())
+}
+
+// Log is an append-only audit log file.
+type Log struct {
+	lk       sync.Mutex
+	file     *os.File
+	seq      uint64
+	lastHash string
+}
+
+// Open opens the audit log file, or creates it if it doesn't exist.
+// The chain of the existing entries is verified, and a broken chain is returned as an error.
+func Open(path string) (*Log, error) {
+	file, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE|os.O_APPEND, 0o600)
+	if err != nil {
+		return nil, err
+	}
+
+	last, err := Verify(file, nil)
+	if err != nil {
+		_ = file.Close()
+
+		return nil, err
+	}
+
+	return &Log{
+		file:     file,
+		seq:      last.Seq,
+		lastHash: last.Hash,
+	}, nil
+}
+
+// Append chains the entry to the last entry and writes it to the file.
+// The sequence number and the hashes of the entry are set by the log.
+func (l *Log) Append(entry Entry) error {
+	l.lk.Lock()
+	defer l.lk.Unlock()
+
+	entry.Seq = l.seq + 1
+	entry.PrevHash = l.lastHash
+	h, err := entry.calcHash()
+	if err != nil {
+		return err
+	}
+	entry.Hash = h
+
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	if _, err := l.file.Write(append(data, '\n')); err != nil {
+		return err
+	}
+	if err := l.file.Sync(); err != nil {
+		return err
+	}
+
+	l.seq = entry.Seq
+	l.lastHash = entry.Hash
+
+	return nil
+}
+
+// Close closes the audit log file.
+func (l *Log) Close() error {
+	l.lk.Lock()
+	defer l.lk.Unlock()
+
+	return l.file.Close()
+}
+
+// Verify reads the entries and verifies their chain.
+// If fn is not nil, it is called for each entry after verifying it.
+// It returns the last entry, which is empty if there is no entry.
+func Verify(r io.Reader, fn func(Entry) error) (Entry, error) {
+	last := Entry{}
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 4096), 1<<20)
+	for scanner.Scan() {
+		entry := Entry{}
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+			return last, ChainError{Seq: last.Seq + 1, Reason: err.Error()}
+		}
+
+		if entry.Seq != last.Seq+1 {
+			return last, ChainError{Seq: last.Seq + 1, Reason: fmt.Sprintf("found entry %d", entry.Seq)}
+		}
+		if entry.PrevHash != last.Hash {
+			return last, ChainError{Seq: entry.Seq, Reason: "previous hash mismatch"}
+		}
+		h, err := entry.calcHash()
+		if err != nil {
+			return last, err
+		}
+		if entry.Hash != h {
+			return last, ChainError{Seq: entry.Seq, Reason: "hash mismatch"}
+		}
+
+		if fn != nil {
+			if err := fn(entry); err != nil {
+				return last, err
+			}
+		}
+		last = entry
+	}
+
+	return last, scanner.Err()
+}
+
+var (
+	lk        sync.RWMutex
+	globalLog *Log
+)
+
+// SetLog sets the log that the signatures of the node are recorded in.
+// Nil disables recording.
+func SetLog(l *Log) {
+	lk.Lock()
+	defer lk.Unlock()
+
+	globalLog = l
+}
+
+// Record records the signature in the log of the node, if it is set.
+// The time of the entry is set if it is not set.
+func Record(entry Entry) {
+	lk.RLock()
+	defer lk.RUnlock()
+
+	if globalLog == nil {
+		return
+	}
+
+	if entry.Time.IsZero() {
+		entry.Time = time.Now().UTC()
+	}
+	if err := globalLog.Append(entry); err != nil {
+		logger.Error("unable to record the signature in the audit log", "kind", entry.Kind, "error", err)
+	}
+}
@@ -0,0 +1,96 @@
+package audit
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAppendAndVerify(t *testing.T) {
+	path := filepath.Join(t.TempDir(), Filename)
+
+	log, err := Open(path)
+	require.NoError(t, err)
+	require.NoError(t, log.Append(Entry{Kind: KindVote, Signer: "val-1", Digest: Digest([]byte("vote")), Height: 1}))
+	require.NoError(t, log.Append(Entry{Kind: KindProposal, Signer: "val-1", Digest: Digest([]byte("prop")), Height: 2}))
+	require.NoError(t, log.Close())
+
+	t.Run("Reopening continues the chain", func(t *testing.T) {
+		log, err := Open(path)
+		require.NoError(t, err)
+		require.NoError(t, log.Append(Entry{Kind: KindTransaction, Signer: "acc-1", Requester: "wallet"}))
+		require.NoError(t, log.Close())
+
+		data, err := os.ReadFile(path)
+		require.NoError(t, err)
+
+		seqs := []uint64{}
+		last, err := Verify(bytes.NewReader(data), func(e Entry) error {
+			seqs = append(seqs, e.Seq)
+
+			return nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []uint64{1, 2, 3}, seqs)
+		assert.Equal(t, KindTransaction, last.Kind)
+	})
+
+	t.Run("Changing an entry breaks the chain", func(t *testing.T) {
+		data, err := os.ReadFile(path)
+		require.NoError(t, err)
+
+		tampered := strings.Replace(string(data), `"height":2`, `"height":3`, 1)
+		_, err = Verify(strings.NewReader(tampered), nil)
+		assert.ErrorIs(t, err, ChainError{Seq: 2, Reason: "hash mismatch"})
+	})
+
+	t.Run("Removing an entry breaks the chain", func(t *testing.T) {
+		data, err := os.ReadFile(path)
+		require.NoError(t, err)
+
+		lines := strings.SplitAfter(string(data), "\n")
+		_, err = Verify(strings.NewReader(lines[0]+lines[2]), nil)
+		assert.ErrorIs(t, err, ChainError{Seq: 2, Reason: "found entry 3"})
+	})
+
+	t.Run("Broken log can't be opened", func(t *testing.T) {
+		data, err := os.ReadFile(path)
+		require.NoError(t, err)
+
+		brokenPath := filepath.Join(t.TempDir(), Filename)
+		lines := strings.SplitAfter(string(data), "\n")
+		require.NoError(t, os.WriteFile(brokenPath, []byte(lines[1]), 0o600))
+
+		_, err = Open(brokenPath)
+		assert.ErrorIs(t, err, ChainError{Seq: 1, Reason: "found entry 2"})
+	})
+}
+
+func TestRecord(t *testing.T) {
+	path := filepath.Join(t.TempDir(), Filename)
+
+	// Nothing is recorded before setting the log.
+	Record(Entry{Kind: KindVote})
+
+	log, err := Open(path)
+	require.NoError(t, err)
+	SetLog(log)
+	defer SetLog(nil)
+
+	Record(Entry{Kind: KindVote, Signer: "val-1"})
+	require.NoError(t, log.Close())
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+
+	last, err := Verify(bytes.NewReader(data), nil)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), last.Seq)
+	assert.Equal(t, "val-1", last.Signer)
+	assert.False(t, last.Time.IsZero())
+}
@@ -0,0 +1,13 @@
+package audit
+
+import "fmt"
+
+// ChainError is returned when the chain of the audit log is broken.
+type ChainError struct {
+	Seq    uint64
+	Reason string
+}
+
+func (e ChainError) Error() string {
+	return fmt.Sprintf("audit log is broken at entry %d: %s", e.Seq, e.Reason)
+}
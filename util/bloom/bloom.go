@@ -0,0 +1,99 @@
+// Package bloom implements a simple bloom filter that is used to index
+// the addresses touched by the transactions of a block.
+//
+// A filter can tell for sure that an item is not added to it,
+// but it may report false positives.
+package bloom
+
+import (
+	"encoding/binary"
+	"errors"
+
+	"github.com/pactus-project/pactus/crypto/hash"
+)
+
+// BitsPerItem is the number of bits allocated for each item. Together with
+// HashCount, it keeps the false positive rate about 1%.
+const (
+	BitsPerItem = 10
+	HashCount   = 7
+)
+
+var ErrInvalidFilter = errors.New("invalid bloom filter")
+
+// Filter is a bloom filter. The position of the bits for an item are
+// calculated by double hashing the Blake2b-256 hash of the item:
+// pos(i) = (h1 + i*h2) mod m, where h1 and h2 are the first and the second
+// 8 bytes of the hash in little-endian, and m is the number of the bits.
+type Filter struct {
+	bits      []byte
+	hashCount uint8
+}
+
+// New creates a new filter sized for the given number of items.
+func New(numItems int) *Filter {
+	numBytes := (numItems*BitsPerItem + 7) / 8
+	if numBytes == 0 {
+		numBytes = 1
+	}
+
+	return &Filter{
+		bits:      make([]byte, numBytes),
+		hashCount: HashCount,
+	}
+}
+
+// FromBytes constructs a filter from its bits and the number of hash functions.
+func FromBytes(bits []byte, hashCount uint8) (*Filter, error) {
+	if len(bits) == 0 || hashCount == 0 {
+		return nil, ErrInvalidFilter
+	}
+
+	return &Filter{
+		bits:      bits,
+		hashCount: hashCount,
+	}, nil
+}
+
+// Add adds the item to the filter.
+func (f *Filter) Add(item []byte) {
+	for _, pos := range f.positions(item) {
+		f.bits[pos/8] |= 1 << (pos % 8)
+	}
+}
+
+// Contains returns false if the item is definitely not added to the filter.
+// If it returns true, the item may be added to the filter.
+func (f *Filter) Contains(item []byte) bool {
+	for _, pos := range f.positions(item) {
+		if f.bits[pos/8]&(1<<(pos%8)) == 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Bytes returns the bits of the filter.
+func (f *Filter) Bytes() []byte {
+	return f.bits
+}
+
+// HashCount returns the number of hash functions of the filter.
+func (f *Filter) HashCount() uint8 {
+	return f.hashCount
+}
+
+func (f *Filter) positions(item []byte) []uint64 {
+	h := hash.Hash256(item)
+	h1 := binary.LittleEndian.Uint64(h[0:8])
+	h2 := binary.LittleEndian.Uint64(h[8:16])
+	m := uint64(len(f.bits)) * 8
+
+	positions := make([]uint64, f.hashCount)
+	for i := range positions {
+		positions[i] = (h1 + uint64(i)*h2) % m
+	}
+
+	return positions
+}
@@ -0,0 +1,60 @@
+package bloom
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddAndContains(t *testing.T) {
+	filter := New(100)
+	for i := 0; i < 100; i++ {
+		filter.Add([]byte(fmt.Sprintf("item-%d", i)))
+	}
+
+	for i := 0; i < 100; i++ {
+		assert.True(t, filter.Contains([]byte(fmt.Sprintf("item-%d", i))))
+	}
+
+	falsePositives := 0
+	for i := 0; i < 10000; i++ {
+		if filter.Contains([]byte(fmt.Sprintf("other-%d", i))) {
+			falsePositives++
+		}
+	}
+	// The expected false positive rate is about 1%.
+	assert.Less(t, falsePositives, 300)
+}
+
+func TestEmptyFilter(t *testing.T) {
+	filter := New(0)
+
+	assert.Len(t, filter.Bytes(), 1)
+	assert.False(t, filter.Contains([]byte("item")))
+}
+
+func TestFromBytes(t *testing.T) {
+	filter := New(10)
+	filter.Add([]byte("item"))
+
+	filter2, err := FromBytes(filter.Bytes(), filter.HashCount())
+	require.NoError(t, err)
+	assert.True(t, filter2.Contains([]byte("item")))
+	assert.Equal(t, filter, filter2)
+
+	_, err = FromBytes(nil, HashCount)
+	assert.ErrorIs(t, err, ErrInvalidFilter)
+
+	_, err = FromBytes(filter.Bytes(), 0)
+	assert.ErrorIs(t, err, ErrInvalidFilter)
+}
+
+func TestDeterministic(t *testing.T) {
+	filter := New(1)
+	filter.Add([]byte("item"))
+
+	// The positions are stable across releases, since clients may evaluate the filters locally.
+	assert.Equal(t, []uint64{4, 3, 2, 1, 0, 15, 14}, filter.positions([]byte("item")))
+}
@@ -0,0 +1,122 @@
+// Package deprecation keeps a registry of the deprecated config keys, RPC fields and CLI flags.
+//
+// Using a deprecated item logs a warning with its replacement, once per item,
+// and counts the uses, so the operators can find the deprecations that they still rely on
+// before the items are removed.
+package deprecation
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/pactus-project/pactus/util/logger"
+)
+
+// Kind is the kind of a deprecated item.
+type Kind string
+
+const (
+	KindConfig Kind = "config"
+	KindRPC    Kind = "rpc"
+	KindFlag   Kind = "flag"
+)
+
+// Deprecation describes a deprecated item.
+type Deprecation struct {
+	Kind Kind
+	Name string
+	// Replacement is empty if the item is removed without replacement.
+	Replacement string
+	// Since is the version that deprecated the item.
+	Since string
+}
+
+// Entry is a registered deprecation with the number of its uses.
+type Entry struct {
+	Deprecation
+	UseCount uint64
+}
+
+type key struct {
+	kind Kind
+	name string
+}
+
+var (
+	lk      sync.Mutex
+	entries = make(map[key]*Entry)
+)
+
+// Register adds the deprecation to the registry.
+// Registering an item again updates its description and keeps its uses.
+func Register(d Deprecation) {
+	lk.Lock()
+	defer lk.Unlock()
+
+	register(d)
+}
+
+func register(d Deprecation) *Entry {
+	k := key{kind: d.Kind, name: d.Name}
+	entry, ok := entries[k]
+	if !ok {
+		entry = &Entry{}
+		entries[k] = entry
+	}
+	entry.Deprecation = d
+
+	return entry
+}
+
+// Use records a use of the deprecated item, and registers it if it is not registered.
+// The first use of an item logs a warning with its replacement.
+func Use(d Deprecation) {
+	lk.Lock()
+	entry, ok := entries[key{kind: d.Kind, name: d.Name}]
+	if !ok {
+		entry = register(d)
+	}
+	entry.UseCount++
+	first := entry.UseCount == 1
+	d = entry.Deprecation
+	lk.Unlock()
+
+	if first {
+		logger.Warn("deprecated item is used", "kind", d.Kind, "name", d.Name,
+			"replacement", d.Replacement, "since", d.Since)
+	}
+}
+
+// List returns the registered deprecations, sorted by kind and name.
+// If onlyUsed is set, the deprecations that are not used are skipped.
+func List(onlyUsed bool) []Entry {
+	lk.Lock()
+	defer lk.Unlock()
+
+	list := make([]Entry, 0, len(entries))
+	for _, entry := range entries {
+		if onlyUsed && entry.UseCount == 0 {
+			continue
+		}
+		list = append(list, *entry)
+	}
+
+	sort.Slice(list, func(i, j int) bool {
+		if list[i].Kind != list[j].Kind {
+			return list[i].Kind < list[j].Kind
+		}
+
+		return list[i].Name < list[j].Name
+	})
+
+	return list
+}
+
+func (d Deprecation) String() string {
+	if d.Replacement == "" {
+		return fmt.Sprintf("%s `%s` is deprecated without replacement", d.Kind, d.Name)
+	}
+
+	return fmt.Sprintf("%s `%s` is deprecated, use `%s` instead", d.Kind, d.Name, d.Replacement)
+}
@@ -0,0 +1,63 @@
+package deprecation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func findEntry(list []Entry, kind Kind, name string) *Entry {
+	for _, entry := range list {
+		if entry.Kind == kind && entry.Name == name {
+			return &entry
+		}
+	}
+
+	return nil
+}
+
+func TestRegisterAndUse(t *testing.T) {
+	d := Deprecation{Kind: KindConfig, Name: "test.old_key", Replacement: "test.new_key", Since: "1.0.0"}
+	Register(d)
+
+	entry := findEntry(List(false), KindConfig, "test.old_key")
+	assert.NotNil(t, entry)
+	assert.Equal(t, d, entry.Deprecation)
+	assert.Zero(t, entry.UseCount)
+	assert.Nil(t, findEntry(List(true), KindConfig, "test.old_key"))
+
+	Use(Deprecation{Kind: KindConfig, Name: "test.old_key"})
+	Use(Deprecation{Kind: KindConfig, Name: "test.old_key"})
+
+	entry = findEntry(List(true), KindConfig, "test.old_key")
+	assert.NotNil(t, entry)
+	assert.Equal(t, uint64(2), entry.UseCount)
+	assert.Equal(t, "test.new_key", entry.Replacement, "registered description should be kept")
+}
+
+func TestUseUnregistered(t *testing.T) {
+	Use(Deprecation{Kind: KindRPC, Name: "test.Request.field"})
+
+	entry := findEntry(List(true), KindRPC, "test.Request.field")
+	assert.NotNil(t, entry)
+	assert.Equal(t, uint64(1), entry.UseCount)
+}
+
+func TestListSorted(t *testing.T) {
+	Register(Deprecation{Kind: KindFlag, Name: "test-b"})
+	Register(Deprecation{Kind: KindFlag, Name: "test-a"})
+	Register(Deprecation{Kind: KindConfig, Name: "test.z"})
+
+	list := List(false)
+	for i := 1; i < len(list); i++ {
+		prev, cur := list[i-1], list[i]
+		assert.True(t, prev.Kind < cur.Kind || (prev.Kind == cur.Kind && prev.Name < cur.Name))
+	}
+}
+
+func TestString(t *testing.T) {
+	assert.Equal(t, "flag `server` is deprecated, use `server-addr` instead",
+		Deprecation{Kind: KindFlag, Name: "server", Replacement: "server-addr"}.String())
+	assert.Equal(t, "config `network.name` is deprecated without replacement",
+		Deprecation{Kind: KindConfig, Name: "network.name"}.String())
+}
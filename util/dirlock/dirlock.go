@@ -0,0 +1,79 @@
+// Package dirlock provides an exclusive lock on a directory, so only one process
+// can use the directory at a time. The lock is released by the operating system
+// when the process exits, so a crashed process doesn't leave a stale lock.
+package dirlock
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	flock "github.com/gofrs/flock"
+)
+
+// LockFileName is the name of the lock file in the locked directory.
+const LockFileName = ".pactus.lock"
+
+// LockedError is returned when the directory is locked by another process.
+type LockedError struct {
+	Path string
+	PID  int // Zero if the process is not known
+}
+
+func (e LockedError) Error() string {
+	if e.PID == 0 {
+		return fmt.Sprintf("directory '%s' is locked by another instance", e.Path)
+	}
+
+	return fmt.Sprintf("directory '%s' is locked by another instance, process id: %d", e.Path, e.PID)
+}
+
+// Lock is an exclusive lock on a directory.
+type Lock struct {
+	fileLock *flock.Flock
+}
+
+// Acquire locks the directory. The directory is created if it doesn't exist.
+// If the directory is locked by another process, a LockedError is returned
+// with the process id of the owner, if it is known.
+func Acquire(dir string) (*Lock, error) {
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		return nil, err
+	}
+
+	lockPath := filepath.Join(dir, LockFileName)
+	fileLock := flock.New(lockPath)
+	locked, err := fileLock.TryLock()
+	if err != nil {
+		return nil, err
+	}
+	if !locked {
+		return nil, LockedError{Path: dir, PID: ownerPID(lockPath)}
+	}
+
+	// The process id helps the users to find the owner of the lock.
+	// On Windows, the locked file can't be written, so the process id is not kept.
+	_ = os.WriteFile(lockPath, []byte(strconv.Itoa(os.Getpid())), 0o600)
+
+	return &Lock{fileLock: fileLock}, nil
+}
+
+// Release unlocks the directory.
+func (l *Lock) Release() error {
+	return l.fileLock.Unlock()
+}
+
+func ownerPID(lockPath string) int {
+	data, err := os.ReadFile(lockPath)
+	if err != nil {
+		return 0
+	}
+	pid, err := strconv.Atoi(strings.TrimSpace(string(data)))
+	if err != nil {
+		return 0
+	}
+
+	return pid
+}
@@ -0,0 +1,47 @@
+package dirlock
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAcquire(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "data")
+
+	lock, err := Acquire(dir)
+	require.NoError(t, err)
+	assert.DirExists(t, dir)
+
+	t.Run("The locked directory can't be locked again", func(t *testing.T) {
+		_, err := Acquire(dir)
+
+		lockedErr := LockedError{}
+		require.ErrorAs(t, err, &lockedErr)
+		assert.Equal(t, dir, lockedErr.Path)
+		if runtime.GOOS != "windows" {
+			assert.Equal(t, os.Getpid(), lockedErr.PID)
+			assert.Contains(t, err.Error(), "process id")
+		}
+	})
+
+	t.Run("The released directory can be locked again", func(t *testing.T) {
+		require.NoError(t, lock.Release())
+
+		lock, err = Acquire(dir)
+		require.NoError(t, err)
+		assert.NoError(t, lock.Release())
+	})
+}
+
+func TestLockedError(t *testing.T) {
+	err := LockedError{Path: "data"}
+	assert.Equal(t, "directory 'data' is locked by another instance", err.Error())
+
+	err = LockedError{Path: "data", PID: 123}
+	assert.Equal(t, "directory 'data' is locked by another instance, process id: 123", err.Error())
+}
@@ -0,0 +1,7 @@
+// Package disk provides information about the disks.
+package disk
+
+import "errors"
+
+// ErrNotSupported is returned when the information is not available on this platform.
+var ErrNotSupported = errors.New("not supported on this platform")
@@ -0,0 +1,9 @@
+//go:build windows || js || wasip1
+
+package disk
+
+// FreeSpace returns the free space, in bytes, that is available to the
+// unprivileged users on the disk that holds the given path.
+func FreeSpace(_ string) (uint64, error) {
+	return 0, ErrNotSupported
+}
@@ -0,0 +1,21 @@
+package disk
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/pactus-project/pactus/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFreeSpace(t *testing.T) {
+	free, err := FreeSpace(util.TempDirPath())
+	if err == ErrNotSupported {
+		t.Skip(err)
+	}
+	assert.NoError(t, err)
+	assert.Positive(t, free)
+
+	_, err = FreeSpace(filepath.Join(util.TempDirPath(), "not-exist"))
+	assert.Error(t, err)
+}
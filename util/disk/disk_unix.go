@@ -0,0 +1,18 @@
+//go:build !windows && !js && !wasip1
+
+package disk
+
+import (
+	"syscall"
+)
+
+// FreeSpace returns the free space, in bytes, that is available to the
+// unprivileged users on the disk that holds the given path.
+func FreeSpace(path string) (uint64, error) {
+	var stat syscall.Statfs_t
+	if err := syscall.Statfs(path, &stat); err != nil {
+		return 0, err
+	}
+
+	return stat.Bavail * uint64(stat.Bsize), nil
+}
@@ -1,9 +1,12 @@
 package errors
 
 import (
+	"errors"
 	"fmt"
 )
 
+// Error codes are exposed to the clients through the RPC interfaces.
+// To keep them stable, never reorder or remove a code; add new codes before ErrCount.
 const (
 	ErrNone = iota
 	ErrGeneric
@@ -24,30 +27,42 @@ const (
 	ErrInvalidMessage
 	ErrInvalidConfig
 	ErrDuplicateVote
+	ErrInsufficientFunds
+	ErrPastLockTime
+	ErrFutureLockTime
+	ErrTxCommitted
+	ErrUnknownPayloadType
+	ErrRejectedTx
 
 	ErrCount
 )
 
 var messages = map[int]string{
-	ErrNone:              "no error",
-	ErrGeneric:           "generic error",
-	ErrInvalidBlock:      "invalid block",
-	ErrInvalidAmount:     "invalid amount",
-	ErrInvalidFee:        "invalid fee",
-	ErrInvalidAddress:    "invalid address",
-	ErrInvalidPublicKey:  "invalid public key",
-	ErrInvalidPrivateKey: "invalid private key",
-	ErrInvalidSignature:  "invalid signature",
-	ErrInvalidTx:         "invalid transaction",
-	ErrInvalidMemo:       "invalid memo",
-	ErrInvalidProof:      "invalid proof",
-	ErrInvalidHeight:     "invalid height",
-	ErrInvalidRound:      "invalid round",
-	ErrInvalidProposal:   "invalid proposal",
-	ErrInvalidVote:       "invalid vote",
-	ErrInvalidMessage:    "invalid message",
-	ErrInvalidConfig:     "invalid config",
-	ErrDuplicateVote:     "duplicate vote",
+	ErrNone:               "no error",
+	ErrGeneric:            "generic error",
+	ErrInvalidBlock:       "invalid block",
+	ErrInvalidAmount:      "invalid amount",
+	ErrInvalidFee:         "invalid fee",
+	ErrInvalidAddress:     "invalid address",
+	ErrInvalidPublicKey:   "invalid public key",
+	ErrInvalidPrivateKey:  "invalid private key",
+	ErrInvalidSignature:   "invalid signature",
+	ErrInvalidTx:          "invalid transaction",
+	ErrInvalidMemo:        "invalid memo",
+	ErrInvalidProof:       "invalid proof",
+	ErrInvalidHeight:      "invalid height",
+	ErrInvalidRound:       "invalid round",
+	ErrInvalidProposal:    "invalid proposal",
+	ErrInvalidVote:        "invalid vote",
+	ErrInvalidMessage:     "invalid message",
+	ErrInvalidConfig:      "invalid config",
+	ErrDuplicateVote:      "duplicate vote",
+	ErrInsufficientFunds:  "insufficient funds",
+	ErrPastLockTime:       "lock time is in the past",
+	ErrFutureLockTime:     "lock time is in the future",
+	ErrTxCommitted:        "transaction committed before",
+	ErrUnknownPayloadType: "unknown payload type",
+	ErrRejectedTx:         "transaction rejected",
 }
 
 type withCodeError struct {
@@ -87,8 +102,8 @@ func Code(err error) int {
 	if err == nil {
 		return ErrNone
 	}
-	_e, ok := err.(i) //nolint
-	if !ok {
+	var _e i
+	if !errors.As(err, &_e) {
 		return ErrGeneric
 	}
 
@@ -15,6 +15,9 @@ func TestCode(t *testing.T) {
 	assert.Equal(t, Code(err2), ErrGeneric)
 
 	assert.Equal(t, Code(nil), ErrNone)
+
+	err3 := fmt.Errorf("wrapped: %w", Error(ErrInsufficientFunds))
+	assert.Equal(t, Code(err3), ErrInsufficientFunds)
 }
 
 func TestMessages(t *testing.T) {
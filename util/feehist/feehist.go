@@ -0,0 +1,201 @@
+// Package feehist implements a compact histogram of the transaction fees,
+// that is kept per block, so the fee percentiles of any range of the blocks
+// can be calculated without reading the blocks.
+//
+// The fees are grouped in log-linear buckets: the fees below 16 have their own buckets,
+// and each power of two above it is split into 8 buckets.
+// A fee is rounded down to the lower bound of its bucket, so the relative error
+// of the percentiles is less than 1/8.
+package feehist
+
+import (
+	"encoding/binary"
+	"errors"
+	"math/bits"
+	"sort"
+)
+
+// subBucketBits is the number of the bits that split each power of two into the buckets.
+const subBucketBits = 3
+
+const (
+	subBuckets  = 1 << subBucketBits
+	exactFees   = 2 * subBuckets // The fees below it have their own buckets
+	maxBucketID = (64-subBucketBits)*subBuckets - 1
+)
+
+var ErrInvalidHistogram = errors.New("invalid fee histogram")
+
+// Bucket is a bucket of the histogram.
+type Bucket struct {
+	ID    int
+	Count uint64
+}
+
+// Histogram is a histogram of the fees. The buckets are sorted by their ID,
+// and the empty buckets are not kept.
+type Histogram struct {
+	buckets []Bucket
+}
+
+// New creates an empty histogram.
+func New() *Histogram {
+	return &Histogram{}
+}
+
+// BucketID returns the ID of the bucket of the fee.
+// The negative fees are counted as zero.
+func BucketID(fee int64) int {
+	if fee < exactFees {
+		if fee < 0 {
+			return 0
+		}
+
+		return int(fee)
+	}
+
+	exp := bits.Len64(uint64(fee)) - (subBucketBits + 1)
+	mantissa := int(uint64(fee) >> exp)
+
+	return exp*subBuckets + mantissa
+}
+
+// LowerBound returns the lowest fee of the bucket.
+func LowerBound(id int) int64 {
+	if id < exactFees {
+		return int64(id)
+	}
+
+	exp := id/subBuckets - 1
+	mantissa := int64(id%subBuckets + subBuckets)
+
+	return mantissa << exp
+}
+
+// Add adds a fee to the histogram.
+func (h *Histogram) Add(fee int64) {
+	h.addCount(BucketID(fee), 1)
+}
+
+func (h *Histogram) addCount(id int, count uint64) {
+	i := sort.Search(len(h.buckets), func(i int) bool {
+		return h.buckets[i].ID >= id
+	})
+	if i < len(h.buckets) && h.buckets[i].ID == id {
+		h.buckets[i].Count += count
+
+		return
+	}
+
+	h.buckets = append(h.buckets, Bucket{})
+	copy(h.buckets[i+1:], h.buckets[i:])
+	h.buckets[i] = Bucket{ID: id, Count: count}
+}
+
+// Merge adds the fees of the other histogram to this one.
+func (h *Histogram) Merge(other *Histogram) {
+	for _, b := range other.buckets {
+		h.addCount(b.ID, b.Count)
+	}
+}
+
+// Buckets returns the non-empty buckets of the histogram, sorted by their ID.
+func (h *Histogram) Buckets() []Bucket {
+	return h.buckets
+}
+
+// Count returns the number of the fees in the histogram.
+func (h *Histogram) Count() uint64 {
+	count := uint64(0)
+	for _, b := range h.buckets {
+		count += b.Count
+	}
+
+	return count
+}
+
+// Percentile returns the fee at the percentile, between 0 and 100, using the nearest-rank method.
+// The fee is the lower bound of its bucket. It returns zero if the histogram is empty.
+func (h *Histogram) Percentile(p uint32) int64 {
+	count := h.Count()
+	if count == 0 {
+		return 0
+	}
+
+	rank := (uint64(p)*count + 99) / 100
+	if rank < 1 {
+		rank = 1
+	}
+
+	seen := uint64(0)
+	for _, b := range h.buckets {
+		seen += b.Count
+		if seen >= rank {
+			return LowerBound(b.ID)
+		}
+	}
+
+	return LowerBound(h.buckets[len(h.buckets)-1].ID)
+}
+
+// Bytes encodes the histogram as: [number of buckets] followed by [ID delta][count] for each bucket.
+// All the numbers are unsigned varints, and the ID delta is the difference from the previous ID.
+func (h *Histogram) Bytes() []byte {
+	buf := make([]byte, 0, binary.MaxVarintLen16*(1+2*len(h.buckets)))
+	buf = binary.AppendUvarint(buf, uint64(len(h.buckets)))
+
+	prevID := 0
+	for _, b := range h.buckets {
+		buf = binary.AppendUvarint(buf, uint64(b.ID-prevID))
+		buf = binary.AppendUvarint(buf, b.Count)
+		prevID = b.ID
+	}
+
+	return buf
+}
+
+// FromBytes decodes a histogram that is encoded by Bytes.
+func FromBytes(data []byte) (*Histogram, error) {
+	readUvarint := func() (uint64, error) {
+		val, n := binary.Uvarint(data)
+		if n <= 0 {
+			return 0, ErrInvalidHistogram
+		}
+		data = data[n:]
+
+		return val, nil
+	}
+
+	num, err := readUvarint()
+	if err != nil {
+		return nil, err
+	}
+	if num > maxBucketID+1 {
+		return nil, ErrInvalidHistogram
+	}
+
+	h := &Histogram{buckets: make([]Bucket, 0, num)}
+	prevID := 0
+	for i := uint64(0); i < num; i++ {
+		delta, err := readUvarint()
+		if err != nil {
+			return nil, err
+		}
+		count, err := readUvarint()
+		if err != nil {
+			return nil, err
+		}
+
+		id := prevID + int(delta)
+		if (i > 0 && delta == 0) || delta > maxBucketID || id > maxBucketID || count == 0 {
+			return nil, ErrInvalidHistogram
+		}
+		h.buckets = append(h.buckets, Bucket{ID: id, Count: count})
+		prevID = id
+	}
+	if len(data) != 0 {
+		return nil, ErrInvalidHistogram
+	}
+
+	return h, nil
+}
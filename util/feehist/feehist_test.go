@@ -0,0 +1,116 @@
+package feehist
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuckets(t *testing.T) {
+	tests := []struct {
+		fee        int64
+		id         int
+		lowerBound int64
+	}{
+		{-1, 0, 0},
+		{0, 0, 0},
+		{1, 1, 1},
+		{15, 15, 15},
+		{16, 16, 16},
+		{17, 16, 16},
+		{18, 17, 18},
+		{31, 23, 30},
+		{32, 24, 32},
+		{1000, 63, 960},
+		{1e7, 169, 9437184},
+		{math.MaxInt64, maxBucketID, 15 << 59},
+	}
+
+	for _, tt := range tests {
+		id := BucketID(tt.fee)
+		assert.Equal(t, tt.id, id, "fee: %d", tt.fee)
+		assert.Equal(t, tt.lowerBound, LowerBound(id), "fee: %d", tt.fee)
+	}
+
+	t.Run("Relative error is less than 1/8", func(t *testing.T) {
+		for fee := int64(1); fee < 1e12; fee = fee*3 + 1 {
+			lower := LowerBound(BucketID(fee))
+			assert.LessOrEqual(t, lower, fee)
+			assert.Less(t, float64(fee-lower)/float64(fee), 1.0/8)
+		}
+	})
+}
+
+func TestPercentile(t *testing.T) {
+	h := New()
+	assert.Zero(t, h.Count())
+	assert.Zero(t, h.Percentile(50))
+
+	for _, fee := range []int64{1000, 2000, 3000, 4000, 10_000_000} {
+		h.Add(fee)
+	}
+
+	assert.Equal(t, uint64(5), h.Count())
+	assert.Equal(t, LowerBound(BucketID(1000)), h.Percentile(0))
+	assert.Equal(t, LowerBound(BucketID(1000)), h.Percentile(20))
+	assert.Equal(t, LowerBound(BucketID(3000)), h.Percentile(50))
+	assert.Equal(t, LowerBound(BucketID(4000)), h.Percentile(80))
+	assert.Equal(t, LowerBound(BucketID(10_000_000)), h.Percentile(100))
+}
+
+func TestMerge(t *testing.T) {
+	h1 := New()
+	h1.Add(10)
+	h1.Add(1000)
+
+	h2 := New()
+	h2.Add(1000)
+	h2.Add(5)
+
+	h1.Merge(h2)
+	assert.Equal(t, []Bucket{
+		{ID: 5, Count: 1},
+		{ID: 10, Count: 1},
+		{ID: BucketID(1000), Count: 2},
+	}, h1.Buckets())
+}
+
+func TestEncoding(t *testing.T) {
+	t.Run("Empty histogram", func(t *testing.T) {
+		data := New().Bytes()
+		assert.Equal(t, []byte{0}, data)
+
+		h, err := FromBytes(data)
+		require.NoError(t, err)
+		assert.Zero(t, h.Count())
+	})
+
+	t.Run("Round trip", func(t *testing.T) {
+		h := New()
+		for _, fee := range []int64{0, 10, 1000, 1000, 1e9, math.MaxInt64} {
+			h.Add(fee)
+		}
+
+		h2, err := FromBytes(h.Bytes())
+		require.NoError(t, err)
+		assert.Equal(t, h.Buckets(), h2.Buckets())
+	})
+
+	t.Run("Invalid data", func(t *testing.T) {
+		invalids := [][]byte{
+			{},
+			{1},                // Missing bucket
+			{1, 5},             // Missing count
+			{1, 5, 0},          // Empty bucket
+			{2, 5, 1, 0, 1},    // Duplicated bucket
+			{1, 5, 1, 0},       // Extra data
+			{1, 0xe8, 0x03, 1}, // Bucket ID is out of range
+		}
+		for _, data := range invalids {
+			_, err := FromBytes(data)
+			assert.ErrorIs(t, err, ErrInvalidHistogram, "data: %x", data)
+		}
+	})
+}
@@ -0,0 +1,89 @@
+package gcs
+
+import "io"
+
+type bitWriter struct {
+	data []byte
+	nBit uint8 // Number of the bits used in the last byte
+}
+
+func newBitWriter() *bitWriter {
+	return &bitWriter{}
+}
+
+func (w *bitWriter) writeBit(bit bool) {
+	if w.nBit == 0 {
+		w.data = append(w.data, 0)
+		w.nBit = 8
+	}
+	w.nBit--
+	if bit {
+		w.data[len(w.data)-1] |= 1 << w.nBit
+	}
+}
+
+func (w *bitWriter) writeBits(value uint64, n uint8) {
+	for i := int(n) - 1; i >= 0; i-- {
+		w.writeBit(value&(1<<uint(i)) != 0)
+	}
+}
+
+// writeGolombRice writes the quotient in unary and the remainder in P bits.
+func (w *bitWriter) writeGolombRice(value uint64) {
+	for q := value >> P; q > 0; q-- {
+		w.writeBit(true)
+	}
+	w.writeBit(false)
+	w.writeBits(value, P)
+}
+
+func (w *bitWriter) bytes() []byte {
+	return w.data
+}
+
+type bitReader struct {
+	data []byte
+	pos  uint64 // Position of the next bit
+}
+
+func newBitReader(data []byte) *bitReader {
+	return &bitReader{data: data}
+}
+
+func (r *bitReader) readBit() (bool, error) {
+	if r.pos >= uint64(len(r.data))*8 {
+		return false, io.EOF
+	}
+	bit := r.data[r.pos/8]&(1<<(7-r.pos%8)) != 0
+	r.pos++
+
+	return bit, nil
+}
+
+func (r *bitReader) readGolombRice() (uint64, error) {
+	q := uint64(0)
+	for {
+		bit, err := r.readBit()
+		if err != nil {
+			return 0, err
+		}
+		if !bit {
+			break
+		}
+		q++
+	}
+
+	rem := uint64(0)
+	for i := 0; i < P; i++ {
+		bit, err := r.readBit()
+		if err != nil {
+			return 0, err
+		}
+		rem <<= 1
+		if bit {
+			rem |= 1
+		}
+	}
+
+	return q<<P | rem, nil
+}
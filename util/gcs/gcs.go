@@ -0,0 +1,139 @@
+// Package gcs implements Golomb-coded sets, the compact filters that are
+// described in BIP-158.
+//
+// Unlike the bloom filters, the compact filters are close to the optimal size.
+// The filter of a block is built from the items touched by the block, and clients
+// can match their items against the filter locally, without revealing them.
+//
+// The items are hashed by the 64-bit keyed Blake2b, instead of SipHash in BIP-158.
+// The key is the hash of the block.
+package gcs
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"math/bits"
+	"slices"
+
+	"github.com/pactus-project/pactus/crypto/hash"
+	"github.com/pactus-project/pactus/util/encoding"
+	"golang.org/x/crypto/blake2b"
+)
+
+// The parameters of the filters, same as the basic filters of BIP-158.
+// P is the number of the bits of the remainders in the Golomb-Rice coding and
+// 1/M is the false positive rate.
+const (
+	P = 19
+	M = 784931
+)
+
+var ErrInvalidFilter = errors.New("invalid compact filter")
+
+// Filter is a Golomb-coded set of the items.
+type Filter struct {
+	n    uint32
+	data []byte
+}
+
+// Build builds a filter from the items, keyed by the given hash.
+func Build(key hash.Hash, items [][]byte) *Filter {
+	n := uint32(len(items))
+	values := hashedValues(key, items, n)
+	slices.Sort(values)
+
+	w := newBitWriter()
+	last := uint64(0)
+	for _, v := range values {
+		w.writeGolombRice(v - last)
+		last = v
+	}
+
+	return &Filter{
+		n:    n,
+		data: w.bytes(),
+	}
+}
+
+// FromBytes constructs a filter from its serialized bytes.
+func FromBytes(data []byte) (*Filter, error) {
+	r := bytes.NewReader(data)
+	n, err := encoding.ReadVarInt(r)
+	if err != nil || n > uint64(^uint32(0)) {
+		return nil, ErrInvalidFilter
+	}
+
+	return &Filter{
+		n:    uint32(n),
+		data: data[len(data)-r.Len():],
+	}, nil
+}
+
+// N returns the number of the items in the filter.
+func (f *Filter) N() uint32 {
+	return f.n
+}
+
+// Bytes returns the serialized bytes of the filter: [N: varint]+[Golomb-Rice coded data].
+func (f *Filter) Bytes() []byte {
+	w := bytes.NewBuffer(make([]byte, 0, encoding.VarIntSerializeSize(uint64(f.n))+len(f.data)))
+	_ = encoding.WriteVarInt(w, uint64(f.n))
+	w.Write(f.data)
+
+	return w.Bytes()
+}
+
+// Match returns true if the item may be in the filter.
+// False positives happen with the probability of 1/M.
+func (f *Filter) Match(key hash.Hash, item []byte) bool {
+	return f.MatchAny(key, [][]byte{item})
+}
+
+// MatchAny returns true if any of the items may be in the filter.
+func (f *Filter) MatchAny(key hash.Hash, items [][]byte) bool {
+	if f.n == 0 || len(items) == 0 {
+		return false
+	}
+
+	targets := hashedValues(key, items, f.n)
+	slices.Sort(targets)
+
+	r := newBitReader(f.data)
+	value := uint64(0)
+	for i := uint32(0); i < f.n; i++ {
+		delta, err := r.readGolombRice()
+		if err != nil {
+			return false
+		}
+		value += delta
+
+		for len(targets) > 0 && targets[0] < value {
+			targets = targets[1:]
+		}
+		if len(targets) == 0 {
+			return false
+		}
+		if targets[0] == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+// hashedValues maps the items uniformly to the range of [0, n*M).
+func hashedValues(key hash.Hash, items [][]byte, n uint32) []uint64 {
+	rng := uint64(n) * M
+	values := make([]uint64, 0, len(items))
+	for _, item := range items {
+		h, _ := blake2b.New(8, key.Bytes())
+		h.Write(item)
+		sum := binary.LittleEndian.Uint64(h.Sum(nil))
+
+		hi, _ := bits.Mul64(sum, rng)
+		values = append(values, hi)
+	}
+
+	return values
+}
@@ -0,0 +1,107 @@
+package gcs
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/pactus-project/pactus/util/testsuite"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func makeItems(prefix string, count int) [][]byte {
+	items := make([][]byte, 0, count)
+	for i := 0; i < count; i++ {
+		items = append(items, []byte(fmt.Sprintf("%s-%d", prefix, i)))
+	}
+
+	return items
+}
+
+func TestMatch(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	key := ts.RandHash()
+	items := makeItems("item", 100)
+	filter := Build(key, items)
+	assert.Equal(t, uint32(100), filter.N())
+
+	for _, item := range items {
+		assert.True(t, filter.Match(key, item))
+	}
+
+	others := makeItems("other", 1000)
+	for _, item := range others {
+		assert.False(t, filter.Match(key, item))
+	}
+	assert.False(t, filter.MatchAny(key, others))
+	assert.True(t, filter.MatchAny(key, append(others, items[50])))
+
+	// The filter depends on the key
+	assert.False(t, filter.MatchAny(ts.RandHash(), items[:10]))
+}
+
+func TestEmptyFilter(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	key := ts.RandHash()
+	filter := Build(key, nil)
+
+	assert.Zero(t, filter.N())
+	assert.Equal(t, []byte{0}, filter.Bytes())
+	assert.False(t, filter.Match(key, []byte("item")))
+	assert.False(t, filter.MatchAny(key, nil))
+}
+
+func TestFromBytes(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	key := ts.RandHash()
+	items := makeItems("item", 10)
+	filter := Build(key, items)
+
+	filter2, err := FromBytes(filter.Bytes())
+	require.NoError(t, err)
+	assert.Equal(t, filter, filter2)
+	assert.True(t, filter2.Match(key, items[0]))
+
+	_, err = FromBytes(nil)
+	assert.ErrorIs(t, err, ErrInvalidFilter)
+}
+
+func TestTruncatedFilter(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	key := ts.RandHash()
+	items := makeItems("item", 10)
+	data := Build(key, items).Bytes()
+
+	filter, err := FromBytes(data[:len(data)/2])
+	require.NoError(t, err)
+	assert.False(t, filter.Match(key, []byte("other")))
+}
+
+func TestFilterSize(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	filter := Build(ts.RandHash(), makeItems("item", 1000))
+
+	// Each item takes about P+2.5 bits.
+	assert.Less(t, len(filter.Bytes()), 1000*(P+3)/8)
+}
+
+func TestGolombRice(t *testing.T) {
+	values := []uint64{0, 1, 1<<P - 1, 1 << P, 3<<P + 5}
+
+	w := newBitWriter()
+	for _, v := range values {
+		w.writeGolombRice(v)
+	}
+
+	r := newBitReader(w.bytes())
+	for _, v := range values {
+		got, err := r.readGolombRice()
+		require.NoError(t, err)
+		assert.Equal(t, v, got)
+	}
+}
@@ -0,0 +1,181 @@
+// Package i18n translates the user-facing messages of the command-line tools and the wallet.
+//
+// The messages are identified by their English text, so an untranslated message
+// is shown in English. A catalog maps the English messages to their translations
+// for a locale. The catalogs of the supported locales are embedded, and more catalogs
+// can be registered, or loaded from the JSON files, without rebuilding the binaries.
+package i18n
+
+import (
+	"embed"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+// DefaultLocale is the locale of the messages in the source code.
+const DefaultLocale = "en"
+
+// LocaleEnv is the environment variable that overrides the locale of the system.
+const LocaleEnv = "PACTUS_LANG"
+
+// Catalog maps the English messages to their translations.
+type Catalog map[string]string
+
+//go:embed locales/*.json
+var locales embed.FS
+
+var (
+	lk       sync.RWMutex
+	locale   = DefaultLocale
+	catalogs = make(map[string]Catalog)
+)
+
+func init() {
+	entries, _ := locales.ReadDir("locales")
+	for _, entry := range entries {
+		data, _ := locales.ReadFile("locales/" + entry.Name())
+		catalog := Catalog{}
+		if err := json.Unmarshal(data, &catalog); err != nil {
+			panic(fmt.Sprintf("invalid catalog %s: %v", entry.Name(), err))
+		}
+		Register(strings.TrimSuffix(entry.Name(), ".json"), catalog)
+	}
+
+	SetLocale(DetectLocale())
+}
+
+// Register adds the messages of the catalog to the locale.
+// The existing messages of the locale are overwritten.
+func Register(loc string, catalog Catalog) {
+	lk.Lock()
+	defer lk.Unlock()
+
+	loc = normalize(loc)
+	existing, ok := catalogs[loc]
+	if !ok {
+		existing = make(Catalog, len(catalog))
+		catalogs[loc] = existing
+	}
+	for msg, translated := range catalog {
+		existing[msg] = translated
+	}
+}
+
+// LoadDir loads the catalogs from the JSON files in the directory.
+// The name of a file is the locale of its catalog, like `es.json`.
+// It does nothing if the directory doesn't exist.
+func LoadDir(dir string) error {
+	files, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil {
+		return err
+	}
+
+	for _, file := range files {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return err
+		}
+
+		catalog := Catalog{}
+		if err := json.Unmarshal(data, &catalog); err != nil {
+			return fmt.Errorf("invalid catalog %s: %w", file, err)
+		}
+		Register(strings.TrimSuffix(filepath.Base(file), ".json"), catalog)
+	}
+
+	return nil
+}
+
+// DetectLocale returns the locale of the user, based on the environment variables.
+// The `PACTUS_LANG` variable takes precedence over the system locale.
+func DetectLocale() string {
+	for _, env := range []string{LocaleEnv, "LC_ALL", "LC_MESSAGES", "LANG"} {
+		if val := os.Getenv(env); val != "" {
+			return normalize(val)
+		}
+	}
+
+	return DefaultLocale
+}
+
+// SetLocale sets the locale of the messages.
+// The locales like `es_ES.UTF-8` fall back to their language, `es`, if they have no catalog.
+func SetLocale(loc string) {
+	lk.Lock()
+	defer lk.Unlock()
+
+	locale = normalize(loc)
+}
+
+// Locale returns the current locale.
+func Locale() string {
+	lk.RLock()
+	defer lk.RUnlock()
+
+	return locale
+}
+
+// Locales returns the locales that have a catalog.
+func Locales() []string {
+	lk.RLock()
+	defer lk.RUnlock()
+
+	locs := make([]string, 0, len(catalogs))
+	for loc := range catalogs {
+		locs = append(locs, loc)
+	}
+
+	return locs
+}
+
+// T returns the translation of the message in the current locale.
+// If there is no translation, the message itself is returned.
+func T(msg string) string {
+	lk.RLock()
+	defer lk.RUnlock()
+
+	if catalog, ok := catalogs[locale]; ok {
+		if translated, ok := catalog[msg]; ok {
+			return translated
+		}
+	}
+
+	lang, _, found := strings.Cut(locale, "_")
+	if found {
+		if catalog, ok := catalogs[lang]; ok {
+			if translated, ok := catalog[msg]; ok {
+				return translated
+			}
+		}
+	}
+
+	return msg
+}
+
+// Sprintf translates the format, then formats it like fmt.Sprintf.
+func Sprintf(format string, a ...any) string {
+	return fmt.Sprintf(T(format), a...)
+}
+
+// normalize converts the locales like `es-ES.UTF-8` or `es_ES@euro` to `es_ES`.
+// The "C" and "POSIX" locales are converted to the default locale.
+func normalize(loc string) string {
+	loc, _, _ = strings.Cut(loc, ".")
+	loc, _, _ = strings.Cut(loc, "@")
+	loc = strings.ReplaceAll(loc, "-", "_")
+
+	lang, region, found := strings.Cut(loc, "_")
+	lang = strings.ToLower(lang)
+	if lang == "" || lang == "c" || lang == "posix" {
+		return DefaultLocale
+	}
+	if found {
+		return lang + "_" + strings.ToUpper(region)
+	}
+
+	return lang
+}
@@ -0,0 +1,134 @@
+package i18n
+
+import (
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	"github.com/pactus-project/pactus/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func resetLocale(t *testing.T) {
+	t.Helper()
+
+	current := Locale()
+	t.Cleanup(func() { SetLocale(current) })
+}
+
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		locale   string
+		expected string
+	}{
+		{"", "en"},
+		{"C", "en"},
+		{"POSIX", "en"},
+		{"C.UTF-8", "en"},
+		{"es", "es"},
+		{"ES", "es"},
+		{"es_ES.UTF-8", "es_ES"},
+		{"es-es", "es_ES"},
+		{"de_DE@euro", "de_DE"},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.expected, normalize(tt.locale), tt.locale)
+	}
+}
+
+func TestDetectLocale(t *testing.T) {
+	t.Setenv(LocaleEnv, "")
+	t.Setenv("LC_ALL", "")
+	t.Setenv("LC_MESSAGES", "")
+	t.Setenv("LANG", "")
+	assert.Equal(t, DefaultLocale, DetectLocale())
+
+	t.Setenv("LANG", "fr_FR.UTF-8")
+	assert.Equal(t, "fr_FR", DetectLocale())
+
+	t.Setenv("LC_ALL", "de_DE.UTF-8")
+	assert.Equal(t, "de_DE", DetectLocale())
+
+	t.Setenv(LocaleEnv, "es")
+	assert.Equal(t, "es", DetectLocale())
+}
+
+func TestTranslate(t *testing.T) {
+	resetLocale(t)
+
+	SetLocale("en")
+	assert.Equal(t, "Aborted.", T("Aborted."))
+
+	SetLocale("es")
+	assert.Equal(t, "Cancelado.", T("Aborted."))
+	assert.Equal(t, "not translated", T("not translated"))
+	assert.Equal(t, "Hash de la transacción: abc", Sprintf("Transaction hash: %s", "abc"))
+
+	// Falls back to the language
+	SetLocale("es_AR.UTF-8")
+	assert.Equal(t, "es_AR", Locale())
+	assert.Equal(t, "Cancelado.", T("Aborted."))
+
+	// Unknown locale
+	SetLocale("xx")
+	assert.Equal(t, "Aborted.", T("Aborted."))
+}
+
+func TestRegister(t *testing.T) {
+	resetLocale(t)
+
+	Register("es_AR", Catalog{"Aborted.": "Abortado."})
+	assert.Contains(t, Locales(), "es_AR")
+
+	SetLocale("es_AR")
+	assert.Equal(t, "Abortado.", T("Aborted."))
+	// The other messages fall back to the language.
+	assert.Equal(t, "Contraseña", T("Password"))
+}
+
+func TestLoadDir(t *testing.T) {
+	resetLocale(t)
+
+	dir := util.TempDirPath()
+	require.NoError(t, util.WriteFile(filepath.Join(dir, "it.json"),
+		[]byte(`{"Aborted.": "Annullato."}`)))
+
+	assert.NoError(t, LoadDir(dir))
+	SetLocale("it")
+	assert.Equal(t, "Annullato.", T("Aborted."))
+
+	t.Run("Directory doesn't exist", func(t *testing.T) {
+		assert.NoError(t, LoadDir(util.TempDirPath()+"-not-exists"))
+	})
+
+	t.Run("Invalid catalog", func(t *testing.T) {
+		dir := util.TempDirPath()
+		require.NoError(t, util.WriteFile(filepath.Join(dir, "it.json"), []byte("invalid")))
+
+		assert.Error(t, LoadDir(dir))
+	})
+}
+
+// TestEmbeddedCatalogs ensures that the translations keep the formatting verbs of the messages,
+// so the arguments are formatted correctly.
+func TestEmbeddedCatalogs(t *testing.T) {
+	verbs := regexp.MustCompile(`%[-+# 0]*[0-9]*(\.[0-9]+)?[a-zA-Z%]`)
+
+	entries, err := locales.ReadDir("locales")
+	require.NoError(t, err)
+	require.NotEmpty(t, entries)
+
+	for _, entry := range entries {
+		loc := normalize(entry.Name()[:len(entry.Name())-len(".json")])
+		catalog := catalogs[loc]
+		require.NotEmpty(t, catalog, loc)
+
+		for msg, translated := range catalog {
+			assert.NotEmpty(t, translated, "%s: %q", loc, msg)
+			assert.Equal(t, verbs.FindAllString(msg, -1), verbs.FindAllString(translated, -1),
+				"%s: %q", loc, msg)
+		}
+	}
+}
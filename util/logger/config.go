@@ -1,16 +1,18 @@
 package logger
 
 type Config struct {
-	Colorful           bool              `toml:"colorful"`
-	MaxBackups         int               `toml:"max_backups"`
-	RotateLogAfterDays int               `toml:"rotate_log_after_days"`
-	Compress           bool              `toml:"compress"`
-	Levels             map[string]string `toml:"levels"`
+	Colorful           bool                      `toml:"colorful"`
+	MaxBackups         int                       `toml:"max_backups"`
+	RotateLogAfterDays int                       `toml:"rotate_log_after_days"`
+	Compress           bool                      `toml:"compress"`
+	Levels             map[string]string         `toml:"levels"`
+	Sampling           map[string]SamplingConfig `toml:"sampling"`
 }
 
 func DefaultConfig() *Config {
 	conf := &Config{
 		Levels:             make(map[string]string),
+		Sampling:           make(map[string]SamplingConfig),
 		Colorful:           true,
 		MaxBackups:         0,
 		RotateLogAfterDays: 1,
@@ -32,5 +34,11 @@ func DefaultConfig() *Config {
 
 // BasicCheck performs basic checks on the configuration.
 func (conf *Config) BasicCheck() error {
+	for _, sampling := range conf.Sampling {
+		if err := sampling.BasicCheck(); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
@@ -6,6 +6,8 @@ import (
 	"io"
 	"os"
 	"reflect"
+	"sync"
+	"sync/atomic"
 
 	"github.com/pactus-project/pactus/util"
 	"github.com/rs/zerolog"
@@ -24,10 +26,21 @@ var (
 
 var globalInst *logger
 
+// errorHook is called by the name of the sub-logger on every error log.
+var errorHook atomic.Pointer[func(name string)]
+
+// SetErrorHook sets the function that is called by the name of the sub-logger on every error log,
+// even if the error logs of the sub-logger are disabled.
+func SetErrorHook(hook func(name string)) {
+	errorHook.Store(&hook)
+}
+
 type logger struct {
-	config *Config
-	subs   map[string]*SubLogger
-	writer io.Writer
+	config     *Config
+	subs       map[string]*SubLogger
+	writer     io.Writer
+	lk         sync.RWMutex
+	categories map[string]*category
 }
 
 type SubLogger struct {
@@ -54,11 +67,13 @@ func getLoggersInst() *logger {
 		conf.Levels["_http"] = "debug"
 		conf.Levels["_grpc"] = "debug"
 		globalInst = &logger{
-			config: conf,
-			subs:   make(map[string]*SubLogger),
-			writer: zerolog.ConsoleWriter{Out: os.Stderr, TimeFormat: "15:04:05"},
+			config:     conf,
+			subs:       make(map[string]*SubLogger),
+			writer:     zerolog.ConsoleWriter{Out: os.Stderr, TimeFormat: "15:04:05"},
+			categories: make(map[string]*category),
 		}
 		log.Logger = zerolog.New(globalInst.writer).With().Timestamp().Logger()
+		log.Logger = log.Logger.Sample(globalInst.category("default"))
 	}
 
 	return globalInst
@@ -84,19 +99,82 @@ func InitGlobalLogger(conf *Config) {
 		}
 		writers = append(writers, fw)
 
+		InitGlobalLoggerWithWriter(conf, io.MultiWriter(writers...))
+	}
+}
+
+// InitGlobalLoggerWithWriter is like InitGlobalLogger, but it writes the logs to the given writer,
+// instead of the console and the log file.
+// It is useful for the programs that embed the node and have their own logging.
+func InitGlobalLoggerWithWriter(conf *Config, writer io.Writer) {
+	if globalInst == nil {
 		globalInst = &logger{
-			config: conf,
-			subs:   make(map[string]*SubLogger),
-			writer: io.MultiWriter(writers...),
+			config:     conf,
+			subs:       make(map[string]*SubLogger),
+			writer:     writer,
+			categories: make(map[string]*category),
 		}
 		log.Logger = zerolog.New(globalInst.writer).With().Timestamp().Logger()
+		log.Logger = log.Logger.Sample(globalInst.category("default"))
+	}
+}
 
-		lvl, err := zerolog.ParseLevel(conf.Levels["default"])
-		if err != nil {
-			Warn("invalid default log level", "error", err)
-		}
-		log.Logger = log.Logger.Level(lvl)
+// category returns the category of the loggers with the given name.
+// The category is created on the first use, by the level and the sampling in the configuration.
+func (l *logger) category(name string) *category {
+	l.lk.Lock()
+	defer l.lk.Unlock()
+
+	if cat, ok := l.categories[name]; ok {
+		return cat
+	}
+
+	lvlStr := l.config.Levels[name]
+	if lvlStr == "" {
+		lvlStr = l.config.Levels["default"]
+	}
+
+	lvl, err := zerolog.ParseLevel(lvlStr)
+	if err != nil {
+		Warn("invalid log level", "error", err, "name", name)
+	}
+
+	cat := newCategory(lvl, l.config.Sampling[name])
+	l.categories[name] = cat
+
+	return cat
+}
+
+// SetLevel changes the log level of the category at runtime.
+func SetLevel(name, level string) error {
+	if level == "" {
+		return fmt.Errorf("log level is not set")
 	}
+	lvl, err := zerolog.ParseLevel(level)
+	if err != nil {
+		return err
+	}
+	getLoggersInst().category(name).setLevel(lvl)
+
+	return nil
+}
+
+// SetSampling changes the sampling of the debug and trace logs of the category at runtime.
+// A zero configuration disables the sampling.
+func SetSampling(name string, conf SamplingConfig) error {
+	if err := conf.BasicCheck(); err != nil {
+		return err
+	}
+	getLoggersInst().category(name).setSampling(conf)
+
+	return nil
+}
+
+// CategoryConfig returns the current log level and sampling of the category.
+func CategoryConfig(name string) (string, SamplingConfig) {
+	cat := getLoggersInst().category(name)
+
+	return cat.getLevel().String(), cat.getSampling()
 }
 
 func addFields(event *zerolog.Event, keyvals ...interface{}) *zerolog.Event {
@@ -143,18 +221,12 @@ func NewSubLogger(name string, obj fmt.Stringer) *SubLogger {
 		obj:    obj,
 	}
 
-	lvlStr := inst.config.Levels[name]
-	if lvlStr == "" {
-		lvlStr = inst.config.Levels["default"]
-	}
-
-	lvl, err := zerolog.ParseLevel(lvlStr)
-	if err != nil {
-		Warn("invalid log level", "error", err, "name", name)
-	}
-	sl.logger = sl.logger.Level(lvl)
+	// The level and the sampling are checked by the category, so they can be changed at runtime.
+	sl.logger = sl.logger.Sample(inst.category(name))
 
+	inst.lk.Lock()
 	inst.subs[name] = sl
+	inst.lk.Unlock()
 
 	return sl
 }
@@ -184,6 +256,9 @@ func (sl *SubLogger) Warn(msg string, keyvals ...interface{}) {
 }
 
 func (sl *SubLogger) Error(msg string, keyvals ...interface{}) {
+	if hook := errorHook.Load(); hook != nil {
+		(*hook)(sl.name)
+	}
 	sl.logObj(sl.logger.Error(), msg, keyvals...)
 }
 
@@ -3,7 +3,9 @@ package logger
 import (
 	"bytes"
 	"fmt"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/rs/zerolog/log"
 	"github.com/stretchr/testify/assert"
@@ -155,3 +157,84 @@ func TestShortStringer(t *testing.T) {
 
 	assert.Contains(t, out, "bar")
 }
+
+func TestRuntimeLevel(t *testing.T) {
+	globalInst = nil
+	c := DefaultConfig()
+	c.Colorful = false
+	InitGlobalLogger(c)
+
+	globalInst.config.Levels["test"] = "warn"
+	l := NewSubLogger("test", nil)
+	var buf bytes.Buffer
+	l.logger = l.logger.Output(&buf)
+
+	l.Debug("before")
+	assert.NotContains(t, buf.String(), "before")
+
+	assert.Error(t, SetLevel("test", ""))
+	assert.Error(t, SetLevel("test", "invalid"))
+	assert.NoError(t, SetLevel("test", "debug"))
+	l.Debug("after")
+	assert.Contains(t, buf.String(), "after")
+
+	level, _ := CategoryConfig("test")
+	assert.Equal(t, "debug", level)
+}
+
+func TestSampling(t *testing.T) {
+	globalInst = nil
+	c := DefaultConfig()
+	c.Colorful = false
+	c.Levels["test"] = "trace"
+	c.Sampling["test"] = SamplingConfig{Every: 10}
+	InitGlobalLogger(c)
+
+	l := NewSubLogger("test", nil)
+	var buf bytes.Buffer
+	l.logger = l.logger.Output(&buf)
+
+	for i := 0; i < 100; i++ {
+		l.Debug("debug-msg")
+		l.Info("info-msg")
+	}
+	assert.Equal(t, 10, strings.Count(buf.String(), "debug-msg"))
+	assert.Equal(t, 100, strings.Count(buf.String(), "info-msg"))
+
+	t.Run("Burst limit", func(t *testing.T) {
+		buf.Reset()
+		assert.NoError(t, SetSampling("test", SamplingConfig{Burst: 5, Period: time.Hour}))
+		for i := 0; i < 100; i++ {
+			l.Trace("burst")
+		}
+		assert.Equal(t, 5, strings.Count(buf.String(), "burst"))
+	})
+
+	t.Run("Invalid sampling", func(t *testing.T) {
+		assert.Error(t, SetSampling("test", SamplingConfig{Burst: 5}))
+	})
+
+	t.Run("Disable sampling", func(t *testing.T) {
+		buf.Reset()
+		assert.NoError(t, SetSampling("test", SamplingConfig{}))
+		for i := 0; i < 100; i++ {
+			l.Debug("all")
+		}
+		assert.Equal(t, 100, strings.Count(buf.String(), "all"))
+
+		_, sampling := CategoryConfig("test")
+		assert.False(t, sampling.IsEnabled())
+	})
+}
+
+func TestErrorHook(t *testing.T) {
+	names := []string{}
+	SetErrorHook(func(name string) { names = append(names, name) })
+	t.Cleanup(func() { errorHook.Store(nil) })
+
+	l := NewSubLogger("_hook", nil)
+	l.Warn("warn")
+	l.Error("error")
+
+	assert.Equal(t, []string{"_hook"}, names)
+}
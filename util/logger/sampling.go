@@ -0,0 +1,119 @@
+package logger
+
+import (
+	"sync/atomic"
+	"time"
+
+	"github.com/pactus-project/pactus/util/errors"
+	"github.com/rs/zerolog"
+)
+
+// SamplingConfig is the configuration of sampling the debug and trace logs of a category.
+// The logs of the higher levels are never sampled.
+type SamplingConfig struct {
+	// Every logs one in every N of the logs. Zero or one logs all of them.
+	Every uint32 `toml:"every"`
+	// Burst logs the first N of the logs in each period, before sampling the rest.
+	// If Every is not set, the rest of the logs in the period are dropped.
+	Burst  uint32        `toml:"burst"`
+	Period time.Duration `toml:"period"`
+}
+
+// IsEnabled checks if the logs are sampled.
+func (conf SamplingConfig) IsEnabled() bool {
+	return conf.Every > 1 || conf.Burst > 0
+}
+
+// BasicCheck performs basic checks on the configuration.
+func (conf SamplingConfig) BasicCheck() error {
+	if conf.Period < 0 {
+		return errors.Errorf(errors.ErrInvalidConfig, "sampling period should not be negative")
+	}
+	if conf.Burst > 0 && conf.Period == 0 {
+		return errors.Errorf(errors.ErrInvalidConfig, "sampling burst needs a period")
+	}
+
+	return nil
+}
+
+func (conf SamplingConfig) sampler() zerolog.Sampler {
+	var sampler zerolog.Sampler
+	if conf.Every > 1 {
+		sampler = &zerolog.BasicSampler{N: conf.Every}
+	}
+	if conf.Burst > 0 {
+		sampler = &zerolog.BurstSampler{
+			Burst:       conf.Burst,
+			Period:      conf.Period,
+			NextSampler: sampler,
+		}
+	}
+
+	return sampler
+}
+
+type sampling struct {
+	config  SamplingConfig
+	sampler zerolog.Sampler
+}
+
+// category controls the level and the sampling of the loggers with the same name.
+// It is shared between the loggers, so it can be changed at runtime.
+type category struct {
+	level    atomic.Int32
+	sampling atomic.Pointer[sampling]
+}
+
+func newCategory(level zerolog.Level, conf SamplingConfig) *category {
+	cat := &category{}
+	cat.setLevel(level)
+	cat.setSampling(conf)
+
+	return cat
+}
+
+func (cat *category) setLevel(level zerolog.Level) {
+	cat.level.Store(int32(level))
+}
+
+func (cat *category) getLevel() zerolog.Level {
+	return zerolog.Level(cat.level.Load())
+}
+
+func (cat *category) setSampling(conf SamplingConfig) {
+	if !conf.IsEnabled() {
+		cat.sampling.Store(nil)
+
+		return
+	}
+
+	cat.sampling.Store(&sampling{
+		config:  conf,
+		sampler: conf.sampler(),
+	})
+}
+
+func (cat *category) getSampling() SamplingConfig {
+	if smp := cat.sampling.Load(); smp != nil {
+		return smp.config
+	}
+
+	return SamplingConfig{}
+}
+
+// Sample implements the zerolog.Sampler interface.
+func (cat *category) Sample(lvl zerolog.Level) bool {
+	if lvl < cat.getLevel() {
+		return false
+	}
+	if lvl > zerolog.DebugLevel {
+		return true
+	}
+
+	smp := cat.sampling.Load()
+	if smp == nil {
+		return true
+	}
+
+	return smp.sampler.Sample(lvl)
+}
@@ -0,0 +1,242 @@
+// Package parquet encodes the tables in the Apache Parquet format, for the analytics tools.
+//
+// Only a small subset of the format is supported: flat tables with the required columns,
+// written in one row group with the plain encoding and no compression.
+// It is enough for the tools like DuckDB, Spark and pandas to read the exported files.
+package parquet
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+const magic = "PAR1"
+
+// The physical types of the columns.
+const (
+	typeInt32     = int32(1)
+	typeInt64     = int32(2)
+	typeByteArray = int32(6)
+)
+
+// The converted types, the logical meanings of the physical types.
+const (
+	convertedNone            = int32(-1)
+	convertedUTF8            = int32(0)
+	convertedTimestampMillis = int32(9)
+	convertedUint8           = int32(11)
+	convertedUint16          = int32(12)
+	convertedUint32          = int32(13)
+)
+
+const (
+	pageTypeData    = int32(0)
+	encodingPlain   = int32(0)
+	encodingRLE     = int32(3)
+	repetitionReq   = int32(0)
+	codecNone       = int32(0)
+	formatVersion   = int32(1)
+	createdBy       = "pactus"
+	timestampOption = "timestamp"
+)
+
+type column struct {
+	name      string
+	field     int
+	physical  int32
+	converted int32
+	data      bytes.Buffer
+}
+
+// Marshal encodes the rows as a Parquet file.
+//
+// The rows should be structs, and each field with a `parquet` tag is a column, named by the tag.
+// The int32, int64, uint8, uint16, uint32 and string fields are supported.
+// The int64 fields with the timestamp option, like `parquet:"time,timestamp"`,
+// are the milliseconds since the Unix epoch.
+func Marshal[T any](rows []T) ([]byte, error) {
+	cols, err := makeColumns(reflect.TypeOf((*T)(nil)).Elem())
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range rows {
+		val := reflect.ValueOf(&rows[i]).Elem()
+		for _, col := range cols {
+			col.appendValue(val.Field(col.field))
+		}
+	}
+
+	return encodeFile(cols, int64(len(rows)))
+}
+
+func makeColumns(typ reflect.Type) ([]*column, error) {
+	if typ.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("rows should be structs, not %s", typ.Kind())
+	}
+
+	cols := []*column{}
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		tag, ok := field.Tag.Lookup("parquet")
+		if !ok {
+			continue
+		}
+		name, option, _ := strings.Cut(tag, ",")
+
+		col := &column{
+			name:      name,
+			field:     i,
+			converted: convertedNone,
+		}
+		switch field.Type.Kind() {
+		case reflect.Int32:
+			col.physical = typeInt32
+		case reflect.Uint8:
+			col.physical = typeInt32
+			col.converted = convertedUint8
+		case reflect.Uint16:
+			col.physical = typeInt32
+			col.converted = convertedUint16
+		case reflect.Uint32:
+			col.physical = typeInt32
+			col.converted = convertedUint32
+		case reflect.Int64:
+			col.physical = typeInt64
+			if option == timestampOption {
+				col.converted = convertedTimestampMillis
+			}
+		case reflect.String:
+			col.physical = typeByteArray
+			col.converted = convertedUTF8
+		default:
+			return nil, fmt.Errorf("unsupported type of field %s: %s", field.Name, field.Type)
+		}
+		cols = append(cols, col)
+	}
+
+	if len(cols) == 0 {
+		return nil, errors.New("no column is defined")
+	}
+
+	return cols, nil
+}
+
+func (c *column) appendValue(val reflect.Value) {
+	switch val.Kind() {
+	case reflect.Int32, reflect.Int64:
+		if c.physical == typeInt32 {
+			c.data.Write(binary.LittleEndian.AppendUint32(nil, uint32(val.Int())))
+		} else {
+			c.data.Write(binary.LittleEndian.AppendUint64(nil, uint64(val.Int())))
+		}
+	case reflect.Uint8, reflect.Uint16, reflect.Uint32:
+		c.data.Write(binary.LittleEndian.AppendUint32(nil, uint32(val.Uint())))
+	case reflect.String:
+		str := val.String()
+		c.data.Write(binary.LittleEndian.AppendUint32(nil, uint32(len(str))))
+		c.data.WriteString(str)
+	}
+}
+
+func encodeFile(cols []*column, numRows int64) ([]byte, error) {
+	buf := bytes.Buffer{}
+	buf.WriteString(magic)
+
+	// Each column chunk has one data page.
+	// The values are required, so the page has no repetition or definition levels.
+	offsets := make([]int64, len(cols))
+	sizes := make([]int64, len(cols))
+	for i, col := range cols {
+		if col.data.Len() > 0x7fffffff {
+			return nil, fmt.Errorf("column %s is too big, export fewer rows", col.name)
+		}
+
+		th := &thriftWriter{}
+		th.beginStruct()
+		th.i32Field(1, pageTypeData)
+		th.i32Field(2, int32(col.data.Len()))
+		th.i32Field(3, int32(col.data.Len()))
+		th.beginStructField(5)
+		th.i32Field(1, int32(numRows))
+		th.i32Field(2, encodingPlain)
+		th.i32Field(3, encodingRLE)
+		th.i32Field(4, encodingRLE)
+		th.endStruct()
+		th.endStruct()
+
+		offsets[i] = int64(buf.Len())
+		sizes[i] = int64(th.buf.Len() + col.data.Len())
+		buf.Write(th.buf.Bytes())
+		buf.Write(col.data.Bytes())
+	}
+
+	footer := encodeFileMetaData(cols, numRows, offsets, sizes)
+	buf.Write(footer)
+	buf.Write(binary.LittleEndian.AppendUint32(nil, uint32(len(footer))))
+	buf.WriteString(magic)
+
+	return buf.Bytes(), nil
+}
+
+func encodeFileMetaData(cols []*column, numRows int64, offsets, sizes []int64) []byte {
+	th := &thriftWriter{}
+	th.beginStruct()
+	th.i32Field(1, formatVersion)
+
+	// The schema is a tree, flatten in the depth-first order; the root is the table.
+	th.listField(2, thriftStruct, len(cols)+1)
+	th.beginStruct()
+	th.stringField(4, "schema")
+	th.i32Field(5, int32(len(cols)))
+	th.endStruct()
+	for _, col := range cols {
+		th.beginStruct()
+		th.i32Field(1, col.physical)
+		th.i32Field(3, repetitionReq)
+		th.stringField(4, col.name)
+		if col.converted != convertedNone {
+			th.i32Field(6, col.converted)
+		}
+		th.endStruct()
+	}
+
+	th.i64Field(3, numRows)
+
+	totalSize := int64(0)
+	for _, size := range sizes {
+		totalSize += size
+	}
+	th.listField(4, thriftStruct, 1)
+	th.beginStruct()
+	th.listField(1, thriftStruct, len(cols))
+	for i, col := range cols {
+		th.beginStruct()
+		th.i64Field(2, offsets[i])
+		th.beginStructField(3)
+		th.i32Field(1, col.physical)
+		th.listField(2, thriftI32, 1)
+		th.varint(zigzag(int64(encodingPlain)))
+		th.listField(3, thriftBinary, 1)
+		th.str(col.name)
+		th.i32Field(4, codecNone)
+		th.i64Field(5, numRows)
+		th.i64Field(6, sizes[i])
+		th.i64Field(7, sizes[i])
+		th.i64Field(9, offsets[i])
+		th.endStruct()
+		th.endStruct()
+	}
+	th.i64Field(2, totalSize)
+	th.i64Field(3, numRows)
+	th.endStruct()
+
+	th.stringField(6, createdBy)
+	th.endStruct()
+
+	return th.buf.Bytes()
+}
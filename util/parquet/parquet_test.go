@@ -0,0 +1,71 @@
+package parquet
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testRow struct {
+	Height  uint32 `parquet:"height"`
+	Version uint8  `parquet:"version"`
+	Index   int32  `parquet:"index"`
+	Time    int64  `parquet:"time,timestamp"`
+	Name    string `parquet:"name"`
+	Ignored string
+}
+
+func TestMarshal(t *testing.T) {
+	rows := []testRow{
+		{Height: 1, Version: 2, Index: -3, Time: 1_700_000_000_000, Name: "foo"},
+		{Height: 4_000_000_000, Name: "bar"},
+	}
+
+	data, err := Marshal(rows)
+	require.NoError(t, err)
+
+	assert.Equal(t, magic, string(data[:4]))
+	assert.Equal(t, magic, string(data[len(data)-4:]))
+
+	footerLen := int(binary.LittleEndian.Uint32(data[len(data)-8:]))
+	footer := data[len(data)-8-footerLen : len(data)-8]
+	assert.Contains(t, string(footer), "height")
+	assert.Contains(t, string(footer), "name")
+	assert.NotContains(t, string(footer), "Ignored")
+
+	// The values of the name column are the last data page, right before the footer.
+	names := data[len(data)-8-footerLen-14 : len(data)-8-footerLen]
+	assert.Equal(t, []byte{3, 0, 0, 0, 'f', 'o', 'o', 3, 0, 0, 0, 'b', 'a', 'r'}, names)
+}
+
+func TestMarshalEmpty(t *testing.T) {
+	data, err := Marshal([]testRow{})
+	require.NoError(t, err)
+
+	assert.Equal(t, magic, string(data[:4]))
+	assert.Equal(t, magic, string(data[len(data)-4:]))
+}
+
+func TestMarshalInvalidRows(t *testing.T) {
+	_, err := Marshal([]int{1, 2})
+	assert.Error(t, err)
+
+	_, err = Marshal([]struct {
+		Value float64 `parquet:"value"`
+	}{})
+	assert.Error(t, err)
+
+	_, err = Marshal([]struct {
+		Value int64
+	}{})
+	assert.Error(t, err)
+}
+
+func TestZigzag(t *testing.T) {
+	assert.Equal(t, uint64(0), zigzag(0))
+	assert.Equal(t, uint64(1), zigzag(-1))
+	assert.Equal(t, uint64(2), zigzag(1))
+	assert.Equal(t, uint64(3), zigzag(-2))
+}
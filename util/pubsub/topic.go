@@ -0,0 +1,103 @@
+// Package pubsub provides the typed topics that decouple the publishers from the subscribers
+// inside the node.
+package pubsub
+
+import (
+	"sync"
+)
+
+// Topic delivers the published values to all of its subscribers, in the published order.
+// Publishing to a topic without any subscriber is a no-op.
+type Topic[T any] struct {
+	lk   sync.RWMutex
+	name string
+	subs []*Subscription[T]
+}
+
+// Subscription receives the values that are published to a topic after subscribing.
+type Subscription[T any] struct {
+	topic *Topic[T]
+	ch    chan T
+	done  chan struct{}
+	once  sync.Once
+}
+
+// NewTopic creates a new topic with the given name.
+func NewTopic[T any](name string) *Topic[T] {
+	return &Topic[T]{
+		name: name,
+	}
+}
+
+// Name returns the name of the topic.
+func (t *Topic[T]) Name() string {
+	return t.name
+}
+
+// Subscribe adds a new subscriber to the topic. The bufferSize is the number of values
+// that can be published before the subscriber reads them.
+func (t *Topic[T]) Subscribe(bufferSize int) *Subscription[T] {
+	sub := &Subscription[T]{
+		topic: t,
+		ch:    make(chan T, bufferSize),
+		done:  make(chan struct{}),
+	}
+
+	t.lk.Lock()
+	defer t.lk.Unlock()
+
+	t.subs = append(t.subs, sub)
+
+	return sub
+}
+
+// HasSubscribers checks if the topic has any subscriber.
+// Publishers can check it to skip making the values that nobody reads.
+func (t *Topic[T]) HasSubscribers() bool {
+	t.lk.RLock()
+	defer t.lk.RUnlock()
+
+	return len(t.subs) > 0
+}
+
+// Publish delivers the value to all the subscribers.
+// It blocks until every subscriber has room for the value, so the values are never dropped,
+// the same as sending to a buffered channel.
+func (t *Topic[T]) Publish(value T) {
+	t.lk.RLock()
+	defer t.lk.RUnlock()
+
+	for _, sub := range t.subs {
+		select {
+		case sub.ch <- value:
+		case <-sub.done:
+		}
+	}
+}
+
+func (t *Topic[T]) remove(sub *Subscription[T]) {
+	t.lk.Lock()
+	defer t.lk.Unlock()
+
+	for i, s := range t.subs {
+		if s == sub {
+			t.subs = append(t.subs[:i], t.subs[i+1:]...)
+
+			break
+		}
+	}
+}
+
+// C returns the channel that receives the published values.
+func (s *Subscription[T]) C() <-chan T {
+	return s.ch
+}
+
+// Unsubscribe removes the subscriber from the topic.
+// A publisher that is blocked on this subscriber is released.
+func (s *Subscription[T]) Unsubscribe() {
+	s.once.Do(func() {
+		close(s.done)
+		s.topic.remove(s)
+	})
+}
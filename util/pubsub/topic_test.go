@@ -0,0 +1,77 @@
+package pubsub
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPublishWithoutSubscriber(t *testing.T) {
+	topic := NewTopic[int]("test")
+
+	assert.Equal(t, "test", topic.Name())
+	assert.False(t, topic.HasSubscribers())
+
+	// Should not block.
+	topic.Publish(1)
+}
+
+func TestMultipleSubscribers(t *testing.T) {
+	topic := NewTopic[int]("test")
+
+	sub1 := topic.Subscribe(10)
+	sub2 := topic.Subscribe(10)
+	assert.True(t, topic.HasSubscribers())
+
+	topic.Publish(1)
+	topic.Publish(2)
+
+	for _, sub := range []*Subscription[int]{sub1, sub2} {
+		assert.Equal(t, 1, <-sub.C())
+		assert.Equal(t, 2, <-sub.C())
+	}
+}
+
+func TestUnsubscribe(t *testing.T) {
+	topic := NewTopic[string]("test")
+
+	sub1 := topic.Subscribe(10)
+	sub2 := topic.Subscribe(10)
+
+	sub1.Unsubscribe()
+	sub1.Unsubscribe() // Should be safe to call twice.
+	topic.Publish("foo")
+
+	assert.Empty(t, sub1.C())
+	assert.Equal(t, "foo", <-sub2.C())
+
+	sub2.Unsubscribe()
+	assert.False(t, topic.HasSubscribers())
+}
+
+func TestUnsubscribeReleasesPublisher(t *testing.T) {
+	topic := NewTopic[int]("test")
+	sub := topic.Subscribe(0)
+
+	published := make(chan struct{})
+	go func() {
+		topic.Publish(1)
+		close(published)
+	}()
+
+	// The publisher is blocked, since nobody reads the subscription.
+	select {
+	case <-published:
+		assert.Fail(t, "publisher should be blocked")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	sub.Unsubscribe()
+
+	select {
+	case <-published:
+	case <-time.After(time.Second):
+		assert.Fail(t, "publisher should be released")
+	}
+}
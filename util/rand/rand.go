@@ -0,0 +1,105 @@
+// Package rand provides random numbers for the non-cryptographic decisions of
+// the node, like picking a random peer or a random vote to broadcast.
+//
+// By default, numbers are read from the crypto/rand. Tests can inject
+// a deterministic source, so a failing scenario can be replayed by its seed.
+// It must not be used for generating keys or any other secret.
+package rand
+
+import (
+	crand "crypto/rand"
+	"encoding/binary"
+	"math"
+	mrand "math/rand"
+	"sync"
+)
+
+// Source is a source of uniformly distributed random numbers.
+type Source interface {
+	Uint64() uint64
+}
+
+type cryptoSource struct{}
+
+func (cryptoSource) Uint64() uint64 {
+	buf := [8]byte{}
+	_, err := crand.Read(buf[:])
+	if err != nil {
+		panic(err)
+	}
+
+	return binary.LittleEndian.Uint64(buf[:])
+}
+
+type deterministicSource struct {
+	lk  sync.Mutex
+	rnd *mrand.Rand
+}
+
+func (s *deterministicSource) Uint64() uint64 {
+	s.lk.Lock()
+	defer s.lk.Unlock()
+
+	return s.rnd.Uint64()
+}
+
+// NewSource returns a deterministic source seeded with the given seed.
+// It is safe for concurrent use.
+func NewSource(seed int64) Source {
+	return &deterministicSource{
+		//nolint:gosec // deterministic by design, to reproduce the failed tests
+		rnd: mrand.New(mrand.NewSource(seed)),
+	}
+}
+
+var (
+	lk     sync.RWMutex
+	source Source = cryptoSource{}
+)
+
+// SetSource replaces the global source and returns a function that restores
+// the previous one. It is intended for tests.
+func SetSource(src Source) func() {
+	lk.Lock()
+	defer lk.Unlock()
+
+	prev := source
+	source = src
+
+	return func() {
+		lk.Lock()
+		defer lk.Unlock()
+
+		source = prev
+	}
+}
+
+func globalSource() Source {
+	lk.RLock()
+	defer lk.RUnlock()
+
+	return source
+}
+
+// Uint64 returns a random uint64.
+func Uint64() uint64 {
+	return globalSource().Uint64()
+}
+
+// Uint64n returns a random uint64 in [0, max).
+// If max is zero, it returns a random number in [0, MaxUint64).
+func Uint64n(max uint64) uint64 {
+	if max == 0 {
+		max = math.MaxUint64
+	}
+
+	src := globalSource()
+	// Rejecting the numbers above the largest multiple of max to avoid modulo bias.
+	limit := math.MaxUint64 - (math.MaxUint64 % max)
+	for {
+		n := src.Uint64()
+		if n < limit {
+			return n % max
+		}
+	}
+}
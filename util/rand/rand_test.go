@@ -0,0 +1,39 @@
+package rand
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUint64n(t *testing.T) {
+	for i := 0; i < 1000; i++ {
+		n := Uint64n(4)
+		assert.Less(t, n, uint64(4))
+	}
+
+	assert.Zero(t, Uint64n(1))
+}
+
+func TestDeterministicSource(t *testing.T) {
+	src1 := NewSource(42)
+	src2 := NewSource(42)
+	src3 := NewSource(43)
+
+	n1 := src1.Uint64()
+	assert.Equal(t, n1, src2.Uint64())
+	assert.NotEqual(t, n1, src3.Uint64())
+}
+
+func TestSetSource(t *testing.T) {
+	restore := SetSource(NewSource(42))
+	seq1 := []uint64{Uint64n(1000), Uint64n(1000), Uint64n(1000)}
+	restore()
+
+	restore = SetSource(NewSource(42))
+	seq2 := []uint64{Uint64n(1000), Uint64n(1000), Uint64n(1000)}
+	restore()
+
+	assert.Equal(t, seq1, seq2)
+	assert.Equal(t, cryptoSource{}, globalSource())
+}
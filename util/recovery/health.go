@@ -0,0 +1,235 @@
+package recovery
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pactus-project/pactus/util"
+	"github.com/pactus-project/pactus/util/logger"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// HealthStatus is the health of a subsystem, based on its recent errors, panics and restarts.
+type HealthStatus int
+
+const (
+	HealthOK       HealthStatus = 0 // No issue in the health window.
+	HealthDegraded HealthStatus = 1 // Subsystem works, but it has panicked or has too many errors.
+	HealthFailing  HealthStatus = 2 // Subsystem keeps panicking and restarting.
+)
+
+func (s HealthStatus) String() string {
+	switch s {
+	case HealthOK:
+		return "ok"
+	case HealthDegraded:
+		return "degraded"
+	case HealthFailing:
+		return "failing"
+	default:
+		return fmt.Sprintf("%d", s)
+	}
+}
+
+// The budgets of a subsystem in the health window.
+// A subsystem that spends its budget is reported as degraded or failing,
+// and it becomes healthy again when the events leave the window.
+var (
+	HealthWindow  = 10 * time.Minute
+	ErrorBudget   = uint64(50) // More errors than this, degrades the subsystem.
+	PanicBudget   = uint64(0)  // More panics than this, degrades the subsystem.
+	RestartBudget = uint64(2)  // More restarts than this, fails the subsystem.
+)
+
+// healthBuckets is the number of the buckets that the health window is divided into.
+const healthBuckets = 10
+
+var (
+	errorsCounter = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "pactus",
+		Subsystem: "recovery",
+		Name:      "errors_total",
+		Help:      "The number of the error logs, labeled by the subsystem.",
+	}, []string{"subsystem"})
+	restartsCounter = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "pactus",
+		Subsystem: "recovery",
+		Name:      "restarts_total",
+		Help:      "The number of the loops that are restarted after a panic, labeled by the subsystem.",
+	}, []string{"subsystem"})
+	healthStatusDesc = prometheus.NewDesc("pactus_health_status",
+		"The health of the subsystem: zero is ok, one is degraded and two is failing.",
+		[]string{"subsystem"}, nil)
+)
+
+// healthCollector reports the health status on scrape,
+// so the status recovers when the events leave the window, even if no new event happens.
+type healthCollector struct{}
+
+func (healthCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- healthStatusDesc
+}
+
+func (healthCollector) Collect(ch chan<- prometheus.Metric) {
+	for _, h := range Health() {
+		ch <- prometheus.MustNewConstMetric(healthStatusDesc,
+			prometheus.GaugeValue, float64(h.Status), h.Name)
+	}
+}
+
+func init() {
+	prometheus.MustRegister(errorsCounter, restartsCounter, healthCollector{})
+
+	// The sub-loggers are named by their subsystem, with a leading underscore.
+	logger.SetErrorHook(func(name string) {
+		subsystem := strings.TrimPrefix(name, "_")
+		errorsCounter.WithLabelValues(subsystem).Inc()
+		tracker.record(subsystem, func(c *healthCounts) { c.errors++ })
+	})
+}
+
+// SubsystemHealth is the health of a subsystem.
+type SubsystemHealth struct {
+	Name     string
+	Status   HealthStatus
+	Reasons  []string // Why the subsystem is not healthy, empty if it is healthy.
+	Errors   uint64   // Number of the errors since the node started.
+	Panics   uint64   // Number of the panics since the node started.
+	Restarts uint64   // Number of the restarts since the node started.
+}
+
+// Health returns the health of the subsystems that are known so far, sorted by the name.
+// A subsystem is known once it runs a loop or a call inside a recovery boundary, or logs an error.
+func Health() []SubsystemHealth {
+	return tracker.health(util.Now())
+}
+
+// OverallHealth returns the worst status of the subsystems.
+func OverallHealth(subsystems []SubsystemHealth) HealthStatus {
+	status := HealthOK
+	for _, h := range subsystems {
+		status = max(status, h.Status)
+	}
+
+	return status
+}
+
+type healthCounts struct {
+	errors   uint64
+	panics   uint64
+	restarts uint64
+}
+
+func (c *healthCounts) add(other healthCounts) {
+	c.errors += other.errors
+	c.panics += other.panics
+	c.restarts += other.restarts
+}
+
+type healthBucket struct {
+	slot   int64 // The index of the bucket since the epoch, to detect the stale buckets.
+	counts healthCounts
+}
+
+type subsystemTracker struct {
+	total   healthCounts
+	buckets [healthBuckets]healthBucket
+}
+
+// healthTracker keeps the events of the subsystems in a ring of the buckets, so the events
+// in the health window are counted without keeping each one of them.
+type healthTracker struct {
+	lk         sync.Mutex
+	subsystems map[string]*subsystemTracker
+}
+
+var tracker = &healthTracker{
+	subsystems: make(map[string]*subsystemTracker),
+}
+
+func bucketSlot(now time.Time) int64 {
+	return now.UnixNano() / int64(HealthWindow/healthBuckets)
+}
+
+func (t *healthTracker) subsystem(name string) *subsystemTracker {
+	sub, ok := t.subsystems[name]
+	if !ok {
+		sub = &subsystemTracker{}
+		t.subsystems[name] = sub
+	}
+
+	return sub
+}
+
+// register makes the subsystem known, so it is reported even if it is healthy.
+func (t *healthTracker) register(name string) {
+	t.lk.Lock()
+	defer t.lk.Unlock()
+
+	t.subsystem(name)
+}
+
+func (t *healthTracker) record(name string, inc func(c *healthCounts)) {
+	t.lk.Lock()
+	defer t.lk.Unlock()
+
+	sub := t.subsystem(name)
+	slot := bucketSlot(util.Now())
+	bucket := &sub.buckets[slot%healthBuckets]
+	if bucket.slot != slot {
+		*bucket = healthBucket{slot: slot}
+	}
+
+	inc(&bucket.counts)
+	inc(&sub.total)
+}
+
+func (t *healthTracker) health(now time.Time) []SubsystemHealth {
+	t.lk.Lock()
+	defer t.lk.Unlock()
+
+	slot := bucketSlot(now)
+	res := make([]SubsystemHealth, 0, len(t.subsystems))
+	for name, sub := range t.subsystems {
+		window := healthCounts{}
+		for _, bucket := range sub.buckets {
+			if slot-bucket.slot < healthBuckets {
+				window.add(bucket.counts)
+			}
+		}
+
+		h := SubsystemHealth{
+			Name:     name,
+			Status:   HealthOK,
+			Reasons:  []string{},
+			Errors:   sub.total.errors,
+			Panics:   sub.total.panics,
+			Restarts: sub.total.restarts,
+		}
+		if window.restarts > RestartBudget {
+			h.Status = HealthFailing
+			h.Reasons = append(h.Reasons,
+				fmt.Sprintf("restarted %d times in the last %v", window.restarts, HealthWindow))
+		}
+		if window.panics > PanicBudget {
+			h.Status = max(h.Status, HealthDegraded)
+			h.Reasons = append(h.Reasons,
+				fmt.Sprintf("panicked %d times in the last %v", window.panics, HealthWindow))
+		}
+		if window.errors > ErrorBudget {
+			h.Status = max(h.Status, HealthDegraded)
+			h.Reasons = append(h.Reasons,
+				fmt.Sprintf("logged %d errors in the last %v", window.errors, HealthWindow))
+		}
+		res = append(res, h)
+	}
+
+	slices.SortFunc(res, func(a, b SubsystemHealth) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	return res
+}
@@ -0,0 +1,94 @@
+package recovery
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pactus-project/pactus/util"
+	"github.com/pactus-project/pactus/util/logger"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func findHealth(t *testing.T, subsystems []SubsystemHealth, name string) SubsystemHealth {
+	t.Helper()
+
+	for _, h := range subsystems {
+		if h.Name == name {
+			return h
+		}
+	}
+	require.Failf(t, "subsystem not found", "subsystem: %s", name)
+
+	return SubsystemHealth{}
+}
+
+func TestHealth(t *testing.T) {
+	delay := RestartDelay
+	RestartDelay = time.Millisecond
+	t.Cleanup(func() { RestartDelay = delay })
+
+	t.Run("Known subsystem is healthy", func(t *testing.T) {
+		_ = Call("health-ok", logger.NewSubLogger("_health-ok", nil), func() {})
+
+		h := findHealth(t, Health(), "health-ok")
+		assert.Equal(t, HealthOK, h.Status)
+		assert.Empty(t, h.Reasons)
+	})
+
+	t.Run("Too many errors degrade the subsystem", func(t *testing.T) {
+		log := logger.NewSubLogger("_health-errors", nil)
+		for i := uint64(0); i <= ErrorBudget; i++ {
+			log.Error("error")
+		}
+
+		h := findHealth(t, Health(), "health-errors")
+		assert.Equal(t, HealthDegraded, h.Status)
+		assert.Equal(t, ErrorBudget+1, h.Errors)
+		assert.Len(t, h.Reasons, 1)
+		assert.Equal(t, float64(ErrorBudget+1), testutil.ToFloat64(errorsCounter.WithLabelValues("health-errors")))
+	})
+
+	t.Run("Panic degrades the subsystem", func(t *testing.T) {
+		_ = Call("health-panic", logger.NewSubLogger("_health-panic", nil), func() { panic("boom") })
+
+		h := findHealth(t, Health(), "health-panic")
+		assert.Equal(t, HealthDegraded, h.Status)
+		assert.Equal(t, uint64(1), h.Panics)
+	})
+
+	t.Run("Restarting loop fails the subsystem", func(t *testing.T) {
+		runs := uint64(0)
+		Loop("health-loop", logger.NewSubLogger("_health-loop", nil), func() {
+			runs++
+			if runs <= RestartBudget+1 {
+				panic("boom")
+			}
+		})
+
+		h := findHealth(t, Health(), "health-loop")
+		assert.Equal(t, HealthFailing, h.Status)
+		assert.Equal(t, RestartBudget+1, h.Restarts)
+		assert.Len(t, h.Reasons, 2)
+		assert.Equal(t, HealthFailing, OverallHealth(Health()))
+		assert.Equal(t, float64(RestartBudget+1), testutil.ToFloat64(restartsCounter.WithLabelValues("health-loop")))
+	})
+
+	t.Run("Subsystem recovers when the events leave the window", func(t *testing.T) {
+		later := util.Now().Add(HealthWindow + time.Minute)
+		for _, h := range tracker.health(later) {
+			assert.Equal(t, HealthOK, h.Status, h.Name)
+		}
+
+		h := findHealth(t, tracker.health(later), "health-loop")
+		assert.Equal(t, RestartBudget+1, h.Restarts)
+	})
+}
+
+func TestOverallHealth(t *testing.T) {
+	assert.Equal(t, HealthOK, OverallHealth(nil))
+	assert.Equal(t, HealthDegraded, OverallHealth([]SubsystemHealth{
+		{Status: HealthOK}, {Status: HealthDegraded},
+	}))
+}
@@ -0,0 +1,75 @@
+// Package recovery provides the boundaries that keep a panic in a non-critical subsystem
+// from taking down the whole node.
+package recovery
+
+import (
+	"fmt"
+	"runtime/debug"
+	"time"
+
+	"github.com/pactus-project/pactus/util/logger"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// RestartDelay is the delay before restarting a loop that is recovered from a panic.
+// It prevents a loop that panics constantly from using all the CPU.
+var RestartDelay = time.Second
+
+var panicsCounter = prometheus.NewCounterVec(prometheus.CounterOpts{
+	Namespace: "pactus",
+	Subsystem: "recovery",
+	Name:      "panics_total",
+	Help:      "The number of the panics that are recovered, labeled by the subsystem.",
+}, []string{"subsystem"})
+
+func init() {
+	prometheus.MustRegister(panicsCounter)
+}
+
+// PanicError is returned when a function panics inside a recovery boundary.
+type PanicError struct {
+	Subsystem string
+	Value     any
+}
+
+func (e PanicError) Error() string {
+	return fmt.Sprintf("recovered from panic in %s: %v", e.Subsystem, e.Value)
+}
+
+// Call calls the function inside a recovery boundary.
+// If the function panics, the panic is logged as an error with its stack trace,
+// counted in the metrics and the health of the subsystem, and returned as a PanicError.
+func Call(subsystem string, log *logger.SubLogger, fn func()) (err error) {
+	tracker.register(subsystem)
+
+	defer func() {
+		if r := recover(); r != nil {
+			panicsCounter.WithLabelValues(subsystem).Inc()
+			tracker.record(subsystem, func(c *healthCounts) { c.panics++ })
+			log.Error("recovered from panic", "subsystem", subsystem,
+				"panic", r, "stack", string(debug.Stack()))
+
+			err = PanicError{Subsystem: subsystem, Value: r}
+		}
+	}()
+
+	fn()
+
+	return nil
+}
+
+// Loop runs the event loop of a subsystem inside a recovery boundary.
+// If the loop panics, it is restarted after the RestartDelay.
+// It returns when the loop returns without panicking, so the loop should return
+// when the subsystem is stopped.
+//
+// It should only be used for the loops that keep no state between the iterations,
+// so they are safe to restart.
+func Loop(subsystem string, log *logger.SubLogger, loop func()) {
+	for Call(subsystem, log, loop) != nil {
+		restartsCounter.WithLabelValues(subsystem).Inc()
+		tracker.record(subsystem, func(c *healthCounts) { c.restarts++ })
+		log.Warn("restarting the loop", "subsystem", subsystem)
+		time.Sleep(RestartDelay)
+	}
+}
@@ -0,0 +1,47 @@
+package recovery
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pactus-project/pactus/util/logger"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCall(t *testing.T) {
+	log := logger.NewSubLogger("_test", nil)
+
+	t.Run("No panic", func(t *testing.T) {
+		called := false
+		err := Call("no-panic", log, func() { called = true })
+		assert.NoError(t, err)
+		assert.True(t, called)
+		assert.Zero(t, testutil.ToFloat64(panicsCounter.WithLabelValues("no-panic")))
+	})
+
+	t.Run("Panic", func(t *testing.T) {
+		err := Call("panic", log, func() { panic("boom") })
+		assert.ErrorIs(t, err, PanicError{Subsystem: "panic", Value: "boom"})
+		assert.Equal(t, 1.0, testutil.ToFloat64(panicsCounter.WithLabelValues("panic")))
+	})
+}
+
+func TestLoop(t *testing.T) {
+	log := logger.NewSubLogger("_test", nil)
+
+	delay := RestartDelay
+	RestartDelay = time.Millisecond
+	t.Cleanup(func() { RestartDelay = delay })
+
+	runs := 0
+	Loop("loop", log, func() {
+		runs++
+		if runs < 3 {
+			panic("boom")
+		}
+	})
+
+	assert.Equal(t, 3, runs)
+	assert.Equal(t, 2.0, testutil.ToFloat64(panicsCounter.WithLabelValues("loop")))
+}
@@ -0,0 +1,40 @@
+package selftest
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// totalMemory returns the total memory of the system.
+// It reads `/proc/meminfo`, therefore it is only supported on Linux.
+func totalMemory() (uint64, error) {
+	f, err := os.Open("/proc/meminfo")
+	if err != nil {
+		return 0, fmt.Errorf("unable to read memory info: %w", err)
+	}
+	defer func() { _ = f.Close() }()
+
+	return parseMemInfo(f)
+}
+
+// parseMemInfo parses the `MemTotal` line of the `/proc/meminfo` file.
+func parseMemInfo(r io.Reader) (uint64, error) {
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) >= 2 && fields[0] == "MemTotal:" {
+			kb, err := strconv.ParseUint(fields[1], 10, 64)
+			if err != nil {
+				return 0, err
+			}
+
+			return kb << 10, nil
+		}
+	}
+
+	return 0, fmt.Errorf("total memory is not found")
+}
@@ -0,0 +1,66 @@
+package selftest
+
+import (
+	"encoding/binary"
+	"errors"
+	"net"
+	"time"
+)
+
+// ntpEpochOffset is the number of seconds between the NTP epoch (1900) and the Unix epoch (1970).
+const ntpEpochOffset = 2208988800
+
+const ntpPacketSize = 48
+
+// queryNTP sends a SNTP request to the server and returns the offset of the local clock.
+// A positive offset means the local clock is behind the server.
+func queryNTP(server string, timeout time.Duration) (time.Duration, error) {
+	conn, err := net.DialTimeout("udp", server, timeout)
+	if err != nil {
+		return 0, err
+	}
+	defer func() { _ = conn.Close() }()
+
+	if err := conn.SetDeadline(time.Now().Add(timeout)); err != nil {
+		return 0, err
+	}
+
+	req := make([]byte, ntpPacketSize)
+	req[0] = 0x1b // LI = 0, VN = 3, Mode = 3 (client)
+
+	t1 := time.Now()
+	if _, err := conn.Write(req); err != nil {
+		return 0, err
+	}
+
+	res := make([]byte, ntpPacketSize)
+	n, err := conn.Read(res)
+	if err != nil {
+		return 0, err
+	}
+	t4 := time.Now()
+
+	if n < ntpPacketSize {
+		return 0, errors.New("short NTP response")
+	}
+
+	t2 := ntpTime(res[32:40]) // receive timestamp
+	t3 := ntpTime(res[40:48]) // transmit timestamp
+	if t3.IsZero() {
+		return 0, errors.New("invalid NTP response")
+	}
+
+	return (t2.Sub(t1) + t3.Sub(t4)) / 2, nil
+}
+
+// ntpTime decodes a 64-bit NTP timestamp.
+func ntpTime(data []byte) time.Time {
+	sec := binary.BigEndian.Uint32(data[0:4])
+	frac := binary.BigEndian.Uint32(data[4:8])
+	if sec == 0 && frac == 0 {
+		return time.Time{}
+	}
+	nsec := (int64(frac) * 1e9) >> 32
+
+	return time.Unix(int64(sec)-ntpEpochOffset, nsec)
+}
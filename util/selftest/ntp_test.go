@@ -0,0 +1,72 @@
+package selftest
+
+import (
+	"encoding/binary"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func putNTPTime(data []byte, t time.Time) {
+	sec := uint32(t.Unix() + ntpEpochOffset)
+	frac := uint32((int64(t.Nanosecond()) << 32) / 1e9)
+	binary.BigEndian.PutUint32(data[0:4], sec)
+	binary.BigEndian.PutUint32(data[4:8], frac)
+}
+
+// startNTPServer starts a fake NTP server that its clock is off by the given offset.
+func startNTPServer(t *testing.T, offset time.Duration) string {
+	t.Helper()
+
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+
+	go func() {
+		buf := make([]byte, ntpPacketSize)
+		for {
+			_, addr, err := conn.ReadFrom(buf)
+			if err != nil {
+				return
+			}
+
+			res := make([]byte, ntpPacketSize)
+			res[0] = 0x1c // LI = 0, VN = 3, Mode = 4 (server)
+			now := time.Now().Add(offset)
+			putNTPTime(res[32:40], now)
+			putNTPTime(res[40:48], now)
+			_, _ = conn.WriteTo(res, addr)
+		}
+	}()
+
+	return conn.LocalAddr().String()
+}
+
+func TestNTPTime(t *testing.T) {
+	now := time.Unix(1700000000, 500000000)
+	data := make([]byte, 8)
+	putNTPTime(data, now)
+
+	assert.WithinDuration(t, now, ntpTime(data), time.Microsecond)
+	assert.True(t, ntpTime(make([]byte, 8)).IsZero())
+}
+
+func TestQueryNTP(t *testing.T) {
+	server := startNTPServer(t, 5*time.Second)
+
+	offset, err := queryNTP(server, time.Second)
+	require.NoError(t, err)
+	assert.InDelta(t, 5*time.Second, offset, float64(100*time.Millisecond))
+}
+
+func TestQueryNTPTimeout(t *testing.T) {
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer conn.Close()
+
+	_, err = queryNTP(conn.LocalAddr().String(), 100*time.Millisecond)
+	assert.Error(t, err)
+}
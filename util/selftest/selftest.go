@@ -0,0 +1,310 @@
+// Package selftest provides the checks that the node runs on startup to detect
+// the environmental issues, like clock drift, low disk space or busy ports.
+package selftest
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/pactus-project/pactus/util"
+	"github.com/pactus-project/pactus/util/disk"
+)
+
+// Status is the outcome of a check.
+type Status int
+
+const (
+	StatusPassed  = Status(0) // The check passed.
+	StatusWarning = Status(1) // The node can run, but it might have issues.
+	StatusFailed  = Status(2) // The node is likely to fail.
+	StatusSkipped = Status(3) // The check is not supported or couldn't be performed.
+)
+
+func (s Status) String() string {
+	switch s {
+	case StatusPassed:
+		return "passed"
+	case StatusWarning:
+		return "warning"
+	case StatusFailed:
+		return "failed"
+	case StatusSkipped:
+		return "skipped"
+	}
+
+	return fmt.Sprintf("%d", s)
+}
+
+// The thresholds of the checks.
+const (
+	MaxClockDrift    = 2 * time.Second
+	MinFreeDiskSpace = 10 << 30 // 10 GiB
+	MinDiskIOPS      = 100
+	MinMemory        = 2 << 30 // 2 GiB
+	MinOpenFiles     = 4096
+)
+
+// DefaultNTPServer is the NTP server used to check the clock drift.
+const DefaultNTPServer = "pool.ntp.org:123"
+
+// Result is the result of a check.
+type Result struct {
+	Name    string
+	Status  Status
+	Message string
+}
+
+func (r Result) String() string {
+	return fmt.Sprintf("%s: %s (%s)", r.Name, r.Status, r.Message)
+}
+
+// Config contains the parameters of the checks.
+type Config struct {
+	// DataDir is the directory where the node stores its data.
+	DataDir string
+	// Addresses are the TCP addresses that the node listens on.
+	Addresses []string
+	// NTPServer is the address of the NTP server. If it is empty, the clock drift is not checked.
+	NTPServer string
+	// Timeout is the timeout for the checks that need network access.
+	Timeout time.Duration
+}
+
+// Run runs all the checks and returns their results.
+func Run(conf *Config) []Result {
+	return []Result{
+		CheckClockDrift(conf.NTPServer, conf.Timeout),
+		CheckDataDir(conf.DataDir),
+		CheckDiskSpace(conf.DataDir),
+		CheckDiskIOPS(conf.DataDir),
+		CheckMemory(),
+		CheckOpenFiles(),
+		CheckPorts(conf.Addresses),
+	}
+}
+
+// CheckClockDrift compares the local clock with the time of the NTP server.
+// Consensus relies on the local clock, a drifted clock makes the node miss its rounds.
+func CheckClockDrift(server string, timeout time.Duration) Result {
+	res := Result{Name: "clock_drift"}
+	if server == "" {
+		res.Status = StatusSkipped
+		res.Message = "no NTP server is set"
+
+		return res
+	}
+
+	offset, err := queryNTP(server, timeout)
+	if err != nil {
+		res.Status = StatusSkipped
+		res.Message = fmt.Sprintf("unable to query NTP server %s: %v", server, err)
+
+		return res
+	}
+
+	if offset.Abs() > MaxClockDrift {
+		res.Status = StatusWarning
+		res.Message = fmt.Sprintf("local clock is off by %v, synchronize it using NTP", offset)
+
+		return res
+	}
+
+	res.Status = StatusPassed
+	res.Message = fmt.Sprintf("local clock is off by %v", offset)
+
+	return res
+}
+
+// CheckDataDir checks that the data directory is writable and not accessible by everyone.
+// The data directory is created if it doesn't exist.
+func CheckDataDir(dir string) Result {
+	res := Result{Name: "data_dir"}
+	if err := util.Mkdir(dir); err != nil {
+		res.Status = StatusFailed
+		res.Message = fmt.Sprintf("unable to create data directory %s: %v", dir, err)
+
+		return res
+	}
+
+	probe := filepath.Join(dir, ".selftest")
+	if err := util.WriteFile(probe, []byte{0}); err != nil {
+		res.Status = StatusFailed
+		res.Message = fmt.Sprintf("data directory %s is not writable, check its owner and permissions", dir)
+
+		return res
+	}
+	_ = os.Remove(probe)
+
+	info, err := os.Stat(dir)
+	if err != nil {
+		res.Status = StatusFailed
+		res.Message = err.Error()
+
+		return res
+	}
+
+	if info.Mode().Perm()&0o002 != 0 {
+		res.Status = StatusWarning
+		res.Message = fmt.Sprintf("data directory %s is writable by everyone, restrict it using `chmod o-w`", dir)
+
+		return res
+	}
+
+	res.Status = StatusPassed
+	res.Message = dir
+
+	return res
+}
+
+// CheckDiskSpace checks the free space of the disk that holds the data directory.
+func CheckDiskSpace(dir string) Result {
+	res := Result{Name: "disk_space"}
+	free, err := disk.FreeSpace(dir)
+	if err != nil {
+		res.Status = StatusSkipped
+		res.Message = err.Error()
+
+		return res
+	}
+
+	if free < MinFreeDiskSpace {
+		res.Status = StatusWarning
+		res.Message = fmt.Sprintf("only %d MiB is free on the data disk, at least %d MiB is recommended",
+			free>>20, MinFreeDiskSpace>>20)
+
+		return res
+	}
+
+	res.Status = StatusPassed
+	res.Message = fmt.Sprintf("%d MiB is free", free>>20)
+
+	return res
+}
+
+// CheckDiskIOPS measures how many synchronized writes per second the data disk can do.
+// The database syncs the writes on committing blocks, therefore slow disks delay the node.
+func CheckDiskIOPS(dir string) Result {
+	res := Result{Name: "disk_iops"}
+	iops, err := measureIOPS(dir, 20)
+	if err != nil {
+		res.Status = StatusSkipped
+		res.Message = err.Error()
+
+		return res
+	}
+
+	if iops < MinDiskIOPS {
+		res.Status = StatusWarning
+		res.Message = fmt.Sprintf("data disk does %d synchronized writes per second, use a faster disk (like SSD)", iops)
+
+		return res
+	}
+
+	res.Status = StatusPassed
+	res.Message = fmt.Sprintf("%d synchronized writes per second", iops)
+
+	return res
+}
+
+// CheckMemory checks the total memory of the system.
+func CheckMemory() Result {
+	res := Result{Name: "memory"}
+	total, err := totalMemory()
+	if err != nil {
+		res.Status = StatusSkipped
+		res.Message = err.Error()
+
+		return res
+	}
+
+	if total < MinMemory {
+		res.Status = StatusWarning
+		res.Message = fmt.Sprintf("system has %d MiB memory, at least %d MiB is recommended",
+			total>>20, MinMemory>>20)
+
+		return res
+	}
+
+	res.Status = StatusPassed
+	res.Message = fmt.Sprintf("%d MiB", total>>20)
+
+	return res
+}
+
+// CheckOpenFiles checks the maximum number of the files that the node can open.
+// Each connection to a peer is a file, and the database opens many files too.
+func CheckOpenFiles() Result {
+	res := Result{Name: "open_files"}
+	limit, err := openFilesLimit()
+	if err != nil {
+		res.Status = StatusSkipped
+		res.Message = err.Error()
+
+		return res
+	}
+
+	if limit < MinOpenFiles {
+		res.Status = StatusWarning
+		res.Message = fmt.Sprintf("open files limit is %d, increase it to at least %d using `ulimit -n`",
+			limit, MinOpenFiles)
+
+		return res
+	}
+
+	res.Status = StatusPassed
+	res.Message = fmt.Sprintf("limit is %d", limit)
+
+	return res
+}
+
+// CheckPorts checks that the node can listen on the given TCP addresses.
+func CheckPorts(addrs []string) Result {
+	res := Result{Name: "ports"}
+	for _, addr := range addrs {
+		listener, err := net.Listen("tcp", addr)
+		if err != nil {
+			res.Status = StatusFailed
+			res.Message = fmt.Sprintf("unable to listen on %s, is another process using the port? %v", addr, err)
+
+			return res
+		}
+		_ = listener.Close()
+	}
+
+	res.Status = StatusPassed
+	res.Message = fmt.Sprintf("%d addresses are available", len(addrs))
+
+	return res
+}
+
+// measureIOPS writes a small block and syncs it to the disk for the given number of times.
+func measureIOPS(dir string, count int) (int, error) {
+	f, err := os.CreateTemp(dir, ".iops")
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		_ = f.Close()
+		_ = os.Remove(f.Name())
+	}()
+
+	buf := make([]byte, 4096)
+	start := time.Now()
+	for i := 0; i < count; i++ {
+		if _, err := f.WriteAt(buf, int64(i*len(buf))); err != nil {
+			return 0, err
+		}
+		if err := f.Sync(); err != nil {
+			return 0, err
+		}
+	}
+	elapsed := time.Since(start)
+	if elapsed <= 0 {
+		elapsed = time.Nanosecond
+	}
+
+	return int(float64(count) / elapsed.Seconds()), nil
+}
@@ -0,0 +1,124 @@
+package selftest
+
+import (
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/pactus-project/pactus/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStatusString(t *testing.T) {
+	assert.Equal(t, "passed", StatusPassed.String())
+	assert.Equal(t, "warning", StatusWarning.String())
+	assert.Equal(t, "failed", StatusFailed.String())
+	assert.Equal(t, "skipped", StatusSkipped.String())
+	assert.Equal(t, "9", Status(9).String())
+}
+
+func TestCheckClockDrift(t *testing.T) {
+	t.Run("No NTP server", func(t *testing.T) {
+		res := CheckClockDrift("", time.Second)
+		assert.Equal(t, StatusSkipped, res.Status)
+	})
+
+	t.Run("Clock is synced", func(t *testing.T) {
+		server := startNTPServer(t, 0)
+		res := CheckClockDrift(server, time.Second)
+		assert.Equal(t, StatusPassed, res.Status)
+	})
+
+	t.Run("Clock is drifted", func(t *testing.T) {
+		server := startNTPServer(t, -time.Minute)
+		res := CheckClockDrift(server, time.Second)
+		assert.Equal(t, StatusWarning, res.Status)
+		assert.Contains(t, res.Message, "synchronize")
+	})
+}
+
+func TestCheckDataDir(t *testing.T) {
+	t.Run("Not existing directory", func(t *testing.T) {
+		dir := filepath.Join(util.TempDirPath(), "data")
+		res := CheckDataDir(dir)
+		assert.Equal(t, StatusPassed, res.Status)
+		assert.True(t, util.PathExists(dir))
+		assert.True(t, util.IsDirEmpty(dir))
+	})
+
+	t.Run("Writable by everyone", func(t *testing.T) {
+		dir := util.TempDirPath()
+		require.NoError(t, os.Chmod(dir, 0o777))
+		res := CheckDataDir(dir)
+		assert.Equal(t, StatusWarning, res.Status)
+	})
+
+	t.Run("Path is a file", func(t *testing.T) {
+		file := util.TempFilePath()
+		require.NoError(t, util.WriteFile(file, []byte{1}))
+		res := CheckDataDir(file)
+		assert.Equal(t, StatusFailed, res.Status)
+	})
+}
+
+func TestCheckDisk(t *testing.T) {
+	dir := util.TempDirPath()
+
+	res := CheckDiskSpace(dir)
+	assert.NotEqual(t, StatusFailed, res.Status)
+
+	res = CheckDiskIOPS(dir)
+	assert.NotEqual(t, StatusFailed, res.Status)
+	assert.True(t, util.IsDirEmpty(dir))
+
+	res = CheckDiskIOPS(filepath.Join(dir, "not-exist"))
+	assert.Equal(t, StatusSkipped, res.Status)
+}
+
+func TestCheckSystem(t *testing.T) {
+	assert.NotEqual(t, StatusFailed, CheckMemory().Status)
+	assert.NotEqual(t, StatusFailed, CheckOpenFiles().Status)
+}
+
+func TestParseMemInfo(t *testing.T) {
+	memInfo := "MemTotal:        8046504 kB\nMemFree:          344384 kB\n"
+	total, err := parseMemInfo(strings.NewReader(memInfo))
+	require.NoError(t, err)
+	assert.Equal(t, uint64(8046504*1024), total)
+
+	_, err = parseMemInfo(strings.NewReader("MemFree: 344384 kB\n"))
+	assert.Error(t, err)
+
+	_, err = parseMemInfo(strings.NewReader("MemTotal: abc kB\n"))
+	assert.Error(t, err)
+}
+
+func TestCheckPorts(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer listener.Close()
+
+	res := CheckPorts([]string{"127.0.0.1:0"})
+	assert.Equal(t, StatusPassed, res.Status)
+
+	res = CheckPorts([]string{"127.0.0.1:0", listener.Addr().String()})
+	assert.Equal(t, StatusFailed, res.Status)
+	assert.Contains(t, res.Message, listener.Addr().String())
+}
+
+func TestRun(t *testing.T) {
+	results := Run(&Config{
+		DataDir: util.TempDirPath(),
+		Timeout: time.Second,
+	})
+
+	assert.Len(t, results, 7)
+	for _, res := range results {
+		assert.NotEmpty(t, res.Name)
+		assert.NotEqual(t, StatusFailed, res.Status, res.String())
+	}
+}
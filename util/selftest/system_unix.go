@@ -0,0 +1,16 @@
+//go:build !windows
+
+package selftest
+
+import (
+	"syscall"
+)
+
+func openFilesLimit() (uint64, error) {
+	var limit syscall.Rlimit
+	if err := syscall.Getrlimit(syscall.RLIMIT_NOFILE, &limit); err != nil {
+		return 0, err
+	}
+
+	return limit.Cur, nil
+}
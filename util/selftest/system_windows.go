@@ -0,0 +1,11 @@
+//go:build windows
+
+package selftest
+
+import (
+	"errors"
+)
+
+func openFilesLimit() (uint64, error) {
+	return 0, errors.New("not supported on this platform")
+}
@@ -0,0 +1,139 @@
+package simplemerkle
+
+import (
+	"github.com/pactus-project/pactus/crypto/hash"
+)
+
+// Builder builds the Merkle tree incrementally.
+// Changing a leaf only rehashes the path from the leaf to the root,
+// and the root is computed lazily, so the untouched subtrees are never rehashed.
+// The root is identical to the root of the tree built by NewTreeFromHashes.
+type Builder struct {
+	// levels keeps the nodes of the tree, level by level, starting from the leaves.
+	// Each level has half of the nodes of the level below it, rounded up.
+	levels [][]hash.Hash
+	// dirty keeps the index of the leaves that are changed since the last root computation.
+	dirty map[int]struct{}
+}
+
+// NewBuilder creates a new empty Merkle tree builder.
+// The capacity is the expected number of the leaves and is used to preallocate the memory.
+func NewBuilder(capacity int) *Builder {
+	if capacity < 0 {
+		capacity = 0
+	}
+
+	return &Builder{
+		levels: [][]hash.Hash{make([]hash.Hash, 0, capacity)},
+		dirty:  make(map[int]struct{}),
+	}
+}
+
+// Len returns the number of the leaves.
+func (b *Builder) Len() int {
+	return len(b.levels[0])
+}
+
+// Leaf returns the leaf at the given index.
+func (b *Builder) Leaf(index int) (hash.Hash, error) {
+	if index < 0 || index >= b.Len() {
+		return hash.UndefHash, ErrIndexOutOfRange
+	}
+
+	return b.levels[0][index], nil
+}
+
+// Append adds a new leaf to the end of the tree.
+func (b *Builder) Append(h hash.Hash) {
+	b.levels[0] = append(b.levels[0], h)
+	b.dirty[b.Len()-1] = struct{}{}
+}
+
+// UpdateLeaf replaces the leaf at the given index.
+func (b *Builder) UpdateLeaf(index int, h hash.Hash) error {
+	if index < 0 || index >= b.Len() {
+		return ErrIndexOutOfRange
+	}
+
+	b.levels[0][index] = h
+	b.dirty[index] = struct{}{}
+
+	return nil
+}
+
+// RemoveLast removes the last leaf of the tree.
+func (b *Builder) RemoveLast() error {
+	length := b.Len()
+	if length == 0 {
+		return ErrIndexOutOfRange
+	}
+
+	b.levels[0] = b.levels[0][:length-1]
+	delete(b.dirty, length-1)
+	if length > 1 {
+		// The ancestors of the removed leaf are either removed,
+		// or they are the ancestors of the new last leaf.
+		b.dirty[length-2] = struct{}{}
+	}
+
+	return nil
+}
+
+// Root returns the root of the Merkle tree.
+// Only the paths from the changed leaves to the root are rehashed.
+func (b *Builder) Root() hash.Hash {
+	if b.Len() == 0 {
+		return hash.UndefHash
+	}
+
+	b.update()
+
+	return b.levels[len(b.levels)-1][0]
+}
+
+// update rehashes the nodes on the paths from the dirty leaves to the root.
+func (b *Builder) update() {
+	dirty := b.dirty
+	level := 0
+	for len(b.levels[level]) > 1 {
+		children := b.levels[level]
+		width := (len(children) + 1) / 2
+
+		if level+1 == len(b.levels) {
+			b.levels = append(b.levels, make([]hash.Hash, 0, width))
+		}
+		parents := b.levels[level+1]
+		if cap(parents) < width {
+			grown := make([]hash.Hash, len(parents), width*2)
+			copy(grown, parents)
+			parents = grown
+		}
+		parents = parents[:width]
+
+		parentDirty := make(map[int]struct{}, len(dirty))
+		for i := range dirty {
+			p := i / 2
+			if _, ok := parentDirty[p]; ok {
+				continue
+			}
+			parentDirty[p] = struct{}{}
+
+			left := &children[2*p]
+			right := left
+			if 2*p+1 < len(children) {
+				// When there is no right child, the parent is generated by
+				// hashing the concatenation of the left child with itself.
+				right = &children[2*p+1]
+			}
+			parents[p] = *HashMerkleBranches(left, right)
+		}
+
+		b.levels[level+1] = parents
+		dirty = parentDirty
+		level++
+	}
+
+	// Dropping the levels above the root, if the tree has shrunk.
+	b.levels = b.levels[:level+1]
+	b.dirty = make(map[int]struct{})
+}
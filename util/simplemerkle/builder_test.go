@@ -0,0 +1,150 @@
+package simplemerkle
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"github.com/pactus-project/pactus/crypto/hash"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuilderEmpty(t *testing.T) {
+	b := NewBuilder(0)
+
+	assert.Equal(t, 0, b.Len())
+	assert.Equal(t, hash.UndefHash, b.Root())
+	assert.ErrorIs(t, b.RemoveLast(), ErrIndexOutOfRange)
+	assert.ErrorIs(t, b.UpdateLeaf(0, strToHash("a")), ErrIndexOutOfRange)
+	_, err := b.Leaf(0)
+	assert.ErrorIs(t, err, ErrIndexOutOfRange)
+}
+
+func TestBuilderAppend(t *testing.T) {
+	hashes := makeTestHashes(33)
+	b := NewBuilder(len(hashes))
+
+	for i, h := range hashes {
+		b.Append(h)
+
+		expected := NewTreeFromHashes(hashes[:i+1]).Root()
+		assert.Equal(t, expected, b.Root(), "leaves: %d", i+1)
+	}
+
+	assert.Equal(t, "e6061997a9011668bcf216020aaad9cc7f5f34d5b6f78f1e63ef6257c1aa1f37",
+		builderFromHashes(strToHash("a"), strToHash("b"), strToHash("c")).Root().String())
+}
+
+func TestBuilderUpdateLeaf(t *testing.T) {
+	hashes := makeTestHashes(13)
+	b := builderFromHashes(hashes...)
+
+	for i := range hashes {
+		hashes[i] = strToHash(fmt.Sprintf("updated-%d", i))
+		require.NoError(t, b.UpdateLeaf(i, hashes[i]))
+
+		leaf, err := b.Leaf(i)
+		require.NoError(t, err)
+		assert.Equal(t, hashes[i], leaf)
+		assert.Equal(t, NewTreeFromHashes(hashes).Root(), b.Root(), "index: %d", i)
+	}
+
+	assert.ErrorIs(t, b.UpdateLeaf(-1, hashes[0]), ErrIndexOutOfRange)
+	assert.ErrorIs(t, b.UpdateLeaf(len(hashes), hashes[0]), ErrIndexOutOfRange)
+}
+
+func TestBuilderRemoveLast(t *testing.T) {
+	hashes := makeTestHashes(17)
+	b := builderFromHashes(hashes...)
+	assert.Equal(t, NewTreeFromHashes(hashes).Root(), b.Root())
+
+	for i := len(hashes) - 1; i >= 0; i-- {
+		require.NoError(t, b.RemoveLast())
+
+		expected := NewTreeFromHashes(hashes[:i]).Root()
+		assert.Equal(t, expected, b.Root(), "leaves: %d", i)
+	}
+	assert.Equal(t, 0, b.Len())
+}
+
+func TestBuilderBatchChanges(t *testing.T) {
+	// The root is computed lazily, after several changes.
+	hashes := makeTestHashes(10)
+	b := builderFromHashes(hashes...)
+	assert.Equal(t, NewTreeFromHashes(hashes).Root(), b.Root())
+
+	for i := 0; i < 3; i++ {
+		require.NoError(t, b.RemoveLast())
+	}
+	hashes = hashes[:7]
+	hashes[2] = strToHash("x")
+	require.NoError(t, b.UpdateLeaf(2, hashes[2]))
+	for i := 0; i < 5; i++ {
+		h := strToHash(fmt.Sprintf("new-%d", i))
+		hashes = append(hashes, h)
+		b.Append(h)
+	}
+
+	assert.Equal(t, NewTreeFromHashes(hashes).Root(), b.Root())
+}
+
+func TestBuilderRandomOperations(t *testing.T) {
+	rnd := rand.New(rand.NewSource(0))
+	b := NewBuilder(16)
+	hashes := make([]hash.Hash, 0)
+
+	for i := 0; i < 1000; i++ {
+		h := strToHash(fmt.Sprintf("random-%d", i))
+		switch op := rnd.Intn(4); {
+		case op == 0 && len(hashes) > 0:
+			require.NoError(t, b.RemoveLast())
+			hashes = hashes[:len(hashes)-1]
+
+		case op == 1 && len(hashes) > 0:
+			index := rnd.Intn(len(hashes))
+			require.NoError(t, b.UpdateLeaf(index, h))
+			hashes[index] = h
+
+		default:
+			b.Append(h)
+			hashes = append(hashes, h)
+		}
+
+		if rnd.Intn(3) == 0 {
+			assert.Equal(t, NewTreeFromHashes(hashes).Root(), b.Root(), "step: %d", i)
+		}
+	}
+	assert.Equal(t, NewTreeFromHashes(hashes).Root(), b.Root())
+}
+
+func builderFromHashes(hashes ...hash.Hash) *Builder {
+	b := NewBuilder(len(hashes))
+	for _, h := range hashes {
+		b.Append(h)
+	}
+
+	return b
+}
+
+func BenchmarkBuilderUpdateLeaf(b *testing.B) {
+	hashes := makeTestHashes(4096)
+	builder := builderFromHashes(hashes...)
+	builder.Root()
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = builder.UpdateLeaf(i%len(hashes), hashes[(i+1)%len(hashes)])
+		builder.Root()
+	}
+}
+
+func BenchmarkTreeFromHashes(b *testing.B) {
+	hashes := makeTestHashes(4096)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		hashes[i%len(hashes)] = hashes[(i+1)%len(hashes)]
+		NewTreeFromHashes(hashes).Root()
+	}
+}
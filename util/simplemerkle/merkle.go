@@ -1,16 +1,15 @@
 package simplemerkle
 
 import (
+	"bytes"
+	"errors"
 	"math"
 
 	"github.com/pactus-project/pactus/crypto/hash"
+	"github.com/pactus-project/pactus/util/encoding"
 )
 
-var hasher func([]byte) hash.Hash
-
-func init() {
-	hasher = hash.CalcHash
-}
+var ErrInvalidTree = errors.New("invalid merkle tree")
 
 type Tree struct {
 	merkles []*hash.Hash
@@ -35,26 +34,49 @@ func nextPowerOfTwo(n int) int {
 // nodes, and returns the hash of their concatenation.  This is a helper
 // function used to aid in the generation of a merkle tree.
 func HashMerkleBranches(left, right *hash.Hash) *hash.Hash {
+	return hashMerkleBranches(hash.DefaultHasher(), left, right)
+}
+
+func hashMerkleBranches(hasher hash.Hasher, left, right *hash.Hash) *hash.Hash {
 	// Concatenate the left and right nodes.
 	var h [hash.HashSize * 2]byte
 	copy(h[:hash.HashSize], left.Bytes())
 	copy(h[hash.HashSize:], right.Bytes())
 
-	newHash := hasher(h[:])
+	newHash := hasher.CalcHash(h[:])
 
 	return &newHash
 }
 
 func NewTreeFromSlices(slices [][]byte) *Tree {
+	return NewTreeFromSlicesWithHasher(hash.DefaultHasher(), slices)
+}
+
+// NewTreeFromSlicesWithHasher makes the tree by the hash function of the hasher.
+func NewTreeFromSlicesWithHasher(hasher hash.Hasher, slices [][]byte) *Tree {
 	hashes := make([]hash.Hash, len(slices))
 	for i, b := range slices {
-		hashes[i] = hasher(b)
+		hashes[i] = hasher.CalcHash(b)
 	}
 
-	return NewTreeFromHashes(hashes)
+	return NewTreeFromHashesWithHasher(hasher, hashes)
 }
 
+// NewTreeFromHashes makes the tree by the default hash function.
+//
+// The duplicated leaves are accepted, so a list with a duplicated last leaf has
+// the same root as the list without it (CVE-2012-2459). The tree is not marked
+// by the number of the leaves, since changing the root is a hard fork and the callers
+// already commit to the number of the leaves: the block hash commits to the number
+// of the transactions after the root, and the block basic check rejects the
+// duplicated transactions. Other callers should reject the duplicated leaves,
+// or check the tree by Mutated.
 func NewTreeFromHashes(hashes []hash.Hash) *Tree {
+	return NewTreeFromHashesWithHasher(hash.DefaultHasher(), hashes)
+}
+
+// NewTreeFromHashesWithHasher makes the tree by the hash function of the hasher.
+func NewTreeFromHashesWithHasher(hasher hash.Hasher, hashes []hash.Hash) *Tree {
 	if len(hashes) == 0 {
 		return nil
 	}
@@ -80,13 +102,13 @@ func NewTreeFromHashes(hashes []hash.Hash) *Tree {
 		// When there is no right child, the parent is generated by
 		// hashing the concatenation of the left child with itself.
 		case merkles[i+1] == nil:
-			newHash := HashMerkleBranches(merkles[i], merkles[i])
+			newHash := hashMerkleBranches(hasher, merkles[i], merkles[i])
 			merkles[offset] = newHash
 
 		// The normal case sets the parent node to the double sha256
 		// of the concatenation of the left and right children.
 		default:
-			newHash := HashMerkleBranches(merkles[i], merkles[i+1])
+			newHash := hashMerkleBranches(hasher, merkles[i], merkles[i+1])
 			merkles[offset] = newHash
 		}
 		offset++
@@ -114,3 +136,98 @@ func (tree *Tree) Depth() int {
 
 	return int(math.Log2(float64(len(tree.merkles))))
 }
+
+// Mutated checks if the tree has two identical siblings.
+// The last node of an odd level is paired with itself, so the tree of [a, b, c]
+// has the same root as the tree of [a, b, c, c] (CVE-2012-2459).
+// A mutated tree always has duplicated leaves, and it should be rejected by the callers
+// that can't have duplicated leaves, otherwise a valid list can be replaced by a mutated
+// one with the same root.
+func (tree *Tree) Mutated() bool {
+	if tree == nil {
+		return false
+	}
+
+	offset := 0
+	width := tree.width()
+	for width > 1 {
+		for i := 0; i < width; i += 2 {
+			left := tree.merkles[offset+i]
+			right := tree.merkles[offset+i+1]
+			if left != nil && right != nil && *left == *right {
+				return true
+			}
+		}
+
+		offset += width
+		width /= 2
+	}
+
+	return false
+}
+
+// MarshalBinary encodes all the nodes of the tree,
+// so the tree can be cached or transmitted instead of being rebuilt.
+// It is encoded as: [node count: variant]+([present: 1 byte]+[hash: 32 bytes, if present])*.
+func (tree *Tree) MarshalBinary() ([]byte, error) {
+	if tree == nil {
+		return nil, ErrInvalidTree
+	}
+
+	w := bytes.NewBuffer(make([]byte, 0, len(tree.merkles)*(hash.HashSize+1)+4))
+	if err := encoding.WriteVarInt(w, uint64(len(tree.merkles))); err != nil {
+		return nil, err
+	}
+	for _, node := range tree.merkles {
+		if node == nil {
+			if err := encoding.WriteElement(w, false); err != nil {
+				return nil, err
+			}
+
+			continue
+		}
+		if err := encoding.WriteElements(w, true, node); err != nil {
+			return nil, err
+		}
+	}
+
+	return w.Bytes(), nil
+}
+
+// UnmarshalBinary decodes the tree that is encoded by MarshalBinary.
+// The nodes are not rehashed, so the root should be checked by the caller if the data is not trusted.
+func (tree *Tree) UnmarshalBinary(data []byte) error {
+	r := bytes.NewReader(data)
+	count, err := encoding.ReadVarInt(r)
+	if err != nil {
+		return err
+	}
+	// Each node takes at least one byte, and the tree has 2^n*2-1 nodes.
+	if count == 0 || count > uint64(r.Len()) || (count+1)&count != 0 {
+		return ErrInvalidTree
+	}
+
+	merkles := make([]*hash.Hash, count)
+	for i := range merkles {
+		present := false
+		if err := encoding.ReadElement(r, &present); err != nil {
+			return err
+		}
+		if !present {
+			continue
+		}
+
+		node := new(hash.Hash)
+		if err := encoding.ReadElement(r, node); err != nil {
+			return err
+		}
+		merkles[i] = node
+	}
+
+	if merkles[count-1] == nil || r.Len() != 0 {
+		return ErrInvalidTree
+	}
+	tree.merkles = merkles
+
+	return nil
+}
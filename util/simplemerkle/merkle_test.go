@@ -8,8 +8,22 @@ import (
 
 	"github.com/pactus-project/pactus/crypto/hash"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
+// doubleSHA256Hasher is the hash function of the Bitcoin Merkle trees.
+type doubleSHA256Hasher struct{}
+
+func (doubleSHA256Hasher) Version() hash.Version {
+	return 0
+}
+
+func (doubleSHA256Hasher) CalcHash(data []byte) hash.Hash {
+	first := sha256.Sum256(data)
+
+	return sha256.Sum256(first[:])
+}
+
 func strToHash(str string) hash.Hash {
 	h := hash.CalcHash([]byte(str))
 
@@ -54,15 +68,56 @@ func TestMerkleTreeDepth2(t *testing.T) {
 	fmt.Println(tree2.ToString())
 }
 
-func TestMerkleTree_Bitcoin_Block100000(t *testing.T) {
-	hasher = func(data []byte) hash.Hash {
-		first := sha256.Sum256(data)
-		second := sha256.Sum256(first[:])
-		h, _ := hash.FromBytes(second[:])
+func TestTreeBinaryEncoding(t *testing.T) {
+	for n := 1; n <= 17; n++ {
+		hashes := makeTestHashes(n)
+		tree := NewTreeFromHashes(hashes)
+
+		data, err := tree.MarshalBinary()
+		require.NoError(t, err)
 
-		return h
+		decoded := new(Tree)
+		require.NoError(t, decoded.UnmarshalBinary(data))
+		assert.Equal(t, tree, decoded)
+		assert.Equal(t, tree.Root(), decoded.Root())
+		assert.Equal(t, tree.Depth(), decoded.Depth())
+
+		proof, err := decoded.Proof(n - 1)
+		require.NoError(t, err)
+		assert.True(t, VerifyProof(tree.Root(), hashes[n-1], proof))
 	}
+}
 
+func TestTreeInvalidBinaryEncoding(t *testing.T) {
+	var nilTree *Tree
+	_, err := nilTree.MarshalBinary()
+	assert.ErrorIs(t, err, ErrInvalidTree)
+
+	tree := NewTreeFromHashes(makeTestHashes(5))
+	data, _ := tree.MarshalBinary()
+
+	tests := []struct {
+		name string
+		data []byte
+	}{
+		{"Empty", []byte{}},
+		{"No nodes", []byte{0x00}},
+		{"Invalid node count", append([]byte{0x06}, data[1:]...)},
+		{"Too many nodes", append([]byte{0x7f}, data[1:]...)},
+		{"Truncated", data[:len(data)-1]},
+		{"Extra data", append(data, 0x00)},
+		{"No root", []byte{0x01, 0x00}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			decoded := new(Tree)
+			assert.Error(t, decoded.UnmarshalBinary(tt.data))
+		})
+	}
+}
+
+func TestMerkleTree_Bitcoin_Block100000(t *testing.T) {
 	// Block 100000 in bitcoin
 	wantMerkle, _ := hex.DecodeString("6657A9252AACD5C0B2940996ECFF952228C3067CC38D4885EFB5A4AC4247E9F3")
 	id1, _ := hex.DecodeString("876DD0A3EF4A2816FFD1C12AB649825A958B0FF3BB3D6F3E1250F13DDBF0148C")
@@ -83,20 +138,12 @@ func TestMerkleTree_Bitcoin_Block100000(t *testing.T) {
 		h4,
 	}
 
-	tree := NewTreeFromHashes(hashes)
+	tree := NewTreeFromHashesWithHasher(doubleSHA256Hasher{}, hashes)
 	assert.Equal(t, tree.Root(), root)
 	assert.Equal(t, tree.Depth(), 2)
 }
 
 func TestMerkleTree_Bitcoin_Block153342(t *testing.T) {
-	hasher = func(data []byte) hash.Hash {
-		first := sha256.Sum256(data)
-		second := sha256.Sum256(first[:])
-		h, _ := hash.FromBytes(second[:])
-
-		return h
-	}
-
 	// Block 153342 in bitcoin
 	wantMerkle, _ := hex.DecodeString("dd8ee246e19ec5c77ddd46c1138e8af6a272da4dbb6500ea74a79c0bf89e2c07")
 	h1, _ := hash.FromString("216404816ca6261f9206d471d0403ba49bda4264719d879819fbda9849781e62")
@@ -119,7 +166,7 @@ func TestMerkleTree_Bitcoin_Block153342(t *testing.T) {
 		h1, h2, h3, h4, h5, h6, h7, h8, h9, h10, h11, h12, h13,
 	}
 
-	tree := NewTreeFromHashes(hashes)
+	tree := NewTreeFromHashesWithHasher(doubleSHA256Hasher{}, hashes)
 	assert.Equal(t, tree.Root(), root)
 	assert.Equal(t, tree.Depth(), 4)
 	fmt.Println(tree.ToString())
@@ -128,6 +175,6 @@ func TestMerkleTree_Bitcoin_Block153342(t *testing.T) {
 	right, _ := hash.FromString("4a3ee07bb7baf6dfa265fa5c85a8955c8e79ddab0f70657a14df5744a103e24d")
 	left, _ := hash.FromString("114799e25e6dc376d65fd5406516919e1e619b89316be91ea064a69400472d1e")
 
-	root2 := HashMerkleBranches(&left, &right)
+	root2 := hashMerkleBranches(doubleSHA256Hasher{}, &left, &right)
 	assert.Equal(t, root, *root2)
 }
@@ -0,0 +1,270 @@
+package simplemerkle
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/fxamacker/cbor/v2"
+	"github.com/pactus-project/pactus/crypto/hash"
+	"github.com/pactus-project/pactus/util/encoding"
+)
+
+// maxMultiProofLength is the maximum number of the indices or the hashes in a multiproof.
+// It prevents allocating huge memory when decoding malformed multiproofs.
+const maxMultiProofLength = 1 << 16
+
+// MultiProof is the inclusion proof of several leaves in the Merkle tree.
+// The internal nodes that are shared by the audit paths of the leaves are not repeated,
+// and the nodes that can be calculated from the leaves are omitted.
+// The hashes are the missing siblings, level by level from the leaves up to the root,
+// and from left to right in each level.
+type MultiProof struct {
+	Depth   uint8
+	Indices []uint32
+	Hashes  []hash.Hash
+}
+
+// MultiProof returns the inclusion proof of the leaves at the given indices.
+// The indices of the proof are sorted and deduplicated.
+func (tree *Tree) MultiProof(indices []int) (*MultiProof, error) {
+	if tree == nil || len(indices) == 0 {
+		return nil, ErrIndexOutOfRange
+	}
+
+	known := make([]int, 0, len(indices))
+	for _, index := range indices {
+		if index < 0 || index >= tree.width() || tree.merkles[index] == nil {
+			return nil, ErrIndexOutOfRange
+		}
+		known = append(known, index)
+	}
+	sort.Ints(known)
+	known = dedupSorted(known)
+
+	proof := &MultiProof{
+		Depth:   uint8(tree.Depth()),
+		Indices: make([]uint32, 0, len(known)),
+		Hashes:  make([]hash.Hash, 0),
+	}
+	for _, index := range known {
+		proof.Indices = append(proof.Indices, uint32(index))
+	}
+
+	offset := 0
+	width := tree.width()
+	for width > 1 {
+		parents := make([]int, 0, len(known))
+		for i := 0; i < len(known); i++ {
+			pos := known[i]
+			if i+1 < len(known) && known[i+1] == pos^1 {
+				// The sibling is known, so it is not needed in the proof.
+				i++
+			} else {
+				sibling := tree.merkles[offset+(pos^1)]
+				if sibling == nil {
+					// When there is no right child, the parent is generated by
+					// hashing the concatenation of the left child with itself.
+					sibling = tree.merkles[offset+pos]
+				}
+				proof.Hashes = append(proof.Hashes, *sibling)
+			}
+			parents = append(parents, pos/2)
+		}
+
+		known = parents
+		offset += width
+		width /= 2
+	}
+
+	return proof, nil
+}
+
+func dedupSorted(values []int) []int {
+	result := values[:0]
+	for i, v := range values {
+		if i == 0 || v != values[i-1] {
+			result = append(result, v)
+		}
+	}
+
+	return result
+}
+
+// VerifyMultiProof checks that the leaves belong to the Merkle tree with the given root.
+// The leaves should be in the same order as the indices of the proof.
+func VerifyMultiProof(root hash.Hash, leaves []hash.Hash, proof *MultiProof) bool {
+	return VerifyMultiProofWithHasher(hash.DefaultHasher(), root, leaves, proof)
+}
+
+// VerifyMultiProofWithHasher checks the multiproof of a tree that is made by the hash function of the hasher.
+func VerifyMultiProofWithHasher(hasher hash.Hasher, root hash.Hash, leaves []hash.Hash, proof *MultiProof) bool {
+	if proof == nil || proof.Depth > maxProofDepth ||
+		len(leaves) == 0 || len(leaves) != len(proof.Indices) {
+		return false
+	}
+
+	positions := make([]uint32, len(proof.Indices))
+	for i, index := range proof.Indices {
+		if index>>proof.Depth != 0 {
+			// The index doesn't fit in the depth of the tree.
+			return false
+		}
+		if i > 0 && index <= proof.Indices[i-1] {
+			// The indices should be sorted and unique.
+			return false
+		}
+		positions[i] = index
+	}
+
+	nodes := make([]*hash.Hash, len(leaves))
+	for i := range leaves {
+		nodes[i] = &leaves[i]
+	}
+
+	used := 0
+	for level := uint8(0); level < proof.Depth; level++ {
+		parents := make([]*hash.Hash, 0, len(nodes))
+		parentPositions := make([]uint32, 0, len(positions))
+		for i := 0; i < len(nodes); i++ {
+			pos := positions[i]
+			var parent *hash.Hash
+			switch {
+			case pos&1 == 0 && i+1 < len(nodes) && positions[i+1] == pos+1:
+				parent = hashMerkleBranches(hasher, nodes[i], nodes[i+1])
+				i++
+
+			case used < len(proof.Hashes):
+				sibling := &proof.Hashes[used]
+				used++
+				if pos&1 == 0 {
+					parent = hashMerkleBranches(hasher, nodes[i], sibling)
+				} else {
+					parent = hashMerkleBranches(hasher, sibling, nodes[i])
+				}
+
+			default:
+				return false
+			}
+			parents = append(parents, parent)
+			parentPositions = append(parentPositions, pos/2)
+		}
+
+		nodes = parents
+		positions = parentPositions
+	}
+
+	return used == len(proof.Hashes) && len(nodes) == 1 && *nodes[0] == root
+}
+
+// MultiProofFromBytes decodes the bytes into a multiproof.
+func MultiProofFromBytes(data []byte) (*MultiProof, error) {
+	proof := new(MultiProof)
+	if err := proof.Decode(bytes.NewReader(data)); err != nil {
+		return nil, err
+	}
+
+	return proof, nil
+}
+
+// SerializeSize returns the number of bytes it would take to serialize the multiproof.
+func (p *MultiProof) SerializeSize() int {
+	return 1 +
+		encoding.VarIntSerializeSize(uint64(len(p.Indices))) +
+		len(p.Indices)*4 +
+		encoding.VarIntSerializeSize(uint64(len(p.Hashes))) +
+		len(p.Hashes)*hash.HashSize
+}
+
+// Bytes returns the serialized bytes of the multiproof.
+func (p *MultiProof) Bytes() ([]byte, error) {
+	w := bytes.NewBuffer(make([]byte, 0, p.SerializeSize()))
+	if err := p.Encode(w); err != nil {
+		return nil, err
+	}
+
+	return w.Bytes(), nil
+}
+
+func (p *MultiProof) MarshalCBOR() ([]byte, error) {
+	bs, err := p.Bytes()
+	if err != nil {
+		return nil, err
+	}
+
+	return cbor.Marshal(bs)
+}
+
+func (p *MultiProof) UnmarshalCBOR(bs []byte) error {
+	data := make([]byte, 0, p.SerializeSize())
+	if err := cbor.Unmarshal(bs, &data); err != nil {
+		return err
+	}
+
+	return p.Decode(bytes.NewReader(data))
+}
+
+func (p *MultiProof) Encode(w io.Writer) error {
+	if err := encoding.WriteElement(w, p.Depth); err != nil {
+		return err
+	}
+	if err := encoding.WriteVarInt(w, uint64(len(p.Indices))); err != nil {
+		return err
+	}
+	for _, index := range p.Indices {
+		if err := encoding.WriteElement(w, index); err != nil {
+			return err
+		}
+	}
+	if err := encoding.WriteVarInt(w, uint64(len(p.Hashes))); err != nil {
+		return err
+	}
+	for i := range p.Hashes {
+		if err := encoding.WriteElement(w, &p.Hashes[i]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (p *MultiProof) Decode(r io.Reader) error {
+	if err := encoding.ReadElement(r, &p.Depth); err != nil {
+		return err
+	}
+	if p.Depth > maxProofDepth {
+		return fmt.Errorf("proof is too deep: %d", p.Depth)
+	}
+
+	length, err := encoding.ReadVarInt(r)
+	if err != nil {
+		return err
+	}
+	// A tree with this depth has at most 2^depth leaves.
+	if length > uint64(1)<<p.Depth || length > maxMultiProofLength {
+		return fmt.Errorf("too many indices: %d", length)
+	}
+	p.Indices = make([]uint32, length)
+	for i := range p.Indices {
+		if err := encoding.ReadElement(r, &p.Indices[i]); err != nil {
+			return err
+		}
+	}
+
+	length, err = encoding.ReadVarInt(r)
+	if err != nil {
+		return err
+	}
+	if length > maxMultiProofLength {
+		return fmt.Errorf("too many hashes: %d", length)
+	}
+	p.Hashes = make([]hash.Hash, length)
+	for i := range p.Hashes {
+		if err := encoding.ReadElement(r, &p.Hashes[i]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,175 @@
+package simplemerkle
+
+import (
+	"testing"
+
+	"github.com/fxamacker/cbor/v2"
+	"github.com/pactus-project/pactus/crypto/hash"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMultiProof(t *testing.T) {
+	for n := 1; n <= 9; n++ {
+		hashes := makeTestHashes(n)
+		tree := NewTreeFromHashes(hashes)
+		root := tree.Root()
+
+		// Checking all the subsets of the leaves.
+		for subset := 1; subset < 1<<n; subset++ {
+			indices := []int{}
+			leaves := []hash.Hash{}
+			for i := 0; i < n; i++ {
+				if subset&(1<<i) != 0 {
+					indices = append(indices, i)
+					leaves = append(leaves, hashes[i])
+				}
+			}
+
+			proof, err := tree.MultiProof(indices)
+			require.NoError(t, err)
+			assert.LessOrEqual(t, len(proof.Hashes), len(indices)*tree.Depth())
+			assert.True(t, VerifyMultiProof(root, leaves, proof), "leaves: %d, subset: %b", n, subset)
+
+			assert.False(t, VerifyMultiProof(strToHash("invalid"), leaves, proof))
+			leaves[0] = strToHash("invalid")
+			assert.False(t, VerifyMultiProof(root, leaves, proof))
+		}
+	}
+}
+
+func TestMultiProofSharedNodes(t *testing.T) {
+	hashes := makeTestHashes(256)
+	tree := NewTreeFromHashes(hashes)
+
+	indices := make([]int, 0, 128)
+	leaves := make([]hash.Hash, 0, 128)
+	for i := 0; i < 256; i += 2 {
+		indices = append(indices, i)
+		leaves = append(leaves, hashes[i])
+	}
+
+	proof, err := tree.MultiProof(indices)
+	require.NoError(t, err)
+
+	// Only the siblings of the leaves are needed, the upper nodes are calculated.
+	assert.Len(t, proof.Hashes, 128)
+	assert.True(t, VerifyMultiProof(tree.Root(), leaves, proof))
+}
+
+func TestMultiProofUnsortedIndices(t *testing.T) {
+	hashes := makeTestHashes(11)
+	tree := NewTreeFromHashes(hashes)
+
+	proof, err := tree.MultiProof([]int{9, 2, 9, 5})
+	require.NoError(t, err)
+	assert.Equal(t, []uint32{2, 5, 9}, proof.Indices)
+	assert.True(t, VerifyMultiProof(tree.Root(), []hash.Hash{hashes[2], hashes[5], hashes[9]}, proof))
+}
+
+func TestMultiProofInvalidIndex(t *testing.T) {
+	tree := NewTreeFromHashes(makeTestHashes(5))
+
+	_, err := tree.MultiProof([]int{})
+	assert.ErrorIs(t, err, ErrIndexOutOfRange)
+
+	_, err = tree.MultiProof([]int{1, -1})
+	assert.ErrorIs(t, err, ErrIndexOutOfRange)
+
+	// The padding leaves can't be proved.
+	_, err = tree.MultiProof([]int{1, 6})
+	assert.ErrorIs(t, err, ErrIndexOutOfRange)
+
+	var nilTree *Tree
+	_, err = nilTree.MultiProof([]int{0})
+	assert.ErrorIs(t, err, ErrIndexOutOfRange)
+}
+
+func TestVerifyInvalidMultiProof(t *testing.T) {
+	hashes := makeTestHashes(8)
+	tree := NewTreeFromHashes(hashes)
+	root := tree.Root()
+	leaves := []hash.Hash{hashes[1], hashes[4]}
+
+	proof, _ := tree.MultiProof([]int{1, 4})
+	require.True(t, VerifyMultiProof(root, leaves, proof))
+
+	t.Run("Nil proof", func(t *testing.T) {
+		assert.False(t, VerifyMultiProof(root, leaves, nil))
+	})
+
+	t.Run("Mismatched leaves", func(t *testing.T) {
+		assert.False(t, VerifyMultiProof(root, leaves[:1], proof))
+		assert.False(t, VerifyMultiProof(root, []hash.Hash{hashes[4], hashes[1]}, proof))
+	})
+
+	t.Run("Unsorted indices", func(t *testing.T) {
+		invalid := *proof
+		invalid.Indices = []uint32{4, 1}
+		assert.False(t, VerifyMultiProof(root, []hash.Hash{hashes[4], hashes[1]}, &invalid))
+	})
+
+	t.Run("Index out of depth", func(t *testing.T) {
+		invalid := *proof
+		invalid.Indices = []uint32{1, 12}
+		assert.False(t, VerifyMultiProof(root, leaves, &invalid))
+	})
+
+	t.Run("Missing hash", func(t *testing.T) {
+		invalid := *proof
+		invalid.Hashes = proof.Hashes[:len(proof.Hashes)-1]
+		assert.False(t, VerifyMultiProof(root, leaves, &invalid))
+	})
+
+	t.Run("Extra hash", func(t *testing.T) {
+		invalid := *proof
+		invalid.Hashes = append(append([]hash.Hash{}, proof.Hashes...), root)
+		assert.False(t, VerifyMultiProof(root, leaves, &invalid))
+	})
+}
+
+func TestMultiProofEncoding(t *testing.T) {
+	hashes := makeTestHashes(11)
+	tree := NewTreeFromHashes(hashes)
+	proof, _ := tree.MultiProof([]int{0, 3, 9})
+
+	t.Run("Bytes", func(t *testing.T) {
+		bs, err := proof.Bytes()
+		require.NoError(t, err)
+		assert.Len(t, bs, proof.SerializeSize())
+
+		decoded, err := MultiProofFromBytes(bs)
+		require.NoError(t, err)
+		assert.Equal(t, proof, decoded)
+		assert.True(t, VerifyMultiProof(tree.Root(),
+			[]hash.Hash{hashes[0], hashes[3], hashes[9]}, decoded))
+
+		_, err = MultiProofFromBytes(bs[:len(bs)-1])
+		assert.Error(t, err)
+	})
+
+	t.Run("CBOR", func(t *testing.T) {
+		bs, err := cbor.Marshal(proof)
+		require.NoError(t, err)
+
+		decoded := new(MultiProof)
+		require.NoError(t, cbor.Unmarshal(bs, decoded))
+		assert.Equal(t, proof, decoded)
+	})
+
+	t.Run("Too deep", func(t *testing.T) {
+		deep := &MultiProof{Depth: maxProofDepth + 1}
+		bs, _ := deep.Bytes()
+
+		_, err := MultiProofFromBytes(bs)
+		assert.Error(t, err)
+	})
+
+	t.Run("Too many indices", func(t *testing.T) {
+		wide := &MultiProof{Depth: 1, Indices: []uint32{0, 1, 2}}
+		bs, _ := wide.Bytes()
+
+		_, err := MultiProofFromBytes(bs)
+		assert.Error(t, err)
+	})
+}
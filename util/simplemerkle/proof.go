@@ -0,0 +1,193 @@
+package simplemerkle
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/fxamacker/cbor/v2"
+	"github.com/pactus-project/pactus/crypto/hash"
+	"github.com/pactus-project/pactus/util/encoding"
+)
+
+// maxProofDepth is the maximum number of the siblings in a proof.
+// It prevents allocating huge memory when decoding malformed proofs.
+const maxProofDepth = 32
+
+var (
+	ErrIndexOutOfRange = errors.New("index is out of range")
+	ErrLeafNotFound    = errors.New("leaf not found")
+)
+
+// Proof is the inclusion proof of a leaf in the Merkle tree.
+// It contains the audit path: the sibling hashes from the leaf up to the root.
+// The position bits of the path are the bits of the leaf index.
+// If a bit is set, the node is the right child, otherwise it is the left child.
+type Proof struct {
+	Index    uint32
+	Siblings []hash.Hash
+}
+
+// Proof returns the inclusion proof of the leaf at the given index.
+func (tree *Tree) Proof(index int) (*Proof, error) {
+	if tree == nil || index < 0 || index >= tree.width() || tree.merkles[index] == nil {
+		return nil, ErrIndexOutOfRange
+	}
+
+	proof := &Proof{
+		Index:    uint32(index),
+		Siblings: make([]hash.Hash, 0, tree.Depth()),
+	}
+
+	offset := 0
+	width := tree.width()
+	pos := index
+	for width > 1 {
+		sibling := tree.merkles[offset+(pos^1)]
+		if sibling == nil {
+			// When there is no right child, the parent is generated by
+			// hashing the concatenation of the left child with itself.
+			sibling = tree.merkles[offset+pos]
+		}
+		proof.Siblings = append(proof.Siblings, *sibling)
+
+		offset += width
+		width /= 2
+		pos /= 2
+	}
+
+	return proof, nil
+}
+
+// ProofOf returns the inclusion proof of the first leaf with the given hash.
+func (tree *Tree) ProofOf(h hash.Hash) (*Proof, error) {
+	if tree == nil {
+		return nil, ErrLeafNotFound
+	}
+
+	for i := 0; i < tree.width(); i++ {
+		if tree.merkles[i] != nil && *tree.merkles[i] == h {
+			return tree.Proof(i)
+		}
+	}
+
+	return nil, ErrLeafNotFound
+}
+
+// width returns the number of the leaves in the tree, including the nil leaves.
+func (tree *Tree) width() int {
+	return (len(tree.merkles) + 1) / 2
+}
+
+// VerifyProof checks that the leaf belongs to the Merkle tree with the given root.
+func VerifyProof(root, leaf hash.Hash, proof *Proof) bool {
+	return VerifyProofWithHasher(hash.DefaultHasher(), root, leaf, proof)
+}
+
+// VerifyProofWithHasher checks the proof of a tree that is made by the hash function of the hasher.
+func VerifyProofWithHasher(hasher hash.Hasher, root, leaf hash.Hash, proof *Proof) bool {
+	if proof == nil || len(proof.Siblings) > maxProofDepth {
+		return false
+	}
+	if proof.Index>>len(proof.Siblings) != 0 {
+		// The index doesn't fit in the depth of the tree.
+		return false
+	}
+
+	node := &leaf
+	pos := proof.Index
+	for i := range proof.Siblings {
+		sibling := &proof.Siblings[i]
+		if pos&1 == 0 {
+			node = hashMerkleBranches(hasher, node, sibling)
+		} else {
+			node = hashMerkleBranches(hasher, sibling, node)
+		}
+		pos >>= 1
+	}
+
+	return *node == root
+}
+
+// ProofFromBytes decodes the bytes into a proof.
+func ProofFromBytes(data []byte) (*Proof, error) {
+	proof := new(Proof)
+	if err := proof.Decode(bytes.NewReader(data)); err != nil {
+		return nil, err
+	}
+
+	return proof, nil
+}
+
+// SerializeSize returns the number of bytes it would take to serialize the proof.
+func (p *Proof) SerializeSize() int {
+	return 4 +
+		encoding.VarIntSerializeSize(uint64(len(p.Siblings))) +
+		len(p.Siblings)*hash.HashSize
+}
+
+// Bytes returns the serialized bytes of the proof.
+func (p *Proof) Bytes() ([]byte, error) {
+	w := bytes.NewBuffer(make([]byte, 0, p.SerializeSize()))
+	if err := p.Encode(w); err != nil {
+		return nil, err
+	}
+
+	return w.Bytes(), nil
+}
+
+func (p *Proof) MarshalCBOR() ([]byte, error) {
+	bs, err := p.Bytes()
+	if err != nil {
+		return nil, err
+	}
+
+	return cbor.Marshal(bs)
+}
+
+func (p *Proof) UnmarshalCBOR(bs []byte) error {
+	data := make([]byte, 0, p.SerializeSize())
+	if err := cbor.Unmarshal(bs, &data); err != nil {
+		return err
+	}
+
+	return p.Decode(bytes.NewReader(data))
+}
+
+func (p *Proof) Encode(w io.Writer) error {
+	if err := encoding.WriteElement(w, p.Index); err != nil {
+		return err
+	}
+	if err := encoding.WriteVarInt(w, uint64(len(p.Siblings))); err != nil {
+		return err
+	}
+	for i := range p.Siblings {
+		if err := encoding.WriteElement(w, &p.Siblings[i]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (p *Proof) Decode(r io.Reader) error {
+	if err := encoding.ReadElement(r, &p.Index); err != nil {
+		return err
+	}
+	length, err := encoding.ReadVarInt(r)
+	if err != nil {
+		return err
+	}
+	if length > maxProofDepth {
+		return fmt.Errorf("proof is too deep: %d", length)
+	}
+	p.Siblings = make([]hash.Hash, length)
+	for i := range p.Siblings {
+		if err := encoding.ReadElement(r, &p.Siblings[i]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
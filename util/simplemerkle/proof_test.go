@@ -0,0 +1,120 @@
+package simplemerkle
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/fxamacker/cbor/v2"
+	"github.com/pactus-project/pactus/crypto/hash"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func makeTestHashes(n int) []hash.Hash {
+	hashes := make([]hash.Hash, n)
+	for i := 0; i < n; i++ {
+		hashes[i] = strToHash(fmt.Sprintf("leaf-%d", i))
+	}
+
+	return hashes
+}
+
+func TestProof(t *testing.T) {
+	for n := 1; n <= 17; n++ {
+		hashes := makeTestHashes(n)
+		tree := NewTreeFromHashes(hashes)
+		root := tree.Root()
+
+		for i, leaf := range hashes {
+			proof, err := tree.Proof(i)
+			require.NoError(t, err)
+			assert.Len(t, proof.Siblings, tree.Depth())
+			assert.True(t, VerifyProof(root, leaf, proof), "leaves: %d, index: %d", n, i)
+
+			assert.False(t, VerifyProof(root, strToHash("invalid"), proof))
+			assert.False(t, VerifyProof(strToHash("invalid"), leaf, proof))
+		}
+	}
+}
+
+func TestProofInvalidIndex(t *testing.T) {
+	tree := NewTreeFromHashes(makeTestHashes(5))
+
+	_, err := tree.Proof(-1)
+	assert.ErrorIs(t, err, ErrIndexOutOfRange)
+
+	// The tree has 8 leaves, but the last three are nil.
+	_, err = tree.Proof(5)
+	assert.ErrorIs(t, err, ErrIndexOutOfRange)
+
+	_, err = tree.Proof(8)
+	assert.ErrorIs(t, err, ErrIndexOutOfRange)
+
+	var nilTree *Tree
+	_, err = nilTree.Proof(0)
+	assert.ErrorIs(t, err, ErrIndexOutOfRange)
+}
+
+func TestProofOf(t *testing.T) {
+	hashes := makeTestHashes(6)
+	tree := NewTreeFromHashes(hashes)
+
+	proof, err := tree.ProofOf(hashes[4])
+	require.NoError(t, err)
+	assert.Equal(t, uint32(4), proof.Index)
+	assert.True(t, VerifyProof(tree.Root(), hashes[4], proof))
+
+	_, err = tree.ProofOf(strToHash("invalid"))
+	assert.ErrorIs(t, err, ErrLeafNotFound)
+}
+
+func TestVerifyProofWrongPosition(t *testing.T) {
+	hashes := makeTestHashes(4)
+	tree := NewTreeFromHashes(hashes)
+
+	proof, _ := tree.Proof(1)
+	proof.Index = 0
+	assert.False(t, VerifyProof(tree.Root(), hashes[1], proof))
+
+	proof.Index = 4
+	assert.False(t, VerifyProof(tree.Root(), hashes[1], proof), "index doesn't fit in the depth")
+
+	assert.False(t, VerifyProof(tree.Root(), hashes[1], nil))
+}
+
+func TestProofEncoding(t *testing.T) {
+	hashes := makeTestHashes(11)
+	tree := NewTreeFromHashes(hashes)
+	proof, _ := tree.Proof(9)
+
+	t.Run("Bytes", func(t *testing.T) {
+		bs, err := proof.Bytes()
+		require.NoError(t, err)
+		assert.Len(t, bs, proof.SerializeSize())
+
+		decoded, err := ProofFromBytes(bs)
+		require.NoError(t, err)
+		assert.Equal(t, proof, decoded)
+		assert.True(t, VerifyProof(tree.Root(), hashes[9], decoded))
+
+		_, err = ProofFromBytes(bs[:len(bs)-1])
+		assert.Error(t, err)
+	})
+
+	t.Run("CBOR", func(t *testing.T) {
+		bs, err := cbor.Marshal(proof)
+		require.NoError(t, err)
+
+		decoded := new(Proof)
+		require.NoError(t, cbor.Unmarshal(bs, decoded))
+		assert.Equal(t, proof, decoded)
+	})
+
+	t.Run("Too deep", func(t *testing.T) {
+		deep := &Proof{Siblings: make([]hash.Hash, maxProofDepth+1)}
+		bs, _ := deep.Bytes()
+
+		_, err := ProofFromBytes(bs)
+		assert.Error(t, err)
+	})
+}
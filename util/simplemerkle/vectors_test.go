@@ -0,0 +1,135 @@
+package simplemerkle
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"github.com/pactus-project/pactus/crypto/hash"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// goldenRoots are the roots of the trees that are made by `makeTestHashes`.
+// They cover the odd leaf counts and the power-of-two boundaries.
+// The roots should never change, otherwise the block and the state hashes change.
+var goldenRoots = []struct {
+	leaves int
+	root   string
+}{
+	{1, "896d5b3080b2122a2b087e07da66d6e1478175055f8d74f5303348660f6a592c"},
+	{2, "9c5adc93cdaabb011d14e8ce966d7df2089bd4f3a1d81e0f07f5fe60c60a2636"},
+	{3, "afaed2a3c81174c712dd69e3912e9702b0fdce8bd6dfc6c4b2d038361d1cebe8"},
+	{4, "a927ca1b1c62cc565d57a2afe99fd5908989324e4ffaa2ce3f7ecd40978b9e48"},
+	{5, "28ccae79fd979a72180c1a7db6aaf548a70cc8c85856774fc02cd10e7e8a4b98"},
+	{7, "a5b7356b180e0f2d96a00d8090709707d6f4b7c14752a86485981718b6e381ea"},
+	{8, "37d2ad427b67f570f87c51db4718ebff75bb8ce0f9490e5144f0710ca6959ce1"},
+	{9, "fd6b0e272bb5a20eb200b38ed8d50a32935b8d677c5d383001323ee727aa01c2"},
+	{15, "762da1a84218c11c4397066e0a63b4c9abcb3a39c1ec5257110c9dc373124884"},
+	{16, "2d6d2262e2f2e090fa06542524276c1b920f674c95a6330ad170a0c8534dcb21"},
+	{17, "fa82c123d45c6ffb057e2f745b6328e195951e9b619ddb522e68d1c4ba980b09"},
+	{31, "1c7521d12aae2c183256810f8a94bac4fb72aaafbb6fc70047f8eb418db17dbc"},
+	{32, "60b64c0db8fd471839cb52f41a19762a5c0d0141286e1af90164399b1ea1662c"},
+	{33, "6ee0601bcc10980f2b2dae46872004612c0fc292e8ff90b3947abb8519da5cba"},
+	{255, "496c835eea5735dee83ea6aad8287e9eaf9eb148c86002cf03a19edb9317b9de"},
+	{256, "3606d5f4686f89ff75a830addcd1f80850497cc57b3f04a5c67b47bedf10e5e3"},
+	{257, "31fc77ac20433e78ba611f33bfe27cae39c5d2c6a39bc06f766077b621cfeda4"},
+	{1000, "219f790d80478f8e667f96c75230b851cd365ad2f611a6ab67f2e78eab23b90d"},
+	{1024, "139ea96168527d592818bac3cfcb4b1a16b6820def2843f61a1deb220dd1527f"},
+}
+
+func TestGoldenRoots(t *testing.T) {
+	for _, golden := range goldenRoots {
+		expected, err := hash.FromString(golden.root)
+		require.NoError(t, err)
+
+		hashes := makeTestHashes(golden.leaves)
+		tree := NewTreeFromHashes(hashes)
+		assert.Equal(t, expected, tree.Root(), "leaves: %d", golden.leaves)
+		assert.Equal(t, expected, builderFromHashes(hashes...).Root(), "leaves: %d", golden.leaves)
+		assert.False(t, tree.Mutated(), "leaves: %d", golden.leaves)
+	}
+}
+
+func TestSingleLeafTree(t *testing.T) {
+	leaf := strToHash("leaf-0")
+	tree := NewTreeFromHashes([]hash.Hash{leaf})
+
+	assert.Equal(t, leaf, tree.Root())
+	assert.Equal(t, 0, tree.Depth())
+	assert.False(t, tree.Mutated())
+}
+
+func TestRootProperties(t *testing.T) {
+	rnd := rand.New(rand.NewSource(0))
+
+	for i := 0; i < 50; i++ {
+		count := rnd.Intn(300) + 1
+		hashes := make([]hash.Hash, count)
+		for j := range hashes {
+			hashes[j] = strToHash(fmt.Sprintf("random-%d-%d", i, j))
+		}
+		tree := NewTreeFromHashes(hashes)
+		root := tree.Root()
+
+		// The builder and the tree have the same root.
+		assert.Equal(t, root, builderFromHashes(hashes...).Root(), "leaves: %d", count)
+
+		// The root is stable after encoding and decoding the tree.
+		data, err := tree.MarshalBinary()
+		require.NoError(t, err)
+		decoded := new(Tree)
+		require.NoError(t, decoded.UnmarshalBinary(data))
+		assert.Equal(t, root, decoded.Root(), "leaves: %d", count)
+
+		// Changing any leaf changes the root.
+		index := rnd.Intn(count)
+		hashes[index] = strToHash("changed")
+		assert.NotEqual(t, root, NewTreeFromHashes(hashes).Root(), "leaves: %d, index: %d", count, index)
+
+		// Appending a new leaf changes the root.
+		hashes = append(hashes, strToHash("appended"))
+		assert.NotEqual(t, root, NewTreeFromHashes(hashes).Root(), "leaves: %d", count)
+	}
+}
+
+func TestMutatedTree(t *testing.T) {
+	a := strToHash("a")
+	b := strToHash("b")
+	c := strToHash("c")
+	d := strToHash("d")
+	e := strToHash("e")
+
+	t.Run("Duplicated last leaf", func(t *testing.T) {
+		tree := NewTreeFromHashes([]hash.Hash{a, b, c})
+		mutated := NewTreeFromHashes([]hash.Hash{a, b, c, c})
+
+		assert.Equal(t, tree.Root(), mutated.Root())
+		assert.False(t, tree.Mutated())
+		assert.True(t, mutated.Mutated())
+	})
+
+	t.Run("Duplicated subtree", func(t *testing.T) {
+		tree := NewTreeFromHashes([]hash.Hash{a, b, c, d, e})
+		mutated := NewTreeFromHashes([]hash.Hash{a, b, c, d, e, e, e, e})
+
+		assert.Equal(t, tree.Root(), mutated.Root())
+		assert.False(t, tree.Mutated())
+		assert.True(t, mutated.Mutated())
+	})
+
+	t.Run("Duplicated leaves that are not siblings", func(t *testing.T) {
+		tree := NewTreeFromHashes([]hash.Hash{a, b, a, b})
+
+		// The leaves are not siblings, but the subtrees are identical.
+		assert.True(t, tree.Mutated())
+
+		tree = NewTreeFromHashes([]hash.Hash{a, b, c, a})
+		assert.False(t, tree.Mutated())
+	})
+
+	t.Run("Nil tree", func(t *testing.T) {
+		var tree *Tree
+		assert.False(t, tree.Mutated())
+	})
+}
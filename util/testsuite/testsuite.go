@@ -17,9 +17,11 @@ import (
 	"github.com/pactus-project/pactus/types/certificate"
 	"github.com/pactus-project/pactus/types/proposal"
 	"github.com/pactus-project/pactus/types/tx"
+	"github.com/pactus-project/pactus/types/tx/payload"
 	"github.com/pactus-project/pactus/types/validator"
 	"github.com/pactus-project/pactus/types/vote"
 	"github.com/pactus-project/pactus/util"
+	utilrand "github.com/pactus-project/pactus/util/rand"
 )
 
 // TestSuite provides a set of helper functions for testing purposes.
@@ -59,6 +61,17 @@ func NewTestSuite(t *testing.T) *TestSuite {
 	}
 }
 
+// UseDeterministicRand replaces the global source of the `util/rand` package
+// with a deterministic source seeded by the seed of the test suite.
+// This makes the random decisions inside the code, like picking a random peer,
+// reproducible by the seed. The previous source is restored when the test finishes.
+func (ts *TestSuite) UseDeterministicRand(t *testing.T) {
+	t.Helper()
+
+	restore := utilrand.SetSource(utilrand.NewSource(ts.Seed))
+	t.Cleanup(restore)
+}
+
 // RandBool returns a random boolean value.
 func (ts *TestSuite) RandBool() bool {
 	return ts.RandInt64(2) == 0
@@ -412,6 +425,41 @@ func (ts *TestSuite) GenerateTestWithdrawTx() (*tx.Tx, *bls.PrivateKey) {
 	return trx, prv
 }
 
+// GenerateTestEscrowTx generates an escrow transaction for testing purposes.
+// The buyer releases the escrow to the seller and the arbiter approves it.
+func (ts *TestSuite) GenerateTestEscrowTx() (*tx.Tx, *bls.PrivateKey) {
+	buyerPub, buyerPrv := ts.RandBLSKeyPair()
+	arbiterPub, arbiterPrv := ts.RandBLSKeyPair()
+	lockTime := ts.RandHeight()
+	pld := &payload.EscrowPayload{
+		Buyer:   buyerPub.AccountAddress(),
+		Seller:  ts.RandAccAddress(),
+		Arbiter: arbiterPub.AccountAddress(),
+		Nonce:   ts.RandUint32(1000),
+		Amount:  ts.RandInt64(1000 * 1e10),
+	}
+	pld.From = pld.Buyer
+	pld.To = pld.Seller
+	approval := arbiterPrv.SignNative(pld.ApprovalSignBytes(lockTime))
+
+	trx := tx.NewEscrowTx(lockTime, pld.Buyer, pld.Seller, pld.Arbiter, pld.Nonce,
+		pld.From, pld.To, arbiterPub, approval,
+		pld.Amount, ts.RandInt64(1*1e10), "test escrow-tx")
+	ts.HelperSignTransaction(buyerPrv, trx)
+
+	return trx, buyerPrv
+}
+
+// GenerateTestDataTx generates a data transaction for testing purposes.
+func (ts *TestSuite) GenerateTestDataTx() (*tx.Tx, *bls.PrivateKey) {
+	pub, prv := ts.RandBLSKeyPair()
+	trx := tx.NewDataTx(ts.RandHeight(), pub.AccountAddress(),
+		ts.RandBytes(ts.RandIntNonZero(1024)), ts.RandInt64(1*1e10), "test data-tx")
+	ts.HelperSignTransaction(prv, trx)
+
+	return trx, prv
+}
+
 // GenerateTestPrecommitVote generates a precommit vote for testing purposes.
 func (ts *TestSuite) GenerateTestPrecommitVote(height uint32, round int16) (*vote.Vote, *bls.ValidatorKey) {
 	valKey := ts.RandValKey()
@@ -475,6 +523,12 @@ func (ts *TestSuite) HelperSignProposal(valKey *bls.ValidatorKey, p *proposal.Pr
 	}
 }
 
+// HelperSetProofOfPossession sets the proof of possession of the validator key in the bond transaction.
+// It should be called before signing the transaction.
+func (ts *TestSuite) HelperSetProofOfPossession(valPrv *bls.PrivateKey, trx *tx.Tx) {
+	trx.Payload().(*payload.BondPayload).ProofOfPossession = valPrv.ProofOfPossession()
+}
+
 func (ts *TestSuite) HelperSignTransaction(prv crypto.PrivateKey, trx *tx.Tx) {
 	sig := prv.Sign(trx.SignBytes())
 	trx.SetSignature(sig)
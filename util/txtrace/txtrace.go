@@ -0,0 +1,100 @@
+// Package txtrace keeps the timeline of the recent transactions.
+//
+// The modules record when a transaction passes each stage of its lifecycle,
+// from receiving it until publishing its event, so the integrators can find
+// where a transaction has spent its time. Only the first time of each stage is kept,
+// and only the timelines of the recent transactions are kept.
+package txtrace
+
+import (
+	"sync"
+	"time"
+
+	lru "github.com/hashicorp/golang-lru/v2"
+	"github.com/pactus-project/pactus/crypto/hash"
+)
+
+// Capacity is the number of the recent transactions whose timeline is kept.
+const Capacity = 8192
+
+// Stage is a stage in the lifecycle of a transaction.
+type Stage int
+
+const (
+	StageReceived     = Stage(0) // Received from a peer or the RPC
+	StagePoolAccepted = Stage(1) // Accepted by the transaction pool
+	StageGossiped     = Stage(2) // Broadcasted or relayed to the peers
+	StageProposed     = Stage(3) // Included in a proposal
+	StageCommitted    = Stage(4) // Committed in a block
+	StagePublished    = Stage(5) // Its event is published
+)
+
+func (s Stage) String() string {
+	switch s {
+	case StageReceived:
+		return "received"
+	case StagePoolAccepted:
+		return "pool_accepted"
+	case StageGossiped:
+		return "gossiped"
+	case StageProposed:
+		return "proposed"
+	case StageCommitted:
+		return "committed"
+	case StagePublished:
+		return "published"
+	default:
+		return "unknown"
+	}
+}
+
+// Event records when a transaction has passed a stage.
+type Event struct {
+	Stage Stage
+	Time  time.Time
+}
+
+var (
+	lk           sync.Mutex
+	timelines, _ = lru.New[hash.Hash, []Event](Capacity)
+)
+
+// Record records that the transaction has passed the stage now.
+// If the transaction has passed the stage before, it is ignored.
+func Record(id hash.Hash, stage Stage) {
+	RecordAt(id, stage, time.Now())
+}
+
+// RecordAt records that the transaction has passed the stage at the given time.
+// If the transaction has passed the stage before, it is ignored.
+func RecordAt(id hash.Hash, stage Stage, at time.Time) {
+	lk.Lock()
+	defer lk.Unlock()
+
+	events, _ := timelines.Get(id)
+	for _, e := range events {
+		if e.Stage == stage {
+			return
+		}
+	}
+
+	events = append(events, Event{Stage: stage, Time: at})
+	timelines.Add(id, events)
+}
+
+// Timeline returns the events of the transaction, in the order that they are recorded.
+// It returns false if the transaction is not traced, or it is not recent.
+func Timeline(id hash.Hash) ([]Event, bool) {
+	lk.Lock()
+	defer lk.Unlock()
+
+	events, ok := timelines.Peek(id)
+	if !ok {
+		return nil, false
+	}
+
+	timeline := make([]Event, len(events))
+	copy(timeline, events)
+
+	return timeline, true
+}
@@ -0,0 +1,54 @@
+package txtrace
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pactus-project/pactus/util/testsuite"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecord(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	id := ts.RandHash()
+	now := time.Now()
+	RecordAt(id, StageReceived, now)
+	RecordAt(id, StagePoolAccepted, now.Add(time.Second))
+	RecordAt(id, StageReceived, now.Add(2*time.Second))
+
+	timeline, ok := Timeline(id)
+	require.True(t, ok)
+	assert.Equal(t, []Event{
+		{Stage: StageReceived, Time: now},
+		{Stage: StagePoolAccepted, Time: now.Add(time.Second)},
+	}, timeline)
+
+	// Modifying the returned timeline should not affect the trace.
+	timeline[0].Stage = StagePublished
+	timeline, _ = Timeline(id)
+	assert.Equal(t, StageReceived, timeline[0].Stage)
+
+	_, ok = Timeline(ts.RandHash())
+	assert.False(t, ok)
+}
+
+func TestRecentWindow(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	oldID := ts.RandHash()
+	Record(oldID, StageReceived)
+	for i := 0; i < Capacity; i++ {
+		Record(ts.RandHash(), StageReceived)
+	}
+
+	_, ok := Timeline(oldID)
+	assert.False(t, ok)
+}
+
+func TestStageString(t *testing.T) {
+	assert.Equal(t, "pool_accepted", StagePoolAccepted.String())
+	assert.Equal(t, "published", StagePublished.String())
+	assert.Equal(t, "unknown", Stage(-1).String())
+}
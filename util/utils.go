@@ -1,11 +1,11 @@
 package util
 
 import (
-	crand "crypto/rand"
 	"math/big"
 	"math/bits"
 	"strconv"
 
+	"github.com/pactus-project/pactus/util/rand"
 	"golang.org/x/exp/constraints"
 )
 
@@ -81,15 +81,7 @@ func RandInt64(max int64) int64 {
 // RandUint64 returns a random uint64 in between 0 and max.
 // If max set to zero or negative, the max will set to MaxUint64.
 func RandUint64(max uint64) uint64 {
-	if max <= 0 {
-		max = MaxUint64
-	}
-
-	bigMax := &big.Int{}
-	bigMax.SetUint64(max)
-	bigRnd, _ := crand.Int(crand.Reader, bigMax)
-
-	return bigRnd.Uint64()
+	return rand.Uint64n(max)
 }
 
 // SetFlag applies mask to the flags.
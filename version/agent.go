@@ -0,0 +1,49 @@
+package version
+
+import (
+	"strconv"
+	"strings"
+)
+
+// AgentInfo is the information that a node advertises in its agent.
+type AgentInfo struct {
+	App             string
+	Version         string
+	ProtocolVersion uint32
+	OS              string
+	Arch            string
+	Commit          string // Empty for the nodes that don't advertise it
+}
+
+// ParseAgent parses the agent of a node, like:
+// `node=daemon/node-version=v1.1.0/protocol-version=1/os=linux/arch=amd64/commit=0123456789ab`.
+// The unknown and the malformed parts are ignored.
+func ParseAgent(agent string) AgentInfo {
+	info := AgentInfo{}
+	for _, part := range strings.Split(agent, "/") {
+		key, value, ok := strings.Cut(part, "=")
+		if !ok {
+			continue
+		}
+
+		switch key {
+		case "node":
+			info.App = value
+		case "node-version":
+			info.Version = strings.TrimPrefix(value, "v")
+		case "protocol-version":
+			ver, err := strconv.ParseUint(value, 10, 32)
+			if err == nil {
+				info.ProtocolVersion = uint32(ver)
+			}
+		case "os":
+			info.OS = value
+		case "arch":
+			info.Arch = value
+		case "commit":
+			info.Commit = value
+		}
+	}
+
+	return info
+}
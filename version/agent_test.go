@@ -0,0 +1,38 @@
+package version
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseAgent(t *testing.T) {
+	tests := []struct {
+		agent string
+		info  AgentInfo
+	}{
+		{
+			"node=daemon/node-version=v1.1.0-beta/protocol-version=1/os=linux/arch=amd64/commit=0123456789ab",
+			AgentInfo{
+				App: "daemon", Version: "1.1.0-beta", ProtocolVersion: 1,
+				OS: "linux", Arch: "amd64", Commit: "0123456789ab",
+			},
+		},
+		{
+			"node=gui/node-version=v1.0.0/protocol-version=1/os=darwin/arch=arm64",
+			AgentInfo{App: "gui", Version: "1.0.0", ProtocolVersion: 1, OS: "darwin", Arch: "arm64"},
+		},
+		{
+			"node=daemon/node-version=v1.0.0/protocol-version=x",
+			AgentInfo{App: "daemon", Version: "1.0.0"},
+		},
+		{"unknown", AgentInfo{}},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.info, ParseAgent(tt.agent), tt.agent)
+	}
+
+	assert.Equal(t, Version(), ParseAgent(Agent()).Version)
+	assert.Equal(t, Commit(), ParseAgent(Agent()).Commit)
+}
@@ -3,6 +3,7 @@ package version
 import (
 	"fmt"
 	"runtime"
+	"runtime/debug"
 )
 
 var AppType string
@@ -16,9 +17,49 @@ const (
 	protocolVersion uint   = 1
 )
 
+// Agent returns the agent of the node, that is advertised to the peers.
+// The commit is included if the node is built from a git repository.
 func Agent() string {
-	return fmt.Sprintf("node=%s/node-version=v%s/protocol-version=%d/os=%s/arch=%s",
+	agent := fmt.Sprintf("node=%s/node-version=v%s/protocol-version=%d/os=%s/arch=%s",
 		AppType, Version(), protocolVersion, runtime.GOOS, runtime.GOARCH)
+	if commit := Commit(); commit != "" {
+		agent = fmt.Sprintf("%s/commit=%s", agent, commit)
+	}
+
+	return agent
+}
+
+// Commit returns the git commit that the node is built from, or empty if it is not known.
+// It has the `-dirty` suffix if the working tree had local changes.
+func Commit() string {
+	info, ok := debug.ReadBuildInfo()
+	if !ok {
+		return ""
+	}
+
+	commit := ""
+	dirty := false
+	for _, setting := range info.Settings {
+		switch setting.Key {
+		case "vcs.revision":
+			commit = setting.Value
+		case "vcs.modified":
+			dirty = setting.Value == "true"
+		}
+	}
+	if len(commit) > 12 {
+		commit = commit[:12]
+	}
+	if commit != "" && dirty {
+		commit += "-dirty"
+	}
+
+	return commit
+}
+
+// ProtocolVersion returns the version of the sync protocol that the node speaks.
+func ProtocolVersion() uint32 {
+	return uint32(protocolVersion)
 }
 
 func Version() string {
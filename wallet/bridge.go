@@ -0,0 +1,74 @@
+package wallet
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	pactus "github.com/pactus-project/pactus/www/grpc/gen/go"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// The bridge token key and the watch method of the gRPC bridge.
+// They are the same as the ones in the `www/grpc` package, that imports the wallet.
+const (
+	bridgeTokenKey    = "x-pactus-bridge-token"
+	bridgeWatchMethod = "/pactus.Bridge/WatchBlockchain"
+)
+
+var bridgeWatchDesc = &grpc.StreamDesc{
+	StreamName:    "WatchBlockchain",
+	ServerStreams: true,
+}
+
+// bridgeToken sends the bridge token with every call.
+type bridgeToken string
+
+func (t bridgeToken) GetRequestMetadata(context.Context, ...string) (map[string]string, error) {
+	return map[string]string{bridgeTokenKey: string(t)}, nil
+}
+
+// RequireTransportSecurity returns false, because the bridge only listens on the loopback interface.
+func (bridgeToken) RequireTransportSecurity() bool {
+	return false
+}
+
+// ConnectToBridge connects the wallet to the gRPC bridge of a local node,
+// using the token of the bridge.
+func (w *Wallet) ConnectToBridge(addr, token string) error {
+	return w.tryToConnect([]string{addr}, insecure.NewCredentials(),
+		grpc.WithPerRPCCredentials(bridgeToken(token)))
+}
+
+// WatchBlockchain calls onInfo with the blockchain info on start and whenever a new block is committed.
+// The wallet should be connected to a gRPC bridge.
+// It blocks until the context is canceled, or the stream fails.
+func (w *Wallet) WatchBlockchain(ctx context.Context, onInfo func(*pactus.GetBlockchainInfoResponse)) error {
+	if w.client == nil {
+		return ErrOffline
+	}
+
+	stream, err := w.client.conn.NewStream(ctx, bridgeWatchDesc, bridgeWatchMethod)
+	if err != nil {
+		return err
+	}
+	if err := stream.SendMsg(&pactus.GetBlockchainInfoRequest{}); err != nil {
+		return err
+	}
+	if err := stream.CloseSend(); err != nil {
+		return err
+	}
+
+	for {
+		info := new(pactus.GetBlockchainInfoResponse)
+		if err := stream.RecvMsg(info); err != nil {
+			if errors.Is(err, io.EOF) || ctx.Err() != nil {
+				return nil
+			}
+
+			return err
+		}
+		onInfo(info)
+	}
+}
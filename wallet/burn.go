@@ -0,0 +1,101 @@
+package wallet
+
+import (
+	"bytes"
+
+	"github.com/pactus-project/pactus/crypto"
+	"github.com/pactus-project/pactus/util/i18n"
+)
+
+const (
+	// lookalikeMaxDistance is the maximum number of the different characters
+	// that an address is considered as a mistyped address.
+	lookalikeMaxDistance = 4
+
+	// lookalikeAffixLen is the length of the prefix and the suffix of the address data
+	// that a spoofed address mimics, so it is mistaken for the original address.
+	lookalikeAffixLen = 5
+)
+
+// knownBurnAddresses are the addresses that no one can spend their funds.
+var knownBurnAddresses = map[crypto.Address]string{
+	crypto.TreasuryAddress: "the treasury address",
+}
+
+// CheckReceiver checks the receiver of a transfer or a withdraw transaction for the mistakes
+// that can't be undone, like sending the funds to a burn address, or to an address that
+// looks like an address of the wallet, but it is not.
+// It doesn't need the wallet to be online and returns an IrreversibleSendError
+// if the receiver is suspicious.
+func (w *Wallet) CheckReceiver(receiver string) error {
+	addr, err := crypto.AddressFromString(receiver)
+	if err != nil {
+		return err
+	}
+
+	if reason := burnReason(addr); reason != "" {
+		return IrreversibleSendError{
+			Receiver: receiver,
+			Reason:   i18n.Sprintf("%s is a burn address, the funds can't be spent by anyone", reason),
+		}
+	}
+
+	if w.Contains(receiver) {
+		return nil
+	}
+
+	for _, info := range w.AddressInfos() {
+		if isLookalikeAddress(receiver, info.Address) {
+			return IrreversibleSendError{
+				Receiver: receiver,
+				Reason: i18n.Sprintf("it looks like the wallet address %s, but it is not the same address",
+					info.Address),
+			}
+		}
+	}
+
+	return nil
+}
+
+// burnReason returns the reason that the address is a burn address,
+// or an empty string if it is not.
+func burnReason(addr crypto.Address) string {
+	if reason, ok := knownBurnAddresses[addr]; ok {
+		return reason
+	}
+
+	// An address with a repeated byte, like all zeros, is not derived from a public key.
+	data := addr.Bytes()[1:]
+	if bytes.Count(data, data[:1]) == len(data) {
+		return i18n.Sprintf("the address with the repeated byte 0x%02x", data[0])
+	}
+
+	return ""
+}
+
+// isLookalikeAddress checks whether the address is close enough to the known address
+// to be a mistyped address, or it has the same prefix and suffix to be a spoofed address.
+func isLookalikeAddress(addr, known string) bool {
+	if len(addr) != len(known) || addr == known {
+		return false
+	}
+
+	distance := 0
+	for i := 0; i < len(addr); i++ {
+		if addr[i] != known[i] {
+			distance++
+		}
+	}
+	if distance <= lookalikeMaxDistance {
+		return true
+	}
+
+	// The human-readable part and the address type are the same for the same kind of addresses.
+	dataStart := len(crypto.AddressHRP) + 2
+	if len(addr) < dataStart+2*lookalikeAffixLen {
+		return false
+	}
+
+	return addr[dataStart:dataStart+lookalikeAffixLen] == known[dataStart:dataStart+lookalikeAffixLen] &&
+		addr[len(addr)-lookalikeAffixLen:] == known[len(known)-lookalikeAffixLen:]
+}
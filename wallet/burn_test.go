@@ -0,0 +1,69 @@
+package wallet
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/pactus-project/pactus/crypto"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckReceiver(t *testing.T) {
+	td := setup(t)
+
+	own, _ := td.wallet.NewBLSAccountAddress("account")
+
+	t.Run("Valid receiver", func(t *testing.T) {
+		assert.NoError(t, td.wallet.CheckReceiver(td.RandAccAddress().String()))
+		assert.NoError(t, td.wallet.CheckReceiver(own))
+	})
+
+	t.Run("Invalid receiver", func(t *testing.T) {
+		err := td.wallet.CheckReceiver("invalid_address")
+		assert.Error(t, err)
+		assert.False(t, errors.As(err, &IrreversibleSendError{}))
+	})
+
+	t.Run("Treasury address", func(t *testing.T) {
+		err := td.wallet.CheckReceiver(crypto.TreasuryAddress.String())
+		assert.ErrorAs(t, err, &IrreversibleSendError{})
+		assert.Contains(t, err.Error(), "treasury")
+	})
+
+	t.Run("Repeated byte address", func(t *testing.T) {
+		zeros := crypto.NewAddress(crypto.AddressTypeBLSAccount, make([]byte, 20))
+		err := td.wallet.CheckReceiver(zeros.String())
+		assert.ErrorAs(t, err, &IrreversibleSendError{})
+
+		ones := crypto.NewAddress(crypto.AddressTypeValidator, []byte(strings.Repeat("\xff", 20)))
+		err = td.wallet.CheckReceiver(ones.String())
+		assert.ErrorAs(t, err, &IrreversibleSendError{})
+	})
+}
+
+func TestIsLookalikeAddress(t *testing.T) {
+	known := "pc1zmpnme0xrgzhml77e8mjvdg82nzdxxqqsvzqkcm"
+
+	spoofed := func(prefixLen, suffixLen int) string {
+		return known[:prefixLen] + strings.Repeat("a", len(known)-prefixLen-suffixLen) + known[len(known)-suffixLen:]
+	}
+
+	tests := []struct {
+		addr      string
+		lookalike bool
+	}{
+		{known, false},
+		{known[:len(known)-1], false},
+		{known[:len(known)-1] + "n", true},
+		{known[:20] + "aaa" + known[23:], true},
+		{spoofed(9, 5), true},
+		{spoofed(8, 5), false},
+		{spoofed(9, 4), false},
+		{"pc1p9y5gmu9l002tt60wak9extgvwm69rq3a9ackrl", false},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.lookalike, isLookalikeAddress(tt.addr, known), tt.addr)
+	}
+}
@@ -2,14 +2,16 @@ package wallet
 
 import (
 	"context"
+	"errors"
 
 	"github.com/pactus-project/pactus/crypto"
 	"github.com/pactus-project/pactus/crypto/hash"
+	"github.com/pactus-project/pactus/types/block"
 	"github.com/pactus-project/pactus/types/tx"
 	"github.com/pactus-project/pactus/types/tx/payload"
 	pactus "github.com/pactus-project/pactus/www/grpc/gen/go"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/credentials"
 )
 
 const (
@@ -18,25 +20,75 @@ const (
 )
 
 type grpcClient struct {
+	conn              *failoverConn
 	blockchainClient  pactus.BlockchainClient
 	transactionClient pactus.TransactionClient
 }
 
-func newGRPCClient(rpcEndpoint string) (*grpcClient, error) {
-	conn, err := grpc.Dial(rpcEndpoint,
-		grpc.WithTransportCredentials(insecure.NewCredentials()))
-	if err != nil {
-		return nil, err
+// newGRPCClient connects to the nodes at the given endpoints.
+// The nodes that don't respond are skipped, and the rest should be on the same chain.
+// If more than one node responds, the calls fail over to the other nodes when a node goes down.
+func newGRPCClient(rpcEndpoints []string, creds credentials.TransportCredentials,
+	opts ...grpc.DialOption,
+) (*grpcClient, error) {
+	addrs := make([]string, 0, len(rpcEndpoints))
+	conns := make([]grpc.ClientConnInterface, 0, len(rpcEndpoints))
+	closers := make([]*grpc.ClientConn, 0, len(rpcEndpoints))
+
+	lastErr := errors.New("no node address is given")
+	for _, endpoint := range rpcEndpoints {
+		conn, err := grpc.Dial(endpoint,
+			append([]grpc.DialOption{grpc.WithTransportCredentials(creds)}, opts...)...)
+		if err != nil {
+			lastErr = err
+
+			continue
+		}
+
+		// Check if the node is responding
+		ctx, cancel := context.WithTimeout(context.Background(), FailoverTimeout)
+		_, err = pactus.NewBlockchainClient(conn).GetBlockchainInfo(ctx, &pactus.GetBlockchainInfoRequest{})
+		cancel()
+		if err != nil {
+			_ = conn.Close()
+			lastErr = err
+
+			continue
+		}
+
+		addrs = append(addrs, endpoint)
+		conns = append(conns, conn)
+		closers = append(closers, conn)
+	}
+
+	if len(conns) == 0 {
+		return nil, lastErr
+	}
+
+	if len(conns) > 1 {
+		ctx, cancel := context.WithTimeout(context.Background(), FailoverTimeout)
+		defer cancel()
+
+		if err := checkChainHeads(ctx, addrs, conns); err != nil {
+			for _, conn := range closers {
+				_ = conn.Close()
+			}
+
+			return nil, err
+		}
 	}
 
+	conn := newFailoverConn(addrs, conns, FailoverTimeout)
+
 	return &grpcClient{
+		conn:              conn,
 		blockchainClient:  pactus.NewBlockchainClient(conn),
 		transactionClient: pactus.NewTransactionClient(conn),
 	}, nil
 }
 
-func (c *grpcClient) getBlockchainInfo() (*pactus.GetBlockchainInfoResponse, error) {
-	info, err := c.blockchainClient.GetBlockchainInfo(context.Background(),
+func (c *grpcClient) getBlockchainInfo(ctx context.Context) (*pactus.GetBlockchainInfoResponse, error) {
+	info, err := c.blockchainClient.GetBlockchainInfo(ctx,
 		&pactus.GetBlockchainInfoRequest{})
 	if err != nil {
 		return nil, err
@@ -45,8 +97,8 @@ func (c *grpcClient) getBlockchainInfo() (*pactus.GetBlockchainInfoResponse, err
 	return info, nil
 }
 
-func (c *grpcClient) getAccount(addr crypto.Address) (*pactus.AccountInfo, error) {
-	res, err := c.blockchainClient.GetAccount(context.Background(),
+func (c *grpcClient) getAccount(ctx context.Context, addr crypto.Address) (*pactus.AccountInfo, error) {
+	res, err := c.blockchainClient.GetAccount(ctx,
 		&pactus.GetAccountRequest{Address: addr.String()})
 	if err != nil {
 		return nil, err
@@ -55,8 +107,18 @@ func (c *grpcClient) getAccount(addr crypto.Address) (*pactus.AccountInfo, error
 	return res.Account, nil
 }
 
-func (c *grpcClient) getValidator(addr crypto.Address) (*pactus.ValidatorInfo, error) {
-	res, err := c.blockchainClient.GetValidator(context.Background(),
+func (c *grpcClient) getAccounts(ctx context.Context, addrs []string) ([]*pactus.AccountResult, error) {
+	res, err := c.blockchainClient.GetAccounts(ctx,
+		&pactus.GetAccountsRequest{Addresses: addrs})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.Results, nil
+}
+
+func (c *grpcClient) getValidator(ctx context.Context, addr crypto.Address) (*pactus.ValidatorInfo, error) {
+	res, err := c.blockchainClient.GetValidator(ctx,
 		&pactus.GetValidatorRequest{Address: addr.String()})
 	if err != nil {
 		return nil, err
@@ -65,12 +127,22 @@ func (c *grpcClient) getValidator(addr crypto.Address) (*pactus.ValidatorInfo, e
 	return res.Validator, nil
 }
 
-func (c *grpcClient) sendTx(trx *tx.Tx) (tx.ID, error) {
+func (c *grpcClient) getPublicKey(ctx context.Context, addr crypto.Address) (string, error) {
+	res, err := c.blockchainClient.GetPublicKey(ctx,
+		&pactus.GetPublicKeyRequest{Address: addr.String()})
+	if err != nil {
+		return "", err
+	}
+
+	return res.PublicKey, nil
+}
+
+func (c *grpcClient) sendTx(ctx context.Context, trx *tx.Tx) (tx.ID, error) {
 	data, err := trx.Bytes()
 	if err != nil {
 		return hash.UndefHash, err
 	}
-	res, err := c.transactionClient.BroadcastTransaction(context.Background(), &pactus.BroadcastTransactionRequest{
+	res, err := c.transactionClient.BroadcastTransaction(ctx, &pactus.BroadcastTransactionRequest{
 		SignedRawTransaction: data,
 	})
 	if err != nil {
@@ -81,8 +153,8 @@ func (c *grpcClient) sendTx(trx *tx.Tx) (tx.ID, error) {
 }
 
 // TODO: check the return value type.
-func (c *grpcClient) getTransaction(id tx.ID) (*pactus.GetTransactionResponse, error) {
-	res, err := c.transactionClient.GetTransaction(context.Background(), &pactus.GetTransactionRequest{
+func (c *grpcClient) getTransaction(ctx context.Context, id tx.ID) (*pactus.GetTransactionResponse, error) {
+	res, err := c.transactionClient.GetTransaction(ctx, &pactus.GetTransactionRequest{
 		Id:        id.Bytes(),
 		Verbosity: pactus.TransactionVerbosity_TRANSACTION_INFO,
 	})
@@ -93,8 +165,8 @@ func (c *grpcClient) getTransaction(id tx.ID) (*pactus.GetTransactionResponse, e
 	return res, nil
 }
 
-func (c *grpcClient) getFee(amount int64, payloadType payload.Type) (int64, error) {
-	res, err := c.transactionClient.CalculateFee(context.Background(), &pactus.CalculateFeeRequest{
+func (c *grpcClient) getFee(ctx context.Context, amount int64, payloadType payload.Type) (int64, error) {
+	res, err := c.transactionClient.CalculateFee(ctx, &pactus.CalculateFeeRequest{
 		Amount: amount, PayloadType: pactus.PayloadType(payloadType),
 	})
 	if err != nil {
@@ -103,3 +175,49 @@ func (c *grpcClient) getFee(amount int64, payloadType payload.Type) (int64, erro
 
 	return res.Fee, nil
 }
+
+func (c *grpcClient) resolveName(ctx context.Context, name string) (crypto.Address, error) {
+	res, err := c.transactionClient.ResolveName(ctx, &pactus.ResolveNameRequest{Name: name})
+	if err != nil {
+		return crypto.Address{}, err
+	}
+
+	return crypto.AddressFromString(res.Address)
+}
+
+func (c *grpcClient) scanBlocks(ctx context.Context, addrs []string, from, to uint32) ([]uint32, error) {
+	res, err := c.blockchainClient.ScanBlocks(ctx, &pactus.ScanBlocksRequest{
+		Addresses:  addrs,
+		FromHeight: from,
+		ToHeight:   to,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.Heights, nil
+}
+
+func (c *grpcClient) getBlock(ctx context.Context, height uint32) (*block.Block, error) {
+	res, err := c.blockchainClient.GetBlock(ctx, &pactus.GetBlockRequest{
+		Height:    height,
+		Verbosity: pactus.BlockVerbosity_BLOCK_DATA,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return block.FromBytes(res.Data)
+}
+
+func (c *grpcClient) getTxPoolChanges(ctx context.Context, since uint64) (*pactus.GetTxPoolChangesResponse, error) {
+	res, err := c.transactionClient.GetTxPoolChanges(ctx, &pactus.GetTxPoolChangesRequest{
+		SinceSequence: since,
+		Verbosity:     pactus.TransactionVerbosity_TRANSACTION_DATA,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
@@ -16,6 +16,14 @@ var (
 	tBlockchainInfoResponse *pactus.GetBlockchainInfoResponse
 	tAccountResponse        *pactus.GetAccountResponse
 	tValidatorResponse      *pactus.GetValidatorResponse
+	tPublicKeyResponse      *pactus.GetPublicKeyResponse
+	tAccountsResponse       *pactus.GetAccountsResponse
+	tScanBlocksResponse     *pactus.ScanBlocksResponse
+	tBlockResponses         map[uint32]*pactus.GetBlockResponse
+	tTxPoolChangesResponse  *pactus.GetTxPoolChangesResponse
+	tNames                  map[string]string
+	tFeeFraction            float64
+	tMinimumFee             int64
 )
 
 func (s *blockchainServer) GetBlockchainInfo(_ context.Context,
@@ -42,9 +50,25 @@ func (s *blockchainServer) GetBlockHeight(_ context.Context,
 	return &pactus.GetBlockHeightResponse{}, nil
 }
 
+func (s *blockchainServer) GetBlockHeaders(_ context.Context,
+	_ *pactus.GetBlockHeadersRequest,
+) (*pactus.GetBlockHeadersResponse, error) {
+	return &pactus.GetBlockHeadersResponse{}, nil
+}
+
+func (s *blockchainServer) GetBeacon(_ context.Context,
+	_ *pactus.GetBeaconRequest,
+) (*pactus.GetBeaconResponse, error) {
+	return &pactus.GetBeaconResponse{}, nil
+}
+
 func (s *blockchainServer) GetBlock(_ context.Context,
-	_ *pactus.GetBlockRequest,
+	req *pactus.GetBlockRequest,
 ) (*pactus.GetBlockResponse, error) {
+	if res, ok := tBlockResponses[req.Height]; ok {
+		return res, nil
+	}
+
 	return &pactus.GetBlockResponse{}, nil
 }
 
@@ -58,6 +82,22 @@ func (s *blockchainServer) GetAccount(_ context.Context,
 	return nil, fmt.Errorf("unknown request")
 }
 
+func (s *blockchainServer) GetAccounts(_ context.Context,
+	_ *pactus.GetAccountsRequest,
+) (*pactus.GetAccountsResponse, error) {
+	if tAccountsResponse != nil {
+		return tAccountsResponse, nil
+	}
+
+	return &pactus.GetAccountsResponse{}, nil
+}
+
+func (s *blockchainServer) GetValidators(_ context.Context,
+	_ *pactus.GetValidatorsRequest,
+) (*pactus.GetValidatorsResponse, error) {
+	return &pactus.GetValidatorsResponse{}, nil
+}
+
 func (s *blockchainServer) GetValidatorAddresses(_ context.Context,
 	_ *pactus.GetValidatorAddressesRequest,
 ) (*pactus.GetValidatorAddressesResponse, error) {
@@ -83,7 +123,94 @@ func (s *blockchainServer) GetValidator(_ context.Context,
 func (s *blockchainServer) GetPublicKey(_ context.Context,
 	_ *pactus.GetPublicKeyRequest,
 ) (*pactus.GetPublicKeyResponse, error) {
-	return &pactus.GetPublicKeyResponse{}, nil
+	if tPublicKeyResponse != nil {
+		return tPublicKeyResponse, nil
+	}
+
+	return nil, fmt.Errorf("unknown request")
+}
+
+func (s *blockchainServer) GetChainStatistics(_ context.Context,
+	_ *pactus.GetChainStatisticsRequest,
+) (*pactus.GetChainStatisticsResponse, error) {
+	return &pactus.GetChainStatisticsResponse{}, nil
+}
+
+func (s *blockchainServer) GetFeePercentiles(_ context.Context,
+	_ *pactus.GetFeePercentilesRequest,
+) (*pactus.GetFeePercentilesResponse, error) {
+	return &pactus.GetFeePercentilesResponse{}, nil
+}
+
+func (s *blockchainServer) GetBlockBloom(_ context.Context,
+	_ *pactus.GetBlockBloomRequest,
+) (*pactus.GetBlockBloomResponse, error) {
+	return &pactus.GetBlockBloomResponse{}, nil
+}
+
+func (s *blockchainServer) ScanBlocks(_ context.Context,
+	req *pactus.ScanBlocksRequest,
+) (*pactus.ScanBlocksResponse, error) {
+	if tScanBlocksResponse != nil {
+		heights := make([]uint32, 0)
+		for _, height := range tScanBlocksResponse.Heights {
+			if height >= req.FromHeight && height <= req.ToHeight {
+				heights = append(heights, height)
+			}
+		}
+
+		return &pactus.ScanBlocksResponse{Heights: heights}, nil
+	}
+
+	return &pactus.ScanBlocksResponse{}, nil
+}
+
+func (s *blockchainServer) GetBlockReward(_ context.Context,
+	_ *pactus.GetBlockRewardRequest,
+) (*pactus.GetBlockRewardResponse, error) {
+	return &pactus.GetBlockRewardResponse{}, nil
+}
+
+func (s *blockchainServer) GetCommittee(_ context.Context,
+	_ *pactus.GetCommitteeRequest,
+) (*pactus.GetCommitteeResponse, error) {
+	return &pactus.GetCommitteeResponse{}, nil
+}
+
+func (s *blockchainServer) VerifyParticipationReport(_ context.Context,
+	_ *pactus.VerifyParticipationReportRequest,
+) (*pactus.VerifyParticipationReportResponse, error) {
+	return &pactus.VerifyParticipationReportResponse{}, nil
+}
+
+func (s *blockchainServer) WatchAddress(_ context.Context,
+	_ *pactus.WatchAddressRequest,
+) (*pactus.WatchAddressResponse, error) {
+	return &pactus.WatchAddressResponse{}, nil
+}
+
+func (s *blockchainServer) UnwatchAddress(_ context.Context,
+	_ *pactus.UnwatchAddressRequest,
+) (*pactus.UnwatchAddressResponse, error) {
+	return &pactus.UnwatchAddressResponse{}, nil
+}
+
+func (s *blockchainServer) GetWatchedAddresses(_ context.Context,
+	_ *pactus.GetWatchedAddressesRequest,
+) (*pactus.GetWatchedAddressesResponse, error) {
+	return &pactus.GetWatchedAddressesResponse{}, nil
+}
+
+func (s *blockchainServer) GetStorageInfo(_ context.Context,
+	_ *pactus.GetStorageInfoRequest,
+) (*pactus.GetStorageInfoResponse, error) {
+	return &pactus.GetStorageInfoResponse{}, nil
+}
+
+func (s *blockchainServer) GenerateBlocks(_ context.Context,
+	_ *pactus.GenerateBlocksRequest,
+) (*pactus.GenerateBlocksResponse, error) {
+	return &pactus.GenerateBlocksResponse{}, nil
 }
 
 func (s *transactionServer) GetTransaction(_ context.Context,
@@ -92,10 +219,18 @@ func (s *transactionServer) GetTransaction(_ context.Context,
 	return &pactus.GetTransactionResponse{}, nil
 }
 
+func (s *transactionServer) GetTransactionReceipt(_ context.Context,
+	_ *pactus.GetTransactionReceiptRequest,
+) (*pactus.GetTransactionReceiptResponse, error) {
+	return &pactus.GetTransactionReceiptResponse{}, nil
+}
+
 func (s *transactionServer) CalculateFee(_ context.Context,
-	_ *pactus.CalculateFeeRequest,
+	req *pactus.CalculateFeeRequest,
 ) (*pactus.CalculateFeeResponse, error) {
-	return &pactus.CalculateFeeResponse{Fee: 0}, nil
+	fee := max(int64(float64(req.Amount)*tFeeFraction), tMinimumFee)
+
+	return &pactus.CalculateFeeResponse{Fee: fee}, nil
 }
 
 func (s *transactionServer) BroadcastTransaction(_ context.Context,
@@ -139,3 +274,48 @@ func (s *transactionServer) GetRawWithdrawTransaction(_ context.Context,
 		RawTransaction: make([]byte, 0),
 	}, nil
 }
+
+func (s *transactionServer) GetTxPoolSnapshot(_ context.Context,
+	_ *pactus.GetTxPoolSnapshotRequest,
+) (*pactus.GetTxPoolSnapshotResponse, error) {
+	return &pactus.GetTxPoolSnapshotResponse{}, nil
+}
+
+func (s *transactionServer) GetTxPoolChanges(_ context.Context,
+	_ *pactus.GetTxPoolChangesRequest,
+) (*pactus.GetTxPoolChangesResponse, error) {
+	if tTxPoolChangesResponse != nil {
+		return tTxPoolChangesResponse, nil
+	}
+
+	return &pactus.GetTxPoolChangesResponse{}, nil
+}
+
+func (s *transactionServer) GetTransactionTimeline(_ context.Context,
+	_ *pactus.GetTransactionTimelineRequest,
+) (*pactus.GetTransactionTimelineResponse, error) {
+	return &pactus.GetTransactionTimelineResponse{}, nil
+}
+
+func (s *transactionServer) EncodePaymentURI(_ context.Context,
+	_ *pactus.EncodePaymentURIRequest,
+) (*pactus.EncodePaymentURIResponse, error) {
+	return &pactus.EncodePaymentURIResponse{}, nil
+}
+
+func (s *transactionServer) DecodePaymentURI(_ context.Context,
+	_ *pactus.DecodePaymentURIRequest,
+) (*pactus.DecodePaymentURIResponse, error) {
+	return &pactus.DecodePaymentURIResponse{}, nil
+}
+
+func (s *transactionServer) ResolveName(_ context.Context,
+	req *pactus.ResolveNameRequest,
+) (*pactus.ResolveNameResponse, error) {
+	addr, ok := tNames[req.Name]
+	if !ok {
+		return nil, fmt.Errorf("name is not registered: %s", req.Name)
+	}
+
+	return &pactus.ResolveNameResponse{Name: req.Name, Address: addr}, nil
+}
@@ -9,6 +9,7 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"strconv"
 	"strings"
 
 	"github.com/pactus-project/pactus/util"
@@ -109,6 +110,31 @@ func (e *Encrypter) IsEncrypted() bool {
 	return e.Method != nameFuncNope
 }
 
+// IsOutdated checks if the encrypter is weaker than the default encrypter.
+// An outdated encrypter can be upgraded by re-encrypting the message with the default encrypter.
+func (e *Encrypter) IsOutdated() bool {
+	if !e.IsEncrypted() {
+		return false
+	}
+
+	defaultEnc := DefaultEncrypter()
+	if e.Method != defaultEnc.Method {
+		return true
+	}
+
+	for _, name := range []string{nameParamIterations, nameParamMemory, nameParamParallelism} {
+		val, err := strconv.ParseUint(e.Params[name], 10, 64)
+		if err != nil {
+			return true
+		}
+		if val < defaultEnc.Params.GetUint64(name) {
+			return true
+		}
+	}
+
+	return false
+}
+
 // Encrypt encrypts the `message` using give `password` and returns the cipher message.
 func (e *Encrypter) Encrypt(message, password string) (string, error) {
 	if e.Method == nameFuncNope {
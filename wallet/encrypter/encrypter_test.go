@@ -65,3 +65,28 @@ func TestEncrypter(t *testing.T) {
 	_, err = e.Encrypt(enc, "")
 	assert.ErrorIs(t, err, ErrInvalidPassword)
 }
+
+func TestOutdatedEncrypter(t *testing.T) {
+	e1 := NopeEncrypter()
+	assert.False(t, e1.IsOutdated())
+
+	e2 := DefaultEncrypter()
+	assert.False(t, e2.IsOutdated())
+
+	e3 := DefaultEncrypter(OptionIteration(1))
+	assert.True(t, e3.IsOutdated())
+
+	e4 := DefaultEncrypter(OptionMemory(1024))
+	assert.True(t, e4.IsOutdated())
+
+	e5 := DefaultEncrypter(OptionParallelism(8))
+	assert.False(t, e5.IsOutdated())
+
+	e6 := DefaultEncrypter()
+	e6.Params["memory"] = "invalid"
+	assert.True(t, e6.IsOutdated())
+
+	e7 := DefaultEncrypter()
+	e7.Method = "ARGON2ID-AES_256_CTR-MACV0"
+	assert.True(t, e7.IsOutdated())
+}
@@ -15,6 +15,10 @@ var (
 	// ErrHistoryExists describes an error in which the transaction already exists
 	// in history.
 	ErrHistoryExists = errors.New("transaction already exists")
+
+	// ErrNotValidatorAddress describes an error in which the address is not
+	// a validator address.
+	ErrNotValidatorAddress = errors.New("not a validator address")
 )
 
 // CRCNotMatchError describes an error in which the wallet CRC is not macthed.
@@ -36,3 +40,67 @@ type ExitsError struct {
 func (e ExitsError) Error() string {
 	return fmt.Sprintf("a wallet exists at: %s", e.Path)
 }
+
+// MigrationRequiredError describes an error in which the wallet is created
+// by an older version and should be migrated.
+type MigrationRequiredError struct {
+	Version int
+}
+
+func (e MigrationRequiredError) Error() string {
+	return fmt.Sprintf("wallet version %d is outdated, migrate it using `pactus-wallet migrate`", e.Version)
+}
+
+// UnsupportedVersionError describes an error in which the wallet is created
+// by a newer version that is not supported.
+type UnsupportedVersionError struct {
+	Version int
+}
+
+func (e UnsupportedVersionError) Error() string {
+	return fmt.Sprintf("wallet version %d is not supported", e.Version)
+}
+
+// BlockedByPolicyError describes an error in which the transaction has warnings,
+// and the warning policy doesn't allow signing it.
+type BlockedByPolicyError struct {
+	Warnings []TxWarning
+}
+
+func (e BlockedByPolicyError) Error() string {
+	return fmt.Sprintf("transaction is blocked by the warning policy: %d warning(s)", len(e.Warnings))
+}
+
+// InvalidStakeTxError describes an error in which a bond or an unbond transaction
+// is mis-constructed and is going to be rejected, or it locks the stake.
+type InvalidStakeTxError struct {
+	Reason string
+}
+
+func (e InvalidStakeTxError) Error() string {
+	return fmt.Sprintf("invalid stake transaction: %s", e.Reason)
+}
+
+// IrreversibleSendError describes an error in which the receiver of the funds is a burn address,
+// or it looks like a mistyped or a spoofed address, so the funds are likely lost.
+type IrreversibleSendError struct {
+	Receiver string
+	Reason   string
+}
+
+func (e IrreversibleSendError) Error() string {
+	return fmt.Sprintf("sending to %s is not reversible: %s", e.Receiver, e.Reason)
+}
+
+// InconsistentNodesError describes an error in which the nodes that the wallet is connected to
+// have different blocks at the same height, so they are not on the same chain.
+type InconsistentNodesError struct {
+	Address string
+	Other   string
+	Height  uint32
+}
+
+func (e InconsistentNodesError) Error() string {
+	return fmt.Sprintf("node %s is not on the same chain as node %s at height %d",
+		e.Address, e.Other, e.Height)
+}
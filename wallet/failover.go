@@ -0,0 +1,158 @@
+package wallet
+
+import (
+	"bytes"
+	"context"
+	"sync"
+	"time"
+
+	pactus "github.com/pactus-project/pactus/www/grpc/gen/go"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// FailoverTimeout is the timeout of each call, when the wallet is connected to multiple nodes.
+// If a node doesn't respond in time, the call is retried on the next node.
+var FailoverTimeout = 10 * time.Second
+
+// failoverConn is a connection to multiple nodes.
+// The calls are sent to the active node. If the node is unavailable or doesn't respond in time,
+// the call is retried on the next nodes, and the first node that responds becomes the active one.
+type failoverConn struct {
+	lk      sync.Mutex
+	addrs   []string
+	conns   []grpc.ClientConnInterface
+	active  int
+	timeout time.Duration
+}
+
+func newFailoverConn(addrs []string, conns []grpc.ClientConnInterface, timeout time.Duration) *failoverConn {
+	return &failoverConn{
+		addrs:   addrs,
+		conns:   conns,
+		timeout: timeout,
+	}
+}
+
+func (c *failoverConn) Invoke(ctx context.Context, method string, args, reply any, opts ...grpc.CallOption) error {
+	start := c.activeIndex()
+
+	var err error
+	for i := 0; i < len(c.conns); i++ {
+		index := (start + i) % len(c.conns)
+		err = c.invoke(ctx, index, method, args, reply, opts...)
+		if !shouldFailover(ctx, err) {
+			if err == nil {
+				c.setActive(index)
+			}
+
+			return err
+		}
+	}
+
+	return err
+}
+
+func (c *failoverConn) invoke(ctx context.Context, index int, method string,
+	args, reply any, opts ...grpc.CallOption,
+) error {
+	// A single node has nowhere to fail over, so its calls are not limited.
+	if len(c.conns) > 1 && c.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.timeout)
+		defer cancel()
+	}
+
+	return c.conns[index].Invoke(ctx, method, args, reply, opts...)
+}
+
+// NewStream opens the stream on the active node.
+// The streams are long-lived, so they are not moved to the other nodes.
+func (c *failoverConn) NewStream(ctx context.Context, desc *grpc.StreamDesc, method string,
+	opts ...grpc.CallOption,
+) (grpc.ClientStream, error) {
+	return c.conns[c.activeIndex()].NewStream(ctx, desc, method, opts...)
+}
+
+// ActiveAddress returns the address of the node that has responded to the last call.
+func (c *failoverConn) ActiveAddress() string {
+	return c.addrs[c.activeIndex()]
+}
+
+func (c *failoverConn) activeIndex() int {
+	c.lk.Lock()
+	defer c.lk.Unlock()
+
+	return c.active
+}
+
+func (c *failoverConn) setActive(index int) {
+	c.lk.Lock()
+	defer c.lk.Unlock()
+
+	c.active = index
+}
+
+// shouldFailover checks whether the call should be retried on the next node.
+// The calls that are canceled by the caller, or rejected by the node, are not retried.
+func shouldFailover(ctx context.Context, err error) bool {
+	if err == nil || ctx.Err() != nil {
+		return false
+	}
+
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded:
+		return true
+	default:
+		return false
+	}
+}
+
+// checkChainHeads checks that the nodes are on the same chain.
+// The nodes may be at different heights, so the hashes of the blocks
+// at the lowest height of the nodes are compared.
+func checkChainHeads(ctx context.Context, addrs []string, conns []grpc.ClientConnInterface) error {
+	infos := make([]*pactus.GetBlockchainInfoResponse, len(conns))
+	commonHeight := uint32(0)
+	for i, conn := range conns {
+		info, err := pactus.NewBlockchainClient(conn).GetBlockchainInfo(ctx, &pactus.GetBlockchainInfoRequest{})
+		if err != nil {
+			return err
+		}
+		infos[i] = info
+
+		if i == 0 || info.LastBlockHeight < commonHeight {
+			commonHeight = info.LastBlockHeight
+		}
+	}
+
+	var commonHash []byte
+	for i, conn := range conns {
+		blockHash := infos[i].LastBlockHash
+		if infos[i].LastBlockHeight != commonHeight {
+			res, err := pactus.NewBlockchainClient(conn).GetBlockHash(ctx,
+				&pactus.GetBlockHashRequest{Height: commonHeight})
+			if err != nil {
+				return err
+			}
+			blockHash = res.Hash
+		}
+
+		if i == 0 {
+			commonHash = blockHash
+
+			continue
+		}
+
+		if !bytes.Equal(commonHash, blockHash) {
+			return InconsistentNodesError{
+				Address: addrs[i],
+				Other:   addrs[0],
+				Height:  commonHeight,
+			}
+		}
+	}
+
+	return nil
+}
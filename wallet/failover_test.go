@@ -0,0 +1,128 @@
+package wallet
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	pactus "github.com/pactus-project/pactus/www/grpc/gen/go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// fakeConn responds to the calls by the given responses, or fails with the given error.
+type fakeConn struct {
+	err       error
+	delay     time.Duration
+	responses map[string]proto.Message
+	calls     int
+}
+
+func (c *fakeConn) Invoke(ctx context.Context, method string, _, reply any, _ ...grpc.CallOption) error {
+	c.calls++
+	if c.delay > 0 {
+		select {
+		case <-time.After(c.delay):
+		case <-ctx.Done():
+			return status.FromContextError(ctx.Err()).Err()
+		}
+	}
+	if c.err != nil {
+		return c.err
+	}
+	if res, ok := c.responses[method]; ok {
+		proto.Merge(reply.(proto.Message), res)
+	}
+
+	return nil
+}
+
+func (*fakeConn) NewStream(context.Context, *grpc.StreamDesc, string, ...grpc.CallOption,
+) (grpc.ClientStream, error) {
+	return nil, status.Error(codes.Unimplemented, "no streams")
+}
+
+// chainConn responds as a node at the given height.
+// If blockHash is set, it is the hash of any block that is requested by height.
+func chainConn(height uint32, lastHash, blockHash []byte) *fakeConn {
+	conn := &fakeConn{
+		responses: map[string]proto.Message{
+			"/pactus.Blockchain/GetBlockchainInfo": &pactus.GetBlockchainInfoResponse{
+				LastBlockHeight: height,
+				LastBlockHash:   lastHash,
+			},
+		},
+	}
+	if blockHash != nil {
+		conn.responses["/pactus.Blockchain/GetBlockHash"] = &pactus.GetBlockHashResponse{Hash: blockHash}
+	}
+
+	return conn
+}
+
+func TestFailover(t *testing.T) {
+	down := &fakeConn{err: status.Error(codes.Unavailable, "connection refused")}
+	slow := &fakeConn{delay: time.Second}
+	healthy := chainConn(10, []byte{1}, nil)
+
+	conn := newFailoverConn([]string{"down", "slow", "healthy"},
+		[]grpc.ClientConnInterface{down, slow, healthy}, 50*time.Millisecond)
+	client := pactus.NewBlockchainClient(conn)
+
+	t.Run("Should fail over to the healthy node", func(t *testing.T) {
+		res, err := client.GetBlockchainInfo(context.Background(), &pactus.GetBlockchainInfoRequest{})
+		require.NoError(t, err)
+		assert.Equal(t, uint32(10), res.LastBlockHeight)
+		assert.Equal(t, "healthy", conn.ActiveAddress())
+	})
+
+	t.Run("Should keep using the active node", func(t *testing.T) {
+		calls := down.calls
+		_, err := client.GetBlockchainInfo(context.Background(), &pactus.GetBlockchainInfoRequest{})
+		require.NoError(t, err)
+		assert.Equal(t, calls, down.calls)
+	})
+
+	t.Run("Should not fail over on the errors of the node", func(t *testing.T) {
+		healthy.err = status.Error(codes.InvalidArgument, "invalid request")
+		calls := down.calls
+		_, err := client.GetBlockchainInfo(context.Background(), &pactus.GetBlockchainInfoRequest{})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Equal(t, calls, down.calls)
+	})
+
+	t.Run("Should fail when all the nodes are down", func(t *testing.T) {
+		healthy.err = status.Error(codes.Unavailable, "connection refused")
+		_, err := client.GetBlockchainInfo(context.Background(), &pactus.GetBlockchainInfoRequest{})
+		// The error of the last node that is tried, which is the slow one.
+		assert.Equal(t, codes.DeadlineExceeded, status.Code(err))
+	})
+}
+
+func TestCheckChainHeads(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Same chain, different heights", func(t *testing.T) {
+		ahead := chainConn(12, []byte{12}, []byte{10})
+		behind := chainConn(10, []byte{10}, nil)
+
+		err := checkChainHeads(ctx, []string{"ahead", "behind"}, []grpc.ClientConnInterface{ahead, behind})
+		assert.NoError(t, err)
+	})
+
+	t.Run("Different chains", func(t *testing.T) {
+		first := chainConn(10, []byte{10}, nil)
+		forked := chainConn(11, []byte{11}, []byte{0xff})
+
+		err := checkChainHeads(ctx, []string{"first", "forked"}, []grpc.ClientConnInterface{first, forked})
+		assert.ErrorIs(t, err, InconsistentNodesError{
+			Address: "forked",
+			Other:   "first",
+			Height:  10,
+		})
+	})
+}
@@ -0,0 +1,170 @@
+package wallet
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pactus-project/pactus/genesis"
+	"github.com/pactus-project/pactus/util"
+	"github.com/pactus-project/pactus/wallet/vault"
+)
+
+// MigrateOptions contains the options for migrating a wallet.
+type MigrateOptions struct {
+	// DryRun reports the migration steps without changing the wallet.
+	DryRun bool
+	// Backup keeps a copy of the original wallet before writing the migrated one.
+	Backup bool
+}
+
+// MigrateResult contains the result of migrating a wallet.
+type MigrateResult struct {
+	FromVersion int
+	ToVersion   int
+	Steps       []string // The migration steps that are applied, or should be applied in dry-run mode
+	BackupPath  string   // The path of the backup file, if any
+
+	// RequiresPassword is set if the migration needs the wallet password.
+	RequiresPassword bool
+}
+
+// IsUpToDate checks if the wallet doesn't need any migration.
+func (r *MigrateResult) IsUpToDate() bool {
+	return len(r.Steps) == 0
+}
+
+// Migrate upgrades the wallet at the given path to the latest version.
+// It upgrades the wallet schema, the missing fields of the addresses and
+// the key derivation function of the encrypted vault.
+// The password is only required for upgrading the key derivation function.
+func Migrate(walletPath, password string, opts MigrateOptions) (*MigrateResult, error) {
+	data, err := util.ReadFile(walletPath)
+	if err != nil {
+		return nil, err
+	}
+
+	s := new(store)
+	if err := json.Unmarshal(data, s); err != nil {
+		return nil, err
+	}
+
+	if s.Vault == nil {
+		return nil, fmt.Errorf("wallet has no vault: %s", walletPath)
+	}
+
+	if s.Version > VersionLatest {
+		return nil, UnsupportedVersionError{Version: s.Version}
+	}
+
+	// Beta wallets have no CRC, so it can only be checked for the versioned wallets.
+	if s.Version != VersionBeta {
+		crc := s.calcVaultCRC()
+		if s.VaultCRC != crc {
+			return nil, CRCNotMatchError{
+				Expected: crc,
+				Got:      s.VaultCRC,
+			}
+		}
+	}
+
+	result := &MigrateResult{
+		FromVersion: s.Version,
+		ToVersion:   VersionLatest,
+	}
+
+	if s.Version == VersionBeta {
+		network, err := networkFromCoinType(s.Vault.CoinType)
+		if err != nil {
+			return nil, err
+		}
+
+		s.Version = Version1
+		s.Network = network
+		if s.UUID == uuid.Nil {
+			s.UUID = uuid.New()
+		}
+		if s.CreatedAt.IsZero() {
+			s.CreatedAt = time.Now().Round(time.Second).UTC()
+		}
+		result.Steps = append(result.Steps,
+			fmt.Sprintf("upgrade the schema from beta to version %d", Version1))
+	}
+
+	setNetworkHRP(s.Network, s.HRP)
+	if n := fillPublicKeys(s.Vault); n > 0 {
+		result.Steps = append(result.Steps,
+			fmt.Sprintf("set the public key of %d addresses", n))
+	}
+
+	if s.Vault.Encrypter.IsOutdated() {
+		result.Steps = append(result.Steps, "upgrade the key derivation function")
+		result.RequiresPassword = true
+
+		if !opts.DryRun {
+			if err := s.Vault.UpdatePassword(password, password); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if opts.DryRun || result.IsUpToDate() {
+		return result, nil
+	}
+
+	if opts.Backup {
+		result.BackupPath = fmt.Sprintf("%s.%s.bak", walletPath,
+			time.Now().UTC().Format("20060102150405"))
+		if err := util.WriteFile(result.BackupPath, data); err != nil {
+			return nil, err
+		}
+	}
+
+	bs, err := s.Load()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := util.WriteFile(walletPath, bs); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// networkFromCoinType returns the network of the wallet based on the coin type of the vault.
+// Beta wallets don't keep the network, so it should be inferred from the coin type.
+func networkFromCoinType(coinType uint32) (genesis.ChainType, error) {
+	switch coinType {
+	case 21888:
+		return genesis.Mainnet, nil
+	case 21777:
+		return genesis.Testnet, nil
+	default:
+		return 0, ErrInvalidNetwork
+	}
+}
+
+// fillPublicKeys sets the public key of the addresses that don't have it, and
+// returns the number of updated addresses.
+// Older wallets don't keep the public keys of the derived addresses.
+func fillPublicKeys(vlt *vault.Vault) int {
+	count := 0
+	for addr, info := range vlt.Addresses {
+		if info.PublicKey != "" {
+			continue
+		}
+
+		derived := vlt.AddressInfo(addr)
+		if derived == nil || derived.PublicKey == "" {
+			continue
+		}
+
+		info.PublicKey = derived.PublicKey
+		vlt.Addresses[addr] = info
+		count++
+	}
+
+	return count
+}
@@ -0,0 +1,150 @@
+package wallet
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/pactus-project/pactus/genesis"
+	"github.com/pactus-project/pactus/util"
+	"github.com/pactus-project/pactus/wallet/encrypter"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// saveBetaWallet saves the wallet in the beta format, that has no version, CRC and network,
+// and doesn't keep the public keys of the addresses.
+func (td *testData) saveBetaWallet(t *testing.T) {
+	t.Helper()
+
+	for addr, info := range td.wallet.store.Vault.Addresses {
+		info.PublicKey = ""
+		td.wallet.store.Vault.Addresses[addr] = info
+	}
+
+	bs, err := json.Marshal(td.wallet.store)
+	require.NoError(t, err)
+
+	raw := map[string]json.RawMessage{}
+	require.NoError(t, json.Unmarshal(bs, &raw))
+	delete(raw, "version")
+	delete(raw, "uuid")
+	delete(raw, "created_at")
+	delete(raw, "network")
+	delete(raw, "crc")
+
+	bs, err = json.Marshal(raw)
+	require.NoError(t, err)
+	require.NoError(t, util.WriteFile(td.wallet.path, bs))
+}
+
+func TestMigrateUpToDateWallet(t *testing.T) {
+	td := setup(t)
+
+	require.NoError(t, td.wallet.Save())
+	before, _ := util.ReadFile(td.wallet.path)
+
+	res, err := Migrate(td.wallet.path, "", MigrateOptions{Backup: true})
+	require.NoError(t, err)
+	assert.True(t, res.IsUpToDate())
+	assert.Equal(t, VersionLatest, res.FromVersion)
+	assert.Empty(t, res.BackupPath)
+
+	after, _ := util.ReadFile(td.wallet.path)
+	assert.Equal(t, before, after)
+}
+
+func TestMigrateBetaWallet(t *testing.T) {
+	td := setup(t)
+
+	accAddr, err := td.wallet.NewBLSAccountAddress("account-1")
+	require.NoError(t, err)
+	valAddr, err := td.wallet.NewValidatorAddress("validator-1")
+	require.NoError(t, err)
+	pubKey := td.wallet.AddressInfo(accAddr).PublicKey
+	td.saveBetaWallet(t)
+
+	_, err = Open(td.wallet.path, true)
+	assert.ErrorIs(t, err, MigrationRequiredError{Version: VersionBeta})
+
+	t.Run("Dry run", func(t *testing.T) {
+		before, _ := util.ReadFile(td.wallet.path)
+
+		res, err := Migrate(td.wallet.path, "", MigrateOptions{DryRun: true, Backup: true})
+		require.NoError(t, err)
+		assert.Equal(t, VersionBeta, res.FromVersion)
+		assert.Equal(t, VersionLatest, res.ToVersion)
+		assert.Len(t, res.Steps, 2)
+		assert.False(t, res.RequiresPassword)
+		assert.Empty(t, res.BackupPath)
+
+		after, _ := util.ReadFile(td.wallet.path)
+		assert.Equal(t, before, after)
+	})
+
+	t.Run("Migrate with backup", func(t *testing.T) {
+		original, _ := util.ReadFile(td.wallet.path)
+
+		res, err := Migrate(td.wallet.path, "", MigrateOptions{Backup: true})
+		require.NoError(t, err)
+		assert.Len(t, res.Steps, 2)
+
+		backup, err := util.ReadFile(res.BackupPath)
+		require.NoError(t, err)
+		assert.Equal(t, original, backup)
+
+		wlt, err := Open(td.wallet.path, true)
+		require.NoError(t, err)
+		assert.Equal(t, genesis.Mainnet, wlt.store.Network)
+		assert.Equal(t, pubKey, wlt.store.Vault.Addresses[accAddr].PublicKey)
+		assert.NotEmpty(t, wlt.store.Vault.Addresses[valAddr].PublicKey)
+
+		res, err = Migrate(td.wallet.path, "", MigrateOptions{})
+		require.NoError(t, err)
+		assert.True(t, res.IsUpToDate())
+	})
+}
+
+func TestMigrateKeyDerivationFunction(t *testing.T) {
+	td := setup(t)
+
+	// Encrypting the wallet with weak parameters, like the older versions.
+	require.NoError(t, td.wallet.store.Vault.UpdatePassword("", "secret",
+		encrypter.OptionIteration(1),
+		encrypter.OptionMemory(8),
+		encrypter.OptionParallelism(1)))
+	require.NoError(t, td.wallet.Save())
+	mnemonic, err := td.wallet.Mnemonic("secret")
+	require.NoError(t, err)
+
+	res, err := Migrate(td.wallet.path, "", MigrateOptions{DryRun: true})
+	require.NoError(t, err)
+	assert.True(t, res.RequiresPassword)
+	assert.Len(t, res.Steps, 1)
+
+	_, err = Migrate(td.wallet.path, "", MigrateOptions{})
+	assert.ErrorIs(t, err, encrypter.ErrInvalidPassword)
+
+	_, err = Migrate(td.wallet.path, "secret", MigrateOptions{})
+	require.NoError(t, err)
+
+	wlt, err := Open(td.wallet.path, true)
+	require.NoError(t, err)
+	assert.False(t, wlt.store.Vault.Encrypter.IsOutdated())
+
+	migratedMnemonic, err := wlt.Mnemonic("secret")
+	require.NoError(t, err)
+	assert.Equal(t, mnemonic, migratedMnemonic)
+}
+
+func TestMigrateUnsupportedVersion(t *testing.T) {
+	td := setup(t)
+
+	td.wallet.store.Version = VersionLatest + 1
+	require.NoError(t, td.wallet.Save())
+
+	_, err := Migrate(td.wallet.path, "", MigrateOptions{})
+	assert.ErrorIs(t, err, UnsupportedVersionError{Version: VersionLatest + 1})
+
+	_, err = Open(td.wallet.path, true)
+	assert.ErrorIs(t, err, UnsupportedVersionError{Version: VersionLatest + 1})
+}
@@ -0,0 +1,22 @@
+package paymenturi
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrInvalidScheme describes an error in which the scheme of the URI is not `pactus`.
+var ErrInvalidScheme = errors.New("the payment URI should start with `pactus:`")
+
+// ErrExpired describes an error in which the payment request is expired.
+var ErrExpired = errors.New("the payment request is expired")
+
+// InvalidParamError describes an error in which a parameter of the URI is invalid.
+type InvalidParamError struct {
+	Param  string
+	Reason string
+}
+
+func (e InvalidParamError) Error() string {
+	return fmt.Sprintf("invalid `%s` parameter: %s", e.Param, e.Reason)
+}
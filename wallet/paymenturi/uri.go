@@ -0,0 +1,185 @@
+// Package paymenturi implements the `pactus:` payment URI, that the wallets and the merchants
+// share the payment requests by, for example in a QR code. It is like the BIP-21 URI:
+//
+//	pactus:<address>?amount=<PAC>&memo=<memo>&expiry=<unix-time>
+//
+// All the parameters are optional. The amount is in PAC, with up to 9 decimals, and the expiry
+// is the Unix time in seconds that the request is not valid after.
+// The unknown parameters are ignored, unless they start with `req-`, that the URI is refused,
+// so new required parameters can be added without being ignored by the older wallets.
+package paymenturi
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pactus-project/pactus/crypto"
+	"github.com/pactus-project/pactus/types/tx"
+)
+
+// Scheme is the scheme of the payment URIs.
+const Scheme = "pactus"
+
+// The parameters of the payment URIs.
+const (
+	ParamAmount = "amount"
+	ParamMemo   = "memo"
+	ParamExpiry = "expiry"
+)
+
+// requiredPrefix is the prefix of the parameters that should be known to accept the URI.
+const requiredPrefix = "req-"
+
+// changeDecimals is the number of the decimals of an amount in PAC.
+const changeDecimals = 9
+
+// PaymentURI is a payment request.
+type PaymentURI struct {
+	Address crypto.Address
+	Amount  int64     // The amount in NanoPAC, zero means the payer chooses the amount.
+	Memo    string    // The memo of the transaction, empty means no memo.
+	Expiry  time.Time // The time that the request is not valid after, zero means no expiry.
+}
+
+// BasicCheck performs basic checks on the payment request.
+func (p *PaymentURI) BasicCheck() error {
+	if p.Amount < 0 {
+		return InvalidParamError{Param: ParamAmount, Reason: "negative amount"}
+	}
+	if len(p.Memo) > tx.MaxMemoLength {
+		return InvalidParamError{
+			Param:  ParamMemo,
+			Reason: fmt.Sprintf("memo is longer than %d bytes", tx.MaxMemoLength),
+		}
+	}
+
+	return nil
+}
+
+// IsExpired checks if the payment request is expired at the given time.
+func (p *PaymentURI) IsExpired(now time.Time) bool {
+	return !p.Expiry.IsZero() && now.After(p.Expiry)
+}
+
+// String encodes the payment request as a URI. The parameters that are not set are omitted.
+func (p *PaymentURI) String() string {
+	params := make([]string, 0, 3)
+	if p.Amount != 0 {
+		params = append(params, ParamAmount+"="+formatAmount(p.Amount))
+	}
+	if p.Memo != "" {
+		params = append(params, ParamMemo+"="+url.QueryEscape(p.Memo))
+	}
+	if !p.Expiry.IsZero() {
+		params = append(params, ParamExpiry+"="+strconv.FormatInt(p.Expiry.Unix(), 10))
+	}
+
+	uri := Scheme + ":" + p.Address.String()
+	if len(params) > 0 {
+		uri += "?" + strings.Join(params, "&")
+	}
+
+	return uri
+}
+
+// Parse decodes and checks a payment URI. The scheme is case-insensitive,
+// so the URIs in the uppercase QR codes are accepted as well.
+// It doesn't check the expiry, since the caller knows the current time.
+func Parse(uri string) (*PaymentURI, error) {
+	scheme, rest, ok := strings.Cut(uri, ":")
+	if !ok || !strings.EqualFold(scheme, Scheme) {
+		return nil, ErrInvalidScheme
+	}
+
+	addrStr, query, _ := strings.Cut(rest, "?")
+	addr, err := crypto.AddressFromString(strings.ToLower(addrStr))
+	if err != nil {
+		return nil, err
+	}
+
+	values, err := url.ParseQuery(query)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &PaymentURI{Address: addr}
+	for param, vals := range values {
+		if len(vals) != 1 {
+			return nil, InvalidParamError{Param: param, Reason: "duplicated parameter"}
+		}
+		val := vals[0]
+
+		switch param {
+		case ParamAmount:
+			p.Amount, err = parseAmount(val)
+			if err != nil {
+				return nil, InvalidParamError{Param: param, Reason: err.Error()}
+			}
+
+		case ParamMemo:
+			p.Memo = val
+
+		case ParamExpiry:
+			expiry, err := strconv.ParseInt(val, 10, 64)
+			if err != nil || expiry <= 0 {
+				return nil, InvalidParamError{Param: param, Reason: "expiry should be a Unix time"}
+			}
+			p.Expiry = time.Unix(expiry, 0)
+
+		default:
+			if strings.HasPrefix(param, requiredPrefix) {
+				return nil, InvalidParamError{Param: param, Reason: "unknown required parameter"}
+			}
+		}
+	}
+
+	if err := p.BasicCheck(); err != nil {
+		return nil, err
+	}
+
+	return p, nil
+}
+
+// parseAmount parses an amount in PAC to NanoPAC exactly, without the rounding errors of the floats.
+func parseAmount(amount string) (int64, error) {
+	whole, frac, _ := strings.Cut(amount, ".")
+	if whole == "" && frac == "" {
+		return 0, fmt.Errorf("empty amount")
+	}
+	if len(frac) > changeDecimals {
+		return 0, fmt.Errorf("more than %d decimals", changeDecimals)
+	}
+	if strings.ContainsAny(whole+frac, "+-") {
+		return 0, fmt.Errorf("amount should be a positive decimal number")
+	}
+
+	frac += strings.Repeat("0", changeDecimals-len(frac))
+	change, err := strconv.ParseInt(whole+frac, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("amount should be a positive decimal number")
+	}
+	if change == 0 {
+		return 0, fmt.Errorf("amount should be greater than zero")
+	}
+
+	return change, nil
+}
+
+// formatAmount formats an amount in NanoPAC to PAC, without the trailing zeros.
+func formatAmount(change int64) string {
+	str := strconv.FormatInt(change, 10)
+	if len(str) <= changeDecimals {
+		str = strings.Repeat("0", changeDecimals-len(str)+1) + str
+	}
+
+	whole := str[:len(str)-changeDecimals]
+	frac := strings.TrimRight(str[len(str)-changeDecimals:], "0")
+	if frac == "" {
+		return whole
+	}
+
+	return whole + "." + frac
+}
@@ -0,0 +1,155 @@
+package paymenturi
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/pactus-project/pactus/util/testsuite"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFormatAmount(t *testing.T) {
+	tests := []struct {
+		change int64
+		want   string
+	}{
+		{1, "0.000000001"},
+		{100, "0.0000001"},
+		{1_000_000_000, "1"},
+		{1_500_000_000, "1.5"},
+		{123_456_789_012, "123.456789012"},
+		{42_000_000_000_000_000, "42000000"},
+	}
+	for _, test := range tests {
+		assert.Equal(t, test.want, formatAmount(test.change))
+
+		change, err := parseAmount(test.want)
+		require.NoError(t, err)
+		assert.Equal(t, test.change, change)
+	}
+}
+
+func TestParseAmount(t *testing.T) {
+	tests := []struct {
+		amount string
+		want   int64
+	}{
+		{"1.", 1_000_000_000},
+		{".5", 500_000_000},
+		{"0001.10", 1_100_000_000},
+		{"0.1", 100_000_000},
+	}
+	for _, test := range tests {
+		change, err := parseAmount(test.amount)
+		require.NoError(t, err, test.amount)
+		assert.Equal(t, test.want, change, test.amount)
+	}
+
+	invalids := []string{"", ".", "0", "0.0", "-1", "+1", "1e9", "1,5", "0.0000000001", "1.2.3", "99999999999"}
+	for _, amount := range invalids {
+		_, err := parseAmount(amount)
+		assert.Error(t, err, amount)
+	}
+}
+
+func TestEncodeDecode(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	addr := ts.RandAccAddress()
+	tests := []struct {
+		uri     PaymentURI
+		wantStr string
+	}{
+		{
+			PaymentURI{Address: addr},
+			"pactus:" + addr.String(),
+		},
+		{
+			PaymentURI{Address: addr, Amount: 1_500_000_000},
+			"pactus:" + addr.String() + "?amount=1.5",
+		},
+		{
+			PaymentURI{Address: addr, Memo: "Order #42 & tip"},
+			"pactus:" + addr.String() + "?memo=Order+%2342+%26+tip",
+		},
+		{
+			PaymentURI{Address: addr, Amount: 1, Memo: "café", Expiry: time.Unix(1_700_000_000, 0)},
+			"pactus:" + addr.String() + "?amount=0.000000001&memo=caf%C3%A9&expiry=1700000000",
+		},
+	}
+	for _, test := range tests {
+		assert.Equal(t, test.wantStr, test.uri.String())
+
+		p, err := Parse(test.wantStr)
+		require.NoError(t, err)
+		assert.Equal(t, test.uri, *p)
+	}
+}
+
+func TestParse(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	addr := ts.RandAccAddress()
+
+	t.Run("Should accept the uppercase URIs", func(t *testing.T) {
+		p, err := Parse(strings.ToUpper("pactus:"+addr.String()) + "?amount=2")
+		require.NoError(t, err)
+		assert.Equal(t, addr, p.Address)
+		assert.Equal(t, int64(2_000_000_000), p.Amount)
+	})
+
+	t.Run("Should ignore the unknown optional parameters", func(t *testing.T) {
+		p, err := Parse("pactus:" + addr.String() + "?label=shop&amount=2")
+		require.NoError(t, err)
+		assert.Equal(t, int64(2_000_000_000), p.Amount)
+	})
+
+	t.Run("Should refuse the unknown required parameters", func(t *testing.T) {
+		_, err := Parse("pactus:" + addr.String() + "?req-asset=usd&amount=2")
+		assert.ErrorIs(t, err, InvalidParamError{Param: "req-asset", Reason: "unknown required parameter"})
+	})
+
+	t.Run("Invalid URIs", func(t *testing.T) {
+		tests := []struct {
+			uri     string
+			wantErr error
+		}{
+			{addr.String(), ErrInvalidScheme},
+			{"bitcoin:" + addr.String(), ErrInvalidScheme},
+			{"pactus:" + addr.String() + "?amount=1&amount=2",
+				InvalidParamError{Param: ParamAmount, Reason: "duplicated parameter"}},
+			{"pactus:" + addr.String() + "?amount=-1",
+				InvalidParamError{Param: ParamAmount, Reason: "amount should be a positive decimal number"}},
+			{"pactus:" + addr.String() + "?expiry=tomorrow",
+				InvalidParamError{Param: ParamExpiry, Reason: "expiry should be a Unix time"}},
+			{"pactus:" + addr.String() + "?memo=" + strings.Repeat("m", 65),
+				InvalidParamError{Param: ParamMemo, Reason: "memo is longer than 64 bytes"}},
+		}
+		for _, test := range tests {
+			_, err := Parse(test.uri)
+			assert.ErrorIs(t, err, test.wantErr, test.uri)
+		}
+
+		_, err := Parse("pactus:invalid-address")
+		assert.Error(t, err)
+
+		_, err = Parse("pactus:" + addr.String() + "?memo=%zz")
+		assert.Error(t, err)
+	})
+}
+
+func TestIsExpired(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	now := time.Now()
+	p := PaymentURI{Address: ts.RandAccAddress()}
+	assert.False(t, p.IsExpired(now))
+
+	p.Expiry = now.Add(time.Minute)
+	assert.False(t, p.IsExpired(now))
+
+	p.Expiry = now.Add(-time.Minute)
+	assert.True(t, p.IsExpired(now))
+}
@@ -0,0 +1,195 @@
+package wallet
+
+import (
+	"context"
+	"time"
+
+	"github.com/pactus-project/pactus/crypto"
+	"github.com/pactus-project/pactus/genesis"
+	"github.com/pactus-project/pactus/types/param"
+	"github.com/pactus-project/pactus/types/tx"
+	"github.com/pactus-project/pactus/types/tx/payload"
+	"github.com/pactus-project/pactus/util"
+	"github.com/pactus-project/pactus/util/i18n"
+)
+
+// StakeSummary summarizes the consequences of a bond or an unbond transaction,
+// so the user can confirm them before signing the transaction.
+type StakeSummary struct {
+	Validator    string
+	NewValidator bool
+	CurrentStake int64
+	Stake        int64
+
+	// The number of blocks that the validator should wait after bonding,
+	// before it can join the committee.
+	BondInterval uint32
+	// The number of blocks that the stake is locked after unbonding,
+	// before it can be withdrawn.
+	UnbondInterval uint32
+	BlockInterval  time.Duration
+}
+
+// Consequences returns the consequences of the transaction as human-readable sentences.
+func (s *StakeSummary) Consequences() []string {
+	lines := make([]string, 0, 3)
+	if s.Stake > s.CurrentStake {
+		if s.NewValidator {
+			lines = append(lines, i18n.Sprintf("a new validator %s is created", s.Validator))
+		}
+		lines = append(lines, i18n.Sprintf("the stake of the validator becomes %.9f PAC",
+			util.ChangeToCoin(s.Stake)))
+		if s.BondInterval > 0 {
+			lines = append(lines, i18n.Sprintf("the validator can join the committee after %d blocks (~%s)",
+				s.BondInterval, s.duration(s.BondInterval)))
+		}
+		if s.UnbondInterval > 0 {
+			lines = append(lines, i18n.Sprintf("the stake can only be withdrawn %d blocks (~%s) after unbonding",
+				s.UnbondInterval, s.duration(s.UnbondInterval)))
+		}
+
+		return lines
+	}
+
+	lines = append(lines, i18n.Sprintf("the validator %s stops validating and can't be bonded again", s.Validator))
+	if s.UnbondInterval > 0 {
+		lines = append(lines, i18n.Sprintf("the stake of %.9f PAC is locked for %d blocks (~%s) before withdrawal",
+			util.ChangeToCoin(s.CurrentStake), s.UnbondInterval, s.duration(s.UnbondInterval)))
+	}
+
+	return lines
+}
+
+func (s *StakeSummary) duration(blocks uint32) time.Duration {
+	return (time.Duration(blocks) * s.BlockInterval).Round(time.Minute)
+}
+
+// params returns the consensus parameters of the network that the wallet belongs to.
+// The parameters of the local networks are defined by their genesis and unknown to the wallet,
+// so it returns nil for them.
+func (w *Wallet) params() *param.Params {
+	switch w.store.Network {
+	case genesis.Mainnet:
+		return genesis.MainnetGenesis().Params()
+	case genesis.Testnet:
+		return genesis.TestnetGenesis().Params()
+	default:
+		return nil
+	}
+}
+
+// PreflightStakeTx validates a bond or an unbond transaction against the state of the validator,
+// before signing it. Mis-constructed bond transactions, like a wrong public key or
+// a stake out of the allowed range, are rejected by the network or lock the stake,
+// so they are reported as InvalidStakeTxError.
+// On success, it returns the summary of the consequences of the transaction.
+func (w *Wallet) PreflightStakeTx(ctx context.Context, trx *tx.Tx) (*StakeSummary, error) {
+	if w.client == nil {
+		return nil, ErrOffline
+	}
+
+	switch pld := trx.Payload().(type) {
+	case *payload.BondPayload:
+		return w.preflightBond(ctx, pld)
+
+	case *payload.UnbondPayload:
+		return w.preflightUnbond(ctx, pld)
+
+	default:
+		return nil, InvalidStakeTxError{Reason: "not a bond or an unbond transaction"}
+	}
+}
+
+func (w *Wallet) stakeSummary(validator string) *StakeSummary {
+	summary := &StakeSummary{Validator: validator}
+	if params := w.params(); params != nil {
+		summary.BondInterval = params.BondInterval
+		summary.UnbondInterval = params.UnbondInterval
+		summary.BlockInterval = params.BlockInterval()
+	}
+
+	return summary
+}
+
+func (w *Wallet) preflightBond(ctx context.Context, pld *payload.BondPayload) (*StakeSummary, error) {
+	if err := pld.BasicCheck(); err != nil {
+		return nil, InvalidStakeTxError{Reason: err.Error()}
+	}
+
+	summary := w.stakeSummary(pld.To.String())
+	val, _ := w.client.getValidator(ctx, pld.To)
+	if val == nil {
+		if pld.PublicKey == nil {
+			return nil, InvalidStakeTxError{
+				Reason: "public key is required to bond to a new validator",
+			}
+		}
+		summary.NewValidator = true
+	} else {
+		if pld.PublicKey != nil {
+			return nil, InvalidStakeTxError{
+				Reason: "public key should not be set for an existing validator",
+			}
+		}
+		if val.UnbondingHeight != 0 {
+			return nil, InvalidStakeTxError{
+				Reason: i18n.Sprintf("validator has unbonded at height %d", val.UnbondingHeight),
+			}
+		}
+		summary.CurrentStake = val.Stake
+	}
+	summary.Stake = summary.CurrentStake + pld.Stake
+
+	if params := w.params(); params != nil {
+		if pld.Stake < params.MinimumStake {
+			return nil, InvalidStakeTxError{
+				Reason: i18n.Sprintf("stake can't be less than %.9f PAC", util.ChangeToCoin(params.MinimumStake)),
+			}
+		}
+		if summary.Stake > params.MaximumStake {
+			return nil, InvalidStakeTxError{
+				Reason: i18n.Sprintf("validator's stake can't be more than %.9f PAC",
+					util.ChangeToCoin(params.MaximumStake)),
+			}
+		}
+	}
+
+	return summary, nil
+}
+
+func (w *Wallet) preflightUnbond(ctx context.Context, pld *payload.UnbondPayload) (*StakeSummary, error) {
+	if err := pld.BasicCheck(); err != nil {
+		return nil, InvalidStakeTxError{Reason: err.Error()}
+	}
+
+	val, _ := w.client.getValidator(ctx, pld.Validator)
+	if val == nil {
+		return nil, InvalidStakeTxError{Reason: "validator doesn't exist"}
+	}
+	if val.UnbondingHeight != 0 {
+		return nil, InvalidStakeTxError{
+			Reason: i18n.Sprintf("validator has unbonded at height %d", val.UnbondingHeight),
+		}
+	}
+
+	summary := w.stakeSummary(pld.Validator.String())
+	summary.CurrentStake = val.Stake
+
+	return summary, nil
+}
+
+// isInCommittee checks if the validator is a member of the committee.
+func (w *Wallet) isInCommittee(ctx context.Context, addr crypto.Address) (bool, error) {
+	info, err := w.client.getBlockchainInfo(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	for _, val := range info.CommitteeValidators {
+		if val.Address == addr.String() {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
@@ -0,0 +1,196 @@
+package wallet
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pactus-project/pactus/crypto"
+	"github.com/pactus-project/pactus/genesis"
+	"github.com/pactus-project/pactus/types/tx"
+	pactus "github.com/pactus-project/pactus/www/grpc/gen/go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPreflightBondTx(t *testing.T) {
+	td := setup(t)
+
+	params := genesis.MainnetGenesis().Params()
+	sender := td.RandAccAddress()
+	valKey := td.RandValKey()
+	lockTime := td.RandHeight()
+
+	t.Run("Not a stake transaction", func(t *testing.T) {
+		trx := tx.NewTransferTx(lockTime, sender, td.RandAccAddress(), 1e9, 1e6, "")
+		_, err := td.wallet.PreflightStakeTx(context.Background(), trx)
+		assert.ErrorAs(t, err, &InvalidStakeTxError{})
+	})
+
+	t.Run("Public key doesn't match the validator", func(t *testing.T) {
+		otherPub, _ := td.RandBLSKeyPair()
+		trx := tx.NewBondTx(lockTime, sender, valKey.Address(), otherPub, params.MinimumStake, 1e6, "")
+		_, err := td.wallet.PreflightStakeTx(context.Background(), trx)
+		assert.ErrorAs(t, err, &InvalidStakeTxError{})
+	})
+
+	t.Run("New validator without public key", func(t *testing.T) {
+		trx := tx.NewBondTx(lockTime, sender, valKey.Address(), nil, params.MinimumStake, 1e6, "")
+		_, err := td.wallet.PreflightStakeTx(context.Background(), trx)
+		assert.ErrorAs(t, err, &InvalidStakeTxError{})
+	})
+
+	t.Run("Less than the minimum stake", func(t *testing.T) {
+		trx := tx.NewBondTx(lockTime, sender, valKey.Address(), valKey.PublicKey(), params.MinimumStake-1, 1e6, "")
+		_, err := td.wallet.PreflightStakeTx(context.Background(), trx)
+		assert.ErrorAs(t, err, &InvalidStakeTxError{})
+	})
+
+	t.Run("New validator", func(t *testing.T) {
+		trx := tx.NewBondTx(lockTime, sender, valKey.Address(), valKey.PublicKey(), params.MinimumStake, 1e6, "")
+		summary, err := td.wallet.PreflightStakeTx(context.Background(), trx)
+		require.NoError(t, err)
+		assert.True(t, summary.NewValidator)
+		assert.Equal(t, params.MinimumStake, summary.Stake)
+		assert.Equal(t, params.BondInterval, summary.BondInterval)
+		assert.Equal(t, params.UnbondInterval, summary.UnbondInterval)
+		assert.Len(t, summary.Consequences(), 4)
+	})
+
+	t.Run("Existing validator with public key", func(t *testing.T) {
+		tValidatorResponse = &pactus.GetValidatorResponse{Validator: &pactus.ValidatorInfo{Stake: 1e10}}
+		defer func() { tValidatorResponse = nil }()
+
+		trx := tx.NewBondTx(lockTime, sender, valKey.Address(), valKey.PublicKey(), params.MinimumStake, 1e6, "")
+		_, err := td.wallet.PreflightStakeTx(context.Background(), trx)
+		assert.ErrorAs(t, err, &InvalidStakeTxError{})
+	})
+
+	t.Run("Exceeding the maximum stake", func(t *testing.T) {
+		tValidatorResponse = &pactus.GetValidatorResponse{Validator: &pactus.ValidatorInfo{Stake: params.MaximumStake}}
+		defer func() { tValidatorResponse = nil }()
+
+		trx := tx.NewBondTx(lockTime, sender, valKey.Address(), nil, params.MinimumStake, 1e6, "")
+		_, err := td.wallet.PreflightStakeTx(context.Background(), trx)
+		assert.ErrorAs(t, err, &InvalidStakeTxError{})
+	})
+
+	t.Run("Unbonded validator", func(t *testing.T) {
+		tValidatorResponse = &pactus.GetValidatorResponse{
+			Validator: &pactus.ValidatorInfo{Stake: 1e10, UnbondingHeight: lockTime},
+		}
+		defer func() { tValidatorResponse = nil }()
+
+		trx := tx.NewBondTx(lockTime, sender, valKey.Address(), nil, params.MinimumStake, 1e6, "")
+		_, err := td.wallet.PreflightStakeTx(context.Background(), trx)
+		assert.ErrorAs(t, err, &InvalidStakeTxError{})
+	})
+
+	t.Run("Existing validator", func(t *testing.T) {
+		tValidatorResponse = &pactus.GetValidatorResponse{Validator: &pactus.ValidatorInfo{Stake: 1e10}}
+		defer func() { tValidatorResponse = nil }()
+
+		trx := tx.NewBondTx(lockTime, sender, valKey.Address(), nil, params.MinimumStake, 1e6, "")
+		summary, err := td.wallet.PreflightStakeTx(context.Background(), trx)
+		require.NoError(t, err)
+		assert.False(t, summary.NewValidator)
+		assert.Equal(t, int64(1e10), summary.CurrentStake)
+		assert.Equal(t, 1e10+params.MinimumStake, summary.Stake)
+		assert.Len(t, summary.Consequences(), 3)
+	})
+
+	t.Run("Offline wallet", func(t *testing.T) {
+		trx := tx.NewBondTx(lockTime, sender, valKey.Address(), valKey.PublicKey(), params.MinimumStake, 1e6, "")
+
+		client := td.wallet.client
+		td.wallet.client = nil
+		defer func() { td.wallet.client = client }()
+
+		_, err := td.wallet.PreflightStakeTx(context.Background(), trx)
+		assert.ErrorIs(t, err, ErrOffline)
+	})
+}
+
+func TestPreflightUnbondTx(t *testing.T) {
+	td := setup(t)
+
+	valAddr := td.RandValAddress()
+	lockTime := td.RandHeight()
+	trx := tx.NewUnbondTx(lockTime, valAddr, "")
+
+	t.Run("Unknown validator", func(t *testing.T) {
+		_, err := td.wallet.PreflightStakeTx(context.Background(), trx)
+		assert.ErrorAs(t, err, &InvalidStakeTxError{})
+	})
+
+	t.Run("Unbonded validator", func(t *testing.T) {
+		tValidatorResponse = &pactus.GetValidatorResponse{
+			Validator: &pactus.ValidatorInfo{Stake: 1e10, UnbondingHeight: lockTime},
+		}
+
+		_, err := td.wallet.PreflightStakeTx(context.Background(), trx)
+		assert.ErrorAs(t, err, &InvalidStakeTxError{})
+	})
+
+	t.Run("Ok", func(t *testing.T) {
+		tValidatorResponse = &pactus.GetValidatorResponse{Validator: &pactus.ValidatorInfo{Stake: 1e10}}
+
+		summary, err := td.wallet.PreflightStakeTx(context.Background(), trx)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1e10), summary.CurrentStake)
+		assert.Len(t, summary.Consequences(), 2)
+		assert.Contains(t, summary.Consequences()[0], valAddr.String())
+	})
+}
+
+func TestStakeWarnings(t *testing.T) {
+	td := setup(t)
+
+	sender, _ := td.wallet.NewBLSAccountAddress("account")
+	ownValidator, _ := td.wallet.NewValidatorAddress("validator")
+	foreignValidator := td.RandValKey()
+	lastHeight := td.RandHeight()
+
+	tBlockchainInfoResponse = &pactus.GetBlockchainInfoResponse{LastBlockHeight: lastHeight}
+	tAccountResponse = &pactus.GetAccountResponse{Account: &pactus.AccountInfo{Balance: 1e12}}
+
+	makeBond := func(receiver, pub string) *tx.Tx {
+		trx, err := td.wallet.MakeBondTx(sender, receiver, pub, 1e9,
+			OptionFee(1e6), OptionLockTime(lastHeight+1))
+		require.NoError(t, err)
+
+		return trx
+	}
+
+	t.Run("Bonding to a validator of the wallet", func(t *testing.T) {
+		warnings, err := td.wallet.CheckTransaction(context.Background(), makeBond(ownValidator, ""))
+		require.NoError(t, err)
+		assert.Empty(t, warnings)
+	})
+
+	t.Run("Bonding to a foreign validator", func(t *testing.T) {
+		trx := makeBond(foreignValidator.Address().String(), foreignValidator.PublicKey().String())
+		warnings, err := td.wallet.CheckTransaction(context.Background(), trx)
+		require.NoError(t, err)
+		require.Len(t, warnings, 1)
+		assert.Equal(t, WarningForeignValidator, warnings[0].Code)
+	})
+
+	t.Run("Validator in the committee", func(t *testing.T) {
+		tBlockchainInfoResponse = &pactus.GetBlockchainInfoResponse{
+			LastBlockHeight:     lastHeight,
+			CommitteeValidators: []*pactus.ValidatorInfo{{Address: ownValidator}},
+		}
+
+		warnings, err := td.wallet.CheckTransaction(context.Background(), makeBond(ownValidator, ""))
+		require.NoError(t, err)
+		require.Len(t, warnings, 1)
+		assert.Equal(t, WarningValidatorInCommittee, warnings[0].Code)
+
+		valAddr, _ := crypto.AddressFromString(ownValidator)
+		trx := tx.NewUnbondTx(lastHeight+1, valAddr, "")
+		warnings, err = td.wallet.CheckTransaction(context.Background(), trx)
+		require.NoError(t, err)
+		require.Len(t, warnings, 1)
+		assert.Equal(t, WarningValidatorInCommittee, warnings[0].Code)
+	})
+}
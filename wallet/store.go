@@ -6,15 +6,24 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/pactus-project/pactus/crypto"
 	"github.com/pactus-project/pactus/genesis"
 	"github.com/pactus-project/pactus/wallet/vault"
 )
 
+const (
+	VersionBeta   = 0 // Wallets of the beta releases, without version and CRC
+	Version1      = 1 // The initial versioned wallet
+	VersionLatest = Version1
+)
+
 type store struct {
 	Version   int               `json:"version"`
 	UUID      uuid.UUID         `json:"uuid"`
 	CreatedAt time.Time         `json:"created_at"`
 	Network   genesis.ChainType `json:"network"`
+	HRP       *crypto.HRP       `json:"hrp,omitempty"`
+	ChainID   string            `json:"chain_id,omitempty"`
 	VaultCRC  uint32            `json:"crc"`
 	Vault     *vault.Vault      `json:"vault"`
 	History   history           `json:"history"`
@@ -32,6 +41,13 @@ func (s *store) Save(bs []byte) error {
 		return err
 	}
 
+	if s.Version < VersionLatest {
+		return MigrationRequiredError{Version: s.Version}
+	}
+	if s.Version > VersionLatest {
+		return UnsupportedVersionError{Version: s.Version}
+	}
+
 	crc := s.calcVaultCRC()
 	if s.VaultCRC != crc {
 		return CRCNotMatchError{
@@ -40,6 +56,12 @@ func (s *store) Save(bs []byte) error {
 		}
 	}
 
+	if s.HRP != nil {
+		if err := networkHRP(s.Network, s.HRP).BasicCheck(); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
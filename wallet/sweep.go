@@ -0,0 +1,150 @@
+package wallet
+
+import (
+	"context"
+	"sort"
+
+	"github.com/pactus-project/pactus/crypto"
+	"github.com/pactus-project/pactus/types/tx"
+	"github.com/pactus-project/pactus/types/tx/payload"
+)
+
+// sweepQueryBatchSize is the number of the accounts that are queried in one request.
+// It should not be more than the batch size limit of the gRPC server.
+const sweepQueryBatchSize = 1000
+
+// SweepTransfer is a planned transfer that moves the balance of an account to the receiver.
+type SweepTransfer struct {
+	Sender  string
+	Balance int64
+	Amount  int64
+	Fee     int64
+}
+
+// FeeRatio returns the ratio of the fee to the balance of the sender.
+func (t SweepTransfer) FeeRatio() float64 {
+	return float64(t.Fee) / float64(t.Balance)
+}
+
+// PlanSweep plans the transfers that consolidate the balances of the wallet accounts,
+// like the reward addresses, into the receiver.
+// The accounts with a balance less than the minBalance are skipped. The accounts that moving
+// their balance costs more than the maxFeeRatio of the balance are skipped too,
+// so they can be swept later when they have accumulated more.
+// The transfers are sorted by their fee ratio, so the cheapest ones come first.
+// Querying the accounts can take a while for big wallets; it stops when the ctx is done.
+func (w *Wallet) PlanSweep(ctx context.Context, receiver string,
+	minBalance int64, maxFeeRatio float64,
+) ([]SweepTransfer, error) {
+	if _, err := crypto.AddressFromString(receiver); err != nil {
+		return nil, err
+	}
+
+	if w.client == nil {
+		return nil, ErrOffline
+	}
+
+	senders := make([]string, 0)
+	for _, info := range w.AddressInfos() {
+		addr, err := crypto.AddressFromString(info.Address)
+		if err != nil || !addr.IsAccountAddress() || info.Address == receiver {
+			continue
+		}
+		senders = append(senders, info.Address)
+	}
+
+	transfers := make([]SweepTransfer, 0)
+	for start := 0; start < len(senders); start += sweepQueryBatchSize {
+		end := min(start+sweepQueryBatchSize, len(senders))
+		results, err := w.client.getAccounts(ctx, senders[start:end])
+		if err != nil {
+			return nil, err
+		}
+
+		for _, res := range results {
+			if !res.Found || res.Account.Balance <= 0 || res.Account.Balance < minBalance {
+				continue
+			}
+
+			trans, err := w.sweepTransfer(ctx, res.Address, res.Account.Balance)
+			if err != nil {
+				return nil, err
+			}
+			if trans.Amount <= 0 || trans.FeeRatio() > maxFeeRatio {
+				continue
+			}
+			transfers = append(transfers, trans)
+		}
+	}
+
+	sort.SliceStable(transfers, func(i, j int) bool {
+		return transfers[i].FeeRatio() < transfers[j].FeeRatio()
+	})
+
+	return transfers, nil
+}
+
+// sweepTransfer finds the amount that can be transferred from the balance, so that
+// the amount and the fee of transferring that amount is not more than the balance.
+func (w *Wallet) sweepTransfer(ctx context.Context, sender string, balance int64) (SweepTransfer, error) {
+	fee, err := w.client.getFee(ctx, balance, payload.TypeTransfer)
+	if err != nil {
+		return SweepTransfer{}, err
+	}
+
+	// The fee of a smaller amount may be less, so the amount can be increased by the difference.
+	amount := balance - fee
+	fee, err = w.client.getFee(ctx, amount, payload.TypeTransfer)
+	if err != nil {
+		return SweepTransfer{}, err
+	}
+	if newAmount := balance - fee; newAmount > amount {
+		newFee, err := w.client.getFee(ctx, newAmount, payload.TypeTransfer)
+		if err != nil {
+			return SweepTransfer{}, err
+		}
+		if newAmount+newFee <= balance {
+			amount = newAmount
+			fee = newFee
+		}
+	}
+
+	return SweepTransfer{
+		Sender:  sender,
+		Balance: balance,
+		Amount:  amount,
+		Fee:     fee,
+	}, nil
+}
+
+// MakeSweepTxs creates the transfer transactions of the planned transfers to the receiver.
+func (w *Wallet) MakeSweepTxs(receiver string, transfers []SweepTransfer,
+	options ...TxOption,
+) ([]*tx.Tx, error) {
+	trxs := make([]*tx.Tx, 0, len(transfers))
+	for _, trans := range transfers {
+		opts := append([]TxOption{OptionFee(trans.Fee)}, options...)
+		trx, err := w.MakeTransferTx(trans.Sender, receiver, trans.Amount, opts...)
+		if err != nil {
+			return nil, err
+		}
+		trxs = append(trxs, trx)
+	}
+
+	return trxs, nil
+}
+
+// SweepBatches splits the transfers into the batches with at most batchSize transfers.
+func SweepBatches(transfers []SweepTransfer, batchSize int) [][]SweepTransfer {
+	if batchSize <= 0 {
+		batchSize = len(transfers)
+	}
+
+	batches := make([][]SweepTransfer, 0)
+	for start := 0; start < len(transfers); start += batchSize {
+		end := min(start+batchSize, len(transfers))
+		batches = append(batches, transfers[start:end])
+	}
+
+	return batches
+}
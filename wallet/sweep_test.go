@@ -0,0 +1,127 @@
+package wallet
+
+import (
+	"context"
+	"testing"
+
+	pactus "github.com/pactus-project/pactus/www/grpc/gen/go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestPlanSweep(t *testing.T) {
+	td := setup(t)
+
+	receiver, _ := td.wallet.NewBLSAccountAddress("receiver")
+	addr1, _ := td.wallet.NewBLSAccountAddress("reward-1")
+	addr2, _ := td.wallet.NewBLSAccountAddress("reward-2")
+	addr3, _ := td.wallet.NewBLSAccountAddress("reward-3")
+	addr4, _ := td.wallet.NewBLSAccountAddress("reward-4")
+	_, _ = td.wallet.NewValidatorAddress("validator")
+
+	tFeeFraction = 0.0001
+	tMinimumFee = 1e6
+	tAccountsResponse = &pactus.GetAccountsResponse{
+		Results: []*pactus.AccountResult{
+			{Address: addr1, Found: true, Account: &pactus.AccountInfo{Balance: 1e9}},
+			{Address: addr2, Found: true, Account: &pactus.AccountInfo{Balance: 2e10}},
+			{Address: addr3, Found: true, Account: &pactus.AccountInfo{Balance: 5e6}},
+			{Address: addr4, Found: false},
+		},
+	}
+
+	t.Run("Should plan the cheapest transfers first", func(t *testing.T) {
+		transfers, err := td.wallet.PlanSweep(context.Background(), receiver, 1e7, 0.01)
+		require.NoError(t, err)
+		require.Len(t, transfers, 2)
+
+		// The fee of a smaller amount is less, and the amount is increased by the difference.
+		assert.Equal(t, SweepTransfer{
+			Sender: addr2, Balance: 2e10, Amount: 19998000200, Fee: 1999800,
+		}, transfers[0])
+		assert.Equal(t, SweepTransfer{
+			Sender: addr1, Balance: 1e9, Amount: 999000000, Fee: 1e6,
+		}, transfers[1])
+	})
+
+	t.Run("Should skip the accounts that the fee is too high", func(t *testing.T) {
+		transfers, err := td.wallet.PlanSweep(context.Background(), receiver, 0, 0.0005)
+		require.NoError(t, err)
+		require.Len(t, transfers, 1)
+		assert.Equal(t, addr2, transfers[0].Sender)
+	})
+
+	t.Run("Should skip the dust accounts", func(t *testing.T) {
+		transfers, err := td.wallet.PlanSweep(context.Background(), receiver, 0, 1)
+		require.NoError(t, err)
+		assert.Len(t, transfers, 3)
+
+		transfers, err = td.wallet.PlanSweep(context.Background(), receiver, 2e10, 1)
+		require.NoError(t, err)
+		assert.Len(t, transfers, 1)
+	})
+
+	t.Run("Invalid receiver", func(t *testing.T) {
+		_, err := td.wallet.PlanSweep(context.Background(), "invalid", 0, 1)
+		assert.Error(t, err)
+	})
+
+	t.Run("Canceled context", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		_, err := td.wallet.PlanSweep(ctx, receiver, 0, 1)
+		assert.Equal(t, codes.Canceled, status.Code(err))
+	})
+
+	t.Run("Offline wallet", func(t *testing.T) {
+		client := td.wallet.client
+		td.wallet.client = nil
+		defer func() { td.wallet.client = client }()
+
+		_, err := td.wallet.PlanSweep(context.Background(), receiver, 0, 1)
+		assert.ErrorIs(t, err, ErrOffline)
+	})
+}
+
+func TestMakeSweepTxs(t *testing.T) {
+	td := setup(t)
+
+	receiver := td.RandAccAddress().String()
+	sender1, _ := td.wallet.NewBLSAccountAddress("reward-1")
+	sender2, _ := td.wallet.NewBLSAccountAddress("reward-2")
+	lockTime := td.RandHeight()
+
+	transfers := []SweepTransfer{
+		{Sender: sender1, Balance: 2e9, Amount: 1999000000, Fee: 1e6},
+		{Sender: sender2, Balance: 3e9, Amount: 2999000000, Fee: 1e6},
+	}
+	trxs, err := td.wallet.MakeSweepTxs(receiver, transfers, OptionLockTime(lockTime))
+	require.NoError(t, err)
+	require.Len(t, trxs, 2)
+
+	for i, trx := range trxs {
+		assert.True(t, trx.IsTransferTx())
+		assert.Equal(t, transfers[i].Sender, trx.Payload().Signer().String())
+		assert.Equal(t, receiver, trx.Payload().Receiver().String())
+		assert.Equal(t, transfers[i].Amount, trx.Payload().Value())
+		assert.Equal(t, transfers[i].Fee, trx.Fee())
+		assert.Equal(t, lockTime, trx.LockTime())
+	}
+}
+
+func TestSweepBatches(t *testing.T) {
+	transfers := make([]SweepTransfer, 5)
+
+	batches := SweepBatches(transfers, 2)
+	require.Len(t, batches, 3)
+	assert.Len(t, batches[0], 2)
+	assert.Len(t, batches[1], 2)
+	assert.Len(t, batches[2], 1)
+
+	assert.Len(t, SweepBatches(transfers, 0), 1)
+	assert.Len(t, SweepBatches(transfers, 10), 1)
+	assert.Empty(t, SweepBatches(nil, 2))
+}
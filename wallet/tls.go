@@ -0,0 +1,42 @@
+package wallet
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+
+	"github.com/pactus-project/pactus/util"
+	"google.golang.org/grpc/credentials"
+)
+
+// LoadTLSCredentials loads the TLS credentials to connect to a remote node.
+// caFile is used to verify the node certificate.
+// If it is empty, the system certificate pool is used.
+// certFile and keyFile are optional and used for client authentication.
+func LoadTLSCredentials(caFile, certFile, keyFile string) (credentials.TransportCredentials, error) {
+	conf := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+	}
+
+	if caFile != "" {
+		data, err := util.ReadFile(caFile)
+		if err != nil {
+			return nil, err
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(data) {
+			return nil, fmt.Errorf("no valid certificate found in %s", caFile)
+		}
+		conf.RootCAs = pool
+	}
+
+	if certFile != "" || keyFile != "" {
+		cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+		if err != nil {
+			return nil, err
+		}
+		conf.Certificates = []tls.Certificate{cert}
+	}
+
+	return credentials.NewTLS(conf), nil
+}
@@ -1,143 +1,106 @@
 package wallet
 
 import (
-	"github.com/pactus-project/pactus/crypto"
-	"github.com/pactus-project/pactus/crypto/bls"
+	"context"
+
+	"errors"
+
+	"github.com/pactus-project/pactus/types/param"
 	"github.com/pactus-project/pactus/types/tx"
 	"github.com/pactus-project/pactus/types/tx/payload"
+	"github.com/pactus-project/pactus/types/tx/txbuilder"
 )
 
-type TxOption func(builder *txBuilder) error
+type TxOption func(builder *txbuilder.Builder) error
 
-func OptionLockTime(lockTime uint32) func(builder *txBuilder) error {
-	return func(builder *txBuilder) error {
-		builder.lockTime = lockTime
+func OptionLockTime(lockTime uint32) func(builder *txbuilder.Builder) error {
+	return func(builder *txbuilder.Builder) error {
+		builder.LockTime(lockTime)
 
 		return nil
 	}
 }
 
-func OptionFee(fee int64) func(builder *txBuilder) error {
-	return func(builder *txBuilder) error {
-		builder.fee = fee
+// OptionExpiry sets the number of blocks, after the next block, that the transaction
+// can be committed. It can't be set together with the lock time.
+func OptionExpiry(blocks uint32) func(builder *txbuilder.Builder) error {
+	return func(builder *txbuilder.Builder) error {
+		builder.Expiry(blocks)
 
 		return nil
 	}
 }
 
-func OptionMemo(memo string) func(builder *txBuilder) error {
-	return func(builder *txBuilder) error {
-		builder.memo = memo
+func OptionFee(fee int64) func(builder *txbuilder.Builder) error {
+	return func(builder *txbuilder.Builder) error {
+		builder.Fee(fee)
 
 		return nil
 	}
 }
 
-type txBuilder struct {
-	client   *grpcClient
-	from     *crypto.Address
-	to       *crypto.Address
-	pub      *bls.PublicKey
-	typ      payload.Type
-	lockTime uint32
-	amount   int64
-	fee      int64
-	memo     string
-}
+func OptionMemo(memo string) func(builder *txbuilder.Builder) error {
+	return func(builder *txbuilder.Builder) error {
+		builder.Memo(memo)
 
-func newTxBuilder(client *grpcClient, options ...TxOption) (*txBuilder, error) {
-	builder := &txBuilder{
-		client: client,
-	}
-	for _, op := range options {
-		err := op(builder)
-		if err != nil {
-			return nil, err
-		}
+		return nil
 	}
-
-	return builder, nil
 }
 
-func (m *txBuilder) setFromAddr(addr string) error {
-	from, err := crypto.AddressFromString(addr)
-	if err != nil {
-		return err
-	}
-	m.from = &from
-
-	return nil
+// txProvider provides the default lock time and fee for the transaction builder
+// through the gRPC client.
+type txProvider struct {
+	client *grpcClient
 }
 
-func (m *txBuilder) setToAddress(addr string) error {
-	to, err := crypto.AddressFromString(addr)
+func (p *txProvider) LastBlockHeight() (uint32, error) {
+	info, err := p.client.getBlockchainInfo(context.Background())
 	if err != nil {
-		return err
+		return 0, err
 	}
-	m.to = &to
 
-	return nil
+	return info.LastBlockHeight, nil
 }
 
-func (m *txBuilder) build() (*tx.Tx, error) {
-	err := m.setLockTime()
-	if err != nil {
-		return nil, err
-	}
+func (p *txProvider) CalculateFee(amount int64, payloadType payload.Type) (int64, error) {
+	return p.client.getFee(context.Background(), amount, payloadType)
+}
 
-	err = m.setFee()
-	if err != nil {
-		return nil, err
+func newTxBuilder(client *grpcClient, options ...TxOption) (*txbuilder.Builder, error) {
+	var provider txbuilder.Provider
+	if client != nil {
+		provider = &txProvider{client: client}
 	}
 
-	var trx *tx.Tx
-	switch m.typ {
-	case payload.TypeTransfer:
-		trx = tx.NewTransferTx(m.lockTime, *m.from, *m.to, m.amount, m.fee, m.memo)
-	case payload.TypeBond:
-		pub := m.pub
-		val, _ := m.client.getValidator(*m.to)
-		if val != nil {
-			// validator exists
-			pub = nil
+	builder := txbuilder.New(provider)
+	for _, op := range options {
+		err := op(builder)
+		if err != nil {
+			return nil, err
 		}
-		trx = tx.NewBondTx(m.lockTime, *m.from, *m.to, pub, m.amount, m.fee, m.memo)
-	case payload.TypeUnbond:
-		trx = tx.NewUnbondTx(m.lockTime, *m.from, m.memo)
-	case payload.TypeWithdraw:
-		trx = tx.NewWithdrawTx(m.lockTime, *m.from, *m.to, m.amount, m.fee, m.memo)
 	}
 
-	return trx, nil
+	return builder, nil
 }
 
-func (m *txBuilder) setLockTime() error {
-	if m.lockTime == 0 {
-		if m.client == nil {
-			return ErrOffline
-		}
-
-		info, err := m.client.getBlockchainInfo()
-		if err != nil {
-			return err
-		}
-		m.lockTime = info.LastBlockHeight + 1
+// build builds the transaction, and returns ErrOffline if the wallet needs
+// to be online to set the default values.
+func build(builder *txbuilder.Builder) (*tx.Tx, error) {
+	trx, err := builder.Build()
+	if errors.Is(err, txbuilder.ErrNoProvider) {
+		return nil, ErrOffline
 	}
 
-	return nil
+	return trx, err
 }
 
-func (m *txBuilder) setFee() error {
-	if m.fee == 0 {
-		if m.client == nil {
-			return ErrOffline
-		}
-		fee, err := m.client.getFee(m.amount, m.typ)
-		if err != nil {
-			return err
-		}
-		m.fee = fee
+// TxExpiry returns the last block height at which the transaction can be committed,
+// or the last block time for the timestamp lock times.
+func (w *Wallet) TxExpiry(trx *tx.Tx) uint32 {
+	params := w.params()
+	if params == nil {
+		params = param.DefaultParams()
 	}
 
-	return nil
+	return trx.Expiry(params)
 }
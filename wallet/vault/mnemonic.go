@@ -0,0 +1,161 @@
+package vault
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/tyler-smith/go-bip39"
+	"github.com/tyler-smith/go-bip39/wordlists"
+	"golang.org/x/text/unicode/norm"
+)
+
+// The languages of the BIP-39 wordlists.
+const (
+	LanguageEnglish            = "english"
+	LanguageChineseSimplified  = "chinese_simplified"
+	LanguageChineseTraditional = "chinese_traditional"
+	LanguageCzech              = "czech"
+	LanguageFrench             = "french"
+	LanguageItalian            = "italian"
+	LanguageJapanese           = "japanese"
+	LanguageKorean             = "korean"
+	LanguageSpanish            = "spanish"
+)
+
+type mnemonicWordlist struct {
+	language string
+	words    []string
+	indexes  map[string]int // The indexes of the words, in the NFKD form.
+}
+
+// mnemonicWordlists are the supported wordlists, in the order that they are detected.
+// Some words are shared between the wordlists, like English and French,
+// so the first wordlist that has all the words and a valid checksum is detected.
+var mnemonicWordlists = []*mnemonicWordlist{
+	newMnemonicWordlist(LanguageEnglish, wordlists.English),
+	newMnemonicWordlist(LanguageChineseSimplified, wordlists.ChineseSimplified),
+	newMnemonicWordlist(LanguageChineseTraditional, wordlists.ChineseTraditional),
+	newMnemonicWordlist(LanguageCzech, wordlists.Czech),
+	newMnemonicWordlist(LanguageFrench, wordlists.French),
+	newMnemonicWordlist(LanguageItalian, wordlists.Italian),
+	newMnemonicWordlist(LanguageJapanese, wordlists.Japanese),
+	newMnemonicWordlist(LanguageKorean, wordlists.Korean),
+	newMnemonicWordlist(LanguageSpanish, wordlists.Spanish),
+}
+
+func newMnemonicWordlist(language string, words []string) *mnemonicWordlist {
+	indexes := make(map[string]int, len(words))
+	for i, word := range words {
+		indexes[norm.NFKD.String(word)] = i
+	}
+
+	return &mnemonicWordlist{
+		language: language,
+		words:    words,
+		indexes:  indexes,
+	}
+}
+
+// MnemonicLanguages returns the languages that the mnemonic can be generated in.
+func MnemonicLanguages() []string {
+	languages := make([]string, 0, len(mnemonicWordlists))
+	for _, wl := range mnemonicWordlists {
+		languages = append(languages, wl.language)
+	}
+
+	return languages
+}
+
+func findMnemonicWordlist(language string) (*mnemonicWordlist, error) {
+	for _, wl := range mnemonicWordlists {
+		if wl.language == language {
+			return wl, nil
+		}
+	}
+
+	return nil, fmt.Errorf("unsupported mnemonic language: %s", language)
+}
+
+// toEnglish translates the words to the English words with the same indexes,
+// so the checksum is checked by the English wordlist.
+// It returns false if any word is not in the wordlist.
+func (wl *mnemonicWordlist) toEnglish(words []string) (string, bool) {
+	english := make([]string, 0, len(words))
+	for _, word := range words {
+		index, ok := wl.indexes[word]
+		if !ok {
+			return "", false
+		}
+		english = append(english, wordlists.English[index])
+	}
+
+	return strings.Join(english, " "), true
+}
+
+// GenerateMnemonicInLanguage generates a new mnemonic (seed phrase) in the given language, based on BIP-39.
+// The words of the Japanese mnemonics are separated by the ideographic space.
+func GenerateMnemonicInLanguage(bitSize int, language string) (string, error) {
+	wl, err := findMnemonicWordlist(language)
+	if err != nil {
+		return "", err
+	}
+
+	mnemonic, err := GenerateMnemonic(bitSize)
+	if err != nil {
+		return "", err
+	}
+
+	words := strings.Fields(mnemonic)
+	for i, word := range words {
+		words[i] = wl.words[mnemonicWordlists[0].indexes[word]]
+	}
+
+	separator := " "
+	if language == LanguageJapanese {
+		separator = "\u3000"
+	}
+
+	return strings.Join(words, separator), nil
+}
+
+// MnemonicLanguage detects the language of the mnemonic, and validates it.
+// If the mnemonic is not valid in any language, the error of the English wordlist is returned.
+func MnemonicLanguage(mnemonic string) (string, error) {
+	words := strings.Fields(norm.NFKD.String(mnemonic))
+
+	var firstErr error
+	for _, wl := range mnemonicWordlists {
+		english, ok := wl.toEnglish(words)
+		if !ok {
+			continue
+		}
+		if _, err := bip39.EntropyFromMnemonic(english); err != nil {
+			if firstErr == nil {
+				firstErr = err
+			}
+
+			continue
+		}
+
+		return wl.language, nil
+	}
+
+	if firstErr != nil {
+		return "", firstErr
+	}
+
+	_, err := bip39.EntropyFromMnemonic(mnemonic)
+
+	return "", err
+}
+
+// mnemonicSeed validates the mnemonic in any of the supported languages and returns its seed.
+// The seed is derived from the NFKD form of the mnemonic, as BIP-39 defines.
+// The NFKD form of the English mnemonics is the same as the mnemonic.
+func mnemonicSeed(mnemonic string) ([]byte, error) {
+	if _, err := MnemonicLanguage(mnemonic); err != nil {
+		return nil, err
+	}
+
+	return bip39.NewSeed(norm.NFKD.String(mnemonic), ""), nil
+}
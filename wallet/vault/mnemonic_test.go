@@ -0,0 +1,94 @@
+package vault
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/text/unicode/norm"
+)
+
+func TestGenerateMnemonicInLanguage(t *testing.T) {
+	for _, language := range MnemonicLanguages() {
+		mnemonic, err := GenerateMnemonicInLanguage(128, language)
+		require.NoError(t, err)
+
+		detected, err := MnemonicLanguage(mnemonic)
+		require.NoError(t, err, language)
+		// The shared words might be detected as an earlier language, but the checksum should be valid.
+		if language != LanguageChineseTraditional && language != LanguageFrench {
+			assert.Equal(t, language, detected)
+		}
+		assert.Len(t, strings.Fields(mnemonic), 12)
+	}
+
+	_, err := GenerateMnemonicInLanguage(128, "klingon")
+	assert.Error(t, err)
+
+	_, err = GenerateMnemonicInLanguage(127, LanguageSpanish)
+	assert.Error(t, err)
+}
+
+func TestMnemonicLanguage(t *testing.T) {
+	tests := []struct {
+		mnemonic string
+		language string
+	}{
+		{
+			"abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about",
+			LanguageEnglish,
+		},
+		{
+			"あいこくしん　あいこくしん　あいこくしん　あいこくしん　あいこくしん　あいこくしん　" +
+				"あいこくしん　あいこくしん　あいこくしん　あいこくしん　あいこくしん　あおぞら",
+			LanguageJapanese,
+		},
+		{
+			"ábaco ábaco ábaco ábaco ábaco ábaco ábaco ábaco ábaco ábaco ábaco abierto",
+			LanguageSpanish,
+		},
+	}
+	for _, test := range tests {
+		language, err := MnemonicLanguage(test.mnemonic)
+		require.NoError(t, err)
+		assert.Equal(t, test.language, language)
+
+		// The composed and the decomposed forms of the words are both accepted.
+		language, err = MnemonicLanguage(norm.NFC.String(test.mnemonic))
+		require.NoError(t, err)
+		assert.Equal(t, test.language, language)
+	}
+
+	t.Run("Invalid checksum", func(t *testing.T) {
+		_, err := MnemonicLanguage("ábaco ábaco ábaco ábaco ábaco ábaco ábaco ábaco ábaco ábaco ábaco ábaco")
+		assert.Error(t, err)
+	})
+
+	t.Run("Mixed languages", func(t *testing.T) {
+		_, err := MnemonicLanguage("ábaco abandon abandon abandon abandon abandon " +
+			"abandon abandon abandon abandon abandon about")
+		assert.Error(t, err)
+	})
+}
+
+func TestCreateVaultFromMnemonicInLanguage(t *testing.T) {
+	mnemonic, err := GenerateMnemonicInLanguage(128, LanguageSpanish)
+	require.NoError(t, err)
+
+	// The seed is derived from the NFKD form, so both forms make the same vault.
+	vlt1, err := CreateVaultFromMnemonic(norm.NFC.String(mnemonic), 21888)
+	require.NoError(t, err)
+	vlt2, err := CreateVaultFromMnemonic(norm.NFD.String(mnemonic), 21888)
+	require.NoError(t, err)
+
+	addr1, err := vlt1.NewBLSAccountAddress("")
+	require.NoError(t, err)
+	addr2, err := vlt2.NewBLSAccountAddress("")
+	require.NoError(t, err)
+	assert.Equal(t, addr1, addr2)
+
+	prv, err := vlt1.PrivateKeys("", []string{addr1})
+	require.NoError(t, err)
+	assert.Len(t, prv, 1)
+}
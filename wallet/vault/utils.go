@@ -19,9 +19,9 @@ func GenerateMnemonic(bitSize int) (string, error) {
 	return mnemonic, nil
 }
 
-// CheckMnemonic validates a mnemonic (seed phrase) based on BIP-39.
+// CheckMnemonic validates a mnemonic (seed phrase) based on BIP-39, in any of the supported languages.
 func CheckMnemonic(mnemonic string) error {
-	_, err := bip39.EntropyFromMnemonic(mnemonic)
+	_, err := MnemonicLanguage(mnemonic)
 
 	return err
 }
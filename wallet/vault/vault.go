@@ -10,7 +10,6 @@ import (
 	"github.com/pactus-project/pactus/crypto/bls/hdkeychain"
 	"github.com/pactus-project/pactus/wallet/addresspath"
 	"github.com/pactus-project/pactus/wallet/encrypter"
-	"github.com/tyler-smith/go-bip39"
 	"golang.org/x/exp/slices"
 )
 
@@ -82,7 +81,7 @@ type purposeBLS struct {
 }
 
 func CreateVaultFromMnemonic(mnemonic string, coinType uint32) (*Vault, error) {
-	seed, err := bip39.NewSeedWithErrorChecking(mnemonic, "")
+	seed, err := mnemonicSeed(mnemonic)
 	if err != nil {
 		return nil, err
 	}
@@ -381,7 +380,7 @@ func (v *Vault) PrivateKeys(password string, addrs []string) ([]crypto.PrivateKe
 
 		switch path.Purpose() {
 		case H(PurposeBLS12381):
-			seed, err := bip39.NewSeedWithErrorChecking(keyStore.MasterNode.Mnemonic, "")
+			seed, err := mnemonicSeed(keyStore.MasterNode.Mnemonic)
 			if err != nil {
 				return nil, err
 			}
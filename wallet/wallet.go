@@ -1,6 +1,8 @@
 package wallet
 
 import (
+	"context"
+
 	_ "embed"
 	"encoding/json"
 	"errors"
@@ -13,9 +15,13 @@ import (
 	"github.com/pactus-project/pactus/genesis"
 	"github.com/pactus-project/pactus/types/tx"
 	"github.com/pactus-project/pactus/types/tx/payload"
+	"github.com/pactus-project/pactus/types/validator"
 	"github.com/pactus-project/pactus/util"
 	"github.com/pactus-project/pactus/wallet/vault"
 	pactus "github.com/pactus-project/pactus/www/grpc/gen/go"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
 )
 
 type Wallet struct {
@@ -38,6 +44,24 @@ func GenerateMnemonic(entropy int) (string, error) {
 	return vault.GenerateMnemonic(entropy)
 }
 
+// LanguageEnglish is the default language of the mnemonics.
+const LanguageEnglish = vault.LanguageEnglish
+
+// GenerateMnemonicInLanguage is a wrapper for `vault.GenerateMnemonicInLanguage`.
+func GenerateMnemonicInLanguage(entropy int, language string) (string, error) {
+	return vault.GenerateMnemonicInLanguage(entropy, language)
+}
+
+// MnemonicLanguage is a wrapper for `vault.MnemonicLanguage`.
+func MnemonicLanguage(mnemonic string) (string, error) {
+	return vault.MnemonicLanguage(mnemonic)
+}
+
+// MnemonicLanguages is a wrapper for `vault.MnemonicLanguages`.
+func MnemonicLanguages() []string {
+	return vault.MnemonicLanguages()
+}
+
 // CheckMnemonic is a wrapper for `vault.CheckMnemonic`.
 func CheckMnemonic(mnemonic string) error {
 	return vault.CheckMnemonic(mnemonic)
@@ -66,6 +90,15 @@ func Open(walletPath string, offline bool) (*Wallet, error) {
 // Create creates a wallet from mnemonic (seed phrase) and save it at the
 // given path.
 func Create(walletPath, mnemonic, password string, chain genesis.ChainType) (*Wallet, error) {
+	return CreateWithHRP(walletPath, mnemonic, password, chain, crypto.HRP{})
+}
+
+// CreateWithHRP creates a wallet like Create, but for a private network that has
+// custom human-readable parts for the addresses and keys.
+// The custom HRP is kept in the wallet, and it is only supported for the local networks.
+func CreateWithHRP(walletPath, mnemonic, password string, chain genesis.ChainType,
+	customHRP crypto.HRP,
+) (*Wallet, error) {
 	walletPath = util.MakeAbs(walletPath)
 	if util.PathExists(walletPath) {
 		return nil, ExitsError{
@@ -77,19 +110,32 @@ func Create(walletPath, mnemonic, password string, chain genesis.ChainType) (*Wa
 	switch chain {
 	case genesis.Mainnet:
 		coinType = 21888
-	case genesis.Testnet, genesis.Localnet:
+	case genesis.Testnet, genesis.Localnet, genesis.Simnet:
 		coinType = 21777
 	default:
 		return nil, ErrInvalidNetwork
 	}
 
+	if err := networkHRP(chain, &customHRP).BasicCheck(); err != nil {
+		return nil, err
+	}
+	if customHRP == networkHRP(chain, nil) {
+		customHRP = crypto.HRP{}
+	}
+	if !customHRP.IsEmpty() && chain != genesis.Localnet && chain != genesis.Simnet {
+		return nil, ErrInvalidNetwork
+	}
+
 	store := &store{
-		Version:   1,
+		Version:   VersionLatest,
 		UUID:      uuid.New(),
 		CreatedAt: time.Now().Round(time.Second).UTC(),
 		Network:   chain,
 		Vault:     nil,
 	}
+	if !customHRP.IsEmpty() {
+		store.HRP = &customHRP
+	}
 	wallet, err := newWallet(walletPath, store, true)
 	if err != nil {
 		return nil, err
@@ -108,12 +154,9 @@ func Create(walletPath, mnemonic, password string, chain genesis.ChainType) (*Wa
 }
 
 func newWallet(walletPath string, store *store, offline bool) (*Wallet, error) {
-	if !store.Network.IsMainnet() {
-		crypto.AddressHRP = "tpc"
-		crypto.PublicKeyHRP = "tpublic"
-		crypto.PrivateKeyHRP = "tsecret"
-		crypto.XPublicKeyHRP = "txpublic"
-		crypto.XPrivateKeyHRP = "txsecret"
+	setNetworkHRP(store.Network, store.HRP)
+	if domain, ok := networkSignDomain(store.Network, store.ChainID); ok {
+		crypto.SetSignDomain(domain)
 	}
 
 	w := &Wallet{
@@ -131,18 +174,94 @@ func newWallet(walletPath string, store *store, offline bool) (*Wallet, error) {
 	return w, nil
 }
 
-func (w *Wallet) Connect(addr string) error {
-	return w.tryToConnect(addr)
+// setNetworkHRP sets the human-readable parts of the addresses and keys for the network.
+func setNetworkHRP(network genesis.ChainType, customHRP *crypto.HRP) {
+	if !network.IsMainnet() {
+		crypto.SetHRP(networkHRP(network, customHRP))
+	}
 }
 
-func (w *Wallet) tryToConnect(addr string) error {
-	client, err := newGRPCClient(addr)
-	if err != nil {
+// networkHRP returns the human-readable parts of the addresses and keys for the network.
+// The parts that are not customized are set from the network defaults.
+func networkHRP(network genesis.ChainType, customHRP *crypto.HRP) crypto.HRP {
+	def := crypto.TestnetHRP
+	if network.IsMainnet() {
+		def = crypto.MainnetHRP
+	}
+	if customHRP == nil {
+		return def
+	}
+
+	return customHRP.WithDefaults(def)
+}
+
+// networkSignDomain returns the sign domain of the network.
+// The chain ID of a local network is derived from its genesis, so it is known only if it is kept in the wallet.
+// Otherwise, it returns false and the sign domain that is set by the caller is used.
+func networkSignDomain(network genesis.ChainType, chainID string) (crypto.SignDomain, bool) {
+	switch network {
+	case genesis.Mainnet:
+		return genesis.MainnetGenesis().SignDomain(), true
+	case genesis.Testnet:
+		return genesis.TestnetGenesis().SignDomain(), true
+	default:
+		if chainID == "" {
+			return crypto.SignDomain{}, false
+		}
+
+		return crypto.SignDomain{ChainID: chainID}, true
+	}
+}
+
+// SetChainID keeps the chain ID of a local network in the wallet, so the transactions are signed for it.
+// The chain ID of the mainnet and testnet are known, so it is only supported for the local networks.
+func (w *Wallet) SetChainID(chainID string) error {
+	if w.store.Network != genesis.Localnet && w.store.Network != genesis.Simnet {
+		return ErrInvalidNetwork
+	}
+
+	domain := crypto.SignDomain{ChainID: chainID}
+	if err := domain.BasicCheck(); err != nil {
 		return err
 	}
 
-	// Check if client is responding
-	_, err = client.getBlockchainInfo()
+	w.store.ChainID = chainID
+	crypto.SetSignDomain(domain)
+
+	return nil
+}
+
+func (w *Wallet) Connect(addr string) error {
+	return w.tryToConnect([]string{addr}, insecure.NewCredentials())
+}
+
+// ConnectWithCredentials connects the wallet to the given node
+// using the provided transport credentials, like TLS.
+func (w *Wallet) ConnectWithCredentials(addr string, creds credentials.TransportCredentials) error {
+	return w.tryToConnect([]string{addr}, creds)
+}
+
+// ConnectToNodes connects the wallet to multiple nodes, using the provided transport credentials.
+// The nodes that don't respond are skipped, and the rest should be on the same chain,
+// otherwise an InconsistentNodesError is returned.
+// When a node goes down or times out, the calls fail over to the next node.
+func (w *Wallet) ConnectToNodes(addrs []string, creds credentials.TransportCredentials) error {
+	return w.tryToConnect(addrs, creds)
+}
+
+// ServerAddr returns the address of the node that the wallet is using, or an empty string if it is offline.
+func (w *Wallet) ServerAddr() string {
+	if w.client == nil || w.client.conn == nil {
+		return ""
+	}
+
+	return w.client.conn.ActiveAddress()
+}
+
+func (w *Wallet) tryToConnect(addrs []string, creds credentials.TransportCredentials,
+	opts ...grpc.DialOption,
+) error {
+	client, err := newGRPCClient(addrs, creds, opts...)
 	if err != nil {
 		return err
 	}
@@ -177,7 +296,7 @@ func (w *Wallet) connectToRandomServer() error {
 		// testnet
 		netServers = serversInfo["testnet"]
 
-	case genesis.Localnet:
+	case genesis.Localnet, genesis.Simnet:
 		// localnet
 		netServers = []serverInfo{{IP: "localhost:50052"}}
 
@@ -188,7 +307,7 @@ func (w *Wallet) connectToRandomServer() error {
 	for i := 0; i < 3; i++ {
 		n := util.RandInt32(int32(len(netServers)))
 		serverInfo := netServers[n]
-		err := w.tryToConnect(serverInfo.IP)
+		err := w.tryToConnect([]string{serverInfo.IP}, insecure.NewCredentials())
 		if err == nil {
 			return nil
 		}
@@ -221,7 +340,7 @@ func (w *Wallet) Balance(addrStr string) (int64, error) {
 		return 0, ErrOffline
 	}
 
-	acc, _ := w.client.getAccount(addr)
+	acc, _ := w.client.getAccount(context.Background(), addr)
 	if acc != nil {
 		return acc.Balance, nil
 	}
@@ -240,7 +359,7 @@ func (w *Wallet) Stake(addrStr string) (int64, error) {
 		return 0, ErrOffline
 	}
 
-	val, _ := w.client.getValidator(addr)
+	val, _ := w.client.getValidator(context.Background(), addr)
 	if val != nil {
 		return val.Stake, nil
 	}
@@ -252,37 +371,35 @@ func (w *Wallet) Stake(addrStr string) (int64, error) {
 func (w *Wallet) MakeTransferTx(sender, receiver string, amount int64,
 	options ...TxOption,
 ) (*tx.Tx, error) {
-	maker, err := newTxBuilder(w.client, options...)
+	builder, err := newTxBuilder(w.client, options...)
 	if err != nil {
 		return nil, err
 	}
-	err = maker.setFromAddr(sender)
+	from, err := crypto.AddressFromString(sender)
 	if err != nil {
 		return nil, err
 	}
-	err = maker.setToAddress(receiver)
+	to, err := crypto.AddressFromString(receiver)
 	if err != nil {
 		return nil, err
 	}
-	maker.amount = amount
-	maker.typ = payload.TypeTransfer
 
-	return maker.build()
+	return build(builder.Transfer(from, to, amount))
 }
 
 // MakeBondTx creates a new bond transaction based on the given parameters.
 func (w *Wallet) MakeBondTx(sender, receiver, pubKey string, amount int64,
 	options ...TxOption,
 ) (*tx.Tx, error) {
-	maker, err := newTxBuilder(w.client, options...)
+	builder, err := newTxBuilder(w.client, options...)
 	if err != nil {
 		return nil, err
 	}
-	err = maker.setFromAddr(sender)
+	from, err := crypto.AddressFromString(sender)
 	if err != nil {
 		return nil, err
 	}
-	err = maker.setToAddress(receiver)
+	to, err := crypto.AddressFromString(receiver)
 	if err != nil {
 		return nil, err
 	}
@@ -293,31 +410,106 @@ func (w *Wallet) MakeBondTx(sender, receiver, pubKey string, amount int64,
 			pubKey = info.PublicKey
 		}
 	}
+	if pubKey == "" && w.client != nil {
+		// The public key might be revealed by the prior transactions on the chain
+		pubKey, _ = w.client.getPublicKey(context.Background(), to)
+	}
+	var pub *bls.PublicKey
 	if pubKey != "" {
-		maker.pub, err = bls.PublicKeyFromString(pubKey)
+		pub, err = bls.PublicKeyFromString(pubKey)
 		if err != nil {
 			return nil, err
 		}
 	}
-	maker.amount = amount
-	maker.typ = payload.TypeBond
+	if w.client != nil {
+		val, _ := w.client.getValidator(context.Background(), to)
+		if val != nil {
+			// validator exists
+			pub = nil
+		}
+	}
+
+	return build(builder.Bond(from, to, pub, amount))
+}
+
+// MakeDelegateTx creates a delegate transaction, which stakes the amount from the delegator account
+// to a validator of this wallet. The stake can only be withdrawn to the delegator.
+// It is called by the operator, who approves the transaction by the validator key.
+// The returned transaction is partially signed: the delegator should sign it by `SignTransaction`.
+func (w *Wallet) MakeDelegateTx(password, delegator, validator string, amount int64,
+	options ...TxOption,
+) (*tx.Tx, error) {
+	builder, err := newTxBuilder(w.client, options...)
+	if err != nil {
+		return nil, err
+	}
+	from, err := crypto.AddressFromString(delegator)
+	if err != nil {
+		return nil, err
+	}
+	to, err := crypto.AddressFromString(validator)
+	if err != nil {
+		return nil, err
+	}
+	if !to.IsValidatorAddress() {
+		return nil, ErrNotValidatorAddress
+	}
+	prv, err := w.PrivateKey(password, validator)
+	if err != nil {
+		return nil, err
+	}
+	valPrv := prv.(*bls.PrivateKey)
+
+	pub := valPrv.PublicKeyNative()
+	if w.client != nil {
+		val, _ := w.client.getValidator(context.Background(), to)
+		if val != nil {
+			// validator exists
+			pub = nil
+		}
+	}
+	if pub != nil {
+		builder.ProofOfPossession(valPrv.ProofOfPossession())
+	}
+	builder.Approve(func(pld payload.Payload, lockTime uint32) error {
+		delegatePld := pld.(*payload.DelegatePayload)
+		delegatePld.OperatorSignature = valPrv.SignNative(delegatePld.ApprovalSignBytes(lockTime))
+
+		return nil
+	})
 
-	return maker.build()
+	return build(builder.Delegate(from, to, pub, amount))
 }
 
 // MakeUnbondTx creates a new unbond transaction based on the given parameters.
 func (w *Wallet) MakeUnbondTx(addr string, opts ...TxOption) (*tx.Tx, error) {
-	maker, err := newTxBuilder(w.client, opts...)
+	builder, err := newTxBuilder(w.client, opts...)
+	if err != nil {
+		return nil, err
+	}
+	val, err := crypto.AddressFromString(addr)
+	if err != nil {
+		return nil, err
+	}
+
+	return build(builder.Unbond(val))
+}
+
+// MakeRewardSplitTx creates a new reward split transaction based on the given parameters.
+// An empty split removes the current reward split of the validator.
+func (w *Wallet) MakeRewardSplitTx(addr string, split *validator.RewardSplit,
+	opts ...TxOption,
+) (*tx.Tx, error) {
+	builder, err := newTxBuilder(w.client, opts...)
 	if err != nil {
 		return nil, err
 	}
-	err = maker.setFromAddr(addr)
+	val, err := crypto.AddressFromString(addr)
 	if err != nil {
 		return nil, err
 	}
-	maker.typ = payload.TypeUnbond
 
-	return maker.build()
+	return build(builder.RewardSplit(val, split))
 }
 
 // MakeWithdrawTx creates a new withdraw transaction based on the given
@@ -325,22 +517,20 @@ func (w *Wallet) MakeUnbondTx(addr string, opts ...TxOption) (*tx.Tx, error) {
 func (w *Wallet) MakeWithdrawTx(sender, receiver string, amount int64,
 	options ...TxOption,
 ) (*tx.Tx, error) {
-	maker, err := newTxBuilder(w.client, options...)
+	builder, err := newTxBuilder(w.client, options...)
 	if err != nil {
 		return nil, err
 	}
-	err = maker.setFromAddr(sender)
+	from, err := crypto.AddressFromString(sender)
 	if err != nil {
 		return nil, err
 	}
-	err = maker.setToAddress(receiver)
+	to, err := crypto.AddressFromString(receiver)
 	if err != nil {
 		return nil, err
 	}
-	maker.amount = amount
-	maker.typ = payload.TypeWithdraw
 
-	return maker.build()
+	return build(builder.Withdraw(from, to, amount))
 }
 
 func (w *Wallet) SignTransaction(password string, trx *tx.Tx) error {
@@ -349,6 +539,10 @@ func (w *Wallet) SignTransaction(password string, trx *tx.Tx) error {
 		return err
 	}
 
+	if err := w.addProofOfPossession(password, trx); err != nil {
+		return err
+	}
+
 	sig := prv.Sign(trx.SignBytes())
 	trx.SetSignature(sig)
 	trx.SetPublicKey(prv.PublicKey())
@@ -356,12 +550,38 @@ func (w *Wallet) SignTransaction(password string, trx *tx.Tx) error {
 	return nil
 }
 
+// addProofOfPossession adds the proof of possession to the bond transaction of a new validator,
+// if the validator belongs to this wallet.
+// The proof is a part of the sign bytes, so it should be added before signing.
+func (w *Wallet) addProofOfPossession(password string, trx *tx.Tx) error {
+	if !trx.IsBondTx() {
+		return nil
+	}
+	pld := trx.Payload().(*payload.BondPayload)
+	if pld.PublicKey == nil || pld.ProofOfPossession != nil {
+		return nil
+	}
+	if w.store.Vault.AddressInfo(pld.To.String()) == nil {
+		return nil
+	}
+
+	valPrv, err := w.PrivateKey(password, pld.To.String())
+	if err != nil {
+		return err
+	}
+	if blsPrv, ok := valPrv.(*bls.PrivateKey); ok {
+		pld.ProofOfPossession = blsPrv.ProofOfPossession()
+	}
+
+	return nil
+}
+
 func (w *Wallet) BroadcastTransaction(trx *tx.Tx) (string, error) {
 	if w.client == nil {
 		return "", ErrOffline
 	}
 
-	id, err := w.client.sendTx(trx)
+	id, err := w.client.sendTx(context.Background(), trx)
 	if err != nil {
 		return "", err
 	}
@@ -373,7 +593,22 @@ func (w *Wallet) BroadcastTransaction(trx *tx.Tx) (string, error) {
 }
 
 func (w *Wallet) CalculateFee(amount int64, payloadType payload.Type) (int64, error) {
-	return w.client.getFee(amount, payloadType)
+	return w.client.getFee(context.Background(), amount, payloadType)
+}
+
+// ResolveName resolves a registered name, like `alice.pac`, to its address
+// by the name service of the connected node.
+func (w *Wallet) ResolveName(name string) (string, error) {
+	if w.client == nil {
+		return "", ErrOffline
+	}
+
+	addr, err := w.client.resolveName(context.Background(), name)
+	if err != nil {
+		return "", err
+	}
+
+	return addr.String(), nil
 }
 
 func (w *Wallet) UpdatePassword(oldPassword, newPassword string) error {
@@ -458,7 +693,7 @@ func (w *Wallet) AddTransaction(id tx.ID) error {
 		return ErrHistoryExists
 	}
 
-	trxRes, err := w.client.getTransaction(id)
+	trxRes, err := w.client.getTransaction(context.Background(), id)
 	if err != nil {
 		return err
 	}
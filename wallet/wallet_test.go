@@ -8,13 +8,17 @@ import (
 	"path"
 	"testing"
 
+	"github.com/pactus-project/pactus/crypto"
 	"github.com/pactus-project/pactus/genesis"
+	"github.com/pactus-project/pactus/types/tx"
 	"github.com/pactus-project/pactus/types/tx/payload"
+	"github.com/pactus-project/pactus/types/validator"
 	"github.com/pactus-project/pactus/util"
 	"github.com/pactus-project/pactus/util/errors"
 	"github.com/pactus-project/pactus/util/testsuite"
 	pactus "github.com/pactus-project/pactus/www/grpc/gen/go"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/test/bufconn"
@@ -85,6 +89,13 @@ func setup(t *testing.T) *testData {
 	tBlockchainInfoResponse = nil
 	tAccountResponse = nil
 	tValidatorResponse = nil
+	tPublicKeyResponse = nil
+	tAccountsResponse = nil
+	tScanBlocksResponse = nil
+	tBlockResponses = nil
+	tTxPoolChangesResponse = nil
+	tFeeFraction = 0
+	tMinimumFee = 0
 
 	return &testData{
 		TestSuite: ts,
@@ -127,6 +138,82 @@ func TestOpenWallet(t *testing.T) {
 	})
 }
 
+func TestCreateWithHRP(t *testing.T) {
+	current := crypto.CurrentHRP()
+	t.Cleanup(func() { crypto.SetHRP(current) })
+
+	mnemonic, _ := GenerateMnemonic(128)
+	customHRP := crypto.HRP{Address: "mynet"}
+
+	t.Run("Not supported on Mainnet", func(t *testing.T) {
+		_, err := CreateWithHRP(util.TempFilePath(), mnemonic, "", genesis.Mainnet, customHRP)
+		assert.ErrorIs(t, err, ErrInvalidNetwork)
+	})
+
+	t.Run("Invalid HRP", func(t *testing.T) {
+		_, err := CreateWithHRP(util.TempFilePath(), mnemonic, "", genesis.Localnet,
+			crypto.HRP{Address: "tsecret"})
+		assert.ErrorIs(t, err, crypto.DuplicatedHRPError("tsecret"))
+	})
+
+	t.Run("Default HRP is not kept", func(t *testing.T) {
+		wlt, err := CreateWithHRP(util.TempFilePath(), mnemonic, "", genesis.Testnet, crypto.TestnetHRP)
+		assert.NoError(t, err)
+		assert.Nil(t, wlt.store.HRP)
+	})
+
+	t.Run("Ok", func(t *testing.T) {
+		wlt, err := CreateWithHRP(util.TempFilePath(), mnemonic, "", genesis.Localnet, customHRP)
+		assert.NoError(t, err)
+		assert.Equal(t, &customHRP, wlt.store.HRP)
+
+		addr, err := wlt.NewBLSAccountAddress("")
+		assert.NoError(t, err)
+		assert.Equal(t, "mynet1", addr[:6])
+		assert.NoError(t, wlt.Save())
+
+		// Opening the wallet sets the custom HRP again.
+		crypto.SetHRP(crypto.MainnetHRP)
+		opened, err := Open(wlt.Path(), true)
+		assert.NoError(t, err)
+		assert.Equal(t, "mynet", crypto.AddressHRP)
+		assert.Equal(t, crypto.TestnetHRP.PublicKey, crypto.PublicKeyHRP)
+		assert.NotNil(t, opened.AddressInfo(addr))
+	})
+}
+
+func TestSetChainID(t *testing.T) {
+	current := crypto.CurrentSignDomain()
+	t.Cleanup(func() { crypto.SetSignDomain(current) })
+
+	mnemonic, _ := GenerateMnemonic(128)
+
+	t.Run("Not supported on Mainnet", func(t *testing.T) {
+		wlt, err := Create(util.TempFilePath(), mnemonic, "", genesis.Mainnet)
+		assert.NoError(t, err)
+		assert.Equal(t, genesis.MainnetGenesis().SignDomain(), crypto.CurrentSignDomain())
+		assert.ErrorIs(t, wlt.SetChainID("mynet"), ErrInvalidNetwork)
+	})
+
+	t.Run("Ok", func(t *testing.T) {
+		crypto.SetSignDomain(crypto.SignDomain{ChainID: "caller"})
+		wlt, err := Create(util.TempFilePath(), mnemonic, "", genesis.Localnet)
+		assert.NoError(t, err)
+
+		// The wallet doesn't know the chain ID yet, so the sign domain of the caller is kept.
+		assert.Equal(t, crypto.SignDomain{ChainID: "caller"}, crypto.CurrentSignDomain())
+
+		assert.NoError(t, wlt.SetChainID("mynet"))
+		assert.NoError(t, wlt.Save())
+
+		// Opening the wallet sets the chain ID again.
+		crypto.SetSignDomain(crypto.SignDomain{})
+		_, err = Open(wlt.Path(), true)
+		assert.NoError(t, err)
+		assert.Equal(t, crypto.SignDomain{ChainID: "mynet"}, crypto.CurrentSignDomain())
+	})
+}
+
 func TestRecoverWallet(t *testing.T) {
 	td := setup(t)
 
@@ -232,6 +319,20 @@ func TestBalance(t *testing.T) {
 	assert.Equal(t, amt, int64(1))
 }
 
+func TestResolveName(t *testing.T) {
+	td := setup(t)
+
+	addr := td.RandAccAddress()
+	tNames = map[string]string{"alice.pac": addr.String()}
+
+	resolved, err := td.wallet.ResolveName("alice.pac")
+	assert.NoError(t, err)
+	assert.Equal(t, addr.String(), resolved)
+
+	_, err = td.wallet.ResolveName("bob.pac")
+	assert.Error(t, err)
+}
+
 func TestStake(t *testing.T) {
 	td := setup(t)
 
@@ -268,6 +369,64 @@ func TestSigningTx(t *testing.T) {
 	assert.Equal(t, trx.ID().String(), id)
 }
 
+func TestTxExpiry(t *testing.T) {
+	td := setup(t)
+
+	sender, _ := td.wallet.NewBLSAccountAddress("testing addr")
+	ttl := genesis.MainnetGenesis().Params().TransactionToLiveInterval
+	lastHeight := ttl + td.RandHeight()
+	tBlockchainInfoResponse = &pactus.GetBlockchainInfoResponse{LastBlockHeight: lastHeight}
+
+	t.Run("Default expiry", func(t *testing.T) {
+		trx, err := td.wallet.MakeTransferTx(sender, td.RandAccAddress().String(), td.RandAmount(),
+			OptionFee(1e6))
+		require.NoError(t, err)
+
+		assert.Equal(t, lastHeight+1+ttl, td.wallet.TxExpiry(trx))
+	})
+
+	t.Run("Expiry option", func(t *testing.T) {
+		trx, err := td.wallet.MakeTransferTx(sender, td.RandAccAddress().String(), td.RandAmount(),
+			OptionFee(1e6), OptionExpiry(360))
+		require.NoError(t, err)
+
+		assert.Equal(t, lastHeight+1+360, td.wallet.TxExpiry(trx))
+	})
+}
+
+func TestSigningBondTx(t *testing.T) {
+	td := setup(t)
+
+	sender, _ := td.wallet.NewBLSAccountAddress("testing addr")
+	amount := td.RandInt64(10000)
+	opts := []TxOption{
+		OptionFee(util.CoinToChange(10)),
+		OptionLockTime(td.RandHeight()),
+	}
+
+	t.Run("validator belongs to the wallet", func(t *testing.T) {
+		receiver, _ := td.wallet.NewValidatorAddress("validator-address")
+		trx, err := td.wallet.MakeBondTx(sender, receiver, "", amount, opts...)
+		require.NoError(t, err)
+		require.NoError(t, td.wallet.SignTransaction(td.password, trx))
+
+		pld := trx.Payload().(*payload.BondPayload)
+		require.NotNil(t, pld.ProofOfPossession)
+		assert.NoError(t, pld.PublicKey.VerifyProofOfPossession(pld.ProofOfPossession))
+		assert.NoError(t, trx.BasicCheck())
+	})
+
+	t.Run("validator doesn't belong to the wallet", func(t *testing.T) {
+		receiver := td.RandValKey()
+		trx, err := td.wallet.MakeBondTx(sender, receiver.Address().String(),
+			receiver.PublicKey().String(), amount, opts...)
+		require.NoError(t, err)
+		require.NoError(t, td.wallet.SignTransaction(td.password, trx))
+
+		assert.Nil(t, trx.Payload().(*payload.BondPayload).ProofOfPossession)
+	})
+}
+
 func TestMakeTransferTx(t *testing.T) {
 	td := setup(t)
 
@@ -324,7 +483,7 @@ func TestMakeTransferTx(t *testing.T) {
 func TestMakeBondTx(t *testing.T) {
 	td := setup(t)
 
-	sender, _ := td.wallet.NewValidatorAddress("testing addr")
+	sender, _ := td.wallet.NewBLSAccountAddress("testing addr")
 	receiver := td.RandValKey()
 	amount := td.RandInt64(10000)
 
@@ -375,6 +534,15 @@ func TestMakeBondTx(t *testing.T) {
 			assert.Equal(t, trx.Payload().(*payload.BondPayload).PublicKey.String(), receiver.PublicKey().String())
 		})
 
+		t.Run("validator doesn't exist and public key is revealed on the chain", func(t *testing.T) {
+			tPublicKeyResponse = &pactus.GetPublicKeyResponse{PublicKey: receiver.PublicKey().String()}
+			defer func() { tPublicKeyResponse = nil }()
+
+			trx, err := td.wallet.MakeBondTx(sender, receiver.Address().String(), "", amount)
+			assert.NoError(t, err)
+			assert.Equal(t, trx.Payload().(*payload.BondPayload).PublicKey.String(), receiver.PublicKey().String())
+		})
+
 		t.Run("validator exists and public key not set", func(t *testing.T) {
 			tValidatorResponse = &pactus.GetValidatorResponse{Validator: &pactus.ValidatorInfo{}}
 			trx, err := td.wallet.MakeBondTx(sender, receiver.Address().String(), "", amount)
@@ -445,6 +613,63 @@ func TestMakeBondTx(t *testing.T) {
 	})
 }
 
+func TestMakeDelegateTx(t *testing.T) {
+	td := setup(t)
+
+	delegator, _ := td.wallet.NewBLSAccountAddress("delegator")
+	validator, _ := td.wallet.NewValidatorAddress("operator")
+	validatorInfo := td.wallet.AddressInfo(validator)
+	amount := td.RandInt64(10000)
+	lockTime := td.RandHeight()
+	opts := []TxOption{
+		OptionFee(util.CoinToChange(10)),
+		OptionLockTime(lockTime),
+	}
+
+	t.Run("validator doesn't exist", func(t *testing.T) {
+		tValidatorResponse = nil
+		trx, err := td.wallet.MakeDelegateTx(td.password, delegator, validator, amount, opts...)
+		require.NoError(t, err)
+
+		pld := trx.Payload().(*payload.DelegatePayload)
+		assert.Equal(t, validatorInfo.PublicKey, pld.PublicKey.String())
+		assert.NoError(t, pld.PublicKey.VerifyProofOfPossession(pld.ProofOfPossession))
+		assert.NoError(t, pld.PublicKey.Verify(pld.ApprovalSignBytes(lockTime), pld.OperatorSignature))
+		assert.Equal(t, delegator, trx.Payload().Signer().String())
+
+		// The delegator signs the partially signed transaction.
+		bs, err := trx.Bytes()
+		require.NoError(t, err)
+		trx2, err := tx.FromBytes(bs)
+		require.NoError(t, err)
+		require.NoError(t, td.wallet.SignTransaction(td.password, trx2))
+		assert.NoError(t, trx2.BasicCheck())
+	})
+
+	t.Run("validator exists", func(t *testing.T) {
+		tValidatorResponse = &pactus.GetValidatorResponse{Validator: &pactus.ValidatorInfo{}}
+		defer func() { tValidatorResponse = nil }()
+
+		trx, err := td.wallet.MakeDelegateTx(td.password, delegator, validator, amount, opts...)
+		require.NoError(t, err)
+
+		pld := trx.Payload().(*payload.DelegatePayload)
+		assert.Nil(t, pld.PublicKey)
+		assert.Nil(t, pld.ProofOfPossession)
+		assert.NotNil(t, pld.OperatorSignature)
+	})
+
+	t.Run("not a validator address", func(t *testing.T) {
+		_, err := td.wallet.MakeDelegateTx(td.password, delegator, delegator, amount, opts...)
+		assert.ErrorIs(t, err, ErrNotValidatorAddress)
+	})
+
+	t.Run("validator is not stored in wallet", func(t *testing.T) {
+		_, err := td.wallet.MakeDelegateTx(td.password, delegator, td.RandValAddress().String(), amount, opts...)
+		assert.Error(t, err)
+	})
+}
+
 func TestMakeUnbondTx(t *testing.T) {
 	td := setup(t)
 
@@ -493,10 +718,52 @@ func TestMakeUnbondTx(t *testing.T) {
 	})
 }
 
+func TestMakeRewardSplitTx(t *testing.T) {
+	td := setup(t)
+
+	valAddr, _ := td.wallet.NewValidatorAddress("testing addr")
+	split := &validator.RewardSplit{
+		Shares: []validator.RewardShare{
+			{Address: td.RandAccAddress(), Percent: 80},
+		},
+	}
+
+	t.Run("set parameters manually", func(t *testing.T) {
+		lockTime := td.RandHeight()
+		opts := []TxOption{
+			OptionFee(util.CoinToChange(10)),
+			OptionLockTime(lockTime),
+			OptionMemo("test"),
+		}
+
+		trx, err := td.wallet.MakeRewardSplitTx(valAddr, split, opts...)
+		assert.NoError(t, err)
+		assert.True(t, trx.IsRewardSplitTx())
+		assert.Equal(t, util.CoinToChange(10), trx.Fee())
+		assert.Equal(t, lockTime, trx.LockTime())
+		assert.Equal(t, *split, trx.Payload().(*payload.RewardSplitPayload).Split)
+	})
+
+	t.Run("invalid split", func(t *testing.T) {
+		invSplit := &validator.RewardSplit{
+			Shares: []validator.RewardShare{
+				{Address: td.RandAccAddress(), Percent: 101},
+			},
+		}
+		_, err := td.wallet.MakeRewardSplitTx(valAddr, invSplit, OptionLockTime(td.RandHeight()))
+		assert.Error(t, err)
+	})
+
+	t.Run("invalid validator address", func(t *testing.T) {
+		_, err := td.wallet.MakeRewardSplitTx("invalid_addr_string", split)
+		assert.Equal(t, errors.ErrInvalidAddress, errors.Code(err))
+	})
+}
+
 func TestMakeWithdrawTx(t *testing.T) {
 	td := setup(t)
 
-	sender, _ := td.wallet.NewBLSAccountAddress("testing addr")
+	sender, _ := td.wallet.NewValidatorAddress("testing addr")
 	receiver, _ := td.wallet.NewBLSAccountAddress("testing addr")
 	amount := td.RandInt64(10000)
 
@@ -510,7 +777,7 @@ func TestMakeWithdrawTx(t *testing.T) {
 
 		trx, err := td.wallet.MakeWithdrawTx(sender, receiver, amount, opts...)
 		assert.NoError(t, err)
-		assert.Equal(t, trx.Fee(), util.CoinToChange(10)) // Fee for unbond transaction is zero
+		assert.Equal(t, trx.Fee(), util.CoinToChange(10))
 		assert.Equal(t, trx.LockTime(), lockTime)
 		assert.Equal(t, trx.Memo(), "test")
 	})
@@ -540,7 +807,7 @@ func TestMakeWithdrawTx(t *testing.T) {
 	t.Run("unable to get the blockchain info", func(t *testing.T) {
 		tBlockchainInfoResponse = nil
 
-		_, err := td.wallet.MakeWithdrawTx(td.RandAccAddress().String(), receiver, amount)
+		_, err := td.wallet.MakeWithdrawTx(td.RandValAddress().String(), receiver, amount)
 		assert.Equal(t, errors.Code(err), errors.ErrGeneric)
 	})
 }
@@ -0,0 +1,213 @@
+package wallet
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pactus-project/pactus/crypto"
+	"github.com/pactus-project/pactus/types/tx"
+	"github.com/pactus-project/pactus/types/tx/payload"
+	"github.com/pactus-project/pactus/util"
+	"github.com/pactus-project/pactus/util/i18n"
+)
+
+// WarningCode identifies the kind of a transaction warning.
+type WarningCode int
+
+const (
+	// WarningDrainsAccount warns that the transaction empties the account,
+	// while the validators of the wallet are still bonded.
+	WarningDrainsAccount WarningCode = 1
+
+	// WarningFutureLockTime warns that the transaction can't be committed until its lock time,
+	// and the funds are stranded in the transaction pool until then.
+	WarningFutureLockTime WarningCode = 2
+
+	// WarningForeignValidator warns that the stake is bonded to a validator that is not in the wallet,
+	// so the wallet can't unbond and withdraw the stake.
+	WarningForeignValidator WarningCode = 3
+
+	// WarningValidatorInCommittee warns that the validator is in the committee,
+	// so the bond or unbond transaction is pending until the validator leaves the committee.
+	WarningValidatorInCommittee WarningCode = 4
+)
+
+// TxWarning describes a potential mistake in a transaction that the user should be aware of
+// before signing it.
+type TxWarning struct {
+	Code    WarningCode
+	Message string
+}
+
+func (w TxWarning) String() string {
+	return w.Message
+}
+
+// WarningPolicy defines how the transaction warnings are treated before signing.
+type WarningPolicy int
+
+const (
+	// WarningPolicyWarn reports the warnings, but allows signing the transaction.
+	WarningPolicyWarn WarningPolicy = 0
+
+	// WarningPolicyBlock doesn't allow signing a transaction that has any warning.
+	WarningPolicyBlock WarningPolicy = 1
+)
+
+// ParseWarningPolicy parses the policy from its name: "warn" or "block".
+func ParseWarningPolicy(name string) (WarningPolicy, error) {
+	switch strings.ToLower(name) {
+	case "warn":
+		return WarningPolicyWarn, nil
+	case "block":
+		return WarningPolicyBlock, nil
+	default:
+		return 0, fmt.Errorf("invalid warning policy: %s", name)
+	}
+}
+
+// Enforce returns an error if the policy doesn't allow signing a transaction with these warnings.
+func (p WarningPolicy) Enforce(warnings []TxWarning) error {
+	if p == WarningPolicyBlock && len(warnings) > 0 {
+		return BlockedByPolicyError{Warnings: warnings}
+	}
+
+	return nil
+}
+
+// CheckTransaction checks the transaction for the mistakes that are hard to undo,
+// like emptying an account while the validators of the wallet are still bonded,
+// a lock time that keeps the funds stranded in the transaction pool,
+// or bonding to a validator that is not in the wallet.
+// It should be called before signing the transaction.
+func (w *Wallet) CheckTransaction(ctx context.Context, trx *tx.Tx) ([]TxWarning, error) {
+	if w.client == nil {
+		return nil, ErrOffline
+	}
+
+	warnings := make([]TxWarning, 0)
+
+	drainWarning, err := w.checkDrainsAccount(ctx, trx)
+	if err != nil {
+		return nil, err
+	}
+	if drainWarning != nil {
+		warnings = append(warnings, *drainWarning)
+	}
+
+	lockTimeWarning, err := w.checkLockTime(ctx, trx)
+	if err != nil {
+		return nil, err
+	}
+	if lockTimeWarning != nil {
+		warnings = append(warnings, *lockTimeWarning)
+	}
+
+	stakeWarnings, err := w.checkStake(ctx, trx)
+	if err != nil {
+		return nil, err
+	}
+	warnings = append(warnings, stakeWarnings...)
+
+	return warnings, nil
+}
+
+func (w *Wallet) checkDrainsAccount(ctx context.Context, trx *tx.Tx) (*TxWarning, error) {
+	if !trx.IsTransferTx() && !trx.IsBondTx() {
+		return nil, nil
+	}
+
+	acc, _ := w.client.getAccount(ctx, trx.Payload().Signer())
+	if acc == nil || acc.Balance > trx.Payload().Value()+trx.Fee() {
+		return nil, nil
+	}
+
+	for _, info := range w.AllValidatorAddresses() {
+		addr, err := crypto.AddressFromString(info.Address)
+		if err != nil {
+			return nil, err
+		}
+
+		val, _ := w.client.getValidator(ctx, addr)
+		if val == nil || val.Stake == 0 || val.UnbondingHeight != 0 {
+			continue
+		}
+
+		return &TxWarning{
+			Code: WarningDrainsAccount,
+			Message: i18n.Sprintf("the account %s will be empty, while the validator %s is still bonded "+
+				"with %.9f PAC stake", trx.Payload().Signer(), info.Address, util.ChangeToCoin(val.Stake)),
+		}, nil
+	}
+
+	return nil, nil
+}
+
+func (w *Wallet) checkStake(ctx context.Context, trx *tx.Tx) ([]TxWarning, error) {
+	var valAddr crypto.Address
+	switch pld := trx.Payload().(type) {
+	case *payload.BondPayload:
+		valAddr = pld.To
+	case *payload.UnbondPayload:
+		valAddr = pld.Validator
+	default:
+		return nil, nil
+	}
+
+	warnings := make([]TxWarning, 0)
+	if trx.IsBondTx() && !w.Contains(valAddr.String()) {
+		warnings = append(warnings, TxWarning{
+			Code: WarningForeignValidator,
+			Message: i18n.Sprintf("the validator %s is not in this wallet, "+
+				"the stake can only be unbonded by the owner of the validator", valAddr),
+		})
+	}
+
+	inCommittee, err := w.isInCommittee(ctx, valAddr)
+	if err != nil {
+		return nil, err
+	}
+	if inCommittee {
+		warnings = append(warnings, TxWarning{
+			Code: WarningValidatorInCommittee,
+			Message: i18n.Sprintf("the validator %s is in the committee, "+
+				"the transaction is pending until it leaves the committee", valAddr),
+		})
+	}
+
+	return warnings, nil
+}
+
+func (w *Wallet) checkLockTime(ctx context.Context, trx *tx.Tx) (*TxWarning, error) {
+	if trx.IsTimestampLocked() {
+		lockTime := time.Unix(int64(trx.LockTime()), 0)
+		if lockTime.After(time.Now()) {
+			return &TxWarning{
+				Code: WarningFutureLockTime,
+				Message: i18n.Sprintf("the transaction can't be committed before %s",
+					lockTime.Format(time.RFC3339)),
+			}, nil
+		}
+
+		return nil, nil
+	}
+
+	info, err := w.client.getBlockchainInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// The transaction can be committed in the next block.
+	nextHeight := info.LastBlockHeight + 1
+	if trx.LockTime() > nextHeight {
+		return &TxWarning{
+			Code: WarningFutureLockTime,
+			Message: i18n.Sprintf("the transaction can't be committed before the block %d, "+
+				"%d blocks from now", trx.LockTime(), trx.LockTime()-nextHeight),
+		}, nil
+	}
+
+	return nil, nil
+}
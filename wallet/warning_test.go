@@ -0,0 +1,119 @@
+package wallet
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pactus-project/pactus/types/tx"
+	pactus "github.com/pactus-project/pactus/www/grpc/gen/go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckTransaction(t *testing.T) {
+	td := setup(t)
+
+	sender, _ := td.wallet.NewBLSAccountAddress("account")
+	receiver := td.RandAccAddress().String()
+	_, _ = td.wallet.NewValidatorAddress("validator")
+	lastHeight := td.RandHeight()
+
+	tBlockchainInfoResponse = &pactus.GetBlockchainInfoResponse{LastBlockHeight: lastHeight}
+	tAccountResponse = &pactus.GetAccountResponse{Account: &pactus.AccountInfo{Balance: 1e9}}
+	tValidatorResponse = &pactus.GetValidatorResponse{Validator: &pactus.ValidatorInfo{Stake: 1e10}}
+
+	makeTransfer := func(amount int64, lockTime uint32) *tx.Tx {
+		trx, err := td.wallet.MakeTransferTx(sender, receiver, amount,
+			OptionFee(1e6), OptionLockTime(lockTime))
+		require.NoError(t, err)
+
+		return trx
+	}
+
+	t.Run("No warning", func(t *testing.T) {
+		warnings, err := td.wallet.CheckTransaction(context.Background(), makeTransfer(1e8, lastHeight+1))
+		require.NoError(t, err)
+		assert.Empty(t, warnings)
+	})
+
+	t.Run("Draining the account with a bonded validator", func(t *testing.T) {
+		warnings, err := td.wallet.CheckTransaction(context.Background(), makeTransfer(1e9-1e6, lastHeight+1))
+		require.NoError(t, err)
+		require.Len(t, warnings, 1)
+		assert.Equal(t, WarningDrainsAccount, warnings[0].Code)
+		assert.Contains(t, warnings[0].String(), sender)
+	})
+
+	t.Run("Draining the account with an unbonded validator", func(t *testing.T) {
+		tValidatorResponse = &pactus.GetValidatorResponse{
+			Validator: &pactus.ValidatorInfo{Stake: 1e10, UnbondingHeight: lastHeight},
+		}
+		defer func() {
+			tValidatorResponse = &pactus.GetValidatorResponse{Validator: &pactus.ValidatorInfo{Stake: 1e10}}
+		}()
+
+		warnings, err := td.wallet.CheckTransaction(context.Background(), makeTransfer(1e9-1e6, lastHeight+1))
+		require.NoError(t, err)
+		assert.Empty(t, warnings)
+	})
+
+	t.Run("Future lock time", func(t *testing.T) {
+		warnings, err := td.wallet.CheckTransaction(context.Background(), makeTransfer(1e8, lastHeight+100))
+		require.NoError(t, err)
+		require.Len(t, warnings, 1)
+		assert.Equal(t, WarningFutureLockTime, warnings[0].Code)
+		assert.Contains(t, warnings[0].String(), "99 blocks from now")
+	})
+
+	t.Run("Future timestamp lock time", func(t *testing.T) {
+		lockTime := uint32(time.Now().Add(time.Hour).Unix())
+		warnings, err := td.wallet.CheckTransaction(context.Background(), makeTransfer(1e8, lockTime))
+		require.NoError(t, err)
+		require.Len(t, warnings, 1)
+		assert.Equal(t, WarningFutureLockTime, warnings[0].Code)
+
+		lockTime = uint32(time.Now().Add(-time.Hour).Unix())
+		warnings, err = td.wallet.CheckTransaction(context.Background(), makeTransfer(1e8, lockTime))
+		require.NoError(t, err)
+		assert.Empty(t, warnings)
+	})
+
+	t.Run("Both warnings", func(t *testing.T) {
+		warnings, err := td.wallet.CheckTransaction(context.Background(), makeTransfer(1e9, lastHeight+100))
+		require.NoError(t, err)
+		assert.Len(t, warnings, 2)
+	})
+
+	t.Run("Offline wallet", func(t *testing.T) {
+		trx := makeTransfer(1e8, lastHeight+1)
+
+		client := td.wallet.client
+		td.wallet.client = nil
+		defer func() { td.wallet.client = client }()
+
+		_, err := td.wallet.CheckTransaction(context.Background(), trx)
+		assert.ErrorIs(t, err, ErrOffline)
+	})
+}
+
+func TestWarningPolicy(t *testing.T) {
+	policy, err := ParseWarningPolicy("warn")
+	assert.NoError(t, err)
+	assert.Equal(t, WarningPolicyWarn, policy)
+
+	policy, err = ParseWarningPolicy("BLOCK")
+	assert.NoError(t, err)
+	assert.Equal(t, WarningPolicyBlock, policy)
+
+	_, err = ParseWarningPolicy("ignore")
+	assert.Error(t, err)
+
+	warnings := []TxWarning{{Code: WarningDrainsAccount, Message: "drained"}}
+	assert.NoError(t, WarningPolicyWarn.Enforce(warnings))
+	assert.NoError(t, WarningPolicyBlock.Enforce(nil))
+
+	blockedErr := BlockedByPolicyError{}
+	assert.ErrorAs(t, WarningPolicyBlock.Enforce(warnings), &blockedErr)
+	assert.Equal(t, warnings, blockedErr.Warnings)
+}
@@ -0,0 +1,267 @@
+package wallet
+
+import (
+	"context"
+	"time"
+
+	"github.com/pactus-project/pactus/crypto"
+	"github.com/pactus-project/pactus/crypto/hash"
+	"github.com/pactus-project/pactus/types/tx"
+	"github.com/pactus-project/pactus/types/tx/payload"
+)
+
+// maxWatchScanRange is the maximum number of the blocks that are scanned in one request.
+// It is less than the scan range limit of the node.
+const maxWatchScanRange = 1000
+
+// WatchEventType is the type of the event that is reported by the watcher.
+type WatchEventType int
+
+const (
+	// WatchEventPending is reported when a transaction enters the transaction pool.
+	WatchEventPending WatchEventType = 1
+	// WatchEventConfirmed is reported when a transaction is committed
+	// and has the required number of confirmations.
+	WatchEventConfirmed WatchEventType = 2
+)
+
+func (t WatchEventType) String() string {
+	switch t {
+	case WatchEventPending:
+		return "pending"
+	case WatchEventConfirmed:
+		return "confirmed"
+	default:
+		return "unknown"
+	}
+}
+
+// WatchEvent is a transaction that touches an address of the wallet.
+// A transaction between two addresses of the wallet is reported for each address.
+type WatchEvent struct {
+	Type        WatchEventType
+	TxID        tx.ID
+	Address     string
+	Incoming    bool // Whether the address receives the amount, otherwise it is the signer
+	Amount      int64
+	Fee         int64
+	PayloadType payload.Type
+	Height      uint32 // Height of the block, zero for the pending transactions
+}
+
+// Watcher tracks the transactions of the wallet addresses, by polling the node.
+// The committed blocks are found by the bloom filters of the node,
+// and the pending transactions are found by the changes of the transaction pool.
+type Watcher struct {
+	wallet        *Wallet
+	confirmations uint32
+	addrs         map[crypto.Address]string
+	lastHeight    uint32
+	poolSequence  uint64
+	pending       map[tx.ID]bool
+	started       bool
+}
+
+// NewWatcher creates a watcher for the addresses of the wallet.
+// The transactions are reported as confirmed when they have the given number of
+// confirmations; the block of the transaction is the first confirmation.
+// Watching starts after the `fromHeight`; if it is zero, it starts from the last block.
+func (w *Wallet) NewWatcher(confirmations, fromHeight uint32) (*Watcher, error) {
+	if w.IsOffline() {
+		return nil, ErrOffline
+	}
+
+	addrs := make(map[crypto.Address]string)
+	for _, info := range w.AddressInfos() {
+		addr, err := crypto.AddressFromString(info.Address)
+		if err != nil {
+			return nil, err
+		}
+		addrs[addr] = info.Address
+	}
+
+	return &Watcher{
+		wallet:        w,
+		confirmations: max(confirmations, 1),
+		addrs:         addrs,
+		lastHeight:    fromHeight,
+		pending:       make(map[tx.ID]bool),
+	}, nil
+}
+
+// Run polls the node at each interval and calls the handler for the new events,
+// until the context is done.
+// The errors are passed to the error handler and polling is retried at the next interval.
+func (wt *Watcher) Run(ctx context.Context, interval time.Duration,
+	handler func(WatchEvent), errHandler func(error),
+) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		events, err := wt.Poll(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+		if err != nil {
+			errHandler(err)
+		}
+		for _, event := range events {
+			handler(event)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Poll returns the events since the last poll.
+func (wt *Watcher) Poll(ctx context.Context) ([]WatchEvent, error) {
+	client := wt.wallet.client
+	info, err := client.getBlockchainInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	events := []WatchEvent{}
+	if wt.started {
+		events, err = wt.pollPool(ctx)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		// The pending transactions are watched from the current state of the pool.
+		changes, err := client.getTxPoolChanges(ctx, 0)
+		if err != nil {
+			return nil, err
+		}
+		wt.poolSequence = changes.Sequence
+		wt.started = true
+
+		if wt.lastHeight == 0 {
+			wt.lastHeight = info.LastBlockHeight
+		}
+	}
+
+	if info.LastBlockHeight+1 < wt.confirmations {
+		return events, nil
+	}
+	confirmedHeight := info.LastBlockHeight + 1 - wt.confirmations
+	for wt.lastHeight < confirmedHeight {
+		from := wt.lastHeight + 1
+		to := min(confirmedHeight, from+maxWatchScanRange-1)
+		confirmed, err := wt.pollBlocks(ctx, from, to)
+		if err != nil {
+			return events, err
+		}
+		events = append(events, confirmed...)
+		wt.lastHeight = to
+	}
+
+	return events, nil
+}
+
+func (wt *Watcher) pollPool(ctx context.Context) ([]WatchEvent, error) {
+	changes, err := wt.wallet.client.getTxPoolChanges(ctx, wt.poolSequence)
+	if err != nil {
+		return nil, err
+	}
+	// If the changes are not kept anymore, some pending transactions are missed,
+	// but they are reported when they are confirmed.
+	wt.poolSequence = changes.Sequence
+
+	events := []WatchEvent{}
+	for _, change := range changes.Changes {
+		if !change.Added {
+			// The transaction is committed or expired.
+			id, err := hash.FromBytes(change.Id)
+			if err == nil {
+				delete(wt.pending, id)
+			}
+
+			continue
+		}
+		if change.Transaction == nil {
+			continue
+		}
+		trx, err := tx.FromBytes(change.Transaction.Data)
+		if err != nil {
+			return nil, err
+		}
+		if wt.pending[trx.ID()] {
+			continue
+		}
+
+		trxEvents := wt.eventsOf(WatchEventPending, trx, 0)
+		if len(trxEvents) > 0 {
+			wt.pending[trx.ID()] = true
+			events = append(events, trxEvents...)
+		}
+	}
+
+	return events, nil
+}
+
+func (wt *Watcher) pollBlocks(ctx context.Context, from, to uint32) ([]WatchEvent, error) {
+	client := wt.wallet.client
+	addrs := make([]string, 0, len(wt.addrs))
+	for _, addr := range wt.addrs {
+		addrs = append(addrs, addr)
+	}
+
+	heights, err := client.scanBlocks(ctx, addrs, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	events := []WatchEvent{}
+	for _, height := range heights {
+		blk, err := client.getBlock(ctx, height)
+		if err != nil {
+			return nil, err
+		}
+
+		// The bloom filters may have false positives.
+		for _, trx := range blk.Transactions() {
+			trxEvents := wt.eventsOf(WatchEventConfirmed, trx, height)
+			if len(trxEvents) > 0 {
+				delete(wt.pending, trx.ID())
+				events = append(events, trxEvents...)
+			}
+		}
+	}
+
+	return events, nil
+}
+
+func (wt *Watcher) eventsOf(typ WatchEventType, trx *tx.Tx, height uint32) []WatchEvent {
+	events := []WatchEvent{}
+	makeEvent := func(addr string, incoming bool) WatchEvent {
+		return WatchEvent{
+			Type:        typ,
+			TxID:        trx.ID(),
+			Address:     addr,
+			Incoming:    incoming,
+			Amount:      trx.Payload().Value(),
+			Fee:         trx.Fee(),
+			PayloadType: trx.Payload().Type(),
+			Height:      height,
+		}
+	}
+
+	if receiver := trx.Payload().Receiver(); receiver != nil {
+		if addr, ok := wt.addrs[*receiver]; ok {
+			events = append(events, makeEvent(addr, true))
+		}
+	}
+	if !trx.IsSubsidyTx() {
+		if addr, ok := wt.addrs[trx.Payload().Signer()]; ok {
+			events = append(events, makeEvent(addr, false))
+		}
+	}
+
+	return events
+}
@@ -0,0 +1,177 @@
+package wallet
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pactus-project/pactus/crypto"
+	"github.com/pactus-project/pactus/crypto/hash"
+	"github.com/pactus-project/pactus/types/block"
+	"github.com/pactus-project/pactus/types/tx"
+	pactus "github.com/pactus-project/pactus/www/grpc/gen/go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func (td *testData) makeWatchBlock(height uint32, txs ...*tx.Tx) {
+	// Blocks without the previous certificate have no previous block hash.
+	blk := block.MakeBlock(1, time.Now(), txs, hash.UndefHash, td.RandHash(),
+		nil, td.RandSeed(), td.RandValAddress())
+	data, _ := blk.Bytes()
+
+	if tBlockResponses == nil {
+		tBlockResponses = make(map[uint32]*pactus.GetBlockResponse)
+	}
+	tBlockResponses[height] = &pactus.GetBlockResponse{Height: height, Data: data}
+}
+
+func (td *testData) makeWatchTx(sender, receiver crypto.Address) *tx.Tx {
+	// The watcher doesn't verify the transactions, so they are not signed.
+	return tx.NewTransferTx(td.RandHeight(), sender, receiver,
+		td.RandInt64(1000*1e10), td.RandInt64(1*1e10), "watch")
+}
+
+func txPoolChange(seq uint64, added bool, trx *tx.Tx) *pactus.TxPoolChange {
+	change := &pactus.TxPoolChange{Sequence: seq, Added: added, Id: trx.ID().Bytes()}
+	if added {
+		data, _ := trx.Bytes()
+		change.Transaction = &pactus.TransactionInfo{Id: trx.ID().Bytes(), Data: data}
+	}
+
+	return change
+}
+
+func TestWatcherOffline(t *testing.T) {
+	td := setup(t)
+
+	require.NoError(t, td.wallet.Save())
+	wlt, err := Open(td.wallet.Path(), true)
+	require.NoError(t, err)
+
+	_, err = wlt.NewWatcher(1, 0)
+	assert.ErrorIs(t, err, ErrOffline)
+}
+
+func TestWatcher(t *testing.T) {
+	td := setup(t)
+
+	addrStr, err := td.wallet.NewBLSAccountAddress("watch")
+	require.NoError(t, err)
+	addr, _ := crypto.AddressFromString(addrStr)
+
+	incomingTx := td.makeWatchTx(td.RandAccAddress(), addr)
+	unrelatedTx := td.makeWatchTx(td.RandAccAddress(), td.RandAccAddress())
+
+	watcher, err := td.wallet.NewWatcher(1, 0)
+	require.NoError(t, err)
+
+	tBlockchainInfoResponse = &pactus.GetBlockchainInfoResponse{LastBlockHeight: 10}
+	tTxPoolChangesResponse = &pactus.GetTxPoolChangesResponse{Sequence: 5}
+
+	t.Run("Starts from the last block", func(t *testing.T) {
+		events, err := watcher.Poll(context.Background())
+		require.NoError(t, err)
+		assert.Empty(t, events)
+		assert.Equal(t, uint32(10), watcher.lastHeight)
+		assert.Equal(t, uint64(5), watcher.poolSequence)
+	})
+
+	t.Run("Pending transaction", func(t *testing.T) {
+		tTxPoolChangesResponse = &pactus.GetTxPoolChangesResponse{
+			Sequence: 7,
+			Changes: []*pactus.TxPoolChange{
+				txPoolChange(6, true, unrelatedTx),
+				txPoolChange(7, true, incomingTx),
+			},
+		}
+
+		events, err := watcher.Poll(context.Background())
+		require.NoError(t, err)
+		require.Len(t, events, 1)
+		assert.Equal(t, WatchEventPending, events[0].Type)
+		assert.Equal(t, incomingTx.ID(), events[0].TxID)
+		assert.Equal(t, addrStr, events[0].Address)
+		assert.True(t, events[0].Incoming)
+		assert.Equal(t, incomingTx.Payload().Value(), events[0].Amount)
+		assert.Zero(t, events[0].Height)
+
+		// The pending transaction is reported once.
+		events, err = watcher.Poll(context.Background())
+		require.NoError(t, err)
+		assert.Empty(t, events)
+	})
+
+	t.Run("Confirmed transaction", func(t *testing.T) {
+		td.makeWatchBlock(11, unrelatedTx)
+		td.makeWatchBlock(12, incomingTx)
+		// The block at height 11 is a false positive of the bloom filter.
+		tScanBlocksResponse = &pactus.ScanBlocksResponse{Heights: []uint32{11, 12}}
+		tBlockchainInfoResponse = &pactus.GetBlockchainInfoResponse{LastBlockHeight: 12}
+		tTxPoolChangesResponse = &pactus.GetTxPoolChangesResponse{
+			Sequence: 8,
+			Changes: []*pactus.TxPoolChange{
+				txPoolChange(8, false, incomingTx),
+			},
+		}
+
+		events, err := watcher.Poll(context.Background())
+		require.NoError(t, err)
+		require.Len(t, events, 1)
+		assert.Equal(t, WatchEventConfirmed, events[0].Type)
+		assert.Equal(t, incomingTx.ID(), events[0].TxID)
+		assert.Equal(t, uint32(12), events[0].Height)
+		assert.Empty(t, watcher.pending)
+		assert.Equal(t, uint32(12), watcher.lastHeight)
+	})
+}
+
+func TestWatcherConfirmations(t *testing.T) {
+	td := setup(t)
+
+	addrStr, err := td.wallet.NewBLSAccountAddress("watch")
+	require.NoError(t, err)
+	addr, _ := crypto.AddressFromString(addrStr)
+
+	outgoingTx := td.makeWatchTx(addr, td.RandAccAddress())
+	td.makeWatchBlock(8, outgoingTx)
+	tScanBlocksResponse = &pactus.ScanBlocksResponse{Heights: []uint32{8}}
+	tTxPoolChangesResponse = &pactus.GetTxPoolChangesResponse{}
+
+	watcher, err := td.wallet.NewWatcher(3, 5)
+	require.NoError(t, err)
+
+	tBlockchainInfoResponse = &pactus.GetBlockchainInfoResponse{LastBlockHeight: 9}
+	events, err := watcher.Poll(context.Background())
+	require.NoError(t, err)
+	assert.Empty(t, events)
+	assert.Equal(t, uint32(7), watcher.lastHeight)
+
+	tBlockchainInfoResponse = &pactus.GetBlockchainInfoResponse{LastBlockHeight: 10}
+	events, err = watcher.Poll(context.Background())
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	assert.Equal(t, WatchEventConfirmed, events[0].Type)
+	assert.False(t, events[0].Incoming)
+	assert.Equal(t, outgoingTx.Fee(), events[0].Fee)
+	assert.Equal(t, uint32(8), events[0].Height)
+}
+
+func TestWatcherRun(t *testing.T) {
+	td := setup(t)
+
+	tBlockchainInfoResponse = &pactus.GetBlockchainInfoResponse{LastBlockHeight: 10}
+	tTxPoolChangesResponse = &pactus.GetTxPoolChangesResponse{}
+
+	watcher, err := td.wallet.NewWatcher(1, 0)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	// Run returns when the context is done.
+	watcher.Run(ctx, 10*time.Millisecond,
+		func(WatchEvent) {},
+		func(err error) { assert.NoError(t, err) })
+	assert.Equal(t, uint32(10), watcher.lastHeight)
+}
@@ -0,0 +1,63 @@
+package watcher
+
+import (
+	"net/url"
+	"time"
+
+	"github.com/pactus-project/pactus/crypto"
+	"github.com/pactus-project/pactus/util/errors"
+)
+
+const (
+	// EncodingJSON posts the notifications to the webhooks as JSON.
+	EncodingJSON = "json"
+	// EncodingProtobuf posts the notifications to the webhooks as the protobuf events,
+	// defined in the `event.proto` file.
+	EncodingProtobuf = "protobuf"
+)
+
+type Config struct {
+	Enable       bool          `toml:"enable"`
+	Addresses    []string      `toml:"addresses"`
+	Webhooks     []string      `toml:"webhooks"`
+	Timeout      time.Duration `toml:"timeout"`
+	MaxAddresses int           `toml:"max_addresses"`
+	Encoding     string        `toml:"encoding"`
+}
+
+func DefaultConfig() *Config {
+	return &Config{
+		Enable:       false,
+		Addresses:    []string{},
+		Webhooks:     []string{},
+		Timeout:      5 * time.Second,
+		MaxAddresses: 1000,
+		Encoding:     EncodingJSON,
+	}
+}
+
+// BasicCheck performs basic checks on the configuration.
+func (conf *Config) BasicCheck() error {
+	for _, addrStr := range conf.Addresses {
+		if _, err := crypto.AddressFromString(addrStr); err != nil {
+			return errors.Errorf(errors.ErrInvalidConfig, "invalid watched address: %v", err.Error())
+		}
+	}
+	if len(conf.Addresses) > conf.MaxAddresses {
+		return errors.Errorf(errors.ErrInvalidConfig, "number of watched addresses is more than %d", conf.MaxAddresses)
+	}
+	for _, webhook := range conf.Webhooks {
+		u, err := url.Parse(webhook)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return errors.Errorf(errors.ErrInvalidConfig, "invalid webhook url: %s", webhook)
+		}
+	}
+	if conf.Timeout <= 0 {
+		return errors.Errorf(errors.ErrInvalidConfig, "webhook timeout should be positive")
+	}
+	if conf.Encoding != EncodingJSON && conf.Encoding != EncodingProtobuf {
+		return errors.Errorf(errors.ErrInvalidConfig, "invalid webhook encoding: %s", conf.Encoding)
+	}
+
+	return nil
+}
@@ -0,0 +1,59 @@
+package watcher
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDefaultConfigCheck(t *testing.T) {
+	c := DefaultConfig()
+	assert.NoError(t, c.BasicCheck())
+}
+
+func TestConfigCheck(t *testing.T) {
+	t.Run("Invalid address", func(t *testing.T) {
+		c := DefaultConfig()
+		c.Addresses = []string{"invalid"}
+		assert.Error(t, c.BasicCheck())
+	})
+
+	t.Run("Too many addresses", func(t *testing.T) {
+		c := DefaultConfig()
+		c.MaxAddresses = 1
+		c.Addresses = []string{
+			"pc1p0hrct7eflrpw4ccrttxzs4qud2axex4dcdzdfr",
+			"pc1zgp0x33hehvczq6dggs04gywfqpzl9fea5039gh",
+		}
+		assert.Error(t, c.BasicCheck())
+	})
+
+	t.Run("Invalid webhook", func(t *testing.T) {
+		c := DefaultConfig()
+		c.Webhooks = []string{"127.0.0.1:8080"}
+		assert.Error(t, c.BasicCheck())
+
+		c.Webhooks = []string{"ftp://127.0.0.1/notify"}
+		assert.Error(t, c.BasicCheck())
+	})
+
+	t.Run("Invalid timeout", func(t *testing.T) {
+		c := DefaultConfig()
+		c.Timeout = 0
+		assert.Error(t, c.BasicCheck())
+	})
+
+	t.Run("Invalid encoding", func(t *testing.T) {
+		c := DefaultConfig()
+		c.Encoding = "xml"
+		assert.Error(t, c.BasicCheck())
+	})
+
+	t.Run("Ok", func(t *testing.T) {
+		c := DefaultConfig()
+		c.Addresses = []string{"pc1p0hrct7eflrpw4ccrttxzs4qud2axex4dcdzdfr"}
+		c.Webhooks = []string{"https://example.com/notify"}
+		c.Encoding = EncodingProtobuf
+		assert.NoError(t, c.BasicCheck())
+	})
+}
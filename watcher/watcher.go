@@ -0,0 +1,376 @@
+// Package watcher notifies about the activities of the watched addresses in the committed blocks.
+package watcher
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/pactus-project/pactus/crypto"
+	"github.com/pactus-project/pactus/crypto/hash"
+	"github.com/pactus-project/pactus/state"
+	"github.com/pactus-project/pactus/types/receipt"
+	"github.com/pactus-project/pactus/util/errors"
+	"github.com/pactus-project/pactus/util/logger"
+	"github.com/pactus-project/pactus/util/recovery"
+	"github.com/pactus-project/pactus/www/nanomsg/event"
+	"golang.org/x/exp/slices"
+)
+
+// checkInterval is the interval for checking the new committed blocks.
+const checkInterval = time.Second
+
+// Notification is sent when a watched address appears in a committed block.
+type Notification struct {
+	Address   string `json:"address"`
+	Height    uint32 `json:"height"`
+	BlockHash string `json:"block_hash"`
+	BlockTime int64  `json:"block_time"`
+	TxID      string `json:"tx_id"`
+	Amount    int64  `json:"amount"` // The balance change of the address by the transaction
+}
+
+type Watcher struct {
+	lk sync.RWMutex
+
+	ctx        context.Context
+	cancel     context.CancelFunc
+	config     *Config
+	state      state.Facade
+	addrs      map[crypto.Address]bool
+	lastHeight uint32
+	eventCh    chan<- event.Event
+	notifyCh   chan *Notification
+	seqNum     uint32
+	client     *http.Client
+	logger     *logger.SubLogger
+}
+
+// NewWatcher creates a new watcher for the addresses in the config.
+// The events are published to the event channel, if it is set.
+func NewWatcher(conf *Config, st state.Facade, eventCh chan<- event.Event) (*Watcher, error) {
+	ctx, cancel := context.WithCancel(context.Background())
+	w := &Watcher{
+		ctx:      ctx,
+		cancel:   cancel,
+		config:   conf,
+		state:    st,
+		addrs:    make(map[crypto.Address]bool),
+		eventCh:  eventCh,
+		notifyCh: make(chan *Notification, 100),
+		client:   &http.Client{Timeout: conf.Timeout},
+		logger:   logger.NewSubLogger("_watcher", nil),
+	}
+
+	for _, addrStr := range conf.Addresses {
+		addr, err := crypto.AddressFromString(addrStr)
+		if err != nil {
+			return nil, err
+		}
+		w.addrs[addr] = true
+	}
+
+	return w, nil
+}
+
+// IsEnabled checks if the watcher is enabled.
+func (w *Watcher) IsEnabled() bool {
+	return w.config.Enable
+}
+
+// Start starts watching the blocks that are committed from now on.
+func (w *Watcher) Start() error {
+	if !w.config.Enable {
+		return nil
+	}
+
+	w.lk.Lock()
+	w.lastHeight = w.state.LastBlockHeight()
+	w.lk.Unlock()
+
+	go recovery.Loop("watcher", w.logger, w.checkLoop)
+	go recovery.Loop("watcher", w.logger, w.notifyLoop)
+
+	w.logger.Info("watcher started", "addresses", len(w.addrs), "webhooks", len(w.config.Webhooks))
+
+	return nil
+}
+
+func (w *Watcher) Stop() {
+	w.cancel()
+}
+
+// Watch adds the address to the watch list.
+func (w *Watcher) Watch(addr crypto.Address) error {
+	w.lk.Lock()
+	defer w.lk.Unlock()
+
+	if w.addrs[addr] {
+		return nil
+	}
+
+	if len(w.addrs) >= w.config.MaxAddresses {
+		return errors.Errorf(errors.ErrInvalidAddress,
+			"number of watched addresses can't be more than %d", w.config.MaxAddresses)
+	}
+	w.addrs[addr] = true
+
+	return nil
+}
+
+// Unwatch removes the address from the watch list.
+// It returns false if the address is not watched.
+func (w *Watcher) Unwatch(addr crypto.Address) bool {
+	w.lk.Lock()
+	defer w.lk.Unlock()
+
+	if !w.addrs[addr] {
+		return false
+	}
+	delete(w.addrs, addr)
+
+	return true
+}
+
+// IsWatched checks if the address is in the watch list.
+func (w *Watcher) IsWatched(addr crypto.Address) bool {
+	w.lk.RLock()
+	defer w.lk.RUnlock()
+
+	return w.addrs[addr]
+}
+
+// Addresses returns the watched addresses, sorted.
+func (w *Watcher) Addresses() []crypto.Address {
+	w.lk.RLock()
+	defer w.lk.RUnlock()
+
+	addrs := make([]crypto.Address, 0, len(w.addrs))
+	for addr := range w.addrs {
+		addrs = append(addrs, addr)
+	}
+	slices.SortFunc(addrs, func(a, b crypto.Address) int {
+		return bytes.Compare(a.Bytes(), b.Bytes())
+	})
+
+	return addrs
+}
+
+func (w *Watcher) checkLoop() {
+	ticker := time.NewTicker(checkInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-w.ctx.Done():
+			return
+
+		case <-ticker.C:
+			w.checkNewBlocks()
+		}
+	}
+}
+
+// checkNewBlocks checks the blocks that are committed since the last check.
+func (w *Watcher) checkNewBlocks() {
+	w.lk.Lock()
+	defer w.lk.Unlock()
+
+	lastHeight := w.state.LastBlockHeight()
+	for height := w.lastHeight + 1; height <= lastHeight; height++ {
+		for _, n := range w.blockNotifications(height) {
+			w.notify(n)
+		}
+		w.lastHeight = height
+	}
+}
+
+// blockNotifications returns the notifications for the watched addresses in the block.
+func (w *Watcher) blockNotifications(height uint32) []*Notification {
+	if len(w.addrs) == 0 {
+		return nil
+	}
+
+	// The bloom filter helps to skip the blocks that don't touch any watched address.
+	if filter := w.state.BlockBloom(height); filter != nil {
+		found := false
+		for addr := range w.addrs {
+			if filter.Contains(addr.Bytes()) {
+				found = true
+
+				break
+			}
+		}
+		if !found {
+			return nil
+		}
+	}
+
+	committedBlock := w.state.CommittedBlock(height)
+	if committedBlock == nil {
+		w.logger.Error("unable to retrieve the committed block", "height", height)
+
+		return nil
+	}
+	blk, err := committedBlock.ToBlock()
+	if err != nil {
+		w.logger.Error("unable to decode the committed block", "height", height, "error", err)
+
+		return nil
+	}
+
+	notifications := make([]*Notification, 0)
+	for _, trx := range blk.Transactions() {
+		rcpt := w.state.TransactionReceipt(trx.ID())
+		for _, addr := range w.txAddresses(trx.Payload().Signer(), trx.Payload().Receiver(), rcpt) {
+			notifications = append(notifications, &Notification{
+				Address:   addr.String(),
+				Height:    height,
+				BlockHash: blk.Hash().String(),
+				BlockTime: blk.Header().Time().Unix(),
+				TxID:      trx.ID().String(),
+				Amount:    balanceChange(addr, rcpt),
+			})
+		}
+	}
+
+	return notifications
+}
+
+// txAddresses returns the watched addresses that the transaction touched, without duplicates.
+func (w *Watcher) txAddresses(signer crypto.Address, receiver *crypto.Address,
+	rcpt *receipt.Receipt,
+) []crypto.Address {
+	addrs := make([]crypto.Address, 0)
+	add := func(addr crypto.Address) {
+		if w.addrs[addr] && !slices.Contains(addrs, addr) {
+			addrs = append(addrs, addr)
+		}
+	}
+
+	add(signer)
+	if receiver != nil {
+		add(*receiver)
+	}
+	if rcpt != nil {
+		for _, b := range rcpt.Balances() {
+			add(b.Address)
+		}
+		for _, e := range rcpt.Events() {
+			add(e.Address)
+		}
+	}
+
+	return addrs
+}
+
+// balanceChange returns the balance change of the address in the receipt.
+func balanceChange(addr crypto.Address, rcpt *receipt.Receipt) int64 {
+	if rcpt == nil {
+		return 0
+	}
+
+	for _, e := range rcpt.Events() {
+		if e.Type == receipt.EventBalanceChange && e.Address == addr {
+			return e.NewValue - e.OldValue
+		}
+	}
+
+	return 0
+}
+
+// notify publishes the notification as an event and queues it for the webhooks.
+// The notification is dropped if the webhooks are too slow, to not block the watcher.
+func (w *Watcher) notify(n *Notification) {
+	w.logger.Info("watched address found", "address", n.Address, "height", n.Height, "tx", n.TxID)
+
+	if w.eventCh != nil {
+		addr, _ := crypto.AddressFromString(n.Address)
+		txID, _ := hash.FromString(n.TxID)
+		w.eventCh <- event.CreateWatchedAddressEvent(addr, txID, n.Height, n.Amount)
+	}
+
+	if len(w.config.Webhooks) == 0 {
+		return
+	}
+
+	select {
+	case w.notifyCh <- n:
+	default:
+		w.logger.Warn("webhook queue is full, notification is dropped", "address", n.Address, "tx", n.TxID)
+	}
+}
+
+func (w *Watcher) notifyLoop() {
+	for {
+		select {
+		case <-w.ctx.Done():
+			return
+
+		case n := <-w.notifyCh:
+			for _, url := range w.config.Webhooks {
+				if err := w.postWebhook(url, n); err != nil {
+					w.logger.Warn("unable to call the webhook", "url", url, "error", err)
+				}
+			}
+			w.seqNum++
+		}
+	}
+}
+
+func (w *Watcher) postWebhook(url string, n *Notification) error {
+	body, contentType, err := w.encodeNotification(n)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(w.ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", contentType)
+
+	res, err := w.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		return fmt.Errorf("webhook responded with status: %s", res.Status)
+	}
+
+	return nil
+}
+
+// encodeNotification encodes the notification based on the encoding in the config,
+// and returns the body and the content type of the webhook request.
+func (w *Watcher) encodeNotification(n *Notification) ([]byte, string, error) {
+	if w.config.Encoding == EncodingProtobuf {
+		addr, err := crypto.AddressFromString(n.Address)
+		if err != nil {
+			return nil, "", err
+		}
+		txID, err := hash.FromString(n.TxID)
+		if err != nil {
+			return nil, "", err
+		}
+		body, err := event.CreateWatchedAddressEvent(addr, txID, n.Height, n.Amount).Encode(w.seqNum)
+		if err != nil {
+			return nil, "", err
+		}
+
+		return body, "application/x-protobuf", nil
+	}
+
+	body, err := json.Marshal(n)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return body, "application/json", nil
+}
@@ -0,0 +1,245 @@
+package watcher
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/pactus-project/pactus/crypto"
+	"github.com/pactus-project/pactus/state"
+	"github.com/pactus-project/pactus/types/receipt"
+	"github.com/pactus-project/pactus/util/bloom"
+	"github.com/pactus-project/pactus/util/testsuite"
+	pactus "github.com/pactus-project/pactus/www/grpc/gen/go"
+	"github.com/pactus-project/pactus/www/nanomsg/event"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testData struct {
+	*testsuite.TestSuite
+
+	mockState *state.MockState
+	eventCh   chan event.Event
+	watcher   *Watcher
+}
+
+func setup(t *testing.T, conf *Config) *testData {
+	t.Helper()
+
+	ts := testsuite.NewTestSuite(t)
+
+	if conf == nil {
+		conf = DefaultConfig()
+		conf.Enable = true
+	}
+
+	mockState := state.MockingState(ts)
+	mockState.CommitTestBlocks(2)
+
+	eventCh := make(chan event.Event, 10)
+	w, err := NewWatcher(conf, mockState, eventCh)
+	require.NoError(t, err)
+
+	w.lastHeight = mockState.LastBlockHeight()
+
+	return &testData{
+		TestSuite: ts,
+		mockState: mockState,
+		eventCh:   eventCh,
+		watcher:   w,
+	}
+}
+
+// commitTransferBlock commits a new block and returns the receiver of its transfer transaction.
+// The receipt of the transfer transaction is saved with the given amount as the balance change.
+func (td *testData) commitTransferBlock(amount int64) crypto.Address {
+	td.mockState.CommitTestBlocks(1)
+	height := td.mockState.LastBlockHeight()
+	blk := td.mockState.TestStore.Blocks[height]
+
+	trx := blk.Transactions()[0]
+	receiver := *trx.Payload().Receiver()
+	rcpt := receipt.NewReceipt(trx.ID(), height, 0, receipt.StatusSuccess, trx.Fee(),
+		[]receipt.Balance{{Address: receiver, Balance: amount}},
+		[]receipt.Event{{
+			Type:     receipt.EventBalanceChange,
+			Address:  receiver,
+			OldValue: 0,
+			NewValue: amount,
+		}})
+	td.mockState.TestStore.SaveReceipts([]*receipt.Receipt{rcpt})
+
+	return receiver
+}
+
+func TestNewWatcher(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+	mockState := state.MockingState(ts)
+
+	t.Run("Should load the addresses from the config", func(t *testing.T) {
+		addr := ts.RandAccAddress()
+		conf := DefaultConfig()
+		conf.Addresses = []string{addr.String()}
+
+		w, err := NewWatcher(conf, mockState, nil)
+		assert.NoError(t, err)
+		assert.False(t, w.IsEnabled())
+		assert.True(t, w.IsWatched(addr))
+	})
+
+	t.Run("Should return error for invalid address", func(t *testing.T) {
+		conf := DefaultConfig()
+		conf.Addresses = []string{"invalid"}
+
+		_, err := NewWatcher(conf, mockState, nil)
+		assert.Error(t, err)
+	})
+}
+
+func TestWatchList(t *testing.T) {
+	conf := DefaultConfig()
+	conf.Enable = true
+	conf.MaxAddresses = 2
+	td := setup(t, conf)
+
+	addr1 := td.RandAccAddress()
+	addr2 := td.RandValAddress()
+
+	assert.NoError(t, td.watcher.Watch(addr1))
+	assert.NoError(t, td.watcher.Watch(addr1))
+	assert.NoError(t, td.watcher.Watch(addr2))
+	assert.Error(t, td.watcher.Watch(td.RandAccAddress()))
+
+	assert.ElementsMatch(t, []crypto.Address{addr1, addr2}, td.watcher.Addresses())
+
+	assert.True(t, td.watcher.Unwatch(addr1))
+	assert.False(t, td.watcher.Unwatch(addr1))
+	assert.False(t, td.watcher.IsWatched(addr1))
+	assert.Equal(t, []crypto.Address{addr2}, td.watcher.Addresses())
+}
+
+func TestCheckNewBlocks(t *testing.T) {
+	td := setup(t, nil)
+
+	t.Run("Should not notify for the blocks without watched addresses", func(t *testing.T) {
+		td.commitTransferBlock(1)
+		td.watcher.checkNewBlocks()
+
+		assert.Empty(t, td.eventCh)
+		assert.Equal(t, td.mockState.LastBlockHeight(), td.watcher.lastHeight)
+	})
+
+	t.Run("Should notify for the watched address", func(t *testing.T) {
+		amount := td.RandAmount()
+		td.mockState.CommitTestBlocks(1)
+		receiver := td.commitTransferBlock(amount)
+		height := td.mockState.LastBlockHeight()
+		trx := td.mockState.TestStore.Blocks[height].Transactions()[0]
+
+		assert.NoError(t, td.watcher.Watch(receiver))
+		td.watcher.checkNewBlocks()
+
+		require.Len(t, td.eventCh, 1)
+		expected := event.CreateWatchedAddressEvent(receiver, trx.ID(), height, amount)
+		assert.Equal(t, expected, <-td.eventCh)
+		assert.Equal(t, height, td.watcher.lastHeight)
+	})
+
+	t.Run("Should skip the block if the bloom filter doesn't contain the address", func(t *testing.T) {
+		receiver := td.commitTransferBlock(1)
+		height := td.mockState.LastBlockHeight()
+		filter := bloom.New(10)
+		filter.Add(td.RandAccAddress().Bytes())
+		td.mockState.TestStore.SaveBloom(height, filter)
+
+		assert.NoError(t, td.watcher.Watch(receiver))
+		td.watcher.checkNewBlocks()
+
+		assert.Empty(t, td.eventCh)
+	})
+}
+
+func TestWebhook(t *testing.T) {
+	notifyCh := make(chan *Notification, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := new(Notification)
+		_ = json.NewDecoder(r.Body).Decode(n)
+		notifyCh <- n
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	conf := DefaultConfig()
+	conf.Enable = true
+	conf.Webhooks = []string{server.URL}
+	td := setup(t, conf)
+
+	go td.watcher.notifyLoop()
+	defer td.watcher.Stop()
+
+	receiver := td.commitTransferBlock(2000)
+	assert.NoError(t, td.watcher.Watch(receiver))
+	td.watcher.checkNewBlocks()
+
+	select {
+	case n := <-notifyCh:
+		height := td.mockState.LastBlockHeight()
+		blk := td.mockState.TestStore.Blocks[height]
+
+		assert.Equal(t, receiver.String(), n.Address)
+		assert.Equal(t, height, n.Height)
+		assert.Equal(t, blk.Hash().String(), n.BlockHash)
+		assert.Equal(t, blk.Transactions()[0].ID().String(), n.TxID)
+		assert.Equal(t, int64(2000), n.Amount)
+
+	case <-time.After(5 * time.Second):
+		t.Fatal("webhook is not called")
+	}
+}
+
+func TestProtobufWebhook(t *testing.T) {
+	bodyCh := make(chan []byte, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "application/x-protobuf", r.Header.Get("Content-Type"))
+		body, _ := io.ReadAll(r.Body)
+		bodyCh <- body
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	conf := DefaultConfig()
+	conf.Enable = true
+	conf.Webhooks = []string{server.URL}
+	conf.Encoding = EncodingProtobuf
+	td := setup(t, conf)
+
+	go td.watcher.notifyLoop()
+	defer td.watcher.Stop()
+
+	receiver := td.commitTransferBlock(2000)
+	assert.NoError(t, td.watcher.Watch(receiver))
+	td.watcher.checkNewBlocks()
+
+	select {
+	case body := <-bodyCh:
+		height := td.mockState.LastBlockHeight()
+		blk := td.mockState.TestStore.Blocks[height]
+
+		e, err := event.Decode(body)
+		require.NoError(t, err)
+		assert.Equal(t, event.Version, e.Version)
+		assert.Equal(t, pactus.EventTopic_EVENT_TOPIC_WATCHED_ADDRESS, e.Topic)
+		assert.Equal(t, uint32(0), e.Sequence)
+		assert.Equal(t, receiver.String(), e.GetWatchedAddress().Address)
+		assert.Equal(t, height, e.GetWatchedAddress().Height)
+		assert.Equal(t, blk.Transactions()[0].ID().Bytes(), e.GetWatchedAddress().TxId)
+		assert.Equal(t, int64(2000), e.GetWatchedAddress().Amount)
+
+	case <-time.After(5 * time.Second):
+		t.Fatal("webhook is not called")
+	}
+}
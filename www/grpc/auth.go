@@ -0,0 +1,229 @@
+package grpc
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pactus-project/pactus/util/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// The metadata keys of a signed request.
+const (
+	AuthTimestampKey = "x-pactus-timestamp"
+	AuthNonceKey     = "x-pactus-nonce"
+	AuthSignatureKey = "x-pactus-signature"
+)
+
+// minSecretLength is the minimum length of the shared secret.
+const minSecretLength = 16
+
+// maxNonces limits the number of the nonces that are kept to detect the replayed requests.
+const maxNonces = 100_000
+
+// adminServices are the services that all of their methods need a signed request.
+var adminServices = []string{
+	"/pactus.Wallet/",
+}
+
+// adminMethods are the methods of the other services that need a signed request.
+var adminMethods = []string{
+	"/pactus.Network/SetSafeMode",
+	"/pactus.Network/SetLogConfig",
+	"/pactus.Network/GetPeerEvidence",
+	"/pactus.Blockchain/GenerateBlocks",
+}
+
+type AuthConfig struct {
+	Enable       bool          `toml:"enable"`
+	Secret       string        `toml:"secret"`
+	MaxClockSkew time.Duration `toml:"max_clock_skew"`
+}
+
+// BasicCheck performs basic checks on the configuration.
+func (conf *AuthConfig) BasicCheck() error {
+	if !conf.Enable {
+		return nil
+	}
+	if len(conf.Secret) < minSecretLength {
+		return errors.Errorf(errors.ErrInvalidConfig,
+			"auth secret should be at least %d characters", minSecretLength)
+	}
+	if conf.MaxClockSkew <= 0 {
+		return errors.Errorf(errors.ErrInvalidConfig, "auth max clock skew should be positive")
+	}
+
+	return nil
+}
+
+// isAdminMethod checks if the method needs a signed request.
+func isAdminMethod(fullMethod string) bool {
+	for _, service := range adminServices {
+		if strings.HasPrefix(fullMethod, service) {
+			return true
+		}
+	}
+	for _, method := range adminMethods {
+		if fullMethod == method {
+			return true
+		}
+	}
+
+	return false
+}
+
+// SignRequest returns the signature of a request, which is the HMAC-SHA256 of the method,
+// the timestamp, the nonce and the deterministic encoding of the request.
+// The timestamp and the nonce prevent replaying a captured request,
+// and signing the request prevents changing it.
+func SignRequest(secret, fullMethod string, timestamp int64, nonce string, req any) (string, error) {
+	var data []byte
+	if msg, ok := req.(proto.Message); ok {
+		var err error
+		data, err = proto.MarshalOptions{Deterministic: true}.Marshal(msg)
+		if err != nil {
+			return "", err
+		}
+	}
+	reqHash := sha256.Sum256(data)
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	fmt.Fprintf(mac, "%s\n%d\n%s\n%x", fullMethod, timestamp, nonce, reqHash)
+
+	return hex.EncodeToString(mac.Sum(nil)), nil
+}
+
+// AuthUnaryClientInterceptor returns a client interceptor that signs the requests
+// with a fresh nonce and the current time.
+func AuthUnaryClientInterceptor(secret string) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any,
+		cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption,
+	) error {
+		nonce := make([]byte, 16)
+		if _, err := rand.Read(nonce); err != nil {
+			return err
+		}
+		nonceStr := hex.EncodeToString(nonce)
+		timestamp := time.Now().Unix()
+
+		sig, err := SignRequest(secret, method, timestamp, nonceStr, req)
+		if err != nil {
+			return err
+		}
+
+		ctx = metadata.AppendToOutgoingContext(ctx,
+			AuthTimestampKey, strconv.FormatInt(timestamp, 10),
+			AuthNonceKey, nonceStr,
+			AuthSignatureKey, sig)
+
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
+
+// authenticator verifies the signed requests for the admin methods
+// and rejects the requests that their nonce is used before.
+type authenticator struct {
+	lk sync.Mutex
+
+	config    *AuthConfig
+	nonces    map[string]time.Time
+	lastPrune time.Time
+	now       func() time.Time
+}
+
+func newAuthenticator(conf *AuthConfig) *authenticator {
+	return &authenticator{
+		config: conf,
+		nonces: make(map[string]time.Time),
+		now:    time.Now,
+	}
+}
+
+func (a *authenticator) unaryInterceptor(ctx context.Context, req any,
+	info *grpc.UnaryServerInfo, handler grpc.UnaryHandler,
+) (any, error) {
+	if isAdminMethod(info.FullMethod) {
+		if err := a.authenticate(ctx, info.FullMethod, req); err != nil {
+			return nil, err
+		}
+	}
+
+	return handler(ctx, req)
+}
+
+func (a *authenticator) authenticate(ctx context.Context, fullMethod string, req any) error {
+	md, _ := metadata.FromIncomingContext(ctx)
+	value := func(key string) string {
+		if values := md.Get(key); len(values) > 0 {
+			return values[0]
+		}
+
+		return ""
+	}
+
+	timestampStr := value(AuthTimestampKey)
+	nonce := value(AuthNonceKey)
+	sig := value(AuthSignatureKey)
+	if timestampStr == "" || nonce == "" || sig == "" {
+		return status.Errorf(codes.Unauthenticated, "request is not signed")
+	}
+
+	timestamp, err := strconv.ParseInt(timestampStr, 10, 64)
+	if err != nil {
+		return status.Errorf(codes.Unauthenticated, "invalid timestamp: %v", err)
+	}
+
+	now := a.now()
+	reqTime := time.Unix(timestamp, 0)
+	if reqTime.Before(now.Add(-a.config.MaxClockSkew)) || reqTime.After(now.Add(a.config.MaxClockSkew)) {
+		return status.Errorf(codes.Unauthenticated, "request is expired")
+	}
+
+	expected, err := SignRequest(a.config.Secret, fullMethod, timestamp, nonce, req)
+	if err != nil {
+		return status.Errorf(codes.Internal, "unable to sign the request: %v", err)
+	}
+	if !hmac.Equal([]byte(expected), []byte(sig)) {
+		return status.Errorf(codes.Unauthenticated, "invalid signature")
+	}
+
+	// The nonce is kept until the request is expired,
+	// after that the timestamp check rejects the replayed request.
+	return a.useNonce(nonce, reqTime.Add(a.config.MaxClockSkew), now)
+}
+
+func (a *authenticator) useNonce(nonce string, expiry, now time.Time) error {
+	a.lk.Lock()
+	defer a.lk.Unlock()
+
+	if now.Sub(a.lastPrune) > a.config.MaxClockSkew {
+		for n, exp := range a.nonces {
+			if exp.Before(now) {
+				delete(a.nonces, n)
+			}
+		}
+		a.lastPrune = now
+	}
+
+	if _, ok := a.nonces[nonce]; ok {
+		return status.Errorf(codes.Unauthenticated, "nonce is already used")
+	}
+	if len(a.nonces) >= maxNonces {
+		return status.Errorf(codes.ResourceExhausted, "too many requests")
+	}
+	a.nonces[nonce] = expiry
+
+	return nil
+}
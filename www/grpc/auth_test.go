@@ -0,0 +1,182 @@
+package grpc
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	pactus "github.com/pactus-project/pactus/www/grpc/gen/go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const testSecret = "a-very-secret-key"
+
+func testAuthConfig() *Config {
+	conf := testConfig()
+	conf.EnableWallet = true
+	conf.Auth.Enable = true
+	conf.Auth.Secret = testSecret
+
+	return conf
+}
+
+func (td *testData) dial(t *testing.T, opts ...grpc.DialOption) *grpc.ClientConn {
+	t.Helper()
+
+	opts = append(opts, grpc.WithContextDialer(td.bufDialer),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	conn, err := grpc.DialContext(context.Background(), "bufnet", opts...)
+	require.NoError(t, err)
+
+	return conn
+}
+
+func TestAuthConfigBasicCheck(t *testing.T) {
+	conf := DefaultConfig()
+	assert.NoError(t, conf.BasicCheck())
+
+	conf.Auth.Enable = true
+	assert.ErrorContains(t, conf.BasicCheck(), "auth secret should be at least")
+
+	conf.Auth.Secret = testSecret
+	assert.NoError(t, conf.BasicCheck())
+
+	conf.Auth.MaxClockSkew = 0
+	assert.ErrorContains(t, conf.BasicCheck(), "auth max clock skew should be positive")
+}
+
+func TestSignedRequests(t *testing.T) {
+	td := setup(t, testAuthConfig())
+
+	signedConn := td.dial(t, grpc.WithUnaryInterceptor(AuthUnaryClientInterceptor(testSecret)))
+	unsignedConn := td.dial(t)
+	wrongConn := td.dial(t, grpc.WithUnaryInterceptor(AuthUnaryClientInterceptor("an-invalid-secret")))
+
+	t.Run("Public methods don't need signing", func(t *testing.T) {
+		_, err := pactus.NewBlockchainClient(unsignedConn).GetBlockchainInfo(context.Background(),
+			&pactus.GetBlockchainInfoRequest{})
+		assert.NoError(t, err)
+	})
+
+	t.Run("Admin methods need signing", func(t *testing.T) {
+		_, err := pactus.NewNetworkClient(unsignedConn).SetSafeMode(context.Background(),
+			&pactus.SetSafeModeRequest{Enable: true})
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+		_, err = pactus.NewWalletClient(unsignedConn).UnloadWallet(context.Background(),
+			&pactus.UnloadWalletRequest{WalletName: "test"})
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+
+	pub, _ := td.RandBLSKeyPair()
+	req := &pactus.GetValidatorAddressRequest{PublicKey: pub.String()}
+
+	t.Run("Invalid secret", func(t *testing.T) {
+		_, err := pactus.NewWalletClient(wrongConn).GetValidatorAddress(context.Background(), req)
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+
+	t.Run("Signed requests are accepted", func(t *testing.T) {
+		res, err := pactus.NewWalletClient(signedConn).GetValidatorAddress(context.Background(), req)
+		assert.NoError(t, err)
+		assert.Equal(t, pub.ValidatorAddress().String(), res.Address)
+
+		// Each request has a new nonce.
+		_, err = pactus.NewWalletClient(signedConn).GetValidatorAddress(context.Background(), req)
+		assert.NoError(t, err)
+	})
+
+	assert.NoError(t, signedConn.Close())
+	assert.NoError(t, unsignedConn.Close())
+	assert.NoError(t, wrongConn.Close())
+	td.StopServer()
+}
+
+func TestReplayedRequests(t *testing.T) {
+	td := setup(t, testAuthConfig())
+	conn := td.dial(t)
+	client := pactus.NewWalletClient(conn)
+
+	method := "/pactus.Wallet/GetValidatorAddress"
+	signedContext := func(timestamp int64, nonce string, req *pactus.GetValidatorAddressRequest) context.Context {
+		sig, err := SignRequest(testSecret, method, timestamp, nonce, req)
+		require.NoError(t, err)
+
+		return metadata.AppendToOutgoingContext(context.Background(),
+			AuthTimestampKey, strconv.FormatInt(timestamp, 10),
+			AuthNonceKey, nonce,
+			AuthSignatureKey, sig)
+	}
+	pub1, _ := td.RandBLSKeyPair()
+	pub2, _ := td.RandBLSKeyPair()
+	req := &pactus.GetValidatorAddressRequest{PublicKey: pub1.String()}
+
+	t.Run("Replaying a request", func(t *testing.T) {
+		ctx := signedContext(time.Now().Unix(), "nonce-1", req)
+
+		_, err := client.GetValidatorAddress(ctx, req)
+		assert.NoError(t, err)
+
+		_, err = client.GetValidatorAddress(ctx, req)
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+		assert.Contains(t, status.Convert(err).Message(), "nonce is already used")
+	})
+
+	t.Run("Changing a signed request", func(t *testing.T) {
+		ctx := signedContext(time.Now().Unix(), "nonce-2", req)
+
+		_, err := client.GetValidatorAddress(ctx,
+			&pactus.GetValidatorAddressRequest{PublicKey: pub2.String()})
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+		assert.Contains(t, status.Convert(err).Message(), "invalid signature")
+	})
+
+	t.Run("Expired request", func(t *testing.T) {
+		ctx := signedContext(time.Now().Add(-time.Minute).Unix(), "nonce-3", req)
+
+		_, err := client.GetValidatorAddress(ctx, req)
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+		assert.Contains(t, status.Convert(err).Message(), "request is expired")
+	})
+
+	t.Run("Request from future", func(t *testing.T) {
+		ctx := signedContext(time.Now().Add(time.Minute).Unix(), "nonce-4", req)
+
+		_, err := client.GetValidatorAddress(ctx, req)
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+
+	assert.NoError(t, conn.Close())
+	td.StopServer()
+}
+
+func TestNoncePruning(t *testing.T) {
+	conf := DefaultConfig().Auth
+	auth := newAuthenticator(&conf)
+	now := time.Now()
+
+	assert.NoError(t, auth.useNonce("nonce-1", now.Add(conf.MaxClockSkew), now))
+	assert.Error(t, auth.useNonce("nonce-1", now.Add(conf.MaxClockSkew), now))
+
+	// After the request is expired, the nonce is removed.
+	later := now.Add(3 * conf.MaxClockSkew)
+	assert.NoError(t, auth.useNonce("nonce-2", later.Add(conf.MaxClockSkew), later))
+	assert.Len(t, auth.nonces, 1)
+}
+
+func TestIsAdminMethod(t *testing.T) {
+	assert.True(t, isAdminMethod("/pactus.Wallet/SignRawTransaction"))
+	assert.True(t, isAdminMethod("/pactus.Network/SetSafeMode"))
+	assert.True(t, isAdminMethod("/pactus.Network/SetLogConfig"))
+	assert.True(t, isAdminMethod("/pactus.Network/GetPeerEvidence"))
+	assert.True(t, isAdminMethod("/pactus.Blockchain/GenerateBlocks"))
+	assert.False(t, isAdminMethod("/pactus.Network/GetNodeInfo"))
+	assert.False(t, isAdminMethod("/pactus.Blockchain/GetBlock"))
+}
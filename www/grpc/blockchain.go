@@ -1,11 +1,21 @@
 package grpc
 
 import (
+	"bytes"
 	"context"
+	"slices"
 
 	"github.com/pactus-project/pactus/crypto"
 	"github.com/pactus-project/pactus/crypto/hash"
+	"github.com/pactus-project/pactus/genesis"
+	"github.com/pactus-project/pactus/state"
+	"github.com/pactus-project/pactus/state/statistics"
+	"github.com/pactus-project/pactus/store"
 	"github.com/pactus-project/pactus/types/account"
+	"github.com/pactus-project/pactus/types/block"
+	"github.com/pactus-project/pactus/types/certificate"
+	"github.com/pactus-project/pactus/types/participation"
+	"github.com/pactus-project/pactus/types/tx"
 	"github.com/pactus-project/pactus/types/validator"
 	"github.com/pactus-project/pactus/types/vote"
 	pactus "github.com/pactus-project/pactus/www/grpc/gen/go"
@@ -13,6 +23,15 @@ import (
 	"google.golang.org/grpc/status"
 )
 
+const (
+	// maxBatchSize is the maximum number of the entries that can be queried in a batch request.
+	maxBatchSize = 1000
+	// maxScanRange is the maximum number of the blocks that can be scanned in a request.
+	maxScanRange = 10000
+	// maxGenerateBlocks is the maximum number of the blocks that can be generated in a request.
+	maxGenerateBlocks = 1000
+)
+
 type blockchainServer struct {
 	*Server
 }
@@ -40,6 +59,7 @@ func (s *blockchainServer) GetBlockchainInfo(_ context.Context,
 		TotalPower:          s.state.TotalPower(),
 		CommitteePower:      s.state.CommitteePower(),
 		CommitteeValidators: cv,
+		PendingTransactions: int32(s.state.PendingTxsCount()),
 	}, nil
 }
 
@@ -99,6 +119,80 @@ func (s *blockchainServer) GetBlockHeight(_ context.Context,
 	}, nil
 }
 
+func (s *blockchainServer) GetBlockHeaders(ctx context.Context,
+	req *pactus.GetBlockHeadersRequest,
+) (*pactus.GetBlockHeadersResponse, error) {
+	if req.Count == 0 || req.Count > maxBatchSize {
+		return nil, status.Errorf(codes.InvalidArgument, "count should be between 1 and %d", maxBatchSize)
+	}
+
+	lastHeight := s.state.LastBlockHeight()
+	fromHeight := max(req.FromHeight, 1)
+	if fromHeight > lastHeight {
+		return nil, status.Errorf(codes.NotFound, "block not found")
+	}
+	toHeight := min(fromHeight+req.Count-1, lastHeight)
+
+	headers := make([]*pactus.BlockHeaderCertificate, 0, toHeight-fromHeight+1)
+	for height := fromHeight; height <= toHeight; height++ {
+		if err := contextError(ctx); err != nil {
+			return nil, err
+		}
+
+		// The header proofs are retained for the pruned blocks,
+		// so the pruned nodes can still serve the light clients.
+		proof := s.state.HeaderProof(height)
+		if proof == nil {
+			return nil, status.Errorf(codes.NotFound, "block header not found")
+		}
+
+		// The certificate of the last block can still get more votes,
+		// so it is read from the state.
+		cert := proof.Certificate
+		if height == lastHeight {
+			cert = s.state.LastCertificate()
+		}
+
+		info, err := headerCertificateToProto(proof, cert)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, err.Error())
+		}
+		headers = append(headers, info)
+	}
+
+	return &pactus.GetBlockHeadersResponse{Headers: headers}, nil
+}
+
+func (s *blockchainServer) GetBeacon(_ context.Context,
+	req *pactus.GetBeaconRequest,
+) (*pactus.GetBeaconResponse, error) {
+	height := req.GetHeight()
+	beacon := s.state.Beacon(height)
+	if beacon.IsUndef() {
+		return nil, status.Errorf(codes.NotFound, "beacon not found with this height")
+	}
+
+	return &pactus.GetBeaconResponse{
+		Height: height,
+		Beacon: beacon.Bytes(),
+	}, nil
+}
+
+// block returns the committed block at the given height.
+func (s *blockchainServer) block(height uint32) (*block.Block, error) {
+	committedBlock := s.state.CommittedBlock(height)
+	if committedBlock == nil {
+		return nil, status.Errorf(codes.NotFound, "block not found")
+	}
+
+	blk, err := committedBlock.ToBlock()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+
+	return blk, nil
+}
+
 func (s *blockchainServer) GetBlock(_ context.Context,
 	req *pactus.GetBlockRequest,
 ) (*pactus.GetBlockResponse, error) {
@@ -173,7 +267,14 @@ func (s *blockchainServer) GetAccount(_ context.Context,
 	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %v", err)
 	}
-	acc := s.state.AccountByAddress(addr)
+	reader, err := s.stateReader(req.AtHeight, req.AtStateRoot)
+	if err != nil {
+		return nil, err
+	}
+	acc, err := reader.account(addr)
+	if err != nil {
+		return nil, err
+	}
 	if acc == nil {
 		return nil, status.Errorf(codes.NotFound, "account not found")
 	}
@@ -184,10 +285,58 @@ func (s *blockchainServer) GetAccount(_ context.Context,
 	return res, nil
 }
 
+func (s *blockchainServer) GetAccounts(ctx context.Context,
+	req *pactus.GetAccountsRequest,
+) (*pactus.GetAccountsResponse, error) {
+	if len(req.Addresses) > maxBatchSize {
+		return nil, status.Errorf(codes.InvalidArgument, "too many addresses, maximum is %d", maxBatchSize)
+	}
+	addrs := make([]crypto.Address, 0, len(req.Addresses))
+	for _, str := range req.Addresses {
+		addr, err := crypto.AddressFromString(str)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid address %s: %v", str, err)
+		}
+		addrs = append(addrs, addr)
+	}
+	reader, err := s.stateReader(req.AtHeight, req.AtStateRoot)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]*pactus.AccountResult, 0, len(addrs))
+	for _, addr := range addrs {
+		if err := contextError(ctx); err != nil {
+			return nil, err
+		}
+		acc, err := reader.account(addr)
+		if err != nil {
+			return nil, err
+		}
+		result := &pactus.AccountResult{
+			Address: addr.String(),
+		}
+		if acc != nil {
+			result.Found = true
+			result.Account = s.accountToProto(addr, acc)
+		}
+		results = append(results, result)
+	}
+
+	return &pactus.GetAccountsResponse{Results: results}, nil
+}
+
 func (s *blockchainServer) GetValidatorByNumber(_ context.Context,
 	req *pactus.GetValidatorByNumberRequest,
 ) (*pactus.GetValidatorResponse, error) {
-	val := s.state.ValidatorByNumber(req.Number)
+	reader, err := s.stateReader(req.AtHeight, req.AtStateRoot)
+	if err != nil {
+		return nil, err
+	}
+	val, err := reader.validatorByNumber(req.Number)
+	if err != nil {
+		return nil, err
+	}
 	if val == nil {
 		return nil, status.Errorf(codes.NotFound, "validator not found")
 	}
@@ -204,7 +353,14 @@ func (s *blockchainServer) GetValidator(_ context.Context,
 	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "invalid validator address: %v", err.Error())
 	}
-	val := s.state.ValidatorByAddress(addr)
+	reader, err := s.stateReader(req.AtHeight, req.AtStateRoot)
+	if err != nil {
+		return nil, err
+	}
+	val, err := reader.validatorByAddress(addr)
+	if err != nil {
+		return nil, err
+	}
 	if val == nil {
 		return nil, status.Errorf(codes.NotFound, "validator not found")
 	}
@@ -214,16 +370,148 @@ func (s *blockchainServer) GetValidator(_ context.Context,
 	}, nil
 }
 
+func (s *blockchainServer) GetValidators(ctx context.Context,
+	req *pactus.GetValidatorsRequest,
+) (*pactus.GetValidatorsResponse, error) {
+	if len(req.Addresses) != 0 && len(req.Numbers) != 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "only one of addresses or numbers should be set")
+	}
+	if len(req.Addresses) > maxBatchSize || len(req.Numbers) > maxBatchSize {
+		return nil, status.Errorf(codes.InvalidArgument, "too many validators, maximum is %d", maxBatchSize)
+	}
+	addrs := make([]crypto.Address, 0, len(req.Addresses))
+	for _, str := range req.Addresses {
+		addr, err := crypto.AddressFromString(str)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid validator address %s: %v", str, err)
+		}
+		addrs = append(addrs, addr)
+	}
+	reader, err := s.stateReader(req.AtHeight, req.AtStateRoot)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]*pactus.ValidatorResult, 0, len(addrs)+len(req.Numbers))
+	appendResult := func(val *validator.Validator) {
+		result := &pactus.ValidatorResult{}
+		if val != nil {
+			result.Found = true
+			result.Validator = s.validatorToProto(val)
+		}
+		results = append(results, result)
+	}
+	for _, addr := range addrs {
+		if err := contextError(ctx); err != nil {
+			return nil, err
+		}
+		val, err := reader.validatorByAddress(addr)
+		if err != nil {
+			return nil, err
+		}
+		appendResult(val)
+	}
+	for _, num := range req.Numbers {
+		if err := contextError(ctx); err != nil {
+			return nil, err
+		}
+		val, err := reader.validatorByNumber(num)
+		if err != nil {
+			return nil, err
+		}
+		appendResult(val)
+	}
+
+	return &pactus.GetValidatorsResponse{Results: results}, nil
+}
+
+// stateReader reads the accounts and validators either from the latest state,
+// or from the snapshot of the state at a recent height.
+type stateReader struct {
+	state  state.Facade
+	height uint32
+	pinned bool
+}
+
+// stateReader returns a reader for the state at the given height or state root.
+// If neither the height nor the state root is set, the latest state is read.
+func (s *blockchainServer) stateReader(atHeight uint32, atStateRoot []byte) (*stateReader, error) {
+	if len(atStateRoot) == 0 {
+		return &stateReader{state: s.state, height: atHeight, pinned: atHeight != 0}, nil
+	}
+
+	if atHeight != 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "only one of height or state root should be set")
+	}
+
+	stateRoot, err := hash.FromBytes(atStateRoot)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid state root: %v", err.Error())
+	}
+
+	height, err := s.state.SnapshotHeight(stateRoot)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "no snapshot for state root %s", stateRoot.String())
+	}
+
+	return &stateReader{state: s.state, height: height, pinned: true}, nil
+}
+
+func (r *stateReader) account(addr crypto.Address) (*account.Account, error) {
+	if !r.pinned {
+		return r.state.AccountByAddress(addr), nil
+	}
+
+	acc, err := r.state.AccountByAddressAt(addr, r.height)
+	if err != nil {
+		return nil, statusError(codes.OutOfRange, err, "unable to read the state")
+	}
+
+	return acc, nil
+}
+
+func (r *stateReader) validatorByAddress(addr crypto.Address) (*validator.Validator, error) {
+	if !r.pinned {
+		return r.state.ValidatorByAddress(addr), nil
+	}
+
+	val, err := r.state.ValidatorByAddressAt(addr, r.height)
+	if err != nil {
+		return nil, statusError(codes.OutOfRange, err, "unable to read the state")
+	}
+
+	return val, nil
+}
+
+func (r *stateReader) validatorByNumber(num int32) (*validator.Validator, error) {
+	if !r.pinned {
+		return r.state.ValidatorByNumber(num), nil
+	}
+
+	val, err := r.state.ValidatorByNumberAt(num, r.height)
+	if err != nil {
+		return nil, statusError(codes.OutOfRange, err, "unable to read the state")
+	}
+
+	return val, nil
+}
+
 func (s *blockchainServer) GetValidatorAddresses(_ context.Context,
-	_ *pactus.GetValidatorAddressesRequest,
+	req *pactus.GetValidatorAddressesRequest,
 ) (*pactus.GetValidatorAddressesResponse, error) {
+	pg, err := parsePageRequest[string](req.Page)
+	if err != nil {
+		return nil, err
+	}
+
 	addresses := s.state.ValidatorAddresses()
 	addressesPB := make([]string, 0, len(addresses))
 	for _, address := range addresses {
 		addressesPB = append(addressesPB, address.String())
 	}
+	addressesPB, pageRes := paginate(addressesPB, pg)
 
-	return &pactus.GetValidatorAddressesResponse{Addresses: addressesPB}, nil
+	return &pactus.GetValidatorAddressesResponse{Addresses: addressesPB, Page: pageRes}, nil
 }
 
 func (s *blockchainServer) GetPublicKey(_ context.Context,
@@ -236,16 +524,475 @@ func (s *blockchainServer) GetPublicKey(_ context.Context,
 
 	publicKey, err := s.state.PublicKey(addr)
 	if err != nil {
-		return nil, status.Errorf(codes.NotFound, "public key not found")
+		publicKey = s.revealedPublicKey(addr)
+		if publicKey == nil {
+			return nil, status.Errorf(codes.NotFound, "public key not found")
+		}
 	}
 
 	return &pactus.GetPublicKeyResponse{PublicKey: publicKey.String()}, nil
 }
 
+// revealedPublicKey looks up the public key of an address that hasn't signed any transaction.
+// The public key is revealed if the address belongs to a bonded validator,
+// or if the other address of the same key, like the account address of a validator,
+// has signed a transaction.
+func (s *blockchainServer) revealedPublicKey(addr crypto.Address) crypto.PublicKey {
+	var twinAddr crypto.Address
+	switch addr.Type() {
+	case crypto.AddressTypeValidator:
+		if val := s.state.ValidatorByAddress(addr); val != nil {
+			return val.PublicKey()
+		}
+		twinAddr = crypto.NewAddress(crypto.AddressTypeBLSAccount, addr.Bytes()[1:])
+
+	case crypto.AddressTypeBLSAccount:
+		twinAddr = crypto.NewAddress(crypto.AddressTypeValidator, addr.Bytes()[1:])
+
+	default:
+		return nil
+	}
+
+	publicKey, err := s.state.PublicKey(twinAddr)
+	if err != nil {
+		return nil
+	}
+	if err := publicKey.VerifyAddress(addr); err != nil {
+		return nil
+	}
+
+	return publicKey
+}
+
+func (s *blockchainServer) GetChainStatistics(_ context.Context,
+	req *pactus.GetChainStatisticsRequest,
+) (*pactus.GetChainStatisticsResponse, error) {
+	if req.Window == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "window should be greater than zero")
+	}
+
+	stats := s.state.ChainStatistics(req.Window)
+	if stats == nil {
+		return nil, status.Errorf(codes.NotFound, "no block committed")
+	}
+
+	percentiles := make([]*pactus.FeePercentile, 0, len(stats.FeePercentiles))
+	for i, fee := range stats.FeePercentiles {
+		percentiles = append(percentiles, &pactus.FeePercentile{
+			Percentile: statistics.FeePercentiles[i],
+			Fee:        fee,
+		})
+	}
+
+	return &pactus.GetChainStatisticsResponse{
+		FromHeight:           stats.FromHeight,
+		ToHeight:             stats.ToHeight,
+		AverageBlockInterval: stats.BlockInterval.Seconds(),
+		TotalTransactions:    int64(stats.TotalTransactions),
+		AverageTransactions:  stats.AvgTransactions,
+		FeePercentiles:       percentiles,
+		BlockFullness:        stats.BlockFullness,
+	}, nil
+}
+
+func (s *blockchainServer) GetFeePercentiles(_ context.Context,
+	req *pactus.GetFeePercentilesRequest,
+) (*pactus.GetFeePercentilesResponse, error) {
+	for _, p := range req.Percentiles {
+		if p > 100 {
+			return nil, status.Errorf(codes.InvalidArgument, "percentile should be between 0 and 100")
+		}
+	}
+	percentiles := req.Percentiles
+	if len(percentiles) == 0 {
+		percentiles = statistics.FeePercentiles
+	}
+
+	from, to := req.FromHeight, req.ToHeight
+	if req.Window > 0 {
+		if from != 0 || to != 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "window and range can't be set together")
+		}
+		to = s.state.LastBlockHeight()
+		from = 1
+		if to > req.Window {
+			from = to - req.Window + 1
+		}
+	}
+
+	res, err := s.state.FeeHistogram(from, to)
+	if err != nil {
+		return nil, statusError(codes.InvalidArgument, err, "unable to calculate the fee percentiles")
+	}
+
+	feePercentiles := make([]*pactus.FeePercentile, 0, len(percentiles))
+	for _, p := range percentiles {
+		feePercentiles = append(feePercentiles, &pactus.FeePercentile{
+			Percentile: p,
+			Fee:        res.Histogram.Percentile(p),
+		})
+	}
+
+	return &pactus.GetFeePercentilesResponse{
+		FromHeight:        res.FromHeight,
+		ToHeight:          res.ToHeight,
+		IndexedBlocks:     res.Blocks,
+		TotalTransactions: int64(res.Histogram.Count()),
+		FeePercentiles:    feePercentiles,
+	}, nil
+}
+
+func (s *blockchainServer) GetBlockBloom(_ context.Context,
+	req *pactus.GetBlockBloomRequest,
+) (*pactus.GetBlockBloomResponse, error) {
+	filter := s.state.BlockBloom(req.Height)
+	if filter == nil {
+		return nil, status.Errorf(codes.NotFound, "bloom filter not found with this height")
+	}
+
+	return &pactus.GetBlockBloomResponse{
+		Height:    req.Height,
+		Filter:    filter.Bytes(),
+		HashCount: uint32(filter.HashCount()),
+	}, nil
+}
+
+func (s *blockchainServer) ScanBlocks(ctx context.Context,
+	req *pactus.ScanBlocksRequest,
+) (*pactus.ScanBlocksResponse, error) {
+	if len(req.Addresses) > maxBatchSize {
+		return nil, status.Errorf(codes.InvalidArgument, "too many addresses, maximum is %d", maxBatchSize)
+	}
+	pg, err := parsePageRequest[uint32](req.Page)
+	if err != nil {
+		return nil, err
+	}
+	filter, err := parseListFilter(req.Filter, filterHeightRange|filterPayloadType|filterAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	addrs := make([]crypto.Address, 0, len(req.Addresses)+1)
+	for _, str := range req.Addresses {
+		addr, err := crypto.AddressFromString(str)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid address %s: %v", str, err)
+		}
+		addrs = append(addrs, addr)
+	}
+	if filter.address != nil {
+		addrs = append(addrs, *filter.address)
+	}
+
+	lastHeight := s.state.LastBlockHeight()
+	reqFromHeight, reqToHeight := req.FromHeight, req.ToHeight
+	if filter.hasHeightRange() {
+		if req.FromHeight != 0 || req.ToHeight != 0 {
+			return nil, status.Errorf(codes.InvalidArgument,
+				"height range should be set either in the request or in the filter")
+		}
+		reqFromHeight, reqToHeight = filter.fromHeight, filter.toHeight
+		if reqToHeight == 0 {
+			reqToHeight = lastHeight
+		}
+	}
+
+	fromHeight := max(reqFromHeight, 1)
+	toHeight := min(reqToHeight, lastHeight)
+	if reqFromHeight > reqToHeight {
+		return nil, status.Errorf(codes.InvalidArgument, "from height is greater than to height")
+	}
+	if toHeight >= fromHeight && toHeight-fromHeight >= maxScanRange {
+		return nil, status.Errorf(codes.InvalidArgument, "too many blocks, maximum is %d", maxScanRange)
+	}
+
+	heights := make([]uint32, 0)
+	for height := fromHeight; height <= toHeight; height++ {
+		if err := contextError(ctx); err != nil {
+			return nil, err
+		}
+		if !s.mayTouch(height, addrs, filter) {
+			continue
+		}
+
+		if filter.payloadType != 0 {
+			// The payload type is not in the bloom filter, so the block should be read.
+			blk, err := s.block(height)
+			if err != nil {
+				return nil, err
+			}
+			if !slices.ContainsFunc(blk.Transactions(), func(trx *tx.Tx) bool {
+				return filter.matchTx(trx, addrs)
+			}) {
+				continue
+			}
+		}
+		heights = append(heights, height)
+	}
+	heights, pageRes := paginate(heights, pg)
+
+	return &pactus.ScanBlocksResponse{Heights: heights, Page: pageRes}, nil
+}
+
+// mayTouch checks the bloom filter of the block to see if the block may touch any of the addresses.
+// If only the payload type is filtered, all the blocks are the candidates.
+func (s *blockchainServer) mayTouch(height uint32, addrs []crypto.Address, filter *listFilter) bool {
+	if len(addrs) == 0 && filter.payloadType != 0 {
+		return true
+	}
+
+	bloom := s.state.BlockBloom(height)
+	if bloom == nil {
+		// Without a filter, the block can't be skipped.
+		return true
+	}
+
+	for _, addr := range addrs {
+		if bloom.Contains(addr.Bytes()) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (s *blockchainServer) GetBlockReward(_ context.Context,
+	req *pactus.GetBlockRewardRequest,
+) (*pactus.GetBlockRewardResponse, error) {
+	info := s.state.RewardInfo(req.Height)
+	if info == nil {
+		return nil, status.Errorf(codes.NotFound, "block reward not found with this height")
+	}
+
+	return &pactus.GetBlockRewardResponse{
+		Height:                info.Height,
+		RewardAddress:         info.RewardAddress.String(),
+		BlockReward:           info.BlockReward,
+		Fees:                  info.Fees,
+		TotalPayout:           info.TotalPayout(),
+		TreasuryBalanceBefore: info.TreasuryBalanceBefore,
+		TreasuryBalanceAfter:  info.TreasuryBalanceAfter,
+		TreasuryChange:        info.TreasuryChange(),
+	}, nil
+}
+
+func (s *blockchainServer) GetCommittee(_ context.Context,
+	req *pactus.GetCommitteeRequest,
+) (*pactus.GetCommitteeResponse, error) {
+	cmt, err := s.state.CommitteeAt(req.Height)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "committee not found with this height: %v", err)
+	}
+
+	vals := make([]*pactus.ValidatorInfo, 0, cmt.Size())
+	for _, val := range cmt.Validators() {
+		vals = append(vals, s.validatorToProto(val))
+	}
+
+	return &pactus.GetCommitteeResponse{
+		Height:          req.Height,
+		Validators:      vals,
+		CommitteePower:  cmt.TotalPower(),
+		ProposerAddress: cmt.Proposer(0).Address().String(),
+	}, nil
+}
+
+func (s *blockchainServer) VerifyParticipationReport(_ context.Context,
+	req *pactus.VerifyParticipationReportRequest,
+) (*pactus.VerifyParticipationReportResponse, error) {
+	report, err := participation.FromBytes(req.Report)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid report: %v", err)
+	}
+	if err := report.BasicCheck(); err != nil {
+		return nil, statusError(codes.InvalidArgument, err, "invalid report")
+	}
+
+	val := s.state.ValidatorByAddress(report.Validator())
+	if val == nil {
+		return nil, status.Errorf(codes.NotFound, "validator not found")
+	}
+
+	chainReport, err := s.state.ParticipationReport(report.Validator(),
+		report.FromHeight(), report.ToHeight())
+	if err != nil {
+		return nil, statusError(codes.InvalidArgument, err, "unable to calculate the participation")
+	}
+
+	return &pactus.VerifyParticipationReportResponse{
+		Report:         participationReportToProto(report),
+		ValidSignature: report.Verify(val.PublicKey()) == nil,
+		MatchesChain:   report.Equal(chainReport),
+		ChainReport:    participationReportToProto(chainReport),
+	}, nil
+}
+
+func participationReportToProto(report *participation.Report) *pactus.ParticipationReportInfo {
+	return &pactus.ParticipationReportInfo{
+		ValidatorAddress: report.Validator().String(),
+		FromHeight:       report.FromHeight(),
+		ToHeight:         report.ToHeight(),
+		InCommittee:      report.InCommittee(),
+		VotesCast:        report.VotesCast(),
+		BlocksProposed:   report.BlocksProposed(),
+		Uptime:           report.Uptime(),
+	}
+}
+
+func (s *blockchainServer) WatchAddress(_ context.Context,
+	req *pactus.WatchAddressRequest,
+) (*pactus.WatchAddressResponse, error) {
+	if err := s.checkWatcher(); err != nil {
+		return nil, err
+	}
+
+	addr, err := crypto.AddressFromString(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %v", err)
+	}
+
+	if err := s.watcher.Watch(addr); err != nil {
+		return nil, status.Errorf(codes.ResourceExhausted, "%s", err.Error())
+	}
+
+	return &pactus.WatchAddressResponse{Address: addr.String()}, nil
+}
+
+func (s *blockchainServer) UnwatchAddress(_ context.Context,
+	req *pactus.UnwatchAddressRequest,
+) (*pactus.UnwatchAddressResponse, error) {
+	if err := s.checkWatcher(); err != nil {
+		return nil, err
+	}
+
+	addr, err := crypto.AddressFromString(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %v", err)
+	}
+
+	if !s.watcher.Unwatch(addr) {
+		return nil, status.Errorf(codes.NotFound, "address is not watched")
+	}
+
+	return &pactus.UnwatchAddressResponse{Address: addr.String()}, nil
+}
+
+func (s *blockchainServer) GetWatchedAddresses(_ context.Context,
+	req *pactus.GetWatchedAddressesRequest,
+) (*pactus.GetWatchedAddressesResponse, error) {
+	if err := s.checkWatcher(); err != nil {
+		return nil, err
+	}
+
+	pg, err := parsePageRequest[string](req.Page)
+	if err != nil {
+		return nil, err
+	}
+
+	addrs := s.watcher.Addresses()
+	res := make([]string, 0, len(addrs))
+	for _, addr := range addrs {
+		res = append(res, addr.String())
+	}
+	res, pageRes := paginate(res, pg)
+
+	return &pactus.GetWatchedAddressesResponse{Addresses: res, Page: pageRes}, nil
+}
+
+func (s *blockchainServer) GetStorageInfo(_ context.Context,
+	_ *pactus.GetStorageInfoRequest,
+) (*pactus.GetStorageInfoResponse, error) {
+	infos := s.state.StoreFamilies()
+	families := make([]*pactus.StoreFamilyInfo, 0, len(infos))
+	for _, info := range infos {
+		families = append(families, &pactus.StoreFamilyInfo{
+			Name:         info.Family.String(),
+			Retention:    info.Retention,
+			PrunedHeight: info.PrunedHeight,
+			Size:         info.Size,
+		})
+	}
+
+	return &pactus.GetStorageInfoResponse{Families: families}, nil
+}
+
+// GenerateBlocks is an admin method. It is rejected on the networks other than the simnet,
+// that the blocks are only produced by the consensus.
+func (s *blockchainServer) GenerateBlocks(_ context.Context,
+	req *pactus.GenerateBlocksRequest,
+) (*pactus.GenerateBlocksResponse, error) {
+	if chainType := s.state.Genesis().ChainType(); chainType != genesis.Simnet {
+		return nil, status.Errorf(codes.FailedPrecondition, "blocks can only be generated on the simnet, not the %s", chainType)
+	}
+
+	if req.Count == 0 || req.Count > maxGenerateBlocks {
+		return nil, status.Errorf(codes.InvalidArgument, "count should be between 1 and %d", maxGenerateBlocks)
+	}
+
+	rewardAddr, err := crypto.AddressFromString(req.RewardAddress)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid reward address: %v", err)
+	}
+	if !rewardAddr.IsAccountAddress() {
+		return nil, status.Errorf(codes.InvalidArgument, "reward address should be an account address")
+	}
+
+	hashes, err := s.state.GenerateBlocks(int(req.Count), rewardAddr)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "generated %d of %d blocks: %v", len(hashes), req.Count, err)
+	}
+
+	blockHashes := make([][]byte, 0, len(hashes))
+	for _, h := range hashes {
+		blockHashes = append(blockHashes, h.Bytes())
+	}
+
+	return &pactus.GenerateBlocksResponse{BlockHashes: blockHashes}, nil
+}
+
+// checkWatcher returns an error if the address watcher is not enabled in the node.
+func (s *blockchainServer) checkWatcher() error {
+	if s.watcher == nil || !s.watcher.IsEnabled() {
+		return status.Errorf(codes.FailedPrecondition, "watcher is not enabled")
+	}
+
+	return nil
+}
+
+func headerCertificateToProto(proof *store.HeaderProof,
+	cert *certificate.Certificate,
+) (*pactus.BlockHeaderCertificate, error) {
+	headerBuf := bytes.NewBuffer(make([]byte, 0, proof.Header.SerializeSize()))
+	if err := proof.Header.Encode(headerBuf); err != nil {
+		return nil, err
+	}
+
+	info := &pactus.BlockHeaderCertificate{
+		Height:   proof.Height,
+		Hash:     proof.BlockHash().Bytes(),
+		Header:   headerBuf.Bytes(),
+		TxsRoot:  proof.TxsRoot.Bytes(),
+		TxsCount: proof.TxsCount,
+	}
+	if cert != nil {
+		certBuf := bytes.NewBuffer(make([]byte, 0, cert.SerializeSize()))
+		if err := cert.Encode(certBuf); err != nil {
+			return nil, err
+		}
+		info.Certificate = certBuf.Bytes()
+	}
+	// The genesis block has no previous certificate.
+	if !proof.PrevCertHash.IsUndef() {
+		info.PrevCertHash = proof.PrevCertHash.Bytes()
+	}
+
+	return info, nil
+}
+
 func (s *blockchainServer) validatorToProto(val *validator.Validator) *pactus.ValidatorInfo {
 	data, _ := val.Bytes()
 
-	return &pactus.ValidatorInfo{
+	info := &pactus.ValidatorInfo{
 		Hash:                val.Hash().Bytes(),
 		Data:                data,
 		PublicKey:           val.PublicKey().String(),
@@ -257,6 +1004,14 @@ func (s *blockchainServer) validatorToProto(val *validator.Validator) *pactus.Va
 		UnbondingHeight:     val.UnbondingHeight(),
 		AvailabilityScore:   s.state.AvailabilityScore(val.Number()),
 	}
+	if val.IsDelegated() {
+		info.Delegator = val.Delegator().String()
+	}
+	if val.RewardSplit() != nil {
+		info.RewardSplit = rewardSharesToProto(val.RewardSplit())
+	}
+
+	return info
 }
 
 func (s *blockchainServer) accountToProto(addr crypto.Address, acc *account.Account) *pactus.AccountInfo {
@@ -1,11 +1,27 @@
 package grpc
 
 import (
+	"bytes"
 	"context"
 	"testing"
 
+	"github.com/pactus-project/pactus/crypto"
+	"github.com/pactus-project/pactus/crypto/bls"
+	"github.com/pactus-project/pactus/genesis"
+	"github.com/pactus-project/pactus/types/account"
+	"github.com/pactus-project/pactus/types/block"
+	"github.com/pactus-project/pactus/types/certificate"
+	"github.com/pactus-project/pactus/types/param"
+	"github.com/pactus-project/pactus/types/participation"
+	"github.com/pactus-project/pactus/types/receipt"
+	"github.com/pactus-project/pactus/types/validator"
+	"github.com/pactus-project/pactus/util"
+	"github.com/pactus-project/pactus/util/bloom"
 	pactus "github.com/pactus-project/pactus/www/grpc/gen/go"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func TestGetBlock(t *testing.T) {
@@ -77,6 +93,173 @@ func TestGetBlock(t *testing.T) {
 	td.StopServer()
 }
 
+func TestGetBlockHeaders(t *testing.T) {
+	td := setup(t, nil)
+	conn, client := td.blockchainClient(t)
+
+	blocks := make([]*block.Block, 0)
+	for height := uint32(1); height <= 10; height++ {
+		blocks = append(blocks, td.mockState.TestStore.AddTestBlock(height))
+	}
+
+	t.Run("Invalid count", func(t *testing.T) {
+		_, err := client.GetBlockHeaders(context.Background(),
+			&pactus.GetBlockHeadersRequest{FromHeight: 1, Count: 0})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		_, err = client.GetBlockHeaders(context.Background(),
+			&pactus.GetBlockHeadersRequest{FromHeight: 1, Count: maxBatchSize + 1})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("Block not found", func(t *testing.T) {
+		_, err := client.GetBlockHeaders(context.Background(),
+			&pactus.GetBlockHeadersRequest{FromHeight: 11, Count: 1})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	t.Run("Ok, headers are verifiable", func(t *testing.T) {
+		res, err := client.GetBlockHeaders(context.Background(),
+			&pactus.GetBlockHeadersRequest{FromHeight: 4, Count: 5})
+		require.NoError(t, err)
+		require.Len(t, res.Headers, 5)
+
+		for i, info := range res.Headers {
+			blk := blocks[3+i]
+			assert.Equal(t, uint32(4+i), info.Height)
+			assert.Equal(t, blk.Hash().Bytes(), info.Hash)
+
+			// The light client calculates the block hash from the header.
+			w := bytes.NewBuffer(info.Header)
+			w.Write(info.PrevCertHash)
+			w.Write(info.TxsRoot)
+			w.Write(util.Int32ToSlice(info.TxsCount))
+			assert.Equal(t, blk.Hash(), blk.Header().Hasher().CalcHash(w.Bytes()))
+
+			cert := new(certificate.Certificate)
+			require.NoError(t, cert.Decode(bytes.NewReader(info.Certificate)))
+			assert.Equal(t, blocks[4+i].PrevCertificate().Hash(), cert.Hash())
+		}
+	})
+
+	t.Run("Ok, the batch stops at the last block", func(t *testing.T) {
+		res, err := client.GetBlockHeaders(context.Background(),
+			&pactus.GetBlockHeadersRequest{FromHeight: 9, Count: 5})
+		require.NoError(t, err)
+		require.Len(t, res.Headers, 2)
+
+		cert := new(certificate.Certificate)
+		require.NoError(t, cert.Decode(bytes.NewReader(res.Headers[1].Certificate)))
+		assert.Equal(t, td.mockState.LastCertificate().Hash(), cert.Hash())
+	})
+
+	assert.Nil(t, conn.Close(), "Error closing connection")
+	td.StopServer()
+}
+
+func TestGetBeacon(t *testing.T) {
+	td := setup(t, nil)
+	conn, client := td.blockchainClient(t)
+
+	td.mockState.TestStore.AddTestBlock(100)
+	b101 := td.mockState.TestStore.AddTestBlock(101)
+
+	t.Run("Should return error for non existing block", func(t *testing.T) {
+		_, err := client.GetBeacon(context.Background(),
+			&pactus.GetBeaconRequest{Height: 0})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	t.Run("Should return error for the last block", func(t *testing.T) {
+		_, err := client.GetBeacon(context.Background(),
+			&pactus.GetBeaconRequest{Height: 101})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	t.Run("Should return beacon of finalized block", func(t *testing.T) {
+		res, err := client.GetBeacon(context.Background(),
+			&pactus.GetBeaconRequest{Height: 100})
+		require.NoError(t, err)
+
+		assert.Equal(t, uint32(100), res.Height)
+		assert.Equal(t, b101.PrevCertificate().Beacon().Bytes(), res.Beacon)
+	})
+
+	assert.Nil(t, conn.Close(), "Error closing connection")
+	td.StopServer()
+}
+
+func TestGetStorageInfo(t *testing.T) {
+	td := setup(t, nil)
+	conn, client := td.blockchainClient(t)
+
+	res, err := client.GetStorageInfo(context.Background(),
+		&pactus.GetStorageInfoRequest{})
+	require.NoError(t, err)
+
+	require.Len(t, res.Families, 4)
+	assert.Equal(t, "blocks", res.Families[0].Name)
+	assert.Equal(t, "txs", res.Families[1].Name)
+	assert.Equal(t, "certificates", res.Families[2].Name)
+	assert.Equal(t, "indexes", res.Families[3].Name)
+
+	assert.Nil(t, conn.Close(), "Error closing connection")
+	td.StopServer()
+}
+
+func TestGenerateBlocks(t *testing.T) {
+	td := setup(t, nil)
+	conn, client := td.blockchainClient(t)
+
+	rewardAddr := td.RandAccAddress()
+
+	t.Run("Should return error on the testnet", func(t *testing.T) {
+		res, err := client.GenerateBlocks(context.Background(),
+			&pactus.GenerateBlocksRequest{Count: 1, RewardAddress: rewardAddr.String()})
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+		assert.Nil(t, res)
+	})
+
+	val, _ := td.GenerateTestValidator(0)
+	gen := genesis.MakeGenesis(util.Now(), map[crypto.Address]*account.Account{},
+		[]*validator.Validator{val}, param.DefaultParams())
+	gen.SetSimnet()
+	td.mockState.TestGenesis = gen
+
+	t.Run("Should return error for invalid count", func(t *testing.T) {
+		res, err := client.GenerateBlocks(context.Background(),
+			&pactus.GenerateBlocksRequest{Count: 0, RewardAddress: rewardAddr.String()})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Nil(t, res)
+
+		res, err = client.GenerateBlocks(context.Background(),
+			&pactus.GenerateBlocksRequest{Count: maxGenerateBlocks + 1, RewardAddress: rewardAddr.String()})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Nil(t, res)
+	})
+
+	t.Run("Should return error for invalid reward address", func(t *testing.T) {
+		res, err := client.GenerateBlocks(context.Background(),
+			&pactus.GenerateBlocksRequest{Count: 1, RewardAddress: td.RandValAddress().String()})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Nil(t, res)
+	})
+
+	t.Run("Should generate blocks", func(t *testing.T) {
+		lastHeight := td.mockState.LastBlockHeight()
+		res, err := client.GenerateBlocks(context.Background(),
+			&pactus.GenerateBlocksRequest{Count: 3, RewardAddress: rewardAddr.String()})
+		require.NoError(t, err)
+
+		require.Len(t, res.BlockHashes, 3)
+		assert.Equal(t, lastHeight+3, td.mockState.LastBlockHeight())
+		assert.Equal(t, td.mockState.LastBlockHash().Bytes(), res.BlockHashes[2])
+	})
+
+	assert.Nil(t, conn.Close(), "Error closing connection")
+	td.StopServer()
+}
+
 func TestGetBlockHash(t *testing.T) {
 	td := setup(t, nil)
 	conn, client := td.blockchainClient(t)
@@ -150,6 +333,442 @@ func TestGetBlockchainInfo(t *testing.T) {
 		assert.NotEmpty(t, res.LastBlockHash)
 	})
 
+	t.Run("Should return the number of pending transactions", func(t *testing.T) {
+		trx, _ := td.GenerateTestTransferTx()
+		assert.NoError(t, td.mockState.AddPendingTx(trx))
+
+		res, err := client.GetBlockchainInfo(context.Background(),
+			&pactus.GetBlockchainInfoRequest{})
+
+		assert.NoError(t, err)
+		assert.Equal(t, int32(1), res.PendingTransactions)
+	})
+
+	assert.Nil(t, conn.Close(), "Error closing connection")
+	td.StopServer()
+}
+
+func TestGetBlockBloom(t *testing.T) {
+	td := setup(t, nil)
+	conn, client := td.blockchainClient(t)
+
+	addr := td.RandAccAddress()
+	filter := bloom.New(1)
+	filter.Add(addr.Bytes())
+	td.mockState.TestStore.SaveBloom(5, filter)
+
+	t.Run("Should return the bloom filter", func(t *testing.T) {
+		res, err := client.GetBlockBloom(context.Background(),
+			&pactus.GetBlockBloomRequest{Height: 5})
+		assert.NoError(t, err)
+		assert.Equal(t, uint32(5), res.Height)
+		assert.Equal(t, filter.Bytes(), res.Filter)
+		assert.Equal(t, uint32(bloom.HashCount), res.HashCount)
+
+		filter2, err := bloom.FromBytes(res.Filter, uint8(res.HashCount))
+		assert.NoError(t, err)
+		assert.True(t, filter2.Contains(addr.Bytes()))
+	})
+
+	t.Run("Should return error for unknown height", func(t *testing.T) {
+		res, err := client.GetBlockBloom(context.Background(),
+			&pactus.GetBlockBloomRequest{Height: 6})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+		assert.Nil(t, res)
+	})
+
+	assert.Nil(t, conn.Close(), "Error closing connection")
+	td.StopServer()
+}
+
+func TestScanBlocks(t *testing.T) {
+	td := setup(t, nil)
+	conn, client := td.blockchainClient(t)
+
+	addr1 := td.RandAccAddress()
+	addr2 := td.RandValAddress()
+	for height := uint32(1); height <= 4; height++ {
+		filter := bloom.New(100)
+		filter.Add(td.RandAccAddress().Bytes())
+		td.mockState.TestStore.SaveBloom(height, filter)
+	}
+	td.mockState.TestStore.Blooms[2].Add(addr1.Bytes())
+	td.mockState.TestStore.Blooms[3].Add(addr2.Bytes())
+
+	t.Run("Should return the matched heights", func(t *testing.T) {
+		res, err := client.ScanBlocks(context.Background(),
+			&pactus.ScanBlocksRequest{
+				Addresses:  []string{addr1.String(), addr2.String()},
+				FromHeight: 1,
+				ToHeight:   4,
+			})
+		assert.NoError(t, err)
+		assert.Equal(t, []uint32{2, 3}, res.Heights)
+	})
+
+	t.Run("Should include the blocks without bloom filter", func(t *testing.T) {
+		res, err := client.ScanBlocks(context.Background(),
+			&pactus.ScanBlocksRequest{
+				Addresses:  []string{addr1.String()},
+				FromHeight: 2,
+				ToHeight:   6,
+			})
+		assert.NoError(t, err)
+		assert.Equal(t, []uint32{2, 5, 6}, res.Heights)
+	})
+
+	t.Run("Should return error for invalid address", func(t *testing.T) {
+		res, err := client.ScanBlocks(context.Background(),
+			&pactus.ScanBlocksRequest{
+				Addresses:  []string{"invalid"},
+				FromHeight: 1,
+				ToHeight:   4,
+			})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Nil(t, res)
+	})
+
+	t.Run("Should return error for invalid range", func(t *testing.T) {
+		res, err := client.ScanBlocks(context.Background(),
+			&pactus.ScanBlocksRequest{FromHeight: 4, ToHeight: 1})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Nil(t, res)
+	})
+
+	t.Run("Should paginate the heights", func(t *testing.T) {
+		res, err := client.ScanBlocks(context.Background(),
+			&pactus.ScanBlocksRequest{
+				Addresses:  []string{addr1.String()},
+				FromHeight: 1,
+				ToHeight:   6,
+				Page:       &pactus.PageRequest{Limit: 2, Order: pactus.SortOrder_SORT_DESC},
+			})
+		assert.NoError(t, err)
+		assert.Equal(t, []uint32{6, 5}, res.Heights)
+		assert.NotEmpty(t, res.Page.NextCursor)
+
+		res, err = client.ScanBlocks(context.Background(),
+			&pactus.ScanBlocksRequest{
+				Addresses:  []string{addr1.String()},
+				FromHeight: 1,
+				ToHeight:   6,
+				Page: &pactus.PageRequest{
+					Limit:  2,
+					Order:  pactus.SortOrder_SORT_DESC,
+					Cursor: res.Page.NextCursor,
+				},
+			})
+		assert.NoError(t, err)
+		assert.Equal(t, []uint32{2}, res.Heights)
+		assert.Empty(t, res.Page.NextCursor)
+	})
+
+	t.Run("Should use the height range and the address of the filter", func(t *testing.T) {
+		lastHeight := td.mockState.LastBlockHeight()
+		res, err := client.ScanBlocks(context.Background(),
+			&pactus.ScanBlocksRequest{
+				Filter: &pactus.ListFilter{FromHeight: 3, Address: addr2.String()},
+			})
+		assert.NoError(t, err)
+		assert.Equal(t, uint32(3), res.Heights[0])
+		assert.Equal(t, lastHeight, res.Heights[len(res.Heights)-1])
+		assert.Nil(t, res.Page)
+	})
+
+	t.Run("Should filter the blocks by the payload type", func(t *testing.T) {
+		lastHeight := td.mockState.LastBlockHeight()
+		expected := make([]uint32, 0)
+		for height := uint32(1); height <= lastHeight; height++ {
+			cb, _ := td.mockState.TestStore.Block(height)
+			blk, _ := cb.ToBlock()
+			for _, trx := range blk.Transactions() {
+				if trx.IsBondTx() {
+					expected = append(expected, height)
+
+					break
+				}
+			}
+		}
+
+		res, err := client.ScanBlocks(context.Background(),
+			&pactus.ScanBlocksRequest{
+				Filter: &pactus.ListFilter{FromHeight: 1, PayloadType: pactus.PayloadType_BOND_PAYLOAD},
+			})
+		assert.NoError(t, err)
+		assert.Equal(t, expected, res.Heights)
+	})
+
+	t.Run("Should return error if the height range is set twice", func(t *testing.T) {
+		res, err := client.ScanBlocks(context.Background(),
+			&pactus.ScanBlocksRequest{
+				FromHeight: 1,
+				ToHeight:   4,
+				Filter:     &pactus.ListFilter{FromHeight: 1},
+			})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Nil(t, res)
+	})
+
+	t.Run("Should return error for invalid cursor", func(t *testing.T) {
+		res, err := client.ScanBlocks(context.Background(),
+			&pactus.ScanBlocksRequest{
+				FromHeight: 1,
+				ToHeight:   4,
+				Page:       &pactus.PageRequest{Cursor: "invalid"},
+			})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Nil(t, res)
+	})
+
+	t.Run("Should stop scanning when the request is canceled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		res, err := newBlockchainServer(td.server).ScanBlocks(ctx,
+			&pactus.ScanBlocksRequest{
+				Addresses:  []string{addr1.String()},
+				FromHeight: 1,
+				ToHeight:   4,
+			})
+		assert.Equal(t, codes.Canceled, status.Code(err))
+		assert.Nil(t, res)
+	})
+
+	assert.Nil(t, conn.Close(), "Error closing connection")
+	td.StopServer()
+}
+
+func TestGetBlockReward(t *testing.T) {
+	td := setup(t, nil)
+	conn, client := td.blockchainClient(t)
+
+	height := td.mockState.LastBlockHeight()
+	cb, _ := td.mockState.TestStore.Block(height)
+	blk, _ := cb.ToBlock()
+	subsidyTx := blk.Transactions()[0]
+	rewardAddr := *subsidyTx.Payload().Receiver()
+	treasuryBalance := td.RandAmount()
+	td.mockState.TestStore.SaveReceipts([]*receipt.Receipt{
+		receipt.NewReceipt(subsidyTx.ID(), height, 0, receipt.StatusSuccess, 0,
+			[]receipt.Balance{
+				{Address: rewardAddr, Balance: subsidyTx.Payload().Value()},
+				{Address: crypto.TreasuryAddress, Balance: treasuryBalance},
+			}, nil),
+	})
+
+	t.Run("Should return the block reward", func(t *testing.T) {
+		res, err := client.GetBlockReward(context.Background(),
+			&pactus.GetBlockRewardRequest{Height: height})
+		assert.NoError(t, err)
+
+		fees := int64(0)
+		for _, trx := range blk.Transactions() {
+			if !trx.IsSubsidyTx() {
+				fees += trx.Fee()
+			}
+		}
+		blockReward := subsidyTx.Payload().Value() - fees
+
+		assert.Equal(t, height, res.Height)
+		assert.Equal(t, rewardAddr.String(), res.RewardAddress)
+		assert.Equal(t, blockReward, res.BlockReward)
+		assert.Equal(t, fees, res.Fees)
+		assert.Equal(t, subsidyTx.Payload().Value(), res.TotalPayout)
+		assert.Equal(t, treasuryBalance+subsidyTx.Payload().Value(), res.TreasuryBalanceBefore)
+		assert.Equal(t, treasuryBalance+fees, res.TreasuryBalanceAfter)
+		assert.Equal(t, -blockReward, res.TreasuryChange)
+	})
+
+	t.Run("Should return error for the block without receipt", func(t *testing.T) {
+		res, err := client.GetBlockReward(context.Background(),
+			&pactus.GetBlockRewardRequest{Height: height - 1})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+		assert.Nil(t, res)
+	})
+
+	t.Run("Should return error for unknown height", func(t *testing.T) {
+		res, err := client.GetBlockReward(context.Background(),
+			&pactus.GetBlockRewardRequest{Height: height + 1})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+		assert.Nil(t, res)
+	})
+
+	assert.Nil(t, conn.Close(), "Error closing connection")
+	td.StopServer()
+}
+
+func TestWatchAddress(t *testing.T) {
+	td := setup(t, nil)
+	conn, client := td.blockchainClient(t)
+
+	addr1 := td.RandAccAddress()
+	addr2 := td.RandValAddress()
+
+	t.Run("Should watch the addresses", func(t *testing.T) {
+		res, err := client.WatchAddress(context.Background(),
+			&pactus.WatchAddressRequest{Address: addr1.String()})
+		assert.NoError(t, err)
+		assert.Equal(t, addr1.String(), res.Address)
+
+		_, err = client.WatchAddress(context.Background(),
+			&pactus.WatchAddressRequest{Address: addr2.String()})
+		assert.NoError(t, err)
+
+		assert.True(t, td.server.watcher.IsWatched(addr1))
+		assert.True(t, td.server.watcher.IsWatched(addr2))
+	})
+
+	t.Run("Should watch an already watched address", func(t *testing.T) {
+		_, err := client.WatchAddress(context.Background(),
+			&pactus.WatchAddressRequest{Address: addr1.String()})
+		assert.NoError(t, err)
+	})
+
+	t.Run("Should return error for too many addresses", func(t *testing.T) {
+		res, err := client.WatchAddress(context.Background(),
+			&pactus.WatchAddressRequest{Address: td.RandAccAddress().String()})
+		assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+		assert.Nil(t, res)
+	})
+
+	t.Run("Should return error for invalid address", func(t *testing.T) {
+		res, err := client.WatchAddress(context.Background(),
+			&pactus.WatchAddressRequest{Address: "invalid"})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Nil(t, res)
+	})
+
+	t.Run("Should return the watched addresses", func(t *testing.T) {
+		res, err := client.GetWatchedAddresses(context.Background(),
+			&pactus.GetWatchedAddressesRequest{})
+		assert.NoError(t, err)
+		assert.ElementsMatch(t, []string{addr1.String(), addr2.String()}, res.Addresses)
+	})
+
+	t.Run("Should paginate the watched addresses", func(t *testing.T) {
+		res, err := client.GetWatchedAddresses(context.Background(),
+			&pactus.GetWatchedAddressesRequest{
+				Page: &pactus.PageRequest{Limit: 1, Order: pactus.SortOrder_SORT_DESC},
+			})
+		assert.NoError(t, err)
+		assert.Equal(t, []string{max(addr1.String(), addr2.String())}, res.Addresses)
+		assert.NotEmpty(t, res.Page.NextCursor)
+	})
+
+	t.Run("Should unwatch the address", func(t *testing.T) {
+		res, err := client.UnwatchAddress(context.Background(),
+			&pactus.UnwatchAddressRequest{Address: addr1.String()})
+		assert.NoError(t, err)
+		assert.Equal(t, addr1.String(), res.Address)
+		assert.False(t, td.server.watcher.IsWatched(addr1))
+	})
+
+	t.Run("Should return error for not watched address", func(t *testing.T) {
+		res, err := client.UnwatchAddress(context.Background(),
+			&pactus.UnwatchAddressRequest{Address: addr1.String()})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+		assert.Nil(t, res)
+	})
+
+	t.Run("Should return error if the watcher is not enabled", func(t *testing.T) {
+		td.server.watcher = nil
+
+		res, err := client.GetWatchedAddresses(context.Background(),
+			&pactus.GetWatchedAddressesRequest{})
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+		assert.Nil(t, res)
+	})
+
+	assert.Nil(t, conn.Close(), "Error closing connection")
+	td.StopServer()
+}
+
+func TestGetChainStatistics(t *testing.T) {
+	td := setup(t, nil)
+	conn, client := td.blockchainClient(t)
+
+	t.Run("Should return error for zero window", func(t *testing.T) {
+		res, err := client.GetChainStatistics(context.Background(),
+			&pactus.GetChainStatisticsRequest{Window: 0})
+
+		assert.Error(t, err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("Should return the statistics", func(t *testing.T) {
+		lastHeight := td.mockState.TestStore.LastHeight
+		res, err := client.GetChainStatistics(context.Background(),
+			&pactus.GetChainStatisticsRequest{Window: 5})
+
+		assert.NoError(t, err)
+		assert.Equal(t, lastHeight-4, res.FromHeight)
+		assert.Equal(t, lastHeight, res.ToHeight)
+		assert.Equal(t, int64(25), res.TotalTransactions)
+		assert.Equal(t, float64(5), res.AverageTransactions)
+		assert.Len(t, res.FeePercentiles, 5)
+		assert.Equal(t, uint32(50), res.FeePercentiles[2].Percentile)
+		assert.Positive(t, res.BlockFullness)
+	})
+
+	assert.Nil(t, conn.Close(), "Error closing connection")
+	td.StopServer()
+}
+
+func TestGetFeePercentiles(t *testing.T) {
+	td := setup(t, nil)
+	conn, client := td.blockchainClient(t)
+
+	t.Run("Should return error for invalid percentile", func(t *testing.T) {
+		res, err := client.GetFeePercentiles(context.Background(),
+			&pactus.GetFeePercentilesRequest{Window: 5, Percentiles: []uint32{101}})
+
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Nil(t, res)
+	})
+
+	t.Run("Should return error for invalid range", func(t *testing.T) {
+		res, err := client.GetFeePercentiles(context.Background(),
+			&pactus.GetFeePercentilesRequest{FromHeight: 6, ToHeight: 3})
+
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Nil(t, res)
+	})
+
+	t.Run("Should return error for both window and range", func(t *testing.T) {
+		res, err := client.GetFeePercentiles(context.Background(),
+			&pactus.GetFeePercentilesRequest{FromHeight: 3, ToHeight: 6, Window: 5})
+
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Nil(t, res)
+	})
+
+	t.Run("Should return the percentiles of the recent blocks", func(t *testing.T) {
+		lastHeight := td.mockState.TestStore.LastHeight
+		res, err := client.GetFeePercentiles(context.Background(),
+			&pactus.GetFeePercentilesRequest{Window: 5})
+
+		require.NoError(t, err)
+		assert.Equal(t, lastHeight-4, res.FromHeight)
+		assert.Equal(t, lastHeight, res.ToHeight)
+		assert.Equal(t, uint32(5), res.IndexedBlocks)
+		assert.Positive(t, res.TotalTransactions)
+		assert.Len(t, res.FeePercentiles, 5)
+		assert.Equal(t, uint32(50), res.FeePercentiles[2].Percentile)
+		assert.LessOrEqual(t, res.FeePercentiles[0].Fee, res.FeePercentiles[4].Fee)
+	})
+
+	t.Run("Should return the requested percentiles of a range", func(t *testing.T) {
+		res, err := client.GetFeePercentiles(context.Background(),
+			&pactus.GetFeePercentilesRequest{FromHeight: 2, ToHeight: 4, Percentiles: []uint32{0, 100}})
+
+		require.NoError(t, err)
+		assert.Equal(t, uint32(2), res.FromHeight)
+		assert.Equal(t, uint32(4), res.ToHeight)
+		assert.Equal(t, uint32(3), res.IndexedBlocks)
+		require.Len(t, res.FeePercentiles, 2)
+		assert.Equal(t, uint32(100), res.FeePercentiles[1].Percentile)
+	})
+
 	assert.Nil(t, conn.Close(), "Error closing connection")
 	td.StopServer()
 }
@@ -190,6 +809,189 @@ func TestGetAccount(t *testing.T) {
 	td.StopServer()
 }
 
+func TestGetAccounts(t *testing.T) {
+	td := setup(t, nil)
+	conn, client := td.blockchainClient(t)
+
+	acc1, addr1 := td.mockState.TestStore.AddTestAccount()
+	acc2, addr2 := td.mockState.TestStore.AddTestAccount()
+	addr3 := td.RandAccAddress()
+
+	t.Run("Should return error for non-parsable address", func(t *testing.T) {
+		res, err := client.GetAccounts(context.Background(),
+			&pactus.GetAccountsRequest{Addresses: []string{addr1.String(), "invalid"}})
+
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Nil(t, res)
+	})
+
+	t.Run("Should return error for too many addresses", func(t *testing.T) {
+		addrs := make([]string, maxBatchSize+1)
+		for i := range addrs {
+			addrs[i] = addr1.String()
+		}
+		res, err := client.GetAccounts(context.Background(),
+			&pactus.GetAccountsRequest{Addresses: addrs})
+
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Nil(t, res)
+	})
+
+	t.Run("Should return accounts in order and mark the missing ones", func(t *testing.T) {
+		res, err := client.GetAccounts(context.Background(),
+			&pactus.GetAccountsRequest{Addresses: []string{addr2.String(), addr3.String(), addr1.String()}})
+
+		assert.NoError(t, err)
+		assert.Len(t, res.Results, 3)
+
+		assert.True(t, res.Results[0].Found)
+		assert.Equal(t, addr2.String(), res.Results[0].Address)
+		assert.Equal(t, acc2.Balance(), res.Results[0].Account.Balance)
+
+		assert.False(t, res.Results[1].Found)
+		assert.Equal(t, addr3.String(), res.Results[1].Address)
+		assert.Nil(t, res.Results[1].Account)
+
+		assert.True(t, res.Results[2].Found)
+		assert.Equal(t, acc1.Number(), res.Results[2].Account.Number)
+	})
+
+	t.Run("Should return error for the height that is not reached yet", func(t *testing.T) {
+		res, err := client.GetAccounts(context.Background(),
+			&pactus.GetAccountsRequest{
+				Addresses: []string{addr1.String()},
+				AtHeight:  td.mockState.LastBlockHeight() + 1,
+			})
+
+		assert.Equal(t, codes.OutOfRange, status.Code(err))
+		assert.Nil(t, res)
+	})
+
+	assert.Nil(t, conn.Close(), "Error closing connection")
+	td.StopServer()
+}
+
+func TestGetValidators(t *testing.T) {
+	td := setup(t, nil)
+	conn, client := td.blockchainClient(t)
+
+	val1 := td.mockState.TestStore.AddTestValidator()
+	val2 := td.mockState.TestStore.AddTestValidator()
+
+	t.Run("Should return error when both addresses and numbers are set", func(t *testing.T) {
+		res, err := client.GetValidators(context.Background(),
+			&pactus.GetValidatorsRequest{
+				Addresses: []string{val1.Address().String()},
+				Numbers:   []int32{val1.Number()},
+			})
+
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Nil(t, res)
+	})
+
+	t.Run("Should return error for non-parsable address", func(t *testing.T) {
+		res, err := client.GetValidators(context.Background(),
+			&pactus.GetValidatorsRequest{Addresses: []string{""}})
+
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Nil(t, res)
+	})
+
+	t.Run("Should return validators by addresses", func(t *testing.T) {
+		res, err := client.GetValidators(context.Background(),
+			&pactus.GetValidatorsRequest{
+				Addresses: []string{val1.Address().String(), td.RandValAddress().String(), val2.Address().String()},
+			})
+
+		assert.NoError(t, err)
+		assert.Len(t, res.Results, 3)
+		assert.True(t, res.Results[0].Found)
+		assert.Equal(t, val1.PublicKey().String(), res.Results[0].Validator.PublicKey)
+		assert.False(t, res.Results[1].Found)
+		assert.Nil(t, res.Results[1].Validator)
+		assert.True(t, res.Results[2].Found)
+		assert.Equal(t, val2.PublicKey().String(), res.Results[2].Validator.PublicKey)
+	})
+
+	t.Run("Should return validators by numbers", func(t *testing.T) {
+		res, err := client.GetValidators(context.Background(),
+			&pactus.GetValidatorsRequest{
+				Numbers: []int32{val2.Number(), val2.Number() + 1, val1.Number()},
+			})
+
+		assert.NoError(t, err)
+		assert.Len(t, res.Results, 3)
+		assert.Equal(t, val2.Number(), res.Results[0].Validator.Number)
+		assert.False(t, res.Results[1].Found)
+		assert.Equal(t, val1.Number(), res.Results[2].Validator.Number)
+	})
+
+	t.Run("Should return empty results", func(t *testing.T) {
+		res, err := client.GetValidators(context.Background(),
+			&pactus.GetValidatorsRequest{})
+
+		assert.NoError(t, err)
+		assert.Empty(t, res.Results)
+	})
+
+	assert.Nil(t, conn.Close(), "Error closing connection")
+	td.StopServer()
+}
+
+func TestGetStateAtSnapshot(t *testing.T) {
+	td := setup(t, nil)
+	conn, client := td.blockchainClient(t)
+
+	td.mockState.CommitTestBlocks(5)
+	lastHeight := td.mockState.LastBlockHeight()
+	acc, addr := td.mockState.TestStore.AddTestAccount()
+	val := td.mockState.TestStore.AddTestValidator()
+
+	t.Run("Should return account at the given height", func(t *testing.T) {
+		res, err := client.GetAccount(context.Background(),
+			&pactus.GetAccountRequest{Address: addr.String(), AtHeight: lastHeight - 1})
+
+		assert.NoError(t, err)
+		assert.Equal(t, acc.Balance(), res.Account.Balance)
+	})
+
+	t.Run("Should return validator at the given state root", func(t *testing.T) {
+		stateRoot := td.mockState.TestStore.Blocks[lastHeight].Header().StateRoot()
+		res, err := client.GetValidator(context.Background(),
+			&pactus.GetValidatorRequest{Address: val.Address().String(), AtStateRoot: stateRoot.Bytes()})
+
+		assert.NoError(t, err)
+		assert.Equal(t, val.Number(), res.Validator.Number)
+	})
+
+	t.Run("Should return error for unknown state root", func(t *testing.T) {
+		res, err := client.GetValidatorByNumber(context.Background(),
+			&pactus.GetValidatorByNumberRequest{Number: val.Number(), AtStateRoot: td.RandHash().Bytes()})
+
+		assert.Equal(t, codes.NotFound, status.Code(err))
+		assert.Nil(t, res)
+	})
+
+	t.Run("Should return error when both height and state root are set", func(t *testing.T) {
+		res, err := client.GetAccount(context.Background(),
+			&pactus.GetAccountRequest{Address: addr.String(), AtHeight: 4, AtStateRoot: td.RandHash().Bytes()})
+
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Nil(t, res)
+	})
+
+	t.Run("Should return error for the height that is not reached yet", func(t *testing.T) {
+		res, err := client.GetValidatorByNumber(context.Background(),
+			&pactus.GetValidatorByNumberRequest{Number: val.Number(), AtHeight: td.mockState.LastBlockHeight() + 1})
+
+		assert.Equal(t, codes.OutOfRange, status.Code(err))
+		assert.Nil(t, res)
+	})
+
+	assert.Nil(t, conn.Close(), "Error closing connection")
+	td.StopServer()
+}
+
 func TestGetValidator(t *testing.T) {
 	td := setup(t, nil)
 	conn, client := td.blockchainClient(t)
@@ -219,6 +1021,37 @@ func TestGetValidator(t *testing.T) {
 		assert.NoError(t, err)
 		assert.NotNil(t, res)
 		assert.Equal(t, val1.PublicKey().String(), res.GetValidator().PublicKey)
+		assert.Empty(t, res.GetValidator().Delegator)
+	})
+
+	t.Run("Should return the delegator of the delegated validator", func(t *testing.T) {
+		val2 := td.mockState.TestStore.AddTestValidator()
+		delegator := td.RandAccAddress()
+		val2.SetDelegator(delegator)
+		td.mockState.TestStore.UpdateValidator(val2)
+
+		res, err := client.GetValidator(context.Background(),
+			&pactus.GetValidatorRequest{Address: val2.Address().String()})
+
+		assert.NoError(t, err)
+		assert.Equal(t, delegator.String(), res.GetValidator().Delegator)
+	})
+
+	t.Run("Should return the reward split of the validator", func(t *testing.T) {
+		val3 := td.mockState.TestStore.AddTestValidator()
+		shareAddr := td.RandAccAddress()
+		val3.SetRewardSplit(&validator.RewardSplit{
+			Shares: []validator.RewardShare{{Address: shareAddr, Percent: 50}},
+		})
+		td.mockState.TestStore.UpdateValidator(val3)
+
+		res, err := client.GetValidator(context.Background(),
+			&pactus.GetValidatorRequest{Address: val3.Address().String()})
+
+		assert.NoError(t, err)
+		require.Len(t, res.GetValidator().RewardSplit, 1)
+		assert.Equal(t, shareAddr.String(), res.GetValidator().RewardSplit[0].Address)
+		assert.Equal(t, uint32(50), res.GetValidator().RewardSplit[0].Percent)
 	})
 
 	assert.Nil(t, conn.Close(), "Error closing connection")
@@ -277,6 +1110,29 @@ func TestGetValidatorAddresses(t *testing.T) {
 		assert.Equal(t, 2, len(res.GetAddresses()))
 	})
 
+	t.Run("should paginate the validator addresses", func(t *testing.T) {
+		td.mockState.TestStore.AddTestValidator()
+
+		all, err := client.GetValidatorAddresses(context.Background(),
+			&pactus.GetValidatorAddressesRequest{})
+		assert.NoError(t, err)
+		assert.IsIncreasing(t, all.Addresses)
+		assert.Nil(t, all.Page)
+
+		res, err := client.GetValidatorAddresses(context.Background(),
+			&pactus.GetValidatorAddressesRequest{Page: &pactus.PageRequest{Limit: 2}})
+		assert.NoError(t, err)
+		assert.Equal(t, all.Addresses[:2], res.Addresses)
+
+		res, err = client.GetValidatorAddresses(context.Background(),
+			&pactus.GetValidatorAddressesRequest{
+				Page: &pactus.PageRequest{Limit: 2, Cursor: res.Page.NextCursor},
+			})
+		assert.NoError(t, err)
+		assert.Equal(t, all.Addresses[2:], res.Addresses)
+		assert.Empty(t, res.Page.NextCursor)
+	})
+
 	assert.Nil(t, conn.Close(), "Error closing connection")
 	td.StopServer()
 }
@@ -312,6 +1168,17 @@ func TestGetPublicKey(t *testing.T) {
 		assert.Equal(t, res.PublicKey, val.PublicKey().String())
 	})
 
+	t.Run("Should return the public key revealed by the account of the validator", func(t *testing.T) {
+		blk := td.mockState.TestStore.AddTestBlock(td.RandHeight())
+		pub := blk.Transactions()[0].PublicKey().(*bls.PublicKey)
+
+		res, err := client.GetPublicKey(context.Background(),
+			&pactus.GetPublicKeyRequest{Address: pub.ValidatorAddress().String()})
+
+		assert.Nil(t, err)
+		assert.Equal(t, res.PublicKey, pub.String())
+	})
+
 	assert.Nil(t, conn.Close(), "Error closing connection")
 	td.StopServer()
 }
@@ -341,3 +1208,121 @@ func TestConsensusInfo(t *testing.T) {
 	assert.Nil(t, conn.Close(), "Error closing connection")
 	td.StopServer()
 }
+
+func TestGetCommittee(t *testing.T) {
+	td := setup(t, nil)
+	conn, client := td.blockchainClient(t)
+
+	height := td.mockState.LastBlockHeight()
+
+	t.Run("Should return the committee", func(t *testing.T) {
+		res, err := client.GetCommittee(context.Background(),
+			&pactus.GetCommitteeRequest{Height: height})
+		assert.NoError(t, err)
+
+		cmt := td.mockState.TestCommittee
+		assert.Equal(t, height, res.Height)
+		assert.Len(t, res.Validators, cmt.Size())
+		for i, val := range cmt.Validators() {
+			assert.Equal(t, val.Address().String(), res.Validators[i].Address)
+		}
+		assert.Equal(t, cmt.TotalPower(), res.CommitteePower)
+		assert.Equal(t, cmt.Proposer(0).Address().String(), res.ProposerAddress)
+	})
+
+	t.Run("Should return error for unknown height", func(t *testing.T) {
+		res, err := client.GetCommittee(context.Background(),
+			&pactus.GetCommitteeRequest{Height: height + 1})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+		assert.Nil(t, res)
+	})
+
+	assert.Nil(t, conn.Close(), "Error closing connection")
+	td.StopServer()
+}
+
+func TestVerifyParticipationReport(t *testing.T) {
+	td := setup(t, nil)
+	conn, client := td.blockchainClient(t)
+
+	valKey := td.RandValKey()
+	td.mockState.TestStore.UpdateValidator(validator.NewValidator(valKey.PublicKey(), 0))
+	lastHeight := td.mockState.LastBlockHeight()
+
+	signedReport := func(report *participation.Report, key *bls.ValidatorKey) []byte {
+		report.SetSignature(key.Sign(report.SignBytes()))
+		data, _ := report.Bytes()
+
+		return data
+	}
+
+	chainReport, err := td.mockState.ParticipationReport(valKey.Address(), 1, lastHeight)
+	require.NoError(t, err)
+
+	t.Run("Should verify a valid report", func(t *testing.T) {
+		report := participation.NewReport(valKey.Address(), 1, lastHeight,
+			chainReport.InCommittee(), chainReport.VotesCast(), chainReport.BlocksProposed())
+		res, err := client.VerifyParticipationReport(context.Background(),
+			&pactus.VerifyParticipationReportRequest{Report: signedReport(report, valKey)})
+		require.NoError(t, err)
+
+		assert.True(t, res.ValidSignature)
+		assert.True(t, res.MatchesChain)
+		assert.Equal(t, valKey.Address().String(), res.Report.ValidatorAddress)
+		assert.Equal(t, lastHeight, res.Report.ToHeight)
+		assert.Equal(t, res.ChainReport, res.Report)
+	})
+
+	t.Run("Should detect the inflated numbers", func(t *testing.T) {
+		report := participation.NewReport(valKey.Address(), 1, lastHeight,
+			lastHeight, lastHeight, lastHeight)
+		res, err := client.VerifyParticipationReport(context.Background(),
+			&pactus.VerifyParticipationReportRequest{Report: signedReport(report, valKey)})
+		require.NoError(t, err)
+
+		assert.True(t, res.ValidSignature)
+		assert.False(t, res.MatchesChain)
+		assert.Equal(t, chainReport.VotesCast(), res.ChainReport.VotesCast)
+	})
+
+	t.Run("Should detect the invalid signature", func(t *testing.T) {
+		report := participation.NewReport(valKey.Address(), 1, lastHeight,
+			chainReport.InCommittee(), chainReport.VotesCast(), chainReport.BlocksProposed())
+		res, err := client.VerifyParticipationReport(context.Background(),
+			&pactus.VerifyParticipationReportRequest{Report: signedReport(report, td.RandValKey())})
+		require.NoError(t, err)
+
+		assert.False(t, res.ValidSignature)
+		assert.True(t, res.MatchesChain)
+	})
+
+	t.Run("Should return error for unknown validator", func(t *testing.T) {
+		key := td.RandValKey()
+		report := participation.NewReport(key.Address(), 1, lastHeight, 0, 0, 0)
+		_, err := client.VerifyParticipationReport(context.Background(),
+			&pactus.VerifyParticipationReportRequest{Report: signedReport(report, key)})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	t.Run("Should return error for invalid range", func(t *testing.T) {
+		report := participation.NewReport(valKey.Address(), 1, lastHeight+1, 0, 0, 0)
+		_, err := client.VerifyParticipationReport(context.Background(),
+			&pactus.VerifyParticipationReportRequest{Report: signedReport(report, valKey)})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("Should return error for invalid data", func(t *testing.T) {
+		_, err := client.VerifyParticipationReport(context.Background(),
+			&pactus.VerifyParticipationReportRequest{Report: td.RandBytes(16)})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		report := participation.NewReport(valKey.Address(), 1, lastHeight, 0, 0, 0)
+		data, _ := report.Bytes()
+		_, err = client.VerifyParticipationReport(context.Background(),
+			&pactus.VerifyParticipationReportRequest{Report: data})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err), "unsigned report")
+	})
+
+	assert.Nil(t, conn.Close(), "Error closing connection")
+	td.StopServer()
+}
@@ -0,0 +1,280 @@
+package grpc
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"net"
+	"os"
+	"time"
+
+	"github.com/pactus-project/pactus/util"
+	"github.com/pactus-project/pactus/util/errors"
+	pactus "github.com/pactus-project/pactus/www/grpc/gen/go"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// BridgeTokenKey is the metadata key of the bridge token.
+const BridgeTokenKey = "x-pactus-bridge-token"
+
+// BridgeWatchMethod is the streaming method of the bridge that sends the blockchain info
+// when a new block is committed. The request is a GetBlockchainInfoRequest
+// and the stream sends GetBlockchainInfoResponse messages.
+const BridgeWatchMethod = "/pactus.Bridge/WatchBlockchain"
+
+// bridgeWatchInterval is the interval to check for the new blocks in the watch streams.
+const bridgeWatchInterval = time.Second
+
+// bridgeMethods are the methods that the GUI needs, and the bridge serves.
+var bridgeMethods = []string{
+	"/pactus.Blockchain/GetBlockchainInfo",
+	"/pactus.Blockchain/GetBlockHash",
+	"/pactus.Blockchain/GetBlock",
+	"/pactus.Blockchain/GetAccount",
+	"/pactus.Blockchain/GetAccounts",
+	"/pactus.Blockchain/GetValidator",
+	"/pactus.Blockchain/GetPublicKey",
+	"/pactus.Blockchain/ScanBlocks",
+	"/pactus.Transaction/GetTransaction",
+	"/pactus.Transaction/BroadcastTransaction",
+	"/pactus.Transaction/CalculateFee",
+	"/pactus.Transaction/GetTxPoolChanges",
+	BridgeWatchMethod,
+}
+
+// BridgeConfig is the configuration of the bridge: a local endpoint for the GUI.
+// The bridge only listens on the loopback interface, it has no gateway,
+// and every call should carry the bridge token.
+type BridgeConfig struct {
+	Enable    bool   `toml:"enable"`
+	Listen    string `toml:"listen"`
+	TokenFile string `toml:"token_file"`
+}
+
+// BasicCheck performs basic checks on the configuration.
+func (conf *BridgeConfig) BasicCheck() error {
+	if !conf.Enable {
+		return nil
+	}
+
+	host, _, err := net.SplitHostPort(conf.Listen)
+	if err != nil {
+		return errors.Errorf(errors.ErrInvalidConfig, "invalid bridge listen address: %s", conf.Listen)
+	}
+	if host != "localhost" {
+		ip := net.ParseIP(host)
+		if ip == nil || !ip.IsLoopback() {
+			return errors.Errorf(errors.ErrInvalidConfig,
+				"bridge should listen on a loopback address: %s", conf.Listen)
+		}
+	}
+
+	return nil
+}
+
+func isBridgeMethod(fullMethod string) bool {
+	for _, method := range bridgeMethods {
+		if fullMethod == method {
+			return true
+		}
+	}
+
+	return false
+}
+
+type bridge struct {
+	config   *BridgeConfig
+	token    string
+	address  string
+	listener net.Listener
+	grpc     *grpc.Server
+}
+
+// BridgeAddress returns the address of the bridge, or an empty string if it is not started.
+func (s *Server) BridgeAddress() string {
+	if s.bridge == nil {
+		return ""
+	}
+
+	return s.bridge.address
+}
+
+// BridgeToken returns the token that the clients of the bridge should send,
+// or an empty string if the bridge is not started.
+// The token is generated on each start.
+func (s *Server) BridgeToken() string {
+	if s.bridge == nil {
+		return ""
+	}
+
+	return s.bridge.token
+}
+
+func (s *Server) startBridge() error {
+	conf := &s.config.Bridge
+	listener, err := net.Listen("tcp", conf.Listen)
+	if err != nil {
+		return err
+	}
+
+	return s.startBridgeListening(listener)
+}
+
+func (s *Server) startBridgeListening(listener net.Listener) error {
+	conf := &s.config.Bridge
+
+	tokenBytes := make([]byte, 32)
+	if _, err := rand.Read(tokenBytes); err != nil {
+		listener.Close()
+
+		return err
+	}
+	token := hex.EncodeToString(tokenBytes)
+
+	if conf.TokenFile != "" {
+		if err := util.WriteFile(util.MakeAbs(conf.TokenFile), []byte(token)); err != nil {
+			listener.Close()
+
+			return err
+		}
+		if err := os.Chmod(util.MakeAbs(conf.TokenFile), 0o600); err != nil {
+			listener.Close()
+
+			return err
+		}
+	}
+
+	brg := &bridge{
+		config:   conf,
+		token:    token,
+		address:  listener.Addr().String(),
+		listener: listener,
+	}
+
+	grpcServer := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(s.recoveryInterceptor, s.deadlineInterceptor,
+			brg.unaryInterceptor, errorInterceptor),
+		grpc.StreamInterceptor(brg.streamInterceptor),
+	)
+	pactus.RegisterBlockchainServer(grpcServer, newBlockchainServer(s))
+	pactus.RegisterTransactionServer(grpcServer, newTransactionServer(s))
+	grpcServer.RegisterService(&bridgeServiceDesc, newBlockchainServer(s))
+	brg.grpc = grpcServer
+	s.bridge = brg
+
+	s.logger.Info("grpc bridge started listening", "address", brg.address)
+	go func() {
+		if err := grpcServer.Serve(listener); err != nil {
+			s.logger.Error("error on grpc bridge serve", "error", err)
+		}
+	}()
+
+	return nil
+}
+
+func (s *Server) stopBridge() {
+	if s.bridge == nil {
+		return
+	}
+
+	s.bridge.grpc.Stop()
+	s.bridge.listener.Close()
+	if s.bridge.config.TokenFile != "" {
+		_ = os.Remove(util.MakeAbs(s.bridge.config.TokenFile))
+	}
+}
+
+// checkAccess checks that the method is served by the bridge and the call carries the token.
+func (b *bridge) checkAccess(ctx context.Context, fullMethod string) error {
+	if !isBridgeMethod(fullMethod) {
+		return status.Errorf(codes.PermissionDenied, "method %s is not served by the bridge", fullMethod)
+	}
+
+	token := ""
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(BridgeTokenKey); len(values) > 0 {
+			token = values[0]
+		}
+	}
+	if subtle.ConstantTimeCompare([]byte(token), []byte(b.token)) != 1 {
+		return status.Error(codes.Unauthenticated, "invalid bridge token")
+	}
+
+	return nil
+}
+
+func (b *bridge) unaryInterceptor(ctx context.Context, req any,
+	info *grpc.UnaryServerInfo, handler grpc.UnaryHandler,
+) (any, error) {
+	if err := b.checkAccess(ctx, info.FullMethod); err != nil {
+		return nil, err
+	}
+
+	return handler(ctx, req)
+}
+
+func (b *bridge) streamInterceptor(srv any, stream grpc.ServerStream,
+	info *grpc.StreamServerInfo, handler grpc.StreamHandler,
+) error {
+	if err := b.checkAccess(stream.Context(), info.FullMethod); err != nil {
+		return err
+	}
+
+	return handler(srv, stream)
+}
+
+// bridgeServiceDesc describes the streaming service of the bridge.
+// It is not a part of the public API, so it is not defined in the proto files.
+var bridgeServiceDesc = grpc.ServiceDesc{
+	ServiceName: "pactus.Bridge",
+	HandlerType: (*any)(nil),
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchBlockchain",
+			Handler:       watchBlockchainHandler,
+			ServerStreams: true,
+		},
+	},
+}
+
+// watchBlockchainHandler sends the blockchain info on start and whenever a new block is committed,
+// until the client cancels the stream or the server is stopped.
+func watchBlockchainHandler(srv any, stream grpc.ServerStream) error {
+	s := srv.(*blockchainServer)
+
+	req := new(pactus.GetBlockchainInfoRequest)
+	if err := stream.RecvMsg(req); err != nil {
+		return err
+	}
+
+	ticker := time.NewTicker(bridgeWatchInterval)
+	defer ticker.Stop()
+
+	sent := false
+	lastHeight := uint32(0)
+	for {
+		if height := s.state.LastBlockHeight(); !sent || height != lastHeight {
+			info, err := s.GetBlockchainInfo(stream.Context(), req)
+			if err != nil {
+				return err
+			}
+			if err := stream.SendMsg(info); err != nil {
+				return err
+			}
+			sent = true
+			lastHeight = info.LastBlockHeight
+		}
+
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case <-s.ctx.Done():
+			return status.Error(codes.Unavailable, "server is stopped")
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,181 @@
+package grpc
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/pactus-project/pactus/consensus"
+	"github.com/pactus-project/pactus/crypto/bls"
+	"github.com/pactus-project/pactus/genesis"
+	"github.com/pactus-project/pactus/network"
+	"github.com/pactus-project/pactus/state"
+	"github.com/pactus-project/pactus/sync"
+	"github.com/pactus-project/pactus/util"
+	"github.com/pactus-project/pactus/util/testsuite"
+	"github.com/pactus-project/pactus/wallet"
+	pactus "github.com/pactus-project/pactus/www/grpc/gen/go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestBridgeConfig(t *testing.T) {
+	tests := []struct {
+		listen string
+		valid  bool
+	}{
+		{"127.0.0.1:0", true},
+		{"[::1]:50053", true},
+		{"localhost:50053", true},
+		{"0.0.0.0:50053", false},
+		{"[::]:50053", false},
+		{"192.168.1.10:50053", false},
+		{"127.0.0.1", false},
+	}
+
+	for _, tt := range tests {
+		conf := BridgeConfig{Enable: true, Listen: tt.listen}
+		err := conf.BasicCheck()
+		if tt.valid {
+			assert.NoError(t, err, "listen: %s", tt.listen)
+		} else {
+			assert.Error(t, err, "listen: %s", tt.listen)
+		}
+	}
+
+	t.Run("Disabled bridge is not checked", func(t *testing.T) {
+		conf := BridgeConfig{Enable: false, Listen: "0.0.0.0:50053"}
+		assert.NoError(t, conf.BasicCheck())
+	})
+}
+
+func setupBridge(t *testing.T) (*testsuite.TestSuite, *state.MockState, *Server) {
+	t.Helper()
+
+	ts := testsuite.NewTestSuite(t)
+
+	mockConsMgr, _ := consensus.MockingManager(ts, []*bls.ValidatorKey{ts.RandValKey()})
+	mockState := state.MockingState(ts)
+	mockNet := network.MockingNetwork(ts, ts.RandPeerID())
+	mockSync := sync.MockingSync(ts)
+
+	mockState.CommitTestBlocks(10)
+
+	conf := testConfig()
+	conf.Enable = false
+	conf.Bridge.Enable = true
+	conf.Bridge.TokenFile = filepath.Join(util.TempDirPath(), "bridge_token")
+
+	server := NewServer(conf, mockState, mockSync, mockNet, mockConsMgr, nil, nil)
+	require.NoError(t, server.StartServer())
+	t.Cleanup(server.StopServer)
+
+	return ts, mockState, server
+}
+
+func TestBridgeAccess(t *testing.T) {
+	_, _, server := setupBridge(t)
+
+	assert.Empty(t, server.Address(), "gRPC server should not be started")
+	assert.NotEmpty(t, server.BridgeAddress())
+	assert.Len(t, server.BridgeToken(), 64)
+
+	token, err := os.ReadFile(server.config.Bridge.TokenFile)
+	require.NoError(t, err)
+	assert.Equal(t, server.BridgeToken(), string(token))
+
+	info, err := os.Stat(server.config.Bridge.TokenFile)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+
+	conn, err := grpc.Dial(server.BridgeAddress(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	defer conn.Close()
+
+	tokenCtx := func(token string) context.Context {
+		return metadata.AppendToOutgoingContext(context.Background(), BridgeTokenKey, token)
+	}
+
+	t.Run("Should reject the calls without the token", func(t *testing.T) {
+		_, err := pactus.NewBlockchainClient(conn).GetBlockchainInfo(context.Background(),
+			&pactus.GetBlockchainInfoRequest{})
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+
+	t.Run("Should reject the calls with an invalid token", func(t *testing.T) {
+		_, err := pactus.NewBlockchainClient(conn).GetBlockchainInfo(tokenCtx("invalid-token"),
+			&pactus.GetBlockchainInfoRequest{})
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+
+	t.Run("Should reject the methods that the GUI doesn't need", func(t *testing.T) {
+		_, err := pactus.NewBlockchainClient(conn).GetConsensusInfo(tokenCtx(server.BridgeToken()),
+			&pactus.GetConsensusInfoRequest{})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+		// The Network service is not registered on the bridge.
+		_, err = pactus.NewNetworkClient(conn).GetNodeInfo(tokenCtx(server.BridgeToken()),
+			&pactus.GetNodeInfoRequest{})
+		assert.Equal(t, codes.Unimplemented, status.Code(err))
+	})
+
+	t.Run("Should serve the calls with the token", func(t *testing.T) {
+		res, err := pactus.NewBlockchainClient(conn).GetBlockchainInfo(tokenCtx(server.BridgeToken()),
+			&pactus.GetBlockchainInfoRequest{})
+		require.NoError(t, err)
+		assert.Equal(t, uint32(10), res.LastBlockHeight)
+	})
+
+	t.Run("Should remove the token file on stop", func(t *testing.T) {
+		server.StopServer()
+		assert.NoFileExists(t, server.config.Bridge.TokenFile)
+	})
+}
+
+func TestBridgeWallet(t *testing.T) {
+	ts, mockState, server := setupBridge(t)
+
+	mnemonic, _ := wallet.GenerateMnemonic(128)
+	wlt, err := wallet.Create(util.TempFilePath(), mnemonic, "", genesis.Mainnet)
+	require.NoError(t, err)
+
+	t.Run("Should not connect without the token", func(t *testing.T) {
+		err := wlt.ConnectToBridge(server.BridgeAddress(), "")
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+
+	require.NoError(t, wlt.ConnectToBridge(server.BridgeAddress(), server.BridgeToken()))
+
+	t.Run("Should stream the blockchain updates", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		heights := []uint32{}
+		err := wlt.WatchBlockchain(ctx, func(info *pactus.GetBlockchainInfoResponse) {
+			heights = append(heights, info.LastBlockHeight)
+			if len(heights) == 1 {
+				mockState.CommitTestBlocks(1)
+			} else {
+				cancel()
+			}
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, []uint32{10, 11}, heights)
+	})
+
+	t.Run("Should use the bridge for the wallet calls", func(t *testing.T) {
+		acc, addr := ts.GenerateTestAccount(0)
+		mockState.TestStore.UpdateAccount(addr, acc)
+
+		balance, err := wlt.Balance(addr.String())
+		assert.NoError(t, err)
+		assert.Equal(t, acc.Balance(), balance)
+	})
+}
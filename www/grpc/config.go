@@ -1,10 +1,19 @@
 package grpc
 
+import "time"
+
 type Config struct {
-	Enable       bool          `toml:"enable"`
-	EnableWallet bool          `toml:"enable_wallet"`
-	Listen       string        `toml:"listen"`
-	Gateway      GatewayConfig `toml:"gateway"`
+	Enable       bool              `toml:"enable"`
+	EnableWallet bool              `toml:"enable_wallet"`
+	Listen       string            `toml:"listen"`
+	UnixSocket   string            `toml:"unix_socket"`
+	Gateway      GatewayConfig     `toml:"gateway"`
+	TLS          TLSConfig         `toml:"tls"`
+	Auth         AuthConfig        `toml:"auth"`
+	Tenants      TenantsConfig     `toml:"tenants"`
+	Deadlines    DeadlinesConfig   `toml:"deadlines"`
+	Bridge       BridgeConfig      `toml:"bridge"`
+	NameService  NameServiceConfig `toml:"name_service"`
 
 	// Private config
 	WalletsDir        string `toml:"-"`
@@ -13,12 +22,68 @@ type Config struct {
 
 func DefaultConfig() *Config {
 	return &Config{
-		Enable: false,
-		Listen: "",
+		Enable:     false,
+		Listen:     "",
+		UnixSocket: "",
 		Gateway: GatewayConfig{
 			Enable:     false,
 			Listen:     "",
 			EnableCORS: false,
 		},
+		TLS: TLSConfig{
+			Enable:       false,
+			CertFile:     "grpc_cert.pem",
+			KeyFile:      "grpc_key.pem",
+			ClientCAFile: "",
+		},
+		Auth: AuthConfig{
+			Enable:       false,
+			Secret:       "",
+			MaxClockSkew: 30 * time.Second,
+		},
+		Tenants: TenantsConfig{
+			Enable:             false,
+			AllowAnonymous:     false,
+			AnonymousRateLimit: 10,
+			AnonymousBurst:     20,
+			AnonymousMethods:   []string{},
+			Tenants:            []TenantConfig{},
+		},
+		Deadlines: DeadlinesConfig{
+			Default:            10 * time.Second,
+			Scan:               30 * time.Second,
+			Wallet:             60 * time.Second,
+			SlowQueryThreshold: 2 * time.Second,
+		},
+		Bridge: BridgeConfig{
+			Enable:    false,
+			Listen:    "127.0.0.1:0",
+			TokenFile: "",
+		},
+		NameService: NameServiceConfig{
+			URL:     "",
+			Timeout: defaultNameServiceTimeout,
+		},
 	}
 }
+
+// BasicCheck performs basic checks on the configuration.
+func (conf *Config) BasicCheck() error {
+	if err := conf.Auth.BasicCheck(); err != nil {
+		return err
+	}
+
+	if err := conf.Tenants.BasicCheck(); err != nil {
+		return err
+	}
+
+	if err := conf.Deadlines.BasicCheck(); err != nil {
+		return err
+	}
+
+	if err := conf.Bridge.BasicCheck(); err != nil {
+		return err
+	}
+
+	return conf.NameService.BasicCheck()
+}
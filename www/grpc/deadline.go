@@ -0,0 +1,150 @@
+package grpc
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/pactus-project/pactus/util/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// maxLoggedRequestSize is the maximum size of the request parameters in the slow request logs.
+const maxLoggedRequestSize = 512
+
+// The categories of the methods, that each has its own deadline.
+const (
+	categoryDefault = "default"
+	categoryScan    = "scan"
+	categoryWallet  = "wallet"
+)
+
+// scanMethods are the methods that read a range of the blocks or a batch of the entries.
+var scanMethods = []string{
+	"/pactus.Blockchain/GetBlock",
+	"/pactus.Blockchain/GetBlockHeaders",
+	"/pactus.Blockchain/GetAccounts",
+	"/pactus.Blockchain/GetValidators",
+	"/pactus.Blockchain/ScanBlocks",
+	"/pactus.Blockchain/GetChainStatistics",
+	"/pactus.Blockchain/GetFeePercentiles",
+	"/pactus.Blockchain/VerifyParticipationReport",
+	"/pactus.Transaction/GetTxPoolSnapshot",
+}
+
+// walletService is the prefix of the methods of the Wallet service.
+// The parameters of these methods are not logged, since they may contain the passwords.
+const walletService = "/pactus.Wallet/"
+
+var slowRequestsCounter = prometheus.NewCounterVec(prometheus.CounterOpts{
+	Namespace: "pactus",
+	Subsystem: "grpc",
+	Name:      "slow_requests_total",
+	Help:      "The number of requests that took longer than the slow query threshold, by the method.",
+}, []string{"method"})
+
+func init() {
+	prometheus.MustRegister(slowRequestsCounter)
+}
+
+// DeadlinesConfig is the configuration of the server-side deadlines of the requests,
+// by the category of their methods. Zero means no deadline.
+// The deadline of the client is respected, if it is shorter.
+type DeadlinesConfig struct {
+	Default time.Duration `toml:"default"`
+	Scan    time.Duration `toml:"scan"`
+	Wallet  time.Duration `toml:"wallet"`
+	// SlowQueryThreshold is the duration that the requests taking longer than it
+	// are logged with their parameters. Zero means no logging.
+	SlowQueryThreshold time.Duration `toml:"slow_query_threshold"`
+}
+
+// BasicCheck performs basic checks on the configuration.
+func (conf *DeadlinesConfig) BasicCheck() error {
+	if conf.Default < 0 || conf.Scan < 0 || conf.Wallet < 0 {
+		return errors.Errorf(errors.ErrInvalidConfig, "deadlines should not be negative")
+	}
+	if conf.SlowQueryThreshold < 0 {
+		return errors.Errorf(errors.ErrInvalidConfig, "slow query threshold should not be negative")
+	}
+
+	return nil
+}
+
+// methodCategory returns the category of the method.
+func methodCategory(fullMethod string) string {
+	if strings.HasPrefix(fullMethod, walletService) {
+		return categoryWallet
+	}
+	for _, method := range scanMethods {
+		if fullMethod == method {
+			return categoryScan
+		}
+	}
+
+	return categoryDefault
+}
+
+// deadline returns the deadline of the category.
+func (conf *DeadlinesConfig) deadline(category string) time.Duration {
+	switch category {
+	case categoryScan:
+		return conf.Scan
+	case categoryWallet:
+		return conf.Wallet
+	default:
+		return conf.Default
+	}
+}
+
+// deadlineInterceptor sets the server-side deadline of the request, so the long-running
+// handlers stop iterating once it is exceeded and the heavy requests can't hold the server.
+// It also logs the requests that take longer than the slow query threshold.
+func (s *Server) deadlineInterceptor(ctx context.Context, req any,
+	info *grpc.UnaryServerInfo, handler grpc.UnaryHandler,
+) (any, error) {
+	conf := &s.config.Deadlines
+	category := methodCategory(info.FullMethod)
+	if timeout := conf.deadline(category); timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	start := time.Now()
+	res, err := handler(ctx, req)
+	elapsed := time.Since(start)
+
+	if conf.SlowQueryThreshold > 0 && elapsed >= conf.SlowQueryThreshold {
+		slowRequestsCounter.WithLabelValues(info.FullMethod).Inc()
+		s.logger.Warn("slow grpc request", "method", info.FullMethod, "category", category,
+			"duration", elapsed, "code", status.Code(err), "request", requestParams(info.FullMethod, req))
+	}
+
+	return res, err
+}
+
+// requestParams returns the parameters of the request for logging.
+func requestParams(fullMethod string, req any) string {
+	if strings.HasPrefix(fullMethod, walletService) {
+		return "<redacted>"
+	}
+
+	msg, ok := req.(proto.Message)
+	if !ok {
+		return ""
+	}
+	data, err := protojson.Marshal(msg)
+	if err != nil {
+		return ""
+	}
+	if len(data) > maxLoggedRequestSize {
+		return string(data[:maxLoggedRequestSize]) + "..."
+	}
+
+	return string(data)
+}
@@ -0,0 +1,110 @@
+package grpc
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	pactus "github.com/pactus-project/pactus/www/grpc/gen/go"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestDeadlinesConfigBasicCheck(t *testing.T) {
+	conf := DefaultConfig().Deadlines
+	assert.NoError(t, conf.BasicCheck())
+
+	conf.Scan = -time.Second
+	assert.Error(t, conf.BasicCheck())
+
+	conf = DefaultConfig().Deadlines
+	conf.SlowQueryThreshold = -time.Second
+	assert.Error(t, conf.BasicCheck())
+}
+
+func TestMethodCategory(t *testing.T) {
+	assert.Equal(t, categoryScan, methodCategory("/pactus.Blockchain/ScanBlocks"))
+	assert.Equal(t, categoryScan, methodCategory("/pactus.Blockchain/GetBlock"))
+	assert.Equal(t, categoryWallet, methodCategory("/pactus.Wallet/CreateWallet"))
+	assert.Equal(t, categoryDefault, methodCategory("/pactus.Blockchain/GetBlockHash"))
+	assert.Equal(t, categoryDefault, methodCategory("/pactus.Network/GetNodeInfo"))
+}
+
+func TestRequestParams(t *testing.T) {
+	params := requestParams("/pactus.Blockchain/GetBlockHash",
+		&pactus.GetBlockHashRequest{Height: 10})
+	assert.JSONEq(t, `{"height":10}`, params)
+
+	params = requestParams("/pactus.Wallet/UnlockWallet",
+		&pactus.UnlockWalletRequest{WalletName: "default", Password: "secret"})
+	assert.Equal(t, "<redacted>", params)
+
+	addrs := make([]string, 0, 100)
+	for i := 0; i < 100; i++ {
+		addrs = append(addrs, "pc1zaddress")
+	}
+	params = requestParams("/pactus.Blockchain/ScanBlocks",
+		&pactus.ScanBlocksRequest{Addresses: addrs})
+	assert.Len(t, params, maxLoggedRequestSize+3)
+	assert.True(t, strings.HasSuffix(params, "..."))
+}
+
+func TestDeadlineInterceptor(t *testing.T) {
+	conf := testConfig()
+	conf.Deadlines.Scan = 20 * time.Millisecond
+	conf.Deadlines.SlowQueryThreshold = 10 * time.Millisecond
+	td := setup(t, conf)
+	defer td.StopServer()
+
+	info := &grpc.UnaryServerInfo{FullMethod: "/pactus.Blockchain/ScanBlocks"}
+	req := &pactus.ScanBlocksRequest{}
+
+	t.Run("Deadline is exceeded", func(t *testing.T) {
+		slowBefore := testutil.ToFloat64(slowRequestsCounter.WithLabelValues(info.FullMethod))
+
+		_, err := td.server.deadlineInterceptor(context.Background(), req, info,
+			func(ctx context.Context, _ any) (any, error) {
+				<-ctx.Done()
+
+				return nil, contextError(ctx)
+			})
+		assert.Equal(t, codes.DeadlineExceeded, status.Code(err))
+
+		slowAfter := testutil.ToFloat64(slowRequestsCounter.WithLabelValues(info.FullMethod))
+		assert.Equal(t, slowBefore+1, slowAfter)
+	})
+
+	t.Run("Shorter deadline of the client is respected", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond)
+		defer cancel()
+		clientDeadline, _ := ctx.Deadline()
+
+		_, err := td.server.deadlineInterceptor(ctx, req, info,
+			func(ctx context.Context, _ any) (any, error) {
+				deadline, ok := ctx.Deadline()
+				assert.True(t, ok)
+				assert.Equal(t, clientDeadline, deadline)
+
+				return &pactus.ScanBlocksResponse{}, nil
+			})
+		assert.NoError(t, err)
+	})
+
+	t.Run("No deadline for the category", func(t *testing.T) {
+		td.server.config.Deadlines.Default = 0
+
+		_, err := td.server.deadlineInterceptor(context.Background(), &pactus.GetBlockHashRequest{},
+			&grpc.UnaryServerInfo{FullMethod: "/pactus.Blockchain/GetBlockHash"},
+			func(ctx context.Context, _ any) (any, error) {
+				_, ok := ctx.Deadline()
+				assert.False(t, ok)
+
+				return &pactus.GetBlockHashResponse{}, nil
+			})
+		assert.NoError(t, err)
+	})
+}
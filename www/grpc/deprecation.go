@@ -0,0 +1,100 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/pactus-project/pactus/util/deprecation"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// deprecationInterceptor records the uses of the request fields that are marked
+// as deprecated in the proto files.
+func deprecationInterceptor(ctx context.Context, req any,
+	_ *grpc.UnaryServerInfo, handler grpc.UnaryHandler,
+) (any, error) {
+	if msg, ok := req.(proto.Message); ok {
+		useDeprecatedFields(msg.ProtoReflect())
+	}
+
+	return handler(ctx, req)
+}
+
+// useDeprecatedFields walks the populated fields of the message, including the nested messages.
+func useDeprecatedFields(msg protoreflect.Message) {
+	msg.Range(func(fd protoreflect.FieldDescriptor, val protoreflect.Value) bool {
+		if isDeprecated(fd) {
+			deprecation.Use(deprecation.Deprecation{
+				Kind: deprecation.KindRPC,
+				Name: string(fd.FullName()),
+			})
+		}
+
+		if fd.Message() != nil && !fd.IsMap() {
+			if fd.IsList() {
+				list := val.List()
+				for i := 0; i < list.Len(); i++ {
+					useDeprecatedFields(list.Get(i).Message())
+				}
+			} else {
+				useDeprecatedFields(val.Message())
+			}
+		}
+
+		return true
+	})
+}
+
+func isDeprecated(fd protoreflect.FieldDescriptor) bool {
+	opts, ok := fd.Options().(*descriptorpb.FieldOptions)
+
+	return ok && opts.GetDeprecated()
+}
+
+// registerDeprecatedFields registers the deprecated request and response fields of the services
+// that are registered on the server, so they are listed before they are used.
+func registerDeprecatedFields(server *grpc.Server) {
+	visited := make(map[protoreflect.FullName]bool)
+
+	var register func(md protoreflect.MessageDescriptor)
+	register = func(md protoreflect.MessageDescriptor) {
+		if visited[md.FullName()] {
+			return
+		}
+		visited[md.FullName()] = true
+
+		fields := md.Fields()
+		for i := 0; i < fields.Len(); i++ {
+			fd := fields.Get(i)
+			if isDeprecated(fd) {
+				deprecation.Register(deprecation.Deprecation{
+					Kind: deprecation.KindRPC,
+					Name: string(fd.FullName()),
+				})
+			}
+			if fd.Message() != nil {
+				register(fd.Message())
+			}
+		}
+	}
+
+	for name := range server.GetServiceInfo() {
+		desc, err := protoregistry.GlobalFiles.FindDescriptorByName(protoreflect.FullName(name))
+		if err != nil {
+			continue
+		}
+		sd, ok := desc.(protoreflect.ServiceDescriptor)
+		if !ok {
+			continue
+		}
+
+		methods := sd.Methods()
+		for i := 0; i < methods.Len(); i++ {
+			register(methods.Get(i).Input())
+			register(methods.Get(i).Output())
+		}
+	}
+}
@@ -0,0 +1,81 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pactus-project/pactus/util/deprecation"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+// testRequestWithDeprecatedField returns a message that has a deprecated field,
+// since the proto files of the node have no deprecated field yet.
+func testRequestWithDeprecatedField(t *testing.T) *dynamicpb.Message {
+	t.Helper()
+
+	fdp := &descriptorpb.FileDescriptorProto{
+		Name:    proto.String("deprecation_test.proto"),
+		Package: proto.String("test"),
+		Syntax:  proto.String("proto3"),
+		MessageType: []*descriptorpb.DescriptorProto{{
+			Name: proto.String("TestRequest"),
+			Field: []*descriptorpb.FieldDescriptorProto{
+				{
+					Name:     proto.String("old_field"),
+					Number:   proto.Int32(1),
+					Label:    descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+					Type:     descriptorpb.FieldDescriptorProto_TYPE_STRING.Enum(),
+					JsonName: proto.String("oldField"),
+					Options:  &descriptorpb.FieldOptions{Deprecated: proto.Bool(true)},
+				},
+				{
+					Name:     proto.String("new_field"),
+					Number:   proto.Int32(2),
+					Label:    descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+					Type:     descriptorpb.FieldDescriptorProto_TYPE_STRING.Enum(),
+					JsonName: proto.String("newField"),
+				},
+			},
+		}},
+	}
+	fd, err := protodesc.NewFile(fdp, nil)
+	require.NoError(t, err)
+
+	return dynamicpb.NewMessage(fd.Messages().ByName("TestRequest"))
+}
+
+func usesOf(name string) uint64 {
+	for _, entry := range deprecation.List(true) {
+		if entry.Kind == deprecation.KindRPC && entry.Name == name {
+			return entry.UseCount
+		}
+	}
+
+	return 0
+}
+
+func TestDeprecationInterceptor(t *testing.T) {
+	msg := testRequestWithDeprecatedField(t)
+	fields := msg.Descriptor().Fields()
+	handler := func(_ context.Context, req any) (any, error) {
+		return req, nil
+	}
+
+	msg.Set(fields.ByName("new_field"), protoreflect.ValueOfString("new"))
+	_, err := deprecationInterceptor(context.Background(), msg, &grpc.UnaryServerInfo{}, handler)
+	assert.NoError(t, err)
+	assert.Zero(t, usesOf("test.TestRequest.old_field"), "unset deprecated field is not used")
+
+	msg.Set(fields.ByName("old_field"), protoreflect.ValueOfString("old"))
+	res, err := deprecationInterceptor(context.Background(), msg, &grpc.UnaryServerInfo{}, handler)
+	assert.NoError(t, err)
+	assert.Equal(t, msg, res)
+	assert.Equal(t, uint64(1), usesOf("test.TestRequest.old_field"))
+}
@@ -0,0 +1,260 @@
+package grpc
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/pactus-project/pactus/types/tx"
+	pactus "github.com/pactus-project/pactus/www/grpc/gen/go"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// defaultDraftTTL is the time-to-live of the drafts, if it is not set by the request.
+	defaultDraftTTL = time.Hour
+	// maxDraftTTL is the maximum time-to-live of the drafts.
+	maxDraftTTL = 7 * 24 * time.Hour
+	// maxDraftsPerWallet limits the number of the drafts that a wallet keeps,
+	// so the clients can't fill the memory of the node.
+	maxDraftsPerWallet = 100
+	// maxDraftNoteLength is the maximum length of the note of the drafts in bytes.
+	maxDraftNoteLength = 1024
+)
+
+// draft is an unsigned transaction that is kept for the wallet until it expires.
+// It lets the GUIs and the approval workflows share a pending transaction,
+// instead of passing the raw transaction around.
+type draft struct {
+	id         string
+	walletName string
+	rawTx      []byte
+	trx        *tx.Tx
+	note       string
+	createdAt  time.Time
+	updatedAt  time.Time
+	expiresAt  time.Time
+}
+
+// draftStore keeps the drafts in memory. The expired drafts are removed
+// when the store is accessed.
+type draftStore struct {
+	lk sync.Mutex
+
+	drafts map[string]*draft
+	now    func() time.Time
+}
+
+func newDraftStore() *draftStore {
+	return &draftStore{
+		drafts: make(map[string]*draft),
+		now:    time.Now,
+	}
+}
+
+// parseDraftTx decodes the raw transaction of a draft. The drafts are not signed.
+func parseDraftTx(rawTx []byte) (*tx.Tx, error) {
+	trx, err := tx.FromBytes(rawTx)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid raw transaction: %v", err)
+	}
+	if trx.IsSigned() {
+		return nil, status.Error(codes.InvalidArgument, "draft transaction should not be signed")
+	}
+	if err := trx.CheckUnsigned(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid transaction: %v", err)
+	}
+
+	return trx, nil
+}
+
+func draftTTL(ttl uint32) (time.Duration, error) {
+	if ttl == 0 {
+		return defaultDraftTTL, nil
+	}
+
+	duration := time.Duration(ttl) * time.Second
+	if duration > maxDraftTTL {
+		return 0, status.Errorf(codes.InvalidArgument, "ttl is more than %v", maxDraftTTL)
+	}
+
+	return duration, nil
+}
+
+func checkDraftNote(note string) error {
+	if len(note) > maxDraftNoteLength {
+		return status.Errorf(codes.InvalidArgument, "note is longer than %d bytes", maxDraftNoteLength)
+	}
+
+	return nil
+}
+
+func newDraftID() (string, error) {
+	idBytes := make([]byte, 16)
+	if _, err := rand.Read(idBytes); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(idBytes), nil
+}
+
+// prune removes the expired drafts.
+func (s *draftStore) prune(now time.Time) {
+	for id, d := range s.drafts {
+		if !now.Before(d.expiresAt) {
+			delete(s.drafts, id)
+		}
+	}
+}
+
+func (s *draftStore) count(walletName string) int {
+	count := 0
+	for _, d := range s.drafts {
+		if d.walletName == walletName {
+			count++
+		}
+	}
+
+	return count
+}
+
+func (s *draftStore) create(walletName string, rawTx []byte, note string, ttl uint32) (*draft, error) {
+	trx, err := parseDraftTx(rawTx)
+	if err != nil {
+		return nil, err
+	}
+	duration, err := draftTTL(ttl)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkDraftNote(note); err != nil {
+		return nil, err
+	}
+	id, err := newDraftID()
+	if err != nil {
+		return nil, err
+	}
+
+	s.lk.Lock()
+	defer s.lk.Unlock()
+
+	now := s.now()
+	s.prune(now)
+
+	if s.count(walletName) >= maxDraftsPerWallet {
+		return nil, status.Errorf(codes.ResourceExhausted,
+			"wallet has more than %d drafts", maxDraftsPerWallet)
+	}
+
+	d := &draft{
+		id:         id,
+		walletName: walletName,
+		rawTx:      rawTx,
+		trx:        trx,
+		note:       note,
+		createdAt:  now,
+		updatedAt:  now,
+		expiresAt:  now.Add(duration),
+	}
+	s.drafts[id] = d
+
+	return d, nil
+}
+
+// list returns the drafts of the wallet, ordered by the creation time.
+func (s *draftStore) list(walletName string) []*draft {
+	s.lk.Lock()
+	defer s.lk.Unlock()
+
+	s.prune(s.now())
+
+	drafts := make([]*draft, 0)
+	for _, d := range s.drafts {
+		if d.walletName == walletName {
+			drafts = append(drafts, d)
+		}
+	}
+	sort.Slice(drafts, func(i, j int) bool {
+		return drafts[i].createdAt.Before(drafts[j].createdAt)
+	})
+
+	return drafts
+}
+
+func (s *draftStore) update(walletName, id string, rawTx []byte, note string, ttl uint32) (*draft, error) {
+	var trx *tx.Tx
+	if len(rawTx) > 0 {
+		var err error
+		trx, err = parseDraftTx(rawTx)
+		if err != nil {
+			return nil, err
+		}
+	}
+	var duration time.Duration
+	if ttl != 0 {
+		var err error
+		duration, err = draftTTL(ttl)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if err := checkDraftNote(note); err != nil {
+		return nil, err
+	}
+
+	s.lk.Lock()
+	defer s.lk.Unlock()
+
+	now := s.now()
+	s.prune(now)
+
+	d, ok := s.drafts[id]
+	if !ok || d.walletName != walletName {
+		return nil, status.Errorf(codes.NotFound, "draft not found: %s", id)
+	}
+
+	// The updated draft replaces the old one, so the listed drafts are not changed.
+	updated := *d
+	if trx != nil {
+		updated.rawTx = rawTx
+		updated.trx = trx
+	}
+	if ttl != 0 {
+		updated.expiresAt = now.Add(duration)
+	}
+	updated.note = note
+	updated.updatedAt = now
+	s.drafts[id] = &updated
+
+	return &updated, nil
+}
+
+func (s *draftStore) remove(walletName, id string) error {
+	s.lk.Lock()
+	defer s.lk.Unlock()
+
+	s.prune(s.now())
+
+	d, ok := s.drafts[id]
+	if !ok || d.walletName != walletName {
+		return status.Errorf(codes.NotFound, "draft not found: %s", id)
+	}
+	delete(s.drafts, id)
+
+	return nil
+}
+
+func draftToProto(d *draft) *pactus.DraftTransaction {
+	return &pactus.DraftTransaction{
+		DraftId:        d.id,
+		RawTransaction: d.rawTx,
+		Transaction:    transactionToProto(d.trx),
+		Note:           d.note,
+		CreatedAt:      d.createdAt.Unix(),
+		UpdatedAt:      d.updatedAt.Unix(),
+		ExpiresAt:      d.expiresAt.Unix(),
+	}
+}
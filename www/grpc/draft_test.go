@@ -0,0 +1,222 @@
+package grpc
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/pactus-project/pactus/genesis"
+	"github.com/pactus-project/pactus/types/tx"
+	"github.com/pactus-project/pactus/util/testsuite"
+	"github.com/pactus-project/pactus/wallet"
+	pactus "github.com/pactus-project/pactus/www/grpc/gen/go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestDraftTransactions(t *testing.T) {
+	conf := testConfig()
+	conf.EnableWallet = true
+
+	td := setup(t, conf)
+	conn, client := td.walletClient(t)
+
+	wltName := td.RandString(16)
+	mnemonic, _ := wallet.GenerateMnemonic(128)
+	wlt, err := wallet.Create(filepath.Join(conf.WalletsDir, wltName), mnemonic, "", genesis.Mainnet)
+	require.NoError(t, err)
+	require.NoError(t, wlt.Save())
+
+	_, err = client.LoadWallet(context.Background(), &pactus.LoadWalletRequest{WalletName: wltName})
+	require.NoError(t, err)
+
+	trx1 := tx.NewTransferTx(td.RandHeight(), td.RandAccAddress(), td.RandAccAddress(),
+		td.RandAmount(), td.RandAmount(), "draft 1")
+	rawTx1, _ := trx1.Bytes()
+	trx2 := tx.NewTransferTx(td.RandHeight(), td.RandAccAddress(), td.RandAccAddress(),
+		td.RandAmount(), td.RandAmount(), "draft 2")
+	rawTx2, _ := trx2.Bytes()
+
+	t.Run("Wallet is not loaded", func(t *testing.T) {
+		res, err := client.CreateDraftTransaction(context.Background(),
+			&pactus.CreateDraftTransactionRequest{
+				WalletName:     "not-loaded-wallet",
+				RawTransaction: rawTx1,
+			})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+		assert.Nil(t, res)
+	})
+
+	t.Run("Invalid raw transaction", func(t *testing.T) {
+		res, err := client.CreateDraftTransaction(context.Background(),
+			&pactus.CreateDraftTransactionRequest{
+				WalletName:     wltName,
+				RawTransaction: td.DecodingHex("bad0"),
+			})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Nil(t, res)
+	})
+
+	t.Run("Signed transaction", func(t *testing.T) {
+		signedTx, _ := td.GenerateTestTransferTx()
+		rawTx, _ := signedTx.Bytes()
+		res, err := client.CreateDraftTransaction(context.Background(),
+			&pactus.CreateDraftTransactionRequest{
+				WalletName:     wltName,
+				RawTransaction: rawTx,
+			})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Nil(t, res)
+	})
+
+	t.Run("TTL is too long", func(t *testing.T) {
+		res, err := client.CreateDraftTransaction(context.Background(),
+			&pactus.CreateDraftTransactionRequest{
+				WalletName:     wltName,
+				RawTransaction: rawTx1,
+				Ttl:            uint32(maxDraftTTL/time.Second) + 1,
+			})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Nil(t, res)
+	})
+
+	var draftID string
+	t.Run("Create draft", func(t *testing.T) {
+		res, err := client.CreateDraftTransaction(context.Background(),
+			&pactus.CreateDraftTransactionRequest{
+				WalletName:     wltName,
+				RawTransaction: rawTx1,
+				Note:           "rent",
+			})
+		require.NoError(t, err)
+
+		draftID = res.Draft.DraftId
+		assert.NotEmpty(t, draftID)
+		assert.Equal(t, rawTx1, res.Draft.RawTransaction)
+		assert.Equal(t, trx1.ID().Bytes(), res.Draft.Transaction.Id)
+		assert.Equal(t, "rent", res.Draft.Note)
+		assert.Equal(t, res.Draft.CreatedAt+int64(defaultDraftTTL/time.Second), res.Draft.ExpiresAt)
+	})
+
+	t.Run("List drafts", func(t *testing.T) {
+		res, err := client.ListDraftTransactions(context.Background(),
+			&pactus.ListDraftTransactionsRequest{WalletName: wltName})
+		require.NoError(t, err)
+
+		require.Len(t, res.Drafts, 1)
+		assert.Equal(t, draftID, res.Drafts[0].DraftId)
+	})
+
+	t.Run("Update unknown draft", func(t *testing.T) {
+		res, err := client.UpdateDraftTransaction(context.Background(),
+			&pactus.UpdateDraftTransactionRequest{
+				WalletName: wltName,
+				DraftId:    "unknown",
+			})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+		assert.Nil(t, res)
+	})
+
+	t.Run("Update draft", func(t *testing.T) {
+		res, err := client.UpdateDraftTransaction(context.Background(),
+			&pactus.UpdateDraftTransactionRequest{
+				WalletName:     wltName,
+				DraftId:        draftID,
+				RawTransaction: rawTx2,
+				Note:           "rent, approved",
+				Ttl:            60,
+			})
+		require.NoError(t, err)
+
+		assert.Equal(t, draftID, res.Draft.DraftId)
+		assert.Equal(t, rawTx2, res.Draft.RawTransaction)
+		assert.Equal(t, trx2.ID().Bytes(), res.Draft.Transaction.Id)
+		assert.Equal(t, "rent, approved", res.Draft.Note)
+		assert.Equal(t, res.Draft.UpdatedAt+60, res.Draft.ExpiresAt)
+	})
+
+	t.Run("Delete draft", func(t *testing.T) {
+		res, err := client.DeleteDraftTransaction(context.Background(),
+			&pactus.DeleteDraftTransactionRequest{
+				WalletName: wltName,
+				DraftId:    draftID,
+			})
+		require.NoError(t, err)
+		assert.Equal(t, draftID, res.DraftId)
+
+		_, err = client.DeleteDraftTransaction(context.Background(),
+			&pactus.DeleteDraftTransactionRequest{
+				WalletName: wltName,
+				DraftId:    draftID,
+			})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	assert.Nil(t, conn.Close(), "Error closing connection")
+	td.StopServer()
+}
+
+func TestDraftStore(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	now := time.Now()
+	store := newDraftStore()
+	store.now = func() time.Time { return now }
+
+	trx := tx.NewTransferTx(ts.RandHeight(), ts.RandAccAddress(), ts.RandAccAddress(),
+		ts.RandAmount(), ts.RandAmount(), "draft")
+	rawTx, _ := trx.Bytes()
+
+	t.Run("Drafts of the other wallets are not accessible", func(t *testing.T) {
+		d, err := store.create("wallet-1", rawTx, "", 0)
+		require.NoError(t, err)
+
+		assert.Empty(t, store.list("wallet-2"))
+		_, err = store.update("wallet-2", d.id, nil, "", 0)
+		assert.Equal(t, codes.NotFound, status.Code(err))
+		assert.Equal(t, codes.NotFound, status.Code(store.remove("wallet-2", d.id)))
+		assert.NoError(t, store.remove("wallet-1", d.id))
+	})
+
+	t.Run("Expired drafts are removed", func(t *testing.T) {
+		d, err := store.create("wallet-1", rawTx, "", 60)
+		require.NoError(t, err)
+
+		now = now.Add(59 * time.Second)
+		assert.Len(t, store.list("wallet-1"), 1)
+
+		now = now.Add(time.Second)
+		assert.Empty(t, store.list("wallet-1"))
+		_, err = store.update("wallet-1", d.id, nil, "", 0)
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	t.Run("Number of the drafts is limited", func(t *testing.T) {
+		for i := 0; i < maxDraftsPerWallet; i++ {
+			_, err := store.create("wallet-1", rawTx, "", 0)
+			require.NoError(t, err)
+		}
+
+		_, err := store.create("wallet-1", rawTx, "", 0)
+		assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+		_, err = store.create("wallet-2", rawTx, "", 0)
+		assert.NoError(t, err)
+	})
+
+	t.Run("Drafts are listed by the creation time", func(t *testing.T) {
+		first, err := store.create("wallet-3", rawTx, "first", 0)
+		require.NoError(t, err)
+		now = now.Add(time.Second)
+		second, err := store.create("wallet-3", rawTx, "second", 0)
+		require.NoError(t, err)
+
+		drafts := store.list("wallet-3")
+		require.Len(t, drafts, 2)
+		assert.Equal(t, first.id, drafts[0].id)
+		assert.Equal(t, second.id, drafts[1].id)
+	})
+}
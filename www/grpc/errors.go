@@ -0,0 +1,117 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pactus-project/pactus/util/errors"
+	"github.com/pactus-project/pactus/util/recovery"
+	pactus "github.com/pactus-project/pactus/www/grpc/gen/go"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ErrorInfo returns the machine-readable details of an error returned by the gRPC server.
+// If the error has no details, the code is ERROR_GENERIC.
+func ErrorInfo(err error) *pactus.ErrorInfo {
+	st, ok := status.FromError(err)
+	if !ok {
+		return &pactus.ErrorInfo{
+			Code:    pactus.ErrorCode(errors.Code(err)),
+			Message: err.Error(),
+		}
+	}
+
+	for _, detail := range st.Details() {
+		if info, ok := detail.(*pactus.ErrorInfo); ok {
+			return info
+		}
+	}
+
+	return &pactus.ErrorInfo{
+		Code:    pactus.ErrorCode_ERROR_GENERIC,
+		Message: st.Message(),
+	}
+}
+
+// BroadcastDiagnostics returns the diagnostics of a transaction that is rejected by
+// the BroadcastTransaction call, or nil if the error has no diagnostics.
+func BroadcastDiagnostics(err error) *pactus.BroadcastDiagnostics {
+	st, ok := status.FromError(err)
+	if !ok {
+		return nil
+	}
+
+	for _, detail := range st.Details() {
+		if diag, ok := detail.(*pactus.BroadcastDiagnostics); ok {
+			return diag
+		}
+	}
+
+	return nil
+}
+
+// statusError returns a gRPC status error and attaches the machine-readable details
+// of the cause to it.
+func statusError(c codes.Code, cause error, msg string) error {
+	return withErrorInfo(status.New(c, fmt.Sprintf("%s: %v", msg, cause.Error())),
+		ErrorInfo(cause))
+}
+
+// contextError returns a gRPC status error if the request is canceled
+// or its deadline is exceeded. The long-running handlers check it while
+// they are iterating, to stop working for the clients that are gone.
+func contextError(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return status.FromContextError(err).Err()
+	}
+
+	return nil
+}
+
+func withErrorInfo(st *status.Status, info *pactus.ErrorInfo) error {
+	detailed, err := st.WithDetails(info)
+	if err != nil {
+		return st.Err()
+	}
+
+	return detailed.Err()
+}
+
+// errorInterceptor ensures that all the errors returned to the clients are
+// gRPC status errors with the machine-readable details attached.
+func errorInterceptor(ctx context.Context, req any,
+	_ *grpc.UnaryServerInfo, handler grpc.UnaryHandler,
+) (any, error) {
+	res, err := handler(ctx, req)
+	if err == nil {
+		return res, nil
+	}
+
+	st, ok := status.FromError(err)
+	if !ok {
+		return nil, withErrorInfo(status.New(codes.Unknown, err.Error()), ErrorInfo(err))
+	}
+
+	if len(st.Details()) == 0 {
+		return nil, withErrorInfo(st, ErrorInfo(err))
+	}
+
+	return nil, err
+}
+
+// recoveryInterceptor converts a panic in a handler into an internal error,
+// so a bad request can't take down the node.
+func (s *Server) recoveryInterceptor(ctx context.Context, req any,
+	info *grpc.UnaryServerInfo, handler grpc.UnaryHandler,
+) (res any, err error) {
+	panicErr := recovery.Call("grpc", s.logger, func() {
+		res, err = handler(ctx, req)
+	})
+	if panicErr != nil {
+		return nil, status.Errorf(codes.Internal, "internal error on %s", info.FullMethod)
+	}
+
+	return res, err
+}
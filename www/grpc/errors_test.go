@@ -0,0 +1,124 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/pactus-project/pactus/execution"
+	"github.com/pactus-project/pactus/execution/executor"
+	"github.com/pactus-project/pactus/util/errors"
+	"github.com/pactus-project/pactus/util/logger"
+	pactus "github.com/pactus-project/pactus/www/grpc/gen/go"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestErrorCodesAreStable(t *testing.T) {
+	for code := 0; code < errors.ErrCount; code++ {
+		_, ok := pactus.ErrorCode_name[int32(code)]
+		assert.True(t, ok, "error code %v is not defined in proto", code)
+	}
+	assert.Len(t, pactus.ErrorCode_name, errors.ErrCount)
+
+	assert.Equal(t, pactus.ErrorCode_ERROR_INVALID_FEE, pactus.ErrorCode(errors.ErrInvalidFee))
+	assert.Equal(t, pactus.ErrorCode_ERROR_INSUFFICIENT_FUNDS, pactus.ErrorCode(errors.ErrInsufficientFunds))
+	assert.Equal(t, pactus.ErrorCode_ERROR_FUTURE_LOCK_TIME, pactus.ErrorCode(errors.ErrFutureLockTime))
+}
+
+func TestErrorInfo(t *testing.T) {
+	t.Run("Plain error", func(t *testing.T) {
+		info := ErrorInfo(fmt.Errorf("oops"))
+
+		assert.Equal(t, pactus.ErrorCode_ERROR_GENERIC, info.Code)
+		assert.Equal(t, "oops", info.Message)
+	})
+
+	t.Run("Error with code", func(t *testing.T) {
+		err := execution.PastLockTimeError{LockTime: 1}
+		info := ErrorInfo(err)
+
+		assert.Equal(t, pactus.ErrorCode_ERROR_PAST_LOCK_TIME, info.Code)
+		assert.Equal(t, err.Error(), info.Message)
+	})
+
+	t.Run("Status error without details", func(t *testing.T) {
+		info := ErrorInfo(status.Error(codes.NotFound, "not found"))
+
+		assert.Equal(t, pactus.ErrorCode_ERROR_GENERIC, info.Code)
+		assert.Equal(t, "not found", info.Message)
+	})
+
+	t.Run("Status error with details", func(t *testing.T) {
+		err := statusError(codes.Canceled, executor.ErrInsufficientFunds, "failed")
+		info := ErrorInfo(err)
+
+		assert.Equal(t, codes.Canceled, status.Code(err))
+		assert.Equal(t, pactus.ErrorCode_ERROR_INSUFFICIENT_FUNDS, info.Code)
+		assert.Equal(t, "insufficient funds", info.Message)
+	})
+}
+
+func TestErrorInterceptor(t *testing.T) {
+	call := func(err error) error {
+		_, err = errorInterceptor(context.Background(), nil, &grpc.UnaryServerInfo{},
+			func(_ context.Context, _ any) (any, error) {
+				return nil, err
+			})
+
+		return err
+	}
+
+	t.Run("No error", func(t *testing.T) {
+		assert.NoError(t, call(nil))
+	})
+
+	t.Run("Plain error", func(t *testing.T) {
+		err := call(errors.Errorf(errors.ErrInvalidFee, "fee is wrong"))
+
+		assert.Equal(t, codes.Unknown, status.Code(err))
+		assert.Equal(t, pactus.ErrorCode_ERROR_INVALID_FEE, ErrorInfo(err).Code)
+	})
+
+	t.Run("Status error without details", func(t *testing.T) {
+		err := call(status.Error(codes.NotFound, "not found"))
+
+		st, _ := status.FromError(err)
+		assert.Equal(t, codes.NotFound, st.Code())
+		assert.Len(t, st.Details(), 1)
+		assert.Equal(t, pactus.ErrorCode_ERROR_GENERIC, ErrorInfo(err).Code)
+	})
+
+	t.Run("Status error with details", func(t *testing.T) {
+		err := call(statusError(codes.InvalidArgument, execution.FutureLockTimeError{LockTime: 1}, "failed"))
+
+		st, _ := status.FromError(err)
+		assert.Len(t, st.Details(), 1)
+		assert.Equal(t, pactus.ErrorCode_ERROR_FUTURE_LOCK_TIME, ErrorInfo(err).Code)
+	})
+}
+
+func TestRecoveryInterceptor(t *testing.T) {
+	s := &Server{logger: logger.NewSubLogger("_grpc", nil)}
+	info := &grpc.UnaryServerInfo{FullMethod: "/pactus.Blockchain/GetBlock"}
+
+	t.Run("No panic", func(t *testing.T) {
+		res, err := s.recoveryInterceptor(context.Background(), nil, info,
+			func(_ context.Context, _ any) (any, error) {
+				return "ok", nil
+			})
+		assert.NoError(t, err)
+		assert.Equal(t, "ok", res)
+	})
+
+	t.Run("Panic", func(t *testing.T) {
+		res, err := s.recoveryInterceptor(context.Background(), nil, info,
+			func(_ context.Context, _ any) (any, error) {
+				panic("boom")
+			})
+		assert.Equal(t, codes.Internal, status.Code(err))
+		assert.Nil(t, res)
+	})
+}
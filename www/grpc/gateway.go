@@ -1,6 +1,7 @@
 package grpc
 
 import (
+	"crypto/tls"
 	"fmt"
 	"mime"
 	"net"
@@ -13,7 +14,6 @@ import (
 	_ "github.com/pactus-project/pactus/www/grpc/statik" // Static files.
 	"github.com/rakyll/statik/fs"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
 )
 
 type GatewayConfig struct {
@@ -46,14 +46,14 @@ func (s *Server) startGateway(grpcAddr string) error {
 	conn, err := grpc.DialContext(
 		s.ctx,
 		grpcAddr,
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithTransportCredentials(s.ClientCredentials()),
 		grpc.WithBlock(),
 	)
 	if err != nil {
 		return fmt.Errorf("failed to dial server: %w", err)
 	}
 
-	gwMux := runtime.NewServeMux()
+	gwMux := runtime.NewServeMux(runtime.WithForwardResponseOption(setCacheHeaders))
 	err = pactus.RegisterBlockchainHandler(s.ctx, gwMux, conn)
 	if err != nil {
 		return err
@@ -76,12 +76,14 @@ func (s *Server) startGateway(grpcAddr string) error {
 		return err
 	}
 
+	gwHandler := handleConditionalGet(gwMux)
+
 	gwServer := &http.Server{
 		Addr:              s.config.Gateway.Listen,
 		ReadHeaderTimeout: 3 * time.Second,
 		Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			if strings.HasPrefix(r.URL.Path, "/v1") {
-				gwMux.ServeHTTP(w, r)
+				gwHandler.ServeHTTP(w, r)
 
 				return
 			}
@@ -98,6 +100,10 @@ func (s *Server) startGateway(grpcAddr string) error {
 		return err
 	}
 
+	if s.certs != nil {
+		listener = tls.NewListener(listener, s.certs.serverTLSConfig())
+	}
+
 	s.logger.Info("grpc-gateway started listening", "address", listener.Addr().String())
 
 	go func() {
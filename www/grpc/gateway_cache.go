@@ -0,0 +1,128 @@
+package grpc
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	pactus "github.com/pactus-project/pactus/www/grpc/gen/go"
+	"google.golang.org/protobuf/proto"
+)
+
+// The committed blocks and transactions never change, so their responses can be cached
+// by the reverse proxies and the CDNs for a long time.
+const (
+	cacheControlImmutable = "public, max-age=31536000, immutable"
+	cacheControlNoCache   = "no-cache"
+)
+
+// setCacheHeaders sets the cache headers of the gateway responses.
+// The responses of the committed blocks and transactions are immutable, and their ETags are
+// the block hashes or the transaction IDs. The other responses change with the chain,
+// so they should be revalidated.
+func setCacheHeaders(_ context.Context, w http.ResponseWriter, msg proto.Message) error {
+	etag := ""
+	var lastModified uint32
+
+	switch res := msg.(type) {
+	case *pactus.GetBlockResponse:
+		etag = hex.EncodeToString(res.Hash)
+		lastModified = res.BlockTime
+
+	case *pactus.GetBlockHashResponse:
+		etag = hex.EncodeToString(res.Hash)
+
+	case *pactus.GetBlockHeightResponse:
+		etag = strconv.FormatUint(uint64(res.Height), 10)
+
+	case *pactus.GetTransactionResponse:
+		if res.Transaction != nil {
+			etag = hex.EncodeToString(res.Transaction.Id)
+		}
+		lastModified = res.BlockTime
+	}
+
+	if etag == "" {
+		w.Header().Set("Cache-Control", cacheControlNoCache)
+
+		return nil
+	}
+
+	w.Header().Set("Cache-Control", cacheControlImmutable)
+	w.Header().Set("ETag", fmt.Sprintf("%q", etag))
+	if lastModified != 0 {
+		w.Header().Set("Last-Modified", time.Unix(int64(lastModified), 0).UTC().Format(http.TimeFormat))
+	}
+
+	return nil
+}
+
+// matchETag checks if the If-None-Match header of the request matches the ETag.
+func matchETag(ifNoneMatch, etag string) bool {
+	for _, tag := range strings.Split(ifNoneMatch, ",") {
+		tag = strings.TrimSpace(tag)
+		if tag == "*" || strings.TrimPrefix(tag, "W/") == etag {
+			return true
+		}
+	}
+
+	return false
+}
+
+// notModifiedWriter replies with "304 Not Modified" and drops the body,
+// if the ETag of the response matches the If-None-Match header of the request.
+type notModifiedWriter struct {
+	http.ResponseWriter
+
+	ifNoneMatch string
+	wroteHeader bool
+	notModified bool
+}
+
+func (w *notModifiedWriter) WriteHeader(code int) {
+	if w.wroteHeader {
+		return
+	}
+	w.wroteHeader = true
+
+	etag := w.Header().Get("ETag")
+	if code == http.StatusOK && etag != "" && matchETag(w.ifNoneMatch, etag) {
+		w.notModified = true
+		w.Header().Del("Content-Type")
+		w.Header().Del("Content-Length")
+		w.ResponseWriter.WriteHeader(http.StatusNotModified)
+
+		return
+	}
+
+	w.ResponseWriter.WriteHeader(code)
+}
+
+func (w *notModifiedWriter) Write(data []byte) (int, error) {
+	if !w.wroteHeader {
+		w.WriteHeader(http.StatusOK)
+	}
+	if w.notModified {
+		return len(data), nil
+	}
+
+	return w.ResponseWriter.Write(data)
+}
+
+// handleConditionalGet handles the conditional GET requests, that have the If-None-Match header.
+func handleConditionalGet(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ifNoneMatch := r.Header.Get("If-None-Match")
+		if ifNoneMatch == "" || (r.Method != http.MethodGet && r.Method != http.MethodHead) {
+			h.ServeHTTP(w, r)
+
+			return
+		}
+
+		h.ServeHTTP(&notModifiedWriter{ResponseWriter: w, ifNoneMatch: ifNoneMatch}, r)
+	})
+}
@@ -0,0 +1,132 @@
+package grpc
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/pactus-project/pactus/util/testsuite"
+	pactus "github.com/pactus-project/pactus/www/grpc/gen/go"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestSetCacheHeaders(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	blockHash := ts.RandHash()
+	txID := ts.RandHash()
+	blockTime := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	tests := []struct {
+		name         string
+		msg          proto.Message
+		cacheControl string
+		etag         string
+		lastModified string
+	}{
+		{
+			name:         "Block",
+			msg:          &pactus.GetBlockResponse{Hash: blockHash.Bytes(), BlockTime: uint32(blockTime.Unix())},
+			cacheControl: cacheControlImmutable,
+			etag:         `"` + blockHash.String() + `"`,
+			lastModified: "Tue, 02 Jan 2024 03:04:05 GMT",
+		},
+		{
+			name:         "Block hash",
+			msg:          &pactus.GetBlockHashResponse{Hash: blockHash.Bytes()},
+			cacheControl: cacheControlImmutable,
+			etag:         `"` + blockHash.String() + `"`,
+		},
+		{
+			name:         "Block height",
+			msg:          &pactus.GetBlockHeightResponse{Height: 123},
+			cacheControl: cacheControlImmutable,
+			etag:         `"123"`,
+		},
+		{
+			name: "Transaction",
+			msg: &pactus.GetTransactionResponse{
+				BlockTime:   uint32(blockTime.Unix()),
+				Transaction: &pactus.TransactionInfo{Id: txID.Bytes()},
+			},
+			cacheControl: cacheControlImmutable,
+			etag:         `"` + txID.String() + `"`,
+			lastModified: "Tue, 02 Jan 2024 03:04:05 GMT",
+		},
+		{
+			name:         "Blockchain info",
+			msg:          &pactus.GetBlockchainInfoResponse{LastBlockHeight: 123},
+			cacheControl: cacheControlNoCache,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			assert.NoError(t, setCacheHeaders(context.Background(), w, tt.msg))
+
+			assert.Equal(t, tt.cacheControl, w.Header().Get("Cache-Control"))
+			assert.Equal(t, tt.etag, w.Header().Get("ETag"))
+			assert.Equal(t, tt.lastModified, w.Header().Get("Last-Modified"))
+		})
+	}
+}
+
+func TestMatchETag(t *testing.T) {
+	assert.True(t, matchETag(`"abc"`, `"abc"`))
+	assert.True(t, matchETag(`"xyz", "abc"`, `"abc"`))
+	assert.True(t, matchETag(`W/"abc"`, `"abc"`))
+	assert.True(t, matchETag(`*`, `"abc"`))
+	assert.False(t, matchETag(`"xyz"`, `"abc"`))
+	assert.False(t, matchETag(``, `"abc"`))
+}
+
+func TestConditionalGet(t *testing.T) {
+	handler := handleConditionalGet(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path == "/immutable" {
+			w.Header().Set("ETag", `"abc"`)
+		}
+		_, _ = w.Write([]byte(`{"height":1}`))
+	}))
+
+	serve := func(method, path, ifNoneMatch string) *httptest.ResponseRecorder {
+		r := httptest.NewRequest(method, path, http.NoBody)
+		if ifNoneMatch != "" {
+			r.Header.Set("If-None-Match", ifNoneMatch)
+		}
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, r)
+
+		return w
+	}
+
+	t.Run("ETag matches => should reply not modified", func(t *testing.T) {
+		w := serve(http.MethodGet, "/immutable", `"abc"`)
+		assert.Equal(t, http.StatusNotModified, w.Code)
+		assert.Empty(t, w.Body.String())
+		assert.Empty(t, w.Header().Get("Content-Type"))
+		assert.Equal(t, `"abc"`, w.Header().Get("ETag"))
+	})
+
+	t.Run("ETag doesn't match => should reply the body", func(t *testing.T) {
+		w := serve(http.MethodGet, "/immutable", `"xyz"`)
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, `{"height":1}`, w.Body.String())
+	})
+
+	t.Run("No ETag => should reply the body", func(t *testing.T) {
+		w := serve(http.MethodGet, "/mutable", `*`)
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, `{"height":1}`, w.Body.String())
+	})
+
+	t.Run("Not a GET request => should reply the body", func(t *testing.T) {
+		w := serve(http.MethodPost, "/immutable", `"abc"`)
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, `{"height":1}`, w.Body.String())
+	})
+}
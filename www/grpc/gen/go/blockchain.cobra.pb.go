@@ -23,13 +23,29 @@ func BlockchainClientCommand(options ...client.Option) *cobra.Command {
 		_BlockchainGetBlockCommand(cfg),
 		_BlockchainGetBlockHashCommand(cfg),
 		_BlockchainGetBlockHeightCommand(cfg),
+		_BlockchainGetBlockHeadersCommand(cfg),
+		_BlockchainGetBeaconCommand(cfg),
 		_BlockchainGetBlockchainInfoCommand(cfg),
 		_BlockchainGetConsensusInfoCommand(cfg),
 		_BlockchainGetAccountCommand(cfg),
 		_BlockchainGetValidatorCommand(cfg),
 		_BlockchainGetValidatorByNumberCommand(cfg),
+		_BlockchainGetAccountsCommand(cfg),
+		_BlockchainGetValidatorsCommand(cfg),
 		_BlockchainGetValidatorAddressesCommand(cfg),
 		_BlockchainGetPublicKeyCommand(cfg),
+		_BlockchainGetChainStatisticsCommand(cfg),
+		_BlockchainGetFeePercentilesCommand(cfg),
+		_BlockchainGetBlockBloomCommand(cfg),
+		_BlockchainScanBlocksCommand(cfg),
+		_BlockchainGetBlockRewardCommand(cfg),
+		_BlockchainGetCommitteeCommand(cfg),
+		_BlockchainVerifyParticipationReportCommand(cfg),
+		_BlockchainWatchAddressCommand(cfg),
+		_BlockchainUnwatchAddressCommand(cfg),
+		_BlockchainGetWatchedAddressesCommand(cfg),
+		_BlockchainGetStorageInfoCommand(cfg),
+		_BlockchainGenerateBlocksCommand(cfg),
 	)
 	return cmd
 }
@@ -161,6 +177,91 @@ func _BlockchainGetBlockHeightCommand(cfg *client.Config) *cobra.Command {
 	return cmd
 }
 
+func _BlockchainGetBlockHeadersCommand(cfg *client.Config) *cobra.Command {
+	req := &GetBlockHeadersRequest{}
+
+	cmd := &cobra.Command{
+		Use:   cfg.CommandNamer("GetBlockHeaders"),
+		Short: "GetBlockHeaders RPC client",
+		Long:  "GetBlockHeaders retrieves a batch of block headers with the certificates that commit them.\n It allows the light clients to follow the chain over HTTP, where the P2P network is not reachable.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if cfg.UseEnvVars {
+				if err := flag.SetFlagsFromEnv(cmd.Parent().PersistentFlags(), true, cfg.EnvVarNamer, cfg.EnvVarPrefix, "Blockchain"); err != nil {
+					return err
+				}
+				if err := flag.SetFlagsFromEnv(cmd.PersistentFlags(), false, cfg.EnvVarNamer, cfg.EnvVarPrefix, "Blockchain", "GetBlockHeaders"); err != nil {
+					return err
+				}
+			}
+			return client.RoundTrip(cmd.Context(), cfg, func(cc grpc.ClientConnInterface, in iocodec.Decoder, out iocodec.Encoder) error {
+				cli := NewBlockchainClient(cc)
+				v := &GetBlockHeadersRequest{}
+
+				if err := in(v); err != nil {
+					return err
+				}
+				proto.Merge(v, req)
+
+				res, err := cli.GetBlockHeaders(cmd.Context(), v)
+
+				if err != nil {
+					return err
+				}
+
+				return out(res)
+
+			})
+		},
+	}
+
+	cmd.PersistentFlags().Uint32Var(&req.FromHeight, cfg.FlagNamer("FromHeight"), 0, "")
+	cmd.PersistentFlags().Uint32Var(&req.Count, cfg.FlagNamer("Count"), 0, "")
+
+	return cmd
+}
+
+func _BlockchainGetBeaconCommand(cfg *client.Config) *cobra.Command {
+	req := &GetBeaconRequest{}
+
+	cmd := &cobra.Command{
+		Use:   cfg.CommandNamer("GetBeacon"),
+		Short: "GetBeacon RPC client",
+		Long:  "GetBeacon retrieves the randomness beacon of a block at the specified height.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if cfg.UseEnvVars {
+				if err := flag.SetFlagsFromEnv(cmd.Parent().PersistentFlags(), true, cfg.EnvVarNamer, cfg.EnvVarPrefix, "Blockchain"); err != nil {
+					return err
+				}
+				if err := flag.SetFlagsFromEnv(cmd.PersistentFlags(), false, cfg.EnvVarNamer, cfg.EnvVarPrefix, "Blockchain", "GetBeacon"); err != nil {
+					return err
+				}
+			}
+			return client.RoundTrip(cmd.Context(), cfg, func(cc grpc.ClientConnInterface, in iocodec.Decoder, out iocodec.Encoder) error {
+				cli := NewBlockchainClient(cc)
+				v := &GetBeaconRequest{}
+
+				if err := in(v); err != nil {
+					return err
+				}
+				proto.Merge(v, req)
+
+				res, err := cli.GetBeacon(cmd.Context(), v)
+
+				if err != nil {
+					return err
+				}
+
+				return out(res)
+
+			})
+		},
+	}
+
+	cmd.PersistentFlags().Uint32Var(&req.Height, cfg.FlagNamer("Height"), 0, "")
+
+	return cmd
+}
+
 func _BlockchainGetBlockchainInfoCommand(cfg *client.Config) *cobra.Command {
 	req := &GetBlockchainInfoRequest{}
 
@@ -279,6 +380,8 @@ func _BlockchainGetAccountCommand(cfg *client.Config) *cobra.Command {
 	}
 
 	cmd.PersistentFlags().StringVar(&req.Address, cfg.FlagNamer("Address"), "", "")
+	cmd.PersistentFlags().Uint32Var(&req.AtHeight, cfg.FlagNamer("AtHeight"), 0, "Height of the block to read the state at. If not set, the latest state is used.\n Only the recent blocks committed since the node has started are available.")
+	flag.BytesBase64Var(cmd.PersistentFlags(), &req.AtStateRoot, cfg.FlagNamer("AtStateRoot"), "State root to read the state at. It can be used instead of the at_height.")
 
 	return cmd
 }
@@ -321,6 +424,8 @@ func _BlockchainGetValidatorCommand(cfg *client.Config) *cobra.Command {
 	}
 
 	cmd.PersistentFlags().StringVar(&req.Address, cfg.FlagNamer("Address"), "", "")
+	cmd.PersistentFlags().Uint32Var(&req.AtHeight, cfg.FlagNamer("AtHeight"), 0, "Height of the block to read the state at. If not set, the latest state is used.\n Only the recent blocks committed since the node has started are available.")
+	flag.BytesBase64Var(cmd.PersistentFlags(), &req.AtStateRoot, cfg.FlagNamer("AtStateRoot"), "State root to read the state at. It can be used instead of the at_height.")
 
 	return cmd
 }
@@ -363,6 +468,97 @@ func _BlockchainGetValidatorByNumberCommand(cfg *client.Config) *cobra.Command {
 	}
 
 	cmd.PersistentFlags().Int32Var(&req.Number, cfg.FlagNamer("Number"), 0, "")
+	cmd.PersistentFlags().Uint32Var(&req.AtHeight, cfg.FlagNamer("AtHeight"), 0, "Height of the block to read the state at. If not set, the latest state is used.\n Only the recent blocks committed since the node has started are available.")
+	flag.BytesBase64Var(cmd.PersistentFlags(), &req.AtStateRoot, cfg.FlagNamer("AtStateRoot"), "State root to read the state at. It can be used instead of the at_height.")
+
+	return cmd
+}
+
+func _BlockchainGetAccountsCommand(cfg *client.Config) *cobra.Command {
+	req := &GetAccountsRequest{}
+
+	cmd := &cobra.Command{
+		Use:   cfg.CommandNamer("GetAccounts"),
+		Short: "GetAccounts RPC client",
+		Long:  "GetAccounts retrieves information about multiple accounts based on the provided addresses.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if cfg.UseEnvVars {
+				if err := flag.SetFlagsFromEnv(cmd.Parent().PersistentFlags(), true, cfg.EnvVarNamer, cfg.EnvVarPrefix, "Blockchain"); err != nil {
+					return err
+				}
+				if err := flag.SetFlagsFromEnv(cmd.PersistentFlags(), false, cfg.EnvVarNamer, cfg.EnvVarPrefix, "Blockchain", "GetAccounts"); err != nil {
+					return err
+				}
+			}
+			return client.RoundTrip(cmd.Context(), cfg, func(cc grpc.ClientConnInterface, in iocodec.Decoder, out iocodec.Encoder) error {
+				cli := NewBlockchainClient(cc)
+				v := &GetAccountsRequest{}
+
+				if err := in(v); err != nil {
+					return err
+				}
+				proto.Merge(v, req)
+
+				res, err := cli.GetAccounts(cmd.Context(), v)
+
+				if err != nil {
+					return err
+				}
+
+				return out(res)
+
+			})
+		},
+	}
+
+	cmd.PersistentFlags().StringSliceVar(&req.Addresses, cfg.FlagNamer("Addresses"), nil, "")
+	cmd.PersistentFlags().Uint32Var(&req.AtHeight, cfg.FlagNamer("AtHeight"), 0, "Height of the block to read the state at. If not set, the latest state is used.\n Only the recent blocks committed since the node has started are available.")
+	flag.BytesBase64Var(cmd.PersistentFlags(), &req.AtStateRoot, cfg.FlagNamer("AtStateRoot"), "State root to read the state at. It can be used instead of the at_height.")
+
+	return cmd
+}
+
+func _BlockchainGetValidatorsCommand(cfg *client.Config) *cobra.Command {
+	req := &GetValidatorsRequest{}
+
+	cmd := &cobra.Command{
+		Use:   cfg.CommandNamer("GetValidators"),
+		Short: "GetValidators RPC client",
+		Long:  "GetValidators retrieves information about multiple validators based on the provided\n addresses or numbers.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if cfg.UseEnvVars {
+				if err := flag.SetFlagsFromEnv(cmd.Parent().PersistentFlags(), true, cfg.EnvVarNamer, cfg.EnvVarPrefix, "Blockchain"); err != nil {
+					return err
+				}
+				if err := flag.SetFlagsFromEnv(cmd.PersistentFlags(), false, cfg.EnvVarNamer, cfg.EnvVarPrefix, "Blockchain", "GetValidators"); err != nil {
+					return err
+				}
+			}
+			return client.RoundTrip(cmd.Context(), cfg, func(cc grpc.ClientConnInterface, in iocodec.Decoder, out iocodec.Encoder) error {
+				cli := NewBlockchainClient(cc)
+				v := &GetValidatorsRequest{}
+
+				if err := in(v); err != nil {
+					return err
+				}
+				proto.Merge(v, req)
+
+				res, err := cli.GetValidators(cmd.Context(), v)
+
+				if err != nil {
+					return err
+				}
+
+				return out(res)
+
+			})
+		},
+	}
+
+	cmd.PersistentFlags().StringSliceVar(&req.Addresses, cfg.FlagNamer("Addresses"), nil, "")
+	cmd.PersistentFlags().Int32SliceVar(&req.Numbers, cfg.FlagNamer("Numbers"), nil, "")
+	cmd.PersistentFlags().Uint32Var(&req.AtHeight, cfg.FlagNamer("AtHeight"), 0, "Height of the block to read the state at. If not set, the latest state is used.\n Only the recent blocks committed since the node has started are available.")
+	flag.BytesBase64Var(cmd.PersistentFlags(), &req.AtStateRoot, cfg.FlagNamer("AtStateRoot"), "State root to read the state at. It can be used instead of the at_height.")
 
 	return cmd
 }
@@ -404,6 +600,14 @@ func _BlockchainGetValidatorAddressesCommand(cfg *client.Config) *cobra.Command
 		},
 	}
 
+	_Page := &PageRequest{}
+	cmd.PersistentFlags().StringVar(&_Page.Cursor, cfg.FlagNamer("Page Cursor"), "", "")
+	flag.WithPostSetHook(cmd.PersistentFlags(), cfg.FlagNamer("Page Cursor"), func() { req.Page = _Page })
+	cmd.PersistentFlags().Uint32Var(&_Page.Limit, cfg.FlagNamer("Page Limit"), 0, "")
+	flag.WithPostSetHook(cmd.PersistentFlags(), cfg.FlagNamer("Page Limit"), func() { req.Page = _Page })
+	flag.EnumVar(cmd.PersistentFlags(), &_Page.Order, cfg.FlagNamer("Page Order"), "")
+	flag.WithPostSetHook(cmd.PersistentFlags(), cfg.FlagNamer("Page Order"), func() { req.Page = _Page })
+
 	return cmd
 }
 
@@ -448,3 +652,534 @@ func _BlockchainGetPublicKeyCommand(cfg *client.Config) *cobra.Command {
 
 	return cmd
 }
+
+func _BlockchainGetChainStatisticsCommand(cfg *client.Config) *cobra.Command {
+	req := &GetChainStatisticsRequest{}
+
+	cmd := &cobra.Command{
+		Use:   cfg.CommandNamer("GetChainStatistics"),
+		Short: "GetChainStatistics RPC client",
+		Long:  "GetChainStatistics retrieves statistics about the recent blocks in the specified window.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if cfg.UseEnvVars {
+				if err := flag.SetFlagsFromEnv(cmd.Parent().PersistentFlags(), true, cfg.EnvVarNamer, cfg.EnvVarPrefix, "Blockchain"); err != nil {
+					return err
+				}
+				if err := flag.SetFlagsFromEnv(cmd.PersistentFlags(), false, cfg.EnvVarNamer, cfg.EnvVarPrefix, "Blockchain", "GetChainStatistics"); err != nil {
+					return err
+				}
+			}
+			return client.RoundTrip(cmd.Context(), cfg, func(cc grpc.ClientConnInterface, in iocodec.Decoder, out iocodec.Encoder) error {
+				cli := NewBlockchainClient(cc)
+				v := &GetChainStatisticsRequest{}
+
+				if err := in(v); err != nil {
+					return err
+				}
+				proto.Merge(v, req)
+
+				res, err := cli.GetChainStatistics(cmd.Context(), v)
+
+				if err != nil {
+					return err
+				}
+
+				return out(res)
+
+			})
+		},
+	}
+
+	cmd.PersistentFlags().Uint32Var(&req.Window, cfg.FlagNamer("Window"), 0, "")
+
+	return cmd
+}
+
+func _BlockchainGetFeePercentilesCommand(cfg *client.Config) *cobra.Command {
+	req := &GetFeePercentilesRequest{}
+
+	cmd := &cobra.Command{
+		Use:   cfg.CommandNamer("GetFeePercentiles"),
+		Short: "GetFeePercentiles RPC client",
+		Long:  "GetFeePercentiles retrieves the percentiles of the transaction fees in a range of blocks.\n The fees are rounded down to their histogram bucket, with a relative error of less than 1/8.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if cfg.UseEnvVars {
+				if err := flag.SetFlagsFromEnv(cmd.Parent().PersistentFlags(), true, cfg.EnvVarNamer, cfg.EnvVarPrefix, "Blockchain"); err != nil {
+					return err
+				}
+				if err := flag.SetFlagsFromEnv(cmd.PersistentFlags(), false, cfg.EnvVarNamer, cfg.EnvVarPrefix, "Blockchain", "GetFeePercentiles"); err != nil {
+					return err
+				}
+			}
+			return client.RoundTrip(cmd.Context(), cfg, func(cc grpc.ClientConnInterface, in iocodec.Decoder, out iocodec.Encoder) error {
+				cli := NewBlockchainClient(cc)
+				v := &GetFeePercentilesRequest{}
+
+				if err := in(v); err != nil {
+					return err
+				}
+				proto.Merge(v, req)
+
+				res, err := cli.GetFeePercentiles(cmd.Context(), v)
+
+				if err != nil {
+					return err
+				}
+
+				return out(res)
+
+			})
+		},
+	}
+
+	cmd.PersistentFlags().Uint32Var(&req.FromHeight, cfg.FlagNamer("FromHeight"), 0, "")
+	cmd.PersistentFlags().Uint32Var(&req.ToHeight, cfg.FlagNamer("ToHeight"), 0, "")
+	cmd.PersistentFlags().Uint32Var(&req.Window, cfg.FlagNamer("Window"), 0, "")
+	flag.Uint32SliceVar(cmd.PersistentFlags(), &req.Percentiles, cfg.FlagNamer("Percentiles"), "")
+
+	return cmd
+}
+
+func _BlockchainGetBlockBloomCommand(cfg *client.Config) *cobra.Command {
+	req := &GetBlockBloomRequest{}
+
+	cmd := &cobra.Command{
+		Use:   cfg.CommandNamer("GetBlockBloom"),
+		Short: "GetBlockBloom RPC client",
+		Long:  "GetBlockBloom retrieves the bloom filter of the addresses touched by a block.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if cfg.UseEnvVars {
+				if err := flag.SetFlagsFromEnv(cmd.Parent().PersistentFlags(), true, cfg.EnvVarNamer, cfg.EnvVarPrefix, "Blockchain"); err != nil {
+					return err
+				}
+				if err := flag.SetFlagsFromEnv(cmd.PersistentFlags(), false, cfg.EnvVarNamer, cfg.EnvVarPrefix, "Blockchain", "GetBlockBloom"); err != nil {
+					return err
+				}
+			}
+			return client.RoundTrip(cmd.Context(), cfg, func(cc grpc.ClientConnInterface, in iocodec.Decoder, out iocodec.Encoder) error {
+				cli := NewBlockchainClient(cc)
+				v := &GetBlockBloomRequest{}
+
+				if err := in(v); err != nil {
+					return err
+				}
+				proto.Merge(v, req)
+
+				res, err := cli.GetBlockBloom(cmd.Context(), v)
+
+				if err != nil {
+					return err
+				}
+
+				return out(res)
+
+			})
+		},
+	}
+
+	cmd.PersistentFlags().Uint32Var(&req.Height, cfg.FlagNamer("Height"), 0, "")
+
+	return cmd
+}
+
+func _BlockchainScanBlocksCommand(cfg *client.Config) *cobra.Command {
+	req := &ScanBlocksRequest{}
+
+	cmd := &cobra.Command{
+		Use:   cfg.CommandNamer("ScanBlocks"),
+		Short: "ScanBlocks RPC client",
+		Long:  "ScanBlocks returns the heights of the blocks in a range that may touch any of the addresses.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if cfg.UseEnvVars {
+				if err := flag.SetFlagsFromEnv(cmd.Parent().PersistentFlags(), true, cfg.EnvVarNamer, cfg.EnvVarPrefix, "Blockchain"); err != nil {
+					return err
+				}
+				if err := flag.SetFlagsFromEnv(cmd.PersistentFlags(), false, cfg.EnvVarNamer, cfg.EnvVarPrefix, "Blockchain", "ScanBlocks"); err != nil {
+					return err
+				}
+			}
+			return client.RoundTrip(cmd.Context(), cfg, func(cc grpc.ClientConnInterface, in iocodec.Decoder, out iocodec.Encoder) error {
+				cli := NewBlockchainClient(cc)
+				v := &ScanBlocksRequest{}
+
+				if err := in(v); err != nil {
+					return err
+				}
+				proto.Merge(v, req)
+
+				res, err := cli.ScanBlocks(cmd.Context(), v)
+
+				if err != nil {
+					return err
+				}
+
+				return out(res)
+
+			})
+		},
+	}
+
+	cmd.PersistentFlags().StringSliceVar(&req.Addresses, cfg.FlagNamer("Addresses"), nil, "")
+	cmd.PersistentFlags().Uint32Var(&req.FromHeight, cfg.FlagNamer("FromHeight"), 0, "")
+	cmd.PersistentFlags().Uint32Var(&req.ToHeight, cfg.FlagNamer("ToHeight"), 0, "")
+
+	_Page := &PageRequest{}
+	cmd.PersistentFlags().StringVar(&_Page.Cursor, cfg.FlagNamer("Page Cursor"), "", "")
+	flag.WithPostSetHook(cmd.PersistentFlags(), cfg.FlagNamer("Page Cursor"), func() { req.Page = _Page })
+	cmd.PersistentFlags().Uint32Var(&_Page.Limit, cfg.FlagNamer("Page Limit"), 0, "")
+	flag.WithPostSetHook(cmd.PersistentFlags(), cfg.FlagNamer("Page Limit"), func() { req.Page = _Page })
+	flag.EnumVar(cmd.PersistentFlags(), &_Page.Order, cfg.FlagNamer("Page Order"), "")
+	flag.WithPostSetHook(cmd.PersistentFlags(), cfg.FlagNamer("Page Order"), func() { req.Page = _Page })
+	_Filter := &ListFilter{}
+	cmd.PersistentFlags().Uint32Var(&_Filter.FromHeight, cfg.FlagNamer("Filter FromHeight"), 0, "")
+	flag.WithPostSetHook(cmd.PersistentFlags(), cfg.FlagNamer("Filter FromHeight"), func() { req.Filter = _Filter })
+	cmd.PersistentFlags().Uint32Var(&_Filter.ToHeight, cfg.FlagNamer("Filter ToHeight"), 0, "")
+	flag.WithPostSetHook(cmd.PersistentFlags(), cfg.FlagNamer("Filter ToHeight"), func() { req.Filter = _Filter })
+	flag.EnumVar(cmd.PersistentFlags(), &_Filter.PayloadType, cfg.FlagNamer("Filter PayloadType"), "")
+	flag.WithPostSetHook(cmd.PersistentFlags(), cfg.FlagNamer("Filter PayloadType"), func() { req.Filter = _Filter })
+	cmd.PersistentFlags().StringVar(&_Filter.Address, cfg.FlagNamer("Filter Address"), "", "")
+	flag.WithPostSetHook(cmd.PersistentFlags(), cfg.FlagNamer("Filter Address"), func() { req.Filter = _Filter })
+
+	return cmd
+}
+
+func _BlockchainGetBlockRewardCommand(cfg *client.Config) *cobra.Command {
+	req := &GetBlockRewardRequest{}
+
+	cmd := &cobra.Command{
+		Use:   cfg.CommandNamer("GetBlockReward"),
+		Short: "GetBlockReward RPC client",
+		Long:  "GetBlockReward retrieves the block reward and the treasury accounting of a block.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if cfg.UseEnvVars {
+				if err := flag.SetFlagsFromEnv(cmd.Parent().PersistentFlags(), true, cfg.EnvVarNamer, cfg.EnvVarPrefix, "Blockchain"); err != nil {
+					return err
+				}
+				if err := flag.SetFlagsFromEnv(cmd.PersistentFlags(), false, cfg.EnvVarNamer, cfg.EnvVarPrefix, "Blockchain", "GetBlockReward"); err != nil {
+					return err
+				}
+			}
+			return client.RoundTrip(cmd.Context(), cfg, func(cc grpc.ClientConnInterface, in iocodec.Decoder, out iocodec.Encoder) error {
+				cli := NewBlockchainClient(cc)
+				v := &GetBlockRewardRequest{}
+
+				if err := in(v); err != nil {
+					return err
+				}
+				proto.Merge(v, req)
+
+				res, err := cli.GetBlockReward(cmd.Context(), v)
+
+				if err != nil {
+					return err
+				}
+
+				return out(res)
+
+			})
+		},
+	}
+
+	cmd.PersistentFlags().Uint32Var(&req.Height, cfg.FlagNamer("Height"), 0, "")
+
+	return cmd
+}
+
+func _BlockchainGetCommitteeCommand(cfg *client.Config) *cobra.Command {
+	req := &GetCommitteeRequest{}
+
+	cmd := &cobra.Command{
+		Use:   cfg.CommandNamer("GetCommittee"),
+		Short: "GetCommittee RPC client",
+		Long:  "GetCommittee retrieves the committee that signed the block at the specified height.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if cfg.UseEnvVars {
+				if err := flag.SetFlagsFromEnv(cmd.Parent().PersistentFlags(), true, cfg.EnvVarNamer, cfg.EnvVarPrefix, "Blockchain"); err != nil {
+					return err
+				}
+				if err := flag.SetFlagsFromEnv(cmd.PersistentFlags(), false, cfg.EnvVarNamer, cfg.EnvVarPrefix, "Blockchain", "GetCommittee"); err != nil {
+					return err
+				}
+			}
+			return client.RoundTrip(cmd.Context(), cfg, func(cc grpc.ClientConnInterface, in iocodec.Decoder, out iocodec.Encoder) error {
+				cli := NewBlockchainClient(cc)
+				v := &GetCommitteeRequest{}
+
+				if err := in(v); err != nil {
+					return err
+				}
+				proto.Merge(v, req)
+
+				res, err := cli.GetCommittee(cmd.Context(), v)
+
+				if err != nil {
+					return err
+				}
+
+				return out(res)
+
+			})
+		},
+	}
+
+	cmd.PersistentFlags().Uint32Var(&req.Height, cfg.FlagNamer("Height"), 0, "")
+
+	return cmd
+}
+
+func _BlockchainVerifyParticipationReportCommand(cfg *client.Config) *cobra.Command {
+	req := &VerifyParticipationReportRequest{}
+
+	cmd := &cobra.Command{
+		Use:   cfg.CommandNamer("VerifyParticipationReport"),
+		Short: "VerifyParticipationReport RPC client",
+		Long:  "VerifyParticipationReport verifies a signed participation report of a validator,\n and checks its numbers against the blocks and certificates of the blockchain.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if cfg.UseEnvVars {
+				if err := flag.SetFlagsFromEnv(cmd.Parent().PersistentFlags(), true, cfg.EnvVarNamer, cfg.EnvVarPrefix, "Blockchain"); err != nil {
+					return err
+				}
+				if err := flag.SetFlagsFromEnv(cmd.PersistentFlags(), false, cfg.EnvVarNamer, cfg.EnvVarPrefix, "Blockchain", "VerifyParticipationReport"); err != nil {
+					return err
+				}
+			}
+			return client.RoundTrip(cmd.Context(), cfg, func(cc grpc.ClientConnInterface, in iocodec.Decoder, out iocodec.Encoder) error {
+				cli := NewBlockchainClient(cc)
+				v := &VerifyParticipationReportRequest{}
+
+				if err := in(v); err != nil {
+					return err
+				}
+				proto.Merge(v, req)
+
+				res, err := cli.VerifyParticipationReport(cmd.Context(), v)
+
+				if err != nil {
+					return err
+				}
+
+				return out(res)
+
+			})
+		},
+	}
+
+	flag.BytesBase64Var(cmd.PersistentFlags(), &req.Report, cfg.FlagNamer("Report"), "")
+
+	return cmd
+}
+
+func _BlockchainWatchAddressCommand(cfg *client.Config) *cobra.Command {
+	req := &WatchAddressRequest{}
+
+	cmd := &cobra.Command{
+		Use:   cfg.CommandNamer("WatchAddress"),
+		Short: "WatchAddress RPC client",
+		Long:  "WatchAddress adds an address to the watch list of the node.\n The node notifies about the transactions of the watched addresses in the committed blocks.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if cfg.UseEnvVars {
+				if err := flag.SetFlagsFromEnv(cmd.Parent().PersistentFlags(), true, cfg.EnvVarNamer, cfg.EnvVarPrefix, "Blockchain"); err != nil {
+					return err
+				}
+				if err := flag.SetFlagsFromEnv(cmd.PersistentFlags(), false, cfg.EnvVarNamer, cfg.EnvVarPrefix, "Blockchain", "WatchAddress"); err != nil {
+					return err
+				}
+			}
+			return client.RoundTrip(cmd.Context(), cfg, func(cc grpc.ClientConnInterface, in iocodec.Decoder, out iocodec.Encoder) error {
+				cli := NewBlockchainClient(cc)
+				v := &WatchAddressRequest{}
+
+				if err := in(v); err != nil {
+					return err
+				}
+				proto.Merge(v, req)
+
+				res, err := cli.WatchAddress(cmd.Context(), v)
+
+				if err != nil {
+					return err
+				}
+
+				return out(res)
+
+			})
+		},
+	}
+
+	cmd.PersistentFlags().StringVar(&req.Address, cfg.FlagNamer("Address"), "", "")
+
+	return cmd
+}
+
+func _BlockchainUnwatchAddressCommand(cfg *client.Config) *cobra.Command {
+	req := &UnwatchAddressRequest{}
+
+	cmd := &cobra.Command{
+		Use:   cfg.CommandNamer("UnwatchAddress"),
+		Short: "UnwatchAddress RPC client",
+		Long:  "UnwatchAddress removes an address from the watch list of the node.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if cfg.UseEnvVars {
+				if err := flag.SetFlagsFromEnv(cmd.Parent().PersistentFlags(), true, cfg.EnvVarNamer, cfg.EnvVarPrefix, "Blockchain"); err != nil {
+					return err
+				}
+				if err := flag.SetFlagsFromEnv(cmd.PersistentFlags(), false, cfg.EnvVarNamer, cfg.EnvVarPrefix, "Blockchain", "UnwatchAddress"); err != nil {
+					return err
+				}
+			}
+			return client.RoundTrip(cmd.Context(), cfg, func(cc grpc.ClientConnInterface, in iocodec.Decoder, out iocodec.Encoder) error {
+				cli := NewBlockchainClient(cc)
+				v := &UnwatchAddressRequest{}
+
+				if err := in(v); err != nil {
+					return err
+				}
+				proto.Merge(v, req)
+
+				res, err := cli.UnwatchAddress(cmd.Context(), v)
+
+				if err != nil {
+					return err
+				}
+
+				return out(res)
+
+			})
+		},
+	}
+
+	cmd.PersistentFlags().StringVar(&req.Address, cfg.FlagNamer("Address"), "", "")
+
+	return cmd
+}
+
+func _BlockchainGetWatchedAddressesCommand(cfg *client.Config) *cobra.Command {
+	req := &GetWatchedAddressesRequest{}
+
+	cmd := &cobra.Command{
+		Use:   cfg.CommandNamer("GetWatchedAddresses"),
+		Short: "GetWatchedAddresses RPC client",
+		Long:  "GetWatchedAddresses retrieves the addresses in the watch list of the node.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if cfg.UseEnvVars {
+				if err := flag.SetFlagsFromEnv(cmd.Parent().PersistentFlags(), true, cfg.EnvVarNamer, cfg.EnvVarPrefix, "Blockchain"); err != nil {
+					return err
+				}
+				if err := flag.SetFlagsFromEnv(cmd.PersistentFlags(), false, cfg.EnvVarNamer, cfg.EnvVarPrefix, "Blockchain", "GetWatchedAddresses"); err != nil {
+					return err
+				}
+			}
+			return client.RoundTrip(cmd.Context(), cfg, func(cc grpc.ClientConnInterface, in iocodec.Decoder, out iocodec.Encoder) error {
+				cli := NewBlockchainClient(cc)
+				v := &GetWatchedAddressesRequest{}
+
+				if err := in(v); err != nil {
+					return err
+				}
+				proto.Merge(v, req)
+
+				res, err := cli.GetWatchedAddresses(cmd.Context(), v)
+
+				if err != nil {
+					return err
+				}
+
+				return out(res)
+
+			})
+		},
+	}
+
+	_Page := &PageRequest{}
+	cmd.PersistentFlags().StringVar(&_Page.Cursor, cfg.FlagNamer("Page Cursor"), "", "")
+	flag.WithPostSetHook(cmd.PersistentFlags(), cfg.FlagNamer("Page Cursor"), func() { req.Page = _Page })
+	cmd.PersistentFlags().Uint32Var(&_Page.Limit, cfg.FlagNamer("Page Limit"), 0, "")
+	flag.WithPostSetHook(cmd.PersistentFlags(), cfg.FlagNamer("Page Limit"), func() { req.Page = _Page })
+	flag.EnumVar(cmd.PersistentFlags(), &_Page.Order, cfg.FlagNamer("Page Order"), "")
+	flag.WithPostSetHook(cmd.PersistentFlags(), cfg.FlagNamer("Page Order"), func() { req.Page = _Page })
+
+	return cmd
+}
+
+func _BlockchainGetStorageInfoCommand(cfg *client.Config) *cobra.Command {
+	req := &GetStorageInfoRequest{}
+
+	cmd := &cobra.Command{
+		Use:   cfg.CommandNamer("GetStorageInfo"),
+		Short: "GetStorageInfo RPC client",
+		Long:  "GetStorageInfo retrieves the retention and the size of the column families of the store.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if cfg.UseEnvVars {
+				if err := flag.SetFlagsFromEnv(cmd.Parent().PersistentFlags(), true, cfg.EnvVarNamer, cfg.EnvVarPrefix, "Blockchain"); err != nil {
+					return err
+				}
+				if err := flag.SetFlagsFromEnv(cmd.PersistentFlags(), false, cfg.EnvVarNamer, cfg.EnvVarPrefix, "Blockchain", "GetStorageInfo"); err != nil {
+					return err
+				}
+			}
+			return client.RoundTrip(cmd.Context(), cfg, func(cc grpc.ClientConnInterface, in iocodec.Decoder, out iocodec.Encoder) error {
+				cli := NewBlockchainClient(cc)
+				v := &GetStorageInfoRequest{}
+
+				if err := in(v); err != nil {
+					return err
+				}
+				proto.Merge(v, req)
+
+				res, err := cli.GetStorageInfo(cmd.Context(), v)
+
+				if err != nil {
+					return err
+				}
+
+				return out(res)
+
+			})
+		},
+	}
+
+	return cmd
+}
+
+func _BlockchainGenerateBlocksCommand(cfg *client.Config) *cobra.Command {
+	req := &GenerateBlocksRequest{}
+
+	cmd := &cobra.Command{
+		Use:   cfg.CommandNamer("GenerateBlocks"),
+		Short: "GenerateBlocks RPC client",
+		Long:  "GenerateBlocks produces and commits the given number of blocks immediately, bypassing the consensus.\n It is only available on the simnet, for the integration tests that need precise control over\n the confirmations.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if cfg.UseEnvVars {
+				if err := flag.SetFlagsFromEnv(cmd.Parent().PersistentFlags(), true, cfg.EnvVarNamer, cfg.EnvVarPrefix, "Blockchain"); err != nil {
+					return err
+				}
+				if err := flag.SetFlagsFromEnv(cmd.PersistentFlags(), false, cfg.EnvVarNamer, cfg.EnvVarPrefix, "Blockchain", "GenerateBlocks"); err != nil {
+					return err
+				}
+			}
+			return client.RoundTrip(cmd.Context(), cfg, func(cc grpc.ClientConnInterface, in iocodec.Decoder, out iocodec.Encoder) error {
+				cli := NewBlockchainClient(cc)
+				v := &GenerateBlocksRequest{}
+
+				if err := in(v); err != nil {
+					return err
+				}
+				proto.Merge(v, req)
+
+				res, err := cli.GenerateBlocks(cmd.Context(), v)
+
+				if err != nil {
+					return err
+				}
+
+				return out(res)
+
+			})
+		},
+	}
+
+	cmd.PersistentFlags().Uint32Var(&req.Count, cfg.FlagNamer("Count"), 0, "")
+	cmd.PersistentFlags().StringVar(&req.RewardAddress, cfg.FlagNamer("RewardAddress"), "", "")
+
+	return cmd
+}
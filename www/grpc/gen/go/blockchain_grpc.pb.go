@@ -28,6 +28,11 @@ type BlockchainClient interface {
 	GetBlockHash(ctx context.Context, in *GetBlockHashRequest, opts ...grpc.CallOption) (*GetBlockHashResponse, error)
 	// GetBlockHeight retrieves the height of a block with the specified hash.
 	GetBlockHeight(ctx context.Context, in *GetBlockHeightRequest, opts ...grpc.CallOption) (*GetBlockHeightResponse, error)
+	// GetBlockHeaders retrieves a batch of block headers with the certificates that commit them.
+	// It allows the light clients to follow the chain over HTTP, where the P2P network is not reachable.
+	GetBlockHeaders(ctx context.Context, in *GetBlockHeadersRequest, opts ...grpc.CallOption) (*GetBlockHeadersResponse, error)
+	// GetBeacon retrieves the randomness beacon of a block at the specified height.
+	GetBeacon(ctx context.Context, in *GetBeaconRequest, opts ...grpc.CallOption) (*GetBeaconResponse, error)
 	// GetBlockchainInfo retrieves general information about the blockchain.
 	GetBlockchainInfo(ctx context.Context, in *GetBlockchainInfoRequest, opts ...grpc.CallOption) (*GetBlockchainInfoResponse, error)
 	// GetConsensusInfo retrieves information about the consensus instances.
@@ -38,10 +43,44 @@ type BlockchainClient interface {
 	GetValidator(ctx context.Context, in *GetValidatorRequest, opts ...grpc.CallOption) (*GetValidatorResponse, error)
 	// GetValidatorByNumber retrieves information about a validator based on the provided number.
 	GetValidatorByNumber(ctx context.Context, in *GetValidatorByNumberRequest, opts ...grpc.CallOption) (*GetValidatorResponse, error)
+	// GetAccounts retrieves information about multiple accounts based on the provided addresses.
+	GetAccounts(ctx context.Context, in *GetAccountsRequest, opts ...grpc.CallOption) (*GetAccountsResponse, error)
+	// GetValidators retrieves information about multiple validators based on the provided
+	// addresses or numbers.
+	GetValidators(ctx context.Context, in *GetValidatorsRequest, opts ...grpc.CallOption) (*GetValidatorsResponse, error)
 	// GetValidatorAddresses retrieves a list of all validator addresses.
 	GetValidatorAddresses(ctx context.Context, in *GetValidatorAddressesRequest, opts ...grpc.CallOption) (*GetValidatorAddressesResponse, error)
 	// GetPublicKey retrieves the public key of an account based on the provided address.
 	GetPublicKey(ctx context.Context, in *GetPublicKeyRequest, opts ...grpc.CallOption) (*GetPublicKeyResponse, error)
+	// GetChainStatistics retrieves statistics about the recent blocks in the specified window.
+	GetChainStatistics(ctx context.Context, in *GetChainStatisticsRequest, opts ...grpc.CallOption) (*GetChainStatisticsResponse, error)
+	// GetFeePercentiles retrieves the percentiles of the transaction fees in a range of blocks.
+	// The fees are rounded down to their histogram bucket, with a relative error of less than 1/8.
+	GetFeePercentiles(ctx context.Context, in *GetFeePercentilesRequest, opts ...grpc.CallOption) (*GetFeePercentilesResponse, error)
+	// GetBlockBloom retrieves the bloom filter of the addresses touched by a block.
+	GetBlockBloom(ctx context.Context, in *GetBlockBloomRequest, opts ...grpc.CallOption) (*GetBlockBloomResponse, error)
+	// ScanBlocks returns the heights of the blocks in a range that may touch any of the addresses.
+	ScanBlocks(ctx context.Context, in *ScanBlocksRequest, opts ...grpc.CallOption) (*ScanBlocksResponse, error)
+	// GetBlockReward retrieves the block reward and the treasury accounting of a block.
+	GetBlockReward(ctx context.Context, in *GetBlockRewardRequest, opts ...grpc.CallOption) (*GetBlockRewardResponse, error)
+	// GetCommittee retrieves the committee that signed the block at the specified height.
+	GetCommittee(ctx context.Context, in *GetCommitteeRequest, opts ...grpc.CallOption) (*GetCommitteeResponse, error)
+	// VerifyParticipationReport verifies a signed participation report of a validator,
+	// and checks its numbers against the blocks and certificates of the blockchain.
+	VerifyParticipationReport(ctx context.Context, in *VerifyParticipationReportRequest, opts ...grpc.CallOption) (*VerifyParticipationReportResponse, error)
+	// WatchAddress adds an address to the watch list of the node.
+	// The node notifies about the transactions of the watched addresses in the committed blocks.
+	WatchAddress(ctx context.Context, in *WatchAddressRequest, opts ...grpc.CallOption) (*WatchAddressResponse, error)
+	// UnwatchAddress removes an address from the watch list of the node.
+	UnwatchAddress(ctx context.Context, in *UnwatchAddressRequest, opts ...grpc.CallOption) (*UnwatchAddressResponse, error)
+	// GetWatchedAddresses retrieves the addresses in the watch list of the node.
+	GetWatchedAddresses(ctx context.Context, in *GetWatchedAddressesRequest, opts ...grpc.CallOption) (*GetWatchedAddressesResponse, error)
+	// GetStorageInfo retrieves the retention and the size of the column families of the store.
+	GetStorageInfo(ctx context.Context, in *GetStorageInfoRequest, opts ...grpc.CallOption) (*GetStorageInfoResponse, error)
+	// GenerateBlocks produces and commits the given number of blocks immediately, bypassing the consensus.
+	// It is only available on the simnet, for the integration tests that need precise control over
+	// the confirmations.
+	GenerateBlocks(ctx context.Context, in *GenerateBlocksRequest, opts ...grpc.CallOption) (*GenerateBlocksResponse, error)
 }
 
 type blockchainClient struct {
@@ -79,6 +118,24 @@ func (c *blockchainClient) GetBlockHeight(ctx context.Context, in *GetBlockHeigh
 	return out, nil
 }
 
+func (c *blockchainClient) GetBlockHeaders(ctx context.Context, in *GetBlockHeadersRequest, opts ...grpc.CallOption) (*GetBlockHeadersResponse, error) {
+	out := new(GetBlockHeadersResponse)
+	err := c.cc.Invoke(ctx, "/pactus.Blockchain/GetBlockHeaders", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blockchainClient) GetBeacon(ctx context.Context, in *GetBeaconRequest, opts ...grpc.CallOption) (*GetBeaconResponse, error) {
+	out := new(GetBeaconResponse)
+	err := c.cc.Invoke(ctx, "/pactus.Blockchain/GetBeacon", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *blockchainClient) GetBlockchainInfo(ctx context.Context, in *GetBlockchainInfoRequest, opts ...grpc.CallOption) (*GetBlockchainInfoResponse, error) {
 	out := new(GetBlockchainInfoResponse)
 	err := c.cc.Invoke(ctx, "/pactus.Blockchain/GetBlockchainInfo", in, out, opts...)
@@ -124,6 +181,24 @@ func (c *blockchainClient) GetValidatorByNumber(ctx context.Context, in *GetVali
 	return out, nil
 }
 
+func (c *blockchainClient) GetAccounts(ctx context.Context, in *GetAccountsRequest, opts ...grpc.CallOption) (*GetAccountsResponse, error) {
+	out := new(GetAccountsResponse)
+	err := c.cc.Invoke(ctx, "/pactus.Blockchain/GetAccounts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blockchainClient) GetValidators(ctx context.Context, in *GetValidatorsRequest, opts ...grpc.CallOption) (*GetValidatorsResponse, error) {
+	out := new(GetValidatorsResponse)
+	err := c.cc.Invoke(ctx, "/pactus.Blockchain/GetValidators", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *blockchainClient) GetValidatorAddresses(ctx context.Context, in *GetValidatorAddressesRequest, opts ...grpc.CallOption) (*GetValidatorAddressesResponse, error) {
 	out := new(GetValidatorAddressesResponse)
 	err := c.cc.Invoke(ctx, "/pactus.Blockchain/GetValidatorAddresses", in, out, opts...)
@@ -142,6 +217,114 @@ func (c *blockchainClient) GetPublicKey(ctx context.Context, in *GetPublicKeyReq
 	return out, nil
 }
 
+func (c *blockchainClient) GetChainStatistics(ctx context.Context, in *GetChainStatisticsRequest, opts ...grpc.CallOption) (*GetChainStatisticsResponse, error) {
+	out := new(GetChainStatisticsResponse)
+	err := c.cc.Invoke(ctx, "/pactus.Blockchain/GetChainStatistics", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blockchainClient) GetFeePercentiles(ctx context.Context, in *GetFeePercentilesRequest, opts ...grpc.CallOption) (*GetFeePercentilesResponse, error) {
+	out := new(GetFeePercentilesResponse)
+	err := c.cc.Invoke(ctx, "/pactus.Blockchain/GetFeePercentiles", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blockchainClient) GetBlockBloom(ctx context.Context, in *GetBlockBloomRequest, opts ...grpc.CallOption) (*GetBlockBloomResponse, error) {
+	out := new(GetBlockBloomResponse)
+	err := c.cc.Invoke(ctx, "/pactus.Blockchain/GetBlockBloom", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blockchainClient) ScanBlocks(ctx context.Context, in *ScanBlocksRequest, opts ...grpc.CallOption) (*ScanBlocksResponse, error) {
+	out := new(ScanBlocksResponse)
+	err := c.cc.Invoke(ctx, "/pactus.Blockchain/ScanBlocks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blockchainClient) GetBlockReward(ctx context.Context, in *GetBlockRewardRequest, opts ...grpc.CallOption) (*GetBlockRewardResponse, error) {
+	out := new(GetBlockRewardResponse)
+	err := c.cc.Invoke(ctx, "/pactus.Blockchain/GetBlockReward", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blockchainClient) GetCommittee(ctx context.Context, in *GetCommitteeRequest, opts ...grpc.CallOption) (*GetCommitteeResponse, error) {
+	out := new(GetCommitteeResponse)
+	err := c.cc.Invoke(ctx, "/pactus.Blockchain/GetCommittee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blockchainClient) VerifyParticipationReport(ctx context.Context, in *VerifyParticipationReportRequest, opts ...grpc.CallOption) (*VerifyParticipationReportResponse, error) {
+	out := new(VerifyParticipationReportResponse)
+	err := c.cc.Invoke(ctx, "/pactus.Blockchain/VerifyParticipationReport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blockchainClient) WatchAddress(ctx context.Context, in *WatchAddressRequest, opts ...grpc.CallOption) (*WatchAddressResponse, error) {
+	out := new(WatchAddressResponse)
+	err := c.cc.Invoke(ctx, "/pactus.Blockchain/WatchAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blockchainClient) UnwatchAddress(ctx context.Context, in *UnwatchAddressRequest, opts ...grpc.CallOption) (*UnwatchAddressResponse, error) {
+	out := new(UnwatchAddressResponse)
+	err := c.cc.Invoke(ctx, "/pactus.Blockchain/UnwatchAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blockchainClient) GetWatchedAddresses(ctx context.Context, in *GetWatchedAddressesRequest, opts ...grpc.CallOption) (*GetWatchedAddressesResponse, error) {
+	out := new(GetWatchedAddressesResponse)
+	err := c.cc.Invoke(ctx, "/pactus.Blockchain/GetWatchedAddresses", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blockchainClient) GetStorageInfo(ctx context.Context, in *GetStorageInfoRequest, opts ...grpc.CallOption) (*GetStorageInfoResponse, error) {
+	out := new(GetStorageInfoResponse)
+	err := c.cc.Invoke(ctx, "/pactus.Blockchain/GetStorageInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blockchainClient) GenerateBlocks(ctx context.Context, in *GenerateBlocksRequest, opts ...grpc.CallOption) (*GenerateBlocksResponse, error) {
+	out := new(GenerateBlocksResponse)
+	err := c.cc.Invoke(ctx, "/pactus.Blockchain/GenerateBlocks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // BlockchainServer is the server API for Blockchain service.
 // All implementations should embed UnimplementedBlockchainServer
 // for forward compatibility
@@ -152,6 +335,11 @@ type BlockchainServer interface {
 	GetBlockHash(context.Context, *GetBlockHashRequest) (*GetBlockHashResponse, error)
 	// GetBlockHeight retrieves the height of a block with the specified hash.
 	GetBlockHeight(context.Context, *GetBlockHeightRequest) (*GetBlockHeightResponse, error)
+	// GetBlockHeaders retrieves a batch of block headers with the certificates that commit them.
+	// It allows the light clients to follow the chain over HTTP, where the P2P network is not reachable.
+	GetBlockHeaders(context.Context, *GetBlockHeadersRequest) (*GetBlockHeadersResponse, error)
+	// GetBeacon retrieves the randomness beacon of a block at the specified height.
+	GetBeacon(context.Context, *GetBeaconRequest) (*GetBeaconResponse, error)
 	// GetBlockchainInfo retrieves general information about the blockchain.
 	GetBlockchainInfo(context.Context, *GetBlockchainInfoRequest) (*GetBlockchainInfoResponse, error)
 	// GetConsensusInfo retrieves information about the consensus instances.
@@ -162,10 +350,44 @@ type BlockchainServer interface {
 	GetValidator(context.Context, *GetValidatorRequest) (*GetValidatorResponse, error)
 	// GetValidatorByNumber retrieves information about a validator based on the provided number.
 	GetValidatorByNumber(context.Context, *GetValidatorByNumberRequest) (*GetValidatorResponse, error)
+	// GetAccounts retrieves information about multiple accounts based on the provided addresses.
+	GetAccounts(context.Context, *GetAccountsRequest) (*GetAccountsResponse, error)
+	// GetValidators retrieves information about multiple validators based on the provided
+	// addresses or numbers.
+	GetValidators(context.Context, *GetValidatorsRequest) (*GetValidatorsResponse, error)
 	// GetValidatorAddresses retrieves a list of all validator addresses.
 	GetValidatorAddresses(context.Context, *GetValidatorAddressesRequest) (*GetValidatorAddressesResponse, error)
 	// GetPublicKey retrieves the public key of an account based on the provided address.
 	GetPublicKey(context.Context, *GetPublicKeyRequest) (*GetPublicKeyResponse, error)
+	// GetChainStatistics retrieves statistics about the recent blocks in the specified window.
+	GetChainStatistics(context.Context, *GetChainStatisticsRequest) (*GetChainStatisticsResponse, error)
+	// GetFeePercentiles retrieves the percentiles of the transaction fees in a range of blocks.
+	// The fees are rounded down to their histogram bucket, with a relative error of less than 1/8.
+	GetFeePercentiles(context.Context, *GetFeePercentilesRequest) (*GetFeePercentilesResponse, error)
+	// GetBlockBloom retrieves the bloom filter of the addresses touched by a block.
+	GetBlockBloom(context.Context, *GetBlockBloomRequest) (*GetBlockBloomResponse, error)
+	// ScanBlocks returns the heights of the blocks in a range that may touch any of the addresses.
+	ScanBlocks(context.Context, *ScanBlocksRequest) (*ScanBlocksResponse, error)
+	// GetBlockReward retrieves the block reward and the treasury accounting of a block.
+	GetBlockReward(context.Context, *GetBlockRewardRequest) (*GetBlockRewardResponse, error)
+	// GetCommittee retrieves the committee that signed the block at the specified height.
+	GetCommittee(context.Context, *GetCommitteeRequest) (*GetCommitteeResponse, error)
+	// VerifyParticipationReport verifies a signed participation report of a validator,
+	// and checks its numbers against the blocks and certificates of the blockchain.
+	VerifyParticipationReport(context.Context, *VerifyParticipationReportRequest) (*VerifyParticipationReportResponse, error)
+	// WatchAddress adds an address to the watch list of the node.
+	// The node notifies about the transactions of the watched addresses in the committed blocks.
+	WatchAddress(context.Context, *WatchAddressRequest) (*WatchAddressResponse, error)
+	// UnwatchAddress removes an address from the watch list of the node.
+	UnwatchAddress(context.Context, *UnwatchAddressRequest) (*UnwatchAddressResponse, error)
+	// GetWatchedAddresses retrieves the addresses in the watch list of the node.
+	GetWatchedAddresses(context.Context, *GetWatchedAddressesRequest) (*GetWatchedAddressesResponse, error)
+	// GetStorageInfo retrieves the retention and the size of the column families of the store.
+	GetStorageInfo(context.Context, *GetStorageInfoRequest) (*GetStorageInfoResponse, error)
+	// GenerateBlocks produces and commits the given number of blocks immediately, bypassing the consensus.
+	// It is only available on the simnet, for the integration tests that need precise control over
+	// the confirmations.
+	GenerateBlocks(context.Context, *GenerateBlocksRequest) (*GenerateBlocksResponse, error)
 }
 
 // UnimplementedBlockchainServer should be embedded to have forward compatible implementations.
@@ -181,6 +403,12 @@ func (UnimplementedBlockchainServer) GetBlockHash(context.Context, *GetBlockHash
 func (UnimplementedBlockchainServer) GetBlockHeight(context.Context, *GetBlockHeightRequest) (*GetBlockHeightResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetBlockHeight not implemented")
 }
+func (UnimplementedBlockchainServer) GetBlockHeaders(context.Context, *GetBlockHeadersRequest) (*GetBlockHeadersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBlockHeaders not implemented")
+}
+func (UnimplementedBlockchainServer) GetBeacon(context.Context, *GetBeaconRequest) (*GetBeaconResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBeacon not implemented")
+}
 func (UnimplementedBlockchainServer) GetBlockchainInfo(context.Context, *GetBlockchainInfoRequest) (*GetBlockchainInfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetBlockchainInfo not implemented")
 }
@@ -196,12 +424,54 @@ func (UnimplementedBlockchainServer) GetValidator(context.Context, *GetValidator
 func (UnimplementedBlockchainServer) GetValidatorByNumber(context.Context, *GetValidatorByNumberRequest) (*GetValidatorResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetValidatorByNumber not implemented")
 }
+func (UnimplementedBlockchainServer) GetAccounts(context.Context, *GetAccountsRequest) (*GetAccountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAccounts not implemented")
+}
+func (UnimplementedBlockchainServer) GetValidators(context.Context, *GetValidatorsRequest) (*GetValidatorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetValidators not implemented")
+}
 func (UnimplementedBlockchainServer) GetValidatorAddresses(context.Context, *GetValidatorAddressesRequest) (*GetValidatorAddressesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetValidatorAddresses not implemented")
 }
 func (UnimplementedBlockchainServer) GetPublicKey(context.Context, *GetPublicKeyRequest) (*GetPublicKeyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetPublicKey not implemented")
 }
+func (UnimplementedBlockchainServer) GetChainStatistics(context.Context, *GetChainStatisticsRequest) (*GetChainStatisticsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChainStatistics not implemented")
+}
+func (UnimplementedBlockchainServer) GetFeePercentiles(context.Context, *GetFeePercentilesRequest) (*GetFeePercentilesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFeePercentiles not implemented")
+}
+func (UnimplementedBlockchainServer) GetBlockBloom(context.Context, *GetBlockBloomRequest) (*GetBlockBloomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBlockBloom not implemented")
+}
+func (UnimplementedBlockchainServer) ScanBlocks(context.Context, *ScanBlocksRequest) (*ScanBlocksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScanBlocks not implemented")
+}
+func (UnimplementedBlockchainServer) GetBlockReward(context.Context, *GetBlockRewardRequest) (*GetBlockRewardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBlockReward not implemented")
+}
+func (UnimplementedBlockchainServer) GetCommittee(context.Context, *GetCommitteeRequest) (*GetCommitteeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCommittee not implemented")
+}
+func (UnimplementedBlockchainServer) VerifyParticipationReport(context.Context, *VerifyParticipationReportRequest) (*VerifyParticipationReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyParticipationReport not implemented")
+}
+func (UnimplementedBlockchainServer) WatchAddress(context.Context, *WatchAddressRequest) (*WatchAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WatchAddress not implemented")
+}
+func (UnimplementedBlockchainServer) UnwatchAddress(context.Context, *UnwatchAddressRequest) (*UnwatchAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnwatchAddress not implemented")
+}
+func (UnimplementedBlockchainServer) GetWatchedAddresses(context.Context, *GetWatchedAddressesRequest) (*GetWatchedAddressesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWatchedAddresses not implemented")
+}
+func (UnimplementedBlockchainServer) GetStorageInfo(context.Context, *GetStorageInfoRequest) (*GetStorageInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStorageInfo not implemented")
+}
+func (UnimplementedBlockchainServer) GenerateBlocks(context.Context, *GenerateBlocksRequest) (*GenerateBlocksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GenerateBlocks not implemented")
+}
 
 // UnsafeBlockchainServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to BlockchainServer will
@@ -268,6 +538,42 @@ func _Blockchain_GetBlockHeight_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Blockchain_GetBlockHeaders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBlockHeadersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlockchainServer).GetBlockHeaders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pactus.Blockchain/GetBlockHeaders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlockchainServer).GetBlockHeaders(ctx, req.(*GetBlockHeadersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Blockchain_GetBeacon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBeaconRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlockchainServer).GetBeacon(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pactus.Blockchain/GetBeacon",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlockchainServer).GetBeacon(ctx, req.(*GetBeaconRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Blockchain_GetBlockchainInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetBlockchainInfoRequest)
 	if err := dec(in); err != nil {
@@ -358,6 +664,42 @@ func _Blockchain_GetValidatorByNumber_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Blockchain_GetAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAccountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlockchainServer).GetAccounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pactus.Blockchain/GetAccounts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlockchainServer).GetAccounts(ctx, req.(*GetAccountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Blockchain_GetValidators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetValidatorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlockchainServer).GetValidators(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pactus.Blockchain/GetValidators",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlockchainServer).GetValidators(ctx, req.(*GetValidatorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Blockchain_GetValidatorAddresses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetValidatorAddressesRequest)
 	if err := dec(in); err != nil {
@@ -394,6 +736,222 @@ func _Blockchain_GetPublicKey_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Blockchain_GetChainStatistics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetChainStatisticsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlockchainServer).GetChainStatistics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pactus.Blockchain/GetChainStatistics",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlockchainServer).GetChainStatistics(ctx, req.(*GetChainStatisticsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Blockchain_GetFeePercentiles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFeePercentilesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlockchainServer).GetFeePercentiles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pactus.Blockchain/GetFeePercentiles",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlockchainServer).GetFeePercentiles(ctx, req.(*GetFeePercentilesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Blockchain_GetBlockBloom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBlockBloomRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlockchainServer).GetBlockBloom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pactus.Blockchain/GetBlockBloom",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlockchainServer).GetBlockBloom(ctx, req.(*GetBlockBloomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Blockchain_ScanBlocks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScanBlocksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlockchainServer).ScanBlocks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pactus.Blockchain/ScanBlocks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlockchainServer).ScanBlocks(ctx, req.(*ScanBlocksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Blockchain_GetBlockReward_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBlockRewardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlockchainServer).GetBlockReward(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pactus.Blockchain/GetBlockReward",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlockchainServer).GetBlockReward(ctx, req.(*GetBlockRewardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Blockchain_GetCommittee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCommitteeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlockchainServer).GetCommittee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pactus.Blockchain/GetCommittee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlockchainServer).GetCommittee(ctx, req.(*GetCommitteeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Blockchain_VerifyParticipationReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyParticipationReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlockchainServer).VerifyParticipationReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pactus.Blockchain/VerifyParticipationReport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlockchainServer).VerifyParticipationReport(ctx, req.(*VerifyParticipationReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Blockchain_WatchAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WatchAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlockchainServer).WatchAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pactus.Blockchain/WatchAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlockchainServer).WatchAddress(ctx, req.(*WatchAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Blockchain_UnwatchAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnwatchAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlockchainServer).UnwatchAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pactus.Blockchain/UnwatchAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlockchainServer).UnwatchAddress(ctx, req.(*UnwatchAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Blockchain_GetWatchedAddresses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWatchedAddressesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlockchainServer).GetWatchedAddresses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pactus.Blockchain/GetWatchedAddresses",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlockchainServer).GetWatchedAddresses(ctx, req.(*GetWatchedAddressesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Blockchain_GetStorageInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStorageInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlockchainServer).GetStorageInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pactus.Blockchain/GetStorageInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlockchainServer).GetStorageInfo(ctx, req.(*GetStorageInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Blockchain_GenerateBlocks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenerateBlocksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlockchainServer).GenerateBlocks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pactus.Blockchain/GenerateBlocks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlockchainServer).GenerateBlocks(ctx, req.(*GenerateBlocksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Blockchain_ServiceDesc is the grpc.ServiceDesc for Blockchain service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -413,6 +971,14 @@ var Blockchain_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetBlockHeight",
 			Handler:    _Blockchain_GetBlockHeight_Handler,
 		},
+		{
+			MethodName: "GetBlockHeaders",
+			Handler:    _Blockchain_GetBlockHeaders_Handler,
+		},
+		{
+			MethodName: "GetBeacon",
+			Handler:    _Blockchain_GetBeacon_Handler,
+		},
 		{
 			MethodName: "GetBlockchainInfo",
 			Handler:    _Blockchain_GetBlockchainInfo_Handler,
@@ -433,6 +999,14 @@ var Blockchain_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetValidatorByNumber",
 			Handler:    _Blockchain_GetValidatorByNumber_Handler,
 		},
+		{
+			MethodName: "GetAccounts",
+			Handler:    _Blockchain_GetAccounts_Handler,
+		},
+		{
+			MethodName: "GetValidators",
+			Handler:    _Blockchain_GetValidators_Handler,
+		},
 		{
 			MethodName: "GetValidatorAddresses",
 			Handler:    _Blockchain_GetValidatorAddresses_Handler,
@@ -441,6 +1015,54 @@ var Blockchain_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetPublicKey",
 			Handler:    _Blockchain_GetPublicKey_Handler,
 		},
+		{
+			MethodName: "GetChainStatistics",
+			Handler:    _Blockchain_GetChainStatistics_Handler,
+		},
+		{
+			MethodName: "GetFeePercentiles",
+			Handler:    _Blockchain_GetFeePercentiles_Handler,
+		},
+		{
+			MethodName: "GetBlockBloom",
+			Handler:    _Blockchain_GetBlockBloom_Handler,
+		},
+		{
+			MethodName: "ScanBlocks",
+			Handler:    _Blockchain_ScanBlocks_Handler,
+		},
+		{
+			MethodName: "GetBlockReward",
+			Handler:    _Blockchain_GetBlockReward_Handler,
+		},
+		{
+			MethodName: "GetCommittee",
+			Handler:    _Blockchain_GetCommittee_Handler,
+		},
+		{
+			MethodName: "VerifyParticipationReport",
+			Handler:    _Blockchain_VerifyParticipationReport_Handler,
+		},
+		{
+			MethodName: "WatchAddress",
+			Handler:    _Blockchain_WatchAddress_Handler,
+		},
+		{
+			MethodName: "UnwatchAddress",
+			Handler:    _Blockchain_UnwatchAddress_Handler,
+		},
+		{
+			MethodName: "GetWatchedAddresses",
+			Handler:    _Blockchain_GetWatchedAddresses_Handler,
+		},
+		{
+			MethodName: "GetStorageInfo",
+			Handler:    _Blockchain_GetStorageInfo_Handler,
+		},
+		{
+			MethodName: "GenerateBlocks",
+			Handler:    _Blockchain_GenerateBlocks_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "blockchain.proto",
@@ -0,0 +1,321 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.32.0
+// 	protoc        (unknown)
+// source: error.proto
+
+package pactus
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Enumeration for the error codes.
+// The codes are stable and will not be changed in future releases.
+type ErrorCode int32
+
+const (
+	ErrorCode_ERROR_NONE                  ErrorCode = 0  // No error.
+	ErrorCode_ERROR_GENERIC               ErrorCode = 1  // Generic error.
+	ErrorCode_ERROR_INVALID_BLOCK         ErrorCode = 2  // Invalid block.
+	ErrorCode_ERROR_INVALID_AMOUNT        ErrorCode = 3  // Invalid amount.
+	ErrorCode_ERROR_INVALID_FEE           ErrorCode = 4  // Invalid fee.
+	ErrorCode_ERROR_INVALID_ADDRESS       ErrorCode = 5  // Invalid address.
+	ErrorCode_ERROR_INVALID_PUBLIC_KEY    ErrorCode = 6  // Invalid public key.
+	ErrorCode_ERROR_INVALID_PRIVATE_KEY   ErrorCode = 7  // Invalid private key.
+	ErrorCode_ERROR_INVALID_SIGNATURE     ErrorCode = 8  // Invalid signature.
+	ErrorCode_ERROR_INVALID_TRANSACTION   ErrorCode = 9  // Invalid transaction.
+	ErrorCode_ERROR_INVALID_MEMO          ErrorCode = 10 // Invalid memo.
+	ErrorCode_ERROR_INVALID_PROOF         ErrorCode = 11 // Invalid proof.
+	ErrorCode_ERROR_INVALID_HEIGHT        ErrorCode = 12 // Invalid height.
+	ErrorCode_ERROR_INVALID_ROUND         ErrorCode = 13 // Invalid round.
+	ErrorCode_ERROR_INVALID_PROPOSAL      ErrorCode = 14 // Invalid proposal.
+	ErrorCode_ERROR_INVALID_VOTE          ErrorCode = 15 // Invalid vote.
+	ErrorCode_ERROR_INVALID_MESSAGE       ErrorCode = 16 // Invalid message.
+	ErrorCode_ERROR_INVALID_CONFIG        ErrorCode = 17 // Invalid config.
+	ErrorCode_ERROR_DUPLICATE_VOTE        ErrorCode = 18 // Duplicate vote.
+	ErrorCode_ERROR_INSUFFICIENT_FUNDS    ErrorCode = 19 // Insufficient funds.
+	ErrorCode_ERROR_PAST_LOCK_TIME        ErrorCode = 20 // Lock time is in the past and the transaction is expired.
+	ErrorCode_ERROR_FUTURE_LOCK_TIME      ErrorCode = 21 // Lock time is in the future and is not reached yet.
+	ErrorCode_ERROR_TRANSACTION_COMMITTED ErrorCode = 22 // Transaction is committed before.
+	ErrorCode_ERROR_UNKNOWN_PAYLOAD_TYPE  ErrorCode = 23 // Unknown payload type.
+	ErrorCode_ERROR_TRANSACTION_REJECTED  ErrorCode = 24 // Transaction is rejected by the node's policy.
+)
+
+// Enum value maps for ErrorCode.
+var (
+	ErrorCode_name = map[int32]string{
+		0:  "ERROR_NONE",
+		1:  "ERROR_GENERIC",
+		2:  "ERROR_INVALID_BLOCK",
+		3:  "ERROR_INVALID_AMOUNT",
+		4:  "ERROR_INVALID_FEE",
+		5:  "ERROR_INVALID_ADDRESS",
+		6:  "ERROR_INVALID_PUBLIC_KEY",
+		7:  "ERROR_INVALID_PRIVATE_KEY",
+		8:  "ERROR_INVALID_SIGNATURE",
+		9:  "ERROR_INVALID_TRANSACTION",
+		10: "ERROR_INVALID_MEMO",
+		11: "ERROR_INVALID_PROOF",
+		12: "ERROR_INVALID_HEIGHT",
+		13: "ERROR_INVALID_ROUND",
+		14: "ERROR_INVALID_PROPOSAL",
+		15: "ERROR_INVALID_VOTE",
+		16: "ERROR_INVALID_MESSAGE",
+		17: "ERROR_INVALID_CONFIG",
+		18: "ERROR_DUPLICATE_VOTE",
+		19: "ERROR_INSUFFICIENT_FUNDS",
+		20: "ERROR_PAST_LOCK_TIME",
+		21: "ERROR_FUTURE_LOCK_TIME",
+		22: "ERROR_TRANSACTION_COMMITTED",
+		23: "ERROR_UNKNOWN_PAYLOAD_TYPE",
+		24: "ERROR_TRANSACTION_REJECTED",
+	}
+	ErrorCode_value = map[string]int32{
+		"ERROR_NONE":                  0,
+		"ERROR_GENERIC":               1,
+		"ERROR_INVALID_BLOCK":         2,
+		"ERROR_INVALID_AMOUNT":        3,
+		"ERROR_INVALID_FEE":           4,
+		"ERROR_INVALID_ADDRESS":       5,
+		"ERROR_INVALID_PUBLIC_KEY":    6,
+		"ERROR_INVALID_PRIVATE_KEY":   7,
+		"ERROR_INVALID_SIGNATURE":     8,
+		"ERROR_INVALID_TRANSACTION":   9,
+		"ERROR_INVALID_MEMO":          10,
+		"ERROR_INVALID_PROOF":         11,
+		"ERROR_INVALID_HEIGHT":        12,
+		"ERROR_INVALID_ROUND":         13,
+		"ERROR_INVALID_PROPOSAL":      14,
+		"ERROR_INVALID_VOTE":          15,
+		"ERROR_INVALID_MESSAGE":       16,
+		"ERROR_INVALID_CONFIG":        17,
+		"ERROR_DUPLICATE_VOTE":        18,
+		"ERROR_INSUFFICIENT_FUNDS":    19,
+		"ERROR_PAST_LOCK_TIME":        20,
+		"ERROR_FUTURE_LOCK_TIME":      21,
+		"ERROR_TRANSACTION_COMMITTED": 22,
+		"ERROR_UNKNOWN_PAYLOAD_TYPE":  23,
+		"ERROR_TRANSACTION_REJECTED":  24,
+	}
+)
+
+func (x ErrorCode) Enum() *ErrorCode {
+	p := new(ErrorCode)
+	*p = x
+	return p
+}
+
+func (x ErrorCode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ErrorCode) Descriptor() protoreflect.EnumDescriptor {
+	return file_error_proto_enumTypes[0].Descriptor()
+}
+
+func (ErrorCode) Type() protoreflect.EnumType {
+	return &file_error_proto_enumTypes[0]
+}
+
+func (x ErrorCode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ErrorCode.Descriptor instead.
+func (ErrorCode) EnumDescriptor() ([]byte, []int) {
+	return file_error_proto_rawDescGZIP(), []int{0}
+}
+
+// Message containing the details of an error.
+// It is attached to the status of the failed RPC calls.
+type ErrorInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code    ErrorCode `protobuf:"varint,1,opt,name=code,proto3,enum=pactus.ErrorCode" json:"code,omitempty"` // Machine-readable code of the error.
+	Message string    `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`                  // Human-readable message of the error.
+}
+
+func (x *ErrorInfo) Reset() {
+	*x = ErrorInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_error_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ErrorInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ErrorInfo) ProtoMessage() {}
+
+func (x *ErrorInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_error_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ErrorInfo.ProtoReflect.Descriptor instead.
+func (*ErrorInfo) Descriptor() ([]byte, []int) {
+	return file_error_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ErrorInfo) GetCode() ErrorCode {
+	if x != nil {
+		return x.Code
+	}
+	return ErrorCode_ERROR_NONE
+}
+
+func (x *ErrorInfo) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+var File_error_proto protoreflect.FileDescriptor
+
+var file_error_proto_rawDesc = []byte{
+	0x0a, 0x0b, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x06, 0x70,
+	0x61, 0x63, 0x74, 0x75, 0x73, 0x22, 0x4c, 0x0a, 0x09, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x49, 0x6e,
+	0x66, 0x6f, 0x12, 0x25, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x11, 0x2e, 0x70, 0x61, 0x63, 0x74, 0x75, 0x73, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43,
+	0x6f, 0x64, 0x65, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x2a, 0xa8, 0x05, 0x0a, 0x09, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64,
+	0x65, 0x12, 0x0e, 0x0a, 0x0a, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x4e, 0x4f, 0x4e, 0x45, 0x10,
+	0x00, 0x12, 0x11, 0x0a, 0x0d, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x47, 0x45, 0x4e, 0x45, 0x52,
+	0x49, 0x43, 0x10, 0x01, 0x12, 0x17, 0x0a, 0x13, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x49, 0x4e,
+	0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x42, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x02, 0x12, 0x18, 0x0a,
+	0x14, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x41,
+	0x4d, 0x4f, 0x55, 0x4e, 0x54, 0x10, 0x03, 0x12, 0x15, 0x0a, 0x11, 0x45, 0x52, 0x52, 0x4f, 0x52,
+	0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x46, 0x45, 0x45, 0x10, 0x04, 0x12, 0x19,
+	0x0a, 0x15, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f,
+	0x41, 0x44, 0x44, 0x52, 0x45, 0x53, 0x53, 0x10, 0x05, 0x12, 0x1c, 0x0a, 0x18, 0x45, 0x52, 0x52,
+	0x4f, 0x52, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x50, 0x55, 0x42, 0x4c, 0x49,
+	0x43, 0x5f, 0x4b, 0x45, 0x59, 0x10, 0x06, 0x12, 0x1d, 0x0a, 0x19, 0x45, 0x52, 0x52, 0x4f, 0x52,
+	0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x50, 0x52, 0x49, 0x56, 0x41, 0x54, 0x45,
+	0x5f, 0x4b, 0x45, 0x59, 0x10, 0x07, 0x12, 0x1b, 0x0a, 0x17, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f,
+	0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x53, 0x49, 0x47, 0x4e, 0x41, 0x54, 0x55, 0x52,
+	0x45, 0x10, 0x08, 0x12, 0x1d, 0x0a, 0x19, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x49, 0x4e, 0x56,
+	0x41, 0x4c, 0x49, 0x44, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e,
+	0x10, 0x09, 0x12, 0x16, 0x0a, 0x12, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x49, 0x4e, 0x56, 0x41,
+	0x4c, 0x49, 0x44, 0x5f, 0x4d, 0x45, 0x4d, 0x4f, 0x10, 0x0a, 0x12, 0x17, 0x0a, 0x13, 0x45, 0x52,
+	0x52, 0x4f, 0x52, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x50, 0x52, 0x4f, 0x4f,
+	0x46, 0x10, 0x0b, 0x12, 0x18, 0x0a, 0x14, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x49, 0x4e, 0x56,
+	0x41, 0x4c, 0x49, 0x44, 0x5f, 0x48, 0x45, 0x49, 0x47, 0x48, 0x54, 0x10, 0x0c, 0x12, 0x17, 0x0a,
+	0x13, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x52,
+	0x4f, 0x55, 0x4e, 0x44, 0x10, 0x0d, 0x12, 0x1a, 0x0a, 0x16, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f,
+	0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x50, 0x52, 0x4f, 0x50, 0x4f, 0x53, 0x41, 0x4c,
+	0x10, 0x0e, 0x12, 0x16, 0x0a, 0x12, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x49, 0x4e, 0x56, 0x41,
+	0x4c, 0x49, 0x44, 0x5f, 0x56, 0x4f, 0x54, 0x45, 0x10, 0x0f, 0x12, 0x19, 0x0a, 0x15, 0x45, 0x52,
+	0x52, 0x4f, 0x52, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x4d, 0x45, 0x53, 0x53,
+	0x41, 0x47, 0x45, 0x10, 0x10, 0x12, 0x18, 0x0a, 0x14, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x49,
+	0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x10, 0x11, 0x12,
+	0x18, 0x0a, 0x14, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x44, 0x55, 0x50, 0x4c, 0x49, 0x43, 0x41,
+	0x54, 0x45, 0x5f, 0x56, 0x4f, 0x54, 0x45, 0x10, 0x12, 0x12, 0x1c, 0x0a, 0x18, 0x45, 0x52, 0x52,
+	0x4f, 0x52, 0x5f, 0x49, 0x4e, 0x53, 0x55, 0x46, 0x46, 0x49, 0x43, 0x49, 0x45, 0x4e, 0x54, 0x5f,
+	0x46, 0x55, 0x4e, 0x44, 0x53, 0x10, 0x13, 0x12, 0x18, 0x0a, 0x14, 0x45, 0x52, 0x52, 0x4f, 0x52,
+	0x5f, 0x50, 0x41, 0x53, 0x54, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x10,
+	0x14, 0x12, 0x1a, 0x0a, 0x16, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x46, 0x55, 0x54, 0x55, 0x52,
+	0x45, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x10, 0x15, 0x12, 0x1f, 0x0a,
+	0x1b, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x41, 0x43, 0x54, 0x49,
+	0x4f, 0x4e, 0x5f, 0x43, 0x4f, 0x4d, 0x4d, 0x49, 0x54, 0x54, 0x45, 0x44, 0x10, 0x16, 0x12, 0x1e,
+	0x0a, 0x1a, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f,
+	0x50, 0x41, 0x59, 0x4c, 0x4f, 0x41, 0x44, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x10, 0x17, 0x12, 0x1e,
+	0x0a, 0x1a, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x41, 0x43, 0x54,
+	0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x45, 0x44, 0x10, 0x18, 0x42, 0x40,
+	0x0a, 0x0c, 0x70, 0x61, 0x63, 0x74, 0x75, 0x73, 0x2e, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5a, 0x30,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x61, 0x63, 0x74, 0x75,
+	0x73, 0x2d, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x2f, 0x70, 0x61, 0x63, 0x74, 0x75, 0x73,
+	0x2f, 0x77, 0x77, 0x77, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x70, 0x61, 0x63, 0x74, 0x75, 0x73,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_error_proto_rawDescOnce sync.Once
+	file_error_proto_rawDescData = file_error_proto_rawDesc
+)
+
+func file_error_proto_rawDescGZIP() []byte {
+	file_error_proto_rawDescOnce.Do(func() {
+		file_error_proto_rawDescData = protoimpl.X.CompressGZIP(file_error_proto_rawDescData)
+	})
+	return file_error_proto_rawDescData
+}
+
+var file_error_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_error_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_error_proto_goTypes = []interface{}{
+	(ErrorCode)(0),    // 0: pactus.ErrorCode
+	(*ErrorInfo)(nil), // 1: pactus.ErrorInfo
+}
+var file_error_proto_depIdxs = []int32{
+	0, // 0: pactus.ErrorInfo.code:type_name -> pactus.ErrorCode
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_error_proto_init() }
+func file_error_proto_init() {
+	if File_error_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_error_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ErrorInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_error_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_error_proto_goTypes,
+		DependencyIndexes: file_error_proto_depIdxs,
+		EnumInfos:         file_error_proto_enumTypes,
+		MessageInfos:      file_error_proto_msgTypes,
+	}.Build()
+	File_error_proto = out.File
+	file_error_proto_rawDesc = nil
+	file_error_proto_goTypes = nil
+	file_error_proto_depIdxs = nil
+}
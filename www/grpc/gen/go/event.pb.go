@@ -0,0 +1,1417 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.32.0
+// 	protoc        (unknown)
+// source: event.proto
+
+package pactus
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Enumeration for the topics of the events.
+// The values are the same as the topic IDs of the raw events.
+type EventTopic int32
+
+const (
+	EventTopic_EVENT_TOPIC_UNSPECIFIED           EventTopic = 0    // Unspecified topic.
+	EventTopic_EVENT_TOPIC_BLOCK_COMMITTED       EventTopic = 257  // Block committed topic.
+	EventTopic_EVENT_TOPIC_REWARD_PAYOUT         EventTopic = 258  // Reward payout topic.
+	EventTopic_EVENT_TOPIC_TREASURY              EventTopic = 259  // Treasury topic.
+	EventTopic_EVENT_TOPIC_FORK_DETECTED         EventTopic = 260  // Fork detected topic.
+	EventTopic_EVENT_TOPIC_ANOMALY               EventTopic = 261  // Anomaly topic.
+	EventTopic_EVENT_TOPIC_TRANSACTION_CONFIRMED EventTopic = 513  // Transaction confirmed topic.
+	EventTopic_EVENT_TOPIC_ACCOUNT_CHANGE        EventTopic = 769  // Account change topic.
+	EventTopic_EVENT_TOPIC_BALANCE_CHANGE        EventTopic = 770  // Balance change topic.
+	EventTopic_EVENT_TOPIC_WATCHED_ADDRESS       EventTopic = 771  // Watched address topic.
+	EventTopic_EVENT_TOPIC_VALIDATOR_STAKE       EventTopic = 1025 // Validator stake topic.
+)
+
+// Enum value maps for EventTopic.
+var (
+	EventTopic_name = map[int32]string{
+		0:    "EVENT_TOPIC_UNSPECIFIED",
+		257:  "EVENT_TOPIC_BLOCK_COMMITTED",
+		258:  "EVENT_TOPIC_REWARD_PAYOUT",
+		259:  "EVENT_TOPIC_TREASURY",
+		260:  "EVENT_TOPIC_FORK_DETECTED",
+		261:  "EVENT_TOPIC_ANOMALY",
+		513:  "EVENT_TOPIC_TRANSACTION_CONFIRMED",
+		769:  "EVENT_TOPIC_ACCOUNT_CHANGE",
+		770:  "EVENT_TOPIC_BALANCE_CHANGE",
+		771:  "EVENT_TOPIC_WATCHED_ADDRESS",
+		1025: "EVENT_TOPIC_VALIDATOR_STAKE",
+	}
+	EventTopic_value = map[string]int32{
+		"EVENT_TOPIC_UNSPECIFIED":           0,
+		"EVENT_TOPIC_BLOCK_COMMITTED":       257,
+		"EVENT_TOPIC_REWARD_PAYOUT":         258,
+		"EVENT_TOPIC_TREASURY":              259,
+		"EVENT_TOPIC_FORK_DETECTED":         260,
+		"EVENT_TOPIC_ANOMALY":               261,
+		"EVENT_TOPIC_TRANSACTION_CONFIRMED": 513,
+		"EVENT_TOPIC_ACCOUNT_CHANGE":        769,
+		"EVENT_TOPIC_BALANCE_CHANGE":        770,
+		"EVENT_TOPIC_WATCHED_ADDRESS":       771,
+		"EVENT_TOPIC_VALIDATOR_STAKE":       1025,
+	}
+)
+
+func (x EventTopic) Enum() *EventTopic {
+	p := new(EventTopic)
+	*p = x
+	return p
+}
+
+func (x EventTopic) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EventTopic) Descriptor() protoreflect.EnumDescriptor {
+	return file_event_proto_enumTypes[0].Descriptor()
+}
+
+func (EventTopic) Type() protoreflect.EnumType {
+	return &file_event_proto_enumTypes[0]
+}
+
+func (x EventTopic) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EventTopic.Descriptor instead.
+func (EventTopic) EnumDescriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{0}
+}
+
+// Enumeration for the kinds of the anomalies.
+type AnomalyKind int32
+
+const (
+	AnomalyKind_ANOMALY_KIND_UNSPECIFIED     AnomalyKind = 0 // Unspecified kind.
+	AnomalyKind_ANOMALY_KIND_FEE_SPIKE       AnomalyKind = 1 // Median fee of the block is much higher than the recent blocks.
+	AnomalyKind_ANOMALY_KIND_EMPTY_BLOCKS    AnomalyKind = 2 // Blocks are empty while there are pending transactions.
+	AnomalyKind_ANOMALY_KIND_COMMITTEE_CHURN AnomalyKind = 3 // Many validators joined the committee in a single block.
+)
+
+// Enum value maps for AnomalyKind.
+var (
+	AnomalyKind_name = map[int32]string{
+		0: "ANOMALY_KIND_UNSPECIFIED",
+		1: "ANOMALY_KIND_FEE_SPIKE",
+		2: "ANOMALY_KIND_EMPTY_BLOCKS",
+		3: "ANOMALY_KIND_COMMITTEE_CHURN",
+	}
+	AnomalyKind_value = map[string]int32{
+		"ANOMALY_KIND_UNSPECIFIED":     0,
+		"ANOMALY_KIND_FEE_SPIKE":       1,
+		"ANOMALY_KIND_EMPTY_BLOCKS":    2,
+		"ANOMALY_KIND_COMMITTEE_CHURN": 3,
+	}
+)
+
+func (x AnomalyKind) Enum() *AnomalyKind {
+	p := new(AnomalyKind)
+	*p = x
+	return p
+}
+
+func (x AnomalyKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AnomalyKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_event_proto_enumTypes[1].Descriptor()
+}
+
+func (AnomalyKind) Type() protoreflect.EnumType {
+	return &file_event_proto_enumTypes[1]
+}
+
+func (x AnomalyKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AnomalyKind.Descriptor instead.
+func (AnomalyKind) EnumDescriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{1}
+}
+
+// Event is published by the node through the event transports, like nanomsg and webhooks.
+// The events are versioned, and new fields and topics can be added in the same version.
+type Event struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Version  uint32     `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`                    // Version of the event schema.
+	Topic    EventTopic `protobuf:"varint,2,opt,name=topic,proto3,enum=pactus.EventTopic" json:"topic,omitempty"` // Topic of the event.
+	Sequence uint32     `protobuf:"varint,3,opt,name=sequence,proto3" json:"sequence,omitempty"`                  // Sequence number of the event in the transport.
+	// Payload of the event, based on the topic.
+	//
+	// Types that are assignable to Payload:
+	//	*Event_BlockCommitted
+	//	*Event_RewardPayout
+	//	*Event_Treasury
+	//	*Event_ForkDetected
+	//	*Event_TransactionConfirmed
+	//	*Event_AccountChange
+	//	*Event_BalanceChange
+	//	*Event_WatchedAddress
+	//	*Event_ValidatorStake
+	//	*Event_Anomaly
+	Payload isEvent_Payload `protobuf_oneof:"payload"`
+}
+
+func (x *Event) Reset() {
+	*x = Event{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_event_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Event) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Event) ProtoMessage() {}
+
+func (x *Event) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Event.ProtoReflect.Descriptor instead.
+func (*Event) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Event) GetVersion() uint32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Event) GetTopic() EventTopic {
+	if x != nil {
+		return x.Topic
+	}
+	return EventTopic_EVENT_TOPIC_UNSPECIFIED
+}
+
+func (x *Event) GetSequence() uint32 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (m *Event) GetPayload() isEvent_Payload {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+func (x *Event) GetBlockCommitted() *BlockCommittedEvent {
+	if x, ok := x.GetPayload().(*Event_BlockCommitted); ok {
+		return x.BlockCommitted
+	}
+	return nil
+}
+
+func (x *Event) GetRewardPayout() *RewardPayoutEvent {
+	if x, ok := x.GetPayload().(*Event_RewardPayout); ok {
+		return x.RewardPayout
+	}
+	return nil
+}
+
+func (x *Event) GetTreasury() *TreasuryEvent {
+	if x, ok := x.GetPayload().(*Event_Treasury); ok {
+		return x.Treasury
+	}
+	return nil
+}
+
+func (x *Event) GetForkDetected() *ForkDetectedEvent {
+	if x, ok := x.GetPayload().(*Event_ForkDetected); ok {
+		return x.ForkDetected
+	}
+	return nil
+}
+
+func (x *Event) GetTransactionConfirmed() *TransactionConfirmedEvent {
+	if x, ok := x.GetPayload().(*Event_TransactionConfirmed); ok {
+		return x.TransactionConfirmed
+	}
+	return nil
+}
+
+func (x *Event) GetAccountChange() *AccountChangeEvent {
+	if x, ok := x.GetPayload().(*Event_AccountChange); ok {
+		return x.AccountChange
+	}
+	return nil
+}
+
+func (x *Event) GetBalanceChange() *BalanceChangeEvent {
+	if x, ok := x.GetPayload().(*Event_BalanceChange); ok {
+		return x.BalanceChange
+	}
+	return nil
+}
+
+func (x *Event) GetWatchedAddress() *WatchedAddressEvent {
+	if x, ok := x.GetPayload().(*Event_WatchedAddress); ok {
+		return x.WatchedAddress
+	}
+	return nil
+}
+
+func (x *Event) GetValidatorStake() *ValidatorStakeEvent {
+	if x, ok := x.GetPayload().(*Event_ValidatorStake); ok {
+		return x.ValidatorStake
+	}
+	return nil
+}
+
+func (x *Event) GetAnomaly() *AnomalyEvent {
+	if x, ok := x.GetPayload().(*Event_Anomaly); ok {
+		return x.Anomaly
+	}
+	return nil
+}
+
+type isEvent_Payload interface {
+	isEvent_Payload()
+}
+
+type Event_BlockCommitted struct {
+	BlockCommitted *BlockCommittedEvent `protobuf:"bytes,10,opt,name=block_committed,json=blockCommitted,proto3,oneof"` // Block committed event.
+}
+
+type Event_RewardPayout struct {
+	RewardPayout *RewardPayoutEvent `protobuf:"bytes,11,opt,name=reward_payout,json=rewardPayout,proto3,oneof"` // Reward payout event.
+}
+
+type Event_Treasury struct {
+	Treasury *TreasuryEvent `protobuf:"bytes,12,opt,name=treasury,proto3,oneof"` // Treasury event.
+}
+
+type Event_ForkDetected struct {
+	ForkDetected *ForkDetectedEvent `protobuf:"bytes,13,opt,name=fork_detected,json=forkDetected,proto3,oneof"` // Fork detected event.
+}
+
+type Event_TransactionConfirmed struct {
+	TransactionConfirmed *TransactionConfirmedEvent `protobuf:"bytes,14,opt,name=transaction_confirmed,json=transactionConfirmed,proto3,oneof"` // Transaction confirmed event.
+}
+
+type Event_AccountChange struct {
+	AccountChange *AccountChangeEvent `protobuf:"bytes,15,opt,name=account_change,json=accountChange,proto3,oneof"` // Account change event.
+}
+
+type Event_BalanceChange struct {
+	BalanceChange *BalanceChangeEvent `protobuf:"bytes,16,opt,name=balance_change,json=balanceChange,proto3,oneof"` // Balance change event.
+}
+
+type Event_WatchedAddress struct {
+	WatchedAddress *WatchedAddressEvent `protobuf:"bytes,17,opt,name=watched_address,json=watchedAddress,proto3,oneof"` // Watched address event.
+}
+
+type Event_ValidatorStake struct {
+	ValidatorStake *ValidatorStakeEvent `protobuf:"bytes,18,opt,name=validator_stake,json=validatorStake,proto3,oneof"` // Validator stake event.
+}
+
+type Event_Anomaly struct {
+	Anomaly *AnomalyEvent `protobuf:"bytes,19,opt,name=anomaly,proto3,oneof"` // Anomaly event.
+}
+
+func (*Event_BlockCommitted) isEvent_Payload() {}
+
+func (*Event_RewardPayout) isEvent_Payload() {}
+
+func (*Event_Treasury) isEvent_Payload() {}
+
+func (*Event_ForkDetected) isEvent_Payload() {}
+
+func (*Event_TransactionConfirmed) isEvent_Payload() {}
+
+func (*Event_AccountChange) isEvent_Payload() {}
+
+func (*Event_BalanceChange) isEvent_Payload() {}
+
+func (*Event_WatchedAddress) isEvent_Payload() {}
+
+func (*Event_ValidatorStake) isEvent_Payload() {}
+
+func (*Event_Anomaly) isEvent_Payload() {}
+
+// Event published when a new block is committed.
+type BlockCommittedEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Hash   []byte `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`      // Hash of the block.
+	Height uint32 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"` // Height of the block.
+}
+
+func (x *BlockCommittedEvent) Reset() {
+	*x = BlockCommittedEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_event_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BlockCommittedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockCommittedEvent) ProtoMessage() {}
+
+func (x *BlockCommittedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockCommittedEvent.ProtoReflect.Descriptor instead.
+func (*BlockCommittedEvent) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *BlockCommittedEvent) GetHash() []byte {
+	if x != nil {
+		return x.Hash
+	}
+	return nil
+}
+
+func (x *BlockCommittedEvent) GetHeight() uint32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+// Event published when the block reward is paid to the proposer.
+type RewardPayoutEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"` // Reward address of the proposer.
+	Height  uint32 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`  // Height of the block.
+	Amount  int64  `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`  // Block reward and the transaction fees, in NanoPAC.
+}
+
+func (x *RewardPayoutEvent) Reset() {
+	*x = RewardPayoutEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_event_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RewardPayoutEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RewardPayoutEvent) ProtoMessage() {}
+
+func (x *RewardPayoutEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RewardPayoutEvent.ProtoReflect.Descriptor instead.
+func (*RewardPayoutEvent) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *RewardPayoutEvent) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *RewardPayoutEvent) GetHeight() uint32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *RewardPayoutEvent) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+// Event published for the treasury accounting of a new block.
+type TreasuryEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Height        uint32 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`                                    // Height of the block.
+	BlockReward   int64  `protobuf:"varint,2,opt,name=block_reward,json=blockReward,proto3" json:"block_reward,omitempty"`       // Block reward that is paid from the treasury, in NanoPAC.
+	Fees          int64  `protobuf:"varint,3,opt,name=fees,proto3" json:"fees,omitempty"`                                        // Transaction fees that are claimed back by the treasury, in NanoPAC.
+	BalanceBefore int64  `protobuf:"varint,4,opt,name=balance_before,json=balanceBefore,proto3" json:"balance_before,omitempty"` // Balance of the treasury before the block, in NanoPAC.
+	BalanceAfter  int64  `protobuf:"varint,5,opt,name=balance_after,json=balanceAfter,proto3" json:"balance_after,omitempty"`    // Balance of the treasury after the block, in NanoPAC.
+}
+
+func (x *TreasuryEvent) Reset() {
+	*x = TreasuryEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_event_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TreasuryEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TreasuryEvent) ProtoMessage() {}
+
+func (x *TreasuryEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TreasuryEvent.ProtoReflect.Descriptor instead.
+func (*TreasuryEvent) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *TreasuryEvent) GetHeight() uint32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *TreasuryEvent) GetBlockReward() int64 {
+	if x != nil {
+		return x.BlockReward
+	}
+	return 0
+}
+
+func (x *TreasuryEvent) GetFees() int64 {
+	if x != nil {
+		return x.Fees
+	}
+	return 0
+}
+
+func (x *TreasuryEvent) GetBalanceBefore() int64 {
+	if x != nil {
+		return x.BalanceBefore
+	}
+	return 0
+}
+
+func (x *TreasuryEvent) GetBalanceAfter() int64 {
+	if x != nil {
+		return x.BalanceAfter
+	}
+	return 0
+}
+
+// Event published when a block is not built on top of the last committed block.
+type ForkDetectedEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Height        uint32 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`                                     // Height of the block.
+	PrevBlockHash []byte `protobuf:"bytes,2,opt,name=prev_block_hash,json=prevBlockHash,proto3" json:"prev_block_hash,omitempty"` // Previous block hash of the block.
+	LastBlockHash []byte `protobuf:"bytes,3,opt,name=last_block_hash,json=lastBlockHash,proto3" json:"last_block_hash,omitempty"` // Hash of the last committed block.
+}
+
+func (x *ForkDetectedEvent) Reset() {
+	*x = ForkDetectedEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_event_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ForkDetectedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForkDetectedEvent) ProtoMessage() {}
+
+func (x *ForkDetectedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForkDetectedEvent.ProtoReflect.Descriptor instead.
+func (*ForkDetectedEvent) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ForkDetectedEvent) GetHeight() uint32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *ForkDetectedEvent) GetPrevBlockHash() []byte {
+	if x != nil {
+		return x.PrevBlockHash
+	}
+	return nil
+}
+
+func (x *ForkDetectedEvent) GetLastBlockHash() []byte {
+	if x != nil {
+		return x.LastBlockHash
+	}
+	return nil
+}
+
+// Event published when an anomaly is detected in the core metrics of the chain.
+type AnomalyEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Height   uint32      `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`                     // Height of the block.
+	Kind     AnomalyKind `protobuf:"varint,2,opt,name=kind,proto3,enum=pactus.AnomalyKind" json:"kind,omitempty"` // Kind of the anomaly.
+	Value    int64       `protobuf:"varint,3,opt,name=value,proto3" json:"value,omitempty"`                       // Observed value, like the median fee or the number of the empty blocks.
+	Baseline int64       `protobuf:"varint,4,opt,name=baseline,proto3" json:"baseline,omitempty"`                 // Expected value or the threshold that is exceeded.
+}
+
+func (x *AnomalyEvent) Reset() {
+	*x = AnomalyEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_event_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AnomalyEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnomalyEvent) ProtoMessage() {}
+
+func (x *AnomalyEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnomalyEvent.ProtoReflect.Descriptor instead.
+func (*AnomalyEvent) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *AnomalyEvent) GetHeight() uint32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *AnomalyEvent) GetKind() AnomalyKind {
+	if x != nil {
+		return x.Kind
+	}
+	return AnomalyKind_ANOMALY_KIND_UNSPECIFIED
+}
+
+func (x *AnomalyEvent) GetValue() int64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *AnomalyEvent) GetBaseline() int64 {
+	if x != nil {
+		return x.Baseline
+	}
+	return 0
+}
+
+// Event published when a transaction is confirmed in a committed block.
+type TransactionConfirmedEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id     []byte `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`          // ID of the transaction.
+	Height uint32 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"` // Height of the block.
+}
+
+func (x *TransactionConfirmedEvent) Reset() {
+	*x = TransactionConfirmedEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_event_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransactionConfirmedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransactionConfirmedEvent) ProtoMessage() {}
+
+func (x *TransactionConfirmedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransactionConfirmedEvent.ProtoReflect.Descriptor instead.
+func (*TransactionConfirmedEvent) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *TransactionConfirmedEvent) GetId() []byte {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *TransactionConfirmedEvent) GetHeight() uint32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+// Event published when an account is touched by a committed transaction.
+type AccountChangeEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"` // Address of the account.
+	Height  uint32 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`  // Height of the block.
+}
+
+func (x *AccountChangeEvent) Reset() {
+	*x = AccountChangeEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_event_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AccountChangeEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountChangeEvent) ProtoMessage() {}
+
+func (x *AccountChangeEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountChangeEvent.ProtoReflect.Descriptor instead.
+func (*AccountChangeEvent) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *AccountChangeEvent) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *AccountChangeEvent) GetHeight() uint32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+// Event published when the balance of an account is changed.
+type BalanceChangeEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address    string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`                          // Address of the account.
+	Height     uint32 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`                           // Height of the block.
+	OldBalance int64  `protobuf:"varint,3,opt,name=old_balance,json=oldBalance,proto3" json:"old_balance,omitempty"` // Balance before the block, in NanoPAC.
+	NewBalance int64  `protobuf:"varint,4,opt,name=new_balance,json=newBalance,proto3" json:"new_balance,omitempty"` // Balance after the block, in NanoPAC.
+}
+
+func (x *BalanceChangeEvent) Reset() {
+	*x = BalanceChangeEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_event_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BalanceChangeEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BalanceChangeEvent) ProtoMessage() {}
+
+func (x *BalanceChangeEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BalanceChangeEvent.ProtoReflect.Descriptor instead.
+func (*BalanceChangeEvent) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *BalanceChangeEvent) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *BalanceChangeEvent) GetHeight() uint32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *BalanceChangeEvent) GetOldBalance() int64 {
+	if x != nil {
+		return x.OldBalance
+	}
+	return 0
+}
+
+func (x *BalanceChangeEvent) GetNewBalance() int64 {
+	if x != nil {
+		return x.NewBalance
+	}
+	return 0
+}
+
+// Event published when a watched address appears in a committed transaction.
+type WatchedAddressEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`       // Watched address.
+	TxId    []byte `protobuf:"bytes,2,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"` // ID of the transaction.
+	Height  uint32 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`        // Height of the block.
+	Amount  int64  `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`        // Balance change of the address by the transaction, in NanoPAC.
+}
+
+func (x *WatchedAddressEvent) Reset() {
+	*x = WatchedAddressEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_event_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchedAddressEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchedAddressEvent) ProtoMessage() {}
+
+func (x *WatchedAddressEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchedAddressEvent.ProtoReflect.Descriptor instead.
+func (*WatchedAddressEvent) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *WatchedAddressEvent) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *WatchedAddressEvent) GetTxId() []byte {
+	if x != nil {
+		return x.TxId
+	}
+	return nil
+}
+
+func (x *WatchedAddressEvent) GetHeight() uint32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *WatchedAddressEvent) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+// Event published when the stake of a validator is changed.
+type ValidatorStakeEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address  string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`                    // Address of the validator.
+	Height   uint32 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`                     // Height of the block.
+	OldStake int64  `protobuf:"varint,3,opt,name=old_stake,json=oldStake,proto3" json:"old_stake,omitempty"` // Stake before the block, in NanoPAC.
+	NewStake int64  `protobuf:"varint,4,opt,name=new_stake,json=newStake,proto3" json:"new_stake,omitempty"` // Stake after the block, in NanoPAC.
+}
+
+func (x *ValidatorStakeEvent) Reset() {
+	*x = ValidatorStakeEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_event_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidatorStakeEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidatorStakeEvent) ProtoMessage() {}
+
+func (x *ValidatorStakeEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidatorStakeEvent.ProtoReflect.Descriptor instead.
+func (*ValidatorStakeEvent) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ValidatorStakeEvent) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *ValidatorStakeEvent) GetHeight() uint32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *ValidatorStakeEvent) GetOldStake() int64 {
+	if x != nil {
+		return x.OldStake
+	}
+	return 0
+}
+
+func (x *ValidatorStakeEvent) GetNewStake() int64 {
+	if x != nil {
+		return x.NewStake
+	}
+	return 0
+}
+
+var File_event_proto protoreflect.FileDescriptor
+
+var file_event_proto_rawDesc = []byte{
+	0x0a, 0x0b, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x06, 0x70,
+	0x61, 0x63, 0x74, 0x75, 0x73, 0x22, 0x99, 0x06, 0x0a, 0x05, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12,
+	0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x28, 0x0a, 0x05, 0x74, 0x6f, 0x70,
+	0x69, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x70, 0x61, 0x63, 0x74, 0x75,
+	0x73, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x52, 0x05, 0x74, 0x6f,
+	0x70, 0x69, 0x63, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12,
+	0x46, 0x0a, 0x0f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74,
+	0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x61, 0x63, 0x74, 0x75,
+	0x73, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x0e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x43, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x12, 0x40, 0x0a, 0x0d, 0x72, 0x65, 0x77, 0x61, 0x72,
+	0x64, 0x5f, 0x70, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x70, 0x61, 0x63, 0x74, 0x75, 0x73, 0x2e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x50, 0x61,
+	0x79, 0x6f, 0x75, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x0c, 0x72, 0x65, 0x77,
+	0x61, 0x72, 0x64, 0x50, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x12, 0x33, 0x0a, 0x08, 0x74, 0x72, 0x65,
+	0x61, 0x73, 0x75, 0x72, 0x79, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x61,
+	0x63, 0x74, 0x75, 0x73, 0x2e, 0x54, 0x72, 0x65, 0x61, 0x73, 0x75, 0x72, 0x79, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x48, 0x00, 0x52, 0x08, 0x74, 0x72, 0x65, 0x61, 0x73, 0x75, 0x72, 0x79, 0x12, 0x40,
+	0x0a, 0x0d, 0x66, 0x6f, 0x72, 0x6b, 0x5f, 0x64, 0x65, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x18,
+	0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x61, 0x63, 0x74, 0x75, 0x73, 0x2e, 0x46,
+	0x6f, 0x72, 0x6b, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x48, 0x00, 0x52, 0x0c, 0x66, 0x6f, 0x72, 0x6b, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x12, 0x58, 0x0a, 0x15, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x65, 0x64, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x21, 0x2e, 0x70, 0x61, 0x63, 0x74, 0x75, 0x73, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x65, 0x64, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x48, 0x00, 0x52, 0x14, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x65, 0x64, 0x12, 0x43, 0x0a, 0x0e, 0x61, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x0f, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x61, 0x63, 0x74, 0x75, 0x73, 0x2e, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00,
+	0x52, 0x0d, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12,
+	0x43, 0x0a, 0x0e, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x61, 0x63, 0x74, 0x75, 0x73,
+	0x2e, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x0d, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x43, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x12, 0x46, 0x0a, 0x0f, 0x77, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x5f,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
+	0x70, 0x61, 0x63, 0x74, 0x75, 0x73, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x0e, 0x77, 0x61,
+	0x74, 0x63, 0x68, 0x65, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x46, 0x0a, 0x0f,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x18,
+	0x12, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x61, 0x63, 0x74, 0x75, 0x73, 0x2e, 0x56,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x48, 0x00, 0x52, 0x0e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x53,
+	0x74, 0x61, 0x6b, 0x65, 0x12, 0x30, 0x0a, 0x07, 0x61, 0x6e, 0x6f, 0x6d, 0x61, 0x6c, 0x79, 0x18,
+	0x13, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x61, 0x63, 0x74, 0x75, 0x73, 0x2e, 0x41,
+	0x6e, 0x6f, 0x6d, 0x61, 0x6c, 0x79, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x07, 0x61,
+	0x6e, 0x6f, 0x6d, 0x61, 0x6c, 0x79, 0x42, 0x09, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61,
+	0x64, 0x22, 0x41, 0x0a, 0x13, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
+	0x74, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x16, 0x0a, 0x06,
+	0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x68, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x22, 0x5d, 0x0a, 0x11, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x50, 0x61,
+	0x79, 0x6f, 0x75, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x22, 0xaa, 0x01, 0x0a, 0x0d, 0x54, 0x72, 0x65, 0x61, 0x73, 0x75, 0x72, 0x79,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x21, 0x0a,
+	0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x66, 0x65, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04,
+	0x66, 0x65, 0x65, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x5f,
+	0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x62, 0x61,
+	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x62,
+	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0c, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x41, 0x66, 0x74, 0x65, 0x72,
+	0x22, 0x7b, 0x0a, 0x11, 0x46, 0x6f, 0x72, 0x6b, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x26, 0x0a,
+	0x0f, 0x70, 0x72, 0x65, 0x76, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x70, 0x72, 0x65, 0x76, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x48, 0x61, 0x73, 0x68, 0x12, 0x26, 0x0a, 0x0f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d,
+	0x6c, 0x61, 0x73, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x22, 0x81, 0x01,
+	0x0a, 0x0c, 0x41, 0x6e, 0x6f, 0x6d, 0x61, 0x6c, 0x79, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x16,
+	0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06,
+	0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x27, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x70, 0x61, 0x63, 0x74, 0x75, 0x73, 0x2e, 0x41, 0x6e,
+	0x6f, 0x6d, 0x61, 0x6c, 0x79, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x62, 0x61, 0x73, 0x65, 0x6c, 0x69, 0x6e,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x62, 0x61, 0x73, 0x65, 0x6c, 0x69, 0x6e,
+	0x65, 0x22, 0x43, 0x0a, 0x19, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16,
+	0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06,
+	0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x46, 0x0a, 0x12, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x88,
+	0x01, 0x0a, 0x12, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
+	0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6f, 0x6c, 0x64, 0x5f, 0x62,
+	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6f, 0x6c,
+	0x64, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x77, 0x5f,
+	0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6e,
+	0x65, 0x77, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x22, 0x74, 0x0a, 0x13, 0x57, 0x61, 0x74,
+	0x63, 0x68, 0x65, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x13, 0x0a, 0x05, 0x74, 0x78,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x74, 0x78, 0x49, 0x64, 0x12,
+	0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22,
+	0x81, 0x01, 0x0a, 0x13, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x61,
+	0x6b, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6f, 0x6c, 0x64,
+	0x5f, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6f, 0x6c,
+	0x64, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x65, 0x77, 0x5f, 0x73, 0x74,
+	0x61, 0x6b, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6e, 0x65, 0x77, 0x53, 0x74,
+	0x61, 0x6b, 0x65, 0x2a, 0xee, 0x02, 0x0a, 0x0a, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x6f, 0x70,
+	0x69, 0x63, 0x12, 0x1b, 0x0a, 0x17, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x4f, 0x50, 0x49,
+	0x43, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
+	0x20, 0x0a, 0x1b, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x4f, 0x50, 0x49, 0x43, 0x5f, 0x42,
+	0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x43, 0x4f, 0x4d, 0x4d, 0x49, 0x54, 0x54, 0x45, 0x44, 0x10, 0x81,
+	0x02, 0x12, 0x1e, 0x0a, 0x19, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x4f, 0x50, 0x49, 0x43,
+	0x5f, 0x52, 0x45, 0x57, 0x41, 0x52, 0x44, 0x5f, 0x50, 0x41, 0x59, 0x4f, 0x55, 0x54, 0x10, 0x82,
+	0x02, 0x12, 0x19, 0x0a, 0x14, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x4f, 0x50, 0x49, 0x43,
+	0x5f, 0x54, 0x52, 0x45, 0x41, 0x53, 0x55, 0x52, 0x59, 0x10, 0x83, 0x02, 0x12, 0x1e, 0x0a, 0x19,
+	0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x4f, 0x50, 0x49, 0x43, 0x5f, 0x46, 0x4f, 0x52, 0x4b,
+	0x5f, 0x44, 0x45, 0x54, 0x45, 0x43, 0x54, 0x45, 0x44, 0x10, 0x84, 0x02, 0x12, 0x18, 0x0a, 0x13,
+	0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x4f, 0x50, 0x49, 0x43, 0x5f, 0x41, 0x4e, 0x4f, 0x4d,
+	0x41, 0x4c, 0x59, 0x10, 0x85, 0x02, 0x12, 0x26, 0x0a, 0x21, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f,
+	0x54, 0x4f, 0x50, 0x49, 0x43, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x41, 0x43, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x52, 0x4d, 0x45, 0x44, 0x10, 0x81, 0x04, 0x12, 0x1f,
+	0x0a, 0x1a, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x4f, 0x50, 0x49, 0x43, 0x5f, 0x41, 0x43,
+	0x43, 0x4f, 0x55, 0x4e, 0x54, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x10, 0x81, 0x06, 0x12,
+	0x1f, 0x0a, 0x1a, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x4f, 0x50, 0x49, 0x43, 0x5f, 0x42,
+	0x41, 0x4c, 0x41, 0x4e, 0x43, 0x45, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x10, 0x82, 0x06,
+	0x12, 0x20, 0x0a, 0x1b, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x4f, 0x50, 0x49, 0x43, 0x5f,
+	0x57, 0x41, 0x54, 0x43, 0x48, 0x45, 0x44, 0x5f, 0x41, 0x44, 0x44, 0x52, 0x45, 0x53, 0x53, 0x10,
+	0x83, 0x06, 0x12, 0x20, 0x0a, 0x1b, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x4f, 0x50, 0x49,
+	0x43, 0x5f, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x41, 0x54, 0x4f, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x4b,
+	0x45, 0x10, 0x81, 0x08, 0x2a, 0x88, 0x01, 0x0a, 0x0b, 0x41, 0x6e, 0x6f, 0x6d, 0x61, 0x6c, 0x79,
+	0x4b, 0x69, 0x6e, 0x64, 0x12, 0x1c, 0x0a, 0x18, 0x41, 0x4e, 0x4f, 0x4d, 0x41, 0x4c, 0x59, 0x5f,
+	0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44,
+	0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16, 0x41, 0x4e, 0x4f, 0x4d, 0x41, 0x4c, 0x59, 0x5f, 0x4b, 0x49,
+	0x4e, 0x44, 0x5f, 0x46, 0x45, 0x45, 0x5f, 0x53, 0x50, 0x49, 0x4b, 0x45, 0x10, 0x01, 0x12, 0x1d,
+	0x0a, 0x19, 0x41, 0x4e, 0x4f, 0x4d, 0x41, 0x4c, 0x59, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x45,
+	0x4d, 0x50, 0x54, 0x59, 0x5f, 0x42, 0x4c, 0x4f, 0x43, 0x4b, 0x53, 0x10, 0x02, 0x12, 0x20, 0x0a,
+	0x1c, 0x41, 0x4e, 0x4f, 0x4d, 0x41, 0x4c, 0x59, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x43, 0x4f,
+	0x4d, 0x4d, 0x49, 0x54, 0x54, 0x45, 0x45, 0x5f, 0x43, 0x48, 0x55, 0x52, 0x4e, 0x10, 0x03, 0x42,
+	0x40, 0x0a, 0x0c, 0x70, 0x61, 0x63, 0x74, 0x75, 0x73, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5a,
+	0x30, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x61, 0x63, 0x74,
+	0x75, 0x73, 0x2d, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x2f, 0x70, 0x61, 0x63, 0x74, 0x75,
+	0x73, 0x2f, 0x77, 0x77, 0x77, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x70, 0x61, 0x63, 0x74, 0x75,
+	0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_event_proto_rawDescOnce sync.Once
+	file_event_proto_rawDescData = file_event_proto_rawDesc
+)
+
+func file_event_proto_rawDescGZIP() []byte {
+	file_event_proto_rawDescOnce.Do(func() {
+		file_event_proto_rawDescData = protoimpl.X.CompressGZIP(file_event_proto_rawDescData)
+	})
+	return file_event_proto_rawDescData
+}
+
+var file_event_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_event_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_event_proto_goTypes = []interface{}{
+	(EventTopic)(0),                   // 0: pactus.EventTopic
+	(AnomalyKind)(0),                  // 1: pactus.AnomalyKind
+	(*Event)(nil),                     // 2: pactus.Event
+	(*BlockCommittedEvent)(nil),       // 3: pactus.BlockCommittedEvent
+	(*RewardPayoutEvent)(nil),         // 4: pactus.RewardPayoutEvent
+	(*TreasuryEvent)(nil),             // 5: pactus.TreasuryEvent
+	(*ForkDetectedEvent)(nil),         // 6: pactus.ForkDetectedEvent
+	(*AnomalyEvent)(nil),              // 7: pactus.AnomalyEvent
+	(*TransactionConfirmedEvent)(nil), // 8: pactus.TransactionConfirmedEvent
+	(*AccountChangeEvent)(nil),        // 9: pactus.AccountChangeEvent
+	(*BalanceChangeEvent)(nil),        // 10: pactus.BalanceChangeEvent
+	(*WatchedAddressEvent)(nil),       // 11: pactus.WatchedAddressEvent
+	(*ValidatorStakeEvent)(nil),       // 12: pactus.ValidatorStakeEvent
+}
+var file_event_proto_depIdxs = []int32{
+	0,  // 0: pactus.Event.topic:type_name -> pactus.EventTopic
+	3,  // 1: pactus.Event.block_committed:type_name -> pactus.BlockCommittedEvent
+	4,  // 2: pactus.Event.reward_payout:type_name -> pactus.RewardPayoutEvent
+	5,  // 3: pactus.Event.treasury:type_name -> pactus.TreasuryEvent
+	6,  // 4: pactus.Event.fork_detected:type_name -> pactus.ForkDetectedEvent
+	8,  // 5: pactus.Event.transaction_confirmed:type_name -> pactus.TransactionConfirmedEvent
+	9,  // 6: pactus.Event.account_change:type_name -> pactus.AccountChangeEvent
+	10, // 7: pactus.Event.balance_change:type_name -> pactus.BalanceChangeEvent
+	11, // 8: pactus.Event.watched_address:type_name -> pactus.WatchedAddressEvent
+	12, // 9: pactus.Event.validator_stake:type_name -> pactus.ValidatorStakeEvent
+	7,  // 10: pactus.Event.anomaly:type_name -> pactus.AnomalyEvent
+	1,  // 11: pactus.AnomalyEvent.kind:type_name -> pactus.AnomalyKind
+	12, // [12:12] is the sub-list for method output_type
+	12, // [12:12] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
+}
+
+func init() { file_event_proto_init() }
+func file_event_proto_init() {
+	if File_event_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_event_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Event); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_event_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlockCommittedEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_event_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RewardPayoutEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_event_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TreasuryEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_event_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ForkDetectedEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_event_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AnomalyEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_event_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransactionConfirmedEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_event_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AccountChangeEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_event_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BalanceChangeEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_event_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchedAddressEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_event_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidatorStakeEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_event_proto_msgTypes[0].OneofWrappers = []interface{}{
+		(*Event_BlockCommitted)(nil),
+		(*Event_RewardPayout)(nil),
+		(*Event_Treasury)(nil),
+		(*Event_ForkDetected)(nil),
+		(*Event_TransactionConfirmed)(nil),
+		(*Event_AccountChange)(nil),
+		(*Event_BalanceChange)(nil),
+		(*Event_WatchedAddress)(nil),
+		(*Event_ValidatorStake)(nil),
+		(*Event_Anomaly)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_event_proto_rawDesc,
+			NumEnums:      2,
+			NumMessages:   11,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_event_proto_goTypes,
+		DependencyIndexes: file_event_proto_depIdxs,
+		EnumInfos:         file_event_proto_enumTypes,
+		MessageInfos:      file_event_proto_msgTypes,
+	}.Build()
+	File_event_proto = out.File
+	file_event_proto_rawDesc = nil
+	file_event_proto_goTypes = nil
+	file_event_proto_depIdxs = nil
+}
@@ -0,0 +1,69 @@
+// Code generated by protoc-gen-cobra. DO NOT EDIT.
+
+package pactus
+
+import (
+	client "github.com/NathanBaulch/protoc-gen-cobra/client"
+	flag "github.com/NathanBaulch/protoc-gen-cobra/flag"
+	iocodec "github.com/NathanBaulch/protoc-gen-cobra/iocodec"
+	cobra "github.com/spf13/cobra"
+	grpc "google.golang.org/grpc"
+	proto "google.golang.org/protobuf/proto"
+)
+
+func TransactionFilterClientCommand(options ...client.Option) *cobra.Command {
+	cfg := client.NewConfig(options...)
+	cmd := &cobra.Command{
+		Use:   cfg.CommandNamer("TransactionFilter"),
+		Short: "TransactionFilter service client",
+		Long:  "TransactionFilter service is implemented by the external services to decide\n whether a transaction should be accepted into the transaction pool of the node.",
+	}
+	cfg.BindFlags(cmd.PersistentFlags())
+	cmd.AddCommand(
+		_TransactionFilterFilterTransactionCommand(cfg),
+	)
+	return cmd
+}
+
+func _TransactionFilterFilterTransactionCommand(cfg *client.Config) *cobra.Command {
+	req := &FilterTransactionRequest{}
+
+	cmd := &cobra.Command{
+		Use:   cfg.CommandNamer("FilterTransaction"),
+		Short: "FilterTransaction RPC client",
+		Long:  "FilterTransaction decides whether the transaction should be accepted or rejected.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if cfg.UseEnvVars {
+				if err := flag.SetFlagsFromEnv(cmd.Parent().PersistentFlags(), true, cfg.EnvVarNamer, cfg.EnvVarPrefix, "TransactionFilter"); err != nil {
+					return err
+				}
+				if err := flag.SetFlagsFromEnv(cmd.PersistentFlags(), false, cfg.EnvVarNamer, cfg.EnvVarPrefix, "TransactionFilter", "FilterTransaction"); err != nil {
+					return err
+				}
+			}
+			return client.RoundTrip(cmd.Context(), cfg, func(cc grpc.ClientConnInterface, in iocodec.Decoder, out iocodec.Encoder) error {
+				cli := NewTransactionFilterClient(cc)
+				v := &FilterTransactionRequest{}
+
+				if err := in(v); err != nil {
+					return err
+				}
+				proto.Merge(v, req)
+
+				res, err := cli.FilterTransaction(cmd.Context(), v)
+
+				if err != nil {
+					return err
+				}
+
+				return out(res)
+
+			})
+		},
+	}
+
+	flag.BytesBase64Var(cmd.PersistentFlags(), &req.Id, cfg.FlagNamer("Id"), "")
+	flag.BytesBase64Var(cmd.PersistentFlags(), &req.Data, cfg.FlagNamer("Data"), "")
+
+	return cmd
+}
@@ -0,0 +1,105 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             (unknown)
+// source: filter.proto
+
+package pactus
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// TransactionFilterClient is the client API for TransactionFilter service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type TransactionFilterClient interface {
+	// FilterTransaction decides whether the transaction should be accepted or rejected.
+	FilterTransaction(ctx context.Context, in *FilterTransactionRequest, opts ...grpc.CallOption) (*FilterTransactionResponse, error)
+}
+
+type transactionFilterClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTransactionFilterClient(cc grpc.ClientConnInterface) TransactionFilterClient {
+	return &transactionFilterClient{cc}
+}
+
+func (c *transactionFilterClient) FilterTransaction(ctx context.Context, in *FilterTransactionRequest, opts ...grpc.CallOption) (*FilterTransactionResponse, error) {
+	out := new(FilterTransactionResponse)
+	err := c.cc.Invoke(ctx, "/pactus.TransactionFilter/FilterTransaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TransactionFilterServer is the server API for TransactionFilter service.
+// All implementations should embed UnimplementedTransactionFilterServer
+// for forward compatibility
+type TransactionFilterServer interface {
+	// FilterTransaction decides whether the transaction should be accepted or rejected.
+	FilterTransaction(context.Context, *FilterTransactionRequest) (*FilterTransactionResponse, error)
+}
+
+// UnimplementedTransactionFilterServer should be embedded to have forward compatible implementations.
+type UnimplementedTransactionFilterServer struct {
+}
+
+func (UnimplementedTransactionFilterServer) FilterTransaction(context.Context, *FilterTransactionRequest) (*FilterTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FilterTransaction not implemented")
+}
+
+// UnsafeTransactionFilterServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TransactionFilterServer will
+// result in compilation errors.
+type UnsafeTransactionFilterServer interface {
+	mustEmbedUnimplementedTransactionFilterServer()
+}
+
+func RegisterTransactionFilterServer(s grpc.ServiceRegistrar, srv TransactionFilterServer) {
+	s.RegisterService(&TransactionFilter_ServiceDesc, srv)
+}
+
+func _TransactionFilter_FilterTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FilterTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionFilterServer).FilterTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pactus.TransactionFilter/FilterTransaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionFilterServer).FilterTransaction(ctx, req.(*FilterTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// TransactionFilter_ServiceDesc is the grpc.ServiceDesc for TransactionFilter service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var TransactionFilter_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "pactus.TransactionFilter",
+	HandlerType: (*TransactionFilterServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "FilterTransaction",
+			Handler:    _TransactionFilter_FilterTransaction_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "filter.proto",
+}
@@ -22,6 +22,11 @@ func NetworkClientCommand(options ...client.Option) *cobra.Command {
 	cmd.AddCommand(
 		_NetworkGetNetworkInfoCommand(cfg),
 		_NetworkGetNodeInfoCommand(cfg),
+		_NetworkSetSafeModeCommand(cfg),
+		_NetworkGetDeprecationsCommand(cfg),
+		_NetworkGetPropagationStatsCommand(cfg),
+		_NetworkSetLogConfigCommand(cfg),
+		_NetworkGetPeerEvidenceCommand(cfg),
 	)
 	return cmd
 }
@@ -105,3 +110,221 @@ func _NetworkGetNodeInfoCommand(cfg *client.Config) *cobra.Command {
 
 	return cmd
 }
+
+func _NetworkSetSafeModeCommand(cfg *client.Config) *cobra.Command {
+	req := &SetSafeModeRequest{}
+
+	cmd := &cobra.Command{
+		Use:   cfg.CommandNamer("SetSafeMode"),
+		Short: "SetSafeMode RPC client",
+		Long:  "SetSafeMode enables or disables the safe mode of the node.\n In safe mode, the node keeps syncing and serving the requests,\n but it doesn't sign any proposal or vote.\n It is only accepted through the unix socket.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if cfg.UseEnvVars {
+				if err := flag.SetFlagsFromEnv(cmd.Parent().PersistentFlags(), true, cfg.EnvVarNamer, cfg.EnvVarPrefix, "Network"); err != nil {
+					return err
+				}
+				if err := flag.SetFlagsFromEnv(cmd.PersistentFlags(), false, cfg.EnvVarNamer, cfg.EnvVarPrefix, "Network", "SetSafeMode"); err != nil {
+					return err
+				}
+			}
+			return client.RoundTrip(cmd.Context(), cfg, func(cc grpc.ClientConnInterface, in iocodec.Decoder, out iocodec.Encoder) error {
+				cli := NewNetworkClient(cc)
+				v := &SetSafeModeRequest{}
+
+				if err := in(v); err != nil {
+					return err
+				}
+				proto.Merge(v, req)
+
+				res, err := cli.SetSafeMode(cmd.Context(), v)
+
+				if err != nil {
+					return err
+				}
+
+				return out(res)
+
+			})
+		},
+	}
+
+	cmd.PersistentFlags().BoolVar(&req.Enable, cfg.FlagNamer("Enable"), false, "")
+
+	return cmd
+}
+
+func _NetworkGetDeprecationsCommand(cfg *client.Config) *cobra.Command {
+	req := &GetDeprecationsRequest{}
+
+	cmd := &cobra.Command{
+		Use:   cfg.CommandNamer("GetDeprecations"),
+		Short: "GetDeprecations RPC client",
+		Long:  "GetDeprecations retrieves the deprecated config keys, RPC fields and CLI flags,\n with their replacements and how many times they are used since the node started.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if cfg.UseEnvVars {
+				if err := flag.SetFlagsFromEnv(cmd.Parent().PersistentFlags(), true, cfg.EnvVarNamer, cfg.EnvVarPrefix, "Network"); err != nil {
+					return err
+				}
+				if err := flag.SetFlagsFromEnv(cmd.PersistentFlags(), false, cfg.EnvVarNamer, cfg.EnvVarPrefix, "Network", "GetDeprecations"); err != nil {
+					return err
+				}
+			}
+			return client.RoundTrip(cmd.Context(), cfg, func(cc grpc.ClientConnInterface, in iocodec.Decoder, out iocodec.Encoder) error {
+				cli := NewNetworkClient(cc)
+				v := &GetDeprecationsRequest{}
+
+				if err := in(v); err != nil {
+					return err
+				}
+				proto.Merge(v, req)
+
+				res, err := cli.GetDeprecations(cmd.Context(), v)
+
+				if err != nil {
+					return err
+				}
+
+				return out(res)
+
+			})
+		},
+	}
+
+	cmd.PersistentFlags().BoolVar(&req.OnlyUsed, cfg.FlagNamer("OnlyUsed"), false, "")
+
+	return cmd
+}
+
+func _NetworkGetPropagationStatsCommand(cfg *client.Config) *cobra.Command {
+	req := &GetPropagationStatsRequest{}
+
+	cmd := &cobra.Command{
+		Use:   cfg.CommandNamer("GetPropagationStats"),
+		Short: "GetPropagationStats RPC client",
+		Long:  "GetPropagationStats retrieves the propagation latencies of the recent blocks and proposals,\n measured from the block time until the node first saw them.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if cfg.UseEnvVars {
+				if err := flag.SetFlagsFromEnv(cmd.Parent().PersistentFlags(), true, cfg.EnvVarNamer, cfg.EnvVarPrefix, "Network"); err != nil {
+					return err
+				}
+				if err := flag.SetFlagsFromEnv(cmd.PersistentFlags(), false, cfg.EnvVarNamer, cfg.EnvVarPrefix, "Network", "GetPropagationStats"); err != nil {
+					return err
+				}
+			}
+			return client.RoundTrip(cmd.Context(), cfg, func(cc grpc.ClientConnInterface, in iocodec.Decoder, out iocodec.Encoder) error {
+				cli := NewNetworkClient(cc)
+				v := &GetPropagationStatsRequest{}
+
+				if err := in(v); err != nil {
+					return err
+				}
+				proto.Merge(v, req)
+
+				res, err := cli.GetPropagationStats(cmd.Context(), v)
+
+				if err != nil {
+					return err
+				}
+
+				return out(res)
+
+			})
+		},
+	}
+
+	cmd.PersistentFlags().BoolVar(&req.IncludeRecords, cfg.FlagNamer("IncludeRecords"), false, "")
+
+	return cmd
+}
+
+func _NetworkSetLogConfigCommand(cfg *client.Config) *cobra.Command {
+	req := &SetLogConfigRequest{}
+
+	cmd := &cobra.Command{
+		Use:   cfg.CommandNamer("SetLogConfig"),
+		Short: "SetLogConfig RPC client",
+		Long:  "SetLogConfig changes the log level and the sampling of a logger module at runtime,\n so the debug logs can be enabled on a busy node without filling up the disk.\n It is only accepted through the unix socket.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if cfg.UseEnvVars {
+				if err := flag.SetFlagsFromEnv(cmd.Parent().PersistentFlags(), true, cfg.EnvVarNamer, cfg.EnvVarPrefix, "Network"); err != nil {
+					return err
+				}
+				if err := flag.SetFlagsFromEnv(cmd.PersistentFlags(), false, cfg.EnvVarNamer, cfg.EnvVarPrefix, "Network", "SetLogConfig"); err != nil {
+					return err
+				}
+			}
+			return client.RoundTrip(cmd.Context(), cfg, func(cc grpc.ClientConnInterface, in iocodec.Decoder, out iocodec.Encoder) error {
+				cli := NewNetworkClient(cc)
+				v := &SetLogConfigRequest{}
+
+				if err := in(v); err != nil {
+					return err
+				}
+				proto.Merge(v, req)
+
+				res, err := cli.SetLogConfig(cmd.Context(), v)
+
+				if err != nil {
+					return err
+				}
+
+				return out(res)
+
+			})
+		},
+	}
+
+	cmd.PersistentFlags().StringVar(&req.Module, cfg.FlagNamer("Module"), "", "")
+	cmd.PersistentFlags().StringVar(&req.Level, cfg.FlagNamer("Level"), "", "")
+	_Sampling := &LogSampling{}
+	cmd.PersistentFlags().Uint32Var(&_Sampling.Every, cfg.FlagNamer("Sampling Every"), 0, "")
+	flag.WithPostSetHook(cmd.PersistentFlags(), cfg.FlagNamer("Sampling Every"), func() { req.Sampling = _Sampling })
+	cmd.PersistentFlags().Uint32Var(&_Sampling.Burst, cfg.FlagNamer("Sampling Burst"), 0, "")
+	flag.WithPostSetHook(cmd.PersistentFlags(), cfg.FlagNamer("Sampling Burst"), func() { req.Sampling = _Sampling })
+	cmd.PersistentFlags().Uint32Var(&_Sampling.Period, cfg.FlagNamer("Sampling Period"), 0, "")
+	flag.WithPostSetHook(cmd.PersistentFlags(), cfg.FlagNamer("Sampling Period"), func() { req.Sampling = _Sampling })
+
+	return cmd
+}
+
+func _NetworkGetPeerEvidenceCommand(cfg *client.Config) *cobra.Command {
+	req := &GetPeerEvidenceRequest{}
+
+	cmd := &cobra.Command{
+		Use:   cfg.CommandNamer("GetPeerEvidence"),
+		Short: "GetPeerEvidence RPC client",
+		Long:  "GetPeerEvidence retrieves the evidences of penalizing the peers, with the offending data,\n so the reports of the malicious peers can be reproduced.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if cfg.UseEnvVars {
+				if err := flag.SetFlagsFromEnv(cmd.Parent().PersistentFlags(), true, cfg.EnvVarNamer, cfg.EnvVarPrefix, "Network"); err != nil {
+					return err
+				}
+				if err := flag.SetFlagsFromEnv(cmd.PersistentFlags(), false, cfg.EnvVarNamer, cfg.EnvVarPrefix, "Network", "GetPeerEvidence"); err != nil {
+					return err
+				}
+			}
+			return client.RoundTrip(cmd.Context(), cfg, func(cc grpc.ClientConnInterface, in iocodec.Decoder, out iocodec.Encoder) error {
+				cli := NewNetworkClient(cc)
+				v := &GetPeerEvidenceRequest{}
+
+				if err := in(v); err != nil {
+					return err
+				}
+				proto.Merge(v, req)
+
+				res, err := cli.GetPeerEvidence(cmd.Context(), v)
+
+				if err != nil {
+					return err
+				}
+
+				return out(res)
+
+			})
+		},
+	}
+
+	flag.BytesBase64Var(cmd.PersistentFlags(), &req.PeerId, cfg.FlagNamer("PeerId"), "")
+
+	return cmd
+}
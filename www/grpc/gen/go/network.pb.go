@@ -20,6 +20,206 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// Enumeration for the kinds of the messages that carry the blocks.
+type PropagationKind int32
+
+const (
+	PropagationKind_PROPAGATION_BLOCK    PropagationKind = 0 // Block announcement.
+	PropagationKind_PROPAGATION_PROPOSAL PropagationKind = 1 // Proposal.
+)
+
+// Enum value maps for PropagationKind.
+var (
+	PropagationKind_name = map[int32]string{
+		0: "PROPAGATION_BLOCK",
+		1: "PROPAGATION_PROPOSAL",
+	}
+	PropagationKind_value = map[string]int32{
+		"PROPAGATION_BLOCK":    0,
+		"PROPAGATION_PROPOSAL": 1,
+	}
+)
+
+func (x PropagationKind) Enum() *PropagationKind {
+	p := new(PropagationKind)
+	*p = x
+	return p
+}
+
+func (x PropagationKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PropagationKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_network_proto_enumTypes[0].Descriptor()
+}
+
+func (PropagationKind) Type() protoreflect.EnumType {
+	return &file_network_proto_enumTypes[0]
+}
+
+func (x PropagationKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PropagationKind.Descriptor instead.
+func (PropagationKind) EnumDescriptor() ([]byte, []int) {
+	return file_network_proto_rawDescGZIP(), []int{0}
+}
+
+// Enumeration for the health status of the subsystems.
+type HealthStatus int32
+
+const (
+	HealthStatus_HEALTH_OK       HealthStatus = 0 // No issue in the recent minutes.
+	HealthStatus_HEALTH_DEGRADED HealthStatus = 1 // Subsystem works, but it has panicked or has logged too many errors.
+	HealthStatus_HEALTH_FAILING  HealthStatus = 2 // Subsystem keeps panicking and restarting.
+)
+
+// Enum value maps for HealthStatus.
+var (
+	HealthStatus_name = map[int32]string{
+		0: "HEALTH_OK",
+		1: "HEALTH_DEGRADED",
+		2: "HEALTH_FAILING",
+	}
+	HealthStatus_value = map[string]int32{
+		"HEALTH_OK":       0,
+		"HEALTH_DEGRADED": 1,
+		"HEALTH_FAILING":  2,
+	}
+)
+
+func (x HealthStatus) Enum() *HealthStatus {
+	p := new(HealthStatus)
+	*p = x
+	return p
+}
+
+func (x HealthStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (HealthStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_network_proto_enumTypes[1].Descriptor()
+}
+
+func (HealthStatus) Type() protoreflect.EnumType {
+	return &file_network_proto_enumTypes[1]
+}
+
+func (x HealthStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use HealthStatus.Descriptor instead.
+func (HealthStatus) EnumDescriptor() ([]byte, []int) {
+	return file_network_proto_rawDescGZIP(), []int{1}
+}
+
+// Enumeration for the levels of the eclipse risk score.
+type EclipseRiskLevel int32
+
+const (
+	EclipseRiskLevel_ECLIPSE_RISK_LOW      EclipseRiskLevel = 0 // Peers are diverse.
+	EclipseRiskLevel_ECLIPSE_RISK_ELEVATED EclipseRiskLevel = 1 // Peers are getting homogeneous.
+	EclipseRiskLevel_ECLIPSE_RISK_HIGH     EclipseRiskLevel = 2 // Peers are dangerously homogeneous, or no peer is connected.
+)
+
+// Enum value maps for EclipseRiskLevel.
+var (
+	EclipseRiskLevel_name = map[int32]string{
+		0: "ECLIPSE_RISK_LOW",
+		1: "ECLIPSE_RISK_ELEVATED",
+		2: "ECLIPSE_RISK_HIGH",
+	}
+	EclipseRiskLevel_value = map[string]int32{
+		"ECLIPSE_RISK_LOW":      0,
+		"ECLIPSE_RISK_ELEVATED": 1,
+		"ECLIPSE_RISK_HIGH":     2,
+	}
+)
+
+func (x EclipseRiskLevel) Enum() *EclipseRiskLevel {
+	p := new(EclipseRiskLevel)
+	*p = x
+	return p
+}
+
+func (x EclipseRiskLevel) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EclipseRiskLevel) Descriptor() protoreflect.EnumDescriptor {
+	return file_network_proto_enumTypes[2].Descriptor()
+}
+
+func (EclipseRiskLevel) Type() protoreflect.EnumType {
+	return &file_network_proto_enumTypes[2]
+}
+
+func (x EclipseRiskLevel) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EclipseRiskLevel.Descriptor instead.
+func (EclipseRiskLevel) EnumDescriptor() ([]byte, []int) {
+	return file_network_proto_rawDescGZIP(), []int{2}
+}
+
+// Enumeration for the outcomes of the self-test checks.
+type SelfTestStatus int32
+
+const (
+	SelfTestStatus_SELF_TEST_PASSED  SelfTestStatus = 0 // Check passed.
+	SelfTestStatus_SELF_TEST_WARNING SelfTestStatus = 1 // Node can run, but it might have issues.
+	SelfTestStatus_SELF_TEST_FAILED  SelfTestStatus = 2 // Node is likely to fail.
+	SelfTestStatus_SELF_TEST_SKIPPED SelfTestStatus = 3 // Check is not supported or couldn't be performed.
+)
+
+// Enum value maps for SelfTestStatus.
+var (
+	SelfTestStatus_name = map[int32]string{
+		0: "SELF_TEST_PASSED",
+		1: "SELF_TEST_WARNING",
+		2: "SELF_TEST_FAILED",
+		3: "SELF_TEST_SKIPPED",
+	}
+	SelfTestStatus_value = map[string]int32{
+		"SELF_TEST_PASSED":  0,
+		"SELF_TEST_WARNING": 1,
+		"SELF_TEST_FAILED":  2,
+		"SELF_TEST_SKIPPED": 3,
+	}
+)
+
+func (x SelfTestStatus) Enum() *SelfTestStatus {
+	p := new(SelfTestStatus)
+	*p = x
+	return p
+}
+
+func (x SelfTestStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SelfTestStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_network_proto_enumTypes[3].Descriptor()
+}
+
+func (SelfTestStatus) Type() protoreflect.EnumType {
+	return &file_network_proto_enumTypes[3]
+}
+
+func (x SelfTestStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SelfTestStatus.Descriptor instead.
+func (SelfTestStatus) EnumDescriptor() ([]byte, []int) {
+	return file_network_proto_rawDescGZIP(), []int{3}
+}
+
 // Request message for retrieving overall network information.
 type GetNetworkInfoRequest struct {
 	state         protoimpl.MessageState
@@ -72,6 +272,7 @@ type GetNetworkInfoResponse struct {
 	ConnectedPeers      []*PeerInfo       `protobuf:"bytes,5,rep,name=connected_peers,json=connectedPeers,proto3" json:"connected_peers,omitempty"`                                                                                        // List of connected peers.
 	SentBytes           map[uint32]uint64 `protobuf:"bytes,6,rep,name=sent_bytes,json=sentBytes,proto3" json:"sent_bytes,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`             // Bytes sent per peer ID.
 	ReceivedBytes       map[uint32]uint64 `protobuf:"bytes,7,rep,name=received_bytes,json=receivedBytes,proto3" json:"received_bytes,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"` // Bytes received per peer ID.
+	PeerVersions        []*PeerVersion    `protobuf:"bytes,8,rep,name=peer_versions,json=peerVersions,proto3" json:"peer_versions,omitempty"`                                                                                              // Number of the known peers by their version, the most common first.
 }
 
 func (x *GetNetworkInfoResponse) Reset() {
@@ -155,6 +356,13 @@ func (x *GetNetworkInfoResponse) GetReceivedBytes() map[uint32]uint64 {
 	return nil
 }
 
+func (x *GetNetworkInfoResponse) GetPeerVersions() []*PeerVersion {
+	if x != nil {
+		return x.PeerVersions
+	}
+	return nil
+}
+
 // Request message for retrieving information about a specific node in the network.
 type GetNodeInfoRequest struct {
 	state         protoimpl.MessageState
@@ -200,15 +408,28 @@ type GetNodeInfoResponse struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Moniker       string   `protobuf:"bytes,1,opt,name=moniker,proto3" json:"moniker,omitempty"`                                  // Moniker of the node.
-	Agent         string   `protobuf:"bytes,2,opt,name=agent,proto3" json:"agent,omitempty"`                                      // Agent information of the node.
-	PeerId        []byte   `protobuf:"bytes,3,opt,name=peer_id,json=peerId,proto3" json:"peer_id,omitempty"`                      // Peer ID of the node.
-	StartedAt     uint64   `protobuf:"varint,4,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`            // Timestamp when the node started.
-	Reachability  string   `protobuf:"bytes,5,opt,name=reachability,proto3" json:"reachability,omitempty"`                        // Reachability status of the node.
-	Services      []int32  `protobuf:"varint,6,rep,packed,name=services,proto3" json:"services,omitempty"`                        // List of services provided by the node.
-	ServicesNames []string `protobuf:"bytes,7,rep,name=services_names,json=servicesNames,proto3" json:"services_names,omitempty"` // Names of services provided by the node.
-	Addrs         []string `protobuf:"bytes,8,rep,name=addrs,proto3" json:"addrs,omitempty"`                                      // List of addresses associated with the node.
-	Protocols     []string `protobuf:"bytes,9,rep,name=protocols,proto3" json:"protocols,omitempty"`                              // List of protocols supported by the node.
+	Moniker           string             `protobuf:"bytes,1,opt,name=moniker,proto3" json:"moniker,omitempty"`                                               // Moniker of the node.
+	Agent             string             `protobuf:"bytes,2,opt,name=agent,proto3" json:"agent,omitempty"`                                                   // Agent information of the node.
+	PeerId            []byte             `protobuf:"bytes,3,opt,name=peer_id,json=peerId,proto3" json:"peer_id,omitempty"`                                   // Peer ID of the node.
+	StartedAt         uint64             `protobuf:"varint,4,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`                         // Timestamp when the node started.
+	Reachability      string             `protobuf:"bytes,5,opt,name=reachability,proto3" json:"reachability,omitempty"`                                     // Reachability status of the node.
+	Services          []int32            `protobuf:"varint,6,rep,packed,name=services,proto3" json:"services,omitempty"`                                     // List of services provided by the node.
+	ServicesNames     []string           `protobuf:"bytes,7,rep,name=services_names,json=servicesNames,proto3" json:"services_names,omitempty"`              // Names of services provided by the node.
+	Addrs             []string           `protobuf:"bytes,8,rep,name=addrs,proto3" json:"addrs,omitempty"`                                                   // List of addresses associated with the node.
+	Protocols         []string           `protobuf:"bytes,9,rep,name=protocols,proto3" json:"protocols,omitempty"`                                           // List of protocols supported by the node.
+	SelfTest          []*SelfTestResult  `protobuf:"bytes,10,rep,name=self_test,json=selfTest,proto3" json:"self_test,omitempty"`                            // Results of the startup self-test.
+	SafeMode          bool               `protobuf:"varint,11,opt,name=safe_mode,json=safeMode,proto3" json:"safe_mode,omitempty"`                           // Whether the node is in safe mode and doesn't sign.
+	ProtocolVersion   uint32             `protobuf:"varint,12,opt,name=protocol_version,json=protocolVersion,proto3" json:"protocol_version,omitempty"`      // Version of the sync protocol of the node.
+	Capabilities      uint32             `protobuf:"varint,13,opt,name=capabilities,proto3" json:"capabilities,omitempty"`                                   // Wire capabilities supported by the node.
+	CapabilitiesNames []string           `protobuf:"bytes,14,rep,name=capabilities_names,json=capabilitiesNames,proto3" json:"capabilities_names,omitempty"` // Names of the wire capabilities supported by the node.
+	Attestation       []byte             `protobuf:"bytes,15,opt,name=attestation,proto3" json:"attestation,omitempty"`                                      // Hardware attestation of the validators of the node, if any.
+	Replica           bool               `protobuf:"varint,16,opt,name=replica,proto3" json:"replica,omitempty"`                                             // Whether the node is a read replica that trusts its primaries for the new blocks.
+	Primaries         [][]byte           `protobuf:"bytes,17,rep,name=primaries,proto3" json:"primaries,omitempty"`                                          // Peer IDs of the trusted primaries, if the node is a read replica.
+	Commit            string             `protobuf:"bytes,18,opt,name=commit,proto3" json:"commit,omitempty"`                                                // Git commit that the node is built from, if known.
+	Health            HealthStatus       `protobuf:"varint,19,opt,name=health,proto3,enum=pactus.HealthStatus" json:"health,omitempty"`                      // Overall health of the node, the worst health of the subsystems.
+	SubsystemsHealth  []*SubsystemHealth `protobuf:"bytes,20,rep,name=subsystems_health,json=subsystemsHealth,proto3" json:"subsystems_health,omitempty"`    // Health of the subsystems, sorted by the name.
+	EclipseRisk       *EclipseRisk       `protobuf:"bytes,21,opt,name=eclipse_risk,json=eclipseRisk,proto3" json:"eclipse_risk,omitempty"`                   // Local estimation of the risk of being eclipsed by the peers.
+	PausedValidators  []string           `protobuf:"bytes,22,rep,name=paused_validators,json=pausedValidators,proto3" json:"paused_validators,omitempty"`    // Addresses of the validators of the node that don't take part in the sortition.
 }
 
 func (x *GetNodeInfoResponse) Reset() {
@@ -306,217 +527,1700 @@ func (x *GetNodeInfoResponse) GetProtocols() []string {
 	return nil
 }
 
-// Information about a peer in the network.
-type PeerInfo struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Status            int32           `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`                                                                                                                              // Status of the peer.
-	Moniker           string          `protobuf:"bytes,2,opt,name=moniker,proto3" json:"moniker,omitempty"`                                                                                                                             // Moniker of the peer.
-	Agent             string          `protobuf:"bytes,3,opt,name=agent,proto3" json:"agent,omitempty"`                                                                                                                                 // Agent information of the peer.
-	PeerId            []byte          `protobuf:"bytes,4,opt,name=peer_id,json=peerId,proto3" json:"peer_id,omitempty"`                                                                                                                 // Peer ID of the peer.
-	ConsensusKeys     []string        `protobuf:"bytes,5,rep,name=consensus_keys,json=consensusKeys,proto3" json:"consensus_keys,omitempty"`                                                                                            // Consensus keys used by the peer.
-	ConsensusAddress  []string        `protobuf:"bytes,6,rep,name=consensus_address,json=consensusAddress,proto3" json:"consensus_address,omitempty"`                                                                                   // Consensus address of the peer.
-	Services          uint32          `protobuf:"varint,7,opt,name=services,proto3" json:"services,omitempty"`                                                                                                                          // Services provided by the peer.
-	LastBlockHash     []byte          `protobuf:"bytes,8,opt,name=last_block_hash,json=lastBlockHash,proto3" json:"last_block_hash,omitempty"`                                                                                          // Hash of the last block the peer knows.
-	Height            uint32          `protobuf:"varint,9,opt,name=height,proto3" json:"height,omitempty"`                                                                                                                              // Height of the peer in the blockchain.
-	ReceivedMessages  int32           `protobuf:"varint,10,opt,name=received_messages,json=receivedMessages,proto3" json:"received_messages,omitempty"`                                                                                 // Count of received messages.
-	InvalidMessages   int32           `protobuf:"varint,11,opt,name=invalid_messages,json=invalidMessages,proto3" json:"invalid_messages,omitempty"`                                                                                    // Count of invalid messages received.
-	LastSent          int64           `protobuf:"varint,12,opt,name=last_sent,json=lastSent,proto3" json:"last_sent,omitempty"`                                                                                                         // Timestamp of the last sent message.
-	LastReceived      int64           `protobuf:"varint,13,opt,name=last_received,json=lastReceived,proto3" json:"last_received,omitempty"`                                                                                             // Timestamp of the last received message.
-	SentBytes         map[int32]int64 `protobuf:"bytes,14,rep,name=sent_bytes,json=sentBytes,proto3" json:"sent_bytes,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`             // Bytes sent per message type.
-	ReceivedBytes     map[int32]int64 `protobuf:"bytes,15,rep,name=received_bytes,json=receivedBytes,proto3" json:"received_bytes,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"` // Bytes received per message type.
-	Address           string          `protobuf:"bytes,16,opt,name=address,proto3" json:"address,omitempty"`                                                                                                                            // Network address of the peer.
-	Direction         string          `protobuf:"bytes,17,opt,name=direction,proto3" json:"direction,omitempty"`                                                                                                                        // Direction of connection with the peer.
-	Protocols         []string        `protobuf:"bytes,18,rep,name=protocols,proto3" json:"protocols,omitempty"`                                                                                                                        // List of protocols supported by the peer.
-	TotalSessions     int32           `protobuf:"varint,19,opt,name=total_sessions,json=totalSessions,proto3" json:"total_sessions,omitempty"`                                                                                          // Total sessions with the peer.
-	CompletedSessions int32           `protobuf:"varint,20,opt,name=completed_sessions,json=completedSessions,proto3" json:"completed_sessions,omitempty"`                                                                              // Completed sessions with the peer.
-}
-
-func (x *PeerInfo) Reset() {
-	*x = PeerInfo{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_network_proto_msgTypes[4]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *GetNodeInfoResponse) GetSelfTest() []*SelfTestResult {
+	if x != nil {
+		return x.SelfTest
 	}
+	return nil
 }
 
-func (x *PeerInfo) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*PeerInfo) ProtoMessage() {}
-
-func (x *PeerInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_network_proto_msgTypes[4]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *GetNodeInfoResponse) GetSafeMode() bool {
+	if x != nil {
+		return x.SafeMode
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use PeerInfo.ProtoReflect.Descriptor instead.
-func (*PeerInfo) Descriptor() ([]byte, []int) {
-	return file_network_proto_rawDescGZIP(), []int{4}
+	return false
 }
 
-func (x *PeerInfo) GetStatus() int32 {
+func (x *GetNodeInfoResponse) GetProtocolVersion() uint32 {
 	if x != nil {
-		return x.Status
+		return x.ProtocolVersion
 	}
 	return 0
 }
 
-func (x *PeerInfo) GetMoniker() string {
+func (x *GetNodeInfoResponse) GetCapabilities() uint32 {
 	if x != nil {
-		return x.Moniker
+		return x.Capabilities
 	}
-	return ""
+	return 0
 }
 
-func (x *PeerInfo) GetAgent() string {
+func (x *GetNodeInfoResponse) GetCapabilitiesNames() []string {
 	if x != nil {
-		return x.Agent
+		return x.CapabilitiesNames
 	}
-	return ""
+	return nil
 }
 
-func (x *PeerInfo) GetPeerId() []byte {
+func (x *GetNodeInfoResponse) GetAttestation() []byte {
 	if x != nil {
-		return x.PeerId
+		return x.Attestation
 	}
 	return nil
 }
 
-func (x *PeerInfo) GetConsensusKeys() []string {
+func (x *GetNodeInfoResponse) GetReplica() bool {
 	if x != nil {
-		return x.ConsensusKeys
+		return x.Replica
 	}
-	return nil
+	return false
 }
 
-func (x *PeerInfo) GetConsensusAddress() []string {
+func (x *GetNodeInfoResponse) GetPrimaries() [][]byte {
 	if x != nil {
-		return x.ConsensusAddress
+		return x.Primaries
 	}
 	return nil
 }
 
-func (x *PeerInfo) GetServices() uint32 {
+func (x *GetNodeInfoResponse) GetCommit() string {
 	if x != nil {
-		return x.Services
+		return x.Commit
 	}
-	return 0
+	return ""
 }
 
-func (x *PeerInfo) GetLastBlockHash() []byte {
+func (x *GetNodeInfoResponse) GetHealth() HealthStatus {
 	if x != nil {
-		return x.LastBlockHash
+		return x.Health
 	}
-	return nil
+	return HealthStatus_HEALTH_OK
 }
 
-func (x *PeerInfo) GetHeight() uint32 {
+func (x *GetNodeInfoResponse) GetSubsystemsHealth() []*SubsystemHealth {
 	if x != nil {
-		return x.Height
+		return x.SubsystemsHealth
 	}
-	return 0
+	return nil
 }
 
-func (x *PeerInfo) GetReceivedMessages() int32 {
+func (x *GetNodeInfoResponse) GetEclipseRisk() *EclipseRisk {
 	if x != nil {
-		return x.ReceivedMessages
+		return x.EclipseRisk
 	}
-	return 0
+	return nil
 }
 
-func (x *PeerInfo) GetInvalidMessages() int32 {
+func (x *GetNodeInfoResponse) GetPausedValidators() []string {
 	if x != nil {
-		return x.InvalidMessages
+		return x.PausedValidators
 	}
-	return 0
+	return nil
 }
 
-func (x *PeerInfo) GetLastSent() int64 {
-	if x != nil {
-		return x.LastSent
-	}
-	return 0
+// Request message for enabling or disabling the safe mode.
+type SetSafeModeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Enable bool `protobuf:"varint,1,opt,name=enable,proto3" json:"enable,omitempty"` // Whether to enable the safe mode.
 }
 
-func (x *PeerInfo) GetLastReceived() int64 {
-	if x != nil {
-		return x.LastReceived
+func (x *SetSafeModeRequest) Reset() {
+	*x = SetSafeModeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_network_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *PeerInfo) GetSentBytes() map[int32]int64 {
-	if x != nil {
-		return x.SentBytes
-	}
-	return nil
+func (x *SetSafeModeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *PeerInfo) GetReceivedBytes() map[int32]int64 {
-	if x != nil {
-		return x.ReceivedBytes
+func (*SetSafeModeRequest) ProtoMessage() {}
+
+func (x *SetSafeModeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_network_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *PeerInfo) GetAddress() string {
-	if x != nil {
-		return x.Address
-	}
-	return ""
+// Deprecated: Use SetSafeModeRequest.ProtoReflect.Descriptor instead.
+func (*SetSafeModeRequest) Descriptor() ([]byte, []int) {
+	return file_network_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *PeerInfo) GetDirection() string {
+func (x *SetSafeModeRequest) GetEnable() bool {
 	if x != nil {
-		return x.Direction
+		return x.Enable
 	}
-	return ""
+	return false
 }
 
-func (x *PeerInfo) GetProtocols() []string {
-	if x != nil {
-		return x.Protocols
+// Response message containing the safe mode status of the node.
+type SetSafeModeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SafeMode bool `protobuf:"varint,1,opt,name=safe_mode,json=safeMode,proto3" json:"safe_mode,omitempty"` // Whether the node is in safe mode.
+}
+
+func (x *SetSafeModeResponse) Reset() {
+	*x = SetSafeModeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_network_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *PeerInfo) GetTotalSessions() int32 {
-	if x != nil {
-		return x.TotalSessions
+func (x *SetSafeModeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetSafeModeResponse) ProtoMessage() {}
+
+func (x *SetSafeModeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_network_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *PeerInfo) GetCompletedSessions() int32 {
+// Deprecated: Use SetSafeModeResponse.ProtoReflect.Descriptor instead.
+func (*SetSafeModeResponse) Descriptor() ([]byte, []int) {
+	return file_network_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *SetSafeModeResponse) GetSafeMode() bool {
 	if x != nil {
-		return x.CompletedSessions
+		return x.SafeMode
 	}
-	return 0
+	return false
 }
 
-var File_network_proto protoreflect.FileDescriptor
+// Request message for retrieving the deprecations.
+type GetDeprecationsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-var file_network_proto_rawDesc = []byte{
-	0x0a, 0x0d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
-	0x06, 0x70, 0x61, 0x63, 0x74, 0x75, 0x73, 0x22, 0x17, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x4e, 0x65,
-	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x22, 0xae, 0x04, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49,
-	0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x6e,
-	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0b, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x28,
-	0x0a, 0x10, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x73, 0x65, 0x6e, 0x74, 0x5f, 0x62, 0x79, 0x74,
+	OnlyUsed bool `protobuf:"varint,1,opt,name=only_used,json=onlyUsed,proto3" json:"only_used,omitempty"` // Whether to return only the deprecations that are used.
+}
+
+func (x *GetDeprecationsRequest) Reset() {
+	*x = GetDeprecationsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_network_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDeprecationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDeprecationsRequest) ProtoMessage() {}
+
+func (x *GetDeprecationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_network_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDeprecationsRequest.ProtoReflect.Descriptor instead.
+func (*GetDeprecationsRequest) Descriptor() ([]byte, []int) {
+	return file_network_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetDeprecationsRequest) GetOnlyUsed() bool {
+	if x != nil {
+		return x.OnlyUsed
+	}
+	return false
+}
+
+// Response message containing the deprecations.
+type GetDeprecationsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Deprecations []*DeprecationInfo `protobuf:"bytes,1,rep,name=deprecations,proto3" json:"deprecations,omitempty"` // List of the deprecations.
+}
+
+func (x *GetDeprecationsResponse) Reset() {
+	*x = GetDeprecationsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_network_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDeprecationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDeprecationsResponse) ProtoMessage() {}
+
+func (x *GetDeprecationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_network_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDeprecationsResponse.ProtoReflect.Descriptor instead.
+func (*GetDeprecationsResponse) Descriptor() ([]byte, []int) {
+	return file_network_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetDeprecationsResponse) GetDeprecations() []*DeprecationInfo {
+	if x != nil {
+		return x.Deprecations
+	}
+	return nil
+}
+
+// Information about a deprecated config key, RPC field or CLI flag.
+type DeprecationInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Kind        string `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`                          // Kind of the deprecated item: `config`, `rpc` or `flag`.
+	Name        string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                          // Name of the deprecated item.
+	Replacement string `protobuf:"bytes,3,opt,name=replacement,proto3" json:"replacement,omitempty"`            // Replacement of the item, empty if it is removed without replacement.
+	Since       string `protobuf:"bytes,4,opt,name=since,proto3" json:"since,omitempty"`                        // Version that deprecated the item.
+	UseCount    uint64 `protobuf:"varint,5,opt,name=use_count,json=useCount,proto3" json:"use_count,omitempty"` // Number of times the item is used since the node started.
+}
+
+func (x *DeprecationInfo) Reset() {
+	*x = DeprecationInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_network_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeprecationInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeprecationInfo) ProtoMessage() {}
+
+func (x *DeprecationInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_network_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeprecationInfo.ProtoReflect.Descriptor instead.
+func (*DeprecationInfo) Descriptor() ([]byte, []int) {
+	return file_network_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *DeprecationInfo) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *DeprecationInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DeprecationInfo) GetReplacement() string {
+	if x != nil {
+		return x.Replacement
+	}
+	return ""
+}
+
+func (x *DeprecationInfo) GetSince() string {
+	if x != nil {
+		return x.Since
+	}
+	return ""
+}
+
+func (x *DeprecationInfo) GetUseCount() uint64 {
+	if x != nil {
+		return x.UseCount
+	}
+	return 0
+}
+
+// Request message for retrieving the propagation statistics.
+type GetPropagationStatsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	IncludeRecords bool `protobuf:"varint,1,opt,name=include_records,json=includeRecords,proto3" json:"include_records,omitempty"` // Whether to return the first-seen records of the blocks and proposals.
+}
+
+func (x *GetPropagationStatsRequest) Reset() {
+	*x = GetPropagationStatsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_network_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPropagationStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPropagationStatsRequest) ProtoMessage() {}
+
+func (x *GetPropagationStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_network_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPropagationStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetPropagationStatsRequest) Descriptor() ([]byte, []int) {
+	return file_network_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetPropagationStatsRequest) GetIncludeRecords() bool {
+	if x != nil {
+		return x.IncludeRecords
+	}
+	return false
+}
+
+// Response message containing the propagation statistics.
+type GetPropagationStatsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Blocks    *PropagationStats    `protobuf:"bytes,1,opt,name=blocks,proto3" json:"blocks,omitempty"`       // Statistics of the announced blocks.
+	Proposals *PropagationStats    `protobuf:"bytes,2,opt,name=proposals,proto3" json:"proposals,omitempty"` // Statistics of the proposals.
+	Records   []*PropagationRecord `protobuf:"bytes,3,rep,name=records,proto3" json:"records,omitempty"`     // First-seen records, from the oldest to the newest.
+}
+
+func (x *GetPropagationStatsResponse) Reset() {
+	*x = GetPropagationStatsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_network_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPropagationStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPropagationStatsResponse) ProtoMessage() {}
+
+func (x *GetPropagationStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_network_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPropagationStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetPropagationStatsResponse) Descriptor() ([]byte, []int) {
+	return file_network_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetPropagationStatsResponse) GetBlocks() *PropagationStats {
+	if x != nil {
+		return x.Blocks
+	}
+	return nil
+}
+
+func (x *GetPropagationStatsResponse) GetProposals() *PropagationStats {
+	if x != nil {
+		return x.Proposals
+	}
+	return nil
+}
+
+func (x *GetPropagationStatsResponse) GetRecords() []*PropagationRecord {
+	if x != nil {
+		return x.Records
+	}
+	return nil
+}
+
+// Statistics of the propagation latencies. The latencies are in milliseconds.
+type PropagationStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Count         uint32 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`                                      // Number of the recorded blocks or proposals.
+	MinLatency    int64  `protobuf:"varint,2,opt,name=min_latency,json=minLatency,proto3" json:"min_latency,omitempty"`          // Minimum latency.
+	MedianLatency int64  `protobuf:"varint,3,opt,name=median_latency,json=medianLatency,proto3" json:"median_latency,omitempty"` // Median latency.
+	P90Latency    int64  `protobuf:"varint,4,opt,name=p90_latency,json=p90Latency,proto3" json:"p90_latency,omitempty"`          // 90th percentile of the latencies.
+	MaxLatency    int64  `protobuf:"varint,5,opt,name=max_latency,json=maxLatency,proto3" json:"max_latency,omitempty"`          // Maximum latency.
+}
+
+func (x *PropagationStats) Reset() {
+	*x = PropagationStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_network_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PropagationStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PropagationStats) ProtoMessage() {}
+
+func (x *PropagationStats) ProtoReflect() protoreflect.Message {
+	mi := &file_network_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PropagationStats.ProtoReflect.Descriptor instead.
+func (*PropagationStats) Descriptor() ([]byte, []int) {
+	return file_network_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *PropagationStats) GetCount() uint32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *PropagationStats) GetMinLatency() int64 {
+	if x != nil {
+		return x.MinLatency
+	}
+	return 0
+}
+
+func (x *PropagationStats) GetMedianLatency() int64 {
+	if x != nil {
+		return x.MedianLatency
+	}
+	return 0
+}
+
+func (x *PropagationStats) GetP90Latency() int64 {
+	if x != nil {
+		return x.P90Latency
+	}
+	return 0
+}
+
+func (x *PropagationStats) GetMaxLatency() int64 {
+	if x != nil {
+		return x.MaxLatency
+	}
+	return 0
+}
+
+// Record of a block or a proposal that is first seen by the node.
+type PropagationRecord struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Kind      PropagationKind `protobuf:"varint,1,opt,name=kind,proto3,enum=pactus.PropagationKind" json:"kind,omitempty"` // Kind of the message that carried the block.
+	Height    uint32          `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`                         // Height of the block.
+	Round     int32           `protobuf:"varint,3,opt,name=round,proto3" json:"round,omitempty"`                           // Consensus round of the block.
+	BlockHash []byte          `protobuf:"bytes,4,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`   // Hash of the block.
+	BlockTime uint32          `protobuf:"varint,5,opt,name=block_time,json=blockTime,proto3" json:"block_time,omitempty"`  // Time of the block, set by the proposer.
+	FirstSeen int64           `protobuf:"varint,6,opt,name=first_seen,json=firstSeen,proto3" json:"first_seen,omitempty"`  // Unix time in milliseconds that the node first saw the block.
+	Latency   int64           `protobuf:"varint,7,opt,name=latency,proto3" json:"latency,omitempty"`                       // Time from the block time until the node first saw it, in milliseconds.
+	PeerId    []byte          `protobuf:"bytes,8,opt,name=peer_id,json=peerId,proto3" json:"peer_id,omitempty"`            // ID of the peer that delivered the block first.
+}
+
+func (x *PropagationRecord) Reset() {
+	*x = PropagationRecord{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_network_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PropagationRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PropagationRecord) ProtoMessage() {}
+
+func (x *PropagationRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_network_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PropagationRecord.ProtoReflect.Descriptor instead.
+func (*PropagationRecord) Descriptor() ([]byte, []int) {
+	return file_network_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *PropagationRecord) GetKind() PropagationKind {
+	if x != nil {
+		return x.Kind
+	}
+	return PropagationKind_PROPAGATION_BLOCK
+}
+
+func (x *PropagationRecord) GetHeight() uint32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *PropagationRecord) GetRound() int32 {
+	if x != nil {
+		return x.Round
+	}
+	return 0
+}
+
+func (x *PropagationRecord) GetBlockHash() []byte {
+	if x != nil {
+		return x.BlockHash
+	}
+	return nil
+}
+
+func (x *PropagationRecord) GetBlockTime() uint32 {
+	if x != nil {
+		return x.BlockTime
+	}
+	return 0
+}
+
+func (x *PropagationRecord) GetFirstSeen() int64 {
+	if x != nil {
+		return x.FirstSeen
+	}
+	return 0
+}
+
+func (x *PropagationRecord) GetLatency() int64 {
+	if x != nil {
+		return x.Latency
+	}
+	return 0
+}
+
+func (x *PropagationRecord) GetPeerId() []byte {
+	if x != nil {
+		return x.PeerId
+	}
+	return nil
+}
+
+// Request message for retrieving the evidences of penalizing the peers.
+type GetPeerEvidenceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PeerId []byte `protobuf:"bytes,1,opt,name=peer_id,json=peerId,proto3" json:"peer_id,omitempty"` // ID of the peer. Not set returns the evidences of all the peers.
+}
+
+func (x *GetPeerEvidenceRequest) Reset() {
+	*x = GetPeerEvidenceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_network_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPeerEvidenceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPeerEvidenceRequest) ProtoMessage() {}
+
+func (x *GetPeerEvidenceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_network_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPeerEvidenceRequest.ProtoReflect.Descriptor instead.
+func (*GetPeerEvidenceRequest) Descriptor() ([]byte, []int) {
+	return file_network_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetPeerEvidenceRequest) GetPeerId() []byte {
+	if x != nil {
+		return x.PeerId
+	}
+	return nil
+}
+
+// Response message containing the evidences of penalizing the peers.
+type GetPeerEvidenceResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Evidences []*PeerEvidence `protobuf:"bytes,1,rep,name=evidences,proto3" json:"evidences,omitempty"` // Evidences, from the oldest to the newest.
+}
+
+func (x *GetPeerEvidenceResponse) Reset() {
+	*x = GetPeerEvidenceResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_network_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPeerEvidenceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPeerEvidenceResponse) ProtoMessage() {}
+
+func (x *GetPeerEvidenceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_network_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPeerEvidenceResponse.ProtoReflect.Descriptor instead.
+func (*GetPeerEvidenceResponse) Descriptor() ([]byte, []int) {
+	return file_network_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetPeerEvidenceResponse) GetEvidences() []*PeerEvidence {
+	if x != nil {
+		return x.Evidences
+	}
+	return nil
+}
+
+// Evidence of penalizing a peer, with the offending data and the context.
+type PeerEvidence struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Time           int64  `protobuf:"varint,1,opt,name=time,proto3" json:"time,omitempty"`                                           // Unix time in milliseconds that the peer is penalized.
+	PeerId         []byte `protobuf:"bytes,2,opt,name=peer_id,json=peerId,proto3" json:"peer_id,omitempty"`                          // ID of the peer.
+	Address        string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`                                      // Network address of the peer.
+	Agent          string `protobuf:"bytes,4,opt,name=agent,proto3" json:"agent,omitempty"`                                          // Agent of the peer.
+	Reason         string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`                                        // Reason of penalizing, like `invalid_bundle`.
+	Error          string `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`                                          // Error of checking the offending data.
+	Height         uint32 `protobuf:"varint,7,opt,name=height,proto3" json:"height,omitempty"`                                       // Last block height of the node.
+	InvalidBundles int32  `protobuf:"varint,8,opt,name=invalid_bundles,json=invalidBundles,proto3" json:"invalid_bundles,omitempty"` // Number of the invalid bundles that the peer has sent.
+	Data           []byte `protobuf:"bytes,9,opt,name=data,proto3" json:"data,omitempty"`                                            // Offending data, truncated if it is too large.
+	DataSize       int32  `protobuf:"varint,10,opt,name=data_size,json=dataSize,proto3" json:"data_size,omitempty"`                  // Size of the offending data before truncating.
+}
+
+func (x *PeerEvidence) Reset() {
+	*x = PeerEvidence{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_network_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PeerEvidence) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PeerEvidence) ProtoMessage() {}
+
+func (x *PeerEvidence) ProtoReflect() protoreflect.Message {
+	mi := &file_network_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PeerEvidence.ProtoReflect.Descriptor instead.
+func (*PeerEvidence) Descriptor() ([]byte, []int) {
+	return file_network_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *PeerEvidence) GetTime() int64 {
+	if x != nil {
+		return x.Time
+	}
+	return 0
+}
+
+func (x *PeerEvidence) GetPeerId() []byte {
+	if x != nil {
+		return x.PeerId
+	}
+	return nil
+}
+
+func (x *PeerEvidence) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *PeerEvidence) GetAgent() string {
+	if x != nil {
+		return x.Agent
+	}
+	return ""
+}
+
+func (x *PeerEvidence) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *PeerEvidence) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *PeerEvidence) GetHeight() uint32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *PeerEvidence) GetInvalidBundles() int32 {
+	if x != nil {
+		return x.InvalidBundles
+	}
+	return 0
+}
+
+func (x *PeerEvidence) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *PeerEvidence) GetDataSize() int32 {
+	if x != nil {
+		return x.DataSize
+	}
+	return 0
+}
+
+// Request message for changing the log configuration of a logger module.
+type SetLogConfigRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Module   string       `protobuf:"bytes,1,opt,name=module,proto3" json:"module,omitempty"`     // Name of the logger module, like `_consensus`.
+	Level    string       `protobuf:"bytes,2,opt,name=level,proto3" json:"level,omitempty"`       // New log level. Empty keeps the current level.
+	Sampling *LogSampling `protobuf:"bytes,3,opt,name=sampling,proto3" json:"sampling,omitempty"` // New sampling of the debug and trace logs. Not set keeps the current sampling.
+}
+
+func (x *SetLogConfigRequest) Reset() {
+	*x = SetLogConfigRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_network_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetLogConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetLogConfigRequest) ProtoMessage() {}
+
+func (x *SetLogConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_network_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetLogConfigRequest.ProtoReflect.Descriptor instead.
+func (*SetLogConfigRequest) Descriptor() ([]byte, []int) {
+	return file_network_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *SetLogConfigRequest) GetModule() string {
+	if x != nil {
+		return x.Module
+	}
+	return ""
+}
+
+func (x *SetLogConfigRequest) GetLevel() string {
+	if x != nil {
+		return x.Level
+	}
+	return ""
+}
+
+func (x *SetLogConfigRequest) GetSampling() *LogSampling {
+	if x != nil {
+		return x.Sampling
+	}
+	return nil
+}
+
+// Response message containing the log configuration of the logger module.
+type SetLogConfigResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Module   string       `protobuf:"bytes,1,opt,name=module,proto3" json:"module,omitempty"`     // Name of the logger module.
+	Level    string       `protobuf:"bytes,2,opt,name=level,proto3" json:"level,omitempty"`       // Current log level.
+	Sampling *LogSampling `protobuf:"bytes,3,opt,name=sampling,proto3" json:"sampling,omitempty"` // Current sampling of the debug and trace logs.
+}
+
+func (x *SetLogConfigResponse) Reset() {
+	*x = SetLogConfigResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_network_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetLogConfigResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetLogConfigResponse) ProtoMessage() {}
+
+func (x *SetLogConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_network_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetLogConfigResponse.ProtoReflect.Descriptor instead.
+func (*SetLogConfigResponse) Descriptor() ([]byte, []int) {
+	return file_network_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *SetLogConfigResponse) GetModule() string {
+	if x != nil {
+		return x.Module
+	}
+	return ""
+}
+
+func (x *SetLogConfigResponse) GetLevel() string {
+	if x != nil {
+		return x.Level
+	}
+	return ""
+}
+
+func (x *SetLogConfigResponse) GetSampling() *LogSampling {
+	if x != nil {
+		return x.Sampling
+	}
+	return nil
+}
+
+// Sampling of the debug and trace logs. All zero disables the sampling.
+type LogSampling struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Every  uint32 `protobuf:"varint,1,opt,name=every,proto3" json:"every,omitempty"`   // Logs one in every N of the logs. Zero or one logs all of them.
+	Burst  uint32 `protobuf:"varint,2,opt,name=burst,proto3" json:"burst,omitempty"`   // Logs the first N of the logs in each period, before sampling the rest.
+	Period uint32 `protobuf:"varint,3,opt,name=period,proto3" json:"period,omitempty"` // Period of the burst in milliseconds.
+}
+
+func (x *LogSampling) Reset() {
+	*x = LogSampling{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_network_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LogSampling) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogSampling) ProtoMessage() {}
+
+func (x *LogSampling) ProtoReflect() protoreflect.Message {
+	mi := &file_network_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogSampling.ProtoReflect.Descriptor instead.
+func (*LogSampling) Descriptor() ([]byte, []int) {
+	return file_network_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *LogSampling) GetEvery() uint32 {
+	if x != nil {
+		return x.Every
+	}
+	return 0
+}
+
+func (x *LogSampling) GetBurst() uint32 {
+	if x != nil {
+		return x.Burst
+	}
+	return 0
+}
+
+func (x *LogSampling) GetPeriod() uint32 {
+	if x != nil {
+		return x.Period
+	}
+	return 0
+}
+
+// Result of a check in the startup self-test.
+type SelfTestResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name    string         `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                                 // Name of the check.
+	Status  SelfTestStatus `protobuf:"varint,2,opt,name=status,proto3,enum=pactus.SelfTestStatus" json:"status,omitempty"` // Outcome of the check.
+	Message string         `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`                           // Details of the outcome, with a hint to fix the issue.
+}
+
+func (x *SelfTestResult) Reset() {
+	*x = SelfTestResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_network_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SelfTestResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SelfTestResult) ProtoMessage() {}
+
+func (x *SelfTestResult) ProtoReflect() protoreflect.Message {
+	mi := &file_network_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SelfTestResult.ProtoReflect.Descriptor instead.
+func (*SelfTestResult) Descriptor() ([]byte, []int) {
+	return file_network_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *SelfTestResult) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SelfTestResult) GetStatus() SelfTestStatus {
+	if x != nil {
+		return x.Status
+	}
+	return SelfTestStatus_SELF_TEST_PASSED
+}
+
+func (x *SelfTestResult) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Health of a subsystem, based on its errors, panics and restarts in the recent minutes.
+type SubsystemHealth struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name     string       `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                               // Name of the subsystem.
+	Status   HealthStatus `protobuf:"varint,2,opt,name=status,proto3,enum=pactus.HealthStatus" json:"status,omitempty"` // Health status of the subsystem.
+	Reasons  []string     `protobuf:"bytes,3,rep,name=reasons,proto3" json:"reasons,omitempty"`                         // Reasons that the subsystem is not healthy, empty if it is healthy.
+	Errors   uint64       `protobuf:"varint,4,opt,name=errors,proto3" json:"errors,omitempty"`                          // Number of the errors since the node started.
+	Panics   uint64       `protobuf:"varint,5,opt,name=panics,proto3" json:"panics,omitempty"`                          // Number of the recovered panics since the node started.
+	Restarts uint64       `protobuf:"varint,6,opt,name=restarts,proto3" json:"restarts,omitempty"`                      // Number of the restarts after a panic since the node started.
+}
+
+func (x *SubsystemHealth) Reset() {
+	*x = SubsystemHealth{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_network_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubsystemHealth) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubsystemHealth) ProtoMessage() {}
+
+func (x *SubsystemHealth) ProtoReflect() protoreflect.Message {
+	mi := &file_network_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubsystemHealth.ProtoReflect.Descriptor instead.
+func (*SubsystemHealth) Descriptor() ([]byte, []int) {
+	return file_network_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *SubsystemHealth) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SubsystemHealth) GetStatus() HealthStatus {
+	if x != nil {
+		return x.Status
+	}
+	return HealthStatus_HEALTH_OK
+}
+
+func (x *SubsystemHealth) GetReasons() []string {
+	if x != nil {
+		return x.Reasons
+	}
+	return nil
+}
+
+func (x *SubsystemHealth) GetErrors() uint64 {
+	if x != nil {
+		return x.Errors
+	}
+	return 0
+}
+
+func (x *SubsystemHealth) GetPanics() uint64 {
+	if x != nil {
+		return x.Panics
+	}
+	return 0
+}
+
+func (x *SubsystemHealth) GetRestarts() uint64 {
+	if x != nil {
+		return x.Restarts
+	}
+	return 0
+}
+
+// Local estimation of the risk of being eclipsed, that is all the peers are controlled by an attacker.
+type EclipseRisk struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Score             int32            `protobuf:"varint,1,opt,name=score,proto3" json:"score,omitempty"`                                                     // Risk score, from 0 for diverse peers to 100 for no diversity.
+	Level             EclipseRiskLevel `protobuf:"varint,2,opt,name=level,proto3,enum=pactus.EclipseRiskLevel" json:"level,omitempty"`                        // Level of the risk score.
+	Peers             int32            `protobuf:"varint,3,opt,name=peers,proto3" json:"peers,omitempty"`                                                     // Number of the connected peers.
+	InboundPeers      int32            `protobuf:"varint,4,opt,name=inbound_peers,json=inboundPeers,proto3" json:"inbound_peers,omitempty"`                   // Number of the inbound peers.
+	OutboundPeers     int32            `protobuf:"varint,5,opt,name=outbound_peers,json=outboundPeers,proto3" json:"outbound_peers,omitempty"`                // Number of the outbound peers.
+	YoungPeers        int32            `protobuf:"varint,6,opt,name=young_peers,json=youngPeers,proto3" json:"young_peers,omitempty"`                         // Number of the peers connected in the last minutes.
+	NetGroups         int32            `protobuf:"varint,7,opt,name=net_groups,json=netGroups,proto3" json:"net_groups,omitempty"`                            // Number of the distinct network groups (ASN or IP prefix) of the peers.
+	OutboundNetGroups int32            `protobuf:"varint,8,opt,name=outbound_net_groups,json=outboundNetGroups,proto3" json:"outbound_net_groups,omitempty"`  // Number of the distinct network groups of the outbound peers.
+	LargestGroupShare float64          `protobuf:"fixed64,9,opt,name=largest_group_share,json=largestGroupShare,proto3" json:"largest_group_share,omitempty"` // Share of the peers in the largest network group.
+	Reasons           []string         `protobuf:"bytes,10,rep,name=reasons,proto3" json:"reasons,omitempty"`                                                 // Reasons that raise the risk score.
+}
+
+func (x *EclipseRisk) Reset() {
+	*x = EclipseRisk{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_network_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EclipseRisk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EclipseRisk) ProtoMessage() {}
+
+func (x *EclipseRisk) ProtoReflect() protoreflect.Message {
+	mi := &file_network_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EclipseRisk.ProtoReflect.Descriptor instead.
+func (*EclipseRisk) Descriptor() ([]byte, []int) {
+	return file_network_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *EclipseRisk) GetScore() int32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *EclipseRisk) GetLevel() EclipseRiskLevel {
+	if x != nil {
+		return x.Level
+	}
+	return EclipseRiskLevel_ECLIPSE_RISK_LOW
+}
+
+func (x *EclipseRisk) GetPeers() int32 {
+	if x != nil {
+		return x.Peers
+	}
+	return 0
+}
+
+func (x *EclipseRisk) GetInboundPeers() int32 {
+	if x != nil {
+		return x.InboundPeers
+	}
+	return 0
+}
+
+func (x *EclipseRisk) GetOutboundPeers() int32 {
+	if x != nil {
+		return x.OutboundPeers
+	}
+	return 0
+}
+
+func (x *EclipseRisk) GetYoungPeers() int32 {
+	if x != nil {
+		return x.YoungPeers
+	}
+	return 0
+}
+
+func (x *EclipseRisk) GetNetGroups() int32 {
+	if x != nil {
+		return x.NetGroups
+	}
+	return 0
+}
+
+func (x *EclipseRisk) GetOutboundNetGroups() int32 {
+	if x != nil {
+		return x.OutboundNetGroups
+	}
+	return 0
+}
+
+func (x *EclipseRisk) GetLargestGroupShare() float64 {
+	if x != nil {
+		return x.LargestGroupShare
+	}
+	return 0
+}
+
+func (x *EclipseRisk) GetReasons() []string {
+	if x != nil {
+		return x.Reasons
+	}
+	return nil
+}
+
+// Information about a peer in the network.
+type PeerInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status                 int32           `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`                                                                                                                              // Status of the peer.
+	Moniker                string          `protobuf:"bytes,2,opt,name=moniker,proto3" json:"moniker,omitempty"`                                                                                                                             // Moniker of the peer.
+	Agent                  string          `protobuf:"bytes,3,opt,name=agent,proto3" json:"agent,omitempty"`                                                                                                                                 // Agent information of the peer.
+	PeerId                 []byte          `protobuf:"bytes,4,opt,name=peer_id,json=peerId,proto3" json:"peer_id,omitempty"`                                                                                                                 // Peer ID of the peer.
+	ConsensusKeys          []string        `protobuf:"bytes,5,rep,name=consensus_keys,json=consensusKeys,proto3" json:"consensus_keys,omitempty"`                                                                                            // Consensus keys used by the peer.
+	ConsensusAddress       []string        `protobuf:"bytes,6,rep,name=consensus_address,json=consensusAddress,proto3" json:"consensus_address,omitempty"`                                                                                   // Consensus address of the peer.
+	Services               uint32          `protobuf:"varint,7,opt,name=services,proto3" json:"services,omitempty"`                                                                                                                          // Services provided by the peer.
+	LastBlockHash          []byte          `protobuf:"bytes,8,opt,name=last_block_hash,json=lastBlockHash,proto3" json:"last_block_hash,omitempty"`                                                                                          // Hash of the last block the peer knows.
+	Height                 uint32          `protobuf:"varint,9,opt,name=height,proto3" json:"height,omitempty"`                                                                                                                              // Height of the peer in the blockchain.
+	ReceivedMessages       int32           `protobuf:"varint,10,opt,name=received_messages,json=receivedMessages,proto3" json:"received_messages,omitempty"`                                                                                 // Count of received messages.
+	InvalidMessages        int32           `protobuf:"varint,11,opt,name=invalid_messages,json=invalidMessages,proto3" json:"invalid_messages,omitempty"`                                                                                    // Count of invalid messages received.
+	LastSent               int64           `protobuf:"varint,12,opt,name=last_sent,json=lastSent,proto3" json:"last_sent,omitempty"`                                                                                                         // Timestamp of the last sent message.
+	LastReceived           int64           `protobuf:"varint,13,opt,name=last_received,json=lastReceived,proto3" json:"last_received,omitempty"`                                                                                             // Timestamp of the last received message.
+	SentBytes              map[int32]int64 `protobuf:"bytes,14,rep,name=sent_bytes,json=sentBytes,proto3" json:"sent_bytes,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`             // Bytes sent per message type.
+	ReceivedBytes          map[int32]int64 `protobuf:"bytes,15,rep,name=received_bytes,json=receivedBytes,proto3" json:"received_bytes,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"` // Bytes received per message type.
+	Address                string          `protobuf:"bytes,16,opt,name=address,proto3" json:"address,omitempty"`                                                                                                                            // Network address of the peer.
+	Direction              string          `protobuf:"bytes,17,opt,name=direction,proto3" json:"direction,omitempty"`                                                                                                                        // Direction of connection with the peer.
+	Protocols              []string        `protobuf:"bytes,18,rep,name=protocols,proto3" json:"protocols,omitempty"`                                                                                                                        // List of protocols supported by the peer.
+	TotalSessions          int32           `protobuf:"varint,19,opt,name=total_sessions,json=totalSessions,proto3" json:"total_sessions,omitempty"`                                                                                          // Total sessions with the peer.
+	CompletedSessions      int32           `protobuf:"varint,20,opt,name=completed_sessions,json=completedSessions,proto3" json:"completed_sessions,omitempty"`                                                                              // Completed sessions with the peer.
+	ProtocolVersion        uint32          `protobuf:"varint,21,opt,name=protocol_version,json=protocolVersion,proto3" json:"protocol_version,omitempty"`                                                                                    // Negotiated version of the sync protocol.
+	Capabilities           uint32          `protobuf:"varint,22,opt,name=capabilities,proto3" json:"capabilities,omitempty"`                                                                                                                 // Wire capabilities advertised by the peer.
+	NegotiatedCapabilities uint32          `protobuf:"varint,23,opt,name=negotiated_capabilities,json=negotiatedCapabilities,proto3" json:"negotiated_capabilities,omitempty"`                                                               // Wire capabilities that both sides support.
+	RoundTripTime          int64           `protobuf:"varint,24,opt,name=round_trip_time,json=roundTripTime,proto3" json:"round_trip_time,omitempty"`                                                                                        // Round-trip time to the peer in milliseconds.
+	ClockOffset            int64           `protobuf:"varint,25,opt,name=clock_offset,json=clockOffset,proto3" json:"clock_offset,omitempty"`                                                                                                // Apparent clock offset of the peer in milliseconds.
+	ServedBytes            int64           `protobuf:"varint,26,opt,name=served_bytes,json=servedBytes,proto3" json:"served_bytes,omitempty"`                                                                                                // Bytes of the blocks and filters served to the peer.
+	ConsumedBytes          int64           `protobuf:"varint,27,opt,name=consumed_bytes,json=consumedBytes,proto3" json:"consumed_bytes,omitempty"`                                                                                          // Bytes of the blocks and filters served by the peer.
+	Attestation            []byte          `protobuf:"bytes,28,opt,name=attestation,proto3" json:"attestation,omitempty"`                                                                                                                    // Hardware attestation advertised by the peer, if any.
+	PausedValidators       []string        `protobuf:"bytes,29,rep,name=paused_validators,json=pausedValidators,proto3" json:"paused_validators,omitempty"`                                                                                  // Addresses of the validators that the peer advertises as paused, a hint only.
+}
+
+func (x *PeerInfo) Reset() {
+	*x = PeerInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_network_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PeerInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PeerInfo) ProtoMessage() {}
+
+func (x *PeerInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_network_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PeerInfo.ProtoReflect.Descriptor instead.
+func (*PeerInfo) Descriptor() ([]byte, []int) {
+	return file_network_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *PeerInfo) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *PeerInfo) GetMoniker() string {
+	if x != nil {
+		return x.Moniker
+	}
+	return ""
+}
+
+func (x *PeerInfo) GetAgent() string {
+	if x != nil {
+		return x.Agent
+	}
+	return ""
+}
+
+func (x *PeerInfo) GetPeerId() []byte {
+	if x != nil {
+		return x.PeerId
+	}
+	return nil
+}
+
+func (x *PeerInfo) GetConsensusKeys() []string {
+	if x != nil {
+		return x.ConsensusKeys
+	}
+	return nil
+}
+
+func (x *PeerInfo) GetConsensusAddress() []string {
+	if x != nil {
+		return x.ConsensusAddress
+	}
+	return nil
+}
+
+func (x *PeerInfo) GetServices() uint32 {
+	if x != nil {
+		return x.Services
+	}
+	return 0
+}
+
+func (x *PeerInfo) GetLastBlockHash() []byte {
+	if x != nil {
+		return x.LastBlockHash
+	}
+	return nil
+}
+
+func (x *PeerInfo) GetHeight() uint32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *PeerInfo) GetReceivedMessages() int32 {
+	if x != nil {
+		return x.ReceivedMessages
+	}
+	return 0
+}
+
+func (x *PeerInfo) GetInvalidMessages() int32 {
+	if x != nil {
+		return x.InvalidMessages
+	}
+	return 0
+}
+
+func (x *PeerInfo) GetLastSent() int64 {
+	if x != nil {
+		return x.LastSent
+	}
+	return 0
+}
+
+func (x *PeerInfo) GetLastReceived() int64 {
+	if x != nil {
+		return x.LastReceived
+	}
+	return 0
+}
+
+func (x *PeerInfo) GetSentBytes() map[int32]int64 {
+	if x != nil {
+		return x.SentBytes
+	}
+	return nil
+}
+
+func (x *PeerInfo) GetReceivedBytes() map[int32]int64 {
+	if x != nil {
+		return x.ReceivedBytes
+	}
+	return nil
+}
+
+func (x *PeerInfo) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *PeerInfo) GetDirection() string {
+	if x != nil {
+		return x.Direction
+	}
+	return ""
+}
+
+func (x *PeerInfo) GetProtocols() []string {
+	if x != nil {
+		return x.Protocols
+	}
+	return nil
+}
+
+func (x *PeerInfo) GetTotalSessions() int32 {
+	if x != nil {
+		return x.TotalSessions
+	}
+	return 0
+}
+
+func (x *PeerInfo) GetCompletedSessions() int32 {
+	if x != nil {
+		return x.CompletedSessions
+	}
+	return 0
+}
+
+func (x *PeerInfo) GetProtocolVersion() uint32 {
+	if x != nil {
+		return x.ProtocolVersion
+	}
+	return 0
+}
+
+func (x *PeerInfo) GetCapabilities() uint32 {
+	if x != nil {
+		return x.Capabilities
+	}
+	return 0
+}
+
+func (x *PeerInfo) GetNegotiatedCapabilities() uint32 {
+	if x != nil {
+		return x.NegotiatedCapabilities
+	}
+	return 0
+}
+
+func (x *PeerInfo) GetRoundTripTime() int64 {
+	if x != nil {
+		return x.RoundTripTime
+	}
+	return 0
+}
+
+func (x *PeerInfo) GetClockOffset() int64 {
+	if x != nil {
+		return x.ClockOffset
+	}
+	return 0
+}
+
+func (x *PeerInfo) GetServedBytes() int64 {
+	if x != nil {
+		return x.ServedBytes
+	}
+	return 0
+}
+
+func (x *PeerInfo) GetConsumedBytes() int64 {
+	if x != nil {
+		return x.ConsumedBytes
+	}
+	return 0
+}
+
+func (x *PeerInfo) GetAttestation() []byte {
+	if x != nil {
+		return x.Attestation
+	}
+	return nil
+}
+
+func (x *PeerInfo) GetPausedValidators() []string {
+	if x != nil {
+		return x.PausedValidators
+	}
+	return nil
+}
+
+// Number of the known peers that run the same version of the node.
+type PeerVersion struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Version         string `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`                                         // Version of the node.
+	Commit          string `protobuf:"bytes,2,opt,name=commit,proto3" json:"commit,omitempty"`                                           // Git commit of the node, if advertised.
+	ProtocolVersion uint32 `protobuf:"varint,3,opt,name=protocol_version,json=protocolVersion,proto3" json:"protocol_version,omitempty"` // Version of the sync protocol.
+	Count           int32  `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`                                            // Number of the peers.
+}
+
+func (x *PeerVersion) Reset() {
+	*x = PeerVersion{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_network_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PeerVersion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PeerVersion) ProtoMessage() {}
+
+func (x *PeerVersion) ProtoReflect() protoreflect.Message {
+	mi := &file_network_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PeerVersion.ProtoReflect.Descriptor instead.
+func (*PeerVersion) Descriptor() ([]byte, []int) {
+	return file_network_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *PeerVersion) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *PeerVersion) GetCommit() string {
+	if x != nil {
+		return x.Commit
+	}
+	return ""
+}
+
+func (x *PeerVersion) GetProtocolVersion() uint32 {
+	if x != nil {
+		return x.ProtocolVersion
+	}
+	return 0
+}
+
+func (x *PeerVersion) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+var File_network_proto protoreflect.FileDescriptor
+
+var file_network_proto_rawDesc = []byte{
+	0x0a, 0x0d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
+	0x06, 0x70, 0x61, 0x63, 0x74, 0x75, 0x73, 0x22, 0x17, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x4e, 0x65,
+	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x22, 0xe8, 0x04, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49,
+	0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x6e,
+	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x28,
+	0x0a, 0x10, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x73, 0x65, 0x6e, 0x74, 0x5f, 0x62, 0x79, 0x74,
 	0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x53,
 	0x65, 0x6e, 0x74, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x30, 0x0a, 0x14, 0x74, 0x6f, 0x74, 0x61,
 	0x6c, 0x5f, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73,
@@ -539,104 +2243,374 @@ var file_network_proto_rawDesc = []byte{
 	0x6f, 0x72, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e,
 	0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x42, 0x79, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74,
 	0x72, 0x79, 0x52, 0x0d, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x42, 0x79, 0x74, 0x65,
-	0x73, 0x1a, 0x3c, 0x0a, 0x0e, 0x53, 0x65, 0x6e, 0x74, 0x42, 0x79, 0x74, 0x65, 0x73, 0x45, 0x6e,
-	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
+	0x73, 0x12, 0x38, 0x0a, 0x0d, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x61, 0x63, 0x74, 0x75,
+	0x73, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x70,
+	0x65, 0x65, 0x72, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x3c, 0x0a, 0x0e, 0x53,
+	0x65, 0x6e, 0x74, 0x42, 0x79, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x40, 0x0a, 0x12, 0x52, 0x65, 0x63,
+	0x65, 0x69, 0x76, 0x65, 0x64, 0x42, 0x79, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x14, 0x0a, 0x12, 0x47,
+	0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x22, 0xb3, 0x06, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x6e, 0x66,
+	0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x6f, 0x6e,
+	0x69, 0x6b, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x6f, 0x6e, 0x69,
+	0x6b, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x65, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x70, 0x65, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65, 0x61, 0x63, 0x68, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74,
+	0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x61, 0x63, 0x68, 0x61, 0x62,
+	0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x05, 0x52, 0x08, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x73, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x64, 0x64, 0x72,
+	0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x61, 0x64, 0x64, 0x72, 0x73, 0x12, 0x1c,
+	0x0a, 0x09, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x73, 0x12, 0x33, 0x0a, 0x09,
+	0x73, 0x65, 0x6c, 0x66, 0x5f, 0x74, 0x65, 0x73, 0x74, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x16, 0x2e, 0x70, 0x61, 0x63, 0x74, 0x75, 0x73, 0x2e, 0x53, 0x65, 0x6c, 0x66, 0x54, 0x65, 0x73,
+	0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x08, 0x73, 0x65, 0x6c, 0x66, 0x54, 0x65, 0x73,
+	0x74, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x61, 0x66, 0x65, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x73, 0x61, 0x66, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x29,
+	0x0a, 0x10, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63,
+	0x6f, 0x6c, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x61, 0x70,
+	0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0c, 0x63, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x2d, 0x0a,
+	0x12, 0x63, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x73, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x09, 0x52, 0x11, 0x63, 0x61, 0x70, 0x61, 0x62,
+	0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x20, 0x0a, 0x0b,
+	0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0f, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x0b, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18,
+	0x0a, 0x07, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x18, 0x10, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x07, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x69, 0x6d,
+	0x61, 0x72, 0x69, 0x65, 0x73, 0x18, 0x11, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x09, 0x70, 0x72, 0x69,
+	0x6d, 0x61, 0x72, 0x69, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
+	0x18, 0x12, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x2c,
+	0x0a, 0x06, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14,
+	0x2e, 0x70, 0x61, 0x63, 0x74, 0x75, 0x73, 0x2e, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x12, 0x44, 0x0a, 0x11,
+	0x73, 0x75, 0x62, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x73, 0x5f, 0x68, 0x65, 0x61, 0x6c, 0x74,
+	0x68, 0x18, 0x14, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x61, 0x63, 0x74, 0x75, 0x73,
+	0x2e, 0x53, 0x75, 0x62, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68,
+	0x52, 0x10, 0x73, 0x75, 0x62, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x73, 0x48, 0x65, 0x61, 0x6c,
+	0x74, 0x68, 0x12, 0x36, 0x0a, 0x0c, 0x65, 0x63, 0x6c, 0x69, 0x70, 0x73, 0x65, 0x5f, 0x72, 0x69,
+	0x73, 0x6b, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x61, 0x63, 0x74, 0x75,
+	0x73, 0x2e, 0x45, 0x63, 0x6c, 0x69, 0x70, 0x73, 0x65, 0x52, 0x69, 0x73, 0x6b, 0x52, 0x0b, 0x65,
+	0x63, 0x6c, 0x69, 0x70, 0x73, 0x65, 0x52, 0x69, 0x73, 0x6b, 0x12, 0x2b, 0x0a, 0x11, 0x70, 0x61,
+	0x75, 0x73, 0x65, 0x64, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x18,
+	0x16, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x56, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x22, 0x2c, 0x0a, 0x12, 0x53, 0x65, 0x74, 0x53, 0x61,
+	0x66, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x65,
+	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x22, 0x32, 0x0a, 0x13, 0x53, 0x65, 0x74, 0x53, 0x61, 0x66, 0x65,
+	0x4d, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x09,
+	0x73, 0x61, 0x66, 0x65, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x08, 0x73, 0x61, 0x66, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x22, 0x35, 0x0a, 0x16, 0x47, 0x65, 0x74,
+	0x44, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6f, 0x6e, 0x6c, 0x79, 0x5f, 0x75, 0x73, 0x65, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x6f, 0x6e, 0x6c, 0x79, 0x55, 0x73, 0x65, 0x64,
+	0x22, 0x56, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x44, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x0c, 0x64,
+	0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x17, 0x2e, 0x70, 0x61, 0x63, 0x74, 0x75, 0x73, 0x2e, 0x44, 0x65, 0x70, 0x72, 0x65,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0c, 0x64, 0x65, 0x70, 0x72,
+	0x65, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x8e, 0x01, 0x0a, 0x0f, 0x44, 0x65, 0x70,
+	0x72, 0x65, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x12, 0x0a, 0x04,
+	0x6b, 0x69, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x70, 0x6c, 0x61,
+	0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x12, 0x1b, 0x0a, 0x09,
+	0x75, 0x73, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x08, 0x75, 0x73, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x45, 0x0a, 0x1a, 0x47, 0x65, 0x74,
+	0x50, 0x72, 0x6f, 0x70, 0x61, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x6e, 0x63, 0x6c, 0x75,
+	0x64, 0x65, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0e, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73,
+	0x22, 0xbc, 0x01, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x61, 0x67, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x30, 0x0a, 0x06, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x18, 0x2e, 0x70, 0x61, 0x63, 0x74, 0x75, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x61, 0x67,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x06, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x73, 0x12, 0x36, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x61, 0x63, 0x74, 0x75, 0x73, 0x2e, 0x50,
+	0x72, 0x6f, 0x70, 0x61, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52,
+	0x09, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x12, 0x33, 0x0a, 0x07, 0x72, 0x65,
+	0x63, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x61,
+	0x63, 0x74, 0x75, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x61, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x07, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x22,
+	0xb2, 0x01, 0x0a, 0x10, 0x50, 0x72, 0x6f, 0x70, 0x61, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53,
+	0x74, 0x61, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x69,
+	0x6e, 0x5f, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0a, 0x6d, 0x69, 0x6e, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x25, 0x0a, 0x0e, 0x6d,
+	0x65, 0x64, 0x69, 0x61, 0x6e, 0x5f, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0d, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x6e, 0x4c, 0x61, 0x74, 0x65, 0x6e,
+	0x63, 0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x39, 0x30, 0x5f, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63,
+	0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x70, 0x39, 0x30, 0x4c, 0x61, 0x74, 0x65,
+	0x6e, 0x63, 0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x5f, 0x6c, 0x61, 0x74, 0x65, 0x6e,
+	0x63, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x4c, 0x61, 0x74,
+	0x65, 0x6e, 0x63, 0x79, 0x22, 0xfe, 0x01, 0x0a, 0x11, 0x50, 0x72, 0x6f, 0x70, 0x61, 0x67, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x2b, 0x0a, 0x04, 0x6b, 0x69,
+	0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x70, 0x61, 0x63, 0x74, 0x75,
+	0x73, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x61, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4b, 0x69, 0x6e,
+	0x64, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12,
+	0x14, 0x0a, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05,
+	0x72, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68,
+	0x61, 0x73, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x48, 0x61, 0x73, 0x68, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x54,
+	0x69, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x73, 0x65, 0x65,
+	0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x66, 0x69, 0x72, 0x73, 0x74, 0x53, 0x65,
+	0x65, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x07, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x17, 0x0a, 0x07,
+	0x70, 0x65, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x70,
+	0x65, 0x65, 0x72, 0x49, 0x64, 0x22, 0x31, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x50, 0x65, 0x65, 0x72,
+	0x45, 0x76, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x17, 0x0a, 0x07, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x06, 0x70, 0x65, 0x65, 0x72, 0x49, 0x64, 0x22, 0x4d, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x50,
+	0x65, 0x65, 0x72, 0x45, 0x76, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x09, 0x65, 0x76, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x61, 0x63, 0x74, 0x75, 0x73, 0x2e,
+	0x50, 0x65, 0x65, 0x72, 0x45, 0x76, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x09, 0x65, 0x76,
+	0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x22, 0x8b, 0x02, 0x0a, 0x0c, 0x50, 0x65, 0x65, 0x72,
+	0x45, 0x76, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x17, 0x0a, 0x07,
+	0x70, 0x65, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x70,
+	0x65, 0x65, 0x72, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
+	0x14, 0x0a, 0x05, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x61, 0x67, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x14, 0x0a,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x69,
+	0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x5f, 0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x73, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x42, 0x75, 0x6e,
+	0x64, 0x6c, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x61, 0x74, 0x61,
+	0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x64, 0x61, 0x74,
+	0x61, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x74, 0x0a, 0x13, 0x53, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06,
+	0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x6f,
+	0x64, 0x75, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x2f, 0x0a, 0x08, 0x73, 0x61,
+	0x6d, 0x70, 0x6c, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70,
+	0x61, 0x63, 0x74, 0x75, 0x73, 0x2e, 0x4c, 0x6f, 0x67, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x69, 0x6e,
+	0x67, 0x52, 0x08, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x69, 0x6e, 0x67, 0x22, 0x75, 0x0a, 0x14, 0x53,
+	0x65, 0x74, 0x4c, 0x6f, 0x67, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c,
+	0x65, 0x76, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65,
+	0x6c, 0x12, 0x2f, 0x0a, 0x08, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x61, 0x63, 0x74, 0x75, 0x73, 0x2e, 0x4c, 0x6f, 0x67,
+	0x53, 0x61, 0x6d, 0x70, 0x6c, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x69,
+	0x6e, 0x67, 0x22, 0x51, 0x0a, 0x0b, 0x4c, 0x6f, 0x67, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x69, 0x6e,
+	0x67, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x05, 0x65, 0x76, 0x65, 0x72, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x75, 0x72, 0x73, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x62, 0x75, 0x72, 0x73, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x70,
+	0x65, 0x72, 0x69, 0x6f, 0x64, 0x22, 0x6e, 0x0a, 0x0e, 0x53, 0x65, 0x6c, 0x66, 0x54, 0x65, 0x73,
+	0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2e, 0x0a, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x70, 0x61,
+	0x63, 0x74, 0x75, 0x73, 0x2e, 0x53, 0x65, 0x6c, 0x66, 0x54, 0x65, 0x73, 0x74, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xb9, 0x01, 0x0a, 0x0f, 0x53, 0x75, 0x62, 0x73, 0x79, 0x73,
+	0x74, 0x65, 0x6d, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2c, 0x0a,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e,
+	0x70, 0x61, 0x63, 0x74, 0x75, 0x73, 0x2e, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x12, 0x16, 0x0a,
+	0x06, 0x70, 0x61, 0x6e, 0x69, 0x63, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x70,
+	0x61, 0x6e, 0x69, 0x63, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x72, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x73, 0x22, 0xef, 0x02, 0x0a, 0x0b, 0x45, 0x63, 0x6c, 0x69, 0x70, 0x73, 0x65, 0x52, 0x69, 0x73,
+	0x6b, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x2e, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x70, 0x61, 0x63, 0x74, 0x75, 0x73, 0x2e,
+	0x45, 0x63, 0x6c, 0x69, 0x70, 0x73, 0x65, 0x52, 0x69, 0x73, 0x6b, 0x4c, 0x65, 0x76, 0x65, 0x6c,
+	0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x65, 0x65, 0x72, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x70, 0x65, 0x65, 0x72, 0x73, 0x12, 0x23, 0x0a,
+	0x0d, 0x69, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x70, 0x65, 0x65, 0x72, 0x73, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x69, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x50, 0x65, 0x65,
+	0x72, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x6f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x70,
+	0x65, 0x65, 0x72, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x6f, 0x75, 0x74, 0x62,
+	0x6f, 0x75, 0x6e, 0x64, 0x50, 0x65, 0x65, 0x72, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x79, 0x6f, 0x75,
+	0x6e, 0x67, 0x5f, 0x70, 0x65, 0x65, 0x72, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a,
+	0x79, 0x6f, 0x75, 0x6e, 0x67, 0x50, 0x65, 0x65, 0x72, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x65,
+	0x74, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09,
+	0x6e, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x6f, 0x75, 0x74,
+	0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x6e, 0x65, 0x74, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x11, 0x6f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64,
+	0x4e, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x6c, 0x61, 0x72,
+	0x67, 0x65, 0x73, 0x74, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x73, 0x68, 0x61, 0x72, 0x65,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x01, 0x52, 0x11, 0x6c, 0x61, 0x72, 0x67, 0x65, 0x73, 0x74, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x53, 0x68, 0x61, 0x72, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x73, 0x22, 0xd9, 0x09, 0x0a, 0x08, 0x50, 0x65, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f,
+	0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x6f, 0x6e, 0x69,
+	0x6b, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x6f, 0x6e, 0x69, 0x6b,
+	0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x65, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x70, 0x65, 0x65, 0x72, 0x49,
+	0x64, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x5f, 0x6b,
+	0x65, 0x79, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x73, 0x65,
+	0x6e, 0x73, 0x75, 0x73, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x63, 0x6f, 0x6e, 0x73,
+	0x65, 0x6e, 0x73, 0x75, 0x73, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x06, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x10, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f,
+	0x68, 0x61, 0x73, 0x68, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x6c, 0x61, 0x73, 0x74,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x12, 0x2b, 0x0a, 0x11, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x72, 0x65,
+	0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x29,
+	0x0a, 0x10, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x61, 0x73,
+	0x74, 0x5f, 0x73, 0x65, 0x6e, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6c, 0x61,
+	0x73, 0x74, 0x53, 0x65, 0x6e, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x72,
+	0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x6c,
+	0x61, 0x73, 0x74, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x12, 0x3e, 0x0a, 0x0a, 0x73,
+	0x65, 0x6e, 0x74, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1f, 0x2e, 0x70, 0x61, 0x63, 0x74, 0x75, 0x73, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x49, 0x6e, 0x66,
+	0x6f, 0x2e, 0x53, 0x65, 0x6e, 0x74, 0x42, 0x79, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x09, 0x73, 0x65, 0x6e, 0x74, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x4a, 0x0a, 0x0e, 0x72,
+	0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x0f, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x70, 0x61, 0x63, 0x74, 0x75, 0x73, 0x2e, 0x50, 0x65, 0x65,
+	0x72, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x42, 0x79,
+	0x74, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0d, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76,
+	0x65, 0x64, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x11,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x73, 0x18, 0x12, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x73, 0x12, 0x25, 0x0a,
+	0x0e, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x13, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2d, 0x0a, 0x12, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65,
+	0x64, 0x5f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x14, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x11, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x53, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x5f,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x22,
+	0x0a, 0x0c, 0x63, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x16,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x63, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69,
+	0x65, 0x73, 0x12, 0x37, 0x0a, 0x17, 0x6e, 0x65, 0x67, 0x6f, 0x74, 0x69, 0x61, 0x74, 0x65, 0x64,
+	0x5f, 0x63, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x17, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x16, 0x6e, 0x65, 0x67, 0x6f, 0x74, 0x69, 0x61, 0x74, 0x65, 0x64, 0x43,
+	0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x72,
+	0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x74, 0x72, 0x69, 0x70, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x18,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x54, 0x72, 0x69, 0x70, 0x54,
+	0x69, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6f, 0x66, 0x66,
+	0x73, 0x65, 0x74, 0x18, 0x19, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x63, 0x6c, 0x6f, 0x63, 0x6b,
+	0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64,
+	0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x64, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x6e,
+	0x73, 0x75, 0x6d, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x1b, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x64, 0x42, 0x79, 0x74, 0x65, 0x73,
+	0x12, 0x20, 0x0a, 0x0b, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x1c, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x2b, 0x0a, 0x11, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x5f, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x1d, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x70,
+	0x61, 0x75, 0x73, 0x65, 0x64, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x1a,
+	0x3c, 0x0a, 0x0e, 0x53, 0x65, 0x6e, 0x74, 0x42, 0x79, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x40, 0x0a,
+	0x12, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x42, 0x79, 0x74, 0x65, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
 	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a,
-	0x40, 0x0a, 0x12, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x42, 0x79, 0x74, 0x65, 0x73,
-	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
-	0x01, 0x22, 0x14, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x6e, 0x66, 0x6f,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x98, 0x02, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x4e,
-	0x6f, 0x64, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x18, 0x0a, 0x07, 0x6d, 0x6f, 0x6e, 0x69, 0x6b, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x6d, 0x6f, 0x6e, 0x69, 0x6b, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x67, 0x65,
-	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x12,
-	0x17, 0x0a, 0x07, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x06, 0x70, 0x65, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72,
-	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x73, 0x74,
-	0x61, 0x72, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65, 0x61, 0x63, 0x68,
-	0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72,
-	0x65, 0x61, 0x63, 0x68, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x73,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x05, 0x52, 0x08, 0x73,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x73, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52,
-	0x0d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x14,
-	0x0a, 0x05, 0x61, 0x64, 0x64, 0x72, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x61,
-	0x64, 0x64, 0x72, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c,
-	0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
-	0x6c, 0x73, 0x22, 0xed, 0x06, 0x0a, 0x08, 0x50, 0x65, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12,
-	0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x6f, 0x6e, 0x69, 0x6b,
-	0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x6f, 0x6e, 0x69, 0x6b, 0x65,
-	0x72, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x65, 0x65, 0x72, 0x5f,
-	0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x70, 0x65, 0x65, 0x72, 0x49, 0x64,
-	0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x5f, 0x6b, 0x65,
-	0x79, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e,
-	0x73, 0x75, 0x73, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x63, 0x6f, 0x6e, 0x73, 0x65,
-	0x6e, 0x73, 0x75, 0x73, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x06, 0x20, 0x03,
-	0x28, 0x09, 0x52, 0x10, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x41, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73,
-	0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73,
-	0x12, 0x26, 0x0a, 0x0f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68,
-	0x61, 0x73, 0x68, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x42,
-	0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67,
-	0x68, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74,
-	0x12, 0x2b, 0x0a, 0x11, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x6d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x72, 0x65, 0x63,
-	0x65, 0x69, 0x76, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x29, 0x0a,
-	0x10, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64,
-	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x61, 0x73, 0x74,
-	0x5f, 0x73, 0x65, 0x6e, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6c, 0x61, 0x73,
-	0x74, 0x53, 0x65, 0x6e, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x72, 0x65,
-	0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x6c, 0x61,
-	0x73, 0x74, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x12, 0x3e, 0x0a, 0x0a, 0x73, 0x65,
-	0x6e, 0x74, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f,
-	0x2e, 0x70, 0x61, 0x63, 0x74, 0x75, 0x73, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f,
-	0x2e, 0x53, 0x65, 0x6e, 0x74, 0x42, 0x79, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
-	0x09, 0x73, 0x65, 0x6e, 0x74, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x4a, 0x0a, 0x0e, 0x72, 0x65,
-	0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x0f, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x23, 0x2e, 0x70, 0x61, 0x63, 0x74, 0x75, 0x73, 0x2e, 0x50, 0x65, 0x65, 0x72,
-	0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x42, 0x79, 0x74,
-	0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0d, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65,
-	0x64, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x12, 0x1c, 0x0a, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x11, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c,
-	0x0a, 0x09, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x73, 0x18, 0x12, 0x20, 0x03, 0x28,
-	0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x73, 0x12, 0x25, 0x0a, 0x0e,
-	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x13,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x65, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x73, 0x12, 0x2d, 0x0a, 0x12, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64,
-	0x5f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x14, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x11, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f,
-	0x6e, 0x73, 0x1a, 0x3c, 0x0a, 0x0e, 0x53, 0x65, 0x6e, 0x74, 0x42, 0x79, 0x74, 0x65, 0x73, 0x45,
-	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
-	0x1a, 0x40, 0x0a, 0x12, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x42, 0x79, 0x74, 0x65,
-	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
-	0x38, 0x01, 0x32, 0xa2, 0x01, 0x0a, 0x07, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x4f,
-	0x0a, 0x0e, 0x47, 0x65, 0x74, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x6e, 0x66, 0x6f,
-	0x12, 0x1d, 0x2e, 0x70, 0x61, 0x63, 0x74, 0x75, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x65, 0x74,
-	0x77, 0x6f, 0x72, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x1e, 0x2e, 0x70, 0x61, 0x63, 0x74, 0x75, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x65, 0x74, 0x77,
-	0x6f, 0x72, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x46, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1a,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22,
+	0x80, 0x01, 0x0a, 0x0b, 0x50, 0x65, 0x65, 0x72, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x12, 0x29, 0x0a, 0x10, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x5f, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x2a, 0x42, 0x0a, 0x0f, 0x50, 0x72, 0x6f, 0x70, 0x61, 0x67, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x15, 0x0a, 0x11, 0x50, 0x52, 0x4f, 0x50, 0x41, 0x47, 0x41,
+	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x42, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x00, 0x12, 0x18, 0x0a, 0x14,
+	0x50, 0x52, 0x4f, 0x50, 0x41, 0x47, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x50, 0x52, 0x4f, 0x50,
+	0x4f, 0x53, 0x41, 0x4c, 0x10, 0x01, 0x2a, 0x46, 0x0a, 0x0c, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x0d, 0x0a, 0x09, 0x48, 0x45, 0x41, 0x4c, 0x54, 0x48,
+	0x5f, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x48, 0x45, 0x41, 0x4c, 0x54, 0x48, 0x5f,
+	0x44, 0x45, 0x47, 0x52, 0x41, 0x44, 0x45, 0x44, 0x10, 0x01, 0x12, 0x12, 0x0a, 0x0e, 0x48, 0x45,
+	0x41, 0x4c, 0x54, 0x48, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x2a, 0x5a,
+	0x0a, 0x10, 0x45, 0x63, 0x6c, 0x69, 0x70, 0x73, 0x65, 0x52, 0x69, 0x73, 0x6b, 0x4c, 0x65, 0x76,
+	0x65, 0x6c, 0x12, 0x14, 0x0a, 0x10, 0x45, 0x43, 0x4c, 0x49, 0x50, 0x53, 0x45, 0x5f, 0x52, 0x49,
+	0x53, 0x4b, 0x5f, 0x4c, 0x4f, 0x57, 0x10, 0x00, 0x12, 0x19, 0x0a, 0x15, 0x45, 0x43, 0x4c, 0x49,
+	0x50, 0x53, 0x45, 0x5f, 0x52, 0x49, 0x53, 0x4b, 0x5f, 0x45, 0x4c, 0x45, 0x56, 0x41, 0x54, 0x45,
+	0x44, 0x10, 0x01, 0x12, 0x15, 0x0a, 0x11, 0x45, 0x43, 0x4c, 0x49, 0x50, 0x53, 0x45, 0x5f, 0x52,
+	0x49, 0x53, 0x4b, 0x5f, 0x48, 0x49, 0x47, 0x48, 0x10, 0x02, 0x2a, 0x6a, 0x0a, 0x0e, 0x53, 0x65,
+	0x6c, 0x66, 0x54, 0x65, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x10,
+	0x53, 0x45, 0x4c, 0x46, 0x5f, 0x54, 0x45, 0x53, 0x54, 0x5f, 0x50, 0x41, 0x53, 0x53, 0x45, 0x44,
+	0x10, 0x00, 0x12, 0x15, 0x0a, 0x11, 0x53, 0x45, 0x4c, 0x46, 0x5f, 0x54, 0x45, 0x53, 0x54, 0x5f,
+	0x57, 0x41, 0x52, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x14, 0x0a, 0x10, 0x53, 0x45, 0x4c,
+	0x46, 0x5f, 0x54, 0x45, 0x53, 0x54, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x02, 0x12,
+	0x15, 0x0a, 0x11, 0x53, 0x45, 0x4c, 0x46, 0x5f, 0x54, 0x45, 0x53, 0x54, 0x5f, 0x53, 0x4b, 0x49,
+	0x50, 0x50, 0x45, 0x44, 0x10, 0x03, 0x32, 0xbd, 0x04, 0x0a, 0x07, 0x4e, 0x65, 0x74, 0x77, 0x6f,
+	0x72, 0x6b, 0x12, 0x4f, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
+	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1d, 0x2e, 0x70, 0x61, 0x63, 0x74, 0x75, 0x73, 0x2e, 0x47, 0x65,
+	0x74, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x70, 0x61, 0x63, 0x74, 0x75, 0x73, 0x2e, 0x47, 0x65, 0x74,
+	0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x46, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x6e,
+	0x66, 0x6f, 0x12, 0x1a, 0x2e, 0x70, 0x61, 0x63, 0x74, 0x75, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x4e,
+	0x6f, 0x64, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b,
 	0x2e, 0x70, 0x61, 0x63, 0x74, 0x75, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x49,
-	0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x70, 0x61, 0x63,
-	0x74, 0x75, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x42, 0x0a, 0x0e, 0x70, 0x61, 0x63, 0x74, 0x75,
-	0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5a, 0x30, 0x67, 0x69, 0x74, 0x68, 0x75,
-	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x61, 0x63, 0x74, 0x75, 0x73, 0x2d, 0x70, 0x72, 0x6f,
-	0x6a, 0x65, 0x63, 0x74, 0x2f, 0x70, 0x61, 0x63, 0x74, 0x75, 0x73, 0x2f, 0x77, 0x77, 0x77, 0x2f,
-	0x67, 0x72, 0x70, 0x63, 0x2f, 0x70, 0x61, 0x63, 0x74, 0x75, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
+	0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x46, 0x0a, 0x0b, 0x53,
+	0x65, 0x74, 0x53, 0x61, 0x66, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x1a, 0x2e, 0x70, 0x61, 0x63,
+	0x74, 0x75, 0x73, 0x2e, 0x53, 0x65, 0x74, 0x53, 0x61, 0x66, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x70, 0x61, 0x63, 0x74, 0x75, 0x73, 0x2e,
+	0x53, 0x65, 0x74, 0x53, 0x61, 0x66, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x52, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x44, 0x65, 0x70, 0x72, 0x65, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1e, 0x2e, 0x70, 0x61, 0x63, 0x74, 0x75, 0x73, 0x2e,
+	0x47, 0x65, 0x74, 0x44, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x70, 0x61, 0x63, 0x74, 0x75, 0x73, 0x2e,
+	0x47, 0x65, 0x74, 0x44, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5e, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x50, 0x72,
+	0x6f, 0x70, 0x61, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x22,
+	0x2e, 0x70, 0x61, 0x63, 0x74, 0x75, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x61,
+	0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x23, 0x2e, 0x70, 0x61, 0x63, 0x74, 0x75, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x50,
+	0x72, 0x6f, 0x70, 0x61, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x49, 0x0a, 0x0c, 0x53, 0x65, 0x74, 0x4c, 0x6f,
+	0x67, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1b, 0x2e, 0x70, 0x61, 0x63, 0x74, 0x75, 0x73,
+	0x2e, 0x53, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x70, 0x61, 0x63, 0x74, 0x75, 0x73, 0x2e, 0x53, 0x65,
+	0x74, 0x4c, 0x6f, 0x67, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x52, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x50, 0x65, 0x65, 0x72, 0x45, 0x76, 0x69,
+	0x64, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x1e, 0x2e, 0x70, 0x61, 0x63, 0x74, 0x75, 0x73, 0x2e, 0x47,
+	0x65, 0x74, 0x50, 0x65, 0x65, 0x72, 0x45, 0x76, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x70, 0x61, 0x63, 0x74, 0x75, 0x73, 0x2e, 0x47,
+	0x65, 0x74, 0x50, 0x65, 0x65, 0x72, 0x45, 0x76, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x42, 0x0a, 0x0e, 0x70, 0x61, 0x63, 0x74, 0x75, 0x73,
+	0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5a, 0x30, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x61, 0x63, 0x74, 0x75, 0x73, 0x2d, 0x70, 0x72, 0x6f, 0x6a,
+	0x65, 0x63, 0x74, 0x2f, 0x70, 0x61, 0x63, 0x74, 0x75, 0x73, 0x2f, 0x77, 0x77, 0x77, 0x2f, 0x67,
+	0x72, 0x70, 0x63, 0x2f, 0x70, 0x61, 0x63, 0x74, 0x75, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
 }
 
 var (
@@ -651,33 +2625,83 @@ func file_network_proto_rawDescGZIP() []byte {
 	return file_network_proto_rawDescData
 }
 
-var file_network_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_network_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_network_proto_msgTypes = make([]protoimpl.MessageInfo, 28)
 var file_network_proto_goTypes = []interface{}{
-	(*GetNetworkInfoRequest)(nil),  // 0: pactus.GetNetworkInfoRequest
-	(*GetNetworkInfoResponse)(nil), // 1: pactus.GetNetworkInfoResponse
-	(*GetNodeInfoRequest)(nil),     // 2: pactus.GetNodeInfoRequest
-	(*GetNodeInfoResponse)(nil),    // 3: pactus.GetNodeInfoResponse
-	(*PeerInfo)(nil),               // 4: pactus.PeerInfo
-	nil,                            // 5: pactus.GetNetworkInfoResponse.SentBytesEntry
-	nil,                            // 6: pactus.GetNetworkInfoResponse.ReceivedBytesEntry
-	nil,                            // 7: pactus.PeerInfo.SentBytesEntry
-	nil,                            // 8: pactus.PeerInfo.ReceivedBytesEntry
+	(PropagationKind)(0),                // 0: pactus.PropagationKind
+	(HealthStatus)(0),                   // 1: pactus.HealthStatus
+	(EclipseRiskLevel)(0),               // 2: pactus.EclipseRiskLevel
+	(SelfTestStatus)(0),                 // 3: pactus.SelfTestStatus
+	(*GetNetworkInfoRequest)(nil),       // 4: pactus.GetNetworkInfoRequest
+	(*GetNetworkInfoResponse)(nil),      // 5: pactus.GetNetworkInfoResponse
+	(*GetNodeInfoRequest)(nil),          // 6: pactus.GetNodeInfoRequest
+	(*GetNodeInfoResponse)(nil),         // 7: pactus.GetNodeInfoResponse
+	(*SetSafeModeRequest)(nil),          // 8: pactus.SetSafeModeRequest
+	(*SetSafeModeResponse)(nil),         // 9: pactus.SetSafeModeResponse
+	(*GetDeprecationsRequest)(nil),      // 10: pactus.GetDeprecationsRequest
+	(*GetDeprecationsResponse)(nil),     // 11: pactus.GetDeprecationsResponse
+	(*DeprecationInfo)(nil),             // 12: pactus.DeprecationInfo
+	(*GetPropagationStatsRequest)(nil),  // 13: pactus.GetPropagationStatsRequest
+	(*GetPropagationStatsResponse)(nil), // 14: pactus.GetPropagationStatsResponse
+	(*PropagationStats)(nil),            // 15: pactus.PropagationStats
+	(*PropagationRecord)(nil),           // 16: pactus.PropagationRecord
+	(*GetPeerEvidenceRequest)(nil),      // 17: pactus.GetPeerEvidenceRequest
+	(*GetPeerEvidenceResponse)(nil),     // 18: pactus.GetPeerEvidenceResponse
+	(*PeerEvidence)(nil),                // 19: pactus.PeerEvidence
+	(*SetLogConfigRequest)(nil),         // 20: pactus.SetLogConfigRequest
+	(*SetLogConfigResponse)(nil),        // 21: pactus.SetLogConfigResponse
+	(*LogSampling)(nil),                 // 22: pactus.LogSampling
+	(*SelfTestResult)(nil),              // 23: pactus.SelfTestResult
+	(*SubsystemHealth)(nil),             // 24: pactus.SubsystemHealth
+	(*EclipseRisk)(nil),                 // 25: pactus.EclipseRisk
+	(*PeerInfo)(nil),                    // 26: pactus.PeerInfo
+	(*PeerVersion)(nil),                 // 27: pactus.PeerVersion
+	nil,                                 // 28: pactus.GetNetworkInfoResponse.SentBytesEntry
+	nil,                                 // 29: pactus.GetNetworkInfoResponse.ReceivedBytesEntry
+	nil,                                 // 30: pactus.PeerInfo.SentBytesEntry
+	nil,                                 // 31: pactus.PeerInfo.ReceivedBytesEntry
 }
 var file_network_proto_depIdxs = []int32{
-	4, // 0: pactus.GetNetworkInfoResponse.connected_peers:type_name -> pactus.PeerInfo
-	5, // 1: pactus.GetNetworkInfoResponse.sent_bytes:type_name -> pactus.GetNetworkInfoResponse.SentBytesEntry
-	6, // 2: pactus.GetNetworkInfoResponse.received_bytes:type_name -> pactus.GetNetworkInfoResponse.ReceivedBytesEntry
-	7, // 3: pactus.PeerInfo.sent_bytes:type_name -> pactus.PeerInfo.SentBytesEntry
-	8, // 4: pactus.PeerInfo.received_bytes:type_name -> pactus.PeerInfo.ReceivedBytesEntry
-	0, // 5: pactus.Network.GetNetworkInfo:input_type -> pactus.GetNetworkInfoRequest
-	2, // 6: pactus.Network.GetNodeInfo:input_type -> pactus.GetNodeInfoRequest
-	1, // 7: pactus.Network.GetNetworkInfo:output_type -> pactus.GetNetworkInfoResponse
-	3, // 8: pactus.Network.GetNodeInfo:output_type -> pactus.GetNodeInfoResponse
-	7, // [7:9] is the sub-list for method output_type
-	5, // [5:7] is the sub-list for method input_type
-	5, // [5:5] is the sub-list for extension type_name
-	5, // [5:5] is the sub-list for extension extendee
-	0, // [0:5] is the sub-list for field type_name
+	26, // 0: pactus.GetNetworkInfoResponse.connected_peers:type_name -> pactus.PeerInfo
+	28, // 1: pactus.GetNetworkInfoResponse.sent_bytes:type_name -> pactus.GetNetworkInfoResponse.SentBytesEntry
+	29, // 2: pactus.GetNetworkInfoResponse.received_bytes:type_name -> pactus.GetNetworkInfoResponse.ReceivedBytesEntry
+	27, // 3: pactus.GetNetworkInfoResponse.peer_versions:type_name -> pactus.PeerVersion
+	23, // 4: pactus.GetNodeInfoResponse.self_test:type_name -> pactus.SelfTestResult
+	1,  // 5: pactus.GetNodeInfoResponse.health:type_name -> pactus.HealthStatus
+	24, // 6: pactus.GetNodeInfoResponse.subsystems_health:type_name -> pactus.SubsystemHealth
+	25, // 7: pactus.GetNodeInfoResponse.eclipse_risk:type_name -> pactus.EclipseRisk
+	12, // 8: pactus.GetDeprecationsResponse.deprecations:type_name -> pactus.DeprecationInfo
+	15, // 9: pactus.GetPropagationStatsResponse.blocks:type_name -> pactus.PropagationStats
+	15, // 10: pactus.GetPropagationStatsResponse.proposals:type_name -> pactus.PropagationStats
+	16, // 11: pactus.GetPropagationStatsResponse.records:type_name -> pactus.PropagationRecord
+	0,  // 12: pactus.PropagationRecord.kind:type_name -> pactus.PropagationKind
+	19, // 13: pactus.GetPeerEvidenceResponse.evidences:type_name -> pactus.PeerEvidence
+	22, // 14: pactus.SetLogConfigRequest.sampling:type_name -> pactus.LogSampling
+	22, // 15: pactus.SetLogConfigResponse.sampling:type_name -> pactus.LogSampling
+	3,  // 16: pactus.SelfTestResult.status:type_name -> pactus.SelfTestStatus
+	1,  // 17: pactus.SubsystemHealth.status:type_name -> pactus.HealthStatus
+	2,  // 18: pactus.EclipseRisk.level:type_name -> pactus.EclipseRiskLevel
+	30, // 19: pactus.PeerInfo.sent_bytes:type_name -> pactus.PeerInfo.SentBytesEntry
+	31, // 20: pactus.PeerInfo.received_bytes:type_name -> pactus.PeerInfo.ReceivedBytesEntry
+	4,  // 21: pactus.Network.GetNetworkInfo:input_type -> pactus.GetNetworkInfoRequest
+	6,  // 22: pactus.Network.GetNodeInfo:input_type -> pactus.GetNodeInfoRequest
+	8,  // 23: pactus.Network.SetSafeMode:input_type -> pactus.SetSafeModeRequest
+	10, // 24: pactus.Network.GetDeprecations:input_type -> pactus.GetDeprecationsRequest
+	13, // 25: pactus.Network.GetPropagationStats:input_type -> pactus.GetPropagationStatsRequest
+	20, // 26: pactus.Network.SetLogConfig:input_type -> pactus.SetLogConfigRequest
+	17, // 27: pactus.Network.GetPeerEvidence:input_type -> pactus.GetPeerEvidenceRequest
+	5,  // 28: pactus.Network.GetNetworkInfo:output_type -> pactus.GetNetworkInfoResponse
+	7,  // 29: pactus.Network.GetNodeInfo:output_type -> pactus.GetNodeInfoResponse
+	9,  // 30: pactus.Network.SetSafeMode:output_type -> pactus.SetSafeModeResponse
+	11, // 31: pactus.Network.GetDeprecations:output_type -> pactus.GetDeprecationsResponse
+	14, // 32: pactus.Network.GetPropagationStats:output_type -> pactus.GetPropagationStatsResponse
+	21, // 33: pactus.Network.SetLogConfig:output_type -> pactus.SetLogConfigResponse
+	18, // 34: pactus.Network.GetPeerEvidence:output_type -> pactus.GetPeerEvidenceResponse
+	28, // [28:35] is the sub-list for method output_type
+	21, // [21:28] is the sub-list for method input_type
+	21, // [21:21] is the sub-list for extension type_name
+	21, // [21:21] is the sub-list for extension extendee
+	0,  // [0:21] is the sub-list for field type_name
 }
 
 func init() { file_network_proto_init() }
@@ -735,6 +2759,222 @@ func file_network_proto_init() {
 			}
 		}
 		file_network_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetSafeModeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_network_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetSafeModeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_network_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDeprecationsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_network_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDeprecationsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_network_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeprecationInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_network_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPropagationStatsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_network_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPropagationStatsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_network_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PropagationStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_network_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PropagationRecord); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_network_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPeerEvidenceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_network_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPeerEvidenceResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_network_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PeerEvidence); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_network_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetLogConfigRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_network_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetLogConfigResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_network_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LogSampling); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_network_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SelfTestResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_network_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubsystemHealth); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_network_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EclipseRisk); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_network_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PeerInfo); i {
 			case 0:
 				return &v.state
@@ -746,19 +2986,32 @@ func file_network_proto_init() {
 				return nil
 			}
 		}
+		file_network_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PeerVersion); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_network_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   9,
+			NumEnums:      4,
+			NumMessages:   28,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_network_proto_goTypes,
 		DependencyIndexes: file_network_proto_depIdxs,
+		EnumInfos:         file_network_proto_enumTypes,
 		MessageInfos:      file_network_proto_msgTypes,
 	}.Build()
 	File_network_proto = out.File
@@ -26,6 +26,24 @@ type NetworkClient interface {
 	GetNetworkInfo(ctx context.Context, in *GetNetworkInfoRequest, opts ...grpc.CallOption) (*GetNetworkInfoResponse, error)
 	// GetNodeInfo retrieves information about a specific node in the network.
 	GetNodeInfo(ctx context.Context, in *GetNodeInfoRequest, opts ...grpc.CallOption) (*GetNodeInfoResponse, error)
+	// SetSafeMode enables or disables the safe mode of the node.
+	// In safe mode, the node keeps syncing and serving the requests,
+	// but it doesn't sign any proposal or vote.
+	// It is only accepted through the unix socket.
+	SetSafeMode(ctx context.Context, in *SetSafeModeRequest, opts ...grpc.CallOption) (*SetSafeModeResponse, error)
+	// GetDeprecations retrieves the deprecated config keys, RPC fields and CLI flags,
+	// with their replacements and how many times they are used since the node started.
+	GetDeprecations(ctx context.Context, in *GetDeprecationsRequest, opts ...grpc.CallOption) (*GetDeprecationsResponse, error)
+	// GetPropagationStats retrieves the propagation latencies of the recent blocks and proposals,
+	// measured from the block time until the node first saw them.
+	GetPropagationStats(ctx context.Context, in *GetPropagationStatsRequest, opts ...grpc.CallOption) (*GetPropagationStatsResponse, error)
+	// SetLogConfig changes the log level and the sampling of a logger module at runtime,
+	// so the debug logs can be enabled on a busy node without filling up the disk.
+	// It is only accepted through the unix socket.
+	SetLogConfig(ctx context.Context, in *SetLogConfigRequest, opts ...grpc.CallOption) (*SetLogConfigResponse, error)
+	// GetPeerEvidence retrieves the evidences of penalizing the peers, with the offending data,
+	// so the reports of the malicious peers can be reproduced.
+	GetPeerEvidence(ctx context.Context, in *GetPeerEvidenceRequest, opts ...grpc.CallOption) (*GetPeerEvidenceResponse, error)
 }
 
 type networkClient struct {
@@ -54,6 +72,51 @@ func (c *networkClient) GetNodeInfo(ctx context.Context, in *GetNodeInfoRequest,
 	return out, nil
 }
 
+func (c *networkClient) SetSafeMode(ctx context.Context, in *SetSafeModeRequest, opts ...grpc.CallOption) (*SetSafeModeResponse, error) {
+	out := new(SetSafeModeResponse)
+	err := c.cc.Invoke(ctx, "/pactus.Network/SetSafeMode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *networkClient) GetDeprecations(ctx context.Context, in *GetDeprecationsRequest, opts ...grpc.CallOption) (*GetDeprecationsResponse, error) {
+	out := new(GetDeprecationsResponse)
+	err := c.cc.Invoke(ctx, "/pactus.Network/GetDeprecations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *networkClient) GetPropagationStats(ctx context.Context, in *GetPropagationStatsRequest, opts ...grpc.CallOption) (*GetPropagationStatsResponse, error) {
+	out := new(GetPropagationStatsResponse)
+	err := c.cc.Invoke(ctx, "/pactus.Network/GetPropagationStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *networkClient) SetLogConfig(ctx context.Context, in *SetLogConfigRequest, opts ...grpc.CallOption) (*SetLogConfigResponse, error) {
+	out := new(SetLogConfigResponse)
+	err := c.cc.Invoke(ctx, "/pactus.Network/SetLogConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *networkClient) GetPeerEvidence(ctx context.Context, in *GetPeerEvidenceRequest, opts ...grpc.CallOption) (*GetPeerEvidenceResponse, error) {
+	out := new(GetPeerEvidenceResponse)
+	err := c.cc.Invoke(ctx, "/pactus.Network/GetPeerEvidence", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // NetworkServer is the server API for Network service.
 // All implementations should embed UnimplementedNetworkServer
 // for forward compatibility
@@ -62,6 +125,24 @@ type NetworkServer interface {
 	GetNetworkInfo(context.Context, *GetNetworkInfoRequest) (*GetNetworkInfoResponse, error)
 	// GetNodeInfo retrieves information about a specific node in the network.
 	GetNodeInfo(context.Context, *GetNodeInfoRequest) (*GetNodeInfoResponse, error)
+	// SetSafeMode enables or disables the safe mode of the node.
+	// In safe mode, the node keeps syncing and serving the requests,
+	// but it doesn't sign any proposal or vote.
+	// It is only accepted through the unix socket.
+	SetSafeMode(context.Context, *SetSafeModeRequest) (*SetSafeModeResponse, error)
+	// GetDeprecations retrieves the deprecated config keys, RPC fields and CLI flags,
+	// with their replacements and how many times they are used since the node started.
+	GetDeprecations(context.Context, *GetDeprecationsRequest) (*GetDeprecationsResponse, error)
+	// GetPropagationStats retrieves the propagation latencies of the recent blocks and proposals,
+	// measured from the block time until the node first saw them.
+	GetPropagationStats(context.Context, *GetPropagationStatsRequest) (*GetPropagationStatsResponse, error)
+	// SetLogConfig changes the log level and the sampling of a logger module at runtime,
+	// so the debug logs can be enabled on a busy node without filling up the disk.
+	// It is only accepted through the unix socket.
+	SetLogConfig(context.Context, *SetLogConfigRequest) (*SetLogConfigResponse, error)
+	// GetPeerEvidence retrieves the evidences of penalizing the peers, with the offending data,
+	// so the reports of the malicious peers can be reproduced.
+	GetPeerEvidence(context.Context, *GetPeerEvidenceRequest) (*GetPeerEvidenceResponse, error)
 }
 
 // UnimplementedNetworkServer should be embedded to have forward compatible implementations.
@@ -74,6 +155,21 @@ func (UnimplementedNetworkServer) GetNetworkInfo(context.Context, *GetNetworkInf
 func (UnimplementedNetworkServer) GetNodeInfo(context.Context, *GetNodeInfoRequest) (*GetNodeInfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetNodeInfo not implemented")
 }
+func (UnimplementedNetworkServer) SetSafeMode(context.Context, *SetSafeModeRequest) (*SetSafeModeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSafeMode not implemented")
+}
+func (UnimplementedNetworkServer) GetDeprecations(context.Context, *GetDeprecationsRequest) (*GetDeprecationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDeprecations not implemented")
+}
+func (UnimplementedNetworkServer) GetPropagationStats(context.Context, *GetPropagationStatsRequest) (*GetPropagationStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPropagationStats not implemented")
+}
+func (UnimplementedNetworkServer) SetLogConfig(context.Context, *SetLogConfigRequest) (*SetLogConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetLogConfig not implemented")
+}
+func (UnimplementedNetworkServer) GetPeerEvidence(context.Context, *GetPeerEvidenceRequest) (*GetPeerEvidenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPeerEvidence not implemented")
+}
 
 // UnsafeNetworkServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to NetworkServer will
@@ -122,6 +218,96 @@ func _Network_GetNodeInfo_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Network_SetSafeMode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetSafeModeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NetworkServer).SetSafeMode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pactus.Network/SetSafeMode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NetworkServer).SetSafeMode(ctx, req.(*SetSafeModeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Network_GetDeprecations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDeprecationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NetworkServer).GetDeprecations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pactus.Network/GetDeprecations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NetworkServer).GetDeprecations(ctx, req.(*GetDeprecationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Network_GetPropagationStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPropagationStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NetworkServer).GetPropagationStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pactus.Network/GetPropagationStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NetworkServer).GetPropagationStats(ctx, req.(*GetPropagationStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Network_SetLogConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetLogConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NetworkServer).SetLogConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pactus.Network/SetLogConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NetworkServer).SetLogConfig(ctx, req.(*SetLogConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Network_GetPeerEvidence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPeerEvidenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NetworkServer).GetPeerEvidence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pactus.Network/GetPeerEvidence",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NetworkServer).GetPeerEvidence(ctx, req.(*GetPeerEvidenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Network_ServiceDesc is the grpc.ServiceDesc for Network service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -137,6 +323,26 @@ var Network_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetNodeInfo",
 			Handler:    _Network_GetNodeInfo_Handler,
 		},
+		{
+			MethodName: "SetSafeMode",
+			Handler:    _Network_SetSafeMode_Handler,
+		},
+		{
+			MethodName: "GetDeprecations",
+			Handler:    _Network_GetDeprecations_Handler,
+		},
+		{
+			MethodName: "GetPropagationStats",
+			Handler:    _Network_GetPropagationStats_Handler,
+		},
+		{
+			MethodName: "SetLogConfig",
+			Handler:    _Network_SetLogConfig_Handler,
+		},
+		{
+			MethodName: "GetPeerEvidence",
+			Handler:    _Network_GetPeerEvidence_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "network.proto",
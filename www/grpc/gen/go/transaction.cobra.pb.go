@@ -21,12 +21,19 @@ func TransactionClientCommand(options ...client.Option) *cobra.Command {
 	cfg.BindFlags(cmd.PersistentFlags())
 	cmd.AddCommand(
 		_TransactionGetTransactionCommand(cfg),
+		_TransactionGetTransactionReceiptCommand(cfg),
 		_TransactionCalculateFeeCommand(cfg),
 		_TransactionBroadcastTransactionCommand(cfg),
 		_TransactionGetRawTransferTransactionCommand(cfg),
 		_TransactionGetRawBondTransactionCommand(cfg),
 		_TransactionGetRawUnBondTransactionCommand(cfg),
 		_TransactionGetRawWithdrawTransactionCommand(cfg),
+		_TransactionGetTxPoolSnapshotCommand(cfg),
+		_TransactionGetTxPoolChangesCommand(cfg),
+		_TransactionGetTransactionTimelineCommand(cfg),
+		_TransactionEncodePaymentURICommand(cfg),
+		_TransactionDecodePaymentURICommand(cfg),
+		_TransactionResolveNameCommand(cfg),
 	)
 	return cmd
 }
@@ -74,6 +81,48 @@ func _TransactionGetTransactionCommand(cfg *client.Config) *cobra.Command {
 	return cmd
 }
 
+func _TransactionGetTransactionReceiptCommand(cfg *client.Config) *cobra.Command {
+	req := &GetTransactionReceiptRequest{}
+
+	cmd := &cobra.Command{
+		Use:   cfg.CommandNamer("GetTransactionReceipt"),
+		Short: "GetTransactionReceipt RPC client",
+		Long:  "GetTransactionReceipt retrieves the execution result of a committed transaction.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if cfg.UseEnvVars {
+				if err := flag.SetFlagsFromEnv(cmd.Parent().PersistentFlags(), true, cfg.EnvVarNamer, cfg.EnvVarPrefix, "Transaction"); err != nil {
+					return err
+				}
+				if err := flag.SetFlagsFromEnv(cmd.PersistentFlags(), false, cfg.EnvVarNamer, cfg.EnvVarPrefix, "Transaction", "GetTransactionReceipt"); err != nil {
+					return err
+				}
+			}
+			return client.RoundTrip(cmd.Context(), cfg, func(cc grpc.ClientConnInterface, in iocodec.Decoder, out iocodec.Encoder) error {
+				cli := NewTransactionClient(cc)
+				v := &GetTransactionReceiptRequest{}
+
+				if err := in(v); err != nil {
+					return err
+				}
+				proto.Merge(v, req)
+
+				res, err := cli.GetTransactionReceipt(cmd.Context(), v)
+
+				if err != nil {
+					return err
+				}
+
+				return out(res)
+
+			})
+		},
+	}
+
+	flag.BytesBase64Var(cmd.PersistentFlags(), &req.Id, cfg.FlagNamer("Id"), "")
+
+	return cmd
+}
+
 func _TransactionCalculateFeeCommand(cfg *client.Config) *cobra.Command {
 	req := &CalculateFeeRequest{}
 
@@ -155,6 +204,7 @@ func _TransactionBroadcastTransactionCommand(cfg *client.Config) *cobra.Command
 	}
 
 	flag.BytesBase64Var(cmd.PersistentFlags(), &req.SignedRawTransaction, cfg.FlagNamer("SignedRawTransaction"), "")
+	cmd.PersistentFlags().StringVar(&req.IdempotencyKey, cfg.FlagNamer("IdempotencyKey"), "", "")
 
 	return cmd
 }
@@ -202,6 +252,7 @@ func _TransactionGetRawTransferTransactionCommand(cfg *client.Config) *cobra.Com
 	cmd.PersistentFlags().Int64Var(&req.Amount, cfg.FlagNamer("Amount"), 0, "")
 	cmd.PersistentFlags().Int64Var(&req.Fee, cfg.FlagNamer("Fee"), 0, "")
 	cmd.PersistentFlags().StringVar(&req.Memo, cfg.FlagNamer("Memo"), "", "")
+	cmd.PersistentFlags().Uint32Var(&req.RelativeLockTime, cfg.FlagNamer("RelativeLockTime"), 0, "")
 
 	return cmd
 }
@@ -250,6 +301,8 @@ func _TransactionGetRawBondTransactionCommand(cfg *client.Config) *cobra.Command
 	cmd.PersistentFlags().StringVar(&req.PublicKey, cfg.FlagNamer("PublicKey"), "", "")
 	cmd.PersistentFlags().Int64Var(&req.Fee, cfg.FlagNamer("Fee"), 0, "")
 	cmd.PersistentFlags().StringVar(&req.Memo, cfg.FlagNamer("Memo"), "", "")
+	cmd.PersistentFlags().Uint32Var(&req.RelativeLockTime, cfg.FlagNamer("RelativeLockTime"), 0, "")
+	cmd.PersistentFlags().StringVar(&req.ProofOfPossession, cfg.FlagNamer("ProofOfPossession"), "", "")
 
 	return cmd
 }
@@ -294,6 +347,7 @@ func _TransactionGetRawUnBondTransactionCommand(cfg *client.Config) *cobra.Comma
 	cmd.PersistentFlags().Uint32Var(&req.LockTime, cfg.FlagNamer("LockTime"), 0, "")
 	cmd.PersistentFlags().StringVar(&req.ValidatorAddress, cfg.FlagNamer("ValidatorAddress"), "", "")
 	cmd.PersistentFlags().StringVar(&req.Memo, cfg.FlagNamer("Memo"), "", "")
+	cmd.PersistentFlags().Uint32Var(&req.RelativeLockTime, cfg.FlagNamer("RelativeLockTime"), 0, "")
 
 	return cmd
 }
@@ -341,6 +395,261 @@ func _TransactionGetRawWithdrawTransactionCommand(cfg *client.Config) *cobra.Com
 	cmd.PersistentFlags().Int64Var(&req.Fee, cfg.FlagNamer("Fee"), 0, "")
 	cmd.PersistentFlags().Int64Var(&req.Amount, cfg.FlagNamer("Amount"), 0, "")
 	cmd.PersistentFlags().StringVar(&req.Memo, cfg.FlagNamer("Memo"), "", "")
+	cmd.PersistentFlags().Uint32Var(&req.RelativeLockTime, cfg.FlagNamer("RelativeLockTime"), 0, "")
+
+	return cmd
+}
+
+func _TransactionGetTxPoolSnapshotCommand(cfg *client.Config) *cobra.Command {
+	req := &GetTxPoolSnapshotRequest{}
+
+	cmd := &cobra.Command{
+		Use:   cfg.CommandNamer("GetTxPoolSnapshot"),
+		Short: "GetTxPoolSnapshot RPC client",
+		Long:  "GetTxPoolSnapshot retrieves the short IDs of the transactions in the transaction pool,\n with the sequence of the last change of the pool.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if cfg.UseEnvVars {
+				if err := flag.SetFlagsFromEnv(cmd.Parent().PersistentFlags(), true, cfg.EnvVarNamer, cfg.EnvVarPrefix, "Transaction"); err != nil {
+					return err
+				}
+				if err := flag.SetFlagsFromEnv(cmd.PersistentFlags(), false, cfg.EnvVarNamer, cfg.EnvVarPrefix, "Transaction", "GetTxPoolSnapshot"); err != nil {
+					return err
+				}
+			}
+			return client.RoundTrip(cmd.Context(), cfg, func(cc grpc.ClientConnInterface, in iocodec.Decoder, out iocodec.Encoder) error {
+				cli := NewTransactionClient(cc)
+				v := &GetTxPoolSnapshotRequest{}
+
+				if err := in(v); err != nil {
+					return err
+				}
+				proto.Merge(v, req)
+
+				res, err := cli.GetTxPoolSnapshot(cmd.Context(), v)
+
+				if err != nil {
+					return err
+				}
+
+				return out(res)
+
+			})
+		},
+	}
+
+	return cmd
+}
+
+func _TransactionGetTxPoolChangesCommand(cfg *client.Config) *cobra.Command {
+	req := &GetTxPoolChangesRequest{}
+
+	cmd := &cobra.Command{
+		Use:   cfg.CommandNamer("GetTxPoolChanges"),
+		Short: "GetTxPoolChanges RPC client",
+		Long:  "GetTxPoolChanges retrieves the transactions that are added to or removed from\n the transaction pool after a sequence, to mirror the pool after taking a snapshot.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if cfg.UseEnvVars {
+				if err := flag.SetFlagsFromEnv(cmd.Parent().PersistentFlags(), true, cfg.EnvVarNamer, cfg.EnvVarPrefix, "Transaction"); err != nil {
+					return err
+				}
+				if err := flag.SetFlagsFromEnv(cmd.PersistentFlags(), false, cfg.EnvVarNamer, cfg.EnvVarPrefix, "Transaction", "GetTxPoolChanges"); err != nil {
+					return err
+				}
+			}
+			return client.RoundTrip(cmd.Context(), cfg, func(cc grpc.ClientConnInterface, in iocodec.Decoder, out iocodec.Encoder) error {
+				cli := NewTransactionClient(cc)
+				v := &GetTxPoolChangesRequest{}
+
+				if err := in(v); err != nil {
+					return err
+				}
+				proto.Merge(v, req)
+
+				res, err := cli.GetTxPoolChanges(cmd.Context(), v)
+
+				if err != nil {
+					return err
+				}
+
+				return out(res)
+
+			})
+		},
+	}
+
+	cmd.PersistentFlags().Uint64Var(&req.SinceSequence, cfg.FlagNamer("SinceSequence"), 0, "")
+	flag.EnumVar(cmd.PersistentFlags(), &req.Verbosity, cfg.FlagNamer("Verbosity"), "")
+
+	return cmd
+}
+
+func _TransactionGetTransactionTimelineCommand(cfg *client.Config) *cobra.Command {
+	req := &GetTransactionTimelineRequest{}
+
+	cmd := &cobra.Command{
+		Use:   cfg.CommandNamer("GetTransactionTimeline"),
+		Short: "GetTransactionTimeline RPC client",
+		Long:  "GetTransactionTimeline retrieves the timeline of a recent transaction,\n from receiving it until publishing its event.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if cfg.UseEnvVars {
+				if err := flag.SetFlagsFromEnv(cmd.Parent().PersistentFlags(), true, cfg.EnvVarNamer, cfg.EnvVarPrefix, "Transaction"); err != nil {
+					return err
+				}
+				if err := flag.SetFlagsFromEnv(cmd.PersistentFlags(), false, cfg.EnvVarNamer, cfg.EnvVarPrefix, "Transaction", "GetTransactionTimeline"); err != nil {
+					return err
+				}
+			}
+			return client.RoundTrip(cmd.Context(), cfg, func(cc grpc.ClientConnInterface, in iocodec.Decoder, out iocodec.Encoder) error {
+				cli := NewTransactionClient(cc)
+				v := &GetTransactionTimelineRequest{}
+
+				if err := in(v); err != nil {
+					return err
+				}
+				proto.Merge(v, req)
+
+				res, err := cli.GetTransactionTimeline(cmd.Context(), v)
+
+				if err != nil {
+					return err
+				}
+
+				return out(res)
+
+			})
+		},
+	}
+
+	flag.BytesBase64Var(cmd.PersistentFlags(), &req.Id, cfg.FlagNamer("Id"), "")
+
+	return cmd
+}
+
+func _TransactionEncodePaymentURICommand(cfg *client.Config) *cobra.Command {
+	req := &EncodePaymentURIRequest{}
+
+	cmd := &cobra.Command{
+		Use:   cfg.CommandNamer("EncodePaymentURI"),
+		Short: "EncodePaymentURI RPC client",
+		Long:  "EncodePaymentURI encodes a payment request as a `pactus:` payment URI,\n for example to share it as a QR code.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if cfg.UseEnvVars {
+				if err := flag.SetFlagsFromEnv(cmd.Parent().PersistentFlags(), true, cfg.EnvVarNamer, cfg.EnvVarPrefix, "Transaction"); err != nil {
+					return err
+				}
+				if err := flag.SetFlagsFromEnv(cmd.PersistentFlags(), false, cfg.EnvVarNamer, cfg.EnvVarPrefix, "Transaction", "EncodePaymentURI"); err != nil {
+					return err
+				}
+			}
+			return client.RoundTrip(cmd.Context(), cfg, func(cc grpc.ClientConnInterface, in iocodec.Decoder, out iocodec.Encoder) error {
+				cli := NewTransactionClient(cc)
+				v := &EncodePaymentURIRequest{}
+
+				if err := in(v); err != nil {
+					return err
+				}
+				proto.Merge(v, req)
+
+				res, err := cli.EncodePaymentURI(cmd.Context(), v)
+
+				if err != nil {
+					return err
+				}
+
+				return out(res)
+
+			})
+		},
+	}
+
+	cmd.PersistentFlags().StringVar(&req.Address, cfg.FlagNamer("Address"), "", "")
+	cmd.PersistentFlags().Int64Var(&req.Amount, cfg.FlagNamer("Amount"), 0, "")
+	cmd.PersistentFlags().StringVar(&req.Memo, cfg.FlagNamer("Memo"), "", "")
+	cmd.PersistentFlags().Int64Var(&req.Expiry, cfg.FlagNamer("Expiry"), 0, "")
+
+	return cmd
+}
+
+func _TransactionDecodePaymentURICommand(cfg *client.Config) *cobra.Command {
+	req := &DecodePaymentURIRequest{}
+
+	cmd := &cobra.Command{
+		Use:   cfg.CommandNamer("DecodePaymentURI"),
+		Short: "DecodePaymentURI RPC client",
+		Long:  "DecodePaymentURI decodes and validates a `pactus:` payment URI.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if cfg.UseEnvVars {
+				if err := flag.SetFlagsFromEnv(cmd.Parent().PersistentFlags(), true, cfg.EnvVarNamer, cfg.EnvVarPrefix, "Transaction"); err != nil {
+					return err
+				}
+				if err := flag.SetFlagsFromEnv(cmd.PersistentFlags(), false, cfg.EnvVarNamer, cfg.EnvVarPrefix, "Transaction", "DecodePaymentURI"); err != nil {
+					return err
+				}
+			}
+			return client.RoundTrip(cmd.Context(), cfg, func(cc grpc.ClientConnInterface, in iocodec.Decoder, out iocodec.Encoder) error {
+				cli := NewTransactionClient(cc)
+				v := &DecodePaymentURIRequest{}
+
+				if err := in(v); err != nil {
+					return err
+				}
+				proto.Merge(v, req)
+
+				res, err := cli.DecodePaymentURI(cmd.Context(), v)
+
+				if err != nil {
+					return err
+				}
+
+				return out(res)
+
+			})
+		},
+	}
+
+	cmd.PersistentFlags().StringVar(&req.Uri, cfg.FlagNamer("Uri"), "", "")
+
+	return cmd
+}
+
+func _TransactionResolveNameCommand(cfg *client.Config) *cobra.Command {
+	req := &ResolveNameRequest{}
+
+	cmd := &cobra.Command{
+		Use:   cfg.CommandNamer("ResolveName"),
+		Short: "ResolveName RPC client",
+		Long:  "ResolveName resolves a registered name, like `alice.pac`, to its address\n by the name service of the node.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if cfg.UseEnvVars {
+				if err := flag.SetFlagsFromEnv(cmd.Parent().PersistentFlags(), true, cfg.EnvVarNamer, cfg.EnvVarPrefix, "Transaction"); err != nil {
+					return err
+				}
+				if err := flag.SetFlagsFromEnv(cmd.PersistentFlags(), false, cfg.EnvVarNamer, cfg.EnvVarPrefix, "Transaction", "ResolveName"); err != nil {
+					return err
+				}
+			}
+			return client.RoundTrip(cmd.Context(), cfg, func(cc grpc.ClientConnInterface, in iocodec.Decoder, out iocodec.Encoder) error {
+				cli := NewTransactionClient(cc)
+				v := &ResolveNameRequest{}
+
+				if err := in(v); err != nil {
+					return err
+				}
+				proto.Merge(v, req)
+
+				res, err := cli.ResolveName(cmd.Context(), v)
+
+				if err != nil {
+					return err
+				}
+
+				return out(res)
+
+			})
+		},
+	}
+
+	cmd.PersistentFlags().StringVar(&req.Name, cfg.FlagNamer("Name"), "", "")
 
 	return cmd
 }
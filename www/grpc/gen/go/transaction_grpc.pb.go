@@ -24,6 +24,8 @@ const _ = grpc.SupportPackageIsVersion7
 type TransactionClient interface {
 	// GetTransaction retrieves transaction details based on the provided request parameters.
 	GetTransaction(ctx context.Context, in *GetTransactionRequest, opts ...grpc.CallOption) (*GetTransactionResponse, error)
+	// GetTransactionReceipt retrieves the execution result of a committed transaction.
+	GetTransactionReceipt(ctx context.Context, in *GetTransactionReceiptRequest, opts ...grpc.CallOption) (*GetTransactionReceiptResponse, error)
 	// CalculateFee calculates the transaction fee based on the specified amount and payload type.
 	CalculateFee(ctx context.Context, in *CalculateFeeRequest, opts ...grpc.CallOption) (*CalculateFeeResponse, error)
 	// BroadcastTransaction broadcasts a signed transaction to the network.
@@ -36,6 +38,23 @@ type TransactionClient interface {
 	GetRawUnBondTransaction(ctx context.Context, in *GetRawUnBondTransactionRequest, opts ...grpc.CallOption) (*GetRawTransactionResponse, error)
 	// GetRawWithdrawTransaction retrieves raw details of a withdraw transaction.
 	GetRawWithdrawTransaction(ctx context.Context, in *GetRawWithdrawTransactionRequest, opts ...grpc.CallOption) (*GetRawTransactionResponse, error)
+	// GetTxPoolSnapshot retrieves the short IDs of the transactions in the transaction pool,
+	// with the sequence of the last change of the pool.
+	GetTxPoolSnapshot(ctx context.Context, in *GetTxPoolSnapshotRequest, opts ...grpc.CallOption) (*GetTxPoolSnapshotResponse, error)
+	// GetTxPoolChanges retrieves the transactions that are added to or removed from
+	// the transaction pool after a sequence, to mirror the pool after taking a snapshot.
+	GetTxPoolChanges(ctx context.Context, in *GetTxPoolChangesRequest, opts ...grpc.CallOption) (*GetTxPoolChangesResponse, error)
+	// GetTransactionTimeline retrieves the timeline of a recent transaction,
+	// from receiving it until publishing its event.
+	GetTransactionTimeline(ctx context.Context, in *GetTransactionTimelineRequest, opts ...grpc.CallOption) (*GetTransactionTimelineResponse, error)
+	// EncodePaymentURI encodes a payment request as a `pactus:` payment URI,
+	// for example to share it as a QR code.
+	EncodePaymentURI(ctx context.Context, in *EncodePaymentURIRequest, opts ...grpc.CallOption) (*EncodePaymentURIResponse, error)
+	// DecodePaymentURI decodes and validates a `pactus:` payment URI.
+	DecodePaymentURI(ctx context.Context, in *DecodePaymentURIRequest, opts ...grpc.CallOption) (*DecodePaymentURIResponse, error)
+	// ResolveName resolves a registered name, like `alice.pac`, to its address
+	// by the name service of the node.
+	ResolveName(ctx context.Context, in *ResolveNameRequest, opts ...grpc.CallOption) (*ResolveNameResponse, error)
 }
 
 type transactionClient struct {
@@ -55,6 +74,15 @@ func (c *transactionClient) GetTransaction(ctx context.Context, in *GetTransacti
 	return out, nil
 }
 
+func (c *transactionClient) GetTransactionReceipt(ctx context.Context, in *GetTransactionReceiptRequest, opts ...grpc.CallOption) (*GetTransactionReceiptResponse, error) {
+	out := new(GetTransactionReceiptResponse)
+	err := c.cc.Invoke(ctx, "/pactus.Transaction/GetTransactionReceipt", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *transactionClient) CalculateFee(ctx context.Context, in *CalculateFeeRequest, opts ...grpc.CallOption) (*CalculateFeeResponse, error) {
 	out := new(CalculateFeeResponse)
 	err := c.cc.Invoke(ctx, "/pactus.Transaction/CalculateFee", in, out, opts...)
@@ -109,12 +137,68 @@ func (c *transactionClient) GetRawWithdrawTransaction(ctx context.Context, in *G
 	return out, nil
 }
 
+func (c *transactionClient) GetTxPoolSnapshot(ctx context.Context, in *GetTxPoolSnapshotRequest, opts ...grpc.CallOption) (*GetTxPoolSnapshotResponse, error) {
+	out := new(GetTxPoolSnapshotResponse)
+	err := c.cc.Invoke(ctx, "/pactus.Transaction/GetTxPoolSnapshot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionClient) GetTxPoolChanges(ctx context.Context, in *GetTxPoolChangesRequest, opts ...grpc.CallOption) (*GetTxPoolChangesResponse, error) {
+	out := new(GetTxPoolChangesResponse)
+	err := c.cc.Invoke(ctx, "/pactus.Transaction/GetTxPoolChanges", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionClient) GetTransactionTimeline(ctx context.Context, in *GetTransactionTimelineRequest, opts ...grpc.CallOption) (*GetTransactionTimelineResponse, error) {
+	out := new(GetTransactionTimelineResponse)
+	err := c.cc.Invoke(ctx, "/pactus.Transaction/GetTransactionTimeline", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionClient) EncodePaymentURI(ctx context.Context, in *EncodePaymentURIRequest, opts ...grpc.CallOption) (*EncodePaymentURIResponse, error) {
+	out := new(EncodePaymentURIResponse)
+	err := c.cc.Invoke(ctx, "/pactus.Transaction/EncodePaymentURI", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionClient) DecodePaymentURI(ctx context.Context, in *DecodePaymentURIRequest, opts ...grpc.CallOption) (*DecodePaymentURIResponse, error) {
+	out := new(DecodePaymentURIResponse)
+	err := c.cc.Invoke(ctx, "/pactus.Transaction/DecodePaymentURI", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionClient) ResolveName(ctx context.Context, in *ResolveNameRequest, opts ...grpc.CallOption) (*ResolveNameResponse, error) {
+	out := new(ResolveNameResponse)
+	err := c.cc.Invoke(ctx, "/pactus.Transaction/ResolveName", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // TransactionServer is the server API for Transaction service.
 // All implementations should embed UnimplementedTransactionServer
 // for forward compatibility
 type TransactionServer interface {
 	// GetTransaction retrieves transaction details based on the provided request parameters.
 	GetTransaction(context.Context, *GetTransactionRequest) (*GetTransactionResponse, error)
+	// GetTransactionReceipt retrieves the execution result of a committed transaction.
+	GetTransactionReceipt(context.Context, *GetTransactionReceiptRequest) (*GetTransactionReceiptResponse, error)
 	// CalculateFee calculates the transaction fee based on the specified amount and payload type.
 	CalculateFee(context.Context, *CalculateFeeRequest) (*CalculateFeeResponse, error)
 	// BroadcastTransaction broadcasts a signed transaction to the network.
@@ -127,6 +211,23 @@ type TransactionServer interface {
 	GetRawUnBondTransaction(context.Context, *GetRawUnBondTransactionRequest) (*GetRawTransactionResponse, error)
 	// GetRawWithdrawTransaction retrieves raw details of a withdraw transaction.
 	GetRawWithdrawTransaction(context.Context, *GetRawWithdrawTransactionRequest) (*GetRawTransactionResponse, error)
+	// GetTxPoolSnapshot retrieves the short IDs of the transactions in the transaction pool,
+	// with the sequence of the last change of the pool.
+	GetTxPoolSnapshot(context.Context, *GetTxPoolSnapshotRequest) (*GetTxPoolSnapshotResponse, error)
+	// GetTxPoolChanges retrieves the transactions that are added to or removed from
+	// the transaction pool after a sequence, to mirror the pool after taking a snapshot.
+	GetTxPoolChanges(context.Context, *GetTxPoolChangesRequest) (*GetTxPoolChangesResponse, error)
+	// GetTransactionTimeline retrieves the timeline of a recent transaction,
+	// from receiving it until publishing its event.
+	GetTransactionTimeline(context.Context, *GetTransactionTimelineRequest) (*GetTransactionTimelineResponse, error)
+	// EncodePaymentURI encodes a payment request as a `pactus:` payment URI,
+	// for example to share it as a QR code.
+	EncodePaymentURI(context.Context, *EncodePaymentURIRequest) (*EncodePaymentURIResponse, error)
+	// DecodePaymentURI decodes and validates a `pactus:` payment URI.
+	DecodePaymentURI(context.Context, *DecodePaymentURIRequest) (*DecodePaymentURIResponse, error)
+	// ResolveName resolves a registered name, like `alice.pac`, to its address
+	// by the name service of the node.
+	ResolveName(context.Context, *ResolveNameRequest) (*ResolveNameResponse, error)
 }
 
 // UnimplementedTransactionServer should be embedded to have forward compatible implementations.
@@ -136,6 +237,9 @@ type UnimplementedTransactionServer struct {
 func (UnimplementedTransactionServer) GetTransaction(context.Context, *GetTransactionRequest) (*GetTransactionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetTransaction not implemented")
 }
+func (UnimplementedTransactionServer) GetTransactionReceipt(context.Context, *GetTransactionReceiptRequest) (*GetTransactionReceiptResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTransactionReceipt not implemented")
+}
 func (UnimplementedTransactionServer) CalculateFee(context.Context, *CalculateFeeRequest) (*CalculateFeeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CalculateFee not implemented")
 }
@@ -154,6 +258,24 @@ func (UnimplementedTransactionServer) GetRawUnBondTransaction(context.Context, *
 func (UnimplementedTransactionServer) GetRawWithdrawTransaction(context.Context, *GetRawWithdrawTransactionRequest) (*GetRawTransactionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetRawWithdrawTransaction not implemented")
 }
+func (UnimplementedTransactionServer) GetTxPoolSnapshot(context.Context, *GetTxPoolSnapshotRequest) (*GetTxPoolSnapshotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTxPoolSnapshot not implemented")
+}
+func (UnimplementedTransactionServer) GetTxPoolChanges(context.Context, *GetTxPoolChangesRequest) (*GetTxPoolChangesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTxPoolChanges not implemented")
+}
+func (UnimplementedTransactionServer) GetTransactionTimeline(context.Context, *GetTransactionTimelineRequest) (*GetTransactionTimelineResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTransactionTimeline not implemented")
+}
+func (UnimplementedTransactionServer) EncodePaymentURI(context.Context, *EncodePaymentURIRequest) (*EncodePaymentURIResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EncodePaymentURI not implemented")
+}
+func (UnimplementedTransactionServer) DecodePaymentURI(context.Context, *DecodePaymentURIRequest) (*DecodePaymentURIResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DecodePaymentURI not implemented")
+}
+func (UnimplementedTransactionServer) ResolveName(context.Context, *ResolveNameRequest) (*ResolveNameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveName not implemented")
+}
 
 // UnsafeTransactionServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to TransactionServer will
@@ -184,6 +306,24 @@ func _Transaction_GetTransaction_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Transaction_GetTransactionReceipt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTransactionReceiptRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServer).GetTransactionReceipt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pactus.Transaction/GetTransactionReceipt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServer).GetTransactionReceipt(ctx, req.(*GetTransactionReceiptRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Transaction_CalculateFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CalculateFeeRequest)
 	if err := dec(in); err != nil {
@@ -292,6 +432,114 @@ func _Transaction_GetRawWithdrawTransaction_Handler(srv interface{}, ctx context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Transaction_GetTxPoolSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTxPoolSnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServer).GetTxPoolSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pactus.Transaction/GetTxPoolSnapshot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServer).GetTxPoolSnapshot(ctx, req.(*GetTxPoolSnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Transaction_GetTxPoolChanges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTxPoolChangesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServer).GetTxPoolChanges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pactus.Transaction/GetTxPoolChanges",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServer).GetTxPoolChanges(ctx, req.(*GetTxPoolChangesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Transaction_GetTransactionTimeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTransactionTimelineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServer).GetTransactionTimeline(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pactus.Transaction/GetTransactionTimeline",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServer).GetTransactionTimeline(ctx, req.(*GetTransactionTimelineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Transaction_EncodePaymentURI_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EncodePaymentURIRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServer).EncodePaymentURI(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pactus.Transaction/EncodePaymentURI",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServer).EncodePaymentURI(ctx, req.(*EncodePaymentURIRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Transaction_DecodePaymentURI_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DecodePaymentURIRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServer).DecodePaymentURI(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pactus.Transaction/DecodePaymentURI",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServer).DecodePaymentURI(ctx, req.(*DecodePaymentURIRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Transaction_ResolveName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveNameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServer).ResolveName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pactus.Transaction/ResolveName",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServer).ResolveName(ctx, req.(*ResolveNameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Transaction_ServiceDesc is the grpc.ServiceDesc for Transaction service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -303,6 +551,10 @@ var Transaction_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetTransaction",
 			Handler:    _Transaction_GetTransaction_Handler,
 		},
+		{
+			MethodName: "GetTransactionReceipt",
+			Handler:    _Transaction_GetTransactionReceipt_Handler,
+		},
 		{
 			MethodName: "CalculateFee",
 			Handler:    _Transaction_CalculateFee_Handler,
@@ -327,6 +579,30 @@ var Transaction_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetRawWithdrawTransaction",
 			Handler:    _Transaction_GetRawWithdrawTransaction_Handler,
 		},
+		{
+			MethodName: "GetTxPoolSnapshot",
+			Handler:    _Transaction_GetTxPoolSnapshot_Handler,
+		},
+		{
+			MethodName: "GetTxPoolChanges",
+			Handler:    _Transaction_GetTxPoolChanges_Handler,
+		},
+		{
+			MethodName: "GetTransactionTimeline",
+			Handler:    _Transaction_GetTransactionTimeline_Handler,
+		},
+		{
+			MethodName: "EncodePaymentURI",
+			Handler:    _Transaction_EncodePaymentURI_Handler,
+		},
+		{
+			MethodName: "DecodePaymentURI",
+			Handler:    _Transaction_DecodePaymentURI_Handler,
+		},
+		{
+			MethodName: "ResolveName",
+			Handler:    _Transaction_ResolveName_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "transaction.proto",
@@ -27,6 +27,13 @@ func WalletClientCommand(options ...client.Option) *cobra.Command {
 		_WalletUnlockWalletCommand(cfg),
 		_WalletSignRawTransactionCommand(cfg),
 		_WalletGetValidatorAddressCommand(cfg),
+		_WalletCreateDraftTransactionCommand(cfg),
+		_WalletListDraftTransactionsCommand(cfg),
+		_WalletUpdateDraftTransactionCommand(cfg),
+		_WalletDeleteDraftTransactionCommand(cfg),
+		_WalletIssueSigningTokenCommand(cfg),
+		_WalletListSigningTokensCommand(cfg),
+		_WalletRevokeSigningTokenCommand(cfg),
 	)
 	return cmd
 }
@@ -286,6 +293,7 @@ func _WalletSignRawTransactionCommand(cfg *client.Config) *cobra.Command {
 	cmd.PersistentFlags().StringVar(&req.WalletName, cfg.FlagNamer("WalletName"), "", "")
 	flag.BytesBase64Var(cmd.PersistentFlags(), &req.RawTransaction, cfg.FlagNamer("RawTransaction"), "")
 	cmd.PersistentFlags().StringVar(&req.Password, cfg.FlagNamer("Password"), "", "")
+	cmd.PersistentFlags().StringVar(&req.SigningToken, cfg.FlagNamer("SigningToken"), "", "")
 
 	return cmd
 }
@@ -331,3 +339,315 @@ func _WalletGetValidatorAddressCommand(cfg *client.Config) *cobra.Command {
 
 	return cmd
 }
+
+func _WalletCreateDraftTransactionCommand(cfg *client.Config) *cobra.Command {
+	req := &CreateDraftTransactionRequest{}
+
+	cmd := &cobra.Command{
+		Use:   cfg.CommandNamer("CreateDraftTransaction"),
+		Short: "CreateDraftTransaction RPC client",
+		Long:  "CreateDraftTransaction stores an unsigned transaction as a draft, to be reviewed and signed later.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if cfg.UseEnvVars {
+				if err := flag.SetFlagsFromEnv(cmd.Parent().PersistentFlags(), true, cfg.EnvVarNamer, cfg.EnvVarPrefix, "Wallet"); err != nil {
+					return err
+				}
+				if err := flag.SetFlagsFromEnv(cmd.PersistentFlags(), false, cfg.EnvVarNamer, cfg.EnvVarPrefix, "Wallet", "CreateDraftTransaction"); err != nil {
+					return err
+				}
+			}
+			return client.RoundTrip(cmd.Context(), cfg, func(cc grpc.ClientConnInterface, in iocodec.Decoder, out iocodec.Encoder) error {
+				cli := NewWalletClient(cc)
+				v := &CreateDraftTransactionRequest{}
+
+				if err := in(v); err != nil {
+					return err
+				}
+				proto.Merge(v, req)
+
+				res, err := cli.CreateDraftTransaction(cmd.Context(), v)
+
+				if err != nil {
+					return err
+				}
+
+				return out(res)
+
+			})
+		},
+	}
+
+	cmd.PersistentFlags().StringVar(&req.WalletName, cfg.FlagNamer("WalletName"), "", "")
+	flag.BytesBase64Var(cmd.PersistentFlags(), &req.RawTransaction, cfg.FlagNamer("RawTransaction"), "")
+	cmd.PersistentFlags().StringVar(&req.Note, cfg.FlagNamer("Note"), "", "")
+	cmd.PersistentFlags().Uint32Var(&req.Ttl, cfg.FlagNamer("Ttl"), 0, "")
+
+	return cmd
+}
+
+func _WalletListDraftTransactionsCommand(cfg *client.Config) *cobra.Command {
+	req := &ListDraftTransactionsRequest{}
+
+	cmd := &cobra.Command{
+		Use:   cfg.CommandNamer("ListDraftTransactions"),
+		Short: "ListDraftTransactions RPC client",
+		Long:  "ListDraftTransactions lists the draft transactions of a wallet that are not expired.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if cfg.UseEnvVars {
+				if err := flag.SetFlagsFromEnv(cmd.Parent().PersistentFlags(), true, cfg.EnvVarNamer, cfg.EnvVarPrefix, "Wallet"); err != nil {
+					return err
+				}
+				if err := flag.SetFlagsFromEnv(cmd.PersistentFlags(), false, cfg.EnvVarNamer, cfg.EnvVarPrefix, "Wallet", "ListDraftTransactions"); err != nil {
+					return err
+				}
+			}
+			return client.RoundTrip(cmd.Context(), cfg, func(cc grpc.ClientConnInterface, in iocodec.Decoder, out iocodec.Encoder) error {
+				cli := NewWalletClient(cc)
+				v := &ListDraftTransactionsRequest{}
+
+				if err := in(v); err != nil {
+					return err
+				}
+				proto.Merge(v, req)
+
+				res, err := cli.ListDraftTransactions(cmd.Context(), v)
+
+				if err != nil {
+					return err
+				}
+
+				return out(res)
+
+			})
+		},
+	}
+
+	cmd.PersistentFlags().StringVar(&req.WalletName, cfg.FlagNamer("WalletName"), "", "")
+
+	return cmd
+}
+
+func _WalletUpdateDraftTransactionCommand(cfg *client.Config) *cobra.Command {
+	req := &UpdateDraftTransactionRequest{}
+
+	cmd := &cobra.Command{
+		Use:   cfg.CommandNamer("UpdateDraftTransaction"),
+		Short: "UpdateDraftTransaction RPC client",
+		Long:  "UpdateDraftTransaction replaces the transaction or the note of a draft transaction.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if cfg.UseEnvVars {
+				if err := flag.SetFlagsFromEnv(cmd.Parent().PersistentFlags(), true, cfg.EnvVarNamer, cfg.EnvVarPrefix, "Wallet"); err != nil {
+					return err
+				}
+				if err := flag.SetFlagsFromEnv(cmd.PersistentFlags(), false, cfg.EnvVarNamer, cfg.EnvVarPrefix, "Wallet", "UpdateDraftTransaction"); err != nil {
+					return err
+				}
+			}
+			return client.RoundTrip(cmd.Context(), cfg, func(cc grpc.ClientConnInterface, in iocodec.Decoder, out iocodec.Encoder) error {
+				cli := NewWalletClient(cc)
+				v := &UpdateDraftTransactionRequest{}
+
+				if err := in(v); err != nil {
+					return err
+				}
+				proto.Merge(v, req)
+
+				res, err := cli.UpdateDraftTransaction(cmd.Context(), v)
+
+				if err != nil {
+					return err
+				}
+
+				return out(res)
+
+			})
+		},
+	}
+
+	cmd.PersistentFlags().StringVar(&req.WalletName, cfg.FlagNamer("WalletName"), "", "")
+	cmd.PersistentFlags().StringVar(&req.DraftId, cfg.FlagNamer("DraftId"), "", "")
+	flag.BytesBase64Var(cmd.PersistentFlags(), &req.RawTransaction, cfg.FlagNamer("RawTransaction"), "")
+	cmd.PersistentFlags().StringVar(&req.Note, cfg.FlagNamer("Note"), "", "")
+	cmd.PersistentFlags().Uint32Var(&req.Ttl, cfg.FlagNamer("Ttl"), 0, "")
+
+	return cmd
+}
+
+func _WalletDeleteDraftTransactionCommand(cfg *client.Config) *cobra.Command {
+	req := &DeleteDraftTransactionRequest{}
+
+	cmd := &cobra.Command{
+		Use:   cfg.CommandNamer("DeleteDraftTransaction"),
+		Short: "DeleteDraftTransaction RPC client",
+		Long:  "DeleteDraftTransaction deletes a draft transaction.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if cfg.UseEnvVars {
+				if err := flag.SetFlagsFromEnv(cmd.Parent().PersistentFlags(), true, cfg.EnvVarNamer, cfg.EnvVarPrefix, "Wallet"); err != nil {
+					return err
+				}
+				if err := flag.SetFlagsFromEnv(cmd.PersistentFlags(), false, cfg.EnvVarNamer, cfg.EnvVarPrefix, "Wallet", "DeleteDraftTransaction"); err != nil {
+					return err
+				}
+			}
+			return client.RoundTrip(cmd.Context(), cfg, func(cc grpc.ClientConnInterface, in iocodec.Decoder, out iocodec.Encoder) error {
+				cli := NewWalletClient(cc)
+				v := &DeleteDraftTransactionRequest{}
+
+				if err := in(v); err != nil {
+					return err
+				}
+				proto.Merge(v, req)
+
+				res, err := cli.DeleteDraftTransaction(cmd.Context(), v)
+
+				if err != nil {
+					return err
+				}
+
+				return out(res)
+
+			})
+		},
+	}
+
+	cmd.PersistentFlags().StringVar(&req.WalletName, cfg.FlagNamer("WalletName"), "", "")
+	cmd.PersistentFlags().StringVar(&req.DraftId, cfg.FlagNamer("DraftId"), "", "")
+
+	return cmd
+}
+
+func _WalletIssueSigningTokenCommand(cfg *client.Config) *cobra.Command {
+	req := &IssueSigningTokenRequest{}
+
+	cmd := &cobra.Command{
+		Use:   cfg.CommandNamer("IssueSigningToken"),
+		Short: "IssueSigningToken RPC client",
+		Long:  "IssueSigningToken issues a scoped and expiring token to sign the transactions of a wallet without its password.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if cfg.UseEnvVars {
+				if err := flag.SetFlagsFromEnv(cmd.Parent().PersistentFlags(), true, cfg.EnvVarNamer, cfg.EnvVarPrefix, "Wallet"); err != nil {
+					return err
+				}
+				if err := flag.SetFlagsFromEnv(cmd.PersistentFlags(), false, cfg.EnvVarNamer, cfg.EnvVarPrefix, "Wallet", "IssueSigningToken"); err != nil {
+					return err
+				}
+			}
+			return client.RoundTrip(cmd.Context(), cfg, func(cc grpc.ClientConnInterface, in iocodec.Decoder, out iocodec.Encoder) error {
+				cli := NewWalletClient(cc)
+				v := &IssueSigningTokenRequest{}
+
+				if err := in(v); err != nil {
+					return err
+				}
+				proto.Merge(v, req)
+
+				res, err := cli.IssueSigningToken(cmd.Context(), v)
+
+				if err != nil {
+					return err
+				}
+
+				return out(res)
+
+			})
+		},
+	}
+
+	cmd.PersistentFlags().StringVar(&req.WalletName, cfg.FlagNamer("WalletName"), "", "")
+	cmd.PersistentFlags().StringVar(&req.Password, cfg.FlagNamer("Password"), "", "")
+	_Scope := &SigningTokenScope{}
+	flag.EnumSliceVar(cmd.PersistentFlags(), &_Scope.PayloadTypes, cfg.FlagNamer("Scope PayloadTypes"), "")
+	flag.WithPostSetHook(cmd.PersistentFlags(), cfg.FlagNamer("Scope PayloadTypes"), func() { req.Scope = _Scope })
+	cmd.PersistentFlags().StringSliceVar(&_Scope.Receivers, cfg.FlagNamer("Scope Receivers"), nil, "")
+	flag.WithPostSetHook(cmd.PersistentFlags(), cfg.FlagNamer("Scope Receivers"), func() { req.Scope = _Scope })
+	cmd.PersistentFlags().Int64Var(&_Scope.SpendLimit, cfg.FlagNamer("Scope SpendLimit"), 0, "")
+	flag.WithPostSetHook(cmd.PersistentFlags(), cfg.FlagNamer("Scope SpendLimit"), func() { req.Scope = _Scope })
+	cmd.PersistentFlags().Uint32Var(&req.Ttl, cfg.FlagNamer("Ttl"), 0, "")
+
+	return cmd
+}
+
+func _WalletListSigningTokensCommand(cfg *client.Config) *cobra.Command {
+	req := &ListSigningTokensRequest{}
+
+	cmd := &cobra.Command{
+		Use:   cfg.CommandNamer("ListSigningTokens"),
+		Short: "ListSigningTokens RPC client",
+		Long:  "ListSigningTokens lists the signing tokens of a wallet that are not expired.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if cfg.UseEnvVars {
+				if err := flag.SetFlagsFromEnv(cmd.Parent().PersistentFlags(), true, cfg.EnvVarNamer, cfg.EnvVarPrefix, "Wallet"); err != nil {
+					return err
+				}
+				if err := flag.SetFlagsFromEnv(cmd.PersistentFlags(), false, cfg.EnvVarNamer, cfg.EnvVarPrefix, "Wallet", "ListSigningTokens"); err != nil {
+					return err
+				}
+			}
+			return client.RoundTrip(cmd.Context(), cfg, func(cc grpc.ClientConnInterface, in iocodec.Decoder, out iocodec.Encoder) error {
+				cli := NewWalletClient(cc)
+				v := &ListSigningTokensRequest{}
+
+				if err := in(v); err != nil {
+					return err
+				}
+				proto.Merge(v, req)
+
+				res, err := cli.ListSigningTokens(cmd.Context(), v)
+
+				if err != nil {
+					return err
+				}
+
+				return out(res)
+
+			})
+		},
+	}
+
+	cmd.PersistentFlags().StringVar(&req.WalletName, cfg.FlagNamer("WalletName"), "", "")
+
+	return cmd
+}
+
+func _WalletRevokeSigningTokenCommand(cfg *client.Config) *cobra.Command {
+	req := &RevokeSigningTokenRequest{}
+
+	cmd := &cobra.Command{
+		Use:   cfg.CommandNamer("RevokeSigningToken"),
+		Short: "RevokeSigningToken RPC client",
+		Long:  "RevokeSigningToken revokes a signing token before it expires.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if cfg.UseEnvVars {
+				if err := flag.SetFlagsFromEnv(cmd.Parent().PersistentFlags(), true, cfg.EnvVarNamer, cfg.EnvVarPrefix, "Wallet"); err != nil {
+					return err
+				}
+				if err := flag.SetFlagsFromEnv(cmd.PersistentFlags(), false, cfg.EnvVarNamer, cfg.EnvVarPrefix, "Wallet", "RevokeSigningToken"); err != nil {
+					return err
+				}
+			}
+			return client.RoundTrip(cmd.Context(), cfg, func(cc grpc.ClientConnInterface, in iocodec.Decoder, out iocodec.Encoder) error {
+				cli := NewWalletClient(cc)
+				v := &RevokeSigningTokenRequest{}
+
+				if err := in(v); err != nil {
+					return err
+				}
+				proto.Merge(v, req)
+
+				res, err := cli.RevokeSigningToken(cmd.Context(), v)
+
+				if err != nil {
+					return err
+				}
+
+				return out(res)
+
+			})
+		},
+	}
+
+	cmd.PersistentFlags().StringVar(&req.WalletName, cfg.FlagNamer("WalletName"), "", "")
+	cmd.PersistentFlags().StringVar(&req.TokenId, cfg.FlagNamer("TokenId"), "", "")
+
+	return cmd
+}
@@ -36,6 +36,20 @@ type WalletClient interface {
 	SignRawTransaction(ctx context.Context, in *SignRawTransactionRequest, opts ...grpc.CallOption) (*SignRawTransactionResponse, error)
 	// GetValidatorAddress retrieves the validator address associated with a public key.
 	GetValidatorAddress(ctx context.Context, in *GetValidatorAddressRequest, opts ...grpc.CallOption) (*GetValidatorAddressResponse, error)
+	// CreateDraftTransaction stores an unsigned transaction as a draft, to be reviewed and signed later.
+	CreateDraftTransaction(ctx context.Context, in *CreateDraftTransactionRequest, opts ...grpc.CallOption) (*CreateDraftTransactionResponse, error)
+	// ListDraftTransactions lists the draft transactions of a wallet that are not expired.
+	ListDraftTransactions(ctx context.Context, in *ListDraftTransactionsRequest, opts ...grpc.CallOption) (*ListDraftTransactionsResponse, error)
+	// UpdateDraftTransaction replaces the transaction or the note of a draft transaction.
+	UpdateDraftTransaction(ctx context.Context, in *UpdateDraftTransactionRequest, opts ...grpc.CallOption) (*UpdateDraftTransactionResponse, error)
+	// DeleteDraftTransaction deletes a draft transaction.
+	DeleteDraftTransaction(ctx context.Context, in *DeleteDraftTransactionRequest, opts ...grpc.CallOption) (*DeleteDraftTransactionResponse, error)
+	// IssueSigningToken issues a scoped and expiring token to sign the transactions of a wallet without its password.
+	IssueSigningToken(ctx context.Context, in *IssueSigningTokenRequest, opts ...grpc.CallOption) (*IssueSigningTokenResponse, error)
+	// ListSigningTokens lists the signing tokens of a wallet that are not expired.
+	ListSigningTokens(ctx context.Context, in *ListSigningTokensRequest, opts ...grpc.CallOption) (*ListSigningTokensResponse, error)
+	// RevokeSigningToken revokes a signing token before it expires.
+	RevokeSigningToken(ctx context.Context, in *RevokeSigningTokenRequest, opts ...grpc.CallOption) (*RevokeSigningTokenResponse, error)
 }
 
 type walletClient struct {
@@ -109,6 +123,69 @@ func (c *walletClient) GetValidatorAddress(ctx context.Context, in *GetValidator
 	return out, nil
 }
 
+func (c *walletClient) CreateDraftTransaction(ctx context.Context, in *CreateDraftTransactionRequest, opts ...grpc.CallOption) (*CreateDraftTransactionResponse, error) {
+	out := new(CreateDraftTransactionResponse)
+	err := c.cc.Invoke(ctx, "/pactus.Wallet/CreateDraftTransaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletClient) ListDraftTransactions(ctx context.Context, in *ListDraftTransactionsRequest, opts ...grpc.CallOption) (*ListDraftTransactionsResponse, error) {
+	out := new(ListDraftTransactionsResponse)
+	err := c.cc.Invoke(ctx, "/pactus.Wallet/ListDraftTransactions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletClient) UpdateDraftTransaction(ctx context.Context, in *UpdateDraftTransactionRequest, opts ...grpc.CallOption) (*UpdateDraftTransactionResponse, error) {
+	out := new(UpdateDraftTransactionResponse)
+	err := c.cc.Invoke(ctx, "/pactus.Wallet/UpdateDraftTransaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletClient) DeleteDraftTransaction(ctx context.Context, in *DeleteDraftTransactionRequest, opts ...grpc.CallOption) (*DeleteDraftTransactionResponse, error) {
+	out := new(DeleteDraftTransactionResponse)
+	err := c.cc.Invoke(ctx, "/pactus.Wallet/DeleteDraftTransaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletClient) IssueSigningToken(ctx context.Context, in *IssueSigningTokenRequest, opts ...grpc.CallOption) (*IssueSigningTokenResponse, error) {
+	out := new(IssueSigningTokenResponse)
+	err := c.cc.Invoke(ctx, "/pactus.Wallet/IssueSigningToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletClient) ListSigningTokens(ctx context.Context, in *ListSigningTokensRequest, opts ...grpc.CallOption) (*ListSigningTokensResponse, error) {
+	out := new(ListSigningTokensResponse)
+	err := c.cc.Invoke(ctx, "/pactus.Wallet/ListSigningTokens", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletClient) RevokeSigningToken(ctx context.Context, in *RevokeSigningTokenRequest, opts ...grpc.CallOption) (*RevokeSigningTokenResponse, error) {
+	out := new(RevokeSigningTokenResponse)
+	err := c.cc.Invoke(ctx, "/pactus.Wallet/RevokeSigningToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // WalletServer is the server API for Wallet service.
 // All implementations should embed UnimplementedWalletServer
 // for forward compatibility
@@ -127,6 +204,20 @@ type WalletServer interface {
 	SignRawTransaction(context.Context, *SignRawTransactionRequest) (*SignRawTransactionResponse, error)
 	// GetValidatorAddress retrieves the validator address associated with a public key.
 	GetValidatorAddress(context.Context, *GetValidatorAddressRequest) (*GetValidatorAddressResponse, error)
+	// CreateDraftTransaction stores an unsigned transaction as a draft, to be reviewed and signed later.
+	CreateDraftTransaction(context.Context, *CreateDraftTransactionRequest) (*CreateDraftTransactionResponse, error)
+	// ListDraftTransactions lists the draft transactions of a wallet that are not expired.
+	ListDraftTransactions(context.Context, *ListDraftTransactionsRequest) (*ListDraftTransactionsResponse, error)
+	// UpdateDraftTransaction replaces the transaction or the note of a draft transaction.
+	UpdateDraftTransaction(context.Context, *UpdateDraftTransactionRequest) (*UpdateDraftTransactionResponse, error)
+	// DeleteDraftTransaction deletes a draft transaction.
+	DeleteDraftTransaction(context.Context, *DeleteDraftTransactionRequest) (*DeleteDraftTransactionResponse, error)
+	// IssueSigningToken issues a scoped and expiring token to sign the transactions of a wallet without its password.
+	IssueSigningToken(context.Context, *IssueSigningTokenRequest) (*IssueSigningTokenResponse, error)
+	// ListSigningTokens lists the signing tokens of a wallet that are not expired.
+	ListSigningTokens(context.Context, *ListSigningTokensRequest) (*ListSigningTokensResponse, error)
+	// RevokeSigningToken revokes a signing token before it expires.
+	RevokeSigningToken(context.Context, *RevokeSigningTokenRequest) (*RevokeSigningTokenResponse, error)
 }
 
 // UnimplementedWalletServer should be embedded to have forward compatible implementations.
@@ -154,6 +245,27 @@ func (UnimplementedWalletServer) SignRawTransaction(context.Context, *SignRawTra
 func (UnimplementedWalletServer) GetValidatorAddress(context.Context, *GetValidatorAddressRequest) (*GetValidatorAddressResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetValidatorAddress not implemented")
 }
+func (UnimplementedWalletServer) CreateDraftTransaction(context.Context, *CreateDraftTransactionRequest) (*CreateDraftTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateDraftTransaction not implemented")
+}
+func (UnimplementedWalletServer) ListDraftTransactions(context.Context, *ListDraftTransactionsRequest) (*ListDraftTransactionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDraftTransactions not implemented")
+}
+func (UnimplementedWalletServer) UpdateDraftTransaction(context.Context, *UpdateDraftTransactionRequest) (*UpdateDraftTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateDraftTransaction not implemented")
+}
+func (UnimplementedWalletServer) DeleteDraftTransaction(context.Context, *DeleteDraftTransactionRequest) (*DeleteDraftTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteDraftTransaction not implemented")
+}
+func (UnimplementedWalletServer) IssueSigningToken(context.Context, *IssueSigningTokenRequest) (*IssueSigningTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IssueSigningToken not implemented")
+}
+func (UnimplementedWalletServer) ListSigningTokens(context.Context, *ListSigningTokensRequest) (*ListSigningTokensResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSigningTokens not implemented")
+}
+func (UnimplementedWalletServer) RevokeSigningToken(context.Context, *RevokeSigningTokenRequest) (*RevokeSigningTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeSigningToken not implemented")
+}
 
 // UnsafeWalletServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to WalletServer will
@@ -292,6 +404,132 @@ func _Wallet_GetValidatorAddress_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Wallet_CreateDraftTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateDraftTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServer).CreateDraftTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pactus.Wallet/CreateDraftTransaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServer).CreateDraftTransaction(ctx, req.(*CreateDraftTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Wallet_ListDraftTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDraftTransactionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServer).ListDraftTransactions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pactus.Wallet/ListDraftTransactions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServer).ListDraftTransactions(ctx, req.(*ListDraftTransactionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Wallet_UpdateDraftTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateDraftTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServer).UpdateDraftTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pactus.Wallet/UpdateDraftTransaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServer).UpdateDraftTransaction(ctx, req.(*UpdateDraftTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Wallet_DeleteDraftTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteDraftTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServer).DeleteDraftTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pactus.Wallet/DeleteDraftTransaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServer).DeleteDraftTransaction(ctx, req.(*DeleteDraftTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Wallet_IssueSigningToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IssueSigningTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServer).IssueSigningToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pactus.Wallet/IssueSigningToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServer).IssueSigningToken(ctx, req.(*IssueSigningTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Wallet_ListSigningTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSigningTokensRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServer).ListSigningTokens(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pactus.Wallet/ListSigningTokens",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServer).ListSigningTokens(ctx, req.(*ListSigningTokensRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Wallet_RevokeSigningToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeSigningTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServer).RevokeSigningToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pactus.Wallet/RevokeSigningToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServer).RevokeSigningToken(ctx, req.(*RevokeSigningTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Wallet_ServiceDesc is the grpc.ServiceDesc for Wallet service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -327,6 +565,34 @@ var Wallet_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetValidatorAddress",
 			Handler:    _Wallet_GetValidatorAddress_Handler,
 		},
+		{
+			MethodName: "CreateDraftTransaction",
+			Handler:    _Wallet_CreateDraftTransaction_Handler,
+		},
+		{
+			MethodName: "ListDraftTransactions",
+			Handler:    _Wallet_ListDraftTransactions_Handler,
+		},
+		{
+			MethodName: "UpdateDraftTransaction",
+			Handler:    _Wallet_UpdateDraftTransaction_Handler,
+		},
+		{
+			MethodName: "DeleteDraftTransaction",
+			Handler:    _Wallet_DeleteDraftTransaction_Handler,
+		},
+		{
+			MethodName: "IssueSigningToken",
+			Handler:    _Wallet_IssueSigningToken_Handler,
+		},
+		{
+			MethodName: "ListSigningTokens",
+			Handler:    _Wallet_ListSigningTokens_Handler,
+		},
+		{
+			MethodName: "RevokeSigningToken",
+			Handler:    _Wallet_RevokeSigningToken_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "wallet.proto",
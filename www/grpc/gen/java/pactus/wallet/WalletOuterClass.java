@@ -60,7 +60,7 @@ public final class WalletOuterClass {
 
     /**
      * <pre>
-     * Language for the mnemonic.
+     * Language of the mnemonic, detected from the mnemonic if not set.
      * </pre>
      *
      * <code>string language = 3 [json_name = "language"];</code>
@@ -69,7 +69,7 @@ public final class WalletOuterClass {
     java.lang.String getLanguage();
     /**
      * <pre>
-     * Language for the mnemonic.
+     * Language of the mnemonic, detected from the mnemonic if not set.
      * </pre>
      *
      * <code>string language = 3 [json_name = "language"];</code>
@@ -242,7 +242,7 @@ public final class WalletOuterClass {
     private volatile java.lang.Object language_;
     /**
      * <pre>
-     * Language for the mnemonic.
+     * Language of the mnemonic, detected from the mnemonic if not set.
      * </pre>
      *
      * <code>string language = 3 [json_name = "language"];</code>
@@ -263,7 +263,7 @@ public final class WalletOuterClass {
     }
     /**
      * <pre>
-     * Language for the mnemonic.
+     * Language of the mnemonic, detected from the mnemonic if not set.
      * </pre>
      *
      * <code>string language = 3 [json_name = "language"];</code>
@@ -910,7 +910,7 @@ public final class WalletOuterClass {
       private java.lang.Object language_ = "";
       /**
        * <pre>
-       * Language for the mnemonic.
+       * Language of the mnemonic, detected from the mnemonic if not set.
        * </pre>
        *
        * <code>string language = 3 [json_name = "language"];</code>
@@ -930,7 +930,7 @@ public final class WalletOuterClass {
       }
       /**
        * <pre>
-       * Language for the mnemonic.
+       * Language of the mnemonic, detected from the mnemonic if not set.
        * </pre>
        *
        * <code>string language = 3 [json_name = "language"];</code>
@@ -951,7 +951,7 @@ public final class WalletOuterClass {
       }
       /**
        * <pre>
-       * Language for the mnemonic.
+       * Language of the mnemonic, detected from the mnemonic if not set.
        * </pre>
        *
        * <code>string language = 3 [json_name = "language"];</code>
@@ -970,7 +970,7 @@ public final class WalletOuterClass {
       }
       /**
        * <pre>
-       * Language for the mnemonic.
+       * Language of the mnemonic, detected from the mnemonic if not set.
        * </pre>
        *
        * <code>string language = 3 [json_name = "language"];</code>
@@ -984,7 +984,7 @@ public final class WalletOuterClass {
       }
       /**
        * <pre>
-       * Language for the mnemonic.
+       * Language of the mnemonic, detected from the mnemonic if not set.
        * </pre>
        *
        * <code>string language = 3 [json_name = "language"];</code>
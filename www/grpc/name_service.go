@@ -0,0 +1,181 @@
+package grpc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pactus-project/pactus/crypto"
+	"github.com/pactus-project/pactus/util/errors"
+)
+
+// defaultNameServiceTimeout is the timeout of the name service, if it is not set.
+const defaultNameServiceTimeout = 5 * time.Second
+
+// NameResolver resolves the registered names, like `alice.pac`, to the addresses.
+// It returns NameNotFoundError if the name is not registered.
+type NameResolver interface {
+	ResolveName(ctx context.Context, name string) (crypto.Address, error)
+}
+
+// NameServiceConfig is the configuration of the external name service that resolves
+// the names of the receivers.
+type NameServiceConfig struct {
+	URL     string        `toml:"url"`
+	Timeout time.Duration `toml:"timeout"`
+}
+
+// BasicCheck performs basic checks on the configuration.
+func (conf *NameServiceConfig) BasicCheck() error {
+	if conf.Timeout < 0 {
+		return errors.Errorf(errors.ErrInvalidConfig, "name service timeout can't be negative")
+	}
+
+	if conf.URL == "" {
+		return nil
+	}
+
+	u, err := url.Parse(conf.URL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return errors.Errorf(errors.ErrInvalidConfig, "invalid name service url: %s", conf.URL)
+	}
+
+	return nil
+}
+
+// NameServiceResponse is the response of the name service.
+type NameServiceResponse struct {
+	Address string `json:"address"`
+}
+
+// IsName returns true if the string is not an address, but it looks like a name,
+// that has at least two labels separated by dots, like `alice.pac`.
+func IsName(str string) bool {
+	if _, err := crypto.AddressFromString(str); err == nil {
+		return false
+	}
+
+	labels := strings.Split(str, ".")
+	if len(labels) < 2 {
+		return false
+	}
+	for _, label := range labels {
+		if label == "" {
+			return false
+		}
+	}
+
+	return true
+}
+
+// httpNameResolver resolves the names by the name service at the given URL.
+// It sends `GET <url>?name=<name>` and expects a NameServiceResponse.
+type httpNameResolver struct {
+	url    string
+	client *http.Client
+}
+
+func newHTTPNameResolver(conf *NameServiceConfig) *httpNameResolver {
+	timeout := conf.Timeout
+	if timeout == 0 {
+		timeout = defaultNameServiceTimeout
+	}
+
+	return &httpNameResolver{
+		url:    conf.URL,
+		client: &http.Client{Timeout: timeout},
+	}
+}
+
+func (r *httpNameResolver) ResolveName(ctx context.Context, name string) (crypto.Address, error) {
+	u, err := url.Parse(r.url)
+	if err != nil {
+		return crypto.Address{}, err
+	}
+	query := u.Query()
+	query.Set("name", name)
+	u.RawQuery = query.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), http.NoBody)
+	if err != nil {
+		return crypto.Address{}, err
+	}
+
+	res, err := r.client.Do(req)
+	if err != nil {
+		return crypto.Address{}, err
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode == http.StatusNotFound {
+		return crypto.Address{}, NameNotFoundError{Name: name}
+	}
+	if res.StatusCode != http.StatusOK {
+		return crypto.Address{}, fmt.Errorf("name service responded with status: %s", res.Status)
+	}
+
+	nameRes := NameServiceResponse{}
+	if err := json.NewDecoder(res.Body).Decode(&nameRes); err != nil {
+		return crypto.Address{}, err
+	}
+
+	addr, err := crypto.AddressFromString(nameRes.Address)
+	if err != nil {
+		return crypto.Address{}, fmt.Errorf("name service returned an invalid address: %w", err)
+	}
+
+	return addr, nil
+}
+
+// nameService keeps the resolver of the names, that can be replaced while the server is running.
+type nameService struct {
+	lk       sync.RWMutex
+	resolver NameResolver
+}
+
+func (ns *nameService) setResolver(resolver NameResolver) {
+	ns.lk.Lock()
+	defer ns.lk.Unlock()
+
+	ns.resolver = resolver
+}
+
+func (ns *nameService) getResolver() NameResolver {
+	ns.lk.RLock()
+	defer ns.lk.RUnlock()
+
+	return ns.resolver
+}
+
+// SetNameResolver sets the resolver of the names.
+// By default, the names are resolved by the name service in the configuration, if any.
+func (s *Server) SetNameResolver(resolver NameResolver) {
+	s.nameService.setResolver(resolver)
+}
+
+// resolveAddress returns the address of the given string. If it is a name,
+// it is resolved by the name resolver, and the resolved flag is true.
+func (s *Server) resolveAddress(ctx context.Context, str string) (crypto.Address, bool, error) {
+	if !IsName(str) {
+		addr, err := crypto.AddressFromString(str)
+
+		return addr, false, err
+	}
+
+	resolver := s.nameService.getResolver()
+	if resolver == nil {
+		return crypto.Address{}, false, fmt.Errorf("name service is not configured to resolve: %s", str)
+	}
+
+	addr, err := resolver.ResolveName(ctx, str)
+	if err != nil {
+		return crypto.Address{}, false, err
+	}
+
+	return addr, true, nil
+}
@@ -0,0 +1,20 @@
+package grpc
+
+import (
+	"errors"
+	"fmt"
+)
+
+// NameNotFoundError is returned when the name is not registered in the name service.
+type NameNotFoundError struct {
+	Name string
+}
+
+func (e NameNotFoundError) Error() string {
+	return fmt.Sprintf("name is not registered: %s", e.Name)
+}
+
+// IsNameNotFound returns true if the name is not registered in the name service.
+func IsNameNotFound(err error) bool {
+	return errors.As(err, &NameNotFoundError{})
+}
@@ -0,0 +1,187 @@
+package grpc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/pactus-project/pactus/crypto"
+	"github.com/pactus-project/pactus/types/tx"
+	"github.com/pactus-project/pactus/util/testsuite"
+	pactus "github.com/pactus-project/pactus/www/grpc/gen/go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// mapNameResolver resolves the names by a map.
+type mapNameResolver map[string]crypto.Address
+
+func (r mapNameResolver) ResolveName(_ context.Context, name string) (crypto.Address, error) {
+	addr, ok := r[name]
+	if !ok {
+		return crypto.Address{}, NameNotFoundError{Name: name}
+	}
+
+	return addr, nil
+}
+
+// failingNameResolver fails to resolve any name, like an unreachable name service.
+type failingNameResolver struct{}
+
+func (failingNameResolver) ResolveName(_ context.Context, _ string) (crypto.Address, error) {
+	return crypto.Address{}, fmt.Errorf("name service is unreachable")
+}
+
+func TestNameServiceConfigBasicCheck(t *testing.T) {
+	tests := []struct {
+		name    string
+		conf    NameServiceConfig
+		wantErr bool
+	}{
+		{"Not configured", NameServiceConfig{}, false},
+		{"Valid URL", NameServiceConfig{URL: "https://names.example.com/resolve"}, false},
+		{"Invalid scheme", NameServiceConfig{URL: "ftp://names.example.com/resolve"}, true},
+		{"No host", NameServiceConfig{URL: "https:///resolve"}, true},
+		{"Negative timeout", NameServiceConfig{Timeout: -time.Second}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.conf.BasicCheck()
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestIsName(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	assert.True(t, IsName("alice.pac"))
+	assert.True(t, IsName("pay.alice.pac"))
+	assert.False(t, IsName("alice"))
+	assert.False(t, IsName("alice."))
+	assert.False(t, IsName(".pac"))
+	assert.False(t, IsName("alice..pac"))
+	assert.False(t, IsName(ts.RandAccAddress().String()))
+}
+
+func TestHTTPNameResolver(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	addr := ts.RandAccAddress()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Query().Get("name") {
+		case "alice.pac":
+			_ = json.NewEncoder(w).Encode(NameServiceResponse{Address: addr.String()})
+		case "bob.pac":
+			_ = json.NewEncoder(w).Encode(NameServiceResponse{Address: "invalid"})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	resolver := newHTTPNameResolver(&NameServiceConfig{URL: server.URL})
+
+	t.Run("Should resolve a registered name", func(t *testing.T) {
+		resolved, err := resolver.ResolveName(context.Background(), "alice.pac")
+		require.NoError(t, err)
+		assert.Equal(t, addr, resolved)
+	})
+
+	t.Run("Should fail for an invalid address", func(t *testing.T) {
+		_, err := resolver.ResolveName(context.Background(), "bob.pac")
+		assert.Error(t, err)
+	})
+
+	t.Run("Should fail for a name that is not registered", func(t *testing.T) {
+		_, err := resolver.ResolveName(context.Background(), "carol.pac")
+		assert.ErrorIs(t, err, NameNotFoundError{Name: "carol.pac"})
+		assert.True(t, IsNameNotFound(err))
+	})
+}
+
+func TestResolveName(t *testing.T) {
+	td := setup(t, nil)
+	conn, client := td.transactionClient(t)
+
+	t.Run("Name service is not configured", func(t *testing.T) {
+		_, err := client.ResolveName(context.Background(),
+			&pactus.ResolveNameRequest{Name: "alice.pac"})
+		assert.Error(t, err)
+	})
+
+	addr := td.RandAccAddress()
+	td.server.SetNameResolver(mapNameResolver{"alice.pac": addr})
+
+	t.Run("Should resolve a registered name", func(t *testing.T) {
+		res, err := client.ResolveName(context.Background(),
+			&pactus.ResolveNameRequest{Name: "alice.pac"})
+		require.NoError(t, err)
+		assert.Equal(t, "alice.pac", res.Name)
+		assert.Equal(t, addr.String(), res.Address)
+	})
+
+	t.Run("Should fail for a name that is not registered", func(t *testing.T) {
+		_, err := client.ResolveName(context.Background(),
+			&pactus.ResolveNameRequest{Name: "bob.pac"})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	t.Run("Should fail for an address", func(t *testing.T) {
+		_, err := client.ResolveName(context.Background(),
+			&pactus.ResolveNameRequest{Name: addr.String()})
+		assert.Error(t, err)
+	})
+
+	t.Run("Should echo the resolved receiver of a transfer", func(t *testing.T) {
+		res, err := client.GetRawTransferTransaction(context.Background(),
+			&pactus.GetRawTransferTransactionRequest{
+				LockTime: td.RandHeight(),
+				Sender:   td.RandAccAddress().String(),
+				Receiver: "alice.pac",
+				Amount:   td.RandAmount(),
+				Fee:      td.RandAmount(),
+			})
+		require.NoError(t, err)
+		assert.Equal(t, addr.String(), res.ResolvedReceiver)
+
+		trx, err := tx.FromBytes(res.RawTransaction)
+		require.NoError(t, err)
+		assert.Equal(t, addr, *trx.Payload().Receiver())
+	})
+
+	t.Run("Should not echo the receiver address", func(t *testing.T) {
+		res, err := client.GetRawTransferTransaction(context.Background(),
+			&pactus.GetRawTransferTransactionRequest{
+				LockTime: td.RandHeight(),
+				Sender:   td.RandAccAddress().String(),
+				Receiver: addr.String(),
+				Amount:   td.RandAmount(),
+				Fee:      td.RandAmount(),
+			})
+		require.NoError(t, err)
+		assert.Empty(t, res.ResolvedReceiver)
+	})
+
+	t.Run("Should fail internally if the name service fails", func(t *testing.T) {
+		td.server.SetNameResolver(failingNameResolver{})
+
+		_, err := client.ResolveName(context.Background(),
+			&pactus.ResolveNameRequest{Name: "alice.pac"})
+		assert.Equal(t, codes.Internal, status.Code(err))
+	})
+
+	assert.Nil(t, conn.Close(), "Error closing connection")
+	td.StopServer()
+}
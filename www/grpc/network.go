@@ -2,13 +2,26 @@ package grpc
 
 import (
 	"context"
+	"time"
 	"unsafe"
 
 	"github.com/fxamacker/cbor/v2"
+	lp2ppeer "github.com/libp2p/go-libp2p/core/peer"
+	"github.com/pactus-project/pactus/crypto"
+	"github.com/pactus-project/pactus/genesis"
+	"github.com/pactus-project/pactus/network"
+	"github.com/pactus-project/pactus/sync"
 	"github.com/pactus-project/pactus/sync/peerset"
 	"github.com/pactus-project/pactus/sync/peerset/service"
+	"github.com/pactus-project/pactus/util/deprecation"
+	"github.com/pactus-project/pactus/util/logger"
+	"github.com/pactus-project/pactus/util/recovery"
+	"github.com/pactus-project/pactus/util/selftest"
 	"github.com/pactus-project/pactus/version"
 	pactus "github.com/pactus-project/pactus/www/grpc/gen/go"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
 )
 
 type networkServer struct {
@@ -39,6 +52,13 @@ func (s *networkServer) GetNodeInfo(_ context.Context,
 		servicesNames = append(servicesNames, "NETWORK")
 	}
 
+	health := recovery.Health()
+
+	primaries := [][]byte{}
+	for _, pid := range s.sync.Primaries() {
+		primaries = append(primaries, []byte(pid))
+	}
+
 	return &pactus.GetNodeInfoResponse{
 		Moniker:       s.sync.Moniker(),
 		Agent:         version.Agent(),
@@ -49,9 +69,240 @@ func (s *networkServer) GetNodeInfo(_ context.Context,
 		Protocols:     s.net.Protocols(),
 		Services:      services,
 		ServicesNames: servicesNames,
+		SelfTest:      selfTestToProto(s.selfTest),
+		SafeMode:      s.consMgr.IsSafeMode(),
+
+		ProtocolVersion:   version.ProtocolVersion(),
+		Capabilities:      uint32(s.sync.Capabilities()),
+		CapabilitiesNames: s.sync.Capabilities().Names(),
+		Attestation:       s.sync.Attestation(),
+		Replica:           len(primaries) > 0,
+		Primaries:         primaries,
+		Commit:            version.Commit(),
+		Health:            pactus.HealthStatus(recovery.OverallHealth(health)),
+		SubsystemsHealth:  healthToProto(health),
+		EclipseRisk:       eclipseRiskToProto(s.net.EclipseRisk()),
+		PausedValidators:  addressesToStrings(s.state.PausedValidators()),
+	}, nil
+}
+
+// SetSafeMode is only accepted through the unix socket, which is restricted
+// to the owner of the node process. Otherwise, anyone that can reach the gRPC
+// server could stop the validators from signing.
+func (s *networkServer) SetSafeMode(ctx context.Context,
+	req *pactus.SetSafeModeRequest,
+) (*pactus.SetSafeModeResponse, error) {
+	if !isUnixSocket(ctx) {
+		return nil, status.Errorf(codes.PermissionDenied, "safe mode can only be changed through the unix socket")
+	}
+
+	if !req.Enable && s.state.Genesis().ChainType() == genesis.Simnet {
+		return nil, status.Errorf(codes.FailedPrecondition, "safe mode can't be disabled on the simnet")
+	}
+
+	s.consMgr.SetSafeMode(req.Enable)
+	s.logger.Warn("safe mode is changed", "enable", req.Enable)
+
+	return &pactus.SetSafeModeResponse{
+		SafeMode: s.consMgr.IsSafeMode(),
 	}, nil
 }
 
+// SetLogConfig is only accepted through the unix socket, since enabling the debug logs
+// on a busy node can fill up the disk.
+func (s *networkServer) SetLogConfig(ctx context.Context,
+	req *pactus.SetLogConfigRequest,
+) (*pactus.SetLogConfigResponse, error) {
+	if !isUnixSocket(ctx) {
+		return nil, status.Errorf(codes.PermissionDenied, "log config can only be changed through the unix socket")
+	}
+	if req.Module == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "module is not set")
+	}
+
+	if req.Level != "" {
+		if err := logger.SetLevel(req.Module, req.Level); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid log level: %s", err.Error())
+		}
+	}
+	if req.Sampling != nil {
+		sampling := logger.SamplingConfig{
+			Every:  req.Sampling.Every,
+			Burst:  req.Sampling.Burst,
+			Period: time.Duration(req.Sampling.Period) * time.Millisecond,
+		}
+		if err := logger.SetSampling(req.Module, sampling); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid sampling: %s", err.Error())
+		}
+	}
+
+	level, sampling := logger.CategoryConfig(req.Module)
+	s.logger.Warn("log config is changed", "module", req.Module, "level", level,
+		"every", sampling.Every, "burst", sampling.Burst, "period", sampling.Period)
+
+	return &pactus.SetLogConfigResponse{
+		Module: req.Module,
+		Level:  level,
+		Sampling: &pactus.LogSampling{
+			Every:  sampling.Every,
+			Burst:  sampling.Burst,
+			Period: uint32(sampling.Period.Milliseconds()),
+		},
+	}, nil
+}
+
+// isUnixSocket checks if the request is received through the unix socket.
+func isUnixSocket(ctx context.Context) bool {
+	p, ok := peer.FromContext(ctx)
+
+	return ok && p.Addr != nil && p.Addr.Network() == "unix"
+}
+
+func (s *networkServer) GetDeprecations(_ context.Context,
+	req *pactus.GetDeprecationsRequest,
+) (*pactus.GetDeprecationsResponse, error) {
+	entries := deprecation.List(req.OnlyUsed)
+	deprecations := make([]*pactus.DeprecationInfo, 0, len(entries))
+	for _, entry := range entries {
+		deprecations = append(deprecations, &pactus.DeprecationInfo{
+			Kind:        string(entry.Kind),
+			Name:        entry.Name,
+			Replacement: entry.Replacement,
+			Since:       entry.Since,
+			UseCount:    entry.UseCount,
+		})
+	}
+
+	return &pactus.GetDeprecationsResponse{
+		Deprecations: deprecations,
+	}, nil
+}
+
+func (s *networkServer) GetPropagationStats(_ context.Context,
+	req *pactus.GetPropagationStatsRequest,
+) (*pactus.GetPropagationStatsResponse, error) {
+	records := s.sync.PropagationRecords()
+	res := &pactus.GetPropagationStatsResponse{
+		Blocks:    propagationStatsToProto(sync.CalcPropagationStats(records, sync.PropagationBlock)),
+		Proposals: propagationStatsToProto(sync.CalcPropagationStats(records, sync.PropagationProposal)),
+	}
+
+	if req.IncludeRecords {
+		res.Records = make([]*pactus.PropagationRecord, 0, len(records))
+		for _, rec := range records {
+			res.Records = append(res.Records, &pactus.PropagationRecord{
+				Kind:      pactus.PropagationKind(rec.Kind),
+				Height:    rec.Height,
+				Round:     int32(rec.Round),
+				BlockHash: rec.BlockHash.Bytes(),
+				BlockTime: uint32(rec.BlockTime.Unix()),
+				FirstSeen: rec.FirstSeen.UnixMilli(),
+				Latency:   rec.Latency().Milliseconds(),
+				PeerId:    []byte(rec.PeerID),
+			})
+		}
+	}
+
+	return res, nil
+}
+
+func (s *networkServer) GetPeerEvidence(_ context.Context,
+	req *pactus.GetPeerEvidenceRequest,
+) (*pactus.GetPeerEvidenceResponse, error) {
+	var pid lp2ppeer.ID
+	if len(req.PeerId) > 0 {
+		var err error
+		pid, err = lp2ppeer.IDFromBytes(req.PeerId)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid peer ID: %v", err)
+		}
+	}
+
+	evidences := s.sync.PeerEvidences(pid)
+	res := &pactus.GetPeerEvidenceResponse{
+		Evidences: make([]*pactus.PeerEvidence, 0, len(evidences)),
+	}
+	for _, evidence := range evidences {
+		res.Evidences = append(res.Evidences, &pactus.PeerEvidence{
+			Time:           evidence.Time.UnixMilli(),
+			PeerId:         []byte(evidence.PeerID),
+			Address:        evidence.Address,
+			Agent:          evidence.Agent,
+			Reason:         evidence.Reason,
+			Error:          evidence.Error,
+			Height:         evidence.Height,
+			InvalidBundles: int32(evidence.InvalidBundles),
+			Data:           evidence.Data,
+			DataSize:       int32(evidence.DataSize),
+		})
+	}
+
+	return res, nil
+}
+
+func propagationStatsToProto(stats sync.PropagationStats) *pactus.PropagationStats {
+	return &pactus.PropagationStats{
+		Count:         uint32(stats.Count),
+		MinLatency:    stats.Min.Milliseconds(),
+		MedianLatency: stats.Median.Milliseconds(),
+		P90Latency:    stats.P90.Milliseconds(),
+		MaxLatency:    stats.Max.Milliseconds(),
+	}
+}
+
+func selfTestToProto(results []selftest.Result) []*pactus.SelfTestResult {
+	protoResults := make([]*pactus.SelfTestResult, 0, len(results))
+	for _, res := range results {
+		protoResults = append(protoResults, &pactus.SelfTestResult{
+			Name:    res.Name,
+			Status:  pactus.SelfTestStatus(res.Status),
+			Message: res.Message,
+		})
+	}
+
+	return protoResults
+}
+
+func healthToProto(subsystems []recovery.SubsystemHealth) []*pactus.SubsystemHealth {
+	protoHealth := make([]*pactus.SubsystemHealth, 0, len(subsystems))
+	for _, h := range subsystems {
+		protoHealth = append(protoHealth, &pactus.SubsystemHealth{
+			Name:     h.Name,
+			Status:   pactus.HealthStatus(h.Status),
+			Reasons:  h.Reasons,
+			Errors:   h.Errors,
+			Panics:   h.Panics,
+			Restarts: h.Restarts,
+		})
+	}
+
+	return protoHealth
+}
+
+func addressesToStrings(addrs []crypto.Address) []string {
+	strs := make([]string, 0, len(addrs))
+	for _, addr := range addrs {
+		strs = append(strs, addr.String())
+	}
+
+	return strs
+}
+
+func eclipseRiskToProto(risk network.EclipseRisk) *pactus.EclipseRisk {
+	return &pactus.EclipseRisk{
+		Score:             int32(risk.Score),
+		Level:             pactus.EclipseRiskLevel(risk.Level),
+		Peers:             int32(risk.Peers),
+		InboundPeers:      int32(risk.InboundPeers),
+		OutboundPeers:     int32(risk.OutboundPeers),
+		YoungPeers:        int32(risk.YoungPeers),
+		NetGroups:         int32(risk.NetGroups),
+		OutboundNetGroups: int32(risk.OutboundNetGroups),
+		LargestGroupShare: risk.LargestGroupShare,
+		Reasons:           risk.Reasons,
+	}
+}
+
 func (s *networkServer) GetNetworkInfo(_ context.Context,
 	_ *pactus.GetNetworkInfoRequest,
 ) (*pactus.GetNetworkInfoResponse, error) {
@@ -88,6 +339,15 @@ func (s *networkServer) GetNetworkInfo(_ context.Context,
 		p.LastBlockHash = peer.LastBlockHash.Bytes()
 		p.TotalSessions = int32(peer.TotalSessions)
 		p.CompletedSessions = int32(peer.CompletedSessions)
+		p.ProtocolVersion = peer.ProtocolVersion
+		p.Capabilities = uint32(peer.Capabilities)
+		p.NegotiatedCapabilities = uint32(peer.Negotiated)
+		p.RoundTripTime = peer.RTT.Milliseconds()
+		p.ClockOffset = peer.ClockOffset.Milliseconds()
+		p.ServedBytes = peer.ServedBytes()
+		p.ConsumedBytes = peer.ConsumedBytes()
+		p.Attestation = peer.Attestation
+		p.PausedValidators = addressesToStrings(peer.PausedValidators)
 
 		for _, key := range peer.ConsensusKeys {
 			p.ConsensusKeys = append(p.ConsensusKeys, key.String())
@@ -100,6 +360,16 @@ func (s *networkServer) GetNetworkInfo(_ context.Context,
 	sentBytes := ps.SentBytes()
 	receivedBytes := ps.ReceivedBytes()
 
+	peerVersions := []*pactus.PeerVersion{}
+	for _, stat := range ps.VersionStats() {
+		peerVersions = append(peerVersions, &pactus.PeerVersion{
+			Version:         stat.Version,
+			Commit:          stat.Commit,
+			ProtocolVersion: stat.ProtocolVersion,
+			Count:           int32(stat.Count),
+		})
+	}
+
 	return &pactus.GetNetworkInfoResponse{
 		TotalSentBytes:      uint32(ps.TotalSentBytes()),
 		TotalReceivedBytes:  uint32(ps.TotalReceivedBytes()),
@@ -108,5 +378,6 @@ func (s *networkServer) GetNetworkInfo(_ context.Context,
 		ConnectedPeers:      peerInfos,
 		SentBytes:           *(*map[uint32]uint64)(unsafe.Pointer(&sentBytes)),
 		ReceivedBytes:       *(*map[uint32]uint64)(unsafe.Pointer(&receivedBytes)),
+		PeerVersions:        peerVersions,
 	}, nil
 }
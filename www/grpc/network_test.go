@@ -2,12 +2,33 @@ package grpc
 
 import (
 	"context"
+	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/pactus-project/pactus/consensus"
+	"github.com/pactus-project/pactus/crypto"
+	"github.com/pactus-project/pactus/crypto/bls"
+	"github.com/pactus-project/pactus/network"
+	"github.com/pactus-project/pactus/state"
+	"github.com/pactus-project/pactus/sync"
+	"github.com/pactus-project/pactus/sync/firewall"
+	"github.com/pactus-project/pactus/sync/peerset"
+	"github.com/pactus-project/pactus/util"
+	"github.com/pactus-project/pactus/util/deprecation"
+	"github.com/pactus-project/pactus/util/logger"
+	"github.com/pactus-project/pactus/util/recovery"
+	"github.com/pactus-project/pactus/util/selftest"
+	"github.com/pactus-project/pactus/util/testsuite"
 	"github.com/pactus-project/pactus/version"
 	pactus "github.com/pactus-project/pactus/www/grpc/gen/go"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
 )
 
 func TestGetNetworkInfo(t *testing.T) {
@@ -23,6 +44,13 @@ func TestGetNetworkInfo(t *testing.T) {
 	})
 
 	t.Run("Should return peer info", func(t *testing.T) {
+		td.mockSync.PeerSet().IteratePeers(func(p *peerset.Peer) bool {
+			td.mockSync.PeerSet().UpdatePausedValidators(p.PeerID,
+				[]crypto.Address{p.ConsensusKeys[0].ValidatorAddress()})
+
+			return false
+		})
+
 		res, err := client.GetNetworkInfo(context.Background(),
 			&pactus.GetNetworkInfoRequest{})
 		assert.NoError(t, err)
@@ -35,6 +63,15 @@ func TestGetNetworkInfo(t *testing.T) {
 			assert.Equal(t, p.Agent, pp.Agent)
 			assert.Equal(t, p.Moniker, pp.Moniker)
 			assert.Equal(t, p.Height, pp.Height)
+			assert.Equal(t, p.ProtocolVersion, pp.ProtocolVersion)
+			assert.Equal(t, p.Capabilities, uint32(pp.Capabilities))
+			assert.Equal(t, p.NegotiatedCapabilities, uint32(pp.Negotiated))
+			assert.Equal(t, p.RoundTripTime, pp.RTT.Milliseconds())
+			assert.Equal(t, p.ClockOffset, pp.ClockOffset.Milliseconds())
+			assert.Equal(t, p.ServedBytes, pp.ServedBytes())
+			assert.Equal(t, p.ConsumedBytes, pp.ConsumedBytes())
+			assert.Equal(t, p.Attestation, pp.Attestation)
+			assert.Equal(t, []string{pp.ConsensusKeys[0].ValidatorAddress().String()}, p.PausedValidators)
 			assert.NotEmpty(t, pp.ConsensusKeys)
 			for _, key := range pp.ConsensusKeys {
 				assert.Contains(t, p.ConsensusKeys, key.String())
@@ -42,6 +79,23 @@ func TestGetNetworkInfo(t *testing.T) {
 		}
 	})
 
+	t.Run("Should return peer versions", func(t *testing.T) {
+		td.mockSync.PeerSet().IteratePeers(func(p *peerset.Peer) bool {
+			td.mockSync.PeerSet().UpdateStatus(p.PeerID, peerset.StatusCodeKnown)
+
+			return false
+		})
+
+		res, err := client.GetNetworkInfo(context.Background(),
+			&pactus.GetNetworkInfoRequest{})
+		assert.NoError(t, err)
+		require.Len(t, res.PeerVersions, 1)
+		assert.Equal(t, version.Version(), res.PeerVersions[0].Version)
+		assert.Equal(t, version.Commit(), res.PeerVersions[0].Commit)
+		assert.Equal(t, version.ProtocolVersion(), res.PeerVersions[0].ProtocolVersion)
+		assert.Equal(t, int32(2), res.PeerVersions[0].Count)
+	})
+
 	assert.Nil(t, conn.Close(), "Error closing connection")
 	td.StopServer()
 }
@@ -49,6 +103,11 @@ func TestGetNetworkInfo(t *testing.T) {
 func TestGetNodeInfo(t *testing.T) {
 	td := setup(t, nil)
 	conn, client := td.networkClient(t)
+	td.mockSync.TestAttestation = []byte("quote")
+	primary := td.RandPeerID()
+	td.mockSync.TestPrimaries = []peer.ID{primary}
+	paused := td.RandValAddress()
+	td.mockState.TestPaused = []crypto.Address{paused}
 
 	res, err := client.GetNodeInfo(context.Background(),
 		&pactus.GetNodeInfoRequest{})
@@ -57,6 +116,383 @@ func TestGetNodeInfo(t *testing.T) {
 	assert.Equal(t, version.Agent(), res.Agent)
 	assert.Equal(t, []byte(td.mockSync.SelfID()), res.PeerId)
 	assert.Equal(t, "test-moniker", res.Moniker)
+	assert.Empty(t, res.SelfTest)
+	assert.False(t, res.SafeMode)
+	assert.Equal(t, version.ProtocolVersion(), res.ProtocolVersion)
+	assert.Equal(t, version.Commit(), res.Commit)
+	assert.Equal(t, uint32(td.mockSync.Capabilities()), res.Capabilities)
+	assert.Equal(t, []string{"COMPRESSION"}, res.CapabilitiesNames)
+	assert.Equal(t, []byte("quote"), res.Attestation)
+	assert.True(t, res.Replica)
+	assert.Equal(t, [][]byte{[]byte(primary)}, res.Primaries)
+	assert.Equal(t, []string{paused.String()}, res.PausedValidators)
+
+	assert.Nil(t, conn.Close(), "Error closing connection")
+	td.StopServer()
+}
+
+func TestGetNodeInfoHealth(t *testing.T) {
+	td := setup(t, nil)
+	conn, client := td.networkClient(t)
+
+	log := logger.NewSubLogger("_grpc-health-test", nil)
+	for i := uint64(0); i <= recovery.ErrorBudget; i++ {
+		log.Error("error")
+	}
+
+	res, err := client.GetNodeInfo(context.Background(),
+		&pactus.GetNodeInfoRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, pactus.HealthStatus_HEALTH_DEGRADED, res.Health)
+
+	var subsystem *pactus.SubsystemHealth
+	for _, h := range res.SubsystemsHealth {
+		if h.Name == "grpc-health-test" {
+			subsystem = h
+		}
+	}
+	require.NotNil(t, subsystem)
+	assert.Equal(t, pactus.HealthStatus_HEALTH_DEGRADED, subsystem.Status)
+	assert.Equal(t, recovery.ErrorBudget+1, subsystem.Errors)
+	assert.Len(t, subsystem.Reasons, 1)
+
+	assert.Nil(t, conn.Close(), "Error closing connection")
+	td.StopServer()
+}
+
+func TestGetNodeInfoEclipseRisk(t *testing.T) {
+	td := setup(t, nil)
+	td.mockNet.Risk = network.EclipseRisk{
+		Score:             81,
+		Level:             network.EclipseRiskHigh,
+		Peers:             6,
+		InboundPeers:      5,
+		OutboundPeers:     1,
+		YoungPeers:        5,
+		NetGroups:         2,
+		OutboundNetGroups: 1,
+		LargestGroupShare: 0.5,
+		Reasons:           []string{"83% of the peers are inbound"},
+	}
+	conn, client := td.networkClient(t)
+
+	res, err := client.GetNodeInfo(context.Background(),
+		&pactus.GetNodeInfoRequest{})
+	assert.NoError(t, err)
+
+	risk := res.EclipseRisk
+	require.NotNil(t, risk)
+	assert.Equal(t, int32(81), risk.Score)
+	assert.Equal(t, pactus.EclipseRiskLevel_ECLIPSE_RISK_HIGH, risk.Level)
+	assert.Equal(t, int32(6), risk.Peers)
+	assert.Equal(t, int32(5), risk.InboundPeers)
+	assert.Equal(t, int32(1), risk.OutboundPeers)
+	assert.Equal(t, int32(5), risk.YoungPeers)
+	assert.Equal(t, int32(2), risk.NetGroups)
+	assert.Equal(t, int32(1), risk.OutboundNetGroups)
+	assert.Equal(t, 0.5, risk.LargestGroupShare)
+	assert.Equal(t, []string{"83% of the peers are inbound"}, risk.Reasons)
+
+	assert.Nil(t, conn.Close(), "Error closing connection")
+	td.StopServer()
+}
+
+func TestGetNodeInfoSelfTest(t *testing.T) {
+	td := setup(t, nil)
+	td.server.selfTest = []selftest.Result{
+		{Name: "ports", Status: selftest.StatusPassed, Message: "ok"},
+		{Name: "open_files", Status: selftest.StatusWarning, Message: "increase the limit"},
+	}
+	conn, client := td.networkClient(t)
+
+	res, err := client.GetNodeInfo(context.Background(),
+		&pactus.GetNodeInfoRequest{})
+	assert.NoError(t, err)
+	require.Len(t, res.SelfTest, 2)
+	assert.Equal(t, "ports", res.SelfTest[0].Name)
+	assert.Equal(t, pactus.SelfTestStatus_SELF_TEST_PASSED, res.SelfTest[0].Status)
+	assert.Equal(t, "open_files", res.SelfTest[1].Name)
+	assert.Equal(t, pactus.SelfTestStatus_SELF_TEST_WARNING, res.SelfTest[1].Status)
+	assert.Equal(t, "increase the limit", res.SelfTest[1].Message)
+
+	assert.Nil(t, conn.Close(), "Error closing connection")
+	td.StopServer()
+}
+
+func TestSetSafeMode(t *testing.T) {
+	t.Run("Should reject requests outside the unix socket", func(t *testing.T) {
+		td := setup(t, nil)
+		conn, client := td.networkClient(t)
+
+		_, err := client.SetSafeMode(context.Background(),
+			&pactus.SetSafeModeRequest{Enable: true})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+		assert.False(t, td.mockConsMgr.IsSafeMode())
+
+		assert.Nil(t, conn.Close(), "Error closing connection")
+		td.StopServer()
+	})
+
+	t.Run("Should toggle the safe mode through the unix socket", func(t *testing.T) {
+		ts := testsuite.NewTestSuite(t)
+
+		mockConsMgr, consMocks := consensus.MockingManager(ts, []*bls.ValidatorKey{
+			ts.RandValKey(), ts.RandValKey(),
+		})
+		mockState := state.MockingState(ts)
+		mockNet := network.MockingNetwork(ts, ts.RandPeerID())
+		mockSync := sync.MockingSync(ts)
+
+		conf := testConfig()
+		conf.Enable = true
+		conf.Listen = ""
+		conf.UnixSocket = filepath.Join(util.TempDirPath(), "pactus.sock")
+
+		server := NewServer(conf, mockState, mockSync, mockNet, mockConsMgr, nil, nil)
+		require.NoError(t, server.StartServer())
+		defer server.StopServer()
+
+		conn, err := grpc.Dial(server.Address(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+		require.NoError(t, err)
+		defer conn.Close()
+		client := pactus.NewNetworkClient(conn)
+
+		res, err := client.SetSafeMode(context.Background(),
+			&pactus.SetSafeModeRequest{Enable: true})
+		require.NoError(t, err)
+		assert.True(t, res.SafeMode)
+		for _, cons := range consMocks {
+			assert.True(t, cons.IsSafeMode())
+		}
+
+		info, err := client.GetNodeInfo(context.Background(), &pactus.GetNodeInfoRequest{})
+		require.NoError(t, err)
+		assert.True(t, info.SafeMode)
+
+		res, err = client.SetSafeMode(context.Background(),
+			&pactus.SetSafeModeRequest{Enable: false})
+		require.NoError(t, err)
+		assert.False(t, res.SafeMode)
+		assert.False(t, mockConsMgr.IsSafeMode())
+	})
+}
+
+func TestSetLogConfig(t *testing.T) {
+	t.Run("Should reject requests outside the unix socket", func(t *testing.T) {
+		td := setup(t, nil)
+		conn, client := td.networkClient(t)
+
+		_, err := client.SetLogConfig(context.Background(),
+			&pactus.SetLogConfigRequest{Module: "_consensus", Level: "debug"})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+		assert.Nil(t, conn.Close(), "Error closing connection")
+		td.StopServer()
+	})
+
+	t.Run("Should change the log config through the unix socket", func(t *testing.T) {
+		ts := testsuite.NewTestSuite(t)
+
+		conf := testConfig()
+		conf.Enable = true
+		conf.Listen = ""
+		conf.UnixSocket = filepath.Join(util.TempDirPath(), "pactus.sock")
+
+		mockConsMgr, _ := consensus.MockingManager(ts, nil)
+		server := NewServer(conf, state.MockingState(ts), sync.MockingSync(ts),
+			network.MockingNetwork(ts, ts.RandPeerID()), mockConsMgr, nil, nil)
+		require.NoError(t, server.StartServer())
+		defer server.StopServer()
+
+		conn, err := grpc.Dial(server.Address(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+		require.NoError(t, err)
+		defer conn.Close()
+		client := pactus.NewNetworkClient(conn)
+
+		_, err = client.SetLogConfig(context.Background(),
+			&pactus.SetLogConfigRequest{Level: "debug"})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		_, err = client.SetLogConfig(context.Background(),
+			&pactus.SetLogConfigRequest{Module: "_test_log", Level: "invalid"})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		_, err = client.SetLogConfig(context.Background(),
+			&pactus.SetLogConfigRequest{Module: "_test_log", Sampling: &pactus.LogSampling{Burst: 10}})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		res, err := client.SetLogConfig(context.Background(),
+			&pactus.SetLogConfigRequest{
+				Module:   "_test_log",
+				Level:    "debug",
+				Sampling: &pactus.LogSampling{Every: 100, Burst: 10, Period: 1000},
+			})
+		require.NoError(t, err)
+		assert.Equal(t, "_test_log", res.Module)
+		assert.Equal(t, "debug", res.Level)
+		assert.Equal(t, uint32(100), res.Sampling.Every)
+		assert.Equal(t, uint32(10), res.Sampling.Burst)
+		assert.Equal(t, uint32(1000), res.Sampling.Period)
+
+		// Not setting the sampling keeps the current one.
+		res, err = client.SetLogConfig(context.Background(),
+			&pactus.SetLogConfigRequest{Module: "_test_log", Level: "trace"})
+		require.NoError(t, err)
+		assert.Equal(t, "trace", res.Level)
+		assert.Equal(t, uint32(100), res.Sampling.Every)
+	})
+}
+
+func TestGetDeprecations(t *testing.T) {
+	td := setup(t, nil)
+	conn, client := td.networkClient(t)
+
+	deprecation.Register(deprecation.Deprecation{
+		Kind: deprecation.KindFlag, Name: "test-unused", Replacement: "test-new", Since: "1.1.0",
+	})
+	deprecation.Use(deprecation.Deprecation{Kind: deprecation.KindFlag, Name: "test-used"})
+
+	findInfo := func(infos []*pactus.DeprecationInfo, name string) *pactus.DeprecationInfo {
+		for _, info := range infos {
+			if info.Name == name {
+				return info
+			}
+		}
+
+		return nil
+	}
+
+	t.Run("Should return all deprecations", func(t *testing.T) {
+		res, err := client.GetDeprecations(context.Background(),
+			&pactus.GetDeprecationsRequest{})
+		assert.NoError(t, err)
+
+		info := findInfo(res.Deprecations, "test-unused")
+		require.NotNil(t, info)
+		assert.Equal(t, "flag", info.Kind)
+		assert.Equal(t, "test-new", info.Replacement)
+		assert.Equal(t, "1.1.0", info.Since)
+		assert.Zero(t, info.UseCount)
+	})
+
+	t.Run("Should return only the used deprecations", func(t *testing.T) {
+		res, err := client.GetDeprecations(context.Background(),
+			&pactus.GetDeprecationsRequest{OnlyUsed: true})
+		assert.NoError(t, err)
+
+		assert.Nil(t, findInfo(res.Deprecations, "test-unused"))
+		info := findInfo(res.Deprecations, "test-used")
+		require.NotNil(t, info)
+		assert.Equal(t, uint64(1), info.UseCount)
+	})
+
+	assert.Nil(t, conn.Close(), "Error closing connection")
+	td.StopServer()
+}
+
+func TestGetPropagationStats(t *testing.T) {
+	td := setup(t, nil)
+	conn, client := td.networkClient(t)
+
+	blockTime := time.Now().Truncate(time.Second)
+	pid := td.RandPeerID()
+	blockHash := td.RandHash()
+	td.mockSync.TestPropagation = []sync.PropagationRecord{
+		{
+			Kind: sync.PropagationProposal, Height: 10, Round: 1, BlockHash: blockHash,
+			BlockTime: blockTime, FirstSeen: blockTime.Add(200 * time.Millisecond), PeerID: pid,
+		},
+		{
+			Kind: sync.PropagationBlock, Height: 10, Round: 1, BlockHash: blockHash,
+			BlockTime: blockTime, FirstSeen: blockTime.Add(900 * time.Millisecond), PeerID: pid,
+		},
+	}
+
+	t.Run("Should return the statistics", func(t *testing.T) {
+		res, err := client.GetPropagationStats(context.Background(),
+			&pactus.GetPropagationStatsRequest{})
+		assert.NoError(t, err)
+
+		assert.Equal(t, uint32(1), res.Blocks.Count)
+		assert.Equal(t, int64(900), res.Blocks.MedianLatency)
+		assert.Equal(t, uint32(1), res.Proposals.Count)
+		assert.Equal(t, int64(200), res.Proposals.MaxLatency)
+		assert.Empty(t, res.Records)
+	})
+
+	t.Run("Should return the records", func(t *testing.T) {
+		res, err := client.GetPropagationStats(context.Background(),
+			&pactus.GetPropagationStatsRequest{IncludeRecords: true})
+		assert.NoError(t, err)
+
+		require.Len(t, res.Records, 2)
+		rec := res.Records[0]
+		assert.Equal(t, pactus.PropagationKind_PROPAGATION_PROPOSAL, rec.Kind)
+		assert.Equal(t, uint32(10), rec.Height)
+		assert.Equal(t, int32(1), rec.Round)
+		assert.Equal(t, blockHash.Bytes(), rec.BlockHash)
+		assert.Equal(t, uint32(blockTime.Unix()), rec.BlockTime)
+		assert.Equal(t, int64(200), rec.Latency)
+		assert.Equal(t, []byte(pid), rec.PeerId)
+		assert.Equal(t, pactus.PropagationKind_PROPAGATION_BLOCK, res.Records[1].Kind)
+	})
+
+	assert.Nil(t, conn.Close(), "Error closing connection")
+	td.StopServer()
+}
+
+func TestGetPeerEvidence(t *testing.T) {
+	td := setup(t, nil)
+	conn, client := td.networkClient(t)
+
+	pid1 := td.RandPeerID()
+	pid2 := td.RandPeerID()
+	now := time.Now().Truncate(time.Millisecond)
+	td.mockSync.TestEvidences = []firewall.Evidence{
+		{
+			Time: now, PeerID: pid1, Address: "/ip4/1.2.3.4/tcp/21888", Agent: "agent-1",
+			Reason: firewall.EvidenceInvalidBundle, Error: "invalid bundle", Height: 10,
+			InvalidBundles: 1, Data: []byte{1, 2, 3}, DataSize: 4,
+		},
+		{
+			Time: now, PeerID: pid2, Reason: firewall.EvidenceHelloRejected, Height: 10,
+		},
+	}
+
+	t.Run("Should return the evidences of all the peers", func(t *testing.T) {
+		res, err := client.GetPeerEvidence(context.Background(),
+			&pactus.GetPeerEvidenceRequest{})
+		require.NoError(t, err)
+
+		require.Len(t, res.Evidences, 2)
+		evidence := res.Evidences[0]
+		assert.Equal(t, now.UnixMilli(), evidence.Time)
+		assert.Equal(t, []byte(pid1), evidence.PeerId)
+		assert.Equal(t, "/ip4/1.2.3.4/tcp/21888", evidence.Address)
+		assert.Equal(t, "agent-1", evidence.Agent)
+		assert.Equal(t, firewall.EvidenceInvalidBundle, evidence.Reason)
+		assert.Equal(t, "invalid bundle", evidence.Error)
+		assert.Equal(t, uint32(10), evidence.Height)
+		assert.Equal(t, int32(1), evidence.InvalidBundles)
+		assert.Equal(t, []byte{1, 2, 3}, evidence.Data)
+		assert.Equal(t, int32(4), evidence.DataSize)
+	})
+
+	t.Run("Should return the evidences of a peer", func(t *testing.T) {
+		res, err := client.GetPeerEvidence(context.Background(),
+			&pactus.GetPeerEvidenceRequest{PeerId: []byte(pid2)})
+		require.NoError(t, err)
+
+		require.Len(t, res.Evidences, 1)
+		assert.Equal(t, firewall.EvidenceHelloRejected, res.Evidences[0].Reason)
+	})
+
+	t.Run("Invalid peer ID", func(t *testing.T) {
+		res, err := client.GetPeerEvidence(context.Background(),
+			&pactus.GetPeerEvidenceRequest{PeerId: []byte{1}})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Nil(t, res)
+	})
 
 	assert.Nil(t, conn.Close(), "Error closing connection")
 	td.StopServer()
@@ -0,0 +1,225 @@
+package grpc
+
+import (
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"slices"
+
+	"github.com/pactus-project/pactus/crypto"
+	"github.com/pactus-project/pactus/types/tx"
+	"github.com/pactus-project/pactus/types/tx/payload"
+	pactus "github.com/pactus-project/pactus/www/grpc/gen/go"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// defaultPageLimit is the number of the items in a page, if the limit is not set.
+const defaultPageLimit = 100
+
+// The fields of the list filter that a list request can support.
+const (
+	filterHeightRange = 1 << iota
+	filterPayloadType
+	filterAddress
+)
+
+// pageKey is the type of the keys that the items of a list are sorted and paginated by.
+type pageKey interface {
+	string | uint32
+}
+
+// page holds the parsed pagination and sorting options of a list request.
+type page[K pageKey] struct {
+	cursor    K
+	hasCursor bool
+	limit     int
+	desc      bool
+}
+
+// parsePageRequest parses the pagination options of a list request.
+// It returns nil if the page is not requested, then all the items should be returned.
+func parsePageRequest[K pageKey](req *pactus.PageRequest) (*page[K], error) {
+	if req == nil {
+		return nil, nil
+	}
+
+	if req.Limit > maxBatchSize {
+		return nil, status.Errorf(codes.InvalidArgument, "limit should be at most %d", maxBatchSize)
+	}
+
+	pg := &page[K]{limit: defaultPageLimit}
+	if req.Limit != 0 {
+		pg.limit = int(req.Limit)
+	}
+
+	switch req.Order {
+	case pactus.SortOrder_SORT_ASC:
+	case pactus.SortOrder_SORT_DESC:
+		pg.desc = true
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "invalid sort order: %v", req.Order)
+	}
+
+	if req.Cursor != "" {
+		cursor, err := decodeCursor[K](req.Cursor)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid cursor: %v", err)
+		}
+		pg.cursor = cursor
+		pg.hasCursor = true
+	}
+
+	return pg, nil
+}
+
+// paginate sorts the keys in the requested order and returns the page of the keys after the cursor.
+// If the page is not requested, all the keys are returned in ascending order.
+func paginate[K pageKey](keys []K, pg *page[K]) ([]K, *pactus.PageResponse) {
+	slices.Sort(keys)
+	if pg == nil {
+		return keys, nil
+	}
+
+	if pg.desc {
+		slices.Reverse(keys)
+	}
+
+	start := 0
+	if pg.hasCursor {
+		// The keys are unique, so the page starts right after the cursor,
+		// even if the cursor itself has been removed from the list.
+		for start < len(keys) && !pg.isAfterCursor(keys[start]) {
+			start++
+		}
+	}
+	end := min(start+pg.limit, len(keys))
+
+	res := &pactus.PageResponse{}
+	if end < len(keys) {
+		res.NextCursor = encodeCursor(keys[end-1])
+	}
+
+	return keys[start:end], res
+}
+
+func (pg *page[K]) isAfterCursor(key K) bool {
+	if pg.desc {
+		return key < pg.cursor
+	}
+
+	return key > pg.cursor
+}
+
+// encodeCursor encodes the key of the last item in a page as an opaque cursor.
+func encodeCursor[K pageKey](key K) string {
+	var data []byte
+	switch k := any(key).(type) {
+	case string:
+		data = []byte(k)
+	case uint32:
+		data = binary.BigEndian.AppendUint32(nil, k)
+	}
+
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func decodeCursor[K pageKey](cursor string) (K, error) {
+	var key K
+	data, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return key, err
+	}
+
+	switch k := any(&key).(type) {
+	case *string:
+		*k = string(data)
+	case *uint32:
+		if len(data) != 4 {
+			return key, errors.New("invalid length")
+		}
+		*k = binary.BigEndian.Uint32(data)
+	}
+
+	return key, nil
+}
+
+// listFilter holds the parsed filters of a list request.
+type listFilter struct {
+	fromHeight  uint32
+	toHeight    uint32
+	payloadType payload.Type
+	address     *crypto.Address
+}
+
+// parseListFilter parses the filters of a list request.
+// The filters that are not in the supported fields are rejected, instead of being ignored silently.
+func parseListFilter(req *pactus.ListFilter, supported int) (*listFilter, error) {
+	filter := &listFilter{}
+	if req == nil {
+		return filter, nil
+	}
+
+	if req.FromHeight != 0 || req.ToHeight != 0 {
+		if supported&filterHeightRange == 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "height range filter is not supported")
+		}
+		if req.ToHeight != 0 && req.FromHeight > req.ToHeight {
+			return nil, status.Errorf(codes.InvalidArgument, "from height is greater than to height")
+		}
+		filter.fromHeight = req.FromHeight
+		filter.toHeight = req.ToHeight
+	}
+
+	if req.PayloadType != pactus.PayloadType_UNKNOWN {
+		if supported&filterPayloadType == 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "payload type filter is not supported")
+		}
+		typ := payload.Type(req.PayloadType)
+		if !typ.IsRegistered() {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid payload type: %v", req.PayloadType)
+		}
+		filter.payloadType = typ
+	}
+
+	if req.Address != "" {
+		if supported&filterAddress == 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "address filter is not supported")
+		}
+		addr, err := crypto.AddressFromString(req.Address)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid address %s: %v", req.Address, err)
+		}
+		filter.address = &addr
+	}
+
+	return filter, nil
+}
+
+// hasHeightRange checks if the height range is set in the filter.
+func (f *listFilter) hasHeightRange() bool {
+	return f.fromHeight != 0 || f.toHeight != 0
+}
+
+// matchTx checks if the transaction has the filtered payload type and touches any of the addresses.
+// If no address is given, the addresses are not checked.
+func (f *listFilter) matchTx(trx *tx.Tx, addrs []crypto.Address) bool {
+	pld := trx.Payload()
+	if f.payloadType != 0 && pld.Type() != f.payloadType {
+		return false
+	}
+	if len(addrs) == 0 {
+		return true
+	}
+
+	for _, addr := range addrs {
+		if pld.Signer() == addr {
+			return true
+		}
+		if receiver := pld.Receiver(); receiver != nil && *receiver == addr {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,151 @@
+package grpc
+
+import (
+	"testing"
+
+	"github.com/pactus-project/pactus/types/tx/payload"
+	"github.com/pactus-project/pactus/util/testsuite"
+	pactus "github.com/pactus-project/pactus/www/grpc/gen/go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestParsePageRequest(t *testing.T) {
+	t.Run("Should return nil if the page is not requested", func(t *testing.T) {
+		pg, err := parsePageRequest[string](nil)
+		assert.NoError(t, err)
+		assert.Nil(t, pg)
+	})
+
+	t.Run("Should use the default limit", func(t *testing.T) {
+		pg, err := parsePageRequest[string](&pactus.PageRequest{})
+		assert.NoError(t, err)
+		assert.Equal(t, defaultPageLimit, pg.limit)
+		assert.False(t, pg.desc)
+		assert.False(t, pg.hasCursor)
+	})
+
+	t.Run("Should return error for too big limit", func(t *testing.T) {
+		_, err := parsePageRequest[string](&pactus.PageRequest{Limit: maxBatchSize + 1})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("Should return error for invalid sort order", func(t *testing.T) {
+		_, err := parsePageRequest[string](&pactus.PageRequest{Order: 2})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("Should return error for invalid cursor", func(t *testing.T) {
+		_, err := parsePageRequest[string](&pactus.PageRequest{Cursor: "not base64!"})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		_, err = parsePageRequest[uint32](&pactus.PageRequest{Cursor: encodeCursor("abc")})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("Should decode the cursor", func(t *testing.T) {
+		pg, err := parsePageRequest[uint32](&pactus.PageRequest{Cursor: encodeCursor(uint32(1234))})
+		assert.NoError(t, err)
+		assert.True(t, pg.hasCursor)
+		assert.Equal(t, uint32(1234), pg.cursor)
+	})
+}
+
+func TestPaginate(t *testing.T) {
+	keys := func() []uint32 { return []uint32{5, 1, 4, 2, 3} }
+
+	t.Run("Should return all the keys sorted if the page is not requested", func(t *testing.T) {
+		res, pageRes := paginate(keys(), nil)
+		assert.Equal(t, []uint32{1, 2, 3, 4, 5}, res)
+		assert.Nil(t, pageRes)
+	})
+
+	t.Run("Should walk through the pages", func(t *testing.T) {
+		for _, order := range []pactus.SortOrder{pactus.SortOrder_SORT_ASC, pactus.SortOrder_SORT_DESC} {
+			req := &pactus.PageRequest{Limit: 2, Order: order}
+			walked := make([]uint32, 0)
+			pages := 0
+			for {
+				pg, err := parsePageRequest[uint32](req)
+				require.NoError(t, err)
+
+				res, pageRes := paginate(keys(), pg)
+				walked = append(walked, res...)
+				pages++
+				if pageRes.NextCursor == "" {
+					break
+				}
+				req.Cursor = pageRes.NextCursor
+			}
+
+			assert.Equal(t, 3, pages)
+			if order == pactus.SortOrder_SORT_ASC {
+				assert.Equal(t, []uint32{1, 2, 3, 4, 5}, walked)
+			} else {
+				assert.Equal(t, []uint32{5, 4, 3, 2, 1}, walked)
+			}
+		}
+	})
+
+	t.Run("Should start after the cursor if it is removed", func(t *testing.T) {
+		pg, err := parsePageRequest[string](&pactus.PageRequest{Cursor: encodeCursor("b")})
+		require.NoError(t, err)
+
+		res, pageRes := paginate([]string{"c", "a", "d"}, pg)
+		assert.Equal(t, []string{"c", "d"}, res)
+		assert.Empty(t, pageRes.NextCursor)
+	})
+}
+
+func TestParseListFilter(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	t.Run("Should return an empty filter if not set", func(t *testing.T) {
+		filter, err := parseListFilter(nil, 0)
+		assert.NoError(t, err)
+		assert.False(t, filter.hasHeightRange())
+		assert.Zero(t, filter.payloadType)
+		assert.Nil(t, filter.address)
+	})
+
+	t.Run("Should reject the unsupported filters", func(t *testing.T) {
+		_, err := parseListFilter(&pactus.ListFilter{FromHeight: 1}, filterAddress)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		_, err = parseListFilter(&pactus.ListFilter{PayloadType: pactus.PayloadType_BOND_PAYLOAD}, filterAddress)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		_, err = parseListFilter(&pactus.ListFilter{Address: ts.RandAccAddress().String()}, filterHeightRange)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("Should reject the invalid filters", func(t *testing.T) {
+		all := filterHeightRange | filterPayloadType | filterAddress
+
+		_, err := parseListFilter(&pactus.ListFilter{FromHeight: 4, ToHeight: 1}, all)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		_, err = parseListFilter(&pactus.ListFilter{PayloadType: 100}, all)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		_, err = parseListFilter(&pactus.ListFilter{Address: "invalid"}, all)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("Should parse the filters", func(t *testing.T) {
+		addr := ts.RandAccAddress()
+		filter, err := parseListFilter(&pactus.ListFilter{
+			FromHeight:  4,
+			PayloadType: pactus.PayloadType_TRANSFER_PAYLOAD,
+			Address:     addr.String(),
+		}, filterHeightRange|filterPayloadType|filterAddress)
+		assert.NoError(t, err)
+		assert.True(t, filter.hasHeightRange())
+		assert.Equal(t, uint32(4), filter.fromHeight)
+		assert.Zero(t, filter.toHeight)
+		assert.Equal(t, payload.TypeTransfer, filter.payloadType)
+		assert.Equal(t, addr, *filter.address)
+	})
+}
@@ -3,44 +3,66 @@ package grpc
 import (
 	"context"
 	"net"
+	"os"
 
 	"github.com/pactus-project/pactus/consensus"
 	"github.com/pactus-project/pactus/network"
 	"github.com/pactus-project/pactus/state"
 	"github.com/pactus-project/pactus/sync"
+	"github.com/pactus-project/pactus/util"
 	"github.com/pactus-project/pactus/util/logger"
+	"github.com/pactus-project/pactus/util/selftest"
+	"github.com/pactus-project/pactus/watcher"
 	pactus "github.com/pactus-project/pactus/www/grpc/gen/go"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
 )
 
 type Server struct {
-	ctx      context.Context
-	cancel   func()
-	config   *Config
-	listener net.Listener
-	address  string
-	grpc     *grpc.Server
-	state    state.Facade
-	net      network.Network
-	sync     sync.Synchronizer
-	consMgr  consensus.ManagerReader
-	logger   *logger.SubLogger
+	ctx       context.Context
+	cancel    func()
+	config    *Config
+	listeners []net.Listener
+	address   string
+	grpc      *grpc.Server
+	state     state.Facade
+	net       network.Network
+	sync      sync.Synchronizer
+	consMgr   consensus.Manager
+	selfTest  []selftest.Result
+	watcher   *watcher.Watcher
+	certs     *certReloader
+	bridge    *bridge
+	logger    *logger.SubLogger
+
+	nameService *nameService
 }
 
 func NewServer(conf *Config, st state.Facade, syn sync.Synchronizer,
-	n network.Network, consMgr consensus.ManagerReader,
+	n network.Network, consMgr consensus.Manager, selfTest []selftest.Result,
+	w *watcher.Watcher,
 ) *Server {
 	ctx, cancel := context.WithCancel(context.Background())
 
+	nameService := &nameService{}
+	if conf.NameService.URL != "" {
+		nameService.setResolver(newHTTPNameResolver(&conf.NameService))
+	}
+
 	return &Server{
-		ctx:     ctx,
-		cancel:  cancel,
-		config:  conf,
-		state:   st,
-		sync:    syn,
-		net:     n,
-		consMgr: consMgr,
-		logger:  logger.NewSubLogger("_grpc", nil),
+		ctx:      ctx,
+		cancel:   cancel,
+		config:   conf,
+		state:    st,
+		sync:     syn,
+		net:      n,
+		consMgr:  consMgr,
+		selfTest: selfTest,
+		watcher:  w,
+		logger:   logger.NewSubLogger("_grpc", nil),
+
+		nameService: nameService,
 	}
 }
 
@@ -49,20 +71,116 @@ func (s *Server) Address() string {
 }
 
 func (s *Server) StartServer() error {
+	// The bridge is independent of the gRPC server, so the GUI can use it
+	// while the gRPC server is disabled.
+	if s.config.Bridge.Enable {
+		if err := s.startBridge(); err != nil {
+			return err
+		}
+	}
+
 	if !s.config.Enable {
 		return nil
 	}
 
-	listener, err := net.Listen("tcp", s.config.Listen)
+	listeners := []net.Listener{}
+	if s.config.Listen != "" || s.config.UnixSocket == "" {
+		listener, err := net.Listen("tcp", s.config.Listen)
+		if err != nil {
+			return err
+		}
+		listeners = append(listeners, listener)
+	}
+
+	if s.config.UnixSocket != "" {
+		listener, err := listenUnix(s.config.UnixSocket)
+		if err != nil {
+			for _, l := range listeners {
+				l.Close()
+			}
+
+			return err
+		}
+		listeners = append(listeners, listener)
+	}
+
+	return s.startListening(listeners...)
+}
+
+// listenUnix listens on a unix domain socket.
+// Access to the socket is restricted to the owner of the process by file permissions.
+func listenUnix(path string) (net.Listener, error) {
+	path = util.MakeAbs(path)
+
+	// Remove the stale socket file, left by a previous run.
+	if info, err := os.Stat(path); err == nil && info.Mode()&os.ModeSocket != 0 {
+		if err := os.Remove(path); err != nil {
+			return nil, err
+		}
+	}
+
+	listener, err := net.Listen("unix", path)
 	if err != nil {
-		return err
+		return nil, err
+	}
+
+	if err := os.Chmod(path, 0o600); err != nil {
+		listener.Close()
+
+		return nil, err
+	}
+
+	return listener, nil
+}
+
+// listenerAddress returns the dial target for the given listener.
+func listenerAddress(listener net.Listener) string {
+	addr := listener.Addr()
+	if addr.Network() == "unix" {
+		return "unix://" + addr.String()
 	}
 
-	return s.startListening(listener)
+	return addr.String()
 }
 
-func (s *Server) startListening(listener net.Listener) error {
-	grpcServer := grpc.NewServer()
+// ClientCredentials returns the transport credentials that should be used
+// by internal clients, like the HTTP server, to connect to this gRPC server.
+func (s *Server) ClientCredentials() credentials.TransportCredentials {
+	if s.certs == nil {
+		return insecure.NewCredentials()
+	}
+
+	return s.certs.selfClientCredentials()
+}
+
+func (s *Server) startListening(listeners ...net.Listener) error {
+	interceptors := []grpc.UnaryServerInterceptor{s.recoveryInterceptor, s.deadlineInterceptor}
+	if s.config.Tenants.Enable {
+		interceptors = append(interceptors, newTenantGate(&s.config.Tenants).unaryInterceptor)
+
+		s.logger.Info("grpc tenants enabled",
+			"tenants", len(s.config.Tenants.Tenants), "anonymous", s.config.Tenants.AllowAnonymous)
+	}
+	interceptors = append(interceptors, errorInterceptor, deprecationInterceptor)
+	if s.config.Auth.Enable {
+		interceptors = append(interceptors, newAuthenticator(&s.config.Auth).unaryInterceptor)
+
+		s.logger.Info("grpc request signing enabled for the admin methods")
+	}
+	opts := []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(interceptors...),
+	}
+	if s.config.TLS.Enable {
+		certs, err := newCertReloader(&s.config.TLS)
+		if err != nil {
+			return err
+		}
+		s.certs = certs
+		opts = append(opts, grpc.Creds(credentials.NewTLS(certs.serverTLSConfig())))
+
+		s.logger.Info("grpc TLS enabled", "client_auth", s.config.TLS.ClientCAFile != "")
+	}
+	grpcServer := grpc.NewServer(opts...)
 
 	blockchainServer := newBlockchainServer(s)
 	transactionServer := newTransactionServer(s)
@@ -73,31 +191,37 @@ func (s *Server) startListening(listener net.Listener) error {
 	pactus.RegisterNetworkServer(grpcServer, networkServer)
 
 	if s.config.EnableWallet {
-		chainType := s.state.Genesis().ChainType()
-		walletServer := newWalletServer(s, chainType)
+		gen := s.state.Genesis()
+		walletServer := newWalletServer(s, gen.ChainType(), gen.HRP())
 
 		pactus.RegisterWalletServer(grpcServer, walletServer)
 	}
+	registerDeprecatedFields(grpcServer)
 
-	s.listener = listener
-	s.address = listener.Addr().String()
+	s.listeners = listeners
+	s.address = listenerAddress(listeners[0])
 	s.grpc = grpcServer
 
-	s.logger.Info("grpc started listening", "address", listener.Addr().String())
-	go func() {
-		if err := s.grpc.Serve(listener); err != nil {
-			s.logger.Error("error on grpc serve", "error", err)
-		}
-	}()
+	for _, listener := range listeners {
+		s.logger.Info("grpc started listening", "address", listenerAddress(listener))
+		go func(l net.Listener) {
+			if err := s.grpc.Serve(l); err != nil {
+				s.logger.Error("error on grpc serve", "error", err)
+			}
+		}(listener)
+	}
 
 	return s.startGateway(s.address)
 }
 
 func (s *Server) StopServer() {
 	s.cancel()
+	s.stopBridge()
 
 	if s.grpc != nil {
 		s.grpc.Stop()
-		s.listener.Close()
+		for _, listener := range s.listeners {
+			listener.Close()
+		}
 	}
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"net"
 	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/pactus-project/pactus/consensus"
@@ -13,6 +14,7 @@ import (
 	"github.com/pactus-project/pactus/sync"
 	"github.com/pactus-project/pactus/util"
 	"github.com/pactus-project/pactus/util/testsuite"
+	"github.com/pactus-project/pactus/watcher"
 	pactus "github.com/pactus-project/pactus/www/grpc/gen/go"
 	"github.com/stretchr/testify/assert"
 	"google.golang.org/grpc"
@@ -25,6 +27,7 @@ type testData struct {
 
 	mockState   *state.MockState
 	mockSync    *sync.MockSync
+	mockNet     *network.MockNetwork
 	consMocks   []*consensus.MockConsensus
 	mockConsMgr consensus.Manager
 	listener    *bufconn.Listener
@@ -66,7 +69,13 @@ func setup(t *testing.T, conf *Config) *testData {
 
 	mockState.CommitTestBlocks(10)
 
-	server := NewServer(conf, mockState, mockSync, mockNet, mockConsMgr)
+	watcherConf := watcher.DefaultConfig()
+	watcherConf.Enable = true
+	watcherConf.MaxAddresses = 2
+	wat, err := watcher.NewWatcher(watcherConf, mockState, nil)
+	assert.NoError(t, err)
+
+	server := NewServer(conf, mockState, mockSync, mockNet, mockConsMgr, nil, wat)
 	err = server.startListening(listener)
 	assert.NoError(t, err)
 
@@ -74,6 +83,7 @@ func setup(t *testing.T, conf *Config) *testData {
 		TestSuite:   ts,
 		mockState:   mockState,
 		mockSync:    mockSync,
+		mockNet:     mockNet,
 		consMocks:   consMocks,
 		mockConsMgr: mockConsMgr,
 		server:      server,
@@ -141,3 +151,35 @@ func (td *testData) walletClient(t *testing.T) (*grpc.ClientConn, pactus.WalletC
 
 	return conn, pactus.NewWalletClient(conn)
 }
+
+func TestUnixSocketListener(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	mockConsMgr, _ := consensus.MockingManager(ts, []*bls.ValidatorKey{ts.RandValKey()})
+	mockState := state.MockingState(ts)
+	mockNet := network.MockingNetwork(ts, ts.RandPeerID())
+	mockSync := sync.MockingSync(ts)
+
+	conf := testConfig()
+	conf.Enable = true
+	conf.Listen = ""
+	conf.UnixSocket = filepath.Join(util.TempDirPath(), "pactus.sock")
+
+	server := NewServer(conf, mockState, mockSync, mockNet, mockConsMgr, nil, nil)
+	assert.NoError(t, server.StartServer())
+	defer server.StopServer()
+
+	assert.Equal(t, "unix://"+conf.UnixSocket, server.Address())
+
+	info, err := os.Stat(conf.UnixSocket)
+	assert.NoError(t, err)
+	assert.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+
+	conn, err := grpc.Dial(server.Address(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	assert.NoError(t, err)
+	defer conn.Close()
+
+	_, err = pactus.NewBlockchainClient(conn).GetBlockchainInfo(context.Background(),
+		&pactus.GetBlockchainInfoRequest{})
+	assert.NoError(t, err)
+}
@@ -0,0 +1,329 @@
+package grpc
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/pactus-project/pactus/crypto"
+	"github.com/pactus-project/pactus/types/tx"
+	"github.com/pactus-project/pactus/types/tx/payload"
+	pactus "github.com/pactus-project/pactus/www/grpc/gen/go"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// defaultSigningTokenTTL is the time-to-live of the signing tokens, if it is not set by the request.
+	defaultSigningTokenTTL = time.Hour
+	// maxSigningTokenTTL is the maximum time-to-live of the signing tokens.
+	maxSigningTokenTTL = 30 * 24 * time.Hour
+	// maxSigningTokensPerWallet limits the number of the signing tokens that a wallet keeps.
+	maxSigningTokensPerWallet = 100
+	// maxSigningTokenReceivers is the maximum number of the receivers in the scope of a token.
+	maxSigningTokenReceivers = 100
+)
+
+// signingToken lets a client, like a trading bot, sign the transactions of a wallet
+// without holding its password. The node keeps the password until the token expires,
+// and checks the scope of the token before each signature.
+type signingToken struct {
+	id           string
+	walletName   string
+	password     string
+	payloadTypes []payload.Type
+	receivers    []crypto.Address
+	spendLimit   int64
+	spent        int64
+	createdAt    time.Time
+	expiresAt    time.Time
+}
+
+// signingTokenStore keeps the signing tokens in memory, indexed by the hash of their secrets.
+// The expired tokens are removed when the store is accessed.
+type signingTokenStore struct {
+	lk sync.Mutex
+
+	tokens map[string]*signingToken
+	now    func() time.Time
+}
+
+func newSigningTokenStore() *signingTokenStore {
+	return &signingTokenStore{
+		tokens: make(map[string]*signingToken),
+		now:    time.Now,
+	}
+}
+
+func signingTokenTTL(ttl uint32) (time.Duration, error) {
+	if ttl == 0 {
+		return defaultSigningTokenTTL, nil
+	}
+
+	duration := time.Duration(ttl) * time.Second
+	if duration > maxSigningTokenTTL {
+		return 0, status.Errorf(codes.InvalidArgument, "ttl is more than %v", maxSigningTokenTTL)
+	}
+
+	return duration, nil
+}
+
+// hashSigningToken returns the key of the token in the store. The secrets are not kept.
+func hashSigningToken(secret string) string {
+	hash := sha256.Sum256([]byte(secret))
+
+	return hex.EncodeToString(hash[:])
+}
+
+func newSigningTokenSecret() (string, error) {
+	secretBytes := make([]byte, 32)
+	if _, err := rand.Read(secretBytes); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(secretBytes), nil
+}
+
+func parseSigningTokenScope(scope *pactus.SigningTokenScope) (*signingToken, error) {
+	if scope == nil {
+		return nil, status.Error(codes.InvalidArgument, "scope is required")
+	}
+	if scope.SpendLimit <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "spend limit should be positive")
+	}
+	if len(scope.Receivers) > maxSigningTokenReceivers {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"scope has more than %d receivers", maxSigningTokenReceivers)
+	}
+
+	tkn := &signingToken{
+		payloadTypes: []payload.Type{payload.TypeTransfer},
+		spendLimit:   scope.SpendLimit,
+	}
+	if len(scope.PayloadTypes) > 0 {
+		tkn.payloadTypes = make([]payload.Type, 0, len(scope.PayloadTypes))
+		for _, typ := range scope.PayloadTypes {
+			if typ < pactus.PayloadType_TRANSFER_PAYLOAD || typ > pactus.PayloadType_REWARD_SPLIT_PAYLOAD {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid payload type: %v", typ)
+			}
+			tkn.payloadTypes = append(tkn.payloadTypes, payload.Type(typ))
+		}
+	}
+	for _, receiver := range scope.Receivers {
+		addr, err := crypto.AddressFromString(receiver)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid receiver %s: %v", receiver, err)
+		}
+		tkn.receivers = append(tkn.receivers, addr)
+	}
+
+	return tkn, nil
+}
+
+// checkScope checks if the transaction is in the scope of the token.
+func (t *signingToken) checkScope(trx *tx.Tx) error {
+	allowed := false
+	for _, typ := range t.payloadTypes {
+		if trx.Payload().Type() == typ {
+			allowed = true
+
+			break
+		}
+	}
+	if !allowed {
+		return status.Errorf(codes.PermissionDenied,
+			"token can't sign %s transactions", trx.Payload().Type())
+	}
+
+	if len(t.receivers) > 0 {
+		receiver := trx.Payload().Receiver()
+		allowed = false
+		for _, addr := range t.receivers {
+			if receiver != nil && *receiver == addr {
+				allowed = true
+
+				break
+			}
+		}
+		if !allowed {
+			return status.Error(codes.PermissionDenied, "receiver is not in the scope of the token")
+		}
+	}
+
+	if t.spent+signingAmount(trx) > t.spendLimit {
+		return status.Errorf(codes.PermissionDenied,
+			"spend limit of the token is exceeded, %d of %d is spent", t.spent, t.spendLimit)
+	}
+
+	return nil
+}
+
+// signingAmount is the amount that a signature spends from the limit of a token.
+func signingAmount(trx *tx.Tx) int64 {
+	return trx.Payload().Value() + trx.Fee()
+}
+
+// prune removes the expired tokens.
+func (s *signingTokenStore) prune(now time.Time) {
+	for key, t := range s.tokens {
+		if !now.Before(t.expiresAt) {
+			delete(s.tokens, key)
+		}
+	}
+}
+
+func (s *signingTokenStore) count(walletName string) int {
+	count := 0
+	for _, t := range s.tokens {
+		if t.walletName == walletName {
+			count++
+		}
+	}
+
+	return count
+}
+
+// issue issues a new token and returns its secret with a copy of the token.
+// The password should be verified by the caller.
+func (s *signingTokenStore) issue(walletName, password string,
+	scope *pactus.SigningTokenScope, ttl uint32,
+) (string, signingToken, error) {
+	tkn, err := parseSigningTokenScope(scope)
+	if err != nil {
+		return "", signingToken{}, err
+	}
+	duration, err := signingTokenTTL(ttl)
+	if err != nil {
+		return "", signingToken{}, err
+	}
+	secret, err := newSigningTokenSecret()
+	if err != nil {
+		return "", signingToken{}, err
+	}
+
+	s.lk.Lock()
+	defer s.lk.Unlock()
+
+	now := s.now()
+	s.prune(now)
+
+	if s.count(walletName) >= maxSigningTokensPerWallet {
+		return "", signingToken{}, status.Errorf(codes.ResourceExhausted,
+			"wallet has more than %d signing tokens", maxSigningTokensPerWallet)
+	}
+
+	key := hashSigningToken(secret)
+	tkn.id = key[:16]
+	tkn.walletName = walletName
+	tkn.password = password
+	tkn.createdAt = now
+	tkn.expiresAt = now.Add(duration)
+	s.tokens[key] = tkn
+
+	return secret, *tkn, nil
+}
+
+// list returns the tokens of the wallet, ordered by the issuing time.
+func (s *signingTokenStore) list(walletName string) []signingToken {
+	s.lk.Lock()
+	defer s.lk.Unlock()
+
+	s.prune(s.now())
+
+	tokens := make([]signingToken, 0)
+	for _, t := range s.tokens {
+		if t.walletName == walletName {
+			tokens = append(tokens, *t)
+		}
+	}
+	sort.Slice(tokens, func(i, j int) bool {
+		return tokens[i].createdAt.Before(tokens[j].createdAt)
+	})
+
+	return tokens
+}
+
+func (s *signingTokenStore) revoke(walletName, id string) error {
+	s.lk.Lock()
+	defer s.lk.Unlock()
+
+	s.prune(s.now())
+
+	for key, t := range s.tokens {
+		if t.walletName == walletName && t.id == id {
+			delete(s.tokens, key)
+
+			return nil
+		}
+	}
+
+	return status.Errorf(codes.NotFound, "signing token not found: %s", id)
+}
+
+// removeWallet removes all the tokens of the wallet, like when the wallet is unloaded.
+func (s *signingTokenStore) removeWallet(walletName string) {
+	s.lk.Lock()
+	defer s.lk.Unlock()
+
+	for key, t := range s.tokens {
+		if t.walletName == walletName {
+			delete(s.tokens, key)
+		}
+	}
+}
+
+// reserve checks the transaction against the scope of the token and
+// reserves its amount from the spend limit. It returns a copy of the token,
+// the reservation should be released if the transaction is not signed.
+func (s *signingTokenStore) reserve(walletName, secret string, trx *tx.Tx) (signingToken, error) {
+	s.lk.Lock()
+	defer s.lk.Unlock()
+
+	s.prune(s.now())
+
+	tkn, ok := s.tokens[hashSigningToken(secret)]
+	if !ok || tkn.walletName != walletName {
+		return signingToken{}, status.Error(codes.Unauthenticated, "invalid or expired signing token")
+	}
+	if err := tkn.checkScope(trx); err != nil {
+		return signingToken{}, err
+	}
+	tkn.spent += signingAmount(trx)
+
+	return *tkn, nil
+}
+
+// release gives back the reserved amount of a transaction that is not signed.
+func (s *signingTokenStore) release(secret string, trx *tx.Tx) {
+	s.lk.Lock()
+	defer s.lk.Unlock()
+
+	if tkn, ok := s.tokens[hashSigningToken(secret)]; ok {
+		tkn.spent -= signingAmount(trx)
+	}
+}
+
+func signingTokenToProto(t *signingToken) *pactus.SigningToken {
+	scope := &pactus.SigningTokenScope{
+		PayloadTypes: make([]pactus.PayloadType, 0, len(t.payloadTypes)),
+		Receivers:    make([]string, 0, len(t.receivers)),
+		SpendLimit:   t.spendLimit,
+	}
+	for _, typ := range t.payloadTypes {
+		scope.PayloadTypes = append(scope.PayloadTypes, pactus.PayloadType(typ))
+	}
+	for _, addr := range t.receivers {
+		scope.Receivers = append(scope.Receivers, addr.String())
+	}
+
+	return &pactus.SigningToken{
+		TokenId:   t.id,
+		Scope:     scope,
+		Spent:     t.spent,
+		CreatedAt: t.createdAt.Unix(),
+		ExpiresAt: t.expiresAt.Unix(),
+	}
+}
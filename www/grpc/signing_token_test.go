@@ -0,0 +1,286 @@
+package grpc
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/pactus-project/pactus/crypto"
+	"github.com/pactus-project/pactus/genesis"
+	"github.com/pactus-project/pactus/types/tx"
+	"github.com/pactus-project/pactus/util/testsuite"
+	"github.com/pactus-project/pactus/wallet"
+	pactus "github.com/pactus-project/pactus/www/grpc/gen/go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestSigningTokens(t *testing.T) {
+	conf := testConfig()
+	conf.EnableWallet = true
+
+	td := setup(t, conf)
+	conn, client := td.walletClient(t)
+
+	wltName := td.RandString(16)
+	mnemonic, _ := wallet.GenerateMnemonic(128)
+	wlt, err := wallet.Create(filepath.Join(conf.WalletsDir, wltName), mnemonic, "secret", genesis.Mainnet)
+	require.NoError(t, err)
+	wltAddr, err := wlt.NewBLSAccountAddress("bot")
+	require.NoError(t, err)
+	require.NoError(t, wlt.Save())
+
+	_, err = client.LoadWallet(context.Background(), &pactus.LoadWalletRequest{WalletName: wltName})
+	require.NoError(t, err)
+
+	sender, _ := crypto.AddressFromString(wltAddr)
+	receiver := td.RandAccAddress()
+	scope := &pactus.SigningTokenScope{
+		Receivers:  []string{receiver.String()},
+		SpendLimit: 1000,
+	}
+
+	t.Run("Invalid password", func(t *testing.T) {
+		res, err := client.IssueSigningToken(context.Background(),
+			&pactus.IssueSigningTokenRequest{
+				WalletName: wltName,
+				Password:   "invalid",
+				Scope:      scope,
+			})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+		assert.Nil(t, res)
+	})
+
+	t.Run("No spend limit", func(t *testing.T) {
+		res, err := client.IssueSigningToken(context.Background(),
+			&pactus.IssueSigningTokenRequest{
+				WalletName: wltName,
+				Password:   "secret",
+				Scope:      &pactus.SigningTokenScope{},
+			})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Nil(t, res)
+	})
+
+	var token, tokenID string
+	t.Run("Issue token", func(t *testing.T) {
+		res, err := client.IssueSigningToken(context.Background(),
+			&pactus.IssueSigningTokenRequest{
+				WalletName: wltName,
+				Password:   "secret",
+				Scope:      scope,
+			})
+		require.NoError(t, err)
+
+		token = res.Token
+		tokenID = res.SigningToken.TokenId
+		assert.NotEmpty(t, token)
+		assert.NotEmpty(t, tokenID)
+		assert.NotContains(t, token, tokenID)
+		assert.Equal(t, []pactus.PayloadType{pactus.PayloadType_TRANSFER_PAYLOAD},
+			res.SigningToken.Scope.PayloadTypes)
+		assert.Equal(t, res.SigningToken.CreatedAt+int64(defaultSigningTokenTTL/time.Second),
+			res.SigningToken.ExpiresAt)
+	})
+
+	signWithToken := func(trx *tx.Tx, token string) (*pactus.SignRawTransactionResponse, error) {
+		rawTx, _ := trx.Bytes()
+
+		return client.SignRawTransaction(context.Background(),
+			&pactus.SignRawTransactionRequest{
+				WalletName:     wltName,
+				RawTransaction: rawTx,
+				SigningToken:   token,
+			})
+	}
+
+	t.Run("Sign with token", func(t *testing.T) {
+		trx := tx.NewTransferTx(td.RandHeight(), sender, receiver, 500, 100, "bot")
+		res, err := signWithToken(trx, token)
+		require.NoError(t, err)
+
+		signedTx, err := tx.FromBytes(res.SignedRawTransaction)
+		require.NoError(t, err)
+		assert.NoError(t, signedTx.BasicCheck())
+	})
+
+	t.Run("Sign with invalid token", func(t *testing.T) {
+		trx := tx.NewTransferTx(td.RandHeight(), sender, receiver, 1, 1, "bot")
+		res, err := signWithToken(trx, "invalid")
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+		assert.Nil(t, res)
+	})
+
+	t.Run("Sign with token and password", func(t *testing.T) {
+		trx := tx.NewTransferTx(td.RandHeight(), sender, receiver, 1, 1, "bot")
+		rawTx, _ := trx.Bytes()
+		res, err := client.SignRawTransaction(context.Background(),
+			&pactus.SignRawTransactionRequest{
+				WalletName:     wltName,
+				RawTransaction: rawTx,
+				Password:       "secret",
+				SigningToken:   token,
+			})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Nil(t, res)
+	})
+
+	t.Run("Transaction is out of scope", func(t *testing.T) {
+		trx := tx.NewTransferTx(td.RandHeight(), sender, td.RandAccAddress(), 1, 1, "bot")
+		_, err := signWithToken(trx, token)
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+		trx = tx.NewBondTx(td.RandHeight(), sender, td.RandValAddress(), nil, 1, 1, "bot")
+		_, err = signWithToken(trx, token)
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+		trx = tx.NewTransferTx(td.RandHeight(), sender, receiver, 400, 1, "bot")
+		_, err = signWithToken(trx, token)
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("List tokens", func(t *testing.T) {
+		res, err := client.ListSigningTokens(context.Background(),
+			&pactus.ListSigningTokensRequest{WalletName: wltName})
+		require.NoError(t, err)
+
+		require.Len(t, res.Tokens, 1)
+		assert.Equal(t, tokenID, res.Tokens[0].TokenId)
+		assert.Equal(t, int64(600), res.Tokens[0].Spent)
+	})
+
+	t.Run("Revoke token", func(t *testing.T) {
+		res, err := client.RevokeSigningToken(context.Background(),
+			&pactus.RevokeSigningTokenRequest{
+				WalletName: wltName,
+				TokenId:    tokenID,
+			})
+		require.NoError(t, err)
+		assert.Equal(t, tokenID, res.TokenId)
+
+		trx := tx.NewTransferTx(td.RandHeight(), sender, receiver, 1, 1, "bot")
+		_, err = signWithToken(trx, token)
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+		_, err = client.RevokeSigningToken(context.Background(),
+			&pactus.RevokeSigningTokenRequest{
+				WalletName: wltName,
+				TokenId:    tokenID,
+			})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	t.Run("Unloading the wallet removes the tokens", func(t *testing.T) {
+		_, err := client.IssueSigningToken(context.Background(),
+			&pactus.IssueSigningTokenRequest{
+				WalletName: wltName,
+				Password:   "secret",
+				Scope:      scope,
+			})
+		require.NoError(t, err)
+
+		_, err = client.UnloadWallet(context.Background(),
+			&pactus.UnloadWalletRequest{WalletName: wltName})
+		require.NoError(t, err)
+		_, err = client.LoadWallet(context.Background(),
+			&pactus.LoadWalletRequest{WalletName: wltName})
+		require.NoError(t, err)
+
+		res, err := client.ListSigningTokens(context.Background(),
+			&pactus.ListSigningTokensRequest{WalletName: wltName})
+		require.NoError(t, err)
+		assert.Empty(t, res.Tokens)
+	})
+
+	assert.Nil(t, conn.Close(), "Error closing connection")
+	td.StopServer()
+}
+
+func TestSigningTokenStore(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	now := time.Now()
+	store := newSigningTokenStore()
+	store.now = func() time.Time { return now }
+
+	scope := &pactus.SigningTokenScope{SpendLimit: 100}
+	trx := tx.NewTransferTx(ts.RandHeight(), ts.RandAccAddress(), ts.RandAccAddress(), 60, 10, "bot")
+
+	t.Run("Tokens of the other wallets are not usable", func(t *testing.T) {
+		secret, tkn, err := store.issue("wallet-1", "", scope, 0)
+		require.NoError(t, err)
+
+		assert.Empty(t, store.list("wallet-2"))
+		_, err = store.reserve("wallet-2", secret, trx)
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+		assert.Equal(t, codes.NotFound, status.Code(store.revoke("wallet-2", tkn.id)))
+		assert.NoError(t, store.revoke("wallet-1", tkn.id))
+	})
+
+	t.Run("Expired tokens are removed", func(t *testing.T) {
+		secret, _, err := store.issue("wallet-1", "", scope, 60)
+		require.NoError(t, err)
+
+		now = now.Add(59 * time.Second)
+		assert.Len(t, store.list("wallet-1"), 1)
+
+		now = now.Add(time.Second)
+		assert.Empty(t, store.list("wallet-1"))
+		_, err = store.reserve("wallet-1", secret, trx)
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+
+	t.Run("Spend limit is reserved and released", func(t *testing.T) {
+		secret, _, err := store.issue("wallet-1", "", scope, 0)
+		require.NoError(t, err)
+
+		tkn, err := store.reserve("wallet-1", secret, trx)
+		require.NoError(t, err)
+		assert.Equal(t, int64(70), tkn.spent)
+
+		_, err = store.reserve("wallet-1", secret, trx)
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+		store.release(secret, trx)
+		tkn, err = store.reserve("wallet-1", secret, trx)
+		require.NoError(t, err)
+		assert.Equal(t, int64(70), tkn.spent)
+		store.removeWallet("wallet-1")
+	})
+
+	t.Run("Invalid scope", func(t *testing.T) {
+		_, _, err := store.issue("wallet-1", "", nil, 0)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		_, _, err = store.issue("wallet-1", "", &pactus.SigningTokenScope{
+			PayloadTypes: []pactus.PayloadType{pactus.PayloadType_UNKNOWN},
+			SpendLimit:   1,
+		}, 0)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		_, _, err = store.issue("wallet-1", "", &pactus.SigningTokenScope{
+			Receivers:  []string{"invalid"},
+			SpendLimit: 1,
+		}, 0)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		_, _, err = store.issue("wallet-1", "", scope, uint32(maxSigningTokenTTL/time.Second)+1)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("Number of the tokens is limited", func(t *testing.T) {
+		for i := 0; i < maxSigningTokensPerWallet; i++ {
+			_, _, err := store.issue("wallet-1", "", scope, 0)
+			require.NoError(t, err)
+		}
+
+		_, _, err := store.issue("wallet-1", "", scope, 0)
+		assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+		_, _, err = store.issue("wallet-2", "", scope, 0)
+		assert.NoError(t, err)
+	})
+}
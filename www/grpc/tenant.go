@@ -0,0 +1,287 @@
+package grpc
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pactus-project/pactus/util/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// APIKeyKey is the metadata key of the tenant API key.
+// The gRPC gateway forwards it from the `Grpc-Metadata-X-Pactus-Api-Key` HTTP header.
+const APIKeyKey = "x-pactus-api-key"
+
+// minAPIKeyLength is the minimum length of the tenant API keys.
+const minAPIKeyLength = 16
+
+// anonymousTenant is the name of the tenant of the requests without an API key.
+const anonymousTenant = "anonymous"
+
+var (
+	tenantRequestsCounter = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "pactus",
+		Subsystem: "grpc",
+		Name:      "tenant_requests_total",
+		Help:      "The number of requests that are served for the tenant, by the method and the status code.",
+	}, []string{"tenant", "method", "code"})
+	tenantRejectsCounter = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "pactus",
+		Subsystem: "grpc",
+		Name:      "tenant_rejects_total",
+		Help:      "The number of requests of the tenant that are rejected, by the reason.",
+	}, []string{"tenant", "reason"})
+)
+
+func init() {
+	prometheus.MustRegister(tenantRequestsCounter, tenantRejectsCounter)
+}
+
+// TenantConfig is the configuration of a tenant: a customer that shares the public node.
+type TenantConfig struct {
+	Name      string  `toml:"name"`
+	APIKey    string  `toml:"api_key"`
+	RateLimit float64 `toml:"rate_limit"`
+	Burst     int     `toml:"burst"`
+	// Methods are the namespaces of the methods that the tenant can call.
+	// A namespace is a package, like `pactus`, a service, like `pactus.Blockchain`,
+	// or a method, like `pactus.Blockchain/GetBlock`. Empty means all the methods.
+	Methods []string `toml:"methods"`
+}
+
+type TenantsConfig struct {
+	Enable             bool           `toml:"enable"`
+	AllowAnonymous     bool           `toml:"allow_anonymous"`
+	AnonymousRateLimit float64        `toml:"anonymous_rate_limit"`
+	AnonymousBurst     int            `toml:"anonymous_burst"`
+	AnonymousMethods   []string       `toml:"anonymous_methods"`
+	Tenants            []TenantConfig `toml:"tenant"`
+}
+
+// anonymous returns the configuration of the tenant of the requests without an API key.
+func (conf *TenantsConfig) anonymous() *TenantConfig {
+	return &TenantConfig{
+		Name:      anonymousTenant,
+		RateLimit: conf.AnonymousRateLimit,
+		Burst:     conf.AnonymousBurst,
+		Methods:   conf.AnonymousMethods,
+	}
+}
+
+// BasicCheck performs basic checks on the configuration.
+func (conf *TenantsConfig) BasicCheck() error {
+	if !conf.Enable {
+		return nil
+	}
+
+	names := make(map[string]bool)
+	keys := make(map[string]bool)
+	for _, tenant := range conf.Tenants {
+		if tenant.Name == "" || tenant.Name == anonymousTenant {
+			return errors.Errorf(errors.ErrInvalidConfig, "invalid tenant name: %q", tenant.Name)
+		}
+		if names[tenant.Name] {
+			return errors.Errorf(errors.ErrInvalidConfig, "duplicated tenant: %s", tenant.Name)
+		}
+		if len(tenant.APIKey) < minAPIKeyLength {
+			return errors.Errorf(errors.ErrInvalidConfig,
+				"API key of tenant %s should be at least %d characters", tenant.Name, minAPIKeyLength)
+		}
+		if keys[tenant.APIKey] {
+			return errors.Errorf(errors.ErrInvalidConfig, "duplicated API key for tenant %s", tenant.Name)
+		}
+		if err := tenant.basicCheck(); err != nil {
+			return err
+		}
+		names[tenant.Name] = true
+		keys[tenant.APIKey] = true
+	}
+
+	if conf.AllowAnonymous {
+		return conf.anonymous().basicCheck()
+	}
+
+	return nil
+}
+
+func (conf *TenantConfig) basicCheck() error {
+	if conf.RateLimit < 0 || conf.Burst < 0 {
+		return errors.Errorf(errors.ErrInvalidConfig,
+			"rate limit of tenant %s should not be negative", conf.Name)
+	}
+	if conf.RateLimit > 0 && conf.Burst == 0 {
+		return errors.Errorf(errors.ErrInvalidConfig,
+			"burst of tenant %s should be positive", conf.Name)
+	}
+	for _, namespace := range conf.Methods {
+		if namespace == "" || strings.HasPrefix(namespace, "/") || strings.Count(namespace, "/") > 1 {
+			return errors.Errorf(errors.ErrInvalidConfig,
+				"invalid method namespace of tenant %s: %q", conf.Name, namespace)
+		}
+	}
+
+	return nil
+}
+
+// allows checks if the method is in the namespaces of the tenant.
+// The full method is in the form of `/package.Service/Method`.
+func (conf *TenantConfig) allows(fullMethod string) bool {
+	if len(conf.Methods) == 0 {
+		return true
+	}
+
+	method := strings.TrimPrefix(fullMethod, "/")
+	for _, namespace := range conf.Methods {
+		if method == namespace ||
+			strings.HasPrefix(method, namespace+"/") ||
+			strings.HasPrefix(method, namespace+".") {
+			return true
+		}
+	}
+
+	return false
+}
+
+// tokenBucket limits the rate of the requests.
+// It is refilled by `rate` tokens per second, up to `burst` tokens.
+type tokenBucket struct {
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+func newTokenBucket(rate float64, burst int, now time.Time) *tokenBucket {
+	return &tokenBucket{
+		rate:   rate,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   now,
+	}
+}
+
+func (b *tokenBucket) take(now time.Time) bool {
+	if elapsed := now.Sub(b.last).Seconds(); elapsed > 0 {
+		b.tokens = min(b.burst, b.tokens+elapsed*b.rate)
+		b.last = now
+	}
+	if b.tokens < 1 {
+		return false
+	}
+	b.tokens--
+
+	return true
+}
+
+type tenant struct {
+	config *TenantConfig
+	bucket *tokenBucket // nil if the tenant is not rate limited
+}
+
+// tenantGate identifies the tenant of the requests by their API key,
+// and enforces the allowed methods and the rate limit of the tenant.
+// Each tenant has its own rate limit, so a busy tenant doesn't affect the others.
+type tenantGate struct {
+	lk sync.Mutex
+
+	tenants   map[[32]byte]*tenant
+	anonymous *tenant // nil if the anonymous requests are not allowed
+	now       func() time.Time
+}
+
+func newTenantGate(conf *TenantsConfig) *tenantGate {
+	gate := &tenantGate{
+		tenants: make(map[[32]byte]*tenant),
+		now:     time.Now,
+	}
+
+	now := gate.now()
+	for i := range conf.Tenants {
+		tenantConf := &conf.Tenants[i]
+		gate.tenants[sha256.Sum256([]byte(tenantConf.APIKey))] = newTenant(tenantConf, now)
+	}
+	if conf.AllowAnonymous {
+		gate.anonymous = newTenant(conf.anonymous(), now)
+	}
+
+	return gate
+}
+
+func newTenant(conf *TenantConfig, now time.Time) *tenant {
+	t := &tenant{config: conf}
+	if conf.RateLimit > 0 {
+		t.bucket = newTokenBucket(conf.RateLimit, conf.Burst, now)
+	}
+
+	return t
+}
+
+// findTenant returns the tenant of the API key.
+// The keys are compared by their hashes, so the lookup time doesn't leak the keys.
+func (g *tenantGate) findTenant(apiKey string) *tenant {
+	if apiKey == "" {
+		return g.anonymous
+	}
+
+	keyHash := sha256.Sum256([]byte(apiKey))
+	t, ok := g.tenants[keyHash]
+	if !ok || subtle.ConstantTimeCompare([]byte(t.config.APIKey), []byte(apiKey)) != 1 {
+		return nil
+	}
+
+	return t
+}
+
+func (g *tenantGate) unaryInterceptor(ctx context.Context, req any,
+	info *grpc.UnaryServerInfo, handler grpc.UnaryHandler,
+) (any, error) {
+	apiKey := ""
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(APIKeyKey); len(values) > 0 {
+			apiKey = values[0]
+		}
+	}
+
+	t := g.findTenant(apiKey)
+	if t == nil {
+		tenantRejectsCounter.WithLabelValues("", "unauthenticated").Inc()
+
+		return nil, status.Errorf(codes.Unauthenticated, "invalid API key")
+	}
+
+	if !t.config.allows(info.FullMethod) {
+		tenantRejectsCounter.WithLabelValues(t.config.Name, "permission_denied").Inc()
+
+		return nil, status.Errorf(codes.PermissionDenied, "method %s is not allowed", info.FullMethod)
+	}
+
+	if !g.take(t) {
+		tenantRejectsCounter.WithLabelValues(t.config.Name, "rate_limited").Inc()
+
+		return nil, status.Errorf(codes.ResourceExhausted, "rate limit exceeded")
+	}
+
+	res, err := handler(ctx, req)
+	tenantRequestsCounter.WithLabelValues(t.config.Name, info.FullMethod, status.Code(err).String()).Inc()
+
+	return res, err
+}
+
+func (g *tenantGate) take(t *tenant) bool {
+	if t.bucket == nil {
+		return true
+	}
+
+	g.lk.Lock()
+	defer g.lk.Unlock()
+
+	return t.bucket.take(g.now())
+}
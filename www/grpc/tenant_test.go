@@ -0,0 +1,230 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pactus-project/pactus/util/testsuite"
+	pactus "github.com/pactus-project/pactus/www/grpc/gen/go"
+	"github.com/pelletier/go-toml"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	testExplorerKey = "explorer-api-key-0001"
+	testWalletKey   = "wallet-api-key-000002"
+)
+
+func testTenantsConfig() *Config {
+	conf := testConfig()
+	conf.Tenants.Enable = true
+	conf.Tenants.Tenants = []TenantConfig{
+		{
+			Name:    "explorer",
+			APIKey:  testExplorerKey,
+			Methods: []string{"pactus.Blockchain"},
+		},
+		{
+			Name:      "wallet",
+			APIKey:    testWalletKey,
+			RateLimit: 1,
+			Burst:     2,
+			Methods:   []string{"pactus.Blockchain/GetBlockchainInfo", "pactus.Transaction"},
+		},
+	}
+
+	return conf
+}
+
+func apiKeyContext(apiKey string) context.Context {
+	return metadata.AppendToOutgoingContext(context.Background(), APIKeyKey, apiKey)
+}
+
+func TestTenantsConfig(t *testing.T) {
+	t.Run("Decoding the tenants", func(t *testing.T) {
+		data := `
+enable = true
+allow_anonymous = true
+anonymous_rate_limit = 5.0
+anonymous_burst = 10
+anonymous_methods = ["pactus.Blockchain/GetBlockchainInfo"]
+
+[[tenant]]
+  name = "explorer"
+  api_key = "explorer-api-key-0001"
+  rate_limit = 50.0
+  burst = 100
+  methods = ["pactus.Blockchain"]
+
+[[tenant]]
+  name = "wallet"
+  api_key = "wallet-api-key-000002"
+`
+		conf := new(TenantsConfig)
+		require.NoError(t, toml.Unmarshal([]byte(data), conf))
+		assert.NoError(t, conf.BasicCheck())
+		require.Len(t, conf.Tenants, 2)
+		assert.Equal(t, "explorer", conf.Tenants[0].Name)
+		assert.Equal(t, 100, conf.Tenants[0].Burst)
+		assert.Empty(t, conf.Tenants[1].Methods)
+		assert.Equal(t, 10, conf.anonymous().Burst)
+	})
+
+	t.Run("Invalid configs", func(t *testing.T) {
+		tests := []struct {
+			modify func(conf *TenantsConfig)
+			err    string
+		}{
+			{func(conf *TenantsConfig) { conf.Tenants[0].Name = "" }, "invalid tenant name"},
+			{func(conf *TenantsConfig) { conf.Tenants[0].Name = anonymousTenant }, "invalid tenant name"},
+			{func(conf *TenantsConfig) { conf.Tenants[1].Name = "explorer" }, "duplicated tenant"},
+			{func(conf *TenantsConfig) { conf.Tenants[0].APIKey = "short" }, "should be at least"},
+			{func(conf *TenantsConfig) { conf.Tenants[1].APIKey = testExplorerKey }, "duplicated API key"},
+			{func(conf *TenantsConfig) { conf.Tenants[0].RateLimit = -1 }, "should not be negative"},
+			{func(conf *TenantsConfig) { conf.Tenants[1].Burst = 0 }, "burst of tenant wallet"},
+			{func(conf *TenantsConfig) { conf.Tenants[0].Methods = []string{"/pactus.Blockchain"} }, "invalid method"},
+			{func(conf *TenantsConfig) { conf.Tenants[0].Methods = []string{"a/b/c"} }, "invalid method"},
+			{func(conf *TenantsConfig) {
+				conf.AllowAnonymous = true
+				conf.AnonymousBurst = 0
+			}, "burst of tenant anonymous"},
+		}
+
+		for _, tt := range tests {
+			conf := testTenantsConfig()
+			tt.modify(&conf.Tenants)
+			assert.ErrorContains(t, conf.BasicCheck(), tt.err)
+		}
+
+		conf := testTenantsConfig()
+		assert.NoError(t, conf.BasicCheck())
+
+		conf.Tenants.Enable = false
+		conf.Tenants.Tenants[0].Name = ""
+		assert.NoError(t, conf.BasicCheck())
+	})
+}
+
+func TestTenantAllowedMethods(t *testing.T) {
+	tests := []struct {
+		methods []string
+		method  string
+		allowed bool
+	}{
+		{nil, "/pactus.Blockchain/GetBlock", true},
+		{[]string{"pactus"}, "/pactus.Blockchain/GetBlock", true},
+		{[]string{"pactus.Blockchain"}, "/pactus.Blockchain/GetBlock", true},
+		{[]string{"pactus.Blockchain"}, "/pactus.Transaction/GetTransaction", false},
+		{[]string{"pactus.Block"}, "/pactus.Blockchain/GetBlock", false},
+		{[]string{"pactus.Blockchain/GetBlock"}, "/pactus.Blockchain/GetBlock", true},
+		{[]string{"pactus.Blockchain/GetBlock"}, "/pactus.Blockchain/GetBlockHash", false},
+		{[]string{"other", "pactus.Network"}, "/pactus.Network/GetNodeInfo", true},
+	}
+
+	for _, tt := range tests {
+		conf := &TenantConfig{Methods: tt.methods}
+		assert.Equal(t, tt.allowed, conf.allows(tt.method), "methods: %v, method: %s", tt.methods, tt.method)
+	}
+}
+
+func TestTokenBucket(t *testing.T) {
+	now := time.Now()
+	bucket := newTokenBucket(2, 3, now)
+
+	// The bucket is full at start.
+	assert.True(t, bucket.take(now))
+	assert.True(t, bucket.take(now))
+	assert.True(t, bucket.take(now))
+	assert.False(t, bucket.take(now))
+
+	// Two tokens per second.
+	assert.True(t, bucket.take(now.Add(500*time.Millisecond)))
+	assert.False(t, bucket.take(now.Add(500*time.Millisecond)))
+
+	// Not more than the burst.
+	now = now.Add(time.Hour)
+	for i := 0; i < 3; i++ {
+		assert.True(t, bucket.take(now))
+	}
+	assert.False(t, bucket.take(now))
+}
+
+func TestTenantRequests(t *testing.T) {
+	td := setup(t, testTenantsConfig())
+	conn := td.dial(t)
+	blockchainClient := pactus.NewBlockchainClient(conn)
+	transactionClient := pactus.NewTransactionClient(conn)
+
+	t.Run("No API key", func(t *testing.T) {
+		_, err := blockchainClient.GetBlockchainInfo(context.Background(), &pactus.GetBlockchainInfoRequest{})
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+
+	t.Run("Invalid API key", func(t *testing.T) {
+		_, err := blockchainClient.GetBlockchainInfo(apiKeyContext("invalid-api-key-0001"),
+			&pactus.GetBlockchainInfoRequest{})
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+
+	t.Run("Allowed methods", func(t *testing.T) {
+		before := testutil.ToFloat64(tenantRequestsCounter.WithLabelValues(
+			"explorer", "/pactus.Blockchain/GetBlockchainInfo", codes.OK.String()))
+
+		_, err := blockchainClient.GetBlockchainInfo(apiKeyContext(testExplorerKey),
+			&pactus.GetBlockchainInfoRequest{})
+		assert.NoError(t, err)
+
+		_, err = transactionClient.CalculateFee(apiKeyContext(testExplorerKey),
+			&pactus.CalculateFeeRequest{Amount: 1, PayloadType: pactus.PayloadType_TRANSFER_PAYLOAD})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+		after := testutil.ToFloat64(tenantRequestsCounter.WithLabelValues(
+			"explorer", "/pactus.Blockchain/GetBlockchainInfo", codes.OK.String()))
+		assert.Equal(t, before+1, after)
+	})
+
+	t.Run("Isolated rate limits", func(t *testing.T) {
+		for i := 0; i < 2; i++ {
+			_, err := blockchainClient.GetBlockchainInfo(apiKeyContext(testWalletKey),
+				&pactus.GetBlockchainInfoRequest{})
+			assert.NoError(t, err)
+		}
+		_, err := blockchainClient.GetBlockchainInfo(apiKeyContext(testWalletKey),
+			&pactus.GetBlockchainInfoRequest{})
+		assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+		// The other tenants are not limited.
+		_, err = blockchainClient.GetBlockchainInfo(apiKeyContext(testExplorerKey),
+			&pactus.GetBlockchainInfoRequest{})
+		assert.NoError(t, err)
+	})
+
+	assert.NoError(t, conn.Close())
+	td.StopServer()
+}
+
+func TestAnonymousRequests(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	conf := testTenantsConfig()
+	conf.Tenants.AllowAnonymous = true
+	conf.Tenants.AnonymousRateLimit = 0
+	conf.Tenants.AnonymousMethods = []string{"pactus.Blockchain/GetBlockchainInfo"}
+	gate := newTenantGate(&conf.Tenants)
+
+	anonymous := gate.findTenant("")
+	require.NotNil(t, anonymous)
+	assert.Equal(t, anonymousTenant, anonymous.config.Name)
+	assert.Nil(t, anonymous.bucket)
+	assert.True(t, anonymous.config.allows("/pactus.Blockchain/GetBlockchainInfo"))
+	assert.False(t, anonymous.config.allows("/pactus.Blockchain/GetBlock"))
+
+	assert.Equal(t, "explorer", gate.findTenant(testExplorerKey).config.Name)
+	assert.Nil(t, gate.findTenant(ts.RandString(20)))
+}
@@ -0,0 +1,238 @@
+package grpc
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"net"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/pactus-project/pactus/util"
+	"google.golang.org/grpc/credentials"
+)
+
+type TLSConfig struct {
+	Enable       bool   `toml:"enable"`
+	CertFile     string `toml:"cert_file"`
+	KeyFile      string `toml:"key_file"`
+	ClientCAFile string `toml:"client_ca_file"`
+}
+
+// certReloader keeps the node certificate and the client CA pool in memory
+// and reloads them whenever the files are rotated on disk.
+type certReloader struct {
+	lk sync.RWMutex
+
+	config      *TLSConfig
+	cert        *tls.Certificate
+	certModTime time.Time
+	clientCAs   *x509.CertPool
+	caModTime   time.Time
+}
+
+func newCertReloader(conf *TLSConfig) (*certReloader, error) {
+	if !util.PathExists(conf.CertFile) && !util.PathExists(conf.KeyFile) {
+		if err := GenerateSelfSignedCert(conf.CertFile, conf.KeyFile); err != nil {
+			return nil, err
+		}
+	}
+
+	r := &certReloader{
+		config: conf,
+	}
+	if err := r.reload(); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+func modTime(path string) time.Time {
+	info, err := os.Stat(path)
+	if err != nil {
+		return time.Time{}
+	}
+
+	return info.ModTime()
+}
+
+func (r *certReloader) reload() error {
+	certModTime := modTime(r.config.CertFile)
+	cert, err := tls.LoadX509KeyPair(r.config.CertFile, r.config.KeyFile)
+	if err != nil {
+		return fmt.Errorf("unable to load TLS key pair: %w", err)
+	}
+	if cert.Leaf == nil {
+		cert.Leaf, err = x509.ParseCertificate(cert.Certificate[0])
+		if err != nil {
+			return err
+		}
+	}
+
+	var clientCAs *x509.CertPool
+	var caModTime time.Time
+	if r.config.ClientCAFile != "" {
+		caModTime = modTime(r.config.ClientCAFile)
+		data, err := util.ReadFile(r.config.ClientCAFile)
+		if err != nil {
+			return fmt.Errorf("unable to load client CA file: %w", err)
+		}
+		clientCAs = x509.NewCertPool()
+		if !clientCAs.AppendCertsFromPEM(data) {
+			return fmt.Errorf("no valid certificate found in %s", r.config.ClientCAFile)
+		}
+		// The node should always be able to connect to itself,
+		// for example the gRPC gateway.
+		clientCAs.AddCert(cert.Leaf)
+	}
+
+	r.lk.Lock()
+	r.cert = &cert
+	r.certModTime = certModTime
+	r.clientCAs = clientCAs
+	r.caModTime = caModTime
+	r.lk.Unlock()
+
+	return nil
+}
+
+// maybeReload checks the files modification time and reloads them if they changed.
+// The old certificate is kept if reloading fails.
+func (r *certReloader) maybeReload() {
+	r.lk.RLock()
+	changed := !modTime(r.config.CertFile).Equal(r.certModTime)
+	if r.config.ClientCAFile != "" {
+		changed = changed || !modTime(r.config.ClientCAFile).Equal(r.caModTime)
+	}
+	r.lk.RUnlock()
+
+	if !changed {
+		return
+	}
+
+	_ = r.reload()
+}
+
+func (r *certReloader) certificate() *tls.Certificate {
+	r.maybeReload()
+
+	r.lk.RLock()
+	defer r.lk.RUnlock()
+
+	return r.cert
+}
+
+func (r *certReloader) serverTLSConfig() *tls.Config {
+	return &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		GetConfigForClient: func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			cert := r.certificate()
+
+			r.lk.RLock()
+			defer r.lk.RUnlock()
+
+			conf := &tls.Config{
+				MinVersion:   tls.VersionTLS12,
+				Certificates: []tls.Certificate{*cert},
+				NextProtos:   []string{"h2", "http/1.1"},
+			}
+			if r.clientCAs != nil {
+				conf.ClientAuth = tls.RequireAndVerifyClientCert
+				conf.ClientCAs = r.clientCAs
+			}
+
+			return conf, nil
+		},
+	}
+}
+
+// selfClientCredentials returns the credentials used by the node
+// when it connects to its own gRPC server, like the gRPC gateway.
+// The node presents its own certificate and only accepts the same certificate
+// from the server.
+func (r *certReloader) selfClientCredentials() credentials.TransportCredentials {
+	return credentials.NewTLS(&tls.Config{
+		MinVersion: tls.VersionTLS12,
+		//nolint:gosec // The server certificate is pinned in VerifyPeerCertificate.
+		InsecureSkipVerify: true,
+		GetClientCertificate: func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+			return r.certificate(), nil
+		},
+		VerifyPeerCertificate: func(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+			if len(rawCerts) == 0 {
+				return fmt.Errorf("no server certificate")
+			}
+			if !bytes.Equal(rawCerts[0], r.certificate().Certificate[0]) {
+				return fmt.Errorf("unexpected server certificate")
+			}
+
+			return nil
+		},
+	})
+}
+
+// GenerateSelfSignedCert generates a self-signed certificate and key for the node
+// and saves them in PEM format.
+// The certificate can be used for both server and client authentication.
+func GenerateSelfSignedCert(certFile, keyFile string) error {
+	prv, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return err
+	}
+
+	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return err
+	}
+
+	hostname, _ := os.Hostname()
+	dnsNames := []string{"localhost"}
+	if hostname != "" && hostname != "localhost" {
+		dnsNames = append(dnsNames, hostname)
+	}
+
+	now := util.Now()
+	template := &x509.Certificate{
+		SerialNumber: serial,
+		Subject: pkix.Name{
+			Organization: []string{"Pactus"},
+			CommonName:   "pactus-node",
+		},
+		NotBefore:             now.Add(-1 * time.Hour),
+		NotAfter:              now.AddDate(10, 0, 0),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+		DNSNames:              dnsNames,
+		IPAddresses:           []net.IP{net.IPv4(127, 0, 0, 1), net.IPv6loopback},
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &prv.PublicKey, prv)
+	if err != nil {
+		return err
+	}
+
+	keyDer, err := x509.MarshalECPrivateKey(prv)
+	if err != nil {
+		return err
+	}
+
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer})
+
+	if err := util.WriteFile(certFile, certPEM); err != nil {
+		return err
+	}
+
+	return util.WriteFile(keyFile, keyPEM)
+}
@@ -0,0 +1,176 @@
+package grpc
+
+import (
+	"context"
+	"crypto/tls"
+	"net"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/pactus-project/pactus/consensus"
+	"github.com/pactus-project/pactus/crypto/bls"
+	"github.com/pactus-project/pactus/network"
+	"github.com/pactus-project/pactus/state"
+	"github.com/pactus-project/pactus/sync"
+	"github.com/pactus-project/pactus/util"
+	"github.com/pactus-project/pactus/util/testsuite"
+	pactus "github.com/pactus-project/pactus/www/grpc/gen/go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+func tlsTestConfig(t *testing.T, clientAuth bool) *Config {
+	t.Helper()
+
+	dir := util.TempDirPath()
+	conf := testConfig()
+	conf.TLS.Enable = true
+	conf.TLS.CertFile = filepath.Join(dir, "cert.pem")
+	conf.TLS.KeyFile = filepath.Join(dir, "key.pem")
+	if clientAuth {
+		caCert := filepath.Join(dir, "client_ca.pem")
+		caKey := filepath.Join(dir, "client_ca_key.pem")
+		require.NoError(t, GenerateSelfSignedCert(caCert, caKey))
+		conf.TLS.ClientCAFile = caCert
+	}
+
+	return conf
+}
+
+func TestGenerateSelfSignedCert(t *testing.T) {
+	dir := util.TempDirPath()
+	certFile := filepath.Join(dir, "cert.pem")
+	keyFile := filepath.Join(dir, "key.pem")
+
+	assert.NoError(t, GenerateSelfSignedCert(certFile, keyFile))
+
+	cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+	assert.NoError(t, err)
+	assert.NotNil(t, cert)
+}
+
+func TestCertReloader(t *testing.T) {
+	conf := tlsTestConfig(t, false)
+	reloader, err := newCertReloader(&conf.TLS)
+	require.NoError(t, err)
+
+	cert1 := reloader.certificate()
+
+	// Rotating the certificate
+	time.Sleep(10 * time.Millisecond)
+	require.NoError(t, GenerateSelfSignedCert(conf.TLS.CertFile, conf.TLS.KeyFile))
+
+	cert2 := reloader.certificate()
+	assert.NotEqual(t, cert1.Certificate[0], cert2.Certificate[0])
+}
+
+func TestCertReloaderInvalidFiles(t *testing.T) {
+	conf := tlsTestConfig(t, false)
+	require.NoError(t, util.WriteFile(conf.TLS.CertFile, []byte("invalid")))
+
+	_, err := newCertReloader(&conf.TLS)
+	assert.Error(t, err)
+}
+
+func startTLSServer(t *testing.T, conf *Config) *Server {
+	t.Helper()
+
+	ts := testsuite.NewTestSuite(t)
+	mockConsMgr, _ := consensus.MockingManager(ts, []*bls.ValidatorKey{ts.RandValKey()})
+	mockState := state.MockingState(ts)
+	mockNet := network.MockingNetwork(ts, ts.RandPeerID())
+	mockSync := sync.MockingSync(ts)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	server := NewServer(conf, mockState, mockSync, mockNet, mockConsMgr, nil, nil)
+	require.NoError(t, server.startListening(listener))
+
+	return server
+}
+
+func callBlockchainInfo(addr string, creds credentials.TransportCredentials) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	conn, err := grpc.DialContext(ctx, addr,
+		grpc.WithTransportCredentials(creds), grpc.WithBlock())
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	_, err = pactus.NewBlockchainClient(conn).GetBlockchainInfo(ctx, &pactus.GetBlockchainInfoRequest{})
+
+	return err
+}
+
+func TestTLSServer(t *testing.T) {
+	conf := tlsTestConfig(t, false)
+	server := startTLSServer(t, conf)
+	defer server.StopServer()
+
+	t.Run("Plaintext client should fail", func(t *testing.T) {
+		assert.Error(t, callBlockchainInfo(server.Address(), insecure.NewCredentials()))
+	})
+
+	t.Run("TLS client should succeed", func(t *testing.T) {
+		//nolint:gosec // Testing only.
+		creds := credentials.NewTLS(&tls.Config{InsecureSkipVerify: true})
+		assert.NoError(t, callBlockchainInfo(server.Address(), creds))
+	})
+
+	t.Run("Internal client should succeed", func(t *testing.T) {
+		assert.NoError(t, callBlockchainInfo(server.Address(), server.ClientCredentials()))
+	})
+}
+
+func TestMutualTLSServer(t *testing.T) {
+	conf := tlsTestConfig(t, true)
+	server := startTLSServer(t, conf)
+	defer server.StopServer()
+
+	t.Run("Client without certificate should fail", func(t *testing.T) {
+		//nolint:gosec // Testing only.
+		creds := credentials.NewTLS(&tls.Config{InsecureSkipVerify: true})
+		assert.Error(t, callBlockchainInfo(server.Address(), creds))
+	})
+
+	t.Run("Client with an unknown certificate should fail", func(t *testing.T) {
+		dir := util.TempDirPath()
+		certFile := filepath.Join(dir, "cert.pem")
+		keyFile := filepath.Join(dir, "key.pem")
+		require.NoError(t, GenerateSelfSignedCert(certFile, keyFile))
+		cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+		require.NoError(t, err)
+
+		//nolint:gosec // Testing only.
+		creds := credentials.NewTLS(&tls.Config{
+			InsecureSkipVerify: true,
+			Certificates:       []tls.Certificate{cert},
+		})
+		assert.Error(t, callBlockchainInfo(server.Address(), creds))
+	})
+
+	t.Run("Client with a trusted certificate should succeed", func(t *testing.T) {
+		caKey := filepath.Join(filepath.Dir(conf.TLS.ClientCAFile), "client_ca_key.pem")
+		cert, err := tls.LoadX509KeyPair(conf.TLS.ClientCAFile, caKey)
+		require.NoError(t, err)
+
+		//nolint:gosec // Testing only.
+		creds := credentials.NewTLS(&tls.Config{
+			InsecureSkipVerify: true,
+			Certificates:       []tls.Certificate{cert},
+		})
+		assert.NoError(t, callBlockchainInfo(server.Address(), creds))
+	})
+
+	t.Run("Internal client should succeed", func(t *testing.T) {
+		assert.NoError(t, callBlockchainInfo(server.Address(), server.ClientCredentials()))
+	})
+}
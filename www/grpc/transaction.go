@@ -2,25 +2,49 @@ package grpc
 
 import (
 	"context"
+	"fmt"
+	"sync"
+	"time"
 
+	"github.com/hashicorp/golang-lru/v2/expirable"
 	"github.com/pactus-project/pactus/crypto"
 	"github.com/pactus-project/pactus/crypto/bls"
 	"github.com/pactus-project/pactus/crypto/hash"
+	"github.com/pactus-project/pactus/types/account"
+	"github.com/pactus-project/pactus/types/receipt"
 	"github.com/pactus-project/pactus/types/tx"
 	"github.com/pactus-project/pactus/types/tx/payload"
+	"github.com/pactus-project/pactus/types/validator"
+	"github.com/pactus-project/pactus/util/errors"
 	"github.com/pactus-project/pactus/util/logger"
+	"github.com/pactus-project/pactus/util/txtrace"
+	"github.com/pactus-project/pactus/wallet/paymenturi"
 	pactus "github.com/pactus-project/pactus/www/grpc/gen/go"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
+const (
+	idempotencyCacheSize = 10000
+	idempotencyTTL       = 10 * time.Minute
+
+	// txPoolShortIDLen is the length of the short IDs in the snapshots of the transaction pool.
+	txPoolShortIDLen = 8
+)
+
 type transactionServer struct {
 	*Server
+
+	// idempotencyLk serializes the broadcasts with an idempotency key.
+	idempotencyLk sync.Mutex
+	// idempotencyCache maps the idempotency keys to the broadcasted transaction IDs.
+	idempotencyCache *expirable.LRU[string, tx.ID]
 }
 
 func newTransactionServer(server *Server) *transactionServer {
 	return &transactionServer{
-		Server: server,
+		Server:           server,
+		idempotencyCache: expirable.NewLRU[string, tx.ID](idempotencyCacheSize, nil, idempotencyTTL),
 	}
 }
 
@@ -58,20 +82,56 @@ func (s *transactionServer) GetTransaction(_ context.Context,
 	return res, nil
 }
 
+func (s *transactionServer) GetTransactionReceipt(_ context.Context,
+	req *pactus.GetTransactionReceiptRequest,
+) (*pactus.GetTransactionReceiptResponse, error) {
+	id, err := hash.FromBytes(req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid transaction ID: %v", err.Error())
+	}
+
+	rcpt := s.state.TransactionReceipt(id)
+	if rcpt == nil {
+		return nil, status.Errorf(codes.NotFound, "transaction receipt not found")
+	}
+
+	return &pactus.GetTransactionReceiptResponse{
+		Receipt: receiptToProto(rcpt),
+	}, nil
+}
+
 func (s *transactionServer) BroadcastTransaction(_ context.Context,
 	req *pactus.BroadcastTransactionRequest,
 ) (*pactus.BroadcastTransactionResponse, error) {
 	trx, err := tx.FromBytes(req.SignedRawTransaction)
 	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "couldn't decode transaction: %v", err.Error())
+		return nil, statusError(codes.InvalidArgument, err, "couldn't decode transaction")
 	}
 
 	if err := trx.BasicCheck(); err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "couldn't verify transaction: %v", err.Error())
+		return nil, statusError(codes.InvalidArgument, err, "couldn't verify transaction")
+	}
+
+	if req.IdempotencyKey != "" {
+		return s.broadcastIdempotent(req.IdempotencyKey, trx)
+	}
+
+	return s.broadcast(trx)
+}
+
+// broadcast adds the transaction to the transaction pool and broadcasts it.
+// If the transaction is already in the pool, it is not broadcasted again.
+// If the pool rejects the transaction, the diagnostics of the rejection are attached to the error.
+func (s *transactionServer) broadcast(trx *tx.Tx) (*pactus.BroadcastTransactionResponse, error) {
+	if s.state.PendingTx(trx.ID()) != nil {
+		return &pactus.BroadcastTransactionResponse{
+			Id:             trx.ID().Bytes(),
+			AlreadyPending: true,
+		}, nil
 	}
 
 	if err := s.state.AddPendingTxAndBroadcast(trx); err != nil {
-		return nil, status.Errorf(codes.Canceled, "couldn't add to transaction pool: %v", err.Error())
+		return nil, s.rejectionError(trx, err)
 	}
 
 	return &pactus.BroadcastTransactionResponse{
@@ -79,6 +139,126 @@ func (s *transactionServer) BroadcastTransaction(_ context.Context,
 	}, nil
 }
 
+// rejectionError returns a gRPC status error for a transaction that is rejected
+// by the transaction pool, with the ErrorInfo and the BroadcastDiagnostics attached to it.
+func (s *transactionServer) rejectionError(trx *tx.Tx, err error) error {
+	st := status.New(codes.Canceled, fmt.Sprintf("couldn't add to transaction pool: %v", err.Error()))
+	diag := s.broadcastDiagnostics(trx, err)
+	if diag == nil {
+		return withErrorInfo(st, ErrorInfo(err))
+	}
+
+	detailed, detailsErr := st.WithDetails(ErrorInfo(err), diag)
+	if detailsErr != nil {
+		return withErrorInfo(st, ErrorInfo(err))
+	}
+
+	return detailed.Err()
+}
+
+// broadcastDiagnostics explains why the transaction is rejected, so the client can fix it.
+// It returns nil if there is nothing to explain beyond the error itself.
+func (s *transactionServer) broadcastDiagnostics(trx *tx.Tx, err error) *pactus.BroadcastDiagnostics {
+	switch errors.Code(err) {
+	case errors.ErrInvalidFee:
+		expectedFee, feeErr := s.expectedFee(trx)
+		if feeErr != nil {
+			return nil
+		}
+
+		return &pactus.BroadcastDiagnostics{
+			ExpectedFee: expectedFee,
+			FeeDelta:    expectedFee - trx.Fee(),
+		}
+
+	case errors.ErrPastLockTime, errors.ErrFutureLockTime:
+		return &pactus.BroadcastDiagnostics{
+			LockTimeDistance: s.lockTimeDistance(trx),
+		}
+
+	case errors.ErrInsufficientFunds:
+		return &pactus.BroadcastDiagnostics{
+			ConflictingTxIds: s.pendingTxsOfSigner(trx),
+		}
+
+	default:
+		return nil
+	}
+}
+
+func (s *transactionServer) expectedFee(trx *tx.Tx) (int64, error) {
+	if trx.IsFreeTx() {
+		return 0, nil
+	}
+
+	amount := trx.Payload().Value()
+	if trx.IsDataTx() {
+		amount = int64(len(trx.Payload().(*payload.DataPayload).Data))
+	}
+
+	return s.state.CalculateFee(amount, trx.Payload().Type())
+}
+
+// lockTimeDistance returns the distance of the lock time from the next block height,
+// or from the last block time for the timestamp lock times.
+func (s *transactionServer) lockTimeDistance(trx *tx.Tx) int64 {
+	if trx.IsTimestampLocked() {
+		return int64(trx.LockTime()) - s.state.LastBlockTime().Unix()
+	}
+
+	return int64(trx.LockTime()) - int64(s.state.LastBlockHeight()+1)
+}
+
+// pendingTxsOfSigner returns the IDs of the pending transactions that have
+// the same signer as the given transaction. They spend the funds of the signer
+// before the given transaction.
+func (s *transactionServer) pendingTxsOfSigner(trx *tx.Tx) [][]byte {
+	signer := trx.Payload().Signer()
+	_, ids := s.state.PendingTxsSnapshot()
+
+	conflicts := make([][]byte, 0)
+	for _, id := range ids {
+		pending := s.state.PendingTx(id)
+		if pending == nil || pending.ID() == trx.ID() {
+			continue
+		}
+		if pending.Payload().Signer() == signer {
+			conflicts = append(conflicts, id.Bytes())
+		}
+	}
+
+	return conflicts
+}
+
+// broadcastIdempotent broadcasts the transaction only once for the given idempotency key.
+// The retried requests with the same key get the cached result, even if the transaction
+// is committed in the meantime. Failed broadcasts are not cached, so they can be retried.
+func (s *transactionServer) broadcastIdempotent(key string, trx *tx.Tx,
+) (*pactus.BroadcastTransactionResponse, error) {
+	s.idempotencyLk.Lock()
+	defer s.idempotencyLk.Unlock()
+
+	id, ok := s.idempotencyCache.Get(key)
+	if ok {
+		if id != trx.ID() {
+			return nil, status.Errorf(codes.AlreadyExists,
+				"idempotency key is used for another transaction: %s", id.String())
+		}
+
+		return &pactus.BroadcastTransactionResponse{
+			Id: id.Bytes(),
+		}, nil
+	}
+
+	res, err := s.broadcast(trx)
+	if err != nil {
+		return nil, err
+	}
+	s.idempotencyCache.Add(key, trx.ID())
+
+	return res, nil
+}
+
 func (s *transactionServer) CalculateFee(_ context.Context,
 	req *pactus.CalculateFeeRequest,
 ) (*pactus.CalculateFeeResponse, error) {
@@ -92,7 +272,7 @@ func (s *transactionServer) CalculateFee(_ context.Context,
 	}, nil
 }
 
-func (s *transactionServer) GetRawTransferTransaction(_ context.Context,
+func (s *transactionServer) GetRawTransferTransaction(ctx context.Context,
 	req *pactus.GetRawTransferTransactionRequest,
 ) (*pactus.GetRawTransactionResponse, error) {
 	sender, err := crypto.AddressFromString(req.Sender)
@@ -100,20 +280,27 @@ func (s *transactionServer) GetRawTransferTransaction(_ context.Context,
 		return nil, err
 	}
 
-	receiver, err := crypto.AddressFromString(req.Receiver)
+	// The receiver can be a registered name. The resolved address is echoed back,
+	// so the client can confirm it before signing.
+	receiver, resolved, err := s.resolveAddress(ctx, req.Receiver)
 	if err != nil {
-		return nil, err
+		return nil, status.Errorf(codes.InvalidArgument, "invalid receiver: %v", err.Error())
 	}
 
-	transferTx := tx.NewTransferTx(req.LockTime, sender, receiver, req.Amount, req.Fee, req.Memo)
+	transferTx := tx.NewTransferTx(s.lockTime(req.LockTime, req.RelativeLockTime), sender, receiver, req.Amount, req.Fee, req.Memo)
 	rawTx, err := transferTx.Bytes()
 	if err != nil {
 		return nil, err
 	}
 
-	return &pactus.GetRawTransactionResponse{
+	res := &pactus.GetRawTransactionResponse{
 		RawTransaction: rawTx,
-	}, nil
+	}
+	if resolved {
+		res.ResolvedReceiver = receiver.String()
+	}
+
+	return res, nil
 }
 
 func (s *transactionServer) GetRawBondTransaction(_ context.Context,
@@ -139,7 +326,14 @@ func (s *transactionServer) GetRawBondTransaction(_ context.Context,
 		publicKey = nil
 	}
 
-	bondTx := tx.NewBondTx(req.LockTime, sender, receiver, publicKey, req.Stake, req.Fee, req.Memo)
+	bondTx := tx.NewBondTx(s.lockTime(req.LockTime, req.RelativeLockTime), sender, receiver, publicKey, req.Stake, req.Fee, req.Memo)
+	if req.ProofOfPossession != "" {
+		pop, err := bls.SignatureFromString(req.ProofOfPossession)
+		if err != nil {
+			return nil, err
+		}
+		bondTx.Payload().(*payload.BondPayload).ProofOfPossession = pop
+	}
 	rawTx, err := bondTx.Bytes()
 	if err != nil {
 		return nil, err
@@ -158,7 +352,7 @@ func (s *transactionServer) GetRawUnBondTransaction(_ context.Context,
 		return nil, err
 	}
 
-	unBondTx := tx.NewUnbondTx(req.LockTime, validatorAddr, req.Memo)
+	unBondTx := tx.NewUnbondTx(s.lockTime(req.LockTime, req.RelativeLockTime), validatorAddr, req.Memo)
 	rawTx, err := unBondTx.Bytes()
 	if err != nil {
 		return nil, err
@@ -182,7 +376,7 @@ func (s *transactionServer) GetRawWithdrawTransaction(_ context.Context,
 		return nil, err
 	}
 
-	withdrawTx := tx.NewWithdrawTx(req.LockTime, validatorAddr, accountAddr, req.Amount, req.Fee, req.Memo)
+	withdrawTx := tx.NewWithdrawTx(s.lockTime(req.LockTime, req.RelativeLockTime), validatorAddr, accountAddr, req.Amount, req.Fee, req.Memo)
 	rawTx, err := withdrawTx.Bytes()
 	if err != nil {
 		return nil, err
@@ -193,6 +387,184 @@ func (s *transactionServer) GetRawWithdrawTransaction(_ context.Context,
 	}, nil
 }
 
+func (s *transactionServer) GetTxPoolSnapshot(_ context.Context,
+	_ *pactus.GetTxPoolSnapshotRequest,
+) (*pactus.GetTxPoolSnapshotResponse, error) {
+	seq, ids := s.state.PendingTxsSnapshot()
+	shortIDs := make([][]byte, 0, len(ids))
+	for _, id := range ids {
+		shortIDs = append(shortIDs, id.Bytes()[:txPoolShortIDLen])
+	}
+
+	return &pactus.GetTxPoolSnapshotResponse{
+		Sequence: seq,
+		ShortIds: shortIDs,
+	}, nil
+}
+
+// GetTxPoolChanges returns the changes of the pool after the sequence.
+// The added transactions are attached if they are still in the pool,
+// so the mirrors don't need to fetch them one by one.
+func (s *transactionServer) GetTxPoolChanges(_ context.Context,
+	req *pactus.GetTxPoolChangesRequest,
+) (*pactus.GetTxPoolChangesResponse, error) {
+	changes, seq, ok := s.state.PendingTxsChangesSince(req.SinceSequence)
+	if !ok {
+		return &pactus.GetTxPoolChangesResponse{
+			Sequence:         seq,
+			SnapshotRequired: true,
+		}, nil
+	}
+
+	protoChanges := make([]*pactus.TxPoolChange, 0, len(changes))
+	for _, change := range changes {
+		protoChange := &pactus.TxPoolChange{
+			Sequence: change.Sequence,
+			Added:    change.Added,
+			Id:       change.ID.Bytes(),
+		}
+
+		if change.Added {
+			if trx := s.state.PendingTx(change.ID); trx != nil {
+				if req.Verbosity == pactus.TransactionVerbosity_TRANSACTION_DATA {
+					data, _ := trx.Bytes()
+					protoChange.Transaction = &pactus.TransactionInfo{
+						Data: data,
+						Id:   change.ID.Bytes(),
+					}
+				} else {
+					protoChange.Transaction = transactionToProto(trx)
+				}
+			}
+		}
+
+		protoChanges = append(protoChanges, protoChange)
+	}
+
+	return &pactus.GetTxPoolChangesResponse{
+		Sequence: seq,
+		Changes:  protoChanges,
+	}, nil
+}
+
+// GetTransactionTimeline returns the stages that a recent transaction has passed in this node.
+func (*transactionServer) GetTransactionTimeline(_ context.Context,
+	req *pactus.GetTransactionTimelineRequest,
+) (*pactus.GetTransactionTimelineResponse, error) {
+	id, err := hash.FromBytes(req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid transaction ID: %v", err.Error())
+	}
+
+	timeline, ok := txtrace.Timeline(id)
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "transaction timeline not found")
+	}
+
+	events := make([]*pactus.TransactionStageEvent, 0, len(timeline))
+	for _, e := range timeline {
+		events = append(events, &pactus.TransactionStageEvent{
+			Stage:   pactus.TransactionStage(e.Stage),
+			Time:    e.Time.UnixMilli(),
+			Elapsed: e.Time.Sub(timeline[0].Time).Milliseconds(),
+		})
+	}
+
+	return &pactus.GetTransactionTimelineResponse{
+		Events: events,
+	}, nil
+}
+
+// EncodePaymentURI encodes a payment request as a `pactus:` payment URI.
+func (*transactionServer) EncodePaymentURI(_ context.Context,
+	req *pactus.EncodePaymentURIRequest,
+) (*pactus.EncodePaymentURIResponse, error) {
+	addr, err := crypto.AddressFromString(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %v", err.Error())
+	}
+
+	uri := paymenturi.PaymentURI{
+		Address: addr,
+		Amount:  req.Amount,
+		Memo:    req.Memo,
+	}
+	if req.Expiry < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid expiry: %d", req.Expiry)
+	}
+	if req.Expiry > 0 {
+		uri.Expiry = time.Unix(req.Expiry, 0)
+	}
+	if err := uri.BasicCheck(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &pactus.EncodePaymentURIResponse{
+		Uri: uri.String(),
+	}, nil
+}
+
+// DecodePaymentURI decodes and validates a `pactus:` payment URI.
+// An expired payment request is decoded as well, so the caller can show why it is refused.
+func (*transactionServer) DecodePaymentURI(_ context.Context,
+	req *pactus.DecodePaymentURIRequest,
+) (*pactus.DecodePaymentURIResponse, error) {
+	uri, err := paymenturi.Parse(req.Uri)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid payment URI: %v", err.Error())
+	}
+
+	expiry := int64(0)
+	if !uri.Expiry.IsZero() {
+		expiry = uri.Expiry.Unix()
+	}
+
+	return &pactus.DecodePaymentURIResponse{
+		Address: uri.Address.String(),
+		Amount:  uri.Amount,
+		Memo:    uri.Memo,
+		Expiry:  expiry,
+		Expired: uri.IsExpired(time.Now()),
+	}, nil
+}
+
+func (s *transactionServer) ResolveName(ctx context.Context,
+	req *pactus.ResolveNameRequest,
+) (*pactus.ResolveNameResponse, error) {
+	if !IsName(req.Name) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid name: %s", req.Name)
+	}
+	resolver := s.nameService.getResolver()
+	if resolver == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "name service is not configured")
+	}
+
+	addr, err := resolver.ResolveName(ctx, req.Name)
+	if err != nil {
+		if IsNameNotFound(err) {
+			return nil, status.Errorf(codes.NotFound, "unable to resolve the name: %v", err.Error())
+		}
+
+		return nil, status.Errorf(codes.Internal, "unable to resolve the name: %v", err.Error())
+	}
+
+	return &pactus.ResolveNameResponse{
+		Name:    req.Name,
+		Address: addr.String(),
+	}, nil
+}
+
+// lockTime returns the absolute lock time for a new transaction.
+// If the lock time is not set, the relative lock time is added to the next block height,
+// so the transaction becomes valid that many blocks after it is submitted.
+func (s *transactionServer) lockTime(lockTime, relativeLockTime uint32) uint32 {
+	if lockTime != 0 {
+		return lockTime
+	}
+
+	return s.state.LastBlockHeight() + 1 + relativeLockTime
+}
+
 func transactionToProto(trx *tx.Tx) *pactus.TransactionInfo {
 	data, _ := trx.Bytes()
 	transaction := &pactus.TransactionInfo{
@@ -206,6 +578,12 @@ func transactionToProto(trx *tx.Tx) *pactus.TransactionInfo {
 		Memo:        trx.Memo(),
 	}
 
+	if trx.IsTimestampLocked() {
+		transaction.LockTimeType = pactus.LockTimeType_LOCK_TIME_TIMESTAMP
+	} else {
+		transaction.LockTimeType = pactus.LockTimeType_LOCK_TIME_HEIGHT
+	}
+
 	if trx.PublicKey() != nil {
 		transaction.PublicKey = trx.PublicKey().String()
 	}
@@ -257,9 +635,142 @@ func transactionToProto(trx *tx.Tx) *pactus.TransactionInfo {
 				Amount: pld.Amount,
 			},
 		}
+	case payload.TypeEscrow:
+		pld := trx.Payload().(*payload.EscrowPayload)
+		transaction.Payload = &pactus.TransactionInfo_Escrow{
+			Escrow: &pactus.PayloadEscrow{
+				Escrow:   pld.Escrow().String(),
+				Buyer:    pld.Buyer.String(),
+				Seller:   pld.Seller.String(),
+				Arbiter:  pld.Arbiter.String(),
+				Nonce:    pld.Nonce,
+				Sender:   pld.From.String(),
+				Receiver: pld.To.String(),
+				Amount:   pld.Amount,
+				Approver: pld.ApproverPublicKey.AccountAddress().String(),
+			},
+		}
+	case payload.TypeData:
+		pld := trx.Payload().(*payload.DataPayload)
+		transaction.Payload = &pactus.TransactionInfo_DataPayload{
+			DataPayload: &pactus.PayloadData{
+				Sender: pld.From.String(),
+				Data:   pld.Data,
+			},
+		}
+	case payload.TypeAllowlist:
+		pld := trx.Payload().(*payload.AllowlistPayload)
+		transaction.Payload = &pactus.TransactionInfo_Allowlist{
+			Allowlist: &pactus.PayloadAllowlist{
+				Governor:  pld.Governor.String(),
+				Validator: pld.Validator.String(),
+				Allow:     pld.Allow,
+			},
+		}
+	case payload.TypeCondition:
+		pld := trx.Payload().(*payload.ConditionPayload)
+		approvers := make([]string, 0, len(pld.Approvals))
+		for _, approval := range pld.Approvals {
+			approvers = append(approvers, approval.PublicKey.AccountAddress().String())
+		}
+		condition := &pactus.PayloadCondition{
+			Sender:    pld.From.String(),
+			Receiver:  pld.To.String(),
+			Amount:    pld.Amount,
+			Approvers: approvers,
+		}
+		if pld.Condition != nil {
+			condition.Condition = conditionToProto(pld.Condition)
+		}
+		transaction.Payload = &pactus.TransactionInfo_Condition{
+			Condition: condition,
+		}
+	case payload.TypeDelegate:
+		pld := trx.Payload().(*payload.DelegatePayload)
+		delegate := &pactus.PayloadDelegate{
+			Delegator: pld.From.String(),
+			Validator: pld.To.String(),
+			Stake:     pld.Stake,
+		}
+		if pld.PublicKey != nil {
+			delegate.PublicKey = pld.PublicKey.String()
+		}
+		transaction.Payload = &pactus.TransactionInfo_Delegate{
+			Delegate: delegate,
+		}
+	case payload.TypeRewardSplit:
+		pld := trx.Payload().(*payload.RewardSplitPayload)
+		transaction.Payload = &pactus.TransactionInfo_RewardSplit{
+			RewardSplit: &pactus.PayloadRewardSplit{
+				Validator: pld.Validator.String(),
+				Shares:    rewardSharesToProto(&pld.Split),
+			},
+		}
 	default:
-		logger.Error("payload type not defined", "type", trx.Payload().Type())
+		// The custom payloads have no message in the protobuf definitions,
+		// they are only identified by the payload type.
+		if !trx.Payload().Type().IsCustom() {
+			logger.Error("payload type not defined", "type", trx.Payload().Type())
+		}
 	}
 
 	return transaction
 }
+
+func rewardSharesToProto(split *validator.RewardSplit) []*pactus.RewardShare {
+	shares := make([]*pactus.RewardShare, 0, len(split.Shares))
+	for _, share := range split.Shares {
+		shares = append(shares, &pactus.RewardShare{
+			Address: share.Address.String(),
+			Percent: uint32(share.Percent),
+		})
+	}
+
+	return shares
+}
+
+func conditionToProto(cond *account.Condition) *pactus.AccountCondition {
+	cosigners := make([]string, 0, len(cond.Cosigners))
+	for _, addr := range cond.Cosigners {
+		cosigners = append(cosigners, addr.String())
+	}
+
+	return &pactus.AccountCondition{
+		UnlockHeight: cond.UnlockHeight,
+		MaxAmount:    cond.MaxAmount,
+		Threshold:    uint32(cond.Threshold),
+		Cosigners:    cosigners,
+	}
+}
+
+func receiptToProto(rcpt *receipt.Receipt) *pactus.TransactionReceipt {
+	balances := make([]*pactus.ReceiptBalance, 0, len(rcpt.Balances()))
+	for _, b := range rcpt.Balances() {
+		balances = append(balances, &pactus.ReceiptBalance{
+			Address: b.Address.String(),
+			Balance: b.Balance,
+		})
+	}
+
+	events := make([]*pactus.ReceiptEvent, 0, len(rcpt.Events()))
+	for _, e := range rcpt.Events() {
+		events = append(events, &pactus.ReceiptEvent{
+			Type:     pactus.ReceiptEventType(e.Type),
+			Address:  e.Address.String(),
+			OldValue: e.OldValue,
+			NewValue: e.NewValue,
+		})
+	}
+
+	txID := rcpt.TxID()
+
+	return &pactus.TransactionReceipt{
+		Id:          txID.Bytes(),
+		BlockHeight: rcpt.Height(),
+		Index:       uint32(rcpt.Index()),
+		Status:      pactus.ReceiptStatus(rcpt.Status()),
+		Fee:         rcpt.Fee(),
+		Balances:    balances,
+		Events:      events,
+	}
+}
@@ -2,11 +2,26 @@ package grpc
 
 import (
 	"context"
+	"fmt"
 	"testing"
+	"time"
 
+	"github.com/pactus-project/pactus/crypto"
+	"github.com/pactus-project/pactus/execution"
+	"github.com/pactus-project/pactus/execution/executor"
+	"github.com/pactus-project/pactus/types/account"
+	"github.com/pactus-project/pactus/types/receipt"
+	"github.com/pactus-project/pactus/types/tx"
 	"github.com/pactus-project/pactus/types/tx/payload"
+	"github.com/pactus-project/pactus/types/validator"
+	"github.com/pactus-project/pactus/util/errors"
+	"github.com/pactus-project/pactus/util/testsuite"
+	"github.com/pactus-project/pactus/util/txtrace"
 	pactus "github.com/pactus-project/pactus/www/grpc/gen/go"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func TestGetTransaction(t *testing.T) {
@@ -51,6 +66,7 @@ func TestGetTransaction(t *testing.T) {
 		assert.Equal(t, trx1.Memo(), res.Transaction.Memo)
 		assert.Equal(t, trx1.Payload().Type(), payload.Type(res.Transaction.PayloadType))
 		assert.Equal(t, trx1.LockTime(), res.Transaction.LockTime)
+		assert.Equal(t, pactus.LockTimeType_LOCK_TIME_HEIGHT, res.Transaction.LockTimeType)
 		assert.Equal(t, trx1.Signature().Bytes(), res.Transaction.Signature)
 		assert.Equal(t, trx1.PublicKey().String(), res.Transaction.PublicKey)
 		assert.Equal(t, trx1.Payload().(*payload.TransferPayload).Amount, pld.Transfer.Amount)
@@ -77,6 +93,167 @@ func TestGetTransaction(t *testing.T) {
 	td.StopServer()
 }
 
+func TestGetTransactionReceipt(t *testing.T) {
+	td := setup(t, nil)
+	conn, client := td.transactionClient(t)
+
+	trx, _ := td.GenerateTestTransferTx()
+	pld := trx.Payload().(*payload.TransferPayload)
+	rcpt := receipt.NewReceipt(trx.ID(), 10, 1, receipt.StatusSuccess, trx.Fee(),
+		[]receipt.Balance{
+			{Address: pld.From, Balance: 1000},
+			{Address: pld.To, Balance: 2000},
+		},
+		[]receipt.Event{
+			{Type: receipt.EventBalanceChange, Address: pld.From, OldValue: 3000, NewValue: 1000},
+		})
+	td.mockState.TestStore.SaveReceipts([]*receipt.Receipt{rcpt})
+
+	t.Run("Should return the receipt", func(t *testing.T) {
+		res, err := client.GetTransactionReceipt(context.Background(),
+			&pactus.GetTransactionReceiptRequest{Id: trx.ID().Bytes()})
+		assert.NoError(t, err)
+
+		assert.Equal(t, trx.ID().Bytes(), res.Receipt.Id)
+		assert.Equal(t, uint32(10), res.Receipt.BlockHeight)
+		assert.Equal(t, uint32(1), res.Receipt.Index)
+		assert.Equal(t, pactus.ReceiptStatus_RECEIPT_STATUS_SUCCESS, res.Receipt.Status)
+		assert.Equal(t, trx.Fee(), res.Receipt.Fee)
+		assert.Len(t, res.Receipt.Balances, 2)
+		assert.Equal(t, pld.From.String(), res.Receipt.Balances[0].Address)
+		assert.Equal(t, int64(1000), res.Receipt.Balances[0].Balance)
+		assert.Equal(t, pld.To.String(), res.Receipt.Balances[1].Address)
+		assert.Equal(t, int64(2000), res.Receipt.Balances[1].Balance)
+		assert.Len(t, res.Receipt.Events, 1)
+		assert.Equal(t, pactus.ReceiptEventType_RECEIPT_EVENT_BALANCE_CHANGE, res.Receipt.Events[0].Type)
+		assert.Equal(t, pld.From.String(), res.Receipt.Events[0].Address)
+		assert.Equal(t, int64(3000), res.Receipt.Events[0].OldValue)
+		assert.Equal(t, int64(1000), res.Receipt.Events[0].NewValue)
+	})
+
+	t.Run("Should fail, invalid transaction id", func(t *testing.T) {
+		res, err := client.GetTransactionReceipt(context.Background(),
+			&pactus.GetTransactionReceiptRequest{Id: []byte("invalid_id")})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Nil(t, res)
+	})
+
+	t.Run("Should fail, receipt doesn't exist", func(t *testing.T) {
+		id := td.RandHash()
+		res, err := client.GetTransactionReceipt(context.Background(),
+			&pactus.GetTransactionReceiptRequest{Id: id.Bytes()})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+		assert.Nil(t, res)
+	})
+
+	assert.Nil(t, conn.Close(), "Error closing connection")
+	td.StopServer()
+}
+
+func TestGetTransactionTimeline(t *testing.T) {
+	td := setup(t, nil)
+	conn, client := td.transactionClient(t)
+
+	id := td.RandHash()
+	now := time.Now()
+	txtrace.RecordAt(id, txtrace.StageReceived, now)
+	txtrace.RecordAt(id, txtrace.StagePoolAccepted, now.Add(5*time.Millisecond))
+	txtrace.RecordAt(id, txtrace.StageCommitted, now.Add(10*time.Second))
+
+	t.Run("Should return the timeline", func(t *testing.T) {
+		res, err := client.GetTransactionTimeline(context.Background(),
+			&pactus.GetTransactionTimelineRequest{Id: id.Bytes()})
+		assert.NoError(t, err)
+
+		require.Len(t, res.Events, 3)
+		assert.Equal(t, pactus.TransactionStage_TRANSACTION_STAGE_RECEIVED, res.Events[0].Stage)
+		assert.Equal(t, now.UnixMilli(), res.Events[0].Time)
+		assert.Zero(t, res.Events[0].Elapsed)
+		assert.Equal(t, pactus.TransactionStage_TRANSACTION_STAGE_POOL_ACCEPTED, res.Events[1].Stage)
+		assert.Equal(t, int64(5), res.Events[1].Elapsed)
+		assert.Equal(t, pactus.TransactionStage_TRANSACTION_STAGE_COMMITTED, res.Events[2].Stage)
+		assert.Equal(t, int64(10000), res.Events[2].Elapsed)
+	})
+
+	t.Run("Should fail, invalid transaction id", func(t *testing.T) {
+		res, err := client.GetTransactionTimeline(context.Background(),
+			&pactus.GetTransactionTimelineRequest{Id: []byte("invalid_id")})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Nil(t, res)
+	})
+
+	t.Run("Should fail, transaction is not traced", func(t *testing.T) {
+		res, err := client.GetTransactionTimeline(context.Background(),
+			&pactus.GetTransactionTimelineRequest{Id: td.RandHash().Bytes()})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+		assert.Nil(t, res)
+	})
+
+	assert.Nil(t, conn.Close(), "Error closing connection")
+	td.StopServer()
+}
+
+func TestPaymentURI(t *testing.T) {
+	td := setup(t, nil)
+	conn, client := td.transactionClient(t)
+
+	addr := td.RandAccAddress()
+
+	t.Run("Should encode and decode the payment URI", func(t *testing.T) {
+		expiry := time.Now().Add(time.Hour).Unix()
+		encRes, err := client.EncodePaymentURI(context.Background(),
+			&pactus.EncodePaymentURIRequest{
+				Address: addr.String(),
+				Amount:  2_500_000_000,
+				Memo:    "invoice 42",
+				Expiry:  expiry,
+			})
+		require.NoError(t, err)
+		assert.Equal(t, fmt.Sprintf("pactus:%s?amount=2.5&memo=invoice+42&expiry=%d", addr, expiry), encRes.Uri)
+
+		decRes, err := client.DecodePaymentURI(context.Background(),
+			&pactus.DecodePaymentURIRequest{Uri: encRes.Uri})
+		require.NoError(t, err)
+		assert.Equal(t, addr.String(), decRes.Address)
+		assert.Equal(t, int64(2_500_000_000), decRes.Amount)
+		assert.Equal(t, "invoice 42", decRes.Memo)
+		assert.Equal(t, expiry, decRes.Expiry)
+		assert.False(t, decRes.Expired)
+	})
+
+	t.Run("Should decode the expired payment URI", func(t *testing.T) {
+		res, err := client.DecodePaymentURI(context.Background(),
+			&pactus.DecodePaymentURIRequest{Uri: fmt.Sprintf("pactus:%s?expiry=1", addr)})
+		require.NoError(t, err)
+		assert.Zero(t, res.Amount)
+		assert.True(t, res.Expired)
+	})
+
+	t.Run("Should fail, invalid address", func(t *testing.T) {
+		res, err := client.EncodePaymentURI(context.Background(),
+			&pactus.EncodePaymentURIRequest{Address: "invalid_address"})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Nil(t, res)
+	})
+
+	t.Run("Should fail, memo is too long", func(t *testing.T) {
+		res, err := client.EncodePaymentURI(context.Background(),
+			&pactus.EncodePaymentURIRequest{Address: addr.String(), Memo: td.RandString(65)})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Nil(t, res)
+	})
+
+	t.Run("Should fail, invalid payment URI", func(t *testing.T) {
+		res, err := client.DecodePaymentURI(context.Background(),
+			&pactus.DecodePaymentURIRequest{Uri: fmt.Sprintf("bitcoin:%s", addr)})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Nil(t, res)
+	})
+
+	assert.Nil(t, conn.Close(), "Error closing connection")
+	td.StopServer()
+}
+
 func TestSendRawTransaction(t *testing.T) {
 	td := setup(t, nil)
 	conn, client := td.transactionClient(t)
@@ -96,6 +273,7 @@ func TestSendRawTransaction(t *testing.T) {
 			&pactus.BroadcastTransactionRequest{SignedRawTransaction: data})
 		assert.Error(t, err)
 		assert.Nil(t, res)
+		assert.Equal(t, pactus.ErrorCode_ERROR_INVALID_TRANSACTION, ErrorInfo(err).Code)
 	})
 	trx, _ := td.GenerateTestTransferTx()
 	data, _ := trx.Bytes()
@@ -105,11 +283,200 @@ func TestSendRawTransaction(t *testing.T) {
 		assert.NoError(t, err)
 		assert.NotNil(t, res)
 	})
-	t.Run("Should fail, Not Broadcasted", func(t *testing.T) {
+	t.Run("Should report the duplicate broadcast", func(t *testing.T) {
 		res, err := client.BroadcastTransaction(context.Background(),
 			&pactus.BroadcastTransactionRequest{SignedRawTransaction: data})
+		assert.NoError(t, err)
+		assert.Equal(t, trx.ID().Bytes(), res.Id)
+		assert.True(t, res.AlreadyPending)
+		assert.Len(t, td.mockState.TestPool.Txs, 1)
+	})
+
+	assert.Nil(t, conn.Close(), "Error closing connection")
+	td.StopServer()
+}
+
+func TestBroadcastTransactionIdempotency(t *testing.T) {
+	td := setup(t, nil)
+	conn, client := td.transactionClient(t)
+
+	trx1, _ := td.GenerateTestTransferTx()
+	data1, _ := trx1.Bytes()
+	trx2, _ := td.GenerateTestTransferTx()
+	data2, _ := trx2.Bytes()
+	key := "key-1"
+
+	t.Run("Should pass", func(t *testing.T) {
+		res, err := client.BroadcastTransaction(context.Background(),
+			&pactus.BroadcastTransactionRequest{SignedRawTransaction: data1, IdempotencyKey: key})
+		assert.NoError(t, err)
+		assert.Equal(t, trx1.ID().Bytes(), res.Id)
+	})
+
+	t.Run("Should return the cached result for the retried request", func(t *testing.T) {
+		res, err := client.BroadcastTransaction(context.Background(),
+			&pactus.BroadcastTransactionRequest{SignedRawTransaction: data1, IdempotencyKey: key})
+		assert.NoError(t, err)
+		assert.Equal(t, trx1.ID().Bytes(), res.Id)
+		assert.Len(t, td.mockState.TestPool.Txs, 1)
+	})
+
+	t.Run("Should fail, the key is used for another transaction", func(t *testing.T) {
+		res, err := client.BroadcastTransaction(context.Background(),
+			&pactus.BroadcastTransactionRequest{SignedRawTransaction: data2, IdempotencyKey: key})
+		assert.Equal(t, codes.AlreadyExists, status.Code(err))
+		assert.Nil(t, res)
+	})
+
+	t.Run("Should not cache the failed request", func(t *testing.T) {
+		td.mockState.TestPool.AppendErr = errors.Error(errors.ErrGeneric)
+		res, err := client.BroadcastTransaction(context.Background(),
+			&pactus.BroadcastTransactionRequest{SignedRawTransaction: data2, IdempotencyKey: "key-2"})
 		assert.Error(t, err)
 		assert.Nil(t, res)
+
+		td.mockState.TestPool.AppendErr = nil
+		res, err = client.BroadcastTransaction(context.Background(),
+			&pactus.BroadcastTransactionRequest{SignedRawTransaction: data2, IdempotencyKey: "key-2"})
+		assert.NoError(t, err)
+		assert.Equal(t, trx2.ID().Bytes(), res.Id)
+	})
+
+	assert.Nil(t, conn.Close(), "Error closing connection")
+	td.StopServer()
+}
+
+func TestBroadcastTransactionDiagnostics(t *testing.T) {
+	td := setup(t, nil)
+	conn, client := td.transactionClient(t)
+
+	pub, prv := td.RandBLSKeyPair()
+	makeTx := func(lockTime uint32) []byte {
+		trx := tx.NewTransferTx(lockTime, pub.AccountAddress(), td.RandAccAddress(),
+			td.RandInt64(1e9), td.RandInt64(1e9), "")
+		td.HelperSignTransaction(prv, trx)
+		data, _ := trx.Bytes()
+
+		return data
+	}
+	broadcast := func(data []byte, appendErr error) error {
+		td.mockState.TestPool.AppendErr = appendErr
+		defer func() { td.mockState.TestPool.AppendErr = nil }()
+
+		_, err := client.BroadcastTransaction(context.Background(),
+			&pactus.BroadcastTransactionRequest{SignedRawTransaction: data})
+
+		return err
+	}
+
+	pendingTx, _ := td.GenerateTestTransferTx()
+	td.mockState.TestPool.Txs = append(td.mockState.TestPool.Txs, pendingTx)
+
+	t.Run("Insufficient funds", func(t *testing.T) {
+		ownTx, _ := tx.FromBytes(makeTx(td.RandHeight()))
+		td.mockState.TestPool.Txs = append(td.mockState.TestPool.Txs, ownTx)
+
+		err := broadcast(makeTx(td.RandHeight()), executor.ErrInsufficientFunds)
+		assert.Equal(t, codes.Canceled, status.Code(err))
+		assert.Equal(t, pactus.ErrorCode_ERROR_INSUFFICIENT_FUNDS, ErrorInfo(err).Code)
+
+		diag := BroadcastDiagnostics(err)
+		require.NotNil(t, diag)
+		assert.Equal(t, [][]byte{ownTx.ID().Bytes()}, diag.ConflictingTxIds)
+	})
+
+	t.Run("Invalid fee", func(t *testing.T) {
+		data := makeTx(td.RandHeight())
+		trx, _ := tx.FromBytes(data)
+
+		err := broadcast(data, errors.Errorf(errors.ErrInvalidFee, "fee is wrong"))
+		assert.Equal(t, pactus.ErrorCode_ERROR_INVALID_FEE, ErrorInfo(err).Code)
+
+		diag := BroadcastDiagnostics(err)
+		require.NotNil(t, diag)
+		assert.Equal(t, diag.ExpectedFee-trx.Fee(), diag.FeeDelta)
+	})
+
+	t.Run("Lock time", func(t *testing.T) {
+		lockTime := td.mockState.LastBlockHeight() + 1 + 5
+
+		err := broadcast(makeTx(lockTime), execution.FutureLockTimeError{LockTime: lockTime})
+		assert.Equal(t, pactus.ErrorCode_ERROR_FUTURE_LOCK_TIME, ErrorInfo(err).Code)
+
+		diag := BroadcastDiagnostics(err)
+		require.NotNil(t, diag)
+		assert.Equal(t, int64(5), diag.LockTimeDistance)
+	})
+
+	t.Run("No diagnostics", func(t *testing.T) {
+		err := broadcast(makeTx(td.RandHeight()), errors.Error(errors.ErrGeneric))
+		assert.Error(t, err)
+		assert.Nil(t, BroadcastDiagnostics(err))
+	})
+
+	assert.Nil(t, conn.Close(), "Error closing connection")
+	td.StopServer()
+}
+
+func TestTxPoolSnapshotAndChanges(t *testing.T) {
+	td := setup(t, nil)
+	conn, client := td.transactionClient(t)
+
+	trx1, _ := td.GenerateTestTransferTx()
+	trx2, _ := td.GenerateTestTransferTx()
+	assert.NoError(t, td.mockState.AddPendingTx(trx1))
+
+	snapshot, err := client.GetTxPoolSnapshot(context.Background(),
+		&pactus.GetTxPoolSnapshotRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(1), snapshot.Sequence)
+	assert.Equal(t, [][]byte{trx1.ID().Bytes()[:8]}, snapshot.ShortIds)
+
+	assert.NoError(t, td.mockState.AddPendingTx(trx2))
+
+	t.Run("Should return the changes since the snapshot (verbosity: 0)", func(t *testing.T) {
+		res, err := client.GetTxPoolChanges(context.Background(),
+			&pactus.GetTxPoolChangesRequest{SinceSequence: snapshot.Sequence})
+		assert.NoError(t, err)
+		assert.False(t, res.SnapshotRequired)
+		assert.Equal(t, uint64(2), res.Sequence)
+		assert.Len(t, res.Changes, 1)
+
+		data, _ := trx2.Bytes()
+		change := res.Changes[0]
+		assert.Equal(t, uint64(2), change.Sequence)
+		assert.True(t, change.Added)
+		assert.Equal(t, trx2.ID().Bytes(), change.Id)
+		assert.Equal(t, data, change.Transaction.Data)
+		assert.Nil(t, change.Transaction.Payload)
+	})
+
+	t.Run("Should return the changes since the snapshot (verbosity: 1)", func(t *testing.T) {
+		res, err := client.GetTxPoolChanges(context.Background(),
+			&pactus.GetTxPoolChangesRequest{
+				SinceSequence: snapshot.Sequence,
+				Verbosity:     pactus.TransactionVerbosity_TRANSACTION_INFO,
+			})
+		assert.NoError(t, err)
+		assert.Len(t, res.Changes, 1)
+		assert.Equal(t, trx2.Payload().Value(), res.Changes[0].Transaction.Value)
+	})
+
+	t.Run("Should return no change", func(t *testing.T) {
+		res, err := client.GetTxPoolChanges(context.Background(),
+			&pactus.GetTxPoolChangesRequest{SinceSequence: 2})
+		assert.NoError(t, err)
+		assert.False(t, res.SnapshotRequired)
+		assert.Empty(t, res.Changes)
+	})
+
+	t.Run("Should require a new snapshot", func(t *testing.T) {
+		res, err := client.GetTxPoolChanges(context.Background(),
+			&pactus.GetTxPoolChangesRequest{SinceSequence: 3})
+		assert.NoError(t, err)
+		assert.True(t, res.SnapshotRequired)
+		assert.Equal(t, uint64(2), res.Sequence)
+		assert.Empty(t, res.Changes)
 	})
 
 	assert.Nil(t, conn.Close(), "Error closing connection")
@@ -153,6 +520,27 @@ func TestGetRawTransaction(t *testing.T) {
 		assert.NotEmpty(t, res.RawTransaction)
 	})
 
+	t.Run("Bond with proof of possession", func(t *testing.T) {
+		pub, prv := td.RandBLSKeyPair()
+		pop := prv.ProofOfPossession()
+
+		res, err := client.GetRawBondTransaction(context.Background(),
+			&pactus.GetRawBondTransactionRequest{
+				LockTime:          td.RandHeight(),
+				Sender:            td.RandAccAddress().String(),
+				Receiver:          pub.ValidatorAddress().String(),
+				Stake:             td.RandAmount(),
+				PublicKey:         pub.String(),
+				Fee:               td.RandAmount(),
+				ProofOfPossession: pop.String(),
+			})
+		require.NoError(t, err)
+
+		trx, err := tx.FromBytes(res.RawTransaction)
+		require.NoError(t, err)
+		assert.True(t, pop.EqualsTo(trx.Payload().(*payload.BondPayload).ProofOfPossession))
+	})
+
 	t.Run("UnBond", func(t *testing.T) {
 		trx, _ := td.GenerateTestUnbondTx()
 
@@ -183,6 +571,151 @@ func TestGetRawTransaction(t *testing.T) {
 		assert.NotEmpty(t, res.RawTransaction)
 	})
 
+	t.Run("Relative lock time", func(t *testing.T) {
+		relativeLockTime := td.RandUint32(100)
+		res, err := client.GetRawUnBondTransaction(context.Background(),
+			&pactus.GetRawUnBondTransactionRequest{
+				RelativeLockTime: relativeLockTime,
+				ValidatorAddress: td.RandValAddress().String(),
+			})
+		assert.NoError(t, err)
+
+		trx, err := tx.FromBytes(res.RawTransaction)
+		assert.NoError(t, err)
+		assert.Equal(t, td.mockState.LastBlockHeight()+1+relativeLockTime, trx.LockTime())
+	})
+
+	t.Run("Absolute lock time takes precedence", func(t *testing.T) {
+		res, err := client.GetRawUnBondTransaction(context.Background(),
+			&pactus.GetRawUnBondTransactionRequest{
+				LockTime:         tx.LockTimeThreshold,
+				RelativeLockTime: td.RandUint32(100),
+				ValidatorAddress: td.RandValAddress().String(),
+			})
+		assert.NoError(t, err)
+
+		trx, err := tx.FromBytes(res.RawTransaction)
+		assert.NoError(t, err)
+		assert.Equal(t, tx.LockTimeThreshold, trx.LockTime())
+		assert.True(t, trx.IsTimestampLocked())
+	})
+
 	assert.Nil(t, conn.Close(), "Error closing connection")
 	td.StopServer()
 }
+
+func TestEscrowTransactionToProto(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	trx, _ := ts.GenerateTestEscrowTx()
+	pld := trx.Payload().(*payload.EscrowPayload)
+	info := transactionToProto(trx)
+
+	assert.Equal(t, pactus.PayloadType_ESCROW_PAYLOAD, info.PayloadType)
+	escrow := info.Payload.(*pactus.TransactionInfo_Escrow).Escrow
+	assert.Equal(t, pld.Escrow().String(), escrow.Escrow)
+	assert.Equal(t, pld.Buyer.String(), escrow.Buyer)
+	assert.Equal(t, pld.Seller.String(), escrow.Seller)
+	assert.Equal(t, pld.Arbiter.String(), escrow.Arbiter)
+	assert.Equal(t, pld.Nonce, escrow.Nonce)
+	assert.Equal(t, pld.From.String(), escrow.Sender)
+	assert.Equal(t, pld.To.String(), escrow.Receiver)
+	assert.Equal(t, pld.Arbiter.String(), escrow.Approver)
+	assert.Equal(t, pld.Amount, escrow.Amount)
+}
+
+func TestDataTransactionToProto(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	trx, _ := ts.GenerateTestDataTx()
+	pld := trx.Payload().(*payload.DataPayload)
+	info := transactionToProto(trx)
+
+	assert.Equal(t, pactus.PayloadType_DATA_PAYLOAD, info.PayloadType)
+	data := info.Payload.(*pactus.TransactionInfo_DataPayload).DataPayload
+	assert.Equal(t, pld.From.String(), data.Sender)
+	assert.Equal(t, pld.Data, data.Data)
+}
+
+func TestAllowlistTransactionToProto(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	trx := tx.NewAllowlistTx(ts.RandHeight(), ts.RandAccAddress(), ts.RandValAddress(), true, 1000, "")
+	pld := trx.Payload().(*payload.AllowlistPayload)
+	info := transactionToProto(trx)
+
+	assert.Equal(t, pactus.PayloadType_ALLOWLIST_PAYLOAD, info.PayloadType)
+	allowlist := info.Payload.(*pactus.TransactionInfo_Allowlist).Allowlist
+	assert.Equal(t, pld.Governor.String(), allowlist.Governor)
+	assert.Equal(t, pld.Validator.String(), allowlist.Validator)
+	assert.True(t, allowlist.Allow)
+}
+
+func TestConditionTransactionToProto(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	cosigner := ts.RandAccAddress()
+	cond := &account.Condition{
+		UnlockHeight: ts.RandHeight(),
+		MaxAmount:    ts.RandInt64(1e9),
+		Threshold:    1,
+		Cosigners:    []crypto.Address{cosigner},
+	}
+	trx := tx.NewConditionTx(ts.RandHeight(), ts.RandAccAddress(), ts.RandAccAddress(),
+		ts.RandInt64(1e9), cond, 1000, "")
+	pld := trx.Payload().(*payload.ConditionPayload)
+	approverPub, approverPrv := ts.RandBLSKeyPair()
+	pld.Approvals = append(pld.Approvals, payload.Approval{
+		PublicKey: approverPub,
+		Signature: approverPrv.SignNative(pld.ApprovalSignBytes(trx.LockTime())),
+	})
+	info := transactionToProto(trx)
+
+	assert.Equal(t, pactus.PayloadType_CONDITION_PAYLOAD, info.PayloadType)
+	condition := info.Payload.(*pactus.TransactionInfo_Condition).Condition
+	assert.Equal(t, pld.From.String(), condition.Sender)
+	assert.Equal(t, pld.To.String(), condition.Receiver)
+	assert.Equal(t, pld.Amount, condition.Amount)
+	assert.Equal(t, []string{approverPub.AccountAddress().String()}, condition.Approvers)
+	assert.Equal(t, cond.UnlockHeight, condition.Condition.UnlockHeight)
+	assert.Equal(t, cond.MaxAmount, condition.Condition.MaxAmount)
+	assert.Equal(t, uint32(1), condition.Condition.Threshold)
+	assert.Equal(t, []string{cosigner.String()}, condition.Condition.Cosigners)
+}
+
+func TestDelegateTransactionToProto(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	valPub, valPrv := ts.RandBLSKeyPair()
+	trx := tx.NewDelegateTx(ts.RandHeight(), ts.RandAccAddress(), valPub.ValidatorAddress(),
+		valPub, valPrv.ProofOfPossession(), ts.RandInt64(1e9), nil, 1000, "")
+	pld := trx.Payload().(*payload.DelegatePayload)
+	pld.OperatorSignature = valPrv.SignNative(pld.ApprovalSignBytes(trx.LockTime()))
+	info := transactionToProto(trx)
+
+	assert.Equal(t, pactus.PayloadType_DELEGATE_PAYLOAD, info.PayloadType)
+	delegate := info.Payload.(*pactus.TransactionInfo_Delegate).Delegate
+	assert.Equal(t, pld.From.String(), delegate.Delegator)
+	assert.Equal(t, pld.To.String(), delegate.Validator)
+	assert.Equal(t, pld.Stake, delegate.Stake)
+	assert.Equal(t, valPub.String(), delegate.PublicKey)
+}
+
+func TestRewardSplitTransactionToProto(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	shareAddr := ts.RandAccAddress()
+	split := &validator.RewardSplit{
+		Shares: []validator.RewardShare{{Address: shareAddr, Percent: 25}},
+	}
+	trx := tx.NewRewardSplitTx(ts.RandHeight(), ts.RandValAddress(), split, ts.RandAmount(), "")
+	pld := trx.Payload().(*payload.RewardSplitPayload)
+	info := transactionToProto(trx)
+
+	assert.Equal(t, pactus.PayloadType_REWARD_SPLIT_PAYLOAD, info.PayloadType)
+	rewardSplit := info.Payload.(*pactus.TransactionInfo_RewardSplit).RewardSplit
+	assert.Equal(t, pld.Validator.String(), rewardSplit.Validator)
+	require.Len(t, rewardSplit.Shares, 1)
+	assert.Equal(t, shareAddr.String(), rewardSplit.Shares[0].Address)
+	assert.Equal(t, uint32(25), rewardSplit.Shares[0].Percent)
+}
@@ -5,13 +5,16 @@ import (
 	"fmt"
 	"path/filepath"
 
+	"github.com/pactus-project/pactus/crypto"
 	"github.com/pactus-project/pactus/crypto/bls"
 	"github.com/pactus-project/pactus/genesis"
 	"github.com/pactus-project/pactus/types/tx"
 	"github.com/pactus-project/pactus/util"
+	"github.com/pactus-project/pactus/util/audit"
 	"github.com/pactus-project/pactus/wallet"
 	pactus "github.com/pactus-project/pactus/www/grpc/gen/go"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
 	"google.golang.org/grpc/status"
 )
 
@@ -24,16 +27,22 @@ import (
 type walletServer struct {
 	*Server
 	wallets   map[string]*wallet.Wallet
+	drafts    *draftStore
+	tokens    *signingTokenStore
 	chainType genesis.ChainType
+	hrp       crypto.HRP
 }
 
-func newWalletServer(server *Server, chainType genesis.ChainType) *walletServer {
+func newWalletServer(server *Server, chainType genesis.ChainType, hrp crypto.HRP) *walletServer {
 	wallets := make(map[string]*wallet.Wallet)
 
 	return &walletServer{
 		Server:    server,
 		wallets:   wallets,
+		drafts:    newDraftStore(),
+		tokens:    newSigningTokenStore(),
 		chainType: chainType,
+		hrp:       hrp,
 	}
 }
 
@@ -61,8 +70,18 @@ func (s *walletServer) CreateWallet(_ context.Context,
 		return nil, fmt.Errorf("wallet name is required")
 	}
 
+	// The language is detected from the mnemonic. If it is set, it should match the detected one.
+	language, err := wallet.MnemonicLanguage(req.Mnemonic)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid mnemonic: %v", err)
+	}
+	if req.Language != "" && req.Language != language {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"mnemonic language is %s, not %s", language, req.Language)
+	}
+
 	walletPath := s.walletPath(req.WalletName)
-	w, err := wallet.Create(walletPath, req.Mnemonic, req.Language, s.chainType)
+	w, err := wallet.CreateWithHRP(walletPath, req.Mnemonic, "", s.chainType, s.hrp)
 	if err != nil {
 		return nil, err
 	}
@@ -111,6 +130,7 @@ func (s *walletServer) UnloadWallet(_ context.Context,
 	}
 
 	delete(s.wallets, req.WalletName)
+	s.tokens.removeWallet(req.WalletName)
 
 	return &pactus.UnloadWalletResponse{
 		WalletName: req.WalletName,
@@ -129,7 +149,7 @@ func (s *walletServer) UnlockWallet(_ context.Context,
 	return nil, status.Error(codes.Unimplemented, "not implemeneted")
 }
 
-func (s *walletServer) SignRawTransaction(_ context.Context,
+func (s *walletServer) SignRawTransaction(ctx context.Context,
 	req *pactus.SignRawTransactionRequest,
 ) (*pactus.SignRawTransactionResponse, error) {
 	wlt, ok := s.wallets[req.WalletName]
@@ -142,11 +162,39 @@ func (s *walletServer) SignRawTransaction(_ context.Context,
 		return nil, err
 	}
 
-	err = wlt.SignTransaction(req.Password, trx)
+	password := req.Password
+	signedBy := requester(ctx, req.WalletName)
+	if req.SigningToken != "" {
+		if req.Password != "" {
+			return nil, status.Error(codes.InvalidArgument, "password and signing token can't be set together")
+		}
+
+		tkn, err := s.tokens.reserve(req.WalletName, req.SigningToken, trx)
+		if err != nil {
+			return nil, err
+		}
+		password = tkn.password
+		signedBy = fmt.Sprintf("%s/token:%s", signedBy, tkn.id)
+	}
+
+	err = wlt.SignTransaction(password, trx)
 	if err != nil {
+		if req.SigningToken != "" {
+			s.tokens.release(req.SigningToken, trx)
+		}
+
 		return nil, err
 	}
 
+	audit.Record(audit.Entry{
+		Kind:      audit.KindTransaction,
+		Subject:   trx.ID().String(),
+		Signer:    trx.Payload().Signer().String(),
+		Digest:    audit.Digest(trx.SignBytes()),
+		Height:    trx.LockTime(),
+		Requester: signedBy,
+	})
+
 	data, err := trx.Bytes()
 	if err != nil {
 		return nil, err
@@ -157,3 +205,141 @@ func (s *walletServer) SignRawTransaction(_ context.Context,
 		SignedRawTransaction: data,
 	}, nil
 }
+
+func (s *walletServer) CreateDraftTransaction(_ context.Context,
+	req *pactus.CreateDraftTransactionRequest,
+) (*pactus.CreateDraftTransactionResponse, error) {
+	if _, ok := s.wallets[req.WalletName]; !ok {
+		return nil, status.Errorf(codes.NotFound, "wallet is not loaded")
+	}
+
+	d, err := s.drafts.create(req.WalletName, req.RawTransaction, req.Note, req.Ttl)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pactus.CreateDraftTransactionResponse{
+		Draft: draftToProto(d),
+	}, nil
+}
+
+func (s *walletServer) ListDraftTransactions(_ context.Context,
+	req *pactus.ListDraftTransactionsRequest,
+) (*pactus.ListDraftTransactionsResponse, error) {
+	if _, ok := s.wallets[req.WalletName]; !ok {
+		return nil, status.Errorf(codes.NotFound, "wallet is not loaded")
+	}
+
+	drafts := s.drafts.list(req.WalletName)
+	res := &pactus.ListDraftTransactionsResponse{
+		Drafts: make([]*pactus.DraftTransaction, 0, len(drafts)),
+	}
+	for _, d := range drafts {
+		res.Drafts = append(res.Drafts, draftToProto(d))
+	}
+
+	return res, nil
+}
+
+func (s *walletServer) UpdateDraftTransaction(_ context.Context,
+	req *pactus.UpdateDraftTransactionRequest,
+) (*pactus.UpdateDraftTransactionResponse, error) {
+	if _, ok := s.wallets[req.WalletName]; !ok {
+		return nil, status.Errorf(codes.NotFound, "wallet is not loaded")
+	}
+
+	d, err := s.drafts.update(req.WalletName, req.DraftId, req.RawTransaction, req.Note, req.Ttl)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pactus.UpdateDraftTransactionResponse{
+		Draft: draftToProto(d),
+	}, nil
+}
+
+func (s *walletServer) DeleteDraftTransaction(_ context.Context,
+	req *pactus.DeleteDraftTransactionRequest,
+) (*pactus.DeleteDraftTransactionResponse, error) {
+	if _, ok := s.wallets[req.WalletName]; !ok {
+		return nil, status.Errorf(codes.NotFound, "wallet is not loaded")
+	}
+
+	if err := s.drafts.remove(req.WalletName, req.DraftId); err != nil {
+		return nil, err
+	}
+
+	return &pactus.DeleteDraftTransactionResponse{
+		DraftId: req.DraftId,
+	}, nil
+}
+
+func (s *walletServer) IssueSigningToken(_ context.Context,
+	req *pactus.IssueSigningTokenRequest,
+) (*pactus.IssueSigningTokenResponse, error) {
+	wlt, ok := s.wallets[req.WalletName]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "wallet is not loaded")
+	}
+
+	// The password is verified now, so the bots don't find out later that the token is useless.
+	if wlt.IsEncrypted() {
+		if _, err := wlt.Mnemonic(req.Password); err != nil {
+			return nil, status.Errorf(codes.PermissionDenied, "invalid password: %v", err)
+		}
+	}
+
+	secret, tkn, err := s.tokens.issue(req.WalletName, req.Password, req.Scope, req.Ttl)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pactus.IssueSigningTokenResponse{
+		Token:        secret,
+		SigningToken: signingTokenToProto(&tkn),
+	}, nil
+}
+
+func (s *walletServer) ListSigningTokens(_ context.Context,
+	req *pactus.ListSigningTokensRequest,
+) (*pactus.ListSigningTokensResponse, error) {
+	if _, ok := s.wallets[req.WalletName]; !ok {
+		return nil, status.Errorf(codes.NotFound, "wallet is not loaded")
+	}
+
+	tokens := s.tokens.list(req.WalletName)
+	res := &pactus.ListSigningTokensResponse{
+		Tokens: make([]*pactus.SigningToken, 0, len(tokens)),
+	}
+	for i := range tokens {
+		res.Tokens = append(res.Tokens, signingTokenToProto(&tokens[i]))
+	}
+
+	return res, nil
+}
+
+func (s *walletServer) RevokeSigningToken(_ context.Context,
+	req *pactus.RevokeSigningTokenRequest,
+) (*pactus.RevokeSigningTokenResponse, error) {
+	if _, ok := s.wallets[req.WalletName]; !ok {
+		return nil, status.Errorf(codes.NotFound, "wallet is not loaded")
+	}
+
+	if err := s.tokens.revoke(req.WalletName, req.TokenId); err != nil {
+		return nil, err
+	}
+
+	return &pactus.RevokeSigningTokenResponse{
+		TokenId: req.TokenId,
+	}, nil
+}
+
+// requester describes who has requested the signature, for the audit log.
+func requester(ctx context.Context, walletName string) string {
+	addr := "unknown"
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		addr = p.Addr.String()
+	}
+
+	return fmt.Sprintf("wallet:%s@%s", walletName, addr)
+}
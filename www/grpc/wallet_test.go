@@ -86,6 +86,31 @@ func TestCreateWallet(t *testing.T) {
 		assert.NotNil(t, res)
 	})
 
+	t.Run("Mismatched language, should return an error", func(t *testing.T) {
+		mnemonic, _ := wallet.GenerateMnemonicInLanguage(128, "japanese")
+		res, err := client.CreateWallet(context.Background(),
+			&pactus.CreateWalletRequest{
+				WalletName: "mismatched",
+				Mnemonic:   mnemonic,
+				Language:   "korean",
+			})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Nil(t, res)
+	})
+
+	t.Run("Should create wallet in another language", func(t *testing.T) {
+		mnemonic, _ := wallet.GenerateMnemonicInLanguage(128, "japanese")
+		res, err := client.CreateWallet(context.Background(),
+			&pactus.CreateWalletRequest{
+				WalletName: "japanese",
+				Mnemonic:   mnemonic,
+				Language:   "japanese",
+				Password:   "password",
+			})
+		assert.NoError(t, err)
+		assert.NotNil(t, res)
+	})
+
 	assert.Nil(t, conn.Close(), "Error closing connection")
 	td.StopServer()
 }
@@ -1,6 +1,7 @@
 package http
 
 import (
+	"context"
 	"net/http"
 	"strconv"
 	"time"
@@ -11,8 +12,8 @@ import (
 	pactus "github.com/pactus-project/pactus/www/grpc/gen/go"
 )
 
-func (s *Server) BlockchainHandler(w http.ResponseWriter, _ *http.Request) {
-	res, err := s.blockchain.GetBlockchainInfo(s.ctx,
+func (s *Server) BlockchainHandler(w http.ResponseWriter, r *http.Request) {
+	res, err := s.blockchain.GetBlockchainInfo(r.Context(),
 		&pactus.GetBlockchainInfoRequest{})
 	if err != nil {
 		s.writeError(w, err)
@@ -43,7 +44,7 @@ func (s *Server) GetBlockByHeightHandler(w http.ResponseWriter, r *http.Request)
 
 		return
 	}
-	s.blockByHeight(w, uint32(height))
+	s.blockByHeight(r.Context(), w, uint32(height))
 }
 
 func (s *Server) GetBlockByHashHandler(w http.ResponseWriter, r *http.Request) {
@@ -55,7 +56,7 @@ func (s *Server) GetBlockByHashHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	res, err := s.blockchain.GetBlockHeight(s.ctx,
+	res, err := s.blockchain.GetBlockHeight(r.Context(),
 		&pactus.GetBlockHeightRequest{Hash: blockHash.Bytes()})
 	if err != nil {
 		s.writeError(w, err)
@@ -63,11 +64,11 @@ func (s *Server) GetBlockByHashHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	s.blockByHeight(w, res.Height)
+	s.blockByHeight(r.Context(), w, res.Height)
 }
 
-func (s *Server) blockByHeight(w http.ResponseWriter, blockHeight uint32) {
-	res, err := s.blockchain.GetBlock(s.ctx,
+func (s *Server) blockByHeight(ctx context.Context, w http.ResponseWriter, blockHeight uint32) {
+	res, err := s.blockchain.GetBlock(ctx,
 		&pactus.GetBlockRequest{
 			Height:    blockHeight,
 			Verbosity: pactus.BlockVerbosity_BLOCK_TRANSACTIONS,
@@ -113,7 +114,7 @@ func (s *Server) blockByHeight(w http.ResponseWriter, blockHeight uint32) {
 // GetAccountHandler returns a handler to get account by address.
 func (s *Server) GetAccountHandler(w http.ResponseWriter, r *http.Request) {
 	vars := mux.Vars(r)
-	res, err := s.blockchain.GetAccount(s.ctx,
+	res, err := s.blockchain.GetAccount(r.Context(),
 		&pactus.GetAccountRequest{Address: vars["address"]})
 	if err != nil {
 		s.writeError(w, err)
@@ -134,7 +135,7 @@ func (s *Server) GetAccountHandler(w http.ResponseWriter, r *http.Request) {
 // GetValidatorHandler returns a handler to get validator by address.
 func (s *Server) GetValidatorHandler(w http.ResponseWriter, r *http.Request) {
 	vars := mux.Vars(r)
-	res, err := s.blockchain.GetValidator(s.ctx,
+	res, err := s.blockchain.GetValidator(r.Context(),
 		&pactus.GetValidatorRequest{Address: vars["address"]})
 	if err != nil {
 		s.writeError(w, err)
@@ -157,7 +158,7 @@ func (s *Server) GetValidatorByNumberHandler(w http.ResponseWriter, r *http.Requ
 		return
 	}
 
-	res, err := s.blockchain.GetValidatorByNumber(s.ctx, &pactus.GetValidatorByNumberRequest{
+	res, err := s.blockchain.GetValidatorByNumber(r.Context(), &pactus.GetValidatorByNumberRequest{
 		Number: int32(num),
 	})
 	if err != nil {
@@ -180,13 +181,20 @@ func (s *Server) writeValidatorTable(val *pactus.ValidatorInfo) *tableMaker {
 	tm.addRowInt("LastSortitionHeight", int(val.LastSortitionHeight))
 	tm.addRowInt("UnbondingHeight", int(val.UnbondingHeight))
 	tm.addRowDouble("AvailabilityScore", val.AvailabilityScore)
+	if val.Delegator != "" {
+		tm.addRowAccAddress("Delegator", val.Delegator)
+	}
+	for _, share := range val.RewardSplit {
+		tm.addRowAccAddress("RewardShare", share.Address)
+		tm.addRowInt("RewardPercent", int(share.Percent))
+	}
 	tm.addRowBytes("Hash", val.Hash)
 
 	return tm
 }
 
-func (s *Server) ConsensusHandler(w http.ResponseWriter, _ *http.Request) {
-	res, err := s.blockchain.GetConsensusInfo(s.ctx,
+func (s *Server) ConsensusHandler(w http.ResponseWriter, r *http.Request) {
+	res, err := s.blockchain.GetConsensusInfo(r.Context(),
 		&pactus.GetConsensusInfoRequest{})
 	if err != nil {
 		s.writeError(w, err)
@@ -9,7 +9,10 @@ import (
 
 	"github.com/gorilla/mux"
 	"github.com/pactus-project/pactus/util"
+	pactus "github.com/pactus-project/pactus/www/grpc/gen/go"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protojson"
 )
 
 func TestBlockchainInfo(t *testing.T) {
@@ -68,6 +71,12 @@ func TestBlock(t *testing.T) {
 		td.httpServer.GetBlockByHashHandler(w, r)
 
 		assert.Equal(t, w.Code, 400)
+		assert.Equal(t, "application/json", w.Header().Get("Content-Type"))
+
+		info := new(pactus.ErrorInfo)
+		require.NoError(t, protojson.Unmarshal(w.Body.Bytes(), info))
+		assert.Equal(t, pactus.ErrorCode_ERROR_GENERIC, info.Code)
+		assert.Contains(t, info.Message, "block not found")
 	})
 
 	t.Run("Shall return an error, invalid hash", func(t *testing.T) {
@@ -3,12 +3,16 @@ package http
 type Config struct {
 	Enable bool   `toml:"enable"`
 	Listen string `toml:"listen"`
+
+	// EnableMetrics exposes the Prometheus metrics of the node on the HTTP server.
+	EnableMetrics bool `toml:"enable_metrics"`
 }
 
 func DefaultConfig() *Config {
 	return &Config{
-		Enable: false,
-		Listen: "",
+		Enable:        false,
+		Listen:        "",
+		EnableMetrics: true,
 	}
 }
 
@@ -0,0 +1,146 @@
+package http
+
+import (
+	"encoding/hex"
+	"net/http"
+	"sort"
+
+	"github.com/pactus-project/pactus/types/vote"
+	pactus "github.com/pactus-project/pactus/www/grpc/gen/go"
+)
+
+// voteSetsInfo is the structured dump of the consensus vote sets, suitable for visualization.
+type voteSetsInfo struct {
+	CommitteePower int64                   `json:"committee_power"`
+	Instances      []consensusVoteSetsInfo `json:"instances"`
+}
+
+type consensusVoteSetsInfo struct {
+	Address  string        `json:"address"`
+	Active   bool          `json:"active"`
+	Height   uint32        `json:"height"`
+	Round    int32         `json:"round"`
+	VoteSets []voteSetInfo `json:"vote_sets"`
+}
+
+// voteSetInfo contains the votes of one step of the consensus.
+// The change-proposer votes are grouped by their CP round as well.
+// HasQuorum shows whether more than 2/3 of the committee power has voted in this step.
+type voteSetInfo struct {
+	Round      int32          `json:"round"`
+	Step       string         `json:"step"`
+	CPRound    int32          `json:"cp_round"`
+	VotedPower int64          `json:"voted_power"`
+	HasQuorum  bool           `json:"has_quorum"`
+	Votes      []voteItemInfo `json:"votes"`
+}
+
+type voteItemInfo struct {
+	Voter     string `json:"voter"`
+	Power     int64  `json:"power"`
+	BlockHash string `json:"block_hash,omitempty"`
+	CPValue   int32  `json:"cp_value,omitempty"`
+}
+
+// ConsensusVotesHandler dumps the vote sets of the consensus instances in JSON.
+// It shows who voted what, at which round and step, to debug the liveness issues.
+func (s *Server) ConsensusVotesHandler(w http.ResponseWriter, r *http.Request) {
+	consRes, err := s.blockchain.GetConsensusInfo(r.Context(),
+		&pactus.GetConsensusInfoRequest{})
+	if err != nil {
+		s.writeError(w, err)
+
+		return
+	}
+
+	chainRes, err := s.blockchain.GetBlockchainInfo(r.Context(),
+		&pactus.GetBlockchainInfoRequest{})
+	if err != nil {
+		s.writeError(w, err)
+
+		return
+	}
+
+	s.writeJSON(w, makeVoteSetsInfo(consRes, chainRes))
+}
+
+func makeVoteSetsInfo(consRes *pactus.GetConsensusInfoResponse,
+	chainRes *pactus.GetBlockchainInfoResponse,
+) *voteSetsInfo {
+	powers := make(map[string]int64, len(chainRes.CommitteeValidators))
+	for _, val := range chainRes.CommitteeValidators {
+		power := val.Stake
+		if power == 0 {
+			// Only bootstrap validators at the genesis block have no stake.
+			power = 1
+		}
+		powers[val.Address] = power
+	}
+
+	info := &voteSetsInfo{
+		CommitteePower: chainRes.CommitteePower,
+		Instances:      make([]consensusVoteSetsInfo, 0, len(consRes.Instances)),
+	}
+	for _, cons := range consRes.Instances {
+		type voteSetKey struct {
+			round   int32
+			typ     pactus.VoteType
+			cpRound int32
+		}
+		sets := make(map[voteSetKey]*voteSetInfo)
+		keys := make([]voteSetKey, 0)
+		for _, v := range cons.Votes {
+			key := voteSetKey{round: v.Round, typ: v.Type, cpRound: v.CpRound}
+			set, ok := sets[key]
+			if !ok {
+				set = &voteSetInfo{
+					Round:   v.Round,
+					Step:    vote.Type(v.Type).String(),
+					CPRound: v.CpRound,
+					Votes:   make([]voteItemInfo, 0),
+				}
+				sets[key] = set
+				keys = append(keys, key)
+			}
+
+			item := voteItemInfo{
+				Voter:   v.Voter,
+				Power:   powers[v.Voter],
+				CPValue: v.CpValue,
+			}
+			if len(v.BlockHash) > 0 {
+				item.BlockHash = hex.EncodeToString(v.BlockHash)
+			}
+			set.Votes = append(set.Votes, item)
+			set.VotedPower += item.Power
+		}
+
+		sort.Slice(keys, func(i, j int) bool {
+			if keys[i].round != keys[j].round {
+				return keys[i].round < keys[j].round
+			}
+			if keys[i].typ != keys[j].typ {
+				return keys[i].typ < keys[j].typ
+			}
+
+			return keys[i].cpRound < keys[j].cpRound
+		})
+
+		voteSets := make([]voteSetInfo, 0, len(keys))
+		for _, key := range keys {
+			set := sets[key]
+			set.HasQuorum = set.VotedPower > (info.CommitteePower * 2 / 3)
+			voteSets = append(voteSets, *set)
+		}
+
+		info.Instances = append(info.Instances, consensusVoteSetsInfo{
+			Address:  cons.Address,
+			Active:   cons.Active,
+			Height:   cons.Height,
+			Round:    cons.Round,
+			VoteSets: voteSets,
+		})
+	}
+
+	return info
+}
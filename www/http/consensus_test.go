@@ -0,0 +1,49 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConsensusVotes(t *testing.T) {
+	td := setup(t)
+
+	h, _ := td.mockConsMgr.HeightRound()
+	v1, _ := td.GenerateTestPrepareVote(h, 1)
+	v2, _ := td.GenerateTestPrepareVote(h, 1)
+	v3, _ := td.GenerateTestPrecommitVote(h, 2)
+	td.mockConsMgr.AddVote(v1)
+	td.mockConsMgr.AddVote(v2)
+	td.mockConsMgr.AddVote(v3)
+
+	w := httptest.NewRecorder()
+	r := new(http.Request)
+
+	td.httpServer.ConsensusVotesHandler(w, r)
+
+	assert.Equal(t, 200, w.Code)
+	assert.Equal(t, "application/json", w.Header().Get("Content-Type"))
+
+	info := new(voteSetsInfo)
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), info))
+	require.NotEmpty(t, info.Instances)
+
+	voteSets := info.Instances[0].VoteSets
+	require.Len(t, voteSets, 2)
+
+	assert.Equal(t, int32(1), voteSets[0].Round)
+	assert.Equal(t, "PREPARE", voteSets[0].Step)
+	assert.Len(t, voteSets[0].Votes, 2)
+
+	assert.Equal(t, int32(2), voteSets[1].Round)
+	assert.Equal(t, "PRECOMMIT", voteSets[1].Step)
+	assert.Equal(t, v3.Signer().String(), voteSets[1].Votes[0].Voter)
+	assert.Equal(t, v3.BlockHash().String(), voteSets[1].Votes[0].BlockHash)
+
+	td.StopServers()
+}
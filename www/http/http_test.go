@@ -59,11 +59,11 @@ func setup(t *testing.T) *testData {
 		Listen: "[::]:0",
 	}
 
-	gRPCServer := grpc.NewServer(grpcConf, mockState, mockSync, mockNet, mockConsMgr)
+	gRPCServer := grpc.NewServer(grpcConf, mockState, mockSync, mockNet, mockConsMgr, nil, nil)
 	assert.NoError(t, gRPCServer.StartServer())
 
 	httpServer := NewServer(httpConf)
-	assert.NoError(t, httpServer.StartServer(gRPCServer.Address()))
+	assert.NoError(t, httpServer.StartServer(gRPCServer.Address(), gRPCServer.ClientCredentials()))
 
 	return &testData{
 		TestSuite:   ts,
@@ -86,3 +86,24 @@ func TestRootHandler(t *testing.T) {
 
 	td.StopServers()
 }
+
+func TestValidatorExportHandler(t *testing.T) {
+	td := setup(t)
+
+	var export []byte
+	td.httpServer.SetValidatorExport(func() []byte { return export })
+
+	w := httptest.NewRecorder()
+	r := new(http.Request)
+	td.httpServer.ValidatorExportHandler(w, r)
+	assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+
+	export = []byte(`{"height":1}`)
+	w = httptest.NewRecorder()
+	td.httpServer.ValidatorExportHandler(w, r)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "application/json", w.Header().Get("Content-Type"))
+	assert.Equal(t, `{"height":1}`, w.Body.String())
+
+	td.StopServers()
+}
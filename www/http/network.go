@@ -9,12 +9,13 @@ import (
 	"github.com/pactus-project/pactus/crypto/bls"
 	"github.com/pactus-project/pactus/sync/bundle/message"
 	"github.com/pactus-project/pactus/sync/peerset"
+	"github.com/pactus-project/pactus/sync/peerset/capability"
 	"github.com/pactus-project/pactus/sync/peerset/service"
 	pactus "github.com/pactus-project/pactus/www/grpc/gen/go"
 )
 
-func (s *Server) NetworkHandler(w http.ResponseWriter, _ *http.Request) {
-	res, err := s.network.GetNetworkInfo(s.ctx,
+func (s *Server) NetworkHandler(w http.ResponseWriter, r *http.Request) {
+	res, err := s.network.GetNetworkInfo(r.Context(),
 		&pactus.GetNetworkInfoRequest{})
 	if err != nil {
 		s.writeError(w, err)
@@ -35,13 +36,19 @@ func (s *Server) NetworkHandler(w http.ResponseWriter, _ *http.Request) {
 		tm.addRowString("Status", peerset.StatusCode(p.Status).String())
 		tm.addRowString("PeerID", pid.String())
 		tm.addRowString("Services", service.Services(p.Services).String())
+		tm.addRowInt("Protocol Version", int(p.ProtocolVersion))
+		tm.addRowString("Capabilities", capability.Capabilities(p.Capabilities).String())
+		tm.addRowString("Negotiated Capabilities", capability.Capabilities(p.NegotiatedCapabilities).String())
 		tm.addRowString("Agent", p.Agent)
 		tm.addRowString("Moniker", p.Moniker)
+		tm.addRowBytes("Attestation", p.Attestation)
 		tm.addRowString("Remote Address", p.Address)
 		tm.addRowString("Direction", p.Direction)
 		tm.addRowStrings("Protocols", p.Protocols)
 		tm.addRowString("LastSent", time.Unix(p.LastSent, 0).String())
 		tm.addRowString("LastReceived", time.Unix(p.LastReceived, 0).String())
+		tm.addRowString("RoundTripTime", (time.Duration(p.RoundTripTime) * time.Millisecond).String())
+		tm.addRowString("ClockOffset", (time.Duration(p.ClockOffset) * time.Millisecond).String())
 		tm.addRowBlockHash("Last block Hash", p.LastBlockHash)
 		tm.addRowInt("Height", int(p.Height))
 		tm.addRowInt("TotalSessions", int(p.TotalSessions))
@@ -65,8 +72,8 @@ func (s *Server) NetworkHandler(w http.ResponseWriter, _ *http.Request) {
 	s.writeHTML(w, tm.html())
 }
 
-func (s *Server) NodeHandler(w http.ResponseWriter, _ *http.Request) {
-	res, err := s.network.GetNodeInfo(s.ctx,
+func (s *Server) NodeHandler(w http.ResponseWriter, r *http.Request) {
+	res, err := s.network.GetNodeInfo(r.Context(),
 		&pactus.GetNodeInfoRequest{})
 	if err != nil {
 		s.writeError(w, err)
@@ -84,6 +91,18 @@ func (s *Server) NodeHandler(w http.ResponseWriter, _ *http.Request) {
 	tm.addRowStrings("Addrs", res.Addrs)
 	tm.addRowInts("Services", res.Services)
 	tm.addRowStrings("Services Names", res.ServicesNames)
+	tm.addRowInt("Protocol Version", int(res.ProtocolVersion))
+	tm.addRowStrings("Capabilities", res.CapabilitiesNames)
+	tm.addRowBytes("Attestation", res.Attestation)
+	tm.addRowBool("Replica", res.Replica)
+	if res.Replica {
+		primaries := make([]string, 0, len(res.Primaries))
+		for _, data := range res.Primaries {
+			pid, _ := peer.IDFromBytes(data)
+			primaries = append(primaries, pid.String())
+		}
+		tm.addRowStrings("Primaries", primaries)
+	}
 
 	tm.addRowString("Protocols", "---")
 	for i, p := range res.Protocols {
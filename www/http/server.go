@@ -3,6 +3,7 @@ package http
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
 	"io"
 	"net"
@@ -14,10 +15,12 @@ import (
 	"github.com/gorilla/mux"
 	"github.com/pactus-project/pactus/util"
 	"github.com/pactus-project/pactus/util/logger"
+	grpcserver "github.com/pactus-project/pactus/www/grpc"
 	pactus "github.com/pactus-project/pactus/www/grpc/gen/go"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/protobuf/encoding/protojson"
 )
 
 type Server struct {
@@ -31,6 +34,7 @@ type Server struct {
 	transaction pactus.TransactionClient
 	network     pactus.NetworkClient
 	listener    net.Listener
+	valExport   func() []byte
 	logger      *logger.SubLogger
 }
 
@@ -45,7 +49,7 @@ func NewServer(conf *Config) *Server {
 	}
 }
 
-func (s *Server) StartServer(grpcServer string) error {
+func (s *Server) StartServer(grpcServer string, creds credentials.TransportCredentials) error {
 	if !s.config.Enable {
 		return nil
 	}
@@ -54,7 +58,7 @@ func (s *Server) StartServer(grpcServer string) error {
 		s.ctx,
 		grpcServer,
 		grpc.WithBlock(),
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithTransportCredentials(creds),
 	)
 	if err != nil {
 		return fmt.Errorf("failed to dial server: %w", err)
@@ -69,6 +73,7 @@ func (s *Server) StartServer(grpcServer string) error {
 	s.router.HandleFunc("/", s.RootHandler)
 	s.router.HandleFunc("/blockchain/", s.BlockchainHandler)
 	s.router.HandleFunc("/consensus", s.ConsensusHandler)
+	s.router.HandleFunc("/consensus/votes", s.ConsensusVotesHandler)
 	s.router.HandleFunc("/network", s.NetworkHandler)
 	s.router.HandleFunc("/node", s.NodeHandler)
 	s.router.HandleFunc("/block/hash/{hash}", s.GetBlockByHashHandler)
@@ -77,7 +82,12 @@ func (s *Server) StartServer(grpcServer string) error {
 	s.router.HandleFunc("/account/address/{address}", s.GetAccountHandler)
 	s.router.HandleFunc("/validator/address/{address}", s.GetValidatorHandler)
 	s.router.HandleFunc("/validator/number/{number}", s.GetValidatorByNumberHandler)
-	s.router.HandleFunc("/metrics/prometheus", promhttp.Handler().ServeHTTP)
+	if s.config.EnableMetrics {
+		s.router.HandleFunc("/metrics/prometheus", promhttp.Handler().ServeHTTP)
+	}
+	if s.valExport != nil {
+		s.router.HandleFunc("/validators/export", s.ValidatorExportHandler)
+	}
 	http.Handle("/", handlers.RecoveryHandler()(s.router))
 
 	listener, err := net.Listen("tcp", s.config.Listen)
@@ -111,6 +121,25 @@ func (s *Server) StartServer(grpcServer string) error {
 	return nil
 }
 
+// SetValidatorExport sets the function that returns the last export of the validator set,
+// to be served at `/validators/export`. It should be called before starting the server.
+func (s *Server) SetValidatorExport(export func() []byte) {
+	s.valExport = export
+}
+
+// ValidatorExportHandler serves the last export of the validator set in JSON.
+func (s *Server) ValidatorExportHandler(w http.ResponseWriter, _ *http.Request) {
+	data := s.valExport()
+	if data == nil {
+		http.Error(w, "validator set is not exported yet", http.StatusServiceUnavailable)
+
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(data)
+}
+
 func (s *Server) StopServer() {
 	s.cancel()
 
@@ -155,9 +184,13 @@ func (s *Server) RootHandler(w http.ResponseWriter, _ *http.Request) {
 func (s *Server) writeError(w http.ResponseWriter, err error) int {
 	s.logger.Error("an error occurred", "error", err)
 
-	w.Header().Set("Content-Type", "text/plain")
+	// The body contains the machine-readable code of the error,
+	// in the same format as the details of the gRPC errors.
+	body, _ := protojson.Marshal(grpcserver.ErrorInfo(err))
+
+	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(http.StatusBadRequest)
-	n, _ := io.WriteString(w, err.Error())
+	n, _ := w.Write(body)
 
 	return n
 }
@@ -170,6 +203,19 @@ func (s *Server) writeHTML(w http.ResponseWriter, html string) int {
 	return n
 }
 
+func (s *Server) writeJSON(w http.ResponseWriter, obj any) int {
+	body, err := json.Marshal(obj)
+	if err != nil {
+		return s.writeError(w, err)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	n, _ := w.Write(body)
+
+	return n
+}
+
 type tableMaker struct {
 	w *bytes.Buffer
 }
@@ -17,7 +17,7 @@ func (s *Server) GetTransactionHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	res, err := s.transaction.GetTransaction(s.ctx,
+	res, err := s.transaction.GetTransaction(r.Context(),
 		&pactus.GetTransactionRequest{
 			Id:        id,
 			Verbosity: pactus.TransactionVerbosity_TRANSACTION_INFO,
@@ -41,7 +41,11 @@ func txToTable(trx *pactus.TransactionInfo, tm *tableMaker) {
 	tm.addRowTxID("ID", trx.Id)
 	tm.addRowBytes("Data", trx.Data)
 	tm.addRowInt("Version", int(trx.Version))
-	tm.addRowInt("LockTime", int(trx.LockTime))
+	if trx.LockTimeType == pactus.LockTimeType_LOCK_TIME_TIMESTAMP {
+		tm.addRowTime("LockTime", int64(trx.LockTime))
+	} else {
+		tm.addRowInt("LockTime", int(trx.LockTime))
+	}
 	tm.addRowInt("Fee", int(trx.Fee))
 	tm.addRowString("Memo", trx.Memo)
 	tm.addRowString("Payload type", trx.PayloadType.String())
@@ -72,6 +76,63 @@ func txToTable(trx *pactus.TransactionInfo, tm *tableMaker) {
 		tm.addRowValAddress("Sender", pld.From)
 		tm.addRowAccAddress("Receiver", pld.To)
 		tm.addRowAmount("Amount", pld.Amount)
+
+	case pactus.PayloadType_ESCROW_PAYLOAD:
+		pld := trx.Payload.(*pactus.TransactionInfo_Escrow).Escrow
+		tm.addRowAccAddress("Escrow", pld.Escrow)
+		tm.addRowAccAddress("Buyer", pld.Buyer)
+		tm.addRowAccAddress("Seller", pld.Seller)
+		tm.addRowAccAddress("Arbiter", pld.Arbiter)
+		tm.addRowInt("Nonce", int(pld.Nonce))
+		tm.addRowAccAddress("Sender", pld.Sender)
+		tm.addRowAccAddress("Receiver", pld.Receiver)
+		tm.addRowAccAddress("Approver", pld.Approver)
+		tm.addRowAmount("Amount", pld.Amount)
+
+	case pactus.PayloadType_DATA_PAYLOAD:
+		pld := trx.Payload.(*pactus.TransactionInfo_DataPayload).DataPayload
+		tm.addRowAccAddress("Sender", pld.Sender)
+		tm.addRowBytes("Data", pld.Data)
+
+	case pactus.PayloadType_ALLOWLIST_PAYLOAD:
+		pld := trx.Payload.(*pactus.TransactionInfo_Allowlist).Allowlist
+		tm.addRowAccAddress("Governor", pld.Governor)
+		tm.addRowValAddress("Validator", pld.Validator)
+		tm.addRowBool("Allow", pld.Allow)
+
+	case pactus.PayloadType_CONDITION_PAYLOAD:
+		pld := trx.Payload.(*pactus.TransactionInfo_Condition).Condition
+		tm.addRowAccAddress("Sender", pld.Sender)
+		tm.addRowAccAddress("Receiver", pld.Receiver)
+		tm.addRowAmount("Amount", pld.Amount)
+		for _, approver := range pld.Approvers {
+			tm.addRowAccAddress("Approver", approver)
+		}
+		if pld.Condition != nil {
+			tm.addRowInt("Unlock Height", int(pld.Condition.UnlockHeight))
+			tm.addRowAmount("Max Amount", pld.Condition.MaxAmount)
+			tm.addRowInt("Threshold", int(pld.Condition.Threshold))
+			for _, cosigner := range pld.Condition.Cosigners {
+				tm.addRowAccAddress("Cosigner", cosigner)
+			}
+		}
+
+	case pactus.PayloadType_DELEGATE_PAYLOAD:
+		pld := trx.Payload.(*pactus.TransactionInfo_Delegate).Delegate
+		tm.addRowAccAddress("Delegator", pld.Delegator)
+		tm.addRowValAddress("Validator", pld.Validator)
+		tm.addRowAmount("Stake", pld.Stake)
+		if pld.PublicKey != "" {
+			tm.addRowString("Validator PublicKey", pld.PublicKey)
+		}
+
+	case pactus.PayloadType_REWARD_SPLIT_PAYLOAD:
+		pld := trx.Payload.(*pactus.TransactionInfo_RewardSplit).RewardSplit
+		tm.addRowValAddress("Validator", pld.Validator)
+		for _, share := range pld.Shares {
+			tm.addRowAccAddress("Share", share.Address)
+			tm.addRowInt("Percent", int(share.Percent))
+		}
 	}
 	if trx.PublicKey != "" {
 		tm.addRowString("PublicKey", trx.PublicKey)
@@ -1,18 +1,33 @@
 package nanomsg
 
+import "github.com/pactus-project/pactus/util/errors"
+
+const (
+	// EncodingRaw publishes the events in the raw byte layout, followed by the sequence number.
+	EncodingRaw = "raw"
+	// EncodingProtobuf publishes the events as the protobuf messages, defined in the `event.proto` file.
+	EncodingProtobuf = "protobuf"
+)
+
 type Config struct {
-	Enable bool   `toml:"enable"`
-	Listen string `toml:"listen"`
+	Enable   bool   `toml:"enable"`
+	Listen   string `toml:"listen"`
+	Encoding string `toml:"encoding"`
 }
 
 func DefaultConfig() *Config {
 	return &Config{
-		Enable: false,
-		Listen: "",
+		Enable:   false,
+		Listen:   "",
+		Encoding: EncodingRaw,
 	}
 }
 
 // BasicCheck performs basic checks on the configuration.
 func (conf *Config) BasicCheck() error {
+	if conf.Encoding != EncodingRaw && conf.Encoding != EncodingProtobuf {
+		return errors.Errorf(errors.ErrInvalidConfig, "invalid nanomsg encoding: %s", conf.Encoding)
+	}
+
 	return nil
 }
@@ -2,6 +2,7 @@ package event
 
 import (
 	"bytes"
+	"fmt"
 
 	"github.com/pactus-project/pactus/crypto"
 	"github.com/pactus-project/pactus/crypto/hash"
@@ -10,10 +11,19 @@ import (
 	"github.com/pactus-project/pactus/util/logger"
 )
 
+// Topics and the structure of the events are stable and consumers can rely on them.
+// New topics can be added, but the existing ones should not be changed.
 const (
-	TopicBlock         = uint16(0x0101)
-	TopicTransaction   = uint16(0x0201)
-	TopicAccountChange = uint16(0x0301)
+	TopicBlock          = uint16(0x0101)
+	TopicRewardPayout   = uint16(0x0102)
+	TopicTreasury       = uint16(0x0103)
+	TopicForkDetected   = uint16(0x0104)
+	TopicAnomaly        = uint16(0x0105)
+	TopicTransaction    = uint16(0x0201)
+	TopicAccountChange  = uint16(0x0301)
+	TopicBalanceChange  = uint16(0x0302)
+	TopicWatchedAddress = uint16(0x0303)
+	TopicValidatorStake = uint16(0x0401)
 )
 
 type Event []byte
@@ -59,3 +69,130 @@ func CreateAccountChangeEvent(accountAddr crypto.Address, height uint32) Event {
 
 	return w.Bytes()
 }
+
+// CreateRewardPayoutEvent creates an event when the block reward is paid to the proposer.
+// The amount includes the block reward and the transaction fees.
+// The reward payout event structure is like :
+// <topic_id><reward_address><height><amount><sequence_number>.
+func CreateRewardPayoutEvent(rewardAddr crypto.Address, height uint32, amount int64) Event {
+	buf := make([]byte, 0, 35)
+	w := bytes.NewBuffer(buf)
+	err := encoding.WriteElements(w, TopicRewardPayout, rewardAddr, height, amount)
+	if err != nil {
+		logger.Error("error on encoding event in reward payout", "error", err)
+	}
+
+	return w.Bytes()
+}
+
+// CreateTreasuryEvent creates an event for the treasury accounting of the new block.
+// The block reward is paid from the treasury, and the fees are claimed back by the treasury.
+// The treasury event structure is like :
+// <topic_id><height><block_reward><fees><treasury_balance_before><treasury_balance_after><sequence_number>.
+func CreateTreasuryEvent(height uint32, blockReward, fees, balanceBefore, balanceAfter int64) Event {
+	buf := make([]byte, 0, 42)
+	w := bytes.NewBuffer(buf)
+	err := encoding.WriteElements(w, TopicTreasury, height, blockReward, fees, balanceBefore, balanceAfter)
+	if err != nil {
+		logger.Error("error on encoding event in treasury", "error", err)
+	}
+
+	return w.Bytes()
+}
+
+// CreateBalanceChangeEvent creates an event when the balance of an account is changed.
+// The difference between the old and the new balance is the credited or debited amount.
+// The balance change event structure is like :
+// <topic_id><account_address><height><old_balance><new_balance><sequence_number>.
+func CreateBalanceChangeEvent(accountAddr crypto.Address, height uint32, oldBalance, newBalance int64) Event {
+	buf := make([]byte, 0, 43)
+	w := bytes.NewBuffer(buf)
+	err := encoding.WriteElements(w, TopicBalanceChange, accountAddr, height, oldBalance, newBalance)
+	if err != nil {
+		logger.Error("error on encoding event in balance change", "error", err)
+	}
+
+	return w.Bytes()
+}
+
+// CreateValidatorStakeEvent creates an event when the stake of a validator is changed.
+// The validator stake event structure is like :
+// <topic_id><validator_address><height><old_stake><new_stake><sequence_number>.
+func CreateValidatorStakeEvent(valAddr crypto.Address, height uint32, oldStake, newStake int64) Event {
+	buf := make([]byte, 0, 43)
+	w := bytes.NewBuffer(buf)
+	err := encoding.WriteElements(w, TopicValidatorStake, valAddr, height, oldStake, newStake)
+	if err != nil {
+		logger.Error("error on encoding event in validator stake change", "error", err)
+	}
+
+	return w.Bytes()
+}
+
+// CreateWatchedAddressEvent creates an event when a watched address appears in a committed transaction.
+// The amount is the balance change of the address by the transaction.
+// The watched address event structure is like :
+// <topic_id><address><tx_hash><height><amount><sequence_number>.
+func CreateWatchedAddressEvent(addr crypto.Address, txID tx.ID, height uint32, amount int64) Event {
+	buf := make([]byte, 0, 67)
+	w := bytes.NewBuffer(buf)
+	err := encoding.WriteElements(w, TopicWatchedAddress, addr, txID, height, amount)
+	if err != nil {
+		logger.Error("error on encoding event in watched address", "error", err)
+	}
+
+	return w.Bytes()
+}
+
+// CreateForkDetectedEvent creates an event when a block is not built on top of the last committed block.
+// The fork detected event structure is like :
+// <topic_id><height><prev_block_hash><last_block_hash><sequence_number>.
+func CreateForkDetectedEvent(height uint32, prevBlockHash, lastBlockHash hash.Hash) Event {
+	buf := make([]byte, 0, 74)
+	w := bytes.NewBuffer(buf)
+	err := encoding.WriteElements(w, TopicForkDetected, height, prevBlockHash, lastBlockHash)
+	if err != nil {
+		logger.Error("error on encoding event in fork detected", "error", err)
+	}
+
+	return w.Bytes()
+}
+
+// CreateAnomalyEvent creates an event when an anomaly is detected in the core metrics of the chain,
+// like a sudden fee spike, a streak of empty blocks or a high committee churn.
+// The anomaly event structure is like :
+// <topic_id><height><kind><value><baseline><sequence_number>.
+func CreateAnomalyEvent(height uint32, kind uint8, value, baseline int64) Event {
+	buf := make([]byte, 0, 27)
+	w := bytes.NewBuffer(buf)
+	err := encoding.WriteElements(w, TopicAnomaly, height, kind, value, baseline)
+	if err != nil {
+		logger.Error("error on encoding event in anomaly", "error", err)
+	}
+
+	return w.Bytes()
+}
+
+// Topic returns the topic ID of the event.
+func (e Event) Topic() uint16 {
+	topic := uint16(0)
+	_ = encoding.ReadElement(bytes.NewReader(e), &topic)
+
+	return topic
+}
+
+// DecodeBlockEvent decodes the block hash and the height of a block event.
+func DecodeBlockEvent(e Event) (hash.Hash, uint32, error) {
+	topic := uint16(0)
+	blockHash := hash.Hash{}
+	height := uint32(0)
+	err := encoding.ReadElements(bytes.NewReader(e), &topic, &blockHash, &height)
+	if err != nil {
+		return hash.UndefHash, 0, err
+	}
+	if topic != TopicBlock {
+		return hash.UndefHash, 0, fmt.Errorf("not a block event: %x", topic)
+	}
+
+	return blockHash, height, nil
+}
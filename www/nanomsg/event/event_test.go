@@ -39,3 +39,113 @@ func TestCreateAccountChangeEvent(t *testing.T) {
 		0x3, 0x5a, 0xcc, 0x28, 0x54, 0x1c, 0x6a, 0xba, 0x6c, 0x9a, 0xad, 0x34, 0x21, 0x0, 0x0,
 	})
 }
+
+func TestCreateRewardPayoutEvent(t *testing.T) {
+	addr, _ := crypto.AddressFromString("pc1p0hrct7eflrpw4ccrttxzs4qud2axex4dcdzdfr")
+	height := uint32(0x2134)
+	amount := int64(0x0102030405)
+	e := CreateRewardPayoutEvent(addr, height, amount)
+	assert.Equal(t, e, Event{
+		0x02, 0x01, 0x1, 0x7d, 0xc7, 0x85, 0xfb, 0x29, 0xf8, 0xc2, 0xea, 0xe3,
+		0x3, 0x5a, 0xcc, 0x28, 0x54, 0x1c, 0x6a, 0xba, 0x6c, 0x9a, 0xad, 0x34, 0x21, 0x0, 0x0,
+		0x05, 0x04, 0x03, 0x02, 0x01, 0x0, 0x0, 0x0,
+	})
+}
+
+func TestCreateTreasuryEvent(t *testing.T) {
+	height := uint32(0x2134)
+	e := CreateTreasuryEvent(height, 0x0102, 0x0304, 0x0605, 0x0506)
+	assert.Equal(t, e, Event{
+		0x03, 0x01, 0x34, 0x21, 0x0, 0x0,
+		0x02, 0x01, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+		0x04, 0x03, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+		0x05, 0x06, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+		0x06, 0x05, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+	})
+}
+
+func TestCreateBalanceChangeEvent(t *testing.T) {
+	addr, _ := crypto.AddressFromString("pc1p0hrct7eflrpw4ccrttxzs4qud2axex4dcdzdfr")
+	height := uint32(0x2134)
+	e := CreateBalanceChangeEvent(addr, height, 0x0a0b, 0x0102)
+	assert.Equal(t, e, Event{
+		0x02, 0x03, 0x1, 0x7d, 0xc7, 0x85, 0xfb, 0x29, 0xf8, 0xc2, 0xea, 0xe3,
+		0x3, 0x5a, 0xcc, 0x28, 0x54, 0x1c, 0x6a, 0xba, 0x6c, 0x9a, 0xad, 0x34, 0x21, 0x0, 0x0,
+		0x0b, 0x0a, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+		0x02, 0x01, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+	})
+}
+
+func TestCreateValidatorStakeEvent(t *testing.T) {
+	addr, _ := crypto.AddressFromString("pc1p0hrct7eflrpw4ccrttxzs4qud2axex4dcdzdfr")
+	height := uint32(0x2134)
+	e := CreateValidatorStakeEvent(addr, height, 0, 0x0102)
+	assert.Equal(t, e, Event{
+		0x01, 0x04, 0x1, 0x7d, 0xc7, 0x85, 0xfb, 0x29, 0xf8, 0xc2, 0xea, 0xe3,
+		0x3, 0x5a, 0xcc, 0x28, 0x54, 0x1c, 0x6a, 0xba, 0x6c, 0x9a, 0xad, 0x34, 0x21, 0x0, 0x0,
+		0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+		0x02, 0x01, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+	})
+}
+
+func TestCreateWatchedAddressEvent(t *testing.T) {
+	addr, _ := crypto.AddressFromString("pc1p0hrct7eflrpw4ccrttxzs4qud2axex4dcdzdfr")
+	txID, _ := hash.FromString("000102030405060708090a0b0c0d0e0f000102030405060708090a0b0c0d0e0f")
+	height := uint32(0x2134)
+	e := CreateWatchedAddressEvent(addr, txID, height, -0x0102)
+	assert.Equal(t, e, Event{
+		0x03, 0x03, 0x1, 0x7d, 0xc7, 0x85, 0xfb, 0x29, 0xf8, 0xc2, 0xea, 0xe3,
+		0x3, 0x5a, 0xcc, 0x28, 0x54, 0x1c, 0x6a, 0xba, 0x6c, 0x9a, 0xad,
+		0x0, 0x1, 0x2, 0x3, 0x4, 0x5, 0x6, 0x7, 0x8, 0x9, 0xa, 0xb, 0xc, 0xd, 0xe, 0xf,
+		0x0, 0x1, 0x2, 0x3, 0x4, 0x5, 0x6, 0x7, 0x8, 0x9, 0xa, 0xb, 0xc, 0xd, 0xe, 0xf,
+		0x34, 0x21, 0x0, 0x0,
+		0xfe, 0xfe, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+	})
+}
+
+func TestCreateForkDetectedEvent(t *testing.T) {
+	h1, _ := hash.FromString("000102030405060708090a0b0c0d0e0f000102030405060708090a0b0c0d0e0f")
+	h2, _ := hash.FromString("0f0e0d0c0b0a090807060504030201000f0e0d0c0b0a09080706050403020100")
+	height := uint32(0x2134)
+	e := CreateForkDetectedEvent(height, h1, h2)
+	assert.Equal(t, e, Event{
+		0x04, 0x01, 0x34, 0x21, 0x0, 0x0,
+		0x0, 0x1, 0x2, 0x3, 0x4, 0x5, 0x6, 0x7, 0x8, 0x9, 0xa, 0xb, 0xc, 0xd, 0xe, 0xf,
+		0x0, 0x1, 0x2, 0x3, 0x4, 0x5, 0x6, 0x7, 0x8, 0x9, 0xa, 0xb, 0xc, 0xd, 0xe, 0xf,
+		0xf, 0xe, 0xd, 0xc, 0xb, 0xa, 0x9, 0x8, 0x7, 0x6, 0x5, 0x4, 0x3, 0x2, 0x1, 0x0,
+		0xf, 0xe, 0xd, 0xc, 0xb, 0xa, 0x9, 0x8, 0x7, 0x6, 0x5, 0x4, 0x3, 0x2, 0x1, 0x0,
+	})
+}
+
+func TestCreateAnomalyEvent(t *testing.T) {
+	height := uint32(0x2134)
+	e := CreateAnomalyEvent(height, 1, 0x0201, 0x04)
+	assert.Equal(t, TopicAnomaly, e.Topic())
+	assert.Equal(t, e, Event{
+		0x05, 0x01, 0x34, 0x21, 0x0, 0x0,
+		0x01,
+		0x01, 0x02, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+		0x04, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+	})
+}
+
+func TestDecodeBlockEvent(t *testing.T) {
+	h, _ := hash.FromString("000102030405060708090a0b0c0d0e0f000102030405060708090a0b0c0d0e0f")
+	height := uint32(0x2134)
+
+	e := CreateBlockEvent(h, height)
+	assert.Equal(t, TopicBlock, e.Topic())
+
+	decodedHash, decodedHeight, err := DecodeBlockEvent(e)
+	assert.NoError(t, err)
+	assert.Equal(t, h, decodedHash)
+	assert.Equal(t, height, decodedHeight)
+
+	e = CreateTransactionEvent(h, height)
+	assert.Equal(t, TopicTransaction, e.Topic())
+	_, _, err = DecodeBlockEvent(e)
+	assert.Error(t, err)
+
+	_, _, err = DecodeBlockEvent(Event{0x01})
+	assert.Error(t, err)
+}
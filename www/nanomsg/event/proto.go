@@ -0,0 +1,216 @@
+package event
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/pactus-project/pactus/crypto"
+	"github.com/pactus-project/pactus/crypto/hash"
+	"github.com/pactus-project/pactus/util/encoding"
+	pactus "github.com/pactus-project/pactus/www/grpc/gen/go"
+	"google.golang.org/protobuf/proto"
+)
+
+// Version is the version of the protobuf schema of the events.
+// It is increased only if a breaking change is made to the schema.
+const Version = uint32(1)
+
+// sequenceSize is the size of the sequence number at the end of the raw events.
+const sequenceSize = 4
+
+// ToProto converts the event to the protobuf message, defined in the `event.proto` file.
+// The sequence is the sequence number of the event in the transport.
+func (e Event) ToProto(seq uint32) (*pactus.Event, error) {
+	r := bytes.NewReader(e)
+	topic := uint16(0)
+	if err := encoding.ReadElement(r, &topic); err != nil {
+		return nil, err
+	}
+
+	pe := &pactus.Event{
+		Version:  Version,
+		Topic:    pactus.EventTopic(topic),
+		Sequence: seq,
+	}
+
+	var err error
+	switch topic {
+	case TopicBlock:
+		blockHash := hash.Hash{}
+		height := uint32(0)
+		err = encoding.ReadElements(r, &blockHash, &height)
+		pe.Payload = &pactus.Event_BlockCommitted{
+			BlockCommitted: &pactus.BlockCommittedEvent{
+				Hash:   blockHash.Bytes(),
+				Height: height,
+			},
+		}
+
+	case TopicRewardPayout:
+		addr := crypto.Address{}
+		height := uint32(0)
+		amount := int64(0)
+		err = encoding.ReadElements(r, &addr, &height, &amount)
+		pe.Payload = &pactus.Event_RewardPayout{
+			RewardPayout: &pactus.RewardPayoutEvent{
+				Address: addr.String(),
+				Height:  height,
+				Amount:  amount,
+			},
+		}
+
+	case TopicTreasury:
+		height := uint32(0)
+		blockReward, fees, balanceBefore, balanceAfter := int64(0), int64(0), int64(0), int64(0)
+		err = encoding.ReadElements(r, &height, &blockReward, &fees, &balanceBefore, &balanceAfter)
+		pe.Payload = &pactus.Event_Treasury{
+			Treasury: &pactus.TreasuryEvent{
+				Height:        height,
+				BlockReward:   blockReward,
+				Fees:          fees,
+				BalanceBefore: balanceBefore,
+				BalanceAfter:  balanceAfter,
+			},
+		}
+
+	case TopicForkDetected:
+		height := uint32(0)
+		prevBlockHash := hash.Hash{}
+		lastBlockHash := hash.Hash{}
+		err = encoding.ReadElements(r, &height, &prevBlockHash, &lastBlockHash)
+		pe.Payload = &pactus.Event_ForkDetected{
+			ForkDetected: &pactus.ForkDetectedEvent{
+				Height:        height,
+				PrevBlockHash: prevBlockHash.Bytes(),
+				LastBlockHash: lastBlockHash.Bytes(),
+			},
+		}
+
+	case TopicAnomaly:
+		height := uint32(0)
+		kind := uint8(0)
+		value, baseline := int64(0), int64(0)
+		err = encoding.ReadElements(r, &height, &kind, &value, &baseline)
+		pe.Payload = &pactus.Event_Anomaly{
+			Anomaly: &pactus.AnomalyEvent{
+				Height:   height,
+				Kind:     pactus.AnomalyKind(kind),
+				Value:    value,
+				Baseline: baseline,
+			},
+		}
+
+	case TopicTransaction:
+		txID := hash.Hash{}
+		height := uint32(0)
+		err = encoding.ReadElements(r, &txID, &height)
+		pe.Payload = &pactus.Event_TransactionConfirmed{
+			TransactionConfirmed: &pactus.TransactionConfirmedEvent{
+				Id:     txID.Bytes(),
+				Height: height,
+			},
+		}
+
+	case TopicAccountChange:
+		addr := crypto.Address{}
+		height := uint32(0)
+		err = encoding.ReadElements(r, &addr, &height)
+		pe.Payload = &pactus.Event_AccountChange{
+			AccountChange: &pactus.AccountChangeEvent{
+				Address: addr.String(),
+				Height:  height,
+			},
+		}
+
+	case TopicBalanceChange:
+		addr := crypto.Address{}
+		height := uint32(0)
+		oldBalance, newBalance := int64(0), int64(0)
+		err = encoding.ReadElements(r, &addr, &height, &oldBalance, &newBalance)
+		pe.Payload = &pactus.Event_BalanceChange{
+			BalanceChange: &pactus.BalanceChangeEvent{
+				Address:    addr.String(),
+				Height:     height,
+				OldBalance: oldBalance,
+				NewBalance: newBalance,
+			},
+		}
+
+	case TopicWatchedAddress:
+		addr := crypto.Address{}
+		txID := hash.Hash{}
+		height := uint32(0)
+		amount := int64(0)
+		err = encoding.ReadElements(r, &addr, &txID, &height, &amount)
+		pe.Payload = &pactus.Event_WatchedAddress{
+			WatchedAddress: &pactus.WatchedAddressEvent{
+				Address: addr.String(),
+				TxId:    txID.Bytes(),
+				Height:  height,
+				Amount:  amount,
+			},
+		}
+
+	case TopicValidatorStake:
+		addr := crypto.Address{}
+		height := uint32(0)
+		oldStake, newStake := int64(0), int64(0)
+		err = encoding.ReadElements(r, &addr, &height, &oldStake, &newStake)
+		pe.Payload = &pactus.Event_ValidatorStake{
+			ValidatorStake: &pactus.ValidatorStakeEvent{
+				Address:  addr.String(),
+				Height:   height,
+				OldStake: oldStake,
+				NewStake: newStake,
+			},
+		}
+
+	default:
+		return nil, fmt.Errorf("unknown event topic: %x", topic)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return pe, nil
+}
+
+// Encode encodes the event as the protobuf message.
+func (e Event) Encode(seq uint32) ([]byte, error) {
+	pe, err := e.ToProto(seq)
+	if err != nil {
+		return nil, err
+	}
+
+	return proto.Marshal(pe)
+}
+
+// Decode decodes an event that is published in the protobuf encoding.
+// It returns an error if the event is published with a newer version of the schema.
+func Decode(data []byte) (*pactus.Event, error) {
+	pe := new(pactus.Event)
+	if err := proto.Unmarshal(data, pe); err != nil {
+		return nil, err
+	}
+	if pe.Version > Version {
+		return nil, fmt.Errorf("unsupported event version: %d", pe.Version)
+	}
+
+	return pe, nil
+}
+
+// DecodeRaw decodes an event that is published in the raw encoding,
+// where the sequence number is appended to the end of the event.
+func DecodeRaw(data []byte) (*pactus.Event, error) {
+	if len(data) < sequenceSize {
+		return nil, fmt.Errorf("event is too short: %d", len(data))
+	}
+
+	seq := uint32(0)
+	seqData := data[len(data)-sequenceSize:]
+	if err := encoding.ReadElement(bytes.NewReader(seqData), &seq); err != nil {
+		return nil, err
+	}
+
+	return Event(data[:len(data)-sequenceSize]).ToProto(seq)
+}
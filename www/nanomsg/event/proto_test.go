@@ -0,0 +1,185 @@
+package event
+
+import (
+	"testing"
+
+	"github.com/pactus-project/pactus/crypto"
+	"github.com/pactus-project/pactus/crypto/hash"
+	pactus "github.com/pactus-project/pactus/www/grpc/gen/go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestToProto(t *testing.T) {
+	h1, _ := hash.FromString("000102030405060708090a0b0c0d0e0f000102030405060708090a0b0c0d0e0f")
+	h2, _ := hash.FromString("0f0e0d0c0b0a090807060504030201000f0e0d0c0b0a09080706050403020100")
+	addr, _ := crypto.AddressFromString("pc1p0hrct7eflrpw4ccrttxzs4qud2axex4dcdzdfr")
+	height := uint32(0x2134)
+
+	tests := []struct {
+		name     string
+		event    Event
+		expected *pactus.Event
+	}{
+		{
+			"block", CreateBlockEvent(h1, height),
+			&pactus.Event{
+				Topic: pactus.EventTopic_EVENT_TOPIC_BLOCK_COMMITTED,
+				Payload: &pactus.Event_BlockCommitted{
+					BlockCommitted: &pactus.BlockCommittedEvent{Hash: h1.Bytes(), Height: height},
+				},
+			},
+		},
+		{
+			"reward payout", CreateRewardPayoutEvent(addr, height, 1000),
+			&pactus.Event{
+				Topic: pactus.EventTopic_EVENT_TOPIC_REWARD_PAYOUT,
+				Payload: &pactus.Event_RewardPayout{
+					RewardPayout: &pactus.RewardPayoutEvent{Address: addr.String(), Height: height, Amount: 1000},
+				},
+			},
+		},
+		{
+			"treasury", CreateTreasuryEvent(height, 1, 2, 3, 4),
+			&pactus.Event{
+				Topic: pactus.EventTopic_EVENT_TOPIC_TREASURY,
+				Payload: &pactus.Event_Treasury{
+					Treasury: &pactus.TreasuryEvent{
+						Height: height, BlockReward: 1, Fees: 2, BalanceBefore: 3, BalanceAfter: 4,
+					},
+				},
+			},
+		},
+		{
+			"fork detected", CreateForkDetectedEvent(height, h1, h2),
+			&pactus.Event{
+				Topic: pactus.EventTopic_EVENT_TOPIC_FORK_DETECTED,
+				Payload: &pactus.Event_ForkDetected{
+					ForkDetected: &pactus.ForkDetectedEvent{
+						Height: height, PrevBlockHash: h1.Bytes(), LastBlockHash: h2.Bytes(),
+					},
+				},
+			},
+		},
+		{
+			"anomaly", CreateAnomalyEvent(height, 2, 30, 30),
+			&pactus.Event{
+				Topic: pactus.EventTopic_EVENT_TOPIC_ANOMALY,
+				Payload: &pactus.Event_Anomaly{
+					Anomaly: &pactus.AnomalyEvent{
+						Height: height, Kind: pactus.AnomalyKind_ANOMALY_KIND_EMPTY_BLOCKS, Value: 30, Baseline: 30,
+					},
+				},
+			},
+		},
+		{
+			"transaction", CreateTransactionEvent(h1, height),
+			&pactus.Event{
+				Topic: pactus.EventTopic_EVENT_TOPIC_TRANSACTION_CONFIRMED,
+				Payload: &pactus.Event_TransactionConfirmed{
+					TransactionConfirmed: &pactus.TransactionConfirmedEvent{Id: h1.Bytes(), Height: height},
+				},
+			},
+		},
+		{
+			"account change", CreateAccountChangeEvent(addr, height),
+			&pactus.Event{
+				Topic: pactus.EventTopic_EVENT_TOPIC_ACCOUNT_CHANGE,
+				Payload: &pactus.Event_AccountChange{
+					AccountChange: &pactus.AccountChangeEvent{Address: addr.String(), Height: height},
+				},
+			},
+		},
+		{
+			"balance change", CreateBalanceChangeEvent(addr, height, 10, 20),
+			&pactus.Event{
+				Topic: pactus.EventTopic_EVENT_TOPIC_BALANCE_CHANGE,
+				Payload: &pactus.Event_BalanceChange{
+					BalanceChange: &pactus.BalanceChangeEvent{
+						Address: addr.String(), Height: height, OldBalance: 10, NewBalance: 20,
+					},
+				},
+			},
+		},
+		{
+			"watched address", CreateWatchedAddressEvent(addr, h1, height, -10),
+			&pactus.Event{
+				Topic: pactus.EventTopic_EVENT_TOPIC_WATCHED_ADDRESS,
+				Payload: &pactus.Event_WatchedAddress{
+					WatchedAddress: &pactus.WatchedAddressEvent{
+						Address: addr.String(), TxId: h1.Bytes(), Height: height, Amount: -10,
+					},
+				},
+			},
+		},
+		{
+			"validator stake", CreateValidatorStakeEvent(addr, height, 0, 30),
+			&pactus.Event{
+				Topic: pactus.EventTopic_EVENT_TOPIC_VALIDATOR_STAKE,
+				Payload: &pactus.Event_ValidatorStake{
+					ValidatorStake: &pactus.ValidatorStakeEvent{
+						Address: addr.String(), Height: height, OldStake: 0, NewStake: 30,
+					},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.expected.Version = Version
+			tt.expected.Sequence = 7
+
+			pe, err := tt.event.ToProto(7)
+			require.NoError(t, err)
+			assert.True(t, proto.Equal(tt.expected, pe))
+
+			data, err := tt.event.Encode(7)
+			require.NoError(t, err)
+			decoded, err := Decode(data)
+			require.NoError(t, err)
+			assert.True(t, proto.Equal(tt.expected, decoded))
+		})
+	}
+}
+
+func TestToProtoInvalidEvent(t *testing.T) {
+	_, err := Event{0x01}.ToProto(0)
+	assert.Error(t, err)
+
+	_, err = Event{0xff, 0xff}.ToProto(0)
+	assert.Error(t, err)
+
+	// Truncated block event
+	_, err = Event{0x01, 0x01, 0x00}.ToProto(0)
+	assert.Error(t, err)
+}
+
+func TestDecodeNewerVersion(t *testing.T) {
+	data, err := proto.Marshal(&pactus.Event{Version: Version + 1})
+	require.NoError(t, err)
+
+	_, err = Decode(data)
+	assert.Error(t, err)
+
+	_, err = Decode([]byte{0xff})
+	assert.Error(t, err)
+}
+
+func TestDecodeRaw(t *testing.T) {
+	h, _ := hash.FromString("000102030405060708090a0b0c0d0e0f000102030405060708090a0b0c0d0e0f")
+	height := uint32(0x2134)
+	data := append(CreateBlockEvent(h, height), 0x05, 0x00, 0x00, 0x00)
+
+	pe, err := DecodeRaw(data)
+	require.NoError(t, err)
+	assert.Equal(t, Version, pe.Version)
+	assert.Equal(t, uint32(5), pe.Sequence)
+	assert.Equal(t, pactus.EventTopic_EVENT_TOPIC_BLOCK_COMMITTED, pe.Topic)
+	assert.Equal(t, h.Bytes(), pe.GetBlockCommitted().Hash)
+	assert.Equal(t, height, pe.GetBlockCommitted().Height)
+
+	_, err = DecodeRaw([]byte{0x01, 0x01})
+	assert.Error(t, err)
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/pactus-project/pactus/util/encoding"
 	"github.com/pactus-project/pactus/util/logger"
+	"github.com/pactus-project/pactus/util/recovery"
 	"github.com/pactus-project/pactus/www/nanomsg/event"
 	mangos "go.nanomsg.org/mangos/v3"
 	"go.nanomsg.org/mangos/v3/protocol/pub"
@@ -54,7 +55,7 @@ func (s *Server) StartServer() error {
 
 	s.logger.Info("nanomsg started listening", "address", listener.Address())
 
-	go s.eventLoop()
+	go recovery.Loop("nanomsg", s.logger, s.eventLoop)
 
 	return nil
 }
@@ -73,14 +74,13 @@ func (s *Server) eventLoop() {
 			return
 
 		case e := <-s.eventCh:
-			w := bytes.NewBuffer(e)
-			err := encoding.WriteElement(w, s.seqNum)
+			data, err := s.encode(e)
 			if err != nil {
 				s.logger.Error("error on encoding event", "error", err)
 
-				return
+				continue
 			}
-			err = s.publisher.Send(w.Bytes())
+			err = s.publisher.Send(data)
 			if err != nil {
 				s.logger.Error("error on emitting event", "error", err)
 
@@ -90,3 +90,17 @@ func (s *Server) eventLoop() {
 		}
 	}
 }
+
+// encode encodes the event based on the encoding in the config.
+func (s *Server) encode(e event.Event) ([]byte, error) {
+	if s.config.Encoding == EncodingProtobuf {
+		return e.Encode(s.seqNum)
+	}
+
+	w := bytes.NewBuffer(e)
+	if err := encoding.WriteElement(w, s.seqNum); err != nil {
+		return nil, err
+	}
+
+	return w.Bytes(), nil
+}
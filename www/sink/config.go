@@ -0,0 +1,88 @@
+package sink
+
+import (
+	"time"
+
+	"github.com/pactus-project/pactus/util/errors"
+)
+
+const (
+	// EncodingProtobuf publishes the events as the protobuf messages, defined in the `event.proto` file.
+	EncodingProtobuf = "protobuf"
+	// EncodingJSON publishes the events as the JSON form of the protobuf messages.
+	EncodingJSON = "json"
+)
+
+type Config struct {
+	Encoding string       `toml:"encoding"`
+	NATS     *NATSConfig  `toml:"nats"`
+	Kafka    *KafkaConfig `toml:"kafka"`
+}
+
+// NATSConfig configures the NATS sink. Each event is published to a subject
+// that is made of the subject prefix and the topic of the event, like `pactus.events.block_committed`.
+type NATSConfig struct {
+	Enable        bool          `toml:"enable"`
+	URL           string        `toml:"url"`
+	SubjectPrefix string        `toml:"subject_prefix"`
+	Timeout       time.Duration `toml:"timeout"`
+}
+
+// KafkaConfig configures the Kafka sink. All the events are published to one Kafka topic,
+// and the topic of the event is the key of the message, so the events of a topic stay in order.
+type KafkaConfig struct {
+	Enable  bool          `toml:"enable"`
+	Brokers []string      `toml:"brokers"`
+	Topic   string        `toml:"topic"`
+	Timeout time.Duration `toml:"timeout"`
+}
+
+func DefaultConfig() *Config {
+	return &Config{
+		Encoding: EncodingJSON,
+		NATS: &NATSConfig{
+			Enable:        false,
+			URL:           "nats://127.0.0.1:4222",
+			SubjectPrefix: "pactus.events",
+			Timeout:       5 * time.Second,
+		},
+		Kafka: &KafkaConfig{
+			Enable:  false,
+			Brokers: []string{},
+			Topic:   "pactus-events",
+			Timeout: 5 * time.Second,
+		},
+	}
+}
+
+// IsEnabled checks if any of the sinks is enabled.
+func (conf *Config) IsEnabled() bool {
+	return conf.NATS.Enable || conf.Kafka.Enable
+}
+
+// BasicCheck performs basic checks on the configuration.
+func (conf *Config) BasicCheck() error {
+	if conf.Encoding != EncodingProtobuf && conf.Encoding != EncodingJSON {
+		return errors.Errorf(errors.ErrInvalidConfig, "invalid sink encoding: %s", conf.Encoding)
+	}
+
+	if conf.NATS.Enable {
+		if conf.NATS.URL == "" {
+			return errors.Errorf(errors.ErrInvalidConfig, "NATS URL is not set")
+		}
+		if conf.NATS.SubjectPrefix == "" {
+			return errors.Errorf(errors.ErrInvalidConfig, "NATS subject prefix is not set")
+		}
+	}
+
+	if conf.Kafka.Enable {
+		if len(conf.Kafka.Brokers) == 0 {
+			return errors.Errorf(errors.ErrInvalidConfig, "no Kafka broker is set")
+		}
+		if conf.Kafka.Topic == "" {
+			return errors.Errorf(errors.ErrInvalidConfig, "Kafka topic is not set")
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,58 @@
+package sink
+
+import (
+	"context"
+	"time"
+
+	"github.com/pactus-project/pactus/util/logger"
+	"github.com/segmentio/kafka-go"
+)
+
+// kafkaBatchTimeout is the time that the events are batched before they are written to Kafka.
+const kafkaBatchTimeout = 50 * time.Millisecond
+
+// kafkaSink publishes the events to a Kafka topic.
+// The events are written asynchronously, so a slow broker doesn't block the node.
+type kafkaSink struct {
+	writer *kafka.Writer
+}
+
+func newKafkaSink(conf *KafkaConfig, log *logger.SubLogger) *kafkaSink {
+	writer := &kafka.Writer{
+		Addr:         kafka.TCP(conf.Brokers...),
+		Topic:        conf.Topic,
+		Balancer:     &kafka.Hash{},
+		BatchTimeout: kafkaBatchTimeout,
+		WriteTimeout: conf.Timeout,
+		RequiredAcks: kafka.RequireOne,
+		Async:        true,
+		Completion: func(messages []kafka.Message, err error) {
+			if err != nil {
+				log.Warn("unable to write events to Kafka", "count", len(messages), "error", err)
+			}
+		},
+	}
+
+	return &kafkaSink{writer: writer}
+}
+
+func (*kafkaSink) Name() string {
+	return "kafka"
+}
+
+func (s *kafkaSink) Publish(subject string, data []byte) error {
+	return s.writer.WriteMessages(context.Background(), kafkaMessage(subject, data))
+}
+
+func (s *kafkaSink) Close() error {
+	return s.writer.Close()
+}
+
+// kafkaMessage makes the Kafka message of an event.
+// The subject is the key, so the events of the same topic go to the same partition.
+func kafkaMessage(subject string, data []byte) kafka.Message {
+	return kafka.Message{
+		Key:   []byte(subject),
+		Value: data,
+	}
+}
@@ -0,0 +1,47 @@
+package sink
+
+import (
+	"github.com/nats-io/nats.go"
+	"github.com/pactus-project/pactus/util/logger"
+)
+
+// natsSink publishes the events to a NATS server.
+// The NATS client buffers the events while it is reconnecting to the server.
+type natsSink struct {
+	conn   *nats.Conn
+	prefix string
+}
+
+func newNATSSink(conf *NATSConfig, log *logger.SubLogger) (*natsSink, error) {
+	conn, err := nats.Connect(conf.URL,
+		nats.Name("pactus"),
+		nats.Timeout(conf.Timeout),
+		nats.MaxReconnects(-1),
+		nats.DisconnectErrHandler(func(_ *nats.Conn, err error) {
+			log.Warn("disconnected from NATS server", "error", err)
+		}),
+		nats.ReconnectHandler(func(conn *nats.Conn) {
+			log.Info("reconnected to NATS server", "url", conn.ConnectedUrl())
+		}),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &natsSink{
+		conn:   conn,
+		prefix: conf.SubjectPrefix,
+	}, nil
+}
+
+func (*natsSink) Name() string {
+	return "nats"
+}
+
+func (s *natsSink) Publish(subject string, data []byte) error {
+	return s.conn.Publish(s.prefix+"."+subject, data)
+}
+
+func (s *natsSink) Close() error {
+	return s.conn.Drain()
+}
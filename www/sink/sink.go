@@ -0,0 +1,136 @@
+// Package sink publishes the node events to the external messaging systems, like NATS and Kafka,
+// so the data pipelines can ingest the chain events without running a bridge to the nanomsg service.
+package sink
+
+import (
+	"context"
+	"strings"
+
+	"github.com/pactus-project/pactus/util/logger"
+	"github.com/pactus-project/pactus/util/recovery"
+	pactus "github.com/pactus-project/pactus/www/grpc/gen/go"
+	"github.com/pactus-project/pactus/www/nanomsg/event"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// Sink is a driver that delivers the encoded events to an external system.
+type Sink interface {
+	// Name returns the name of the sink, used for logging.
+	Name() string
+	// Publish delivers the event, encoded in data, to the subject of its topic.
+	// It should not block the caller for long, the slow deliveries should be done asynchronously.
+	Publish(subject string, data []byte) error
+	// Close flushes the pending events and closes the connection.
+	Close() error
+}
+
+// Dispatcher encodes the node events and publishes them to the enabled sinks.
+type Dispatcher struct {
+	ctx     context.Context
+	cancel  context.CancelFunc
+	config  *Config
+	sinks   []Sink
+	eventCh <-chan event.Event
+	seqNum  uint32
+	logger  *logger.SubLogger
+}
+
+func NewDispatcher(conf *Config, eventCh <-chan event.Event) *Dispatcher {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	return &Dispatcher{
+		ctx:     ctx,
+		cancel:  cancel,
+		config:  conf,
+		eventCh: eventCh,
+		logger:  logger.NewSubLogger("_sink", nil),
+	}
+}
+
+// Start connects to the enabled sinks and starts publishing the events.
+func (d *Dispatcher) Start() error {
+	if !d.config.IsEnabled() {
+		return nil
+	}
+
+	if d.config.NATS.Enable {
+		natsSink, err := newNATSSink(d.config.NATS, d.logger)
+		if err != nil {
+			return err
+		}
+		d.sinks = append(d.sinks, natsSink)
+	}
+
+	if d.config.Kafka.Enable {
+		d.sinks = append(d.sinks, newKafkaSink(d.config.Kafka, d.logger))
+	}
+
+	for _, s := range d.sinks {
+		d.logger.Info("event sink started", "sink", s.Name())
+	}
+
+	go recovery.Loop("sink", d.logger, d.eventLoop)
+
+	return nil
+}
+
+func (d *Dispatcher) Stop() {
+	d.cancel()
+
+	for _, s := range d.sinks {
+		if err := s.Close(); err != nil {
+			d.logger.Warn("unable to close event sink", "sink", s.Name(), "error", err)
+		}
+	}
+}
+
+func (d *Dispatcher) eventLoop() {
+	for {
+		select {
+		case <-d.ctx.Done():
+			return
+
+		case e := <-d.eventCh:
+			d.dispatch(e)
+		}
+	}
+}
+
+func (d *Dispatcher) dispatch(e event.Event) {
+	pe, err := e.ToProto(d.seqNum)
+	if err != nil {
+		d.logger.Error("error on decoding event", "error", err)
+
+		return
+	}
+
+	data, err := d.encode(pe)
+	if err != nil {
+		d.logger.Error("error on encoding event", "error", err)
+
+		return
+	}
+
+	subject := subjectOf(pe.Topic)
+	for _, s := range d.sinks {
+		if err := s.Publish(subject, data); err != nil {
+			d.logger.Warn("unable to publish event", "sink", s.Name(), "subject", subject, "error", err)
+		}
+	}
+	d.seqNum++
+}
+
+// encode encodes the event based on the encoding in the config.
+func (d *Dispatcher) encode(pe *pactus.Event) ([]byte, error) {
+	if d.config.Encoding == EncodingProtobuf {
+		return proto.Marshal(pe)
+	}
+
+	return protojson.Marshal(pe)
+}
+
+// subjectOf returns the subject of the event topic, like `block_committed`.
+func subjectOf(topic pactus.EventTopic) string {
+	return strings.ToLower(strings.TrimPrefix(topic.String(), "EVENT_TOPIC_"))
+}
@@ -0,0 +1,246 @@
+package sink
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/pactus-project/pactus/util/testsuite"
+	pactus "github.com/pactus-project/pactus/www/grpc/gen/go"
+	"github.com/pactus-project/pactus/www/nanomsg/event"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+type published struct {
+	subject string
+	data    []byte
+}
+
+type mockSink struct {
+	published []published
+}
+
+func (*mockSink) Name() string {
+	return "mock"
+}
+
+func (s *mockSink) Publish(subject string, data []byte) error {
+	s.published = append(s.published, published{subject: subject, data: data})
+
+	return nil
+}
+
+func (*mockSink) Close() error {
+	return nil
+}
+
+func TestConfigBasicCheck(t *testing.T) {
+	t.Run("Default config", func(t *testing.T) {
+		conf := DefaultConfig()
+		assert.NoError(t, conf.BasicCheck())
+		assert.False(t, conf.IsEnabled())
+	})
+
+	t.Run("Invalid encoding", func(t *testing.T) {
+		conf := DefaultConfig()
+		conf.Encoding = "raw"
+		assert.Error(t, conf.BasicCheck())
+	})
+
+	t.Run("NATS without URL", func(t *testing.T) {
+		conf := DefaultConfig()
+		conf.NATS.Enable = true
+		conf.NATS.URL = ""
+		assert.Error(t, conf.BasicCheck())
+	})
+
+	t.Run("NATS without subject prefix", func(t *testing.T) {
+		conf := DefaultConfig()
+		conf.NATS.Enable = true
+		conf.NATS.SubjectPrefix = ""
+		assert.Error(t, conf.BasicCheck())
+	})
+
+	t.Run("Kafka without brokers", func(t *testing.T) {
+		conf := DefaultConfig()
+		conf.Kafka.Enable = true
+		assert.Error(t, conf.BasicCheck())
+	})
+
+	t.Run("Kafka without topic", func(t *testing.T) {
+		conf := DefaultConfig()
+		conf.Kafka.Enable = true
+		conf.Kafka.Brokers = []string{"127.0.0.1:9092"}
+		conf.Kafka.Topic = ""
+		assert.Error(t, conf.BasicCheck())
+	})
+
+	t.Run("Ok", func(t *testing.T) {
+		conf := DefaultConfig()
+		conf.Encoding = EncodingProtobuf
+		conf.NATS.Enable = true
+		conf.Kafka.Enable = true
+		conf.Kafka.Brokers = []string{"127.0.0.1:9092"}
+		assert.NoError(t, conf.BasicCheck())
+		assert.True(t, conf.IsEnabled())
+	})
+}
+
+func TestDispatch(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	blockHash := ts.RandHash()
+	height := ts.RandHeight()
+	blockEvent := event.CreateBlockEvent(blockHash, height)
+	txEvent := event.CreateTransactionEvent(ts.RandHash(), height)
+
+	t.Run("JSON encoding", func(t *testing.T) {
+		sink := &mockSink{}
+		d := NewDispatcher(DefaultConfig(), nil)
+		d.sinks = []Sink{sink}
+
+		d.dispatch(blockEvent)
+		d.dispatch(txEvent)
+
+		require.Len(t, sink.published, 2)
+		assert.Equal(t, "block_committed", sink.published[0].subject)
+		assert.Equal(t, "transaction_confirmed", sink.published[1].subject)
+
+		pe := new(pactus.Event)
+		require.NoError(t, protojson.Unmarshal(sink.published[0].data, pe))
+		assert.Equal(t, pactus.EventTopic_EVENT_TOPIC_BLOCK_COMMITTED, pe.Topic)
+		assert.Equal(t, blockHash.Bytes(), pe.GetBlockCommitted().Hash)
+		assert.Equal(t, height, pe.GetBlockCommitted().Height)
+		assert.Zero(t, pe.Sequence)
+
+		require.NoError(t, protojson.Unmarshal(sink.published[1].data, pe))
+		assert.Equal(t, uint32(1), pe.Sequence)
+	})
+
+	t.Run("Protobuf encoding", func(t *testing.T) {
+		sink := &mockSink{}
+		conf := DefaultConfig()
+		conf.Encoding = EncodingProtobuf
+		d := NewDispatcher(conf, nil)
+		d.sinks = []Sink{sink}
+
+		d.dispatch(blockEvent)
+
+		require.Len(t, sink.published, 1)
+		pe, err := event.Decode(sink.published[0].data)
+		require.NoError(t, err)
+		assert.Equal(t, height, pe.GetBlockCommitted().Height)
+	})
+
+	t.Run("Invalid event", func(t *testing.T) {
+		sink := &mockSink{}
+		d := NewDispatcher(DefaultConfig(), nil)
+		d.sinks = []Sink{sink}
+
+		d.dispatch(event.Event{0x01})
+
+		assert.Empty(t, sink.published)
+		assert.Zero(t, d.seqNum)
+	})
+}
+
+// runNATSServer runs a minimal NATS server that accepts one client
+// and sends the published messages to the returned channel.
+func runNATSServer(t *testing.T) (string, <-chan published) {
+	t.Helper()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = listener.Close() })
+
+	pubCh := make(chan published, 10)
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		_, _ = fmt.Fprintf(conn, "INFO {\"server_id\":\"test\",\"version\":\"2.10.0\",\"max_payload\":1048576}\r\n")
+		r := bufio.NewReader(conn)
+		for {
+			line, err := r.ReadString('\n')
+			if err != nil {
+				return
+			}
+			fields := strings.Fields(line)
+			if len(fields) == 0 {
+				continue
+			}
+
+			switch fields[0] {
+			case "PING":
+				_, _ = fmt.Fprintf(conn, "PONG\r\n")
+
+			case "PUB":
+				size, _ := strconv.Atoi(fields[len(fields)-1])
+				data := make([]byte, size+2) // Including the trailing CRLF
+				if _, err := io.ReadFull(r, data); err != nil {
+					return
+				}
+				pubCh <- published{subject: fields[1], data: data[:size]}
+			}
+		}
+	}()
+
+	return "nats://" + listener.Addr().String(), pubCh
+}
+
+func TestNATSSink(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	url, pubCh := runNATSServer(t)
+	conf := DefaultConfig()
+	conf.NATS.Enable = true
+	conf.NATS.URL = url
+	conf.NATS.SubjectPrefix = "pactus.test"
+
+	eventCh := make(chan event.Event, 1)
+	d := NewDispatcher(conf, eventCh)
+	require.NoError(t, d.Start())
+
+	height := ts.RandHeight()
+	eventCh <- event.CreateBlockEvent(ts.RandHash(), height)
+
+	select {
+	case pub := <-pubCh:
+		assert.Equal(t, "pactus.test.block_committed", pub.subject)
+
+		pe := new(pactus.Event)
+		require.NoError(t, protojson.Unmarshal(pub.data, pe))
+		assert.Equal(t, height, pe.GetBlockCommitted().Height)
+
+	case <-time.After(5 * time.Second):
+		require.Fail(t, "event is not published")
+	}
+
+	d.Stop()
+}
+
+func TestNATSSinkUnreachable(t *testing.T) {
+	conf := DefaultConfig()
+	conf.NATS.Enable = true
+	conf.NATS.URL = "nats://127.0.0.1:1"
+	conf.NATS.Timeout = time.Second
+
+	d := NewDispatcher(conf, nil)
+	assert.Error(t, d.Start())
+}
+
+func TestKafkaMessage(t *testing.T) {
+	msg := kafkaMessage("block_committed", []byte{1, 2, 3})
+	assert.Equal(t, []byte("block_committed"), msg.Key)
+	assert.Equal(t, []byte{1, 2, 3}, msg.Value)
+}